@@ -0,0 +1,1761 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/bindings_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "bindings",
+  tests: [
+    {
+      original: {
+        name: "single bind",
+        expr: "cel.bind(a, 'hell' + 'o' + '!', \"%s, %s, %s\".format([a, a, a])) ==\n\t                       'hello!, hello!, hello' + '!'",
+        value: { boolValue: true },
+      },
+      library: "bindings",
+      ast: '_==_(\n  cel^#*expr.Expr_IdentExpr#.bind(\n    a^#*expr.Expr_IdentExpr#,\n    _+_(\n      _+_(\n        "hell"^#*expr.Constant_StringValue#,\n        "o"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      "!"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    "%s, %s, %s"^#*expr.Constant_StringValue#.format(\n      [\n        a^#*expr.Expr_IdentExpr#,\n        a^#*expr.Expr_IdentExpr#,\n        a^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    "hello!, hello!, hello"^#*expr.Constant_StringValue#,\n    "!"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'cel.bind(a, "hell" + "o" + "!", "%s, %s, %s".format([a, a, a])) == "hello!, hello!, hello" + "!"',
+      locationAst:
+        '_==_(\n  cel^#1[1,0]#.bind(\n    a^#3[1,9]#,\n    _+_(\n      _+_(\n        "hell"^#4[1,12]#,\n        "o"^#6[1,21]#\n      )^#5[1,19]#,\n      "!"^#8[1,27]#\n    )^#7[1,25]#,\n    "%s, %s, %s"^#9[1,32]#.format(\n      [\n        a^#12[1,53]#,\n        a^#13[1,56]#,\n        a^#14[1,59]#\n      ]^#11[1,52]#\n    )^#10[1,51]#\n  )^#2[1,8]#,\n  _+_(\n    "hello!, hello!, hello"^#16[2,24]#,\n    "!"^#18[2,50]#\n  )^#17[2,48]#\n)^#15[1,64]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 18, 1, 12, 1, 18],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 21, 24, 1, 21, 1, 24],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 27, 30, 1, 27, 1, 30],
+        [9, 32, 44, 1, 32, 1, 44],
+        [10, 51, 52, 1, 51, 1, 52],
+        [11, 52, 53, 1, 52, 1, 53],
+        [12, 53, 54, 1, 53, 1, 54],
+        [13, 56, 57, 1, 56, 1, 57],
+        [14, 59, 60, 1, 59, 1, 60],
+        [15, 64, 66, 1, 64, 1, 66],
+        [16, 91, 114, 2, 24, 2, 47],
+        [17, 115, 116, 2, 48, 2, 49],
+        [18, 117, 120, 2, 50, 2, 53],
+      ],
+      lineOffsets: [67, 121],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "cel" } },
+                  function: "bind",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "4", constExpr: { stringValue: "hell" } },
+                                { id: "6", constExpr: { stringValue: "o" } },
+                              ],
+                            },
+                          },
+                          { id: "8", constExpr: { stringValue: "!" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "10",
+                      callExpr: {
+                        target: {
+                          id: "9",
+                          constExpr: { stringValue: "%s, %s, %s" },
+                        },
+                        function: "format",
+                        args: [
+                          {
+                            id: "11",
+                            listExpr: {
+                              elements: [
+                                { id: "12", identExpr: { name: "a" } },
+                                { id: "13", identExpr: { name: "a" } },
+                                { id: "14", identExpr: { name: "a" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "17",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "16",
+                      constExpr: { stringValue: "hello!, hello!, hello" },
+                    },
+                    { id: "18", constExpr: { stringValue: "!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "single bind",
+          lineOffsets: [67, 121],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 12,
+            "5": 19,
+            "6": 21,
+            "7": 25,
+            "8": 27,
+            "9": 32,
+            "10": 51,
+            "11": 52,
+            "12": 53,
+            "13": 56,
+            "14": 59,
+            "15": 64,
+            "16": 91,
+            "17": 115,
+            "18": 117,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    a,\n    // Init\n    _+_(\n      _+_(\n        "hell"~string,\n        "o"~string\n      )~string^add_string,\n      "!"~string\n    )~string^add_string,\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    a~string^a,\n    // Result\n    "%s, %s, %s"~string.format(\n      [\n        a~string^a,\n        a~string^a,\n        a~string^a\n      ]~list(string)\n    )~string^string_format)~string,\n  _+_(\n    "hello!, hello!, hello"~string,\n    "!"~string\n  )~string^add_string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["add_string"] },
+          "7": { overloadId: ["add_string"] },
+          "10": { overloadId: ["string_format"] },
+          "12": { name: "a" },
+          "13": { name: "a" },
+          "14": { name: "a" },
+          "17": { name: "a" },
+          "19": { overloadId: ["equals"] },
+          "21": { overloadId: ["add_string"] },
+        },
+        typeMap: {
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+          "11": { listType: { elemType: { primitive: "STRING" } } },
+          "12": { primitive: "STRING" },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "STRING" },
+          "15": { listType: { elemType: { dyn: {} } } },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "STRING" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "STRING" },
+          "21": { primitive: "STRING" },
+          "22": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67, 121],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 19,
+            "6": 21,
+            "7": 25,
+            "8": 27,
+            "9": 32,
+            "10": 51,
+            "11": 52,
+            "12": 53,
+            "13": 56,
+            "14": 59,
+            "15": 8,
+            "16": 8,
+            "17": 8,
+            "18": 8,
+            "19": 64,
+            "20": 91,
+            "21": 115,
+            "22": 117,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        {
+                          id: "5",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "4", constExpr: { stringValue: "hell" } },
+                              { id: "6", constExpr: { stringValue: "o" } },
+                            ],
+                          },
+                        },
+                        { id: "8", constExpr: { stringValue: "!" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "10",
+                    callExpr: {
+                      target: {
+                        id: "9",
+                        constExpr: { stringValue: "%s, %s, %s" },
+                      },
+                      function: "format",
+                      args: [
+                        {
+                          id: "11",
+                          listExpr: {
+                            elements: [
+                              { id: "12", identExpr: { name: "a" } },
+                              { id: "13", identExpr: { name: "a" } },
+                              { id: "14", identExpr: { name: "a" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "19",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "18",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "15", listExpr: {} },
+                  accuVar: "a",
+                  accuInit: {
+                    id: "7",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        {
+                          id: "5",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "4", constExpr: { stringValue: "hell" } },
+                              { id: "6", constExpr: { stringValue: "o" } },
+                            ],
+                          },
+                        },
+                        { id: "8", constExpr: { stringValue: "!" } },
+                      ],
+                    },
+                  },
+                  loopCondition: { id: "16", constExpr: { boolValue: false } },
+                  loopStep: { id: "17", identExpr: { name: "a" } },
+                  result: {
+                    id: "10",
+                    callExpr: {
+                      target: {
+                        id: "9",
+                        constExpr: { stringValue: "%s, %s, %s" },
+                      },
+                      function: "format",
+                      args: [
+                        {
+                          id: "11",
+                          listExpr: {
+                            elements: [
+                              { id: "12", identExpr: { name: "a" } },
+                              { id: "13", identExpr: { name: "a" } },
+                              { id: "14", identExpr: { name: "a" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "21",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "20",
+                      constExpr: { stringValue: "hello!, hello!, hello" },
+                    },
+                    { id: "22", constExpr: { stringValue: "!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "30", max: "32" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "32",
+    },
+    {
+      original: {
+        name: "multiple binds",
+        expr: "cel.bind(a, 'hello!',\n\t\t       cel.bind(b, 'goodbye',\n\t\t\t\ta + ' and, ' + b)) == 'hello! and, goodbye'",
+        value: { boolValue: true },
+      },
+      library: "bindings",
+      ast: '_==_(\n  cel^#*expr.Expr_IdentExpr#.bind(\n    a^#*expr.Expr_IdentExpr#,\n    "hello!"^#*expr.Constant_StringValue#,\n    cel^#*expr.Expr_IdentExpr#.bind(\n      b^#*expr.Expr_IdentExpr#,\n      "goodbye"^#*expr.Constant_StringValue#,\n      _+_(\n        _+_(\n          a^#*expr.Expr_IdentExpr#,\n          " and, "^#*expr.Constant_StringValue#\n        )^#*expr.Expr_CallExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  "hello! and, goodbye"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'cel.bind(a, "hello!", cel.bind(b, "goodbye", a + " and, " + b)) == "hello! and, goodbye"',
+      locationAst:
+        '_==_(\n  cel^#1[1,0]#.bind(\n    a^#3[1,9]#,\n    "hello!"^#4[1,12]#,\n    cel^#5[2,9]#.bind(\n      b^#7[2,18]#,\n      "goodbye"^#8[2,21]#,\n      _+_(\n        _+_(\n          a^#9[3,4]#,\n          " and, "^#11[3,8]#\n        )^#10[3,6]#,\n        b^#13[3,19]#\n      )^#12[3,17]#\n    )^#6[2,17]#\n  )^#2[1,8]#,\n  "hello! and, goodbye"^#15[3,26]#\n)^#14[3,23]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 20, 1, 12, 1, 20],
+        [5, 31, 34, 2, 9, 2, 12],
+        [6, 39, 40, 2, 17, 2, 18],
+        [7, 40, 41, 2, 18, 2, 19],
+        [8, 43, 52, 2, 21, 2, 30],
+        [9, 58, 59, 3, 4, 3, 5],
+        [10, 60, 61, 3, 6, 3, 7],
+        [11, 62, 70, 3, 8, 3, 16],
+        [12, 71, 72, 3, 17, 3, 18],
+        [13, 73, 74, 3, 19, 3, 20],
+        [14, 77, 79, 3, 23, 3, 25],
+        [15, 80, 101, 3, 26, 3, 47],
+      ],
+      lineOffsets: [22, 54, 102],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "cel" } },
+                  function: "bind",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    { id: "4", constExpr: { stringValue: "hello!" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        target: { id: "5", identExpr: { name: "cel" } },
+                        function: "bind",
+                        args: [
+                          { id: "7", identExpr: { name: "b" } },
+                          { id: "8", constExpr: { stringValue: "goodbye" } },
+                          {
+                            id: "12",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                {
+                                  id: "10",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      { id: "9", identExpr: { name: "a" } },
+                                      {
+                                        id: "11",
+                                        constExpr: { stringValue: " and, " },
+                                      },
+                                    ],
+                                  },
+                                },
+                                { id: "13", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "15", constExpr: { stringValue: "hello! and, goodbye" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "multiple binds",
+          lineOffsets: [22, 54, 102],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 12,
+            "5": 31,
+            "6": 39,
+            "7": 40,
+            "8": 43,
+            "9": 58,
+            "10": 60,
+            "11": 62,
+            "12": 71,
+            "13": 73,
+            "14": 77,
+            "15": 80,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    a,\n    // Init\n    "hello!"~string,\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    a~string^a,\n    // Result\n    __comprehension__(\n      // Variable\n      #unused,\n      // Target\n      []~list(dyn),\n      // Accumulator\n      b,\n      // Init\n      "goodbye"~string,\n      // LoopCondition\n      false~bool,\n      // LoopStep\n      b~string^b,\n      // Result\n      _+_(\n        _+_(\n          a~string^a,\n          " and, "~string\n        )~string^add_string,\n        b~string^b\n      )~string^add_string)~string)~string,\n  "hello! and, goodbye"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "a" },
+          "10": { overloadId: ["add_string"] },
+          "12": { overloadId: ["add_string"] },
+          "13": { name: "b" },
+          "16": { name: "b" },
+          "20": { name: "a" },
+          "22": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "4": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "STRING" },
+          "12": { primitive: "STRING" },
+          "13": { primitive: "STRING" },
+          "14": { listType: { elemType: { dyn: {} } } },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "STRING" },
+          "18": { listType: { elemType: { dyn: {} } } },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "STRING" },
+          "21": { primitive: "STRING" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22, 54, 102],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 31,
+            "7": 40,
+            "8": 43,
+            "9": 58,
+            "10": 60,
+            "11": 62,
+            "12": 71,
+            "13": 73,
+            "14": 39,
+            "15": 39,
+            "16": 39,
+            "17": 39,
+            "18": 8,
+            "19": 8,
+            "20": 8,
+            "21": 8,
+            "22": 77,
+            "23": 80,
+          },
+          macroCalls: {
+            "17": {
+              callExpr: {
+                target: { id: "5", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "7", identExpr: { name: "b" } },
+                  { id: "8", constExpr: { stringValue: "goodbye" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "9", identExpr: { name: "a" } },
+                              {
+                                id: "11",
+                                constExpr: { stringValue: " and, " },
+                              },
+                            ],
+                          },
+                        },
+                        { id: "13", identExpr: { name: "b" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "21": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  { id: "4", constExpr: { stringValue: "hello!" } },
+                  { id: "17" },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "21",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "18", listExpr: {} },
+                  accuVar: "a",
+                  accuInit: { id: "4", constExpr: { stringValue: "hello!" } },
+                  loopCondition: { id: "19", constExpr: { boolValue: false } },
+                  loopStep: { id: "20", identExpr: { name: "a" } },
+                  result: {
+                    id: "17",
+                    comprehensionExpr: {
+                      iterVar: "#unused",
+                      iterRange: { id: "14", listExpr: {} },
+                      accuVar: "b",
+                      accuInit: {
+                        id: "8",
+                        constExpr: { stringValue: "goodbye" },
+                      },
+                      loopCondition: {
+                        id: "15",
+                        constExpr: { boolValue: false },
+                      },
+                      loopStep: { id: "16", identExpr: { name: "b" } },
+                      result: {
+                        id: "12",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "9", identExpr: { name: "a" } },
+                                  {
+                                    id: "11",
+                                    constExpr: { stringValue: " and, " },
+                                  },
+                                ],
+                              },
+                            },
+                            { id: "13", identExpr: { name: "b" } },
+                          ],
+                        },
+                      },
+                    },
+                  },
+                },
+              },
+              { id: "23", constExpr: { stringValue: "hello! and, goodbye" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "27", max: "28" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "28",
+    },
+    {
+      original: {
+        name: "shadow binds",
+        expr: "cel.bind(a,\n\t\t       cel.bind(a, 'world', a + '!'),\n\t\t   \t    'hello ' + a) == 'hello ' + 'world' + '!'",
+        value: { boolValue: true },
+      },
+      library: "bindings",
+      ast: '_==_(\n  cel^#*expr.Expr_IdentExpr#.bind(\n    a^#*expr.Expr_IdentExpr#,\n    cel^#*expr.Expr_IdentExpr#.bind(\n      a^#*expr.Expr_IdentExpr#,\n      "world"^#*expr.Constant_StringValue#,\n      _+_(\n        a^#*expr.Expr_IdentExpr#,\n        "!"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      "hello "^#*expr.Constant_StringValue#,\n      a^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    _+_(\n      "hello "^#*expr.Constant_StringValue#,\n      "world"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    "!"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'cel.bind(a, cel.bind(a, "world", a + "!"), "hello " + a) == "hello " + "world" + "!"',
+      locationAst:
+        '_==_(\n  cel^#1[1,0]#.bind(\n    a^#3[1,9]#,\n    cel^#4[2,9]#.bind(\n      a^#6[2,18]#,\n      "world"^#7[2,21]#,\n      _+_(\n        a^#8[2,30]#,\n        "!"^#10[2,34]#\n      )^#9[2,32]#\n    )^#5[2,17]#,\n    _+_(\n      "hello "^#11[3,10]#,\n      a^#13[3,21]#\n    )^#12[3,19]#\n  )^#2[1,8]#,\n  _+_(\n    _+_(\n      "hello "^#15[3,27]#,\n      "world"^#17[3,38]#\n    )^#16[3,36]#,\n    "!"^#19[3,48]#\n  )^#18[3,46]#\n)^#14[3,24]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 21, 24, 2, 9, 2, 12],
+        [5, 29, 30, 2, 17, 2, 18],
+        [6, 30, 31, 2, 18, 2, 19],
+        [7, 33, 40, 2, 21, 2, 28],
+        [8, 42, 43, 2, 30, 2, 31],
+        [9, 44, 45, 2, 32, 2, 33],
+        [10, 46, 49, 2, 34, 2, 37],
+        [11, 62, 70, 3, 10, 3, 18],
+        [12, 71, 72, 3, 19, 3, 20],
+        [13, 73, 74, 3, 21, 3, 22],
+        [14, 76, 78, 3, 24, 3, 26],
+        [15, 79, 87, 3, 27, 3, 35],
+        [16, 88, 89, 3, 36, 3, 37],
+        [17, 90, 97, 3, 38, 3, 45],
+        [18, 98, 99, 3, 46, 3, 47],
+        [19, 100, 103, 3, 48, 3, 51],
+      ],
+      lineOffsets: [12, 52, 104],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "cel" } },
+                  function: "bind",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    {
+                      id: "5",
+                      callExpr: {
+                        target: { id: "4", identExpr: { name: "cel" } },
+                        function: "bind",
+                        args: [
+                          { id: "6", identExpr: { name: "a" } },
+                          { id: "7", constExpr: { stringValue: "world" } },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "8", identExpr: { name: "a" } },
+                                { id: "10", constExpr: { stringValue: "!" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "11", constExpr: { stringValue: "hello " } },
+                          { id: "13", identExpr: { name: "a" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "15", constExpr: { stringValue: "hello " } },
+                          { id: "17", constExpr: { stringValue: "world" } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { stringValue: "!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "shadow binds",
+          lineOffsets: [12, 52, 104],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 21,
+            "5": 29,
+            "6": 30,
+            "7": 33,
+            "8": 42,
+            "9": 44,
+            "10": 46,
+            "11": 62,
+            "12": 71,
+            "13": 73,
+            "14": 76,
+            "15": 79,
+            "16": 88,
+            "17": 90,
+            "18": 98,
+            "19": 100,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    a,\n    // Init\n    __comprehension__(\n      // Variable\n      #unused,\n      // Target\n      []~list(dyn),\n      // Accumulator\n      a,\n      // Init\n      "world"~string,\n      // LoopCondition\n      false~bool,\n      // LoopStep\n      a~string^a,\n      // Result\n      _+_(\n        a~string^a,\n        "!"~string\n      )~string^add_string)~string,\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    a~string^a,\n    // Result\n    _+_(\n      "hello "~string,\n      a~string^a\n    )~string^add_string)~string,\n  _+_(\n    _+_(\n      "hello "~string,\n      "world"~string\n    )~string^add_string,\n    "!"~string\n  )~string^add_string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "8": { name: "a" },
+          "9": { overloadId: ["add_string"] },
+          "13": { name: "a" },
+          "16": { overloadId: ["add_string"] },
+          "17": { name: "a" },
+          "20": { name: "a" },
+          "22": { overloadId: ["equals"] },
+          "24": { overloadId: ["add_string"] },
+          "26": { overloadId: ["add_string"] },
+        },
+        typeMap: {
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+          "11": { listType: { elemType: { dyn: {} } } },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "STRING" },
+          "15": { primitive: "STRING" },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "STRING" },
+          "18": { listType: { elemType: { dyn: {} } } },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "STRING" },
+          "21": { primitive: "STRING" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "STRING" },
+          "24": { primitive: "STRING" },
+          "25": { primitive: "STRING" },
+          "26": { primitive: "STRING" },
+          "27": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12, 52, 104],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 21,
+            "6": 30,
+            "7": 33,
+            "8": 42,
+            "9": 44,
+            "10": 46,
+            "11": 29,
+            "12": 29,
+            "13": 29,
+            "14": 29,
+            "15": 62,
+            "16": 71,
+            "17": 73,
+            "18": 8,
+            "19": 8,
+            "20": 8,
+            "21": 8,
+            "22": 76,
+            "23": 79,
+            "24": 88,
+            "25": 90,
+            "26": 98,
+            "27": 100,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "4", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "6", identExpr: { name: "a" } },
+                  { id: "7", constExpr: { stringValue: "world" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "8", identExpr: { name: "a" } },
+                        { id: "10", constExpr: { stringValue: "!" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "21": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  { id: "14" },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "15", constExpr: { stringValue: "hello " } },
+                        { id: "17", identExpr: { name: "a" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "21",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "18", listExpr: {} },
+                  accuVar: "a",
+                  accuInit: {
+                    id: "14",
+                    comprehensionExpr: {
+                      iterVar: "#unused",
+                      iterRange: { id: "11", listExpr: {} },
+                      accuVar: "a",
+                      accuInit: {
+                        id: "7",
+                        constExpr: { stringValue: "world" },
+                      },
+                      loopCondition: {
+                        id: "12",
+                        constExpr: { boolValue: false },
+                      },
+                      loopStep: { id: "13", identExpr: { name: "a" } },
+                      result: {
+                        id: "9",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "8", identExpr: { name: "a" } },
+                            { id: "10", constExpr: { stringValue: "!" } },
+                          ],
+                        },
+                      },
+                    },
+                  },
+                  loopCondition: { id: "19", constExpr: { boolValue: false } },
+                  loopStep: { id: "20", identExpr: { name: "a" } },
+                  result: {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "15", constExpr: { stringValue: "hello " } },
+                        { id: "17", identExpr: { name: "a" } },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "26",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "24",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "23", constExpr: { stringValue: "hello " } },
+                          { id: "25", constExpr: { stringValue: "world" } },
+                        ],
+                      },
+                    },
+                    { id: "27", constExpr: { stringValue: "!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "30", max: "31" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "31",
+    },
+    {
+      original: {
+        name: "nested bind with int list",
+        expr: "cel.bind(a, x,\n\t\t\t   cel.bind(b, a[0],\n\t\t\t   cel.bind(c, a[1], b + c))) == 10",
+        typeEnv: [
+          {
+            name: "x",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        bindings: {
+          x: {
+            value: {
+              listValue: { values: [{ int64Value: "3" }, { int64Value: "7" }] },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      library: "bindings",
+      ast: "_==_(\n  cel^#*expr.Expr_IdentExpr#.bind(\n    a^#*expr.Expr_IdentExpr#,\n    x^#*expr.Expr_IdentExpr#,\n    cel^#*expr.Expr_IdentExpr#.bind(\n      b^#*expr.Expr_IdentExpr#,\n      _[_](\n        a^#*expr.Expr_IdentExpr#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      cel^#*expr.Expr_IdentExpr#.bind(\n        c^#*expr.Expr_IdentExpr#,\n        _[_](\n          a^#*expr.Expr_IdentExpr#,\n          1^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#,\n        _+_(\n          b^#*expr.Expr_IdentExpr#,\n          c^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "cel.bind(a, x, cel.bind(b, a[0], cel.bind(c, a[1], b + c))) == 10",
+      locationAst:
+        "_==_(\n  cel^#1[1,0]#.bind(\n    a^#3[1,9]#,\n    x^#4[1,12]#,\n    cel^#5[2,6]#.bind(\n      b^#7[2,15]#,\n      _[_](\n        a^#8[2,18]#,\n        0^#10[2,20]#\n      )^#9[2,19]#,\n      cel^#11[3,6]#.bind(\n        c^#13[3,15]#,\n        _[_](\n          a^#14[3,18]#,\n          1^#16[3,20]#\n        )^#15[3,19]#,\n        _+_(\n          b^#17[3,24]#,\n          c^#19[3,28]#\n        )^#18[3,26]#\n      )^#12[3,14]#\n    )^#6[2,14]#\n  )^#2[1,8]#,\n  10^#21[3,36]#\n)^#20[3,33]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 21, 24, 2, 6, 2, 9],
+        [6, 29, 30, 2, 14, 2, 15],
+        [7, 30, 31, 2, 15, 2, 16],
+        [8, 33, 34, 2, 18, 2, 19],
+        [9, 34, 35, 2, 19, 2, 20],
+        [10, 35, 36, 2, 20, 2, 21],
+        [11, 45, 48, 3, 6, 3, 9],
+        [12, 53, 54, 3, 14, 3, 15],
+        [13, 54, 55, 3, 15, 3, 16],
+        [14, 57, 58, 3, 18, 3, 19],
+        [15, 58, 59, 3, 19, 3, 20],
+        [16, 59, 60, 3, 20, 3, 21],
+        [17, 63, 64, 3, 24, 3, 25],
+        [18, 65, 66, 3, 26, 3, 27],
+        [19, 67, 68, 3, 28, 3, 29],
+        [20, 72, 74, 3, 33, 3, 35],
+        [21, 75, 77, 3, 36, 3, 38],
+      ],
+      lineOffsets: [15, 39, 78],
+      parsedExpr: {
+        expr: {
+          id: "20",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "cel" } },
+                  function: "bind",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    { id: "4", identExpr: { name: "x" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        target: { id: "5", identExpr: { name: "cel" } },
+                        function: "bind",
+                        args: [
+                          { id: "7", identExpr: { name: "b" } },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                { id: "8", identExpr: { name: "a" } },
+                                { id: "10", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "12",
+                            callExpr: {
+                              target: { id: "11", identExpr: { name: "cel" } },
+                              function: "bind",
+                              args: [
+                                { id: "13", identExpr: { name: "c" } },
+                                {
+                                  id: "15",
+                                  callExpr: {
+                                    function: "_[_]",
+                                    args: [
+                                      { id: "14", identExpr: { name: "a" } },
+                                      {
+                                        id: "16",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                    ],
+                                  },
+                                },
+                                {
+                                  id: "18",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      { id: "17", identExpr: { name: "b" } },
+                                      { id: "19", identExpr: { name: "c" } },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "21", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "nested bind with int list",
+          lineOffsets: [15, 39, 78],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 12,
+            "5": 21,
+            "6": 29,
+            "7": 30,
+            "8": 33,
+            "9": 34,
+            "10": 35,
+            "11": 45,
+            "12": 53,
+            "13": 54,
+            "14": 57,
+            "15": 58,
+            "16": 59,
+            "17": 63,
+            "18": 65,
+            "19": 67,
+            "20": 72,
+            "21": 75,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    a,\n    // Init\n    x~list(int)^x,\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    a~list(int)^a,\n    // Result\n    __comprehension__(\n      // Variable\n      #unused,\n      // Target\n      []~list(dyn),\n      // Accumulator\n      b,\n      // Init\n      _[_](\n        a~list(int)^a,\n        0~int\n      )~int^index_list,\n      // LoopCondition\n      false~bool,\n      // LoopStep\n      b~int^b,\n      // Result\n      __comprehension__(\n        // Variable\n        #unused,\n        // Target\n        []~list(dyn),\n        // Accumulator\n        c,\n        // Init\n        _[_](\n          a~list(int)^a,\n          1~int\n        )~int^index_list,\n        // LoopCondition\n        false~bool,\n        // LoopStep\n        c~int^c,\n        // Result\n        _+_(\n          b~int^b,\n          c~int^c\n        )~int^add_int64)~int)~int)~int,\n  10~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { name: "x" },
+          "8": { name: "a" },
+          "9": { overloadId: ["index_list"] },
+          "14": { name: "a" },
+          "15": { overloadId: ["index_list"] },
+          "17": { name: "b" },
+          "18": { overloadId: ["add_int64"] },
+          "19": { name: "c" },
+          "22": { name: "c" },
+          "26": { name: "b" },
+          "30": { name: "a" },
+          "32": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "INT64" },
+          "19": { primitive: "INT64" },
+          "20": { listType: { elemType: { dyn: {} } } },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "INT64" },
+          "24": { listType: { elemType: { dyn: {} } } },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "27": { primitive: "INT64" },
+          "28": { listType: { elemType: { dyn: {} } } },
+          "29": { primitive: "BOOL" },
+          "30": { listType: { elemType: { primitive: "INT64" } } },
+          "31": { primitive: "INT64" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15, 39, 78],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 21,
+            "7": 30,
+            "8": 33,
+            "9": 34,
+            "10": 35,
+            "11": 45,
+            "13": 54,
+            "14": 57,
+            "15": 58,
+            "16": 59,
+            "17": 63,
+            "18": 65,
+            "19": 67,
+            "20": 53,
+            "21": 53,
+            "22": 53,
+            "23": 53,
+            "24": 29,
+            "25": 29,
+            "26": 29,
+            "27": 29,
+            "28": 8,
+            "29": 8,
+            "30": 8,
+            "31": 8,
+            "32": 72,
+            "33": 75,
+          },
+          macroCalls: {
+            "23": {
+              callExpr: {
+                target: { id: "11", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "13", identExpr: { name: "c" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "14", identExpr: { name: "a" } },
+                        { id: "16", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "17", identExpr: { name: "b" } },
+                        { id: "19", identExpr: { name: "c" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "5", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "7", identExpr: { name: "b" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "8", identExpr: { name: "a" } },
+                        { id: "10", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "23" },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  { id: "4", identExpr: { name: "x" } },
+                  { id: "27" },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "32",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "31",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "28", listExpr: {} },
+                  accuVar: "a",
+                  accuInit: { id: "4", identExpr: { name: "x" } },
+                  loopCondition: { id: "29", constExpr: { boolValue: false } },
+                  loopStep: { id: "30", identExpr: { name: "a" } },
+                  result: {
+                    id: "27",
+                    comprehensionExpr: {
+                      iterVar: "#unused",
+                      iterRange: { id: "24", listExpr: {} },
+                      accuVar: "b",
+                      accuInit: {
+                        id: "9",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "8", identExpr: { name: "a" } },
+                            { id: "10", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      loopCondition: {
+                        id: "25",
+                        constExpr: { boolValue: false },
+                      },
+                      loopStep: { id: "26", identExpr: { name: "b" } },
+                      result: {
+                        id: "23",
+                        comprehensionExpr: {
+                          iterVar: "#unused",
+                          iterRange: { id: "20", listExpr: {} },
+                          accuVar: "c",
+                          accuInit: {
+                            id: "15",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                { id: "14", identExpr: { name: "a" } },
+                                { id: "16", constExpr: { int64Value: "1" } },
+                              ],
+                            },
+                          },
+                          loopCondition: {
+                            id: "21",
+                            constExpr: { boolValue: false },
+                          },
+                          loopStep: { id: "22", identExpr: { name: "c" } },
+                          result: {
+                            id: "18",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "17", identExpr: { name: "b" } },
+                                { id: "19", identExpr: { name: "c" } },
+                              ],
+                            },
+                          },
+                        },
+                      },
+                    },
+                  },
+                },
+              },
+              { id: "33", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "39", max: "39" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "39",
+    },
+    {
+      original: {
+        name: "nested bind with string list",
+        expr: 'cel.bind(a, x,\n\t\t\t   cel.bind(b, a[0],\n\t\t\t   cel.bind(c, a[1], b + c))) == "threeseven"',
+        typeEnv: [
+          {
+            name: "x",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+        ],
+        bindings: {
+          x: {
+            value: {
+              listValue: {
+                values: [{ stringValue: "three" }, { stringValue: "seven" }],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      library: "bindings",
+      ast: '_==_(\n  cel^#*expr.Expr_IdentExpr#.bind(\n    a^#*expr.Expr_IdentExpr#,\n    x^#*expr.Expr_IdentExpr#,\n    cel^#*expr.Expr_IdentExpr#.bind(\n      b^#*expr.Expr_IdentExpr#,\n      _[_](\n        a^#*expr.Expr_IdentExpr#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      cel^#*expr.Expr_IdentExpr#.bind(\n        c^#*expr.Expr_IdentExpr#,\n        _[_](\n          a^#*expr.Expr_IdentExpr#,\n          1^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#,\n        _+_(\n          b^#*expr.Expr_IdentExpr#,\n          c^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  "threeseven"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'cel.bind(a, x, cel.bind(b, a[0], cel.bind(c, a[1], b + c))) == "threeseven"',
+      locationAst:
+        '_==_(\n  cel^#1[1,0]#.bind(\n    a^#3[1,9]#,\n    x^#4[1,12]#,\n    cel^#5[2,6]#.bind(\n      b^#7[2,15]#,\n      _[_](\n        a^#8[2,18]#,\n        0^#10[2,20]#\n      )^#9[2,19]#,\n      cel^#11[3,6]#.bind(\n        c^#13[3,15]#,\n        _[_](\n          a^#14[3,18]#,\n          1^#16[3,20]#\n        )^#15[3,19]#,\n        _+_(\n          b^#17[3,24]#,\n          c^#19[3,28]#\n        )^#18[3,26]#\n      )^#12[3,14]#\n    )^#6[2,14]#\n  )^#2[1,8]#,\n  "threeseven"^#21[3,36]#\n)^#20[3,33]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 21, 24, 2, 6, 2, 9],
+        [6, 29, 30, 2, 14, 2, 15],
+        [7, 30, 31, 2, 15, 2, 16],
+        [8, 33, 34, 2, 18, 2, 19],
+        [9, 34, 35, 2, 19, 2, 20],
+        [10, 35, 36, 2, 20, 2, 21],
+        [11, 45, 48, 3, 6, 3, 9],
+        [12, 53, 54, 3, 14, 3, 15],
+        [13, 54, 55, 3, 15, 3, 16],
+        [14, 57, 58, 3, 18, 3, 19],
+        [15, 58, 59, 3, 19, 3, 20],
+        [16, 59, 60, 3, 20, 3, 21],
+        [17, 63, 64, 3, 24, 3, 25],
+        [18, 65, 66, 3, 26, 3, 27],
+        [19, 67, 68, 3, 28, 3, 29],
+        [20, 72, 74, 3, 33, 3, 35],
+        [21, 75, 87, 3, 36, 3, 48],
+      ],
+      lineOffsets: [15, 39, 88],
+      parsedExpr: {
+        expr: {
+          id: "20",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "cel" } },
+                  function: "bind",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    { id: "4", identExpr: { name: "x" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        target: { id: "5", identExpr: { name: "cel" } },
+                        function: "bind",
+                        args: [
+                          { id: "7", identExpr: { name: "b" } },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                { id: "8", identExpr: { name: "a" } },
+                                { id: "10", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "12",
+                            callExpr: {
+                              target: { id: "11", identExpr: { name: "cel" } },
+                              function: "bind",
+                              args: [
+                                { id: "13", identExpr: { name: "c" } },
+                                {
+                                  id: "15",
+                                  callExpr: {
+                                    function: "_[_]",
+                                    args: [
+                                      { id: "14", identExpr: { name: "a" } },
+                                      {
+                                        id: "16",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                    ],
+                                  },
+                                },
+                                {
+                                  id: "18",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      { id: "17", identExpr: { name: "b" } },
+                                      { id: "19", identExpr: { name: "c" } },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "21", constExpr: { stringValue: "threeseven" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "nested bind with string list",
+          lineOffsets: [15, 39, 88],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 12,
+            "5": 21,
+            "6": 29,
+            "7": 30,
+            "8": 33,
+            "9": 34,
+            "10": 35,
+            "11": 45,
+            "12": 53,
+            "13": 54,
+            "14": 57,
+            "15": 58,
+            "16": 59,
+            "17": 63,
+            "18": 65,
+            "19": 67,
+            "20": 72,
+            "21": 75,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    a,\n    // Init\n    x~list(string)^x,\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    a~list(string)^a,\n    // Result\n    __comprehension__(\n      // Variable\n      #unused,\n      // Target\n      []~list(dyn),\n      // Accumulator\n      b,\n      // Init\n      _[_](\n        a~list(string)^a,\n        0~int\n      )~string^index_list,\n      // LoopCondition\n      false~bool,\n      // LoopStep\n      b~string^b,\n      // Result\n      __comprehension__(\n        // Variable\n        #unused,\n        // Target\n        []~list(dyn),\n        // Accumulator\n        c,\n        // Init\n        _[_](\n          a~list(string)^a,\n          1~int\n        )~string^index_list,\n        // LoopCondition\n        false~bool,\n        // LoopStep\n        c~string^c,\n        // Result\n        _+_(\n          b~string^b,\n          c~string^c\n        )~string^add_string)~string)~string)~string,\n  "threeseven"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { name: "x" },
+          "8": { name: "a" },
+          "9": { overloadId: ["index_list"] },
+          "14": { name: "a" },
+          "15": { overloadId: ["index_list"] },
+          "17": { name: "b" },
+          "18": { overloadId: ["add_string"] },
+          "19": { name: "c" },
+          "22": { name: "c" },
+          "26": { name: "b" },
+          "30": { name: "a" },
+          "32": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "4": { listType: { elemType: { primitive: "STRING" } } },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+          "14": { listType: { elemType: { primitive: "STRING" } } },
+          "15": { primitive: "STRING" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "STRING" },
+          "19": { primitive: "STRING" },
+          "20": { listType: { elemType: { dyn: {} } } },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "STRING" },
+          "23": { primitive: "STRING" },
+          "24": { listType: { elemType: { dyn: {} } } },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "STRING" },
+          "27": { primitive: "STRING" },
+          "28": { listType: { elemType: { dyn: {} } } },
+          "29": { primitive: "BOOL" },
+          "30": { listType: { elemType: { primitive: "STRING" } } },
+          "31": { primitive: "STRING" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15, 39, 88],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 21,
+            "7": 30,
+            "8": 33,
+            "9": 34,
+            "10": 35,
+            "11": 45,
+            "13": 54,
+            "14": 57,
+            "15": 58,
+            "16": 59,
+            "17": 63,
+            "18": 65,
+            "19": 67,
+            "20": 53,
+            "21": 53,
+            "22": 53,
+            "23": 53,
+            "24": 29,
+            "25": 29,
+            "26": 29,
+            "27": 29,
+            "28": 8,
+            "29": 8,
+            "30": 8,
+            "31": 8,
+            "32": 72,
+            "33": 75,
+          },
+          macroCalls: {
+            "23": {
+              callExpr: {
+                target: { id: "11", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "13", identExpr: { name: "c" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "14", identExpr: { name: "a" } },
+                        { id: "16", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "17", identExpr: { name: "b" } },
+                        { id: "19", identExpr: { name: "c" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "5", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "7", identExpr: { name: "b" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "8", identExpr: { name: "a" } },
+                        { id: "10", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "23" },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  { id: "4", identExpr: { name: "x" } },
+                  { id: "27" },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "32",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "31",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "28", listExpr: {} },
+                  accuVar: "a",
+                  accuInit: { id: "4", identExpr: { name: "x" } },
+                  loopCondition: { id: "29", constExpr: { boolValue: false } },
+                  loopStep: { id: "30", identExpr: { name: "a" } },
+                  result: {
+                    id: "27",
+                    comprehensionExpr: {
+                      iterVar: "#unused",
+                      iterRange: { id: "24", listExpr: {} },
+                      accuVar: "b",
+                      accuInit: {
+                        id: "9",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "8", identExpr: { name: "a" } },
+                            { id: "10", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      loopCondition: {
+                        id: "25",
+                        constExpr: { boolValue: false },
+                      },
+                      loopStep: { id: "26", identExpr: { name: "b" } },
+                      result: {
+                        id: "23",
+                        comprehensionExpr: {
+                          iterVar: "#unused",
+                          iterRange: { id: "20", listExpr: {} },
+                          accuVar: "c",
+                          accuInit: {
+                            id: "15",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                { id: "14", identExpr: { name: "a" } },
+                                { id: "16", constExpr: { int64Value: "1" } },
+                              ],
+                            },
+                          },
+                          loopCondition: {
+                            id: "21",
+                            constExpr: { boolValue: false },
+                          },
+                          loopStep: { id: "22", identExpr: { name: "c" } },
+                          result: {
+                            id: "18",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "17", identExpr: { name: "b" } },
+                                { id: "19", identExpr: { name: "c" } },
+                              ],
+                            },
+                          },
+                        },
+                      },
+                    },
+                  },
+                },
+              },
+              { id: "33", constExpr: { stringValue: "threeseven" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "38", max: "1844674407370955302" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "39",
+    },
+    {
+      original: { expr: "cel.bind(a.b, 1, a.b)" },
+      section: "TestBindingsInvalidIdent",
+      library: "bindings",
+      ast: "cel^#*expr.Expr_IdentExpr#.bind(\n  a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#,\n  1^#*expr.Constant_Int64Value#,\n  a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "cel.bind(a.b, 1, a.b)",
+      locationAst:
+        "cel^#1[1,0]#.bind(\n  a^#3[1,9]#.b^#4[1,10]#,\n  1^#5[1,14]#,\n  a^#6[1,17]#.b^#7[1,18]#\n)^#2[1,8]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "cel" } },
+            function: "bind",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "3", identExpr: { name: "a" } },
+                  field: "b",
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "a" } },
+                  field: "b",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 10,
+            "5": 14,
+            "6": 17,
+            "7": 18,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:11: cel.bind() variable names must be simple identifiers\n | cel.bind(a.b, 1, a.b)\n | ..........^",
+      errors: [
+        {
+          category: "syntax",
+          message: "cel.bind() variable names must be simple identifiers",
+          id: 8,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:11: cel.bind() variable names must be simple identifiers",
+    },
+  ],
+} as const;
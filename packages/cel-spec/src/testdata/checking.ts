@@ -19,215 +19,1794 @@ export const tests: SerializedIncrementalTestSuite = {
   tests: [
     {
       original: { expr: '"A"' },
+      crossTypeNumericComparisons: true,
       ast: '"A"^#*expr.Constant_StringValue#',
+      unparsed: '"A"',
+      locationAst: '"A"^#1[1,0]#',
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "A" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"A"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "A" } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "A" } },
+      runtimeCost: "0",
+      expectedCheckedAst: '"A"~string',
+      expectedType: "string",
     },
     {
       original: { expr: "12" },
+      crossTypeNumericComparisons: true,
       ast: "12^#*expr.Constant_Int64Value#",
+      unparsed: "12",
+      locationAst: "12^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { int64Value: "12" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "12~int",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { int64Value: "12" } },
+      },
       type: "int",
+      cost: { min: "0", max: "0" },
+      result: { value: { int64Value: "12" } },
+      runtimeCost: "0",
+      expectedCheckedAst: "12~int",
+      expectedType: "int",
     },
     {
       original: { expr: "12u" },
+      crossTypeNumericComparisons: true,
       ast: "12u^#*expr.Constant_Uint64Value#",
+      unparsed: "12u",
+      locationAst: "12u^#1[1,0]#",
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { uint64Value: "12" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "12u~uint",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "UINT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { uint64Value: "12" } },
+      },
       type: "uint",
+      cost: { min: "0", max: "0" },
+      result: { value: { uint64Value: "12" } },
+      runtimeCost: "0",
+      expectedCheckedAst: "12u~uint",
+      expectedType: "uint",
     },
     {
       original: { expr: "true" },
+      crossTypeNumericComparisons: true,
       ast: "true^#*expr.Constant_BoolValue#",
+      unparsed: "true",
+      locationAst: "true^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: true } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "true~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { boolValue: true } },
+      },
       type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      expectedCheckedAst: "true~bool",
+      expectedType: "bool",
     },
     {
       original: { expr: "false" },
+      crossTypeNumericComparisons: true,
       ast: "false^#*expr.Constant_BoolValue#",
+      unparsed: "false",
+      locationAst: "false^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: false } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "false~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { boolValue: false } },
+      },
       type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedCheckedAst: "false~bool",
+      expectedType: "bool",
     },
     {
       original: { expr: "12.23" },
+      crossTypeNumericComparisons: true,
       ast: "12.23^#*expr.Constant_DoubleValue#",
+      unparsed: "12.23",
+      locationAst: "12.23^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { doubleValue: 12.23 } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "12.23~double",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "DOUBLE" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { doubleValue: 12.23 } },
+      },
       type: "double",
+      cost: { min: "0", max: "0" },
+      result: { value: { doubleValue: 12.23 } },
+      runtimeCost: "0",
+      expectedCheckedAst: "12.23~double",
+      expectedType: "double",
     },
     {
       original: { expr: "null" },
+      crossTypeNumericComparisons: true,
       ast: "null^#*expr.Constant_NullValue#",
+      unparsed: "null",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
       type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+      expectedCheckedAst: "null~null",
+      expectedType: "null",
     },
     {
       original: { expr: 'b"ABC"' },
+      crossTypeNumericComparisons: true,
       ast: 'b"ABC"^#*expr.Constant_BytesValue#',
+      unparsed: 'b"\\101\\102\\103"',
+      locationAst: 'b"ABC"^#1[1,0]#',
+      positions: [[1, 0, 6, 1, 0, 1, 6]],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { bytesValue: "QUJD" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: 'b"ABC"~bytes',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { bytesValue: "QUJD" } },
+      },
       type: "bytes",
+      cost: { min: "0", max: "0" },
+      result: { value: { bytesValue: "QUJD" } },
+      runtimeCost: "0",
+      expectedCheckedAst: 'b"ABC"~bytes',
+      expectedType: "bytes",
     },
     {
       original: { expr: "is" },
+      crossTypeNumericComparisons: true,
       ast: "is^#*expr.Expr_IdentExpr#",
+      unparsed: "is",
+      locationAst: "is^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "is" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "is~string^is",
+      checkedExpr: {
+        referenceMap: { "1": { name: "is" } },
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "is" } },
+      },
       type: "string",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): is" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "is~string^is",
+      expectedType: "string",
     },
     {
       original: { expr: "ii" },
+      crossTypeNumericComparisons: true,
       ast: "ii^#*expr.Expr_IdentExpr#",
+      unparsed: "ii",
+      locationAst: "ii^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "ii" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "ii~int^ii",
+      checkedExpr: {
+        referenceMap: { "1": { name: "ii" } },
+        typeMap: { "1": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "ii" } },
+      },
       type: "int",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): ii" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "ii~int^ii",
+      expectedType: "int",
     },
     {
       original: { expr: "iu" },
+      crossTypeNumericComparisons: true,
       ast: "iu^#*expr.Expr_IdentExpr#",
+      unparsed: "iu",
+      locationAst: "iu^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "iu" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "iu~uint^iu",
+      checkedExpr: {
+        referenceMap: { "1": { name: "iu" } },
+        typeMap: { "1": { primitive: "UINT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "iu" } },
+      },
       type: "uint",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): iu" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "iu~uint^iu",
+      expectedType: "uint",
     },
     {
       original: { expr: "iz" },
+      crossTypeNumericComparisons: true,
       ast: "iz^#*expr.Expr_IdentExpr#",
+      unparsed: "iz",
+      locationAst: "iz^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "iz" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "iz~bool^iz",
+      checkedExpr: {
+        referenceMap: { "1": { name: "iz" } },
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "iz" } },
+      },
       type: "bool",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): iz" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "iz~bool^iz",
+      expectedType: "bool",
     },
     {
       original: { expr: "id" },
+      crossTypeNumericComparisons: true,
       ast: "id^#*expr.Expr_IdentExpr#",
+      unparsed: "id",
+      locationAst: "id^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "id" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "id~double^id",
+      checkedExpr: {
+        referenceMap: { "1": { name: "id" } },
+        typeMap: { "1": { primitive: "DOUBLE" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "id" } },
+      },
       type: "double",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): id" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "id~double^id",
+      expectedType: "double",
     },
     {
       original: { expr: "ix" },
+      crossTypeNumericComparisons: true,
       ast: "ix^#*expr.Expr_IdentExpr#",
+      unparsed: "ix",
+      locationAst: "ix^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "ix" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "ix~null^ix",
+      checkedExpr: {
+        referenceMap: { "1": { name: "ix" } },
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "ix" } },
+      },
       type: "null",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): ix" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "ix~null^ix",
+      expectedType: "null",
     },
     {
       original: { expr: "ib" },
+      crossTypeNumericComparisons: true,
       ast: "ib^#*expr.Expr_IdentExpr#",
+      unparsed: "ib",
+      locationAst: "ib^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "ib" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "ib~bytes^ib",
+      checkedExpr: {
+        referenceMap: { "1": { name: "ib" } },
+        typeMap: { "1": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "ib" } },
+      },
       type: "bytes",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): ib" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "ib~bytes^ib",
+      expectedType: "bytes",
     },
     {
       original: { expr: "id" },
+      crossTypeNumericComparisons: true,
       ast: "id^#*expr.Expr_IdentExpr#",
+      unparsed: "id",
+      locationAst: "id^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "id" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "id~double^id",
+      checkedExpr: {
+        referenceMap: { "1": { name: "id" } },
+        typeMap: { "1": { primitive: "DOUBLE" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "id" } },
+      },
       type: "double",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): id" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "id~double^id",
+      expectedType: "double",
     },
     {
       original: { expr: "[]" },
+      crossTypeNumericComparisons: true,
       ast: "[]^#*expr.Expr_ListExpr#",
+      unparsed: "[]",
+      locationAst: "[]^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", listExpr: {} },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "[]~list(dyn)",
+      checkedExpr: {
+        typeMap: { "1": { listType: { elemType: { dyn: {} } } } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", listExpr: {} },
+      },
       type: "list(dyn)",
+      cost: { min: "10", max: "10" },
+      result: { value: { listValue: {} } },
+      runtimeCost: "10",
+      expectedCheckedAst: "[]~list(dyn)",
+      expectedType: "list(dyn)",
     },
     {
       original: { expr: "[1]" },
+      crossTypeNumericComparisons: true,
       ast: "[\n  1^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1]",
+      locationAst: "[\n  1^#2[1,1]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: { elements: [{ id: "2", constExpr: { int64Value: "1" } }] },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
       checkedAst: "[\n  1~int\n]~list(int)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "1",
+          listExpr: { elements: [{ id: "2", constExpr: { int64Value: "1" } }] },
+        },
+      },
       type: "list(int)",
+      cost: { min: "10", max: "10" },
+      result: { value: { listValue: { values: [{ int64Value: "1" }] } } },
+      runtimeCost: "10",
+      expectedCheckedAst: "[1~int]~list(int)",
+      expectedType: "list(int)",
     },
     {
       original: { expr: '[1, "A"]' },
+      crossTypeNumericComparisons: true,
       ast: '[\n  1^#*expr.Constant_Int64Value#,\n  "A"^#*expr.Constant_StringValue#\n]^#*expr.Expr_ListExpr#',
+      unparsed: '[1, "A"]',
+      locationAst: '[\n  1^#2[1,1]#,\n  "A"^#3[1,4]#\n]^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 7, 1, 4, 1, 7],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { stringValue: "A" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 1, "3": 4 },
+        },
+      },
       checkedAst: '[\n  1~int,\n  "A"~string\n]~list(dyn)',
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 1, "3": 4 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { stringValue: "A" } },
+            ],
+          },
+        },
+      },
       type: "list(dyn)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: { values: [{ int64Value: "1" }, { stringValue: "A" }] },
+        },
+      },
+      runtimeCost: "10",
+      expectedCheckedAst: '[1~int, "A"~string]~list(dyn)',
+      expectedType: "list(dyn)",
     },
     {
       original: { expr: "foo" },
+      crossTypeNumericComparisons: true,
       ast: "foo^#*expr.Expr_IdentExpr#",
+      unparsed: "foo",
+      locationAst: "foo^#1[1,0]#",
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "foo" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'foo' (in container '')\n | foo\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'foo' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedCheckedAst: "foo~!error!",
+      expectedType: "!error!",
+      expectedError:
+        "\nERROR: \u003cinput\u003e:1:1: undeclared reference to 'foo' (in container '')\n| foo\n| ^",
     },
     {
       original: { expr: "fg_s()" },
+      crossTypeNumericComparisons: true,
       ast: "fg_s()^#*expr.Expr_CallExpr#",
+      unparsed: "fg_s()",
+      locationAst: "fg_s()^#1[1,4]#",
+      positions: [[1, 4, 5, 1, 4, 1, 5]],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: { id: "1", callExpr: { function: "fg_s" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 4 },
+        },
+      },
       checkedAst: "fg_s()~string^fg_s_0",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["fg_s_0"] } },
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 4 },
+        },
+        expr: { id: "1", callExpr: { function: "fg_s" } },
+      },
       type: "string",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such overload: fg_s()" }] },
+      },
+      expectedCheckedAst: "fg_s()~string^fg_s_0",
+      expectedType: "string",
     },
     {
       original: { expr: "is.fi_s_s()" },
+      crossTypeNumericComparisons: true,
       ast: "is^#*expr.Expr_IdentExpr#.fi_s_s()^#*expr.Expr_CallExpr#",
+      unparsed: "is.fi_s_s()",
+      locationAst: "is^#1[1,0]#.fi_s_s()^#2[1,9]#",
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 9, 10, 1, 9, 1, 10],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "is" } },
+            function: "fi_s_s",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 9 },
+        },
+      },
       checkedAst: "is~string^is.fi_s_s()~string^fi_s_s_0",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "is" },
+          "2": { overloadId: ["fi_s_s_0"] },
+        },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 9 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "is" } },
+            function: "fi_s_s",
+          },
+        },
+      },
       type: "string",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): is" }] },
+      },
+      runtimeCost: "2",
+      expectedCheckedAst: "is~string^is.fi_s_s()~string^fi_s_s_0",
+      expectedType: "string",
     },
     {
       original: { expr: "1 + 2" },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + 2",
+      locationAst: "_+_(\n  1^#1[1,0]#,\n  2^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       checkedAst: "_+_(\n  1~int,\n  2~int\n)~int^add_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["add_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "3" } },
+      runtimeCost: "1",
+      expectedCheckedAst: "_+_(1~int, 2~int)~int^add_int64",
+      expectedType: "int",
     },
     {
       original: { expr: "1 + ii" },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  1^#*expr.Constant_Int64Value#,\n  ii^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + ii",
+      locationAst: "_+_(\n  1^#1[1,0]#,\n  ii^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 6, 1, 4, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", identExpr: { name: "ii" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       checkedAst: "_+_(\n  1~int,\n  ii~int^ii\n)~int^add_int64",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_int64"] },
+          "3": { name: "ii" },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", identExpr: { name: "ii" } },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): ii" }] },
+      },
+      runtimeCost: "2",
+      expectedCheckedAst: "_+_(1~int, ii~int^ii)~int^add_int64",
+      expectedType: "int",
     },
     {
       original: { expr: "[1] + [2]" },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1] + [2]",
+      locationAst:
+        "_+_(\n  [\n    1^#2[1,1]#\n  ]^#1[1,0]#,\n  [\n    2^#5[1,7]#\n  ]^#4[1,6]#\n)^#3[1,4]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [{ id: "5", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 6, "5": 7 },
+        },
+      },
       checkedAst:
         "_+_(\n  [\n    1~int\n  ]~list(int),\n  [\n    2~int\n  ]~list(int)\n)~list(int)^add_list",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["add_list"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 6, "5": 7 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [{ id: "5", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "list(int)",
+      cost: { min: "21", max: "21" },
+      result: {
+        value: {
+          listValue: { values: [{ int64Value: "1" }, { int64Value: "2" }] },
+        },
+      },
+      runtimeCost: "21",
+      expectedCheckedAst:
+        "_+_([1~int]~list(int), [2~int]~list(int))~list(int)^add_list",
+      expectedType: "list(int)",
     },
     {
       original: { expr: "[] + [1,2,3,] + [4]" },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  _+_(\n    []^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[] + [1, 2, 3] + [4]",
+      locationAst:
+        "_+_(\n  _+_(\n    []^#1[1,0]#,\n    [\n      1^#4[1,6]#,\n      2^#5[1,8]#,\n      3^#6[1,10]#\n    ]^#3[1,5]#\n  )^#2[1,3]#,\n  [\n    4^#9[1,17]#\n  ]^#8[1,16]#\n)^#7[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 14, 15, 1, 14, 1, 15],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", listExpr: {} },
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [
+                          { id: "4", constExpr: { int64Value: "1" } },
+                          { id: "5", constExpr: { int64Value: "2" } },
+                          { id: "6", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [{ id: "9", constExpr: { int64Value: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 5,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 14,
+            "8": 16,
+            "9": 17,
+          },
+        },
+      },
       checkedAst:
         "_+_(\n  _+_(\n    []~list(int),\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int)\n  )~list(int)^add_list,\n  [\n    4~int\n  ]~list(int)\n)~list(int)^add_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_list"] },
+          "7": { overloadId: ["add_list"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 5,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 14,
+            "8": 16,
+            "9": 17,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", listExpr: {} },
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [
+                          { id: "4", constExpr: { int64Value: "1" } },
+                          { id: "5", constExpr: { int64Value: "2" } },
+                          { id: "6", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [{ id: "9", constExpr: { int64Value: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "list(int)",
+      cost: { min: "32", max: "32" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+              { int64Value: "4" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "32",
+      expectedCheckedAst:
+        "\n\t_+_(\n\t\t_+_(\n\t\t\t[]~list(int),\n\t\t\t[1~int, 2~int, 3~int]~list(int))~list(int)^add_list,\n\t\t\t[4~int]~list(int))\n\t~list(int)^add_list\n\t",
+      expectedType: "list(int)",
     },
     {
       original: { expr: "[1, 2u] + []" },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2u^#*expr.Constant_Uint64Value#\n  ]^#*expr.Expr_ListExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2u] + []",
+      locationAst:
+        "_+_(\n  [\n    1^#2[1,1]#,\n    2u^#3[1,4]#\n  ]^#1[1,0]#,\n  []^#5[1,10]#\n)^#4[1,8]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 6, 1, 4, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { uint64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "5", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 8, "5": 10 },
+        },
+      },
       checkedAst:
         "_+_(\n  [\n    1~int,\n    2u~uint\n  ]~list(dyn),\n  []~list(dyn)\n)~list(dyn)^add_list",
+      checkedExpr: {
+        referenceMap: { "4": { overloadId: ["add_list"] } },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "UINT64" },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 8, "5": 10 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { uint64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "5", listExpr: {} },
+            ],
+          },
+        },
+      },
       type: "list(dyn)",
+      cost: { min: "21", max: "21" },
+      result: {
+        value: {
+          listValue: { values: [{ int64Value: "1" }, { uint64Value: "2" }] },
+        },
+      },
+      runtimeCost: "21",
+      expectedCheckedAst:
+        "_+_(\n\t\t\t[\n\t\t\t\t1~int,\n\t\t\t\t2u~uint\n\t\t\t]~list(dyn),\n\t\t\t[]~list(dyn)\n\t\t)~list(dyn)^add_list",
+      expectedType: "list(dyn)",
     },
     {
       original: { expr: "{1:2u, 2:3u}" },
+      crossTypeNumericComparisons: true,
       ast: "{\n  1^#*expr.Constant_Int64Value#:2u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#,\n  2^#*expr.Constant_Int64Value#:3u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "{1: 2u, 2: 3u}",
+      locationAst:
+        "{\n  1^#3[1,1]#:2u^#4[1,3]#^#2[1,2]#,\n  2^#6[1,7]#:3u^#7[1,9]#^#5[1,8]#\n}^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 3, 5, 1, 3, 1, 5],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                value: { id: "4", constExpr: { uint64Value: "2" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                value: { id: "7", constExpr: { uint64Value: "3" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 2, "3": 1, "4": 3, "5": 8, "6": 7, "7": 9 },
+        },
+      },
       checkedAst: "{\n  1~int:2u~uint,\n  2~int:3u~uint\n}~map(int, uint)",
+      checkedExpr: {
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "UINT64" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "UINT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 2, "3": 1, "4": 3, "5": 8, "6": 7, "7": 9 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                value: { id: "4", constExpr: { uint64Value: "2" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                value: { id: "7", constExpr: { uint64Value: "3" } },
+              },
+            ],
+          },
+        },
+      },
       type: "map(int, uint)",
+      cost: { min: "30", max: "30" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              { key: { int64Value: "1" }, value: { uint64Value: "2" } },
+              { key: { int64Value: "2" }, value: { uint64Value: "3" } },
+            ],
+          },
+        },
+      },
+      runtimeCost: "30",
+      expectedCheckedAst: "{1~int : 2u~uint, 2~int : 3u~uint}~map(int, uint)",
+      expectedType: "map(int, uint)",
     },
     {
       original: { expr: '{"a":1, "b":2}.a' },
+      crossTypeNumericComparisons: true,
       ast: '{\n  "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  "b"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#.a^#*expr.Expr_SelectExpr#',
+      unparsed: '{"a": 1, "b": 2}.a',
+      locationAst:
+        '{\n  "a"^#3[1,1]#:1^#4[1,5]#^#2[1,4]#,\n  "b"^#6[1,8]#:2^#7[1,12]#^#5[1,11]#\n}^#1[1,0]#.a^#8[1,14]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 11, 12, 1, 11, 1, 12],
+        [6, 8, 11, 1, 8, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                    value: { id: "4", constExpr: { int64Value: "1" } },
+                  },
+                  {
+                    id: "5",
+                    mapKey: { id: "6", constExpr: { stringValue: "b" } },
+                    value: { id: "7", constExpr: { int64Value: "2" } },
+                  },
+                ],
+              },
+            },
+            field: "a",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 5,
+            "5": 11,
+            "6": 8,
+            "7": 12,
+            "8": 14,
+          },
+        },
+      },
       checkedAst:
         '{\n  "a"~string:1~int,\n  "b"~string:2~int\n}~map(string, int).a~int',
+      checkedExpr: {
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 5,
+            "5": 11,
+            "6": 8,
+            "7": 12,
+            "8": 14,
+          },
+        },
+        expr: {
+          id: "8",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                    value: { id: "4", constExpr: { int64Value: "1" } },
+                  },
+                  {
+                    id: "5",
+                    mapKey: { id: "6", constExpr: { stringValue: "b" } },
+                    value: { id: "7", constExpr: { int64Value: "2" } },
+                  },
+                ],
+              },
+            },
+            field: "a",
+          },
+        },
+      },
       type: "int",
+      cost: { min: "31", max: "31" },
+      result: { value: { int64Value: "1" } },
+      runtimeCost: "32",
+      expectedCheckedAst:
+        '{"a"~string : 1~int, "b"~string : 2~int}~map(string, int).a~int',
+      expectedType: "int",
     },
     {
       original: { expr: "{1:2u, 2u:3}" },
+      crossTypeNumericComparisons: true,
       ast: "{\n  1^#*expr.Constant_Int64Value#:2u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#,\n  2u^#*expr.Constant_Uint64Value#:3^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "{1: 2u, 2u: 3}",
+      locationAst:
+        "{\n  1^#3[1,1]#:2u^#4[1,3]#^#2[1,2]#,\n  2u^#6[1,7]#:3^#7[1,10]#^#5[1,9]#\n}^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 3, 5, 1, 3, 1, 5],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 7, 9, 1, 7, 1, 9],
+        [7, 10, 11, 1, 10, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                value: { id: "4", constExpr: { uint64Value: "2" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { uint64Value: "2" } },
+                value: { id: "7", constExpr: { int64Value: "3" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 3,
+            "5": 9,
+            "6": 7,
+            "7": 10,
+          },
+        },
+      },
       checkedAst: "{\n  1~int:2u~uint,\n  2u~uint:3~int\n}~map(dyn, dyn)",
+      checkedExpr: {
+        typeMap: {
+          "1": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "UINT64" },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 3,
+            "5": 9,
+            "6": 7,
+            "7": 10,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                value: { id: "4", constExpr: { uint64Value: "2" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { uint64Value: "2" } },
+                value: { id: "7", constExpr: { int64Value: "3" } },
+              },
+            ],
+          },
+        },
+      },
       type: "map(dyn, dyn)",
+      cost: { min: "30", max: "30" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              { key: { int64Value: "1" }, value: { uint64Value: "2" } },
+              { key: { uint64Value: "2" }, value: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      runtimeCost: "30",
+      expectedCheckedAst: "{1~int : 2u~uint, 2u~uint : 3~int}~map(dyn, dyn)",
+      expectedType: "map(dyn, dyn)",
     },
     {
       original: {
         expr: "TestAllTypes{single_int32: 1, single_int64: 2}",
         container: "google.expr.proto3.test",
       },
+      crossTypeNumericComparisons: true,
       ast: "TestAllTypes{\n  single_int32:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  single_int64:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "TestAllTypes{single_int32: 1, single_int64: 2}",
+      locationAst:
+        "TestAllTypes{\n  single_int32:1^#3[1,27]#^#2[1,25]#,\n  single_int64:2^#5[1,44]#^#4[1,42]#\n}^#1[1,12]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 27, 28, 1, 27, 1, 28],
+        [4, 42, 43, 1, 42, 1, 43],
+        [5, 44, 45, 1, 44, 1, 45],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "single_int64",
+                value: { id: "5", constExpr: { int64Value: "2" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: { "1": 12, "2": 25, "3": 27, "4": 42, "5": 44 },
+        },
+      },
       checkedAst:
         "google.expr.proto3.test.TestAllTypes{\n  single_int32:1~int,\n  single_int64:2~int\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      checkedExpr: {
+        referenceMap: { "1": { name: "google.expr.proto3.test.TestAllTypes" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: { "1": 12, "2": 25, "3": 27, "4": 42, "5": 44 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "single_int64",
+                value: { id: "5", constExpr: { int64Value: "2" } },
+              },
+            ],
+          },
+        },
+      },
       type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "40", max: "40" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+            singleInt32: 1,
+            singleInt64: "2",
+          },
+        },
+      },
+      runtimeCost: "40",
+      expectedCheckedAst:
+        "\n\t\tgoogle.expr.proto3.test.TestAllTypes{\n\t\t\tsingle_int32 : 1~int,\n\t\t\tsingle_int64 : 2~int\n\t\t}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      expectedType: "google.expr.proto3.test.TestAllTypes",
     },
     {
       original: {
         expr: "TestAllTypes{single_int32: 1u}",
         container: "google.expr.proto3.test",
       },
+      crossTypeNumericComparisons: true,
       ast: "TestAllTypes{\n  single_int32:1u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "TestAllTypes{single_int32: 1u}",
+      locationAst:
+        "TestAllTypes{\n  single_int32:1u^#3[1,27]#^#2[1,25]#\n}^#1[1,12]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 27, 29, 1, 27, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { uint64Value: "1" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 12, "2": 25, "3": 27 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:26: expected type of field 'single_int32' is 'int' but provided type is 'uint'\n | TestAllTypes{single_int32: 1u}\n | .........................^",
+      errors: [
+        {
+          category: "type-mismatch",
+          message:
+            "expected type of field 'single_int32' is 'int' but provided type is 'uint'",
+          id: 2,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+      ],
+      expectedError:
+        "\n\tERROR: \u003cinput\u003e:1:26: expected type of field 'single_int32' is 'int' but provided type is 'uint'\n\t  | TestAllTypes{single_int32: 1u}\n\t  | .........................^",
     },
     {
       original: {
         expr: "TestAllTypes{single_int32: 1, undefined: 2}",
         container: "google.expr.proto3.test",
       },
+      crossTypeNumericComparisons: true,
       ast: "TestAllTypes{\n  single_int32:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  undefined:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "TestAllTypes{single_int32: 1, undefined: 2}",
+      locationAst:
+        "TestAllTypes{\n  single_int32:1^#3[1,27]#^#2[1,25]#,\n  undefined:2^#5[1,41]#^#4[1,39]#\n}^#1[1,12]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 27, 28, 1, 27, 1, 28],
+        [4, 39, 40, 1, 39, 1, 40],
+        [5, 41, 42, 1, 41, 1, 42],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "undefined",
+                value: { id: "5", constExpr: { int64Value: "2" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "1": 12, "2": 25, "3": 27, "4": 39, "5": 41 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:40: undefined field 'undefined'\n | TestAllTypes{single_int32: 1, undefined: 2}\n | .......................................^",
+      errors: [
+        {
+          category: "undefined-field",
+          message: "undefined field 'undefined'",
+          id: 4,
+          offset: 39,
+          line: 1,
+          column: 39,
+        },
+      ],
+      expectedError:
+        "\n\tERROR: \u003cinput\u003e:1:40: undefined field 'undefined'\n\t  | TestAllTypes{single_int32: 1, undefined: 2}\n\t  | .......................................^",
     },
     {
       original: {
@@ -239,71 +1818,1341 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  size(\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "size(x) == x.size()",
+      locationAst:
+        "_==_(\n  size(\n    x^#2[1,5]#\n  )^#1[1,4]#,\n  x^#4[1,11]#.size()^#5[1,17]#\n)^#3[1,8]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 8, 10, 1, 8, 1, 10],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "size",
+                  args: [{ id: "2", identExpr: { name: "x" } }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  target: { id: "4", identExpr: { name: "x" } },
+                  function: "size",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 11, "5": 17 },
+        },
+      },
       checkedAst:
         "_==_(\n  size(\n    x~list(int)^x\n  )~int^size_list,\n  x~list(int)^x.size()~int^list_size\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["size_list"] },
+          "2": { name: "x" },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "x" },
+          "5": { overloadId: ["list_size"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 11, "5": 17 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "size",
+                  args: [{ id: "2", identExpr: { name: "x" } }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  target: { id: "4", identExpr: { name: "x" } },
+                  function: "size",
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "5", max: "5" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "5",
+      expectedCheckedAst:
+        "\n_==_(size(x~list(int)^x)~int^size_list, x~list(int)^x.size()~int^list_size)\n  ~bool^equals",
+      expectedType: "bool",
     },
     {
       original: { expr: 'int(1u) + int(uint("1"))' },
+      crossTypeNumericComparisons: true,
       ast: '_+_(\n  int(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  int(\n    uint(\n      "1"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'int(1u) + int(uint("1"))',
+      locationAst:
+        '_+_(\n  int(\n    1u^#2[1,4]#\n  )^#1[1,3]#,\n  int(\n    uint(\n      "1"^#6[1,19]#\n    )^#5[1,18]#\n  )^#4[1,13]#\n)^#3[1,8]#',
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 6, 1, 4, 1, 6],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "int",
+                  args: [{ id: "2", constExpr: { uint64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "int",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "6", constExpr: { stringValue: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 3, "2": 4, "3": 8, "4": 13, "5": 18, "6": 19 },
+        },
+      },
       checkedAst:
         '_+_(\n  int(\n    1u~uint\n  )~int^uint64_to_int64,\n  int(\n    uint(\n      "1"~string\n    )~uint^string_to_uint64\n  )~int^uint64_to_int64\n)~int^add_int64',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["uint64_to_int64"] },
+          "3": { overloadId: ["add_int64"] },
+          "4": { overloadId: ["uint64_to_int64"] },
+          "5": { overloadId: ["string_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "UINT64" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 3, "2": 4, "3": 8, "4": 13, "5": 18, "6": 19 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "int",
+                  args: [{ id: "2", constExpr: { uint64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "int",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "6", constExpr: { stringValue: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "4", max: "4" },
+      result: { value: { int64Value: "2" } },
+      runtimeCost: "4",
+      expectedCheckedAst:
+        '\n_+_(int(1u~uint)~int^uint64_to_int64,\n      int(uint("1"~string)~uint^string_to_uint64)~int^uint64_to_int64)\n  ~int^add_int64',
+      expectedType: "int",
     },
     {
       original: { expr: "false \u0026\u0026 !true || false ? 2 : 3" },
+      crossTypeNumericComparisons: true,
       ast: "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false^#*expr.Constant_BoolValue#,\n      !_(\n        true^#*expr.Constant_BoolValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(false \u0026\u0026 !true || false) ? 2 : 3",
+      locationAst:
+        "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false^#1[1,0]#,\n      !_(\n        true^#3[1,10]#\n      )^#2[1,9]#\n    )^#4[1,6]#,\n    false^#5[1,18]#\n  )^#6[1,15]#,\n  2^#8[1,26]#,\n  3^#9[1,30]#\n)^#7[1,24]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 14, 1, 10, 1, 14],
+        [4, 6, 8, 1, 6, 1, 8],
+        [5, 18, 23, 1, 18, 1, 23],
+        [6, 15, 17, 1, 15, 1, 17],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", constExpr: { boolValue: false } },
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                { id: "3", constExpr: { boolValue: true } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "2" } },
+              { id: "9", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 6,
+            "5": 18,
+            "6": 15,
+            "7": 24,
+            "8": 26,
+            "9": 30,
+          },
+        },
+      },
       checkedAst:
         "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false~bool,\n      !_(\n        true~bool\n      )~bool^logical_not\n    )~bool^logical_and,\n    false~bool\n  )~bool^logical_or,\n  2~int,\n  3~int\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["logical_not"] },
+          "4": { overloadId: ["logical_and"] },
+          "6": { overloadId: ["logical_or"] },
+          "7": { overloadId: ["conditional"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 6,
+            "5": 18,
+            "6": 15,
+            "7": 24,
+            "8": 26,
+            "9": 30,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", constExpr: { boolValue: false } },
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                { id: "3", constExpr: { boolValue: true } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "2" } },
+              { id: "9", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "0", max: "1" },
+      result: { value: { int64Value: "3" } },
+      runtimeCost: "0",
+      expectedCheckedAst:
+        "\n_?_:_(_||_(_\u0026\u0026_(false~bool, !_(true~bool)~bool^logical_not)~bool^logical_and,\n            false~bool)\n        ~bool^logical_or,\n      2~int,\n      3~int)\n  ~int^conditional\n",
+      expectedType: "int",
     },
     {
       original: { expr: 'b"abc" + b"def"' },
+      crossTypeNumericComparisons: true,
       ast: '_+_(\n  b"abc"^#*expr.Constant_BytesValue#,\n  b"def"^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'b"\\141\\142\\143" + b"\\144\\145\\146"',
+      locationAst: '_+_(\n  b"abc"^#1[1,0]#,\n  b"def"^#3[1,9]#\n)^#2[1,7]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 9, 15, 1, 9, 1, 15],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { bytesValue: "YWJj" } },
+              { id: "3", constExpr: { bytesValue: "ZGVm" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 7, "3": 9 },
+        },
+      },
       checkedAst: '_+_(\n  b"abc"~bytes,\n  b"def"~bytes\n)~bytes^add_bytes',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["add_bytes"] } },
+        typeMap: {
+          "1": { primitive: "BYTES" },
+          "2": { primitive: "BYTES" },
+          "3": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 7, "3": 9 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { bytesValue: "YWJj" } },
+              { id: "3", constExpr: { bytesValue: "ZGVm" } },
+            ],
+          },
+        },
+      },
       type: "bytes",
+      cost: { min: "1", max: "1" },
+      result: { value: { bytesValue: "YWJjZGVm" } },
+      runtimeCost: "1",
+      expectedCheckedAst: '_+_(b"abc"~bytes, b"def"~bytes)~bytes^add_bytes',
+      expectedType: "bytes",
     },
     {
       original: { expr: "1.0 + 2.0 * 3.0 - 1.0 / 2.20202 != 66.6" },
+      crossTypeNumericComparisons: true,
       ast: "_!=_(\n  _-_(\n    _+_(\n      1^#*expr.Constant_DoubleValue#,\n      _*_(\n        2^#*expr.Constant_DoubleValue#,\n        3^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _/_(\n      1^#*expr.Constant_DoubleValue#,\n      2.20202^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  66.6^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1.0 + 2.0 * 3.0 - 1.0 / 2.20202 != 66.6",
+      locationAst:
+        "_!=_(\n  _-_(\n    _+_(\n      1^#1[1,0]#,\n      _*_(\n        2^#3[1,6]#,\n        3^#5[1,12]#\n      )^#4[1,10]#\n    )^#2[1,4]#,\n    _/_(\n      1^#7[1,18]#,\n      2.20202^#9[1,24]#\n    )^#8[1,22]#\n  )^#6[1,16]#,\n  66.6^#11[1,35]#\n)^#10[1,32]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 15, 1, 12, 1, 15],
+        [6, 16, 17, 1, 16, 1, 17],
+        [7, 18, 21, 1, 18, 1, 21],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 24, 31, 1, 24, 1, 31],
+        [10, 32, 34, 1, 32, 1, 34],
+        [11, 35, 39, 1, 35, 1, 39],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { doubleValue: 1 } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { doubleValue: 2 } },
+                                { id: "5", constExpr: { doubleValue: 3 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "7", constExpr: { doubleValue: 1 } },
+                          { id: "9", constExpr: { doubleValue: 2.20202 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { doubleValue: 66.6 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 6,
+            "4": 10,
+            "5": 12,
+            "6": 16,
+            "7": 18,
+            "8": 22,
+            "9": 24,
+            "10": 32,
+            "11": 35,
+          },
+        },
+      },
       checkedAst:
         "_!=_(\n  _-_(\n    _+_(\n      1~double,\n      _*_(\n        2~double,\n        3~double\n      )~double^multiply_double\n    )~double^add_double,\n    _/_(\n      1~double,\n      2.20202~double\n    )~double^divide_double\n  )~double^subtract_double,\n  66.6~double\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_double"] },
+          "4": { overloadId: ["multiply_double"] },
+          "6": { overloadId: ["subtract_double"] },
+          "8": { overloadId: ["divide_double"] },
+          "10": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "DOUBLE" },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "DOUBLE" },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 6,
+            "4": 10,
+            "5": 12,
+            "6": 16,
+            "7": 18,
+            "8": 22,
+            "9": 24,
+            "10": 32,
+            "11": 35,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { doubleValue: 1 } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { doubleValue: 2 } },
+                                { id: "5", constExpr: { doubleValue: 3 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "7", constExpr: { doubleValue: 1 } },
+                          { id: "9", constExpr: { doubleValue: 2.20202 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { doubleValue: 66.6 } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "5", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+      expectedCheckedAst:
+        "\n_!=_(_-_(_+_(1~double, _*_(2~double, 3~double)~double^multiply_double)\n           ~double^add_double,\n           _/_(1~double, 2.20202~double)~double^divide_double)\n       ~double^subtract_double,\n      66.6~double)\n  ~bool^not_equals",
+      expectedType: "bool",
     },
     {
       original: { expr: "null == null \u0026\u0026 null != null" },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _==_(\n    null^#*expr.Constant_NullValue#,\n    null^#*expr.Constant_NullValue#\n  )^#*expr.Expr_CallExpr#,\n  _!=_(\n    null^#*expr.Constant_NullValue#,\n    null^#*expr.Constant_NullValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
-      checkedAst:
-        "_\u0026\u0026_(\n  _==_(\n    null~null,\n    null~null\n  )~bool^equals,\n  _!=_(\n    null~null,\n    null~null\n  )~bool^not_equals\n)~bool^logical_and",
-      type: "bool",
-    },
-    {
+      unparsed: "null == null \u0026\u0026 null != null",
+      locationAst:
+        "_\u0026\u0026_(\n  _==_(\n    null^#1[1,0]#,\n    null^#3[1,8]#\n  )^#2[1,5]#,\n  _!=_(\n    null^#4[1,16]#,\n    null^#6[1,24]#\n  )^#5[1,21]#\n)^#7[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 12, 1, 8, 1, 12],
+        [4, 16, 20, 1, 16, 1, 20],
+        [5, 21, 23, 1, 21, 1, 23],
+        [6, 24, 28, 1, 24, 1, 28],
+        [7, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", constExpr: { nullValue: null } },
+                    { id: "3", constExpr: { nullValue: null } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    { id: "4", constExpr: { nullValue: null } },
+                    { id: "6", constExpr: { nullValue: null } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 16,
+            "5": 21,
+            "6": 24,
+            "7": 13,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _==_(\n    null~null,\n    null~null\n  )~bool^equals,\n  _!=_(\n    null~null,\n    null~null\n  )~bool^not_equals\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["equals"] },
+          "5": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { null: null },
+          "2": { primitive: "BOOL" },
+          "3": { null: null },
+          "4": { null: null },
+          "5": { primitive: "BOOL" },
+          "6": { null: null },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 16,
+            "5": 21,
+            "6": 24,
+            "7": 13,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", constExpr: { nullValue: null } },
+                    { id: "3", constExpr: { nullValue: null } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    { id: "4", constExpr: { nullValue: null } },
+                    { id: "6", constExpr: { nullValue: null } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "2" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "2",
+      expectedCheckedAst:
+        "\n\t\t_\u0026\u0026_(\n\t\t\t_==_(\n\t\t\t\tnull~null,\n\t\t\t\tnull~null\n\t\t\t)~bool^equals,\n\t\t\t_!=_(\n\t\t\t\tnull~null,\n\t\t\t\tnull~null\n\t\t\t)~bool^not_equals\n\t\t)~bool^logical_and",
+      expectedType: "bool",
+    },
+    {
       original: { expr: "1 == 1 \u0026\u0026 2 != 1" },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _==_(\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _!=_(\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 == 1 \u0026\u0026 2 != 1",
+      locationAst:
+        "_\u0026\u0026_(\n  _==_(\n    1^#1[1,0]#,\n    1^#3[1,5]#\n  )^#2[1,2]#,\n  _!=_(\n    2^#4[1,10]#,\n    1^#6[1,15]#\n  )^#5[1,12]#\n)^#7[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 14, 1, 12, 1, 14],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 7, 9, 1, 7, 1, 9],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 10,
+            "5": 12,
+            "6": 15,
+            "7": 7,
+          },
+        },
+      },
       checkedAst:
         "_\u0026\u0026_(\n  _==_(\n    1~int,\n    1~int\n  )~bool^equals,\n  _!=_(\n    2~int,\n    1~int\n  )~bool^not_equals\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["equals"] },
+          "5": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 10,
+            "5": 12,
+            "6": 15,
+            "7": 7,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "1", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedCheckedAst:
+        "\n\t\t_\u0026\u0026_(\n\t\t\t_==_(\n\t\t\t\t1~int,\n\t\t\t\t1~int\n\t\t\t)~bool^equals,\n\t\t\t_!=_(\n\t\t\t\t2~int,\n\t\t\t\t1~int\n\t\t\t)~bool^not_equals\n\t\t)~bool^logical_and",
+      expectedType: "bool",
     },
     {
       original: { expr: "1 + 2 * 3 - 1 / 2 == 6 % 1" },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  _-_(\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      _*_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _%_(\n    6^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + 2 * 3 - 1 / 2 == 6 % 1",
+      locationAst:
+        "_==_(\n  _-_(\n    _+_(\n      1^#1[1,0]#,\n      _*_(\n        2^#3[1,4]#,\n        3^#5[1,8]#\n      )^#4[1,6]#\n    )^#2[1,2]#,\n    _/_(\n      1^#7[1,12]#,\n      2^#9[1,16]#\n    )^#8[1,14]#\n  )^#6[1,10]#,\n  _%_(\n    6^#11[1,21]#,\n    1^#13[1,25]#\n  )^#12[1,23]#\n)^#10[1,18]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+        [10, 18, 20, 1, 18, 1, 20],
+        [11, 21, 22, 1, 21, 1, 22],
+        [12, 23, 24, 1, 23, 1, 24],
+        [13, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { int64Value: "2" } },
+                                { id: "5", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "7", constExpr: { int64Value: "1" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_%_",
+                  args: [
+                    { id: "11", constExpr: { int64Value: "6" } },
+                    { id: "13", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+            "10": 18,
+            "11": 21,
+            "12": 23,
+            "13": 25,
+          },
+        },
+      },
       checkedAst:
         "_==_(\n  _-_(\n    _+_(\n      1~int,\n      _*_(\n        2~int,\n        3~int\n      )~int^multiply_int64\n    )~int^add_int64,\n    _/_(\n      1~int,\n      2~int\n    )~int^divide_int64\n  )~int^subtract_int64,\n  _%_(\n    6~int,\n    1~int\n  )~int^modulo_int64\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_int64"] },
+          "4": { overloadId: ["multiply_int64"] },
+          "6": { overloadId: ["subtract_int64"] },
+          "8": { overloadId: ["divide_int64"] },
+          "10": { overloadId: ["equals"] },
+          "12": { overloadId: ["modulo_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+            "10": 18,
+            "11": 21,
+            "12": 23,
+            "13": 25,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { int64Value: "2" } },
+                                { id: "5", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "7", constExpr: { int64Value: "1" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_%_",
+                  args: [
+                    { id: "11", constExpr: { int64Value: "6" } },
+                    { id: "13", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "6", max: "6" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "6",
+      expectedCheckedAst:
+        " _==_(_-_(_+_(1~int, _*_(2~int, 3~int)~int^multiply_int64)~int^add_int64, _/_(1~int, 2~int)~int^divide_int64)~int^subtract_int64, _%_(6~int, 1~int)~int^modulo_int64)~bool^equals",
+      expectedType: "bool",
     },
     {
       original: { expr: '"abc" + "def"' },
+      crossTypeNumericComparisons: true,
       ast: '_+_(\n  "abc"^#*expr.Constant_StringValue#,\n  "def"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"abc" + "def"',
+      locationAst: '_+_(\n  "abc"^#1[1,0]#,\n  "def"^#3[1,8]#\n)^#2[1,6]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 8, 13, 1, 8, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { stringValue: "abc" } },
+              { id: "3", constExpr: { stringValue: "def" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 6, "3": 8 },
+        },
+      },
       checkedAst: '_+_(\n  "abc"~string,\n  "def"~string\n)~string^add_string',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["add_string"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 6, "3": 8 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { stringValue: "abc" } },
+              { id: "3", constExpr: { stringValue: "def" } },
+            ],
+          },
+        },
+      },
       type: "string",
+      cost: { min: "1", max: "1" },
+      result: { value: { stringValue: "abcdef" } },
+      runtimeCost: "1",
+      expectedCheckedAst: '_+_("abc"~string, "def"~string)~string^add_string',
+      expectedType: "string",
     },
     {
       original: { expr: "1u + 2u * 3u - 1u / 2u == 6u % 1u" },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  _-_(\n    _+_(\n      1u^#*expr.Constant_Uint64Value#,\n      _*_(\n        2u^#*expr.Constant_Uint64Value#,\n        3u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _/_(\n      1u^#*expr.Constant_Uint64Value#,\n      2u^#*expr.Constant_Uint64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _%_(\n    6u^#*expr.Constant_Uint64Value#,\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1u + 2u * 3u - 1u / 2u == 6u % 1u",
+      locationAst:
+        "_==_(\n  _-_(\n    _+_(\n      1u^#1[1,0]#,\n      _*_(\n        2u^#3[1,5]#,\n        3u^#5[1,10]#\n      )^#4[1,8]#\n    )^#2[1,3]#,\n    _/_(\n      1u^#7[1,15]#,\n      2u^#9[1,20]#\n    )^#8[1,18]#\n  )^#6[1,13]#,\n  _%_(\n    6u^#11[1,26]#,\n    1u^#13[1,31]#\n  )^#12[1,29]#\n)^#10[1,23]#",
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 7, 1, 5, 1, 7],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 12, 1, 10, 1, 12],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 15, 17, 1, 15, 1, 17],
+        [8, 18, 19, 1, 18, 1, 19],
+        [9, 20, 22, 1, 20, 1, 22],
+        [10, 23, 25, 1, 23, 1, 25],
+        [11, 26, 28, 1, 26, 1, 28],
+        [12, 29, 30, 1, 29, 1, 30],
+        [13, 31, 33, 1, 31, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { uint64Value: "1" } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { uint64Value: "2" } },
+                                { id: "5", constExpr: { uint64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "7", constExpr: { uint64Value: "1" } },
+                          { id: "9", constExpr: { uint64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_%_",
+                  args: [
+                    { id: "11", constExpr: { uint64Value: "6" } },
+                    { id: "13", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 5,
+            "4": 8,
+            "5": 10,
+            "6": 13,
+            "7": 15,
+            "8": 18,
+            "9": 20,
+            "10": 23,
+            "11": 26,
+            "12": 29,
+            "13": 31,
+          },
+        },
+      },
       checkedAst:
         "_==_(\n  _-_(\n    _+_(\n      1u~uint,\n      _*_(\n        2u~uint,\n        3u~uint\n      )~uint^multiply_uint64\n    )~uint^add_uint64,\n    _/_(\n      1u~uint,\n      2u~uint\n    )~uint^divide_uint64\n  )~uint^subtract_uint64,\n  _%_(\n    6u~uint,\n    1u~uint\n  )~uint^modulo_uint64\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_uint64"] },
+          "4": { overloadId: ["multiply_uint64"] },
+          "6": { overloadId: ["subtract_uint64"] },
+          "8": { overloadId: ["divide_uint64"] },
+          "10": { overloadId: ["equals"] },
+          "12": { overloadId: ["modulo_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "UINT64" },
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "UINT64" },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "UINT64" },
+          "8": { primitive: "UINT64" },
+          "9": { primitive: "UINT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "UINT64" },
+          "12": { primitive: "UINT64" },
+          "13": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 5,
+            "4": 8,
+            "5": 10,
+            "6": 13,
+            "7": 15,
+            "8": 18,
+            "9": 20,
+            "10": 23,
+            "11": 26,
+            "12": 29,
+            "13": 31,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { uint64Value: "1" } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { uint64Value: "2" } },
+                                { id: "5", constExpr: { uint64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "7", constExpr: { uint64Value: "1" } },
+                          { id: "9", constExpr: { uint64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_%_",
+                  args: [
+                    { id: "11", constExpr: { uint64Value: "6" } },
+                    { id: "13", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "6", max: "6" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "6",
+      expectedCheckedAst:
+        "_==_(_-_(_+_(1u~uint, _*_(2u~uint, 3u~uint)~uint^multiply_uint64)\n\t         ~uint^add_uint64,\n\t         _/_(1u~uint, 2u~uint)~uint^divide_uint64)\n\t     ~uint^subtract_uint64,\n\t    _%_(6u~uint, 1u~uint)~uint^modulo_uint64)\n\t~bool^equals",
+      expectedType: "bool",
     },
     {
       original: {
@@ -317,9 +3166,56 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_!=_(\n  x^#*expr.Expr_IdentExpr#.single_int32^#*expr.Expr_SelectExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.single_int32 != null",
+      locationAst:
+        "_!=_(\n  x^#1[1,0]#.single_int32^#2[1,1]#,\n  null^#4[1,18]#\n)^#3[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 15, 17, 1, 15, 1, 17],
+        [4, 18, 22, 1, 18, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "x" } },
+                  field: "single_int32",
+                },
+              },
+              { id: "4", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 1, "3": 15, "4": 18 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: unexpected failed resolution of 'google.expr.proto3.test.Proto2Message'\n | x.single_int32 != null\n | .^",
+      errors: [
+        {
+          category: "other",
+          message:
+            "unexpected failed resolution of 'google.expr.proto3.test.Proto2Message'",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedError:
+        "\n\tERROR: \u003cinput\u003e:1:2: unexpected failed resolution of 'google.expr.proto3.test.Proto2Message'\n\t  | x.single_int32 != null\n\t  | .^\n\t",
     },
     {
       original: {
@@ -333,10 +3229,193 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  _+_(\n    x^#*expr.Expr_IdentExpr#.single_value^#*expr.Expr_SelectExpr#,\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      x^#*expr.Expr_IdentExpr#.single_struct^#*expr.Expr_SelectExpr#.y^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  23^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.single_value + 1 / x.single_struct.y == 23",
+      locationAst:
+        "_==_(\n  _+_(\n    x^#1[1,0]#.single_value^#2[1,1]#,\n    _/_(\n      1^#4[1,17]#,\n      x^#6[1,21]#.single_struct^#7[1,22]#.y^#8[1,36]#\n    )^#5[1,19]#\n  )^#3[1,15]#,\n  23^#10[1,42]#\n)^#9[1,39]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 36, 37, 1, 36, 1, 37],
+        [9, 39, 41, 1, 39, 1, 41],
+        [10, 42, 44, 1, 42, 1, 44],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_value",
+                      },
+                    },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "4", constExpr: { int64Value: "1" } },
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: {
+                                id: "7",
+                                selectExpr: {
+                                  operand: {
+                                    id: "6",
+                                    identExpr: { name: "x" },
+                                  },
+                                  field: "single_struct",
+                                },
+                              },
+                              field: "y",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 15,
+            "4": 17,
+            "5": 19,
+            "6": 21,
+            "7": 22,
+            "8": 36,
+            "9": 39,
+            "10": 42,
+          },
+        },
+      },
       checkedAst:
         "_==_(\n  _+_(\n    x~google.expr.proto3.test.TestAllTypes^x.single_value~dyn,\n    _/_(\n      1~int,\n      x~google.expr.proto3.test.TestAllTypes^x.single_struct~map(string, dyn).y~dyn\n    )~int^divide_int64\n  )~int^add_int64,\n  23~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["add_int64"] },
+          "5": { overloadId: ["divide_int64"] },
+          "6": { name: "x" },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { dyn: {} },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "8": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 15,
+            "4": 17,
+            "5": 19,
+            "6": 21,
+            "7": 22,
+            "8": 36,
+            "9": 39,
+            "10": 42,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_value",
+                      },
+                    },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "4", constExpr: { int64Value: "1" } },
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: {
+                                id: "7",
+                                selectExpr: {
+                                  operand: {
+                                    id: "6",
+                                    identExpr: { name: "x" },
+                                  },
+                                  field: "single_struct",
+                                },
+                              },
+                              field: "y",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "8", max: "8" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "5",
+      expectedCheckedAst:
+        "_==_(\n\t\t\t_+_(\n\t\t\t  x~google.expr.proto3.test.TestAllTypes^x.single_value~dyn,\n\t\t\t  _/_(\n\t\t\t\t1~int,\n\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_struct~map(string, dyn).y~dyn\n\t\t\t  )~int^divide_int64\n\t\t\t)~int^add_int64,\n\t\t\t23~int\n\t\t  )~bool^equals",
+      expectedType: "bool",
     },
     {
       original: {
@@ -350,20 +3429,281 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: '_+_(\n  _[_](\n    x^#*expr.Expr_IdentExpr#.single_value^#*expr.Expr_SelectExpr#,\n    23^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _[_](\n    x^#*expr.Expr_IdentExpr#.single_struct^#*expr.Expr_SelectExpr#,\n    "y"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'x.single_value[23] + x.single_struct["y"]',
+      locationAst:
+        '_+_(\n  _[_](\n    x^#1[1,0]#.single_value^#2[1,1]#,\n    23^#4[1,15]#\n  )^#3[1,14]#,\n  _[_](\n    x^#6[1,21]#.single_struct^#7[1,22]#,\n    "y"^#9[1,37]#\n  )^#8[1,36]#\n)^#5[1,19]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 36, 37, 1, 36, 1, 37],
+        [9, 37, 40, 1, 37, 1, 40],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_value",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "23" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "7",
+                      selectExpr: {
+                        operand: { id: "6", identExpr: { name: "x" } },
+                        field: "single_struct",
+                      },
+                    },
+                    { id: "9", constExpr: { stringValue: "y" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 19,
+            "6": 21,
+            "7": 22,
+            "8": 36,
+            "9": 37,
+          },
+        },
+      },
       checkedAst:
         '_+_(\n  _[_](\n    x~google.expr.proto3.test.TestAllTypes^x.single_value~dyn,\n    23~int\n  )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n  _[_](\n    x~google.expr.proto3.test.TestAllTypes^x.single_struct~map(string, dyn),\n    "y"~string\n  )~dyn^index_map\n)~dyn^add_bytes|add_double|add_duration_duration|add_duration_timestamp|add_int64|add_list|add_string|add_timestamp_duration|add_uint64',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "5": {
+            overloadId: [
+              "add_bytes",
+              "add_double",
+              "add_duration_duration",
+              "add_duration_timestamp",
+              "add_int64",
+              "add_list",
+              "add_string",
+              "add_timestamp_duration",
+              "add_uint64",
+            ],
+          },
+          "6": { name: "x" },
+          "8": { overloadId: ["index_map"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "8": { dyn: {} },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 19,
+            "6": 21,
+            "7": 22,
+            "8": 36,
+            "9": 37,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_value",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "23" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "7",
+                      selectExpr: {
+                        operand: { id: "6", identExpr: { name: "x" } },
+                        field: "single_struct",
+                      },
+                    },
+                    { id: "9", constExpr: { stringValue: "y" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "dyn",
+      cost: { min: "6", max: "1844674407370955270" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "3",
+      expectedCheckedAst:
+        '_+_(\n\t\t\t_[_](\n\t\t\t  x~google.expr.proto3.test.TestAllTypes^x.single_value~dyn,\n\t\t\t  23~int\n\t\t\t)~dyn^index_list|index_map,\n\t\t\t_[_](\n\t\t\t  x~google.expr.proto3.test.TestAllTypes^x.single_struct~map(string, dyn),\n\t\t\t  "y"~string\n\t\t\t)~dyn^index_map\n\t\t  )~dyn^add_bytes|add_double|add_duration_duration|add_duration_timestamp|add_int64|add_list|add_string|add_timestamp_duration|add_uint64\n\t\t  ',
+      expectedType: "dyn",
     },
     {
       original: {
         expr: "TestAllTypes.NestedEnum.BAR != 99",
         container: "google.expr.proto3.test",
       },
+      crossTypeNumericComparisons: true,
       ast: "_!=_(\n  TestAllTypes^#*expr.Expr_IdentExpr#.NestedEnum^#*expr.Expr_SelectExpr#.BAR^#*expr.Expr_SelectExpr#,\n  99^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "TestAllTypes.NestedEnum.BAR != 99",
+      locationAst:
+        "_!=_(\n  TestAllTypes^#1[1,0]#.NestedEnum^#2[1,12]#.BAR^#3[1,23]#,\n  99^#5[1,31]#\n)^#4[1,28]#",
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 23, 24, 1, 23, 1, 24],
+        [4, 28, 30, 1, 28, 1, 30],
+        [5, 31, 33, 1, 31, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "3",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    selectExpr: {
+                      operand: { id: "1", identExpr: { name: "TestAllTypes" } },
+                      field: "NestedEnum",
+                    },
+                  },
+                  field: "BAR",
+                },
+              },
+              { id: "5", constExpr: { int64Value: "99" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 12, "3": 23, "4": 28, "5": 31 },
+        },
+      },
       checkedAst:
         "_!=_(\n  google.expr.proto3.test.TestAllTypes.NestedEnum.BAR~int^google.expr.proto3.test.TestAllTypes.NestedEnum.BAR,\n  99~int\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": {
+            name: "google.expr.proto3.test.TestAllTypes.NestedEnum.BAR",
+            value: { int64Value: "1" },
+          },
+          "4": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 12, "3": 23, "4": 28, "5": 31 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "3",
+                identExpr: {
+                  name: "google.expr.proto3.test.TestAllTypes.NestedEnum.BAR",
+                },
+              },
+              { id: "5", constExpr: { int64Value: "99" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        "_!=_(google.expr.proto3.test.TestAllTypes.NestedEnum.BAR\n\t     ~int^google.expr.proto3.test.TestAllTypes.NestedEnum.BAR,\n\t    99~int)\n\t~bool^not_equals",
+      expectedType: "bool",
     },
     {
       original: {
@@ -377,10 +3717,99 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "size(\n  _+_(\n    []^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "size([] + [1])",
+      locationAst:
+        "size(\n  _+_(\n    []^#2[1,5]#,\n    [\n      1^#5[1,11]#\n    ]^#4[1,10]#\n  )^#3[1,8]#\n)^#1[1,4]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "2", listExpr: {} },
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 10, "5": 11 },
+        },
+      },
       checkedAst:
         "size(\n  _+_(\n    []~list(int),\n    [\n      1~int\n    ]~list(int)\n  )~list(int)^add_list\n)~int^size_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["size_list"] },
+          "3": { overloadId: ["add_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 10, "5": 11 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "2", listExpr: {} },
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "22", max: "22" },
+      result: { value: { int64Value: "1" } },
+      runtimeCost: "22",
+      expectedCheckedAst:
+        "size(_+_([]~list(int), [1~int]~list(int))~list(int)^add_list)~int^size_list",
+      expectedType: "int",
     },
     {
       original: {
@@ -400,10 +3829,572 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _==_(\n      _[_](\n        _[_](\n          _[_](\n            x^#*expr.Expr_IdentExpr#,\n            "claims"^#*expr.Constant_StringValue#\n          )^#*expr.Expr_CallExpr#,\n          "groups"^#*expr.Constant_StringValue#\n        )^#*expr.Expr_CallExpr#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#.name^#*expr.Expr_SelectExpr#,\n      "dummy"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      _[_](\n        x^#*expr.Expr_IdentExpr#.claims^#*expr.Expr_SelectExpr#,\n        "exp"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      _[_](\n        y^#*expr.Expr_IdentExpr#,\n        1^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#.time^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _==_(\n      x^#*expr.Expr_IdentExpr#.claims^#*expr.Expr_SelectExpr#.structured^#*expr.Expr_SelectExpr#,\n      {\n        "key"^#*expr.Constant_StringValue#:z^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      z^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'x["claims"]["groups"][0].name == "dummy" \u0026\u0026 x.claims["exp"] == y[1].time \u0026\u0026 x.claims.structured == {"key": z} \u0026\u0026\nz == 1.0',
+      locationAst:
+        '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _==_(\n      _[_](\n        _[_](\n          _[_](\n            x^#1[1,0]#,\n            "claims"^#3[1,2]#\n          )^#2[1,1]#,\n          "groups"^#5[1,12]#\n        )^#4[1,11]#,\n        0^#7[1,22]#\n      )^#6[1,21]#.name^#8[1,24]#,\n      "dummy"^#10[1,33]#\n    )^#9[1,30]#,\n    _==_(\n      _[_](\n        x^#11[2,5]#.claims^#12[2,6]#,\n        "exp"^#14[2,14]#\n      )^#13[2,13]#,\n      _[_](\n        y^#16[2,24]#,\n        1^#18[2,26]#\n      )^#17[2,25]#.time^#19[2,28]#\n    )^#15[2,21]#\n  )^#20[2,2]#,\n  _\u0026\u0026_(\n    _==_(\n      x^#21[3,5]#.claims^#22[3,6]#.structured^#23[3,13]#,\n      {\n        "key"^#27[3,29]#:z^#28[3,36]#^#26[3,34]#\n      }^#25[3,28]#\n    )^#24[3,25]#,\n    _==_(\n      z^#30[4,5]#,\n      1^#32[4,10]#\n    )^#31[4,7]#\n  )^#33[4,2]#\n)^#29[3,2]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 10, 1, 2, 1, 10],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 12, 20, 1, 12, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 30, 32, 1, 30, 1, 32],
+        [10, 33, 40, 1, 33, 1, 40],
+        [11, 46, 47, 2, 5, 2, 6],
+        [12, 47, 48, 2, 6, 2, 7],
+        [13, 54, 55, 2, 13, 2, 14],
+        [14, 55, 60, 2, 14, 2, 19],
+        [15, 62, 64, 2, 21, 2, 23],
+        [16, 65, 66, 2, 24, 2, 25],
+        [17, 66, 67, 2, 25, 2, 26],
+        [18, 67, 68, 2, 26, 2, 27],
+        [19, 69, 70, 2, 28, 2, 29],
+        [20, 43, 45, 2, 2, 2, 4],
+        [21, 80, 81, 3, 5, 3, 6],
+        [22, 81, 82, 3, 6, 3, 7],
+        [23, 88, 89, 3, 13, 3, 14],
+        [24, 100, 102, 3, 25, 3, 27],
+        [25, 103, 104, 3, 28, 3, 29],
+        [26, 109, 110, 3, 34, 3, 35],
+        [27, 104, 109, 3, 29, 3, 34],
+        [28, 111, 112, 3, 36, 3, 37],
+        [29, 77, 79, 3, 2, 3, 4],
+        [30, 119, 120, 4, 5, 4, 6],
+        [31, 121, 123, 4, 7, 4, 9],
+        [32, 124, 127, 4, 10, 4, 13],
+        [33, 116, 118, 4, 2, 4, 4],
+      ],
+      lineOffsets: [41, 75, 114, 128],
+      parsedExpr: {
+        expr: {
+          id: "29",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "20",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                callExpr: {
+                                  function: "_[_]",
+                                  args: [
+                                    {
+                                      id: "4",
+                                      callExpr: {
+                                        function: "_[_]",
+                                        args: [
+                                          {
+                                            id: "2",
+                                            callExpr: {
+                                              function: "_[_]",
+                                              args: [
+                                                {
+                                                  id: "1",
+                                                  identExpr: { name: "x" },
+                                                },
+                                                {
+                                                  id: "3",
+                                                  constExpr: {
+                                                    stringValue: "claims",
+                                                  },
+                                                },
+                                              ],
+                                            },
+                                          },
+                                          {
+                                            id: "5",
+                                            constExpr: {
+                                              stringValue: "groups",
+                                            },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                    { id: "7", constExpr: { int64Value: "0" } },
+                                  ],
+                                },
+                              },
+                              field: "name",
+                            },
+                          },
+                          { id: "10", constExpr: { stringValue: "dummy" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "15",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                {
+                                  id: "12",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "11",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "claims",
+                                  },
+                                },
+                                { id: "14", constExpr: { stringValue: "exp" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "19",
+                            selectExpr: {
+                              operand: {
+                                id: "17",
+                                callExpr: {
+                                  function: "_[_]",
+                                  args: [
+                                    { id: "16", identExpr: { name: "y" } },
+                                    {
+                                      id: "18",
+                                      constExpr: { int64Value: "1" },
+                                    },
+                                  ],
+                                },
+                              },
+                              field: "time",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "33",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "24",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "23",
+                            selectExpr: {
+                              operand: {
+                                id: "22",
+                                selectExpr: {
+                                  operand: {
+                                    id: "21",
+                                    identExpr: { name: "x" },
+                                  },
+                                  field: "claims",
+                                },
+                              },
+                              field: "structured",
+                            },
+                          },
+                          {
+                            id: "25",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "26",
+                                  mapKey: {
+                                    id: "27",
+                                    constExpr: { stringValue: "key" },
+                                  },
+                                  value: { id: "28", identExpr: { name: "z" } },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "31",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "30", identExpr: { name: "z" } },
+                          { id: "32", constExpr: { doubleValue: 1 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41, 75, 114, 128],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 11,
+            "5": 12,
+            "6": 21,
+            "7": 22,
+            "8": 24,
+            "9": 30,
+            "10": 33,
+            "11": 46,
+            "12": 47,
+            "13": 54,
+            "14": 55,
+            "15": 62,
+            "16": 65,
+            "17": 66,
+            "18": 67,
+            "19": 69,
+            "20": 43,
+            "21": 80,
+            "22": 81,
+            "23": 88,
+            "24": 100,
+            "25": 103,
+            "26": 109,
+            "27": 104,
+            "28": 111,
+            "29": 77,
+            "30": 119,
+            "31": 121,
+            "32": 124,
+            "33": 116,
+          },
+        },
+      },
       checkedAst:
         '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _==_(\n      _[_](\n        _[_](\n          _[_](\n            x~map(string, dyn)^x,\n            "claims"~string\n          )~dyn^index_map,\n          "groups"~string\n        )~dyn^index_map|optional_map_index_value,\n        0~int\n      )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value.name~dyn,\n      "dummy"~string\n    )~bool^equals,\n    _==_(\n      _[_](\n        x~map(string, dyn)^x.claims~dyn,\n        "exp"~string\n      )~dyn^index_map|optional_map_index_value,\n      _[_](\n        y~list(dyn)^y,\n        1~int\n      )~dyn^index_list.time~dyn\n    )~bool^equals\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _==_(\n      x~map(string, dyn)^x.claims~dyn.structured~dyn,\n      {\n        "key"~string:z~dyn^z\n      }~map(string, dyn)\n    )~bool^equals,\n    _==_(\n      z~dyn^z,\n      1~double\n    )~bool^equals\n  )~bool^logical_and\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["index_map"] },
+          "4": { overloadId: ["index_map", "optional_map_index_value"] },
+          "6": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "9": { overloadId: ["equals"] },
+          "11": { name: "x" },
+          "13": { overloadId: ["index_map", "optional_map_index_value"] },
+          "15": { overloadId: ["equals"] },
+          "16": { name: "y" },
+          "17": { overloadId: ["index_list"] },
+          "20": { overloadId: ["logical_and"] },
+          "21": { name: "x" },
+          "24": { overloadId: ["equals"] },
+          "28": { name: "z" },
+          "29": { overloadId: ["logical_and"] },
+          "30": { name: "z" },
+          "31": { overloadId: ["equals"] },
+          "33": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "2": { dyn: {} },
+          "3": { primitive: "STRING" },
+          "4": { dyn: {} },
+          "5": { primitive: "STRING" },
+          "6": { dyn: {} },
+          "7": { primitive: "INT64" },
+          "8": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "STRING" },
+          "11": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "12": { dyn: {} },
+          "13": { dyn: {} },
+          "14": { primitive: "STRING" },
+          "15": { primitive: "BOOL" },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "17": { dyn: {} },
+          "18": { primitive: "INT64" },
+          "19": { dyn: {} },
+          "20": { primitive: "BOOL" },
+          "21": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "22": { dyn: {} },
+          "23": { dyn: {} },
+          "24": { primitive: "BOOL" },
+          "25": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "27": { primitive: "STRING" },
+          "28": { dyn: {} },
+          "29": { primitive: "BOOL" },
+          "30": { dyn: {} },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "DOUBLE" },
+          "33": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41, 75, 114, 128],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 11,
+            "5": 12,
+            "6": 21,
+            "7": 22,
+            "8": 24,
+            "9": 30,
+            "10": 33,
+            "11": 46,
+            "12": 47,
+            "13": 54,
+            "14": 55,
+            "15": 62,
+            "16": 65,
+            "17": 66,
+            "18": 67,
+            "19": 69,
+            "20": 43,
+            "21": 80,
+            "22": 81,
+            "23": 88,
+            "24": 100,
+            "25": 103,
+            "26": 109,
+            "27": 104,
+            "28": 111,
+            "29": 77,
+            "30": 119,
+            "31": 121,
+            "32": 124,
+            "33": 116,
+          },
+        },
+        expr: {
+          id: "29",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "20",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                callExpr: {
+                                  function: "_[_]",
+                                  args: [
+                                    {
+                                      id: "4",
+                                      callExpr: {
+                                        function: "_[_]",
+                                        args: [
+                                          {
+                                            id: "2",
+                                            callExpr: {
+                                              function: "_[_]",
+                                              args: [
+                                                {
+                                                  id: "1",
+                                                  identExpr: { name: "x" },
+                                                },
+                                                {
+                                                  id: "3",
+                                                  constExpr: {
+                                                    stringValue: "claims",
+                                                  },
+                                                },
+                                              ],
+                                            },
+                                          },
+                                          {
+                                            id: "5",
+                                            constExpr: {
+                                              stringValue: "groups",
+                                            },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                    { id: "7", constExpr: { int64Value: "0" } },
+                                  ],
+                                },
+                              },
+                              field: "name",
+                            },
+                          },
+                          { id: "10", constExpr: { stringValue: "dummy" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "15",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                {
+                                  id: "12",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "11",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "claims",
+                                  },
+                                },
+                                { id: "14", constExpr: { stringValue: "exp" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "19",
+                            selectExpr: {
+                              operand: {
+                                id: "17",
+                                callExpr: {
+                                  function: "_[_]",
+                                  args: [
+                                    { id: "16", identExpr: { name: "y" } },
+                                    {
+                                      id: "18",
+                                      constExpr: { int64Value: "1" },
+                                    },
+                                  ],
+                                },
+                              },
+                              field: "time",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "33",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "24",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "23",
+                            selectExpr: {
+                              operand: {
+                                id: "22",
+                                selectExpr: {
+                                  operand: {
+                                    id: "21",
+                                    identExpr: { name: "x" },
+                                  },
+                                  field: "claims",
+                                },
+                              },
+                              field: "structured",
+                            },
+                          },
+                          {
+                            id: "25",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "26",
+                                  mapKey: {
+                                    id: "27",
+                                    constExpr: { stringValue: "key" },
+                                  },
+                                  value: { id: "28", identExpr: { name: "z" } },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "31",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "30", identExpr: { name: "z" } },
+                          { id: "32", constExpr: { doubleValue: 1 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "5", max: "1844674407370955310" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "40",
+      expectedCheckedAst:
+        '_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t\t_==_(\n\t\t\t\t\t_[_](\n\t\t\t\t\t\t_[_](\n\t\t\t\t\t\t\t_[_](\n\t\t\t\t\t\t\t\tx~map(string, dyn)^x,\n\t\t\t\t\t\t\t\t"claims"~string\n\t\t\t\t\t\t\t)~dyn^index_map,\n\t\t\t\t\t\t\t"groups"~string\n\t\t\t\t\t\t)~list(dyn)^index_map,\n\t\t\t\t\t\t0~int\n\t\t\t\t\t)~dyn^index_list.name~dyn,\n\t\t\t\t\t"dummy"~string\n\t\t\t\t)~bool^equals,\n\t\t\t\t_==_(\n\t\t\t\t\t_[_](\n\t\t\t\t\t\tx~map(string, dyn)^x.claims~dyn,\n\t\t\t\t\t\t"exp"~string\n\t\t\t\t\t)~dyn^index_map,\n\t\t\t\t\t_[_](\n\t\t\t\t\t\ty~list(dyn)^y,\n\t\t\t\t\t\t1~int\n\t\t\t\t\t)~dyn^index_list.time~dyn\n\t\t\t\t)~bool^equals\n\t\t\t)~bool^logical_and,\n\t\t\t_\u0026\u0026_(\n\t\t\t\t_==_(\n\t\t\t\t\tx~map(string, dyn)^x.claims~dyn.structured~dyn,\n\t\t\t\t\t{\n\t\t\t\t\t\t"key"~string:z~dyn^z\n\t\t\t\t\t}~map(string, dyn)\n\t\t\t\t)~bool^equals,\n\t\t\t\t_==_(\n\t\t\t\t\tz~dyn^z,\n\t\t\t\t\t1~double\n\t\t\t\t)~bool^equals\n\t\t\t)~bool^logical_and\n\t\t)~bool^logical_and',
+      expectedType: "bool",
     },
     {
       original: {
@@ -427,9 +4418,48 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x + y",
+      locationAst: "_+_(\n  x^#1[1,0]#,\n  y^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "3", identExpr: { name: "y" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:3: found no matching overload for '_+_' applied to '(list(google.expr.proto3.test.TestAllTypes), list(int))'\n | x + y\n | ..^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_+_' applied to '(list(google.expr.proto3.test.TestAllTypes), list(int))'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedError:
+        "\nERROR: \u003cinput\u003e:1:3: found no matching overload for '_+_' applied to '(list(google.expr.proto3.test.TestAllTypes), list(int))'\n  | x + y\n  | ..^\n\t\t",
     },
     {
       original: {
@@ -449,9 +4479,48 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x[1u]",
+      locationAst: "_[_](\n  x^#1[1,0]#,\n  1u^#3[1,2]#\n)^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "3", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1, "3": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: found no matching overload for '_[_]' applied to '(list(google.expr.proto3.test.TestAllTypes), uint)'\n | x[1u]\n | .^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_[_]' applied to '(list(google.expr.proto3.test.TestAllTypes), uint)'",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedError:
+        "\nERROR: \u003cinput\u003e:1:2: found no matching overload for '_[_]' applied to '(list(google.expr.proto3.test.TestAllTypes), uint)'\n  | x[1u]\n  | .^\n",
     },
     {
       original: {
@@ -471,10 +4540,184 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  _[_](\n    _+_(\n      x^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#.single_int32^#*expr.Expr_SelectExpr#,\n  size(\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
-      checkedAst:
-        "_==_(\n  _[_](\n    _+_(\n      x~list(google.expr.proto3.test.TestAllTypes)^x,\n      x~list(google.expr.proto3.test.TestAllTypes)^x\n    )~list(google.expr.proto3.test.TestAllTypes)^add_list,\n    1~int\n  )~google.expr.proto3.test.TestAllTypes^index_list.single_int32~int,\n  size(\n    x~list(google.expr.proto3.test.TestAllTypes)^x\n  )~int^size_list\n)~bool^equals",
+      unparsed: "(x + x)[1].single_int32 == size(x)",
+      locationAst:
+        "_==_(\n  _[_](\n    _+_(\n      x^#1[1,1]#,\n      x^#3[1,5]#\n    )^#2[1,3]#,\n    1^#5[1,8]#\n  )^#4[1,7]#.single_int32^#6[1,10]#,\n  size(\n    x^#9[1,32]#\n  )^#8[1,31]#\n)^#7[1,24]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 24, 26, 1, 24, 1, 26],
+        [8, 31, 32, 1, 31, 1, 32],
+        [9, 32, 33, 1, 32, 1, 33],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                selectExpr: {
+                  operand: {
+                    id: "4",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        {
+                          id: "2",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "1", identExpr: { name: "x" } },
+                              { id: "3", identExpr: { name: "x" } },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  field: "single_int32",
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "size",
+                  args: [{ id: "9", identExpr: { name: "x" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 8,
+            "6": 10,
+            "7": 24,
+            "8": 31,
+            "9": 32,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _[_](\n    _+_(\n      x~list(google.expr.proto3.test.TestAllTypes)^x,\n      x~list(google.expr.proto3.test.TestAllTypes)^x\n    )~list(google.expr.proto3.test.TestAllTypes)^add_list,\n    1~int\n  )~google.expr.proto3.test.TestAllTypes^index_list.single_int32~int,\n  size(\n    x~list(google.expr.proto3.test.TestAllTypes)^x\n  )~int^size_list\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["add_list"] },
+          "3": { name: "x" },
+          "4": { overloadId: ["index_list"] },
+          "7": { overloadId: ["equals"] },
+          "8": { overloadId: ["size_list"] },
+          "9": { name: "x" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "2": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "3": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "4": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "INT64" },
+          "9": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 8,
+            "6": 10,
+            "7": 24,
+            "8": 31,
+            "9": 32,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                selectExpr: {
+                  operand: {
+                    id: "4",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        {
+                          id: "2",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "1", identExpr: { name: "x" } },
+                              { id: "3", identExpr: { name: "x" } },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  field: "single_int32",
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "size",
+                  args: [{ id: "9", identExpr: { name: "x" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "8", max: "8" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "7",
+      expectedCheckedAst:
+        "\n_==_(_[_](_+_(x~list(google.expr.proto3.test.TestAllTypes)^x,\n                x~list(google.expr.proto3.test.TestAllTypes)^x)\n            ~list(google.expr.proto3.test.TestAllTypes)^add_list,\n           1~int)\n       ~google.expr.proto3.test.TestAllTypes^index_list\n       .\n       single_int32\n       ~int,\n      size(x~list(google.expr.proto3.test.TestAllTypes)^x)~int^size_list)\n  ~bool^equals\n\t",
+      expectedType: "bool",
     },
     {
       original: {
@@ -488,10 +4731,139 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  _[_](\n    x^#*expr.Expr_IdentExpr#.repeated_int64^#*expr.Expr_SelectExpr#,\n    x^#*expr.Expr_IdentExpr#.single_int32^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  23^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.repeated_int64[x.single_int32] == 23",
+      locationAst:
+        "_==_(\n  _[_](\n    x^#1[1,0]#.repeated_int64^#2[1,1]#,\n    x^#4[1,17]#.single_int32^#5[1,18]#\n  )^#3[1,16]#,\n  23^#7[1,36]#\n)^#6[1,33]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 33, 35, 1, 33, 1, 35],
+        [7, 36, 38, 1, 36, 1, 38],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "repeated_int64",
+                      },
+                    },
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: { id: "4", identExpr: { name: "x" } },
+                        field: "single_int32",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 33,
+            "7": 36,
+          },
+        },
+      },
       checkedAst:
         "_==_(\n  _[_](\n    x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n    x~google.expr.proto3.test.TestAllTypes^x.single_int32~int\n  )~int^index_list,\n  23~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["index_list"] },
+          "4": { name: "x" },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 33,
+            "7": 36,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "repeated_int64",
+                      },
+                    },
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: { id: "4", identExpr: { name: "x" } },
+                        field: "single_int32",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "6", max: "6" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "2",
+      expectedCheckedAst:
+        "\n_==_(_[_](x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n           x~google.expr.proto3.test.TestAllTypes^x.single_int32~int)\n       ~int^index_list,\n      23~int)\n  ~bool^equals",
+      expectedType: "bool",
     },
     {
       original: {
@@ -505,19 +4877,251 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  size(\n    x^#*expr.Expr_IdentExpr#.map_int64_nested_type^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "size(x.map_int64_nested_type) == 0",
+      locationAst:
+        "_==_(\n  size(\n    x^#2[1,5]#.map_int64_nested_type^#3[1,6]#\n  )^#1[1,4]#,\n  0^#5[1,33]#\n)^#4[1,30]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 30, 32, 1, 30, 1, 32],
+        [5, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "size",
+                  args: [
+                    {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "x" } },
+                        field: "map_int64_nested_type",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 4, "2": 5, "3": 6, "4": 30, "5": 33 },
+        },
+      },
       checkedAst:
         "_==_(\n  size(\n    x~google.expr.proto3.test.TestAllTypes^x.map_int64_nested_type~map(int, google.expr.proto3.test.NestedTestAllTypes)\n  )~int^size_map,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["size_map"] },
+          "2": { name: "x" },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: {
+                messageType: "google.expr.proto3.test.NestedTestAllTypes",
+              },
+            },
+          },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 4, "2": 5, "3": 6, "4": 30, "5": 33 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "size",
+                  args: [
+                    {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "x" } },
+                        field: "map_int64_nested_type",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "4", max: "4" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "3",
+      expectedCheckedAst:
+        "\n_==_(size(x~google.expr.proto3.test.TestAllTypes^x.map_int64_nested_type\n            ~map(int, google.expr.proto3.test.NestedTestAllTypes))\n       ~int^size_map,\n      0~int)\n  ~bool^equals\n\t\t",
+      expectedType: "bool",
     },
     {
       original: {
         expr: "x.all(y, y == true)",
         typeEnv: [{ name: "x", ident: { type: { primitive: "BOOL" } } }],
       },
+      crossTypeNumericComparisons: true,
       ast: "__comprehension__(\n  // Variable\n  y,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      y^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "x.all(y, y == true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  x^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#7[1,5]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#8[1,5]#\n  )^#9[1,5]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#10[1,5]#,\n    _==_(\n      y^#4[1,9]#,\n      true^#6[1,14]#\n    )^#5[1,11]#\n  )^#11[1,5]#,\n  // Result\n  @result^#12[1,5]#)^#13[1,5]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 11, 13, 1, 11, 1, 13],
+        [6, 14, 18, 1, 14, 1, 18],
+        [7, 5, 5, 1, 5, 1, 5],
+        [8, 5, 5, 1, 5, 1, 5],
+        [9, 5, 5, 1, 5, 1, 5],
+        [10, 5, 5, 1, 5, 1, 5],
+        [11, 5, 5, 1, 5, 1, 5],
+        [12, 5, 5, 1, 5, 1, 5],
+        [13, 5, 5, 1, 5, 1, 5],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: { id: "1", identExpr: { name: "x" } },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "4", identExpr: { name: "y" } },
+                        { id: "6", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "3": 6,
+            "4": 9,
+            "5": 11,
+            "6": 14,
+            "7": 5,
+            "8": 5,
+            "9": 5,
+            "10": 5,
+            "11": 5,
+            "12": 5,
+            "13": 5,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "x" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "y" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "4", identExpr: { name: "y" } },
+                        { id: "6", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "all",
+          target: { id: "1", identExpr: { name: "x" } },
+          args: [
+            { id: "3", identExpr: { name: "y" } },
+            {
+              id: "5",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "4", identExpr: { name: "y" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: expression of type 'bool' cannot be range of a comprehension (must be list, map, or dynamic)\n | x.all(y, y == true)\n | ^",
+      errors: [
+        {
+          category: "other",
+          message:
+            "expression of type 'bool' cannot be range of a comprehension (must be list, map, or dynamic)",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedCheckedAst:
+        "\n\t\t__comprehension__(\n\t\t// Variable\n\t\ty,\n\t\t// Target\n\t\tx~bool^x,\n\t\t// Accumulator\n\t\t@result,\n\t\t// Init\n\t\ttrue~bool,\n\t\t// LoopCondition\n\t\t@not_strictly_false(\n\t\t\t@result~bool^@result\n\t\t)~bool^not_strictly_false,\n\t\t// LoopStep\n\t\t_\u0026\u0026_(\n\t\t\t@result~bool^@result,\n\t\t\t_==_(\n\t\t\ty~!error!^y,\n\t\t\ttrue~bool\n\t\t\t)~bool^equals\n\t\t)~bool^logical_and,\n\t\t// Result\n\t\t@result~bool^@result)~bool\n\t\t",
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: expression of type 'bool' cannot be range of a comprehension (must be list, map, or dynamic)\n\t\t| x.all(y, y == true)\n\t\t| ^",
     },
     {
       original: {
@@ -531,10 +5135,249 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  x^#*expr.Expr_IdentExpr#.repeated_int64^#*expr.Expr_SelectExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      double(\n        x^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "x.repeated_int64.map(x, double(x))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  x^#1[1,0]#.repeated_int64^#2[1,1]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#7[1,20]#,\n  // LoopCondition\n  true^#8[1,20]#,\n  // LoopStep\n  _+_(\n    @result^#9[1,20]#,\n    [\n      double(\n        x^#6[1,31]#\n      )^#5[1,30]#\n    ]^#10[1,20]#\n  )^#11[1,20]#,\n  // Result\n  @result^#12[1,20]#)^#13[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 30, 31, 1, 30, 1, 31],
+        [6, 31, 32, 1, 31, 1, 32],
+        [7, 20, 20, 1, 20, 1, 20],
+        [8, 20, 20, 1, 20, 1, 20],
+        [9, 20, 20, 1, 20, 1, 20],
+        [10, 20, 20, 1, 20, 1, 20],
+        [11, 20, 20, 1, 20, 1, 20],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "repeated_int64",
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "7", listExpr: {} },
+            loopCondition: { id: "8", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "9", identExpr: { name: "@result" } },
+                  {
+                    id: "10",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "5",
+                          callExpr: {
+                            function: "double",
+                            args: [{ id: "6", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 21,
+            "5": 30,
+            "6": 31,
+            "7": 20,
+            "8": 20,
+            "9": 20,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "4", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "double",
+                      args: [{ id: "6", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "map",
+          target: {
+            id: "2",
+            selectExpr: {
+              operand: { id: "1", identExpr: { name: "x" } },
+              field: "repeated_int64",
+            },
+          },
+          args: [
+            { id: "4", identExpr: { name: "x" } },
+            {
+              id: "5",
+              callExpr: {
+                function: "double",
+                args: [{ id: "6", identExpr: { name: "x" } }],
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         "__comprehension__(\n  // Variable\n  x,\n  // Target\n  x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(double),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(double)^@result,\n    [\n      double(\n        x~int^x\n      )~double^int64_to_double\n    ]~list(double)\n  )~list(double)^add_list,\n  // Result\n  @result~list(double)^@result)~list(double)",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "5": { overloadId: ["int64_to_double"] },
+          "6": { name: "x" },
+          "9": { name: "@result" },
+          "11": { overloadId: ["add_list"] },
+          "12": { name: "@result" },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "8": { primitive: "BOOL" },
+          "9": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "10": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "11": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "12": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "13": { listType: { elemType: { primitive: "DOUBLE" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 21,
+            "5": 30,
+            "6": 31,
+            "7": 20,
+            "8": 20,
+            "9": 20,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "4", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "double",
+                      args: [{ id: "6", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "repeated_int64",
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "7", listExpr: {} },
+            loopCondition: { id: "8", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "9", identExpr: { name: "@result" } },
+                  {
+                    id: "10",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "5",
+                          callExpr: {
+                            function: "double",
+                            args: [{ id: "6", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+      },
       type: "list(double)",
+      cost: { min: "13", max: "18446744073709551615" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        "\n\t\t__comprehension__(\n    \t\t  // Variable\n    \t\t  x,\n    \t\t  // Target\n    \t\t  x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n    \t\t  // Accumulator\n    \t\t  @result,\n    \t\t  // Init\n    \t\t  []~list(double),\n    \t\t  // LoopCondition\n    \t\t  true~bool,\n    \t\t  // LoopStep\n    \t\t  _+_(\n    \t\t    @result~list(double)^@result,\n    \t\t    [\n    \t\t      double(\n    \t\t        x~int^x\n    \t\t      )~double^int64_to_double\n    \t\t    ]~list(double)\n    \t\t  )~list(double)^add_list,\n    \t\t  // Result\n    \t\t  @result~list(double)^@result)~list(double)\n\t\t",
+      expectedType: "list(double)",
     },
     {
       original: {
@@ -548,10 +5391,341 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  x^#*expr.Expr_IdentExpr#.repeated_int64^#*expr.Expr_SelectExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        double(\n          x^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "x.repeated_int64.map(x, x \u003e 0, double(x))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  x^#1[1,0]#.repeated_int64^#2[1,1]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#10[1,20]#,\n  // LoopCondition\n  true^#11[1,20]#,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x^#5[1,24]#,\n      0^#7[1,28]#\n    )^#6[1,26]#,\n    _+_(\n      @result^#12[1,20]#,\n      [\n        double(\n          x^#9[1,38]#\n        )^#8[1,37]#\n      ]^#13[1,20]#\n    )^#14[1,20]#,\n    @result^#15[1,20]#\n  )^#16[1,20]#,\n  // Result\n  @result^#17[1,20]#)^#18[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 26, 27, 1, 26, 1, 27],
+        [7, 28, 29, 1, 28, 1, 29],
+        [8, 37, 38, 1, 37, 1, 38],
+        [9, 38, 39, 1, 38, 1, 39],
+        [10, 20, 20, 1, 20, 1, 20],
+        [11, 20, 20, 1, 20, 1, 20],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 20, 20, 1, 20, 1, 20],
+        [16, 20, 20, 1, 20, 1, 20],
+        [17, 20, 20, 1, 20, 1, 20],
+        [18, 20, 20, 1, 20, 1, 20],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "18",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "repeated_int64",
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", listExpr: {} },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "16",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "5", identExpr: { name: "x" } },
+                        { id: "7", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "double",
+                                  args: [{ id: "9", identExpr: { name: "x" } }],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "15", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "17", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 21,
+            "5": 24,
+            "6": 26,
+            "7": 28,
+            "8": 37,
+            "9": 38,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "4", identExpr: { name: "x" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "5", identExpr: { name: "x" } },
+                        { id: "7", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "double",
+                      args: [{ id: "9", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "map",
+          target: {
+            id: "2",
+            selectExpr: {
+              operand: { id: "1", identExpr: { name: "x" } },
+              field: "repeated_int64",
+            },
+          },
+          args: [
+            { id: "4", identExpr: { name: "x" } },
+            {
+              id: "6",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "5", identExpr: { name: "x" } },
+                  { id: "7", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+            {
+              id: "8",
+              callExpr: {
+                function: "double",
+                args: [{ id: "9", identExpr: { name: "x" } }],
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         "__comprehension__(\n  // Variable\n  x,\n  // Target\n  x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(double),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x~int^x,\n      0~int\n    )~bool^greater_int64,\n    _+_(\n      @result~list(double)^@result,\n      [\n        double(\n          x~int^x\n        )~double^int64_to_double\n      ]~list(double)\n    )~list(double)^add_list,\n    @result~list(double)^@result\n  )~list(double)^conditional,\n  // Result\n  @result~list(double)^@result)~list(double)",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "5": { name: "x" },
+          "6": { overloadId: ["greater_int64"] },
+          "8": { overloadId: ["int64_to_double"] },
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "16": { overloadId: ["conditional"] },
+          "17": { name: "@result" },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "DOUBLE" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "13": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "14": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "15": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "16": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "17": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "18": { listType: { elemType: { primitive: "DOUBLE" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 21,
+            "5": 24,
+            "6": 26,
+            "7": 28,
+            "8": 37,
+            "9": 38,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "4", identExpr: { name: "x" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "5", identExpr: { name: "x" } },
+                        { id: "7", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "double",
+                      args: [{ id: "9", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "18",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "repeated_int64",
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", listExpr: {} },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "16",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "5", identExpr: { name: "x" } },
+                        { id: "7", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "double",
+                                  args: [{ id: "9", identExpr: { name: "x" } }],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "15", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "17", identExpr: { name: "@result" } },
+          },
+        },
+      },
       type: "list(double)",
+      cost: { min: "13", max: "18446744073709551615" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        "\n\t__comprehension__(\n    \t\t  // Variable\n    \t\t  x,\n    \t\t  // Target\n    \t\t  x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n    \t\t  // Accumulator\n    \t\t  @result,\n    \t\t  // Init\n    \t\t  []~list(double),\n    \t\t  // LoopCondition\n    \t\t  true~bool,\n    \t\t  // LoopStep\n    \t\t  _?_:_(\n    \t\t    _\u003e_(\n    \t\t      x~int^x,\n    \t\t      0~int\n    \t\t    )~bool^greater_int64,\n    \t\t    _+_(\n    \t\t      @result~list(double)^@result,\n    \t\t      [\n    \t\t        double(\n    \t\t          x~int^x\n    \t\t        )~double^int64_to_double\n    \t\t      ]~list(double)\n    \t\t    )~list(double)^add_list,\n    \t\t    @result~list(double)^@result\n    \t\t  )~list(double)^conditional,\n    \t\t  // Result\n    \t\t  @result~list(double)^@result)~list(double)\n\t\t",
+      expectedType: "list(double)",
     },
     {
       original: {
@@ -572,9 +5746,67 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  _[_](\n    x^#*expr.Expr_IdentExpr#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#.single_int32^#*expr.Expr_SelectExpr#,\n  23^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x[2].single_int32 == 23",
+      locationAst:
+        "_==_(\n  _[_](\n    x^#1[1,0]#,\n    2^#3[1,2]#\n  )^#2[1,1]#.single_int32^#4[1,4]#,\n  23^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  field: "single_int32",
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 4, "5": 18, "6": 21 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: found no matching overload for '_[_]' applied to '(map(string, google.expr.proto3.test.TestAllTypes), int)'\n | x[2].single_int32 == 23\n | .^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_[_]' applied to '(map(string, google.expr.proto3.test.TestAllTypes), int)'",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedError:
+        "\nERROR: \u003cinput\u003e:1:2: found no matching overload for '_[_]' applied to '(map(string, google.expr.proto3.test.TestAllTypes), int)'\n  | x[2].single_int32 == 23\n  | .^\n\t\t",
     },
     {
       original: {
@@ -595,10 +5827,115 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: '_==_(\n  _[_](\n    x^#*expr.Expr_IdentExpr#,\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#.single_int32^#*expr.Expr_SelectExpr#,\n  23^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'x["a"].single_int32 == 23',
+      locationAst:
+        '_==_(\n  _[_](\n    x^#1[1,0]#,\n    "a"^#3[1,2]#\n  )^#2[1,1]#.single_int32^#4[1,6]#,\n  23^#6[1,23]#\n)^#5[1,20]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 5, 1, 2, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { stringValue: "a" } },
+                      ],
+                    },
+                  },
+                  field: "single_int32",
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 6, "5": 20, "6": 23 },
+        },
+      },
       checkedAst:
         '_==_(\n  _[_](\n    x~map(string, google.expr.proto3.test.TestAllTypes)^x,\n    "a"~string\n  )~google.expr.proto3.test.TestAllTypes^index_map.single_int32~int,\n  23~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["index_map"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 6, "5": 20, "6": 23 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { stringValue: "a" } },
+                      ],
+                    },
+                  },
+                  field: "single_int32",
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "4", max: "4" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "2",
+      expectedCheckedAst:
+        '\n\t\t_==_(_[_](x~map(string, google.expr.proto3.test.TestAllTypes)^x, "a"~string)\n\t\t~google.expr.proto3.test.TestAllTypes^index_map\n\t\t.\n\t\tsingle_int32\n\t\t~int,\n\t\t23~int)\n\t\t~bool^equals',
+      expectedType: "bool",
     },
     {
       original: {
@@ -612,10 +5949,210 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _==_(\n    x^#*expr.Expr_IdentExpr#.single_nested_message^#*expr.Expr_SelectExpr#.bb^#*expr.Expr_SelectExpr#,\n    43^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#.single_nested_message~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "x.single_nested_message.bb == 43 \u0026\u0026 has(x.single_nested_message)",
+      locationAst:
+        "_\u0026\u0026_(\n  _==_(\n    x^#1[1,0]#.single_nested_message^#2[1,1]#.bb^#3[1,23]#,\n    43^#5[1,30]#\n  )^#4[1,27]#,\n  x^#7[1,40]#.single_nested_message~test-only~^#9[1,39]#\n)^#10[1,33]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 23, 24, 1, 23, 1, 24],
+        [4, 27, 29, 1, 27, 1, 29],
+        [5, 30, 32, 1, 30, 1, 32],
+        [7, 40, 41, 1, 40, 1, 41],
+        [8, 41, 42, 1, 41, 1, 42],
+        [9, 39, 39, 1, 39, 1, 39],
+        [10, 33, 35, 1, 33, 1, 35],
+      ],
+      lineOffsets: [65],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      selectExpr: {
+                        operand: {
+                          id: "2",
+                          selectExpr: {
+                            operand: { id: "1", identExpr: { name: "x" } },
+                            field: "single_nested_message",
+                          },
+                        },
+                        field: "bb",
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "43" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                selectExpr: {
+                  operand: { id: "7", identExpr: { name: "x" } },
+                  field: "single_nested_message",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [65],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 23,
+            "4": 27,
+            "5": 30,
+            "7": 40,
+            "8": 41,
+            "9": 39,
+            "10": 33,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "x" } },
+                      field: "single_nested_message",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "x" } },
+                field: "single_nested_message",
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         "_\u0026\u0026_(\n  _==_(\n    x~google.expr.proto3.test.TestAllTypes^x.single_nested_message~google.expr.proto3.test.TestAllTypes.NestedMessage.bb~int,\n    43~int\n  )~bool^equals,\n  x~google.expr.proto3.test.TestAllTypes^x.single_nested_message~test-only~~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "4": { overloadId: ["equals"] },
+          "7": { name: "x" },
+          "10": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": {
+            messageType: "google.expr.proto3.test.TestAllTypes.NestedMessage",
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+          "7": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [65],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 23,
+            "4": 27,
+            "5": 30,
+            "7": 40,
+            "8": 41,
+            "9": 39,
+            "10": 33,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "x" } },
+                      field: "single_nested_message",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      selectExpr: {
+                        operand: {
+                          id: "2",
+                          selectExpr: {
+                            operand: { id: "1", identExpr: { name: "x" } },
+                            field: "single_nested_message",
+                          },
+                        },
+                        field: "bb",
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "43" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                selectExpr: {
+                  operand: { id: "7", identExpr: { name: "x" } },
+                  field: "single_nested_message",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "4", max: "6" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "3",
+      expectedCheckedAst:
+        "_\u0026\u0026_(\n    \t\t  _==_(\n    \t\t    x~google.expr.proto3.test.TestAllTypes^x.single_nested_message~google.expr.proto3.test.TestAllTypes.NestedMessage.bb~int,\n    \t\t    43~int\n    \t\t  )~bool^equals,\n    \t\t  x~google.expr.proto3.test.TestAllTypes^x.single_nested_message~test-only~~bool\n    \t\t)~bool^logical_and",
+      expectedType: "bool",
     },
     {
       original: {
@@ -629,26 +6166,288 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _==_(\n      x^#*expr.Expr_IdentExpr#.single_nested_message^#*expr.Expr_SelectExpr#.undefined^#*expr.Expr_SelectExpr#,\n      x^#*expr.Expr_IdentExpr#.undefined^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#,\n    x^#*expr.Expr_IdentExpr#.single_int32~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#.repeated_int32~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
-      error:
-        "ERROR: \u003cinput\u003e:1:24: undefined field 'undefined'\n | x.single_nested_message.undefined == x.undefined \u0026\u0026 has(x.single_int32) \u0026\u0026 has(x.repeated_int32)\n | .......................^\nERROR: \u003cinput\u003e:1:39: undefined field 'undefined'\n | x.single_nested_message.undefined == x.undefined \u0026\u0026 has(x.single_int32) \u0026\u0026 has(x.repeated_int32)\n | ......................................^",
-    },
-    {
-      original: {
-        expr: "x.single_nested_message != null",
-        typeEnv: [
-          {
-            name: "x",
-            ident: {
-              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
-            },
+      unparsed:
+        "x.single_nested_message.undefined == x.undefined \u0026\u0026 has(x.single_int32) \u0026\u0026 has(x.repeated_int32)",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _==_(\n      x^#1[1,0]#.single_nested_message^#2[1,1]#.undefined^#3[1,23]#,\n      x^#5[1,37]#.undefined^#6[1,38]#\n    )^#4[1,34]#,\n    x^#8[1,56]#.single_int32~test-only~^#10[1,55]#\n  )^#11[1,49]#,\n  x^#13[1,79]#.repeated_int32~test-only~^#15[1,78]#\n)^#16[1,72]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 23, 24, 1, 23, 1, 24],
+        [4, 34, 36, 1, 34, 1, 36],
+        [5, 37, 38, 1, 37, 1, 38],
+        [6, 38, 39, 1, 38, 1, 39],
+        [8, 56, 57, 1, 56, 1, 57],
+        [9, 57, 58, 1, 57, 1, 58],
+        [10, 55, 55, 1, 55, 1, 55],
+        [11, 49, 51, 1, 49, 1, 51],
+        [13, 79, 80, 1, 79, 1, 80],
+        [14, 80, 81, 1, 80, 1, 81],
+        [15, 78, 78, 1, 78, 1, 78],
+        [16, 72, 74, 1, 72, 1, 74],
+      ],
+      lineOffsets: [97],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "3",
+                            selectExpr: {
+                              operand: {
+                                id: "2",
+                                selectExpr: {
+                                  operand: {
+                                    id: "1",
+                                    identExpr: { name: "x" },
+                                  },
+                                  field: "single_nested_message",
+                                },
+                              },
+                              field: "undefined",
+                            },
+                          },
+                          {
+                            id: "6",
+                            selectExpr: {
+                              operand: { id: "5", identExpr: { name: "x" } },
+                              field: "undefined",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "10",
+                      selectExpr: {
+                        operand: { id: "8", identExpr: { name: "x" } },
+                        field: "single_int32",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                selectExpr: {
+                  operand: { id: "13", identExpr: { name: "x" } },
+                  field: "repeated_int32",
+                  testOnly: true,
+                },
+              },
+            ],
           },
-        ],
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [97],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 23,
+            "4": 34,
+            "5": 37,
+            "6": 38,
+            "8": 56,
+            "9": 57,
+            "10": 55,
+            "11": 49,
+            "13": 79,
+            "14": 80,
+            "15": 78,
+            "16": 72,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "8", identExpr: { name: "x" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+            "15": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "14",
+                    selectExpr: {
+                      operand: { id: "13", identExpr: { name: "x" } },
+                      field: "repeated_int32",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 10,
+          function: "has",
+          args: [
+            {
+              id: "9",
+              selectExpr: {
+                operand: { id: "8", identExpr: { name: "x" } },
+                field: "single_int32",
+              },
+            },
+          ],
+        },
+        {
+          id: 15,
+          function: "has",
+          args: [
+            {
+              id: "14",
+              selectExpr: {
+                operand: { id: "13", identExpr: { name: "x" } },
+                field: "repeated_int32",
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        "ERROR: \u003cinput\u003e:1:24: undefined field 'undefined'\n | x.single_nested_message.undefined == x.undefined \u0026\u0026 has(x.single_int32) \u0026\u0026 has(x.repeated_int32)\n | .......................^\nERROR: \u003cinput\u003e:1:39: undefined field 'undefined'\n | x.single_nested_message.undefined == x.undefined \u0026\u0026 has(x.single_int32) \u0026\u0026 has(x.repeated_int32)\n | ......................................^",
+      errors: [
+        {
+          category: "undefined-field",
+          message: "undefined field 'undefined'",
+          id: 3,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+        {
+          category: "undefined-field",
+          message: "undefined field 'undefined'",
+          id: 6,
+          offset: 38,
+          line: 1,
+          column: 38,
+        },
+      ],
+      expectedError:
+        "\nERROR: \u003cinput\u003e:1:24: undefined field 'undefined'\n| x.single_nested_message.undefined == x.undefined \u0026\u0026 has(x.single_int32) \u0026\u0026 has(x.repeated_int32)\n| .......................^\nERROR: \u003cinput\u003e:1:39: undefined field 'undefined'\n| x.single_nested_message.undefined == x.undefined \u0026\u0026 has(x.single_int32) \u0026\u0026 has(x.repeated_int32)\n| ......................................^",
+    },
+    {
+      original: {
+        expr: "x.single_nested_message != null",
+        typeEnv: [
+          {
+            name: "x",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_!=_(\n  x^#*expr.Expr_IdentExpr#.single_nested_message^#*expr.Expr_SelectExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.single_nested_message != null",
+      locationAst:
+        "_!=_(\n  x^#1[1,0]#.single_nested_message^#2[1,1]#,\n  null^#4[1,27]#\n)^#3[1,24]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 24, 26, 1, 24, 1, 26],
+        [4, 27, 31, 1, 27, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "x" } },
+                  field: "single_nested_message",
+                },
+              },
+              { id: "4", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 1, "3": 24, "4": 27 },
+        },
+      },
       checkedAst:
         "_!=_(\n  x~google.expr.proto3.test.TestAllTypes^x.single_nested_message~google.expr.proto3.test.TestAllTypes.NestedMessage,\n  null~null\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": {
+            messageType: "google.expr.proto3.test.TestAllTypes.NestedMessage",
+          },
+          "3": { primitive: "BOOL" },
+          "4": { null: null },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 1, "3": 24, "4": 27 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "x" } },
+                  field: "single_nested_message",
+                },
+              },
+              { id: "4", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "2",
+      expectedCheckedAst:
+        "\n\t\t_!=_(x~google.expr.proto3.test.TestAllTypes^x.single_nested_message\n\t\t~google.expr.proto3.test.TestAllTypes.NestedMessage,\n\t\tnull~null)\n\t\t~bool^not_equals\n\t\t",
+      expectedType: "bool",
     },
     {
       original: {
@@ -662,9 +6461,56 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_!=_(\n  x^#*expr.Expr_IdentExpr#.single_int64^#*expr.Expr_SelectExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.single_int64 != null",
+      locationAst:
+        "_!=_(\n  x^#1[1,0]#.single_int64^#2[1,1]#,\n  null^#4[1,18]#\n)^#3[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 15, 17, 1, 15, 1, 17],
+        [4, 18, 22, 1, 18, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "x" } },
+                  field: "single_int64",
+                },
+              },
+              { id: "4", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 1, "3": 15, "4": 18 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:16: found no matching overload for '_!=_' applied to '(int, null)'\n | x.single_int64 != null\n | ...............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_!=_' applied to '(int, null)'",
+          id: 3,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+      ],
+      expectedError:
+        "\nERROR: \u003cinput\u003e:1:16: found no matching overload for '_!=_' applied to '(int, null)'\n | x.single_int64 != null\n | ...............^\n\t\t",
     },
     {
       original: {
@@ -678,10 +6524,82 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  x^#*expr.Expr_IdentExpr#.single_int64_wrapper^#*expr.Expr_SelectExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.single_int64_wrapper == null",
+      locationAst:
+        "_==_(\n  x^#1[1,0]#.single_int64_wrapper^#2[1,1]#,\n  null^#4[1,26]#\n)^#3[1,23]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 30, 1, 26, 1, 30],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "x" } },
+                  field: "single_int64_wrapper",
+                },
+              },
+              { id: "4", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 1, "3": 23, "4": 26 },
+        },
+      },
       checkedAst:
         "_==_(\n  x~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper~wrapper(int),\n  null~null\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: { "1": { name: "x" }, "3": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wrapper: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { null: null },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 1, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "x" } },
+                  field: "single_int64_wrapper",
+                },
+              },
+              { id: "4", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "2",
+      expectedCheckedAst:
+        "\n\t\t_==_(x~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper\n\t\t~wrapper(int),\n\t\tnull~null)\n\t\t~bool^equals\n\t\t",
+      expectedType: "bool",
     },
     {
       original: {
@@ -695,10 +6613,697 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        x^#*expr.Expr_IdentExpr#.single_bool_wrapper^#*expr.Expr_SelectExpr#,\n        _==_(\n          x^#*expr.Expr_IdentExpr#.single_bytes_wrapper^#*expr.Expr_SelectExpr#,\n          b"hi"^#*expr.Constant_BytesValue#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      _!=_(\n        x^#*expr.Expr_IdentExpr#.single_double_wrapper^#*expr.Expr_SelectExpr#,\n        2^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_float_wrapper^#*expr.Expr_SelectExpr#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#,\n      _!=_(\n        x^#*expr.Expr_IdentExpr#.single_int32_wrapper^#*expr.Expr_SelectExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_int64_wrapper^#*expr.Expr_SelectExpr#,\n        1^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_string_wrapper^#*expr.Expr_SelectExpr#,\n        "hi"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_uint32_wrapper^#*expr.Expr_SelectExpr#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#,\n      _!=_(\n        x^#*expr.Expr_IdentExpr#.single_uint64_wrapper^#*expr.Expr_SelectExpr#,\n        42u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'x.single_bool_wrapper \u0026\u0026 x.single_bytes_wrapper == b"\\150\\151" \u0026\u0026 x.single_double_wrapper != 2.0 \u0026\u0026\nx.single_float_wrapper == 1.0 \u0026\u0026 x.single_int32_wrapper != 2 \u0026\u0026 x.single_int64_wrapper == 1 \u0026\u0026\nx.single_string_wrapper == "hi" \u0026\u0026 x.single_uint32_wrapper == 1u \u0026\u0026 x.single_uint64_wrapper != 42u',
+      locationAst:
+        '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        x^#1[1,0]#.single_bool_wrapper^#2[1,1]#,\n        _==_(\n          x^#3[2,5]#.single_bytes_wrapper^#4[2,6]#,\n          b"hi"^#6[2,31]#\n        )^#5[2,28]#\n      )^#7[2,2]#,\n      _!=_(\n        x^#8[3,5]#.single_double_wrapper^#9[3,6]#,\n        2^#11[3,32]#\n      )^#10[3,29]#\n    )^#12[3,2]#,\n    _\u0026\u0026_(\n      _==_(\n        x^#13[4,5]#.single_float_wrapper^#14[4,6]#,\n        1^#16[4,31]#\n      )^#15[4,28]#,\n      _!=_(\n        x^#18[5,5]#.single_int32_wrapper^#19[5,6]#,\n        2^#21[5,31]#\n      )^#20[5,28]#\n    )^#22[5,2]#\n  )^#17[4,2]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _==_(\n        x^#23[6,5]#.single_int64_wrapper^#24[6,6]#,\n        1^#26[6,31]#\n      )^#25[6,28]#,\n      _==_(\n        x^#28[7,5]#.single_string_wrapper^#29[7,6]#,\n        "hi"^#31[7,32]#\n      )^#30[7,29]#\n    )^#32[7,2]#,\n    _\u0026\u0026_(\n      _==_(\n        x^#33[8,5]#.single_uint32_wrapper^#34[8,6]#,\n        1u^#36[8,32]#\n      )^#35[8,29]#,\n      _!=_(\n        x^#38[9,5]#.single_uint64_wrapper^#39[9,6]#,\n        42u^#41[9,32]#\n      )^#40[9,29]#\n    )^#42[9,2]#\n  )^#37[8,2]#\n)^#27[6,2]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 27, 28, 2, 5, 2, 6],
+        [4, 28, 29, 2, 6, 2, 7],
+        [5, 50, 52, 2, 28, 2, 30],
+        [6, 53, 58, 2, 31, 2, 36],
+        [7, 24, 26, 2, 2, 2, 4],
+        [8, 64, 65, 3, 5, 3, 6],
+        [9, 65, 66, 3, 6, 3, 7],
+        [10, 88, 90, 3, 29, 3, 31],
+        [11, 91, 94, 3, 32, 3, 35],
+        [12, 61, 63, 3, 2, 3, 4],
+        [13, 100, 101, 4, 5, 4, 6],
+        [14, 101, 102, 4, 6, 4, 7],
+        [15, 123, 125, 4, 28, 4, 30],
+        [16, 126, 129, 4, 31, 4, 34],
+        [17, 97, 99, 4, 2, 4, 4],
+        [18, 135, 136, 5, 5, 5, 6],
+        [19, 136, 137, 5, 6, 5, 7],
+        [20, 158, 160, 5, 28, 5, 30],
+        [21, 161, 162, 5, 31, 5, 32],
+        [22, 132, 134, 5, 2, 5, 4],
+        [23, 168, 169, 6, 5, 6, 6],
+        [24, 169, 170, 6, 6, 6, 7],
+        [25, 191, 193, 6, 28, 6, 30],
+        [26, 194, 195, 6, 31, 6, 32],
+        [27, 165, 167, 6, 2, 6, 4],
+        [28, 201, 202, 7, 5, 7, 6],
+        [29, 202, 203, 7, 6, 7, 7],
+        [30, 225, 227, 7, 29, 7, 31],
+        [31, 228, 232, 7, 32, 7, 36],
+        [32, 198, 200, 7, 2, 7, 4],
+        [33, 238, 239, 8, 5, 8, 6],
+        [34, 239, 240, 8, 6, 8, 7],
+        [35, 262, 264, 8, 29, 8, 31],
+        [36, 265, 267, 8, 32, 8, 34],
+        [37, 235, 237, 8, 2, 8, 4],
+        [38, 273, 274, 9, 5, 9, 6],
+        [39, 274, 275, 9, 6, 9, 7],
+        [40, 297, 299, 9, 29, 9, 31],
+        [41, 300, 303, 9, 32, 9, 35],
+        [42, 270, 272, 9, 2, 9, 4],
+      ],
+      lineOffsets: [22, 59, 95, 130, 163, 196, 233, 268, 304],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "7",
+                            callExpr: {
+                              function: "_\u0026\u0026_",
+                              args: [
+                                {
+                                  id: "2",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "1",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_bool_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "5",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "4",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "3",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_bytes_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "6",
+                                        constExpr: { bytesValue: "aGk=" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "10",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "9",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "8",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_double_wrapper",
+                                  },
+                                },
+                                { id: "11", constExpr: { doubleValue: 2 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "22",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "15",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "14",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "13",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_float_wrapper",
+                                  },
+                                },
+                                { id: "16", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "20",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "19",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "18",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_int32_wrapper",
+                                  },
+                                },
+                                { id: "21", constExpr: { int64Value: "2" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "37",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "32",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "25",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "24",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "23",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_int64_wrapper",
+                                  },
+                                },
+                                { id: "26", constExpr: { int64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "30",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "29",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "28",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_string_wrapper",
+                                  },
+                                },
+                                { id: "31", constExpr: { stringValue: "hi" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "42",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "35",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "34",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "33",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_uint32_wrapper",
+                                  },
+                                },
+                                { id: "36", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "40",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "39",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "38",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_uint64_wrapper",
+                                  },
+                                },
+                                { id: "41", constExpr: { uint64Value: "42" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22, 59, 95, 130, 163, 196, 233, 268, 304],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 27,
+            "4": 28,
+            "5": 50,
+            "6": 53,
+            "7": 24,
+            "8": 64,
+            "9": 65,
+            "10": 88,
+            "11": 91,
+            "12": 61,
+            "13": 100,
+            "14": 101,
+            "15": 123,
+            "16": 126,
+            "17": 97,
+            "18": 135,
+            "19": 136,
+            "20": 158,
+            "21": 161,
+            "22": 132,
+            "23": 168,
+            "24": 169,
+            "25": 191,
+            "26": 194,
+            "27": 165,
+            "28": 201,
+            "29": 202,
+            "30": 225,
+            "31": 228,
+            "32": 198,
+            "33": 238,
+            "34": 239,
+            "35": 262,
+            "36": 265,
+            "37": 235,
+            "38": 273,
+            "39": 274,
+            "40": 297,
+            "41": 300,
+            "42": 270,
+          },
+        },
+      },
       checkedAst:
         '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_bool_wrapper~wrapper(bool),\n        _==_(\n          x~google.expr.proto3.test.TestAllTypes^x.single_bytes_wrapper~wrapper(bytes),\n          b"hi"~bytes\n        )~bool^equals\n      )~bool^logical_and,\n      _!=_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_double_wrapper~wrapper(double),\n        2~double\n      )~bool^not_equals\n    )~bool^logical_and,\n    _\u0026\u0026_(\n      _==_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_float_wrapper~wrapper(double),\n        1~double\n      )~bool^equals,\n      _!=_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_int32_wrapper~wrapper(int),\n        2~int\n      )~bool^not_equals\n    )~bool^logical_and\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _==_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper~wrapper(int),\n        1~int\n      )~bool^equals,\n      _==_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_string_wrapper~wrapper(string),\n        "hi"~string\n      )~bool^equals\n    )~bool^logical_and,\n    _\u0026\u0026_(\n      _==_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_uint32_wrapper~wrapper(uint),\n        1u~uint\n      )~bool^equals,\n      _!=_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_uint64_wrapper~wrapper(uint),\n        42u~uint\n      )~bool^not_equals\n    )~bool^logical_and\n  )~bool^logical_and\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { name: "x" },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["logical_and"] },
+          "8": { name: "x" },
+          "10": { overloadId: ["not_equals"] },
+          "12": { overloadId: ["logical_and"] },
+          "13": { name: "x" },
+          "15": { overloadId: ["equals"] },
+          "17": { overloadId: ["logical_and"] },
+          "18": { name: "x" },
+          "20": { overloadId: ["not_equals"] },
+          "22": { overloadId: ["logical_and"] },
+          "23": { name: "x" },
+          "25": { overloadId: ["equals"] },
+          "27": { overloadId: ["logical_and"] },
+          "28": { name: "x" },
+          "30": { overloadId: ["equals"] },
+          "32": { overloadId: ["logical_and"] },
+          "33": { name: "x" },
+          "35": { overloadId: ["equals"] },
+          "37": { overloadId: ["logical_and"] },
+          "38": { name: "x" },
+          "40": { overloadId: ["not_equals"] },
+          "42": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wrapper: "BOOL" },
+          "3": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { wrapper: "BYTES" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BYTES" },
+          "7": { primitive: "BOOL" },
+          "8": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "9": { wrapper: "DOUBLE" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "DOUBLE" },
+          "12": { primitive: "BOOL" },
+          "13": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "14": { wrapper: "DOUBLE" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "DOUBLE" },
+          "17": { primitive: "BOOL" },
+          "18": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "19": { wrapper: "INT64" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "INT64" },
+          "22": { primitive: "BOOL" },
+          "23": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "24": { wrapper: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "27": { primitive: "BOOL" },
+          "28": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "29": { wrapper: "STRING" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "STRING" },
+          "32": { primitive: "BOOL" },
+          "33": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "34": { wrapper: "UINT64" },
+          "35": { primitive: "BOOL" },
+          "36": { primitive: "UINT64" },
+          "37": { primitive: "BOOL" },
+          "38": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "39": { wrapper: "UINT64" },
+          "40": { primitive: "BOOL" },
+          "41": { primitive: "UINT64" },
+          "42": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22, 59, 95, 130, 163, 196, 233, 268, 304],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 27,
+            "4": 28,
+            "5": 50,
+            "6": 53,
+            "7": 24,
+            "8": 64,
+            "9": 65,
+            "10": 88,
+            "11": 91,
+            "12": 61,
+            "13": 100,
+            "14": 101,
+            "15": 123,
+            "16": 126,
+            "17": 97,
+            "18": 135,
+            "19": 136,
+            "20": 158,
+            "21": 161,
+            "22": 132,
+            "23": 168,
+            "24": 169,
+            "25": 191,
+            "26": 194,
+            "27": 165,
+            "28": 201,
+            "29": 202,
+            "30": 225,
+            "31": 228,
+            "32": 198,
+            "33": 238,
+            "34": 239,
+            "35": 262,
+            "36": 265,
+            "37": 235,
+            "38": 273,
+            "39": 274,
+            "40": 297,
+            "41": 300,
+            "42": 270,
+          },
+        },
+        expr: {
+          id: "27",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "7",
+                            callExpr: {
+                              function: "_\u0026\u0026_",
+                              args: [
+                                {
+                                  id: "2",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "1",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_bool_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "5",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "4",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "3",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_bytes_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "6",
+                                        constExpr: { bytesValue: "aGk=" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "10",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "9",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "8",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_double_wrapper",
+                                  },
+                                },
+                                { id: "11", constExpr: { doubleValue: 2 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "22",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "15",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "14",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "13",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_float_wrapper",
+                                  },
+                                },
+                                { id: "16", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "20",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "19",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "18",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_int32_wrapper",
+                                  },
+                                },
+                                { id: "21", constExpr: { int64Value: "2" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "37",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "32",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "25",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "24",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "23",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_int64_wrapper",
+                                  },
+                                },
+                                { id: "26", constExpr: { int64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "30",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "29",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "28",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_string_wrapper",
+                                  },
+                                },
+                                { id: "31", constExpr: { stringValue: "hi" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "42",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "35",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "34",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "33",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_uint32_wrapper",
+                                  },
+                                },
+                                { id: "36", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "40",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "39",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "38",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_uint64_wrapper",
+                                  },
+                                },
+                                { id: "41", constExpr: { uint64Value: "42" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "2", max: "26" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "17",
+      expectedCheckedAst:
+        '\n\t\t_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_bool_wrapper~wrapper(bool),\n\t\t\t\t\t_==_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_bytes_wrapper~wrapper(bytes),\n\t\t\t\t\tb"hi"~bytes\n\t\t\t\t\t)~bool^equals\n\t\t\t\t)~bool^logical_and,\n\t\t\t\t_!=_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_double_wrapper~wrapper(double),\n\t\t\t\t\t2~double\n\t\t\t\t)~bool^not_equals\n\t\t\t\t)~bool^logical_and,\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t_==_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_float_wrapper~wrapper(double),\n\t\t\t\t\t1~double\n\t\t\t\t)~bool^equals,\n\t\t\t\t_!=_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_int32_wrapper~wrapper(int),\n\t\t\t\t\t2~int\n\t\t\t\t)~bool^not_equals\n\t\t\t\t)~bool^logical_and\n\t\t\t)~bool^logical_and,\n\t\t\t_\u0026\u0026_(\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t_==_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper~wrapper(int),\n\t\t\t\t\t1~int\n\t\t\t\t)~bool^equals,\n\t\t\t\t_==_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_string_wrapper~wrapper(string),\n\t\t\t\t\t"hi"~string\n\t\t\t\t)~bool^equals\n\t\t\t\t)~bool^logical_and,\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t_==_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_uint32_wrapper~wrapper(uint),\n\t\t\t\t\t1u~uint\n\t\t\t\t)~bool^equals,\n\t\t\t\t_!=_(\n\t\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.single_uint64_wrapper~wrapper(uint),\n\t\t\t\t\t42u~uint\n\t\t\t\t)~bool^not_equals\n\t\t\t\t)~bool^logical_and\n\t\t\t)~bool^logical_and\n\t\t)~bool^logical_and',
+      expectedType: "bool",
     },
     {
       original: {
@@ -712,10 +7317,235 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _==_(\n    x^#*expr.Expr_IdentExpr#.single_timestamp^#*expr.Expr_SelectExpr#,\n    google.protobuf.Timestamp{\n      seconds:20^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u003c_(\n    x^#*expr.Expr_IdentExpr#.single_duration^#*expr.Expr_SelectExpr#,\n    google.protobuf.Duration{\n      seconds:10^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "x.single_timestamp == google.protobuf.Timestamp{seconds: 20} \u0026\u0026 x.single_duration \u003c google.protobuf.Duration{seconds: 10}",
+      locationAst:
+        "_\u0026\u0026_(\n  _==_(\n    x^#1[1,0]#.single_timestamp^#2[1,1]#,\n    google.protobuf.Timestamp{\n      seconds:20^#6[1,57]#^#5[1,55]#\n    }^#4[1,47]#\n  )^#3[1,19]#,\n  _\u003c_(\n    x^#7[2,7]#.single_duration^#8[2,8]#,\n    google.protobuf.Duration{\n      seconds:10^#12[2,61]#^#11[2,59]#\n    }^#10[2,51]#\n  )^#9[2,25]#\n)^#13[1,61]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 19, 21, 1, 19, 1, 21],
+        [4, 47, 48, 1, 47, 1, 48],
+        [5, 55, 56, 1, 55, 1, 56],
+        [6, 57, 59, 1, 57, 1, 59],
+        [7, 71, 72, 2, 7, 2, 8],
+        [8, 72, 73, 2, 8, 2, 9],
+        [9, 89, 90, 2, 25, 2, 26],
+        [10, 115, 116, 2, 51, 2, 52],
+        [11, 123, 124, 2, 59, 2, 60],
+        [12, 125, 127, 2, 61, 2, 63],
+        [13, 61, 63, 1, 61, 1, 63],
+      ],
+      lineOffsets: [64, 129],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_timestamp",
+                      },
+                    },
+                    {
+                      id: "4",
+                      structExpr: {
+                        messageName: "google.protobuf.Timestamp",
+                        entries: [
+                          {
+                            id: "5",
+                            fieldKey: "seconds",
+                            value: { id: "6", constExpr: { int64Value: "20" } },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "x" } },
+                        field: "single_duration",
+                      },
+                    },
+                    {
+                      id: "10",
+                      structExpr: {
+                        messageName: "google.protobuf.Duration",
+                        entries: [
+                          {
+                            id: "11",
+                            fieldKey: "seconds",
+                            value: {
+                              id: "12",
+                              constExpr: { int64Value: "10" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64, 129],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 19,
+            "4": 47,
+            "5": 55,
+            "6": 57,
+            "7": 71,
+            "8": 72,
+            "9": 89,
+            "10": 115,
+            "11": 123,
+            "12": 125,
+            "13": 61,
+          },
+        },
+      },
       checkedAst:
         "_\u0026\u0026_(\n  _==_(\n    x~google.expr.proto3.test.TestAllTypes^x.single_timestamp~timestamp,\n    google.protobuf.Timestamp{\n      seconds:20~int\n    }~timestamp^google.protobuf.Timestamp\n  )~bool^equals,\n  _\u003c_(\n    x~google.expr.proto3.test.TestAllTypes^x.single_duration~duration,\n    google.protobuf.Duration{\n      seconds:10~int\n    }~duration^google.protobuf.Duration\n  )~bool^less_duration\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "google.protobuf.Timestamp" },
+          "7": { name: "x" },
+          "9": { overloadId: ["less_duration"] },
+          "10": { name: "google.protobuf.Duration" },
+          "13": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wellKnown: "TIMESTAMP" },
+          "3": { primitive: "BOOL" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "6": { primitive: "INT64" },
+          "7": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "8": { wellKnown: "DURATION" },
+          "9": { primitive: "BOOL" },
+          "10": { wellKnown: "DURATION" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64, 129],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 19,
+            "4": 47,
+            "5": 55,
+            "6": 57,
+            "7": 71,
+            "8": 72,
+            "9": 89,
+            "10": 115,
+            "11": 123,
+            "12": 125,
+            "13": 61,
+          },
+        },
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_timestamp",
+                      },
+                    },
+                    {
+                      id: "4",
+                      structExpr: {
+                        messageName: "google.protobuf.Timestamp",
+                        entries: [
+                          {
+                            id: "5",
+                            fieldKey: "seconds",
+                            value: { id: "6", constExpr: { int64Value: "20" } },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "x" } },
+                        field: "single_duration",
+                      },
+                    },
+                    {
+                      id: "10",
+                      structExpr: {
+                        messageName: "google.protobuf.Duration",
+                        entries: [
+                          {
+                            id: "11",
+                            fieldKey: "seconds",
+                            value: {
+                              id: "12",
+                              constExpr: { int64Value: "10" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "43", max: "86" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "84",
+      expectedType: "bool",
     },
     {
       original: {
@@ -729,10 +7559,1189 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        _==_(\n          x^#*expr.Expr_IdentExpr#.single_bool_wrapper^#*expr.Expr_SelectExpr#,\n          google.protobuf.BoolValue{\n            value:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n          }^#*expr.Expr_StructExpr#\n        )^#*expr.Expr_CallExpr#,\n        _==_(\n          x^#*expr.Expr_IdentExpr#.single_bytes_wrapper^#*expr.Expr_SelectExpr#,\n          google.protobuf.BytesValue{\n            value:b"hi"^#*expr.Constant_BytesValue#^#*expr.Expr_CreateStruct_Entry#\n          }^#*expr.Expr_StructExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      _!=_(\n        x^#*expr.Expr_IdentExpr#.single_double_wrapper^#*expr.Expr_SelectExpr#,\n        google.protobuf.DoubleValue{\n          value:2^#*expr.Constant_DoubleValue#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_float_wrapper^#*expr.Expr_SelectExpr#,\n        google.protobuf.FloatValue{\n          value:1^#*expr.Constant_DoubleValue#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      )^#*expr.Expr_CallExpr#,\n      _!=_(\n        x^#*expr.Expr_IdentExpr#.single_int32_wrapper^#*expr.Expr_SelectExpr#,\n        google.protobuf.Int32Value{\n          value:-2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        _==_(\n          x^#*expr.Expr_IdentExpr#.single_int64_wrapper^#*expr.Expr_SelectExpr#,\n          google.protobuf.Int64Value{\n            value:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n          }^#*expr.Expr_StructExpr#\n        )^#*expr.Expr_CallExpr#,\n        _==_(\n          x^#*expr.Expr_IdentExpr#.single_string_wrapper^#*expr.Expr_SelectExpr#,\n          google.protobuf.StringValue{\n            value:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n          }^#*expr.Expr_StructExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_string_wrapper^#*expr.Expr_SelectExpr#,\n        google.protobuf.Value{\n          string_value:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_uint32_wrapper^#*expr.Expr_SelectExpr#,\n        google.protobuf.UInt32Value{\n          value:1u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      )^#*expr.Expr_CallExpr#,\n      _!=_(\n        x^#*expr.Expr_IdentExpr#.single_uint64_wrapper^#*expr.Expr_SelectExpr#,\n        google.protobuf.UInt64Value{\n          value:42u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'x.single_bool_wrapper == google.protobuf.BoolValue{value: true} \u0026\u0026 x.single_bytes_wrapper == google.protobuf.BytesValue{value: b"\\150\\151"} \u0026\u0026\nx.single_double_wrapper != google.protobuf.DoubleValue{value: 2.0} \u0026\u0026 x.single_float_wrapper == google.protobuf.FloatValue{value: 1.0} \u0026\u0026\nx.single_int32_wrapper != google.protobuf.Int32Value{value: -2} \u0026\u0026 x.single_int64_wrapper == google.protobuf.Int64Value{value: 1} \u0026\u0026\nx.single_string_wrapper == google.protobuf.StringValue{value: "hi"} \u0026\u0026 x.single_string_wrapper == google.protobuf.Value{string_value: "hi"} \u0026\u0026\nx.single_uint32_wrapper == google.protobuf.UInt32Value{value: 1u} \u0026\u0026 x.single_uint64_wrapper != google.protobuf.UInt64Value{value: 42u}',
+      locationAst:
+        '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        _==_(\n          x^#1[1,0]#.single_bool_wrapper^#2[1,1]#,\n          google.protobuf.BoolValue{\n            value:true^#6[1,58]#^#5[1,56]#\n          }^#4[1,50]#\n        )^#3[1,22]#,\n        _==_(\n          x^#7[2,6]#.single_bytes_wrapper^#8[2,7]#,\n          google.protobuf.BytesValue{\n            value:b"hi"^#12[2,66]#^#11[2,64]#\n          }^#10[2,58]#\n        )^#9[2,29]#\n      )^#13[2,3]#,\n      _!=_(\n        x^#14[3,6]#.single_double_wrapper^#15[3,7]#,\n        google.protobuf.DoubleValue{\n          value:2^#19[3,68]#^#18[3,66]#\n        }^#17[3,60]#\n      )^#16[3,30]#\n    )^#20[3,3]#,\n    _\u0026\u0026_(\n      _==_(\n        x^#21[4,6]#.single_float_wrapper^#22[4,7]#,\n        google.protobuf.FloatValue{\n          value:1^#26[4,66]#^#25[4,64]#\n        }^#24[4,58]#\n      )^#23[4,29]#,\n      _!=_(\n        x^#28[5,6]#.single_int32_wrapper^#29[5,7]#,\n        google.protobuf.Int32Value{\n          value:-2^#33[5,66]#^#32[5,64]#\n        }^#31[5,58]#\n      )^#30[5,29]#\n    )^#34[5,3]#\n  )^#27[4,3]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        _==_(\n          x^#35[6,6]#.single_int64_wrapper^#36[6,7]#,\n          google.protobuf.Int64Value{\n            value:1^#40[6,66]#^#39[6,64]#\n          }^#38[6,58]#\n        )^#37[6,29]#,\n        _==_(\n          x^#42[7,6]#.single_string_wrapper^#43[7,7]#,\n          google.protobuf.StringValue{\n            value:"hi"^#47[7,68]#^#46[7,66]#\n          }^#45[7,60]#\n        )^#44[7,30]#\n      )^#48[7,3]#,\n      _==_(\n        x^#49[8,6]#.single_string_wrapper^#50[8,7]#,\n        google.protobuf.Value{\n          string_value:"hi"^#54[8,69]#^#53[8,67]#\n        }^#52[8,54]#\n      )^#51[8,30]#\n    )^#55[8,3]#,\n    _\u0026\u0026_(\n      _==_(\n        x^#56[9,6]#.single_uint32_wrapper^#57[9,7]#,\n        google.protobuf.UInt32Value{\n          value:1u^#61[9,68]#^#60[9,66]#\n        }^#59[9,60]#\n      )^#58[9,30]#,\n      _!=_(\n        x^#63[10,6]#.single_uint64_wrapper^#64[10,7]#,\n        google.protobuf.UInt64Value{\n          value:42u^#68[10,68]#^#67[10,66]#\n        }^#66[10,60]#\n      )^#65[10,30]#\n    )^#69[10,3]#\n  )^#62[9,3]#\n)^#41[6,3]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 22, 24, 1, 22, 1, 24],
+        [4, 50, 51, 1, 50, 1, 51],
+        [5, 56, 57, 1, 56, 1, 57],
+        [6, 58, 62, 1, 58, 1, 62],
+        [7, 70, 71, 2, 6, 2, 7],
+        [8, 71, 72, 2, 7, 2, 8],
+        [9, 93, 95, 2, 29, 2, 31],
+        [10, 122, 123, 2, 58, 2, 59],
+        [11, 128, 129, 2, 64, 2, 65],
+        [12, 130, 135, 2, 66, 2, 71],
+        [13, 67, 69, 2, 3, 2, 5],
+        [14, 143, 144, 3, 6, 3, 7],
+        [15, 144, 145, 3, 7, 3, 8],
+        [16, 167, 169, 3, 30, 3, 32],
+        [17, 197, 198, 3, 60, 3, 61],
+        [18, 203, 204, 3, 66, 3, 67],
+        [19, 205, 208, 3, 68, 3, 71],
+        [20, 140, 142, 3, 3, 3, 5],
+        [21, 216, 217, 4, 6, 4, 7],
+        [22, 217, 218, 4, 7, 4, 8],
+        [23, 239, 241, 4, 29, 4, 31],
+        [24, 268, 269, 4, 58, 4, 59],
+        [25, 274, 275, 4, 64, 4, 65],
+        [26, 276, 279, 4, 66, 4, 69],
+        [27, 213, 215, 4, 3, 4, 5],
+        [28, 287, 288, 5, 6, 5, 7],
+        [29, 288, 289, 5, 7, 5, 8],
+        [30, 310, 312, 5, 29, 5, 31],
+        [31, 339, 340, 5, 58, 5, 59],
+        [32, 345, 346, 5, 64, 5, 65],
+        [33, 347, 349, 5, 66, 5, 68],
+        [34, 284, 286, 5, 3, 5, 5],
+        [35, 357, 358, 6, 6, 6, 7],
+        [36, 358, 359, 6, 7, 6, 8],
+        [37, 380, 382, 6, 29, 6, 31],
+        [38, 409, 410, 6, 58, 6, 59],
+        [39, 415, 416, 6, 64, 6, 65],
+        [40, 417, 418, 6, 66, 6, 67],
+        [41, 354, 356, 6, 3, 6, 5],
+        [42, 426, 427, 7, 6, 7, 7],
+        [43, 427, 428, 7, 7, 7, 8],
+        [44, 450, 452, 7, 30, 7, 32],
+        [45, 480, 481, 7, 60, 7, 61],
+        [46, 486, 487, 7, 66, 7, 67],
+        [47, 488, 492, 7, 68, 7, 72],
+        [48, 423, 425, 7, 3, 7, 5],
+        [49, 500, 501, 8, 6, 8, 7],
+        [50, 501, 502, 8, 7, 8, 8],
+        [51, 524, 526, 8, 30, 8, 32],
+        [52, 548, 549, 8, 54, 8, 55],
+        [53, 561, 562, 8, 67, 8, 68],
+        [54, 563, 567, 8, 69, 8, 73],
+        [55, 497, 499, 8, 3, 8, 5],
+        [56, 575, 576, 9, 6, 9, 7],
+        [57, 576, 577, 9, 7, 9, 8],
+        [58, 599, 601, 9, 30, 9, 32],
+        [59, 629, 630, 9, 60, 9, 61],
+        [60, 635, 636, 9, 66, 9, 67],
+        [61, 637, 639, 9, 68, 9, 70],
+        [62, 572, 574, 9, 3, 9, 5],
+        [63, 647, 648, 10, 6, 10, 7],
+        [64, 648, 649, 10, 7, 10, 8],
+        [65, 671, 673, 10, 30, 10, 32],
+        [66, 701, 702, 10, 60, 10, 61],
+        [67, 707, 708, 10, 66, 10, 67],
+        [68, 709, 712, 10, 68, 10, 71],
+        [69, 644, 646, 10, 3, 10, 5],
+      ],
+      lineOffsets: [64, 137, 210, 281, 351, 420, 494, 569, 641, 714],
+      parsedExpr: {
+        expr: {
+          id: "41",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "27",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "20",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u0026\u0026_",
+                              args: [
+                                {
+                                  id: "3",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "2",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "1",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_bool_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "4",
+                                        structExpr: {
+                                          messageName:
+                                            "google.protobuf.BoolValue",
+                                          entries: [
+                                            {
+                                              id: "5",
+                                              fieldKey: "value",
+                                              value: {
+                                                id: "6",
+                                                constExpr: { boolValue: true },
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                {
+                                  id: "9",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "8",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "7",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_bytes_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "10",
+                                        structExpr: {
+                                          messageName:
+                                            "google.protobuf.BytesValue",
+                                          entries: [
+                                            {
+                                              id: "11",
+                                              fieldKey: "value",
+                                              value: {
+                                                id: "12",
+                                                constExpr: {
+                                                  bytesValue: "aGk=",
+                                                },
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "16",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "15",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "14",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_double_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "17",
+                                  structExpr: {
+                                    messageName: "google.protobuf.DoubleValue",
+                                    entries: [
+                                      {
+                                        id: "18",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "19",
+                                          constExpr: { doubleValue: 2 },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "34",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "23",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "22",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "21",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_float_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "24",
+                                  structExpr: {
+                                    messageName: "google.protobuf.FloatValue",
+                                    entries: [
+                                      {
+                                        id: "25",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "26",
+                                          constExpr: { doubleValue: 1 },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "30",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "29",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "28",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_int32_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "31",
+                                  structExpr: {
+                                    messageName: "google.protobuf.Int32Value",
+                                    entries: [
+                                      {
+                                        id: "32",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "33",
+                                          constExpr: { int64Value: "-2" },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "62",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "55",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "48",
+                            callExpr: {
+                              function: "_\u0026\u0026_",
+                              args: [
+                                {
+                                  id: "37",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "36",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "35",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_int64_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "38",
+                                        structExpr: {
+                                          messageName:
+                                            "google.protobuf.Int64Value",
+                                          entries: [
+                                            {
+                                              id: "39",
+                                              fieldKey: "value",
+                                              value: {
+                                                id: "40",
+                                                constExpr: { int64Value: "1" },
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                {
+                                  id: "44",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "43",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "42",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_string_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "45",
+                                        structExpr: {
+                                          messageName:
+                                            "google.protobuf.StringValue",
+                                          entries: [
+                                            {
+                                              id: "46",
+                                              fieldKey: "value",
+                                              value: {
+                                                id: "47",
+                                                constExpr: {
+                                                  stringValue: "hi",
+                                                },
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "51",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "50",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "49",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_string_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "52",
+                                  structExpr: {
+                                    messageName: "google.protobuf.Value",
+                                    entries: [
+                                      {
+                                        id: "53",
+                                        fieldKey: "string_value",
+                                        value: {
+                                          id: "54",
+                                          constExpr: { stringValue: "hi" },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "69",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "58",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "57",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "56",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_uint32_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "59",
+                                  structExpr: {
+                                    messageName: "google.protobuf.UInt32Value",
+                                    entries: [
+                                      {
+                                        id: "60",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "61",
+                                          constExpr: { uint64Value: "1" },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "65",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "64",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "63",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_uint64_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "66",
+                                  structExpr: {
+                                    messageName: "google.protobuf.UInt64Value",
+                                    entries: [
+                                      {
+                                        id: "67",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "68",
+                                          constExpr: { uint64Value: "42" },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64, 137, 210, 281, 351, 420, 494, 569, 641, 714],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 22,
+            "4": 50,
+            "5": 56,
+            "6": 58,
+            "7": 70,
+            "8": 71,
+            "9": 93,
+            "10": 122,
+            "11": 128,
+            "12": 130,
+            "13": 67,
+            "14": 143,
+            "15": 144,
+            "16": 167,
+            "17": 197,
+            "18": 203,
+            "19": 205,
+            "20": 140,
+            "21": 216,
+            "22": 217,
+            "23": 239,
+            "24": 268,
+            "25": 274,
+            "26": 276,
+            "27": 213,
+            "28": 287,
+            "29": 288,
+            "30": 310,
+            "31": 339,
+            "32": 345,
+            "33": 347,
+            "34": 284,
+            "35": 357,
+            "36": 358,
+            "37": 380,
+            "38": 409,
+            "39": 415,
+            "40": 417,
+            "41": 354,
+            "42": 426,
+            "43": 427,
+            "44": 450,
+            "45": 480,
+            "46": 486,
+            "47": 488,
+            "48": 423,
+            "49": 500,
+            "50": 501,
+            "51": 524,
+            "52": 548,
+            "53": 561,
+            "54": 563,
+            "55": 497,
+            "56": 575,
+            "57": 576,
+            "58": 599,
+            "59": 629,
+            "60": 635,
+            "61": 637,
+            "62": 572,
+            "63": 647,
+            "64": 648,
+            "65": 671,
+            "66": 701,
+            "67": 707,
+            "68": 709,
+            "69": 644,
+          },
+        },
+      },
       checkedAst:
         '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        _==_(\n          x~google.expr.proto3.test.TestAllTypes^x.single_bool_wrapper~wrapper(bool),\n          google.protobuf.BoolValue{\n            value:true~bool\n          }~wrapper(bool)^google.protobuf.BoolValue\n        )~bool^equals,\n        _==_(\n          x~google.expr.proto3.test.TestAllTypes^x.single_bytes_wrapper~wrapper(bytes),\n          google.protobuf.BytesValue{\n            value:b"hi"~bytes\n          }~wrapper(bytes)^google.protobuf.BytesValue\n        )~bool^equals\n      )~bool^logical_and,\n      _!=_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_double_wrapper~wrapper(double),\n        google.protobuf.DoubleValue{\n          value:2~double\n        }~wrapper(double)^google.protobuf.DoubleValue\n      )~bool^not_equals\n    )~bool^logical_and,\n    _\u0026\u0026_(\n      _==_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_float_wrapper~wrapper(double),\n        google.protobuf.FloatValue{\n          value:1~double\n        }~wrapper(double)^google.protobuf.FloatValue\n      )~bool^equals,\n      _!=_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_int32_wrapper~wrapper(int),\n        google.protobuf.Int32Value{\n          value:-2~int\n        }~wrapper(int)^google.protobuf.Int32Value\n      )~bool^not_equals\n    )~bool^logical_and\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        _==_(\n          x~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper~wrapper(int),\n          google.protobuf.Int64Value{\n            value:1~int\n          }~wrapper(int)^google.protobuf.Int64Value\n        )~bool^equals,\n        _==_(\n          x~google.expr.proto3.test.TestAllTypes^x.single_string_wrapper~wrapper(string),\n          google.protobuf.StringValue{\n            value:"hi"~string\n          }~wrapper(string)^google.protobuf.StringValue\n        )~bool^equals\n      )~bool^logical_and,\n      _==_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_string_wrapper~wrapper(string),\n        google.protobuf.Value{\n          string_value:"hi"~string\n        }~dyn^google.protobuf.Value\n      )~bool^equals\n    )~bool^logical_and,\n    _\u0026\u0026_(\n      _==_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_uint32_wrapper~wrapper(uint),\n        google.protobuf.UInt32Value{\n          value:1u~uint\n        }~wrapper(uint)^google.protobuf.UInt32Value\n      )~bool^equals,\n      _!=_(\n        x~google.expr.proto3.test.TestAllTypes^x.single_uint64_wrapper~wrapper(uint),\n        google.protobuf.UInt64Value{\n          value:42u~uint\n        }~wrapper(uint)^google.protobuf.UInt64Value\n      )~bool^not_equals\n    )~bool^logical_and\n  )~bool^logical_and\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "google.protobuf.BoolValue" },
+          "7": { name: "x" },
+          "9": { overloadId: ["equals"] },
+          "10": { name: "google.protobuf.BytesValue" },
+          "13": { overloadId: ["logical_and"] },
+          "14": { name: "x" },
+          "16": { overloadId: ["not_equals"] },
+          "17": { name: "google.protobuf.DoubleValue" },
+          "20": { overloadId: ["logical_and"] },
+          "21": { name: "x" },
+          "23": { overloadId: ["equals"] },
+          "24": { name: "google.protobuf.FloatValue" },
+          "27": { overloadId: ["logical_and"] },
+          "28": { name: "x" },
+          "30": { overloadId: ["not_equals"] },
+          "31": { name: "google.protobuf.Int32Value" },
+          "34": { overloadId: ["logical_and"] },
+          "35": { name: "x" },
+          "37": { overloadId: ["equals"] },
+          "38": { name: "google.protobuf.Int64Value" },
+          "41": { overloadId: ["logical_and"] },
+          "42": { name: "x" },
+          "44": { overloadId: ["equals"] },
+          "45": { name: "google.protobuf.StringValue" },
+          "48": { overloadId: ["logical_and"] },
+          "49": { name: "x" },
+          "51": { overloadId: ["equals"] },
+          "52": { name: "google.protobuf.Value" },
+          "55": { overloadId: ["logical_and"] },
+          "56": { name: "x" },
+          "58": { overloadId: ["equals"] },
+          "59": { name: "google.protobuf.UInt32Value" },
+          "62": { overloadId: ["logical_and"] },
+          "63": { name: "x" },
+          "65": { overloadId: ["not_equals"] },
+          "66": { name: "google.protobuf.UInt64Value" },
+          "69": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wrapper: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { wrapper: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "8": { wrapper: "BYTES" },
+          "9": { primitive: "BOOL" },
+          "10": { wrapper: "BYTES" },
+          "12": { primitive: "BYTES" },
+          "13": { primitive: "BOOL" },
+          "14": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "15": { wrapper: "DOUBLE" },
+          "16": { primitive: "BOOL" },
+          "17": { wrapper: "DOUBLE" },
+          "19": { primitive: "DOUBLE" },
+          "20": { primitive: "BOOL" },
+          "21": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "22": { wrapper: "DOUBLE" },
+          "23": { primitive: "BOOL" },
+          "24": { wrapper: "DOUBLE" },
+          "26": { primitive: "DOUBLE" },
+          "27": { primitive: "BOOL" },
+          "28": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "29": { wrapper: "INT64" },
+          "30": { primitive: "BOOL" },
+          "31": { wrapper: "INT64" },
+          "33": { primitive: "INT64" },
+          "34": { primitive: "BOOL" },
+          "35": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "36": { wrapper: "INT64" },
+          "37": { primitive: "BOOL" },
+          "38": { wrapper: "INT64" },
+          "40": { primitive: "INT64" },
+          "41": { primitive: "BOOL" },
+          "42": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "43": { wrapper: "STRING" },
+          "44": { primitive: "BOOL" },
+          "45": { wrapper: "STRING" },
+          "47": { primitive: "STRING" },
+          "48": { primitive: "BOOL" },
+          "49": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "50": { wrapper: "STRING" },
+          "51": { primitive: "BOOL" },
+          "52": { dyn: {} },
+          "54": { primitive: "STRING" },
+          "55": { primitive: "BOOL" },
+          "56": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "57": { wrapper: "UINT64" },
+          "58": { primitive: "BOOL" },
+          "59": { wrapper: "UINT64" },
+          "61": { primitive: "UINT64" },
+          "62": { primitive: "BOOL" },
+          "63": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "64": { wrapper: "UINT64" },
+          "65": { primitive: "BOOL" },
+          "66": { wrapper: "UINT64" },
+          "68": { primitive: "UINT64" },
+          "69": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64, 137, 210, 281, 351, 420, 494, 569, 641, 714],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 22,
+            "4": 50,
+            "5": 56,
+            "6": 58,
+            "7": 70,
+            "8": 71,
+            "9": 93,
+            "10": 122,
+            "11": 128,
+            "12": 130,
+            "13": 67,
+            "14": 143,
+            "15": 144,
+            "16": 167,
+            "17": 197,
+            "18": 203,
+            "19": 205,
+            "20": 140,
+            "21": 216,
+            "22": 217,
+            "23": 239,
+            "24": 268,
+            "25": 274,
+            "26": 276,
+            "27": 213,
+            "28": 287,
+            "29": 288,
+            "30": 310,
+            "31": 339,
+            "32": 345,
+            "33": 347,
+            "34": 284,
+            "35": 357,
+            "36": 358,
+            "37": 380,
+            "38": 409,
+            "39": 415,
+            "40": 417,
+            "41": 354,
+            "42": 426,
+            "43": 427,
+            "44": 450,
+            "45": 480,
+            "46": 486,
+            "47": 488,
+            "48": 423,
+            "49": 500,
+            "50": 501,
+            "51": 524,
+            "52": 548,
+            "53": 561,
+            "54": 563,
+            "55": 497,
+            "56": 575,
+            "57": 576,
+            "58": 599,
+            "59": 629,
+            "60": 635,
+            "61": 637,
+            "62": 572,
+            "63": 647,
+            "64": 648,
+            "65": 671,
+            "66": 701,
+            "67": 707,
+            "68": 709,
+            "69": 644,
+          },
+        },
+        expr: {
+          id: "41",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "27",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "20",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u0026\u0026_",
+                              args: [
+                                {
+                                  id: "3",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "2",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "1",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_bool_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "4",
+                                        structExpr: {
+                                          messageName:
+                                            "google.protobuf.BoolValue",
+                                          entries: [
+                                            {
+                                              id: "5",
+                                              fieldKey: "value",
+                                              value: {
+                                                id: "6",
+                                                constExpr: { boolValue: true },
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                {
+                                  id: "9",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "8",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "7",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_bytes_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "10",
+                                        structExpr: {
+                                          messageName:
+                                            "google.protobuf.BytesValue",
+                                          entries: [
+                                            {
+                                              id: "11",
+                                              fieldKey: "value",
+                                              value: {
+                                                id: "12",
+                                                constExpr: {
+                                                  bytesValue: "aGk=",
+                                                },
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "16",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "15",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "14",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_double_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "17",
+                                  structExpr: {
+                                    messageName: "google.protobuf.DoubleValue",
+                                    entries: [
+                                      {
+                                        id: "18",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "19",
+                                          constExpr: { doubleValue: 2 },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "34",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "23",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "22",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "21",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_float_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "24",
+                                  structExpr: {
+                                    messageName: "google.protobuf.FloatValue",
+                                    entries: [
+                                      {
+                                        id: "25",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "26",
+                                          constExpr: { doubleValue: 1 },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "30",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "29",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "28",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_int32_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "31",
+                                  structExpr: {
+                                    messageName: "google.protobuf.Int32Value",
+                                    entries: [
+                                      {
+                                        id: "32",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "33",
+                                          constExpr: { int64Value: "-2" },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "62",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "55",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "48",
+                            callExpr: {
+                              function: "_\u0026\u0026_",
+                              args: [
+                                {
+                                  id: "37",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "36",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "35",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_int64_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "38",
+                                        structExpr: {
+                                          messageName:
+                                            "google.protobuf.Int64Value",
+                                          entries: [
+                                            {
+                                              id: "39",
+                                              fieldKey: "value",
+                                              value: {
+                                                id: "40",
+                                                constExpr: { int64Value: "1" },
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                {
+                                  id: "44",
+                                  callExpr: {
+                                    function: "_==_",
+                                    args: [
+                                      {
+                                        id: "43",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "42",
+                                            identExpr: { name: "x" },
+                                          },
+                                          field: "single_string_wrapper",
+                                        },
+                                      },
+                                      {
+                                        id: "45",
+                                        structExpr: {
+                                          messageName:
+                                            "google.protobuf.StringValue",
+                                          entries: [
+                                            {
+                                              id: "46",
+                                              fieldKey: "value",
+                                              value: {
+                                                id: "47",
+                                                constExpr: {
+                                                  stringValue: "hi",
+                                                },
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "51",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "50",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "49",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_string_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "52",
+                                  structExpr: {
+                                    messageName: "google.protobuf.Value",
+                                    entries: [
+                                      {
+                                        id: "53",
+                                        fieldKey: "string_value",
+                                        value: {
+                                          id: "54",
+                                          constExpr: { stringValue: "hi" },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "69",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "58",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "57",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "56",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_uint32_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "59",
+                                  structExpr: {
+                                    messageName: "google.protobuf.UInt32Value",
+                                    entries: [
+                                      {
+                                        id: "60",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "61",
+                                          constExpr: { uint64Value: "1" },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "65",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                {
+                                  id: "64",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "63",
+                                      identExpr: { name: "x" },
+                                    },
+                                    field: "single_uint64_wrapper",
+                                  },
+                                },
+                                {
+                                  id: "66",
+                                  structExpr: {
+                                    messageName: "google.protobuf.UInt64Value",
+                                    entries: [
+                                      {
+                                        id: "67",
+                                        fieldKey: "value",
+                                        value: {
+                                          id: "68",
+                                          constExpr: { uint64Value: "42" },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "43", max: "5534023222112866219" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "420",
+      expectedType: "bool",
     },
     {
       original: {
@@ -746,9 +8755,194 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  __comprehension__(\n    // Variable\n    y,\n    // Target\n    x^#*expr.Expr_IdentExpr#.repeated_int64^#*expr.Expr_SelectExpr#,\n    // Accumulator\n    @result,\n    // Init\n    false^#*expr.Constant_BoolValue#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // LoopStep\n    _||_(\n      @result^#*expr.Expr_IdentExpr#,\n      _\u003e_(\n        y^#*expr.Expr_IdentExpr#,\n        10^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  _\u003c_(\n    y^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "x.repeated_int64.exists(y, y \u003e 10) \u0026\u0026 y \u003c 5",
+      locationAst:
+        "_\u0026\u0026_(\n  __comprehension__(\n    // Variable\n    y,\n    // Target\n    x^#1[1,0]#.repeated_int64^#2[1,1]#,\n    // Accumulator\n    @result,\n    // Init\n    false^#8[1,23]#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#9[1,23]#\n      )^#10[1,23]#\n    )^#11[1,23]#,\n    // LoopStep\n    _||_(\n      @result^#12[1,23]#,\n      _\u003e_(\n        y^#5[1,27]#,\n        10^#7[1,31]#\n      )^#6[1,29]#\n    )^#13[1,23]#,\n    // Result\n    @result^#14[1,23]#)^#15[1,23]#,\n  _\u003c_(\n    y^#16[1,38]#,\n    5^#18[1,42]#\n  )^#17[1,40]#\n)^#19[1,35]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 27, 28, 1, 27, 1, 28],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 31, 33, 1, 31, 1, 33],
+        [8, 23, 23, 1, 23, 1, 23],
+        [9, 23, 23, 1, 23, 1, 23],
+        [10, 23, 23, 1, 23, 1, 23],
+        [11, 23, 23, 1, 23, 1, 23],
+        [12, 23, 23, 1, 23, 1, 23],
+        [13, 23, 23, 1, 23, 1, 23],
+        [14, 23, 23, 1, 23, 1, 23],
+        [15, 23, 23, 1, 23, 1, 23],
+        [16, 38, 39, 1, 38, 1, 39],
+        [17, 40, 41, 1, 40, 1, 41],
+        [18, 42, 43, 1, 42, 1, 43],
+        [19, 35, 37, 1, 35, 1, 37],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "19",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "15",
+                comprehensionExpr: {
+                  iterVar: "y",
+                  iterRange: {
+                    id: "2",
+                    selectExpr: {
+                      operand: { id: "1", identExpr: { name: "x" } },
+                      field: "repeated_int64",
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "8", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "11",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "!_",
+                            args: [{ id: "9", identExpr: { name: "@result" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "13",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "6",
+                          callExpr: {
+                            function: "_\u003e_",
+                            args: [
+                              { id: "5", identExpr: { name: "y" } },
+                              { id: "7", constExpr: { int64Value: "10" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "14", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "16", identExpr: { name: "y" } },
+                    { id: "18", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 24,
+            "5": 27,
+            "6": 29,
+            "7": 31,
+            "8": 23,
+            "9": 23,
+            "10": 23,
+            "11": 23,
+            "12": 23,
+            "13": 23,
+            "14": 23,
+            "15": 23,
+            "16": 38,
+            "17": 40,
+            "18": 42,
+            "19": 35,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "4", identExpr: { name: "y" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "5", identExpr: { name: "y" } },
+                        { id: "7", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 15,
+          function: "exists",
+          target: {
+            id: "2",
+            selectExpr: {
+              operand: { id: "1", identExpr: { name: "x" } },
+              field: "repeated_int64",
+            },
+          },
+          args: [
+            { id: "4", identExpr: { name: "y" } },
+            {
+              id: "6",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "5", identExpr: { name: "y" } },
+                  { id: "7", constExpr: { int64Value: "10" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:39: undeclared reference to 'y' (in container '')\n | x.repeated_int64.exists(y, y \u003e 10) \u0026\u0026 y \u003c 5\n | ......................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'y' (in container '')",
+          id: 16,
+          offset: 38,
+          line: 1,
+          column: 38,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:39: undeclared reference to 'y' (in container '')\n\t\t| x.repeated_int64.exists(y, y \u003e 10) \u0026\u0026 y \u003c 5\n\t\t| ......................................^",
     },
     {
       original: {
@@ -762,56 +8956,1387 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      x^#*expr.Expr_IdentExpr#.repeated_int64^#*expr.Expr_SelectExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        _\u003e_(\n          e^#*expr.Expr_IdentExpr#,\n          0^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      x^#*expr.Expr_IdentExpr#.repeated_int64^#*expr.Expr_SelectExpr#,\n      // Accumulator\n      @result,\n      // Init\n      false^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        !_(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _||_(\n        @result^#*expr.Expr_IdentExpr#,\n        _\u003c_(\n          e^#*expr.Expr_IdentExpr#,\n          0^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    x^#*expr.Expr_IdentExpr#.repeated_int64^#*expr.Expr_SelectExpr#,\n    // Accumulator\n    @result,\n    // Init\n    0^#*expr.Constant_Int64Value#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _?_:_(\n      _==_(\n        e^#*expr.Expr_IdentExpr#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        1^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    _==_(\n      @result^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#)^#*expr.Expr_ComprehensionExpr#\n)^#*expr.Expr_CallExpr#",
-      checkedAst:
-        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        _\u003e_(\n          e~int^e,\n          0~int\n        )~bool^greater_int64\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool,\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n      // Accumulator\n      @result,\n      // Init\n      false~bool,\n      // LoopCondition\n      @not_strictly_false(\n        !_(\n          @result~bool^@result\n        )~bool^logical_not\n      )~bool^not_strictly_false,\n      // LoopStep\n      _||_(\n        @result~bool^@result,\n        _\u003c_(\n          e~int^e,\n          0~int\n        )~bool^less_int64\n      )~bool^logical_or,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n    // Accumulator\n    @result,\n    // Init\n    0~int,\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _?_:_(\n      _==_(\n        e~int^e,\n        0~int\n      )~bool^equals,\n      _+_(\n        @result~int^@result,\n        1~int\n      )~int^add_int64,\n      @result~int^@result\n    )~int^conditional,\n    // Result\n    _==_(\n      @result~int^@result,\n      1~int\n    )~bool^equals)~bool\n)~bool^logical_and",
-      type: "bool",
-    },
-    {
-      original: {
-        expr: "x.all(e, 0)",
-        typeEnv: [
-          {
-            name: "x",
-            ident: {
-              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+      unparsed:
+        "x.repeated_int64.all(e, e \u003e 0) \u0026\u0026 x.repeated_int64.exists(e, e \u003c 0) \u0026\u0026 x.repeated_int64.exists_one(e, e == 0)",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      x^#1[1,0]#.repeated_int64^#2[1,1]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#8[1,20]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#9[1,20]#\n      )^#10[1,20]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#11[1,20]#,\n        _\u003e_(\n          e^#5[1,24]#,\n          0^#7[1,28]#\n        )^#6[1,26]#\n      )^#12[1,20]#,\n      // Result\n      @result^#13[1,20]#)^#14[1,20]#,\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      x^#15[1,34]#.repeated_int64^#16[1,35]#,\n      // Accumulator\n      @result,\n      // Init\n      false^#22[1,57]#,\n      // LoopCondition\n      @not_strictly_false(\n        !_(\n          @result^#23[1,57]#\n        )^#24[1,57]#\n      )^#25[1,57]#,\n      // LoopStep\n      _||_(\n        @result^#26[1,57]#,\n        _\u003c_(\n          e^#19[1,61]#,\n          0^#21[1,65]#\n        )^#20[1,63]#\n      )^#27[1,57]#,\n      // Result\n      @result^#28[1,57]#)^#29[1,57]#\n  )^#30[1,31]#,\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    x^#31[1,71]#.repeated_int64^#32[1,72]#,\n    // Accumulator\n    @result,\n    // Init\n    0^#38[1,98]#,\n    // LoopCondition\n    true^#39[1,98]#,\n    // LoopStep\n    _?_:_(\n      _==_(\n        e^#35[1,102]#,\n        0^#37[1,107]#\n      )^#36[1,104]#,\n      _+_(\n        @result^#40[1,98]#,\n        1^#41[1,98]#\n      )^#42[1,98]#,\n      @result^#43[1,98]#\n    )^#44[1,98]#,\n    // Result\n    _==_(\n      @result^#45[1,98]#,\n      1^#46[1,98]#\n    )^#47[1,98]#)^#48[1,98]#\n)^#49[1,68]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 26, 27, 1, 26, 1, 27],
+        [7, 28, 29, 1, 28, 1, 29],
+        [8, 20, 20, 1, 20, 1, 20],
+        [9, 20, 20, 1, 20, 1, 20],
+        [10, 20, 20, 1, 20, 1, 20],
+        [11, 20, 20, 1, 20, 1, 20],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 34, 35, 1, 34, 1, 35],
+        [16, 35, 36, 1, 35, 1, 36],
+        [18, 58, 59, 1, 58, 1, 59],
+        [19, 61, 62, 1, 61, 1, 62],
+        [20, 63, 64, 1, 63, 1, 64],
+        [21, 65, 66, 1, 65, 1, 66],
+        [22, 57, 57, 1, 57, 1, 57],
+        [23, 57, 57, 1, 57, 1, 57],
+        [24, 57, 57, 1, 57, 1, 57],
+        [25, 57, 57, 1, 57, 1, 57],
+        [26, 57, 57, 1, 57, 1, 57],
+        [27, 57, 57, 1, 57, 1, 57],
+        [28, 57, 57, 1, 57, 1, 57],
+        [29, 57, 57, 1, 57, 1, 57],
+        [30, 31, 33, 1, 31, 1, 33],
+        [31, 71, 72, 1, 71, 1, 72],
+        [32, 72, 73, 1, 72, 1, 73],
+        [34, 99, 100, 1, 99, 1, 100],
+        [35, 102, 103, 1, 102, 1, 103],
+        [36, 104, 106, 1, 104, 1, 106],
+        [37, 107, 108, 1, 107, 1, 108],
+        [38, 98, 98, 1, 98, 1, 98],
+        [39, 98, 98, 1, 98, 1, 98],
+        [40, 98, 98, 1, 98, 1, 98],
+        [41, 98, 98, 1, 98, 1, 98],
+        [42, 98, 98, 1, 98, 1, 98],
+        [43, 98, 98, 1, 98, 1, 98],
+        [44, 98, 98, 1, 98, 1, 98],
+        [45, 98, 98, 1, 98, 1, 98],
+        [46, 98, 98, 1, 98, 1, 98],
+        [47, 98, 98, 1, 98, 1, 98],
+        [48, 98, 98, 1, 98, 1, 98],
+        [49, 68, 70, 1, 68, 1, 70],
+      ],
+      lineOffsets: [110],
+      parsedExpr: {
+        expr: {
+          id: "49",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "30",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "14",
+                      comprehensionExpr: {
+                        iterVar: "e",
+                        iterRange: {
+                          id: "2",
+                          selectExpr: {
+                            operand: { id: "1", identExpr: { name: "x" } },
+                            field: "repeated_int64",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "8", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "10",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [{ id: "9", identExpr: { name: "@result" } }],
+                          },
+                        },
+                        loopStep: {
+                          id: "12",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "11", identExpr: { name: "@result" } },
+                              {
+                                id: "6",
+                                callExpr: {
+                                  function: "_\u003e_",
+                                  args: [
+                                    { id: "5", identExpr: { name: "e" } },
+                                    { id: "7", constExpr: { int64Value: "0" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "13", identExpr: { name: "@result" } },
+                      },
+                    },
+                    {
+                      id: "29",
+                      comprehensionExpr: {
+                        iterVar: "e",
+                        iterRange: {
+                          id: "16",
+                          selectExpr: {
+                            operand: { id: "15", identExpr: { name: "x" } },
+                            field: "repeated_int64",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "22", constExpr: { boolValue: false } },
+                        loopCondition: {
+                          id: "25",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              {
+                                id: "24",
+                                callExpr: {
+                                  function: "!_",
+                                  args: [
+                                    {
+                                      id: "23",
+                                      identExpr: { name: "@result" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "27",
+                          callExpr: {
+                            function: "_||_",
+                            args: [
+                              { id: "26", identExpr: { name: "@result" } },
+                              {
+                                id: "20",
+                                callExpr: {
+                                  function: "_\u003c_",
+                                  args: [
+                                    { id: "19", identExpr: { name: "e" } },
+                                    {
+                                      id: "21",
+                                      constExpr: { int64Value: "0" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "28", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "48",
+                comprehensionExpr: {
+                  iterVar: "e",
+                  iterRange: {
+                    id: "32",
+                    selectExpr: {
+                      operand: { id: "31", identExpr: { name: "x" } },
+                      field: "repeated_int64",
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "38", constExpr: { int64Value: "0" } },
+                  loopCondition: { id: "39", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "44",
+                    callExpr: {
+                      function: "_?_:_",
+                      args: [
+                        {
+                          id: "36",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "35", identExpr: { name: "e" } },
+                              { id: "37", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "42",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "40", identExpr: { name: "@result" } },
+                              { id: "41", constExpr: { int64Value: "1" } },
+                            ],
+                          },
+                        },
+                        { id: "43", identExpr: { name: "@result" } },
+                      ],
+                    },
+                  },
+                  result: {
+                    id: "47",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "45", identExpr: { name: "@result" } },
+                        { id: "46", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [110],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 21,
+            "5": 24,
+            "6": 26,
+            "7": 28,
+            "8": 20,
+            "9": 20,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 34,
+            "16": 35,
+            "18": 58,
+            "19": 61,
+            "20": 63,
+            "21": 65,
+            "22": 57,
+            "23": 57,
+            "24": 57,
+            "25": 57,
+            "26": 57,
+            "27": 57,
+            "28": 57,
+            "29": 57,
+            "30": 31,
+            "31": 71,
+            "32": 72,
+            "34": 99,
+            "35": 102,
+            "36": 104,
+            "37": 107,
+            "38": 98,
+            "39": 98,
+            "40": 98,
+            "41": 98,
+            "42": 98,
+            "43": 98,
+            "44": 98,
+            "45": 98,
+            "46": 98,
+            "47": 98,
+            "48": 98,
+            "49": 68,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "4", identExpr: { name: "e" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "5", identExpr: { name: "e" } },
+                        { id: "7", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: {
+                  id: "16",
+                  selectExpr: {
+                    operand: { id: "15", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "18", identExpr: { name: "e" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "19", identExpr: { name: "e" } },
+                        { id: "21", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "48": {
+              callExpr: {
+                target: {
+                  id: "32",
+                  selectExpr: {
+                    operand: { id: "31", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "exists_one",
+                args: [
+                  { id: "34", identExpr: { name: "e" } },
+                  {
+                    id: "36",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "35", identExpr: { name: "e" } },
+                        { id: "37", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
             },
           },
-        ],
+        },
       },
-      ast: "__comprehension__(\n  // Variable\n  e,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      macroCalls: [
+        {
+          id: 14,
+          function: "all",
+          target: {
+            id: "2",
+            selectExpr: {
+              operand: { id: "1", identExpr: { name: "x" } },
+              field: "repeated_int64",
+            },
+          },
+          args: [
+            { id: "4", identExpr: { name: "e" } },
+            {
+              id: "6",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "5", identExpr: { name: "e" } },
+                  { id: "7", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 29,
+          function: "exists",
+          target: {
+            id: "16",
+            selectExpr: {
+              operand: { id: "15", identExpr: { name: "x" } },
+              field: "repeated_int64",
+            },
+          },
+          args: [
+            { id: "18", identExpr: { name: "e" } },
+            {
+              id: "20",
+              callExpr: {
+                function: "_\u003c_",
+                args: [
+                  { id: "19", identExpr: { name: "e" } },
+                  { id: "21", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 48,
+          function: "exists_one",
+          target: {
+            id: "32",
+            selectExpr: {
+              operand: { id: "31", identExpr: { name: "x" } },
+              field: "repeated_int64",
+            },
+          },
+          args: [
+            { id: "34", identExpr: { name: "e" } },
+            {
+              id: "36",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "35", identExpr: { name: "e" } },
+                  { id: "37", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        _\u003e_(\n          e~int^e,\n          0~int\n        )~bool^greater_int64\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool,\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n      // Accumulator\n      @result,\n      // Init\n      false~bool,\n      // LoopCondition\n      @not_strictly_false(\n        !_(\n          @result~bool^@result\n        )~bool^logical_not\n      )~bool^not_strictly_false,\n      // LoopStep\n      _||_(\n        @result~bool^@result,\n        _\u003c_(\n          e~int^e,\n          0~int\n        )~bool^less_int64\n      )~bool^logical_or,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n    // Accumulator\n    @result,\n    // Init\n    0~int,\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _?_:_(\n      _==_(\n        e~int^e,\n        0~int\n      )~bool^equals,\n      _+_(\n        @result~int^@result,\n        1~int\n      )~int^add_int64,\n      @result~int^@result\n    )~int^conditional,\n    // Result\n    _==_(\n      @result~int^@result,\n      1~int\n    )~bool^equals)~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "5": { name: "e" },
+          "6": { overloadId: ["greater_int64"] },
+          "9": { name: "@result" },
+          "10": { overloadId: ["not_strictly_false"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["logical_and"] },
+          "13": { name: "@result" },
+          "15": { name: "x" },
+          "19": { name: "e" },
+          "20": { overloadId: ["less_int64"] },
+          "23": { name: "@result" },
+          "24": { overloadId: ["logical_not"] },
+          "25": { overloadId: ["not_strictly_false"] },
+          "26": { name: "@result" },
+          "27": { overloadId: ["logical_or"] },
+          "28": { name: "@result" },
+          "30": { overloadId: ["logical_and"] },
+          "31": { name: "x" },
+          "35": { name: "e" },
+          "36": { overloadId: ["equals"] },
+          "40": { name: "@result" },
+          "42": { overloadId: ["add_int64"] },
+          "43": { name: "@result" },
+          "44": { overloadId: ["conditional"] },
+          "45": { name: "@result" },
+          "47": { overloadId: ["equals"] },
+          "49": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "INT64" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "INT64" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "32": { listType: { elemType: { primitive: "INT64" } } },
+          "35": { primitive: "INT64" },
+          "36": { primitive: "BOOL" },
+          "37": { primitive: "INT64" },
+          "38": { primitive: "INT64" },
+          "39": { primitive: "BOOL" },
+          "40": { primitive: "INT64" },
+          "41": { primitive: "INT64" },
+          "42": { primitive: "INT64" },
+          "43": { primitive: "INT64" },
+          "44": { primitive: "INT64" },
+          "45": { primitive: "INT64" },
+          "46": { primitive: "INT64" },
+          "47": { primitive: "BOOL" },
+          "48": { primitive: "BOOL" },
+          "49": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [110],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 21,
+            "5": 24,
+            "6": 26,
+            "7": 28,
+            "8": 20,
+            "9": 20,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 34,
+            "16": 35,
+            "18": 58,
+            "19": 61,
+            "20": 63,
+            "21": 65,
+            "22": 57,
+            "23": 57,
+            "24": 57,
+            "25": 57,
+            "26": 57,
+            "27": 57,
+            "28": 57,
+            "29": 57,
+            "30": 31,
+            "31": 71,
+            "32": 72,
+            "34": 99,
+            "35": 102,
+            "36": 104,
+            "37": 107,
+            "38": 98,
+            "39": 98,
+            "40": 98,
+            "41": 98,
+            "42": 98,
+            "43": 98,
+            "44": 98,
+            "45": 98,
+            "46": 98,
+            "47": 98,
+            "48": 98,
+            "49": 68,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "4", identExpr: { name: "e" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "5", identExpr: { name: "e" } },
+                        { id: "7", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: {
+                  id: "16",
+                  selectExpr: {
+                    operand: { id: "15", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "18", identExpr: { name: "e" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "19", identExpr: { name: "e" } },
+                        { id: "21", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "48": {
+              callExpr: {
+                target: {
+                  id: "32",
+                  selectExpr: {
+                    operand: { id: "31", identExpr: { name: "x" } },
+                    field: "repeated_int64",
+                  },
+                },
+                function: "exists_one",
+                args: [
+                  { id: "34", identExpr: { name: "e" } },
+                  {
+                    id: "36",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "35", identExpr: { name: "e" } },
+                        { id: "37", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "49",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "30",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "14",
+                      comprehensionExpr: {
+                        iterVar: "e",
+                        iterRange: {
+                          id: "2",
+                          selectExpr: {
+                            operand: { id: "1", identExpr: { name: "x" } },
+                            field: "repeated_int64",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "8", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "10",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [{ id: "9", identExpr: { name: "@result" } }],
+                          },
+                        },
+                        loopStep: {
+                          id: "12",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "11", identExpr: { name: "@result" } },
+                              {
+                                id: "6",
+                                callExpr: {
+                                  function: "_\u003e_",
+                                  args: [
+                                    { id: "5", identExpr: { name: "e" } },
+                                    { id: "7", constExpr: { int64Value: "0" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "13", identExpr: { name: "@result" } },
+                      },
+                    },
+                    {
+                      id: "29",
+                      comprehensionExpr: {
+                        iterVar: "e",
+                        iterRange: {
+                          id: "16",
+                          selectExpr: {
+                            operand: { id: "15", identExpr: { name: "x" } },
+                            field: "repeated_int64",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "22", constExpr: { boolValue: false } },
+                        loopCondition: {
+                          id: "25",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              {
+                                id: "24",
+                                callExpr: {
+                                  function: "!_",
+                                  args: [
+                                    {
+                                      id: "23",
+                                      identExpr: { name: "@result" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "27",
+                          callExpr: {
+                            function: "_||_",
+                            args: [
+                              { id: "26", identExpr: { name: "@result" } },
+                              {
+                                id: "20",
+                                callExpr: {
+                                  function: "_\u003c_",
+                                  args: [
+                                    { id: "19", identExpr: { name: "e" } },
+                                    {
+                                      id: "21",
+                                      constExpr: { int64Value: "0" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "28", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "48",
+                comprehensionExpr: {
+                  iterVar: "e",
+                  iterRange: {
+                    id: "32",
+                    selectExpr: {
+                      operand: { id: "31", identExpr: { name: "x" } },
+                      field: "repeated_int64",
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "38", constExpr: { int64Value: "0" } },
+                  loopCondition: { id: "39", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "44",
+                    callExpr: {
+                      function: "_?_:_",
+                      args: [
+                        {
+                          id: "36",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "35", identExpr: { name: "e" } },
+                              { id: "37", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "42",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "40", identExpr: { name: "@result" } },
+                              { id: "41", constExpr: { int64Value: "1" } },
+                            ],
+                          },
+                        },
+                        { id: "43", identExpr: { name: "@result" } },
+                      ],
+                    },
+                  },
+                  result: {
+                    id: "47",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "45", identExpr: { name: "@result" } },
+                        { id: "46", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "18446744073709551615" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "3",
+      expectedCheckedAst:
+        "_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  __comprehension__(\n\t\t\t\t// Variable\n\t\t\t\te,\n\t\t\t\t// Target\n\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n\t\t\t\t// Accumulator\n\t\t\t\t@result,\n\t\t\t\t// Init\n\t\t\t\ttrue~bool,\n\t\t\t\t// LoopCondition\n\t\t\t\t@not_strictly_false(\n\t\t\t\t  @result~bool^@result\n\t\t\t\t)~bool^not_strictly_false,\n\t\t\t\t// LoopStep\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t  @result~bool^@result,\n\t\t\t\t  _\u003e_(\n\t\t\t\t\te~int^e,\n\t\t\t\t\t0~int\n\t\t\t\t  )~bool^greater_int64\n\t\t\t\t)~bool^logical_and,\n\t\t\t\t// Result\n\t\t\t\t@result~bool^@result)~bool,\n\t\t\t  __comprehension__(\n\t\t\t\t// Variable\n\t\t\t\te,\n\t\t\t\t// Target\n\t\t\t\tx~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n\t\t\t\t// Accumulator\n\t\t\t\t@result,\n\t\t\t\t// Init\n\t\t\t\tfalse~bool,\n\t\t\t\t// LoopCondition\n\t\t\t\t@not_strictly_false(\n\t\t\t\t  !_(\n\t\t\t\t\t@result~bool^@result\n\t\t\t\t  )~bool^logical_not\n\t\t\t\t)~bool^not_strictly_false,\n\t\t\t\t// LoopStep\n\t\t\t\t_||_(\n\t\t\t\t  @result~bool^@result,\n\t\t\t\t  _\u003c_(\n\t\t\t\t\te~int^e,\n\t\t\t\t\t0~int\n\t\t\t\t  )~bool^less_int64\n\t\t\t\t)~bool^logical_or,\n\t\t\t\t// Result\n\t\t\t\t@result~bool^@result)~bool\n\t\t\t)~bool^logical_and,\n\t\t\t__comprehension__(\n\t\t\t  // Variable\n\t\t\t  e,\n\t\t\t  // Target\n\t\t\t  x~google.expr.proto3.test.TestAllTypes^x.repeated_int64~list(int),\n\t\t\t  // Accumulator\n\t\t\t  @result,\n\t\t\t  // Init\n\t\t\t  0~int,\n\t\t\t  // LoopCondition\n\t\t\t  true~bool,\n\t\t\t  // LoopStep\n\t\t\t  _?_:_(\n\t\t\t\t_==_(\n\t\t\t\t  e~int^e,\n\t\t\t\t  0~int\n\t\t\t\t)~bool^equals,\n\t\t\t\t_+_(\n\t\t\t\t  @result~int^@result,\n\t\t\t\t  1~int\n\t\t\t\t)~int^add_int64,\n\t\t\t\t@result~int^@result\n\t\t\t  )~int^conditional,\n\t\t\t  // Result\n\t\t\t  _==_(\n\t\t\t\t@result~int^@result,\n\t\t\t\t1~int\n\t\t\t  )~bool^equals)~bool\n\t\t  )~bool^logical_and",
+      expectedType: "bool",
+    },
+    {
+      original: {
+        expr: "x.all(e, 0)",
+        typeEnv: [
+          {
+            name: "x",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      crossTypeNumericComparisons: true,
+      ast: "__comprehension__(\n  // Variable\n  e,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "x.all(e, 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  e,\n  // Target\n  x^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#5[1,5]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#6[1,5]#\n  )^#7[1,5]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#8[1,5]#,\n    0^#4[1,9]#\n  )^#9[1,5]#,\n  // Result\n  @result^#10[1,5]#)^#11[1,5]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 5, 5, 1, 5, 1, 5],
+        [6, 5, 5, 1, 5, 1, 5],
+        [7, 5, 5, 1, 5, 1, 5],
+        [8, 5, 5, 1, 5, 1, 5],
+        [9, 5, 5, 1, 5, 1, 5],
+        [10, 5, 5, 1, 5, 1, 5],
+        [11, 5, 5, 1, 5, 1, 5],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "e",
+            iterRange: { id: "1", identExpr: { name: "x" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "7",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "6", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "8", identExpr: { name: "@result" } },
+                  { id: "4", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: {
+            "1": 0,
+            "3": 6,
+            "4": 9,
+            "5": 5,
+            "6": 5,
+            "7": 5,
+            "8": 5,
+            "9": 5,
+            "10": 5,
+            "11": 5,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "x" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "e" } },
+                  { id: "4", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 11,
+          function: "all",
+          target: { id: "1", identExpr: { name: "x" } },
+          args: [
+            { id: "3", identExpr: { name: "e" } },
+            { id: "4", constExpr: { int64Value: "0" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: expression of type 'google.expr.proto3.test.TestAllTypes' cannot be range of a comprehension (must be list, map, or dynamic)\n | x.all(e, 0)\n | ^\nERROR: \u003cinput\u003e:1:10: expected type 'bool' but found 'int'\n | x.all(e, 0)\n | .........^",
+      errors: [
+        {
+          category: "other",
+          message:
+            "expression of type 'google.expr.proto3.test.TestAllTypes' cannot be range of a comprehension (must be list, map, or dynamic)",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "type-mismatch",
+          message: "expected type 'bool' but found 'int'",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError:
+        "\nERROR: \u003cinput\u003e:1:1: expression of type 'google.expr.proto3.test.TestAllTypes' cannot be range of a comprehension (must be list, map, or dynamic)\n | x.all(e, 0)\n | ^\nERROR: \u003cinput\u003e:1:10: expected type 'bool' but found 'int'\n | x.all(e, 0)\n | .........^\n\t\t",
     },
     {
       original: {
         expr: "lists.filter(x, x \u003e 1.5)",
         typeEnv: [{ name: "lists", ident: { type: { dyn: {} } } }],
       },
+      crossTypeNumericComparisons: true,
       ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  lists^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      1.5^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "lists.filter(x, x \u003e 1.5)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  lists^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#7[1,12]#,\n  // LoopCondition\n  true^#8[1,12]#,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x^#4[1,16]#,\n      1.5^#6[1,20]#\n    )^#5[1,18]#,\n    _+_(\n      @result^#9[1,12]#,\n      [\n        x^#3[1,13]#\n      ]^#10[1,12]#\n    )^#11[1,12]#,\n    @result^#12[1,12]#\n  )^#13[1,12]#,\n  // Result\n  @result^#14[1,12]#)^#15[1,12]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 20, 23, 1, 20, 1, 23],
+        [7, 12, 12, 1, 12, 1, 12],
+        [8, 12, 12, 1, 12, 1, 12],
+        [9, 12, 12, 1, 12, 1, 12],
+        [10, 12, 12, 1, 12, 1, 12],
+        [11, 12, 12, 1, 12, 1, 12],
+        [12, 12, 12, 1, 12, 1, 12],
+        [13, 12, 12, 1, 12, 1, 12],
+        [14, 12, 12, 1, 12, 1, 12],
+        [15, 12, 12, 1, 12, 1, 12],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "lists" } },
+            accuVar: "@result",
+            accuInit: { id: "7", listExpr: {} },
+            loopCondition: { id: "8", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "13",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "4", identExpr: { name: "x" } },
+                        { id: "6", constExpr: { doubleValue: 1.5 } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "9", identExpr: { name: "@result" } },
+                        {
+                          id: "10",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "12", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "14", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 16,
+            "5": 18,
+            "6": 20,
+            "7": 12,
+            "8": 12,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "lists" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "4", identExpr: { name: "x" } },
+                        { id: "6", constExpr: { doubleValue: 1.5 } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 15,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "lists" } },
+          args: [
+            { id: "3", identExpr: { name: "x" } },
+            {
+              id: "5",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "4", identExpr: { name: "x" } },
+                  { id: "6", constExpr: { doubleValue: 1.5 } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         "__comprehension__(\n  // Variable\n  x,\n  // Target\n  lists~dyn^lists,\n  // Accumulator\n  @result,\n  // Init\n  []~list(dyn),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x~dyn^x,\n      1.5~double\n    )~bool^greater_double|greater_int64_double|greater_uint64_double,\n    _+_(\n      @result~list(dyn)^@result,\n      [\n        x~dyn^x\n      ]~list(dyn)\n    )~list(dyn)^add_list,\n    @result~list(dyn)^@result\n  )~list(dyn)^conditional,\n  // Result\n  @result~list(dyn)^@result)~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "lists" },
+          "3": { name: "x" },
+          "4": { name: "x" },
+          "5": {
+            overloadId: [
+              "greater_double",
+              "greater_int64_double",
+              "greater_uint64_double",
+            ],
+          },
+          "9": { name: "@result" },
+          "11": { overloadId: ["add_list"] },
+          "12": { name: "@result" },
+          "13": { overloadId: ["conditional"] },
+          "14": { name: "@result" },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { dyn: {} },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "DOUBLE" },
+          "7": { listType: { elemType: { dyn: {} } } },
+          "8": { primitive: "BOOL" },
+          "9": { listType: { elemType: { dyn: {} } } },
+          "10": { listType: { elemType: { dyn: {} } } },
+          "11": { listType: { elemType: { dyn: {} } } },
+          "12": { listType: { elemType: { dyn: {} } } },
+          "13": { listType: { elemType: { dyn: {} } } },
+          "14": { listType: { elemType: { dyn: {} } } },
+          "15": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 16,
+            "5": 18,
+            "6": 20,
+            "7": 12,
+            "8": 12,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "lists" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "4", identExpr: { name: "x" } },
+                        { id: "6", constExpr: { doubleValue: 1.5 } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "15",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "lists" } },
+            accuVar: "@result",
+            accuInit: { id: "7", listExpr: {} },
+            loopCondition: { id: "8", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "13",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "4", identExpr: { name: "x" } },
+                        { id: "6", constExpr: { doubleValue: 1.5 } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "9", identExpr: { name: "@result" } },
+                        {
+                          id: "10",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "12", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "14", identExpr: { name: "@result" } },
+          },
+        },
+      },
       type: "list(dyn)",
+      cost: { min: "12", max: "18446744073709551615" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such attribute(s): lists" }],
+        },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tx,\n\t\t\t// Target\n\t\t\tlists~dyn^lists,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]~list(dyn),\n\t\t\t// LoopCondition\n\t\t\ttrue~bool,\n\t\t\t// LoopStep\n\t\t\t_?_:_(\n\t\t\t  _\u003e_(\n\t\t\t\tx~dyn^x,\n\t\t\t\t1.5~double\n\t\t\t  )~bool^greater_double|greater_int64_double|greater_uint64_double,\n\t\t\t  _+_(\n\t\t\t\t@result~list(dyn)^@result,\n\t\t\t\t[\n\t\t\t\t  x~dyn^x\n\t\t\t\t]~list(dyn)\n\t\t\t  )~list(dyn)^add_list,\n\t\t\t  @result~list(dyn)^@result\n\t\t\t)~list(dyn)^conditional,\n\t\t\t// Result\n\t\t\t@result~list(dyn)^@result)~list(dyn)",
+      expectedType: "list(dyn)",
     },
     {
       original: { expr: ".google.expr.proto3.test.TestAllTypes" },
+      crossTypeNumericComparisons: true,
       ast: ".google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto3^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.TestAllTypes^#*expr.Expr_SelectExpr#",
+      unparsed: ".google.expr.proto3.test.TestAllTypes",
+      locationAst:
+        ".google^#1[1,1]#.expr^#2[1,7]#.proto3^#3[1,12]#.test^#4[1,19]#.TestAllTypes^#5[1,24]#",
+      positions: [
+        [1, 1, 7, 1, 1, 1, 7],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: ".google" } },
+                        field: "expr",
+                      },
+                    },
+                    field: "proto3",
+                  },
+                },
+                field: "test",
+              },
+            },
+            field: "TestAllTypes",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 1, "2": 7, "3": 12, "4": 19, "5": 24 },
+        },
+      },
       checkedAst:
         "google.expr.proto3.test.TestAllTypes~type(google.expr.proto3.test.TestAllTypes)^google.expr.proto3.test.TestAllTypes",
+      checkedExpr: {
+        referenceMap: { "5": { name: "google.expr.proto3.test.TestAllTypes" } },
+        typeMap: {
+          "5": {
+            type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 1, "2": 7, "3": 12, "4": 19, "5": 24 },
+        },
+        expr: {
+          id: "5",
+          identExpr: { name: "google.expr.proto3.test.TestAllTypes" },
+        },
+      },
       type: "type(google.expr.proto3.test.TestAllTypes)",
+      cost: { min: "1", max: "1" },
+      result: { value: { typeValue: "google.expr.proto3.test.TestAllTypes" } },
+      runtimeCost: "0",
+      expectedCheckedAst:
+        "google.expr.proto3.test.TestAllTypes\n\t~type(google.expr.proto3.test.TestAllTypes)\n\t^google.expr.proto3.test.TestAllTypes",
+      expectedType: "type(google.expr.proto3.test.TestAllTypes)",
     },
     {
       original: { expr: "test.TestAllTypes", container: "google.expr.proto3" },
+      crossTypeNumericComparisons: true,
       ast: "test^#*expr.Expr_IdentExpr#.TestAllTypes^#*expr.Expr_SelectExpr#",
+      unparsed: "test.TestAllTypes",
+      locationAst: "test^#1[1,0]#.TestAllTypes^#2[1,4]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "test" } },
+            field: "TestAllTypes",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 4 },
+        },
+      },
       checkedAst:
         "google.expr.proto3.test.TestAllTypes~type(google.expr.proto3.test.TestAllTypes)^google.expr.proto3.test.TestAllTypes",
+      checkedExpr: {
+        referenceMap: { "2": { name: "google.expr.proto3.test.TestAllTypes" } },
+        typeMap: {
+          "2": {
+            type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 4 },
+        },
+        expr: {
+          id: "2",
+          identExpr: { name: "google.expr.proto3.test.TestAllTypes" },
+        },
+      },
       type: "type(google.expr.proto3.test.TestAllTypes)",
+      cost: { min: "1", max: "1" },
+      result: { value: { typeValue: "google.expr.proto3.test.TestAllTypes" } },
+      runtimeCost: "0",
+      expectedCheckedAst:
+        "\n\tgoogle.expr.proto3.test.TestAllTypes\n\t~type(google.expr.proto3.test.TestAllTypes)\n\t^google.expr.proto3.test.TestAllTypes\n\t\t",
+      expectedType: "type(google.expr.proto3.test.TestAllTypes)",
     },
     {
       original: { expr: "1 + x" },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  1^#*expr.Constant_Int64Value#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + x",
+      locationAst: "_+_(\n  1^#1[1,0]#,\n  x^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:5: undeclared reference to 'x' (in container '')\n | 1 + x\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedError:
+        "\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'x' (in container '')\n | 1 + x\n | ....^",
     },
     {
       original: {
@@ -821,10 +10346,420 @@ export const tests: SerializedIncrementalTestSuite = {
           { name: "y", ident: { type: { wrapper: "INT64" } } },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: '_||_(\n  _||_(\n    _\u0026\u0026_(\n      _==_(\n        x^#*expr.Expr_IdentExpr#,\n        google.protobuf.Any{\n          type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      )^#*expr.Expr_CallExpr#,\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_nested_message^#*expr.Expr_SelectExpr#.bb^#*expr.Expr_SelectExpr#,\n        43^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      google.expr.proto3.test.TestAllTypes{}^#*expr.Expr_StructExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    _\u003c_(\n      y^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e=_(\n      x^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'x == google.protobuf.Any{type_url: "types.googleapis.com/google.expr.proto3.test.TestAllTypes"} \u0026\u0026\nx.single_nested_message.bb == 43 || x == google.expr.proto3.test.TestAllTypes{} ||\ny \u003c x || x \u003e= x',
+      locationAst:
+        '_||_(\n  _||_(\n    _\u0026\u0026_(\n      _==_(\n        x^#1[1,0]#,\n        google.protobuf.Any{\n          type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"^#5[2,13]#^#4[2,12]#\n        }^#3[1,24]#\n      )^#2[1,2]#,\n      _==_(\n        x^#6[3,8]#.single_nested_message^#7[3,9]#.bb^#8[3,31]#,\n        43^#10[3,38]#\n      )^#9[3,35]#\n    )^#11[3,5]#,\n    _==_(\n      x^#12[4,6]#,\n      google.expr.proto3.test.TestAllTypes{}^#14[4,47]#\n    )^#13[4,8]#\n  )^#15[4,3]#,\n  _||_(\n    _\u003c_(\n      y^#16[5,6]#,\n      x^#18[5,10]#\n    )^#17[5,8]#,\n    _\u003e=_(\n      x^#20[6,6]#,\n      x^#22[6,11]#\n    )^#21[6,8]#\n  )^#23[6,3]#\n)^#19[5,3]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 38, 39, 2, 12, 2, 13],
+        [5, 39, 98, 2, 13, 2, 72],
+        [6, 107, 108, 3, 8, 3, 9],
+        [7, 108, 109, 3, 9, 3, 10],
+        [8, 130, 131, 3, 31, 3, 32],
+        [9, 134, 136, 3, 35, 3, 37],
+        [10, 137, 139, 3, 38, 3, 40],
+        [11, 104, 106, 3, 5, 3, 7],
+        [12, 146, 147, 4, 6, 4, 7],
+        [13, 148, 150, 4, 8, 4, 10],
+        [14, 187, 188, 4, 47, 4, 48],
+        [15, 143, 145, 4, 3, 4, 5],
+        [16, 196, 197, 5, 6, 5, 7],
+        [17, 198, 199, 5, 8, 5, 9],
+        [18, 200, 201, 5, 10, 5, 11],
+        [19, 193, 195, 5, 3, 5, 5],
+        [20, 208, 209, 6, 6, 6, 7],
+        [21, 210, 212, 6, 8, 6, 10],
+        [22, 213, 214, 6, 11, 6, 12],
+        [23, 205, 207, 6, 3, 6, 5],
+      ],
+      lineOffsets: [26, 99, 140, 190, 202, 215],
+      parsedExpr: {
+        expr: {
+          id: "19",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "15",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                { id: "1", identExpr: { name: "x" } },
+                                {
+                                  id: "3",
+                                  structExpr: {
+                                    messageName: "google.protobuf.Any",
+                                    entries: [
+                                      {
+                                        id: "4",
+                                        fieldKey: "type_url",
+                                        value: {
+                                          id: "5",
+                                          constExpr: {
+                                            stringValue:
+                                              "types.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                                          },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "8",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "7",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "6",
+                                          identExpr: { name: "x" },
+                                        },
+                                        field: "single_nested_message",
+                                      },
+                                    },
+                                    field: "bb",
+                                  },
+                                },
+                                { id: "10", constExpr: { int64Value: "43" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "13",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "12", identExpr: { name: "x" } },
+                          {
+                            id: "14",
+                            structExpr: {
+                              messageName:
+                                "google.expr.proto3.test.TestAllTypes",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "17",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          { id: "16", identExpr: { name: "y" } },
+                          { id: "18", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003e=_",
+                        args: [
+                          { id: "20", identExpr: { name: "x" } },
+                          { id: "22", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26, 99, 140, 190, 202, 215],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 24,
+            "4": 38,
+            "5": 39,
+            "6": 107,
+            "7": 108,
+            "8": 130,
+            "9": 134,
+            "10": 137,
+            "11": 104,
+            "12": 146,
+            "13": 148,
+            "14": 187,
+            "15": 143,
+            "16": 196,
+            "17": 198,
+            "18": 200,
+            "19": 193,
+            "20": 208,
+            "21": 210,
+            "22": 213,
+            "23": 205,
+          },
+        },
+      },
       checkedAst:
-        '_||_(\n  _||_(\n    _\u0026\u0026_(\n      _==_(\n        x~any^x,\n        google.protobuf.Any{\n          type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"~string\n        }~any^google.protobuf.Any\n      )~bool^equals,\n      _==_(\n        x~any^x.single_nested_message~dyn.bb~dyn,\n        43~int\n      )~bool^equals\n    )~bool^logical_and,\n    _==_(\n      x~any^x,\n      google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes\n    )~bool^equals\n  )~bool^logical_or,\n  _||_(\n    _\u003c_(\n      y~wrapper(int)^y,\n      x~any^x\n    )~bool^less_int64,\n    _\u003e=_(\n      x~any^x,\n      x~any^x\n    )~bool^greater_equals_bool|greater_equals_bytes|greater_equals_double|greater_equals_duration|greater_equals_int64|greater_equals_string|greater_equals_timestamp|greater_equals_uint64\n  )~bool^logical_or\n)~bool^logical_or',
+        '_||_(\n  _||_(\n    _\u0026\u0026_(\n      _==_(\n        x~any^x,\n        google.protobuf.Any{\n          type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"~string\n        }~any^google.protobuf.Any\n      )~bool^equals,\n      _==_(\n        x~any^x.single_nested_message~dyn.bb~dyn,\n        43~int\n      )~bool^equals\n    )~bool^logical_and,\n    _==_(\n      x~any^x,\n      google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes\n    )~bool^equals\n  )~bool^logical_or,\n  _||_(\n    _\u003c_(\n      y~wrapper(int)^y,\n      x~any^x\n    )~bool^less_int64|less_int64_double|less_int64_uint64,\n    _\u003e=_(\n      x~any^x,\n      x~any^x\n    )~bool^greater_equals_bool|greater_equals_bytes|greater_equals_double|greater_equals_double_int64|greater_equals_double_uint64|greater_equals_duration|greater_equals_int64|greater_equals_int64_double|greater_equals_int64_uint64|greater_equals_string|greater_equals_timestamp|greater_equals_uint64|greater_equals_uint64_double|greater_equals_uint64_int64\n  )~bool^logical_or\n)~bool^logical_or',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["equals"] },
+          "3": { name: "google.protobuf.Any" },
+          "6": { name: "x" },
+          "9": { overloadId: ["equals"] },
+          "11": { overloadId: ["logical_and"] },
+          "12": { name: "x" },
+          "13": { overloadId: ["equals"] },
+          "14": { name: "google.expr.proto3.test.TestAllTypes" },
+          "15": { overloadId: ["logical_or"] },
+          "16": { name: "y" },
+          "17": {
+            overloadId: [
+              "less_int64",
+              "less_int64_double",
+              "less_int64_uint64",
+            ],
+          },
+          "18": { name: "x" },
+          "19": { overloadId: ["logical_or"] },
+          "20": { name: "x" },
+          "21": {
+            overloadId: [
+              "greater_equals_bool",
+              "greater_equals_bytes",
+              "greater_equals_double",
+              "greater_equals_double_int64",
+              "greater_equals_double_uint64",
+              "greater_equals_duration",
+              "greater_equals_int64",
+              "greater_equals_int64_double",
+              "greater_equals_int64_uint64",
+              "greater_equals_string",
+              "greater_equals_timestamp",
+              "greater_equals_uint64",
+              "greater_equals_uint64_double",
+              "greater_equals_uint64_int64",
+            ],
+          },
+          "22": { name: "x" },
+          "23": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "ANY" },
+          "2": { primitive: "BOOL" },
+          "3": { wellKnown: "ANY" },
+          "5": { primitive: "STRING" },
+          "6": { wellKnown: "ANY" },
+          "7": { dyn: {} },
+          "8": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { wellKnown: "ANY" },
+          "13": { primitive: "BOOL" },
+          "14": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "15": { primitive: "BOOL" },
+          "16": { wrapper: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { wellKnown: "ANY" },
+          "19": { primitive: "BOOL" },
+          "20": { wellKnown: "ANY" },
+          "21": { primitive: "BOOL" },
+          "22": { wellKnown: "ANY" },
+          "23": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26, 99, 140, 190, 202, 215],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 24,
+            "4": 38,
+            "5": 39,
+            "6": 107,
+            "7": 108,
+            "8": 130,
+            "9": 134,
+            "10": 137,
+            "11": 104,
+            "12": 146,
+            "13": 148,
+            "14": 187,
+            "15": 143,
+            "16": 196,
+            "17": 198,
+            "18": 200,
+            "19": 193,
+            "20": 208,
+            "21": 210,
+            "22": 213,
+            "23": 205,
+          },
+        },
+        expr: {
+          id: "19",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "15",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                { id: "1", identExpr: { name: "x" } },
+                                {
+                                  id: "3",
+                                  structExpr: {
+                                    messageName: "google.protobuf.Any",
+                                    entries: [
+                                      {
+                                        id: "4",
+                                        fieldKey: "type_url",
+                                        value: {
+                                          id: "5",
+                                          constExpr: {
+                                            stringValue:
+                                              "types.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                                          },
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "8",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "7",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "6",
+                                          identExpr: { name: "x" },
+                                        },
+                                        field: "single_nested_message",
+                                      },
+                                    },
+                                    field: "bb",
+                                  },
+                                },
+                                { id: "10", constExpr: { int64Value: "43" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "13",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "12", identExpr: { name: "x" } },
+                          {
+                            id: "14",
+                            structExpr: {
+                              messageName:
+                                "google.expr.proto3.test.TestAllTypes",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "17",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          { id: "16", identExpr: { name: "y" } },
+                          { id: "18", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003e=_",
+                        args: [
+                          { id: "20", identExpr: { name: "x" } },
+                          { id: "22", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "42", max: "5534023222112865881" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "92",
+      expectedCheckedAst:
+        '\n\t\t_||_(\n\t\t\t_||_(\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t\t_==_(\n\t\t\t\t\t\tx~any^x,\n\t\t\t\t\t\tgoogle.protobuf.Any{\n\t\t\t\t\t\t\ttype_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"~string\n\t\t\t\t\t\t}~any^google.protobuf.Any\n\t\t\t\t\t)~bool^equals,\n\t\t\t\t\t_==_(\n\t\t\t\t\t\tx~any^x.single_nested_message~dyn.bb~dyn,\n\t\t\t\t\t\t43~int\n\t\t\t\t\t)~bool^equals\n\t\t\t\t)~bool^logical_and,\n\t\t\t\t_==_(\n\t\t\t\t\tx~any^x,\n\t\t\t\t\tgoogle.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes\n\t\t\t\t)~bool^equals\n\t\t\t)~bool^logical_or,\n\t\t\t_||_(\n\t\t\t\t_\u003c_(\n\t\t\t\t\ty~wrapper(int)^y,\n\t\t\t\t\tx~any^x\n\t\t\t\t)~bool^less_int64|less_int64_double|less_int64_uint64,\n\t\t\t\t_\u003e=_(\n\t\t\t\t\tx~any^x,\n\t\t\t\t\tx~any^x\n\t\t\t\t)~bool^greater_equals_bool|greater_equals_bytes|greater_equals_double|greater_equals_double_int64|greater_equals_double_uint64|greater_equals_duration|greater_equals_int64|greater_equals_int64_double|greater_equals_int64_uint64|greater_equals_string|greater_equals_timestamp|greater_equals_uint64|greater_equals_uint64_double|greater_equals_uint64_int64\n\t\t\t)~bool^logical_or\n\t\t)~bool^logical_or\n\t\t',
+      expectedType: "bool",
     },
     {
       original: {
@@ -834,10 +10769,383 @@ export const tests: SerializedIncrementalTestSuite = {
           { name: "y", ident: { type: { wrapper: "INT64" } } },
         ],
       },
-      ast: '_||_(\n  _||_(\n    _\u0026\u0026_(\n      _==_(\n        x^#*expr.Expr_IdentExpr#,\n        google.protobuf.Any{\n          type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      )^#*expr.Expr_CallExpr#,\n      _==_(\n        x^#*expr.Expr_IdentExpr#.single_nested_message^#*expr.Expr_SelectExpr#.bb^#*expr.Expr_SelectExpr#,\n        43^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      google.expr.proto3.test.TestAllTypes{}^#*expr.Expr_StructExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    _\u003c_(\n      y^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e=_(\n      x^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      variadicAsts: true,
+      crossTypeNumericComparisons: true,
+      ast: '_||_(\n  _\u0026\u0026_(\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      google.protobuf.Any{\n        type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#.single_nested_message^#*expr.Expr_SelectExpr#.bb^#*expr.Expr_SelectExpr#,\n      43^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    x^#*expr.Expr_IdentExpr#,\n    google.expr.proto3.test.TestAllTypes{}^#*expr.Expr_StructExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u003c_(\n    y^#*expr.Expr_IdentExpr#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e=_(\n    x^#*expr.Expr_IdentExpr#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'x == google.protobuf.Any{type_url: "types.googleapis.com/google.expr.proto3.test.TestAllTypes"} \u0026\u0026\nx.single_nested_message.bb == 43 || x == google.expr.proto3.test.TestAllTypes{}',
+      locationAst:
+        '_||_(\n  _\u0026\u0026_(\n    _==_(\n      x^#1[1,0]#,\n      google.protobuf.Any{\n        type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"^#5[2,13]#^#4[2,12]#\n      }^#3[1,24]#\n    )^#2[1,2]#,\n    _==_(\n      x^#6[3,8]#.single_nested_message^#7[3,9]#.bb^#8[3,31]#,\n      43^#10[3,38]#\n    )^#9[3,35]#\n  )^#11[3,5]#,\n  _==_(\n    x^#12[4,6]#,\n    google.expr.proto3.test.TestAllTypes{}^#14[4,47]#\n  )^#13[4,8]#,\n  _\u003c_(\n    y^#16[5,6]#,\n    x^#18[5,10]#\n  )^#17[5,8]#,\n  _\u003e=_(\n    x^#20[6,6]#,\n    x^#22[6,11]#\n  )^#21[6,8]#\n)^#15[4,3]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 38, 39, 2, 12, 2, 13],
+        [5, 39, 98, 2, 13, 2, 72],
+        [6, 107, 108, 3, 8, 3, 9],
+        [7, 108, 109, 3, 9, 3, 10],
+        [8, 130, 131, 3, 31, 3, 32],
+        [9, 134, 136, 3, 35, 3, 37],
+        [10, 137, 139, 3, 38, 3, 40],
+        [11, 104, 106, 3, 5, 3, 7],
+        [12, 146, 147, 4, 6, 4, 7],
+        [13, 148, 150, 4, 8, 4, 10],
+        [14, 187, 188, 4, 47, 4, 48],
+        [15, 143, 145, 4, 3, 4, 5],
+        [16, 196, 197, 5, 6, 5, 7],
+        [17, 198, 199, 5, 8, 5, 9],
+        [18, 200, 201, 5, 10, 5, 11],
+        [19, 193, 195, 5, 3, 5, 5],
+        [20, 208, 209, 6, 6, 6, 7],
+        [21, 210, 212, 6, 8, 6, 10],
+        [22, 213, 214, 6, 11, 6, 12],
+        [23, 205, 207, 6, 3, 6, 5],
+      ],
+      lineOffsets: [26, 99, 140, 190, 202, 215],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "1", identExpr: { name: "x" } },
+                          {
+                            id: "3",
+                            structExpr: {
+                              messageName: "google.protobuf.Any",
+                              entries: [
+                                {
+                                  id: "4",
+                                  fieldKey: "type_url",
+                                  value: {
+                                    id: "5",
+                                    constExpr: {
+                                      stringValue:
+                                        "types.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                                    },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: {
+                                id: "7",
+                                selectExpr: {
+                                  operand: {
+                                    id: "6",
+                                    identExpr: { name: "x" },
+                                  },
+                                  field: "single_nested_message",
+                                },
+                              },
+                              field: "bb",
+                            },
+                          },
+                          { id: "10", constExpr: { int64Value: "43" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "12", identExpr: { name: "x" } },
+                    {
+                      id: "14",
+                      structExpr: {
+                        messageName: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "16", identExpr: { name: "y" } },
+                    { id: "18", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              {
+                id: "21",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "20", identExpr: { name: "x" } },
+                    { id: "22", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26, 99, 140, 190, 202, 215],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 24,
+            "4": 38,
+            "5": 39,
+            "6": 107,
+            "7": 108,
+            "8": 130,
+            "9": 134,
+            "10": 137,
+            "11": 104,
+            "12": 146,
+            "13": 148,
+            "14": 187,
+            "15": 143,
+            "16": 196,
+            "17": 198,
+            "18": 200,
+            "19": 193,
+            "20": 208,
+            "21": 210,
+            "22": 213,
+            "23": 205,
+          },
+        },
+      },
       checkedAst:
-        '_||_(\n  _||_(\n    _\u0026\u0026_(\n      _==_(\n        x~any^x,\n        google.protobuf.Any{\n          type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"~string\n        }~any^google.protobuf.Any\n      )~bool^equals,\n      _==_(\n        x~any^x.single_nested_message~dyn.bb~dyn,\n        43~int\n      )~bool^equals\n    )~bool^logical_and,\n    _==_(\n      x~any^x,\n      google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes\n    )~bool^equals\n  )~bool^logical_or,\n  _||_(\n    _\u003c_(\n      y~wrapper(int)^y,\n      x~any^x\n    )~bool^less_int64,\n    _\u003e=_(\n      x~any^x,\n      x~any^x\n    )~bool^greater_equals_bool|greater_equals_bytes|greater_equals_double|greater_equals_duration|greater_equals_int64|greater_equals_string|greater_equals_timestamp|greater_equals_uint64\n  )~bool^logical_or\n)~bool^logical_or',
+        '_||_(\n  _\u0026\u0026_(\n    _==_(\n      x~any^x,\n      google.protobuf.Any{\n        type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"~string\n      }~any^google.protobuf.Any\n    )~bool^equals,\n    _==_(\n      x~any^x.single_nested_message~dyn.bb~dyn,\n      43~int\n    )~bool^equals\n  )~bool^logical_and,\n  _==_(\n    x~any^x,\n    google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes\n  )~bool^equals,\n  _\u003c_(\n    y~wrapper(int)^y,\n    x~any^x\n  )~bool^less_int64|less_int64_double|less_int64_uint64,\n  _\u003e=_(\n    x~any^x,\n    x~any^x\n  )~bool^greater_equals_bool|greater_equals_bytes|greater_equals_double|greater_equals_double_int64|greater_equals_double_uint64|greater_equals_duration|greater_equals_int64|greater_equals_int64_double|greater_equals_int64_uint64|greater_equals_string|greater_equals_timestamp|greater_equals_uint64|greater_equals_uint64_double|greater_equals_uint64_int64\n)~bool^logical_or',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["equals"] },
+          "3": { name: "google.protobuf.Any" },
+          "6": { name: "x" },
+          "9": { overloadId: ["equals"] },
+          "11": { overloadId: ["logical_and"] },
+          "12": { name: "x" },
+          "13": { overloadId: ["equals"] },
+          "14": { name: "google.expr.proto3.test.TestAllTypes" },
+          "15": { overloadId: ["logical_or"] },
+          "16": { name: "y" },
+          "17": {
+            overloadId: [
+              "less_int64",
+              "less_int64_double",
+              "less_int64_uint64",
+            ],
+          },
+          "18": { name: "x" },
+          "20": { name: "x" },
+          "21": {
+            overloadId: [
+              "greater_equals_bool",
+              "greater_equals_bytes",
+              "greater_equals_double",
+              "greater_equals_double_int64",
+              "greater_equals_double_uint64",
+              "greater_equals_duration",
+              "greater_equals_int64",
+              "greater_equals_int64_double",
+              "greater_equals_int64_uint64",
+              "greater_equals_string",
+              "greater_equals_timestamp",
+              "greater_equals_uint64",
+              "greater_equals_uint64_double",
+              "greater_equals_uint64_int64",
+            ],
+          },
+          "22": { name: "x" },
+        },
+        typeMap: {
+          "1": { wellKnown: "ANY" },
+          "2": { primitive: "BOOL" },
+          "3": { wellKnown: "ANY" },
+          "5": { primitive: "STRING" },
+          "6": { wellKnown: "ANY" },
+          "7": { dyn: {} },
+          "8": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { wellKnown: "ANY" },
+          "13": { primitive: "BOOL" },
+          "14": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "15": { primitive: "BOOL" },
+          "16": { wrapper: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { wellKnown: "ANY" },
+          "20": { wellKnown: "ANY" },
+          "21": { primitive: "BOOL" },
+          "22": { wellKnown: "ANY" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26, 99, 140, 190, 202, 215],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 24,
+            "4": 38,
+            "5": 39,
+            "6": 107,
+            "7": 108,
+            "8": 130,
+            "9": 134,
+            "10": 137,
+            "11": 104,
+            "12": 146,
+            "13": 148,
+            "14": 187,
+            "15": 143,
+            "16": 196,
+            "17": 198,
+            "18": 200,
+            "19": 193,
+            "20": 208,
+            "21": 210,
+            "22": 213,
+            "23": 205,
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "1", identExpr: { name: "x" } },
+                          {
+                            id: "3",
+                            structExpr: {
+                              messageName: "google.protobuf.Any",
+                              entries: [
+                                {
+                                  id: "4",
+                                  fieldKey: "type_url",
+                                  value: {
+                                    id: "5",
+                                    constExpr: {
+                                      stringValue:
+                                        "types.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                                    },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: {
+                                id: "7",
+                                selectExpr: {
+                                  operand: {
+                                    id: "6",
+                                    identExpr: { name: "x" },
+                                  },
+                                  field: "single_nested_message",
+                                },
+                              },
+                              field: "bb",
+                            },
+                          },
+                          { id: "10", constExpr: { int64Value: "43" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "12", identExpr: { name: "x" } },
+                    {
+                      id: "14",
+                      structExpr: {
+                        messageName: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "16", identExpr: { name: "y" } },
+                    { id: "18", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              {
+                id: "21",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "20", identExpr: { name: "x" } },
+                    { id: "22", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "42", max: "3689348814741910612" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "92",
+      expectedCheckedAst:
+        '\n\t\t_||_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  _==_(\n\t\t\t\tx~any^x,\n\t\t\t\tgoogle.protobuf.Any{\n\t\t\t\t  type_url:"types.googleapis.com/google.expr.proto3.test.TestAllTypes"~string\n\t\t\t\t}~any^google.protobuf.Any\n\t\t\t  )~bool^equals,\n\t\t\t  _==_(\n\t\t\t\tx~any^x.single_nested_message~dyn.bb~dyn,\n\t\t\t\t43~int\n\t\t\t  )~bool^equals\n\t\t\t)~bool^logical_and,\n\t\t\t_==_(\n\t\t\t  x~any^x,\n\t\t\t  google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes\n\t\t\t)~bool^equals,\n\t\t\t_\u003c_(\n\t\t\t  y~wrapper(int)^y,\n\t\t\t  x~any^x\n\t\t\t)~bool^less_int64|less_int64_double|less_int64_uint64,\n\t\t\t_\u003e=_(\n\t\t\t  x~any^x,\n\t\t\t  x~any^x\n\t\t\t)~bool^greater_equals_bool|greater_equals_bytes|greater_equals_double|greater_equals_double_int64|greater_equals_double_uint64|greater_equals_duration|greater_equals_int64|greater_equals_int64_double|greater_equals_int64_uint64|greater_equals_string|greater_equals_timestamp|greater_equals_uint64|greater_equals_uint64_double|greater_equals_uint64_int64\n\t\t  )~bool^logical_or\n\t\t',
+      expectedType: "bool",
     },
     {
       original: {
@@ -852,41 +11160,317 @@ export const tests: SerializedIncrementalTestSuite = {
         ],
         container: "container",
       },
+      crossTypeNumericComparisons: true,
       ast: "x^#*expr.Expr_IdentExpr#",
+      unparsed: "x",
+      locationAst: "x^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [2],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "x" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [2],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst:
         "container.x~google.expr.proto3.test.TestAllTypes^container.x",
+      checkedExpr: {
+        referenceMap: { "1": { name: "container.x" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [2],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "container.x" } },
+      },
       type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such attribute(s): container.x" }],
+        },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        "container.x~google.expr.proto3.test.TestAllTypes^container.x",
+      expectedType: "google.expr.proto3.test.TestAllTypes",
     },
     {
       original: { expr: "list == type([1]) \u0026\u0026 map == type({1:2u})" },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _==_(\n    list^#*expr.Expr_IdentExpr#,\n    type(\n      [\n        1^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    map^#*expr.Expr_IdentExpr#,\n    type(\n      {\n        1^#*expr.Constant_Int64Value#:2u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
-      checkedAst:
-        "_\u0026\u0026_(\n  _==_(\n    list~type(list(dyn))^list,\n    type(\n      [\n        1~int\n      ]~list(int)\n    )~type(list(int))^type\n  )~bool^equals,\n  _==_(\n    map~type(map(dyn, dyn))^map,\n    type(\n      {\n        1~int:2u~uint\n      }~map(int, uint)\n    )~type(map(int, uint))^type\n  )~bool^equals\n)~bool^logical_and",
-      type: "bool",
-    },
-    {
-      original: {
-        expr: "myfun(1, true, 3u) + 1.myfun(false, 3u).myfun(true, 42u)",
-        typeEnv: [
-          {
-            name: "myfun",
-            function: {
-              overloads: [
-                {
-                  overloadId: "myfun_instance",
-                  params: [
-                    { primitive: "INT64" },
-                    { primitive: "BOOL" },
-                    { primitive: "UINT64" },
+      unparsed: "list == type([1]) \u0026\u0026 map == type({1: 2u})",
+      locationAst:
+        "_\u0026\u0026_(\n  _==_(\n    list^#1[1,0]#,\n    type(\n      [\n        1^#5[1,14]#\n      ]^#4[1,13]#\n    )^#3[1,12]#\n  )^#2[1,5]#,\n  _==_(\n    map^#6[1,21]#,\n    type(\n      {\n        1^#11[1,34]#:2u^#12[1,36]#^#10[1,35]#\n      }^#9[1,33]#\n    )^#8[1,32]#\n  )^#7[1,25]#\n)^#13[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 21, 24, 1, 21, 1, 24],
+        [7, 25, 27, 1, 25, 1, 27],
+        [8, 32, 33, 1, 32, 1, 33],
+        [9, 33, 34, 1, 33, 1, 34],
+        [10, 35, 36, 1, 35, 1, 36],
+        [11, 34, 35, 1, 34, 1, 35],
+        [12, 36, 38, 1, 36, 1, 38],
+        [13, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "list" } },
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "type",
+                        args: [
+                          {
+                            id: "4",
+                            listExpr: {
+                              elements: [
+                                { id: "5", constExpr: { int64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
                   ],
-                  resultType: { primitive: "INT64" },
-                  isInstanceFunction: true,
                 },
-                {
-                  overloadId: "myfun_static",
-                  params: [
-                    { primitive: "INT64" },
-                    { primitive: "BOOL" },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "6", identExpr: { name: "map" } },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "type",
+                        args: [
+                          {
+                            id: "9",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "10",
+                                  mapKey: {
+                                    id: "11",
+                                    constExpr: { int64Value: "1" },
+                                  },
+                                  value: {
+                                    id: "12",
+                                    constExpr: { uint64Value: "2" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 12,
+            "4": 13,
+            "5": 14,
+            "6": 21,
+            "7": 25,
+            "8": 32,
+            "9": 33,
+            "10": 35,
+            "11": 34,
+            "12": 36,
+            "13": 18,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _==_(\n    list~type(list(dyn))^list,\n    type(\n      [\n        1~int\n      ]~list(int)\n    )~type(list(int))^type\n  )~bool^equals,\n  _==_(\n    map~type(map(dyn, dyn))^map,\n    type(\n      {\n        1~int:2u~uint\n      }~map(int, uint)\n    )~type(map(int, uint))^type\n  )~bool^equals\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "list" },
+          "2": { overloadId: ["equals"] },
+          "3": { overloadId: ["type"] },
+          "6": { name: "map" },
+          "7": { overloadId: ["equals"] },
+          "8": { overloadId: ["type"] },
+          "13": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { type: { listType: { elemType: { dyn: {} } } } },
+          "2": { primitive: "BOOL" },
+          "3": { type: { listType: { elemType: { primitive: "INT64" } } } },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": {
+            type: { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          },
+          "7": { primitive: "BOOL" },
+          "8": {
+            type: {
+              mapType: {
+                keyType: { primitive: "INT64" },
+                valueType: { primitive: "UINT64" },
+              },
+            },
+          },
+          "9": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "UINT64" },
+            },
+          },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "UINT64" },
+          "13": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 12,
+            "4": 13,
+            "5": 14,
+            "6": 21,
+            "7": 25,
+            "8": 32,
+            "9": 33,
+            "10": 35,
+            "11": 34,
+            "12": 36,
+            "13": 18,
+          },
+        },
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "list" } },
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "type",
+                        args: [
+                          {
+                            id: "4",
+                            listExpr: {
+                              elements: [
+                                { id: "5", constExpr: { int64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "6", identExpr: { name: "map" } },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "type",
+                        args: [
+                          {
+                            id: "9",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "10",
+                                  mapKey: {
+                                    id: "11",
+                                    constExpr: { int64Value: "1" },
+                                  },
+                                  value: {
+                                    id: "12",
+                                    constExpr: { uint64Value: "2" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "3689348814741910572" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "44",
+      expectedCheckedAst:
+        "\n_\u0026\u0026_(_==_(list~type(list(dyn))^list,\n           type([1~int]~list(int))~type(list(int))^type)\n       ~bool^equals,\n      _==_(map~type(map(dyn, dyn))^map,\n            type({1~int : 2u~uint}~map(int, uint))~type(map(int, uint))^type)\n        ~bool^equals)\n  ~bool^logical_and\n\t",
+      expectedType: "bool",
+    },
+    {
+      original: {
+        expr: "myfun(1, true, 3u) + 1.myfun(false, 3u).myfun(true, 42u)",
+        typeEnv: [
+          {
+            name: "myfun",
+            function: {
+              overloads: [
+                {
+                  overloadId: "myfun_instance",
+                  params: [
+                    { primitive: "INT64" },
+                    { primitive: "BOOL" },
+                    { primitive: "UINT64" },
+                  ],
+                  resultType: { primitive: "INT64" },
+                  isInstanceFunction: true,
+                },
+                {
+                  overloadId: "myfun_static",
+                  params: [
+                    { primitive: "INT64" },
+                    { primitive: "BOOL" },
                     { primitive: "UINT64" },
                   ],
                   resultType: { primitive: "INT64" },
@@ -896,10 +11480,177 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  myfun(\n    1^#*expr.Constant_Int64Value#,\n    true^#*expr.Constant_BoolValue#,\n    3u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#.myfun(\n    false^#*expr.Constant_BoolValue#,\n    3u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#.myfun(\n    true^#*expr.Constant_BoolValue#,\n    42u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "myfun(1, true, 3u) + 1.myfun(false, 3u).myfun(true, 42u)",
+      locationAst:
+        "_+_(\n  myfun(\n    1^#2[1,6]#,\n    true^#3[1,9]#,\n    3u^#4[1,15]#\n  )^#1[1,5]#,\n  1^#6[1,21]#.myfun(\n    false^#8[1,29]#,\n    3u^#9[1,36]#\n  )^#7[1,28]#.myfun(\n    true^#11[1,46]#,\n    42u^#12[1,52]#\n  )^#10[1,45]#\n)^#5[1,19]#",
+      positions: [
+        [1, 5, 6, 1, 5, 1, 6],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 9, 13, 1, 9, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 28, 29, 1, 28, 1, 29],
+        [8, 29, 34, 1, 29, 1, 34],
+        [9, 36, 38, 1, 36, 1, 38],
+        [10, 45, 46, 1, 45, 1, 46],
+        [11, 46, 50, 1, 46, 1, 50],
+        [12, 52, 55, 1, 52, 1, 55],
+      ],
+      lineOffsets: [57],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "myfun",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { boolValue: true } },
+                    { id: "4", constExpr: { uint64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "7",
+                    callExpr: {
+                      target: { id: "6", constExpr: { int64Value: "1" } },
+                      function: "myfun",
+                      args: [
+                        { id: "8", constExpr: { boolValue: false } },
+                        { id: "9", constExpr: { uint64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "myfun",
+                  args: [
+                    { id: "11", constExpr: { boolValue: true } },
+                    { id: "12", constExpr: { uint64Value: "42" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [57],
+          positions: {
+            "1": 5,
+            "2": 6,
+            "3": 9,
+            "4": 15,
+            "5": 19,
+            "6": 21,
+            "7": 28,
+            "8": 29,
+            "9": 36,
+            "10": 45,
+            "11": 46,
+            "12": 52,
+          },
+        },
+      },
       checkedAst:
         "_+_(\n  myfun(\n    1~int,\n    true~bool,\n    3u~uint\n  )~int^myfun_static,\n  1~int.myfun(\n    false~bool,\n    3u~uint\n  )~int^myfun_instance.myfun(\n    true~bool,\n    42u~uint\n  )~int^myfun_instance\n)~int^add_int64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["myfun_static"] },
+          "5": { overloadId: ["add_int64"] },
+          "7": { overloadId: ["myfun_instance"] },
+          "10": { overloadId: ["myfun_instance"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "UINT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [57],
+          positions: {
+            "1": 5,
+            "2": 6,
+            "3": 9,
+            "4": 15,
+            "5": 19,
+            "6": 21,
+            "7": 28,
+            "8": 29,
+            "9": 36,
+            "10": 45,
+            "11": 46,
+            "12": 52,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "myfun",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { boolValue: true } },
+                    { id: "4", constExpr: { uint64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "7",
+                    callExpr: {
+                      target: { id: "6", constExpr: { int64Value: "1" } },
+                      function: "myfun",
+                      args: [
+                        { id: "8", constExpr: { boolValue: false } },
+                        { id: "9", constExpr: { uint64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "myfun",
+                  args: [
+                    { id: "11", constExpr: { boolValue: true } },
+                    { id: "12", constExpr: { uint64Value: "42" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "4", max: "4" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such overload: myfun 1" }] },
+      },
+      runtimeCost: "3",
+      expectedCheckedAst:
+        "_+_(\n    \t\t  myfun(\n    \t\t    1~int,\n    \t\t    true~bool,\n    \t\t    3u~uint\n    \t\t  )~int^myfun_static,\n    \t\t  1~int.myfun(\n    \t\t    false~bool,\n    \t\t    3u~uint\n    \t\t  )~int^myfun_instance.myfun(\n    \t\t    true~bool,\n    \t\t    42u~uint\n    \t\t  )~int^myfun_instance\n    \t\t)~int^add_int64",
+      expectedType: "int",
     },
     {
       original: {
@@ -927,10 +11678,84 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u003e_(\n  size(\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "size(x) \u003e 4",
+      locationAst:
+        "_\u003e_(\n  size(\n    x^#2[1,5]#\n  )^#1[1,4]#,\n  4^#4[1,10]#\n)^#3[1,8]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "size",
+                  args: [{ id: "2", identExpr: { name: "x" } }],
+                },
+              },
+              { id: "4", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 10 },
+        },
+      },
       checkedAst:
         "_\u003e_(\n  size(\n    x~google.expr.proto3.test.TestAllTypes^x\n  )~int^size_message,\n  4~int\n)~bool^greater_int64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["size_message"] },
+          "2": { name: "x" },
+          "3": { overloadId: ["greater_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 10 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "size",
+                  args: [{ id: "2", identExpr: { name: "x" } }],
+                },
+              },
+              { id: "4", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "3",
+      expectedType: "bool",
     },
     {
       original: {
@@ -944,10 +11769,108 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_!=_(\n  _+_(\n    x^#*expr.Expr_IdentExpr#.single_int64_wrapper^#*expr.Expr_SelectExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  23^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.single_int64_wrapper + 1 != 23",
+      locationAst:
+        "_!=_(\n  _+_(\n    x^#1[1,0]#.single_int64_wrapper^#2[1,1]#,\n    1^#4[1,25]#\n  )^#3[1,23]#,\n  23^#6[1,30]#\n)^#5[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 23, 24, 1, 23, 1, 24],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_int64_wrapper",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 1, "3": 23, "4": 25, "5": 27, "6": 30 },
+        },
+      },
       checkedAst:
         "_!=_(\n  _+_(\n    x~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper~wrapper(int),\n    1~int\n  )~int^add_int64,\n  23~int\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["add_int64"] },
+          "5": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wrapper: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 1, "3": 23, "4": 25, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_int64_wrapper",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "4", max: "4" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "3",
+      expectedCheckedAst:
+        "\n\t\t_!=_(_+_(x~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper\n\t\t~wrapper(int),\n\t\t1~int)\n\t\t~int^add_int64,\n\t\t23~int)\n\t\t~bool^not_equals\n\t\t",
+      expectedType: "bool",
     },
     {
       original: {
@@ -965,67 +11888,1491 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_!=_(\n  _+_(\n    x^#*expr.Expr_IdentExpr#.single_int64_wrapper^#*expr.Expr_SelectExpr#,\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  23^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.single_int64_wrapper + y != 23",
+      locationAst:
+        "_!=_(\n  _+_(\n    x^#1[1,0]#.single_int64_wrapper^#2[1,1]#,\n    y^#4[1,25]#\n  )^#3[1,23]#,\n  23^#6[1,30]#\n)^#5[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 23, 24, 1, 23, 1, 24],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_int64_wrapper",
+                      },
+                    },
+                    { id: "4", identExpr: { name: "y" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 1, "3": 23, "4": 25, "5": 27, "6": 30 },
+        },
+      },
       checkedAst:
         "_!=_(\n  _+_(\n    x~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper~wrapper(int),\n    y~wrapper(int)^y\n  )~int^add_int64,\n  23~int\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["add_int64"] },
+          "4": { name: "y" },
+          "5": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wrapper: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { wrapper: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 1, "3": 23, "4": 25, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "x" } },
+                        field: "single_int64_wrapper",
+                      },
+                    },
+                    { id: "4", identExpr: { name: "y" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "5", max: "5" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "4",
+      expectedCheckedAst:
+        "\n\t\t_!=_(\n\t\t\t_+_(\n\t\t\t  x~google.expr.proto3.test.TestAllTypes^x.single_int64_wrapper~wrapper(int),\n\t\t\t  y~wrapper(int)^y\n\t\t\t)~int^add_int64,\n\t\t\t23~int\n\t\t  )~bool^not_equals\n\t\t",
+      expectedType: "bool",
     },
     {
       original: { expr: "1 in [1, 2, 3]" },
+      crossTypeNumericComparisons: true,
       ast: "@in(\n  1^#*expr.Constant_Int64Value#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 in [1, 2, 3]",
+      locationAst:
+        "@in(\n  1^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    2^#5[1,9]#,\n    3^#6[1,12]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 12 },
+        },
+      },
       checkedAst:
         "@in(\n  1~int,\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 12 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+      expectedCheckedAst:
+        "@in(\n    \t\t  1~int,\n    \t\t  [\n    \t\t    1~int,\n    \t\t    2~int,\n    \t\t    3~int\n    \t\t  ]~list(int)\n    \t\t)~bool^in_list",
+      expectedType: "bool",
     },
     {
       original: { expr: "1 in dyn([1, 2, 3])" },
+      crossTypeNumericComparisons: true,
       ast: "@in(\n  1^#*expr.Constant_Int64Value#,\n  dyn(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 in dyn([1, 2, 3])",
+      locationAst:
+        "@in(\n  1^#1[1,0]#,\n  dyn(\n    [\n      1^#5[1,10]#,\n      2^#6[1,13]#,\n      3^#7[1,16]#\n    ]^#4[1,9]#\n  )^#3[1,8]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "6", constExpr: { int64Value: "2" } },
+                          { id: "7", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 8,
+            "4": 9,
+            "5": 10,
+            "6": 13,
+            "7": 16,
+          },
+        },
+      },
       checkedAst:
         "@in(\n  1~int,\n  dyn(\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int)\n  )~dyn^to_dyn\n)~bool^in_list|in_map",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_list", "in_map"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { dyn: {} },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 8,
+            "4": 9,
+            "5": 10,
+            "6": 13,
+            "7": 16,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "6", constExpr: { int64Value: "2" } },
+                          { id: "7", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "12", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+      expectedCheckedAst:
+        "@in(\n\t\t\t1~int,\n\t\t\tdyn(\n\t\t\t  [\n\t\t\t\t1~int,\n\t\t\t\t2~int,\n\t\t\t\t3~int\n\t\t\t  ]~list(int)\n\t\t\t)~dyn^to_dyn\n\t\t  )~bool^in_list|in_map",
+      expectedType: "bool",
     },
     {
       original: { expr: "type(null) == null_type" },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  type(\n    null^#*expr.Constant_NullValue#\n  )^#*expr.Expr_CallExpr#,\n  null_type^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(null) == null_type",
+      locationAst:
+        "_==_(\n  type(\n    null^#2[1,5]#\n  )^#1[1,4]#,\n  null_type^#4[1,14]#\n)^#3[1,11]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 9, 1, 5, 1, 9],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 14, 23, 1, 14, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [{ id: "2", constExpr: { nullValue: null } }],
+                },
+              },
+              { id: "4", identExpr: { name: "null_type" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 4, "2": 5, "3": 11, "4": 14 },
+        },
+      },
       checkedAst:
         "_==_(\n  type(\n    null~null\n  )~type(null)^type,\n  null_type~type(null)^null_type\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["type"] },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "null_type" },
+        },
+        typeMap: {
+          "1": { type: { null: null } },
+          "2": { null: null },
+          "3": { primitive: "BOOL" },
+          "4": { type: { null: null } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 4, "2": 5, "3": 11, "4": 14 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [{ id: "2", constExpr: { nullValue: null } }],
+                },
+              },
+              { id: "4", identExpr: { name: "null_type" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedCheckedAst:
+        "_==_(\n    \t\t  type(\n    \t\t    null~null\n    \t\t  )~type(null)^type,\n    \t\t  null_type~type(null)^null_type\n    \t\t)~bool^equals",
+      expectedType: "bool",
     },
     {
       original: { expr: "type(type) == type" },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  type(\n    type^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  type^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(type) == type",
+      locationAst:
+        "_==_(\n  type(\n    type^#2[1,5]#\n  )^#1[1,4]#,\n  type^#4[1,14]#\n)^#3[1,11]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 9, 1, 5, 1, 9],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 14, 18, 1, 14, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [{ id: "2", identExpr: { name: "type" } }],
+                },
+              },
+              { id: "4", identExpr: { name: "type" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 4, "2": 5, "3": 11, "4": 14 },
+        },
+      },
       checkedAst:
         "_==_(\n  type(\n    type~type(type)^type\n  )~type(type(type))^type,\n  type~type(type)^type\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["type"] },
+          "2": { name: "type" },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "type" },
+        },
+        typeMap: {
+          "1": { type: { type: { type: {} } } },
+          "2": { type: { type: {} } },
+          "3": { primitive: "BOOL" },
+          "4": { type: { type: {} } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 4, "2": 5, "3": 11, "4": 14 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [{ id: "2", identExpr: { name: "type" } }],
+                },
+              },
+              { id: "4", identExpr: { name: "type" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "4", max: "1844674407370955267" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedCheckedAst:
+        "_==_(\n\t\t  type(\n\t\t    type~type(type)^type\n\t\t  )~type(type(type))^type,\n\t\t  type~type(type)^type\n\t\t)~bool^equals",
+      expectedType: "bool",
     },
     {
       original: {
         expr: "([[[1]], [[2]], [[3]]][0][0] + [2, 3, {'four': {'five': 'six'}}])[3]",
       },
+      crossTypeNumericComparisons: true,
       ast: '_[_](\n  _+_(\n    _[_](\n      _[_](\n        [\n          [\n            [\n              1^#*expr.Constant_Int64Value#\n            ]^#*expr.Expr_ListExpr#\n          ]^#*expr.Expr_ListExpr#,\n          [\n            [\n              2^#*expr.Constant_Int64Value#\n            ]^#*expr.Expr_ListExpr#\n          ]^#*expr.Expr_ListExpr#,\n          [\n            [\n              3^#*expr.Constant_Int64Value#\n            ]^#*expr.Expr_ListExpr#\n          ]^#*expr.Expr_ListExpr#\n        ]^#*expr.Expr_ListExpr#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      {\n        "four"^#*expr.Constant_StringValue#:{\n          "five"^#*expr.Constant_StringValue#:"six"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '([[[1]], [[2]], [[3]]][0][0] + [2, 3, {"four": {"five": "six"}}])[3]',
+      locationAst:
+        '_[_](\n  _+_(\n    _[_](\n      _[_](\n        [\n          [\n            [\n              1^#4[1,4]#\n            ]^#3[1,3]#\n          ]^#2[1,2]#,\n          [\n            [\n              2^#7[1,11]#\n            ]^#6[1,10]#\n          ]^#5[1,9]#,\n          [\n            [\n              3^#10[1,18]#\n            ]^#9[1,17]#\n          ]^#8[1,16]#\n        ]^#1[1,1]#,\n        0^#12[1,23]#\n      )^#11[1,22]#,\n      0^#14[1,26]#\n    )^#13[1,25]#,\n    [\n      2^#17[1,32]#,\n      3^#18[1,35]#,\n      {\n        "four"^#21[1,39]#:{\n          "five"^#24[1,48]#:"six"^#25[1,56]#^#23[1,54]#\n        }^#22[1,47]#^#20[1,45]#\n      }^#19[1,38]#\n    ]^#16[1,31]#\n  )^#15[1,29]#,\n  3^#27[1,66]#\n)^#26[1,65]#',
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 11, 12, 1, 11, 1, 12],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 17, 18, 1, 17, 1, 18],
+        [10, 18, 19, 1, 18, 1, 19],
+        [11, 22, 23, 1, 22, 1, 23],
+        [12, 23, 24, 1, 23, 1, 24],
+        [13, 25, 26, 1, 25, 1, 26],
+        [14, 26, 27, 1, 26, 1, 27],
+        [15, 29, 30, 1, 29, 1, 30],
+        [16, 31, 32, 1, 31, 1, 32],
+        [17, 32, 33, 1, 32, 1, 33],
+        [18, 35, 36, 1, 35, 1, 36],
+        [19, 38, 39, 1, 38, 1, 39],
+        [20, 45, 46, 1, 45, 1, 46],
+        [21, 39, 45, 1, 39, 1, 45],
+        [22, 47, 48, 1, 47, 1, 48],
+        [23, 54, 55, 1, 54, 1, 55],
+        [24, 48, 54, 1, 48, 1, 54],
+        [25, 56, 61, 1, 56, 1, 61],
+        [26, 65, 66, 1, 65, 1, 66],
+        [27, 66, 67, 1, 66, 1, 67],
+      ],
+      lineOffsets: [69],
+      parsedExpr: {
+        expr: {
+          id: "26",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "15",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "13",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                {
+                                  id: "1",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "2",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "3",
+                                              listExpr: {
+                                                elements: [
+                                                  {
+                                                    id: "4",
+                                                    constExpr: {
+                                                      int64Value: "1",
+                                                    },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                      {
+                                        id: "5",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "6",
+                                              listExpr: {
+                                                elements: [
+                                                  {
+                                                    id: "7",
+                                                    constExpr: {
+                                                      int64Value: "2",
+                                                    },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                      {
+                                        id: "8",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "9",
+                                              listExpr: {
+                                                elements: [
+                                                  {
+                                                    id: "10",
+                                                    constExpr: {
+                                                      int64Value: "3",
+                                                    },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                { id: "12", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          { id: "14", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "16",
+                      listExpr: {
+                        elements: [
+                          { id: "17", constExpr: { int64Value: "2" } },
+                          { id: "18", constExpr: { int64Value: "3" } },
+                          {
+                            id: "19",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "20",
+                                  mapKey: {
+                                    id: "21",
+                                    constExpr: { stringValue: "four" },
+                                  },
+                                  value: {
+                                    id: "22",
+                                    structExpr: {
+                                      entries: [
+                                        {
+                                          id: "23",
+                                          mapKey: {
+                                            id: "24",
+                                            constExpr: { stringValue: "five" },
+                                          },
+                                          value: {
+                                            id: "25",
+                                            constExpr: { stringValue: "six" },
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "27", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 1,
+            "2": 2,
+            "3": 3,
+            "4": 4,
+            "5": 9,
+            "6": 10,
+            "7": 11,
+            "8": 16,
+            "9": 17,
+            "10": 18,
+            "11": 22,
+            "12": 23,
+            "13": 25,
+            "14": 26,
+            "15": 29,
+            "16": 31,
+            "17": 32,
+            "18": 35,
+            "19": 38,
+            "20": 45,
+            "21": 39,
+            "22": 47,
+            "23": 54,
+            "24": 48,
+            "25": 56,
+            "26": 65,
+            "27": 66,
+          },
+        },
+      },
       checkedAst:
         '_[_](\n  _+_(\n    _[_](\n      _[_](\n        [\n          [\n            [\n              1~int\n            ]~list(int)\n          ]~list(list(int)),\n          [\n            [\n              2~int\n            ]~list(int)\n          ]~list(list(int)),\n          [\n            [\n              3~int\n            ]~list(int)\n          ]~list(list(int))\n        ]~list(list(list(int))),\n        0~int\n      )~list(list(int))^index_list,\n      0~int\n    )~list(int)^index_list,\n    [\n      2~int,\n      3~int,\n      {\n        "four"~string:{\n          "five"~string:"six"~string\n        }~map(string, string)\n      }~map(string, map(string, string))\n    ]~list(dyn)\n  )~list(dyn)^add_list,\n  3~int\n)~dyn^index_list',
+      checkedExpr: {
+        referenceMap: {
+          "11": { overloadId: ["index_list"] },
+          "13": { overloadId: ["index_list"] },
+          "15": { overloadId: ["add_list"] },
+          "26": { overloadId: ["index_list"] },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: { listType: { elemType: { primitive: "INT64" } } },
+                },
+              },
+            },
+          },
+          "2": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { primitive: "INT64" },
+          "15": { listType: { elemType: { dyn: {} } } },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "INT64" },
+          "19": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "21": { primitive: "STRING" },
+          "22": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "24": { primitive: "STRING" },
+          "25": { primitive: "STRING" },
+          "26": { dyn: {} },
+          "27": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 1,
+            "2": 2,
+            "3": 3,
+            "4": 4,
+            "5": 9,
+            "6": 10,
+            "7": 11,
+            "8": 16,
+            "9": 17,
+            "10": 18,
+            "11": 22,
+            "12": 23,
+            "13": 25,
+            "14": 26,
+            "15": 29,
+            "16": 31,
+            "17": 32,
+            "18": 35,
+            "19": 38,
+            "20": 45,
+            "21": 39,
+            "22": 47,
+            "23": 54,
+            "24": 48,
+            "25": 56,
+            "26": 65,
+            "27": 66,
+          },
+        },
+        expr: {
+          id: "26",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "15",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "13",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                {
+                                  id: "1",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "2",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "3",
+                                              listExpr: {
+                                                elements: [
+                                                  {
+                                                    id: "4",
+                                                    constExpr: {
+                                                      int64Value: "1",
+                                                    },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                      {
+                                        id: "5",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "6",
+                                              listExpr: {
+                                                elements: [
+                                                  {
+                                                    id: "7",
+                                                    constExpr: {
+                                                      int64Value: "2",
+                                                    },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                      {
+                                        id: "8",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "9",
+                                              listExpr: {
+                                                elements: [
+                                                  {
+                                                    id: "10",
+                                                    constExpr: {
+                                                      int64Value: "3",
+                                                    },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                { id: "12", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          { id: "14", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "16",
+                      listExpr: {
+                        elements: [
+                          { id: "17", constExpr: { int64Value: "2" } },
+                          { id: "18", constExpr: { int64Value: "3" } },
+                          {
+                            id: "19",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "20",
+                                  mapKey: {
+                                    id: "21",
+                                    constExpr: { stringValue: "four" },
+                                  },
+                                  value: {
+                                    id: "22",
+                                    structExpr: {
+                                      entries: [
+                                        {
+                                          id: "23",
+                                          mapKey: {
+                                            id: "24",
+                                            constExpr: { stringValue: "five" },
+                                          },
+                                          value: {
+                                            id: "25",
+                                            constExpr: { stringValue: "six" },
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "27", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
       type: "dyn",
+      cost: { min: "144", max: "144" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              {
+                key: { stringValue: "four" },
+                value: {
+                  mapValue: {
+                    entries: [
+                      {
+                        key: { stringValue: "five" },
+                        value: { stringValue: "six" },
+                      },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+      },
+      runtimeCost: "146",
+      expectedCheckedAst:
+        '_[_](\n\t\t\t_+_(\n\t\t\t\t_[_](\n\t\t\t\t\t_[_](\n\t\t\t\t\t\t[\n\t\t\t\t\t\t\t[\n\t\t\t\t\t\t\t\t[\n\t\t\t\t\t\t\t\t\t1~int\n\t\t\t\t\t\t\t\t]~list(int)\n\t\t\t\t\t\t\t]~list(list(int)),\n\t\t\t\t\t\t\t[\n\t\t\t\t\t\t\t\t[\n\t\t\t\t\t\t\t\t\t2~int\n\t\t\t\t\t\t\t\t]~list(int)\n\t\t\t\t\t\t\t]~list(list(int)),\n\t\t\t\t\t\t\t[\n\t\t\t\t\t\t\t\t[\n\t\t\t\t\t\t\t\t\t3~int\n\t\t\t\t\t\t\t\t]~list(int)\n\t\t\t\t\t\t\t]~list(list(int))\n\t\t\t\t\t\t]~list(list(list(int))),\n\t\t\t\t\t\t0~int\n\t\t\t\t\t)~list(list(int))^index_list,\n\t\t\t\t\t0~int\n\t\t\t\t)~list(int)^index_list,\n\t\t\t\t[\n\t\t\t\t\t2~int,\n\t\t\t\t\t3~int,\n\t\t\t\t\t{\n\t\t\t\t\t\t"four"~string:{\n\t\t\t\t\t\t\t"five"~string:"six"~string\n\t\t\t\t\t\t}~map(string, string)\n\t\t\t\t\t}~map(string, map(string, string))\n\t\t\t\t]~list(dyn)\n\t\t\t)~list(dyn)^add_list,\n\t\t\t3~int\n\t\t)~dyn^index_list',
+      expectedType: "dyn",
     },
     {
       original: { expr: "[1] + [dyn('string')]" },
+      crossTypeNumericComparisons: true,
       ast: '_+_(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    dyn(\n      "string"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '[1] + [dyn("string")]',
+      locationAst:
+        '_+_(\n  [\n    1^#2[1,1]#\n  ]^#1[1,0]#,\n  [\n    dyn(\n      "string"^#6[1,11]#\n    )^#5[1,10]#\n  ]^#4[1,6]#\n)^#3[1,4]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 11, 19, 1, 11, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "dyn",
+                        args: [
+                          { id: "6", constExpr: { stringValue: "string" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 6, "5": 10, "6": 11 },
+        },
+      },
       checkedAst:
         '_+_(\n  [\n    1~int\n  ]~list(int),\n  [\n    dyn(\n      "string"~string\n    )~dyn^to_dyn\n  ]~list(dyn)\n)~list(dyn)^add_list',
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["add_list"] },
+          "5": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { dyn: {} },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 6, "5": 10, "6": 11 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "dyn",
+                        args: [
+                          { id: "6", constExpr: { stringValue: "string" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "list(dyn)",
+      cost: { min: "22", max: "22" },
+      result: {
+        value: {
+          listValue: {
+            values: [{ int64Value: "1" }, { stringValue: "string" }],
+          },
+        },
+      },
+      runtimeCost: "22",
+      expectedCheckedAst:
+        '_+_(\n\t\t\t[\n\t\t\t\t1~int\n\t\t\t]~list(int),\n\t\t\t[\n\t\t\t\tdyn(\n\t\t\t\t\t"string"~string\n\t\t\t\t)~dyn^to_dyn\n\t\t\t]~list(dyn)\n\t\t)~list(dyn)^add_list',
+      expectedType: "list(dyn)",
     },
     {
       original: { expr: "[dyn('string')] + [1]" },
+      crossTypeNumericComparisons: true,
       ast: '_+_(\n  [\n    dyn(\n      "string"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '[dyn("string")] + [1]',
+      locationAst:
+        '_+_(\n  [\n    dyn(\n      "string"^#3[1,5]#\n    )^#2[1,4]#\n  ]^#1[1,0]#,\n  [\n    1^#6[1,19]#\n  ]^#5[1,18]#\n)^#4[1,16]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 13, 1, 5, 1, 13],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "dyn",
+                        args: [
+                          { id: "3", constExpr: { stringValue: "string" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 4, "3": 5, "4": 16, "5": 18, "6": 19 },
+        },
+      },
       checkedAst:
         '_+_(\n  [\n    dyn(\n      "string"~string\n    )~dyn^to_dyn\n  ]~list(dyn),\n  [\n    1~int\n  ]~list(int)\n)~list(dyn)^add_list',
-      type: "list(dyn)",
-    },
-    {
-      original: { expr: "[].map(x, [].map(y, x in y \u0026\u0026 y in x))" },
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["to_dyn"] },
+          "4": { overloadId: ["add_list"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { dyn: {} },
+          "3": { primitive: "STRING" },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 4, "3": 5, "4": 16, "5": 18, "6": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "dyn",
+                        args: [
+                          { id: "3", constExpr: { stringValue: "string" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "22", max: "22" },
+      result: {
+        value: {
+          listValue: {
+            values: [{ stringValue: "string" }, { int64Value: "1" }],
+          },
+        },
+      },
+      runtimeCost: "22",
+      expectedCheckedAst:
+        '_+_(\n\t\t\t[\n\t\t\t\tdyn(\n\t\t\t\t\t"string"~string\n\t\t\t\t)~dyn^to_dyn\n\t\t\t]~list(dyn),\n\t\t\t[\n\t\t\t\t1~int\n\t\t\t]~list(int)\n\t\t)~list(dyn)^add_list',
+      expectedType: "list(dyn)",
+    },
+    {
+      original: { expr: "[].map(x, [].map(y, x in y \u0026\u0026 y in x))" },
+      crossTypeNumericComparisons: true,
       ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  []^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      __comprehension__(\n        // Variable\n        y,\n        // Target\n        []^#*expr.Expr_ListExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            _\u0026\u0026_(\n              @in(\n                x^#*expr.Expr_IdentExpr#,\n                y^#*expr.Expr_IdentExpr#\n              )^#*expr.Expr_CallExpr#,\n              @in(\n                y^#*expr.Expr_IdentExpr#,\n                x^#*expr.Expr_IdentExpr#\n              )^#*expr.Expr_CallExpr#\n            )^#*expr.Expr_CallExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[].map(x, [].map(y, x in y \u0026\u0026 y in x))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  []^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#21[1,6]#,\n  // LoopCondition\n  true^#22[1,6]#,\n  // LoopStep\n  _+_(\n    @result^#23[1,6]#,\n    [\n      __comprehension__(\n        // Variable\n        y,\n        // Target\n        []^#4[1,10]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#14[1,16]#,\n        // LoopCondition\n        true^#15[1,16]#,\n        // LoopStep\n        _+_(\n          @result^#16[1,16]#,\n          [\n            _\u0026\u0026_(\n              @in(\n                x^#7[1,20]#,\n                y^#9[1,25]#\n              )^#8[1,22]#,\n              @in(\n                y^#10[1,30]#,\n                x^#12[1,35]#\n              )^#11[1,32]#\n            )^#13[1,27]#\n          ]^#17[1,16]#\n        )^#18[1,16]#,\n        // Result\n        @result^#19[1,16]#)^#20[1,16]#\n    ]^#24[1,6]#\n  )^#25[1,6]#,\n  // Result\n  @result^#26[1,6]#)^#27[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 10, 11, 1, 10, 1, 11],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 20, 21, 1, 20, 1, 21],
+        [8, 22, 24, 1, 22, 1, 24],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 30, 31, 1, 30, 1, 31],
+        [11, 32, 34, 1, 32, 1, 34],
+        [12, 35, 36, 1, 35, 1, 36],
+        [13, 27, 29, 1, 27, 1, 29],
+        [14, 16, 16, 1, 16, 1, 16],
+        [15, 16, 16, 1, 16, 1, 16],
+        [16, 16, 16, 1, 16, 1, 16],
+        [17, 16, 16, 1, 16, 1, 16],
+        [18, 16, 16, 1, 16, 1, 16],
+        [19, 16, 16, 1, 16, 1, 16],
+        [20, 16, 16, 1, 16, 1, 16],
+        [21, 6, 6, 1, 6, 1, 6],
+        [22, 6, 6, 1, 6, 1, 6],
+        [23, 6, 6, 1, 6, 1, 6],
+        [24, 6, 6, 1, 6, 1, 6],
+        [25, 6, 6, 1, 6, 1, 6],
+        [26, 6, 6, 1, 6, 1, 6],
+        [27, 6, 6, 1, 6, 1, 6],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", listExpr: {} },
+            accuVar: "@result",
+            accuInit: { id: "21", listExpr: {} },
+            loopCondition: { id: "22", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "23", identExpr: { name: "@result" } },
+                  {
+                    id: "24",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "20",
+                          comprehensionExpr: {
+                            iterVar: "y",
+                            iterRange: { id: "4", listExpr: {} },
+                            accuVar: "@result",
+                            accuInit: { id: "14", listExpr: {} },
+                            loopCondition: {
+                              id: "15",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "18",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "16", identExpr: { name: "@result" } },
+                                  {
+                                    id: "17",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "13",
+                                          callExpr: {
+                                            function: "_\u0026\u0026_",
+                                            args: [
+                                              {
+                                                id: "8",
+                                                callExpr: {
+                                                  function: "@in",
+                                                  args: [
+                                                    {
+                                                      id: "7",
+                                                      identExpr: { name: "x" },
+                                                    },
+                                                    {
+                                                      id: "9",
+                                                      identExpr: { name: "y" },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                              {
+                                                id: "11",
+                                                callExpr: {
+                                                  function: "@in",
+                                                  args: [
+                                                    {
+                                                      id: "10",
+                                                      identExpr: { name: "y" },
+                                                    },
+                                                    {
+                                                      id: "12",
+                                                      identExpr: { name: "x" },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "19",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "3": 7,
+            "4": 10,
+            "6": 17,
+            "7": 20,
+            "8": 22,
+            "9": 25,
+            "10": 30,
+            "11": 32,
+            "12": 35,
+            "13": 27,
+            "14": 16,
+            "15": 16,
+            "16": 16,
+            "17": 16,
+            "18": 16,
+            "19": 16,
+            "20": 16,
+            "21": 6,
+            "22": 6,
+            "23": 6,
+            "24": 6,
+            "25": 6,
+            "26": 6,
+            "27": 6,
+          },
+          macroCalls: {
+            "20": {
+              callExpr: {
+                target: { id: "4", listExpr: {} },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "y" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "8",
+                          callExpr: {
+                            function: "@in",
+                            args: [
+                              { id: "7", identExpr: { name: "x" } },
+                              { id: "9", identExpr: { name: "y" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "11",
+                          callExpr: {
+                            function: "@in",
+                            args: [
+                              { id: "10", identExpr: { name: "y" } },
+                              { id: "12", identExpr: { name: "x" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", listExpr: {} },
+                function: "map",
+                args: [{ id: "3", identExpr: { name: "x" } }, { id: "20" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 20,
+          function: "map",
+          target: { id: "4", listExpr: {} },
+          args: [
+            { id: "6", identExpr: { name: "y" } },
+            {
+              id: "13",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", identExpr: { name: "y" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "10", identExpr: { name: "y" } },
+                        { id: "12", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 27,
+          function: "map",
+          target: { id: "1", listExpr: {} },
+          args: [{ id: "3", identExpr: { name: "x" } }, { id: "20" }],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:33: found no matching overload for '@in' applied to '(list(dyn), dyn)'\n | [].map(x, [].map(y, x in y \u0026\u0026 y in x))\n | ................................^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '@in' applied to '(list(dyn), dyn)'",
+          id: 11,
+          offset: 32,
+          line: 1,
+          column: 32,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:33: found no matching overload for '@in' applied to '(list(dyn), dyn)'\n\t\t| [].map(x, [].map(y, x in y \u0026\u0026 y in x))\n\t\t| ................................^",
     },
     {
       original: {
@@ -1044,20 +13391,587 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: '__comprehension__(\n  // Variable\n  x,\n  // Target\n  _[_](\n    args^#*expr.Expr_IdentExpr#.user^#*expr.Expr_SelectExpr#,\n    "myextension"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#.customAttributes^#*expr.Expr_SelectExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      x^#*expr.Expr_IdentExpr#.name^#*expr.Expr_SelectExpr#,\n      "hobbies"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed:
+        'args.user["myextension"].customAttributes.filter(x, x.name == "hobbies")',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  x,\n  // Target\n  _[_](\n    args^#1[1,0]#.user^#2[1,4]#,\n    "myextension"^#4[1,10]#\n  )^#3[1,9]#.customAttributes^#5[1,24]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#12[1,48]#,\n  // LoopCondition\n  true^#13[1,48]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      x^#8[1,52]#.name^#9[1,53]#,\n      "hobbies"^#11[1,62]#\n    )^#10[1,59]#,\n    _+_(\n      @result^#14[1,48]#,\n      [\n        x^#7[1,49]#\n      ]^#15[1,48]#\n    )^#16[1,48]#,\n    @result^#17[1,48]#\n  )^#18[1,48]#,\n  // Result\n  @result^#19[1,48]#)^#20[1,48]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 10, 23, 1, 10, 1, 23],
+        [5, 24, 25, 1, 24, 1, 25],
+        [7, 49, 50, 1, 49, 1, 50],
+        [8, 52, 53, 1, 52, 1, 53],
+        [9, 53, 54, 1, 53, 1, 54],
+        [10, 59, 61, 1, 59, 1, 61],
+        [11, 62, 71, 1, 62, 1, 71],
+        [12, 48, 48, 1, 48, 1, 48],
+        [13, 48, 48, 1, 48, 1, 48],
+        [14, 48, 48, 1, 48, 1, 48],
+        [15, 48, 48, 1, 48, 1, 48],
+        [16, 48, 48, 1, 48, 1, 48],
+        [17, 48, 48, 1, 48, 1, 48],
+        [18, 48, 48, 1, 48, 1, 48],
+        [19, 48, 48, 1, 48, 1, 48],
+        [20, 48, 48, 1, 48, 1, 48],
+      ],
+      lineOffsets: [73],
+      parsedExpr: {
+        expr: {
+          id: "20",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      {
+                        id: "2",
+                        selectExpr: {
+                          operand: { id: "1", identExpr: { name: "args" } },
+                          field: "user",
+                        },
+                      },
+                      { id: "4", constExpr: { stringValue: "myextension" } },
+                    ],
+                  },
+                },
+                field: "customAttributes",
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", listExpr: {} },
+            loopCondition: { id: "13", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "18",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "9",
+                          selectExpr: {
+                            operand: { id: "8", identExpr: { name: "x" } },
+                            field: "name",
+                          },
+                        },
+                        { id: "11", constExpr: { stringValue: "hobbies" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "14", identExpr: { name: "@result" } },
+                        {
+                          id: "15",
+                          listExpr: {
+                            elements: [{ id: "7", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "17", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "19", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [73],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 9,
+            "4": 10,
+            "5": 24,
+            "7": 49,
+            "8": 52,
+            "9": 53,
+            "10": 59,
+            "11": 62,
+            "12": 48,
+            "13": 48,
+            "14": 48,
+            "15": 48,
+            "16": 48,
+            "17": 48,
+            "18": 48,
+            "19": 48,
+            "20": 48,
+          },
+          macroCalls: {
+            "20": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "args" } },
+                              field: "user",
+                            },
+                          },
+                          {
+                            id: "4",
+                            constExpr: { stringValue: "myextension" },
+                          },
+                        ],
+                      },
+                    },
+                    field: "customAttributes",
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "9",
+                          selectExpr: {
+                            operand: { id: "8", identExpr: { name: "x" } },
+                            field: "name",
+                          },
+                        },
+                        { id: "11", constExpr: { stringValue: "hobbies" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 20,
+          function: "filter",
+          target: {
+            id: "5",
+            selectExpr: {
+              operand: {
+                id: "3",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "args" } },
+                        field: "user",
+                      },
+                    },
+                    { id: "4", constExpr: { stringValue: "myextension" } },
+                  ],
+                },
+              },
+              field: "customAttributes",
+            },
+          },
+          args: [
+            { id: "7", identExpr: { name: "x" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "8", identExpr: { name: "x" } },
+                      field: "name",
+                    },
+                  },
+                  { id: "11", constExpr: { stringValue: "hobbies" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         '__comprehension__(\n  // Variable\n  x,\n  // Target\n  _[_](\n    args~map(string, dyn)^args.user~dyn,\n    "myextension"~string\n  )~dyn^index_map|optional_map_index_value.customAttributes~dyn,\n  // Accumulator\n  @result,\n  // Init\n  []~list(dyn),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      x~dyn^x.name~dyn,\n      "hobbies"~string\n    )~bool^equals,\n    _+_(\n      @result~list(dyn)^@result,\n      [\n        x~dyn^x\n      ]~list(dyn)\n    )~list(dyn)^add_list,\n    @result~list(dyn)^@result\n  )~list(dyn)^conditional,\n  // Result\n  @result~list(dyn)^@result)~list(dyn)',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "args" },
+          "3": { overloadId: ["index_map", "optional_map_index_value"] },
+          "7": { name: "x" },
+          "8": { name: "x" },
+          "10": { overloadId: ["equals"] },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_list"] },
+          "17": { name: "@result" },
+          "18": { overloadId: ["conditional"] },
+          "19": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+          "5": { dyn: {} },
+          "7": { dyn: {} },
+          "8": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "STRING" },
+          "12": { listType: { elemType: { dyn: {} } } },
+          "13": { primitive: "BOOL" },
+          "14": { listType: { elemType: { dyn: {} } } },
+          "15": { listType: { elemType: { dyn: {} } } },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "17": { listType: { elemType: { dyn: {} } } },
+          "18": { listType: { elemType: { dyn: {} } } },
+          "19": { listType: { elemType: { dyn: {} } } },
+          "20": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [73],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 9,
+            "4": 10,
+            "5": 24,
+            "7": 49,
+            "8": 52,
+            "9": 53,
+            "10": 59,
+            "11": 62,
+            "12": 48,
+            "13": 48,
+            "14": 48,
+            "15": 48,
+            "16": 48,
+            "17": 48,
+            "18": 48,
+            "19": 48,
+            "20": 48,
+          },
+          macroCalls: {
+            "20": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "args" } },
+                              field: "user",
+                            },
+                          },
+                          {
+                            id: "4",
+                            constExpr: { stringValue: "myextension" },
+                          },
+                        ],
+                      },
+                    },
+                    field: "customAttributes",
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "9",
+                          selectExpr: {
+                            operand: { id: "8", identExpr: { name: "x" } },
+                            field: "name",
+                          },
+                        },
+                        { id: "11", constExpr: { stringValue: "hobbies" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "20",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      {
+                        id: "2",
+                        selectExpr: {
+                          operand: { id: "1", identExpr: { name: "args" } },
+                          field: "user",
+                        },
+                      },
+                      { id: "4", constExpr: { stringValue: "myextension" } },
+                    ],
+                  },
+                },
+                field: "customAttributes",
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", listExpr: {} },
+            loopCondition: { id: "13", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "18",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "9",
+                          selectExpr: {
+                            operand: { id: "8", identExpr: { name: "x" } },
+                            field: "name",
+                          },
+                        },
+                        { id: "11", constExpr: { stringValue: "hobbies" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "14", identExpr: { name: "@result" } },
+                        {
+                          id: "15",
+                          listExpr: {
+                            elements: [{ id: "7", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "17", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "19", identExpr: { name: "@result" } },
+          },
+        },
+      },
       type: "list(dyn)",
+      cost: { min: "14", max: "18446744073709551615" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): args" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        '__comprehension__(\n\t\t\t// Variable\n\t\t\tx,\n\t\t\t// Target\n\t\t\t_[_](\n\t\t\targs~map(string, dyn)^args.user~dyn,\n\t\t\t"myextension"~string\n\t\t\t)~dyn^index_map.customAttributes~dyn,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]~list(dyn),\n\t\t\t// LoopCondition\n\t\t\ttrue~bool,\n\t\t\t// LoopStep\n\t\t\t_?_:_(\n\t\t\t_==_(\n\t\t\t\tx~dyn^x.name~dyn,\n\t\t\t\t"hobbies"~string\n\t\t\t)~bool^equals,\n\t\t\t_+_(\n\t\t\t\t@result~list(dyn)^@result,\n\t\t\t\t[\n\t\t\t\tx~dyn^x\n\t\t\t\t]~list(dyn)\n\t\t\t)~list(dyn)^add_list,\n\t\t\t@result~list(dyn)^@result\n\t\t\t)~list(dyn)^conditional,\n\t\t\t// Result\n\t\t\t@result~list(dyn)^@result)~list(dyn)',
+      expectedType: "list(dyn)",
     },
     {
       original: {
         expr: "a.b + 1 == a[0]",
         typeEnv: [{ name: "a", ident: { type: { typeParam: "T" } } }],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  _+_(\n    a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _[_](\n    a^#*expr.Expr_IdentExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a.b + 1 == a[0]",
+      locationAst:
+        "_==_(\n  _+_(\n    a^#1[1,0]#.b^#2[1,1]#,\n    1^#4[1,6]#\n  )^#3[1,4]#,\n  _[_](\n    a^#6[1,11]#,\n    0^#8[1,13]#\n  )^#7[1,12]#\n)^#5[1,8]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 10, 1, 8, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "a" } },
+                        field: "b",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "6", identExpr: { name: "a" } },
+                    { id: "8", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 12,
+            "8": 13,
+          },
+        },
+      },
       checkedAst:
         "_==_(\n  _+_(\n    a~dyn^a.b~dyn,\n    1~int\n  )~int^add_int64,\n  _[_](\n    a~dyn^a,\n    0~int\n  )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "3": { overloadId: ["add_int64"] },
+          "5": { overloadId: ["equals"] },
+          "6": { name: "a" },
+          "7": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { dyn: {} },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { dyn: {} },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 12,
+            "8": 13,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "a" } },
+                        field: "b",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "6", identExpr: { name: "a" } },
+                    { id: "8", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "5", max: "5" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "4",
+      expectedCheckedAst:
+        "_==_(\n\t\t\t_+_(\n\t\t\t  a~dyn^a.b~dyn,\n\t\t\t  1~int\n\t\t\t)~int^add_int64,\n\t\t\t_[_](\n\t\t\t  a~dyn^a,\n\t\t\t  0~int\n\t\t\t)~dyn^index_list|index_map\n\t\t  )~bool^equals",
+      expectedType: "bool",
     },
     {
       original: {
@@ -1077,30 +13991,859 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      !_(\n        pb2^#*expr.Expr_IdentExpr#.single_int64~test-only~^#*expr.Expr_SelectExpr#\n      )^#*expr.Expr_CallExpr#,\n      !_(\n        pb2^#*expr.Expr_IdentExpr#.repeated_int32~test-only~^#*expr.Expr_SelectExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    !_(\n      pb2^#*expr.Expr_IdentExpr#.map_string_string~test-only~^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      !_(\n        pb3^#*expr.Expr_IdentExpr#.single_int64~test-only~^#*expr.Expr_SelectExpr#\n      )^#*expr.Expr_CallExpr#,\n      !_(\n        pb3^#*expr.Expr_IdentExpr#.repeated_int32~test-only~^#*expr.Expr_SelectExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    !_(\n      pb3^#*expr.Expr_IdentExpr#.map_string_string~test-only~^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "!has(pb2.single_int64) \u0026\u0026 !has(pb2.repeated_int32) \u0026\u0026 !has(pb2.map_string_string) \u0026\u0026\n!has(pb3.single_int64) \u0026\u0026 !has(pb3.repeated_int32) \u0026\u0026 !has(pb3.map_string_string)",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      !_(\n        pb2^#3[1,5]#.single_int64~test-only~^#5[1,4]#\n      )^#1[1,0]#,\n      !_(\n        pb2^#8[2,10]#.repeated_int32~test-only~^#10[2,9]#\n      )^#6[2,5]#\n    )^#11[2,2]#,\n    !_(\n      pb2^#14[3,10]#.map_string_string~test-only~^#16[3,9]#\n    )^#12[3,5]#\n  )^#17[3,2]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      !_(\n        pb3^#20[4,10]#.single_int64~test-only~^#22[4,9]#\n      )^#18[4,5]#,\n      !_(\n        pb3^#26[5,10]#.repeated_int32~test-only~^#28[5,9]#\n      )^#24[5,5]#\n    )^#29[5,2]#,\n    !_(\n      pb3^#32[6,10]#.map_string_string~test-only~^#34[6,9]#\n    )^#30[6,5]#\n  )^#35[6,2]#\n)^#23[4,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 5, 8, 1, 5, 1, 8],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 4, 4, 1, 4, 1, 4],
+        [6, 28, 29, 2, 5, 2, 6],
+        [8, 33, 36, 2, 10, 2, 13],
+        [9, 36, 37, 2, 13, 2, 14],
+        [10, 32, 32, 2, 9, 2, 9],
+        [11, 25, 27, 2, 2, 2, 4],
+        [12, 58, 59, 3, 5, 3, 6],
+        [14, 63, 66, 3, 10, 3, 13],
+        [15, 66, 67, 3, 13, 3, 14],
+        [16, 62, 62, 3, 9, 3, 9],
+        [17, 55, 57, 3, 2, 3, 4],
+        [18, 91, 92, 4, 5, 4, 6],
+        [20, 96, 99, 4, 10, 4, 13],
+        [21, 99, 100, 4, 13, 4, 14],
+        [22, 95, 95, 4, 9, 4, 9],
+        [23, 88, 90, 4, 2, 4, 4],
+        [24, 119, 120, 5, 5, 5, 6],
+        [26, 124, 127, 5, 10, 5, 13],
+        [27, 127, 128, 5, 13, 5, 14],
+        [28, 123, 123, 5, 9, 5, 9],
+        [29, 116, 118, 5, 2, 5, 4],
+        [30, 149, 150, 6, 5, 6, 6],
+        [32, 154, 157, 6, 10, 6, 13],
+        [33, 157, 158, 6, 13, 6, 14],
+        [34, 153, 153, 6, 9, 6, 9],
+        [35, 146, 148, 6, 2, 6, 4],
+      ],
+      lineOffsets: [23, 53, 86, 114, 144, 177],
+      parsedExpr: {
+        expr: {
+          id: "23",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "1",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "3",
+                                      identExpr: { name: "pb2" },
+                                    },
+                                    field: "single_int64",
+                                    testOnly: true,
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "6",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                {
+                                  id: "10",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "8",
+                                      identExpr: { name: "pb2" },
+                                    },
+                                    field: "repeated_int32",
+                                    testOnly: true,
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "16",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "pb2" } },
+                              field: "map_string_string",
+                              testOnly: true,
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "35",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "29",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "18",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                {
+                                  id: "22",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "20",
+                                      identExpr: { name: "pb3" },
+                                    },
+                                    field: "single_int64",
+                                    testOnly: true,
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "24",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                {
+                                  id: "28",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "26",
+                                      identExpr: { name: "pb3" },
+                                    },
+                                    field: "repeated_int32",
+                                    testOnly: true,
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "34",
+                            selectExpr: {
+                              operand: { id: "32", identExpr: { name: "pb3" } },
+                              field: "map_string_string",
+                              testOnly: true,
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23, 53, 86, 114, 144, 177],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 8,
+            "5": 4,
+            "6": 28,
+            "8": 33,
+            "9": 36,
+            "10": 32,
+            "11": 25,
+            "12": 58,
+            "14": 63,
+            "15": 66,
+            "16": 62,
+            "17": 55,
+            "18": 91,
+            "20": 96,
+            "21": 99,
+            "22": 95,
+            "23": 88,
+            "24": 119,
+            "26": 124,
+            "27": 127,
+            "28": 123,
+            "29": 116,
+            "30": 149,
+            "32": 154,
+            "33": 157,
+            "34": 153,
+            "35": 146,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "pb2" } },
+                      field: "single_int64",
+                    },
+                  },
+                ],
+              },
+            },
+            "10": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "8", identExpr: { name: "pb2" } },
+                      field: "repeated_int32",
+                    },
+                  },
+                ],
+              },
+            },
+            "16": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "15",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "pb2" } },
+                      field: "map_string_string",
+                    },
+                  },
+                ],
+              },
+            },
+            "22": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "21",
+                    selectExpr: {
+                      operand: { id: "20", identExpr: { name: "pb3" } },
+                      field: "single_int64",
+                    },
+                  },
+                ],
+              },
+            },
+            "28": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "27",
+                    selectExpr: {
+                      operand: { id: "26", identExpr: { name: "pb3" } },
+                      field: "repeated_int32",
+                    },
+                  },
+                ],
+              },
+            },
+            "34": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "33",
+                    selectExpr: {
+                      operand: { id: "32", identExpr: { name: "pb3" } },
+                      field: "map_string_string",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: { id: "3", identExpr: { name: "pb2" } },
+                field: "single_int64",
+              },
+            },
+          ],
+        },
+        {
+          id: 10,
+          function: "has",
+          args: [
+            {
+              id: "9",
+              selectExpr: {
+                operand: { id: "8", identExpr: { name: "pb2" } },
+                field: "repeated_int32",
+              },
+            },
+          ],
+        },
+        {
+          id: 16,
+          function: "has",
+          args: [
+            {
+              id: "15",
+              selectExpr: {
+                operand: { id: "14", identExpr: { name: "pb2" } },
+                field: "map_string_string",
+              },
+            },
+          ],
+        },
+        {
+          id: 22,
+          function: "has",
+          args: [
+            {
+              id: "21",
+              selectExpr: {
+                operand: { id: "20", identExpr: { name: "pb3" } },
+                field: "single_int64",
+              },
+            },
+          ],
+        },
+        {
+          id: 28,
+          function: "has",
+          args: [
+            {
+              id: "27",
+              selectExpr: {
+                operand: { id: "26", identExpr: { name: "pb3" } },
+                field: "repeated_int32",
+              },
+            },
+          ],
+        },
+        {
+          id: 34,
+          function: "has",
+          args: [
+            {
+              id: "33",
+              selectExpr: {
+                operand: { id: "32", identExpr: { name: "pb3" } },
+                field: "map_string_string",
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      !_(\n        pb2~google.expr.proto2.test.TestAllTypes^pb2.single_int64~test-only~~bool\n      )~bool^logical_not,\n      !_(\n        pb2~google.expr.proto2.test.TestAllTypes^pb2.repeated_int32~test-only~~bool\n      )~bool^logical_not\n    )~bool^logical_and,\n    !_(\n      pb2~google.expr.proto2.test.TestAllTypes^pb2.map_string_string~test-only~~bool\n    )~bool^logical_not\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      !_(\n        pb3~google.expr.proto3.test.TestAllTypes^pb3.single_int64~test-only~~bool\n      )~bool^logical_not,\n      !_(\n        pb3~google.expr.proto3.test.TestAllTypes^pb3.repeated_int32~test-only~~bool\n      )~bool^logical_not\n    )~bool^logical_and,\n    !_(\n      pb3~google.expr.proto3.test.TestAllTypes^pb3.map_string_string~test-only~~bool\n    )~bool^logical_not\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { name: "pb2" },
+          "6": { overloadId: ["logical_not"] },
+          "8": { name: "pb2" },
+          "11": { overloadId: ["logical_and"] },
+          "12": { overloadId: ["logical_not"] },
+          "14": { name: "pb2" },
+          "17": { overloadId: ["logical_and"] },
+          "18": { overloadId: ["logical_not"] },
+          "20": { name: "pb3" },
+          "23": { overloadId: ["logical_and"] },
+          "24": { overloadId: ["logical_not"] },
+          "26": { name: "pb3" },
+          "29": { overloadId: ["logical_and"] },
+          "30": { overloadId: ["logical_not"] },
+          "32": { name: "pb3" },
+          "35": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "8": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "14": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "20": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "26": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "32": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23, 53, 86, 114, 144, 177],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 8,
+            "5": 4,
+            "6": 28,
+            "8": 33,
+            "9": 36,
+            "10": 32,
+            "11": 25,
+            "12": 58,
+            "14": 63,
+            "15": 66,
+            "16": 62,
+            "17": 55,
+            "18": 91,
+            "20": 96,
+            "21": 99,
+            "22": 95,
+            "23": 88,
+            "24": 119,
+            "26": 124,
+            "27": 127,
+            "28": 123,
+            "29": 116,
+            "30": 149,
+            "32": 154,
+            "33": 157,
+            "34": 153,
+            "35": 146,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "pb2" } },
+                      field: "single_int64",
+                    },
+                  },
+                ],
+              },
+            },
+            "10": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "8", identExpr: { name: "pb2" } },
+                      field: "repeated_int32",
+                    },
+                  },
+                ],
+              },
+            },
+            "16": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "15",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "pb2" } },
+                      field: "map_string_string",
+                    },
+                  },
+                ],
+              },
+            },
+            "22": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "21",
+                    selectExpr: {
+                      operand: { id: "20", identExpr: { name: "pb3" } },
+                      field: "single_int64",
+                    },
+                  },
+                ],
+              },
+            },
+            "28": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "27",
+                    selectExpr: {
+                      operand: { id: "26", identExpr: { name: "pb3" } },
+                      field: "repeated_int32",
+                    },
+                  },
+                ],
+              },
+            },
+            "34": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "33",
+                    selectExpr: {
+                      operand: { id: "32", identExpr: { name: "pb3" } },
+                      field: "map_string_string",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "23",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "1",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "3",
+                                      identExpr: { name: "pb2" },
+                                    },
+                                    field: "single_int64",
+                                    testOnly: true,
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "6",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                {
+                                  id: "10",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "8",
+                                      identExpr: { name: "pb2" },
+                                    },
+                                    field: "repeated_int32",
+                                    testOnly: true,
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "16",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "pb2" } },
+                              field: "map_string_string",
+                              testOnly: true,
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "35",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "29",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "18",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                {
+                                  id: "22",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "20",
+                                      identExpr: { name: "pb3" },
+                                    },
+                                    field: "single_int64",
+                                    testOnly: true,
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "24",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                {
+                                  id: "28",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "26",
+                                      identExpr: { name: "pb3" },
+                                    },
+                                    field: "repeated_int32",
+                                    testOnly: true,
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "34",
+                            selectExpr: {
+                              operand: { id: "32", identExpr: { name: "pb3" } },
+                              field: "map_string_string",
+                              testOnly: true,
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "3", max: "18" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): pb2" }] },
+      },
+      runtimeCost: "12",
+      expectedCheckedAst:
+        "\n\t\t_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t!_(\n\t\t\t\t  pb2~google.expr.proto2.test.TestAllTypes^pb2.single_int64~test-only~~bool\n\t\t\t\t)~bool^logical_not,\n\t\t\t\t!_(\n\t\t\t\t  pb2~google.expr.proto2.test.TestAllTypes^pb2.repeated_int32~test-only~~bool\n\t\t\t\t)~bool^logical_not\n\t\t\t  )~bool^logical_and,\n\t\t\t  !_(\n\t\t\t\tpb2~google.expr.proto2.test.TestAllTypes^pb2.map_string_string~test-only~~bool\n\t\t\t  )~bool^logical_not\n\t\t\t)~bool^logical_and,\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t!_(\n\t\t\t\t  pb3~google.expr.proto3.test.TestAllTypes^pb3.single_int64~test-only~~bool\n\t\t\t\t)~bool^logical_not,\n\t\t\t\t!_(\n\t\t\t\t  pb3~google.expr.proto3.test.TestAllTypes^pb3.repeated_int32~test-only~~bool\n\t\t\t\t)~bool^logical_not\n\t\t\t  )~bool^logical_and,\n\t\t\t  !_(\n\t\t\t\tpb3~google.expr.proto3.test.TestAllTypes^pb3.map_string_string~test-only~~bool\n\t\t\t  )~bool^logical_not\n\t\t\t)~bool^logical_and\n\t\t  )~bool^logical_and",
+      expectedType: "bool",
     },
     {
       original: {
         expr: "TestAllTypes{}.repeated_nested_message",
         container: "google.expr.proto2.test",
       },
+      crossTypeNumericComparisons: true,
       ast: "TestAllTypes{}^#*expr.Expr_StructExpr#.repeated_nested_message^#*expr.Expr_SelectExpr#",
+      unparsed: "TestAllTypes{}.repeated_nested_message",
+      locationAst: "TestAllTypes{}^#1[1,12]#.repeated_nested_message^#2[1,14]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", structExpr: { messageName: "TestAllTypes" } },
+            field: "repeated_nested_message",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 12, "2": 14 },
+        },
+      },
       checkedAst:
         "google.expr.proto2.test.TestAllTypes{}~google.expr.proto2.test.TestAllTypes^google.expr.proto2.test.TestAllTypes.repeated_nested_message~list(google.expr.proto2.test.TestAllTypes.NestedMessage)",
+      checkedExpr: {
+        referenceMap: { "1": { name: "google.expr.proto2.test.TestAllTypes" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "2": {
+            listType: {
+              elemType: {
+                messageType:
+                  "google.expr.proto2.test.TestAllTypes.NestedMessage",
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 12, "2": 14 },
+        },
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                messageName: "google.expr.proto2.test.TestAllTypes",
+              },
+            },
+            field: "repeated_nested_message",
+          },
+        },
+      },
       type: "list(google.expr.proto2.test.TestAllTypes.NestedMessage)",
+      cost: { min: "41", max: "41" },
+      result: { value: { listValue: {} } },
+      runtimeCost: "42",
+      expectedCheckedAst:
+        "\n\t\tgoogle.expr.proto2.test.TestAllTypes{}~google.expr.proto2.test.TestAllTypes^\n\t\tgoogle.expr.proto2.test.TestAllTypes.repeated_nested_message\n\t\t~list(google.expr.proto2.test.TestAllTypes.NestedMessage)",
+      expectedType: "list(google.expr.proto2.test.TestAllTypes.NestedMessage)",
     },
     {
       original: {
         expr: "TestAllTypes{}.repeated_nested_message",
         container: "google.expr.proto3.test",
       },
+      crossTypeNumericComparisons: true,
       ast: "TestAllTypes{}^#*expr.Expr_StructExpr#.repeated_nested_message^#*expr.Expr_SelectExpr#",
+      unparsed: "TestAllTypes{}.repeated_nested_message",
+      locationAst: "TestAllTypes{}^#1[1,12]#.repeated_nested_message^#2[1,14]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", structExpr: { messageName: "TestAllTypes" } },
+            field: "repeated_nested_message",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 12, "2": 14 },
+        },
+      },
       checkedAst:
         "google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes.repeated_nested_message~list(google.expr.proto3.test.TestAllTypes.NestedMessage)",
+      checkedExpr: {
+        referenceMap: { "1": { name: "google.expr.proto3.test.TestAllTypes" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": {
+            listType: {
+              elemType: {
+                messageType:
+                  "google.expr.proto3.test.TestAllTypes.NestedMessage",
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 12, "2": 14 },
+        },
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                messageName: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+            field: "repeated_nested_message",
+          },
+        },
+      },
       type: "list(google.expr.proto3.test.TestAllTypes.NestedMessage)",
+      cost: { min: "41", max: "41" },
+      result: { value: { listValue: {} } },
+      runtimeCost: "42",
+      expectedCheckedAst:
+        "\n\t\tgoogle.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^\n\t\tgoogle.expr.proto3.test.TestAllTypes.repeated_nested_message\n\t\t~list(google.expr.proto3.test.TestAllTypes.NestedMessage)",
+      expectedType: "list(google.expr.proto3.test.TestAllTypes.NestedMessage)",
     },
     {
       original: {
@@ -1120,10 +14863,62 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: 'base64^#*expr.Expr_IdentExpr#.encode(\n  "hello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'base64.encode("hello")',
+      locationAst: 'base64^#1[1,0]#.encode(\n  "hello"^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "base64" } },
+            function: "encode",
+            args: [{ id: "3", constExpr: { stringValue: "hello" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
       checkedAst:
         'base64.encode(\n  "hello"~string\n)~string^base64_encode_string',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["base64_encode_string"] } },
+        typeMap: { "2": { primitive: "STRING" }, "3": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "base64.encode",
+            args: [{ id: "3", constExpr: { stringValue: "hello" } }],
+          },
+        },
+      },
       type: "string",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: base64.encode(string)" },
+          ],
+        },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        '\n\t\tbase64.encode(\n\t\t\t"hello"~string\n\t\t)~string^base64_encode_string',
+      expectedType: "string",
     },
     {
       original: {
@@ -1144,16 +14939,95 @@ export const tests: SerializedIncrementalTestSuite = {
         ],
         container: "base64",
       },
+      crossTypeNumericComparisons: true,
       ast: 'encode(\n  "hello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'encode("hello")',
+      locationAst: 'encode(\n  "hello"^#2[1,7]#\n)^#1[1,6]#',
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 14, 1, 7, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "encode",
+            args: [{ id: "2", constExpr: { stringValue: "hello" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 6, "2": 7 },
+        },
+      },
       checkedAst:
         'base64.encode(\n  "hello"~string\n)~string^base64_encode_string',
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["base64_encode_string"] } },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 6, "2": 7 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "base64.encode",
+            args: [{ id: "2", constExpr: { stringValue: "hello" } }],
+          },
+        },
+      },
       type: "string",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: base64.encode(string)" },
+          ],
+        },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        '\n\t\tbase64.encode(\n\t\t\t"hello"~string\n\t\t)~string^base64_encode_string',
+      expectedType: "string",
     },
     {
       original: { expr: "{}" },
+      crossTypeNumericComparisons: true,
       ast: "{}^#*expr.Expr_StructExpr#",
+      unparsed: "{}",
+      locationAst: "{}^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", structExpr: {} },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "{}~map(dyn, dyn)",
+      checkedExpr: {
+        typeMap: {
+          "1": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", structExpr: {} },
+      },
       type: "map(dyn, dyn)",
+      cost: { min: "30", max: "30" },
+      result: { value: { mapValue: {} } },
+      runtimeCost: "30",
+      expectedCheckedAst: "{}~map(dyn, dyn)",
+      expectedType: "map(dyn, dyn)",
     },
     {
       original: {
@@ -1178,10 +15052,93 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "set(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "set([1, 2, 3])",
+      locationAst:
+        "set(\n  [\n    1^#3[1,5]#,\n    2^#4[1,8]#,\n    3^#5[1,11]#\n  ]^#2[1,4]#\n)^#1[1,3]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "set",
+            args: [
+              {
+                id: "2",
+                listExpr: {
+                  elements: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 3, "2": 4, "3": 5, "4": 8, "5": 11 },
+        },
+      },
       checkedAst:
         "set(\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n)~set(int)^set_list",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["set_list"] } },
+        typeMap: {
+          "1": {
+            abstractType: {
+              name: "set",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 3, "2": 4, "3": 5, "4": 8, "5": 11 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "set",
+            args: [
+              {
+                id: "2",
+                listExpr: {
+                  elements: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "set(int)",
+      cost: { min: "11", max: "11" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such overload: set" }] },
+      },
+      runtimeCost: "11",
+      expectedCheckedAst:
+        "\n\t\tset(\n\t\t  [\n\t\t    1~int,\n\t\t    2~int,\n\t\t    3~int\n\t\t  ]~list(int)\n\t\t)~set(int)^set_list",
+      expectedType: "set(int)",
     },
     {
       original: {
@@ -1206,10 +15163,178 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  set(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  set(\n    [\n      2^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "set([1, 2]) == set([2, 1])",
+      locationAst:
+        "_==_(\n  set(\n    [\n      1^#3[1,5]#,\n      2^#4[1,8]#\n    ]^#2[1,4]#\n  )^#1[1,3]#,\n  set(\n    [\n      2^#8[1,20]#,\n      1^#9[1,23]#\n    ]^#7[1,19]#\n  )^#6[1,18]#\n)^#5[1,12]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 12, 14, 1, 12, 1, 14],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 19, 20, 1, 19, 1, 20],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "set",
+                  args: [
+                    {
+                      id: "2",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "set",
+                  args: [
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "8", constExpr: { int64Value: "2" } },
+                          { id: "9", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 18,
+            "7": 19,
+            "8": 20,
+            "9": 23,
+          },
+        },
+      },
       checkedAst:
         "_==_(\n  set(\n    [\n      1~int,\n      2~int\n    ]~list(int)\n  )~set(int)^set_list,\n  set(\n    [\n      2~int,\n      1~int\n    ]~list(int)\n  )~set(int)^set_list\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["set_list"] },
+          "5": { overloadId: ["equals"] },
+          "6": { overloadId: ["set_list"] },
+        },
+        typeMap: {
+          "1": {
+            abstractType: {
+              name: "set",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": {
+            abstractType: {
+              name: "set",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 18,
+            "7": 19,
+            "8": 20,
+            "9": 23,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "set",
+                  args: [
+                    {
+                      id: "2",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "set",
+                  args: [
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "8", constExpr: { int64Value: "2" } },
+                          { id: "9", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "23", max: "1844674407370955286" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such overload: set" }] },
+      },
+      runtimeCost: "23",
+      expectedCheckedAst:
+        "\n\t\t_==_(\n\t\t  set([1~int, 2~int]~list(int))~set(int)^set_list,\n\t\t  set([2~int, 1~int]~list(int))~set(int)^set_list\n\t\t)~bool^equals",
+      expectedType: "bool",
     },
     {
       original: {
@@ -1245,95 +15370,2697 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  set(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "set([1, 2]) == x",
+      locationAst:
+        "_==_(\n  set(\n    [\n      1^#3[1,5]#,\n      2^#4[1,8]#\n    ]^#2[1,4]#\n  )^#1[1,3]#,\n  x^#6[1,15]#\n)^#5[1,12]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 12, 14, 1, 12, 1, 14],
+        [6, 15, 16, 1, 15, 1, 16],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "set",
+                  args: [
+                    {
+                      id: "2",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 3, "2": 4, "3": 5, "4": 8, "5": 12, "6": 15 },
+        },
+      },
       checkedAst:
         "_==_(\n  set(\n    [\n      1~int,\n      2~int\n    ]~list(int)\n  )~set(int)^set_list,\n  x~set(int)^x\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["set_list"] },
+          "5": { overloadId: ["equals"] },
+          "6": { name: "x" },
+        },
+        typeMap: {
+          "1": {
+            abstractType: {
+              name: "set",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": {
+            abstractType: {
+              name: "set",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 3, "2": 4, "3": 5, "4": 8, "5": 12, "6": 15 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "set",
+                  args: [
+                    {
+                      id: "2",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "13", max: "1844674407370955276" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such overload: set" }] },
+      },
+      runtimeCost: "13",
+      expectedCheckedAst:
+        "\n\t\t_==_(\n\t\t  set([1~int, 2~int]~list(int))~set(int)^set_list,\n\t\t  x~set(int)^x\n\t\t)~bool^equals",
+      expectedType: "bool",
     },
     {
       original: { expr: "int{}" },
+      crossTypeNumericComparisons: true,
       ast: "int{}^#*expr.Expr_StructExpr#",
+      unparsed: "int{}",
+      locationAst: "int{}^#1[1,3]#",
+      positions: [[1, 3, 4, 1, 3, 1, 4]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", structExpr: { messageName: "int" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 3 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:4: 'int' is not a message type\n | int{}\n | ...^",
+      errors: [
+        {
+          category: "other",
+          message: "'int' is not a message type",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:4: 'int' is not a message type\n\t\t | int{}\n\t\t | ...^\n\t\t",
     },
     {
       original: { expr: "Msg{}" },
+      crossTypeNumericComparisons: true,
       ast: "Msg{}^#*expr.Expr_StructExpr#",
+      unparsed: "Msg{}",
+      locationAst: "Msg{}^#1[1,3]#",
+      positions: [[1, 3, 4, 1, 3, 1, 4]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", structExpr: { messageName: "Msg" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 3 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'Msg' (in container '')\n | Msg{}\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'Msg' (in container '')",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:4: undeclared reference to 'Msg' (in container '')\n\t\t | Msg{}\n\t\t | ...^\n\t\t",
     },
     {
       original: { expr: "fun()" },
+      crossTypeNumericComparisons: true,
       ast: "fun()^#*expr.Expr_CallExpr#",
+      unparsed: "fun()",
+      locationAst: "fun()^#1[1,3]#",
+      positions: [[1, 3, 4, 1, 3, 1, 4]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", callExpr: { function: "fun" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 3 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'fun' (in container '')\n | fun()\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'fun' (in container '')",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:4: undeclared reference to 'fun' (in container '')\n\t\t | fun()\n\t\t | ...^\n\t\t",
     },
     {
       original: { expr: "'string'.fun()" },
+      crossTypeNumericComparisons: true,
       ast: '"string"^#*expr.Constant_StringValue#.fun()^#*expr.Expr_CallExpr#',
+      unparsed: '"string".fun()',
+      locationAst: '"string"^#1[1,0]#.fun()^#2[1,12]#',
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "string" } },
+            function: "fun",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 12 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:13: undeclared reference to 'fun' (in container '')\n | 'string'.fun()\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'fun' (in container '')",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:13: undeclared reference to 'fun' (in container '')\n\t\t | 'string'.fun()\n\t\t | ............^\n\t\t",
     },
     {
       original: { expr: "[].length" },
+      crossTypeNumericComparisons: true,
       ast: "[]^#*expr.Expr_ListExpr#.length^#*expr.Expr_SelectExpr#",
+      unparsed: "[].length",
+      locationAst: "[]^#1[1,0]#.length^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: { operand: { id: "1", listExpr: {} }, field: "length" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:3: type 'list(_var0)' does not support field selection\n | [].length\n | ..^",
+      errors: [
+        {
+          category: "other",
+          message: "type 'list(_var0)' does not support field selection",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:3: type 'list(_var0)' does not support field selection\n\t\t | [].length\n\t\t | ..^\n\t\t",
     },
     {
       original: {
         expr: "1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1",
       },
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1^#*expr.Constant_Int64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#,\n      _\u003c=_(\n        1u^#*expr.Constant_Uint64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003c=_(\n      1^#*expr.Constant_DoubleValue#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1^#*expr.Constant_DoubleValue#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#,\n      _\u003c=_(\n        1^#*expr.Constant_Int64Value#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003c=_(\n      1u^#*expr.Constant_Uint64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1^#1[1,0]#,\n        1^#3[1,5]#\n      )^#2[1,2]#,\n      _\u003c=_(\n        1u^#4[1,12]#,\n        1^#6[1,18]#\n      )^#5[1,15]#\n    )^#7[1,9]#,\n    _\u003c=_(\n      1^#8[1,25]#,\n      1^#10[1,32]#\n    )^#9[1,29]#\n  )^#11[1,22]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1^#12[1,37]#,\n        1u^#14[1,44]#\n      )^#13[1,41]#,\n      _\u003c=_(\n        1^#16[1,50]#,\n        1u^#18[1,55]#\n      )^#17[1,52]#\n    )^#19[1,47]#,\n    _\u003c=_(\n      1u^#20[1,61]#,\n      1^#22[1,67]#\n    )^#21[1,64]#\n  )^#23[1,58]#\n)^#15[1,34]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 8, 1, 5, 1, 8],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 15, 17, 1, 15, 1, 17],
+        [6, 18, 21, 1, 18, 1, 21],
+        [7, 9, 11, 1, 9, 1, 11],
+        [8, 25, 28, 1, 25, 1, 28],
+        [9, 29, 31, 1, 29, 1, 31],
+        [10, 32, 33, 1, 32, 1, 33],
+        [11, 22, 24, 1, 22, 1, 24],
+        [12, 37, 40, 1, 37, 1, 40],
+        [13, 41, 43, 1, 41, 1, 43],
+        [14, 44, 46, 1, 44, 1, 46],
+        [15, 34, 36, 1, 34, 1, 36],
+        [16, 50, 51, 1, 50, 1, 51],
+        [17, 52, 54, 1, 52, 1, 54],
+        [18, 55, 57, 1, 55, 1, 57],
+        [19, 47, 49, 1, 47, 1, 49],
+        [20, 61, 63, 1, 61, 1, 63],
+        [21, 64, 66, 1, 64, 1, 66],
+        [22, 67, 68, 1, 67, 1, 68],
+        [23, 58, 60, 1, 58, 1, 60],
+      ],
+      lineOffsets: [69],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 12,
+            "5": 15,
+            "6": 18,
+            "7": 9,
+            "8": 25,
+            "9": 29,
+            "10": 32,
+            "11": 22,
+            "12": 37,
+            "13": 41,
+            "14": 44,
+            "15": 34,
+            "16": 50,
+            "17": 52,
+            "18": 55,
+            "19": 47,
+            "20": 61,
+            "21": 64,
+            "22": 67,
+            "23": 58,
+          },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:3: found no matching overload for '_\u003c=_' applied to '(int, double)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | ..^\nERROR: \u003cinput\u003e:1:16: found no matching overload for '_\u003c=_' applied to '(uint, double)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | ...............^\nERROR: \u003cinput\u003e:1:30: found no matching overload for '_\u003c=_' applied to '(double, int)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | .............................^\nERROR: \u003cinput\u003e:1:42: found no matching overload for '_\u003c=_' applied to '(double, uint)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | .........................................^\nERROR: \u003cinput\u003e:1:53: found no matching overload for '_\u003c=_' applied to '(int, uint)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | ....................................................^\nERROR: \u003cinput\u003e:1:65: found no matching overload for '_\u003c=_' applied to '(uint, int)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | ................................................................^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_\u003c=_' applied to '(int, double)'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_\u003c=_' applied to '(uint, double)'",
+          id: 5,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_\u003c=_' applied to '(double, int)'",
+          id: 9,
+          offset: 29,
+          line: 1,
+          column: 29,
+        },
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_\u003c=_' applied to '(double, uint)'",
+          id: 13,
+          offset: 41,
+          line: 1,
+          column: 41,
+        },
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_\u003c=_' applied to '(int, uint)'",
+          id: 17,
+          offset: 52,
+          line: 1,
+          column: 52,
+        },
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_\u003c=_' applied to '(uint, int)'",
+          id: 21,
+          offset: 64,
+          line: 1,
+          column: 64,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:3: found no matching overload for '_\u003c=_' applied to '(int, double)'\n\t\t | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n\t\t | ..^\n\t\tERROR: \u003cinput\u003e:1:16: found no matching overload for '_\u003c=_' applied to '(uint, double)'\n\t\t | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n\t\t | ...............^\n\t\tERROR: \u003cinput\u003e:1:30: found no matching overload for '_\u003c=_' applied to '(double, int)'\n\t\t | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n\t\t | .............................^\n\t\tERROR: \u003cinput\u003e:1:42: found no matching overload for '_\u003c=_' applied to '(double, uint)'\n\t\t | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n\t\t | .........................................^\n\t\tERROR: \u003cinput\u003e:1:53: found no matching overload for '_\u003c=_' applied to '(int, uint)'\n\t\t | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n\t\t | ....................................................^\n\t\tERROR: \u003cinput\u003e:1:65: found no matching overload for '_\u003c=_' applied to '(uint, int)'\n\t\t | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n\t\t | ................................................................^\n\t\t",
     },
     {
       original: {
         expr: "1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1",
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1^#*expr.Constant_Int64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#,\n      _\u003c=_(\n        1u^#*expr.Constant_Uint64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003c=_(\n      1^#*expr.Constant_DoubleValue#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1^#*expr.Constant_DoubleValue#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#,\n      _\u003c=_(\n        1^#*expr.Constant_Int64Value#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003c=_(\n      1u^#*expr.Constant_Uint64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
-      error:
-        "ERROR: \u003cinput\u003e:1:3: found no matching overload for '_\u003c=_' applied to '(int, double)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | ..^\nERROR: \u003cinput\u003e:1:16: found no matching overload for '_\u003c=_' applied to '(uint, double)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | ...............^\nERROR: \u003cinput\u003e:1:30: found no matching overload for '_\u003c=_' applied to '(double, int)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | .............................^\nERROR: \u003cinput\u003e:1:42: found no matching overload for '_\u003c=_' applied to '(double, uint)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | .........................................^\nERROR: \u003cinput\u003e:1:53: found no matching overload for '_\u003c=_' applied to '(int, uint)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | ....................................................^\nERROR: \u003cinput\u003e:1:65: found no matching overload for '_\u003c=_' applied to '(uint, int)'\n | 1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1\n | ................................................................^",
+      unparsed:
+        "1 \u003c= 1.0 \u0026\u0026 1u \u003c= 1.0 \u0026\u0026 1.0 \u003c= 1 \u0026\u0026 1.0 \u003c= 1u \u0026\u0026 1 \u003c= 1u \u0026\u0026 1u \u003c= 1",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1^#1[1,0]#,\n        1^#3[1,5]#\n      )^#2[1,2]#,\n      _\u003c=_(\n        1u^#4[1,12]#,\n        1^#6[1,18]#\n      )^#5[1,15]#\n    )^#7[1,9]#,\n    _\u003c=_(\n      1^#8[1,25]#,\n      1^#10[1,32]#\n    )^#9[1,29]#\n  )^#11[1,22]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1^#12[1,37]#,\n        1u^#14[1,44]#\n      )^#13[1,41]#,\n      _\u003c=_(\n        1^#16[1,50]#,\n        1u^#18[1,55]#\n      )^#17[1,52]#\n    )^#19[1,47]#,\n    _\u003c=_(\n      1u^#20[1,61]#,\n      1^#22[1,67]#\n    )^#21[1,64]#\n  )^#23[1,58]#\n)^#15[1,34]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 8, 1, 5, 1, 8],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 15, 17, 1, 15, 1, 17],
+        [6, 18, 21, 1, 18, 1, 21],
+        [7, 9, 11, 1, 9, 1, 11],
+        [8, 25, 28, 1, 25, 1, 28],
+        [9, 29, 31, 1, 29, 1, 31],
+        [10, 32, 33, 1, 32, 1, 33],
+        [11, 22, 24, 1, 22, 1, 24],
+        [12, 37, 40, 1, 37, 1, 40],
+        [13, 41, 43, 1, 41, 1, 43],
+        [14, 44, 46, 1, 44, 1, 46],
+        [15, 34, 36, 1, 34, 1, 36],
+        [16, 50, 51, 1, 50, 1, 51],
+        [17, 52, 54, 1, 52, 1, 54],
+        [18, 55, 57, 1, 55, 1, 57],
+        [19, 47, 49, 1, 47, 1, 49],
+        [20, 61, 63, 1, 61, 1, 63],
+        [21, 64, 66, 1, 64, 1, 66],
+        [22, 67, 68, 1, 67, 1, 68],
+        [23, 58, 60, 1, 58, 1, 60],
+      ],
+      lineOffsets: [69],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 12,
+            "5": 15,
+            "6": 18,
+            "7": 9,
+            "8": 25,
+            "9": 29,
+            "10": 32,
+            "11": 22,
+            "12": 37,
+            "13": 41,
+            "14": 44,
+            "15": 34,
+            "16": 50,
+            "17": 52,
+            "18": 55,
+            "19": 47,
+            "20": 61,
+            "21": 64,
+            "22": 67,
+            "23": 58,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1~int,\n        1~double\n      )~bool^less_equals_int64_double,\n      _\u003c=_(\n        1u~uint,\n        1~double\n      )~bool^less_equals_uint64_double\n    )~bool^logical_and,\n    _\u003c=_(\n      1~double,\n      1~int\n    )~bool^less_equals_double_int64\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c=_(\n        1~double,\n        1u~uint\n      )~bool^less_equals_double_uint64,\n      _\u003c=_(\n        1~int,\n        1u~uint\n      )~bool^less_equals_int64_uint64\n    )~bool^logical_and,\n    _\u003c=_(\n      1u~uint,\n      1~int\n    )~bool^less_equals_uint64_int64\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["less_equals_int64_double"] },
+          "5": { overloadId: ["less_equals_uint64_double"] },
+          "7": { overloadId: ["logical_and"] },
+          "9": { overloadId: ["less_equals_double_int64"] },
+          "11": { overloadId: ["logical_and"] },
+          "13": { overloadId: ["less_equals_double_uint64"] },
+          "15": { overloadId: ["logical_and"] },
+          "17": { overloadId: ["less_equals_int64_uint64"] },
+          "19": { overloadId: ["logical_and"] },
+          "21": { overloadId: ["less_equals_uint64_int64"] },
+          "23": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "DOUBLE" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "UINT64" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "UINT64" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "UINT64" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 12,
+            "5": 15,
+            "6": 18,
+            "7": 9,
+            "8": 25,
+            "9": 29,
+            "10": 32,
+            "11": 22,
+            "12": 37,
+            "13": 41,
+            "14": 44,
+            "15": 34,
+            "16": 50,
+            "17": 52,
+            "18": 55,
+            "19": 47,
+            "20": 61,
+            "21": 64,
+            "22": 67,
+            "23": 58,
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "6" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "6",
+      expectedCheckedAst:
+        "\n\t\t_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t_\u003c=_(\n\t\t\t\t  1~int,\n\t\t\t\t  1~double\n\t\t\t\t)~bool^less_equals_int64_double,\n\t\t\t\t_\u003c=_(\n\t\t\t\t  1u~uint,\n\t\t\t\t  1~double\n\t\t\t\t)~bool^less_equals_uint64_double\n\t\t\t  )~bool^logical_and,\n\t\t\t  _\u003c=_(\n\t\t\t\t1~double,\n\t\t\t\t1~int\n\t\t\t  )~bool^less_equals_double_int64\n\t\t\t)~bool^logical_and,\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t_\u003c=_(\n\t\t\t\t  1~double,\n\t\t\t\t  1u~uint\n\t\t\t\t)~bool^less_equals_double_uint64,\n\t\t\t\t_\u003c=_(\n\t\t\t\t  1~int,\n\t\t\t\t  1u~uint\n\t\t\t\t)~bool^less_equals_int64_uint64\n\t\t\t  )~bool^logical_and,\n\t\t\t  _\u003c=_(\n\t\t\t\t1u~uint,\n\t\t\t\t1~int\n\t\t\t  )~bool^less_equals_uint64_int64\n\t\t\t)~bool^logical_and\n\t\t  )~bool^logical_and",
+      expectedType: "bool",
     },
     {
       original: {
         expr: "1 \u003c 1.0 \u0026\u0026 1u \u003c 1.0 \u0026\u0026 1.0 \u003c 1 \u0026\u0026 1.0 \u003c 1u \u0026\u0026 1 \u003c 1u \u0026\u0026 1u \u003c 1",
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c_(\n        1^#*expr.Constant_Int64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#,\n      _\u003c_(\n        1u^#*expr.Constant_Uint64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003c_(\n      1^#*expr.Constant_DoubleValue#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c_(\n        1^#*expr.Constant_DoubleValue#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#,\n      _\u003c_(\n        1^#*expr.Constant_Int64Value#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003c_(\n      1u^#*expr.Constant_Uint64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
-      error:
-        "ERROR: \u003cinput\u003e:1:3: found no matching overload for '_\u003c_' applied to '(int, double)'\n | 1 \u003c 1.0 \u0026\u0026 1u \u003c 1.0 \u0026\u0026 1.0 \u003c 1 \u0026\u0026 1.0 \u003c 1u \u0026\u0026 1 \u003c 1u \u0026\u0026 1u \u003c 1\n | ..^\nERROR: \u003cinput\u003e:1:15: found no matching overload for '_\u003c_' applied to '(uint, double)'\n | 1 \u003c 1.0 \u0026\u0026 1u \u003c 1.0 \u0026\u0026 1.0 \u003c 1 \u0026\u0026 1.0 \u003c 1u \u0026\u0026 1 \u003c 1u \u0026\u0026 1u \u003c 1\n | ..............^\nERROR: \u003cinput\u003e:1:28: found no matching overload for '_\u003c_' applied to '(double, int)'\n | 1 \u003c 1.0 \u0026\u0026 1u \u003c 1.0 \u0026\u0026 1.0 \u003c 1 \u0026\u0026 1.0 \u003c 1u \u0026\u0026 1 \u003c 1u \u0026\u0026 1u \u003c 1\n | ...........................^\nERROR: \u003cinput\u003e:1:39: found no matching overload for '_\u003c_' applied to '(double, uint)'\n | 1 \u003c 1.0 \u0026\u0026 1u \u003c 1.0 \u0026\u0026 1.0 \u003c 1 \u0026\u0026 1.0 \u003c 1u \u0026\u0026 1 \u003c 1u \u0026\u0026 1u \u003c 1\n | ......................................^\nERROR: \u003cinput\u003e:1:49: found no matching overload for '_\u003c_' applied to '(int, uint)'\n | 1 \u003c 1.0 \u0026\u0026 1u \u003c 1.0 \u0026\u0026 1.0 \u003c 1 \u0026\u0026 1.0 \u003c 1u \u0026\u0026 1 \u003c 1u \u0026\u0026 1u \u003c 1\n | ................................................^\nERROR: \u003cinput\u003e:1:60: found no matching overload for '_\u003c_' applied to '(uint, int)'\n | 1 \u003c 1.0 \u0026\u0026 1u \u003c 1.0 \u0026\u0026 1.0 \u003c 1 \u0026\u0026 1.0 \u003c 1u \u0026\u0026 1 \u003c 1u \u0026\u0026 1u \u003c 1\n | ...........................................................^",
+      unparsed:
+        "1 \u003c 1.0 \u0026\u0026 1u \u003c 1.0 \u0026\u0026 1.0 \u003c 1 \u0026\u0026 1.0 \u003c 1u \u0026\u0026 1 \u003c 1u \u0026\u0026 1u \u003c 1",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c_(\n        1^#1[1,0]#,\n        1^#3[1,4]#\n      )^#2[1,2]#,\n      _\u003c_(\n        1u^#4[1,11]#,\n        1^#6[1,16]#\n      )^#5[1,14]#\n    )^#7[1,8]#,\n    _\u003c_(\n      1^#8[1,23]#,\n      1^#10[1,29]#\n    )^#9[1,27]#\n  )^#11[1,20]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c_(\n        1^#12[1,34]#,\n        1u^#14[1,40]#\n      )^#13[1,38]#,\n      _\u003c_(\n        1^#16[1,46]#,\n        1u^#18[1,50]#\n      )^#17[1,48]#\n    )^#19[1,43]#,\n    _\u003c_(\n      1u^#20[1,56]#,\n      1^#22[1,61]#\n    )^#21[1,59]#\n  )^#23[1,53]#\n)^#15[1,31]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 7, 1, 4, 1, 7],
+        [4, 11, 13, 1, 11, 1, 13],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 16, 19, 1, 16, 1, 19],
+        [7, 8, 10, 1, 8, 1, 10],
+        [8, 23, 26, 1, 23, 1, 26],
+        [9, 27, 28, 1, 27, 1, 28],
+        [10, 29, 30, 1, 29, 1, 30],
+        [11, 20, 22, 1, 20, 1, 22],
+        [12, 34, 37, 1, 34, 1, 37],
+        [13, 38, 39, 1, 38, 1, 39],
+        [14, 40, 42, 1, 40, 1, 42],
+        [15, 31, 33, 1, 31, 1, 33],
+        [16, 46, 47, 1, 46, 1, 47],
+        [17, 48, 49, 1, 48, 1, 49],
+        [18, 50, 52, 1, 50, 1, 52],
+        [19, 43, 45, 1, 43, 1, 45],
+        [20, 56, 58, 1, 56, 1, 58],
+        [21, 59, 60, 1, 59, 1, 60],
+        [22, 61, 62, 1, 61, 1, 62],
+        [23, 53, 55, 1, 53, 1, 55],
+      ],
+      lineOffsets: [63],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 11,
+            "5": 14,
+            "6": 16,
+            "7": 8,
+            "8": 23,
+            "9": 27,
+            "10": 29,
+            "11": 20,
+            "12": 34,
+            "13": 38,
+            "14": 40,
+            "15": 31,
+            "16": 46,
+            "17": 48,
+            "18": 50,
+            "19": 43,
+            "20": 56,
+            "21": 59,
+            "22": 61,
+            "23": 53,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c_(\n        1~int,\n        1~double\n      )~bool^less_int64_double,\n      _\u003c_(\n        1u~uint,\n        1~double\n      )~bool^less_uint64_double\n    )~bool^logical_and,\n    _\u003c_(\n      1~double,\n      1~int\n    )~bool^less_double_int64\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003c_(\n        1~double,\n        1u~uint\n      )~bool^less_double_uint64,\n      _\u003c_(\n        1~int,\n        1u~uint\n      )~bool^less_int64_uint64\n    )~bool^logical_and,\n    _\u003c_(\n      1u~uint,\n      1~int\n    )~bool^less_uint64_int64\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["less_int64_double"] },
+          "5": { overloadId: ["less_uint64_double"] },
+          "7": { overloadId: ["logical_and"] },
+          "9": { overloadId: ["less_double_int64"] },
+          "11": { overloadId: ["logical_and"] },
+          "13": { overloadId: ["less_double_uint64"] },
+          "15": { overloadId: ["logical_and"] },
+          "17": { overloadId: ["less_int64_uint64"] },
+          "19": { overloadId: ["logical_and"] },
+          "21": { overloadId: ["less_uint64_int64"] },
+          "23": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "DOUBLE" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "UINT64" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "UINT64" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "UINT64" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 11,
+            "5": 14,
+            "6": 16,
+            "7": 8,
+            "8": 23,
+            "9": 27,
+            "10": 29,
+            "11": 20,
+            "12": 34,
+            "13": 38,
+            "14": 40,
+            "15": 31,
+            "16": 46,
+            "17": 48,
+            "18": 50,
+            "19": 43,
+            "20": 56,
+            "21": 59,
+            "22": 61,
+            "23": 53,
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "6" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        "\n\t\t_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t_\u003c_(\n\t\t\t\t  1~int,\n\t\t\t\t  1~double\n\t\t\t\t)~bool^less_int64_double,\n\t\t\t\t_\u003c_(\n\t\t\t\t  1u~uint,\n\t\t\t\t  1~double\n\t\t\t\t)~bool^less_uint64_double\n\t\t\t  )~bool^logical_and,\n\t\t\t  _\u003c_(\n\t\t\t\t1~double,\n\t\t\t\t1~int\n\t\t\t  )~bool^less_double_int64\n\t\t\t)~bool^logical_and,\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t_\u003c_(\n\t\t\t\t  1~double,\n\t\t\t\t  1u~uint\n\t\t\t\t)~bool^less_double_uint64,\n\t\t\t\t_\u003c_(\n\t\t\t\t  1~int,\n\t\t\t\t  1u~uint\n\t\t\t\t)~bool^less_int64_uint64\n\t\t\t  )~bool^logical_and,\n\t\t\t  _\u003c_(\n\t\t\t\t1u~uint,\n\t\t\t\t1~int\n\t\t\t  )~bool^less_uint64_int64\n\t\t\t)~bool^logical_and\n\t\t  )~bool^logical_and",
+      expectedType: "bool",
     },
     {
       original: {
         expr: "1 \u003e 1.0 \u0026\u0026 1u \u003e 1.0 \u0026\u0026 1.0 \u003e 1 \u0026\u0026 1.0 \u003e 1u \u0026\u0026 1 \u003e 1u \u0026\u0026 1u \u003e 1",
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e_(\n        1^#*expr.Constant_Int64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#,\n      _\u003e_(\n        1u^#*expr.Constant_Uint64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e_(\n      1^#*expr.Constant_DoubleValue#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e_(\n        1^#*expr.Constant_DoubleValue#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#,\n      _\u003e_(\n        1^#*expr.Constant_Int64Value#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e_(\n      1u^#*expr.Constant_Uint64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
-      error:
-        "ERROR: \u003cinput\u003e:1:3: found no matching overload for '_\u003e_' applied to '(int, double)'\n | 1 \u003e 1.0 \u0026\u0026 1u \u003e 1.0 \u0026\u0026 1.0 \u003e 1 \u0026\u0026 1.0 \u003e 1u \u0026\u0026 1 \u003e 1u \u0026\u0026 1u \u003e 1\n | ..^\nERROR: \u003cinput\u003e:1:15: found no matching overload for '_\u003e_' applied to '(uint, double)'\n | 1 \u003e 1.0 \u0026\u0026 1u \u003e 1.0 \u0026\u0026 1.0 \u003e 1 \u0026\u0026 1.0 \u003e 1u \u0026\u0026 1 \u003e 1u \u0026\u0026 1u \u003e 1\n | ..............^\nERROR: \u003cinput\u003e:1:28: found no matching overload for '_\u003e_' applied to '(double, int)'\n | 1 \u003e 1.0 \u0026\u0026 1u \u003e 1.0 \u0026\u0026 1.0 \u003e 1 \u0026\u0026 1.0 \u003e 1u \u0026\u0026 1 \u003e 1u \u0026\u0026 1u \u003e 1\n | ...........................^\nERROR: \u003cinput\u003e:1:39: found no matching overload for '_\u003e_' applied to '(double, uint)'\n | 1 \u003e 1.0 \u0026\u0026 1u \u003e 1.0 \u0026\u0026 1.0 \u003e 1 \u0026\u0026 1.0 \u003e 1u \u0026\u0026 1 \u003e 1u \u0026\u0026 1u \u003e 1\n | ......................................^\nERROR: \u003cinput\u003e:1:49: found no matching overload for '_\u003e_' applied to '(int, uint)'\n | 1 \u003e 1.0 \u0026\u0026 1u \u003e 1.0 \u0026\u0026 1.0 \u003e 1 \u0026\u0026 1.0 \u003e 1u \u0026\u0026 1 \u003e 1u \u0026\u0026 1u \u003e 1\n | ................................................^\nERROR: \u003cinput\u003e:1:60: found no matching overload for '_\u003e_' applied to '(uint, int)'\n | 1 \u003e 1.0 \u0026\u0026 1u \u003e 1.0 \u0026\u0026 1.0 \u003e 1 \u0026\u0026 1.0 \u003e 1u \u0026\u0026 1 \u003e 1u \u0026\u0026 1u \u003e 1\n | ...........................................................^",
+      unparsed:
+        "1 \u003e 1.0 \u0026\u0026 1u \u003e 1.0 \u0026\u0026 1.0 \u003e 1 \u0026\u0026 1.0 \u003e 1u \u0026\u0026 1 \u003e 1u \u0026\u0026 1u \u003e 1",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e_(\n        1^#1[1,0]#,\n        1^#3[1,4]#\n      )^#2[1,2]#,\n      _\u003e_(\n        1u^#4[1,11]#,\n        1^#6[1,16]#\n      )^#5[1,14]#\n    )^#7[1,8]#,\n    _\u003e_(\n      1^#8[1,23]#,\n      1^#10[1,29]#\n    )^#9[1,27]#\n  )^#11[1,20]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e_(\n        1^#12[1,34]#,\n        1u^#14[1,40]#\n      )^#13[1,38]#,\n      _\u003e_(\n        1^#16[1,46]#,\n        1u^#18[1,50]#\n      )^#17[1,48]#\n    )^#19[1,43]#,\n    _\u003e_(\n      1u^#20[1,56]#,\n      1^#22[1,61]#\n    )^#21[1,59]#\n  )^#23[1,53]#\n)^#15[1,31]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 7, 1, 4, 1, 7],
+        [4, 11, 13, 1, 11, 1, 13],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 16, 19, 1, 16, 1, 19],
+        [7, 8, 10, 1, 8, 1, 10],
+        [8, 23, 26, 1, 23, 1, 26],
+        [9, 27, 28, 1, 27, 1, 28],
+        [10, 29, 30, 1, 29, 1, 30],
+        [11, 20, 22, 1, 20, 1, 22],
+        [12, 34, 37, 1, 34, 1, 37],
+        [13, 38, 39, 1, 38, 1, 39],
+        [14, 40, 42, 1, 40, 1, 42],
+        [15, 31, 33, 1, 31, 1, 33],
+        [16, 46, 47, 1, 46, 1, 47],
+        [17, 48, 49, 1, 48, 1, 49],
+        [18, 50, 52, 1, 50, 1, 52],
+        [19, 43, 45, 1, 43, 1, 45],
+        [20, 56, 58, 1, 56, 1, 58],
+        [21, 59, 60, 1, 59, 1, 60],
+        [22, 61, 62, 1, 61, 1, 62],
+        [23, 53, 55, 1, 53, 1, 55],
+      ],
+      lineOffsets: [63],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 11,
+            "5": 14,
+            "6": 16,
+            "7": 8,
+            "8": 23,
+            "9": 27,
+            "10": 29,
+            "11": 20,
+            "12": 34,
+            "13": 38,
+            "14": 40,
+            "15": 31,
+            "16": 46,
+            "17": 48,
+            "18": 50,
+            "19": 43,
+            "20": 56,
+            "21": 59,
+            "22": 61,
+            "23": 53,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e_(\n        1~int,\n        1~double\n      )~bool^greater_int64_double,\n      _\u003e_(\n        1u~uint,\n        1~double\n      )~bool^greater_uint64_double\n    )~bool^logical_and,\n    _\u003e_(\n      1~double,\n      1~int\n    )~bool^greater_double_int64\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e_(\n        1~double,\n        1u~uint\n      )~bool^greater_double_uint64,\n      _\u003e_(\n        1~int,\n        1u~uint\n      )~bool^greater_int64_uint64\n    )~bool^logical_and,\n    _\u003e_(\n      1u~uint,\n      1~int\n    )~bool^greater_uint64_int64\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["greater_int64_double"] },
+          "5": { overloadId: ["greater_uint64_double"] },
+          "7": { overloadId: ["logical_and"] },
+          "9": { overloadId: ["greater_double_int64"] },
+          "11": { overloadId: ["logical_and"] },
+          "13": { overloadId: ["greater_double_uint64"] },
+          "15": { overloadId: ["logical_and"] },
+          "17": { overloadId: ["greater_int64_uint64"] },
+          "19": { overloadId: ["logical_and"] },
+          "21": { overloadId: ["greater_uint64_int64"] },
+          "23": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "DOUBLE" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "UINT64" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "UINT64" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "UINT64" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 11,
+            "5": 14,
+            "6": 16,
+            "7": 8,
+            "8": 23,
+            "9": 27,
+            "10": 29,
+            "11": 20,
+            "12": 34,
+            "13": 38,
+            "14": 40,
+            "15": 31,
+            "16": 46,
+            "17": 48,
+            "18": 50,
+            "19": 43,
+            "20": 56,
+            "21": 59,
+            "22": 61,
+            "23": 53,
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "6" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        "\n\t\t_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t_\u003e_(\n\t\t\t\t  1~int,\n\t\t\t\t  1~double\n\t\t\t\t)~bool^greater_int64_double,\n\t\t\t\t_\u003e_(\n\t\t\t\t  1u~uint,\n\t\t\t\t  1~double\n\t\t\t\t)~bool^greater_uint64_double\n\t\t\t  )~bool^logical_and,\n\t\t\t  _\u003e_(\n\t\t\t\t1~double,\n\t\t\t\t1~int\n\t\t\t  )~bool^greater_double_int64\n\t\t\t)~bool^logical_and,\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t_\u003e_(\n\t\t\t\t  1~double,\n\t\t\t\t  1u~uint\n\t\t\t\t)~bool^greater_double_uint64,\n\t\t\t\t_\u003e_(\n\t\t\t\t  1~int,\n\t\t\t\t  1u~uint\n\t\t\t\t)~bool^greater_int64_uint64\n\t\t\t  )~bool^logical_and,\n\t\t\t  _\u003e_(\n\t\t\t\t1u~uint,\n\t\t\t\t1~int\n\t\t\t  )~bool^greater_uint64_int64\n\t\t\t)~bool^logical_and\n\t\t  )~bool^logical_and",
+      expectedType: "bool",
     },
     {
       original: {
         expr: "1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1",
       },
+      crossTypeNumericComparisons: true,
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e=_(\n        1^#*expr.Constant_Int64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#,\n      _\u003e=_(\n        1u^#*expr.Constant_Uint64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e=_(\n      1^#*expr.Constant_DoubleValue#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e=_(\n        1^#*expr.Constant_DoubleValue#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#,\n      _\u003e=_(\n        1^#*expr.Constant_Int64Value#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e=_(\n      1u^#*expr.Constant_Uint64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
-      error:
-        "ERROR: \u003cinput\u003e:1:3: found no matching overload for '_\u003e=_' applied to '(int, double)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | ..^\nERROR: \u003cinput\u003e:1:16: found no matching overload for '_\u003e=_' applied to '(uint, double)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | ...............^\nERROR: \u003cinput\u003e:1:30: found no matching overload for '_\u003e=_' applied to '(double, int)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | .............................^\nERROR: \u003cinput\u003e:1:42: found no matching overload for '_\u003e=_' applied to '(double, uint)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | .........................................^\nERROR: \u003cinput\u003e:1:53: found no matching overload for '_\u003e=_' applied to '(int, uint)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | ....................................................^\nERROR: \u003cinput\u003e:1:65: found no matching overload for '_\u003e=_' applied to '(uint, int)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | ................................................................^",
+      unparsed:
+        "1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e=_(\n        1^#1[1,0]#,\n        1^#3[1,5]#\n      )^#2[1,2]#,\n      _\u003e=_(\n        1u^#4[1,12]#,\n        1^#6[1,18]#\n      )^#5[1,15]#\n    )^#7[1,9]#,\n    _\u003e=_(\n      1^#8[1,25]#,\n      1^#10[1,32]#\n    )^#9[1,29]#\n  )^#11[1,22]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e=_(\n        1^#12[1,37]#,\n        1u^#14[1,44]#\n      )^#13[1,41]#,\n      _\u003e=_(\n        1^#16[1,50]#,\n        1u^#18[1,55]#\n      )^#17[1,52]#\n    )^#19[1,47]#,\n    _\u003e=_(\n      1u^#20[1,61]#,\n      1^#22[1,67]#\n    )^#21[1,64]#\n  )^#23[1,58]#\n)^#15[1,34]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 8, 1, 5, 1, 8],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 15, 17, 1, 15, 1, 17],
+        [6, 18, 21, 1, 18, 1, 21],
+        [7, 9, 11, 1, 9, 1, 11],
+        [8, 25, 28, 1, 25, 1, 28],
+        [9, 29, 31, 1, 29, 1, 31],
+        [10, 32, 33, 1, 32, 1, 33],
+        [11, 22, 24, 1, 22, 1, 24],
+        [12, 37, 40, 1, 37, 1, 40],
+        [13, 41, 43, 1, 41, 1, 43],
+        [14, 44, 46, 1, 44, 1, 46],
+        [15, 34, 36, 1, 34, 1, 36],
+        [16, 50, 51, 1, 50, 1, 51],
+        [17, 52, 54, 1, 52, 1, 54],
+        [18, 55, 57, 1, 55, 1, 57],
+        [19, 47, 49, 1, 47, 1, 49],
+        [20, 61, 63, 1, 61, 1, 63],
+        [21, 64, 66, 1, 64, 1, 66],
+        [22, 67, 68, 1, 67, 1, 68],
+        [23, 58, 60, 1, 58, 1, 60],
+      ],
+      lineOffsets: [69],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003e=_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003e=_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 12,
+            "5": 15,
+            "6": 18,
+            "7": 9,
+            "8": 25,
+            "9": 29,
+            "10": 32,
+            "11": 22,
+            "12": 37,
+            "13": 41,
+            "14": 44,
+            "15": 34,
+            "16": 50,
+            "17": 52,
+            "18": 55,
+            "19": 47,
+            "20": 61,
+            "21": 64,
+            "22": 67,
+            "23": 58,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e=_(\n        1~int,\n        1~double\n      )~bool^greater_equals_int64_double,\n      _\u003e=_(\n        1u~uint,\n        1~double\n      )~bool^greater_equals_uint64_double\n    )~bool^logical_and,\n    _\u003e=_(\n      1~double,\n      1~int\n    )~bool^greater_equals_double_int64\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e=_(\n        1~double,\n        1u~uint\n      )~bool^greater_equals_double_uint64,\n      _\u003e=_(\n        1~int,\n        1u~uint\n      )~bool^greater_equals_int64_uint64\n    )~bool^logical_and,\n    _\u003e=_(\n      1u~uint,\n      1~int\n    )~bool^greater_equals_uint64_int64\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["greater_equals_int64_double"] },
+          "5": { overloadId: ["greater_equals_uint64_double"] },
+          "7": { overloadId: ["logical_and"] },
+          "9": { overloadId: ["greater_equals_double_int64"] },
+          "11": { overloadId: ["logical_and"] },
+          "13": { overloadId: ["greater_equals_double_uint64"] },
+          "15": { overloadId: ["logical_and"] },
+          "17": { overloadId: ["greater_equals_int64_uint64"] },
+          "19": { overloadId: ["logical_and"] },
+          "21": { overloadId: ["greater_equals_uint64_int64"] },
+          "23": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "DOUBLE" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "UINT64" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "UINT64" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "UINT64" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 12,
+            "5": 15,
+            "6": 18,
+            "7": 9,
+            "8": 25,
+            "9": 29,
+            "10": 32,
+            "11": 22,
+            "12": 37,
+            "13": 41,
+            "14": 44,
+            "15": 34,
+            "16": 50,
+            "17": 52,
+            "18": 55,
+            "19": 47,
+            "20": 61,
+            "21": 64,
+            "22": 67,
+            "23": 58,
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "1", constExpr: { int64Value: "1" } },
+                                { id: "3", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "4", constExpr: { uint64Value: "1" } },
+                                { id: "6", constExpr: { doubleValue: 1 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_\u003e=_",
+                        args: [
+                          { id: "8", constExpr: { doubleValue: 1 } },
+                          { id: "10", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "12", constExpr: { doubleValue: 1 } },
+                                { id: "14", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "17",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "16", constExpr: { int64Value: "1" } },
+                                { id: "18", constExpr: { uint64Value: "1" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_\u003e=_",
+                        args: [
+                          { id: "20", constExpr: { uint64Value: "1" } },
+                          { id: "22", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "6" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "6",
+      expectedCheckedAst:
+        "\n\t\t_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t_\u003e=_(\n\t\t\t\t  1~int,\n\t\t\t\t  1~double\n\t\t\t\t)~bool^greater_equals_int64_double,\n\t\t\t\t_\u003e=_(\n\t\t\t\t  1u~uint,\n\t\t\t\t  1~double\n\t\t\t\t)~bool^greater_equals_uint64_double\n\t\t\t  )~bool^logical_and,\n\t\t\t  _\u003e=_(\n\t\t\t\t1~double,\n\t\t\t\t1~int\n\t\t\t  )~bool^greater_equals_double_int64\n\t\t\t)~bool^logical_and,\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t_\u003e=_(\n\t\t\t\t  1~double,\n\t\t\t\t  1u~uint\n\t\t\t\t)~bool^greater_equals_double_uint64,\n\t\t\t\t_\u003e=_(\n\t\t\t\t  1~int,\n\t\t\t\t  1u~uint\n\t\t\t\t)~bool^greater_equals_int64_uint64\n\t\t\t  )~bool^logical_and,\n\t\t\t  _\u003e=_(\n\t\t\t\t1u~uint,\n\t\t\t\t1~int\n\t\t\t  )~bool^greater_equals_uint64_int64\n\t\t\t)~bool^logical_and\n\t\t  )~bool^logical_and",
+      expectedType: "bool",
     },
     {
       original: {
         expr: "1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1",
       },
-      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e=_(\n        1^#*expr.Constant_Int64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#,\n      _\u003e=_(\n        1u^#*expr.Constant_Uint64Value#,\n        1^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e=_(\n      1^#*expr.Constant_DoubleValue#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      _\u003e=_(\n        1^#*expr.Constant_DoubleValue#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#,\n      _\u003e=_(\n        1^#*expr.Constant_Int64Value#,\n        1u^#*expr.Constant_Uint64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e=_(\n      1u^#*expr.Constant_Uint64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
-      error:
-        "ERROR: \u003cinput\u003e:1:3: found no matching overload for '_\u003e=_' applied to '(int, double)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | ..^\nERROR: \u003cinput\u003e:1:16: found no matching overload for '_\u003e=_' applied to '(uint, double)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | ...............^\nERROR: \u003cinput\u003e:1:30: found no matching overload for '_\u003e=_' applied to '(double, int)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | .............................^\nERROR: \u003cinput\u003e:1:42: found no matching overload for '_\u003e=_' applied to '(double, uint)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | .........................................^\nERROR: \u003cinput\u003e:1:53: found no matching overload for '_\u003e=_' applied to '(int, uint)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | ....................................................^\nERROR: \u003cinput\u003e:1:65: found no matching overload for '_\u003e=_' applied to '(uint, int)'\n | 1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0 \u0026\u0026 1.0 \u003e= 1 \u0026\u0026 1.0 \u003e= 1u \u0026\u0026 1 \u003e= 1u \u0026\u0026 1u \u003e= 1\n | ................................................................^",
+      variadicAsts: true,
+      crossTypeNumericComparisons: true,
+      ast: "_\u0026\u0026_(\n  _\u003e=_(\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e=_(\n    1u^#*expr.Constant_Uint64Value#,\n    1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e=_(\n    1^#*expr.Constant_DoubleValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e=_(\n    1^#*expr.Constant_DoubleValue#,\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e=_(\n    1^#*expr.Constant_Int64Value#,\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e=_(\n    1u^#*expr.Constant_Uint64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 \u003e= 1.0 \u0026\u0026 1u \u003e= 1.0",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u003e=_(\n    1^#1[1,0]#,\n    1^#3[1,5]#\n  )^#2[1,2]#,\n  _\u003e=_(\n    1u^#4[1,12]#,\n    1^#6[1,18]#\n  )^#5[1,15]#,\n  _\u003e=_(\n    1^#8[1,25]#,\n    1^#10[1,32]#\n  )^#9[1,29]#,\n  _\u003e=_(\n    1^#12[1,37]#,\n    1u^#14[1,44]#\n  )^#13[1,41]#,\n  _\u003e=_(\n    1^#16[1,50]#,\n    1u^#18[1,55]#\n  )^#17[1,52]#,\n  _\u003e=_(\n    1u^#20[1,61]#,\n    1^#22[1,67]#\n  )^#21[1,64]#\n)^#7[1,9]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 8, 1, 5, 1, 8],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 15, 17, 1, 15, 1, 17],
+        [6, 18, 21, 1, 18, 1, 21],
+        [7, 9, 11, 1, 9, 1, 11],
+        [8, 25, 28, 1, 25, 1, 28],
+        [9, 29, 31, 1, 29, 1, 31],
+        [10, 32, 33, 1, 32, 1, 33],
+        [11, 22, 24, 1, 22, 1, 24],
+        [12, 37, 40, 1, 37, 1, 40],
+        [13, 41, 43, 1, 41, 1, 43],
+        [14, 44, 46, 1, 44, 1, 46],
+        [15, 34, 36, 1, 34, 1, 36],
+        [16, 50, 51, 1, 50, 1, 51],
+        [17, 52, 54, 1, 52, 1, 54],
+        [18, 55, 57, 1, 55, 1, 57],
+        [19, 47, 49, 1, 47, 1, 49],
+        [20, 61, 63, 1, 61, 1, 63],
+        [21, 64, 66, 1, 64, 1, 66],
+        [22, 67, 68, 1, 67, 1, 68],
+        [23, 58, 60, 1, 58, 1, 60],
+      ],
+      lineOffsets: [69],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "1", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                    { id: "6", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "8", constExpr: { doubleValue: 1 } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "12", constExpr: { doubleValue: 1 } },
+                    { id: "14", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "16", constExpr: { int64Value: "1" } },
+                    { id: "18", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "21",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "20", constExpr: { uint64Value: "1" } },
+                    { id: "22", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 12,
+            "5": 15,
+            "6": 18,
+            "7": 9,
+            "8": 25,
+            "9": 29,
+            "10": 32,
+            "11": 22,
+            "12": 37,
+            "13": 41,
+            "14": 44,
+            "15": 34,
+            "16": 50,
+            "17": 52,
+            "18": 55,
+            "19": 47,
+            "20": 61,
+            "21": 64,
+            "22": 67,
+            "23": 58,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u003e=_(\n    1~int,\n    1~double\n  )~bool^greater_equals_int64_double,\n  _\u003e=_(\n    1u~uint,\n    1~double\n  )~bool^greater_equals_uint64_double,\n  _\u003e=_(\n    1~double,\n    1~int\n  )~bool^greater_equals_double_int64,\n  _\u003e=_(\n    1~double,\n    1u~uint\n  )~bool^greater_equals_double_uint64,\n  _\u003e=_(\n    1~int,\n    1u~uint\n  )~bool^greater_equals_int64_uint64,\n  _\u003e=_(\n    1u~uint,\n    1~int\n  )~bool^greater_equals_uint64_int64\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["greater_equals_int64_double"] },
+          "5": { overloadId: ["greater_equals_uint64_double"] },
+          "7": { overloadId: ["logical_and"] },
+          "9": { overloadId: ["greater_equals_double_int64"] },
+          "13": { overloadId: ["greater_equals_double_uint64"] },
+          "17": { overloadId: ["greater_equals_int64_uint64"] },
+          "21": { overloadId: ["greater_equals_uint64_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "DOUBLE" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "UINT64" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "UINT64" },
+          "20": { primitive: "UINT64" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 12,
+            "5": 15,
+            "6": 18,
+            "7": 9,
+            "8": 25,
+            "9": 29,
+            "10": 32,
+            "11": 22,
+            "12": 37,
+            "13": 41,
+            "14": 44,
+            "15": 34,
+            "16": 50,
+            "17": 52,
+            "18": 55,
+            "19": 47,
+            "20": 61,
+            "21": 64,
+            "22": 67,
+            "23": 58,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "1", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                    { id: "6", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "8", constExpr: { doubleValue: 1 } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "12", constExpr: { doubleValue: 1 } },
+                    { id: "14", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "17",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "16", constExpr: { int64Value: "1" } },
+                    { id: "18", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "21",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    { id: "20", constExpr: { uint64Value: "1" } },
+                    { id: "22", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "6",
+      expectedCheckedAst:
+        "\n\t\t_\u0026\u0026_(\n\t\t\t_\u003e=_(\n\t\t\t  1~int,\n\t\t\t  1~double\n\t\t\t)~bool^greater_equals_int64_double,\n\t\t\t_\u003e=_(\n\t\t\t  1u~uint,\n\t\t\t  1~double\n\t\t\t)~bool^greater_equals_uint64_double,\n\t\t\t_\u003e=_(\n\t\t\t  1~double,\n\t\t\t  1~int\n\t\t\t)~bool^greater_equals_double_int64,\n\t\t\t_\u003e=_(\n\t\t\t  1~double,\n\t\t\t  1u~uint\n\t\t\t)~bool^greater_equals_double_uint64,\n\t\t\t_\u003e=_(\n\t\t\t  1~int,\n\t\t\t  1u~uint\n\t\t\t)~bool^greater_equals_int64_uint64,\n\t\t\t_\u003e=_(\n\t\t\t  1u~uint,\n\t\t\t  1~int\n\t\t\t)~bool^greater_equals_uint64_int64\n\t\t  )~bool^logical_and",
+      expectedType: "bool",
     },
     {
       original: { expr: "[1].map(x, [x, x]).map(x, [x, x])" },
+      crossTypeNumericComparisons: true,
       ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        [\n          x^#*expr.Expr_IdentExpr#,\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      [\n        x^#*expr.Expr_IdentExpr#,\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1].map(x, [x, x]).map(x, [x, x])",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#8[1,7]#,\n    // LoopCondition\n    true^#9[1,7]#,\n    // LoopStep\n    _+_(\n      @result^#10[1,7]#,\n      [\n        [\n          x^#6[1,12]#,\n          x^#7[1,15]#\n        ]^#5[1,11]#\n      ]^#11[1,7]#\n    )^#12[1,7]#,\n    // Result\n    @result^#13[1,7]#)^#14[1,7]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#20[1,22]#,\n  // LoopCondition\n  true^#21[1,22]#,\n  // LoopStep\n  _+_(\n    @result^#22[1,22]#,\n    [\n      [\n        x^#18[1,27]#,\n        x^#19[1,30]#\n      ]^#17[1,26]#\n    ]^#23[1,22]#\n  )^#24[1,22]#,\n  // Result\n  @result^#25[1,22]#)^#26[1,22]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 11, 12, 1, 11, 1, 12],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 7, 7, 1, 7, 1, 7],
+        [9, 7, 7, 1, 7, 1, 7],
+        [10, 7, 7, 1, 7, 1, 7],
+        [11, 7, 7, 1, 7, 1, 7],
+        [12, 7, 7, 1, 7, 1, 7],
+        [13, 7, 7, 1, 7, 1, 7],
+        [14, 7, 7, 1, 7, 1, 7],
+        [16, 23, 24, 1, 23, 1, 24],
+        [17, 26, 27, 1, 26, 1, 27],
+        [18, 27, 28, 1, 27, 1, 28],
+        [19, 30, 31, 1, 30, 1, 31],
+        [20, 22, 22, 1, 22, 1, 22],
+        [21, 22, 22, 1, 22, 1, 22],
+        [22, 22, 22, 1, 22, 1, 22],
+        [23, 22, 22, 1, 22, 1, 22],
+        [24, 22, 22, 1, 22, 1, 22],
+        [25, 22, 22, 1, 22, 1, 22],
+        [26, 22, 22, 1, 22, 1, 22],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "26",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "8", listExpr: {} },
+                loopCondition: { id: "9", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "10", identExpr: { name: "@result" } },
+                      {
+                        id: "11",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "5",
+                              listExpr: {
+                                elements: [
+                                  { id: "6", identExpr: { name: "x" } },
+                                  { id: "7", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", listExpr: {} },
+            loopCondition: { id: "21", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "24",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "22", identExpr: { name: "@result" } },
+                  {
+                    id: "23",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "17",
+                          listExpr: {
+                            elements: [
+                              { id: "18", identExpr: { name: "x" } },
+                              { id: "19", identExpr: { name: "x" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "25", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 8,
+            "5": 11,
+            "6": 12,
+            "7": 15,
+            "8": 7,
+            "9": 7,
+            "10": 7,
+            "11": 7,
+            "12": 7,
+            "13": 7,
+            "14": 7,
+            "16": 23,
+            "17": 26,
+            "18": 27,
+            "19": 30,
+            "20": 22,
+            "21": 22,
+            "22": 22,
+            "23": 22,
+            "24": 22,
+            "25": 22,
+            "26": 22,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "4", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    listExpr: {
+                      elements: [
+                        { id: "6", identExpr: { name: "x" } },
+                        { id: "7", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "14" },
+                function: "map",
+                args: [
+                  { id: "16", identExpr: { name: "x" } },
+                  {
+                    id: "17",
+                    listExpr: {
+                      elements: [
+                        { id: "18", identExpr: { name: "x" } },
+                        { id: "19", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+            },
+          },
+          args: [
+            { id: "4", identExpr: { name: "x" } },
+            {
+              id: "5",
+              listExpr: {
+                elements: [
+                  { id: "6", identExpr: { name: "x" } },
+                  { id: "7", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 26,
+          function: "map",
+          target: { id: "14" },
+          args: [
+            { id: "16", identExpr: { name: "x" } },
+            {
+              id: "17",
+              listExpr: {
+                elements: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(list(int)),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(list(int))^@result,\n      [\n        [\n          x~int^x,\n          x~int^x\n        ]~list(int)\n      ]~list(list(int))\n    )~list(list(int))^add_list,\n    // Result\n    @result~list(list(int))^@result)~list(list(int)),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(list(int))),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(list(list(int)))^@result,\n    [\n      [\n        x~list(int)^x,\n        x~list(int)^x\n      ]~list(list(int))\n    ]~list(list(list(int)))\n  )~list(list(list(int)))^add_list,\n  // Result\n  @result~list(list(list(int)))^@result)~list(list(list(int)))",
+      checkedExpr: {
+        referenceMap: {
+          "6": { name: "x" },
+          "7": { name: "x" },
+          "10": { name: "@result" },
+          "12": { overloadId: ["add_list"] },
+          "13": { name: "@result" },
+          "18": { name: "x" },
+          "19": { name: "x" },
+          "22": { name: "@result" },
+          "24": { overloadId: ["add_list"] },
+          "25": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "9": { primitive: "BOOL" },
+          "10": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "11": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "12": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "13": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "14": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "17": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: { listType: { elemType: { primitive: "INT64" } } },
+                },
+              },
+            },
+          },
+          "21": { primitive: "BOOL" },
+          "22": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: { listType: { elemType: { primitive: "INT64" } } },
+                },
+              },
+            },
+          },
+          "23": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: { listType: { elemType: { primitive: "INT64" } } },
+                },
+              },
+            },
+          },
+          "24": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: { listType: { elemType: { primitive: "INT64" } } },
+                },
+              },
+            },
+          },
+          "25": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: { listType: { elemType: { primitive: "INT64" } } },
+                },
+              },
+            },
+          },
+          "26": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: { listType: { elemType: { primitive: "INT64" } } },
+                },
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 8,
+            "5": 11,
+            "6": 12,
+            "7": 15,
+            "8": 7,
+            "9": 7,
+            "10": 7,
+            "11": 7,
+            "12": 7,
+            "13": 7,
+            "14": 7,
+            "16": 23,
+            "17": 26,
+            "18": 27,
+            "19": 30,
+            "20": 22,
+            "21": 22,
+            "22": 22,
+            "23": 22,
+            "24": 22,
+            "25": 22,
+            "26": 22,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "4", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    listExpr: {
+                      elements: [
+                        { id: "6", identExpr: { name: "x" } },
+                        { id: "7", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "14" },
+                function: "map",
+                args: [
+                  { id: "16", identExpr: { name: "x" } },
+                  {
+                    id: "17",
+                    listExpr: {
+                      elements: [
+                        { id: "18", identExpr: { name: "x" } },
+                        { id: "19", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "26",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "8", listExpr: {} },
+                loopCondition: { id: "9", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "10", identExpr: { name: "@result" } },
+                      {
+                        id: "11",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "5",
+                              listExpr: {
+                                elements: [
+                                  { id: "6", identExpr: { name: "x" } },
+                                  { id: "7", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", listExpr: {} },
+            loopCondition: { id: "21", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "24",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "22", identExpr: { name: "@result" } },
+                  {
+                    id: "23",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "17",
+                          listExpr: {
+                            elements: [
+                              { id: "18", identExpr: { name: "x" } },
+                              { id: "19", identExpr: { name: "x" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "25", identExpr: { name: "@result" } },
+          },
+        },
+      },
       type: "list(list(list(int)))",
+      cost: { min: "80", max: "80" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              {
+                listValue: {
+                  values: [
+                    {
+                      listValue: {
+                        values: [{ int64Value: "1" }, { int64Value: "1" }],
+                      },
+                    },
+                    {
+                      listValue: {
+                        values: [{ int64Value: "1" }, { int64Value: "1" }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      runtimeCost: "80",
+      expectedCheckedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tx,\n\t\t\t// Target\n\t\t\t__comprehension__(\n\t\t\t  // Variable\n\t\t\t  x,\n\t\t\t  // Target\n\t\t\t  [\n\t\t\t\t1~int\n\t\t\t  ]~list(int),\n\t\t\t  // Accumulator\n\t\t\t  @result,\n\t\t\t  // Init\n\t\t\t  []~list(list(int)),\n\t\t\t  // LoopCondition\n\t\t\t  true~bool,\n\t\t\t  // LoopStep\n\t\t\t  _+_(\n\t\t\t\t@result~list(list(int))^@result,\n\t\t\t\t[\n\t\t\t\t  [\n\t\t\t\t\tx~int^x,\n\t\t\t\t\tx~int^x\n\t\t\t\t  ]~list(int)\n\t\t\t\t]~list(list(int))\n\t\t\t  )~list(list(int))^add_list,\n\t\t\t  // Result\n\t\t\t  @result~list(list(int))^@result)~list(list(int)),\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]~list(list(list(int))),\n\t\t\t// LoopCondition\n\t\t\ttrue~bool,\n\t\t\t// LoopStep\n\t\t\t_+_(\n\t\t\t  @result~list(list(list(int)))^@result,\n\t\t\t  [\n\t\t\t\t[\n\t\t\t\t  x~list(int)^x,\n\t\t\t\t  x~list(int)^x\n\t\t\t\t]~list(list(int))\n\t\t\t  ]~list(list(list(int)))\n\t\t\t)~list(list(list(int)))^add_list,\n\t\t\t// Result\n\t\t\t@result~list(list(list(int)))^@result)~list(list(list(int)))\n\t\t  ",
+      expectedType: "list(list(list(int)))",
     },
     {
       original: {
@@ -1356,17 +18083,1033 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: '__comprehension__(\n  // Variable\n  i,\n  // Target\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    values^#*expr.Expr_IdentExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _?_:_(\n      _!=_(\n        i^#*expr.Expr_IdentExpr#.content^#*expr.Expr_SelectExpr#,\n        ""^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          i^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      i^#*expr.Expr_IdentExpr#.content^#*expr.Expr_SelectExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: 'values.filter(i, i.content != "").map(i, i.content)',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  i,\n  // Target\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    values^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#8[1,13]#,\n    // LoopCondition\n    true^#9[1,13]#,\n    // LoopStep\n    _?_:_(\n      _!=_(\n        i^#4[1,17]#.content^#5[1,18]#,\n        ""^#7[1,30]#\n      )^#6[1,27]#,\n      _+_(\n        @result^#10[1,13]#,\n        [\n          i^#3[1,14]#\n        ]^#11[1,13]#\n      )^#12[1,13]#,\n      @result^#13[1,13]#\n    )^#14[1,13]#,\n    // Result\n    @result^#15[1,13]#)^#16[1,13]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#21[1,37]#,\n  // LoopCondition\n  true^#22[1,37]#,\n  // LoopStep\n  _+_(\n    @result^#23[1,37]#,\n    [\n      i^#19[1,41]#.content^#20[1,42]#\n    ]^#24[1,37]#\n  )^#25[1,37]#,\n  // Result\n  @result^#26[1,37]#)^#27[1,37]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 27, 29, 1, 27, 1, 29],
+        [7, 30, 32, 1, 30, 1, 32],
+        [8, 13, 13, 1, 13, 1, 13],
+        [9, 13, 13, 1, 13, 1, 13],
+        [10, 13, 13, 1, 13, 1, 13],
+        [11, 13, 13, 1, 13, 1, 13],
+        [12, 13, 13, 1, 13, 1, 13],
+        [13, 13, 13, 1, 13, 1, 13],
+        [14, 13, 13, 1, 13, 1, 13],
+        [15, 13, 13, 1, 13, 1, 13],
+        [16, 13, 13, 1, 13, 1, 13],
+        [18, 38, 39, 1, 38, 1, 39],
+        [19, 41, 42, 1, 41, 1, 42],
+        [20, 42, 43, 1, 42, 1, 43],
+        [21, 37, 37, 1, 37, 1, 37],
+        [22, 37, 37, 1, 37, 1, 37],
+        [23, 37, 37, 1, 37, 1, 37],
+        [24, 37, 37, 1, 37, 1, 37],
+        [25, 37, 37, 1, 37, 1, 37],
+        [26, 37, 37, 1, 37, 1, 37],
+        [27, 37, 37, 1, 37, 1, 37],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: { id: "1", identExpr: { name: "values" } },
+                accuVar: "@result",
+                accuInit: { id: "8", listExpr: {} },
+                loopCondition: { id: "9", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "6",
+                        callExpr: {
+                          function: "_!=_",
+                          args: [
+                            {
+                              id: "5",
+                              selectExpr: {
+                                operand: { id: "4", identExpr: { name: "i" } },
+                                field: "content",
+                              },
+                            },
+                            { id: "7", constExpr: { stringValue: "" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "10", identExpr: { name: "@result" } },
+                            {
+                              id: "11",
+                              listExpr: {
+                                elements: [
+                                  { id: "3", identExpr: { name: "i" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "13", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "21", listExpr: {} },
+            loopCondition: { id: "22", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "23", identExpr: { name: "@result" } },
+                  {
+                    id: "24",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "20",
+                          selectExpr: {
+                            operand: { id: "19", identExpr: { name: "i" } },
+                            field: "content",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 17,
+            "5": 18,
+            "6": 27,
+            "7": 30,
+            "8": 13,
+            "9": 13,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "18": 38,
+            "19": 41,
+            "20": 42,
+            "21": 37,
+            "22": 37,
+            "23": 37,
+            "24": 37,
+            "25": 37,
+            "26": 37,
+            "27": 37,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "values" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "i" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_!=_",
+                      args: [
+                        {
+                          id: "5",
+                          selectExpr: {
+                            operand: { id: "4", identExpr: { name: "i" } },
+                            field: "content",
+                          },
+                        },
+                        { id: "7", constExpr: { stringValue: "" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "i" } },
+                  {
+                    id: "20",
+                    selectExpr: {
+                      operand: { id: "19", identExpr: { name: "i" } },
+                      field: "content",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "values" } },
+          args: [
+            { id: "3", identExpr: { name: "i" } },
+            {
+              id: "6",
+              callExpr: {
+                function: "_!=_",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "4", identExpr: { name: "i" } },
+                      field: "content",
+                    },
+                  },
+                  { id: "7", constExpr: { stringValue: "" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 27,
+          function: "map",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "i" } },
+            {
+              id: "20",
+              selectExpr: {
+                operand: { id: "19", identExpr: { name: "i" } },
+                field: "content",
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         '__comprehension__(\n  // Variable\n  i,\n  // Target\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    values~list(map(string, string))^values,\n    // Accumulator\n    @result,\n    // Init\n    []~list(map(string, string)),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _?_:_(\n      _!=_(\n        i~map(string, string)^i.content~string,\n        ""~string\n      )~bool^not_equals,\n      _+_(\n        @result~list(map(string, string))^@result,\n        [\n          i~map(string, string)^i\n        ]~list(map(string, string))\n      )~list(map(string, string))^add_list,\n      @result~list(map(string, string))^@result\n    )~list(map(string, string))^conditional,\n    // Result\n    @result~list(map(string, string))^@result)~list(map(string, string)),\n  // Accumulator\n  @result,\n  // Init\n  []~list(string),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(string)^@result,\n    [\n      i~map(string, string)^i.content~string\n    ]~list(string)\n  )~list(string)^add_list,\n  // Result\n  @result~list(string)^@result)~list(string)',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "values" },
+          "3": { name: "i" },
+          "4": { name: "i" },
+          "6": { overloadId: ["not_equals"] },
+          "10": { name: "@result" },
+          "12": { overloadId: ["add_list"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["conditional"] },
+          "15": { name: "@result" },
+          "19": { name: "i" },
+          "23": { name: "@result" },
+          "25": { overloadId: ["add_list"] },
+          "26": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+          "8": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "9": { primitive: "BOOL" },
+          "10": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "11": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "12": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "13": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "14": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "15": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "16": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "19": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "20": { primitive: "STRING" },
+          "21": { listType: { elemType: { primitive: "STRING" } } },
+          "22": { primitive: "BOOL" },
+          "23": { listType: { elemType: { primitive: "STRING" } } },
+          "24": { listType: { elemType: { primitive: "STRING" } } },
+          "25": { listType: { elemType: { primitive: "STRING" } } },
+          "26": { listType: { elemType: { primitive: "STRING" } } },
+          "27": { listType: { elemType: { primitive: "STRING" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 17,
+            "5": 18,
+            "6": 27,
+            "7": 30,
+            "8": 13,
+            "9": 13,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "18": 38,
+            "19": 41,
+            "20": 42,
+            "21": 37,
+            "22": 37,
+            "23": 37,
+            "24": 37,
+            "25": 37,
+            "26": 37,
+            "27": 37,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "values" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "i" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_!=_",
+                      args: [
+                        {
+                          id: "5",
+                          selectExpr: {
+                            operand: { id: "4", identExpr: { name: "i" } },
+                            field: "content",
+                          },
+                        },
+                        { id: "7", constExpr: { stringValue: "" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "i" } },
+                  {
+                    id: "20",
+                    selectExpr: {
+                      operand: { id: "19", identExpr: { name: "i" } },
+                      field: "content",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: { id: "1", identExpr: { name: "values" } },
+                accuVar: "@result",
+                accuInit: { id: "8", listExpr: {} },
+                loopCondition: { id: "9", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "6",
+                        callExpr: {
+                          function: "_!=_",
+                          args: [
+                            {
+                              id: "5",
+                              selectExpr: {
+                                operand: { id: "4", identExpr: { name: "i" } },
+                                field: "content",
+                              },
+                            },
+                            { id: "7", constExpr: { stringValue: "" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "10", identExpr: { name: "@result" } },
+                            {
+                              id: "11",
+                              listExpr: {
+                                elements: [
+                                  { id: "3", identExpr: { name: "i" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "13", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "21", listExpr: {} },
+            loopCondition: { id: "22", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "23", identExpr: { name: "@result" } },
+                  {
+                    id: "24",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "20",
+                          selectExpr: {
+                            operand: { id: "19", identExpr: { name: "i" } },
+                            field: "content",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+      },
       type: "list(string)",
+      cost: { min: "23", max: "18446744073709551615" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such attribute(s): values" }],
+        },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        '__comprehension__(\n\t\t\t// Variable\n\t\t\ti,\n\t\t\t// Target\n\t\t\t__comprehension__(\n\t\t\t  // Variable\n\t\t\t  i,\n\t\t\t  // Target\n\t\t\t  values~list(map(string, string))^values,\n\t\t\t  // Accumulator\n\t\t\t  @result,\n\t\t\t  // Init\n\t\t\t  []~list(map(string, string)),\n\t\t\t  // LoopCondition\n\t\t\t  true~bool,\n\t\t\t  // LoopStep\n\t\t\t  _?_:_(\n\t\t\t\t_!=_(\n\t\t\t\t  i~map(string, string)^i.content~string,\n\t\t\t\t  ""~string\n\t\t\t\t)~bool^not_equals,\n\t\t\t\t_+_(\n\t\t\t\t  @result~list(map(string, string))^@result,\n\t\t\t\t  [\n\t\t\t\t\ti~map(string, string)^i\n\t\t\t\t  ]~list(map(string, string))\n\t\t\t\t)~list(map(string, string))^add_list,\n\t\t\t\t@result~list(map(string, string))^@result\n\t\t\t  )~list(map(string, string))^conditional,\n\t\t\t  // Result\n\t\t\t  @result~list(map(string, string))^@result)~list(map(string, string)),\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]~list(string),\n\t\t\t// LoopCondition\n\t\t\ttrue~bool,\n\t\t\t// LoopStep\n\t\t\t_+_(\n\t\t\t  @result~list(string)^@result,\n\t\t\t  [\n\t\t\t\ti~map(string, string)^i.content~string\n\t\t\t  ]~list(string)\n\t\t\t)~list(string)^add_list,\n\t\t\t// Result\n\t\t\t@result~list(string)^@result)~list(string)',
+      expectedType: "list(string)",
     },
     {
       original: { expr: "[{}.map(c,c,c)]+[{}.map(c,c,c)]" },
+      crossTypeNumericComparisons: true,
       ast: "_+_(\n  [\n    __comprehension__(\n      // Variable\n      c,\n      // Target\n      {}^#*expr.Expr_StructExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _?_:_(\n        c^#*expr.Expr_IdentExpr#,\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            c^#*expr.Expr_IdentExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    __comprehension__(\n      // Variable\n      c,\n      // Target\n      {}^#*expr.Expr_StructExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _?_:_(\n        c^#*expr.Expr_IdentExpr#,\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            c^#*expr.Expr_IdentExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[{}.map(c, c, c)] + [{}.map(c, c, c)]",
+      locationAst:
+        "_+_(\n  [\n    __comprehension__(\n      // Variable\n      c,\n      // Target\n      {}^#2[1,1]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#7[1,7]#,\n      // LoopCondition\n      true^#8[1,7]#,\n      // LoopStep\n      _?_:_(\n        c^#5[1,10]#,\n        _+_(\n          @result^#9[1,7]#,\n          [\n            c^#6[1,12]#\n          ]^#10[1,7]#\n        )^#11[1,7]#,\n        @result^#12[1,7]#\n      )^#13[1,7]#,\n      // Result\n      @result^#14[1,7]#)^#15[1,7]#\n  ]^#1[1,0]#,\n  [\n    __comprehension__(\n      // Variable\n      c,\n      // Target\n      {}^#18[1,17]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#23[1,23]#,\n      // LoopCondition\n      true^#24[1,23]#,\n      // LoopStep\n      _?_:_(\n        c^#21[1,26]#,\n        _+_(\n          @result^#25[1,23]#,\n          [\n            c^#22[1,28]#\n          ]^#26[1,23]#\n        )^#27[1,23]#,\n        @result^#28[1,23]#\n      )^#29[1,23]#,\n      // Result\n      @result^#30[1,23]#)^#31[1,23]#\n  ]^#17[1,16]#\n)^#16[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 7, 7, 1, 7, 1, 7],
+        [8, 7, 7, 1, 7, 1, 7],
+        [9, 7, 7, 1, 7, 1, 7],
+        [10, 7, 7, 1, 7, 1, 7],
+        [11, 7, 7, 1, 7, 1, 7],
+        [12, 7, 7, 1, 7, 1, 7],
+        [13, 7, 7, 1, 7, 1, 7],
+        [14, 7, 7, 1, 7, 1, 7],
+        [15, 7, 7, 1, 7, 1, 7],
+        [16, 15, 16, 1, 15, 1, 16],
+        [17, 16, 17, 1, 16, 1, 17],
+        [18, 17, 18, 1, 17, 1, 18],
+        [20, 24, 25, 1, 24, 1, 25],
+        [21, 26, 27, 1, 26, 1, 27],
+        [22, 28, 29, 1, 28, 1, 29],
+        [23, 23, 23, 1, 23, 1, 23],
+        [24, 23, 23, 1, 23, 1, 23],
+        [25, 23, 23, 1, 23, 1, 23],
+        [26, 23, 23, 1, 23, 1, 23],
+        [27, 23, 23, 1, 23, 1, 23],
+        [28, 23, 23, 1, 23, 1, 23],
+        [29, 23, 23, 1, 23, 1, 23],
+        [30, 23, 23, 1, 23, 1, 23],
+        [31, 23, 23, 1, 23, 1, 23],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "15",
+                      comprehensionExpr: {
+                        iterVar: "c",
+                        iterRange: { id: "2", structExpr: {} },
+                        accuVar: "@result",
+                        accuInit: { id: "7", listExpr: {} },
+                        loopCondition: {
+                          id: "8",
+                          constExpr: { boolValue: true },
+                        },
+                        loopStep: {
+                          id: "13",
+                          callExpr: {
+                            function: "_?_:_",
+                            args: [
+                              { id: "5", identExpr: { name: "c" } },
+                              {
+                                id: "11",
+                                callExpr: {
+                                  function: "_+_",
+                                  args: [
+                                    { id: "9", identExpr: { name: "@result" } },
+                                    {
+                                      id: "10",
+                                      listExpr: {
+                                        elements: [
+                                          { id: "6", identExpr: { name: "c" } },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "12", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        result: { id: "14", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "17",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "31",
+                      comprehensionExpr: {
+                        iterVar: "c",
+                        iterRange: { id: "18", structExpr: {} },
+                        accuVar: "@result",
+                        accuInit: { id: "23", listExpr: {} },
+                        loopCondition: {
+                          id: "24",
+                          constExpr: { boolValue: true },
+                        },
+                        loopStep: {
+                          id: "29",
+                          callExpr: {
+                            function: "_?_:_",
+                            args: [
+                              { id: "21", identExpr: { name: "c" } },
+                              {
+                                id: "27",
+                                callExpr: {
+                                  function: "_+_",
+                                  args: [
+                                    {
+                                      id: "25",
+                                      identExpr: { name: "@result" },
+                                    },
+                                    {
+                                      id: "26",
+                                      listExpr: {
+                                        elements: [
+                                          {
+                                            id: "22",
+                                            identExpr: { name: "c" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "28", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        result: { id: "30", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 8,
+            "5": 10,
+            "6": 12,
+            "7": 7,
+            "8": 7,
+            "9": 7,
+            "10": 7,
+            "11": 7,
+            "12": 7,
+            "13": 7,
+            "14": 7,
+            "15": 7,
+            "16": 15,
+            "17": 16,
+            "18": 17,
+            "20": 24,
+            "21": 26,
+            "22": 28,
+            "23": 23,
+            "24": 23,
+            "25": 23,
+            "26": 23,
+            "27": 23,
+            "28": 23,
+            "29": 23,
+            "30": 23,
+            "31": 23,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: { id: "2", structExpr: {} },
+                function: "map",
+                args: [
+                  { id: "4", identExpr: { name: "c" } },
+                  { id: "5", identExpr: { name: "c" } },
+                  { id: "6", identExpr: { name: "c" } },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: { id: "18", structExpr: {} },
+                function: "map",
+                args: [
+                  { id: "20", identExpr: { name: "c" } },
+                  { id: "21", identExpr: { name: "c" } },
+                  { id: "22", identExpr: { name: "c" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 15,
+          function: "map",
+          target: { id: "2", structExpr: {} },
+          args: [
+            { id: "4", identExpr: { name: "c" } },
+            { id: "5", identExpr: { name: "c" } },
+            { id: "6", identExpr: { name: "c" } },
+          ],
+        },
+        {
+          id: 31,
+          function: "map",
+          target: { id: "18", structExpr: {} },
+          args: [
+            { id: "20", identExpr: { name: "c" } },
+            { id: "21", identExpr: { name: "c" } },
+            { id: "22", identExpr: { name: "c" } },
+          ],
+        },
+      ],
       checkedAst:
         "_+_(\n  [\n    __comprehension__(\n      // Variable\n      c,\n      // Target\n      {}~map(bool, dyn),\n      // Accumulator\n      @result,\n      // Init\n      []~list(bool),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _?_:_(\n        c~bool^c,\n        _+_(\n          @result~list(bool)^@result,\n          [\n            c~bool^c\n          ]~list(bool)\n        )~list(bool)^add_list,\n        @result~list(bool)^@result\n      )~list(bool)^conditional,\n      // Result\n      @result~list(bool)^@result)~list(bool)\n  ]~list(list(bool)),\n  [\n    __comprehension__(\n      // Variable\n      c,\n      // Target\n      {}~map(bool, dyn),\n      // Accumulator\n      @result,\n      // Init\n      []~list(bool),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _?_:_(\n        c~bool^c,\n        _+_(\n          @result~list(bool)^@result,\n          [\n            c~bool^c\n          ]~list(bool)\n        )~list(bool)^add_list,\n        @result~list(bool)^@result\n      )~list(bool)^conditional,\n      // Result\n      @result~list(bool)^@result)~list(bool)\n  ]~list(list(bool))\n)~list(list(bool))^add_list",
+      checkedExpr: {
+        referenceMap: {
+          "5": { name: "c" },
+          "6": { name: "c" },
+          "9": { name: "@result" },
+          "11": { overloadId: ["add_list"] },
+          "12": { name: "@result" },
+          "13": { overloadId: ["conditional"] },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_list"] },
+          "21": { name: "c" },
+          "22": { name: "c" },
+          "25": { name: "@result" },
+          "27": { overloadId: ["add_list"] },
+          "28": { name: "@result" },
+          "29": { overloadId: ["conditional"] },
+          "30": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "BOOL" } } },
+            },
+          },
+          "2": {
+            mapType: { keyType: { primitive: "BOOL" }, valueType: { dyn: {} } },
+          },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { listType: { elemType: { primitive: "BOOL" } } },
+          "8": { primitive: "BOOL" },
+          "9": { listType: { elemType: { primitive: "BOOL" } } },
+          "10": { listType: { elemType: { primitive: "BOOL" } } },
+          "11": { listType: { elemType: { primitive: "BOOL" } } },
+          "12": { listType: { elemType: { primitive: "BOOL" } } },
+          "13": { listType: { elemType: { primitive: "BOOL" } } },
+          "14": { listType: { elemType: { primitive: "BOOL" } } },
+          "15": { listType: { elemType: { primitive: "BOOL" } } },
+          "16": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "BOOL" } } },
+            },
+          },
+          "17": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "BOOL" } } },
+            },
+          },
+          "18": {
+            mapType: { keyType: { primitive: "BOOL" }, valueType: { dyn: {} } },
+          },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { listType: { elemType: { primitive: "BOOL" } } },
+          "24": { primitive: "BOOL" },
+          "25": { listType: { elemType: { primitive: "BOOL" } } },
+          "26": { listType: { elemType: { primitive: "BOOL" } } },
+          "27": { listType: { elemType: { primitive: "BOOL" } } },
+          "28": { listType: { elemType: { primitive: "BOOL" } } },
+          "29": { listType: { elemType: { primitive: "BOOL" } } },
+          "30": { listType: { elemType: { primitive: "BOOL" } } },
+          "31": { listType: { elemType: { primitive: "BOOL" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 8,
+            "5": 10,
+            "6": 12,
+            "7": 7,
+            "8": 7,
+            "9": 7,
+            "10": 7,
+            "11": 7,
+            "12": 7,
+            "13": 7,
+            "14": 7,
+            "15": 7,
+            "16": 15,
+            "17": 16,
+            "18": 17,
+            "20": 24,
+            "21": 26,
+            "22": 28,
+            "23": 23,
+            "24": 23,
+            "25": 23,
+            "26": 23,
+            "27": 23,
+            "28": 23,
+            "29": 23,
+            "30": 23,
+            "31": 23,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: { id: "2", structExpr: {} },
+                function: "map",
+                args: [
+                  { id: "4", identExpr: { name: "c" } },
+                  { id: "5", identExpr: { name: "c" } },
+                  { id: "6", identExpr: { name: "c" } },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: { id: "18", structExpr: {} },
+                function: "map",
+                args: [
+                  { id: "20", identExpr: { name: "c" } },
+                  { id: "21", identExpr: { name: "c" } },
+                  { id: "22", identExpr: { name: "c" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "15",
+                      comprehensionExpr: {
+                        iterVar: "c",
+                        iterRange: { id: "2", structExpr: {} },
+                        accuVar: "@result",
+                        accuInit: { id: "7", listExpr: {} },
+                        loopCondition: {
+                          id: "8",
+                          constExpr: { boolValue: true },
+                        },
+                        loopStep: {
+                          id: "13",
+                          callExpr: {
+                            function: "_?_:_",
+                            args: [
+                              { id: "5", identExpr: { name: "c" } },
+                              {
+                                id: "11",
+                                callExpr: {
+                                  function: "_+_",
+                                  args: [
+                                    { id: "9", identExpr: { name: "@result" } },
+                                    {
+                                      id: "10",
+                                      listExpr: {
+                                        elements: [
+                                          { id: "6", identExpr: { name: "c" } },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "12", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        result: { id: "14", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "17",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "31",
+                      comprehensionExpr: {
+                        iterVar: "c",
+                        iterRange: { id: "18", structExpr: {} },
+                        accuVar: "@result",
+                        accuInit: { id: "23", listExpr: {} },
+                        loopCondition: {
+                          id: "24",
+                          constExpr: { boolValue: true },
+                        },
+                        loopStep: {
+                          id: "29",
+                          callExpr: {
+                            function: "_?_:_",
+                            args: [
+                              { id: "21", identExpr: { name: "c" } },
+                              {
+                                id: "27",
+                                callExpr: {
+                                  function: "_+_",
+                                  args: [
+                                    {
+                                      id: "25",
+                                      identExpr: { name: "@result" },
+                                    },
+                                    {
+                                      id: "26",
+                                      listExpr: {
+                                        elements: [
+                                          {
+                                            id: "22",
+                                            identExpr: { name: "c" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "28", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        result: { id: "30", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "list(list(bool))",
+      cost: { min: "103", max: "103" },
+      result: {
+        value: {
+          listValue: { values: [{ listValue: {} }, { listValue: {} }] },
+        },
+      },
+      runtimeCost: "103",
+      expectedCheckedAst:
+        "_+_(\n\t\t\t[\n\t\t\t  __comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tc,\n\t\t\t\t// Target\n\t\t\t\t{}~map(bool, dyn),\n\t\t\t\t// Accumulator\n\t\t\t\t@result,\n\t\t\t\t// Init\n\t\t\t\t[]~list(bool),\n\t\t\t\t// LoopCondition\n\t\t\t\ttrue~bool,\n\t\t\t\t// LoopStep\n\t\t\t\t_?_:_(\n\t\t\t\t  c~bool^c,\n\t\t\t\t  _+_(\n\t\t\t\t\t@result~list(bool)^@result,\n\t\t\t\t\t[\n\t\t\t\t\t  c~bool^c\n\t\t\t\t\t]~list(bool)\n\t\t\t\t  )~list(bool)^add_list,\n\t\t\t\t  @result~list(bool)^@result\n\t\t\t\t)~list(bool)^conditional,\n\t\t\t\t// Result\n\t\t\t\t@result~list(bool)^@result)~list(bool)\n\t\t\t]~list(list(bool)),\n\t\t\t[\n\t\t\t  __comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tc,\n\t\t\t\t// Target\n\t\t\t\t{}~map(bool, dyn),\n\t\t\t\t// Accumulator\n\t\t\t\t@result,\n\t\t\t\t// Init\n\t\t\t\t[]~list(bool),\n\t\t\t\t// LoopCondition\n\t\t\t\ttrue~bool,\n\t\t\t\t// LoopStep\n\t\t\t\t_?_:_(\n\t\t\t\t  c~bool^c,\n\t\t\t\t  _+_(\n\t\t\t\t\t@result~list(bool)^@result,\n\t\t\t\t\t[\n\t\t\t\t\t  c~bool^c\n\t\t\t\t\t]~list(bool)\n\t\t\t\t  )~list(bool)^add_list,\n\t\t\t\t  @result~list(bool)^@result\n\t\t\t\t)~list(bool)^conditional,\n\t\t\t\t// Result\n\t\t\t\t@result~list(bool)^@result)~list(bool)\n\t\t\t]~list(list(bool))\n\t\t  )~list(list(bool))^add_list",
+      expectedType: "list(list(bool))",
     },
     {
       original: {
@@ -1380,10 +19123,129 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_==_(\n  type(\n    testAllTypes^#*expr.Expr_IdentExpr#.nestedgroup^#*expr.Expr_SelectExpr#.nested_id^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  int^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(testAllTypes.nestedgroup.nested_id) == int",
+      locationAst:
+        "_==_(\n  type(\n    testAllTypes^#2[1,5]#.nestedgroup^#3[1,17]#.nested_id^#4[1,29]#\n  )^#1[1,4]#,\n  int^#6[1,44]#\n)^#5[1,41]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 17, 1, 5, 1, 17],
+        [3, 17, 18, 1, 17, 1, 18],
+        [4, 29, 30, 1, 29, 1, 30],
+        [5, 41, 43, 1, 41, 1, 43],
+        [6, 44, 47, 1, 44, 1, 47],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [
+                    {
+                      id: "4",
+                      selectExpr: {
+                        operand: {
+                          id: "3",
+                          selectExpr: {
+                            operand: {
+                              id: "2",
+                              identExpr: { name: "testAllTypes" },
+                            },
+                            field: "nestedgroup",
+                          },
+                        },
+                        field: "nested_id",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", identExpr: { name: "int" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: { "1": 4, "2": 5, "3": 17, "4": 29, "5": 41, "6": 44 },
+        },
+      },
       checkedAst:
         "_==_(\n  type(\n    testAllTypes~google.expr.proto2.test.TestAllTypes^testAllTypes.nestedgroup~google.expr.proto2.test.TestAllTypes.NestedGroup.nested_id~int\n  )~type(int)^type,\n  int~type(int)^int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["type"] },
+          "2": { name: "testAllTypes" },
+          "5": { overloadId: ["equals"] },
+          "6": { name: "int" },
+        },
+        typeMap: {
+          "1": { type: { primitive: "INT64" } },
+          "2": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "3": {
+            messageType: "google.expr.proto2.test.TestAllTypes.NestedGroup",
+          },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { type: { primitive: "INT64" } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: { "1": 4, "2": 5, "3": 17, "4": 29, "5": 41, "6": 44 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [
+                    {
+                      id: "4",
+                      selectExpr: {
+                        operand: {
+                          id: "3",
+                          selectExpr: {
+                            operand: {
+                              id: "2",
+                              identExpr: { name: "testAllTypes" },
+                            },
+                            field: "nestedgroup",
+                          },
+                        },
+                        field: "nested_id",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", identExpr: { name: "int" } },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "6", max: "1844674407370955269" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such attribute(s): testAllTypes" }],
+        },
+      },
+      runtimeCost: "3",
+      expectedCheckedAst:
+        "_==_(\n\t\t\ttype(\n\t\t\t  testAllTypes~google.expr.proto2.test.TestAllTypes^testAllTypes.nestedgroup~google.expr.proto2.test.TestAllTypes.NestedGroup.nested_id~int\n\t\t\t)~type(int)^type,\n\t\t\tint~type(int)^int\n\t\t  )~bool^equals",
+      expectedType: "bool",
     },
     {
       original: {
@@ -1402,7 +19264,80 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
-      error: "ERROR: :1:2: unsupported syntax '.?'\n | a.?b\n | .^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '_?._(\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "a.?b",
+      locationAst: '_?._(\n  a^#1[1,0]#,\n  "b"^#2[1,3]#\n)^#3[1,1]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+      },
+      checkedAst:
+        '_?._(\n  a~map(string, string)^a,\n  "b"\n)~optional_type(string)^select_optional_field',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "3": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+      },
+      type: "optional_type(string)",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        '_?._(\n\t\t\ta~map(string, string)^a,\n\t\t\t"b"\n\t\t  )~optional_type(string)^select_optional_field',
+      expectedType: "optional_type(string)",
     },
     {
       original: {
@@ -1421,8 +19356,131 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
-      error:
-        "ERROR: :1:7: unsupported syntax '.?'\n | type(a.?b) == optional_type\n | ......^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '_==_(\n  type(\n    _?._(\n      a^#*expr.Expr_IdentExpr#,\n      "b"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  optional_type^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "type(a.?b) == optional_type",
+      locationAst:
+        '_==_(\n  type(\n    _?._(\n      a^#2[1,5]#,\n      "b"^#3[1,8]#\n    )^#4[1,6]#\n  )^#1[1,4]#,\n  optional_type^#6[1,14]#\n)^#5[1,11]#',
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 11, 13, 1, 11, 1, 13],
+        [6, 14, 27, 1, 14, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_?._",
+                        args: [
+                          { id: "2", identExpr: { name: "a" } },
+                          { id: "3", constExpr: { stringValue: "b" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", identExpr: { name: "optional_type" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 6, "5": 11, "6": 14 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  type(\n    _?._(\n      a~map(string, string)^a,\n      "b"\n    )~optional_type(string)^select_optional_field\n  )~type(optional_type(string))^type,\n  optional_type~type(optional_type)^optional_type\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["type"] },
+          "2": { name: "a" },
+          "4": { overloadId: ["select_optional_field"] },
+          "5": { overloadId: ["equals"] },
+          "6": { name: "optional_type" },
+        },
+        typeMap: {
+          "1": {
+            type: {
+              abstractType: {
+                name: "optional_type",
+                parameterTypes: [{ primitive: "STRING" }],
+              },
+            },
+          },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "4": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "5": { primitive: "BOOL" },
+          "6": { type: { abstractType: { name: "optional_type" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 6, "5": 11, "6": 14 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_?._",
+                        args: [
+                          { id: "2", identExpr: { name: "a" } },
+                          { id: "3", constExpr: { stringValue: "b" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", identExpr: { name: "optional_type" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "1844674407370955268" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "3",
+      expectedCheckedAst:
+        '_==_(\n\t\t\t\ttype(\n\t\t\t\t  _?._(\n\t\t\t\t\ta~map(string, string)^a,\n\t\t\t\t\t"b"\n\t\t\t\t  )~optional_type(string)^select_optional_field\n\t\t\t\t)~type(optional_type(string))^type,\n\t\t\t\toptional_type~type(optional_type)^optional_type\n\t\t\t  )~bool^equals',
+      expectedType: "bool",
     },
     {
       original: {
@@ -1448,10 +19506,76 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
-      ast: "a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#",
-      checkedAst:
+      crossTypeNumericComparisons: true,
+      ast: "a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#",
+      unparsed: "a.b",
+      locationAst: "a^#1[1,0]#.b^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "a" } },
+            field: "b",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      checkedAst:
+        "a~optional_type(map(string, string))^a.b~optional_type(string)",
+      checkedExpr: {
+        referenceMap: { "1": { name: "a" } },
+        typeMap: {
+          "1": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [
+                {
+                  mapType: {
+                    keyType: { primitive: "STRING" },
+                    valueType: { primitive: "STRING" },
+                  },
+                },
+              ],
+            },
+          },
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "a" } },
+            field: "b",
+          },
+        },
+      },
+      type: "optional_type(string)",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
         "a~optional_type(map(string, string))^a.b~optional_type(string)",
-      type: "optional_type(string)",
+      expectedType: "optional_type(string)",
     },
     {
       original: {
@@ -1470,9 +19594,67 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "a^#*expr.Expr_IdentExpr#.dynamic^#*expr.Expr_SelectExpr#",
+      unparsed: "a.dynamic",
+      locationAst: "a^#1[1,0]#.dynamic^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "a" } },
+            field: "dynamic",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
       checkedAst: "a~optional_type(dyn)^a.dynamic~optional_type(dyn)",
+      checkedExpr: {
+        referenceMap: { "1": { name: "a" } },
+        typeMap: {
+          "1": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "a" } },
+            field: "dynamic",
+          },
+        },
+      },
       type: "optional_type(dyn)",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "a~optional_type(dyn)^a.dynamic~optional_type(dyn)",
+      expectedType: "optional_type(dyn)",
     },
     {
       original: {
@@ -1491,9 +19673,112 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "a^#*expr.Expr_IdentExpr#.dynamic~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(a.dynamic)",
+      locationAst: "a^#2[1,4]#.dynamic~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "a" } },
+            field: "dynamic",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "2": 4, "3": 5, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "dynamic",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "a" } },
+                field: "dynamic",
+              },
+            },
+          ],
+        },
+      ],
       checkedAst: "a~optional_type(dyn)^a.dynamic~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "a" } },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "2": 4, "3": 5, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "dynamic",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "a" } },
+            field: "dynamic",
+            testOnly: true,
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst: "a~optional_type(dyn)^a.dynamic~test-only~~bool",
+      expectedType: "bool",
     },
     {
       original: {
@@ -1519,17 +19804,527 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
-      error: "ERROR: :1:6: unsupported syntax '.?'\n | has(a.?b.c)\n | .....^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '_?._(\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#.c~test-only~^#*expr.Expr_SelectExpr#',
+      unparsed: "has(a.?b.c)",
+      locationAst:
+        '_?._(\n  a^#2[1,4]#,\n  "b"^#3[1,7]#\n)^#4[1,5]#.c~test-only~^#6[1,3]#',
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "4",
+              callExpr: {
+                function: "_?._",
+                args: [
+                  { id: "2", identExpr: { name: "a" } },
+                  { id: "3", constExpr: { stringValue: "b" } },
+                ],
+              },
+            },
+            field: "c",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "2": 4, "3": 7, "4": 5, "5": 8, "6": 3 },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "4",
+                        callExpr: {
+                          function: "_?._",
+                          args: [
+                            { id: "2", identExpr: { name: "a" } },
+                            { id: "3", constExpr: { stringValue: "b" } },
+                          ],
+                        },
+                      },
+                      field: "c",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 6,
+          function: "has",
+          args: [
+            {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "4",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "2", identExpr: { name: "a" } },
+                      { id: "3", constExpr: { stringValue: "b" } },
+                    ],
+                  },
+                },
+                field: "c",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_?._(\n  a~optional_type(map(string, dyn))^a,\n  "b"\n)~optional_type(dyn)^select_optional_field.c~test-only~~bool',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "a" },
+          "4": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [
+                {
+                  mapType: {
+                    keyType: { primitive: "STRING" },
+                    valueType: { dyn: {} },
+                  },
+                },
+              ],
+            },
+          },
+          "4": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+          "6": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "2": 4, "3": 7, "4": 5, "5": 8, "6": 3 },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "4",
+                        callExpr: {
+                          function: "_?._",
+                          args: [
+                            { id: "2", identExpr: { name: "a" } },
+                            { id: "3", constExpr: { stringValue: "b" } },
+                          ],
+                        },
+                      },
+                      field: "c",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "4",
+              callExpr: {
+                function: "_?._",
+                args: [
+                  { id: "2", identExpr: { name: "a" } },
+                  { id: "3", constExpr: { stringValue: "b" } },
+                ],
+              },
+            },
+            field: "c",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "1",
+      expectedCheckedAst:
+        '_?._(\n\t\t\ta~optional_type(map(string, dyn))^a,\n\t\t\t"b"\n\t\t  )~optional_type(dyn)^select_optional_field.c~test-only~~bool',
+      expectedType: "bool",
     },
     {
       original: { expr: "{?'key': {'a': 'b'}.?value}" },
-      error:
-        "ERROR: :1:2: unsupported syntax '?'\n | {?'key': {'a': 'b'}.?value}\n | .^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '{\n  ?"key"^#*expr.Constant_StringValue#:_?._(\n    {\n      "a"^#*expr.Constant_StringValue#:"b"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "value"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{?"key": {"a": "b"}.?value}',
+      locationAst:
+        '{\n  ?"key"^#3[1,2]#:_?._(\n    {\n      "a"^#6[1,10]#:"b"^#7[1,15]#^#5[1,13]#\n    }^#4[1,9]#,\n    "value"^#8[1,21]#\n  )^#9[1,19]#^#2[1,7]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 2, 7, 1, 2, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 13, 14, 1, 13, 1, 14],
+        [6, 10, 13, 1, 10, 1, 13],
+        [7, 15, 18, 1, 15, 1, 18],
+        [8, 21, 26, 1, 21, 1, 26],
+        [9, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "key" } },
+                value: {
+                  id: "9",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      {
+                        id: "4",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "5",
+                              mapKey: {
+                                id: "6",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "7",
+                                constExpr: { stringValue: "b" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "8", constExpr: { stringValue: "value" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 7,
+            "3": 2,
+            "4": 9,
+            "5": 13,
+            "6": 10,
+            "7": 15,
+            "8": 21,
+            "9": 19,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  ?"key"~string:_?._(\n    {\n      "a"~string:"b"~string\n    }~map(string, string),\n    "value"\n  )~optional_type(string)^select_optional_field\n}~map(string, string)',
+      checkedExpr: {
+        referenceMap: { "9": { overloadId: ["select_optional_field"] } },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "9": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 7,
+            "3": 2,
+            "4": 9,
+            "5": 13,
+            "6": 10,
+            "7": 15,
+            "8": 21,
+            "9": 19,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "key" } },
+                value: {
+                  id: "9",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      {
+                        id: "4",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "5",
+                              mapKey: {
+                                id: "6",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "7",
+                                constExpr: { stringValue: "b" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "8", constExpr: { stringValue: "value" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, string)",
+      cost: { min: "61", max: "61" },
+      result: { value: { mapValue: {} } },
+      runtimeCost: "61",
+      expectedCheckedAst:
+        '{\n\t\t\t?"key"~string:_?._(\n\t\t\t  {\n\t\t\t\t"a"~string:"b"~string\n\t\t\t  }~map(string, string),\n\t\t\t  "value"\n\t\t\t)~optional_type(string)^select_optional_field\n\t\t  }~map(string, string)',
+      expectedType: "map(string, string)",
     },
     {
       original: { expr: "{?'key': {'a': 'b'}.?value}.key" },
-      error:
-        "ERROR: :1:2: unsupported syntax '?'\n | {?'key': {'a': 'b'}.?value}.key\n | .^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '{\n  ?"key"^#*expr.Constant_StringValue#:_?._(\n    {\n      "a"^#*expr.Constant_StringValue#:"b"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "value"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#.key^#*expr.Expr_SelectExpr#',
+      unparsed: '{?"key": {"a": "b"}.?value}.key',
+      locationAst:
+        '{\n  ?"key"^#3[1,2]#:_?._(\n    {\n      "a"^#6[1,10]#:"b"^#7[1,15]#^#5[1,13]#\n    }^#4[1,9]#,\n    "value"^#8[1,21]#\n  )^#9[1,19]#^#2[1,7]#\n}^#1[1,0]#.key^#10[1,27]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 2, 7, 1, 2, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 13, 14, 1, 13, 1, 14],
+        [6, 10, 13, 1, 10, 1, 13],
+        [7, 15, 18, 1, 15, 1, 18],
+        [8, 21, 26, 1, 21, 1, 26],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", constExpr: { stringValue: "key" } },
+                    value: {
+                      id: "9",
+                      callExpr: {
+                        function: "_?._",
+                        args: [
+                          {
+                            id: "4",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "5",
+                                  mapKey: {
+                                    id: "6",
+                                    constExpr: { stringValue: "a" },
+                                  },
+                                  value: {
+                                    id: "7",
+                                    constExpr: { stringValue: "b" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "8", constExpr: { stringValue: "value" } },
+                        ],
+                      },
+                    },
+                    optionalEntry: true,
+                  },
+                ],
+              },
+            },
+            field: "key",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 7,
+            "3": 2,
+            "4": 9,
+            "5": 13,
+            "6": 10,
+            "7": 15,
+            "8": 21,
+            "9": 19,
+            "10": 27,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  ?"key"~string:_?._(\n    {\n      "a"~string:"b"~string\n    }~map(string, string),\n    "value"\n  )~optional_type(string)^select_optional_field\n}~map(string, string).key~string',
+      checkedExpr: {
+        referenceMap: { "9": { overloadId: ["select_optional_field"] } },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "9": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "10": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 7,
+            "3": 2,
+            "4": 9,
+            "5": 13,
+            "6": 10,
+            "7": 15,
+            "8": 21,
+            "9": 19,
+            "10": 27,
+          },
+        },
+        expr: {
+          id: "10",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", constExpr: { stringValue: "key" } },
+                    value: {
+                      id: "9",
+                      callExpr: {
+                        function: "_?._",
+                        args: [
+                          {
+                            id: "4",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "5",
+                                  mapKey: {
+                                    id: "6",
+                                    constExpr: { stringValue: "a" },
+                                  },
+                                  value: {
+                                    id: "7",
+                                    constExpr: { stringValue: "b" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "8", constExpr: { stringValue: "value" } },
+                        ],
+                      },
+                    },
+                    optionalEntry: true,
+                  },
+                ],
+              },
+            },
+            field: "key",
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "62", max: "62" },
+      result: { error: { errors: [{ code: 2, message: "no such key: key" }] } },
+      runtimeCost: "63",
+      expectedCheckedAst:
+        '{\n\t\t\t?"key"~string:_?._(\n\t\t\t  {\n\t\t\t\t"a"~string:"b"~string\n\t\t\t  }~map(string, string),\n\t\t\t  "value"\n\t\t\t)~optional_type(string)^select_optional_field\n\t\t  }~map(string, string).key~string',
+      expectedType: "string",
     },
     {
       original: {
@@ -1555,11 +20350,161 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
-      error: "ERROR: :1:2: unsupported syntax '?'\n | {?'nested': a.b}\n | .^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '{\n  ?"nested"^#*expr.Constant_StringValue#:a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{?"nested": a.b}',
+      locationAst:
+        '{\n  ?"nested"^#3[1,2]#:a^#4[1,12]#.b^#5[1,13]#^#2[1,10]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 2, 10, 1, 2, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "nested" } },
+                value: {
+                  id: "5",
+                  selectExpr: {
+                    operand: { id: "4", identExpr: { name: "a" } },
+                    field: "b",
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 10, "3": 2, "4": 12, "5": 13 },
+        },
+      },
+      checkedAst:
+        '{\n  ?"nested"~string:a~optional_type(map(string, string))^a.b~optional_type(string)\n}~map(string, string)',
+      checkedExpr: {
+        referenceMap: { "4": { name: "a" } },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [
+                {
+                  mapType: {
+                    keyType: { primitive: "STRING" },
+                    valueType: { primitive: "STRING" },
+                  },
+                },
+              ],
+            },
+          },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 10, "3": 2, "4": 12, "5": 13 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "nested" } },
+                value: {
+                  id: "5",
+                  selectExpr: {
+                    operand: { id: "4", identExpr: { name: "a" } },
+                    field: "b",
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, string)",
+      cost: { min: "31", max: "31" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "31",
+      expectedCheckedAst:
+        '{\n\t\t\t?"nested"~string:a~optional_type(map(string, string))^a.b~optional_type(string)\n\t\t  }~map(string, string)',
+      expectedType: "map(string, string)",
     },
     {
       original: { expr: "{?'key': 'hi'}" },
-      error: "ERROR: :1:2: unsupported syntax '?'\n | {?'key': 'hi'}\n | .^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '{\n  ?"key"^#*expr.Constant_StringValue#:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{?"key": "hi"}',
+      locationAst: '{\n  ?"key"^#3[1,2]#:"hi"^#4[1,9]#^#2[1,7]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 2, 7, 1, 2, 1, 7],
+        [4, 9, 13, 1, 9, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "key" } },
+                value: { id: "4", constExpr: { stringValue: "hi" } },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 7, "3": 2, "4": 9 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:10: expected type 'optional_type(string)' but found 'string'\n | {?'key': 'hi'}\n | .........^",
+      errors: [
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(string)' but found 'string'",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:10: expected type 'optional_type(string)' but found 'string'\n\t\t| {?'key': 'hi'}\n\t\t| .........^",
     },
     {
       original: {
@@ -1589,40 +20534,353 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
-      error:
-        "ERROR: :1:2: unsupported syntax '?'\n | [?a, ?b, 'world']\n | .^\nERROR: :1:6: unsupported syntax '?'\n | [?a, ?b, 'world']\n | .....^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '[\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  "world"^#*expr.Constant_StringValue#\n]^#*expr.Expr_ListExpr#',
+      unparsed: '[?a, ?b, "world"]',
+      locationAst:
+        '[\n  a^#2[1,2]#,\n  b^#3[1,6]#,\n  "world"^#4[1,9]#\n]^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 16, 1, 9, 1, 16],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", constExpr: { stringValue: "world" } },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 2, "3": 6, "4": 9 },
+        },
+      },
+      checkedAst:
+        '[\n  a~optional_type(string)^a,\n  b~optional_type(string)^b,\n  "world"~string\n]~list(string)',
+      checkedExpr: {
+        referenceMap: { "2": { name: "a" }, "3": { name: "b" } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 2, "3": 6, "4": 9 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", constExpr: { stringValue: "world" } },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+      },
+      type: "list(string)",
+      cost: { min: "12", max: "12" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): a" }] },
+      },
+      runtimeCost: "11",
+      expectedCheckedAst:
+        '[\n\t\t\ta~optional_type(string)^a,\n\t\t\tb~optional_type(string)^b,\n\t\t\t"world"~string\n\t\t  ]~list(string)',
+      expectedType: "list(string)",
     },
     {
       original: { expr: "[?'value']" },
-      error: "ERROR: :1:2: unsupported syntax '?'\n | [?'value']\n | .^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: '[\n  "value"^#*expr.Constant_StringValue#\n]^#*expr.Expr_ListExpr#',
+      unparsed: '[?"value"]',
+      locationAst: '[\n  "value"^#2[1,2]#\n]^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 9, 1, 2, 1, 9],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [{ id: "2", constExpr: { stringValue: "value" } }],
+            optionalIndices: [0],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 2 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:3: expected type 'optional_type(string)' but found 'string'\n | [?'value']\n | ..^",
+      errors: [
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(string)' but found 'string'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:3: expected type 'optional_type(string)' but found 'string'\n\t\t| [?'value']\n\t\t| ..^",
     },
     {
       original: {
         expr: "TestAllTypes{?single_int32: {}.?i}",
         container: "google.expr.proto2.test",
       },
-      error:
-        "ERROR: :1:14: unsupported syntax '?'\n | TestAllTypes{?single_int32: {}.?i}\n | .............^",
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: 'TestAllTypes{\n  ?single_int32:_?._(\n    {}^#*expr.Expr_StructExpr#,\n    "i"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: "TestAllTypes{?single_int32: {}.?i}",
+      locationAst:
+        'TestAllTypes{\n  ?single_int32:_?._(\n    {}^#3[1,28]#,\n    "i"^#4[1,32]#\n  )^#5[1,30]#^#2[1,26]#\n}^#1[1,12]#',
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 26, 27, 1, 26, 1, 27],
+        [3, 28, 29, 1, 28, 1, 29],
+        [4, 32, 33, 1, 32, 1, 33],
+        [5, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: {
+                  id: "5",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "3", structExpr: {} },
+                      { id: "4", constExpr: { stringValue: "i" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 12, "2": 26, "3": 28, "4": 32, "5": 30 },
+        },
+      },
+      checkedAst:
+        'google.expr.proto2.test.TestAllTypes{\n  ?single_int32:_?._(\n    {}~map(dyn, int),\n    "i"\n  )~optional_type(int)^select_optional_field\n}~google.expr.proto2.test.TestAllTypes^google.expr.proto2.test.TestAllTypes',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto2.test.TestAllTypes" },
+          "5": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "3": {
+            mapType: {
+              keyType: { dyn: {} },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 12, "2": 26, "3": 28, "4": 32, "5": 30 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto2.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: {
+                  id: "5",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "3", structExpr: {} },
+                      { id: "4", constExpr: { stringValue: "i" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto2.test.TestAllTypes",
+      cost: { min: "71", max: "71" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.expr.proto2.test.TestAllTypes",
+          },
+        },
+      },
+      runtimeCost: "71",
+      expectedCheckedAst:
+        'google.expr.proto2.test.TestAllTypes{\n\t\t\t?single_int32:_?._(\n\t\t\t  {}~map(dyn, int),\n\t\t\t  "i"\n\t\t\t)~optional_type(int)^select_optional_field\n\t\t  }~google.expr.proto2.test.TestAllTypes^google.expr.proto2.test.TestAllTypes',
+      expectedType: "google.expr.proto2.test.TestAllTypes",
     },
     {
       original: {
         expr: "TestAllTypes{?single_int32: 1}",
         container: "google.expr.proto2.test",
       },
+      optionalSyntax: true,
+      crossTypeNumericComparisons: true,
+      ast: "TestAllTypes{\n  ?single_int32:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "TestAllTypes{?single_int32: 1}",
+      locationAst:
+        "TestAllTypes{\n  ?single_int32:1^#3[1,28]#^#2[1,26]#\n}^#1[1,12]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 26, 27, 1, 26, 1, 27],
+        [3, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 12, "2": 26, "3": 28 },
+        },
+      },
       error:
-        "ERROR: :1:14: unsupported syntax '?'\n | TestAllTypes{?single_int32: 1}\n | .............^",
+        "ERROR: \u003cinput\u003e:1:29: expected type 'optional_type(int)' but found 'int'\n | TestAllTypes{?single_int32: 1}\n | ............................^",
+      errors: [
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(int)' but found 'int'",
+          id: 3,
+          offset: 28,
+          line: 1,
+          column: 28,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:29: expected type 'optional_type(int)' but found 'int'\n\t\t| TestAllTypes{?single_int32: 1}\n\t\t| ............................^",
     },
     {
       original: { expr: "undef" },
+      crossTypeNumericComparisons: true,
       ast: "undef^#*expr.Expr_IdentExpr#",
+      unparsed: "undef",
+      locationAst: "undef^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "undef" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'undef' (in container '')\n | undef\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'undef' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'undef' (in container '')\n\t\t\t| undef\n\t\t\t| ^",
     },
     {
       original: { expr: "undef()" },
+      crossTypeNumericComparisons: true,
       ast: "undef()^#*expr.Expr_CallExpr#",
+      unparsed: "undef()",
+      locationAst: "undef()^#1[1,5]#",
+      positions: [[1, 5, 6, 1, 5, 1, 6]],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: { id: "1", callExpr: { function: "undef" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 5 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:6: undeclared reference to 'undef' (in container '')\n | undef()\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'undef' (in container '')",
+          id: 1,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:6: undeclared reference to 'undef' (in container '')\n\t\t\t| undef()\n\t\t\t| .....^",
     },
     {
       original: {
@@ -1637,10 +20895,245 @@ export const tests: SerializedIncrementalTestSuite = {
           },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "_||_(\n  _||_(\n    _==_(\n      null_int^#*expr.Expr_IdentExpr#,\n      null^#*expr.Constant_NullValue#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      null^#*expr.Constant_NullValue#,\n      null_int^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    _==_(\n      null_msg^#*expr.Expr_IdentExpr#,\n      null^#*expr.Constant_NullValue#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      null^#*expr.Constant_NullValue#,\n      null_msg^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "null_int == null || null == null_int || null_msg == null || null == null_msg",
+      locationAst:
+        "_||_(\n  _||_(\n    _==_(\n      null_int^#1[1,0]#,\n      null^#3[1,12]#\n    )^#2[1,9]#,\n    _==_(\n      null^#4[1,20]#,\n      null_int^#6[1,28]#\n    )^#5[1,25]#\n  )^#7[1,17]#,\n  _||_(\n    _==_(\n      null_msg^#8[1,40]#,\n      null^#10[1,52]#\n    )^#9[1,49]#,\n    _==_(\n      null^#12[1,60]#,\n      null_msg^#14[1,68]#\n    )^#13[1,65]#\n  )^#15[1,57]#\n)^#11[1,37]#",
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 9, 11, 1, 9, 1, 11],
+        [3, 12, 16, 1, 12, 1, 16],
+        [4, 20, 24, 1, 20, 1, 24],
+        [5, 25, 27, 1, 25, 1, 27],
+        [6, 28, 36, 1, 28, 1, 36],
+        [7, 17, 19, 1, 17, 1, 19],
+        [8, 40, 48, 1, 40, 1, 48],
+        [9, 49, 51, 1, 49, 1, 51],
+        [10, 52, 56, 1, 52, 1, 56],
+        [11, 37, 39, 1, 37, 1, 39],
+        [12, 60, 64, 1, 60, 1, 64],
+        [13, 65, 67, 1, 65, 1, 67],
+        [14, 68, 76, 1, 68, 1, 76],
+        [15, 57, 59, 1, 57, 1, 59],
+      ],
+      lineOffsets: [77],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "1", identExpr: { name: "null_int" } },
+                          { id: "3", constExpr: { nullValue: null } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "4", constExpr: { nullValue: null } },
+                          { id: "6", identExpr: { name: "null_int" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "8", identExpr: { name: "null_msg" } },
+                          { id: "10", constExpr: { nullValue: null } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "13",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "12", constExpr: { nullValue: null } },
+                          { id: "14", identExpr: { name: "null_msg" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [77],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 12,
+            "4": 20,
+            "5": 25,
+            "6": 28,
+            "7": 17,
+            "8": 40,
+            "9": 49,
+            "10": 52,
+            "11": 37,
+            "12": 60,
+            "13": 65,
+            "14": 68,
+            "15": 57,
+          },
+        },
+      },
       checkedAst:
         "_||_(\n  _||_(\n    _==_(\n      null_int~wrapper(int)^null_int,\n      null~null\n    )~bool^equals,\n    _==_(\n      null~null,\n      null_int~wrapper(int)^null_int\n    )~bool^equals\n  )~bool^logical_or,\n  _||_(\n    _==_(\n      null_msg~google.expr.proto2.test.TestAllTypes^null_msg,\n      null~null\n    )~bool^equals,\n    _==_(\n      null~null,\n      null_msg~google.expr.proto2.test.TestAllTypes^null_msg\n    )~bool^equals\n  )~bool^logical_or\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "null_int" },
+          "2": { overloadId: ["equals"] },
+          "5": { overloadId: ["equals"] },
+          "6": { name: "null_int" },
+          "7": { overloadId: ["logical_or"] },
+          "8": { name: "null_msg" },
+          "9": { overloadId: ["equals"] },
+          "11": { overloadId: ["logical_or"] },
+          "13": { overloadId: ["equals"] },
+          "14": { name: "null_msg" },
+          "15": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { wrapper: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { null: null },
+          "4": { null: null },
+          "5": { primitive: "BOOL" },
+          "6": { wrapper: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "9": { primitive: "BOOL" },
+          "10": { null: null },
+          "11": { primitive: "BOOL" },
+          "12": { null: null },
+          "13": { primitive: "BOOL" },
+          "14": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "15": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [77],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 12,
+            "4": 20,
+            "5": 25,
+            "6": 28,
+            "7": 17,
+            "8": 40,
+            "9": 49,
+            "10": 52,
+            "11": 37,
+            "12": 60,
+            "13": 65,
+            "14": 68,
+            "15": 57,
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "1", identExpr: { name: "null_int" } },
+                          { id: "3", constExpr: { nullValue: null } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "4", constExpr: { nullValue: null } },
+                          { id: "6", identExpr: { name: "null_int" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "8", identExpr: { name: "null_msg" } },
+                          { id: "10", constExpr: { nullValue: null } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "13",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "12", constExpr: { nullValue: null } },
+                          { id: "14", identExpr: { name: "null_msg" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "2", max: "8" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such attribute(s): null_int" }],
+        },
+      },
+      runtimeCost: "8",
+      expectedType: "bool",
     },
     {
       original: {
@@ -1649,16 +21142,304 @@ export const tests: SerializedIncrementalTestSuite = {
           { name: "NotAMessage", ident: { type: { wrapper: "INT64" } } },
         ],
       },
+      crossTypeNumericComparisons: true,
       ast: "NotAMessage{}^#*expr.Expr_StructExpr#",
+      unparsed: "NotAMessage{}",
+      locationAst: "NotAMessage{}^#1[1,11]#",
+      positions: [[1, 11, 12, 1, 11, 1, 12]],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: { id: "1", structExpr: { messageName: "NotAMessage" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 11 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:12: 'wrapper(int)' is not a type\n | NotAMessage{}\n | ...........^",
+      errors: [
+        {
+          category: "other",
+          message: "'wrapper(int)' is not a type",
+          id: 1,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:12: 'wrapper(int)' is not a type\n\t\t\t| NotAMessage{}\n\t\t\t| ...........^",
     },
     {
       original: { expr: "{}.map(c,[c,type(c)])" },
+      crossTypeNumericComparisons: true,
       ast: "__comprehension__(\n  // Variable\n  c,\n  // Target\n  {}^#*expr.Expr_StructExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      [\n        c^#*expr.Expr_IdentExpr#,\n        type(\n          c^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "{}.map(c, [c, type(c)])",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  c,\n  // Target\n  {}^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#8[1,6]#,\n  // LoopCondition\n  true^#9[1,6]#,\n  // LoopStep\n  _+_(\n    @result^#10[1,6]#,\n    [\n      [\n        c^#5[1,10]#,\n        type(\n          c^#7[1,17]#\n        )^#6[1,16]#\n      ]^#4[1,9]#\n    ]^#11[1,6]#\n  )^#12[1,6]#,\n  // Result\n  @result^#13[1,6]#)^#14[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 16, 17, 1, 16, 1, 17],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 6, 6, 1, 6, 1, 6],
+        [9, 6, 6, 1, 6, 1, 6],
+        [10, 6, 6, 1, 6, 1, 6],
+        [11, 6, 6, 1, 6, 1, 6],
+        [12, 6, 6, 1, 6, 1, 6],
+        [13, 6, 6, 1, 6, 1, 6],
+        [14, 6, 6, 1, 6, 1, 6],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          comprehensionExpr: {
+            iterVar: "c",
+            iterRange: { id: "1", structExpr: {} },
+            accuVar: "@result",
+            accuInit: { id: "8", listExpr: {} },
+            loopCondition: { id: "9", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "12",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  {
+                    id: "11",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              { id: "5", identExpr: { name: "c" } },
+                              {
+                                id: "6",
+                                callExpr: {
+                                  function: "type",
+                                  args: [{ id: "7", identExpr: { name: "c" } }],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "13", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "3": 7,
+            "4": 9,
+            "5": 10,
+            "6": 16,
+            "7": 17,
+            "8": 6,
+            "9": 6,
+            "10": 6,
+            "11": 6,
+            "12": 6,
+            "13": 6,
+            "14": 6,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "1", structExpr: {} },
+                function: "map",
+                args: [
+                  { id: "3", identExpr: { name: "c" } },
+                  {
+                    id: "4",
+                    listExpr: {
+                      elements: [
+                        { id: "5", identExpr: { name: "c" } },
+                        {
+                          id: "6",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "7", identExpr: { name: "c" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "map",
+          target: { id: "1", structExpr: {} },
+          args: [
+            { id: "3", identExpr: { name: "c" } },
+            {
+              id: "4",
+              listExpr: {
+                elements: [
+                  { id: "5", identExpr: { name: "c" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "type",
+                      args: [{ id: "7", identExpr: { name: "c" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
       checkedAst:
         "__comprehension__(\n  // Variable\n  c,\n  // Target\n  {}~map(dyn, dyn),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(dyn)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(list(dyn))^@result,\n    [\n      [\n        c~dyn^c,\n        type(\n          c~dyn^c\n        )~type(dyn)^type\n      ]~list(dyn)\n    ]~list(list(dyn))\n  )~list(list(dyn))^add_list,\n  // Result\n  @result~list(list(dyn))^@result)~list(list(dyn))",
+      checkedExpr: {
+        referenceMap: {
+          "5": { name: "c" },
+          "6": { overloadId: ["type"] },
+          "7": { name: "c" },
+          "10": { name: "@result" },
+          "12": { overloadId: ["add_list"] },
+          "13": { name: "@result" },
+        },
+        typeMap: {
+          "1": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { dyn: {} },
+          "6": { type: { dyn: {} } },
+          "7": { dyn: {} },
+          "8": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "9": { primitive: "BOOL" },
+          "10": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "11": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "12": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "13": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "14": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "3": 7,
+            "4": 9,
+            "5": 10,
+            "6": 16,
+            "7": 17,
+            "8": 6,
+            "9": 6,
+            "10": 6,
+            "11": 6,
+            "12": 6,
+            "13": 6,
+            "14": 6,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "1", structExpr: {} },
+                function: "map",
+                args: [
+                  { id: "3", identExpr: { name: "c" } },
+                  {
+                    id: "4",
+                    listExpr: {
+                      elements: [
+                        { id: "5", identExpr: { name: "c" } },
+                        {
+                          id: "6",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "7", identExpr: { name: "c" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "14",
+          comprehensionExpr: {
+            iterVar: "c",
+            iterRange: { id: "1", structExpr: {} },
+            accuVar: "@result",
+            accuInit: { id: "8", listExpr: {} },
+            loopCondition: { id: "9", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "12",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  {
+                    id: "11",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              { id: "5", identExpr: { name: "c" } },
+                              {
+                                id: "6",
+                                callExpr: {
+                                  function: "type",
+                                  args: [{ id: "7", identExpr: { name: "c" } }],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "13", identExpr: { name: "@result" } },
+          },
+        },
+      },
       type: "list(list(dyn))",
+      cost: { min: "41", max: "41" },
+      result: { value: { listValue: {} } },
+      runtimeCost: "41",
+      expectedCheckedAst:
+        "__comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tc,\n\t\t\t\t// Target\n\t\t\t\t{}~map(dyn, dyn),\n\t\t\t\t// Accumulator\n\t\t\t\t@result,\n\t\t\t\t// Init\n\t\t\t\t[]~list(list(dyn)),\n\t\t\t\t// LoopCondition\n\t\t\t\ttrue~bool,\n\t\t\t\t// LoopStep\n\t\t\t\t_+_(\n\t\t\t\t  @result~list(list(dyn))^@result,\n\t\t\t\t  [\n\t\t\t\t\t[\n\t\t\t\t\t  c~dyn^c,\n\t\t\t\t\t  type(\n\t\t\t\t\t\tc~dyn^c\n\t\t\t\t\t  )~type(dyn)^type\n\t\t\t\t\t]~list(dyn)\n\t\t\t\t  ]~list(list(dyn))\n\t\t\t\t)~list(list(dyn))^add_list,\n\t\t\t\t// Result\n\t\t\t\t@result~list(list(dyn))^@result)~list(list(dyn))",
+      expectedType: "list(list(dyn))",
     },
   ],
 } as const;
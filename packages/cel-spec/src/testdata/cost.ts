@@ -0,0 +1,16797 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/checker/cost_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "cost",
+  tests: [
+    {
+      original: { name: "const", expr: '"Hello World!"', checkOnly: true },
+      ast: '"Hello World!"^#*expr.Constant_StringValue#',
+      unparsed: '"Hello World!"',
+      locationAst: '"Hello World!"^#1[1,0]#',
+      positions: [[1, 0, 14, 1, 0, 1, 14]],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "Hello World!" } },
+        sourceInfo: {
+          location: "const",
+          lineOffsets: [15],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: '"Hello World!"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "Hello World!" } },
+      },
+      type: "string",
+      cost: { min: "0", max: "0" },
+      expectedCost: { min: "0", max: "0" },
+    },
+    {
+      original: {
+        name: "identity",
+        expr: "input",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+      },
+      ast: "input^#*expr.Expr_IdentExpr#",
+      unparsed: "input",
+      locationAst: "input^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "input" } },
+        sourceInfo: {
+          location: "identity",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "input~list(int)^input",
+      checkedExpr: {
+        referenceMap: { "1": { name: "input" } },
+        typeMap: { "1": { listType: { elemType: { primitive: "INT64" } } } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "input" } },
+      },
+      type: "list(int)",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: {
+        name: "select: map",
+        expr: "input['key']",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: '_[_](\n  input^#*expr.Expr_IdentExpr#,\n  "key"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input["key"]',
+      locationAst: '_[_](\n  input^#1[1,0]#,\n  "key"^#3[1,6]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 6, 11, 1, 6, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              { id: "3", constExpr: { stringValue: "key" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select: map",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 6 },
+        },
+      },
+      checkedAst:
+        '_[_](\n  input~map(string, string)^input,\n  "key"~string\n)~string^index_map',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["index_map"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 6 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              { id: "3", constExpr: { stringValue: "key" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "2", max: "2" },
+      expectedCost: { min: "2", max: "2" },
+    },
+    {
+      original: {
+        name: "select: field",
+        expr: "input.single_int32",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.single_int32^#*expr.Expr_SelectExpr#",
+      unparsed: "input.single_int32",
+      locationAst: "input^#1[1,0]#.single_int32^#2[1,5]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "input" } },
+            field: "single_int32",
+          },
+        },
+        sourceInfo: {
+          location: "select: field",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 5 },
+        },
+      },
+      checkedAst:
+        "input~google.expr.proto3.test.TestAllTypes^input.single_int32~int",
+      checkedExpr: {
+        referenceMap: { "1": { name: "input" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 5 },
+        },
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "input" } },
+            field: "single_int32",
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      expectedCost: { min: "2", max: "2" },
+    },
+    {
+      original: {
+        name: "select: field test only no has() cost",
+        expr: "has(input.single_int32)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      presenceTestHasCost: false,
+      ast: "input^#*expr.Expr_IdentExpr#.single_int32~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(input.single_int32)",
+      locationAst: "input^#2[1,4]#.single_int32~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "input" } },
+            field: "single_int32",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "select: field test only no has() cost",
+          lineOffsets: [24],
+          positions: { "2": 4, "3": 9, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "input" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "single_int32",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "input~google.expr.proto3.test.TestAllTypes^input.single_int32~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "input" } },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "2": 4, "3": 9, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "input" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "input" } },
+            field: "single_int32",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: {
+        name: "select: field test only",
+        expr: "has(input.single_int32)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.single_int32~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(input.single_int32)",
+      locationAst: "input^#2[1,4]#.single_int32~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "input" } },
+            field: "single_int32",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "select: field test only",
+          lineOffsets: [24],
+          positions: { "2": 4, "3": 9, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "input" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "single_int32",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "input~google.expr.proto3.test.TestAllTypes^input.single_int32~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "input" } },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "2": 4, "3": 9, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "input" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "input" } },
+            field: "single_int32",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      expectedCost: { min: "2", max: "2" },
+    },
+    {
+      original: {
+        name: "select: non-proto field test has() cost",
+        expr: "has(input.testAttr.nestedAttr)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      presenceTestHasCost: true,
+      ast: "input^#*expr.Expr_IdentExpr#.testAttr^#*expr.Expr_SelectExpr#.nestedAttr~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(input.testAttr.nestedAttr)",
+      locationAst:
+        "input^#2[1,4]#.testAttr^#3[1,9]#.nestedAttr~test-only~^#5[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "select: non-proto field test has() cost",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "input" } },
+                    field: "testAttr",
+                  },
+                },
+                field: "nestedAttr",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input.testAttr~map(string, google.expr.proto3.test.TestAllTypes).nestedAttr~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "input" } },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      expectedCost: { min: "3", max: "3" },
+    },
+    {
+      original: {
+        name: "select: non-proto field test no has() cost",
+        expr: "has(input.testAttr.nestedAttr)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      presenceTestHasCost: false,
+      ast: "input^#*expr.Expr_IdentExpr#.testAttr^#*expr.Expr_SelectExpr#.nestedAttr~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(input.testAttr.nestedAttr)",
+      locationAst:
+        "input^#2[1,4]#.testAttr^#3[1,9]#.nestedAttr~test-only~^#5[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "select: non-proto field test no has() cost",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "input" } },
+                    field: "testAttr",
+                  },
+                },
+                field: "nestedAttr",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input.testAttr~map(string, google.expr.proto3.test.TestAllTypes).nestedAttr~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "input" } },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      expectedCost: { min: "2", max: "2" },
+    },
+    {
+      original: {
+        name: "select: non-proto field test",
+        expr: "has(input.testAttr.nestedAttr)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.testAttr^#*expr.Expr_SelectExpr#.nestedAttr~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(input.testAttr.nestedAttr)",
+      locationAst:
+        "input^#2[1,4]#.testAttr^#3[1,9]#.nestedAttr~test-only~^#5[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "select: non-proto field test",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "input" } },
+                    field: "testAttr",
+                  },
+                },
+                field: "nestedAttr",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input.testAttr~map(string, google.expr.proto3.test.TestAllTypes).nestedAttr~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "input" } },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      expectedCost: { min: "3", max: "3" },
+    },
+    {
+      original: {
+        name: "estimated function call",
+        expr: "input.getFullYear()",
+        checkOnly: true,
+        typeEnv: [
+          { name: "input", ident: { type: { wellKnown: "TIMESTAMP" } } },
+        ],
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.getFullYear()^#*expr.Expr_CallExpr#",
+      unparsed: "input.getFullYear()",
+      locationAst: "input^#1[1,0]#.getFullYear()^#2[1,17]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "getFullYear",
+          },
+        },
+        sourceInfo: {
+          location: "estimated function call",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 17 },
+        },
+      },
+      checkedAst: "input~timestamp^input.getFullYear()~int^timestamp_to_year",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["timestamp_to_year"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 17 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "getFullYear",
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "8", max: "8" },
+      expectedCost: { min: "8", max: "8" },
+    },
+    {
+      original: { name: "create list", expr: "[1, 2, 3]", checkOnly: true },
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 2, 3]",
+      locationAst: "[\n  1^#2[1,1]#,\n  2^#3[1,4]#,\n  3^#4[1,7]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "create list",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+      },
+      checkedAst: "[\n  1~int,\n  2~int,\n  3~int\n]~list(int)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "10", max: "10" },
+      expectedCost: { min: "10", max: "10" },
+    },
+    {
+      original: {
+        name: "create struct",
+        expr: "google.expr.proto3.test.TestAllTypes{single_int32: 1, single_float: 3.14, single_string: 'str'}",
+        checkOnly: true,
+      },
+      ast: 'google.expr.proto3.test.TestAllTypes{\n  single_int32:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  single_float:3.14^#*expr.Constant_DoubleValue#^#*expr.Expr_CreateStruct_Entry#,\n  single_string:"str"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed:
+        'google.expr.proto3.test.TestAllTypes{single_int32: 1, single_float: 3.14, single_string: "str"}',
+      locationAst:
+        'google.expr.proto3.test.TestAllTypes{\n  single_int32:1^#3[1,51]#^#2[1,49]#,\n  single_float:3.14^#5[1,68]#^#4[1,66]#,\n  single_string:"str"^#7[1,89]#^#6[1,87]#\n}^#1[1,36]#',
+      positions: [
+        [1, 36, 37, 1, 36, 1, 37],
+        [2, 49, 50, 1, 49, 1, 50],
+        [3, 51, 52, 1, 51, 1, 52],
+        [4, 66, 67, 1, 66, 1, 67],
+        [5, 68, 72, 1, 68, 1, 72],
+        [6, 87, 88, 1, 87, 1, 88],
+        [7, 89, 94, 1, 89, 1, 94],
+      ],
+      lineOffsets: [96],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "single_float",
+                value: { id: "5", constExpr: { doubleValue: 3.14 } },
+              },
+              {
+                id: "6",
+                fieldKey: "single_string",
+                value: { id: "7", constExpr: { stringValue: "str" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "create struct",
+          lineOffsets: [96],
+          positions: {
+            "1": 36,
+            "2": 49,
+            "3": 51,
+            "4": 66,
+            "5": 68,
+            "6": 87,
+            "7": 89,
+          },
+        },
+      },
+      checkedAst:
+        'google.expr.proto3.test.TestAllTypes{\n  single_int32:1~int,\n  single_float:3.14~double,\n  single_string:"str"~string\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes',
+      checkedExpr: {
+        referenceMap: { "1": { name: "google.expr.proto3.test.TestAllTypes" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { primitive: "INT64" },
+          "5": { primitive: "DOUBLE" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [96],
+          positions: {
+            "1": 36,
+            "2": 49,
+            "3": 51,
+            "4": 66,
+            "5": 68,
+            "6": 87,
+            "7": 89,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "single_float",
+                value: { id: "5", constExpr: { doubleValue: 3.14 } },
+              },
+              {
+                id: "6",
+                fieldKey: "single_string",
+                value: { id: "7", constExpr: { stringValue: "str" } },
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "40", max: "40" },
+      expectedCost: { min: "40", max: "40" },
+    },
+    {
+      original: {
+        name: "create map",
+        expr: '{"a": 1, "b": 2, "c": 3}',
+        checkOnly: true,
+      },
+      ast: '{\n  "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  "b"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  "c"^#*expr.Constant_StringValue#:3^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{"a": 1, "b": 2, "c": 3}',
+      locationAst:
+        '{\n  "a"^#3[1,1]#:1^#4[1,6]#^#2[1,4]#,\n  "b"^#6[1,9]#:2^#7[1,14]#^#5[1,12]#,\n  "c"^#9[1,17]#:3^#10[1,22]#^#8[1,20]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 9, 12, 1, 9, 1, 12],
+        [7, 14, 15, 1, 14, 1, 15],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 17, 20, 1, 17, 1, 20],
+        [10, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: { id: "4", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { stringValue: "b" } },
+                value: { id: "7", constExpr: { int64Value: "2" } },
+              },
+              {
+                id: "8",
+                mapKey: { id: "9", constExpr: { stringValue: "c" } },
+                value: { id: "10", constExpr: { int64Value: "3" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "create map",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 12,
+            "6": 9,
+            "7": 14,
+            "8": 20,
+            "9": 17,
+            "10": 22,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  "a"~string:1~int,\n  "b"~string:2~int,\n  "c"~string:3~int\n}~map(string, int)',
+      checkedExpr: {
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "INT64" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 12,
+            "6": 9,
+            "7": 14,
+            "8": 20,
+            "9": 17,
+            "10": 22,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: { id: "4", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { stringValue: "b" } },
+                value: { id: "7", constExpr: { int64Value: "2" } },
+              },
+              {
+                id: "8",
+                mapKey: { id: "9", constExpr: { stringValue: "c" } },
+                value: { id: "10", constExpr: { int64Value: "3" } },
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, int)",
+      cost: { min: "30", max: "30" },
+      expectedCost: { min: "30", max: "30" },
+    },
+    {
+      original: {
+        name: "all comprehension",
+        expr: "input.all(x, true)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                listType: {
+                  elemType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      sizeHints: { input: 100 },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(x, true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#5[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#6[1,9]#\n  )^#7[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#8[1,9]#,\n    true^#4[1,13]#\n  )^#9[1,9]#,\n  // Result\n  @result^#10[1,9]#)^#11[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 17, 1, 13, 1, 17],
+        [5, 9, 9, 1, 9, 1, 9],
+        [6, 9, 9, 1, 9, 1, 9],
+        [7, 9, 9, 1, 9, 1, 9],
+        [8, 9, 9, 1, 9, 1, 9],
+        [9, 9, 9, 1, 9, 1, 9],
+        [10, 9, 9, 1, 9, 1, 9],
+        [11, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "7",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "6", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "8", identExpr: { name: "@result" } },
+                  { id: "4", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "all comprehension",
+          lineOffsets: [19],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 9,
+            "6": 9,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "x" } },
+                  { id: "4", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 11,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "x" } },
+            { id: "4", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input~list(google.expr.proto3.test.TestAllTypes)^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "6": { name: "@result" },
+          "7": { overloadId: ["not_strictly_false"] },
+          "8": { name: "@result" },
+          "9": { overloadId: ["logical_and"] },
+          "10": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 9,
+            "6": 9,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "x" } },
+                  { id: "4", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "7",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "6", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "8", identExpr: { name: "@result" } },
+                  { id: "4", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "302" },
+      expectedCost: { min: "2", max: "302" },
+    },
+    {
+      original: {
+        name: "nested all comprehension",
+        expr: "input.all(x, x.all(y, true))",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                listType: {
+                  elemType: {
+                    listType: {
+                      elemType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      sizeHints: { input: 50, "input.@items": 10 },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      x^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        true^#*expr.Constant_BoolValue#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(x, x.all(y, true))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#15[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#16[1,9]#\n  )^#17[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#18[1,9]#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      x^#4[1,13]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#8[1,18]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#9[1,18]#\n      )^#10[1,18]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#11[1,18]#,\n        true^#7[1,22]#\n      )^#12[1,18]#,\n      // Result\n      @result^#13[1,18]#)^#14[1,18]#\n  )^#19[1,9]#,\n  // Result\n  @result^#20[1,9]#)^#21[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 14, 1, 13, 1, 14],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 22, 26, 1, 22, 1, 26],
+        [8, 18, 18, 1, 18, 1, 18],
+        [9, 18, 18, 1, 18, 1, 18],
+        [10, 18, 18, 1, 18, 1, 18],
+        [11, 18, 18, 1, 18, 1, 18],
+        [12, 18, 18, 1, 18, 1, 18],
+        [13, 18, 18, 1, 18, 1, 18],
+        [14, 18, 18, 1, 18, 1, 18],
+        [15, 9, 9, 1, 9, 1, 9],
+        [16, 9, 9, 1, 9, 1, 9],
+        [17, 9, 9, 1, 9, 1, 9],
+        [18, 9, 9, 1, 9, 1, 9],
+        [19, 9, 9, 1, 9, 1, 9],
+        [20, 9, 9, 1, 9, 1, 9],
+        [21, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "21",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "17",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "16", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "19",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "18", identExpr: { name: "@result" } },
+                  {
+                    id: "14",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "4", identExpr: { name: "x" } },
+                      accuVar: "@result",
+                      accuInit: { id: "8", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "10",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "9", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "12",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "11", identExpr: { name: "@result" } },
+                            { id: "7", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      result: { id: "13", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "20", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "nested all comprehension",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "6": 19,
+            "7": 22,
+            "8": 18,
+            "9": 18,
+            "10": 18,
+            "11": 18,
+            "12": 18,
+            "13": 18,
+            "14": 18,
+            "15": 9,
+            "16": 9,
+            "17": 9,
+            "18": 9,
+            "19": 9,
+            "20": 9,
+            "21": 9,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "4", identExpr: { name: "x" } },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "y" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "21": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [{ id: "3", identExpr: { name: "x" } }, { id: "14" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "all",
+          target: { id: "4", identExpr: { name: "x" } },
+          args: [
+            { id: "6", identExpr: { name: "y" } },
+            { id: "7", constExpr: { boolValue: true } },
+          ],
+        },
+        {
+          id: 21,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [{ id: "3", identExpr: { name: "x" } }, { id: "14" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input~list(list(google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      x~list(google.expr.proto3.test.TestAllTypes)^x,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        true~bool\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "x" },
+          "9": { name: "@result" },
+          "10": { overloadId: ["not_strictly_false"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["logical_and"] },
+          "13": { name: "@result" },
+          "16": { name: "@result" },
+          "17": { overloadId: ["not_strictly_false"] },
+          "18": { name: "@result" },
+          "19": { overloadId: ["logical_and"] },
+          "20": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "6": 19,
+            "7": 22,
+            "8": 18,
+            "9": 18,
+            "10": 18,
+            "11": 18,
+            "12": 18,
+            "13": 18,
+            "14": 18,
+            "15": 9,
+            "16": 9,
+            "17": 9,
+            "18": 9,
+            "19": 9,
+            "20": 9,
+            "21": 9,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "4", identExpr: { name: "x" } },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "y" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "21": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [{ id: "3", identExpr: { name: "x" } }, { id: "14" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "21",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "17",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "16", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "19",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "18", identExpr: { name: "@result" } },
+                  {
+                    id: "14",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "4", identExpr: { name: "x" } },
+                      accuVar: "@result",
+                      accuInit: { id: "8", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "10",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "9", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "12",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "11", identExpr: { name: "@result" } },
+                            { id: "7", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      result: { id: "13", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "20", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "1752" },
+      expectedCost: { min: "2", max: "1752" },
+    },
+    {
+      original: {
+        name: "all comprehension on literal",
+        expr: "[1, 2, 3].all(x, true)",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].all(x, true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#8[1,13]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#9[1,13]#\n  )^#10[1,13]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#11[1,13]#,\n    true^#7[1,17]#\n  )^#12[1,13]#,\n  // Result\n  @result^#13[1,13]#)^#14[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 21, 1, 17, 1, 21],
+        [8, 13, 13, 1, 13, 1, 13],
+        [9, 13, 13, 1, 13, 1, 13],
+        [10, 13, 13, 1, 13, 1, 13],
+        [11, 13, 13, 1, 13, 1, 13],
+        [12, 13, 13, 1, 13, 1, 13],
+        [13, 13, 13, 1, 13, 1, 13],
+        [14, 13, 13, 1, 13, 1, 13],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "8", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "10",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "9", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "12",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "11", identExpr: { name: "@result" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "13", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "all comprehension on literal",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 13,
+            "9": 13,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "all",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            { id: "7", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "@result" },
+          "10": { overloadId: ["not_strictly_false"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["logical_and"] },
+          "13": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 13,
+            "9": 13,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "14",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "8", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "10",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "9", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "12",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "11", identExpr: { name: "@result" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "13", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "20", max: "20" },
+      expectedCost: { min: "20", max: "20" },
+    },
+    {
+      original: {
+        name: "variable cost function",
+        expr: "input.matches('[0-9]')",
+        checkOnly: true,
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+      },
+      sizeHints: { input: 500 },
+      ast: 'input^#*expr.Expr_IdentExpr#.matches(\n  "[0-9]"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input.matches("[0-9]")',
+      locationAst: 'input^#1[1,0]#.matches(\n  "[0-9]"^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "[0-9]" } }],
+          },
+        },
+        sourceInfo: {
+          location: "variable cost function",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        'input~string^input.matches(\n  "[0-9]"~string\n)~bool^matches_string',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["matches_string"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "[0-9]" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "103" },
+      expectedCost: { min: "3", max: "103" },
+    },
+    {
+      original: {
+        name: "variable cost function with constant",
+        expr: "'123'.matches('[0-9]')",
+        checkOnly: true,
+      },
+      ast: '"123"^#*expr.Constant_StringValue#.matches(\n  "[0-9]"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"123".matches("[0-9]")',
+      locationAst: '"123"^#1[1,0]#.matches(\n  "[0-9]"^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "123" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "[0-9]" } }],
+          },
+        },
+        sourceInfo: {
+          location: "variable cost function with constant",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        '"123"~string.matches(\n  "[0-9]"~string\n)~bool^matches_string',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["matches_string"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "123" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "[0-9]" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      expectedCost: { min: "2", max: "2" },
+    },
+    {
+      original: { name: "or", expr: "true || false", checkOnly: true },
+      ast: "_||_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true || false",
+      locationAst: "_||_(\n  true^#1[1,0]#,\n  false^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst: "_||_(\n  true~bool,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_or"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      expectedCost: { min: "0", max: "0" },
+    },
+    {
+      original: {
+        name: "or accumulated branch cost",
+        expr: "a || b || c || d",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          { name: "c", ident: { type: { primitive: "BOOL" } } },
+          { name: "d", ident: { type: { primitive: "BOOL" } } },
+        ],
+      },
+      ast: "_||_(\n  _||_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    c^#*expr.Expr_IdentExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a || b || c || d",
+      locationAst:
+        "_||_(\n  _||_(\n    a^#1[1,0]#,\n    b^#2[1,5]#\n  )^#3[1,2]#,\n  _||_(\n    c^#4[1,10]#,\n    d^#6[1,15]#\n  )^#7[1,12]#\n)^#5[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or accumulated branch cost",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+          },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _||_(\n    a~bool^a,\n    b~bool^b\n  )~bool^logical_or,\n  _||_(\n    c~bool^c,\n    d~bool^d\n  )~bool^logical_or\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "b" },
+          "3": { overloadId: ["logical_or"] },
+          "4": { name: "c" },
+          "5": { overloadId: ["logical_or"] },
+          "6": { name: "d" },
+          "7": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "4" },
+      expectedCost: { min: "1", max: "4" },
+    },
+    {
+      original: {
+        name: "and",
+        expr: "true \u0026\u0026 false",
+        checkOnly: true,
+      },
+      ast: "_\u0026\u0026_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 false",
+      locationAst:
+        "_\u0026\u0026_(\n  true^#1[1,0]#,\n  false^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  true~bool,\n  false~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_and"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      expectedCost: { min: "0", max: "0" },
+    },
+    {
+      original: {
+        name: "and accumulated branch cost",
+        expr: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          { name: "c", ident: { type: { primitive: "BOOL" } } },
+          { name: "d", ident: { type: { primitive: "BOOL" } } },
+        ],
+      },
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    c^#*expr.Expr_IdentExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a^#1[1,0]#,\n    b^#2[1,5]#\n  )^#3[1,2]#,\n  _\u0026\u0026_(\n    c^#4[1,10]#,\n    d^#6[1,15]#\n  )^#7[1,12]#\n)^#5[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and accumulated branch cost",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a~bool^a,\n    b~bool^b\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    c~bool^c,\n    d~bool^d\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "b" },
+          "3": { overloadId: ["logical_and"] },
+          "4": { name: "c" },
+          "5": { overloadId: ["logical_and"] },
+          "6": { name: "d" },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "4" },
+      expectedCost: { min: "1", max: "4" },
+    },
+    {
+      original: { name: "lt", expr: "1 \u003c 2", checkOnly: true },
+      ast: "_\u003c_(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 \u003c 2",
+      locationAst: "_\u003c_(\n  1^#1[1,0]#,\n  2^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "lt",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_\u003c_(\n  1~int,\n  2~int\n)~bool^less_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["less_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "lte", expr: "1 \u003c= 2", checkOnly: true },
+      ast: "_\u003c=_(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 \u003c= 2",
+      locationAst: "_\u003c=_(\n  1^#1[1,0]#,\n  2^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c=_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "lte",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      checkedAst: "_\u003c=_(\n  1~int,\n  2~int\n)~bool^less_equals_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["less_equals_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c=_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "eq", expr: "1 == 2", checkOnly: true },
+      ast: "_==_(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 == 2",
+      locationAst: "_==_(\n  1^#1[1,0]#,\n  2^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "eq",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      checkedAst: "_==_(\n  1~int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "gt", expr: "2 \u003e 1", checkOnly: true },
+      ast: "_\u003e_(\n  2^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2 \u003e 1",
+      locationAst: "_\u003e_(\n  2^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "gt",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_\u003e_(\n  2~int,\n  1~int\n)~bool^greater_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["greater_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "gte", expr: "2 \u003e= 1", checkOnly: true },
+      ast: "_\u003e=_(\n  2^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2 \u003e= 1",
+      locationAst: "_\u003e=_(\n  2^#1[1,0]#,\n  1^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "gte",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      checkedAst: "_\u003e=_(\n  2~int,\n  1~int\n)~bool^greater_equals_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["greater_equals_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "in", expr: "2 in [1, 2, 3]", checkOnly: true },
+      ast: "@in(\n  2^#*expr.Constant_Int64Value#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2 in [1, 2, 3]",
+      locationAst:
+        "@in(\n  2^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    2^#5[1,9]#,\n    3^#6[1,12]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 12 },
+        },
+      },
+      checkedAst:
+        "@in(\n  2~int,\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 12 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      expectedCost: { min: "13", max: "13" },
+    },
+    {
+      original: { name: "plus", expr: "1 + 1", checkOnly: true },
+      ast: "_+_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + 1",
+      locationAst: "_+_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "plus",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_+_(\n  1~int,\n  1~int\n)~int^add_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["add_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "minus", expr: "1 - 1", checkOnly: true },
+      ast: "_-_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 - 1",
+      locationAst: "_-_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "minus",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_-_(\n  1~int,\n  1~int\n)~int^subtract_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["subtract_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "/", expr: "1 / 1", checkOnly: true },
+      ast: "_/_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 / 1",
+      locationAst: "_/_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_/_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "/",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_/_(\n  1~int,\n  1~int\n)~int^divide_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["divide_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_/_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "/", expr: "1 * 1", checkOnly: true },
+      ast: "_*_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 * 1",
+      locationAst: "_*_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_*_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "/",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_*_(\n  1~int,\n  1~int\n)~int^multiply_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["multiply_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_*_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "%", expr: "1 % 1", checkOnly: true },
+      ast: "_%_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 % 1",
+      locationAst: "_%_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_%_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "%",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_%_(\n  1~int,\n  1~int\n)~int^modulo_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["modulo_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_%_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "ternary", expr: "true ? 1 : 2", checkOnly: true },
+      ast: "_?_:_(\n  true^#*expr.Constant_BoolValue#,\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true ? 1 : 2",
+      locationAst:
+        "_?_:_(\n  true^#1[1,0]#,\n  1^#3[1,7]#,\n  2^#4[1,11]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "3", constExpr: { int64Value: "1" } },
+              { id: "4", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "ternary",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 7, "4": 11 },
+        },
+      },
+      checkedAst: "_?_:_(\n  true~bool,\n  1~int,\n  2~int\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["conditional"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 7, "4": 11 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "3", constExpr: { int64Value: "1" } },
+              { id: "4", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "0", max: "0" },
+      expectedCost: { min: "0", max: "0" },
+    },
+    {
+      original: { name: "string size", expr: 'size("123")', checkOnly: true },
+      ast: 'size(\n  "123"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'size("123")',
+      locationAst: 'size(\n  "123"^#2[1,5]#\n)^#1[1,4]#',
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 10, 1, 5, 1, 10],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", constExpr: { stringValue: "123" } }],
+          },
+        },
+        sourceInfo: {
+          location: "string size",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5 },
+        },
+      },
+      checkedAst: 'size(\n  "123"~string\n)~int^size_string',
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["size_string"] } },
+        typeMap: { "1": { primitive: "INT64" }, "2": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", constExpr: { stringValue: "123" } }],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: { name: "bytes size", expr: 'size(b"123")', checkOnly: true },
+      ast: 'size(\n  b"123"^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'size(b"\\061\\062\\063")',
+      locationAst: 'size(\n  b"123"^#2[1,5]#\n)^#1[1,4]#',
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 11, 1, 5, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", constExpr: { bytesValue: "MTIz" } }],
+          },
+        },
+        sourceInfo: {
+          location: "bytes size",
+          lineOffsets: [13],
+          positions: { "1": 4, "2": 5 },
+        },
+      },
+      checkedAst: 'size(\n  b"123"~bytes\n)~int^size_bytes',
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["size_bytes"] } },
+        typeMap: { "1": { primitive: "INT64" }, "2": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 4, "2": 5 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", constExpr: { bytesValue: "MTIz" } }],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: {
+        name: "bytes to string conversion",
+        expr: "string(input)",
+        checkOnly: true,
+        typeEnv: [{ name: "input", ident: { type: { primitive: "BYTES" } } }],
+      },
+      sizeHints: { input: 500 },
+      ast: "string(\n  input^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "string(input)",
+      locationAst: "string(\n  input^#2[1,7]#\n)^#1[1,6]#",
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 12, 1, 7, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+        sourceInfo: {
+          location: "bytes to string conversion",
+          lineOffsets: [14],
+          positions: { "1": 6, "2": 7 },
+        },
+      },
+      checkedAst: "string(\n  input~bytes^input\n)~string^bytes_to_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["bytes_to_string"] },
+          "2": { name: "input" },
+        },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 6, "2": 7 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "51" },
+      expectedCost: { min: "1", max: "51" },
+    },
+    {
+      original: {
+        name: "bytes to string conversion equality",
+        expr: "string(input) == string(input)",
+        checkOnly: true,
+        typeEnv: [{ name: "input", ident: { type: { primitive: "BYTES" } } }],
+      },
+      sizeHints: { input: 500 },
+      ast: "_==_(\n  string(\n    input^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  string(\n    input^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "string(input) == string(input)",
+      locationAst:
+        "_==_(\n  string(\n    input^#2[1,7]#\n  )^#1[1,6]#,\n  string(\n    input^#5[1,24]#\n  )^#4[1,23]#\n)^#3[1,14]#",
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 12, 1, 7, 1, 12],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 24, 29, 1, 24, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "2", identExpr: { name: "input" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "5", identExpr: { name: "input" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "bytes to string conversion equality",
+          lineOffsets: [31],
+          positions: { "1": 6, "2": 7, "3": 14, "4": 23, "5": 24 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  string(\n    input~bytes^input\n  )~string^bytes_to_string,\n  string(\n    input~bytes^input\n  )~string^bytes_to_string\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["bytes_to_string"] },
+          "2": { name: "input" },
+          "3": { overloadId: ["equals"] },
+          "4": { overloadId: ["bytes_to_string"] },
+          "5": { name: "input" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BYTES" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 6, "2": 7, "3": 14, "4": 23, "5": 24 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "2", identExpr: { name: "input" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "5", identExpr: { name: "input" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "152" },
+      expectedCost: { min: "3", max: "152" },
+    },
+    {
+      original: {
+        name: "string to bytes conversion",
+        expr: "bytes(input)",
+        checkOnly: true,
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+      },
+      sizeHints: { input: 500 },
+      ast: "bytes(\n  input^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "bytes(input)",
+      locationAst: "bytes(\n  input^#2[1,6]#\n)^#1[1,5]#",
+      positions: [
+        [1, 5, 6, 1, 5, 1, 6],
+        [2, 6, 11, 1, 6, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "bytes",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+        sourceInfo: {
+          location: "string to bytes conversion",
+          lineOffsets: [13],
+          positions: { "1": 5, "2": 6 },
+        },
+      },
+      checkedAst: "bytes(\n  input~string^input\n)~bytes^string_to_bytes",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["string_to_bytes"] },
+          "2": { name: "input" },
+        },
+        typeMap: { "1": { primitive: "BYTES" }, "2": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 5, "2": 6 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "bytes",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+      },
+      type: "bytes",
+      cost: { min: "1", max: "51" },
+      expectedCost: { min: "1", max: "51" },
+    },
+    {
+      original: {
+        name: "string to bytes conversion equality",
+        expr: "bytes(input) == bytes(input)",
+        checkOnly: true,
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+      },
+      sizeHints: { input: 500 },
+      ast: "_==_(\n  bytes(\n    input^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  bytes(\n    input^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "bytes(input) == bytes(input)",
+      locationAst:
+        "_==_(\n  bytes(\n    input^#2[1,6]#\n  )^#1[1,5]#,\n  bytes(\n    input^#5[1,22]#\n  )^#4[1,21]#\n)^#3[1,13]#",
+      positions: [
+        [1, 5, 6, 1, 5, 1, 6],
+        [2, 6, 11, 1, 6, 1, 11],
+        [3, 13, 15, 1, 13, 1, 15],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 27, 1, 22, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "bytes",
+                  args: [{ id: "2", identExpr: { name: "input" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "bytes",
+                  args: [{ id: "5", identExpr: { name: "input" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "string to bytes conversion equality",
+          lineOffsets: [29],
+          positions: { "1": 5, "2": 6, "3": 13, "4": 21, "5": 22 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  bytes(\n    input~string^input\n  )~bytes^string_to_bytes,\n  bytes(\n    input~string^input\n  )~bytes^string_to_bytes\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["string_to_bytes"] },
+          "2": { name: "input" },
+          "3": { overloadId: ["equals"] },
+          "4": { overloadId: ["string_to_bytes"] },
+          "5": { name: "input" },
+        },
+        typeMap: {
+          "1": { primitive: "BYTES" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BYTES" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 5, "2": 6, "3": 13, "4": 21, "5": 22 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "bytes",
+                  args: [{ id: "2", identExpr: { name: "input" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "bytes",
+                  args: [{ id: "5", identExpr: { name: "input" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "302" },
+      expectedCost: { min: "3", max: "302" },
+    },
+    {
+      original: {
+        name: "int to string conversion",
+        expr: "string(1)",
+        checkOnly: true,
+      },
+      ast: "string(\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "string(1)",
+      locationAst: "string(\n  1^#2[1,7]#\n)^#1[1,6]#",
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", constExpr: { int64Value: "1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "int to string conversion",
+          lineOffsets: [10],
+          positions: { "1": 6, "2": 7 },
+        },
+      },
+      checkedAst: "string(\n  1~int\n)~string^int64_to_string",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["int64_to_string"] } },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 6, "2": 7 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", constExpr: { int64Value: "1" } }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: {
+        name: "contains",
+        expr: "input.contains(arg1)",
+        checkOnly: true,
+        typeEnv: [
+          { name: "input", ident: { type: { primitive: "STRING" } } },
+          { name: "arg1", ident: { type: { primitive: "STRING" } } },
+        ],
+      },
+      sizeHints: { arg1: 500, input: 500 },
+      ast: "input^#*expr.Expr_IdentExpr#.contains(\n  arg1^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "input.contains(arg1)",
+      locationAst: "input^#1[1,0]#.contains(\n  arg1^#3[1,15]#\n)^#2[1,14]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 19, 1, 15, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "contains",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "contains",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 14, "3": 15 },
+        },
+      },
+      checkedAst:
+        "input~string^input.contains(\n  arg1~string^arg1\n)~bool^contains_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["contains_string"] },
+          "3": { name: "arg1" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 14, "3": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "contains",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2502" },
+      expectedCost: { min: "2", max: "2502" },
+    },
+    {
+      original: {
+        name: "matches",
+        expr: "input.matches('\\\\d+a\\\\d+b')",
+        checkOnly: true,
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+      },
+      sizeHints: { input: 500 },
+      ast: 'input^#*expr.Expr_IdentExpr#.matches(\n  "\\\\d+a\\\\d+b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input.matches("\\\\d+a\\\\d+b")',
+      locationAst:
+        'input^#1[1,0]#.matches(\n  "\\\\d+a\\\\d+b"^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 26, 1, 14, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "\\d+a\\d+b" } }],
+          },
+        },
+        sourceInfo: {
+          location: "matches",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        'input~string^input.matches(\n  "\\\\d+a\\\\d+b"~string\n)~bool^matches_string',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["matches_string"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "\\d+a\\d+b" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "103" },
+      expectedCost: { min: "3", max: "103" },
+    },
+    {
+      original: {
+        name: "startsWith",
+        expr: "input.startsWith(arg1)",
+        checkOnly: true,
+        typeEnv: [
+          { name: "input", ident: { type: { primitive: "STRING" } } },
+          { name: "arg1", ident: { type: { primitive: "STRING" } } },
+        ],
+      },
+      sizeHints: { arg1: 500 },
+      ast: "input^#*expr.Expr_IdentExpr#.startsWith(\n  arg1^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "input.startsWith(arg1)",
+      locationAst: "input^#1[1,0]#.startsWith(\n  arg1^#3[1,17]#\n)^#2[1,16]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 21, 1, 17, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "startsWith",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "startsWith",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 16, "3": 17 },
+        },
+      },
+      checkedAst:
+        "input~string^input.startsWith(\n  arg1~string^arg1\n)~bool^starts_with_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["starts_with_string"] },
+          "3": { name: "arg1" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 16, "3": 17 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "startsWith",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "52" },
+      expectedCost: { min: "2", max: "52" },
+    },
+    {
+      original: {
+        name: "endsWith",
+        expr: "input.endsWith(arg1)",
+        checkOnly: true,
+        typeEnv: [
+          { name: "input", ident: { type: { primitive: "STRING" } } },
+          { name: "arg1", ident: { type: { primitive: "STRING" } } },
+        ],
+      },
+      sizeHints: { arg1: 500 },
+      ast: "input^#*expr.Expr_IdentExpr#.endsWith(\n  arg1^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "input.endsWith(arg1)",
+      locationAst: "input^#1[1,0]#.endsWith(\n  arg1^#3[1,15]#\n)^#2[1,14]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 19, 1, 15, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "endsWith",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "endsWith",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 14, "3": 15 },
+        },
+      },
+      checkedAst:
+        "input~string^input.endsWith(\n  arg1~string^arg1\n)~bool^ends_with_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["ends_with_string"] },
+          "3": { name: "arg1" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 14, "3": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "endsWith",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "52" },
+      expectedCost: { min: "2", max: "52" },
+    },
+    {
+      original: {
+        name: "size receiver",
+        expr: "input.size()",
+        checkOnly: true,
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#",
+      unparsed: "input.size()",
+      locationAst: "input^#1[1,0]#.size()^#2[1,10]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 10, 11, 1, 10, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "size",
+          },
+        },
+        sourceInfo: {
+          location: "size receiver",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 10 },
+        },
+      },
+      checkedAst: "input~string^input.size()~int^string_size",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["string_size"] },
+        },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 10 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "size",
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      expectedCost: { min: "2", max: "2" },
+    },
+    {
+      original: {
+        name: "size",
+        expr: "size(input)",
+        checkOnly: true,
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+      },
+      ast: "size(\n  input^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "size(input)",
+      locationAst: "size(\n  input^#2[1,5]#\n)^#1[1,4]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 10, 1, 5, 1, 10],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+        sourceInfo: {
+          location: "size",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5 },
+        },
+      },
+      checkedAst: "size(\n  input~string^input\n)~int^size_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["size_string"] },
+          "2": { name: "input" },
+        },
+        typeMap: { "1": { primitive: "INT64" }, "2": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      expectedCost: { min: "2", max: "2" },
+    },
+    {
+      original: {
+        name: "ternary eval",
+        expr: "(x \u003e 2 ? input1 : input2).all(y, true)",
+        checkOnly: true,
+        typeEnv: [
+          { name: "x", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "input1",
+            ident: {
+              type: {
+                listType: {
+                  elemType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          {
+            name: "input2",
+            ident: {
+              type: {
+                listType: {
+                  elemType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      sizeHints: { input1: 1, input2: 1 },
+      ast: "__comprehension__(\n  // Variable\n  y,\n  // Target\n  _?_:_(\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    input1^#*expr.Expr_IdentExpr#,\n    input2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "((x \u003e 2) ? input1 : input2).all(y, true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  _?_:_(\n    _\u003e_(\n      x^#1[1,1]#,\n      2^#3[1,5]#\n    )^#2[1,3]#,\n    input1^#5[1,9]#,\n    input2^#6[1,18]#\n  )^#4[1,7]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#10[1,29]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#11[1,29]#\n  )^#12[1,29]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#13[1,29]#,\n    true^#9[1,33]#\n  )^#14[1,29]#,\n  // Result\n  @result^#15[1,29]#)^#16[1,29]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 15, 1, 9, 1, 15],
+        [6, 18, 24, 1, 18, 1, 24],
+        [8, 30, 31, 1, 30, 1, 31],
+        [9, 33, 37, 1, 33, 1, 37],
+        [10, 29, 29, 1, 29, 1, 29],
+        [11, 29, 29, 1, 29, 1, 29],
+        [12, 29, 29, 1, 29, 1, 29],
+        [13, 29, 29, 1, 29, 1, 29],
+        [14, 29, 29, 1, 29, 1, 29],
+        [15, 29, 29, 1, 29, 1, 29],
+        [16, 29, 29, 1, 29, 1, 29],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "4",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "2",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "5", identExpr: { name: "input1" } },
+                  { id: "6", identExpr: { name: "input2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "ternary eval",
+          lineOffsets: [39],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 18,
+            "8": 30,
+            "9": 33,
+            "10": 29,
+            "11": 29,
+            "12": 29,
+            "13": 29,
+            "14": 29,
+            "15": 29,
+            "16": 29,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "4",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "2",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "1", identExpr: { name: "x" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      { id: "5", identExpr: { name: "input1" } },
+                      { id: "6", identExpr: { name: "input2" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "y" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "4",
+            callExpr: {
+              function: "_?_:_",
+              args: [
+                {
+                  id: "2",
+                  callExpr: {
+                    function: "_\u003e_",
+                    args: [
+                      { id: "1", identExpr: { name: "x" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                { id: "5", identExpr: { name: "input1" } },
+                { id: "6", identExpr: { name: "input2" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "y" } },
+            { id: "9", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  _?_:_(\n    _\u003e_(\n      x~int^x,\n      2~int\n    )~bool^greater_int64,\n    input1~list(google.expr.proto3.test.TestAllTypes)^input1,\n    input2~list(google.expr.proto3.test.TestAllTypes)^input2\n  )~list(google.expr.proto3.test.TestAllTypes)^conditional,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["greater_int64"] },
+          "4": { overloadId: ["conditional"] },
+          "5": { name: "input1" },
+          "6": { name: "input2" },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "5": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "6": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 18,
+            "8": 30,
+            "9": 33,
+            "10": 29,
+            "11": 29,
+            "12": 29,
+            "13": 29,
+            "14": 29,
+            "15": 29,
+            "16": 29,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "4",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "2",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "1", identExpr: { name: "x" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      { id: "5", identExpr: { name: "input1" } },
+                      { id: "6", identExpr: { name: "input2" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "y" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "4",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "2",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "5", identExpr: { name: "input1" } },
+                  { id: "6", identExpr: { name: "input2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "7" },
+      expectedCost: { min: "4", max: "7" },
+    },
+    {
+      original: {
+        name: "comprehension over map",
+        expr: "input.all(k, input[k].single_int32 \u003e 3)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      sizeHints: { input: 10 },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003e_(\n      _[_](\n        input^#*expr.Expr_IdentExpr#,\n        k^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#.single_int32^#*expr.Expr_SelectExpr#,\n      3^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(k, input[k].single_int32 \u003e 3)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#10[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#11[1,9]#\n  )^#12[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#13[1,9]#,\n    _\u003e_(\n      _[_](\n        input^#4[1,13]#,\n        k^#6[1,19]#\n      )^#5[1,18]#.single_int32^#7[1,21]#,\n      3^#9[1,37]#\n    )^#8[1,35]#\n  )^#14[1,9]#,\n  // Result\n  @result^#15[1,9]#)^#16[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 18, 1, 13, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 35, 36, 1, 35, 1, 36],
+        [9, 37, 38, 1, 37, 1, 38],
+        [10, 9, 9, 1, 9, 1, 9],
+        [11, 9, 9, 1, 9, 1, 9],
+        [12, 9, 9, 1, 9, 1, 9],
+        [13, 9, 9, 1, 9, 1, 9],
+        [14, 9, 9, 1, 9, 1, 9],
+        [15, 9, 9, 1, 9, 1, 9],
+        [16, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            field: "single_int32",
+                          },
+                        },
+                        { id: "9", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension over map",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "7": 21,
+            "8": 35,
+            "9": 37,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+            "14": 9,
+            "15": 9,
+            "16": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            field: "single_int32",
+                          },
+                        },
+                        { id: "9", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "k" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  {
+                    id: "7",
+                    selectExpr: {
+                      operand: {
+                        id: "5",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "4", identExpr: { name: "input" } },
+                            { id: "6", identExpr: { name: "k" } },
+                          ],
+                        },
+                      },
+                      field: "single_int32",
+                    },
+                  },
+                  { id: "9", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, google.expr.proto3.test.TestAllTypes)^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u003e_(\n      _[_](\n        input~map(string, google.expr.proto3.test.TestAllTypes)^input,\n        k~string^k\n      )~google.expr.proto3.test.TestAllTypes^index_map.single_int32~int,\n      3~int\n    )~bool^greater_int64\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "input" },
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "k" },
+          "8": { overloadId: ["greater_int64"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "5": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "7": 21,
+            "8": 35,
+            "9": 37,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+            "14": 9,
+            "15": 9,
+            "16": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            field: "single_int32",
+                          },
+                        },
+                        { id: "9", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            field: "single_int32",
+                          },
+                        },
+                        { id: "9", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "82" },
+      expectedCost: { min: "2", max: "82" },
+    },
+    {
+      original: {
+        name: "comprehension over nested map of maps",
+        expr: "input.all(k, input[k].all(x, true))",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      sizeHints: { input: 5, "input.@values": 10 },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      _[_](\n        input^#*expr.Expr_IdentExpr#,\n        k^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        true^#*expr.Constant_BoolValue#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(k, input[k].all(x, true))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#17[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#18[1,9]#\n  )^#19[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#20[1,9]#,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      _[_](\n        input^#4[1,13]#,\n        k^#6[1,19]#\n      )^#5[1,18]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#10[1,25]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#11[1,25]#\n      )^#12[1,25]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#13[1,25]#,\n        true^#9[1,29]#\n      )^#14[1,25]#,\n      // Result\n      @result^#15[1,25]#)^#16[1,25]#\n  )^#21[1,9]#,\n  // Result\n  @result^#22[1,9]#)^#23[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 18, 1, 13, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 29, 33, 1, 29, 1, 33],
+        [10, 25, 25, 1, 25, 1, 25],
+        [11, 25, 25, 1, 25, 1, 25],
+        [12, 25, 25, 1, 25, 1, 25],
+        [13, 25, 25, 1, 25, 1, 25],
+        [14, 25, 25, 1, 25, 1, 25],
+        [15, 25, 25, 1, 25, 1, 25],
+        [16, 25, 25, 1, 25, 1, 25],
+        [17, 9, 9, 1, 9, 1, 9],
+        [18, 9, 9, 1, 9, 1, 9],
+        [19, 9, 9, 1, 9, 1, 9],
+        [20, 9, 9, 1, 9, 1, 9],
+        [21, 9, 9, 1, 9, 1, 9],
+        [22, 9, 9, 1, 9, 1, 9],
+        [23, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "23",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "19",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "18", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "21",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "20", identExpr: { name: "@result" } },
+                  {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "5",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "4", identExpr: { name: "input" } },
+                            { id: "6", identExpr: { name: "k" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "12",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "11", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "13", identExpr: { name: "@result" } },
+                            { id: "9", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "22", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension over nested map of maps",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 9,
+            "18": 9,
+            "19": 9,
+            "20": 9,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "23": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [{ id: "3", identExpr: { name: "k" } }, { id: "16" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "5",
+            callExpr: {
+              function: "_[_]",
+              args: [
+                { id: "4", identExpr: { name: "input" } },
+                { id: "6", identExpr: { name: "k" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", constExpr: { boolValue: true } },
+          ],
+        },
+        {
+          id: 23,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [{ id: "3", identExpr: { name: "k" } }, { id: "16" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      _[_](\n        input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n        k~string^k\n      )~map(string, google.expr.proto3.test.TestAllTypes)^index_map,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        true~bool\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "input" },
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "k" },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+          "18": { name: "@result" },
+          "19": { overloadId: ["not_strictly_false"] },
+          "20": { name: "@result" },
+          "21": { overloadId: ["logical_and"] },
+          "22": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "5": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 9,
+            "18": 9,
+            "19": 9,
+            "20": 9,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "23": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [{ id: "3", identExpr: { name: "k" } }, { id: "16" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "23",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "19",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "18", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "21",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "20", identExpr: { name: "@result" } },
+                  {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "5",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "4", identExpr: { name: "input" } },
+                            { id: "6", identExpr: { name: "k" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "12",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "11", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "13", identExpr: { name: "@result" } },
+                            { id: "9", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "22", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "187" },
+      expectedCost: { min: "2", max: "187" },
+    },
+    {
+      original: {
+        name: "string size of map keys",
+        expr: "input.all(k, k.contains(k))",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      sizeHints: { input: 5, "input.@keys": 10 },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    k^#*expr.Expr_IdentExpr#.contains(\n      k^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(k, k.contains(k))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#7[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#8[1,9]#\n  )^#9[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#10[1,9]#,\n    k^#4[1,13]#.contains(\n      k^#6[1,24]#\n    )^#5[1,23]#\n  )^#11[1,9]#,\n  // Result\n  @result^#12[1,9]#)^#13[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 23, 24, 1, 23, 1, 24],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 9, 9, 1, 9, 1, 9],
+        [8, 9, 9, 1, 9, 1, 9],
+        [9, 9, 9, 1, 9, 1, 9],
+        [10, 9, 9, 1, 9, 1, 9],
+        [11, 9, 9, 1, 9, 1, 9],
+        [12, 9, 9, 1, 9, 1, 9],
+        [13, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "6", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "string size of map keys",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 23,
+            "6": 24,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "6", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "k" } },
+            {
+              id: "5",
+              callExpr: {
+                target: { id: "4", identExpr: { name: "k" } },
+                function: "contains",
+                args: [{ id: "6", identExpr: { name: "k" } }],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    k~string^k.contains(\n      k~string^k\n    )~bool^contains_string\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "k" },
+          "5": { overloadId: ["contains_string"] },
+          "6": { name: "k" },
+          "8": { name: "@result" },
+          "9": { overloadId: ["not_strictly_false"] },
+          "10": { name: "@result" },
+          "11": { overloadId: ["logical_and"] },
+          "12": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 23,
+            "6": 24,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "6", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "6", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "32" },
+      expectedCost: { min: "2", max: "32" },
+    },
+    {
+      original: {
+        name: "comprehension variable shadowing",
+        expr: "input.all(k, input[k].all(k, true) \u0026\u0026 k.contains(k))",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      sizeHints: { input: 2, "input.@keys": 5, "input.@values": 2 },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input^#*expr.Expr_IdentExpr#,\n          k^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Accumulator\n        @result,\n        // Init\n        true^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#*expr.Expr_IdentExpr#,\n          true^#*expr.Constant_BoolValue#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n      k^#*expr.Expr_IdentExpr#.contains(\n        k^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "input.all(k, input[k].all(k, true) \u0026\u0026 k.contains(k))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#21[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#22[1,9]#\n  )^#23[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#24[1,9]#,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input^#4[1,13]#,\n          k^#6[1,19]#\n        )^#5[1,18]#,\n        // Accumulator\n        @result,\n        // Init\n        true^#10[1,25]#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#11[1,25]#\n        )^#12[1,25]#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#13[1,25]#,\n          true^#9[1,29]#\n        )^#14[1,25]#,\n        // Result\n        @result^#15[1,25]#)^#16[1,25]#,\n      k^#17[1,38]#.contains(\n        k^#19[1,49]#\n      )^#18[1,48]#\n    )^#20[1,35]#\n  )^#25[1,9]#,\n  // Result\n  @result^#26[1,9]#)^#27[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 18, 1, 13, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 29, 33, 1, 29, 1, 33],
+        [10, 25, 25, 1, 25, 1, 25],
+        [11, 25, 25, 1, 25, 1, 25],
+        [12, 25, 25, 1, 25, 1, 25],
+        [13, 25, 25, 1, 25, 1, 25],
+        [14, 25, 25, 1, 25, 1, 25],
+        [15, 25, 25, 1, 25, 1, 25],
+        [16, 25, 25, 1, 25, 1, 25],
+        [17, 38, 39, 1, 38, 1, 39],
+        [18, 48, 49, 1, 48, 1, 49],
+        [19, 49, 50, 1, 49, 1, 50],
+        [20, 35, 37, 1, 35, 1, 37],
+        [21, 9, 9, 1, 9, 1, 9],
+        [22, 9, 9, 1, 9, 1, 9],
+        [23, 9, 9, 1, 9, 1, 9],
+        [24, 9, 9, 1, 9, 1, 9],
+        [25, 9, 9, 1, 9, 1, 9],
+        [26, 9, 9, 1, 9, 1, 9],
+        [27, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "21", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "22", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "10",
+                              constExpr: { boolValue: true },
+                            },
+                            loopCondition: {
+                              id: "12",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  { id: "11", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_\u0026\u0026_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                  { id: "9", constExpr: { boolValue: true } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension variable shadowing",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 38,
+            "18": 48,
+            "19": 49,
+            "20": 35,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+            "24": 9,
+            "25": 9,
+            "26": 9,
+            "27": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "16" },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "5",
+            callExpr: {
+              function: "_[_]",
+              args: [
+                { id: "4", identExpr: { name: "input" } },
+                { id: "6", identExpr: { name: "k" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "k" } },
+            { id: "9", constExpr: { boolValue: true } },
+          ],
+        },
+        {
+          id: 27,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "k" } },
+            {
+              id: "20",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "16" },
+                  {
+                    id: "18",
+                    callExpr: {
+                      target: { id: "17", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "19", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n          k~string^k\n        )~map(string, google.expr.proto3.test.TestAllTypes)^index_map,\n        // Accumulator\n        @result,\n        // Init\n        true~bool,\n        // LoopCondition\n        @not_strictly_false(\n          @result~bool^@result\n        )~bool^not_strictly_false,\n        // LoopStep\n        _\u0026\u0026_(\n          @result~bool^@result,\n          true~bool\n        )~bool^logical_and,\n        // Result\n        @result~bool^@result)~bool,\n      k~string^k.contains(\n        k~string^k\n      )~bool^contains_string\n    )~bool^logical_and\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "input" },
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "k" },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+          "17": { name: "k" },
+          "18": { overloadId: ["contains_string"] },
+          "19": { name: "k" },
+          "20": { overloadId: ["logical_and"] },
+          "22": { name: "@result" },
+          "23": { overloadId: ["not_strictly_false"] },
+          "24": { name: "@result" },
+          "25": { overloadId: ["logical_and"] },
+          "26": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "5": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "STRING" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 38,
+            "18": 48,
+            "19": 49,
+            "20": 35,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+            "24": 9,
+            "25": 9,
+            "26": 9,
+            "27": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "16" },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "21", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "22", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "10",
+                              constExpr: { boolValue: true },
+                            },
+                            loopCondition: {
+                              id: "12",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  { id: "11", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_\u0026\u0026_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                  { id: "9", constExpr: { boolValue: true } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "34" },
+      expectedCost: { min: "2", max: "34" },
+    },
+    {
+      original: {
+        name: "comprehension variable shadowing",
+        expr: "input.all(k, input[k].all(k, true) \u0026\u0026 k.contains(k))",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+      },
+      sizeHints: { input: 2, "input.@keys": 5, "input.@values": 2 },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input^#*expr.Expr_IdentExpr#,\n          k^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Accumulator\n        @result,\n        // Init\n        true^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#*expr.Expr_IdentExpr#,\n          true^#*expr.Constant_BoolValue#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n      k^#*expr.Expr_IdentExpr#.contains(\n        k^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "input.all(k, input[k].all(k, true) \u0026\u0026 k.contains(k))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#21[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#22[1,9]#\n  )^#23[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#24[1,9]#,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input^#4[1,13]#,\n          k^#6[1,19]#\n        )^#5[1,18]#,\n        // Accumulator\n        @result,\n        // Init\n        true^#10[1,25]#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#11[1,25]#\n        )^#12[1,25]#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#13[1,25]#,\n          true^#9[1,29]#\n        )^#14[1,25]#,\n        // Result\n        @result^#15[1,25]#)^#16[1,25]#,\n      k^#17[1,38]#.contains(\n        k^#19[1,49]#\n      )^#18[1,48]#\n    )^#20[1,35]#\n  )^#25[1,9]#,\n  // Result\n  @result^#26[1,9]#)^#27[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 18, 1, 13, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 29, 33, 1, 29, 1, 33],
+        [10, 25, 25, 1, 25, 1, 25],
+        [11, 25, 25, 1, 25, 1, 25],
+        [12, 25, 25, 1, 25, 1, 25],
+        [13, 25, 25, 1, 25, 1, 25],
+        [14, 25, 25, 1, 25, 1, 25],
+        [15, 25, 25, 1, 25, 1, 25],
+        [16, 25, 25, 1, 25, 1, 25],
+        [17, 38, 39, 1, 38, 1, 39],
+        [18, 48, 49, 1, 48, 1, 49],
+        [19, 49, 50, 1, 49, 1, 50],
+        [20, 35, 37, 1, 35, 1, 37],
+        [21, 9, 9, 1, 9, 1, 9],
+        [22, 9, 9, 1, 9, 1, 9],
+        [23, 9, 9, 1, 9, 1, 9],
+        [24, 9, 9, 1, 9, 1, 9],
+        [25, 9, 9, 1, 9, 1, 9],
+        [26, 9, 9, 1, 9, 1, 9],
+        [27, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "21", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "22", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "10",
+                              constExpr: { boolValue: true },
+                            },
+                            loopCondition: {
+                              id: "12",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  { id: "11", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_\u0026\u0026_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                  { id: "9", constExpr: { boolValue: true } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension variable shadowing",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 38,
+            "18": 48,
+            "19": 49,
+            "20": 35,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+            "24": 9,
+            "25": 9,
+            "26": 9,
+            "27": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "16" },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "5",
+            callExpr: {
+              function: "_[_]",
+              args: [
+                { id: "4", identExpr: { name: "input" } },
+                { id: "6", identExpr: { name: "k" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "k" } },
+            { id: "9", constExpr: { boolValue: true } },
+          ],
+        },
+        {
+          id: 27,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "k" } },
+            {
+              id: "20",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "16" },
+                  {
+                    id: "18",
+                    callExpr: {
+                      target: { id: "17", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "19", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n          k~string^k\n        )~map(string, google.expr.proto3.test.TestAllTypes)^index_map,\n        // Accumulator\n        @result,\n        // Init\n        true~bool,\n        // LoopCondition\n        @not_strictly_false(\n          @result~bool^@result\n        )~bool^not_strictly_false,\n        // LoopStep\n        _\u0026\u0026_(\n          @result~bool^@result,\n          true~bool\n        )~bool^logical_and,\n        // Result\n        @result~bool^@result)~bool,\n      k~string^k.contains(\n        k~string^k\n      )~bool^contains_string\n    )~bool^logical_and\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "input" },
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "k" },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+          "17": { name: "k" },
+          "18": { overloadId: ["contains_string"] },
+          "19": { name: "k" },
+          "20": { overloadId: ["logical_and"] },
+          "22": { name: "@result" },
+          "23": { overloadId: ["not_strictly_false"] },
+          "24": { name: "@result" },
+          "25": { overloadId: ["logical_and"] },
+          "26": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "5": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "STRING" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 38,
+            "18": 48,
+            "19": 49,
+            "20": 35,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+            "24": 9,
+            "25": 9,
+            "26": 9,
+            "27": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "16" },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "21", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "22", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "10",
+                              constExpr: { boolValue: true },
+                            },
+                            loopCondition: {
+                              id: "12",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  { id: "11", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_\u0026\u0026_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                  { id: "9", constExpr: { boolValue: true } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "34" },
+      expectedCost: { min: "2", max: "34" },
+    },
+    {
+      original: {
+        name: "list concat",
+        expr: "(list1 + list2).all(x, true)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "list1",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "list2",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+      },
+      sizeHints: { list1: 10, list2: 10 },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  _+_(\n    list1^#*expr.Expr_IdentExpr#,\n    list2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "(list1 + list2).all(x, true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  _+_(\n    list1^#1[1,1]#,\n    list2^#3[1,9]#\n  )^#2[1,7]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#7[1,19]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#8[1,19]#\n  )^#9[1,19]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#10[1,19]#,\n    true^#6[1,23]#\n  )^#11[1,19]#,\n  // Result\n  @result^#12[1,19]#)^#13[1,19]#",
+      positions: [
+        [1, 1, 6, 1, 1, 1, 6],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 9, 14, 1, 9, 1, 14],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 23, 27, 1, 23, 1, 27],
+        [7, 19, 19, 1, 19, 1, 19],
+        [8, 19, 19, 1, 19, 1, 19],
+        [9, 19, 19, 1, 19, 1, 19],
+        [10, 19, 19, 1, 19, 1, 19],
+        [11, 19, 19, 1, 19, 1, 19],
+        [12, 19, 19, 1, 19, 1, 19],
+        [13, 19, 19, 1, 19, 1, 19],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "list1" } },
+                  { id: "3", identExpr: { name: "list2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "list concat",
+          lineOffsets: [29],
+          positions: {
+            "1": 1,
+            "2": 7,
+            "3": 9,
+            "5": 20,
+            "6": 23,
+            "7": 19,
+            "8": 19,
+            "9": 19,
+            "10": 19,
+            "11": 19,
+            "12": 19,
+            "13": 19,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "1", identExpr: { name: "list1" } },
+                      { id: "3", identExpr: { name: "list2" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "5", identExpr: { name: "x" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "all",
+          target: {
+            id: "2",
+            callExpr: {
+              function: "_+_",
+              args: [
+                { id: "1", identExpr: { name: "list1" } },
+                { id: "3", identExpr: { name: "list2" } },
+              ],
+            },
+          },
+          args: [
+            { id: "5", identExpr: { name: "x" } },
+            { id: "6", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  _+_(\n    list1~list(int)^list1,\n    list2~list(int)^list2\n  )~list(int)^add_list,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "list1" },
+          "2": { overloadId: ["add_list"] },
+          "3": { name: "list2" },
+          "8": { name: "@result" },
+          "9": { overloadId: ["not_strictly_false"] },
+          "10": { name: "@result" },
+          "11": { overloadId: ["logical_and"] },
+          "12": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 1,
+            "2": 7,
+            "3": 9,
+            "5": 20,
+            "6": 23,
+            "7": 19,
+            "8": 19,
+            "9": 19,
+            "10": 19,
+            "11": 19,
+            "12": 19,
+            "13": 19,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "1", identExpr: { name: "list1" } },
+                      { id: "3", identExpr: { name: "list2" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "5", identExpr: { name: "x" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "list1" } },
+                  { id: "3", identExpr: { name: "list2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "64" },
+      expectedCost: { min: "4", max: "64" },
+    },
+    {
+      original: {
+        name: "str concat",
+        expr: '"abcdefg".contains(str1 + str2)',
+        checkOnly: true,
+        typeEnv: [
+          { name: "str1", ident: { type: { primitive: "STRING" } } },
+          { name: "str2", ident: { type: { primitive: "STRING" } } },
+        ],
+      },
+      sizeHints: { str1: 10, str2: 10 },
+      ast: '"abcdefg"^#*expr.Constant_StringValue#.contains(\n  _+_(\n    str1^#*expr.Expr_IdentExpr#,\n    str2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"abcdefg".contains(str1 + str2)',
+      locationAst:
+        '"abcdefg"^#1[1,0]#.contains(\n  _+_(\n    str1^#3[1,19]#,\n    str2^#5[1,26]#\n  )^#4[1,24]#\n)^#2[1,18]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 23, 1, 19, 1, 23],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 26, 30, 1, 26, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "abcdefg" } },
+            function: "contains",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "str1" } },
+                    { id: "5", identExpr: { name: "str2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "str concat",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 24, "5": 26 },
+        },
+      },
+      checkedAst:
+        '"abcdefg"~string.contains(\n  _+_(\n    str1~string^str1,\n    str2~string^str2\n  )~string^add_string\n)~bool^contains_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["contains_string"] },
+          "3": { name: "str1" },
+          "4": { overloadId: ["add_string"] },
+          "5": { name: "str2" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 24, "5": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "abcdefg" } },
+            function: "contains",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "str1" } },
+                    { id: "5", identExpr: { name: "str2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "6" },
+      expectedCost: { min: "2", max: "6" },
+    },
+    {
+      original: {
+        name: "list size comparison",
+        expr: "list1.size() == list2.size()",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "list1",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "list2",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+      },
+      ast: "_==_(\n  list1^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#,\n  list2^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "list1.size() == list2.size()",
+      locationAst:
+        "_==_(\n  list1^#1[1,0]#.size()^#2[1,10]#,\n  list2^#4[1,16]#.size()^#5[1,26]#\n)^#3[1,13]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 13, 15, 1, 13, 1, 15],
+        [4, 16, 21, 1, 16, 1, 21],
+        [5, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "list1" } },
+                  function: "size",
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  target: { id: "4", identExpr: { name: "list2" } },
+                  function: "size",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list size comparison",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 10, "3": 13, "4": 16, "5": 26 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  list1~list(int)^list1.size()~int^list_size,\n  list2~list(int)^list2.size()~int^list_size\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "list1" },
+          "2": { overloadId: ["list_size"] },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "list2" },
+          "5": { overloadId: ["list_size"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 10, "3": 13, "4": 16, "5": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "list1" } },
+                  function: "size",
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  target: { id: "4", identExpr: { name: "list2" } },
+                  function: "size",
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      expectedCost: { min: "5", max: "5" },
+    },
+    {
+      original: {
+        name: "list size from ternary",
+        expr: "x \u003e y ? list1.size() : list2.size()",
+        checkOnly: true,
+        typeEnv: [
+          { name: "x", ident: { type: { primitive: "INT64" } } },
+          { name: "y", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "list1",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "list2",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+      },
+      ast: "_?_:_(\n  _\u003e_(\n    x^#*expr.Expr_IdentExpr#,\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  list1^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#,\n  list2^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(x \u003e y) ? list1.size() : list2.size()",
+      locationAst:
+        "_?_:_(\n  _\u003e_(\n    x^#1[1,0]#,\n    y^#3[1,4]#\n  )^#2[1,2]#,\n  list1^#5[1,8]#.size()^#6[1,18]#,\n  list2^#7[1,23]#.size()^#8[1,33]#\n)^#4[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 13, 1, 8, 1, 13],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 23, 28, 1, 23, 1, 28],
+        [8, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "1", identExpr: { name: "x" } },
+                    { id: "3", identExpr: { name: "y" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  target: { id: "5", identExpr: { name: "list1" } },
+                  function: "size",
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  target: { id: "7", identExpr: { name: "list2" } },
+                  function: "size",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list size from ternary",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 18,
+            "7": 23,
+            "8": 33,
+          },
+        },
+      },
+      checkedAst:
+        "_?_:_(\n  _\u003e_(\n    x~int^x,\n    y~int^y\n  )~bool^greater_int64,\n  list1~list(int)^list1.size()~int^list_size,\n  list2~list(int)^list2.size()~int^list_size\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["greater_int64"] },
+          "3": { name: "y" },
+          "4": { overloadId: ["conditional"] },
+          "5": { name: "list1" },
+          "6": { overloadId: ["list_size"] },
+          "7": { name: "list2" },
+          "8": { overloadId: ["list_size"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 18,
+            "7": 23,
+            "8": 33,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "1", identExpr: { name: "x" } },
+                    { id: "3", identExpr: { name: "y" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  target: { id: "5", identExpr: { name: "list1" } },
+                  function: "size",
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  target: { id: "7", identExpr: { name: "list2" } },
+                  function: "size",
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "5", max: "5" },
+      expectedCost: { min: "5", max: "5" },
+    },
+    {
+      original: {
+        name: "list size from concat",
+        expr: "([x, y] + list1 + list2).size()",
+        checkOnly: true,
+        typeEnv: [
+          { name: "x", ident: { type: { primitive: "INT64" } } },
+          { name: "y", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "list1",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "list2",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+      },
+      sizeHints: { list1: 10, list2: 20 },
+      ast: "_+_(\n  _+_(\n    [\n      x^#*expr.Expr_IdentExpr#,\n      y^#*expr.Expr_IdentExpr#\n    ]^#*expr.Expr_ListExpr#,\n    list1^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  list2^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#.size()^#*expr.Expr_CallExpr#",
+      unparsed: "([x, y] + list1 + list2).size()",
+      locationAst:
+        "_+_(\n  _+_(\n    [\n      x^#2[1,2]#,\n      y^#3[1,5]#\n    ]^#1[1,1]#,\n    list1^#5[1,10]#\n  )^#4[1,8]#,\n  list2^#7[1,18]#\n)^#6[1,16]#.size()^#8[1,29]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 15, 1, 10, 1, 15],
+        [6, 16, 17, 1, 16, 1, 17],
+        [7, 18, 23, 1, 18, 1, 23],
+        [8, 29, 30, 1, 29, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            target: {
+              id: "6",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        {
+                          id: "1",
+                          listExpr: {
+                            elements: [
+                              { id: "2", identExpr: { name: "x" } },
+                              { id: "3", identExpr: { name: "y" } },
+                            ],
+                          },
+                        },
+                        { id: "5", identExpr: { name: "list1" } },
+                      ],
+                    },
+                  },
+                  { id: "7", identExpr: { name: "list2" } },
+                ],
+              },
+            },
+            function: "size",
+          },
+        },
+        sourceInfo: {
+          location: "list size from concat",
+          lineOffsets: [32],
+          positions: {
+            "1": 1,
+            "2": 2,
+            "3": 5,
+            "4": 8,
+            "5": 10,
+            "6": 16,
+            "7": 18,
+            "8": 29,
+          },
+        },
+      },
+      checkedAst:
+        "_+_(\n  _+_(\n    [\n      x~int^x,\n      y~int^y\n    ]~list(int),\n    list1~list(int)^list1\n  )~list(int)^add_list,\n  list2~list(int)^list2\n)~list(int)^add_list.size()~int^list_size",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "3": { name: "y" },
+          "4": { overloadId: ["add_list"] },
+          "5": { name: "list1" },
+          "6": { overloadId: ["add_list"] },
+          "7": { name: "list2" },
+          "8": { overloadId: ["list_size"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 1,
+            "2": 2,
+            "3": 5,
+            "4": 8,
+            "5": 10,
+            "6": 16,
+            "7": 18,
+            "8": 29,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            target: {
+              id: "6",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        {
+                          id: "1",
+                          listExpr: {
+                            elements: [
+                              { id: "2", identExpr: { name: "x" } },
+                              { id: "3", identExpr: { name: "y" } },
+                            ],
+                          },
+                        },
+                        { id: "5", identExpr: { name: "list1" } },
+                      ],
+                    },
+                  },
+                  { id: "7", identExpr: { name: "list2" } },
+                ],
+              },
+            },
+            function: "size",
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "17", max: "17" },
+      expectedCost: { min: "17", max: "17" },
+    },
+    {
+      original: {
+        name: "list cost tracking through comprehension",
+        expr: "[list1, list2].exists(l, l.exists(v, v.startsWith('hi')))",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "list1",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "list2",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+        ],
+      },
+      sizeHints: {
+        list1: 10,
+        "list1.@items": 64,
+        list2: 20,
+        "list2.@items": 128,
+      },
+      ast: '__comprehension__(\n  // Variable\n  l,\n  // Target\n  [\n    list1^#*expr.Expr_IdentExpr#,\n    list2^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      v,\n      // Target\n      l^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      false^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        !_(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _||_(\n        @result^#*expr.Expr_IdentExpr#,\n        v^#*expr.Expr_IdentExpr#.startsWith(\n          "hi"^#*expr.Constant_StringValue#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: '[list1, list2].exists(l, l.exists(v, v.startsWith("hi")))',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  l,\n  // Target\n  [\n    list1^#2[1,1]#,\n    list2^#3[1,8]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#20[1,21]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#21[1,21]#\n    )^#22[1,21]#\n  )^#23[1,21]#,\n  // LoopStep\n  _||_(\n    @result^#24[1,21]#,\n    __comprehension__(\n      // Variable\n      v,\n      // Target\n      l^#6[1,25]#,\n      // Accumulator\n      @result,\n      // Init\n      false^#12[1,33]#,\n      // LoopCondition\n      @not_strictly_false(\n        !_(\n          @result^#13[1,33]#\n        )^#14[1,33]#\n      )^#15[1,33]#,\n      // LoopStep\n      _||_(\n        @result^#16[1,33]#,\n        v^#9[1,37]#.startsWith(\n          "hi"^#11[1,50]#\n        )^#10[1,49]#\n      )^#17[1,33]#,\n      // Result\n      @result^#18[1,33]#)^#19[1,33]#\n  )^#25[1,21]#,\n  // Result\n  @result^#26[1,21]#)^#27[1,21]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 6, 1, 1, 1, 6],
+        [3, 8, 13, 1, 8, 1, 13],
+        [5, 22, 23, 1, 22, 1, 23],
+        [6, 25, 26, 1, 25, 1, 26],
+        [8, 34, 35, 1, 34, 1, 35],
+        [9, 37, 38, 1, 37, 1, 38],
+        [10, 49, 50, 1, 49, 1, 50],
+        [11, 50, 54, 1, 50, 1, 54],
+        [12, 33, 33, 1, 33, 1, 33],
+        [13, 33, 33, 1, 33, 1, 33],
+        [14, 33, 33, 1, 33, 1, 33],
+        [15, 33, 33, 1, 33, 1, 33],
+        [16, 33, 33, 1, 33, 1, 33],
+        [17, 33, 33, 1, 33, 1, 33],
+        [18, 33, 33, 1, 33, 1, 33],
+        [19, 33, 33, 1, 33, 1, 33],
+        [20, 21, 21, 1, 21, 1, 21],
+        [21, 21, 21, 1, 21, 1, 21],
+        [22, 21, 21, 1, 21, 1, 21],
+        [23, 21, 21, 1, 21, 1, 21],
+        [24, 21, 21, 1, 21, 1, 21],
+        [25, 21, 21, 1, 21, 1, 21],
+        [26, 21, 21, 1, 21, 1, 21],
+        [27, 21, 21, 1, 21, 1, 21],
+      ],
+      lineOffsets: [58],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "l",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", identExpr: { name: "list1" } },
+                  { id: "3", identExpr: { name: "list2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "21", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "19",
+                    comprehensionExpr: {
+                      iterVar: "v",
+                      iterRange: { id: "6", identExpr: { name: "l" } },
+                      accuVar: "@result",
+                      accuInit: { id: "12", constExpr: { boolValue: false } },
+                      loopCondition: {
+                        id: "15",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [
+                            {
+                              id: "14",
+                              callExpr: {
+                                function: "!_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      loopStep: {
+                        id: "17",
+                        callExpr: {
+                          function: "_||_",
+                          args: [
+                            { id: "16", identExpr: { name: "@result" } },
+                            {
+                              id: "10",
+                              callExpr: {
+                                target: { id: "9", identExpr: { name: "v" } },
+                                function: "startsWith",
+                                args: [
+                                  {
+                                    id: "11",
+                                    constExpr: { stringValue: "hi" },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "18", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "list cost tracking through comprehension",
+          lineOffsets: [58],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 8,
+            "5": 22,
+            "6": 25,
+            "8": 34,
+            "9": 37,
+            "10": 49,
+            "11": 50,
+            "12": 33,
+            "13": 33,
+            "14": 33,
+            "15": 33,
+            "16": 33,
+            "17": 33,
+            "18": 33,
+            "19": 33,
+            "20": 21,
+            "21": 21,
+            "22": 21,
+            "23": 21,
+            "24": 21,
+            "25": 21,
+            "26": 21,
+            "27": 21,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: { id: "6", identExpr: { name: "l" } },
+                function: "exists",
+                args: [
+                  { id: "8", identExpr: { name: "v" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      target: { id: "9", identExpr: { name: "v" } },
+                      function: "startsWith",
+                      args: [{ id: "11", constExpr: { stringValue: "hi" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "list1" } },
+                      { id: "3", identExpr: { name: "list2" } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [{ id: "5", identExpr: { name: "l" } }, { id: "19" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "exists",
+          target: { id: "6", identExpr: { name: "l" } },
+          args: [
+            { id: "8", identExpr: { name: "v" } },
+            {
+              id: "10",
+              callExpr: {
+                target: { id: "9", identExpr: { name: "v" } },
+                function: "startsWith",
+                args: [{ id: "11", constExpr: { stringValue: "hi" } }],
+              },
+            },
+          ],
+        },
+        {
+          id: 27,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", identExpr: { name: "list1" } },
+                { id: "3", identExpr: { name: "list2" } },
+              ],
+            },
+          },
+          args: [{ id: "5", identExpr: { name: "l" } }, { id: "19" }],
+        },
+      ],
+      checkedAst:
+        '__comprehension__(\n  // Variable\n  l,\n  // Target\n  [\n    list1~list(string)^list1,\n    list2~list(string)^list2\n  ]~list(list(string)),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      v,\n      // Target\n      l~list(string)^l,\n      // Accumulator\n      @result,\n      // Init\n      false~bool,\n      // LoopCondition\n      @not_strictly_false(\n        !_(\n          @result~bool^@result\n        )~bool^logical_not\n      )~bool^not_strictly_false,\n      // LoopStep\n      _||_(\n        @result~bool^@result,\n        v~string^v.startsWith(\n          "hi"~string\n        )~bool^starts_with_string\n      )~bool^logical_or,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "list1" },
+          "3": { name: "list2" },
+          "6": { name: "l" },
+          "9": { name: "v" },
+          "10": { overloadId: ["starts_with_string"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_not"] },
+          "15": { overloadId: ["not_strictly_false"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_or"] },
+          "18": { name: "@result" },
+          "21": { name: "@result" },
+          "22": { overloadId: ["logical_not"] },
+          "23": { overloadId: ["not_strictly_false"] },
+          "24": { name: "@result" },
+          "25": { overloadId: ["logical_or"] },
+          "26": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "STRING" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 8,
+            "5": 22,
+            "6": 25,
+            "8": 34,
+            "9": 37,
+            "10": 49,
+            "11": 50,
+            "12": 33,
+            "13": 33,
+            "14": 33,
+            "15": 33,
+            "16": 33,
+            "17": 33,
+            "18": 33,
+            "19": 33,
+            "20": 21,
+            "21": 21,
+            "22": 21,
+            "23": 21,
+            "24": 21,
+            "25": 21,
+            "26": 21,
+            "27": 21,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: { id: "6", identExpr: { name: "l" } },
+                function: "exists",
+                args: [
+                  { id: "8", identExpr: { name: "v" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      target: { id: "9", identExpr: { name: "v" } },
+                      function: "startsWith",
+                      args: [{ id: "11", constExpr: { stringValue: "hi" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "list1" } },
+                      { id: "3", identExpr: { name: "list2" } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [{ id: "5", identExpr: { name: "l" } }, { id: "19" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "l",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", identExpr: { name: "list1" } },
+                  { id: "3", identExpr: { name: "list2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "21", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "19",
+                    comprehensionExpr: {
+                      iterVar: "v",
+                      iterRange: { id: "6", identExpr: { name: "l" } },
+                      accuVar: "@result",
+                      accuInit: { id: "12", constExpr: { boolValue: false } },
+                      loopCondition: {
+                        id: "15",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [
+                            {
+                              id: "14",
+                              callExpr: {
+                                function: "!_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      loopStep: {
+                        id: "17",
+                        callExpr: {
+                          function: "_||_",
+                          args: [
+                            { id: "16", identExpr: { name: "@result" } },
+                            {
+                              id: "10",
+                              callExpr: {
+                                target: { id: "9", identExpr: { name: "v" } },
+                                function: "startsWith",
+                                args: [
+                                  {
+                                    id: "11",
+                                    constExpr: { stringValue: "hi" },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "18", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "265" },
+      expectedCost: { min: "21", max: "265" },
+    },
+    {
+      original: {
+        name: "str endsWith equality",
+        expr: 'str1.endsWith("abcdefghijklmnopqrstuvwxyz") == str2.endsWith("abcdefghijklmnopqrstuvwxyz")',
+        checkOnly: true,
+        typeEnv: [
+          { name: "str1", ident: { type: { primitive: "STRING" } } },
+          { name: "str2", ident: { type: { primitive: "STRING" } } },
+        ],
+      },
+      ast: '_==_(\n  str1^#*expr.Expr_IdentExpr#.endsWith(\n    "abcdefghijklmnopqrstuvwxyz"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  str2^#*expr.Expr_IdentExpr#.endsWith(\n    "abcdefghijklmnopqrstuvwxyz"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'str1.endsWith("abcdefghijklmnopqrstuvwxyz") == str2.endsWith("abcdefghijklmnopqrstuvwxyz")',
+      locationAst:
+        '_==_(\n  str1^#1[1,0]#.endsWith(\n    "abcdefghijklmnopqrstuvwxyz"^#3[1,14]#\n  )^#2[1,13]#,\n  str2^#5[1,47]#.endsWith(\n    "abcdefghijklmnopqrstuvwxyz"^#7[1,61]#\n  )^#6[1,60]#\n)^#4[1,44]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 42, 1, 14, 1, 42],
+        [4, 44, 46, 1, 44, 1, 46],
+        [5, 47, 51, 1, 47, 1, 51],
+        [6, 60, 61, 1, 60, 1, 61],
+        [7, 61, 89, 1, 61, 1, 89],
+      ],
+      lineOffsets: [91],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "str1" } },
+                  function: "endsWith",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "abcdefghijklmnopqrstuvwxyz" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  target: { id: "5", identExpr: { name: "str2" } },
+                  function: "endsWith",
+                  args: [
+                    {
+                      id: "7",
+                      constExpr: { stringValue: "abcdefghijklmnopqrstuvwxyz" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "str endsWith equality",
+          lineOffsets: [91],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 44,
+            "5": 47,
+            "6": 60,
+            "7": 61,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  str1~string^str1.endsWith(\n    "abcdefghijklmnopqrstuvwxyz"~string\n  )~bool^ends_with_string,\n  str2~string^str2.endsWith(\n    "abcdefghijklmnopqrstuvwxyz"~string\n  )~bool^ends_with_string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "str1" },
+          "2": { overloadId: ["ends_with_string"] },
+          "4": { overloadId: ["equals"] },
+          "5": { name: "str2" },
+          "6": { overloadId: ["ends_with_string"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [91],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 44,
+            "5": 47,
+            "6": 60,
+            "7": 61,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "str1" } },
+                  function: "endsWith",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "abcdefghijklmnopqrstuvwxyz" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  target: { id: "5", identExpr: { name: "str2" } },
+                  function: "endsWith",
+                  args: [
+                    {
+                      id: "7",
+                      constExpr: { stringValue: "abcdefghijklmnopqrstuvwxyz" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "9", max: "9" },
+      expectedCost: { min: "9", max: "9" },
+    },
+    {
+      original: {
+        name: "nested subexpression operators",
+        expr: "((5 != 6) == (1 == 2)) == ((3 \u003c= 4) == (9 != 9))",
+        checkOnly: true,
+      },
+      ast: "_==_(\n  _==_(\n    _!=_(\n      5^#*expr.Constant_Int64Value#,\n      6^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _\u003c=_(\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _!=_(\n      9^#*expr.Constant_Int64Value#,\n      9^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "5 != 6 == (1 == 2) == (3 \u003c= 4 == (9 != 9))",
+      locationAst:
+        "_==_(\n  _==_(\n    _!=_(\n      5^#1[1,2]#,\n      6^#3[1,7]#\n    )^#2[1,4]#,\n    _==_(\n      1^#5[1,14]#,\n      2^#7[1,19]#\n    )^#6[1,16]#\n  )^#4[1,10]#,\n  _==_(\n    _\u003c=_(\n      3^#9[1,28]#,\n      4^#11[1,33]#\n    )^#10[1,30]#,\n    _!=_(\n      9^#13[1,40]#,\n      9^#15[1,45]#\n    )^#14[1,42]#\n  )^#12[1,36]#\n)^#8[1,23]#",
+      positions: [
+        [1, 2, 3, 1, 2, 1, 3],
+        [2, 4, 6, 1, 4, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 10, 12, 1, 10, 1, 12],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 16, 18, 1, 16, 1, 18],
+        [7, 19, 20, 1, 19, 1, 20],
+        [8, 23, 25, 1, 23, 1, 25],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 30, 32, 1, 30, 1, 32],
+        [11, 33, 34, 1, 33, 1, 34],
+        [12, 36, 38, 1, 36, 1, 38],
+        [13, 40, 41, 1, 40, 1, 41],
+        [14, 42, 44, 1, 42, 1, 44],
+        [15, 45, 46, 1, 45, 1, 46],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_!=_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "5" } },
+                          { id: "3", constExpr: { int64Value: "6" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "10",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          { id: "9", constExpr: { int64Value: "3" } },
+                          { id: "11", constExpr: { int64Value: "4" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "14",
+                      callExpr: {
+                        function: "_!=_",
+                        args: [
+                          { id: "13", constExpr: { int64Value: "9" } },
+                          { id: "15", constExpr: { int64Value: "9" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "nested subexpression operators",
+          lineOffsets: [49],
+          positions: {
+            "1": 2,
+            "2": 4,
+            "3": 7,
+            "4": 10,
+            "5": 14,
+            "6": 16,
+            "7": 19,
+            "8": 23,
+            "9": 28,
+            "10": 30,
+            "11": 33,
+            "12": 36,
+            "13": 40,
+            "14": 42,
+            "15": 45,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _==_(\n    _!=_(\n      5~int,\n      6~int\n    )~bool^not_equals,\n    _==_(\n      1~int,\n      2~int\n    )~bool^equals\n  )~bool^equals,\n  _==_(\n    _\u003c=_(\n      3~int,\n      4~int\n    )~bool^less_equals_int64,\n    _!=_(\n      9~int,\n      9~int\n    )~bool^not_equals\n  )~bool^equals\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["not_equals"] },
+          "4": { overloadId: ["equals"] },
+          "6": { overloadId: ["equals"] },
+          "8": { overloadId: ["equals"] },
+          "10": { overloadId: ["less_equals_int64"] },
+          "12": { overloadId: ["equals"] },
+          "14": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 2,
+            "2": 4,
+            "3": 7,
+            "4": 10,
+            "5": 14,
+            "6": 16,
+            "7": 19,
+            "8": 23,
+            "9": 28,
+            "10": 30,
+            "11": 33,
+            "12": 36,
+            "13": 40,
+            "14": 42,
+            "15": 45,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_!=_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "5" } },
+                          { id: "3", constExpr: { int64Value: "6" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "10",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          { id: "9", constExpr: { int64Value: "3" } },
+                          { id: "11", constExpr: { int64Value: "4" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "14",
+                      callExpr: {
+                        function: "_!=_",
+                        args: [
+                          { id: "13", constExpr: { int64Value: "9" } },
+                          { id: "15", constExpr: { int64Value: "9" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "7", max: "7" },
+      expectedCost: { min: "7", max: "7" },
+    },
+    {
+      original: {
+        name: "str size estimate",
+        expr: "string(timestamp1) == string(timestamp2)",
+        checkOnly: true,
+        typeEnv: [
+          { name: "timestamp1", ident: { type: { wellKnown: "TIMESTAMP" } } },
+          { name: "timestamp2", ident: { type: { wellKnown: "TIMESTAMP" } } },
+        ],
+      },
+      ast: "_==_(\n  string(\n    timestamp1^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  string(\n    timestamp2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "string(timestamp1) == string(timestamp2)",
+      locationAst:
+        "_==_(\n  string(\n    timestamp1^#2[1,7]#\n  )^#1[1,6]#,\n  string(\n    timestamp2^#5[1,29]#\n  )^#4[1,28]#\n)^#3[1,19]#",
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 17, 1, 7, 1, 17],
+        [3, 19, 21, 1, 19, 1, 21],
+        [4, 28, 29, 1, 28, 1, 29],
+        [5, 29, 39, 1, 29, 1, 39],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "2", identExpr: { name: "timestamp1" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "5", identExpr: { name: "timestamp2" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "str size estimate",
+          lineOffsets: [41],
+          positions: { "1": 6, "2": 7, "3": 19, "4": 28, "5": 29 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  string(\n    timestamp1~timestamp^timestamp1\n  )~string^timestamp_to_string,\n  string(\n    timestamp2~timestamp^timestamp2\n  )~string^timestamp_to_string\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["timestamp_to_string"] },
+          "2": { name: "timestamp1" },
+          "3": { overloadId: ["equals"] },
+          "4": { overloadId: ["timestamp_to_string"] },
+          "5": { name: "timestamp2" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { wellKnown: "TIMESTAMP" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+          "5": { wellKnown: "TIMESTAMP" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 6, "2": 7, "3": 19, "4": 28, "5": 29 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "2", identExpr: { name: "timestamp1" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "5", identExpr: { name: "timestamp2" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "1844674407370955268" },
+      expectedCost: { min: "5", max: "1844674407370955268" },
+    },
+    {
+      original: {
+        name: "timestamp equality check",
+        expr: "timestamp1 == timestamp2",
+        checkOnly: true,
+        typeEnv: [
+          { name: "timestamp1", ident: { type: { wellKnown: "TIMESTAMP" } } },
+          { name: "timestamp2", ident: { type: { wellKnown: "TIMESTAMP" } } },
+        ],
+      },
+      ast: "_==_(\n  timestamp1^#*expr.Expr_IdentExpr#,\n  timestamp2^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "timestamp1 == timestamp2",
+      locationAst:
+        "_==_(\n  timestamp1^#1[1,0]#,\n  timestamp2^#3[1,14]#\n)^#2[1,11]#",
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 11, 13, 1, 11, 1, 13],
+        [3, 14, 24, 1, 14, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", identExpr: { name: "timestamp1" } },
+              { id: "3", identExpr: { name: "timestamp2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp equality check",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 11, "3": 14 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  timestamp1~timestamp^timestamp1,\n  timestamp2~timestamp^timestamp2\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "timestamp1" },
+          "2": { overloadId: ["equals"] },
+          "3": { name: "timestamp2" },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "BOOL" },
+          "3": { wellKnown: "TIMESTAMP" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 11, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", identExpr: { name: "timestamp1" } },
+              { id: "3", identExpr: { name: "timestamp2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      expectedCost: { min: "3", max: "3" },
+    },
+    {
+      original: {
+        name: "duration inequality check",
+        expr: "duration1 != duration2",
+        checkOnly: true,
+        typeEnv: [
+          { name: "duration1", ident: { type: { wellKnown: "DURATION" } } },
+          { name: "duration2", ident: { type: { wellKnown: "DURATION" } } },
+        ],
+      },
+      ast: "_!=_(\n  duration1^#*expr.Expr_IdentExpr#,\n  duration2^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "duration1 != duration2",
+      locationAst:
+        "_!=_(\n  duration1^#1[1,0]#,\n  duration2^#3[1,13]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 10, 12, 1, 10, 1, 12],
+        [3, 13, 22, 1, 13, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              { id: "1", identExpr: { name: "duration1" } },
+              { id: "3", identExpr: { name: "duration2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "duration inequality check",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 13 },
+        },
+      },
+      checkedAst:
+        "_!=_(\n  duration1~duration^duration1,\n  duration2~duration^duration2\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "duration1" },
+          "2": { overloadId: ["not_equals"] },
+          "3": { name: "duration2" },
+        },
+        typeMap: {
+          "1": { wellKnown: "DURATION" },
+          "2": { primitive: "BOOL" },
+          "3": { wellKnown: "DURATION" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              { id: "1", identExpr: { name: "duration1" } },
+              { id: "3", identExpr: { name: "duration2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      expectedCost: { min: "3", max: "3" },
+    },
+    {
+      original: {
+        name: ".filter list literal",
+        expr: "[1,2,3,4,5].filter(x, x % 2 == 0)",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#*expr.Expr_IdentExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].filter(x, x % 2 == 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#,\n    5^#6[1,9]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#14[1,18]#,\n  // LoopCondition\n  true^#15[1,18]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#9[1,22]#,\n        2^#11[1,26]#\n      )^#10[1,24]#,\n      0^#13[1,31]#\n    )^#12[1,28]#,\n    _+_(\n      @result^#16[1,18]#,\n      [\n        x^#8[1,19]#\n      ]^#17[1,18]#\n    )^#18[1,18]#,\n    @result^#19[1,18]#\n  )^#20[1,18]#,\n  // Result\n  @result^#21[1,18]#)^#22[1,18]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 24, 25, 1, 24, 1, 25],
+        [11, 26, 27, 1, 26, 1, 27],
+        [12, 28, 30, 1, 28, 1, 30],
+        [13, 31, 32, 1, 31, 1, 32],
+        [14, 18, 18, 1, 18, 1, 18],
+        [15, 18, 18, 1, 18, 1, 18],
+        [16, 18, 18, 1, 18, 1, 18],
+        [17, 18, 18, 1, 18, 1, 18],
+        [18, 18, 18, 1, 18, 1, 18],
+        [19, 18, 18, 1, 18, 1, 18],
+        [20, 18, 18, 1, 18, 1, 18],
+        [21, 18, 18, 1, 18, 1, 18],
+        [22, 18, 18, 1, 18, 1, 18],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "14", listExpr: {} },
+            loopCondition: { id: "15", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "9", identExpr: { name: "x" } },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "13", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "16", identExpr: { name: "@result" } },
+                        {
+                          id: "17",
+                          listExpr: {
+                            elements: [{ id: "8", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "19", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".filter list literal",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 19,
+            "9": 22,
+            "10": 24,
+            "11": 26,
+            "12": 28,
+            "13": 31,
+            "14": 18,
+            "15": 18,
+            "16": 18,
+            "17": 18,
+            "18": 18,
+            "19": 18,
+            "20": 18,
+            "21": 18,
+            "22": 18,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "9", identExpr: { name: "x" } },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "13", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 22,
+          function: "filter",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "12",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_%_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "13", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int,\n    5~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x~int^x,\n        2~int\n      )~int^modulo_int64,\n      0~int\n    )~bool^equals,\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "8": { name: "x" },
+          "9": { name: "x" },
+          "10": { overloadId: ["modulo_int64"] },
+          "12": { overloadId: ["equals"] },
+          "16": { name: "@result" },
+          "18": { overloadId: ["add_list"] },
+          "19": { name: "@result" },
+          "20": { overloadId: ["conditional"] },
+          "21": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "INT64" },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { primitive: "BOOL" },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 19,
+            "9": 22,
+            "10": 24,
+            "11": 26,
+            "12": 28,
+            "13": 31,
+            "14": 18,
+            "15": 18,
+            "16": 18,
+            "17": 18,
+            "18": 18,
+            "19": 18,
+            "20": 18,
+            "21": 18,
+            "22": 18,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "9", identExpr: { name: "x" } },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "13", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "14", listExpr: {} },
+            loopCondition: { id: "15", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "9", identExpr: { name: "x" } },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "13", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "16", identExpr: { name: "@result" } },
+                        {
+                          id: "17",
+                          listExpr: {
+                            elements: [{ id: "8", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "19", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "41", max: "101" },
+      expectedCost: { min: "41", max: "101" },
+    },
+    {
+      original: {
+        name: ".map list literal",
+        expr: "[1,2,3,4,5].map(x, x)",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      x^#*expr.Expr_IdentExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#,\n    5^#6[1,9]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#10[1,15]#,\n  // LoopCondition\n  true^#11[1,15]#,\n  // LoopStep\n  _+_(\n    @result^#12[1,15]#,\n    [\n      x^#9[1,19]#\n    ]^#13[1,15]#\n  )^#14[1,15]#,\n  // Result\n  @result^#15[1,15]#)^#16[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", listExpr: {} },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "12", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    listExpr: {
+                      elements: [{ id: "9", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".map list literal",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int,\n    5~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(int)^@result,\n    [\n      x~int^x\n    ]~list(int)\n  )~list(int)^add_list,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", listExpr: {} },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "12", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    listExpr: {
+                      elements: [{ id: "9", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "86", max: "86" },
+      expectedCost: { min: "86", max: "86" },
+    },
+    {
+      original: {
+        name: ".map.filter list literal",
+        expr: "[1,2,3,4,5].map(x, x).filter(x, x % 2 == 0)",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#*expr.Expr_IdentExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x).filter(x, x % 2 == 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,3]#,\n      3^#4[1,5]#,\n      4^#5[1,7]#,\n      5^#6[1,9]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#10[1,15]#,\n    // LoopCondition\n    true^#11[1,15]#,\n    // LoopStep\n    _+_(\n      @result^#12[1,15]#,\n      [\n        x^#9[1,19]#\n      ]^#13[1,15]#\n    )^#14[1,15]#,\n    // Result\n    @result^#15[1,15]#)^#16[1,15]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#24[1,28]#,\n  // LoopCondition\n  true^#25[1,28]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#19[1,32]#,\n        2^#21[1,36]#\n      )^#20[1,34]#,\n      0^#23[1,41]#\n    )^#22[1,38]#,\n    _+_(\n      @result^#26[1,28]#,\n      [\n        x^#18[1,29]#\n      ]^#27[1,28]#\n    )^#28[1,28]#,\n    @result^#29[1,28]#\n  )^#30[1,28]#,\n  // Result\n  @result^#31[1,28]#)^#32[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [18, 29, 30, 1, 29, 1, 30],
+        [19, 32, 33, 1, 32, 1, 33],
+        [20, 34, 35, 1, 34, 1, 35],
+        [21, 36, 37, 1, 36, 1, 37],
+        [22, 38, 40, 1, 38, 1, 40],
+        [23, 41, 42, 1, 41, 1, 42],
+        [24, 28, 28, 1, 28, 1, 28],
+        [25, 28, 28, 1, 28, 1, 28],
+        [26, 28, 28, 1, 28, 1, 28],
+        [27, 28, 28, 1, 28, 1, 28],
+        [28, 28, 28, 1, 28, 1, 28],
+        [29, 28, 28, 1, 28, 1, 28],
+        [30, 28, 28, 1, 28, 1, 28],
+        [31, 28, 28, 1, 28, 1, 28],
+        [32, 28, 28, 1, 28, 1, 28],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "32",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "10", listExpr: {} },
+                loopCondition: { id: "11", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "13",
+                        listExpr: {
+                          elements: [{ id: "9", identExpr: { name: "x" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "24", listExpr: {} },
+            loopCondition: { id: "25", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "30",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "23", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "28",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "26", identExpr: { name: "@result" } },
+                        {
+                          id: "27",
+                          listExpr: {
+                            elements: [{ id: "18", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "29", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "31", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".map.filter list literal",
+          lineOffsets: [44],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 29,
+            "19": 32,
+            "20": 34,
+            "21": 36,
+            "22": 38,
+            "23": 41,
+            "24": 28,
+            "25": 28,
+            "26": 28,
+            "27": 28,
+            "28": 28,
+            "29": 28,
+            "30": 28,
+            "31": 28,
+            "32": 28,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "32": {
+              callExpr: {
+                target: { id: "16" },
+                function: "filter",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "23", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+        {
+          id: 32,
+          function: "filter",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "x" } },
+            {
+              id: "22",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_%_",
+                      args: [
+                        { id: "19", identExpr: { name: "x" } },
+                        { id: "21", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "23", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int,\n      5~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x~int^x,\n        2~int\n      )~int^modulo_int64,\n      0~int\n    )~bool^equals,\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "18": { name: "x" },
+          "19": { name: "x" },
+          "20": { overloadId: ["modulo_int64"] },
+          "22": { overloadId: ["equals"] },
+          "26": { name: "@result" },
+          "28": { overloadId: ["add_list"] },
+          "29": { name: "@result" },
+          "30": { overloadId: ["conditional"] },
+          "31": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { primitive: "INT64" },
+          "19": { primitive: "INT64" },
+          "20": { primitive: "INT64" },
+          "21": { primitive: "INT64" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "INT64" },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { primitive: "BOOL" },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+          "28": { listType: { elemType: { primitive: "INT64" } } },
+          "29": { listType: { elemType: { primitive: "INT64" } } },
+          "30": { listType: { elemType: { primitive: "INT64" } } },
+          "31": { listType: { elemType: { primitive: "INT64" } } },
+          "32": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 29,
+            "19": 32,
+            "20": 34,
+            "21": 36,
+            "22": 38,
+            "23": 41,
+            "24": 28,
+            "25": 28,
+            "26": 28,
+            "27": 28,
+            "28": 28,
+            "29": 28,
+            "30": 28,
+            "31": 28,
+            "32": 28,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "32": {
+              callExpr: {
+                target: { id: "16" },
+                function: "filter",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "23", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "32",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "10", listExpr: {} },
+                loopCondition: { id: "11", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "13",
+                        listExpr: {
+                          elements: [{ id: "9", identExpr: { name: "x" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "24", listExpr: {} },
+            loopCondition: { id: "25", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "30",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "23", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "28",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "26", identExpr: { name: "@result" } },
+                        {
+                          id: "27",
+                          listExpr: {
+                            elements: [{ id: "18", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "29", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "31", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "117", max: "177" },
+      expectedCost: { min: "117", max: "177" },
+    },
+    {
+      original: {
+        name: ".map.exists list literal",
+        expr: "[1,2,3,4,5].map(x, x).exists(x, x == 5) == true",
+        checkOnly: true,
+      },
+      ast: "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#,\n        4^#*expr.Constant_Int64Value#,\n        5^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    // Accumulator\n    @result,\n    // Init\n    false^#*expr.Constant_BoolValue#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // LoopStep\n    _||_(\n      @result^#*expr.Expr_IdentExpr#,\n      _==_(\n        x^#*expr.Expr_IdentExpr#,\n        5^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x).exists(x, x == 5) == true",
+      locationAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1^#2[1,1]#,\n        2^#3[1,3]#,\n        3^#4[1,5]#,\n        4^#5[1,7]#,\n        5^#6[1,9]#\n      ]^#1[1,0]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#10[1,15]#,\n      // LoopCondition\n      true^#11[1,15]#,\n      // LoopStep\n      _+_(\n        @result^#12[1,15]#,\n        [\n          x^#9[1,19]#\n        ]^#13[1,15]#\n      )^#14[1,15]#,\n      // Result\n      @result^#15[1,15]#)^#16[1,15]#,\n    // Accumulator\n    @result,\n    // Init\n    false^#22[1,28]#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#23[1,28]#\n      )^#24[1,28]#\n    )^#25[1,28]#,\n    // LoopStep\n    _||_(\n      @result^#26[1,28]#,\n      _==_(\n        x^#19[1,32]#,\n        5^#21[1,37]#\n      )^#20[1,34]#\n    )^#27[1,28]#,\n    // Result\n    @result^#28[1,28]#)^#29[1,28]#,\n  true^#31[1,43]#\n)^#30[1,40]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [18, 29, 30, 1, 29, 1, 30],
+        [19, 32, 33, 1, 32, 1, 33],
+        [20, 34, 36, 1, 34, 1, 36],
+        [21, 37, 38, 1, 37, 1, 38],
+        [22, 28, 28, 1, 28, 1, 28],
+        [23, 28, 28, 1, 28, 1, 28],
+        [24, 28, 28, 1, 28, 1, 28],
+        [25, 28, 28, 1, 28, 1, 28],
+        [26, 28, 28, 1, 28, 1, 28],
+        [27, 28, 28, 1, 28, 1, 28],
+        [28, 28, 28, 1, 28, 1, 28],
+        [29, 28, 28, 1, 28, 1, 28],
+        [30, 40, 42, 1, 40, 1, 42],
+        [31, 43, 47, 1, 43, 1, 47],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "30",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "29",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                            { id: "5", constExpr: { int64Value: "4" } },
+                            { id: "6", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "12", identExpr: { name: "@result" } },
+                            {
+                              id: "13",
+                              listExpr: {
+                                elements: [
+                                  { id: "9", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "22", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "25",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "24",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "23", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "27",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "26", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "5" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "28", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "31", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: ".map.exists list literal",
+          lineOffsets: [48],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 29,
+            "19": 32,
+            "20": 34,
+            "21": 37,
+            "22": 28,
+            "23": 28,
+            "24": 28,
+            "25": 28,
+            "26": 28,
+            "27": 28,
+            "28": 28,
+            "29": 28,
+            "30": 40,
+            "31": 43,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: { id: "16" },
+                function: "exists",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "19", identExpr: { name: "x" } },
+                        { id: "21", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+        {
+          id: 29,
+          function: "exists",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "x" } },
+            {
+              id: "20",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "19", identExpr: { name: "x" } },
+                  { id: "21", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1~int,\n        2~int,\n        3~int,\n        4~int,\n        5~int\n      ]~list(int),\n      // Accumulator\n      @result,\n      // Init\n      []~list(int),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _+_(\n        @result~list(int)^@result,\n        [\n          x~int^x\n        ]~list(int)\n      )~list(int)^add_list,\n      // Result\n      @result~list(int)^@result)~list(int),\n    // Accumulator\n    @result,\n    // Init\n    false~bool,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result~bool^@result\n      )~bool^logical_not\n    )~bool^not_strictly_false,\n    // LoopStep\n    _||_(\n      @result~bool^@result,\n      _==_(\n        x~int^x,\n        5~int\n      )~bool^equals\n    )~bool^logical_or,\n    // Result\n    @result~bool^@result)~bool,\n  true~bool\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "19": { name: "x" },
+          "20": { overloadId: ["equals"] },
+          "23": { name: "@result" },
+          "24": { overloadId: ["logical_not"] },
+          "25": { overloadId: ["not_strictly_false"] },
+          "26": { name: "@result" },
+          "27": { overloadId: ["logical_or"] },
+          "28": { name: "@result" },
+          "30": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "INT64" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "INT64" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 29,
+            "19": 32,
+            "20": 34,
+            "21": 37,
+            "22": 28,
+            "23": 28,
+            "24": 28,
+            "25": 28,
+            "26": 28,
+            "27": 28,
+            "28": 28,
+            "29": 28,
+            "30": 40,
+            "31": 43,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: { id: "16" },
+                function: "exists",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "19", identExpr: { name: "x" } },
+                        { id: "21", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "30",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "29",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                            { id: "5", constExpr: { int64Value: "4" } },
+                            { id: "6", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "12", identExpr: { name: "@result" } },
+                            {
+                              id: "13",
+                              listExpr: {
+                                elements: [
+                                  { id: "9", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "22", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "25",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "24",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "23", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "27",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "26", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "5" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "28", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "31", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "108", max: "118" },
+      expectedCost: { min: "108", max: "118" },
+    },
+    {
+      original: {
+        name: ".map.map list literal",
+        expr: "[1,2,3,4,5].map(x, x).map(x, x)",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      x^#*expr.Expr_IdentExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x).map(x, x)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,3]#,\n      3^#4[1,5]#,\n      4^#5[1,7]#,\n      5^#6[1,9]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#10[1,15]#,\n    // LoopCondition\n    true^#11[1,15]#,\n    // LoopStep\n    _+_(\n      @result^#12[1,15]#,\n      [\n        x^#9[1,19]#\n      ]^#13[1,15]#\n    )^#14[1,15]#,\n    // Result\n    @result^#15[1,15]#)^#16[1,15]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#20[1,25]#,\n  // LoopCondition\n  true^#21[1,25]#,\n  // LoopStep\n  _+_(\n    @result^#22[1,25]#,\n    [\n      x^#19[1,29]#\n    ]^#23[1,25]#\n  )^#24[1,25]#,\n  // Result\n  @result^#25[1,25]#)^#26[1,25]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [18, 26, 27, 1, 26, 1, 27],
+        [19, 29, 30, 1, 29, 1, 30],
+        [20, 25, 25, 1, 25, 1, 25],
+        [21, 25, 25, 1, 25, 1, 25],
+        [22, 25, 25, 1, 25, 1, 25],
+        [23, 25, 25, 1, 25, 1, 25],
+        [24, 25, 25, 1, 25, 1, 25],
+        [25, 25, 25, 1, 25, 1, 25],
+        [26, 25, 25, 1, 25, 1, 25],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "26",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "10", listExpr: {} },
+                loopCondition: { id: "11", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "13",
+                        listExpr: {
+                          elements: [{ id: "9", identExpr: { name: "x" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", listExpr: {} },
+            loopCondition: { id: "21", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "24",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "22", identExpr: { name: "@result" } },
+                  {
+                    id: "23",
+                    listExpr: {
+                      elements: [{ id: "19", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "25", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".map.map list literal",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 26,
+            "19": 29,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+        {
+          id: 26,
+          function: "map",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "x" } },
+            { id: "19", identExpr: { name: "x" } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int,\n      5~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(int)^@result,\n    [\n      x~int^x\n    ]~list(int)\n  )~list(int)^add_list,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "19": { name: "x" },
+          "22": { name: "@result" },
+          "24": { overloadId: ["add_list"] },
+          "25": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "INT64" },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { primitive: "BOOL" },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { listType: { elemType: { primitive: "INT64" } } },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 26,
+            "19": 29,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "26",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "10", listExpr: {} },
+                loopCondition: { id: "11", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "13",
+                        listExpr: {
+                          elements: [{ id: "9", identExpr: { name: "x" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", listExpr: {} },
+            loopCondition: { id: "21", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "24",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "22", identExpr: { name: "@result" } },
+                  {
+                    id: "23",
+                    listExpr: {
+                      elements: [{ id: "19", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "25", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "162", max: "162" },
+      expectedCost: { min: "162", max: "162" },
+    },
+    {
+      original: {
+        name: ".map list literal selection",
+        expr: "[1,2,3,4,5].map(x, x)[4]",
+        checkOnly: true,
+      },
+      ast: "_[_](\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x)[4]",
+      locationAst:
+        "_[_](\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,3]#,\n      3^#4[1,5]#,\n      4^#5[1,7]#,\n      5^#6[1,9]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#10[1,15]#,\n    // LoopCondition\n    true^#11[1,15]#,\n    // LoopStep\n    _+_(\n      @result^#12[1,15]#,\n      [\n        x^#9[1,19]#\n      ]^#13[1,15]#\n    )^#14[1,15]#,\n    // Result\n    @result^#15[1,15]#)^#16[1,15]#,\n  4^#18[1,22]#\n)^#17[1,21]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [17, 21, 22, 1, 21, 1, 22],
+        [18, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "16",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                        { id: "6", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "10", listExpr: {} },
+                  loopCondition: { id: "11", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [{ id: "9", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "15", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "18", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: ".map list literal selection",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "17": 21,
+            "18": 22,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+      ],
+      checkedAst:
+        "_[_](\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int,\n      5~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  4~int\n)~int^index_list",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "17": { overloadId: ["index_list"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "17": 21,
+            "18": 22,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "16",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                        { id: "6", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "10", listExpr: {} },
+                  loopCondition: { id: "11", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [{ id: "9", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "15", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "18", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "87", max: "87" },
+      expectedCost: { min: "87", max: "87" },
+    },
+    {
+      original: {
+        name: "nested array selection",
+        expr: "[[1,2],[1,2],[1,2],[1,2],[1,2]][4]",
+        checkOnly: true,
+      },
+      ast: "_[_](\n  [\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[[1, 2], [1, 2], [1, 2], [1, 2], [1, 2]][4]",
+      locationAst:
+        "_[_](\n  [\n    [\n      1^#3[1,2]#,\n      2^#4[1,4]#\n    ]^#2[1,1]#,\n    [\n      1^#6[1,8]#,\n      2^#7[1,10]#\n    ]^#5[1,7]#,\n    [\n      1^#9[1,14]#,\n      2^#10[1,16]#\n    ]^#8[1,13]#,\n    [\n      1^#12[1,20]#,\n      2^#13[1,22]#\n    ]^#11[1,19]#,\n    [\n      1^#15[1,26]#,\n      2^#16[1,28]#\n    ]^#14[1,25]#\n  ]^#1[1,0]#,\n  4^#18[1,32]#\n)^#17[1,31]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 8, 9, 1, 8, 1, 9],
+        [7, 10, 11, 1, 10, 1, 11],
+        [8, 13, 14, 1, 13, 1, 14],
+        [9, 14, 15, 1, 14, 1, 15],
+        [10, 16, 17, 1, 16, 1, 17],
+        [11, 19, 20, 1, 19, 1, 20],
+        [12, 20, 21, 1, 20, 1, 21],
+        [13, 22, 23, 1, 22, 1, 23],
+        [14, 25, 26, 1, 25, 1, 26],
+        [15, 26, 27, 1, 26, 1, 27],
+        [16, 28, 29, 1, 28, 1, 29],
+        [17, 31, 32, 1, 31, 1, 32],
+        [18, 32, 33, 1, 32, 1, 33],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "2",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "5",
+                      listExpr: {
+                        elements: [
+                          { id: "6", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { int64Value: "1" } },
+                          { id: "10", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "11",
+                      listExpr: {
+                        elements: [
+                          { id: "12", constExpr: { int64Value: "1" } },
+                          { id: "13", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "14",
+                      listExpr: {
+                        elements: [
+                          { id: "15", constExpr: { int64Value: "1" } },
+                          { id: "16", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "18", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "nested array selection",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 8,
+            "7": 10,
+            "8": 13,
+            "9": 14,
+            "10": 16,
+            "11": 19,
+            "12": 20,
+            "13": 22,
+            "14": 25,
+            "15": 26,
+            "16": 28,
+            "17": 31,
+            "18": 32,
+          },
+        },
+      },
+      checkedAst:
+        "_[_](\n  [\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      1~int,\n      2~int\n    ]~list(int)\n  ]~list(list(int)),\n  4~int\n)~list(int)^index_list",
+      checkedExpr: {
+        referenceMap: { "17": { overloadId: ["index_list"] } },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 8,
+            "7": 10,
+            "8": 13,
+            "9": 14,
+            "10": 16,
+            "11": 19,
+            "12": 20,
+            "13": 22,
+            "14": 25,
+            "15": 26,
+            "16": 28,
+            "17": 31,
+            "18": 32,
+          },
+        },
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "2",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "5",
+                      listExpr: {
+                        elements: [
+                          { id: "6", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { int64Value: "1" } },
+                          { id: "10", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "11",
+                      listExpr: {
+                        elements: [
+                          { id: "12", constExpr: { int64Value: "1" } },
+                          { id: "13", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "14",
+                      listExpr: {
+                        elements: [
+                          { id: "15", constExpr: { int64Value: "1" } },
+                          { id: "16", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "18", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "61", max: "61" },
+      expectedCost: { min: "61", max: "61" },
+    },
+    {
+      original: {
+        name: "nested map selection",
+        expr: "{'a': [1,2], 'b': [1,2], 'c': [1,2], 'd': [1,2], 'e': [1,2]}.b",
+        checkOnly: true,
+      },
+      ast: '{\n  "a"^#*expr.Constant_StringValue#:[\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#,\n  "b"^#*expr.Constant_StringValue#:[\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#,\n  "c"^#*expr.Constant_StringValue#:[\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#,\n  "d"^#*expr.Constant_StringValue#:[\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#,\n  "e"^#*expr.Constant_StringValue#:[\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#.b^#*expr.Expr_SelectExpr#',
+      unparsed:
+        '{"a": [1, 2], "b": [1, 2], "c": [1, 2], "d": [1, 2], "e": [1, 2]}.b',
+      locationAst:
+        '{\n  "a"^#3[1,1]#:[\n    1^#5[1,7]#,\n    2^#6[1,9]#\n  ]^#4[1,6]#^#2[1,4]#,\n  "b"^#8[1,13]#:[\n    1^#10[1,19]#,\n    2^#11[1,21]#\n  ]^#9[1,18]#^#7[1,16]#,\n  "c"^#13[1,25]#:[\n    1^#15[1,31]#,\n    2^#16[1,33]#\n  ]^#14[1,30]#^#12[1,28]#,\n  "d"^#18[1,37]#:[\n    1^#20[1,43]#,\n    2^#21[1,45]#\n  ]^#19[1,42]#^#17[1,40]#,\n  "e"^#23[1,49]#:[\n    1^#25[1,55]#,\n    2^#26[1,57]#\n  ]^#24[1,54]#^#22[1,52]#\n}^#1[1,0]#.b^#27[1,60]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 13, 16, 1, 13, 1, 16],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 19, 20, 1, 19, 1, 20],
+        [11, 21, 22, 1, 21, 1, 22],
+        [12, 28, 29, 1, 28, 1, 29],
+        [13, 25, 28, 1, 25, 1, 28],
+        [14, 30, 31, 1, 30, 1, 31],
+        [15, 31, 32, 1, 31, 1, 32],
+        [16, 33, 34, 1, 33, 1, 34],
+        [17, 40, 41, 1, 40, 1, 41],
+        [18, 37, 40, 1, 37, 1, 40],
+        [19, 42, 43, 1, 42, 1, 43],
+        [20, 43, 44, 1, 43, 1, 44],
+        [21, 45, 46, 1, 45, 1, 46],
+        [22, 52, 53, 1, 52, 1, 53],
+        [23, 49, 52, 1, 49, 1, 52],
+        [24, 54, 55, 1, 54, 1, 55],
+        [25, 55, 56, 1, 55, 1, 56],
+        [26, 57, 58, 1, 57, 1, 58],
+        [27, 60, 61, 1, 60, 1, 61],
+      ],
+      lineOffsets: [63],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                    value: {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "6", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    id: "7",
+                    mapKey: { id: "8", constExpr: { stringValue: "b" } },
+                    value: {
+                      id: "9",
+                      listExpr: {
+                        elements: [
+                          { id: "10", constExpr: { int64Value: "1" } },
+                          { id: "11", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    id: "12",
+                    mapKey: { id: "13", constExpr: { stringValue: "c" } },
+                    value: {
+                      id: "14",
+                      listExpr: {
+                        elements: [
+                          { id: "15", constExpr: { int64Value: "1" } },
+                          { id: "16", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    id: "17",
+                    mapKey: { id: "18", constExpr: { stringValue: "d" } },
+                    value: {
+                      id: "19",
+                      listExpr: {
+                        elements: [
+                          { id: "20", constExpr: { int64Value: "1" } },
+                          { id: "21", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    id: "22",
+                    mapKey: { id: "23", constExpr: { stringValue: "e" } },
+                    value: {
+                      id: "24",
+                      listExpr: {
+                        elements: [
+                          { id: "25", constExpr: { int64Value: "1" } },
+                          { id: "26", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+            field: "b",
+          },
+        },
+        sourceInfo: {
+          location: "nested map selection",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 7,
+            "6": 9,
+            "7": 16,
+            "8": 13,
+            "9": 18,
+            "10": 19,
+            "11": 21,
+            "12": 28,
+            "13": 25,
+            "14": 30,
+            "15": 31,
+            "16": 33,
+            "17": 40,
+            "18": 37,
+            "19": 42,
+            "20": 43,
+            "21": 45,
+            "22": 52,
+            "23": 49,
+            "24": 54,
+            "25": 55,
+            "26": 57,
+            "27": 60,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  "a"~string:[\n    1~int,\n    2~int\n  ]~list(int),\n  "b"~string:[\n    1~int,\n    2~int\n  ]~list(int),\n  "c"~string:[\n    1~int,\n    2~int\n  ]~list(int),\n  "d"~string:[\n    1~int,\n    2~int\n  ]~list(int),\n  "e"~string:[\n    1~int,\n    2~int\n  ]~list(int)\n}~map(string, list(int)).b~list(int)',
+      checkedExpr: {
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "8": { primitive: "STRING" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "13": { primitive: "STRING" },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "18": { primitive: "STRING" },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { primitive: "INT64" },
+          "21": { primitive: "INT64" },
+          "23": { primitive: "STRING" },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { primitive: "INT64" },
+          "26": { primitive: "INT64" },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 7,
+            "6": 9,
+            "7": 16,
+            "8": 13,
+            "9": 18,
+            "10": 19,
+            "11": 21,
+            "12": 28,
+            "13": 25,
+            "14": 30,
+            "15": 31,
+            "16": 33,
+            "17": 40,
+            "18": 37,
+            "19": 42,
+            "20": 43,
+            "21": 45,
+            "22": 52,
+            "23": 49,
+            "24": 54,
+            "25": 55,
+            "26": 57,
+            "27": 60,
+          },
+        },
+        expr: {
+          id: "27",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                    value: {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "6", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    id: "7",
+                    mapKey: { id: "8", constExpr: { stringValue: "b" } },
+                    value: {
+                      id: "9",
+                      listExpr: {
+                        elements: [
+                          { id: "10", constExpr: { int64Value: "1" } },
+                          { id: "11", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    id: "12",
+                    mapKey: { id: "13", constExpr: { stringValue: "c" } },
+                    value: {
+                      id: "14",
+                      listExpr: {
+                        elements: [
+                          { id: "15", constExpr: { int64Value: "1" } },
+                          { id: "16", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    id: "17",
+                    mapKey: { id: "18", constExpr: { stringValue: "d" } },
+                    value: {
+                      id: "19",
+                      listExpr: {
+                        elements: [
+                          { id: "20", constExpr: { int64Value: "1" } },
+                          { id: "21", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    id: "22",
+                    mapKey: { id: "23", constExpr: { stringValue: "e" } },
+                    value: {
+                      id: "24",
+                      listExpr: {
+                        elements: [
+                          { id: "25", constExpr: { int64Value: "1" } },
+                          { id: "26", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+            field: "b",
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "81", max: "81" },
+      expectedCost: { min: "81", max: "81" },
+    },
+    {
+      original: {
+        name: "comprehension on nested list",
+        expr: "[[1, 1], [2, 2], [3, 3], [4, 4], [5, 5]].all(y, y.all(y, y == 1))",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  y,\n  // Target\n  [\n    [\n      1^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      3^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      4^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      5^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        _==_(\n          y^#*expr.Expr_IdentExpr#,\n          1^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "[[1, 1], [2, 2], [3, 3], [4, 4], [5, 5]].all(y, y.all(y, y == 1))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  [\n    [\n      1^#3[1,2]#,\n      1^#4[1,5]#\n    ]^#2[1,1]#,\n    [\n      2^#6[1,10]#,\n      2^#7[1,13]#\n    ]^#5[1,9]#,\n    [\n      3^#9[1,18]#,\n      3^#10[1,21]#\n    ]^#8[1,17]#,\n    [\n      4^#12[1,26]#,\n      4^#13[1,29]#\n    ]^#11[1,25]#,\n    [\n      5^#15[1,34]#,\n      5^#16[1,37]#\n    ]^#14[1,33]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#32[1,44]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#33[1,44]#\n  )^#34[1,44]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#35[1,44]#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#19[1,48]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#25[1,53]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#26[1,53]#\n      )^#27[1,53]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#28[1,53]#,\n        _==_(\n          y^#22[1,57]#,\n          1^#24[1,62]#\n        )^#23[1,59]#\n      )^#29[1,53]#,\n      // Result\n      @result^#30[1,53]#)^#31[1,53]#\n  )^#36[1,44]#,\n  // Result\n  @result^#37[1,44]#)^#38[1,44]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 17, 18, 1, 17, 1, 18],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 25, 26, 1, 25, 1, 26],
+        [12, 26, 27, 1, 26, 1, 27],
+        [13, 29, 30, 1, 29, 1, 30],
+        [14, 33, 34, 1, 33, 1, 34],
+        [15, 34, 35, 1, 34, 1, 35],
+        [16, 37, 38, 1, 37, 1, 38],
+        [18, 45, 46, 1, 45, 1, 46],
+        [19, 48, 49, 1, 48, 1, 49],
+        [21, 54, 55, 1, 54, 1, 55],
+        [22, 57, 58, 1, 57, 1, 58],
+        [23, 59, 61, 1, 59, 1, 61],
+        [24, 62, 63, 1, 62, 1, 63],
+        [25, 53, 53, 1, 53, 1, 53],
+        [26, 53, 53, 1, 53, 1, 53],
+        [27, 53, 53, 1, 53, 1, 53],
+        [28, 53, 53, 1, 53, 1, 53],
+        [29, 53, 53, 1, 53, 1, 53],
+        [30, 53, 53, 1, 53, 1, 53],
+        [31, 53, 53, 1, 53, 1, 53],
+        [32, 44, 44, 1, 44, 1, 44],
+        [33, 44, 44, 1, 44, 1, 44],
+        [34, 44, 44, 1, 44, 1, 44],
+        [35, 44, 44, 1, 44, 1, 44],
+        [36, 44, 44, 1, 44, 1, 44],
+        [37, 44, 44, 1, 44, 1, 44],
+        [38, 44, 44, 1, 44, 1, 44],
+      ],
+      lineOffsets: [66],
+      parsedExpr: {
+        expr: {
+          id: "38",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "2",
+                    listExpr: {
+                      elements: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "5",
+                    listExpr: {
+                      elements: [
+                        { id: "6", constExpr: { int64Value: "2" } },
+                        { id: "7", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "8",
+                    listExpr: {
+                      elements: [
+                        { id: "9", constExpr: { int64Value: "3" } },
+                        { id: "10", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    listExpr: {
+                      elements: [
+                        { id: "12", constExpr: { int64Value: "4" } },
+                        { id: "13", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "14",
+                    listExpr: {
+                      elements: [
+                        { id: "15", constExpr: { int64Value: "5" } },
+                        { id: "16", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "32", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "34",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "33", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "36",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "35", identExpr: { name: "@result" } },
+                  {
+                    id: "31",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "19", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "25", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "27",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "26", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "29",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "28", identExpr: { name: "@result" } },
+                            {
+                              id: "23",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "22", identExpr: { name: "y" } },
+                                  { id: "24", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "30", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "37", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension on nested list",
+          lineOffsets: [66],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "7": 13,
+            "8": 17,
+            "9": 18,
+            "10": 21,
+            "11": 25,
+            "12": 26,
+            "13": 29,
+            "14": 33,
+            "15": 34,
+            "16": 37,
+            "18": 45,
+            "19": 48,
+            "21": 54,
+            "22": 57,
+            "23": 59,
+            "24": 62,
+            "25": 53,
+            "26": 53,
+            "27": 53,
+            "28": 53,
+            "29": 53,
+            "30": 53,
+            "31": 53,
+            "32": 44,
+            "33": 44,
+            "34": 44,
+            "35": 44,
+            "36": 44,
+            "37": 44,
+            "38": 44,
+          },
+          macroCalls: {
+            "31": {
+              callExpr: {
+                target: { id: "19", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "21", identExpr: { name: "y" } },
+                  {
+                    id: "23",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "22", identExpr: { name: "y" } },
+                        { id: "24", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        listExpr: {
+                          elements: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "4", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "5",
+                        listExpr: {
+                          elements: [
+                            { id: "6", constExpr: { int64Value: "2" } },
+                            { id: "7", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "8",
+                        listExpr: {
+                          elements: [
+                            { id: "9", constExpr: { int64Value: "3" } },
+                            { id: "10", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "11",
+                        listExpr: {
+                          elements: [
+                            { id: "12", constExpr: { int64Value: "4" } },
+                            { id: "13", constExpr: { int64Value: "4" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "14",
+                        listExpr: {
+                          elements: [
+                            { id: "15", constExpr: { int64Value: "5" } },
+                            { id: "16", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [{ id: "18", identExpr: { name: "y" } }, { id: "31" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 31,
+          function: "all",
+          target: { id: "19", identExpr: { name: "y" } },
+          args: [
+            { id: "21", identExpr: { name: "y" } },
+            {
+              id: "23",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "22", identExpr: { name: "y" } },
+                  { id: "24", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 38,
+          function: "all",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "2",
+                  listExpr: {
+                    elements: [
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "4", constExpr: { int64Value: "1" } },
+                    ],
+                  },
+                },
+                {
+                  id: "5",
+                  listExpr: {
+                    elements: [
+                      { id: "6", constExpr: { int64Value: "2" } },
+                      { id: "7", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                {
+                  id: "8",
+                  listExpr: {
+                    elements: [
+                      { id: "9", constExpr: { int64Value: "3" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                {
+                  id: "11",
+                  listExpr: {
+                    elements: [
+                      { id: "12", constExpr: { int64Value: "4" } },
+                      { id: "13", constExpr: { int64Value: "4" } },
+                    ],
+                  },
+                },
+                {
+                  id: "14",
+                  listExpr: {
+                    elements: [
+                      { id: "15", constExpr: { int64Value: "5" } },
+                      { id: "16", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [{ id: "18", identExpr: { name: "y" } }, { id: "31" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  [\n    [\n      1~int,\n      1~int\n    ]~list(int),\n    [\n      2~int,\n      2~int\n    ]~list(int),\n    [\n      3~int,\n      3~int\n    ]~list(int),\n    [\n      4~int,\n      4~int\n    ]~list(int),\n    [\n      5~int,\n      5~int\n    ]~list(int)\n  ]~list(list(int)),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y~list(int)^y,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        _==_(\n          y~int^y,\n          1~int\n        )~bool^equals\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "19": { name: "y" },
+          "22": { name: "y" },
+          "23": { overloadId: ["equals"] },
+          "26": { name: "@result" },
+          "27": { overloadId: ["not_strictly_false"] },
+          "28": { name: "@result" },
+          "29": { overloadId: ["logical_and"] },
+          "30": { name: "@result" },
+          "33": { name: "@result" },
+          "34": { overloadId: ["not_strictly_false"] },
+          "35": { name: "@result" },
+          "36": { overloadId: ["logical_and"] },
+          "37": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "BOOL" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "BOOL" },
+          "36": { primitive: "BOOL" },
+          "37": { primitive: "BOOL" },
+          "38": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [66],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "7": 13,
+            "8": 17,
+            "9": 18,
+            "10": 21,
+            "11": 25,
+            "12": 26,
+            "13": 29,
+            "14": 33,
+            "15": 34,
+            "16": 37,
+            "18": 45,
+            "19": 48,
+            "21": 54,
+            "22": 57,
+            "23": 59,
+            "24": 62,
+            "25": 53,
+            "26": 53,
+            "27": 53,
+            "28": 53,
+            "29": 53,
+            "30": 53,
+            "31": 53,
+            "32": 44,
+            "33": 44,
+            "34": 44,
+            "35": 44,
+            "36": 44,
+            "37": 44,
+            "38": 44,
+          },
+          macroCalls: {
+            "31": {
+              callExpr: {
+                target: { id: "19", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "21", identExpr: { name: "y" } },
+                  {
+                    id: "23",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "22", identExpr: { name: "y" } },
+                        { id: "24", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        listExpr: {
+                          elements: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "4", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "5",
+                        listExpr: {
+                          elements: [
+                            { id: "6", constExpr: { int64Value: "2" } },
+                            { id: "7", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "8",
+                        listExpr: {
+                          elements: [
+                            { id: "9", constExpr: { int64Value: "3" } },
+                            { id: "10", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "11",
+                        listExpr: {
+                          elements: [
+                            { id: "12", constExpr: { int64Value: "4" } },
+                            { id: "13", constExpr: { int64Value: "4" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "14",
+                        listExpr: {
+                          elements: [
+                            { id: "15", constExpr: { int64Value: "5" } },
+                            { id: "16", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [{ id: "18", identExpr: { name: "y" } }, { id: "31" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "38",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "2",
+                    listExpr: {
+                      elements: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "5",
+                    listExpr: {
+                      elements: [
+                        { id: "6", constExpr: { int64Value: "2" } },
+                        { id: "7", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "8",
+                    listExpr: {
+                      elements: [
+                        { id: "9", constExpr: { int64Value: "3" } },
+                        { id: "10", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    listExpr: {
+                      elements: [
+                        { id: "12", constExpr: { int64Value: "4" } },
+                        { id: "13", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "14",
+                    listExpr: {
+                      elements: [
+                        { id: "15", constExpr: { int64Value: "5" } },
+                        { id: "16", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "32", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "34",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "33", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "36",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "35", identExpr: { name: "@result" } },
+                  {
+                    id: "31",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "19", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "25", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "27",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "26", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "29",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "28", identExpr: { name: "@result" } },
+                            {
+                              id: "23",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "22", identExpr: { name: "y" } },
+                                  { id: "24", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "30", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "37", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "76", max: "136" },
+      expectedCost: { min: "76", max: "136" },
+    },
+    {
+      original: {
+        name: "comprehension on transformed nested list",
+        expr: "[1,2,3,4,5].map(x, [x, x]).all(y, y.all(y, y == 1))",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        [\n          x^#*expr.Expr_IdentExpr#,\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        _==_(\n          y^#*expr.Expr_IdentExpr#,\n          1^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, [x, x]).all(y, y.all(y, y == 1))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,3]#,\n      3^#4[1,5]#,\n      4^#5[1,7]#,\n      5^#6[1,9]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#12[1,15]#,\n    // LoopCondition\n    true^#13[1,15]#,\n    // LoopStep\n    _+_(\n      @result^#14[1,15]#,\n      [\n        [\n          x^#10[1,20]#,\n          x^#11[1,23]#\n        ]^#9[1,19]#\n      ]^#15[1,15]#\n    )^#16[1,15]#,\n    // Result\n    @result^#17[1,15]#)^#18[1,15]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#34[1,30]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#35[1,30]#\n  )^#36[1,30]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#37[1,30]#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#21[1,34]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#27[1,39]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#28[1,39]#\n      )^#29[1,39]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#30[1,39]#,\n        _==_(\n          y^#24[1,43]#,\n          1^#26[1,48]#\n        )^#25[1,45]#\n      )^#31[1,39]#,\n      // Result\n      @result^#32[1,39]#)^#33[1,39]#\n  )^#38[1,30]#,\n  // Result\n  @result^#39[1,30]#)^#40[1,30]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 20, 21, 1, 20, 1, 21],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [17, 15, 15, 1, 15, 1, 15],
+        [18, 15, 15, 1, 15, 1, 15],
+        [20, 31, 32, 1, 31, 1, 32],
+        [21, 34, 35, 1, 34, 1, 35],
+        [23, 40, 41, 1, 40, 1, 41],
+        [24, 43, 44, 1, 43, 1, 44],
+        [25, 45, 47, 1, 45, 1, 47],
+        [26, 48, 49, 1, 48, 1, 49],
+        [27, 39, 39, 1, 39, 1, 39],
+        [28, 39, 39, 1, 39, 1, 39],
+        [29, 39, 39, 1, 39, 1, 39],
+        [30, 39, 39, 1, 39, 1, 39],
+        [31, 39, 39, 1, 39, 1, 39],
+        [32, 39, 39, 1, 39, 1, 39],
+        [33, 39, 39, 1, 39, 1, 39],
+        [34, 30, 30, 1, 30, 1, 30],
+        [35, 30, 30, 1, 30, 1, 30],
+        [36, 30, 30, 1, 30, 1, 30],
+        [37, 30, 30, 1, 30, 1, 30],
+        [38, 30, 30, 1, 30, 1, 30],
+        [39, 30, 30, 1, 30, 1, 30],
+        [40, 30, 30, 1, 30, 1, 30],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "40",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "18",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "12", listExpr: {} },
+                loopCondition: { id: "13", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "16",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "14", identExpr: { name: "@result" } },
+                      {
+                        id: "15",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", identExpr: { name: "x" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "17", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "34", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "36",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "35", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "38",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "37", identExpr: { name: "@result" } },
+                  {
+                    id: "33",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "21", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "27", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "29",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "28", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "31",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "30", identExpr: { name: "@result" } },
+                            {
+                              id: "25",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "24", identExpr: { name: "y" } },
+                                  { id: "26", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "32", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "39", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension on transformed nested list",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 20,
+            "11": 23,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "17": 15,
+            "18": 15,
+            "20": 31,
+            "21": 34,
+            "23": 40,
+            "24": 43,
+            "25": 45,
+            "26": 48,
+            "27": 39,
+            "28": 39,
+            "29": 39,
+            "30": 39,
+            "31": 39,
+            "32": 39,
+            "33": 39,
+            "34": 30,
+            "35": 30,
+            "36": 30,
+            "37": 30,
+            "38": 30,
+            "39": 30,
+            "40": 30,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "9",
+                    listExpr: {
+                      elements: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "21", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "23", identExpr: { name: "y" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "24", identExpr: { name: "y" } },
+                        { id: "26", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "40": {
+              callExpr: {
+                target: { id: "18" },
+                function: "all",
+                args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "9",
+              listExpr: {
+                elements: [
+                  { id: "10", identExpr: { name: "x" } },
+                  { id: "11", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 33,
+          function: "all",
+          target: { id: "21", identExpr: { name: "y" } },
+          args: [
+            { id: "23", identExpr: { name: "y" } },
+            {
+              id: "25",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "24", identExpr: { name: "y" } },
+                  { id: "26", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 40,
+          function: "all",
+          target: { id: "18" },
+          args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int,\n      5~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(list(int)),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(list(int))^@result,\n      [\n        [\n          x~int^x,\n          x~int^x\n        ]~list(int)\n      ]~list(list(int))\n    )~list(list(int))^add_list,\n    // Result\n    @result~list(list(int))^@result)~list(list(int)),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y~list(int)^y,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        _==_(\n          y~int^y,\n          1~int\n        )~bool^equals\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "10": { name: "x" },
+          "11": { name: "x" },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_list"] },
+          "17": { name: "@result" },
+          "21": { name: "y" },
+          "24": { name: "y" },
+          "25": { overloadId: ["equals"] },
+          "28": { name: "@result" },
+          "29": { overloadId: ["not_strictly_false"] },
+          "30": { name: "@result" },
+          "31": { overloadId: ["logical_and"] },
+          "32": { name: "@result" },
+          "35": { name: "@result" },
+          "36": { overloadId: ["not_strictly_false"] },
+          "37": { name: "@result" },
+          "38": { overloadId: ["logical_and"] },
+          "39": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "13": { primitive: "BOOL" },
+          "14": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "15": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "16": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "17": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "18": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "BOOL" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "BOOL" },
+          "36": { primitive: "BOOL" },
+          "37": { primitive: "BOOL" },
+          "38": { primitive: "BOOL" },
+          "39": { primitive: "BOOL" },
+          "40": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 20,
+            "11": 23,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "17": 15,
+            "18": 15,
+            "20": 31,
+            "21": 34,
+            "23": 40,
+            "24": 43,
+            "25": 45,
+            "26": 48,
+            "27": 39,
+            "28": 39,
+            "29": 39,
+            "30": 39,
+            "31": 39,
+            "32": 39,
+            "33": 39,
+            "34": 30,
+            "35": 30,
+            "36": 30,
+            "37": 30,
+            "38": 30,
+            "39": 30,
+            "40": 30,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "9",
+                    listExpr: {
+                      elements: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "21", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "23", identExpr: { name: "y" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "24", identExpr: { name: "y" } },
+                        { id: "26", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "40": {
+              callExpr: {
+                target: { id: "18" },
+                function: "all",
+                args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "40",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "18",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "12", listExpr: {} },
+                loopCondition: { id: "13", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "16",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "14", identExpr: { name: "@result" } },
+                      {
+                        id: "15",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", identExpr: { name: "x" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "17", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "34", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "36",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "35", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "38",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "37", identExpr: { name: "@result" } },
+                  {
+                    id: "33",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "21", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "27", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "29",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "28", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "31",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "30", identExpr: { name: "@result" } },
+                            {
+                              id: "25",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "24", identExpr: { name: "y" } },
+                                  { id: "26", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "32", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "39", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "157", max: "217" },
+      expectedCost: { min: "157", max: "217" },
+    },
+    {
+      original: {
+        name: "comprehension on nested literal list",
+        expr: '["a", "ab", "abc", "abcd", "abcde"].map(x, [x, x]).all(y, y.all(y, y.startsWith(\'a\')))',
+        checkOnly: true,
+      },
+      ast: '__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      "a"^#*expr.Constant_StringValue#,\n      "ab"^#*expr.Constant_StringValue#,\n      "abc"^#*expr.Constant_StringValue#,\n      "abcd"^#*expr.Constant_StringValue#,\n      "abcde"^#*expr.Constant_StringValue#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        [\n          x^#*expr.Expr_IdentExpr#,\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        y^#*expr.Expr_IdentExpr#.startsWith(\n          "a"^#*expr.Constant_StringValue#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed:
+        '["a", "ab", "abc", "abcd", "abcde"].map(x, [x, x]).all(y, y.all(y, y.startsWith("a")))',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      "a"^#2[1,1]#,\n      "ab"^#3[1,6]#,\n      "abc"^#4[1,12]#,\n      "abcd"^#5[1,19]#,\n      "abcde"^#6[1,27]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#12[1,39]#,\n    // LoopCondition\n    true^#13[1,39]#,\n    // LoopStep\n    _+_(\n      @result^#14[1,39]#,\n      [\n        [\n          x^#10[1,44]#,\n          x^#11[1,47]#\n        ]^#9[1,43]#\n      ]^#15[1,39]#\n    )^#16[1,39]#,\n    // Result\n    @result^#17[1,39]#)^#18[1,39]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#34[1,54]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#35[1,54]#\n  )^#36[1,54]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#37[1,54]#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#21[1,58]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#27[1,63]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#28[1,63]#\n      )^#29[1,63]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#30[1,63]#,\n        y^#24[1,67]#.startsWith(\n          "a"^#26[1,80]#\n        )^#25[1,79]#\n      )^#31[1,63]#,\n      // Result\n      @result^#32[1,63]#)^#33[1,63]#\n  )^#38[1,54]#,\n  // Result\n  @result^#39[1,54]#)^#40[1,54]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 10, 1, 6, 1, 10],
+        [4, 12, 17, 1, 12, 1, 17],
+        [5, 19, 25, 1, 19, 1, 25],
+        [6, 27, 34, 1, 27, 1, 34],
+        [8, 40, 41, 1, 40, 1, 41],
+        [9, 43, 44, 1, 43, 1, 44],
+        [10, 44, 45, 1, 44, 1, 45],
+        [11, 47, 48, 1, 47, 1, 48],
+        [12, 39, 39, 1, 39, 1, 39],
+        [13, 39, 39, 1, 39, 1, 39],
+        [14, 39, 39, 1, 39, 1, 39],
+        [15, 39, 39, 1, 39, 1, 39],
+        [16, 39, 39, 1, 39, 1, 39],
+        [17, 39, 39, 1, 39, 1, 39],
+        [18, 39, 39, 1, 39, 1, 39],
+        [20, 55, 56, 1, 55, 1, 56],
+        [21, 58, 59, 1, 58, 1, 59],
+        [23, 64, 65, 1, 64, 1, 65],
+        [24, 67, 68, 1, 67, 1, 68],
+        [25, 79, 80, 1, 79, 1, 80],
+        [26, 80, 83, 1, 80, 1, 83],
+        [27, 63, 63, 1, 63, 1, 63],
+        [28, 63, 63, 1, 63, 1, 63],
+        [29, 63, 63, 1, 63, 1, 63],
+        [30, 63, 63, 1, 63, 1, 63],
+        [31, 63, 63, 1, 63, 1, 63],
+        [32, 63, 63, 1, 63, 1, 63],
+        [33, 63, 63, 1, 63, 1, 63],
+        [34, 54, 54, 1, 54, 1, 54],
+        [35, 54, 54, 1, 54, 1, 54],
+        [36, 54, 54, 1, 54, 1, 54],
+        [37, 54, 54, 1, 54, 1, 54],
+        [38, 54, 54, 1, 54, 1, 54],
+        [39, 54, 54, 1, 54, 1, 54],
+        [40, 54, 54, 1, 54, 1, 54],
+      ],
+      lineOffsets: [87],
+      parsedExpr: {
+        expr: {
+          id: "40",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "18",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { stringValue: "a" } },
+                      { id: "3", constExpr: { stringValue: "ab" } },
+                      { id: "4", constExpr: { stringValue: "abc" } },
+                      { id: "5", constExpr: { stringValue: "abcd" } },
+                      { id: "6", constExpr: { stringValue: "abcde" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "12", listExpr: {} },
+                loopCondition: { id: "13", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "16",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "14", identExpr: { name: "@result" } },
+                      {
+                        id: "15",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", identExpr: { name: "x" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "17", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "34", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "36",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "35", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "38",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "37", identExpr: { name: "@result" } },
+                  {
+                    id: "33",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "21", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "27", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "29",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "28", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "31",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "30", identExpr: { name: "@result" } },
+                            {
+                              id: "25",
+                              callExpr: {
+                                target: { id: "24", identExpr: { name: "y" } },
+                                function: "startsWith",
+                                args: [
+                                  { id: "26", constExpr: { stringValue: "a" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "32", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "39", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension on nested literal list",
+          lineOffsets: [87],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 12,
+            "5": 19,
+            "6": 27,
+            "8": 40,
+            "9": 43,
+            "10": 44,
+            "11": 47,
+            "12": 39,
+            "13": 39,
+            "14": 39,
+            "15": 39,
+            "16": 39,
+            "17": 39,
+            "18": 39,
+            "20": 55,
+            "21": 58,
+            "23": 64,
+            "24": 67,
+            "25": 79,
+            "26": 80,
+            "27": 63,
+            "28": 63,
+            "29": 63,
+            "30": 63,
+            "31": 63,
+            "32": 63,
+            "33": 63,
+            "34": 54,
+            "35": 54,
+            "36": 54,
+            "37": 54,
+            "38": 54,
+            "39": 54,
+            "40": 54,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { stringValue: "a" } },
+                      { id: "3", constExpr: { stringValue: "ab" } },
+                      { id: "4", constExpr: { stringValue: "abc" } },
+                      { id: "5", constExpr: { stringValue: "abcd" } },
+                      { id: "6", constExpr: { stringValue: "abcde" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "9",
+                    listExpr: {
+                      elements: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "21", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "23", identExpr: { name: "y" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      target: { id: "24", identExpr: { name: "y" } },
+                      function: "startsWith",
+                      args: [{ id: "26", constExpr: { stringValue: "a" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            "40": {
+              callExpr: {
+                target: { id: "18" },
+                function: "all",
+                args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { stringValue: "a" } },
+                { id: "3", constExpr: { stringValue: "ab" } },
+                { id: "4", constExpr: { stringValue: "abc" } },
+                { id: "5", constExpr: { stringValue: "abcd" } },
+                { id: "6", constExpr: { stringValue: "abcde" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "9",
+              listExpr: {
+                elements: [
+                  { id: "10", identExpr: { name: "x" } },
+                  { id: "11", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 33,
+          function: "all",
+          target: { id: "21", identExpr: { name: "y" } },
+          args: [
+            { id: "23", identExpr: { name: "y" } },
+            {
+              id: "25",
+              callExpr: {
+                target: { id: "24", identExpr: { name: "y" } },
+                function: "startsWith",
+                args: [{ id: "26", constExpr: { stringValue: "a" } }],
+              },
+            },
+          ],
+        },
+        {
+          id: 40,
+          function: "all",
+          target: { id: "18" },
+          args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+        },
+      ],
+      checkedAst:
+        '__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      "a"~string,\n      "ab"~string,\n      "abc"~string,\n      "abcd"~string,\n      "abcde"~string\n    ]~list(string),\n    // Accumulator\n    @result,\n    // Init\n    []~list(list(string)),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(list(string))^@result,\n      [\n        [\n          x~string^x,\n          x~string^x\n        ]~list(string)\n      ]~list(list(string))\n    )~list(list(string))^add_list,\n    // Result\n    @result~list(list(string))^@result)~list(list(string)),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y~list(string)^y,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        y~string^y.startsWith(\n          "a"~string\n        )~bool^starts_with_string\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool',
+      checkedExpr: {
+        referenceMap: {
+          "10": { name: "x" },
+          "11": { name: "x" },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_list"] },
+          "17": { name: "@result" },
+          "21": { name: "y" },
+          "24": { name: "y" },
+          "25": { overloadId: ["starts_with_string"] },
+          "28": { name: "@result" },
+          "29": { overloadId: ["not_strictly_false"] },
+          "30": { name: "@result" },
+          "31": { overloadId: ["logical_and"] },
+          "32": { name: "@result" },
+          "35": { name: "@result" },
+          "36": { overloadId: ["not_strictly_false"] },
+          "37": { name: "@result" },
+          "38": { overloadId: ["logical_and"] },
+          "39": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "9": { listType: { elemType: { primitive: "STRING" } } },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "STRING" },
+          "12": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "13": { primitive: "BOOL" },
+          "14": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "15": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "16": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "17": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "18": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "21": { listType: { elemType: { primitive: "STRING" } } },
+          "24": { primitive: "STRING" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "STRING" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "BOOL" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "BOOL" },
+          "36": { primitive: "BOOL" },
+          "37": { primitive: "BOOL" },
+          "38": { primitive: "BOOL" },
+          "39": { primitive: "BOOL" },
+          "40": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [87],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 12,
+            "5": 19,
+            "6": 27,
+            "8": 40,
+            "9": 43,
+            "10": 44,
+            "11": 47,
+            "12": 39,
+            "13": 39,
+            "14": 39,
+            "15": 39,
+            "16": 39,
+            "17": 39,
+            "18": 39,
+            "20": 55,
+            "21": 58,
+            "23": 64,
+            "24": 67,
+            "25": 79,
+            "26": 80,
+            "27": 63,
+            "28": 63,
+            "29": 63,
+            "30": 63,
+            "31": 63,
+            "32": 63,
+            "33": 63,
+            "34": 54,
+            "35": 54,
+            "36": 54,
+            "37": 54,
+            "38": 54,
+            "39": 54,
+            "40": 54,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { stringValue: "a" } },
+                      { id: "3", constExpr: { stringValue: "ab" } },
+                      { id: "4", constExpr: { stringValue: "abc" } },
+                      { id: "5", constExpr: { stringValue: "abcd" } },
+                      { id: "6", constExpr: { stringValue: "abcde" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "9",
+                    listExpr: {
+                      elements: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "21", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "23", identExpr: { name: "y" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      target: { id: "24", identExpr: { name: "y" } },
+                      function: "startsWith",
+                      args: [{ id: "26", constExpr: { stringValue: "a" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            "40": {
+              callExpr: {
+                target: { id: "18" },
+                function: "all",
+                args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "40",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "18",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { stringValue: "a" } },
+                      { id: "3", constExpr: { stringValue: "ab" } },
+                      { id: "4", constExpr: { stringValue: "abc" } },
+                      { id: "5", constExpr: { stringValue: "abcd" } },
+                      { id: "6", constExpr: { stringValue: "abcde" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "12", listExpr: {} },
+                loopCondition: { id: "13", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "16",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "14", identExpr: { name: "@result" } },
+                      {
+                        id: "15",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", identExpr: { name: "x" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "17", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "34", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "36",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "35", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "38",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "37", identExpr: { name: "@result" } },
+                  {
+                    id: "33",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "21", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "27", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "29",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "28", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "31",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "30", identExpr: { name: "@result" } },
+                            {
+                              id: "25",
+                              callExpr: {
+                                target: { id: "24", identExpr: { name: "y" } },
+                                function: "startsWith",
+                                args: [
+                                  { id: "26", constExpr: { stringValue: "a" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "32", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "39", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "157", max: "217" },
+      expectedCost: { min: "157", max: "217" },
+    },
+    {
+      original: {
+        name: "comprehension on nested variable list",
+        expr: "input.map(x, [x, x]).all(y, y.all(y, y.startsWith('a')))",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+        ],
+      },
+      sizeHints: { input: 5, "input.@items": 10 },
+      ast: '__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    input^#*expr.Expr_IdentExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        [\n          x^#*expr.Expr_IdentExpr#,\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        y^#*expr.Expr_IdentExpr#.startsWith(\n          "a"^#*expr.Constant_StringValue#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: 'input.map(x, [x, x]).all(y, y.all(y, y.startsWith("a")))',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    input^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#7[1,9]#,\n    // LoopCondition\n    true^#8[1,9]#,\n    // LoopStep\n    _+_(\n      @result^#9[1,9]#,\n      [\n        [\n          x^#5[1,14]#,\n          x^#6[1,17]#\n        ]^#4[1,13]#\n      ]^#10[1,9]#\n    )^#11[1,9]#,\n    // Result\n    @result^#12[1,9]#)^#13[1,9]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#29[1,24]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#30[1,24]#\n  )^#31[1,24]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#32[1,24]#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#16[1,28]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#22[1,33]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#23[1,33]#\n      )^#24[1,33]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#25[1,33]#,\n        y^#19[1,37]#.startsWith(\n          "a"^#21[1,50]#\n        )^#20[1,49]#\n      )^#26[1,33]#,\n      // Result\n      @result^#27[1,33]#)^#28[1,33]#\n  )^#33[1,24]#,\n  // Result\n  @result^#34[1,24]#)^#35[1,24]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 9, 9, 1, 9, 1, 9],
+        [8, 9, 9, 1, 9, 1, 9],
+        [9, 9, 9, 1, 9, 1, 9],
+        [10, 9, 9, 1, 9, 1, 9],
+        [11, 9, 9, 1, 9, 1, 9],
+        [12, 9, 9, 1, 9, 1, 9],
+        [13, 9, 9, 1, 9, 1, 9],
+        [15, 25, 26, 1, 25, 1, 26],
+        [16, 28, 29, 1, 28, 1, 29],
+        [18, 34, 35, 1, 34, 1, 35],
+        [19, 37, 38, 1, 37, 1, 38],
+        [20, 49, 50, 1, 49, 1, 50],
+        [21, 50, 53, 1, 50, 1, 53],
+        [22, 33, 33, 1, 33, 1, 33],
+        [23, 33, 33, 1, 33, 1, 33],
+        [24, 33, 33, 1, 33, 1, 33],
+        [25, 33, 33, 1, 33, 1, 33],
+        [26, 33, 33, 1, 33, 1, 33],
+        [27, 33, 33, 1, 33, 1, 33],
+        [28, 33, 33, 1, 33, 1, 33],
+        [29, 24, 24, 1, 24, 1, 24],
+        [30, 24, 24, 1, 24, 1, 24],
+        [31, 24, 24, 1, 24, 1, 24],
+        [32, 24, 24, 1, 24, 1, 24],
+        [33, 24, 24, 1, 24, 1, 24],
+        [34, 24, 24, 1, 24, 1, 24],
+        [35, 24, 24, 1, 24, 1, 24],
+      ],
+      lineOffsets: [57],
+      parsedExpr: {
+        expr: {
+          id: "35",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "13",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: { id: "1", identExpr: { name: "input" } },
+                accuVar: "@result",
+                accuInit: { id: "7", listExpr: {} },
+                loopCondition: { id: "8", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "11",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "9", identExpr: { name: "@result" } },
+                      {
+                        id: "10",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "4",
+                              listExpr: {
+                                elements: [
+                                  { id: "5", identExpr: { name: "x" } },
+                                  { id: "6", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "12", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "29", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "31",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "30", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "33",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "32", identExpr: { name: "@result" } },
+                  {
+                    id: "28",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "16", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "22", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "24",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "23", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "26",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "25", identExpr: { name: "@result" } },
+                            {
+                              id: "20",
+                              callExpr: {
+                                target: { id: "19", identExpr: { name: "y" } },
+                                function: "startsWith",
+                                args: [
+                                  { id: "21", constExpr: { stringValue: "a" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "27", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "34", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension on nested variable list",
+          lineOffsets: [57],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 14,
+            "6": 17,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+            "15": 25,
+            "16": 28,
+            "18": 34,
+            "19": 37,
+            "20": 49,
+            "21": 50,
+            "22": 33,
+            "23": 33,
+            "24": 33,
+            "25": 33,
+            "26": 33,
+            "27": 33,
+            "28": 33,
+            "29": 24,
+            "30": 24,
+            "31": 24,
+            "32": 24,
+            "33": 24,
+            "34": 24,
+            "35": 24,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "map",
+                args: [
+                  { id: "3", identExpr: { name: "x" } },
+                  {
+                    id: "4",
+                    listExpr: {
+                      elements: [
+                        { id: "5", identExpr: { name: "x" } },
+                        { id: "6", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "28": {
+              callExpr: {
+                target: { id: "16", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "18", identExpr: { name: "y" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      target: { id: "19", identExpr: { name: "y" } },
+                      function: "startsWith",
+                      args: [{ id: "21", constExpr: { stringValue: "a" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            "35": {
+              callExpr: {
+                target: { id: "13" },
+                function: "all",
+                args: [{ id: "15", identExpr: { name: "y" } }, { id: "28" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "map",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "x" } },
+            {
+              id: "4",
+              listExpr: {
+                elements: [
+                  { id: "5", identExpr: { name: "x" } },
+                  { id: "6", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 28,
+          function: "all",
+          target: { id: "16", identExpr: { name: "y" } },
+          args: [
+            { id: "18", identExpr: { name: "y" } },
+            {
+              id: "20",
+              callExpr: {
+                target: { id: "19", identExpr: { name: "y" } },
+                function: "startsWith",
+                args: [{ id: "21", constExpr: { stringValue: "a" } }],
+              },
+            },
+          ],
+        },
+        {
+          id: 35,
+          function: "all",
+          target: { id: "13" },
+          args: [{ id: "15", identExpr: { name: "y" } }, { id: "28" }],
+        },
+      ],
+      checkedAst:
+        '__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    input~list(string)^input,\n    // Accumulator\n    @result,\n    // Init\n    []~list(list(string)),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(list(string))^@result,\n      [\n        [\n          x~string^x,\n          x~string^x\n        ]~list(string)\n      ]~list(list(string))\n    )~list(list(string))^add_list,\n    // Result\n    @result~list(list(string))^@result)~list(list(string)),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y~list(string)^y,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        y~string^y.startsWith(\n          "a"~string\n        )~bool^starts_with_string\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "5": { name: "x" },
+          "6": { name: "x" },
+          "9": { name: "@result" },
+          "11": { overloadId: ["add_list"] },
+          "12": { name: "@result" },
+          "16": { name: "y" },
+          "19": { name: "y" },
+          "20": { overloadId: ["starts_with_string"] },
+          "23": { name: "@result" },
+          "24": { overloadId: ["not_strictly_false"] },
+          "25": { name: "@result" },
+          "26": { overloadId: ["logical_and"] },
+          "27": { name: "@result" },
+          "30": { name: "@result" },
+          "31": { overloadId: ["not_strictly_false"] },
+          "32": { name: "@result" },
+          "33": { overloadId: ["logical_and"] },
+          "34": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { listType: { elemType: { primitive: "STRING" } } },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "8": { primitive: "BOOL" },
+          "9": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "10": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "11": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "12": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "13": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "16": { listType: { elemType: { primitive: "STRING" } } },
+          "19": { primitive: "STRING" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "STRING" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "BOOL" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [57],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 14,
+            "6": 17,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+            "15": 25,
+            "16": 28,
+            "18": 34,
+            "19": 37,
+            "20": 49,
+            "21": 50,
+            "22": 33,
+            "23": 33,
+            "24": 33,
+            "25": 33,
+            "26": 33,
+            "27": 33,
+            "28": 33,
+            "29": 24,
+            "30": 24,
+            "31": 24,
+            "32": 24,
+            "33": 24,
+            "34": 24,
+            "35": 24,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "map",
+                args: [
+                  { id: "3", identExpr: { name: "x" } },
+                  {
+                    id: "4",
+                    listExpr: {
+                      elements: [
+                        { id: "5", identExpr: { name: "x" } },
+                        { id: "6", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "28": {
+              callExpr: {
+                target: { id: "16", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "18", identExpr: { name: "y" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      target: { id: "19", identExpr: { name: "y" } },
+                      function: "startsWith",
+                      args: [{ id: "21", constExpr: { stringValue: "a" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            "35": {
+              callExpr: {
+                target: { id: "13" },
+                function: "all",
+                args: [{ id: "15", identExpr: { name: "y" } }, { id: "28" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "35",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "13",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: { id: "1", identExpr: { name: "input" } },
+                accuVar: "@result",
+                accuInit: { id: "7", listExpr: {} },
+                loopCondition: { id: "8", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "11",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "9", identExpr: { name: "@result" } },
+                      {
+                        id: "10",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "4",
+                              listExpr: {
+                                elements: [
+                                  { id: "5", identExpr: { name: "x" } },
+                                  { id: "6", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "12", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "29", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "31",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "30", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "33",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "32", identExpr: { name: "@result" } },
+                  {
+                    id: "28",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "16", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "22", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "24",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "23", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "26",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "25", identExpr: { name: "@result" } },
+                            {
+                              id: "20",
+                              callExpr: {
+                                target: { id: "19", identExpr: { name: "y" } },
+                                function: "startsWith",
+                                args: [
+                                  { id: "21", constExpr: { stringValue: "a" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "27", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "34", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "208" },
+      expectedCost: { min: "13", max: "208" },
+    },
+    {
+      original: {
+        name: "comprehension chaining with concat",
+        expr: "[1,2,3,4,5].map(x, x).map(x, x) + [1]",
+        checkOnly: true,
+      },
+      ast: "_+_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#,\n        4^#*expr.Constant_Int64Value#,\n        5^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x).map(x, x) + [1]",
+      locationAst:
+        "_+_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1^#2[1,1]#,\n        2^#3[1,3]#,\n        3^#4[1,5]#,\n        4^#5[1,7]#,\n        5^#6[1,9]#\n      ]^#1[1,0]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#10[1,15]#,\n      // LoopCondition\n      true^#11[1,15]#,\n      // LoopStep\n      _+_(\n        @result^#12[1,15]#,\n        [\n          x^#9[1,19]#\n        ]^#13[1,15]#\n      )^#14[1,15]#,\n      // Result\n      @result^#15[1,15]#)^#16[1,15]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#20[1,25]#,\n    // LoopCondition\n    true^#21[1,25]#,\n    // LoopStep\n    _+_(\n      @result^#22[1,25]#,\n      [\n        x^#19[1,29]#\n      ]^#23[1,25]#\n    )^#24[1,25]#,\n    // Result\n    @result^#25[1,25]#)^#26[1,25]#,\n  [\n    1^#29[1,35]#\n  ]^#28[1,34]#\n)^#27[1,32]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [18, 26, 27, 1, 26, 1, 27],
+        [19, 29, 30, 1, 29, 1, 30],
+        [20, 25, 25, 1, 25, 1, 25],
+        [21, 25, 25, 1, 25, 1, 25],
+        [22, 25, 25, 1, 25, 1, 25],
+        [23, 25, 25, 1, 25, 1, 25],
+        [24, 25, 25, 1, 25, 1, 25],
+        [25, 25, 25, 1, 25, 1, 25],
+        [26, 25, 25, 1, 25, 1, 25],
+        [27, 32, 33, 1, 32, 1, 33],
+        [28, 34, 35, 1, 34, 1, 35],
+        [29, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "26",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                            { id: "5", constExpr: { int64Value: "4" } },
+                            { id: "6", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "12", identExpr: { name: "@result" } },
+                            {
+                              id: "13",
+                              listExpr: {
+                                elements: [
+                                  { id: "9", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "20", listExpr: {} },
+                  loopCondition: { id: "21", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "24",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "22", identExpr: { name: "@result" } },
+                        {
+                          id: "23",
+                          listExpr: {
+                            elements: [{ id: "19", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "25", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "28",
+                listExpr: {
+                  elements: [{ id: "29", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "comprehension chaining with concat",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 26,
+            "19": 29,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+            "27": 32,
+            "28": 34,
+            "29": 35,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+        {
+          id: 26,
+          function: "map",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "x" } },
+            { id: "19", identExpr: { name: "x" } },
+          ],
+        },
+      ],
+      checkedAst:
+        "_+_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1~int,\n        2~int,\n        3~int,\n        4~int,\n        5~int\n      ]~list(int),\n      // Accumulator\n      @result,\n      // Init\n      []~list(int),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _+_(\n        @result~list(int)^@result,\n        [\n          x~int^x\n        ]~list(int)\n      )~list(int)^add_list,\n      // Result\n      @result~list(int)^@result)~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  [\n    1~int\n  ]~list(int)\n)~list(int)^add_list",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "19": { name: "x" },
+          "22": { name: "@result" },
+          "24": { overloadId: ["add_list"] },
+          "25": { name: "@result" },
+          "27": { overloadId: ["add_list"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "INT64" },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { primitive: "BOOL" },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { listType: { elemType: { primitive: "INT64" } } },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+          "28": { listType: { elemType: { primitive: "INT64" } } },
+          "29": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 26,
+            "19": 29,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+            "27": 32,
+            "28": 34,
+            "29": 35,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "26",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                            { id: "5", constExpr: { int64Value: "4" } },
+                            { id: "6", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "12", identExpr: { name: "@result" } },
+                            {
+                              id: "13",
+                              listExpr: {
+                                elements: [
+                                  { id: "9", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "20", listExpr: {} },
+                  loopCondition: { id: "21", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "24",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "22", identExpr: { name: "@result" } },
+                        {
+                          id: "23",
+                          listExpr: {
+                            elements: [{ id: "19", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "25", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "28",
+                listExpr: {
+                  elements: [{ id: "29", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "173", max: "173" },
+      expectedCost: { min: "173", max: "173" },
+    },
+    {
+      original: {
+        name: "nested comprehension",
+        expr: "[1,2,3].all(i, i in [1,2,3].map(j, j + j))",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    @in(\n      i^#*expr.Expr_IdentExpr#,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1^#*expr.Constant_Int64Value#,\n          2^#*expr.Constant_Int64Value#,\n          3^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            _+_(\n              j^#*expr.Expr_IdentExpr#,\n              j^#*expr.Expr_IdentExpr#\n            )^#*expr.Expr_CallExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].all(i, i in [1, 2, 3].map(j, j + j))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#25[1,11]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#26[1,11]#\n  )^#27[1,11]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#28[1,11]#,\n    @in(\n      i^#7[1,15]#,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1^#10[1,21]#,\n          2^#11[1,23]#,\n          3^#12[1,25]#\n        ]^#9[1,20]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#18[1,31]#,\n        // LoopCondition\n        true^#19[1,31]#,\n        // LoopStep\n        _+_(\n          @result^#20[1,31]#,\n          [\n            _+_(\n              j^#15[1,35]#,\n              j^#17[1,39]#\n            )^#16[1,37]#\n          ]^#21[1,31]#\n        )^#22[1,31]#,\n        // Result\n        @result^#23[1,31]#)^#24[1,31]#\n    )^#8[1,17]#\n  )^#29[1,11]#,\n  // Result\n  @result^#30[1,11]#)^#31[1,11]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 21, 1, 20, 1, 21],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+        [14, 32, 33, 1, 32, 1, 33],
+        [15, 35, 36, 1, 35, 1, 36],
+        [16, 37, 38, 1, 37, 1, 38],
+        [17, 39, 40, 1, 39, 1, 40],
+        [18, 31, 31, 1, 31, 1, 31],
+        [19, 31, 31, 1, 31, 1, 31],
+        [20, 31, 31, 1, 31, 1, 31],
+        [21, 31, 31, 1, 31, 1, 31],
+        [22, 31, 31, 1, 31, 1, 31],
+        [23, 31, 31, 1, 31, 1, 31],
+        [24, 31, 31, 1, 31, 1, 31],
+        [25, 11, 11, 1, 11, 1, 11],
+        [26, 11, 11, 1, 11, 1, 11],
+        [27, 11, 11, 1, 11, 1, 11],
+        [28, 11, 11, 1, 11, 1, 11],
+        [29, 11, 11, 1, 11, 1, 11],
+        [30, 11, 11, 1, 11, 1, 11],
+        [31, 11, 11, 1, 11, 1, 11],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "31",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "25", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "27",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "26", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "29",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "28", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        {
+                          id: "24",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", constExpr: { int64Value: "1" } },
+                                  { id: "11", constExpr: { int64Value: "2" } },
+                                  { id: "12", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "18", listExpr: {} },
+                            loopCondition: {
+                              id: "19",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "22",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "20", identExpr: { name: "@result" } },
+                                  {
+                                    id: "21",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "16",
+                                          callExpr: {
+                                            function: "_+_",
+                                            args: [
+                                              {
+                                                id: "15",
+                                                identExpr: { name: "j" },
+                                              },
+                                              {
+                                                id: "17",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "23",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "30", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "nested comprehension",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+            "14": 32,
+            "15": 35,
+            "16": 37,
+            "17": 39,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 11,
+            "26": 11,
+            "27": 11,
+            "28": 11,
+            "29": 11,
+            "30": 11,
+            "31": 11,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: {
+                  id: "9",
+                  listExpr: {
+                    elements: [
+                      { id: "10", constExpr: { int64Value: "1" } },
+                      { id: "11", constExpr: { int64Value: "2" } },
+                      { id: "12", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "14", identExpr: { name: "j" } },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "15", identExpr: { name: "j" } },
+                        { id: "17", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "i" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        { id: "24" },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 24,
+          function: "map",
+          target: {
+            id: "9",
+            listExpr: {
+              elements: [
+                { id: "10", constExpr: { int64Value: "1" } },
+                { id: "11", constExpr: { int64Value: "2" } },
+                { id: "12", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "14", identExpr: { name: "j" } },
+            {
+              id: "16",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "15", identExpr: { name: "j" } },
+                  { id: "17", identExpr: { name: "j" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 31,
+          function: "all",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "i" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "@in",
+                args: [{ id: "7", identExpr: { name: "i" } }, { id: "24" }],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    @in(\n      i~int^i,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1~int,\n          2~int,\n          3~int\n        ]~list(int),\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            _+_(\n              j~int^j,\n              j~int^j\n            )~int^add_int64\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int)\n    )~bool^in_list\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "i" },
+          "8": { overloadId: ["in_list"] },
+          "15": { name: "j" },
+          "16": { overloadId: ["add_int64"] },
+          "17": { name: "j" },
+          "20": { name: "@result" },
+          "22": { overloadId: ["add_list"] },
+          "23": { name: "@result" },
+          "26": { name: "@result" },
+          "27": { overloadId: ["not_strictly_false"] },
+          "28": { name: "@result" },
+          "29": { overloadId: ["logical_and"] },
+          "30": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "BOOL" },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+            "14": 32,
+            "15": 35,
+            "16": 37,
+            "17": 39,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 11,
+            "26": 11,
+            "27": 11,
+            "28": 11,
+            "29": 11,
+            "30": 11,
+            "31": 11,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: {
+                  id: "9",
+                  listExpr: {
+                    elements: [
+                      { id: "10", constExpr: { int64Value: "1" } },
+                      { id: "11", constExpr: { int64Value: "2" } },
+                      { id: "12", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "14", identExpr: { name: "j" } },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "15", identExpr: { name: "j" } },
+                        { id: "17", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "i" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        { id: "24" },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "31",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "25", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "27",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "26", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "29",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "28", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        {
+                          id: "24",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", constExpr: { int64Value: "1" } },
+                                  { id: "11", constExpr: { int64Value: "2" } },
+                                  { id: "12", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "18", listExpr: {} },
+                            loopCondition: {
+                              id: "19",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "22",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "20", identExpr: { name: "@result" } },
+                                  {
+                                    id: "21",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "16",
+                                          callExpr: {
+                                            function: "_+_",
+                                            args: [
+                                              {
+                                                id: "15",
+                                                identExpr: { name: "j" },
+                                              },
+                                              {
+                                                id: "17",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "23",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "30", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "20", max: "230" },
+      expectedCost: { min: "20", max: "230" },
+    },
+    {
+      original: {
+        name: "nested dyn comprehension",
+        expr: "dyn([1,2,3]).all(i, i in dyn([1,2,3]).map(j, j + j))",
+        checkOnly: true,
+      },
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  dyn(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    @in(\n      i^#*expr.Expr_IdentExpr#,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        dyn(\n          [\n            1^#*expr.Constant_Int64Value#,\n            2^#*expr.Constant_Int64Value#,\n            3^#*expr.Constant_Int64Value#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            _+_(\n              j^#*expr.Expr_IdentExpr#,\n              j^#*expr.Expr_IdentExpr#\n            )^#*expr.Expr_CallExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "dyn([1, 2, 3]).all(i, i in dyn([1, 2, 3]).map(j, j + j))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  dyn(\n    [\n      1^#3[1,5]#,\n      2^#4[1,7]#,\n      3^#5[1,9]#\n    ]^#2[1,4]#\n  )^#1[1,3]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#27[1,16]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#28[1,16]#\n  )^#29[1,16]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#30[1,16]#,\n    @in(\n      i^#8[1,20]#,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        dyn(\n          [\n            1^#12[1,30]#,\n            2^#13[1,32]#,\n            3^#14[1,34]#\n          ]^#11[1,29]#\n        )^#10[1,28]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#20[1,41]#,\n        // LoopCondition\n        true^#21[1,41]#,\n        // LoopStep\n        _+_(\n          @result^#22[1,41]#,\n          [\n            _+_(\n              j^#17[1,45]#,\n              j^#19[1,49]#\n            )^#18[1,47]#\n          ]^#23[1,41]#\n        )^#24[1,41]#,\n        // Result\n        @result^#25[1,41]#)^#26[1,41]#\n    )^#9[1,22]#\n  )^#31[1,16]#,\n  // Result\n  @result^#32[1,16]#)^#33[1,16]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 22, 24, 1, 22, 1, 24],
+        [10, 28, 29, 1, 28, 1, 29],
+        [11, 29, 30, 1, 29, 1, 30],
+        [12, 30, 31, 1, 30, 1, 31],
+        [13, 32, 33, 1, 32, 1, 33],
+        [14, 34, 35, 1, 34, 1, 35],
+        [16, 42, 43, 1, 42, 1, 43],
+        [17, 45, 46, 1, 45, 1, 46],
+        [18, 47, 48, 1, 47, 1, 48],
+        [19, 49, 50, 1, 49, 1, 50],
+        [20, 41, 41, 1, 41, 1, 41],
+        [21, 41, 41, 1, 41, 1, 41],
+        [22, 41, 41, 1, 41, 1, 41],
+        [23, 41, 41, 1, 41, 1, 41],
+        [24, 41, 41, 1, 41, 1, 41],
+        [25, 41, 41, 1, 41, 1, 41],
+        [26, 41, 41, 1, 41, 1, 41],
+        [27, 16, 16, 1, 16, 1, 16],
+        [28, 16, 16, 1, 16, 1, 16],
+        [29, 16, 16, 1, 16, 1, 16],
+        [30, 16, 16, 1, 16, 1, 16],
+        [31, 16, 16, 1, 16, 1, 16],
+        [32, 16, 16, 1, 16, 1, 16],
+        [33, 16, 16, 1, 16, 1, 16],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "33",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              callExpr: {
+                function: "dyn",
+                args: [
+                  {
+                    id: "2",
+                    listExpr: {
+                      elements: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "27", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "29",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "28", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "31",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "30", identExpr: { name: "@result" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "8", identExpr: { name: "i" } },
+                        {
+                          id: "26",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "10",
+                              callExpr: {
+                                function: "dyn",
+                                args: [
+                                  {
+                                    id: "11",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "12",
+                                          constExpr: { int64Value: "1" },
+                                        },
+                                        {
+                                          id: "13",
+                                          constExpr: { int64Value: "2" },
+                                        },
+                                        {
+                                          id: "14",
+                                          constExpr: { int64Value: "3" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "20", listExpr: {} },
+                            loopCondition: {
+                              id: "21",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "24",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "22", identExpr: { name: "@result" } },
+                                  {
+                                    id: "23",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "18",
+                                          callExpr: {
+                                            function: "_+_",
+                                            args: [
+                                              {
+                                                id: "17",
+                                                identExpr: { name: "j" },
+                                              },
+                                              {
+                                                id: "19",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "25",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "32", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "nested dyn comprehension",
+          lineOffsets: [53],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "7": 17,
+            "8": 20,
+            "9": 22,
+            "10": 28,
+            "11": 29,
+            "12": 30,
+            "13": 32,
+            "14": 34,
+            "16": 42,
+            "17": 45,
+            "18": 47,
+            "19": 49,
+            "20": 41,
+            "21": 41,
+            "22": 41,
+            "23": 41,
+            "24": 41,
+            "25": 41,
+            "26": 41,
+            "27": 16,
+            "28": 16,
+            "29": 16,
+            "30": 16,
+            "31": 16,
+            "32": 16,
+            "33": 16,
+          },
+          macroCalls: {
+            "26": {
+              callExpr: {
+                target: {
+                  id: "10",
+                  callExpr: {
+                    function: "dyn",
+                    args: [
+                      {
+                        id: "11",
+                        listExpr: {
+                          elements: [
+                            { id: "12", constExpr: { int64Value: "1" } },
+                            { id: "13", constExpr: { int64Value: "2" } },
+                            { id: "14", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "16", identExpr: { name: "j" } },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "17", identExpr: { name: "j" } },
+                        { id: "19", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  callExpr: {
+                    function: "dyn",
+                    args: [
+                      {
+                        id: "2",
+                        listExpr: {
+                          elements: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "4", constExpr: { int64Value: "2" } },
+                            { id: "5", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "7", identExpr: { name: "i" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "8", identExpr: { name: "i" } },
+                        { id: "26" },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 26,
+          function: "map",
+          target: {
+            id: "10",
+            callExpr: {
+              function: "dyn",
+              args: [
+                {
+                  id: "11",
+                  listExpr: {
+                    elements: [
+                      { id: "12", constExpr: { int64Value: "1" } },
+                      { id: "13", constExpr: { int64Value: "2" } },
+                      { id: "14", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "16", identExpr: { name: "j" } },
+            {
+              id: "18",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "17", identExpr: { name: "j" } },
+                  { id: "19", identExpr: { name: "j" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 33,
+          function: "all",
+          target: {
+            id: "1",
+            callExpr: {
+              function: "dyn",
+              args: [
+                {
+                  id: "2",
+                  listExpr: {
+                    elements: [
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "4", constExpr: { int64Value: "2" } },
+                      { id: "5", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "7", identExpr: { name: "i" } },
+            {
+              id: "9",
+              callExpr: {
+                function: "@in",
+                args: [{ id: "8", identExpr: { name: "i" } }, { id: "26" }],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  dyn(\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int)\n  )~dyn^to_dyn,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    @in(\n      i~dyn^i,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        dyn(\n          [\n            1~int,\n            2~int,\n            3~int\n          ]~list(int)\n        )~dyn^to_dyn,\n        // Accumulator\n        @result,\n        // Init\n        []~list(dyn),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(dyn)^@result,\n          [\n            _+_(\n              j~dyn^j,\n              j~dyn^j\n            )~dyn^add_bytes|add_double|add_duration_duration|add_duration_timestamp|add_int64|add_list|add_string|add_timestamp_duration|add_uint64\n          ]~list(dyn)\n        )~list(dyn)^add_list,\n        // Result\n        @result~list(dyn)^@result)~list(dyn)\n    )~bool^in_list\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "8": { name: "i" },
+          "9": { overloadId: ["in_list"] },
+          "10": { overloadId: ["to_dyn"] },
+          "17": { name: "j" },
+          "18": {
+            overloadId: [
+              "add_bytes",
+              "add_double",
+              "add_duration_duration",
+              "add_duration_timestamp",
+              "add_int64",
+              "add_list",
+              "add_string",
+              "add_timestamp_duration",
+              "add_uint64",
+            ],
+          },
+          "19": { name: "j" },
+          "22": { name: "@result" },
+          "24": { overloadId: ["add_list"] },
+          "25": { name: "@result" },
+          "28": { name: "@result" },
+          "29": { overloadId: ["not_strictly_false"] },
+          "30": { name: "@result" },
+          "31": { overloadId: ["logical_and"] },
+          "32": { name: "@result" },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "8": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { dyn: {} },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "17": { dyn: {} },
+          "18": { dyn: {} },
+          "19": { dyn: {} },
+          "20": { listType: { elemType: { dyn: {} } } },
+          "21": { primitive: "BOOL" },
+          "22": { listType: { elemType: { dyn: {} } } },
+          "23": { listType: { elemType: { dyn: {} } } },
+          "24": { listType: { elemType: { dyn: {} } } },
+          "25": { listType: { elemType: { dyn: {} } } },
+          "26": { listType: { elemType: { dyn: {} } } },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "7": 17,
+            "8": 20,
+            "9": 22,
+            "10": 28,
+            "11": 29,
+            "12": 30,
+            "13": 32,
+            "14": 34,
+            "16": 42,
+            "17": 45,
+            "18": 47,
+            "19": 49,
+            "20": 41,
+            "21": 41,
+            "22": 41,
+            "23": 41,
+            "24": 41,
+            "25": 41,
+            "26": 41,
+            "27": 16,
+            "28": 16,
+            "29": 16,
+            "30": 16,
+            "31": 16,
+            "32": 16,
+            "33": 16,
+          },
+          macroCalls: {
+            "26": {
+              callExpr: {
+                target: {
+                  id: "10",
+                  callExpr: {
+                    function: "dyn",
+                    args: [
+                      {
+                        id: "11",
+                        listExpr: {
+                          elements: [
+                            { id: "12", constExpr: { int64Value: "1" } },
+                            { id: "13", constExpr: { int64Value: "2" } },
+                            { id: "14", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "16", identExpr: { name: "j" } },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "17", identExpr: { name: "j" } },
+                        { id: "19", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  callExpr: {
+                    function: "dyn",
+                    args: [
+                      {
+                        id: "2",
+                        listExpr: {
+                          elements: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "4", constExpr: { int64Value: "2" } },
+                            { id: "5", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "7", identExpr: { name: "i" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "8", identExpr: { name: "i" } },
+                        { id: "26" },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "33",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              callExpr: {
+                function: "dyn",
+                args: [
+                  {
+                    id: "2",
+                    listExpr: {
+                      elements: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "27", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "29",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "28", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "31",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "30", identExpr: { name: "@result" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "8", identExpr: { name: "i" } },
+                        {
+                          id: "26",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "10",
+                              callExpr: {
+                                function: "dyn",
+                                args: [
+                                  {
+                                    id: "11",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "12",
+                                          constExpr: { int64Value: "1" },
+                                        },
+                                        {
+                                          id: "13",
+                                          constExpr: { int64Value: "2" },
+                                        },
+                                        {
+                                          id: "14",
+                                          constExpr: { int64Value: "3" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "20", listExpr: {} },
+                            loopCondition: {
+                              id: "21",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "24",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "22", identExpr: { name: "@result" } },
+                                  {
+                                    id: "23",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "18",
+                                          callExpr: {
+                                            function: "_+_",
+                                            args: [
+                                              {
+                                                id: "17",
+                                                identExpr: { name: "j" },
+                                              },
+                                              {
+                                                id: "19",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "25",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "32", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "234" },
+      expectedCost: { min: "21", max: "234" },
+    },
+    {
+      original: {
+        name: "literal map access",
+        expr: "{'hello': 'hi'}['hello'] != {'hello': 'bye'}['hello']",
+        checkOnly: true,
+      },
+      ast: '_!=_(\n  _[_](\n    {\n      "hello"^#*expr.Constant_StringValue#:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _[_](\n    {\n      "hello"^#*expr.Constant_StringValue#:"bye"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{"hello": "hi"}["hello"] != {"hello": "bye"}["hello"]',
+      locationAst:
+        '_!=_(\n  _[_](\n    {\n      "hello"^#3[1,1]#:"hi"^#4[1,10]#^#2[1,8]#\n    }^#1[1,0]#,\n    "hello"^#6[1,16]#\n  )^#5[1,15]#,\n  _[_](\n    {\n      "hello"^#10[1,29]#:"bye"^#11[1,38]#^#9[1,36]#\n    }^#8[1,28]#,\n    "hello"^#13[1,45]#\n  )^#12[1,44]#\n)^#7[1,25]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 1, 8, 1, 1, 1, 8],
+        [4, 10, 14, 1, 10, 1, 14],
+        [5, 15, 16, 1, 15, 1, 16],
+        [6, 16, 23, 1, 16, 1, 23],
+        [7, 25, 27, 1, 25, 1, 27],
+        [8, 28, 29, 1, 28, 1, 29],
+        [9, 36, 37, 1, 36, 1, 37],
+        [10, 29, 36, 1, 29, 1, 36],
+        [11, 38, 43, 1, 38, 1, 43],
+        [12, 44, 45, 1, 44, 1, 45],
+        [13, 45, 52, 1, 45, 1, 52],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: {
+                              id: "3",
+                              constExpr: { stringValue: "hello" },
+                            },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hi" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "8",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "9",
+                            mapKey: {
+                              id: "10",
+                              constExpr: { stringValue: "hello" },
+                            },
+                            value: {
+                              id: "11",
+                              constExpr: { stringValue: "bye" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "13", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal map access",
+          lineOffsets: [54],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 1,
+            "4": 10,
+            "5": 15,
+            "6": 16,
+            "7": 25,
+            "8": 28,
+            "9": 36,
+            "10": 29,
+            "11": 38,
+            "12": 44,
+            "13": 45,
+          },
+        },
+      },
+      checkedAst:
+        '_!=_(\n  _[_](\n    {\n      "hello"~string:"hi"~string\n    }~map(string, string),\n    "hello"~string\n  )~string^index_map,\n  _[_](\n    {\n      "hello"~string:"bye"~string\n    }~map(string, string),\n    "hello"~string\n  )~string^index_map\n)~bool^not_equals',
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["index_map"] },
+          "7": { overloadId: ["not_equals"] },
+          "12": { overloadId: ["index_map"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "STRING" },
+          "12": { primitive: "STRING" },
+          "13": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 1,
+            "4": 10,
+            "5": 15,
+            "6": 16,
+            "7": 25,
+            "8": 28,
+            "9": 36,
+            "10": 29,
+            "11": 38,
+            "12": 44,
+            "13": 45,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: {
+                              id: "3",
+                              constExpr: { stringValue: "hello" },
+                            },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hi" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "8",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "9",
+                            mapKey: {
+                              id: "10",
+                              constExpr: { stringValue: "hello" },
+                            },
+                            value: {
+                              id: "11",
+                              constExpr: { stringValue: "bye" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "13", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "63", max: "63" },
+      expectedCost: { min: "63", max: "63" },
+    },
+    {
+      original: {
+        name: "literal list access",
+        expr: "['hello', 'hi'][0] != ['hello', 'bye'][1]",
+        checkOnly: true,
+      },
+      ast: '_!=_(\n  _[_](\n    [\n      "hello"^#*expr.Constant_StringValue#,\n      "hi"^#*expr.Constant_StringValue#\n    ]^#*expr.Expr_ListExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _[_](\n    [\n      "hello"^#*expr.Constant_StringValue#,\n      "bye"^#*expr.Constant_StringValue#\n    ]^#*expr.Expr_ListExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["hello", "hi"][0] != ["hello", "bye"][1]',
+      locationAst:
+        '_!=_(\n  _[_](\n    [\n      "hello"^#2[1,1]#,\n      "hi"^#3[1,10]#\n    ]^#1[1,0]#,\n    0^#5[1,16]#\n  )^#4[1,15]#,\n  _[_](\n    [\n      "hello"^#8[1,23]#,\n      "bye"^#9[1,32]#\n    ]^#7[1,22]#,\n    1^#11[1,39]#\n  )^#10[1,38]#\n)^#6[1,19]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 8, 1, 1, 1, 8],
+        [3, 10, 14, 1, 10, 1, 14],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 19, 21, 1, 19, 1, 21],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 23, 30, 1, 23, 1, 30],
+        [9, 32, 37, 1, 32, 1, 37],
+        [10, 38, 39, 1, 38, 1, 39],
+        [11, 39, 40, 1, 39, 1, 40],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { stringValue: "hello" } },
+                          { id: "3", constExpr: { stringValue: "hi" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "8", constExpr: { stringValue: "hello" } },
+                          { id: "9", constExpr: { stringValue: "bye" } },
+                        ],
+                      },
+                    },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal list access",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 10,
+            "4": 15,
+            "5": 16,
+            "6": 19,
+            "7": 22,
+            "8": 23,
+            "9": 32,
+            "10": 38,
+            "11": 39,
+          },
+        },
+      },
+      checkedAst:
+        '_!=_(\n  _[_](\n    [\n      "hello"~string,\n      "hi"~string\n    ]~list(string),\n    0~int\n  )~string^index_list,\n  _[_](\n    [\n      "hello"~string,\n      "bye"~string\n    ]~list(string),\n    1~int\n  )~string^index_list\n)~bool^not_equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["index_list"] },
+          "6": { overloadId: ["not_equals"] },
+          "10": { overloadId: ["index_list"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { listType: { elemType: { primitive: "STRING" } } },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 10,
+            "4": 15,
+            "5": 16,
+            "6": 19,
+            "7": 22,
+            "8": 23,
+            "9": 32,
+            "10": 38,
+            "11": 39,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { stringValue: "hello" } },
+                          { id: "3", constExpr: { stringValue: "hi" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "8", constExpr: { stringValue: "hello" } },
+                          { id: "9", constExpr: { stringValue: "bye" } },
+                        ],
+                      },
+                    },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      expectedCost: { min: "23", max: "23" },
+    },
+    {
+      original: { name: "type call", expr: "type(1)", checkOnly: true },
+      ast: "type(\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(1)",
+      locationAst: "type(\n  1^#2[1,5]#\n)^#1[1,4]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "type",
+            args: [{ id: "2", constExpr: { int64Value: "1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "type call",
+          lineOffsets: [8],
+          positions: { "1": 4, "2": 5 },
+        },
+      },
+      checkedAst: "type(\n  1~int\n)~type(int)^type",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["type"] } },
+        typeMap: {
+          "1": { type: { primitive: "INT64" } },
+          "2": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 4, "2": 5 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "type",
+            args: [{ id: "2", constExpr: { int64Value: "1" } }],
+          },
+        },
+      },
+      type: "type(int)",
+      cost: { min: "1", max: "1" },
+      expectedCost: { min: "1", max: "1" },
+    },
+    {
+      original: {
+        name: "type call variable",
+        expr: "type(self.val1)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "self",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: "type(\n  self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(self.val1)",
+      locationAst: "type(\n  self^#2[1,5]#.val1^#3[1,9]#\n)^#1[1,4]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 9, 1, 5, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "type",
+            args: [
+              {
+                id: "3",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "type call variable",
+          lineOffsets: [16],
+          positions: { "1": 4, "2": 5, "3": 9 },
+        },
+      },
+      checkedAst:
+        "type(\n  self~map(string, int)^self.val1~int\n)~type(int)^type",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["type"] }, "2": { name: "self" } },
+        typeMap: {
+          "1": { type: { primitive: "INT64" } },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 4, "2": 5, "3": 9 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "type",
+            args: [
+              {
+                id: "3",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "type(int)",
+      cost: { min: "3", max: "3" },
+      expectedCost: { min: "3", max: "3" },
+    },
+    {
+      original: {
+        name: "type call variable equality",
+        expr: "type(self.val1) == int",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "self",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: "_==_(\n  type(\n    self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  int^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(self.val1) == int",
+      locationAst:
+        "_==_(\n  type(\n    self^#2[1,5]#.val1^#3[1,9]#\n  )^#1[1,4]#,\n  int^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 9, 1, 5, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [
+                    {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "self" } },
+                        field: "val1",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", identExpr: { name: "int" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "type call variable equality",
+          lineOffsets: [23],
+          positions: { "1": 4, "2": 5, "3": 9, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  type(\n    self~map(string, int)^self.val1~int\n  )~type(int)^type,\n  int~type(int)^int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["type"] },
+          "2": { name: "self" },
+          "4": { overloadId: ["equals"] },
+          "5": { name: "int" },
+        },
+        typeMap: {
+          "1": { type: { primitive: "INT64" } },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { type: { primitive: "INT64" } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 4, "2": 5, "3": 9, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [
+                    {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "self" } },
+                        field: "val1",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", identExpr: { name: "int" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "1844674407370955268" },
+      expectedCost: { min: "5", max: "1844674407370955268" },
+    },
+    {
+      original: {
+        name: "type literal equality cost",
+        expr: "type(1) == int",
+        checkOnly: true,
+      },
+      ast: "_==_(\n  type(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  int^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(1) == int",
+      locationAst:
+        "_==_(\n  type(\n    1^#2[1,5]#\n  )^#1[1,4]#,\n  int^#4[1,11]#\n)^#3[1,8]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 8, 10, 1, 8, 1, 10],
+        [4, 11, 14, 1, 11, 1, 14],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "4", identExpr: { name: "int" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "type literal equality cost",
+          lineOffsets: [15],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 11 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  type(\n    1~int\n  )~type(int)^type,\n  int~type(int)^int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["type"] },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "int" },
+        },
+        typeMap: {
+          "1": { type: { primitive: "INT64" } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { type: { primitive: "INT64" } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 11 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "4", identExpr: { name: "int" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      expectedCost: { min: "3", max: "1844674407370955266" },
+    },
+    {
+      original: {
+        name: "type variable equality cost",
+        expr: "type(1) == int",
+        checkOnly: true,
+      },
+      ast: "_==_(\n  type(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  int^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(1) == int",
+      locationAst:
+        "_==_(\n  type(\n    1^#2[1,5]#\n  )^#1[1,4]#,\n  int^#4[1,11]#\n)^#3[1,8]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 8, 10, 1, 8, 1, 10],
+        [4, 11, 14, 1, 11, 1, 14],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "4", identExpr: { name: "int" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "type variable equality cost",
+          lineOffsets: [15],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 11 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  type(\n    1~int\n  )~type(int)^type,\n  int~type(int)^int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["type"] },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "int" },
+        },
+        typeMap: {
+          "1": { type: { primitive: "INT64" } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { type: { primitive: "INT64" } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 11 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "type",
+                  args: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "4", identExpr: { name: "int" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      expectedCost: { min: "3", max: "1844674407370955266" },
+    },
+    {
+      original: {
+        name: "namespace variable equality",
+        expr: "self.val1 == 1.0",
+        checkOnly: true,
+        typeEnv: [
+          { name: "self.val1", ident: { type: { primitive: "DOUBLE" } } },
+        ],
+      },
+      ast: "_==_(\n  self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "self.val1 == 1.0",
+      locationAst:
+        "_==_(\n  self^#1[1,0]#.val1^#2[1,4]#,\n  1^#4[1,13]#\n)^#3[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 16, 1, 13, 1, 16],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              { id: "4", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "namespace variable equality",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  self.val1~double^self.val1,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "self.val1" },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "2", identExpr: { name: "self.val1" } },
+              { id: "4", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      expectedCost: { min: "2", max: "2" },
+    },
+    {
+      original: {
+        name: "simple map variable equality",
+        expr: "self.val1 == 1.0",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "self",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "DOUBLE" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: "_==_(\n  self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "self.val1 == 1.0",
+      locationAst:
+        "_==_(\n  self^#1[1,0]#.val1^#2[1,4]#,\n  1^#4[1,13]#\n)^#3[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 16, 1, 13, 1, 16],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              { id: "4", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "simple map variable equality",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  self~map(string, double)^self.val1~double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "self" },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "DOUBLE" },
+            },
+          },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              { id: "4", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      expectedCost: { min: "3", max: "3" },
+    },
+    {
+      original: {
+        name: "date-time math",
+        expr: "self.val1 == timestamp('2011-08-18T00:00:00.000+01:00') + duration('19h3m37s10ms')",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "self",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { wellKnown: "TIMESTAMP" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: '_==_(\n  self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#,\n  _+_(\n    timestamp(\n      "2011-08-18T00:00:00.000+01:00"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    duration(\n      "19h3m37s10ms"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'self.val1 == timestamp("2011-08-18T00:00:00.000+01:00") + duration("19h3m37s10ms")',
+      locationAst:
+        '_==_(\n  self^#1[1,0]#.val1^#2[1,4]#,\n  _+_(\n    timestamp(\n      "2011-08-18T00:00:00.000+01:00"^#5[1,23]#\n    )^#4[1,22]#,\n    duration(\n      "19h3m37s10ms"^#8[1,67]#\n    )^#7[1,66]#\n  )^#6[1,56]#\n)^#3[1,10]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 23, 54, 1, 23, 1, 54],
+        [6, 56, 57, 1, 56, 1, 57],
+        [7, 66, 67, 1, 66, 1, 67],
+        [8, 67, 81, 1, 67, 1, 81],
+      ],
+      lineOffsets: [83],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "timestamp",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: {
+                              stringValue: "2011-08-18T00:00:00.000+01:00",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          {
+                            id: "8",
+                            constExpr: { stringValue: "19h3m37s10ms" },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "date-time math",
+          lineOffsets: [83],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 10,
+            "4": 22,
+            "5": 23,
+            "6": 56,
+            "7": 66,
+            "8": 67,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  self~map(string, timestamp)^self.val1~timestamp,\n  _+_(\n    timestamp(\n      "2011-08-18T00:00:00.000+01:00"~string\n    )~timestamp^string_to_timestamp,\n    duration(\n      "19h3m37s10ms"~string\n    )~duration^string_to_duration\n  )~timestamp^add_timestamp_duration\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "self" },
+          "3": { overloadId: ["equals"] },
+          "4": { overloadId: ["string_to_timestamp"] },
+          "6": { overloadId: ["add_timestamp_duration"] },
+          "7": { overloadId: ["string_to_duration"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { wellKnown: "TIMESTAMP" },
+            },
+          },
+          "2": { wellKnown: "TIMESTAMP" },
+          "3": { primitive: "BOOL" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "STRING" },
+          "6": { wellKnown: "TIMESTAMP" },
+          "7": { wellKnown: "DURATION" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [83],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 10,
+            "4": 22,
+            "5": 23,
+            "6": 56,
+            "7": 66,
+            "8": 67,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "timestamp",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: {
+                              stringValue: "2011-08-18T00:00:00.000+01:00",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          {
+                            id: "8",
+                            constExpr: { stringValue: "19h3m37s10ms" },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "6", max: "6" },
+      expectedCost: { min: "6", max: "6" },
+    },
+    {
+      original: {
+        name: "date-time math self-conversion",
+        expr: "timestamp(self.val1) == timestamp('2011-08-18T00:00:00.000+01:00') + duration('19h3m37s10ms')",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "self",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { wellKnown: "TIMESTAMP" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: '_==_(\n  timestamp(\n    self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    timestamp(\n      "2011-08-18T00:00:00.000+01:00"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    duration(\n      "19h3m37s10ms"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'timestamp(self.val1) == timestamp("2011-08-18T00:00:00.000+01:00") + duration("19h3m37s10ms")',
+      locationAst:
+        '_==_(\n  timestamp(\n    self^#2[1,10]#.val1^#3[1,14]#\n  )^#1[1,9]#,\n  _+_(\n    timestamp(\n      "2011-08-18T00:00:00.000+01:00"^#6[1,34]#\n    )^#5[1,33]#,\n    duration(\n      "19h3m37s10ms"^#9[1,78]#\n    )^#8[1,77]#\n  )^#7[1,67]#\n)^#4[1,21]#',
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 14, 1, 10, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 33, 34, 1, 33, 1, 34],
+        [6, 34, 65, 1, 34, 1, 65],
+        [7, 67, 68, 1, 67, 1, 68],
+        [8, 77, 78, 1, 77, 1, 78],
+        [9, 78, 92, 1, 78, 1, 92],
+      ],
+      lineOffsets: [94],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [
+                    {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "self" } },
+                        field: "val1",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "timestamp",
+                        args: [
+                          {
+                            id: "6",
+                            constExpr: {
+                              stringValue: "2011-08-18T00:00:00.000+01:00",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          {
+                            id: "9",
+                            constExpr: { stringValue: "19h3m37s10ms" },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "date-time math self-conversion",
+          lineOffsets: [94],
+          positions: {
+            "1": 9,
+            "2": 10,
+            "3": 14,
+            "4": 21,
+            "5": 33,
+            "6": 34,
+            "7": 67,
+            "8": 77,
+            "9": 78,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  timestamp(\n    self~map(string, timestamp)^self.val1~timestamp\n  )~timestamp^timestamp_to_timestamp,\n  _+_(\n    timestamp(\n      "2011-08-18T00:00:00.000+01:00"~string\n    )~timestamp^string_to_timestamp,\n    duration(\n      "19h3m37s10ms"~string\n    )~duration^string_to_duration\n  )~timestamp^add_timestamp_duration\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["timestamp_to_timestamp"] },
+          "2": { name: "self" },
+          "4": { overloadId: ["equals"] },
+          "5": { overloadId: ["string_to_timestamp"] },
+          "7": { overloadId: ["add_timestamp_duration"] },
+          "8": { overloadId: ["string_to_duration"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { wellKnown: "TIMESTAMP" },
+            },
+          },
+          "3": { wellKnown: "TIMESTAMP" },
+          "4": { primitive: "BOOL" },
+          "5": { wellKnown: "TIMESTAMP" },
+          "6": { primitive: "STRING" },
+          "7": { wellKnown: "TIMESTAMP" },
+          "8": { wellKnown: "DURATION" },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [94],
+          positions: {
+            "1": 9,
+            "2": 10,
+            "3": 14,
+            "4": 21,
+            "5": 33,
+            "6": 34,
+            "7": 67,
+            "8": 77,
+            "9": 78,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [
+                    {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "self" } },
+                        field: "val1",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "timestamp",
+                        args: [
+                          {
+                            id: "6",
+                            constExpr: {
+                              stringValue: "2011-08-18T00:00:00.000+01:00",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          {
+                            id: "9",
+                            constExpr: { stringValue: "19h3m37s10ms" },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "7", max: "7" },
+      expectedCost: { min: "7", max: "7" },
+    },
+    {
+      original: {
+        name: "boolean vars equal",
+        expr: "self.val1 != self.val2",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "self",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "BOOL" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: "_!=_(\n  self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#,\n  self^#*expr.Expr_IdentExpr#.val2^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "self.val1 != self.val2",
+      locationAst:
+        "_!=_(\n  self^#1[1,0]#.val1^#2[1,4]#,\n  self^#4[1,13]#.val2^#5[1,17]#\n)^#3[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 17, 1, 13, 1, 17],
+        [5, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              {
+                id: "5",
+                selectExpr: {
+                  operand: { id: "4", identExpr: { name: "self" } },
+                  field: "val2",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "boolean vars equal",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_!=_(\n  self~map(string, bool)^self.val1~bool,\n  self~map(string, bool)^self.val2~bool\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "self" },
+          "3": { overloadId: ["not_equals"] },
+          "4": { name: "self" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "BOOL" },
+            },
+          },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "BOOL" },
+            },
+          },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13, "5": 17 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              {
+                id: "5",
+                selectExpr: {
+                  operand: { id: "4", identExpr: { name: "self" } },
+                  field: "val2",
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      expectedCost: { min: "5", max: "5" },
+    },
+    {
+      original: {
+        name: "boolean var equals literal",
+        expr: "self.val1 != true",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "self",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "BOOL" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: "_!=_(\n  self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "self.val1 != true",
+      locationAst:
+        "_!=_(\n  self^#1[1,0]#.val1^#2[1,4]#,\n  true^#4[1,13]#\n)^#3[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 17, 1, 13, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              { id: "4", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "boolean var equals literal",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13 },
+        },
+      },
+      checkedAst:
+        "_!=_(\n  self~map(string, bool)^self.val1~bool,\n  true~bool\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "self" },
+          "3": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "BOOL" },
+            },
+          },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              { id: "4", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      expectedCost: { min: "3", max: "3" },
+    },
+    {
+      original: {
+        name: "double var equals literal",
+        expr: "self.val1 == 1.0",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "self",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "DOUBLE" },
+                },
+              },
+            },
+          },
+        ],
+      },
+      ast: "_==_(\n  self^#*expr.Expr_IdentExpr#.val1^#*expr.Expr_SelectExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "self.val1 == 1.0",
+      locationAst:
+        "_==_(\n  self^#1[1,0]#.val1^#2[1,4]#,\n  1^#4[1,13]#\n)^#3[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 16, 1, 13, 1, 16],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              { id: "4", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "double var equals literal",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  self~map(string, double)^self.val1~double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "self" },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "DOUBLE" },
+            },
+          },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 4, "3": 10, "4": 13 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "self" } },
+                  field: "val1",
+                },
+              },
+              { id: "4", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      expectedCost: { min: "3", max: "3" },
+    },
+  ],
+} as const;
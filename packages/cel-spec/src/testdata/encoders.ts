@@ -0,0 +1,406 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/encoders_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "encoders",
+  tests: [
+    {
+      original: {
+        expr: "base64.decode('aGVsbG8=') == b'hello'",
+        value: { boolValue: true },
+      },
+      section: "TestEncoders",
+      library: "encoders",
+      ast: '_==_(\n  base64^#*expr.Expr_IdentExpr#.decode(\n    "aGVsbG8="^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  b"hello"^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'base64.decode("aGVsbG8=") == b"\\150\\145\\154\\154\\157"',
+      locationAst:
+        '_==_(\n  base64^#1[1,0]#.decode(\n    "aGVsbG8="^#3[1,14]#\n  )^#2[1,13]#,\n  b"hello"^#5[1,29]#\n)^#4[1,26]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 24, 1, 14, 1, 24],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 29, 37, 1, 29, 1, 37],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "base64" } },
+                  function: "decode",
+                  args: [{ id: "3", constExpr: { stringValue: "aGVsbG8=" } }],
+                },
+              },
+              { id: "5", constExpr: { bytesValue: "aGVsbG8=" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 26, "5": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  base64.decode(\n    "aGVsbG8="~string\n  )~bytes^base64_decode_string,\n  b"hello"~bytes\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["base64_decode_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "BYTES" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 26, "5": 29 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "base64.decode",
+                  args: [{ id: "3", constExpr: { stringValue: "aGVsbG8=" } }],
+                },
+              },
+              { id: "5", constExpr: { bytesValue: "aGVsbG8=" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "base64.decode('aGVsbG8') == b'hello'",
+        value: { boolValue: true },
+      },
+      section: "TestEncoders",
+      library: "encoders",
+      ast: '_==_(\n  base64^#*expr.Expr_IdentExpr#.decode(\n    "aGVsbG8"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  b"hello"^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'base64.decode("aGVsbG8") == b"\\150\\145\\154\\154\\157"',
+      locationAst:
+        '_==_(\n  base64^#1[1,0]#.decode(\n    "aGVsbG8"^#3[1,14]#\n  )^#2[1,13]#,\n  b"hello"^#5[1,28]#\n)^#4[1,25]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 27, 1, 25, 1, 27],
+        [5, 28, 36, 1, 28, 1, 36],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "base64" } },
+                  function: "decode",
+                  args: [{ id: "3", constExpr: { stringValue: "aGVsbG8" } }],
+                },
+              },
+              { id: "5", constExpr: { bytesValue: "aGVsbG8=" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 25, "5": 28 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  base64.decode(\n    "aGVsbG8"~string\n  )~bytes^base64_decode_string,\n  b"hello"~bytes\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["base64_decode_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "BYTES" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 25, "5": 28 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "base64.decode",
+                  args: [{ id: "3", constExpr: { stringValue: "aGVsbG8" } }],
+                },
+              },
+              { id: "5", constExpr: { bytesValue: "aGVsbG8=" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "base64.decode(b'aGVsbG8=') == b'hello'",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      section: "TestEncoders",
+      library: "encoders",
+      ast: '_==_(\n  base64^#*expr.Expr_IdentExpr#.decode(\n    b"aGVsbG8="^#*expr.Constant_BytesValue#\n  )^#*expr.Expr_CallExpr#,\n  b"hello"^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'base64.decode(b"\\141\\107\\126\\163\\142\\107\\070\\075") == b"\\150\\145\\154\\154\\157"',
+      locationAst:
+        '_==_(\n  base64^#1[1,0]#.decode(\n    b"aGVsbG8="^#3[1,14]#\n  )^#2[1,13]#,\n  b"hello"^#5[1,30]#\n)^#4[1,27]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 25, 1, 14, 1, 25],
+        [4, 27, 29, 1, 27, 1, 29],
+        [5, 30, 38, 1, 30, 1, 38],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "base64" } },
+                  function: "decode",
+                  args: [
+                    { id: "3", constExpr: { bytesValue: "YUdWc2JHOD0=" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { bytesValue: "aGVsbG8=" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 27, "5": 30 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: found no matching overload for 'base64.decode' applied to '(bytes)'\n | base64.decode(b'aGVsbG8=') == b'hello'\n | .............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'base64.decode' applied to '(bytes)'",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: base64.decode(bytes)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "base64.encode(b'hello') == 'aGVsbG8='",
+        value: { boolValue: true },
+      },
+      section: "TestEncoders",
+      library: "encoders",
+      ast: '_==_(\n  base64^#*expr.Expr_IdentExpr#.encode(\n    b"hello"^#*expr.Constant_BytesValue#\n  )^#*expr.Expr_CallExpr#,\n  "aGVsbG8="^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'base64.encode(b"\\150\\145\\154\\154\\157") == "aGVsbG8="',
+      locationAst:
+        '_==_(\n  base64^#1[1,0]#.encode(\n    b"hello"^#3[1,14]#\n  )^#2[1,13]#,\n  "aGVsbG8="^#5[1,27]#\n)^#4[1,24]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 26, 1, 24, 1, 26],
+        [5, 27, 37, 1, 27, 1, 37],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "base64" } },
+                  function: "encode",
+                  args: [{ id: "3", constExpr: { bytesValue: "aGVsbG8=" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "aGVsbG8=" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  base64.encode(\n    b"hello"~bytes\n  )~string^base64_encode_bytes,\n  "aGVsbG8="~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["base64_encode_bytes"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BYTES" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 27 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "base64.encode",
+                  args: [{ id: "3", constExpr: { bytesValue: "aGVsbG8=" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "aGVsbG8=" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "base64.encode('hello') == b'aGVsbG8='",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      section: "TestEncoders",
+      library: "encoders",
+      ast: '_==_(\n  base64^#*expr.Expr_IdentExpr#.encode(\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  b"aGVsbG8="^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'base64.encode("hello") == b"\\141\\107\\126\\163\\142\\107\\070\\075"',
+      locationAst:
+        '_==_(\n  base64^#1[1,0]#.encode(\n    "hello"^#3[1,14]#\n  )^#2[1,13]#,\n  b"aGVsbG8="^#5[1,26]#\n)^#4[1,23]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 26, 37, 1, 26, 1, 37],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "base64" } },
+                  function: "encode",
+                  args: [{ id: "3", constExpr: { stringValue: "hello" } }],
+                },
+              },
+              { id: "5", constExpr: { bytesValue: "YUdWc2JHOD0=" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 23, "5": 26 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: found no matching overload for 'base64.encode' applied to '(string)'\n | base64.encode('hello') == b'aGVsbG8='\n | .............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'base64.encode' applied to '(string)'",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: base64.encode(string)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+  ],
+} as const;
@@ -0,0 +1,16434 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/cel/folding_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "folding",
+  tests: [
+    {
+      original: {
+        expr: "[1, 1 + 2, 1 + (2 + 3)]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  _+_(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 1 + 2, 1 + (2 + 3)]",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  _+_(\n    1^#3[1,4]#,\n    2^#5[1,8]#\n  )^#4[1,6]#,\n  _+_(\n    1^#6[1,11]#,\n    _+_(\n      2^#8[1,16]#,\n      3^#10[1,20]#\n    )^#9[1,18]#\n  )^#7[1,13]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "2" } },
+                          { id: "10", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 20,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  _+_(\n    1~int,\n    2~int\n  )~int^add_int64,\n  _+_(\n    1~int,\n    _+_(\n      2~int,\n      3~int\n    )~int^add_int64\n  )~int^add_int64\n]~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["add_int64"] },
+          "7": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 20,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "2" } },
+                          { id: "10", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "13", max: "13" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "3" },
+              { int64Value: "6" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "13",
+      foldedAst: "[\n  1~int,\n  3~int,\n  6~int\n]~list(int)",
+      folded: "[1, 3, 6]",
+      expectedFolded: "[1, 3, 6]",
+    },
+    {
+      original: {
+        expr: "6 in [1, 1 + 2, 1 + (2 + 3)]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "@in(\n  6^#*expr.Constant_Int64Value#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      _+_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "6 in [1, 1 + 2, 1 + (2 + 3)]",
+      locationAst:
+        "@in(\n  6^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    _+_(\n      1^#5[1,9]#,\n      2^#7[1,13]#\n    )^#6[1,11]#,\n    _+_(\n      1^#8[1,16]#,\n      _+_(\n        2^#10[1,21]#,\n        3^#12[1,25]#\n      )^#11[1,23]#\n    )^#9[1,18]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  6~int,\n  [\n    1~int,\n    _+_(\n      1~int,\n      2~int\n    )~int^add_int64,\n    _+_(\n      1~int,\n      _+_(\n        2~int,\n        3~int\n      )~int^add_int64\n    )~int^add_int64\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_list"] },
+          "6": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+          "11": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "16", max: "16" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "16",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "5 in [1, 1 + 2, 1 + (2 + 3)]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "@in(\n  5^#*expr.Constant_Int64Value#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      _+_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "5 in [1, 1 + 2, 1 + (2 + 3)]",
+      locationAst:
+        "@in(\n  5^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    _+_(\n      1^#5[1,9]#,\n      2^#7[1,13]#\n    )^#6[1,11]#,\n    _+_(\n      1^#8[1,16]#,\n      _+_(\n        2^#10[1,21]#,\n        3^#12[1,25]#\n      )^#11[1,23]#\n    )^#9[1,18]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "5" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  5~int,\n  [\n    1~int,\n    _+_(\n      1~int,\n      2~int\n    )~int^add_int64,\n    _+_(\n      1~int,\n      _+_(\n        2~int,\n        3~int\n      )~int^add_int64\n    )~int^add_int64\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_list"] },
+          "6": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+          "11": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "5" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "16", max: "16" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "16",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "x in [1, 1 + 2, 1 + (2 + 3)]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "@in(\n  x^#*expr.Expr_IdentExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      _+_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x in [1, 1 + 2, 1 + (2 + 3)]",
+      locationAst:
+        "@in(\n  x^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    _+_(\n      1^#5[1,9]#,\n      2^#7[1,13]#\n    )^#6[1,11]#,\n    _+_(\n      1^#8[1,16]#,\n      _+_(\n        2^#10[1,21]#,\n        3^#12[1,25]#\n      )^#11[1,23]#\n    )^#9[1,18]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  x~dyn^x,\n  [\n    1~int,\n    _+_(\n      1~int,\n      2~int\n    )~int^add_int64,\n    _+_(\n      1~int,\n      _+_(\n        2~int,\n        3~int\n      )~int^add_int64\n    )~int^add_int64\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["in_list"] },
+          "6": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+          "11": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "17", max: "17" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "17",
+      foldedAst:
+        "@in(\n  x~dyn^x,\n  [\n    1~int,\n    3~int,\n    6~int\n  ]~list(int)\n)~bool^in_list",
+      folded: "x in [1, 3, 6]",
+      expectedFolded: "x in [1, 3, 6]",
+    },
+    {
+      original: {
+        expr: "1 in [1, x + 2, 1 + (2 + 3)]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "@in(\n  1^#*expr.Constant_Int64Value#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      x^#*expr.Expr_IdentExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      _+_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 in [1, x + 2, 1 + (2 + 3)]",
+      locationAst:
+        "@in(\n  1^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    _+_(\n      x^#5[1,9]#,\n      2^#7[1,13]#\n    )^#6[1,11]#,\n    _+_(\n      1^#8[1,16]#,\n      _+_(\n        2^#10[1,21]#,\n        3^#12[1,25]#\n      )^#11[1,23]#\n    )^#9[1,18]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", identExpr: { name: "x" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  1~int,\n  [\n    1~int,\n    _+_(\n      x~dyn^x,\n      2~int\n    )~int^add_int64,\n    _+_(\n      1~int,\n      _+_(\n        2~int,\n        3~int\n      )~int^add_int64\n    )~int^add_int64\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_list"] },
+          "5": { name: "x" },
+          "6": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+          "11": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", identExpr: { name: "x" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "17", max: "17" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "13",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "1 in [x, x + 2, 1 + (2 + 3)]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "@in(\n  1^#*expr.Constant_Int64Value#,\n  [\n    x^#*expr.Expr_IdentExpr#,\n    _+_(\n      x^#*expr.Expr_IdentExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      _+_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 in [x, x + 2, 1 + (2 + 3)]",
+      locationAst:
+        "@in(\n  1^#1[1,0]#,\n  [\n    x^#4[1,6]#,\n    _+_(\n      x^#5[1,9]#,\n      2^#7[1,13]#\n    )^#6[1,11]#,\n    _+_(\n      1^#8[1,16]#,\n      _+_(\n        2^#10[1,21]#,\n        3^#12[1,25]#\n      )^#11[1,23]#\n    )^#9[1,18]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "x" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", identExpr: { name: "x" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  1~int,\n  [\n    x~dyn^x,\n    _+_(\n      x~dyn^x,\n      2~int\n    )~int^add_int64,\n    _+_(\n      1~int,\n      _+_(\n        2~int,\n        3~int\n      )~int^add_int64\n    )~int^add_int64\n  ]~list(dyn)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_list"] },
+          "4": { name: "x" },
+          "5": { name: "x" },
+          "6": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+          "11": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "x" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", identExpr: { name: "x" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "18", max: "18" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "12",
+      foldedAst:
+        "@in(\n  1~int,\n  [\n    x~dyn^x,\n    _+_(\n      x~dyn^x,\n      2~int\n    )~int^add_int64,\n    6~int\n  ]~list(dyn)\n)~bool^in_list",
+      folded: "1 in [x, x + 2, 6]",
+      expectedFolded: "1 in [x, x + 2, 6]",
+    },
+    {
+      original: {
+        expr: "x in []",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "@in(\n  x^#*expr.Expr_IdentExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x in []",
+      locationAst: "@in(\n  x^#1[1,0]#,\n  []^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "3", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      checkedAst: "@in(\n  x~dyn^x,\n  []~list(dyn)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "1": { name: "x" }, "2": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "3", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "11" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "11",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "{'hello': 'world'}.hello == x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '_==_(\n  {\n    "hello"^#*expr.Constant_StringValue#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.hello^#*expr.Expr_SelectExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{"hello": "world"}.hello == x',
+      locationAst:
+        '_==_(\n  {\n    "hello"^#3[1,1]#:"world"^#4[1,10]#^#2[1,8]#\n  }^#1[1,0]#.hello^#5[1,18]#,\n  x^#7[1,28]#\n)^#6[1,25]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 1, 8, 1, 1, 1, 8],
+        [4, 10, 17, 1, 10, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 25, 27, 1, 25, 1, 27],
+        [7, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                selectExpr: {
+                  operand: {
+                    id: "1",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "2",
+                          mapKey: {
+                            id: "3",
+                            constExpr: { stringValue: "hello" },
+                          },
+                          value: {
+                            id: "4",
+                            constExpr: { stringValue: "world" },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  field: "hello",
+                },
+              },
+              { id: "7", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 1,
+            "4": 10,
+            "5": 18,
+            "6": 25,
+            "7": 28,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  {\n    "hello"~string:"world"~string\n  }~map(string, string).hello~string,\n  x~dyn^x\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: { "6": { overloadId: ["equals"] }, "7": { name: "x" } },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 1,
+            "4": 10,
+            "5": 18,
+            "6": 25,
+            "7": 28,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                selectExpr: {
+                  operand: {
+                    id: "1",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "2",
+                          mapKey: {
+                            id: "3",
+                            constExpr: { stringValue: "hello" },
+                          },
+                          value: {
+                            id: "4",
+                            constExpr: { stringValue: "world" },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  field: "hello",
+                },
+              },
+              { id: "7", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "1844674407370955296" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "34",
+      foldedAst: '_==_(\n  "world"~string,\n  x~dyn^x\n)~bool^equals',
+      folded: '"world" == x',
+      expectedFolded: '"world" == x',
+    },
+    {
+      original: {
+        expr: "{'hello': 'world'}.?hello.orValue('default') == x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '_==_(\n  _?._(\n    {\n      "hello"^#*expr.Constant_StringValue#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#.orValue(\n    "default"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{"hello": "world"}.?hello.orValue("default") == x',
+      locationAst:
+        '_==_(\n  _?._(\n    {\n      "hello"^#3[1,1]#:"world"^#4[1,10]#^#2[1,8]#\n    }^#1[1,0]#,\n    "hello"^#5[1,20]#\n  )^#6[1,18]#.orValue(\n    "default"^#8[1,34]#\n  )^#7[1,33]#,\n  x^#10[1,48]#\n)^#9[1,45]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 1, 8, 1, 1, 1, 8],
+        [4, 10, 17, 1, 10, 1, 17],
+        [5, 20, 25, 1, 20, 1, 25],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 33, 34, 1, 33, 1, 34],
+        [8, 34, 43, 1, 34, 1, 43],
+        [9, 45, 47, 1, 45, 1, 47],
+        [10, 48, 49, 1, 48, 1, 49],
+      ],
+      lineOffsets: [50],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "6",
+                    callExpr: {
+                      function: "_?._",
+                      args: [
+                        {
+                          id: "1",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "2",
+                                mapKey: {
+                                  id: "3",
+                                  constExpr: { stringValue: "hello" },
+                                },
+                                value: {
+                                  id: "4",
+                                  constExpr: { stringValue: "world" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { stringValue: "hello" } },
+                      ],
+                    },
+                  },
+                  function: "orValue",
+                  args: [{ id: "8", constExpr: { stringValue: "default" } }],
+                },
+              },
+              { id: "10", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 1,
+            "4": 10,
+            "5": 20,
+            "6": 18,
+            "7": 33,
+            "8": 34,
+            "9": 45,
+            "10": 48,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  _?._(\n    {\n      "hello"~string:"world"~string\n    }~map(string, string),\n    "hello"\n  )~optional_type(string)^select_optional_field.orValue(\n    "default"~string\n  )~string^optional_orValue_value,\n  x~dyn^x\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["select_optional_field"] },
+          "7": { overloadId: ["optional_orValue_value"] },
+          "9": { overloadId: ["equals"] },
+          "10": { name: "x" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "6": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 1,
+            "4": 10,
+            "5": 20,
+            "6": 18,
+            "7": 33,
+            "8": 34,
+            "9": 45,
+            "10": 48,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "6",
+                    callExpr: {
+                      function: "_?._",
+                      args: [
+                        {
+                          id: "1",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "2",
+                                mapKey: {
+                                  id: "3",
+                                  constExpr: { stringValue: "hello" },
+                                },
+                                value: {
+                                  id: "4",
+                                  constExpr: { stringValue: "world" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { stringValue: "hello" } },
+                      ],
+                    },
+                  },
+                  function: "orValue",
+                  args: [{ id: "8", constExpr: { stringValue: "default" } }],
+                },
+              },
+              { id: "10", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "34", max: "1844674407370955297" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "34",
+      foldedAst: '_==_(\n  "world"~string,\n  x~dyn^x\n)~bool^equals',
+      folded: '"world" == x',
+      expectedFolded: '"world" == x',
+    },
+    {
+      original: {
+        expr: "{'hello': 'world'}['hello'] == x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    {\n      "hello"^#*expr.Constant_StringValue#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{"hello": "world"}["hello"] == x',
+      locationAst:
+        '_==_(\n  _[_](\n    {\n      "hello"^#3[1,1]#:"world"^#4[1,10]#^#2[1,8]#\n    }^#1[1,0]#,\n    "hello"^#6[1,19]#\n  )^#5[1,18]#,\n  x^#8[1,31]#\n)^#7[1,28]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 1, 8, 1, 1, 1, 8],
+        [4, 10, 17, 1, 10, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 26, 1, 19, 1, 26],
+        [7, 28, 30, 1, 28, 1, 30],
+        [8, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: {
+                              id: "3",
+                              constExpr: { stringValue: "hello" },
+                            },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              { id: "8", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 1,
+            "4": 10,
+            "5": 18,
+            "6": 19,
+            "7": 28,
+            "8": 31,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  _[_](\n    {\n      "hello"~string:"world"~string\n    }~map(string, string),\n    "hello"~string\n  )~string^index_map,\n  x~dyn^x\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["index_map"] },
+          "7": { overloadId: ["equals"] },
+          "8": { name: "x" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 1,
+            "4": 10,
+            "5": 18,
+            "6": 19,
+            "7": 28,
+            "8": 31,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: {
+                              id: "3",
+                              constExpr: { stringValue: "hello" },
+                            },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              { id: "8", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "34",
+      foldedAst: '_==_(\n  "world"~string,\n  x~dyn^x\n)~bool^equals',
+      folded: '"world" == x',
+      expectedFolded: '"world" == x',
+    },
+    {
+      original: {
+        expr: 'optional.of("hello")',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: 'optional^#*expr.Expr_IdentExpr#.of(\n  "hello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'optional.of("hello")',
+      locationAst: 'optional^#1[1,0]#.of(\n  "hello"^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 19, 1, 12, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "optional" } },
+            function: "of",
+            args: [{ id: "3", constExpr: { stringValue: "hello" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12 },
+        },
+      },
+      checkedAst:
+        'optional.of(\n  "hello"~string\n)~optional_type(string)^optional_of',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["optional_of"] } },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "optional.of",
+            args: [{ id: "3", constExpr: { stringValue: "hello" } }],
+          },
+        },
+      },
+      type: "optional_type(string)",
+      cost: { min: "1", max: "1" },
+      runtimeCost: "1",
+      foldedAst:
+        'optional.of(\n  "hello"~string\n)~optional_type(string)^optional_of',
+      folded: 'optional.of("hello")',
+      expectedFolded: 'optional.of("hello")',
+    },
+    {
+      original: {
+        expr: 'optional.ofNonZeroValue("")',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: 'optional^#*expr.Expr_IdentExpr#.ofNonZeroValue(\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'optional.ofNonZeroValue("")',
+      locationAst:
+        'optional^#1[1,0]#.ofNonZeroValue(\n  ""^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 26, 1, 24, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "optional" } },
+            function: "ofNonZeroValue",
+            args: [{ id: "3", constExpr: { stringValue: "" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 23, "3": 24 },
+        },
+      },
+      checkedAst:
+        'optional.ofNonZeroValue(\n  ""~string\n)~optional_type(string)^optional_ofNonZeroValue',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["optional_ofNonZeroValue"] } },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 23, "3": 24 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "optional.ofNonZeroValue",
+            args: [{ id: "3", constExpr: { stringValue: "" } }],
+          },
+        },
+      },
+      type: "optional_type(string)",
+      cost: { min: "1", max: "1" },
+      runtimeCost: "1",
+      foldedAst: "optional.none()~optional_type(dyn)^optional_none",
+      folded: "optional.none()",
+      expectedFolded: "optional.none()",
+    },
+    {
+      original: {
+        expr: "{?'hello': optional.of('world')}['hello'] == x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    {\n      ?"hello"^#*expr.Constant_StringValue#:optional^#*expr.Expr_IdentExpr#.of(\n        "world"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{?"hello": optional.of("world")}["hello"] == x',
+      locationAst:
+        '_==_(\n  _[_](\n    {\n      ?"hello"^#3[1,2]#:optional^#4[1,11]#.of(\n        "world"^#6[1,23]#\n      )^#5[1,22]#^#2[1,9]#\n    }^#1[1,0]#,\n    "hello"^#8[1,33]#\n  )^#7[1,32]#,\n  x^#10[1,45]#\n)^#9[1,42]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 2, 9, 1, 2, 1, 9],
+        [4, 11, 19, 1, 11, 1, 19],
+        [5, 22, 23, 1, 22, 1, 23],
+        [6, 23, 30, 1, 23, 1, 30],
+        [7, 32, 33, 1, 32, 1, 33],
+        [8, 33, 40, 1, 33, 1, 40],
+        [9, 42, 44, 1, 42, 1, 44],
+        [10, 45, 46, 1, 45, 1, 46],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: {
+                              id: "3",
+                              constExpr: { stringValue: "hello" },
+                            },
+                            value: {
+                              id: "5",
+                              callExpr: {
+                                target: {
+                                  id: "4",
+                                  identExpr: { name: "optional" },
+                                },
+                                function: "of",
+                                args: [
+                                  {
+                                    id: "6",
+                                    constExpr: { stringValue: "world" },
+                                  },
+                                ],
+                              },
+                            },
+                            optionalEntry: true,
+                          },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              { id: "10", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 2,
+            "4": 11,
+            "5": 22,
+            "6": 23,
+            "7": 32,
+            "8": 33,
+            "9": 42,
+            "10": 45,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  _[_](\n    {\n      ?"hello"~string:optional.of(\n        "world"~string\n      )~optional_type(string)^optional_of\n    }~map(string, string),\n    "hello"~string\n  )~string^index_map,\n  x~dyn^x\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["optional_of"] },
+          "7": { overloadId: ["index_map"] },
+          "9": { overloadId: ["equals"] },
+          "10": { name: "x" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 2,
+            "4": 11,
+            "5": 22,
+            "6": 23,
+            "7": 32,
+            "8": 33,
+            "9": 42,
+            "10": 45,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: {
+                              id: "3",
+                              constExpr: { stringValue: "hello" },
+                            },
+                            value: {
+                              id: "5",
+                              callExpr: {
+                                function: "optional.of",
+                                args: [
+                                  {
+                                    id: "6",
+                                    constExpr: { stringValue: "world" },
+                                  },
+                                ],
+                              },
+                            },
+                            optionalEntry: true,
+                          },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              { id: "10", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "34", max: "1844674407370955297" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "35",
+      foldedAst: '_==_(\n  "world"~string,\n  x~dyn^x\n)~bool^equals',
+      folded: '"world" == x',
+      expectedFolded: '"world" == x',
+    },
+    {
+      original: {
+        expr: "duration(string(7 * 24) + 'h')",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: 'duration(\n  _+_(\n    string(\n      _*_(\n        7^#*expr.Constant_Int64Value#,\n        24^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    "h"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'duration(string(7 * 24) + "h")',
+      locationAst:
+        'duration(\n  _+_(\n    string(\n      _*_(\n        7^#3[1,16]#,\n        24^#5[1,20]#\n      )^#4[1,18]#\n    )^#2[1,15]#,\n    "h"^#7[1,26]#\n  )^#6[1,24]#\n)^#1[1,8]#',
+      positions: [
+        [1, 8, 9, 1, 8, 1, 9],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "duration",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "string",
+                        args: [
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { int64Value: "7" } },
+                                { id: "5", constExpr: { int64Value: "24" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "7", constExpr: { stringValue: "h" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 8,
+            "2": 15,
+            "3": 16,
+            "4": 18,
+            "5": 20,
+            "6": 24,
+            "7": 26,
+          },
+        },
+      },
+      checkedAst:
+        'duration(\n  _+_(\n    string(\n      _*_(\n        7~int,\n        24~int\n      )~int^multiply_int64\n    )~string^int64_to_string,\n    "h"~string\n  )~string^add_string\n)~duration^string_to_duration',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["string_to_duration"] },
+          "2": { overloadId: ["int64_to_string"] },
+          "4": { overloadId: ["multiply_int64"] },
+          "6": { overloadId: ["add_string"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "DURATION" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 8,
+            "2": 15,
+            "3": 16,
+            "4": 18,
+            "5": 20,
+            "6": 24,
+            "7": 26,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "duration",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "string",
+                        args: [
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { int64Value: "7" } },
+                                { id: "5", constExpr: { int64Value: "24" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "7", constExpr: { stringValue: "h" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "duration",
+      cost: { min: "4", max: "1844674407370955267" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.protobuf.Duration",
+            value: "604800s",
+          },
+        },
+      },
+      runtimeCost: "4",
+      foldedAst: 'duration(\n  "604800s"~string\n)~duration^string_to_duration',
+      folded: 'duration("604800s")',
+      expectedFolded: 'duration("604800s")',
+    },
+    {
+      original: {
+        expr: 'timestamp("1970-01-01T00:00:00Z")',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: 'timestamp(\n  "1970-01-01T00:00:00Z"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'timestamp("1970-01-01T00:00:00Z")',
+      locationAst: 'timestamp(\n  "1970-01-01T00:00:00Z"^#2[1,10]#\n)^#1[1,9]#',
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 32, 1, 10, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "timestamp",
+            args: [
+              { id: "2", constExpr: { stringValue: "1970-01-01T00:00:00Z" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 9, "2": 10 },
+        },
+      },
+      checkedAst:
+        'timestamp(\n  "1970-01-01T00:00:00Z"~string\n)~timestamp^string_to_timestamp',
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["string_to_timestamp"] } },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 9, "2": 10 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "timestamp",
+            args: [
+              { id: "2", constExpr: { stringValue: "1970-01-01T00:00:00Z" } },
+            ],
+          },
+        },
+      },
+      type: "timestamp",
+      cost: { min: "1", max: "1" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.protobuf.Timestamp",
+            value: "1970-01-01T00:00:00Z",
+          },
+        },
+      },
+      runtimeCost: "1",
+      foldedAst:
+        'timestamp(\n  "1970-01-01T00:00:00Z"~string\n)~timestamp^string_to_timestamp',
+      folded: 'timestamp("1970-01-01T00:00:00Z")',
+      expectedFolded: 'timestamp("1970-01-01T00:00:00Z")',
+    },
+    {
+      original: {
+        expr: "[1, 1 + 1, 1 + 2, 2 + 3].exists(i, i \u003c 10)",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003c_(\n      i^#*expr.Expr_IdentExpr#,\n      10^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 1 + 1, 1 + 2, 2 + 3].exists(i, i \u003c 10)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    _+_(\n      1^#3[1,4]#,\n      1^#5[1,8]#\n    )^#4[1,6]#,\n    _+_(\n      1^#6[1,11]#,\n      2^#8[1,15]#\n    )^#7[1,13]#,\n    _+_(\n      2^#9[1,18]#,\n      3^#11[1,22]#\n    )^#10[1,20]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#17[1,31]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#18[1,31]#\n    )^#19[1,31]#\n  )^#20[1,31]#,\n  // LoopStep\n  _||_(\n    @result^#21[1,31]#,\n    _\u003c_(\n      i^#14[1,35]#,\n      10^#16[1,39]#\n    )^#15[1,37]#\n  )^#22[1,31]#,\n  // Result\n  @result^#23[1,31]#)^#24[1,31]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 15, 16, 1, 15, 1, 16],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 20, 21, 1, 20, 1, 21],
+        [11, 22, 23, 1, 22, 1, 23],
+        [13, 32, 33, 1, 32, 1, 33],
+        [14, 35, 36, 1, 35, 1, 36],
+        [15, 37, 38, 1, 37, 1, 38],
+        [16, 39, 41, 1, 39, 1, 41],
+        [17, 31, 31, 1, 31, 1, 31],
+        [18, 31, 31, 1, 31, 1, 31],
+        [19, 31, 31, 1, 31, 1, 31],
+        [20, 31, 31, 1, 31, 1, 31],
+        [21, 31, 31, 1, 31, 1, 31],
+        [22, 31, 31, 1, 31, 1, 31],
+        [23, 31, 31, 1, 31, 1, 31],
+        [24, 31, 31, 1, 31, 1, 31],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "24",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "6", constExpr: { int64Value: "1" } },
+                        { id: "8", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "9", constExpr: { int64Value: "2" } },
+                        { id: "11", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "20",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "18", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "22",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "21", identExpr: { name: "@result" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "14", identExpr: { name: "i" } },
+                        { id: "16", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "23", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 13,
+            "8": 15,
+            "9": 18,
+            "10": 20,
+            "11": 22,
+            "13": 32,
+            "14": 35,
+            "15": 37,
+            "16": 39,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      {
+                        id: "4",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "5", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "6", constExpr: { int64Value: "1" } },
+                            { id: "8", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "10",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "9", constExpr: { int64Value: "2" } },
+                            { id: "11", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "13", identExpr: { name: "i" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "14", identExpr: { name: "i" } },
+                        { id: "16", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 24,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                {
+                  id: "4",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "5", constExpr: { int64Value: "1" } },
+                    ],
+                  },
+                },
+                {
+                  id: "7",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "6", constExpr: { int64Value: "1" } },
+                      { id: "8", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                {
+                  id: "10",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "11", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "13", identExpr: { name: "i" } },
+            {
+              id: "15",
+              callExpr: {
+                function: "_\u003c_",
+                args: [
+                  { id: "14", identExpr: { name: "i" } },
+                  { id: "16", constExpr: { int64Value: "10" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    _+_(\n      1~int,\n      1~int\n    )~int^add_int64,\n    _+_(\n      1~int,\n      2~int\n    )~int^add_int64,\n    _+_(\n      2~int,\n      3~int\n    )~int^add_int64\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _\u003c_(\n      i~int^i,\n      10~int\n    )~bool^less_int64\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["add_int64"] },
+          "7": { overloadId: ["add_int64"] },
+          "10": { overloadId: ["add_int64"] },
+          "14": { name: "i" },
+          "15": { overloadId: ["less_int64"] },
+          "18": { name: "@result" },
+          "19": { overloadId: ["logical_not"] },
+          "20": { overloadId: ["not_strictly_false"] },
+          "21": { name: "@result" },
+          "22": { overloadId: ["logical_or"] },
+          "23": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 13,
+            "8": 15,
+            "9": 18,
+            "10": 20,
+            "11": 22,
+            "13": 32,
+            "14": 35,
+            "15": 37,
+            "16": 39,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      {
+                        id: "4",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "5", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "6", constExpr: { int64Value: "1" } },
+                            { id: "8", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "10",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "9", constExpr: { int64Value: "2" } },
+                            { id: "11", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "13", identExpr: { name: "i" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "14", identExpr: { name: "i" } },
+                        { id: "16", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "24",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "6", constExpr: { int64Value: "1" } },
+                        { id: "8", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "9", constExpr: { int64Value: "2" } },
+                        { id: "11", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "20",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "18", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "22",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "21", identExpr: { name: "@result" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "14", identExpr: { name: "i" } },
+                        { id: "16", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "23", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "30", max: "38" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "[1, 1 + 1, 1 + 2, 2 + 3].exists(i, i \u003c 1 % 2)",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003c_(\n      i^#*expr.Expr_IdentExpr#,\n      _%_(\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 1 + 1, 1 + 2, 2 + 3].exists(i, i \u003c 1 % 2)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    _+_(\n      1^#3[1,4]#,\n      1^#5[1,8]#\n    )^#4[1,6]#,\n    _+_(\n      1^#6[1,11]#,\n      2^#8[1,15]#\n    )^#7[1,13]#,\n    _+_(\n      2^#9[1,18]#,\n      3^#11[1,22]#\n    )^#10[1,20]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#19[1,31]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#20[1,31]#\n    )^#21[1,31]#\n  )^#22[1,31]#,\n  // LoopStep\n  _||_(\n    @result^#23[1,31]#,\n    _\u003c_(\n      i^#14[1,35]#,\n      _%_(\n        1^#16[1,39]#,\n        2^#18[1,43]#\n      )^#17[1,41]#\n    )^#15[1,37]#\n  )^#24[1,31]#,\n  // Result\n  @result^#25[1,31]#)^#26[1,31]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 15, 16, 1, 15, 1, 16],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 20, 21, 1, 20, 1, 21],
+        [11, 22, 23, 1, 22, 1, 23],
+        [13, 32, 33, 1, 32, 1, 33],
+        [14, 35, 36, 1, 35, 1, 36],
+        [15, 37, 38, 1, 37, 1, 38],
+        [16, 39, 40, 1, 39, 1, 40],
+        [17, 41, 42, 1, 41, 1, 42],
+        [18, 43, 44, 1, 43, 1, 44],
+        [19, 31, 31, 1, 31, 1, 31],
+        [20, 31, 31, 1, 31, 1, 31],
+        [21, 31, 31, 1, 31, 1, 31],
+        [22, 31, 31, 1, 31, 1, 31],
+        [23, 31, 31, 1, 31, 1, 31],
+        [24, 31, 31, 1, 31, 1, 31],
+        [25, 31, 31, 1, 31, 1, 31],
+        [26, 31, 31, 1, 31, 1, 31],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "26",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "6", constExpr: { int64Value: "1" } },
+                        { id: "8", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "9", constExpr: { int64Value: "2" } },
+                        { id: "11", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "19", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "22",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "21",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "20", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "24",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "23", identExpr: { name: "@result" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "14", identExpr: { name: "i" } },
+                        {
+                          id: "17",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "16", constExpr: { int64Value: "1" } },
+                              { id: "18", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "25", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 13,
+            "8": 15,
+            "9": 18,
+            "10": 20,
+            "11": 22,
+            "13": 32,
+            "14": 35,
+            "15": 37,
+            "16": 39,
+            "17": 41,
+            "18": 43,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 31,
+            "26": 31,
+          },
+          macroCalls: {
+            "26": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      {
+                        id: "4",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "5", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "6", constExpr: { int64Value: "1" } },
+                            { id: "8", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "10",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "9", constExpr: { int64Value: "2" } },
+                            { id: "11", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "13", identExpr: { name: "i" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "14", identExpr: { name: "i" } },
+                        {
+                          id: "17",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "16", constExpr: { int64Value: "1" } },
+                              { id: "18", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 26,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                {
+                  id: "4",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "5", constExpr: { int64Value: "1" } },
+                    ],
+                  },
+                },
+                {
+                  id: "7",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "6", constExpr: { int64Value: "1" } },
+                      { id: "8", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                {
+                  id: "10",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "11", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "13", identExpr: { name: "i" } },
+            {
+              id: "15",
+              callExpr: {
+                function: "_\u003c_",
+                args: [
+                  { id: "14", identExpr: { name: "i" } },
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "_%_",
+                      args: [
+                        { id: "16", constExpr: { int64Value: "1" } },
+                        { id: "18", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    _+_(\n      1~int,\n      1~int\n    )~int^add_int64,\n    _+_(\n      1~int,\n      2~int\n    )~int^add_int64,\n    _+_(\n      2~int,\n      3~int\n    )~int^add_int64\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _\u003c_(\n      i~int^i,\n      _%_(\n        1~int,\n        2~int\n      )~int^modulo_int64\n    )~bool^less_int64\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["add_int64"] },
+          "7": { overloadId: ["add_int64"] },
+          "10": { overloadId: ["add_int64"] },
+          "14": { name: "i" },
+          "15": { overloadId: ["less_int64"] },
+          "17": { overloadId: ["modulo_int64"] },
+          "20": { name: "@result" },
+          "21": { overloadId: ["logical_not"] },
+          "22": { overloadId: ["not_strictly_false"] },
+          "23": { name: "@result" },
+          "24": { overloadId: ["logical_or"] },
+          "25": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "INT64" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 13,
+            "8": 15,
+            "9": 18,
+            "10": 20,
+            "11": 22,
+            "13": 32,
+            "14": 35,
+            "15": 37,
+            "16": 39,
+            "17": 41,
+            "18": 43,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 31,
+            "26": 31,
+          },
+          macroCalls: {
+            "26": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      {
+                        id: "4",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "5", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "6", constExpr: { int64Value: "1" } },
+                            { id: "8", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "10",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "9", constExpr: { int64Value: "2" } },
+                            { id: "11", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "13", identExpr: { name: "i" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "14", identExpr: { name: "i" } },
+                        {
+                          id: "17",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "16", constExpr: { int64Value: "1" } },
+                              { id: "18", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "26",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "6", constExpr: { int64Value: "1" } },
+                        { id: "8", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "9", constExpr: { int64Value: "2" } },
+                        { id: "11", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "19", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "22",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "21",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "20", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "24",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "23", identExpr: { name: "@result" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "14", identExpr: { name: "i" } },
+                        {
+                          id: "17",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "16", constExpr: { int64Value: "1" } },
+                              { id: "18", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "25", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "30", max: "42" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "42",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j))",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1^#*expr.Constant_Int64Value#,\n          2^#*expr.Constant_Int64Value#,\n          3^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            _*_(\n              i^#*expr.Expr_IdentExpr#,\n              j^#*expr.Expr_IdentExpr#\n            )^#*expr.Expr_CallExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#23[1,13]#,\n  // LoopCondition\n  true^#24[1,13]#,\n  // LoopStep\n  _+_(\n    @result^#25[1,13]#,\n    [\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1^#8[1,18]#,\n          2^#9[1,21]#,\n          3^#10[1,24]#\n        ]^#7[1,17]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#16[1,30]#,\n        // LoopCondition\n        true^#17[1,30]#,\n        // LoopStep\n        _+_(\n          @result^#18[1,30]#,\n          [\n            _*_(\n              i^#13[1,34]#,\n              j^#15[1,38]#\n            )^#14[1,36]#\n          ]^#19[1,30]#\n        )^#20[1,30]#,\n        // Result\n        @result^#21[1,30]#)^#22[1,30]#\n    ]^#26[1,13]#\n  )^#27[1,13]#,\n  // Result\n  @result^#28[1,13]#)^#29[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 18, 19, 1, 18, 1, 19],
+        [9, 21, 22, 1, 21, 1, 22],
+        [10, 24, 25, 1, 24, 1, 25],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 34, 35, 1, 34, 1, 35],
+        [14, 36, 37, 1, 36, 1, 37],
+        [15, 38, 39, 1, 38, 1, 39],
+        [16, 30, 30, 1, 30, 1, 30],
+        [17, 30, 30, 1, 30, 1, 30],
+        [18, 30, 30, 1, 30, 1, 30],
+        [19, 30, 30, 1, 30, 1, 30],
+        [20, 30, 30, 1, 30, 1, 30],
+        [21, 30, 30, 1, 30, 1, 30],
+        [22, 30, 30, 1, 30, 1, 30],
+        [23, 13, 13, 1, 13, 1, 13],
+        [24, 13, 13, 1, 13, 1, 13],
+        [25, 13, 13, 1, 13, 1, 13],
+        [26, 13, 13, 1, 13, 1, 13],
+        [27, 13, 13, 1, 13, 1, 13],
+        [28, 13, 13, 1, 13, 1, 13],
+        [29, 13, 13, 1, 13, 1, 13],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "29",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "23", listExpr: {} },
+            loopCondition: { id: "24", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "27",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "25", identExpr: { name: "@result" } },
+                  {
+                    id: "26",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "22",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "7",
+                              listExpr: {
+                                elements: [
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                  { id: "9", constExpr: { int64Value: "2" } },
+                                  { id: "10", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "16", listExpr: {} },
+                            loopCondition: {
+                              id: "17",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "20",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "18", identExpr: { name: "@result" } },
+                                  {
+                                    id: "19",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "14",
+                                          callExpr: {
+                                            function: "_*_",
+                                            args: [
+                                              {
+                                                id: "13",
+                                                identExpr: { name: "i" },
+                                              },
+                                              {
+                                                id: "15",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "21",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "28", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 18,
+            "9": 21,
+            "10": 24,
+            "12": 31,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+            "16": 30,
+            "17": 30,
+            "18": 30,
+            "19": 30,
+            "20": 30,
+            "21": 30,
+            "22": 30,
+            "23": 13,
+            "24": 13,
+            "25": 13,
+            "26": 13,
+            "27": 13,
+            "28": 13,
+            "29": 13,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "7",
+                  listExpr: {
+                    elements: [
+                      { id: "8", constExpr: { int64Value: "1" } },
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "12", identExpr: { name: "j" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "13", identExpr: { name: "i" } },
+                        { id: "15", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "6", identExpr: { name: "i" } }, { id: "22" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 22,
+          function: "map",
+          target: {
+            id: "7",
+            listExpr: {
+              elements: [
+                { id: "8", constExpr: { int64Value: "1" } },
+                { id: "9", constExpr: { int64Value: "2" } },
+                { id: "10", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "12", identExpr: { name: "j" } },
+            {
+              id: "14",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "13", identExpr: { name: "i" } },
+                  { id: "15", identExpr: { name: "j" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 29,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [{ id: "6", identExpr: { name: "i" } }, { id: "22" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(list(int))^@result,\n    [\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1~int,\n          2~int,\n          3~int\n        ]~list(int),\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            _*_(\n              i~int^i,\n              j~int^j\n            )~int^multiply_int64\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int)\n    ]~list(list(int))\n  )~list(list(int))^add_list,\n  // Result\n  @result~list(list(int))^@result)~list(list(int))",
+      checkedExpr: {
+        referenceMap: {
+          "13": { name: "i" },
+          "14": { overloadId: ["multiply_int64"] },
+          "15": { name: "j" },
+          "18": { name: "@result" },
+          "20": { overloadId: ["add_list"] },
+          "21": { name: "@result" },
+          "25": { name: "@result" },
+          "27": { overloadId: ["add_list"] },
+          "28": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "24": { primitive: "BOOL" },
+          "25": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "26": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "27": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "28": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "29": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 18,
+            "9": 21,
+            "10": 24,
+            "12": 31,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+            "16": 30,
+            "17": 30,
+            "18": 30,
+            "19": 30,
+            "20": 30,
+            "21": 30,
+            "22": 30,
+            "23": 13,
+            "24": 13,
+            "25": 13,
+            "26": 13,
+            "27": 13,
+            "28": 13,
+            "29": 13,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "7",
+                  listExpr: {
+                    elements: [
+                      { id: "8", constExpr: { int64Value: "1" } },
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "12", identExpr: { name: "j" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "13", identExpr: { name: "i" } },
+                        { id: "15", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "6", identExpr: { name: "i" } }, { id: "22" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "29",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "23", listExpr: {} },
+            loopCondition: { id: "24", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "27",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "25", identExpr: { name: "@result" } },
+                  {
+                    id: "26",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "22",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "7",
+                              listExpr: {
+                                elements: [
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                  { id: "9", constExpr: { int64Value: "2" } },
+                                  { id: "10", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "16", listExpr: {} },
+                            loopCondition: {
+                              id: "17",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "20",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "18", identExpr: { name: "@result" } },
+                                  {
+                                    id: "19",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "14",
+                                          callExpr: {
+                                            function: "_*_",
+                                            args: [
+                                              {
+                                                id: "13",
+                                                identExpr: { name: "i" },
+                                              },
+                                              {
+                                                id: "15",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "21",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "28", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(list(int))",
+      cost: { min: "255", max: "255" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              {
+                listValue: {
+                  values: [
+                    { int64Value: "1" },
+                    { int64Value: "2" },
+                    { int64Value: "3" },
+                  ],
+                },
+              },
+              {
+                listValue: {
+                  values: [
+                    { int64Value: "2" },
+                    { int64Value: "4" },
+                    { int64Value: "6" },
+                  ],
+                },
+              },
+              {
+                listValue: {
+                  values: [
+                    { int64Value: "3" },
+                    { int64Value: "6" },
+                    { int64Value: "9" },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      runtimeCost: "255",
+      foldedAst:
+        "[\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  [\n    2~int,\n    4~int,\n    6~int\n  ]~list(int),\n  [\n    3~int,\n    6~int,\n    9~int\n  ]~list(int)\n]~list(list(int))",
+      folded: "[[1, 2, 3], [2, 4, 6], [3, 6, 9]]",
+      expectedFolded: "[[1, 2, 3], [2, 4, 6], [3, 6, 9]]",
+    },
+    {
+      original: {
+        expr: "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j).filter(k, k % 2 == 0))",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        __comprehension__(\n          // Variable\n          j,\n          // Target\n          [\n            1^#*expr.Constant_Int64Value#,\n            2^#*expr.Constant_Int64Value#,\n            3^#*expr.Constant_Int64Value#\n          ]^#*expr.Expr_ListExpr#,\n          // Accumulator\n          @result,\n          // Init\n          []^#*expr.Expr_ListExpr#,\n          // LoopCondition\n          true^#*expr.Constant_BoolValue#,\n          // LoopStep\n          _+_(\n            @result^#*expr.Expr_IdentExpr#,\n            [\n              _*_(\n                i^#*expr.Expr_IdentExpr#,\n                j^#*expr.Expr_IdentExpr#\n              )^#*expr.Expr_CallExpr#\n            ]^#*expr.Expr_ListExpr#\n          )^#*expr.Expr_CallExpr#,\n          // Result\n          @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _?_:_(\n          _==_(\n            _%_(\n              k^#*expr.Expr_IdentExpr#,\n              2^#*expr.Constant_Int64Value#\n            )^#*expr.Expr_CallExpr#,\n            0^#*expr.Constant_Int64Value#\n          )^#*expr.Expr_CallExpr#,\n          _+_(\n            @result^#*expr.Expr_IdentExpr#,\n            [\n              k^#*expr.Expr_IdentExpr#\n            ]^#*expr.Expr_ListExpr#\n          )^#*expr.Expr_CallExpr#,\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j).filter(k, k % 2 == 0))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#39[1,13]#,\n  // LoopCondition\n  true^#40[1,13]#,\n  // LoopStep\n  _+_(\n    @result^#41[1,13]#,\n    [\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        __comprehension__(\n          // Variable\n          j,\n          // Target\n          [\n            1^#8[1,18]#,\n            2^#9[1,21]#,\n            3^#10[1,24]#\n          ]^#7[1,17]#,\n          // Accumulator\n          @result,\n          // Init\n          []^#16[1,30]#,\n          // LoopCondition\n          true^#17[1,30]#,\n          // LoopStep\n          _+_(\n            @result^#18[1,30]#,\n            [\n              _*_(\n                i^#13[1,34]#,\n                j^#15[1,38]#\n              )^#14[1,36]#\n            ]^#19[1,30]#\n          )^#20[1,30]#,\n          // Result\n          @result^#21[1,30]#)^#22[1,30]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#30[1,47]#,\n        // LoopCondition\n        true^#31[1,47]#,\n        // LoopStep\n        _?_:_(\n          _==_(\n            _%_(\n              k^#25[1,51]#,\n              2^#27[1,55]#\n            )^#26[1,53]#,\n            0^#29[1,60]#\n          )^#28[1,57]#,\n          _+_(\n            @result^#32[1,47]#,\n            [\n              k^#24[1,48]#\n            ]^#33[1,47]#\n          )^#34[1,47]#,\n          @result^#35[1,47]#\n        )^#36[1,47]#,\n        // Result\n        @result^#37[1,47]#)^#38[1,47]#\n    ]^#42[1,13]#\n  )^#43[1,13]#,\n  // Result\n  @result^#44[1,13]#)^#45[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 18, 19, 1, 18, 1, 19],
+        [9, 21, 22, 1, 21, 1, 22],
+        [10, 24, 25, 1, 24, 1, 25],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 34, 35, 1, 34, 1, 35],
+        [14, 36, 37, 1, 36, 1, 37],
+        [15, 38, 39, 1, 38, 1, 39],
+        [16, 30, 30, 1, 30, 1, 30],
+        [17, 30, 30, 1, 30, 1, 30],
+        [18, 30, 30, 1, 30, 1, 30],
+        [19, 30, 30, 1, 30, 1, 30],
+        [20, 30, 30, 1, 30, 1, 30],
+        [21, 30, 30, 1, 30, 1, 30],
+        [22, 30, 30, 1, 30, 1, 30],
+        [24, 48, 49, 1, 48, 1, 49],
+        [25, 51, 52, 1, 51, 1, 52],
+        [26, 53, 54, 1, 53, 1, 54],
+        [27, 55, 56, 1, 55, 1, 56],
+        [28, 57, 59, 1, 57, 1, 59],
+        [29, 60, 61, 1, 60, 1, 61],
+        [30, 47, 47, 1, 47, 1, 47],
+        [31, 47, 47, 1, 47, 1, 47],
+        [32, 47, 47, 1, 47, 1, 47],
+        [33, 47, 47, 1, 47, 1, 47],
+        [34, 47, 47, 1, 47, 1, 47],
+        [35, 47, 47, 1, 47, 1, 47],
+        [36, 47, 47, 1, 47, 1, 47],
+        [37, 47, 47, 1, 47, 1, 47],
+        [38, 47, 47, 1, 47, 1, 47],
+        [39, 13, 13, 1, 13, 1, 13],
+        [40, 13, 13, 1, 13, 1, 13],
+        [41, 13, 13, 1, 13, 1, 13],
+        [42, 13, 13, 1, 13, 1, 13],
+        [43, 13, 13, 1, 13, 1, 13],
+        [44, 13, 13, 1, 13, 1, 13],
+        [45, 13, 13, 1, 13, 1, 13],
+      ],
+      lineOffsets: [64],
+      parsedExpr: {
+        expr: {
+          id: "45",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "39", listExpr: {} },
+            loopCondition: { id: "40", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "43",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "41", identExpr: { name: "@result" } },
+                  {
+                    id: "42",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "38",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "22",
+                              comprehensionExpr: {
+                                iterVar: "j",
+                                iterRange: {
+                                  id: "7",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "8",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                      {
+                                        id: "9",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "10",
+                                        constExpr: { int64Value: "3" },
+                                      },
+                                    ],
+                                  },
+                                },
+                                accuVar: "@result",
+                                accuInit: { id: "16", listExpr: {} },
+                                loopCondition: {
+                                  id: "17",
+                                  constExpr: { boolValue: true },
+                                },
+                                loopStep: {
+                                  id: "20",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      {
+                                        id: "18",
+                                        identExpr: { name: "@result" },
+                                      },
+                                      {
+                                        id: "19",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "14",
+                                              callExpr: {
+                                                function: "_*_",
+                                                args: [
+                                                  {
+                                                    id: "13",
+                                                    identExpr: { name: "i" },
+                                                  },
+                                                  {
+                                                    id: "15",
+                                                    identExpr: { name: "j" },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                result: {
+                                  id: "21",
+                                  identExpr: { name: "@result" },
+                                },
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "30", listExpr: {} },
+                            loopCondition: {
+                              id: "31",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "36",
+                              callExpr: {
+                                function: "_?_:_",
+                                args: [
+                                  {
+                                    id: "28",
+                                    callExpr: {
+                                      function: "_==_",
+                                      args: [
+                                        {
+                                          id: "26",
+                                          callExpr: {
+                                            function: "_%_",
+                                            args: [
+                                              {
+                                                id: "25",
+                                                identExpr: { name: "k" },
+                                              },
+                                              {
+                                                id: "27",
+                                                constExpr: { int64Value: "2" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        {
+                                          id: "29",
+                                          constExpr: { int64Value: "0" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  {
+                                    id: "34",
+                                    callExpr: {
+                                      function: "_+_",
+                                      args: [
+                                        {
+                                          id: "32",
+                                          identExpr: { name: "@result" },
+                                        },
+                                        {
+                                          id: "33",
+                                          listExpr: {
+                                            elements: [
+                                              {
+                                                id: "24",
+                                                identExpr: { name: "k" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  { id: "35", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "37",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "44", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 18,
+            "9": 21,
+            "10": 24,
+            "12": 31,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+            "16": 30,
+            "17": 30,
+            "18": 30,
+            "19": 30,
+            "20": 30,
+            "21": 30,
+            "22": 30,
+            "24": 48,
+            "25": 51,
+            "26": 53,
+            "27": 55,
+            "28": 57,
+            "29": 60,
+            "30": 47,
+            "31": 47,
+            "32": 47,
+            "33": 47,
+            "34": 47,
+            "35": 47,
+            "36": 47,
+            "37": 47,
+            "38": 47,
+            "39": 13,
+            "40": 13,
+            "41": 13,
+            "42": 13,
+            "43": 13,
+            "44": 13,
+            "45": 13,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "7",
+                  listExpr: {
+                    elements: [
+                      { id: "8", constExpr: { int64Value: "1" } },
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "12", identExpr: { name: "j" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "13", identExpr: { name: "i" } },
+                        { id: "15", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: { id: "22" },
+                function: "filter",
+                args: [
+                  { id: "24", identExpr: { name: "k" } },
+                  {
+                    id: "28",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "26",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "25", identExpr: { name: "k" } },
+                              { id: "27", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "29", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "45": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "6", identExpr: { name: "i" } }, { id: "38" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 22,
+          function: "map",
+          target: {
+            id: "7",
+            listExpr: {
+              elements: [
+                { id: "8", constExpr: { int64Value: "1" } },
+                { id: "9", constExpr: { int64Value: "2" } },
+                { id: "10", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "12", identExpr: { name: "j" } },
+            {
+              id: "14",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "13", identExpr: { name: "i" } },
+                  { id: "15", identExpr: { name: "j" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 38,
+          function: "filter",
+          target: { id: "22" },
+          args: [
+            { id: "24", identExpr: { name: "k" } },
+            {
+              id: "28",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "26",
+                    callExpr: {
+                      function: "_%_",
+                      args: [
+                        { id: "25", identExpr: { name: "k" } },
+                        { id: "27", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "29", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 45,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [{ id: "6", identExpr: { name: "i" } }, { id: "38" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(list(int))^@result,\n    [\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        __comprehension__(\n          // Variable\n          j,\n          // Target\n          [\n            1~int,\n            2~int,\n            3~int\n          ]~list(int),\n          // Accumulator\n          @result,\n          // Init\n          []~list(int),\n          // LoopCondition\n          true~bool,\n          // LoopStep\n          _+_(\n            @result~list(int)^@result,\n            [\n              _*_(\n                i~int^i,\n                j~int^j\n              )~int^multiply_int64\n            ]~list(int)\n          )~list(int)^add_list,\n          // Result\n          @result~list(int)^@result)~list(int),\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _?_:_(\n          _==_(\n            _%_(\n              k~int^k,\n              2~int\n            )~int^modulo_int64,\n            0~int\n          )~bool^equals,\n          _+_(\n            @result~list(int)^@result,\n            [\n              k~int^k\n            ]~list(int)\n          )~list(int)^add_list,\n          @result~list(int)^@result\n        )~list(int)^conditional,\n        // Result\n        @result~list(int)^@result)~list(int)\n    ]~list(list(int))\n  )~list(list(int))^add_list,\n  // Result\n  @result~list(list(int))^@result)~list(list(int))",
+      checkedExpr: {
+        referenceMap: {
+          "13": { name: "i" },
+          "14": { overloadId: ["multiply_int64"] },
+          "15": { name: "j" },
+          "18": { name: "@result" },
+          "20": { overloadId: ["add_list"] },
+          "21": { name: "@result" },
+          "24": { name: "k" },
+          "25": { name: "k" },
+          "26": { overloadId: ["modulo_int64"] },
+          "28": { overloadId: ["equals"] },
+          "32": { name: "@result" },
+          "34": { overloadId: ["add_list"] },
+          "35": { name: "@result" },
+          "36": { overloadId: ["conditional"] },
+          "37": { name: "@result" },
+          "41": { name: "@result" },
+          "43": { overloadId: ["add_list"] },
+          "44": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "INT64" },
+          "26": { primitive: "INT64" },
+          "27": { primitive: "INT64" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "INT64" },
+          "30": { listType: { elemType: { primitive: "INT64" } } },
+          "31": { primitive: "BOOL" },
+          "32": { listType: { elemType: { primitive: "INT64" } } },
+          "33": { listType: { elemType: { primitive: "INT64" } } },
+          "34": { listType: { elemType: { primitive: "INT64" } } },
+          "35": { listType: { elemType: { primitive: "INT64" } } },
+          "36": { listType: { elemType: { primitive: "INT64" } } },
+          "37": { listType: { elemType: { primitive: "INT64" } } },
+          "38": { listType: { elemType: { primitive: "INT64" } } },
+          "39": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "40": { primitive: "BOOL" },
+          "41": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "42": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "43": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "44": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "45": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 18,
+            "9": 21,
+            "10": 24,
+            "12": 31,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+            "16": 30,
+            "17": 30,
+            "18": 30,
+            "19": 30,
+            "20": 30,
+            "21": 30,
+            "22": 30,
+            "24": 48,
+            "25": 51,
+            "26": 53,
+            "27": 55,
+            "28": 57,
+            "29": 60,
+            "30": 47,
+            "31": 47,
+            "32": 47,
+            "33": 47,
+            "34": 47,
+            "35": 47,
+            "36": 47,
+            "37": 47,
+            "38": 47,
+            "39": 13,
+            "40": 13,
+            "41": 13,
+            "42": 13,
+            "43": 13,
+            "44": 13,
+            "45": 13,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "7",
+                  listExpr: {
+                    elements: [
+                      { id: "8", constExpr: { int64Value: "1" } },
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "12", identExpr: { name: "j" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "13", identExpr: { name: "i" } },
+                        { id: "15", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: { id: "22" },
+                function: "filter",
+                args: [
+                  { id: "24", identExpr: { name: "k" } },
+                  {
+                    id: "28",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "26",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "25", identExpr: { name: "k" } },
+                              { id: "27", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "29", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "45": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "6", identExpr: { name: "i" } }, { id: "38" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "45",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "39", listExpr: {} },
+            loopCondition: { id: "40", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "43",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "41", identExpr: { name: "@result" } },
+                  {
+                    id: "42",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "38",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "22",
+                              comprehensionExpr: {
+                                iterVar: "j",
+                                iterRange: {
+                                  id: "7",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "8",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                      {
+                                        id: "9",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "10",
+                                        constExpr: { int64Value: "3" },
+                                      },
+                                    ],
+                                  },
+                                },
+                                accuVar: "@result",
+                                accuInit: { id: "16", listExpr: {} },
+                                loopCondition: {
+                                  id: "17",
+                                  constExpr: { boolValue: true },
+                                },
+                                loopStep: {
+                                  id: "20",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      {
+                                        id: "18",
+                                        identExpr: { name: "@result" },
+                                      },
+                                      {
+                                        id: "19",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "14",
+                                              callExpr: {
+                                                function: "_*_",
+                                                args: [
+                                                  {
+                                                    id: "13",
+                                                    identExpr: { name: "i" },
+                                                  },
+                                                  {
+                                                    id: "15",
+                                                    identExpr: { name: "j" },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                result: {
+                                  id: "21",
+                                  identExpr: { name: "@result" },
+                                },
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "30", listExpr: {} },
+                            loopCondition: {
+                              id: "31",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "36",
+                              callExpr: {
+                                function: "_?_:_",
+                                args: [
+                                  {
+                                    id: "28",
+                                    callExpr: {
+                                      function: "_==_",
+                                      args: [
+                                        {
+                                          id: "26",
+                                          callExpr: {
+                                            function: "_%_",
+                                            args: [
+                                              {
+                                                id: "25",
+                                                identExpr: { name: "k" },
+                                              },
+                                              {
+                                                id: "27",
+                                                constExpr: { int64Value: "2" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        {
+                                          id: "29",
+                                          constExpr: { int64Value: "0" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  {
+                                    id: "34",
+                                    callExpr: {
+                                      function: "_+_",
+                                      args: [
+                                        {
+                                          id: "32",
+                                          identExpr: { name: "@result" },
+                                        },
+                                        {
+                                          id: "33",
+                                          listExpr: {
+                                            elements: [
+                                              {
+                                                id: "24",
+                                                identExpr: { name: "k" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  { id: "35", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "37",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "44", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(list(int))",
+      cost: { min: "324", max: "432" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { listValue: { values: [{ int64Value: "2" }] } },
+              {
+                listValue: {
+                  values: [
+                    { int64Value: "2" },
+                    { int64Value: "4" },
+                    { int64Value: "6" },
+                  ],
+                },
+              },
+              { listValue: { values: [{ int64Value: "6" }] } },
+            ],
+          },
+        },
+      },
+      runtimeCost: "380",
+      foldedAst:
+        "[\n  [\n    2~int\n  ]~list(int),\n  [\n    2~int,\n    4~int,\n    6~int\n  ]~list(int),\n  [\n    6~int\n  ]~list(int)\n]~list(list(int))",
+      folded: "[[2], [2, 4, 6], [6]]",
+      expectedFolded: "[[2], [2, 4, 6], [6]]",
+    },
+    {
+      original: {
+        expr: "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j).filter(k, k % 2 == x))",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        __comprehension__(\n          // Variable\n          j,\n          // Target\n          [\n            1^#*expr.Constant_Int64Value#,\n            2^#*expr.Constant_Int64Value#,\n            3^#*expr.Constant_Int64Value#\n          ]^#*expr.Expr_ListExpr#,\n          // Accumulator\n          @result,\n          // Init\n          []^#*expr.Expr_ListExpr#,\n          // LoopCondition\n          true^#*expr.Constant_BoolValue#,\n          // LoopStep\n          _+_(\n            @result^#*expr.Expr_IdentExpr#,\n            [\n              _*_(\n                i^#*expr.Expr_IdentExpr#,\n                j^#*expr.Expr_IdentExpr#\n              )^#*expr.Expr_CallExpr#\n            ]^#*expr.Expr_ListExpr#\n          )^#*expr.Expr_CallExpr#,\n          // Result\n          @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _?_:_(\n          _==_(\n            _%_(\n              k^#*expr.Expr_IdentExpr#,\n              2^#*expr.Constant_Int64Value#\n            )^#*expr.Expr_CallExpr#,\n            x^#*expr.Expr_IdentExpr#\n          )^#*expr.Expr_CallExpr#,\n          _+_(\n            @result^#*expr.Expr_IdentExpr#,\n            [\n              k^#*expr.Expr_IdentExpr#\n            ]^#*expr.Expr_ListExpr#\n          )^#*expr.Expr_CallExpr#,\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j).filter(k, k % 2 == x))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#39[1,13]#,\n  // LoopCondition\n  true^#40[1,13]#,\n  // LoopStep\n  _+_(\n    @result^#41[1,13]#,\n    [\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        __comprehension__(\n          // Variable\n          j,\n          // Target\n          [\n            1^#8[1,18]#,\n            2^#9[1,21]#,\n            3^#10[1,24]#\n          ]^#7[1,17]#,\n          // Accumulator\n          @result,\n          // Init\n          []^#16[1,30]#,\n          // LoopCondition\n          true^#17[1,30]#,\n          // LoopStep\n          _+_(\n            @result^#18[1,30]#,\n            [\n              _*_(\n                i^#13[1,34]#,\n                j^#15[1,38]#\n              )^#14[1,36]#\n            ]^#19[1,30]#\n          )^#20[1,30]#,\n          // Result\n          @result^#21[1,30]#)^#22[1,30]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#30[1,47]#,\n        // LoopCondition\n        true^#31[1,47]#,\n        // LoopStep\n        _?_:_(\n          _==_(\n            _%_(\n              k^#25[1,51]#,\n              2^#27[1,55]#\n            )^#26[1,53]#,\n            x^#29[1,60]#\n          )^#28[1,57]#,\n          _+_(\n            @result^#32[1,47]#,\n            [\n              k^#24[1,48]#\n            ]^#33[1,47]#\n          )^#34[1,47]#,\n          @result^#35[1,47]#\n        )^#36[1,47]#,\n        // Result\n        @result^#37[1,47]#)^#38[1,47]#\n    ]^#42[1,13]#\n  )^#43[1,13]#,\n  // Result\n  @result^#44[1,13]#)^#45[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 18, 19, 1, 18, 1, 19],
+        [9, 21, 22, 1, 21, 1, 22],
+        [10, 24, 25, 1, 24, 1, 25],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 34, 35, 1, 34, 1, 35],
+        [14, 36, 37, 1, 36, 1, 37],
+        [15, 38, 39, 1, 38, 1, 39],
+        [16, 30, 30, 1, 30, 1, 30],
+        [17, 30, 30, 1, 30, 1, 30],
+        [18, 30, 30, 1, 30, 1, 30],
+        [19, 30, 30, 1, 30, 1, 30],
+        [20, 30, 30, 1, 30, 1, 30],
+        [21, 30, 30, 1, 30, 1, 30],
+        [22, 30, 30, 1, 30, 1, 30],
+        [24, 48, 49, 1, 48, 1, 49],
+        [25, 51, 52, 1, 51, 1, 52],
+        [26, 53, 54, 1, 53, 1, 54],
+        [27, 55, 56, 1, 55, 1, 56],
+        [28, 57, 59, 1, 57, 1, 59],
+        [29, 60, 61, 1, 60, 1, 61],
+        [30, 47, 47, 1, 47, 1, 47],
+        [31, 47, 47, 1, 47, 1, 47],
+        [32, 47, 47, 1, 47, 1, 47],
+        [33, 47, 47, 1, 47, 1, 47],
+        [34, 47, 47, 1, 47, 1, 47],
+        [35, 47, 47, 1, 47, 1, 47],
+        [36, 47, 47, 1, 47, 1, 47],
+        [37, 47, 47, 1, 47, 1, 47],
+        [38, 47, 47, 1, 47, 1, 47],
+        [39, 13, 13, 1, 13, 1, 13],
+        [40, 13, 13, 1, 13, 1, 13],
+        [41, 13, 13, 1, 13, 1, 13],
+        [42, 13, 13, 1, 13, 1, 13],
+        [43, 13, 13, 1, 13, 1, 13],
+        [44, 13, 13, 1, 13, 1, 13],
+        [45, 13, 13, 1, 13, 1, 13],
+      ],
+      lineOffsets: [64],
+      parsedExpr: {
+        expr: {
+          id: "45",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "39", listExpr: {} },
+            loopCondition: { id: "40", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "43",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "41", identExpr: { name: "@result" } },
+                  {
+                    id: "42",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "38",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "22",
+                              comprehensionExpr: {
+                                iterVar: "j",
+                                iterRange: {
+                                  id: "7",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "8",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                      {
+                                        id: "9",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "10",
+                                        constExpr: { int64Value: "3" },
+                                      },
+                                    ],
+                                  },
+                                },
+                                accuVar: "@result",
+                                accuInit: { id: "16", listExpr: {} },
+                                loopCondition: {
+                                  id: "17",
+                                  constExpr: { boolValue: true },
+                                },
+                                loopStep: {
+                                  id: "20",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      {
+                                        id: "18",
+                                        identExpr: { name: "@result" },
+                                      },
+                                      {
+                                        id: "19",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "14",
+                                              callExpr: {
+                                                function: "_*_",
+                                                args: [
+                                                  {
+                                                    id: "13",
+                                                    identExpr: { name: "i" },
+                                                  },
+                                                  {
+                                                    id: "15",
+                                                    identExpr: { name: "j" },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                result: {
+                                  id: "21",
+                                  identExpr: { name: "@result" },
+                                },
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "30", listExpr: {} },
+                            loopCondition: {
+                              id: "31",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "36",
+                              callExpr: {
+                                function: "_?_:_",
+                                args: [
+                                  {
+                                    id: "28",
+                                    callExpr: {
+                                      function: "_==_",
+                                      args: [
+                                        {
+                                          id: "26",
+                                          callExpr: {
+                                            function: "_%_",
+                                            args: [
+                                              {
+                                                id: "25",
+                                                identExpr: { name: "k" },
+                                              },
+                                              {
+                                                id: "27",
+                                                constExpr: { int64Value: "2" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        { id: "29", identExpr: { name: "x" } },
+                                      ],
+                                    },
+                                  },
+                                  {
+                                    id: "34",
+                                    callExpr: {
+                                      function: "_+_",
+                                      args: [
+                                        {
+                                          id: "32",
+                                          identExpr: { name: "@result" },
+                                        },
+                                        {
+                                          id: "33",
+                                          listExpr: {
+                                            elements: [
+                                              {
+                                                id: "24",
+                                                identExpr: { name: "k" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  { id: "35", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "37",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "44", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 18,
+            "9": 21,
+            "10": 24,
+            "12": 31,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+            "16": 30,
+            "17": 30,
+            "18": 30,
+            "19": 30,
+            "20": 30,
+            "21": 30,
+            "22": 30,
+            "24": 48,
+            "25": 51,
+            "26": 53,
+            "27": 55,
+            "28": 57,
+            "29": 60,
+            "30": 47,
+            "31": 47,
+            "32": 47,
+            "33": 47,
+            "34": 47,
+            "35": 47,
+            "36": 47,
+            "37": 47,
+            "38": 47,
+            "39": 13,
+            "40": 13,
+            "41": 13,
+            "42": 13,
+            "43": 13,
+            "44": 13,
+            "45": 13,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "7",
+                  listExpr: {
+                    elements: [
+                      { id: "8", constExpr: { int64Value: "1" } },
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "12", identExpr: { name: "j" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "13", identExpr: { name: "i" } },
+                        { id: "15", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: { id: "22" },
+                function: "filter",
+                args: [
+                  { id: "24", identExpr: { name: "k" } },
+                  {
+                    id: "28",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "26",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "25", identExpr: { name: "k" } },
+                              { id: "27", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "29", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "45": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "6", identExpr: { name: "i" } }, { id: "38" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 22,
+          function: "map",
+          target: {
+            id: "7",
+            listExpr: {
+              elements: [
+                { id: "8", constExpr: { int64Value: "1" } },
+                { id: "9", constExpr: { int64Value: "2" } },
+                { id: "10", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "12", identExpr: { name: "j" } },
+            {
+              id: "14",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "13", identExpr: { name: "i" } },
+                  { id: "15", identExpr: { name: "j" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 38,
+          function: "filter",
+          target: { id: "22" },
+          args: [
+            { id: "24", identExpr: { name: "k" } },
+            {
+              id: "28",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "26",
+                    callExpr: {
+                      function: "_%_",
+                      args: [
+                        { id: "25", identExpr: { name: "k" } },
+                        { id: "27", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "29", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 45,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [{ id: "6", identExpr: { name: "i" } }, { id: "38" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(list(int))^@result,\n    [\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        __comprehension__(\n          // Variable\n          j,\n          // Target\n          [\n            1~int,\n            2~int,\n            3~int\n          ]~list(int),\n          // Accumulator\n          @result,\n          // Init\n          []~list(int),\n          // LoopCondition\n          true~bool,\n          // LoopStep\n          _+_(\n            @result~list(int)^@result,\n            [\n              _*_(\n                i~int^i,\n                j~int^j\n              )~int^multiply_int64\n            ]~list(int)\n          )~list(int)^add_list,\n          // Result\n          @result~list(int)^@result)~list(int),\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _?_:_(\n          _==_(\n            _%_(\n              k~int^k,\n              2~int\n            )~int^modulo_int64,\n            x~dyn^x\n          )~bool^equals,\n          _+_(\n            @result~list(int)^@result,\n            [\n              k~int^k\n            ]~list(int)\n          )~list(int)^add_list,\n          @result~list(int)^@result\n        )~list(int)^conditional,\n        // Result\n        @result~list(int)^@result)~list(int)\n    ]~list(list(int))\n  )~list(list(int))^add_list,\n  // Result\n  @result~list(list(int))^@result)~list(list(int))",
+      checkedExpr: {
+        referenceMap: {
+          "13": { name: "i" },
+          "14": { overloadId: ["multiply_int64"] },
+          "15": { name: "j" },
+          "18": { name: "@result" },
+          "20": { overloadId: ["add_list"] },
+          "21": { name: "@result" },
+          "24": { name: "k" },
+          "25": { name: "k" },
+          "26": { overloadId: ["modulo_int64"] },
+          "28": { overloadId: ["equals"] },
+          "29": { name: "x" },
+          "32": { name: "@result" },
+          "34": { overloadId: ["add_list"] },
+          "35": { name: "@result" },
+          "36": { overloadId: ["conditional"] },
+          "37": { name: "@result" },
+          "41": { name: "@result" },
+          "43": { overloadId: ["add_list"] },
+          "44": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "INT64" },
+          "26": { primitive: "INT64" },
+          "27": { primitive: "INT64" },
+          "28": { primitive: "BOOL" },
+          "29": { dyn: {} },
+          "30": { listType: { elemType: { primitive: "INT64" } } },
+          "31": { primitive: "BOOL" },
+          "32": { listType: { elemType: { primitive: "INT64" } } },
+          "33": { listType: { elemType: { primitive: "INT64" } } },
+          "34": { listType: { elemType: { primitive: "INT64" } } },
+          "35": { listType: { elemType: { primitive: "INT64" } } },
+          "36": { listType: { elemType: { primitive: "INT64" } } },
+          "37": { listType: { elemType: { primitive: "INT64" } } },
+          "38": { listType: { elemType: { primitive: "INT64" } } },
+          "39": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "40": { primitive: "BOOL" },
+          "41": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "42": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "43": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "44": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "45": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 18,
+            "9": 21,
+            "10": 24,
+            "12": 31,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+            "16": 30,
+            "17": 30,
+            "18": 30,
+            "19": 30,
+            "20": 30,
+            "21": 30,
+            "22": 30,
+            "24": 48,
+            "25": 51,
+            "26": 53,
+            "27": 55,
+            "28": 57,
+            "29": 60,
+            "30": 47,
+            "31": 47,
+            "32": 47,
+            "33": 47,
+            "34": 47,
+            "35": 47,
+            "36": 47,
+            "37": 47,
+            "38": 47,
+            "39": 13,
+            "40": 13,
+            "41": 13,
+            "42": 13,
+            "43": 13,
+            "44": 13,
+            "45": 13,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "7",
+                  listExpr: {
+                    elements: [
+                      { id: "8", constExpr: { int64Value: "1" } },
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "12", identExpr: { name: "j" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "13", identExpr: { name: "i" } },
+                        { id: "15", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: { id: "22" },
+                function: "filter",
+                args: [
+                  { id: "24", identExpr: { name: "k" } },
+                  {
+                    id: "28",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "26",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "25", identExpr: { name: "k" } },
+                              { id: "27", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "29", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "45": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "6", identExpr: { name: "i" } }, { id: "38" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "45",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "39", listExpr: {} },
+            loopCondition: { id: "40", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "43",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "41", identExpr: { name: "@result" } },
+                  {
+                    id: "42",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "38",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "22",
+                              comprehensionExpr: {
+                                iterVar: "j",
+                                iterRange: {
+                                  id: "7",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "8",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                      {
+                                        id: "9",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "10",
+                                        constExpr: { int64Value: "3" },
+                                      },
+                                    ],
+                                  },
+                                },
+                                accuVar: "@result",
+                                accuInit: { id: "16", listExpr: {} },
+                                loopCondition: {
+                                  id: "17",
+                                  constExpr: { boolValue: true },
+                                },
+                                loopStep: {
+                                  id: "20",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      {
+                                        id: "18",
+                                        identExpr: { name: "@result" },
+                                      },
+                                      {
+                                        id: "19",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "14",
+                                              callExpr: {
+                                                function: "_*_",
+                                                args: [
+                                                  {
+                                                    id: "13",
+                                                    identExpr: { name: "i" },
+                                                  },
+                                                  {
+                                                    id: "15",
+                                                    identExpr: { name: "j" },
+                                                  },
+                                                ],
+                                              },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                                result: {
+                                  id: "21",
+                                  identExpr: { name: "@result" },
+                                },
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "30", listExpr: {} },
+                            loopCondition: {
+                              id: "31",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "36",
+                              callExpr: {
+                                function: "_?_:_",
+                                args: [
+                                  {
+                                    id: "28",
+                                    callExpr: {
+                                      function: "_==_",
+                                      args: [
+                                        {
+                                          id: "26",
+                                          callExpr: {
+                                            function: "_%_",
+                                            args: [
+                                              {
+                                                id: "25",
+                                                identExpr: { name: "k" },
+                                              },
+                                              {
+                                                id: "27",
+                                                constExpr: { int64Value: "2" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        { id: "29", identExpr: { name: "x" } },
+                                      ],
+                                    },
+                                  },
+                                  {
+                                    id: "34",
+                                    callExpr: {
+                                      function: "_+_",
+                                      args: [
+                                        {
+                                          id: "32",
+                                          identExpr: { name: "@result" },
+                                        },
+                                        {
+                                          id: "33",
+                                          listExpr: {
+                                            elements: [
+                                              {
+                                                id: "24",
+                                                identExpr: { name: "k" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  { id: "35", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "37",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "44", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(list(int))",
+      cost: { min: "333", max: "441" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "294",
+      foldedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(list(int))^@result,\n    [\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        __comprehension__(\n          // Variable\n          j,\n          // Target\n          [\n            1~int,\n            2~int,\n            3~int\n          ]~list(int),\n          // Accumulator\n          @result,\n          // Init\n          []~list(int),\n          // LoopCondition\n          true~bool,\n          // LoopStep\n          _+_(\n            @result~list(int)^@result,\n            [\n              _*_(\n                i~int^i,\n                j~int^j\n              )~int^multiply_int64\n            ]~list(int)\n          )~list(int)^add_list,\n          // Result\n          @result~list(int)^@result)~list(int),\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _?_:_(\n          _==_(\n            _%_(\n              k~int^k,\n              2~int\n            )~int^modulo_int64,\n            x~dyn^x\n          )~bool^equals,\n          _+_(\n            @result~list(int)^@result,\n            [\n              k~int^k\n            ]~list(int)\n          )~list(int)^add_list,\n          @result~list(int)^@result\n        )~list(int)^conditional,\n        // Result\n        @result~list(int)^@result)~list(int)\n    ]~list(list(int))\n  )~list(list(int))^add_list,\n  // Result\n  @result~list(list(int))^@result)~list(list(int))",
+      folded: "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j).filter(k, k % 2 == x))",
+      expectedFolded:
+        "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j).filter(k, k % 2 == x))",
+    },
+    {
+      original: {
+        expr: '[{}, {"a": 1}, {"b": 2}].filter(m, has(m.a))',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '__comprehension__(\n  // Variable\n  m,\n  // Target\n  [\n    {}^#*expr.Expr_StructExpr#,\n    {\n      "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "b"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    m^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        m^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: '[{}, {"a": 1}, {"b": 2}].filter(m, has(m.a))',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  m,\n  // Target\n  [\n    {}^#2[1,1]#,\n    {\n      "a"^#5[1,6]#:1^#6[1,11]#^#4[1,9]#\n    }^#3[1,5]#,\n    {\n      "b"^#9[1,16]#:2^#10[1,21]#^#8[1,19]#\n    }^#7[1,15]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#17[1,31]#,\n  // LoopCondition\n  true^#18[1,31]#,\n  // LoopStep\n  _?_:_(\n    m^#14[1,39]#.a~test-only~^#16[1,38]#,\n    _+_(\n      @result^#19[1,31]#,\n      [\n        m^#12[1,32]#\n      ]^#20[1,31]#\n    )^#21[1,31]#,\n    @result^#22[1,31]#\n  )^#23[1,31]#,\n  // Result\n  @result^#24[1,31]#)^#25[1,31]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 6, 9, 1, 6, 1, 9],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 16, 19, 1, 16, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [12, 32, 33, 1, 32, 1, 33],
+        [14, 39, 40, 1, 39, 1, 40],
+        [15, 40, 41, 1, 40, 1, 41],
+        [16, 38, 38, 1, 38, 1, 38],
+        [17, 31, 31, 1, 31, 1, 31],
+        [18, 31, 31, 1, 31, 1, 31],
+        [19, 31, 31, 1, 31, 1, 31],
+        [20, 31, 31, 1, 31, 1, 31],
+        [21, 31, 31, 1, 31, 1, 31],
+        [22, 31, 31, 1, 31, 1, 31],
+        [23, 31, 31, 1, 31, 1, 31],
+        [24, 31, 31, 1, 31, 1, 31],
+        [25, 31, 31, 1, 31, 1, 31],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "25",
+          comprehensionExpr: {
+            iterVar: "m",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", structExpr: {} },
+                  {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "8",
+                          mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                          value: { id: "10", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "17", listExpr: {} },
+            loopCondition: { id: "18", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "23",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "16",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "m" } },
+                      field: "a",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "21",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "19", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          listExpr: {
+                            elements: [{ id: "12", identExpr: { name: "m" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "22", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "24", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 9,
+            "5": 6,
+            "6": 11,
+            "7": 15,
+            "8": 19,
+            "9": 16,
+            "10": 21,
+            "12": 32,
+            "14": 39,
+            "15": 40,
+            "16": 38,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 31,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "15",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "m" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "25": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", structExpr: {} },
+                      {
+                        id: "3",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "4",
+                              mapKey: {
+                                id: "5",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "6",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "8",
+                              mapKey: {
+                                id: "9",
+                                constExpr: { stringValue: "b" },
+                              },
+                              value: {
+                                id: "10",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [{ id: "12", identExpr: { name: "m" } }, { id: "16" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "has",
+          args: [
+            {
+              id: "15",
+              selectExpr: {
+                operand: { id: "14", identExpr: { name: "m" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 25,
+          function: "filter",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", structExpr: {} },
+                {
+                  id: "3",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "4",
+                        mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                        value: { id: "6", constExpr: { int64Value: "1" } },
+                      },
+                    ],
+                  },
+                },
+                {
+                  id: "7",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "8",
+                        mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                        value: { id: "10", constExpr: { int64Value: "2" } },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [{ id: "12", identExpr: { name: "m" } }, { id: "16" }],
+        },
+      ],
+      checkedAst:
+        '__comprehension__(\n  // Variable\n  m,\n  // Target\n  [\n    {}~map(string, int),\n    {\n      "a"~string:1~int\n    }~map(string, int),\n    {\n      "b"~string:2~int\n    }~map(string, int)\n  ]~list(map(string, int)),\n  // Accumulator\n  @result,\n  // Init\n  []~list(map(string, int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    m~map(string, int)^m.a~test-only~~bool,\n    _+_(\n      @result~list(map(string, int))^@result,\n      [\n        m~map(string, int)^m\n      ]~list(map(string, int))\n    )~list(map(string, int))^add_list,\n    @result~list(map(string, int))^@result\n  )~list(map(string, int))^conditional,\n  // Result\n  @result~list(map(string, int))^@result)~list(map(string, int))',
+      checkedExpr: {
+        referenceMap: {
+          "12": { name: "m" },
+          "14": { name: "m" },
+          "19": { name: "@result" },
+          "21": { overloadId: ["add_list"] },
+          "22": { name: "@result" },
+          "23": { overloadId: ["conditional"] },
+          "24": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+          "12": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "14": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "16": { primitive: "BOOL" },
+          "17": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "18": { primitive: "BOOL" },
+          "19": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "20": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "21": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "22": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "23": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "24": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "25": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 9,
+            "5": 6,
+            "6": 11,
+            "7": 15,
+            "8": 19,
+            "9": 16,
+            "10": 21,
+            "12": 32,
+            "14": 39,
+            "15": 40,
+            "16": 38,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 31,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "15",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "m" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "25": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", structExpr: {} },
+                      {
+                        id: "3",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "4",
+                              mapKey: {
+                                id: "5",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "6",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "8",
+                              mapKey: {
+                                id: "9",
+                                constExpr: { stringValue: "b" },
+                              },
+                              value: {
+                                id: "10",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [{ id: "12", identExpr: { name: "m" } }, { id: "16" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "25",
+          comprehensionExpr: {
+            iterVar: "m",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", structExpr: {} },
+                  {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "8",
+                          mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                          value: { id: "10", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "17", listExpr: {} },
+            loopCondition: { id: "18", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "23",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "16",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "m" } },
+                      field: "a",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "21",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "19", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          listExpr: {
+                            elements: [{ id: "12", identExpr: { name: "m" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "22", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "24", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(map(string, int))",
+      cost: { min: "120", max: "156" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              {
+                mapValue: {
+                  entries: [
+                    { key: { stringValue: "a" }, value: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      runtimeCost: "130",
+      foldedAst:
+        '[\n  {\n    "a"~string:1~int\n  }~map(string, int)\n]~list(map(string, int))',
+      folded: '[{"a": 1}]',
+      expectedFolded: '[{"a": 1}]',
+    },
+    {
+      original: {
+        expr: '[{}, {"a": 1}, {"b": 2}].filter(m, has({\'a\': true}.a))',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '__comprehension__(\n  // Variable\n  m,\n  // Target\n  [\n    {}^#*expr.Expr_StructExpr#,\n    {\n      "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "b"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    {\n      "a"^#*expr.Constant_StringValue#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        m^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: '[{}, {"a": 1}, {"b": 2}].filter(m, has({"a": true}.a))',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  m,\n  // Target\n  [\n    {}^#2[1,1]#,\n    {\n      "a"^#5[1,6]#:1^#6[1,11]#^#4[1,9]#\n    }^#3[1,5]#,\n    {\n      "b"^#9[1,16]#:2^#10[1,21]#^#8[1,19]#\n    }^#7[1,15]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#20[1,31]#,\n  // LoopCondition\n  true^#21[1,31]#,\n  // LoopStep\n  _?_:_(\n    {\n      "a"^#16[1,40]#:true^#17[1,45]#^#15[1,43]#\n    }^#14[1,39]#.a~test-only~^#19[1,38]#,\n    _+_(\n      @result^#22[1,31]#,\n      [\n        m^#12[1,32]#\n      ]^#23[1,31]#\n    )^#24[1,31]#,\n    @result^#25[1,31]#\n  )^#26[1,31]#,\n  // Result\n  @result^#27[1,31]#)^#28[1,31]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 6, 9, 1, 6, 1, 9],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 16, 19, 1, 16, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [12, 32, 33, 1, 32, 1, 33],
+        [14, 39, 40, 1, 39, 1, 40],
+        [15, 43, 44, 1, 43, 1, 44],
+        [16, 40, 43, 1, 40, 1, 43],
+        [17, 45, 49, 1, 45, 1, 49],
+        [18, 50, 51, 1, 50, 1, 51],
+        [19, 38, 38, 1, 38, 1, 38],
+        [20, 31, 31, 1, 31, 1, 31],
+        [21, 31, 31, 1, 31, 1, 31],
+        [22, 31, 31, 1, 31, 1, 31],
+        [23, 31, 31, 1, 31, 1, 31],
+        [24, 31, 31, 1, 31, 1, 31],
+        [25, 31, 31, 1, 31, 1, 31],
+        [26, 31, 31, 1, 31, 1, 31],
+        [27, 31, 31, 1, 31, 1, 31],
+        [28, 31, 31, 1, 31, 1, 31],
+      ],
+      lineOffsets: [55],
+      parsedExpr: {
+        expr: {
+          id: "28",
+          comprehensionExpr: {
+            iterVar: "m",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", structExpr: {} },
+                  {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "8",
+                          mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                          value: { id: "10", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", listExpr: {} },
+            loopCondition: { id: "21", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "26",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "19",
+                    selectExpr: {
+                      operand: {
+                        id: "14",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "15",
+                              mapKey: {
+                                id: "16",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "17",
+                                constExpr: { boolValue: true },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      field: "a",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "24",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "22", identExpr: { name: "@result" } },
+                        {
+                          id: "23",
+                          listExpr: {
+                            elements: [{ id: "12", identExpr: { name: "m" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "25", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "27", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [55],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 9,
+            "5": 6,
+            "6": 11,
+            "7": 15,
+            "8": 19,
+            "9": 16,
+            "10": 21,
+            "12": 32,
+            "14": 39,
+            "15": 43,
+            "16": 40,
+            "17": 45,
+            "18": 50,
+            "19": 38,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 31,
+            "26": 31,
+            "27": 31,
+            "28": 31,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "18",
+                    selectExpr: {
+                      operand: {
+                        id: "14",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "15",
+                              mapKey: {
+                                id: "16",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "17",
+                                constExpr: { boolValue: true },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "28": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", structExpr: {} },
+                      {
+                        id: "3",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "4",
+                              mapKey: {
+                                id: "5",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "6",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "8",
+                              mapKey: {
+                                id: "9",
+                                constExpr: { stringValue: "b" },
+                              },
+                              value: {
+                                id: "10",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [{ id: "12", identExpr: { name: "m" } }, { id: "19" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "has",
+          args: [
+            {
+              id: "18",
+              selectExpr: {
+                operand: {
+                  id: "14",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "15",
+                        mapKey: { id: "16", constExpr: { stringValue: "a" } },
+                        value: { id: "17", constExpr: { boolValue: true } },
+                      },
+                    ],
+                  },
+                },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 28,
+          function: "filter",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", structExpr: {} },
+                {
+                  id: "3",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "4",
+                        mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                        value: { id: "6", constExpr: { int64Value: "1" } },
+                      },
+                    ],
+                  },
+                },
+                {
+                  id: "7",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "8",
+                        mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                        value: { id: "10", constExpr: { int64Value: "2" } },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [{ id: "12", identExpr: { name: "m" } }, { id: "19" }],
+        },
+      ],
+      checkedAst:
+        '__comprehension__(\n  // Variable\n  m,\n  // Target\n  [\n    {}~map(string, int),\n    {\n      "a"~string:1~int\n    }~map(string, int),\n    {\n      "b"~string:2~int\n    }~map(string, int)\n  ]~list(map(string, int)),\n  // Accumulator\n  @result,\n  // Init\n  []~list(map(string, int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    {\n      "a"~string:true~bool\n    }~map(string, bool).a~test-only~~bool,\n    _+_(\n      @result~list(map(string, int))^@result,\n      [\n        m~map(string, int)^m\n      ]~list(map(string, int))\n    )~list(map(string, int))^add_list,\n    @result~list(map(string, int))^@result\n  )~list(map(string, int))^conditional,\n  // Result\n  @result~list(map(string, int))^@result)~list(map(string, int))',
+      checkedExpr: {
+        referenceMap: {
+          "12": { name: "m" },
+          "22": { name: "@result" },
+          "24": { overloadId: ["add_list"] },
+          "25": { name: "@result" },
+          "26": { overloadId: ["conditional"] },
+          "27": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+          "12": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "14": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "BOOL" },
+            },
+          },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "21": { primitive: "BOOL" },
+          "22": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "23": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "24": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "25": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "26": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "27": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "28": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [55],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 9,
+            "5": 6,
+            "6": 11,
+            "7": 15,
+            "8": 19,
+            "9": 16,
+            "10": 21,
+            "12": 32,
+            "14": 39,
+            "15": 43,
+            "16": 40,
+            "17": 45,
+            "18": 50,
+            "19": 38,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 31,
+            "26": 31,
+            "27": 31,
+            "28": 31,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "18",
+                    selectExpr: {
+                      operand: {
+                        id: "14",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "15",
+                              mapKey: {
+                                id: "16",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "17",
+                                constExpr: { boolValue: true },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "28": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", structExpr: {} },
+                      {
+                        id: "3",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "4",
+                              mapKey: {
+                                id: "5",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "6",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "8",
+                              mapKey: {
+                                id: "9",
+                                constExpr: { stringValue: "b" },
+                              },
+                              value: {
+                                id: "10",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [{ id: "12", identExpr: { name: "m" } }, { id: "19" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "28",
+          comprehensionExpr: {
+            iterVar: "m",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", structExpr: {} },
+                  {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "8",
+                          mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                          value: { id: "10", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", listExpr: {} },
+            loopCondition: { id: "21", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "26",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "19",
+                    selectExpr: {
+                      operand: {
+                        id: "14",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "15",
+                              mapKey: {
+                                id: "16",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "17",
+                                constExpr: { boolValue: true },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      field: "a",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "24",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "22", identExpr: { name: "@result" } },
+                        {
+                          id: "23",
+                          listExpr: {
+                            elements: [{ id: "12", identExpr: { name: "m" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "25", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "27", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(map(string, int))",
+      cost: { min: "207", max: "243" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { mapValue: {} },
+              {
+                mapValue: {
+                  entries: [
+                    { key: { stringValue: "a" }, value: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                mapValue: {
+                  entries: [
+                    { key: { stringValue: "b" }, value: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      runtimeCost: "246",
+      foldedAst:
+        '[\n  {}~map(string, int),\n  {\n    "a"~string:1~int\n  }~map(string, int),\n  {\n    "b"~string:2~int\n  }~map(string, int)\n]~list(map(string, int))',
+      folded: '[{}, {"a": 1}, {"b": 2}]',
+      expectedFolded: '[{}, {"a": 1}, {"b": 2}]',
+    },
+    {
+      original: {
+        expr: "type(1)",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "type(\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "type(1)",
+      locationAst: "type(\n  1^#2[1,5]#\n)^#1[1,4]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "type",
+            args: [{ id: "2", constExpr: { int64Value: "1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 4, "2": 5 },
+        },
+      },
+      checkedAst: "type(\n  1~int\n)~type(int)^type",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["type"] } },
+        typeMap: {
+          "1": { type: { primitive: "INT64" } },
+          "2": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 4, "2": 5 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "type",
+            args: [{ id: "2", constExpr: { int64Value: "1" } }],
+          },
+        },
+      },
+      type: "type(int)",
+      cost: { min: "1", max: "1" },
+      result: { value: { typeValue: "int" } },
+      runtimeCost: "1",
+      foldedAst: "int~type(int)^int",
+      folded: "int",
+      expectedFolded: "int",
+    },
+    {
+      original: {
+        expr: "[google.expr.proto3.test.TestAllTypes{single_int32: 2 + 3}].map(i, i)[0]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_[_](\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      google.expr.proto3.test.TestAllTypes{\n        single_int32:_+_(\n          2^#*expr.Constant_Int64Value#,\n          3^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        i^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "[google.expr.proto3.test.TestAllTypes{single_int32: 2 + 3}].map(i, i)[0]",
+      locationAst:
+        "_[_](\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      google.expr.proto3.test.TestAllTypes{\n        single_int32:_+_(\n          2^#4[1,52]#,\n          3^#6[1,56]#\n        )^#5[1,54]#^#3[1,50]#\n      }^#2[1,37]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#10[1,63]#,\n    // LoopCondition\n    true^#11[1,63]#,\n    // LoopStep\n    _+_(\n      @result^#12[1,63]#,\n      [\n        i^#9[1,67]#\n      ]^#13[1,63]#\n    )^#14[1,63]#,\n    // Result\n    @result^#15[1,63]#)^#16[1,63]#,\n  0^#18[1,70]#\n)^#17[1,69]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 37, 38, 1, 37, 1, 38],
+        [3, 50, 51, 1, 50, 1, 51],
+        [4, 52, 53, 1, 52, 1, 53],
+        [5, 54, 55, 1, 54, 1, 55],
+        [6, 56, 57, 1, 56, 1, 57],
+        [8, 64, 65, 1, 64, 1, 65],
+        [9, 67, 68, 1, 67, 1, 68],
+        [10, 63, 63, 1, 63, 1, 63],
+        [11, 63, 63, 1, 63, 1, 63],
+        [12, 63, 63, 1, 63, 1, 63],
+        [13, 63, 63, 1, 63, 1, 63],
+        [14, 63, 63, 1, 63, 1, 63],
+        [15, 63, 63, 1, 63, 1, 63],
+        [16, 63, 63, 1, 63, 1, 63],
+        [17, 69, 70, 1, 69, 1, 70],
+        [18, 70, 71, 1, 70, 1, 71],
+      ],
+      lineOffsets: [73],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "16",
+                comprehensionExpr: {
+                  iterVar: "i",
+                  iterRange: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            messageName: "google.expr.proto3.test.TestAllTypes",
+                            entries: [
+                              {
+                                id: "3",
+                                fieldKey: "single_int32",
+                                value: {
+                                  id: "5",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      {
+                                        id: "4",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "6",
+                                        constExpr: { int64Value: "3" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "10", listExpr: {} },
+                  loopCondition: { id: "11", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [{ id: "9", identExpr: { name: "i" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "15", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "18", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [73],
+          positions: {
+            "1": 0,
+            "2": 37,
+            "3": 50,
+            "4": 52,
+            "5": 54,
+            "6": 56,
+            "8": 64,
+            "9": 67,
+            "10": 63,
+            "11": 63,
+            "12": 63,
+            "13": 63,
+            "14": 63,
+            "15": 63,
+            "16": 63,
+            "17": 69,
+            "18": 70,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        structExpr: {
+                          messageName: "google.expr.proto3.test.TestAllTypes",
+                          entries: [
+                            {
+                              id: "3",
+                              fieldKey: "single_int32",
+                              value: {
+                                id: "5",
+                                callExpr: {
+                                  function: "_+_",
+                                  args: [
+                                    { id: "4", constExpr: { int64Value: "2" } },
+                                    { id: "6", constExpr: { int64Value: "3" } },
+                                  ],
+                                },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "i" } },
+                  { id: "9", identExpr: { name: "i" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "2",
+                  structExpr: {
+                    messageName: "google.expr.proto3.test.TestAllTypes",
+                    entries: [
+                      {
+                        id: "3",
+                        fieldKey: "single_int32",
+                        value: {
+                          id: "5",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              { id: "6", constExpr: { int64Value: "3" } },
+                            ],
+                          },
+                        },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "i" } },
+            { id: "9", identExpr: { name: "i" } },
+          ],
+        },
+      ],
+      checkedAst:
+        "_[_](\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      google.expr.proto3.test.TestAllTypes{\n        single_int32:_+_(\n          2~int,\n          3~int\n        )~int^add_int64\n      }~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes\n    ]~list(google.expr.proto3.test.TestAllTypes),\n    // Accumulator\n    @result,\n    // Init\n    []~list(google.expr.proto3.test.TestAllTypes),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(google.expr.proto3.test.TestAllTypes)^@result,\n      [\n        i~google.expr.proto3.test.TestAllTypes^i\n      ]~list(google.expr.proto3.test.TestAllTypes)\n    )~list(google.expr.proto3.test.TestAllTypes)^add_list,\n    // Result\n    @result~list(google.expr.proto3.test.TestAllTypes)^@result)~list(google.expr.proto3.test.TestAllTypes),\n  0~int\n)~google.expr.proto3.test.TestAllTypes^index_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "google.expr.proto3.test.TestAllTypes" },
+          "5": { overloadId: ["add_int64"] },
+          "9": { name: "i" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "17": { overloadId: ["index_list"] },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "10": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "11": { primitive: "BOOL" },
+          "12": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "13": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "14": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "15": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "16": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "17": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "18": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [73],
+          positions: {
+            "1": 0,
+            "2": 37,
+            "3": 50,
+            "4": 52,
+            "5": 54,
+            "6": 56,
+            "8": 64,
+            "9": 67,
+            "10": 63,
+            "11": 63,
+            "12": 63,
+            "13": 63,
+            "14": 63,
+            "15": 63,
+            "16": 63,
+            "17": 69,
+            "18": 70,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        structExpr: {
+                          messageName: "google.expr.proto3.test.TestAllTypes",
+                          entries: [
+                            {
+                              id: "3",
+                              fieldKey: "single_int32",
+                              value: {
+                                id: "5",
+                                callExpr: {
+                                  function: "_+_",
+                                  args: [
+                                    { id: "4", constExpr: { int64Value: "2" } },
+                                    { id: "6", constExpr: { int64Value: "3" } },
+                                  ],
+                                },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "i" } },
+                  { id: "9", identExpr: { name: "i" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "16",
+                comprehensionExpr: {
+                  iterVar: "i",
+                  iterRange: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            messageName: "google.expr.proto3.test.TestAllTypes",
+                            entries: [
+                              {
+                                id: "3",
+                                fieldKey: "single_int32",
+                                value: {
+                                  id: "5",
+                                  callExpr: {
+                                    function: "_+_",
+                                    args: [
+                                      {
+                                        id: "4",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "6",
+                                        constExpr: { int64Value: "3" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "10", listExpr: {} },
+                  loopCondition: { id: "11", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [{ id: "9", identExpr: { name: "i" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "15", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "18", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "76", max: "76" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+            singleInt32: 5,
+          },
+        },
+      },
+      runtimeCost: "77",
+      foldedAst:
+        "google.expr.proto3.test.TestAllTypes{\n  single_int32:5~int\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      folded: "google.expr.proto3.test.TestAllTypes{single_int32: 5}",
+      expectedFolded: "google.expr.proto3.test.TestAllTypes{single_int32: 5}",
+    },
+    {
+      original: {
+        expr: "[?optional.ofNonZeroValue(0)]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "[\n  optional^#*expr.Expr_IdentExpr#.ofNonZeroValue(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[?optional.ofNonZeroValue(0)]",
+      locationAst:
+        "[\n  optional^#2[1,2]#.ofNonZeroValue(\n    0^#4[1,26]#\n  )^#3[1,25]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 10, 1, 2, 1, 10],
+        [3, 25, 26, 1, 25, 1, 26],
+        [4, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "optional" } },
+                  function: "ofNonZeroValue",
+                  args: [{ id: "4", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+            optionalIndices: [0],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 2, "3": 25, "4": 26 },
+        },
+      },
+      checkedAst:
+        "[\n  optional.ofNonZeroValue(\n    0~int\n  )~optional_type(int)^optional_ofNonZeroValue\n]~list(int)",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["optional_ofNonZeroValue"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 2, "3": 25, "4": 26 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "optional.ofNonZeroValue",
+                  args: [{ id: "4", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+            optionalIndices: [0],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "11", max: "11" },
+      result: { value: { listValue: {} } },
+      runtimeCost: "11",
+      foldedAst: "[]~list(dyn)",
+      folded: "[]",
+      expectedFolded: "[]",
+    },
+    {
+      original: {
+        expr: "[1, ?optional.ofNonZeroValue(0)]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  optional^#*expr.Expr_IdentExpr#.ofNonZeroValue(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, ?optional.ofNonZeroValue(0)]",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  optional^#3[1,5]#.ofNonZeroValue(\n    0^#5[1,29]#\n  )^#4[1,28]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 5, 13, 1, 5, 1, 13],
+        [4, 28, 29, 1, 28, 1, 29],
+        [5, 29, 30, 1, 29, 1, 30],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  target: { id: "3", identExpr: { name: "optional" } },
+                  function: "ofNonZeroValue",
+                  args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+            optionalIndices: [1],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 1, "3": 5, "4": 28, "5": 29 },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  optional.ofNonZeroValue(\n    0~int\n  )~optional_type(int)^optional_ofNonZeroValue\n]~list(int)",
+      checkedExpr: {
+        referenceMap: { "4": { overloadId: ["optional_ofNonZeroValue"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "4": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 1, "3": 5, "4": 28, "5": 29 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "optional.ofNonZeroValue",
+                  args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+            optionalIndices: [1],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "11", max: "11" },
+      result: { value: { listValue: { values: [{ int64Value: "1" }] } } },
+      runtimeCost: "11",
+      foldedAst: "[\n  1~int\n]~list(int)",
+      folded: "[1]",
+      expectedFolded: "[1]",
+    },
+    {
+      original: {
+        expr: "[optional.none(), ?x]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "[\n  optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[optional.none(), ?x]",
+      locationAst:
+        "[\n  optional^#2[1,1]#.none()^#3[1,14]#,\n  x^#4[1,19]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 9, 1, 1, 1, 9],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "optional" } },
+                  function: "none",
+                },
+              },
+              { id: "4", identExpr: { name: "x" } },
+            ],
+            optionalIndices: [1],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 1, "3": 14, "4": 19 },
+        },
+      },
+      checkedAst:
+        "[\n  optional.none()~optional_type(dyn)^optional_none,\n  x~dyn^x\n]~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["optional_none"] },
+          "4": { name: "x" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 1, "3": 14, "4": 19 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "3", callExpr: { function: "optional.none" } },
+              { id: "4", identExpr: { name: "x" } },
+            ],
+            optionalIndices: [1],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "12" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "12",
+      foldedAst:
+        "[\n  optional.none()~optional_type(dyn)^optional_none,\n  x~dyn^x\n]~list(dyn)",
+      folded: "[optional.none(), ?x]",
+      expectedFolded: "[optional.none(), ?x]",
+    },
+    {
+      original: {
+        expr: "[?optional.none(), ?x]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "[\n  optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[?optional.none(), ?x]",
+      locationAst:
+        "[\n  optional^#2[1,2]#.none()^#3[1,15]#,\n  x^#4[1,20]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 10, 1, 2, 1, 10],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "optional" } },
+                  function: "none",
+                },
+              },
+              { id: "4", identExpr: { name: "x" } },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 2, "3": 15, "4": 20 },
+        },
+      },
+      checkedAst:
+        "[\n  optional.none()~optional_type(dyn)^optional_none,\n  x~dyn^x\n]~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["optional_none"] },
+          "4": { name: "x" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 2, "3": 15, "4": 20 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "3", callExpr: { function: "optional.none" } },
+              { id: "4", identExpr: { name: "x" } },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "12" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "12",
+      foldedAst: "[\n  x~dyn^x\n]~list(dyn)",
+      folded: "[?x]",
+      expectedFolded: "[?x]",
+    },
+    {
+      original: {
+        expr: "[1, x, ?optional.ofNonZeroValue(0), ?x.?y]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '[\n  1^#*expr.Constant_Int64Value#,\n  x^#*expr.Expr_IdentExpr#,\n  optional^#*expr.Expr_IdentExpr#.ofNonZeroValue(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _?._(\n    x^#*expr.Expr_IdentExpr#,\n    "y"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#',
+      unparsed: "[1, x, ?optional.ofNonZeroValue(0), ?x.?y]",
+      locationAst:
+        '[\n  1^#2[1,1]#,\n  x^#3[1,4]#,\n  optional^#4[1,8]#.ofNonZeroValue(\n    0^#6[1,32]#\n  )^#5[1,31]#,\n  _?._(\n    x^#7[1,37]#,\n    "y"^#8[1,40]#\n  )^#9[1,38]#\n]^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 8, 16, 1, 8, 1, 16],
+        [5, 31, 32, 1, 31, 1, 32],
+        [6, 32, 33, 1, 32, 1, 33],
+        [7, 37, 38, 1, 37, 1, 38],
+        [8, 40, 41, 1, 40, 1, 41],
+        [9, 38, 39, 1, 38, 1, 39],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", identExpr: { name: "x" } },
+              {
+                id: "5",
+                callExpr: {
+                  target: { id: "4", identExpr: { name: "optional" } },
+                  function: "ofNonZeroValue",
+                  args: [{ id: "6", constExpr: { int64Value: "0" } }],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_?._",
+                  args: [
+                    { id: "7", identExpr: { name: "x" } },
+                    { id: "8", constExpr: { stringValue: "y" } },
+                  ],
+                },
+              },
+            ],
+            optionalIndices: [2, 3],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 8,
+            "5": 31,
+            "6": 32,
+            "7": 37,
+            "8": 40,
+            "9": 38,
+          },
+        },
+      },
+      checkedAst:
+        '[\n  1~int,\n  x~dyn^x,\n  optional.ofNonZeroValue(\n    0~int\n  )~optional_type(int)^optional_ofNonZeroValue,\n  _?._(\n    x~dyn^x,\n    "y"\n  )~optional_type(dyn)^select_optional_field\n]~list(dyn)',
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "x" },
+          "5": { overloadId: ["optional_ofNonZeroValue"] },
+          "7": { name: "x" },
+          "9": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { dyn: {} },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "6": { primitive: "INT64" },
+          "7": { dyn: {} },
+          "9": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 8,
+            "5": 31,
+            "6": 32,
+            "7": 37,
+            "8": 40,
+            "9": 38,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", identExpr: { name: "x" } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "optional.ofNonZeroValue",
+                  args: [{ id: "6", constExpr: { int64Value: "0" } }],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_?._",
+                  args: [
+                    { id: "7", identExpr: { name: "x" } },
+                    { id: "8", constExpr: { stringValue: "y" } },
+                  ],
+                },
+              },
+            ],
+            optionalIndices: [2, 3],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "14", max: "14" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "11",
+      foldedAst:
+        '[\n  1~int,\n  x~dyn^x,\n  _?._(\n    x~dyn^x,\n    "y"\n  )~optional_type(dyn)^select_optional_field\n]~list(dyn)',
+      folded: "[1, x, ?x.?y]",
+      expectedFolded: "[1, x, ?x.?y]",
+    },
+    {
+      original: {
+        expr: "[1, x, ?optional.ofNonZeroValue(3), ?x.?y]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '[\n  1^#*expr.Constant_Int64Value#,\n  x^#*expr.Expr_IdentExpr#,\n  optional^#*expr.Expr_IdentExpr#.ofNonZeroValue(\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _?._(\n    x^#*expr.Expr_IdentExpr#,\n    "y"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#',
+      unparsed: "[1, x, ?optional.ofNonZeroValue(3), ?x.?y]",
+      locationAst:
+        '[\n  1^#2[1,1]#,\n  x^#3[1,4]#,\n  optional^#4[1,8]#.ofNonZeroValue(\n    3^#6[1,32]#\n  )^#5[1,31]#,\n  _?._(\n    x^#7[1,37]#,\n    "y"^#8[1,40]#\n  )^#9[1,38]#\n]^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 8, 16, 1, 8, 1, 16],
+        [5, 31, 32, 1, 31, 1, 32],
+        [6, 32, 33, 1, 32, 1, 33],
+        [7, 37, 38, 1, 37, 1, 38],
+        [8, 40, 41, 1, 40, 1, 41],
+        [9, 38, 39, 1, 38, 1, 39],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", identExpr: { name: "x" } },
+              {
+                id: "5",
+                callExpr: {
+                  target: { id: "4", identExpr: { name: "optional" } },
+                  function: "ofNonZeroValue",
+                  args: [{ id: "6", constExpr: { int64Value: "3" } }],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_?._",
+                  args: [
+                    { id: "7", identExpr: { name: "x" } },
+                    { id: "8", constExpr: { stringValue: "y" } },
+                  ],
+                },
+              },
+            ],
+            optionalIndices: [2, 3],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 8,
+            "5": 31,
+            "6": 32,
+            "7": 37,
+            "8": 40,
+            "9": 38,
+          },
+        },
+      },
+      checkedAst:
+        '[\n  1~int,\n  x~dyn^x,\n  optional.ofNonZeroValue(\n    3~int\n  )~optional_type(int)^optional_ofNonZeroValue,\n  _?._(\n    x~dyn^x,\n    "y"\n  )~optional_type(dyn)^select_optional_field\n]~list(dyn)',
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "x" },
+          "5": { overloadId: ["optional_ofNonZeroValue"] },
+          "7": { name: "x" },
+          "9": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { dyn: {} },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "6": { primitive: "INT64" },
+          "7": { dyn: {} },
+          "9": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 8,
+            "5": 31,
+            "6": 32,
+            "7": 37,
+            "8": 40,
+            "9": 38,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", identExpr: { name: "x" } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "optional.ofNonZeroValue",
+                  args: [{ id: "6", constExpr: { int64Value: "3" } }],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_?._",
+                  args: [
+                    { id: "7", identExpr: { name: "x" } },
+                    { id: "8", constExpr: { stringValue: "y" } },
+                  ],
+                },
+              },
+            ],
+            optionalIndices: [2, 3],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "14", max: "14" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "11",
+      foldedAst:
+        '[\n  1~int,\n  x~dyn^x,\n  3~int,\n  _?._(\n    x~dyn^x,\n    "y"\n  )~optional_type(dyn)^select_optional_field\n]~list(dyn)',
+      folded: "[1, x, 3, ?x.?y]",
+      expectedFolded: "[1, x, 3, ?x.?y]",
+    },
+    {
+      original: {
+        expr: "[1, x, ?optional.ofNonZeroValue(3), ?x.?y].size() \u003e 3",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '_\u003e_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    x^#*expr.Expr_IdentExpr#,\n    optional^#*expr.Expr_IdentExpr#.ofNonZeroValue(\n      3^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _?._(\n      x^#*expr.Expr_IdentExpr#,\n      "y"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#.size()^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "[1, x, ?optional.ofNonZeroValue(3), ?x.?y].size() \u003e 3",
+      locationAst:
+        '_\u003e_(\n  [\n    1^#2[1,1]#,\n    x^#3[1,4]#,\n    optional^#4[1,8]#.ofNonZeroValue(\n      3^#6[1,32]#\n    )^#5[1,31]#,\n    _?._(\n      x^#7[1,37]#,\n      "y"^#8[1,40]#\n    )^#9[1,38]#\n  ]^#1[1,0]#.size()^#10[1,47]#,\n  3^#12[1,52]#\n)^#11[1,50]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 8, 16, 1, 8, 1, 16],
+        [5, 31, 32, 1, 31, 1, 32],
+        [6, 32, 33, 1, 32, 1, 33],
+        [7, 37, 38, 1, 37, 1, 38],
+        [8, 40, 41, 1, 40, 1, 41],
+        [9, 38, 39, 1, 38, 1, 39],
+        [10, 47, 48, 1, 47, 1, 48],
+        [11, 50, 51, 1, 50, 1, 51],
+        [12, 52, 53, 1, 52, 1, 53],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", identExpr: { name: "x" } },
+                        {
+                          id: "5",
+                          callExpr: {
+                            target: {
+                              id: "4",
+                              identExpr: { name: "optional" },
+                            },
+                            function: "ofNonZeroValue",
+                            args: [{ id: "6", constExpr: { int64Value: "3" } }],
+                          },
+                        },
+                        {
+                          id: "9",
+                          callExpr: {
+                            function: "_?._",
+                            args: [
+                              { id: "7", identExpr: { name: "x" } },
+                              { id: "8", constExpr: { stringValue: "y" } },
+                            ],
+                          },
+                        },
+                      ],
+                      optionalIndices: [2, 3],
+                    },
+                  },
+                  function: "size",
+                },
+              },
+              { id: "12", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 8,
+            "5": 31,
+            "6": 32,
+            "7": 37,
+            "8": 40,
+            "9": 38,
+            "10": 47,
+            "11": 50,
+            "12": 52,
+          },
+        },
+      },
+      checkedAst:
+        '_\u003e_(\n  [\n    1~int,\n    x~dyn^x,\n    optional.ofNonZeroValue(\n      3~int\n    )~optional_type(int)^optional_ofNonZeroValue,\n    _?._(\n      x~dyn^x,\n      "y"\n    )~optional_type(dyn)^select_optional_field\n  ]~list(dyn).size()~int^list_size,\n  3~int\n)~bool^greater_int64',
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "x" },
+          "5": { overloadId: ["optional_ofNonZeroValue"] },
+          "7": { name: "x" },
+          "9": { overloadId: ["select_optional_field"] },
+          "10": { overloadId: ["list_size"] },
+          "11": { overloadId: ["greater_int64"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { dyn: {} },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "6": { primitive: "INT64" },
+          "7": { dyn: {} },
+          "9": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 8,
+            "5": 31,
+            "6": 32,
+            "7": 37,
+            "8": 40,
+            "9": 38,
+            "10": 47,
+            "11": 50,
+            "12": 52,
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", identExpr: { name: "x" } },
+                        {
+                          id: "5",
+                          callExpr: {
+                            function: "optional.ofNonZeroValue",
+                            args: [{ id: "6", constExpr: { int64Value: "3" } }],
+                          },
+                        },
+                        {
+                          id: "9",
+                          callExpr: {
+                            function: "_?._",
+                            args: [
+                              { id: "7", identExpr: { name: "x" } },
+                              { id: "8", constExpr: { stringValue: "y" } },
+                            ],
+                          },
+                        },
+                      ],
+                      optionalIndices: [2, 3],
+                    },
+                  },
+                  function: "size",
+                },
+              },
+              { id: "12", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "16", max: "16" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "13",
+      foldedAst:
+        '_\u003e_(\n  [\n    1~int,\n    x~dyn^x,\n    3~int,\n    _?._(\n      x~dyn^x,\n      "y"\n    )~optional_type(dyn)^select_optional_field\n  ]~list(dyn).size()~int^list_size,\n  3~int\n)~bool^greater_int64',
+      folded: "[1, x, 3, ?x.?y].size() \u003e 3",
+      expectedFolded: "[1, x, 3, ?x.?y].size() \u003e 3",
+    },
+    {
+      original: {
+        expr: "{?'a': optional.of('hello'), ?x : optional.of(1), ?'b': optional.none()}",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '{\n  ?"a"^#*expr.Constant_StringValue#:optional^#*expr.Expr_IdentExpr#.of(\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#,\n  ?x^#*expr.Expr_IdentExpr#:optional^#*expr.Expr_IdentExpr#.of(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#,\n  ?"b"^#*expr.Constant_StringValue#:optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed:
+        '{?"a": optional.of("hello"), ?x: optional.of(1), ?"b": optional.none()}',
+      locationAst:
+        '{\n  ?"a"^#3[1,2]#:optional^#4[1,7]#.of(\n    "hello"^#6[1,19]#\n  )^#5[1,18]#^#2[1,5]#,\n  ?x^#8[1,30]#:optional^#9[1,34]#.of(\n    1^#11[1,46]#\n  )^#10[1,45]#^#7[1,32]#,\n  ?"b"^#13[1,51]#:optional^#14[1,56]#.none()^#15[1,69]#^#12[1,54]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 5, 1, 2, 1, 5],
+        [4, 7, 15, 1, 7, 1, 15],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 26, 1, 19, 1, 26],
+        [7, 32, 33, 1, 32, 1, 33],
+        [8, 30, 31, 1, 30, 1, 31],
+        [9, 34, 42, 1, 34, 1, 42],
+        [10, 45, 46, 1, 45, 1, 46],
+        [11, 46, 47, 1, 46, 1, 47],
+        [12, 54, 55, 1, 54, 1, 55],
+        [13, 51, 54, 1, 51, 1, 54],
+        [14, 56, 64, 1, 56, 1, 64],
+        [15, 69, 70, 1, 69, 1, 70],
+      ],
+      lineOffsets: [73],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: {
+                  id: "5",
+                  callExpr: {
+                    target: { id: "4", identExpr: { name: "optional" } },
+                    function: "of",
+                    args: [{ id: "6", constExpr: { stringValue: "hello" } }],
+                  },
+                },
+                optionalEntry: true,
+              },
+              {
+                id: "7",
+                mapKey: { id: "8", identExpr: { name: "x" } },
+                value: {
+                  id: "10",
+                  callExpr: {
+                    target: { id: "9", identExpr: { name: "optional" } },
+                    function: "of",
+                    args: [{ id: "11", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                optionalEntry: true,
+              },
+              {
+                id: "12",
+                mapKey: { id: "13", constExpr: { stringValue: "b" } },
+                value: {
+                  id: "15",
+                  callExpr: {
+                    target: { id: "14", identExpr: { name: "optional" } },
+                    function: "none",
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [73],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 7,
+            "5": 18,
+            "6": 19,
+            "7": 32,
+            "8": 30,
+            "9": 34,
+            "10": 45,
+            "11": 46,
+            "12": 54,
+            "13": 51,
+            "14": 56,
+            "15": 69,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  ?"a"~string:optional.of(\n    "hello"~string\n  )~optional_type(string)^optional_of,\n  ?x~dyn^x:optional.of(\n    1~int\n  )~optional_type(int)^optional_of,\n  ?"b"~string:optional.none()~optional_type(dyn)^optional_none\n}~map(dyn, dyn)',
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["optional_of"] },
+          "8": { name: "x" },
+          "10": { overloadId: ["optional_of"] },
+          "15": { overloadId: ["optional_none"] },
+        },
+        typeMap: {
+          "1": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "3": { primitive: "STRING" },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "6": { primitive: "STRING" },
+          "8": { dyn: {} },
+          "10": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "11": { primitive: "INT64" },
+          "13": { primitive: "STRING" },
+          "15": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [73],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 7,
+            "5": 18,
+            "6": 19,
+            "7": 32,
+            "8": 30,
+            "9": 34,
+            "10": 45,
+            "11": 46,
+            "12": 54,
+            "13": 51,
+            "14": 56,
+            "15": 69,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: {
+                  id: "5",
+                  callExpr: {
+                    function: "optional.of",
+                    args: [{ id: "6", constExpr: { stringValue: "hello" } }],
+                  },
+                },
+                optionalEntry: true,
+              },
+              {
+                id: "7",
+                mapKey: { id: "8", identExpr: { name: "x" } },
+                value: {
+                  id: "10",
+                  callExpr: {
+                    function: "optional.of",
+                    args: [{ id: "11", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                optionalEntry: true,
+              },
+              {
+                id: "12",
+                mapKey: { id: "13", constExpr: { stringValue: "b" } },
+                value: { id: "15", callExpr: { function: "optional.none" } },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "map(dyn, dyn)",
+      cost: { min: "34", max: "34" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "32",
+      foldedAst:
+        '{\n  "a"~string:"hello"~string,\n  ?x~dyn^x:optional.of(\n    1~int\n  )~optional_type(int)^optional_of\n}~map(dyn, dyn)',
+      folded: '{"a": "hello", ?x: optional.of(1)}',
+      expectedFolded: '{"a": "hello", ?x: optional.of(1)}',
+    },
+    {
+      original: {
+        expr: "true ? x + 1 : x + 2",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  true^#*expr.Constant_BoolValue#,\n  _+_(\n    x^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    x^#*expr.Expr_IdentExpr#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true ? (x + 1) : (x + 2)",
+      locationAst:
+        "_?_:_(\n  true^#1[1,0]#,\n  _+_(\n    x^#3[1,7]#,\n    1^#5[1,11]#\n  )^#4[1,9]#,\n  _+_(\n    x^#6[1,15]#,\n    2^#8[1,19]#\n  )^#7[1,17]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 11, 12, 1, 11, 1, 12],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "x" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", identExpr: { name: "x" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 7,
+            "4": 9,
+            "5": 11,
+            "6": 15,
+            "7": 17,
+            "8": 19,
+          },
+        },
+      },
+      checkedAst:
+        "_?_:_(\n  true~bool,\n  _+_(\n    x~dyn^x,\n    1~int\n  )~int^add_int64,\n  _+_(\n    x~dyn^x,\n    2~int\n  )~int^add_int64\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["conditional"] },
+          "3": { name: "x" },
+          "4": { overloadId: ["add_int64"] },
+          "6": { name: "x" },
+          "7": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "INT64" },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 7,
+            "4": 9,
+            "5": 11,
+            "6": 15,
+            "7": 17,
+            "8": 19,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "x" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", identExpr: { name: "x" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "2",
+      foldedAst: "_+_(\n  x~dyn^x,\n  1~int\n)~int^add_int64",
+      folded: "x + 1",
+      expectedFolded: "x + 1",
+    },
+    {
+      original: {
+        expr: "false ? x + 1 : x + 2",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  false^#*expr.Constant_BoolValue#,\n  _+_(\n    x^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    x^#*expr.Expr_IdentExpr#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false ? (x + 1) : (x + 2)",
+      locationAst:
+        "_?_:_(\n  false^#1[1,0]#,\n  _+_(\n    x^#3[1,8]#,\n    1^#5[1,12]#\n  )^#4[1,10]#,\n  _+_(\n    x^#6[1,16]#,\n    2^#8[1,20]#\n  )^#7[1,18]#\n)^#2[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 16, 17, 1, 16, 1, 17],
+        [7, 18, 19, 1, 18, 1, 19],
+        [8, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "x" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", identExpr: { name: "x" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 6,
+            "3": 8,
+            "4": 10,
+            "5": 12,
+            "6": 16,
+            "7": 18,
+            "8": 20,
+          },
+        },
+      },
+      checkedAst:
+        "_?_:_(\n  false~bool,\n  _+_(\n    x~dyn^x,\n    1~int\n  )~int^add_int64,\n  _+_(\n    x~dyn^x,\n    2~int\n  )~int^add_int64\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["conditional"] },
+          "3": { name: "x" },
+          "4": { overloadId: ["add_int64"] },
+          "6": { name: "x" },
+          "7": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "INT64" },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 6,
+            "3": 8,
+            "4": 10,
+            "5": 12,
+            "6": 16,
+            "7": 18,
+            "8": 20,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "x" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", identExpr: { name: "x" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "2",
+      foldedAst: "_+_(\n  x~dyn^x,\n  2~int\n)~int^add_int64",
+      folded: "x + 2",
+      expectedFolded: "x + 2",
+    },
+    {
+      original: {
+        expr: "false ? x + 'world' : 'hello' + 'world'",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '_?_:_(\n  false^#*expr.Constant_BoolValue#,\n  _+_(\n    x^#*expr.Expr_IdentExpr#,\n    "world"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    "hello"^#*expr.Constant_StringValue#,\n    "world"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'false ? (x + "world") : ("hello" + "world")',
+      locationAst:
+        '_?_:_(\n  false^#1[1,0]#,\n  _+_(\n    x^#3[1,8]#,\n    "world"^#5[1,12]#\n  )^#4[1,10]#,\n  _+_(\n    "hello"^#6[1,22]#,\n    "world"^#8[1,32]#\n  )^#7[1,30]#\n)^#2[1,6]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 19, 1, 12, 1, 19],
+        [6, 22, 29, 1, 22, 1, 29],
+        [7, 30, 31, 1, 30, 1, 31],
+        [8, 32, 39, 1, 32, 1, 39],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "x" } },
+                    { id: "5", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "8", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 6,
+            "3": 8,
+            "4": 10,
+            "5": 12,
+            "6": 22,
+            "7": 30,
+            "8": 32,
+          },
+        },
+      },
+      checkedAst:
+        '_?_:_(\n  false~bool,\n  _+_(\n    x~dyn^x,\n    "world"~string\n  )~string^add_string,\n  _+_(\n    "hello"~string,\n    "world"~string\n  )~string^add_string\n)~string^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["conditional"] },
+          "3": { name: "x" },
+          "4": { overloadId: ["add_string"] },
+          "7": { overloadId: ["add_string"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "STRING" },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 6,
+            "3": 8,
+            "4": 10,
+            "5": 12,
+            "6": 22,
+            "7": 30,
+            "8": 32,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "x" } },
+                    { id: "5", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "8", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1844674407370955265" },
+      result: { value: { stringValue: "helloworld" } },
+      runtimeCost: "1",
+      foldedAst: '"helloworld"~string',
+      folded: '"helloworld"',
+      expectedFolded: '"helloworld"',
+    },
+    {
+      original: {
+        expr: "true \u0026\u0026 x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  true^#*expr.Constant_BoolValue#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 x",
+      locationAst:
+        "_\u0026\u0026_(\n  true^#1[1,0]#,\n  x^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  true~bool,\n  x~dyn^x\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "3": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "1",
+      foldedAst: "x~dyn^x",
+      folded: "x",
+      expectedFolded: "x",
+    },
+    {
+      original: {
+        expr: "x \u0026\u0026 true",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x \u0026\u0026 true",
+      locationAst:
+        "_\u0026\u0026_(\n  x^#1[1,0]#,\n  true^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 9, 1, 5, 1, 9],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  x~dyn^x,\n  true~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "1",
+      foldedAst: "x~dyn^x",
+      folded: "x",
+      expectedFolded: "x",
+    },
+    {
+      original: {
+        expr: "false \u0026\u0026 x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  false^#*expr.Constant_BoolValue#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false \u0026\u0026 x",
+      locationAst:
+        "_\u0026\u0026_(\n  false^#1[1,0]#,\n  x^#2[1,9]#\n)^#3[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  false~bool,\n  x~dyn^x\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "3": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "x \u0026\u0026 false",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x \u0026\u0026 false",
+      locationAst:
+        "_\u0026\u0026_(\n  x^#1[1,0]#,\n  false^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 10, 1, 5, 1, 10],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  x~dyn^x,\n  false~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "1",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "true || x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  true^#*expr.Constant_BoolValue#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true || x",
+      locationAst: "_||_(\n  true^#1[1,0]#,\n  x^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst: "_||_(\n  true~bool,\n  x~dyn^x\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "3": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "x || true",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x || true",
+      locationAst: "_||_(\n  x^#1[1,0]#,\n  true^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 9, 1, 5, 1, 9],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
+      checkedAst: "_||_(\n  x~dyn^x,\n  true~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "false || x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  false^#*expr.Constant_BoolValue#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false || x",
+      locationAst: "_||_(\n  false^#1[1,0]#,\n  x^#2[1,9]#\n)^#3[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+      },
+      checkedAst: "_||_(\n  false~bool,\n  x~dyn^x\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "3": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "1",
+      foldedAst: "x~dyn^x",
+      folded: "x",
+      expectedFolded: "x",
+    },
+    {
+      original: {
+        expr: "x || false",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x || false",
+      locationAst: "_||_(\n  x^#1[1,0]#,\n  false^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 10, 1, 5, 1, 10],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
+      checkedAst: "_||_(\n  x~dyn^x,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "3": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "1",
+      foldedAst: "x~dyn^x",
+      folded: "x",
+      expectedFolded: "x",
+    },
+    {
+      original: {
+        expr: "true \u0026\u0026 x \u0026\u0026 true \u0026\u0026 x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    true^#*expr.Constant_BoolValue#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    true^#*expr.Constant_BoolValue#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 x \u0026\u0026 true \u0026\u0026 x",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    true^#1[1,0]#,\n    x^#2[1,8]#\n  )^#3[1,5]#,\n  _\u0026\u0026_(\n    true^#4[1,13]#,\n    x^#6[1,21]#\n  )^#7[1,18]#\n)^#5[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 5, 7, 1, 5, 1, 7],
+        [4, 13, 17, 1, 13, 1, 17],
+        [5, 10, 12, 1, 10, 1, 12],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: true } },
+                    { id: "2", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "4", constExpr: { boolValue: true } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 5,
+            "4": 13,
+            "5": 10,
+            "6": 21,
+            "7": 18,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    true~bool,\n    x~dyn^x\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    true~bool,\n    x~dyn^x\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "3": { overloadId: ["logical_and"] },
+          "5": { overloadId: ["logical_and"] },
+          "6": { name: "x" },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 5,
+            "4": 13,
+            "5": 10,
+            "6": 21,
+            "7": 18,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: true } },
+                    { id: "2", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "4", constExpr: { boolValue: true } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "2",
+      foldedAst: "_\u0026\u0026_(\n  x~dyn^x,\n  x~dyn^x\n)~bool^logical_and",
+      folded: "x \u0026\u0026 x",
+      expectedFolded: "x \u0026\u0026 x",
+    },
+    {
+      original: {
+        expr: "false || x || false || x",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  _||_(\n    false^#*expr.Constant_BoolValue#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    false^#*expr.Constant_BoolValue#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false || x || false || x",
+      locationAst:
+        "_||_(\n  _||_(\n    false^#1[1,0]#,\n    x^#2[1,9]#\n  )^#3[1,6]#,\n  _||_(\n    false^#4[1,14]#,\n    x^#6[1,23]#\n  )^#7[1,20]#\n)^#5[1,11]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 6, 8, 1, 6, 1, 8],
+        [4, 14, 19, 1, 14, 1, 19],
+        [5, 11, 13, 1, 11, 1, 13],
+        [6, 23, 24, 1, 23, 1, 24],
+        [7, 20, 22, 1, 20, 1, 22],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    { id: "2", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "4", constExpr: { boolValue: false } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 6,
+            "4": 14,
+            "5": 11,
+            "6": 23,
+            "7": 20,
+          },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _||_(\n    false~bool,\n    x~dyn^x\n  )~bool^logical_or,\n  _||_(\n    false~bool,\n    x~dyn^x\n  )~bool^logical_or\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "3": { overloadId: ["logical_or"] },
+          "5": { overloadId: ["logical_or"] },
+          "6": { name: "x" },
+          "7": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 6,
+            "4": 14,
+            "5": 11,
+            "6": 23,
+            "7": 20,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    { id: "2", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "4", constExpr: { boolValue: false } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "2",
+      foldedAst: "_||_(\n  x~dyn^x,\n  x~dyn^x\n)~bool^logical_or",
+      folded: "x || x",
+      expectedFolded: "x || x",
+    },
+    {
+      original: {
+        expr: "true \u0026\u0026 true",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  true^#*expr.Constant_BoolValue#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 true",
+      locationAst:
+        "_\u0026\u0026_(\n  true^#1[1,0]#,\n  true^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 12, 1, 8, 1, 12],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  true~bool,\n  true~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_and"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "true \u0026\u0026 false",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 false",
+      locationAst:
+        "_\u0026\u0026_(\n  true^#1[1,0]#,\n  false^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  true~bool,\n  false~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_and"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "true || false",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true || false",
+      locationAst: "_||_(\n  true^#1[1,0]#,\n  false^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst: "_||_(\n  true~bool,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_or"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "false || false",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  false^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false || false",
+      locationAst: "_||_(\n  false^#1[1,0]#,\n  false^#2[1,9]#\n)^#3[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 14, 1, 9, 1, 14],
+        [3, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+      },
+      checkedAst: "_||_(\n  false~bool,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_or"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "true \u0026\u0026 false || true",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  _\u0026\u0026_(\n    true^#*expr.Constant_BoolValue#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 false || true",
+      locationAst:
+        "_||_(\n  _\u0026\u0026_(\n    true^#1[1,0]#,\n    false^#2[1,8]#\n  )^#3[1,5]#,\n  true^#4[1,17]#\n)^#5[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+        [4, 17, 21, 1, 17, 1, 21],
+        [5, 14, 16, 1, 14, 1, 16],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: true } },
+                    { id: "2", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "4", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 8, "3": 5, "4": 17, "5": 14 },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _\u0026\u0026_(\n    true~bool,\n    false~bool\n  )~bool^logical_and,\n  true~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["logical_and"] },
+          "5": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 8, "3": 5, "4": 17, "5": 14 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: true } },
+                    { id: "2", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "4", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "false \u0026\u0026 true || false",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_||_(\n  _\u0026\u0026_(\n    false^#*expr.Constant_BoolValue#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false \u0026\u0026 true || false",
+      locationAst:
+        "_||_(\n  _\u0026\u0026_(\n    false^#1[1,0]#,\n    true^#2[1,9]#\n  )^#3[1,6]#,\n  false^#4[1,17]#\n)^#5[1,14]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 13, 1, 9, 1, 13],
+        [3, 6, 8, 1, 6, 1, 8],
+        [4, 17, 22, 1, 17, 1, 22],
+        [5, 14, 16, 1, 14, 1, 16],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    { id: "2", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              { id: "4", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 9, "3": 6, "4": 17, "5": 14 },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _\u0026\u0026_(\n    false~bool,\n    true~bool\n  )~bool^logical_and,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["logical_and"] },
+          "5": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 9, "3": 6, "4": 17, "5": 14 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    { id: "2", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              { id: "4", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "null",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "null^#*expr.Constant_NullValue#",
+      unparsed: "null",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
+      type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+      foldedAst: "null~null",
+      folded: "null",
+      expectedFolded: "null",
+    },
+    {
+      original: {
+        expr: "google.expr.proto3.test.TestAllTypes{?single_int32: optional.ofNonZeroValue(1)}",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "google.expr.proto3.test.TestAllTypes{\n  ?single_int32:optional^#*expr.Expr_IdentExpr#.ofNonZeroValue(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed:
+        "google.expr.proto3.test.TestAllTypes{?single_int32: optional.ofNonZeroValue(1)}",
+      locationAst:
+        "google.expr.proto3.test.TestAllTypes{\n  ?single_int32:optional^#3[1,52]#.ofNonZeroValue(\n    1^#5[1,76]#\n  )^#4[1,75]#^#2[1,50]#\n}^#1[1,36]#",
+      positions: [
+        [1, 36, 37, 1, 36, 1, 37],
+        [2, 50, 51, 1, 50, 1, 51],
+        [3, 52, 60, 1, 52, 1, 60],
+        [4, 75, 76, 1, 75, 1, 76],
+        [5, 76, 77, 1, 76, 1, 77],
+      ],
+      lineOffsets: [80],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: {
+                  id: "4",
+                  callExpr: {
+                    target: { id: "3", identExpr: { name: "optional" } },
+                    function: "ofNonZeroValue",
+                    args: [{ id: "5", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [80],
+          positions: { "1": 36, "2": 50, "3": 52, "4": 75, "5": 76 },
+        },
+      },
+      checkedAst:
+        "google.expr.proto3.test.TestAllTypes{\n  ?single_int32:optional.ofNonZeroValue(\n    1~int\n  )~optional_type(int)^optional_ofNonZeroValue\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto3.test.TestAllTypes" },
+          "4": { overloadId: ["optional_ofNonZeroValue"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [80],
+          positions: { "1": 36, "2": 50, "3": 52, "4": 75, "5": 76 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: {
+                  id: "4",
+                  callExpr: {
+                    function: "optional.ofNonZeroValue",
+                    args: [{ id: "5", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "41", max: "41" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+            singleInt32: 1,
+          },
+        },
+      },
+      runtimeCost: "41",
+      foldedAst:
+        "google.expr.proto3.test.TestAllTypes{\n  single_int32:1~int\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      folded: "google.expr.proto3.test.TestAllTypes{single_int32: 1}",
+      expectedFolded: "google.expr.proto3.test.TestAllTypes{single_int32: 1}",
+    },
+    {
+      original: {
+        expr: "google.expr.proto3.test.TestAllTypes{?single_int32: optional.ofNonZeroValue(0)}",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "google.expr.proto3.test.TestAllTypes{\n  ?single_int32:optional^#*expr.Expr_IdentExpr#.ofNonZeroValue(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed:
+        "google.expr.proto3.test.TestAllTypes{?single_int32: optional.ofNonZeroValue(0)}",
+      locationAst:
+        "google.expr.proto3.test.TestAllTypes{\n  ?single_int32:optional^#3[1,52]#.ofNonZeroValue(\n    0^#5[1,76]#\n  )^#4[1,75]#^#2[1,50]#\n}^#1[1,36]#",
+      positions: [
+        [1, 36, 37, 1, 36, 1, 37],
+        [2, 50, 51, 1, 50, 1, 51],
+        [3, 52, 60, 1, 52, 1, 60],
+        [4, 75, 76, 1, 75, 1, 76],
+        [5, 76, 77, 1, 76, 1, 77],
+      ],
+      lineOffsets: [80],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: {
+                  id: "4",
+                  callExpr: {
+                    target: { id: "3", identExpr: { name: "optional" } },
+                    function: "ofNonZeroValue",
+                    args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [80],
+          positions: { "1": 36, "2": 50, "3": 52, "4": 75, "5": 76 },
+        },
+      },
+      checkedAst:
+        "google.expr.proto3.test.TestAllTypes{\n  ?single_int32:optional.ofNonZeroValue(\n    0~int\n  )~optional_type(int)^optional_ofNonZeroValue\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto3.test.TestAllTypes" },
+          "4": { overloadId: ["optional_ofNonZeroValue"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [80],
+          positions: { "1": 36, "2": 50, "3": 52, "4": 75, "5": 76 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: {
+                  id: "4",
+                  callExpr: {
+                    function: "optional.ofNonZeroValue",
+                    args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "41", max: "41" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+          },
+        },
+      },
+      runtimeCost: "41",
+      foldedAst:
+        "google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      folded: "google.expr.proto3.test.TestAllTypes{}",
+      expectedFolded: "google.expr.proto3.test.TestAllTypes{}",
+    },
+    {
+      original: {
+        expr: "google.expr.proto3.test.TestAllTypes{single_int32: x, repeated_int32: [1, 2, 3]}",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "google.expr.proto3.test.TestAllTypes{\n  single_int32:x^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#,\n  repeated_int32:[\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed:
+        "google.expr.proto3.test.TestAllTypes{single_int32: x, repeated_int32: [1, 2, 3]}",
+      locationAst:
+        "google.expr.proto3.test.TestAllTypes{\n  single_int32:x^#3[1,51]#^#2[1,49]#,\n  repeated_int32:[\n    1^#6[1,71]#,\n    2^#7[1,74]#,\n    3^#8[1,77]#\n  ]^#5[1,70]#^#4[1,68]#\n}^#1[1,36]#",
+      positions: [
+        [1, 36, 37, 1, 36, 1, 37],
+        [2, 49, 50, 1, 49, 1, 50],
+        [3, 51, 52, 1, 51, 1, 52],
+        [4, 68, 69, 1, 68, 1, 69],
+        [5, 70, 71, 1, 70, 1, 71],
+        [6, 71, 72, 1, 71, 1, 72],
+        [7, 74, 75, 1, 74, 1, 75],
+        [8, 77, 78, 1, 77, 1, 78],
+      ],
+      lineOffsets: [81],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", identExpr: { name: "x" } },
+              },
+              {
+                id: "4",
+                fieldKey: "repeated_int32",
+                value: {
+                  id: "5",
+                  listExpr: {
+                    elements: [
+                      { id: "6", constExpr: { int64Value: "1" } },
+                      { id: "7", constExpr: { int64Value: "2" } },
+                      { id: "8", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [81],
+          positions: {
+            "1": 36,
+            "2": 49,
+            "3": 51,
+            "4": 68,
+            "5": 70,
+            "6": 71,
+            "7": 74,
+            "8": 77,
+          },
+        },
+      },
+      checkedAst:
+        "google.expr.proto3.test.TestAllTypes{\n  single_int32:x~dyn^x,\n  repeated_int32:[\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto3.test.TestAllTypes" },
+          "3": { name: "x" },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { dyn: {} },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [81],
+          positions: {
+            "1": 36,
+            "2": 49,
+            "3": 51,
+            "4": 68,
+            "5": 70,
+            "6": 71,
+            "7": 74,
+            "8": 77,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", identExpr: { name: "x" } },
+              },
+              {
+                id: "4",
+                fieldKey: "repeated_int32",
+                value: {
+                  id: "5",
+                  listExpr: {
+                    elements: [
+                      { id: "6", constExpr: { int64Value: "1" } },
+                      { id: "7", constExpr: { int64Value: "2" } },
+                      { id: "8", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "51", max: "51" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "41",
+      foldedAst:
+        "google.expr.proto3.test.TestAllTypes{\n  single_int32:x~dyn^x,\n  repeated_int32:[\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      folded:
+        "google.expr.proto3.test.TestAllTypes{single_int32: x, repeated_int32: [1, 2, 3]}",
+      expectedFolded:
+        "google.expr.proto3.test.TestAllTypes{single_int32: x, repeated_int32: [1, 2, 3]}",
+    },
+    {
+      original: {
+        expr: "x + dyn([1, 2] + [3, 4])",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_+_(\n  x^#*expr.Expr_IdentExpr#,\n  dyn(\n    _+_(\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#,\n      [\n        3^#*expr.Constant_Int64Value#,\n        4^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x + dyn([1, 2] + [3, 4])",
+      locationAst:
+        "_+_(\n  x^#1[1,0]#,\n  dyn(\n    _+_(\n      [\n        1^#5[1,9]#,\n        2^#6[1,12]#\n      ]^#4[1,8]#,\n      [\n        3^#9[1,18]#,\n        4^#10[1,21]#\n      ]^#8[1,17]#\n    )^#7[1,15]#\n  )^#3[1,7]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 18, 1, 17, 1, 18],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          {
+                            id: "4",
+                            listExpr: {
+                              elements: [
+                                { id: "5", constExpr: { int64Value: "1" } },
+                                { id: "6", constExpr: { int64Value: "2" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "8",
+                            listExpr: {
+                              elements: [
+                                { id: "9", constExpr: { int64Value: "3" } },
+                                { id: "10", constExpr: { int64Value: "4" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 7,
+            "4": 8,
+            "5": 9,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 18,
+            "10": 21,
+          },
+        },
+      },
+      checkedAst:
+        "_+_(\n  x~dyn^x,\n  dyn(\n    _+_(\n      [\n        1~int,\n        2~int\n      ]~list(int),\n      [\n        3~int,\n        4~int\n      ]~list(int)\n    )~list(int)^add_list\n  )~dyn^to_dyn\n)~dyn^add_bytes|add_double|add_duration_duration|add_duration_timestamp|add_int64|add_list|add_string|add_timestamp_duration|add_uint64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": {
+            overloadId: [
+              "add_bytes",
+              "add_double",
+              "add_duration_duration",
+              "add_duration_timestamp",
+              "add_int64",
+              "add_list",
+              "add_string",
+              "add_timestamp_duration",
+              "add_uint64",
+            ],
+          },
+          "3": { overloadId: ["to_dyn"] },
+          "7": { overloadId: ["add_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 7,
+            "4": 8,
+            "5": 9,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 18,
+            "10": 21,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          {
+                            id: "4",
+                            listExpr: {
+                              elements: [
+                                { id: "5", constExpr: { int64Value: "1" } },
+                                { id: "6", constExpr: { int64Value: "2" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "8",
+                            listExpr: {
+                              elements: [
+                                { id: "9", constExpr: { int64Value: "3" } },
+                                { id: "10", constExpr: { int64Value: "4" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "24", max: "1844674407370955287" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "24",
+      foldedAst:
+        "_+_(\n  x~dyn^x,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~list(int)^add_list",
+      folded: "x + [1, 2, 3, 4]",
+      expectedFolded: "x + [1, 2, 3, 4]",
+    },
+    {
+      original: {
+        expr: "dyn([1, 2]) + [3.0, 4.0]",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_+_(\n  dyn(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    3^#*expr.Constant_DoubleValue#,\n    4^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn([1, 2]) + [3.0, 4.0]",
+      locationAst:
+        "_+_(\n  dyn(\n    [\n      1^#3[1,5]#,\n      2^#4[1,8]#\n    ]^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    3^#7[1,15]#,\n    4^#8[1,20]#\n  ]^#6[1,14]#\n)^#5[1,12]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 15, 18, 1, 15, 1, 18],
+        [8, 20, 23, 1, 20, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "2",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { doubleValue: 3 } },
+                    { id: "8", constExpr: { doubleValue: 4 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 14,
+            "7": 15,
+            "8": 20,
+          },
+        },
+      },
+      checkedAst:
+        "_+_(\n  dyn(\n    [\n      1~int,\n      2~int\n    ]~list(int)\n  )~dyn^to_dyn,\n  [\n    3~double,\n    4~double\n  ]~list(double)\n)~list(double)^add_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "5": { overloadId: ["add_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "6": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 14,
+            "7": 15,
+            "8": 20,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "2",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { doubleValue: 3 } },
+                    { id: "8", constExpr: { doubleValue: 4 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(double)",
+      cost: { min: "22", max: "22" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { doubleValue: 3 },
+              { doubleValue: 4 },
+            ],
+          },
+        },
+      },
+      runtimeCost: "22",
+      foldedAst: "[\n  1~int,\n  2~int,\n  3~double,\n  4~double\n]~list(dyn)",
+      folded: "[1, 2, 3.0, 4.0]",
+      expectedFolded: "[1, 2, 3.0, 4.0]",
+    },
+    {
+      original: {
+        expr: "{'a': dyn([1, 2]), 'b': x}",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: '{\n  "a"^#*expr.Constant_StringValue#:dyn(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#,\n  "b"^#*expr.Constant_StringValue#:x^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{"a": dyn([1, 2]), "b": x}',
+      locationAst:
+        '{\n  "a"^#3[1,1]#:dyn(\n    [\n      1^#6[1,11]#,\n      2^#7[1,14]#\n    ]^#5[1,10]#\n  )^#4[1,9]#^#2[1,4]#,\n  "b"^#9[1,19]#:x^#10[1,24]#^#8[1,22]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 14, 15, 1, 14, 1, 15],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 19, 22, 1, 19, 1, 22],
+        [10, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: {
+                  id: "4",
+                  callExpr: {
+                    function: "dyn",
+                    args: [
+                      {
+                        id: "5",
+                        listExpr: {
+                          elements: [
+                            { id: "6", constExpr: { int64Value: "1" } },
+                            { id: "7", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+              {
+                id: "8",
+                mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                value: { id: "10", identExpr: { name: "x" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 9,
+            "5": 10,
+            "6": 11,
+            "7": 14,
+            "8": 22,
+            "9": 19,
+            "10": 24,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  "a"~string:dyn(\n    [\n      1~int,\n      2~int\n    ]~list(int)\n  )~dyn^to_dyn,\n  "b"~string:x~dyn^x\n}~map(string, dyn)',
+      checkedExpr: {
+        referenceMap: { "4": { overloadId: ["to_dyn"] }, "10": { name: "x" } },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { dyn: {} },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "9": { primitive: "STRING" },
+          "10": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 9,
+            "5": 10,
+            "6": 11,
+            "7": 14,
+            "8": 22,
+            "9": 19,
+            "10": 24,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: {
+                  id: "4",
+                  callExpr: {
+                    function: "dyn",
+                    args: [
+                      {
+                        id: "5",
+                        listExpr: {
+                          elements: [
+                            { id: "6", constExpr: { int64Value: "1" } },
+                            { id: "7", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+              {
+                id: "8",
+                mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                value: { id: "10", identExpr: { name: "x" } },
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, dyn)",
+      cost: { min: "42", max: "42" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "42",
+      foldedAst:
+        '{\n  "a"~string:[\n    1~int,\n    2~int\n  ]~list(int),\n  "b"~string:x~dyn^x\n}~map(string, dyn)',
+      folded: '{"a": [1, 2], "b": x}',
+      expectedFolded: '{"a": [1, 2], "b": x}',
+    },
+    {
+      original: {
+        expr: "1 + x + 2 == 2 + x + 1",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_==_(\n  _+_(\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    _+_(\n      2^#*expr.Constant_Int64Value#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + x + 2 == 2 + x + 1",
+      locationAst:
+        "_==_(\n  _+_(\n    _+_(\n      1^#1[1,0]#,\n      x^#3[1,4]#\n    )^#2[1,2]#,\n    2^#5[1,8]#\n  )^#4[1,6]#,\n  _+_(\n    _+_(\n      2^#7[1,13]#,\n      x^#9[1,17]#\n    )^#8[1,15]#,\n    1^#11[1,21]#\n  )^#10[1,19]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 12, 1, 10, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 15, 16, 1, 15, 1, 16],
+        [9, 17, 18, 1, 17, 1, 18],
+        [10, 19, 20, 1, 19, 1, 20],
+        [11, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "7", constExpr: { int64Value: "2" } },
+                          { id: "9", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 13,
+            "8": 15,
+            "9": 17,
+            "10": 19,
+            "11": 21,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _+_(\n    _+_(\n      1~int,\n      x~dyn^x\n    )~int^add_int64,\n    2~int\n  )~int^add_int64,\n  _+_(\n    _+_(\n      2~int,\n      x~dyn^x\n    )~int^add_int64,\n    1~int\n  )~int^add_int64\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_int64"] },
+          "3": { name: "x" },
+          "4": { overloadId: ["add_int64"] },
+          "6": { overloadId: ["equals"] },
+          "8": { overloadId: ["add_int64"] },
+          "9": { name: "x" },
+          "10": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { dyn: {} },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 13,
+            "8": 15,
+            "9": 17,
+            "10": 19,
+            "11": 21,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "7", constExpr: { int64Value: "2" } },
+                          { id: "9", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "7", max: "7" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "7",
+      foldedAst:
+        "_==_(\n  _+_(\n    _+_(\n      1~int,\n      x~dyn^x\n    )~int^add_int64,\n    2~int\n  )~int^add_int64,\n  _+_(\n    _+_(\n      2~int,\n      x~dyn^x\n    )~int^add_int64,\n    1~int\n  )~int^add_int64\n)~bool^equals",
+      folded: "1 + x + 2 == 2 + x + 1",
+      expectedFolded: "1 + x + 2 == 2 + x + 1",
+    },
+    {
+      original: {
+        expr: "1 + 2 + x ==  x + 2 + 1",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "_==_(\n  _+_(\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    _+_(\n      x^#*expr.Expr_IdentExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + 2 + x == x + 2 + 1",
+      locationAst:
+        "_==_(\n  _+_(\n    _+_(\n      1^#1[1,0]#,\n      2^#3[1,4]#\n    )^#2[1,2]#,\n    x^#5[1,8]#\n  )^#4[1,6]#,\n  _+_(\n    _+_(\n      x^#7[1,14]#,\n      2^#9[1,18]#\n    )^#8[1,16]#,\n    1^#11[1,22]#\n  )^#10[1,20]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 12, 1, 10, 1, 12],
+        [7, 14, 15, 1, 14, 1, 15],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 20, 21, 1, 20, 1, 21],
+        [11, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "7", identExpr: { name: "x" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 14,
+            "8": 16,
+            "9": 18,
+            "10": 20,
+            "11": 22,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _+_(\n    _+_(\n      1~int,\n      2~int\n    )~int^add_int64,\n    x~dyn^x\n  )~int^add_int64,\n  _+_(\n    _+_(\n      x~dyn^x,\n      2~int\n    )~int^add_int64,\n    1~int\n  )~int^add_int64\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_int64"] },
+          "4": { overloadId: ["add_int64"] },
+          "5": { name: "x" },
+          "6": { overloadId: ["equals"] },
+          "7": { name: "x" },
+          "8": { overloadId: ["add_int64"] },
+          "10": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 14,
+            "8": 16,
+            "9": 18,
+            "10": 20,
+            "11": 22,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "7", identExpr: { name: "x" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "7", max: "7" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "7",
+      foldedAst:
+        "_==_(\n  _+_(\n    3~int,\n    x~dyn^x\n  )~int^add_int64,\n  _+_(\n    _+_(\n      x~dyn^x,\n      2~int\n    )~int^add_int64,\n    1~int\n  )~int^add_int64\n)~bool^equals",
+      folded: "3 + x == x + 2 + 1",
+      expectedFolded: "3 + x == x + 2 + 1",
+    },
+    {
+      original: {
+        expr: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      foldKnownValues: true,
+      ast: "google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto3^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ImportedGlobalEnum^#*expr.Expr_SelectExpr#.IMPORT_BAR^#*expr.Expr_SelectExpr#",
+      unparsed: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+      locationAst:
+        "google^#1[1,0]#.expr^#2[1,6]#.proto3^#3[1,11]#.test^#4[1,18]#.ImportedGlobalEnum^#5[1,23]#.IMPORT_BAR^#6[1,42]#",
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 23, 24, 1, 23, 1, 24],
+        [6, 42, 43, 1, 42, 1, 43],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "4",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      selectExpr: {
+                        operand: {
+                          id: "2",
+                          selectExpr: {
+                            operand: { id: "1", identExpr: { name: "google" } },
+                            field: "expr",
+                          },
+                        },
+                        field: "proto3",
+                      },
+                    },
+                    field: "test",
+                  },
+                },
+                field: "ImportedGlobalEnum",
+              },
+            },
+            field: "IMPORT_BAR",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 6, "3": 11, "4": 18, "5": 23, "6": 42 },
+        },
+      },
+      checkedAst:
+        "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR~int^google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+      checkedExpr: {
+        referenceMap: {
+          "6": {
+            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+            value: { int64Value: "1" },
+          },
+        },
+        typeMap: { "6": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 6, "3": 11, "4": 18, "5": 23, "6": 42 },
+        },
+        expr: {
+          id: "6",
+          identExpr: {
+            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "1" } },
+      runtimeCost: "0",
+      foldedAst: "1~int",
+      folded: "1",
+      expectedFolded: "1",
+    },
+    {
+      original: {
+        expr: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      ast: "google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto3^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ImportedGlobalEnum^#*expr.Expr_SelectExpr#.IMPORT_BAR^#*expr.Expr_SelectExpr#",
+      unparsed: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+      locationAst:
+        "google^#1[1,0]#.expr^#2[1,6]#.proto3^#3[1,11]#.test^#4[1,18]#.ImportedGlobalEnum^#5[1,23]#.IMPORT_BAR^#6[1,42]#",
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 23, 24, 1, 23, 1, 24],
+        [6, 42, 43, 1, 42, 1, 43],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "4",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      selectExpr: {
+                        operand: {
+                          id: "2",
+                          selectExpr: {
+                            operand: { id: "1", identExpr: { name: "google" } },
+                            field: "expr",
+                          },
+                        },
+                        field: "proto3",
+                      },
+                    },
+                    field: "test",
+                  },
+                },
+                field: "ImportedGlobalEnum",
+              },
+            },
+            field: "IMPORT_BAR",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 6, "3": 11, "4": 18, "5": 23, "6": 42 },
+        },
+      },
+      checkedAst:
+        "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR~int^google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+      checkedExpr: {
+        referenceMap: {
+          "6": {
+            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+            value: { int64Value: "1" },
+          },
+        },
+        typeMap: { "6": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 6, "3": 11, "4": 18, "5": 23, "6": 42 },
+        },
+        expr: {
+          id: "6",
+          identExpr: {
+            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "1" } },
+      runtimeCost: "0",
+      foldedAst:
+        "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR~int^google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+      folded: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+      expectedFolded: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+    },
+    {
+      original: {
+        expr: 'c == google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAZ ? "BAZ" : "Unknown"',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      foldKnownValues: true,
+      ast: '_?_:_(\n  _==_(\n    c^#*expr.Expr_IdentExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto3^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ImportedGlobalEnum^#*expr.Expr_SelectExpr#.IMPORT_BAZ^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  "BAZ"^#*expr.Constant_StringValue#,\n  "Unknown"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '(c == google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAZ) ? "BAZ" : "Unknown"',
+      locationAst:
+        '_?_:_(\n  _==_(\n    c^#1[1,0]#,\n    google^#3[1,5]#.expr^#4[1,11]#.proto3^#5[1,16]#.test^#6[1,23]#.ImportedGlobalEnum^#7[1,28]#.IMPORT_BAZ^#8[1,47]#\n  )^#2[1,2]#,\n  "BAZ"^#10[1,61]#,\n  "Unknown"^#11[1,69]#\n)^#9[1,59]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 11, 1, 5, 1, 11],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 23, 24, 1, 23, 1, 24],
+        [7, 28, 29, 1, 28, 1, 29],
+        [8, 47, 48, 1, 47, 1, 48],
+        [9, 59, 60, 1, 59, 1, 60],
+        [10, 61, 66, 1, 61, 1, 66],
+        [11, 69, 78, 1, 69, 1, 78],
+      ],
+      lineOffsets: [79],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "c" } },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "6",
+                              selectExpr: {
+                                operand: {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "4",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "3",
+                                          identExpr: { name: "google" },
+                                        },
+                                        field: "expr",
+                                      },
+                                    },
+                                    field: "proto3",
+                                  },
+                                },
+                                field: "test",
+                              },
+                            },
+                            field: "ImportedGlobalEnum",
+                          },
+                        },
+                        field: "IMPORT_BAZ",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { stringValue: "BAZ" } },
+              { id: "11", constExpr: { stringValue: "Unknown" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [79],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 11,
+            "5": 16,
+            "6": 23,
+            "7": 28,
+            "8": 47,
+            "9": 59,
+            "10": 61,
+            "11": 69,
+          },
+        },
+      },
+      checkedAst:
+        '_?_:_(\n  _==_(\n    c~int^c,\n    google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAZ~int^google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAZ\n  )~bool^equals,\n  "BAZ"~string,\n  "Unknown"~string\n)~string^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "c", value: { int64Value: "2" } },
+          "2": { overloadId: ["equals"] },
+          "8": {
+            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAZ",
+            value: { int64Value: "2" },
+          },
+          "9": { overloadId: ["conditional"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [79],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 11,
+            "5": 16,
+            "6": 23,
+            "7": 28,
+            "8": 47,
+            "9": 59,
+            "10": 61,
+            "11": 69,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "c" } },
+                    {
+                      id: "8",
+                      identExpr: {
+                        name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAZ",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { stringValue: "BAZ" } },
+              { id: "11", constExpr: { stringValue: "Unknown" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "3", max: "3" },
+      result: { value: { stringValue: "BAZ" } },
+      runtimeCost: "1",
+      foldedAst: '"BAZ"~string',
+      folded: '"BAZ"',
+      expectedFolded: '"BAZ"',
+    },
+    {
+      original: {
+        expr: '[\n\t\t\t\t\t\tgoogle.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR,\n\t\t\t\t\t\tc,\n\t\t\t\t\t\tgoogle.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO\n\t\t\t\t\t].exists(e, e == google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO)\n\t\t\t\t\t\t? "has Foo" : "no Foo"',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      foldKnownValues: true,
+      ast: '_?_:_(\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    [\n      google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto3^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ImportedGlobalEnum^#*expr.Expr_SelectExpr#.IMPORT_BAR^#*expr.Expr_SelectExpr#,\n      c^#*expr.Expr_IdentExpr#,\n      google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto3^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ImportedGlobalEnum^#*expr.Expr_SelectExpr#.IMPORT_FOO^#*expr.Expr_SelectExpr#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    false^#*expr.Constant_BoolValue#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // LoopStep\n    _||_(\n      @result^#*expr.Expr_IdentExpr#,\n      _==_(\n        e^#*expr.Expr_IdentExpr#,\n        google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto3^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ImportedGlobalEnum^#*expr.Expr_SelectExpr#.IMPORT_FOO^#*expr.Expr_SelectExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  "has Foo"^#*expr.Constant_StringValue#,\n  "no Foo"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '[google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR, c, google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO].exists(e, e == google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO) ? "has Foo" : "no Foo"',
+      locationAst:
+        '_?_:_(\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    [\n      google^#2[2,6]#.expr^#3[2,12]#.proto3^#4[2,17]#.test^#5[2,24]#.ImportedGlobalEnum^#6[2,29]#.IMPORT_BAR^#7[2,48]#,\n      c^#8[3,6]#,\n      google^#9[4,6]#.expr^#10[4,12]#.proto3^#11[4,17]#.test^#12[4,24]#.ImportedGlobalEnum^#13[4,29]#.IMPORT_FOO^#14[4,48]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    false^#25[5,13]#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#26[5,13]#\n      )^#27[5,13]#\n    )^#28[5,13]#,\n    // LoopStep\n    _||_(\n      @result^#29[5,13]#,\n      _==_(\n        e^#17[5,17]#,\n        google^#19[5,22]#.expr^#20[5,28]#.proto3^#21[5,33]#.test^#22[5,40]#.ImportedGlobalEnum^#23[5,45]#.IMPORT_FOO^#24[5,64]#\n      )^#18[5,19]#\n    )^#30[5,13]#,\n    // Result\n    @result^#31[5,13]#)^#32[5,13]#,\n  "has Foo"^#34[6,8]#,\n  "no Foo"^#35[6,20]#\n)^#33[6,6]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 8, 14, 2, 6, 2, 12],
+        [3, 14, 15, 2, 12, 2, 13],
+        [4, 19, 20, 2, 17, 2, 18],
+        [5, 26, 27, 2, 24, 2, 25],
+        [6, 31, 32, 2, 29, 2, 30],
+        [7, 50, 51, 2, 48, 2, 49],
+        [8, 69, 70, 3, 6, 3, 7],
+        [9, 78, 84, 4, 6, 4, 12],
+        [10, 84, 85, 4, 12, 4, 13],
+        [11, 89, 90, 4, 17, 4, 18],
+        [12, 96, 97, 4, 24, 4, 25],
+        [13, 101, 102, 4, 29, 4, 30],
+        [14, 120, 121, 4, 48, 4, 49],
+        [16, 146, 147, 5, 14, 5, 15],
+        [17, 149, 150, 5, 17, 5, 18],
+        [18, 151, 153, 5, 19, 5, 21],
+        [19, 154, 160, 5, 22, 5, 28],
+        [20, 160, 161, 5, 28, 5, 29],
+        [21, 165, 166, 5, 33, 5, 34],
+        [22, 172, 173, 5, 40, 5, 41],
+        [23, 177, 178, 5, 45, 5, 46],
+        [24, 196, 197, 5, 64, 5, 65],
+        [25, 145, 145, 5, 13, 5, 13],
+        [26, 145, 145, 5, 13, 5, 13],
+        [27, 145, 145, 5, 13, 5, 13],
+        [28, 145, 145, 5, 13, 5, 13],
+        [29, 145, 145, 5, 13, 5, 13],
+        [30, 145, 145, 5, 13, 5, 13],
+        [31, 145, 145, 5, 13, 5, 13],
+        [32, 145, 145, 5, 13, 5, 13],
+        [33, 215, 216, 6, 6, 6, 7],
+        [34, 217, 226, 6, 8, 6, 17],
+        [35, 229, 237, 6, 20, 6, 28],
+      ],
+      lineOffsets: [2, 63, 72, 132, 209, 238],
+      parsedExpr: {
+        expr: {
+          id: "33",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "32",
+                comprehensionExpr: {
+                  iterVar: "e",
+                  iterRange: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "6",
+                              selectExpr: {
+                                operand: {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "4",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "3",
+                                          selectExpr: {
+                                            operand: {
+                                              id: "2",
+                                              identExpr: { name: "google" },
+                                            },
+                                            field: "expr",
+                                          },
+                                        },
+                                        field: "proto3",
+                                      },
+                                    },
+                                    field: "test",
+                                  },
+                                },
+                                field: "ImportedGlobalEnum",
+                              },
+                            },
+                            field: "IMPORT_BAR",
+                          },
+                        },
+                        { id: "8", identExpr: { name: "c" } },
+                        {
+                          id: "14",
+                          selectExpr: {
+                            operand: {
+                              id: "13",
+                              selectExpr: {
+                                operand: {
+                                  id: "12",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "11",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "10",
+                                          selectExpr: {
+                                            operand: {
+                                              id: "9",
+                                              identExpr: { name: "google" },
+                                            },
+                                            field: "expr",
+                                          },
+                                        },
+                                        field: "proto3",
+                                      },
+                                    },
+                                    field: "test",
+                                  },
+                                },
+                                field: "ImportedGlobalEnum",
+                              },
+                            },
+                            field: "IMPORT_FOO",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "25", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "28",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "27",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "26", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "30",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "29", identExpr: { name: "@result" } },
+                        {
+                          id: "18",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "17", identExpr: { name: "e" } },
+                              {
+                                id: "24",
+                                selectExpr: {
+                                  operand: {
+                                    id: "23",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "22",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "21",
+                                            selectExpr: {
+                                              operand: {
+                                                id: "20",
+                                                selectExpr: {
+                                                  operand: {
+                                                    id: "19",
+                                                    identExpr: {
+                                                      name: "google",
+                                                    },
+                                                  },
+                                                  field: "expr",
+                                                },
+                                              },
+                                              field: "proto3",
+                                            },
+                                          },
+                                          field: "test",
+                                        },
+                                      },
+                                      field: "ImportedGlobalEnum",
+                                    },
+                                  },
+                                  field: "IMPORT_FOO",
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "31", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "34", constExpr: { stringValue: "has Foo" } },
+              { id: "35", constExpr: { stringValue: "no Foo" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [2, 63, 72, 132, 209, 238],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 14,
+            "4": 19,
+            "5": 26,
+            "6": 31,
+            "7": 50,
+            "8": 69,
+            "9": 78,
+            "10": 84,
+            "11": 89,
+            "12": 96,
+            "13": 101,
+            "14": 120,
+            "16": 146,
+            "17": 149,
+            "18": 151,
+            "19": 154,
+            "20": 160,
+            "21": 165,
+            "22": 172,
+            "23": 177,
+            "24": 196,
+            "25": 145,
+            "26": 145,
+            "27": 145,
+            "28": 145,
+            "29": 145,
+            "30": 145,
+            "31": 145,
+            "32": 145,
+            "33": 215,
+            "34": 217,
+            "35": 229,
+          },
+          macroCalls: {
+            "32": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                selectExpr: {
+                                  operand: {
+                                    id: "4",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "3",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "2",
+                                            identExpr: { name: "google" },
+                                          },
+                                          field: "expr",
+                                        },
+                                      },
+                                      field: "proto3",
+                                    },
+                                  },
+                                  field: "test",
+                                },
+                              },
+                              field: "ImportedGlobalEnum",
+                            },
+                          },
+                          field: "IMPORT_BAR",
+                        },
+                      },
+                      { id: "8", identExpr: { name: "c" } },
+                      {
+                        id: "14",
+                        selectExpr: {
+                          operand: {
+                            id: "13",
+                            selectExpr: {
+                              operand: {
+                                id: "12",
+                                selectExpr: {
+                                  operand: {
+                                    id: "11",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "10",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "9",
+                                            identExpr: { name: "google" },
+                                          },
+                                          field: "expr",
+                                        },
+                                      },
+                                      field: "proto3",
+                                    },
+                                  },
+                                  field: "test",
+                                },
+                              },
+                              field: "ImportedGlobalEnum",
+                            },
+                          },
+                          field: "IMPORT_FOO",
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "16", identExpr: { name: "e" } },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "17", identExpr: { name: "e" } },
+                        {
+                          id: "24",
+                          selectExpr: {
+                            operand: {
+                              id: "23",
+                              selectExpr: {
+                                operand: {
+                                  id: "22",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "21",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "20",
+                                          selectExpr: {
+                                            operand: {
+                                              id: "19",
+                                              identExpr: { name: "google" },
+                                            },
+                                            field: "expr",
+                                          },
+                                        },
+                                        field: "proto3",
+                                      },
+                                    },
+                                    field: "test",
+                                  },
+                                },
+                                field: "ImportedGlobalEnum",
+                              },
+                            },
+                            field: "IMPORT_FOO",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 32,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "7",
+                  selectExpr: {
+                    operand: {
+                      id: "6",
+                      selectExpr: {
+                        operand: {
+                          id: "5",
+                          selectExpr: {
+                            operand: {
+                              id: "4",
+                              selectExpr: {
+                                operand: {
+                                  id: "3",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "2",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto3",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "ImportedGlobalEnum",
+                      },
+                    },
+                    field: "IMPORT_BAR",
+                  },
+                },
+                { id: "8", identExpr: { name: "c" } },
+                {
+                  id: "14",
+                  selectExpr: {
+                    operand: {
+                      id: "13",
+                      selectExpr: {
+                        operand: {
+                          id: "12",
+                          selectExpr: {
+                            operand: {
+                              id: "11",
+                              selectExpr: {
+                                operand: {
+                                  id: "10",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "9",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto3",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "ImportedGlobalEnum",
+                      },
+                    },
+                    field: "IMPORT_FOO",
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "16", identExpr: { name: "e" } },
+            {
+              id: "18",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "17", identExpr: { name: "e" } },
+                  {
+                    id: "24",
+                    selectExpr: {
+                      operand: {
+                        id: "23",
+                        selectExpr: {
+                          operand: {
+                            id: "22",
+                            selectExpr: {
+                              operand: {
+                                id: "21",
+                                selectExpr: {
+                                  operand: {
+                                    id: "20",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "19",
+                                        identExpr: { name: "google" },
+                                      },
+                                      field: "expr",
+                                    },
+                                  },
+                                  field: "proto3",
+                                },
+                              },
+                              field: "test",
+                            },
+                          },
+                          field: "ImportedGlobalEnum",
+                        },
+                      },
+                      field: "IMPORT_FOO",
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_?_:_(\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    [\n      google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR~int^google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR,\n      c~int^c,\n      google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO~int^google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    false~bool,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result~bool^@result\n      )~bool^logical_not\n    )~bool^not_strictly_false,\n    // LoopStep\n    _||_(\n      @result~bool^@result,\n      _==_(\n        e~int^e,\n        google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO~int^google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO\n      )~bool^equals\n    )~bool^logical_or,\n    // Result\n    @result~bool^@result)~bool,\n  "has Foo"~string,\n  "no Foo"~string\n)~string^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "7": {
+            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+            value: { int64Value: "1" },
+          },
+          "8": { name: "c", value: { int64Value: "2" } },
+          "14": {
+            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO",
+            value: { int64Value: "0" },
+          },
+          "17": { name: "e" },
+          "18": { overloadId: ["equals"] },
+          "24": {
+            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO",
+            value: { int64Value: "0" },
+          },
+          "26": { name: "@result" },
+          "27": { overloadId: ["logical_not"] },
+          "28": { overloadId: ["not_strictly_false"] },
+          "29": { name: "@result" },
+          "30": { overloadId: ["logical_or"] },
+          "31": { name: "@result" },
+          "33": { overloadId: ["conditional"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "BOOL" },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "STRING" },
+          "34": { primitive: "STRING" },
+          "35": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [2, 63, 72, 132, 209, 238],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 14,
+            "4": 19,
+            "5": 26,
+            "6": 31,
+            "7": 50,
+            "8": 69,
+            "9": 78,
+            "10": 84,
+            "11": 89,
+            "12": 96,
+            "13": 101,
+            "14": 120,
+            "16": 146,
+            "17": 149,
+            "18": 151,
+            "19": 154,
+            "20": 160,
+            "21": 165,
+            "22": 172,
+            "23": 177,
+            "24": 196,
+            "25": 145,
+            "26": 145,
+            "27": 145,
+            "28": 145,
+            "29": 145,
+            "30": 145,
+            "31": 145,
+            "32": 145,
+            "33": 215,
+            "34": 217,
+            "35": 229,
+          },
+          macroCalls: {
+            "32": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "7",
+                        identExpr: {
+                          name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+                        },
+                      },
+                      { id: "8", identExpr: { name: "c" } },
+                      {
+                        id: "14",
+                        identExpr: {
+                          name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO",
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "16", identExpr: { name: "e" } },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "17", identExpr: { name: "e" } },
+                        {
+                          id: "24",
+                          identExpr: {
+                            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "33",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "32",
+                comprehensionExpr: {
+                  iterVar: "e",
+                  iterRange: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "7",
+                          identExpr: {
+                            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_BAR",
+                          },
+                        },
+                        { id: "8", identExpr: { name: "c" } },
+                        {
+                          id: "14",
+                          identExpr: {
+                            name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "25", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "28",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "27",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "26", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "30",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "29", identExpr: { name: "@result" } },
+                        {
+                          id: "18",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "17", identExpr: { name: "e" } },
+                              {
+                                id: "24",
+                                identExpr: {
+                                  name: "google.expr.proto3.test.ImportedGlobalEnum.IMPORT_FOO",
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "31", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "34", constExpr: { stringValue: "has Foo" } },
+              { id: "35", constExpr: { stringValue: "no Foo" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "26", max: "35" },
+      result: { value: { stringValue: "has Foo" } },
+      runtimeCost: "29",
+      foldedAst: '"has Foo"~string',
+      folded: '"has Foo"',
+      expectedFolded: '"has Foo"',
+    },
+    {
+      original: {
+        expr: 'l.exists(e, e == "foo") ? "has Foo" : "no Foo"',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+        bindings: {
+          l: {
+            value: {
+              listValue: {
+                values: [
+                  { stringValue: "foo" },
+                  { stringValue: "bar" },
+                  { stringValue: "baz" },
+                ],
+              },
+            },
+          },
+        },
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      foldKnownValues: true,
+      ast: '_?_:_(\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    l^#*expr.Expr_IdentExpr#,\n    // Accumulator\n    @result,\n    // Init\n    false^#*expr.Constant_BoolValue#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // LoopStep\n    _||_(\n      @result^#*expr.Expr_IdentExpr#,\n      _==_(\n        e^#*expr.Expr_IdentExpr#,\n        "foo"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  "has Foo"^#*expr.Constant_StringValue#,\n  "no Foo"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'l.exists(e, e == "foo") ? "has Foo" : "no Foo"',
+      locationAst:
+        '_?_:_(\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    l^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    false^#7[1,8]#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#8[1,8]#\n      )^#9[1,8]#\n    )^#10[1,8]#,\n    // LoopStep\n    _||_(\n      @result^#11[1,8]#,\n      _==_(\n        e^#4[1,12]#,\n        "foo"^#6[1,17]#\n      )^#5[1,14]#\n    )^#12[1,8]#,\n    // Result\n    @result^#13[1,8]#)^#14[1,8]#,\n  "has Foo"^#16[1,26]#,\n  "no Foo"^#17[1,38]#\n)^#15[1,24]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 14, 16, 1, 14, 1, 16],
+        [6, 17, 22, 1, 17, 1, 22],
+        [7, 8, 8, 1, 8, 1, 8],
+        [8, 8, 8, 1, 8, 1, 8],
+        [9, 8, 8, 1, 8, 1, 8],
+        [10, 8, 8, 1, 8, 1, 8],
+        [11, 8, 8, 1, 8, 1, 8],
+        [12, 8, 8, 1, 8, 1, 8],
+        [13, 8, 8, 1, 8, 1, 8],
+        [14, 8, 8, 1, 8, 1, 8],
+        [15, 24, 25, 1, 24, 1, 25],
+        [16, 26, 35, 1, 26, 1, 35],
+        [17, 38, 46, 1, 38, 1, 46],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "14",
+                comprehensionExpr: {
+                  iterVar: "e",
+                  iterRange: { id: "1", identExpr: { name: "l" } },
+                  accuVar: "@result",
+                  accuInit: { id: "7", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "10",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "9",
+                          callExpr: {
+                            function: "!_",
+                            args: [{ id: "8", identExpr: { name: "@result" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "12",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "11", identExpr: { name: "@result" } },
+                        {
+                          id: "5",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "4", identExpr: { name: "e" } },
+                              { id: "6", constExpr: { stringValue: "foo" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "13", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "16", constExpr: { stringValue: "has Foo" } },
+              { id: "17", constExpr: { stringValue: "no Foo" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 14,
+            "6": 17,
+            "7": 8,
+            "8": 8,
+            "9": 8,
+            "10": 8,
+            "11": 8,
+            "12": 8,
+            "13": 8,
+            "14": 8,
+            "15": 24,
+            "16": 26,
+            "17": 38,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "l" } },
+                function: "exists",
+                args: [
+                  { id: "3", identExpr: { name: "e" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "4", identExpr: { name: "e" } },
+                        { id: "6", constExpr: { stringValue: "foo" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "exists",
+          target: { id: "1", identExpr: { name: "l" } },
+          args: [
+            { id: "3", identExpr: { name: "e" } },
+            {
+              id: "5",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "4", identExpr: { name: "e" } },
+                  { id: "6", constExpr: { stringValue: "foo" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_?_:_(\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    l~list(string)^l,\n    // Accumulator\n    @result,\n    // Init\n    false~bool,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result~bool^@result\n      )~bool^logical_not\n    )~bool^not_strictly_false,\n    // LoopStep\n    _||_(\n      @result~bool^@result,\n      _==_(\n        e~string^e,\n        "foo"~string\n      )~bool^equals\n    )~bool^logical_or,\n    // Result\n    @result~bool^@result)~bool,\n  "has Foo"~string,\n  "no Foo"~string\n)~string^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "l" },
+          "4": { name: "e" },
+          "5": { overloadId: ["equals"] },
+          "8": { name: "@result" },
+          "9": { overloadId: ["logical_not"] },
+          "10": { overloadId: ["not_strictly_false"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["logical_or"] },
+          "13": { name: "@result" },
+          "15": { overloadId: ["conditional"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "STRING" },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 14,
+            "6": 17,
+            "7": 8,
+            "8": 8,
+            "9": 8,
+            "10": 8,
+            "11": 8,
+            "12": 8,
+            "13": 8,
+            "14": 8,
+            "15": 24,
+            "16": 26,
+            "17": 38,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "l" } },
+                function: "exists",
+                args: [
+                  { id: "3", identExpr: { name: "e" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "4", identExpr: { name: "e" } },
+                        { id: "6", constExpr: { stringValue: "foo" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "14",
+                comprehensionExpr: {
+                  iterVar: "e",
+                  iterRange: { id: "1", identExpr: { name: "l" } },
+                  accuVar: "@result",
+                  accuInit: { id: "7", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "10",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "9",
+                          callExpr: {
+                            function: "!_",
+                            args: [{ id: "8", identExpr: { name: "@result" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "12",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "11", identExpr: { name: "@result" } },
+                        {
+                          id: "5",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "4", identExpr: { name: "e" } },
+                              { id: "6", constExpr: { stringValue: "foo" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "13", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "16", constExpr: { stringValue: "has Foo" } },
+              { id: "17", constExpr: { stringValue: "no Foo" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { stringValue: "has Foo" } },
+      runtimeCost: "11",
+      foldedAst: '"has Foo"~string',
+      folded: '"has Foo"',
+      expectedFolded: '"has Foo"',
+    },
+    {
+      original: {
+        expr: '"foo" in l',
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+        bindings: {
+          l: {
+            value: {
+              listValue: {
+                values: [
+                  { stringValue: "foo" },
+                  { stringValue: "bar" },
+                  { stringValue: "baz" },
+                ],
+              },
+            },
+          },
+        },
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      foldKnownValues: true,
+      ast: '@in(\n  "foo"^#*expr.Constant_StringValue#,\n  l^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"foo" in l',
+      locationAst: '@in(\n  "foo"^#1[1,0]#,\n  l^#3[1,9]#\n)^#2[1,6]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 6, 8, 1, 6, 1, 8],
+        [3, 9, 10, 1, 9, 1, 10],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "foo" } },
+              { id: "3", identExpr: { name: "l" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 6, "3": 9 },
+        },
+      },
+      checkedAst: '@in(\n  "foo"~string,\n  l~list(string)^l\n)~bool^in_list',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] }, "3": { name: "l" } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 6, "3": 9 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "foo" } },
+              { id: "3", identExpr: { name: "l" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "o.repeated_int32",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          {
+            name: "c",
+            ident: { type: { primitive: "INT64" }, value: { int64Value: "2" } },
+          },
+          {
+            name: "l",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          {
+            name: "o",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+        bindings: {
+          o: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                repeatedInt32: [1, 2, 3],
+              },
+            },
+          },
+        },
+      },
+      section: "TestConstantFoldingOptimizer",
+      optionalSyntax: true,
+      foldKnownValues: true,
+      ast: "o^#*expr.Expr_IdentExpr#.repeated_int32^#*expr.Expr_SelectExpr#",
+      unparsed: "o.repeated_int32",
+      locationAst: "o^#1[1,0]#.repeated_int32^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "o" } },
+            field: "repeated_int32",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      checkedAst:
+        "o~google.expr.proto3.test.TestAllTypes^o.repeated_int32~list(int)",
+      checkedExpr: {
+        referenceMap: { "1": { name: "o" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "o" } },
+            field: "repeated_int32",
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "2", max: "2" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "2",
+      foldedAst: "[\n  1~int,\n  2~int,\n  3~int\n]~list(int)",
+      folded: "[1, 2, 3]",
+      expectedFolded: "[1, 2, 3]",
+    },
+    {
+      original: {
+        expr: "has({}.key)",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      section: "TestConstantFoldingOptimizerMacroElimination",
+      optionalSyntax: true,
+      ast: "{}^#*expr.Expr_StructExpr#.key~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has({}.key)",
+      locationAst: "{}^#2[1,4]#.key~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", structExpr: {} },
+            field: "key",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "2": 4, "3": 6, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", structExpr: {} },
+                      field: "key",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", structExpr: {} },
+                field: "key",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst: "{}~map(dyn, dyn).key~test-only~~bool",
+      checkedExpr: {
+        typeMap: {
+          "2": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "2": 4, "3": 6, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", structExpr: {} },
+                      field: "key",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", structExpr: {} },
+            field: "key",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "31", max: "31" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "32",
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "[1, 2, 3].filter(i, i \u003c 1)",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      section: "TestConstantFoldingOptimizerMacroElimination",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003c_(\n      i^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        i^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].filter(i, i \u003c 1)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#10[1,16]#,\n  // LoopCondition\n  true^#11[1,16]#,\n  // LoopStep\n  _?_:_(\n    _\u003c_(\n      i^#7[1,20]#,\n      1^#9[1,24]#\n    )^#8[1,22]#,\n    _+_(\n      @result^#12[1,16]#,\n      [\n        i^#6[1,17]#\n      ]^#13[1,16]#\n    )^#14[1,16]#,\n    @result^#15[1,16]#\n  )^#16[1,16]#,\n  // Result\n  @result^#17[1,16]#)^#18[1,16]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 20, 21, 1, 20, 1, 21],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 16, 16, 1, 16, 1, 16],
+        [11, 16, 16, 1, 16, 1, 16],
+        [12, 16, 16, 1, 16, 1, 16],
+        [13, 16, 16, 1, 16, 1, 16],
+        [14, 16, 16, 1, 16, 1, 16],
+        [15, 16, 16, 1, 16, 1, 16],
+        [16, 16, 16, 1, 16, 1, 16],
+        [17, 16, 16, 1, 16, 1, 16],
+        [18, 16, 16, 1, 16, 1, 16],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "18",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", listExpr: {} },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "16",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        { id: "9", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [{ id: "6", identExpr: { name: "i" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "15", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "17", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 17,
+            "7": 20,
+            "8": 22,
+            "9": 24,
+            "10": 16,
+            "11": 16,
+            "12": 16,
+            "13": 16,
+            "14": 16,
+            "15": 16,
+            "16": 16,
+            "17": 16,
+            "18": 16,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "6", identExpr: { name: "i" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        { id: "9", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "filter",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "i" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003c_",
+                args: [
+                  { id: "7", identExpr: { name: "i" } },
+                  { id: "9", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _\u003c_(\n      i~int^i,\n      1~int\n    )~bool^less_int64,\n    _+_(\n      @result~list(int)^@result,\n      [\n        i~int^i\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "6": { name: "i" },
+          "7": { name: "i" },
+          "8": { overloadId: ["less_int64"] },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "16": { overloadId: ["conditional"] },
+          "17": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 17,
+            "7": 20,
+            "8": 22,
+            "9": 24,
+            "10": 16,
+            "11": 16,
+            "12": 16,
+            "13": 16,
+            "14": 16,
+            "15": 16,
+            "16": 16,
+            "17": 16,
+            "18": 16,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "6", identExpr: { name: "i" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        { id: "9", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "18",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", listExpr: {} },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "16",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        { id: "9", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [{ id: "6", identExpr: { name: "i" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "15", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "17", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "30", max: "66" },
+      result: { value: { listValue: {} } },
+      runtimeCost: "27",
+      foldedAst: "[]~list(dyn)",
+      folded: "[]",
+      expectedFolded: "[]",
+    },
+    {
+      original: {
+        expr: '[{}, {"a": 1}, {"b": 2}].exists(i, has(i.b))',
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      section: "TestConstantFoldingOptimizerMacroElimination",
+      optionalSyntax: true,
+      ast: '__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    {}^#*expr.Expr_StructExpr#,\n    {\n      "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "b"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    i^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: '[{}, {"a": 1}, {"b": 2}].exists(i, has(i.b))',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    {}^#2[1,1]#,\n    {\n      "a"^#5[1,6]#:1^#6[1,11]#^#4[1,9]#\n    }^#3[1,5]#,\n    {\n      "b"^#9[1,16]#:2^#10[1,21]#^#8[1,19]#\n    }^#7[1,15]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#17[1,31]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#18[1,31]#\n    )^#19[1,31]#\n  )^#20[1,31]#,\n  // LoopStep\n  _||_(\n    @result^#21[1,31]#,\n    i^#14[1,39]#.b~test-only~^#16[1,38]#\n  )^#22[1,31]#,\n  // Result\n  @result^#23[1,31]#)^#24[1,31]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 6, 9, 1, 6, 1, 9],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 16, 19, 1, 16, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [12, 32, 33, 1, 32, 1, 33],
+        [14, 39, 40, 1, 39, 1, 40],
+        [15, 40, 41, 1, 40, 1, 41],
+        [16, 38, 38, 1, 38, 1, 38],
+        [17, 31, 31, 1, 31, 1, 31],
+        [18, 31, 31, 1, 31, 1, 31],
+        [19, 31, 31, 1, 31, 1, 31],
+        [20, 31, 31, 1, 31, 1, 31],
+        [21, 31, 31, 1, 31, 1, 31],
+        [22, 31, 31, 1, 31, 1, 31],
+        [23, 31, 31, 1, 31, 1, 31],
+        [24, 31, 31, 1, 31, 1, 31],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "24",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", structExpr: {} },
+                  {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "8",
+                          mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                          value: { id: "10", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "20",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "18", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "22",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "21", identExpr: { name: "@result" } },
+                  {
+                    id: "16",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "i" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "23", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 9,
+            "5": 6,
+            "6": 11,
+            "7": 15,
+            "8": 19,
+            "9": 16,
+            "10": 21,
+            "12": 32,
+            "14": 39,
+            "15": 40,
+            "16": 38,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "15",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "i" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "24": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", structExpr: {} },
+                      {
+                        id: "3",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "4",
+                              mapKey: {
+                                id: "5",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "6",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "8",
+                              mapKey: {
+                                id: "9",
+                                constExpr: { stringValue: "b" },
+                              },
+                              value: {
+                                id: "10",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [{ id: "12", identExpr: { name: "i" } }, { id: "16" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "has",
+          args: [
+            {
+              id: "15",
+              selectExpr: {
+                operand: { id: "14", identExpr: { name: "i" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 24,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", structExpr: {} },
+                {
+                  id: "3",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "4",
+                        mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                        value: { id: "6", constExpr: { int64Value: "1" } },
+                      },
+                    ],
+                  },
+                },
+                {
+                  id: "7",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "8",
+                        mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                        value: { id: "10", constExpr: { int64Value: "2" } },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [{ id: "12", identExpr: { name: "i" } }, { id: "16" }],
+        },
+      ],
+      checkedAst:
+        '__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    {}~map(string, int),\n    {\n      "a"~string:1~int\n    }~map(string, int),\n    {\n      "b"~string:2~int\n    }~map(string, int)\n  ]~list(map(string, int)),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    i~map(string, int)^i.b~test-only~~bool\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool',
+      checkedExpr: {
+        referenceMap: {
+          "14": { name: "i" },
+          "18": { name: "@result" },
+          "19": { overloadId: ["logical_not"] },
+          "20": { overloadId: ["not_strictly_false"] },
+          "21": { name: "@result" },
+          "22": { overloadId: ["logical_or"] },
+          "23": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+          "14": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 9,
+            "5": 6,
+            "6": 11,
+            "7": 15,
+            "8": 19,
+            "9": 16,
+            "10": 21,
+            "12": 32,
+            "14": 39,
+            "15": 40,
+            "16": 38,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "15",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "i" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "24": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", structExpr: {} },
+                      {
+                        id: "3",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "4",
+                              mapKey: {
+                                id: "5",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "6",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "7",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "8",
+                              mapKey: {
+                                id: "9",
+                                constExpr: { stringValue: "b" },
+                              },
+                              value: {
+                                id: "10",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [{ id: "12", identExpr: { name: "i" } }, { id: "16" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "24",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", structExpr: {} },
+                  {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "7",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "8",
+                          mapKey: { id: "9", constExpr: { stringValue: "b" } },
+                          value: { id: "10", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "20",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "18", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "22",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "21", identExpr: { name: "@result" } },
+                  {
+                    id: "16",
+                    selectExpr: {
+                      operand: { id: "14", identExpr: { name: "i" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "23", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "113", max: "119" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "119",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: 'has(x.b) \u0026\u0026 [{}, {"a": 1}, {"b": 2}].exists(i, has(i.b))',
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      section: "TestConstantFoldingOptimizerMacroElimination",
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      {}^#*expr.Expr_StructExpr#,\n      {\n        "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#,\n      {\n        "b"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    false^#*expr.Constant_BoolValue#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // LoopStep\n    _||_(\n      @result^#*expr.Expr_IdentExpr#,\n      i^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'has(x.b) \u0026\u0026 [{}, {"a": 1}, {"b": 2}].exists(i, has(i.b))',
+      locationAst:
+        '_\u0026\u0026_(\n  x^#2[1,4]#.b~test-only~^#4[1,3]#,\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      {}^#6[1,13]#,\n      {\n        "a"^#9[1,18]#:1^#10[1,23]#^#8[1,21]#\n      }^#7[1,17]#,\n      {\n        "b"^#13[1,28]#:2^#14[1,33]#^#12[1,31]#\n      }^#11[1,27]#\n    ]^#5[1,12]#,\n    // Accumulator\n    @result,\n    // Init\n    false^#21[1,43]#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#22[1,43]#\n      )^#23[1,43]#\n    )^#24[1,43]#,\n    // LoopStep\n    _||_(\n      @result^#25[1,43]#,\n      i^#18[1,51]#.b~test-only~^#20[1,50]#\n    )^#26[1,43]#,\n    // Result\n    @result^#27[1,43]#)^#28[1,43]#\n)^#29[1,9]#',
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 18, 21, 1, 18, 1, 21],
+        [10, 23, 24, 1, 23, 1, 24],
+        [11, 27, 28, 1, 27, 1, 28],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 28, 31, 1, 28, 1, 31],
+        [14, 33, 34, 1, 33, 1, 34],
+        [16, 44, 45, 1, 44, 1, 45],
+        [18, 51, 52, 1, 51, 1, 52],
+        [19, 52, 53, 1, 52, 1, 53],
+        [20, 50, 50, 1, 50, 1, 50],
+        [21, 43, 43, 1, 43, 1, 43],
+        [22, 43, 43, 1, 43, 1, 43],
+        [23, 43, 43, 1, 43, 1, 43],
+        [24, 43, 43, 1, 43, 1, 43],
+        [25, 43, 43, 1, 43, 1, 43],
+        [26, 43, 43, 1, 43, 1, 43],
+        [27, 43, 43, 1, 43, 1, 43],
+        [28, 43, 43, 1, 43, 1, 43],
+        [29, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [57],
+      parsedExpr: {
+        expr: {
+          id: "29",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "x" } },
+                  field: "b",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "28",
+                comprehensionExpr: {
+                  iterVar: "i",
+                  iterRange: {
+                    id: "5",
+                    listExpr: {
+                      elements: [
+                        { id: "6", structExpr: {} },
+                        {
+                          id: "7",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "8",
+                                mapKey: {
+                                  id: "9",
+                                  constExpr: { stringValue: "a" },
+                                },
+                                value: {
+                                  id: "10",
+                                  constExpr: { int64Value: "1" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "11",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "12",
+                                mapKey: {
+                                  id: "13",
+                                  constExpr: { stringValue: "b" },
+                                },
+                                value: {
+                                  id: "14",
+                                  constExpr: { int64Value: "2" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "21", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "24",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "23",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "22", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "26",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "25", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          selectExpr: {
+                            operand: { id: "18", identExpr: { name: "i" } },
+                            field: "b",
+                            testOnly: true,
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "27", identExpr: { name: "@result" } },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [57],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "5": 12,
+            "6": 13,
+            "7": 17,
+            "8": 21,
+            "9": 18,
+            "10": 23,
+            "11": 27,
+            "12": 31,
+            "13": 28,
+            "14": 33,
+            "16": 44,
+            "18": 51,
+            "19": 52,
+            "20": 50,
+            "21": 43,
+            "22": 43,
+            "23": 43,
+            "24": 43,
+            "25": 43,
+            "26": 43,
+            "27": 43,
+            "28": 43,
+            "29": 9,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "x" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "20": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "19",
+                    selectExpr: {
+                      operand: { id: "18", identExpr: { name: "i" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "28": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  listExpr: {
+                    elements: [
+                      { id: "6", structExpr: {} },
+                      {
+                        id: "7",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "8",
+                              mapKey: {
+                                id: "9",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "10",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "11",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "12",
+                              mapKey: {
+                                id: "13",
+                                constExpr: { stringValue: "b" },
+                              },
+                              value: {
+                                id: "14",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [{ id: "16", identExpr: { name: "i" } }, { id: "20" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "x" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 20,
+          function: "has",
+          args: [
+            {
+              id: "19",
+              selectExpr: {
+                operand: { id: "18", identExpr: { name: "i" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 28,
+          function: "exists",
+          target: {
+            id: "5",
+            listExpr: {
+              elements: [
+                { id: "6", structExpr: {} },
+                {
+                  id: "7",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "8",
+                        mapKey: { id: "9", constExpr: { stringValue: "a" } },
+                        value: { id: "10", constExpr: { int64Value: "1" } },
+                      },
+                    ],
+                  },
+                },
+                {
+                  id: "11",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "12",
+                        mapKey: { id: "13", constExpr: { stringValue: "b" } },
+                        value: { id: "14", constExpr: { int64Value: "2" } },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [{ id: "16", identExpr: { name: "i" } }, { id: "20" }],
+        },
+      ],
+      checkedAst:
+        '_\u0026\u0026_(\n  x~dyn^x.b~test-only~~bool,\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      {}~map(string, int),\n      {\n        "a"~string:1~int\n      }~map(string, int),\n      {\n        "b"~string:2~int\n      }~map(string, int)\n    ]~list(map(string, int)),\n    // Accumulator\n    @result,\n    // Init\n    false~bool,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result~bool^@result\n      )~bool^logical_not\n    )~bool^not_strictly_false,\n    // LoopStep\n    _||_(\n      @result~bool^@result,\n      i~map(string, int)^i.b~test-only~~bool\n    )~bool^logical_or,\n    // Result\n    @result~bool^@result)~bool\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "18": { name: "i" },
+          "22": { name: "@result" },
+          "23": { overloadId: ["logical_not"] },
+          "24": { overloadId: ["not_strictly_false"] },
+          "25": { name: "@result" },
+          "26": { overloadId: ["logical_or"] },
+          "27": { name: "@result" },
+          "29": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "6": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+          "11": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "INT64" },
+          "18": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [57],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "5": 12,
+            "6": 13,
+            "7": 17,
+            "8": 21,
+            "9": 18,
+            "10": 23,
+            "11": 27,
+            "12": 31,
+            "13": 28,
+            "14": 33,
+            "16": 44,
+            "18": 51,
+            "19": 52,
+            "20": 50,
+            "21": 43,
+            "22": 43,
+            "23": 43,
+            "24": 43,
+            "25": 43,
+            "26": 43,
+            "27": 43,
+            "28": 43,
+            "29": 9,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "x" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "20": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "19",
+                    selectExpr: {
+                      operand: { id: "18", identExpr: { name: "i" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "28": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  listExpr: {
+                    elements: [
+                      { id: "6", structExpr: {} },
+                      {
+                        id: "7",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "8",
+                              mapKey: {
+                                id: "9",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "10",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "11",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "12",
+                              mapKey: {
+                                id: "13",
+                                constExpr: { stringValue: "b" },
+                              },
+                              value: {
+                                id: "14",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [{ id: "16", identExpr: { name: "i" } }, { id: "20" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "29",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "x" } },
+                  field: "b",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "28",
+                comprehensionExpr: {
+                  iterVar: "i",
+                  iterRange: {
+                    id: "5",
+                    listExpr: {
+                      elements: [
+                        { id: "6", structExpr: {} },
+                        {
+                          id: "7",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "8",
+                                mapKey: {
+                                  id: "9",
+                                  constExpr: { stringValue: "a" },
+                                },
+                                value: {
+                                  id: "10",
+                                  constExpr: { int64Value: "1" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "11",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "12",
+                                mapKey: {
+                                  id: "13",
+                                  constExpr: { stringValue: "b" },
+                                },
+                                value: {
+                                  id: "14",
+                                  constExpr: { int64Value: "2" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "21", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "24",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "23",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "22", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "26",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "25", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          selectExpr: {
+                            operand: { id: "18", identExpr: { name: "i" } },
+                            field: "b",
+                            testOnly: true,
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "27", identExpr: { name: "@result" } },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "121" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): x" }] },
+      },
+      runtimeCost: "120",
+      foldedAst: "x~dyn^x.b~test-only~~bool",
+      folded: "has(x.b)",
+      expectedFolded: "has(x.b)",
+    },
+    {
+      original: {
+        expr: "[1, 1 + 2, 1 + (2 + 3)]",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      section: "TestConstantFoldingOptimizerWithLimit",
+      optionalSyntax: true,
+      maxFoldIterations: 1,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  _+_(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 1 + 2, 1 + (2 + 3)]",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  _+_(\n    1^#3[1,4]#,\n    2^#5[1,8]#\n  )^#4[1,6]#,\n  _+_(\n    1^#6[1,11]#,\n    _+_(\n      2^#8[1,16]#,\n      3^#10[1,20]#\n    )^#9[1,18]#\n  )^#7[1,13]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "2" } },
+                          { id: "10", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 20,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  _+_(\n    1~int,\n    2~int\n  )~int^add_int64,\n  _+_(\n    1~int,\n    _+_(\n      2~int,\n      3~int\n    )~int^add_int64\n  )~int^add_int64\n]~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["add_int64"] },
+          "7": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 20,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "2" } },
+                          { id: "10", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "13", max: "13" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "3" },
+              { int64Value: "6" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "13",
+      foldedAst:
+        "[\n  1~int,\n  3~int,\n  _+_(\n    1~int,\n    5~int\n  )~int^add_int64\n]~list(int)",
+      folded: "[1, 3, 1 + 5]",
+      expectedFolded: "[1, 3, 1 + 5]",
+    },
+    {
+      original: {
+        expr: "5 in [1, 1 + 2, 1 + (2 + 3)]",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      section: "TestConstantFoldingOptimizerWithLimit",
+      optionalSyntax: true,
+      maxFoldIterations: 2,
+      ast: "@in(\n  5^#*expr.Constant_Int64Value#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      _+_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "5 in [1, 1 + 2, 1 + (2 + 3)]",
+      locationAst:
+        "@in(\n  5^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    _+_(\n      1^#5[1,9]#,\n      2^#7[1,13]#\n    )^#6[1,11]#,\n    _+_(\n      1^#8[1,16]#,\n      _+_(\n        2^#10[1,21]#,\n        3^#12[1,25]#\n      )^#11[1,23]#\n    )^#9[1,18]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 18, 19, 1, 18, 1, 19],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "5" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  5~int,\n  [\n    1~int,\n    _+_(\n      1~int,\n      2~int\n    )~int^add_int64,\n    _+_(\n      1~int,\n      _+_(\n        2~int,\n        3~int\n      )~int^add_int64\n    )~int^add_int64\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_list"] },
+          "6": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+          "11": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 9,
+            "6": 11,
+            "7": 13,
+            "8": 16,
+            "9": 18,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "5" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "7", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "8", constExpr: { int64Value: "1" } },
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_+_",
+                              args: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "12", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "16", max: "16" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "16",
+      foldedAst:
+        "@in(\n  5~int,\n  [\n    1~int,\n    3~int,\n    6~int\n  ]~list(int)\n)~bool^in_list",
+      folded: "5 in [1, 3, 6]",
+      expectedFolded: "5 in [1, 3, 6]",
+    },
+    {
+      original: {
+        expr: "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j))",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      section: "TestConstantFoldingOptimizerWithLimit",
+      optionalSyntax: true,
+      maxFoldIterations: 1,
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1^#*expr.Constant_Int64Value#,\n          2^#*expr.Constant_Int64Value#,\n          3^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            _*_(\n              i^#*expr.Expr_IdentExpr#,\n              j^#*expr.Expr_IdentExpr#\n            )^#*expr.Expr_CallExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].map(i, [1, 2, 3].map(j, i * j))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#23[1,13]#,\n  // LoopCondition\n  true^#24[1,13]#,\n  // LoopStep\n  _+_(\n    @result^#25[1,13]#,\n    [\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1^#8[1,18]#,\n          2^#9[1,21]#,\n          3^#10[1,24]#\n        ]^#7[1,17]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#16[1,30]#,\n        // LoopCondition\n        true^#17[1,30]#,\n        // LoopStep\n        _+_(\n          @result^#18[1,30]#,\n          [\n            _*_(\n              i^#13[1,34]#,\n              j^#15[1,38]#\n            )^#14[1,36]#\n          ]^#19[1,30]#\n        )^#20[1,30]#,\n        // Result\n        @result^#21[1,30]#)^#22[1,30]#\n    ]^#26[1,13]#\n  )^#27[1,13]#,\n  // Result\n  @result^#28[1,13]#)^#29[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 18, 19, 1, 18, 1, 19],
+        [9, 21, 22, 1, 21, 1, 22],
+        [10, 24, 25, 1, 24, 1, 25],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 34, 35, 1, 34, 1, 35],
+        [14, 36, 37, 1, 36, 1, 37],
+        [15, 38, 39, 1, 38, 1, 39],
+        [16, 30, 30, 1, 30, 1, 30],
+        [17, 30, 30, 1, 30, 1, 30],
+        [18, 30, 30, 1, 30, 1, 30],
+        [19, 30, 30, 1, 30, 1, 30],
+        [20, 30, 30, 1, 30, 1, 30],
+        [21, 30, 30, 1, 30, 1, 30],
+        [22, 30, 30, 1, 30, 1, 30],
+        [23, 13, 13, 1, 13, 1, 13],
+        [24, 13, 13, 1, 13, 1, 13],
+        [25, 13, 13, 1, 13, 1, 13],
+        [26, 13, 13, 1, 13, 1, 13],
+        [27, 13, 13, 1, 13, 1, 13],
+        [28, 13, 13, 1, 13, 1, 13],
+        [29, 13, 13, 1, 13, 1, 13],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "29",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "23", listExpr: {} },
+            loopCondition: { id: "24", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "27",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "25", identExpr: { name: "@result" } },
+                  {
+                    id: "26",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "22",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "7",
+                              listExpr: {
+                                elements: [
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                  { id: "9", constExpr: { int64Value: "2" } },
+                                  { id: "10", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "16", listExpr: {} },
+                            loopCondition: {
+                              id: "17",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "20",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "18", identExpr: { name: "@result" } },
+                                  {
+                                    id: "19",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "14",
+                                          callExpr: {
+                                            function: "_*_",
+                                            args: [
+                                              {
+                                                id: "13",
+                                                identExpr: { name: "i" },
+                                              },
+                                              {
+                                                id: "15",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "21",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "28", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 18,
+            "9": 21,
+            "10": 24,
+            "12": 31,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+            "16": 30,
+            "17": 30,
+            "18": 30,
+            "19": 30,
+            "20": 30,
+            "21": 30,
+            "22": 30,
+            "23": 13,
+            "24": 13,
+            "25": 13,
+            "26": 13,
+            "27": 13,
+            "28": 13,
+            "29": 13,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "7",
+                  listExpr: {
+                    elements: [
+                      { id: "8", constExpr: { int64Value: "1" } },
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "12", identExpr: { name: "j" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "13", identExpr: { name: "i" } },
+                        { id: "15", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "6", identExpr: { name: "i" } }, { id: "22" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 22,
+          function: "map",
+          target: {
+            id: "7",
+            listExpr: {
+              elements: [
+                { id: "8", constExpr: { int64Value: "1" } },
+                { id: "9", constExpr: { int64Value: "2" } },
+                { id: "10", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "12", identExpr: { name: "j" } },
+            {
+              id: "14",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "13", identExpr: { name: "i" } },
+                  { id: "15", identExpr: { name: "j" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 29,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [{ id: "6", identExpr: { name: "i" } }, { id: "22" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(list(int))^@result,\n    [\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1~int,\n          2~int,\n          3~int\n        ]~list(int),\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            _*_(\n              i~int^i,\n              j~int^j\n            )~int^multiply_int64\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int)\n    ]~list(list(int))\n  )~list(list(int))^add_list,\n  // Result\n  @result~list(list(int))^@result)~list(list(int))",
+      checkedExpr: {
+        referenceMap: {
+          "13": { name: "i" },
+          "14": { overloadId: ["multiply_int64"] },
+          "15": { name: "j" },
+          "18": { name: "@result" },
+          "20": { overloadId: ["add_list"] },
+          "21": { name: "@result" },
+          "25": { name: "@result" },
+          "27": { overloadId: ["add_list"] },
+          "28": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "24": { primitive: "BOOL" },
+          "25": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "26": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "27": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "28": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "29": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 18,
+            "9": 21,
+            "10": 24,
+            "12": 31,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+            "16": 30,
+            "17": 30,
+            "18": 30,
+            "19": 30,
+            "20": 30,
+            "21": 30,
+            "22": 30,
+            "23": 13,
+            "24": 13,
+            "25": 13,
+            "26": 13,
+            "27": 13,
+            "28": 13,
+            "29": 13,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "7",
+                  listExpr: {
+                    elements: [
+                      { id: "8", constExpr: { int64Value: "1" } },
+                      { id: "9", constExpr: { int64Value: "2" } },
+                      { id: "10", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "12", identExpr: { name: "j" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "13", identExpr: { name: "i" } },
+                        { id: "15", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "6", identExpr: { name: "i" } }, { id: "22" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "29",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "23", listExpr: {} },
+            loopCondition: { id: "24", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "27",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "25", identExpr: { name: "@result" } },
+                  {
+                    id: "26",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "22",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "7",
+                              listExpr: {
+                                elements: [
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                  { id: "9", constExpr: { int64Value: "2" } },
+                                  { id: "10", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "16", listExpr: {} },
+                            loopCondition: {
+                              id: "17",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "20",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "18", identExpr: { name: "@result" } },
+                                  {
+                                    id: "19",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "14",
+                                          callExpr: {
+                                            function: "_*_",
+                                            args: [
+                                              {
+                                                id: "13",
+                                                identExpr: { name: "i" },
+                                              },
+                                              {
+                                                id: "15",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "21",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "28", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(list(int))",
+      cost: { min: "255", max: "255" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              {
+                listValue: {
+                  values: [
+                    { int64Value: "1" },
+                    { int64Value: "2" },
+                    { int64Value: "3" },
+                  ],
+                },
+              },
+              {
+                listValue: {
+                  values: [
+                    { int64Value: "2" },
+                    { int64Value: "4" },
+                    { int64Value: "6" },
+                  ],
+                },
+              },
+              {
+                listValue: {
+                  values: [
+                    { int64Value: "3" },
+                    { int64Value: "6" },
+                    { int64Value: "9" },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      runtimeCost: "255",
+      foldedAst:
+        "[\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  [\n    2~int,\n    4~int,\n    6~int\n  ]~list(int),\n  [\n    3~int,\n    6~int,\n    9~int\n  ]~list(int)\n]~list(list(int))",
+      folded: "[[1, 2, 3], [2, 4, 6], [3, 6, 9]]",
+      expectedFolded: "[[1, 2, 3], [2, 4, 6], [3, 6, 9]]",
+    },
+  ],
+} as const;
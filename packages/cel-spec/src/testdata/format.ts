@@ -0,0 +1,17878 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/formatting_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "format",
+  tests: [
+    {
+      original: {
+        name: "no-op",
+        expr: '"no substitution".format([])',
+        container: "ext",
+        value: { stringValue: "no substitution" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"no substitution"^#*expr.Constant_StringValue#.format(\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"no substitution".format([])',
+      locationAst:
+        '"no substitution"^#1[1,0]#.format(\n  []^#3[1,25]#\n)^#2[1,24]#',
+      positions: [
+        [1, 0, 17, 1, 0, 1, 17],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "no substitution" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+        sourceInfo: {
+          location: "no-op",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 24, "3": 25 },
+        },
+      },
+      checkedAst:
+        '"no substitution"~string.format(\n  []~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 24, "3": 25 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "no substitution" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "no substitution" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "mid-string substitution",
+        expr: '"str is %s and some more".format(["filler"])',
+        container: "ext",
+        value: { stringValue: "str is filler and some more" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"str is %s and some more"^#*expr.Constant_StringValue#.format(\n  [\n    "filler"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"str is %s and some more".format(["filler"])',
+      locationAst:
+        '"str is %s and some more"^#1[1,0]#.format(\n  [\n    "filler"^#4[1,34]#\n  ]^#3[1,33]#\n)^#2[1,32]#',
+      positions: [
+        [1, 0, 25, 1, 0, 1, 25],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 33, 34, 1, 33, 1, 34],
+        [4, 34, 42, 1, 34, 1, 42],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "str is %s and some more" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "filler" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "mid-string substitution",
+          lineOffsets: [45],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+      },
+      checkedAst:
+        '"str is %s and some more"~string.format(\n  [\n    "filler"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "str is %s and some more" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "filler" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "str is filler and some more" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "percent escaping",
+        expr: '"%% and also %%".format([])',
+        container: "ext",
+        value: { stringValue: "% and also %" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%% and also %%"^#*expr.Constant_StringValue#.format(\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%% and also %%".format([])',
+      locationAst:
+        '"%% and also %%"^#1[1,0]#.format(\n  []^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%% and also %%" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+        sourceInfo: {
+          location: "percent escaping",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 23, "3": 24 },
+        },
+      },
+      checkedAst:
+        '"%% and also %%"~string.format(\n  []~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 23, "3": 24 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%% and also %%" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "% and also %" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "substution inside escaped percent signs",
+        expr: '"%%%s%%".format(["text"])',
+        container: "ext",
+        value: { stringValue: "%text%" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%%%s%%"^#*expr.Constant_StringValue#.format(\n  [\n    "text"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%%%s%%".format(["text"])',
+      locationAst:
+        '"%%%s%%"^#1[1,0]#.format(\n  [\n    "text"^#4[1,17]#\n  ]^#3[1,16]#\n)^#2[1,15]#',
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 23, 1, 17, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%%s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "text" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "substution inside escaped percent signs",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 17 },
+        },
+      },
+      checkedAst:
+        '"%%%s%%"~string.format(\n  [\n    "text"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 17 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%%s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "text" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "%text%" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "substitution with one escaped percent sign on the right",
+        expr: '"%s%%".format(["percent on the right"])',
+        container: "ext",
+        value: { stringValue: "percent on the right%" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s%%"^#*expr.Constant_StringValue#.format(\n  [\n    "percent on the right"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s%%".format(["percent on the right"])',
+      locationAst:
+        '"%s%%"^#1[1,0]#.format(\n  [\n    "percent on the right"^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 37, 1, 15, 1, 37],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "percent on the right" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "substitution with one escaped percent sign on the right",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%s%%"~string.format(\n  [\n    "percent on the right"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "percent on the right" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "percent on the right%" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "substitution with one escaped percent sign on the left",
+        expr: '"%%%s".format(["percent on the left"])',
+        container: "ext",
+        value: { stringValue: "%percent on the left" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%%%s"^#*expr.Constant_StringValue#.format(\n  [\n    "percent on the left"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%%%s".format(["percent on the left"])',
+      locationAst:
+        '"%%%s"^#1[1,0]#.format(\n  [\n    "percent on the left"^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 36, 1, 15, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "percent on the left" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "substitution with one escaped percent sign on the left",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%%%s"~string.format(\n  [\n    "percent on the left"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "percent on the left" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "%percent on the left" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "multiple substitutions",
+        expr: '"%d %d %d, %s %s %s, %d %d %d, %s %s %s".format([1, 2, 3, "A", "B", "C", 4, 5, 6, "D", "E", "F"])',
+        container: "ext",
+        value: { stringValue: "1 2 3, A B C, 4 5 6, D E F" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%d %d %d, %s %s %s, %d %d %d, %s %s %s"^#*expr.Constant_StringValue#.format(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    "A"^#*expr.Constant_StringValue#,\n    "B"^#*expr.Constant_StringValue#,\n    "C"^#*expr.Constant_StringValue#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#,\n    6^#*expr.Constant_Int64Value#,\n    "D"^#*expr.Constant_StringValue#,\n    "E"^#*expr.Constant_StringValue#,\n    "F"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%d %d %d, %s %s %s, %d %d %d, %s %s %s".format([1, 2, 3, "A", "B", "C", 4, 5, 6, "D", "E", "F"])',
+      locationAst:
+        '"%d %d %d, %s %s %s, %d %d %d, %s %s %s"^#1[1,0]#.format(\n  [\n    1^#4[1,49]#,\n    2^#5[1,52]#,\n    3^#6[1,55]#,\n    "A"^#7[1,58]#,\n    "B"^#8[1,63]#,\n    "C"^#9[1,68]#,\n    4^#10[1,73]#,\n    5^#11[1,76]#,\n    6^#12[1,79]#,\n    "D"^#13[1,82]#,\n    "E"^#14[1,87]#,\n    "F"^#15[1,92]#\n  ]^#3[1,48]#\n)^#2[1,47]#',
+      positions: [
+        [1, 0, 40, 1, 0, 1, 40],
+        [2, 47, 48, 1, 47, 1, 48],
+        [3, 48, 49, 1, 48, 1, 49],
+        [4, 49, 50, 1, 49, 1, 50],
+        [5, 52, 53, 1, 52, 1, 53],
+        [6, 55, 56, 1, 55, 1, 56],
+        [7, 58, 61, 1, 58, 1, 61],
+        [8, 63, 66, 1, 63, 1, 66],
+        [9, 68, 71, 1, 68, 1, 71],
+        [10, 73, 74, 1, 73, 1, 74],
+        [11, 76, 77, 1, 76, 1, 77],
+        [12, 79, 80, 1, 79, 1, 80],
+        [13, 82, 85, 1, 82, 1, 85],
+        [14, 87, 90, 1, 87, 1, 90],
+        [15, 92, 95, 1, 92, 1, 95],
+      ],
+      lineOffsets: [98],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: {
+                stringValue: "%d %d %d, %s %s %s, %d %d %d, %s %s %s",
+              },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                    { id: "7", constExpr: { stringValue: "A" } },
+                    { id: "8", constExpr: { stringValue: "B" } },
+                    { id: "9", constExpr: { stringValue: "C" } },
+                    { id: "10", constExpr: { int64Value: "4" } },
+                    { id: "11", constExpr: { int64Value: "5" } },
+                    { id: "12", constExpr: { int64Value: "6" } },
+                    { id: "13", constExpr: { stringValue: "D" } },
+                    { id: "14", constExpr: { stringValue: "E" } },
+                    { id: "15", constExpr: { stringValue: "F" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "multiple substitutions",
+          lineOffsets: [98],
+          positions: {
+            "1": 0,
+            "2": 47,
+            "3": 48,
+            "4": 49,
+            "5": 52,
+            "6": 55,
+            "7": 58,
+            "8": 63,
+            "9": 68,
+            "10": 73,
+            "11": 76,
+            "12": 79,
+            "13": 82,
+            "14": 87,
+            "15": 92,
+          },
+        },
+      },
+      checkedAst:
+        '"%d %d %d, %s %s %s, %d %d %d, %s %s %s"~string.format(\n  [\n    1~int,\n    2~int,\n    3~int,\n    "A"~string,\n    "B"~string,\n    "C"~string,\n    4~int,\n    5~int,\n    6~int,\n    "D"~string,\n    "E"~string,\n    "F"~string\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "STRING" },
+          "15": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [98],
+          positions: {
+            "1": 0,
+            "2": 47,
+            "3": 48,
+            "4": 49,
+            "5": 52,
+            "6": 55,
+            "7": 58,
+            "8": 63,
+            "9": 68,
+            "10": 73,
+            "11": 76,
+            "12": 79,
+            "13": 82,
+            "14": 87,
+            "15": 92,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: {
+                stringValue: "%d %d %d, %s %s %s, %d %d %d, %s %s %s",
+              },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                    { id: "7", constExpr: { stringValue: "A" } },
+                    { id: "8", constExpr: { stringValue: "B" } },
+                    { id: "9", constExpr: { stringValue: "C" } },
+                    { id: "10", constExpr: { int64Value: "4" } },
+                    { id: "11", constExpr: { int64Value: "5" } },
+                    { id: "12", constExpr: { int64Value: "6" } },
+                    { id: "13", constExpr: { stringValue: "D" } },
+                    { id: "14", constExpr: { stringValue: "E" } },
+                    { id: "15", constExpr: { stringValue: "F" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "1 2 3, A B C, 4 5 6, D E F" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "percent sign escape sequence support",
+        expr: '"%%escaped %s%%".format(["percent"])',
+        container: "ext",
+        value: { stringValue: "%escaped percent%" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%%escaped %s%%"^#*expr.Constant_StringValue#.format(\n  [\n    "percent"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%%escaped %s%%".format(["percent"])',
+      locationAst:
+        '"%%escaped %s%%"^#1[1,0]#.format(\n  [\n    "percent"^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 34, 1, 25, 1, 34],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%escaped %s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "percent" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "percent sign escape sequence support",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      checkedAst:
+        '"%%escaped %s%%"~string.format(\n  [\n    "percent"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%escaped %s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "percent" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "%escaped percent%" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "fixed point formatting clause",
+        expr: '"%.3f".format([1.2345])',
+        container: "ext",
+        value: { stringValue: "1.234" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%.3f"^#*expr.Constant_StringValue#.format(\n  [\n    1.2345^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%.3f".format([1.2345])',
+      locationAst:
+        '"%.3f"^#1[1,0]#.format(\n  [\n    1.2345^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 21, 1, 15, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.3f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 1.2345 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "fixed point formatting clause",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%.3f"~string.format(\n  [\n    1.2345~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.3f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 1.2345 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "1.234" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "binary formatting clause",
+        expr: '"this is 5 in binary: %b".format([5])',
+        container: "ext",
+        value: { stringValue: "this is 5 in binary: 101" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"this is 5 in binary: %b"^#*expr.Constant_StringValue#.format(\n  [\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"this is 5 in binary: %b".format([5])',
+      locationAst:
+        '"this is 5 in binary: %b"^#1[1,0]#.format(\n  [\n    5^#4[1,34]#\n  ]^#3[1,33]#\n)^#2[1,32]#',
+      positions: [
+        [1, 0, 25, 1, 0, 1, 25],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 33, 34, 1, 33, 1, 34],
+        [4, 34, 35, 1, 34, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is 5 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "5" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "binary formatting clause",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+      },
+      checkedAst:
+        '"this is 5 in binary: %b"~string.format(\n  [\n    5~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is 5 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "5" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "this is 5 in binary: 101" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "negative binary formatting clause",
+        expr: '"this is -5 in binary: %b".format([-5])',
+        container: "ext",
+        value: { stringValue: "this is -5 in binary: -101" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"this is -5 in binary: %b"^#*expr.Constant_StringValue#.format(\n  [\n    -5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"this is -5 in binary: %b".format([-5])',
+      locationAst:
+        '"this is -5 in binary: %b"^#1[1,0]#.format(\n  [\n    -5^#4[1,35]#\n  ]^#3[1,34]#\n)^#2[1,33]#',
+      positions: [
+        [1, 0, 26, 1, 0, 1, 26],
+        [2, 33, 34, 1, 33, 1, 34],
+        [3, 34, 35, 1, 34, 1, 35],
+        [4, 35, 37, 1, 35, 1, 37],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is -5 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-5" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative binary formatting clause",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+      },
+      checkedAst:
+        '"this is -5 in binary: %b"~string.format(\n  [\n    -5~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is -5 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-5" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "this is -5 in binary: -101" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "uint support for binary formatting",
+        expr: '"unsigned 64 in binary: %b".format([uint(64)])',
+        container: "ext",
+        value: { stringValue: "unsigned 64 in binary: 1000000" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"unsigned 64 in binary: %b"^#*expr.Constant_StringValue#.format(\n  [\n    uint(\n      64^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"unsigned 64 in binary: %b".format([uint(64)])',
+      locationAst:
+        '"unsigned 64 in binary: %b"^#1[1,0]#.format(\n  [\n    uint(\n      64^#5[1,41]#\n    )^#4[1,40]#\n  ]^#3[1,35]#\n)^#2[1,34]#',
+      positions: [
+        [1, 0, 27, 1, 0, 1, 27],
+        [2, 34, 35, 1, 34, 1, 35],
+        [3, 35, 36, 1, 35, 1, 36],
+        [4, 40, 41, 1, 40, 1, 41],
+        [5, 41, 43, 1, 41, 1, 43],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "unsigned 64 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "64" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uint support for binary formatting",
+          lineOffsets: [47],
+          positions: { "1": 0, "2": 34, "3": 35, "4": 40, "5": 41 },
+        },
+      },
+      checkedAst:
+        '"unsigned 64 in binary: %b"~string.format(\n  [\n    uint(\n      64~int\n    )~uint^int64_to_uint64\n  ]~list(uint)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["int64_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: { "1": 0, "2": 34, "3": 35, "4": 40, "5": 41 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "unsigned 64 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "64" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "unsigned 64 in binary: 1000000" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "bool support for binary formatting",
+        expr: '"bit set from bool: %b".format([true])',
+        container: "ext",
+        value: { stringValue: "bit set from bool: 1" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"bit set from bool: %b"^#*expr.Constant_StringValue#.format(\n  [\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"bit set from bool: %b".format([true])',
+      locationAst:
+        '"bit set from bool: %b"^#1[1,0]#.format(\n  [\n    true^#4[1,32]#\n  ]^#3[1,31]#\n)^#2[1,30]#',
+      positions: [
+        [1, 0, 23, 1, 0, 1, 23],
+        [2, 30, 31, 1, 30, 1, 31],
+        [3, 31, 32, 1, 31, 1, 32],
+        [4, 32, 36, 1, 32, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "bit set from bool: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { boolValue: true } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "bool support for binary formatting",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32 },
+        },
+      },
+      checkedAst:
+        '"bit set from bool: %b"~string.format(\n  [\n    true~bool\n  ]~list(bool)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BOOL" } } },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "bit set from bool: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { boolValue: true } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "bit set from bool: 1" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "octal formatting clause",
+        expr: '"%o".format([11])',
+        container: "ext",
+        value: { stringValue: "13" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%o"^#*expr.Constant_StringValue#.format(\n  [\n    11^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%o".format([11])',
+      locationAst:
+        '"%o"^#1[1,0]#.format(\n  [\n    11^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "11" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "octal formatting clause",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%o"~string.format(\n  [\n    11~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "11" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "13" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "negative octal formatting clause",
+        expr: '"%o".format([-11])',
+        container: "ext",
+        value: { stringValue: "-13" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%o"^#*expr.Constant_StringValue#.format(\n  [\n    -11^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%o".format([-11])',
+      locationAst:
+        '"%o"^#1[1,0]#.format(\n  [\n    -11^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 16, 1, 13, 1, 16],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-11" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative octal formatting clause",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%o"~string.format(\n  [\n    -11~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-11" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "-13" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "uint support for octal formatting clause",
+        expr: '"this is an unsigned octal: %o".format([uint(65535)])',
+        container: "ext",
+        value: { stringValue: "this is an unsigned octal: 177777" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"this is an unsigned octal: %o"^#*expr.Constant_StringValue#.format(\n  [\n    uint(\n      65535^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"this is an unsigned octal: %o".format([uint(65535)])',
+      locationAst:
+        '"this is an unsigned octal: %o"^#1[1,0]#.format(\n  [\n    uint(\n      65535^#5[1,45]#\n    )^#4[1,44]#\n  ]^#3[1,39]#\n)^#2[1,38]#',
+      positions: [
+        [1, 0, 31, 1, 0, 1, 31],
+        [2, 38, 39, 1, 38, 1, 39],
+        [3, 39, 40, 1, 39, 1, 40],
+        [4, 44, 45, 1, 44, 1, 45],
+        [5, 45, 50, 1, 45, 1, 50],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is an unsigned octal: %o" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "65535" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uint support for octal formatting clause",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 38, "3": 39, "4": 44, "5": 45 },
+        },
+      },
+      checkedAst:
+        '"this is an unsigned octal: %o"~string.format(\n  [\n    uint(\n      65535~int\n    )~uint^int64_to_uint64\n  ]~list(uint)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["int64_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 38, "3": 39, "4": 44, "5": 45 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is an unsigned octal: %o" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "65535" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "this is an unsigned octal: 177777" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "lowercase hexadecimal formatting clause",
+        expr: '"%x is 30 in hexadecimal".format([30])',
+        container: "ext",
+        value: { stringValue: "1e is 30 in hexadecimal" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%x is 30 in hexadecimal"^#*expr.Constant_StringValue#.format(\n  [\n    30^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%x is 30 in hexadecimal".format([30])',
+      locationAst:
+        '"%x is 30 in hexadecimal"^#1[1,0]#.format(\n  [\n    30^#4[1,34]#\n  ]^#3[1,33]#\n)^#2[1,32]#',
+      positions: [
+        [1, 0, 25, 1, 0, 1, 25],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 33, 34, 1, 33, 1, 34],
+        [4, 34, 36, 1, 34, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%x is 30 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "30" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "lowercase hexadecimal formatting clause",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+      },
+      checkedAst:
+        '"%x is 30 in hexadecimal"~string.format(\n  [\n    30~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%x is 30 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "30" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "1e is 30 in hexadecimal" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "uppercase hexadecimal formatting clause",
+        expr: '"%X is 20 in hexadecimal".format([30])',
+        container: "ext",
+        value: { stringValue: "1E is 20 in hexadecimal" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%X is 20 in hexadecimal"^#*expr.Constant_StringValue#.format(\n  [\n    30^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%X is 20 in hexadecimal".format([30])',
+      locationAst:
+        '"%X is 20 in hexadecimal"^#1[1,0]#.format(\n  [\n    30^#4[1,34]#\n  ]^#3[1,33]#\n)^#2[1,32]#',
+      positions: [
+        [1, 0, 25, 1, 0, 1, 25],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 33, 34, 1, 33, 1, 34],
+        [4, 34, 36, 1, 34, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%X is 20 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "30" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uppercase hexadecimal formatting clause",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+      },
+      checkedAst:
+        '"%X is 20 in hexadecimal"~string.format(\n  [\n    30~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%X is 20 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "30" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "1E is 20 in hexadecimal" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "negative hexadecimal formatting clause",
+        expr: '"%x is -30 in hexadecimal".format([-30])',
+        container: "ext",
+        value: { stringValue: "-1e is -30 in hexadecimal" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%x is -30 in hexadecimal"^#*expr.Constant_StringValue#.format(\n  [\n    -30^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%x is -30 in hexadecimal".format([-30])',
+      locationAst:
+        '"%x is -30 in hexadecimal"^#1[1,0]#.format(\n  [\n    -30^#4[1,35]#\n  ]^#3[1,34]#\n)^#2[1,33]#',
+      positions: [
+        [1, 0, 26, 1, 0, 1, 26],
+        [2, 33, 34, 1, 33, 1, 34],
+        [3, 34, 35, 1, 34, 1, 35],
+        [4, 35, 38, 1, 35, 1, 38],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%x is -30 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-30" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative hexadecimal formatting clause",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+      },
+      checkedAst:
+        '"%x is -30 in hexadecimal"~string.format(\n  [\n    -30~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%x is -30 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-30" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "-1e is -30 in hexadecimal" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "unsigned support for hexadecimal formatting clause",
+        expr: '"%X is 6000 in hexadecimal".format([uint(6000)])',
+        container: "ext",
+        value: { stringValue: "1770 is 6000 in hexadecimal" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%X is 6000 in hexadecimal"^#*expr.Constant_StringValue#.format(\n  [\n    uint(\n      6000^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%X is 6000 in hexadecimal".format([uint(6000)])',
+      locationAst:
+        '"%X is 6000 in hexadecimal"^#1[1,0]#.format(\n  [\n    uint(\n      6000^#5[1,41]#\n    )^#4[1,40]#\n  ]^#3[1,35]#\n)^#2[1,34]#',
+      positions: [
+        [1, 0, 27, 1, 0, 1, 27],
+        [2, 34, 35, 1, 34, 1, 35],
+        [3, 35, 36, 1, 35, 1, 36],
+        [4, 40, 41, 1, 40, 1, 41],
+        [5, 41, 45, 1, 41, 1, 45],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%X is 6000 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "6000" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "unsigned support for hexadecimal formatting clause",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 34, "3": 35, "4": 40, "5": 41 },
+        },
+      },
+      checkedAst:
+        '"%X is 6000 in hexadecimal"~string.format(\n  [\n    uint(\n      6000~int\n    )~uint^int64_to_uint64\n  ]~list(uint)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["int64_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 34, "3": 35, "4": 40, "5": 41 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%X is 6000 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "6000" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "1770 is 6000 in hexadecimal" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "string support with hexadecimal formatting clause",
+        expr: '"%x".format(["Hello world!"])',
+        container: "ext",
+        value: { stringValue: "48656c6c6f20776f726c6421" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%x"^#*expr.Constant_StringValue#.format(\n  [\n    "Hello world!"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%x".format(["Hello world!"])',
+      locationAst:
+        '"%x"^#1[1,0]#.format(\n  [\n    "Hello world!"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 27, 1, 13, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Hello world!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "string support with hexadecimal formatting clause",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%x"~string.format(\n  [\n    "Hello world!"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Hello world!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "48656c6c6f20776f726c6421" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "string support with uppercase hexadecimal formatting clause",
+        expr: '"%X".format(["Hello world!"])',
+        container: "ext",
+        value: { stringValue: "48656C6C6F20776F726C6421" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%X"^#*expr.Constant_StringValue#.format(\n  [\n    "Hello world!"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%X".format(["Hello world!"])',
+      locationAst:
+        '"%X"^#1[1,0]#.format(\n  [\n    "Hello world!"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 27, 1, 13, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Hello world!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location:
+            "string support with uppercase hexadecimal formatting clause",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%X"~string.format(\n  [\n    "Hello world!"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Hello world!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "48656C6C6F20776F726C6421" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "byte support with hexadecimal formatting clause",
+        expr: '"%x".format([b"byte string"])',
+        container: "ext",
+        value: { stringValue: "6279746520737472696e67" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%x"^#*expr.Constant_StringValue#.format(\n  [\n    b"byte string"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%x".format([b"\\142\\171\\164\\145\\040\\163\\164\\162\\151\\156\\147"])',
+      locationAst:
+        '"%x"^#1[1,0]#.format(\n  [\n    b"byte string"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 27, 1, 13, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "Ynl0ZSBzdHJpbmc=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "byte support with hexadecimal formatting clause",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%x"~string.format(\n  [\n    b"byte string"~bytes\n  ]~list(bytes)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "Ynl0ZSBzdHJpbmc=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "6279746520737472696e67" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "byte support with hexadecimal formatting clause leading zero",
+        expr: '"%x".format([b"\\x00\\x00byte string\\x00"])',
+        container: "ext",
+        value: { stringValue: "00006279746520737472696e6700" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%x"^#*expr.Constant_StringValue#.format(\n  [\n    b"\\x00\\x00byte string\\x00"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%x".format([b"\\000\\000\\142\\171\\164\\145\\040\\163\\164\\162\\151\\156\\147\\000"])',
+      locationAst:
+        '"%x"^#1[1,0]#.format(\n  [\n    b"\\x00\\x00byte string\\x00"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 39, 1, 13, 1, 39],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { bytesValue: "AABieXRlIHN0cmluZwA=" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location:
+            "byte support with hexadecimal formatting clause leading zero",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%x"~string.format(\n  [\n    b"\\x00\\x00byte string\\x00"~bytes\n  ]~list(bytes)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { bytesValue: "AABieXRlIHN0cmluZwA=" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "00006279746520737472696e6700" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "byte support with uppercase hexadecimal formatting clause",
+        expr: '"%X".format([b"byte string"])',
+        container: "ext",
+        value: { stringValue: "6279746520737472696E67" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%X"^#*expr.Constant_StringValue#.format(\n  [\n    b"byte string"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%X".format([b"\\142\\171\\164\\145\\040\\163\\164\\162\\151\\156\\147"])',
+      locationAst:
+        '"%X"^#1[1,0]#.format(\n  [\n    b"byte string"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 27, 1, 13, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "Ynl0ZSBzdHJpbmc=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "byte support with uppercase hexadecimal formatting clause",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%X"~string.format(\n  [\n    b"byte string"~bytes\n  ]~list(bytes)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "Ynl0ZSBzdHJpbmc=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "6279746520737472696E67" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "scientific notation formatting clause",
+        expr: '"%.6e".format([1052.032911275])',
+        container: "ext",
+        value: { stringValue: "1.052033 × 10⁰³" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%.6e"^#*expr.Constant_StringValue#.format(\n  [\n    1052.032911275^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%.6e".format([1052.032911275])',
+      locationAst:
+        '"%.6e"^#1[1,0]#.format(\n  [\n    1052.032911275^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 29, 1, 15, 1, 29],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.6e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { doubleValue: 1052.032911275 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "scientific notation formatting clause",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%.6e"~string.format(\n  [\n    1052.032911275~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.6e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { doubleValue: 1052.032911275 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "1.052033 × 10⁰³" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "locale support",
+        expr: '"%.3f".format([3.14])',
+        container: "ext",
+        value: { stringValue: "3,140" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "fr_FR",
+      ast: '"%.3f"^#*expr.Constant_StringValue#.format(\n  [\n    3.14^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%.3f".format([3.14])',
+      locationAst:
+        '"%.3f"^#1[1,0]#.format(\n  [\n    3.14^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 19, 1, 15, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.3f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 3.14 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "locale support",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%.3f"~string.format(\n  [\n    3.14~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.3f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 3.14 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "3,140" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default precision for fixed-point clause",
+        expr: '"%f".format([2.71828])',
+        container: "ext",
+        value: { stringValue: "2.718280" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%f"^#*expr.Constant_StringValue#.format(\n  [\n    2.71828^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%f".format([2.71828])',
+      locationAst:
+        '"%f"^#1[1,0]#.format(\n  [\n    2.71828^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 20, 1, 13, 1, 20],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71828 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default precision for fixed-point clause",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%f"~string.format(\n  [\n    2.71828~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71828 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "2.718280" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default precision for scientific notation",
+        expr: '"%e".format([2.71828])',
+        container: "ext",
+        value: { stringValue: "2.718280 × 10⁰⁰" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%e"^#*expr.Constant_StringValue#.format(\n  [\n    2.71828^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%e".format([2.71828])',
+      locationAst:
+        '"%e"^#1[1,0]#.format(\n  [\n    2.71828^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 20, 1, 13, 1, 20],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71828 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default precision for scientific notation",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%e"~string.format(\n  [\n    2.71828~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71828 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "2.718280 × 10⁰⁰" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default precision for string",
+        expr: '"%s".format([2.71])',
+        container: "ext",
+        value: { stringValue: "2.71" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    2.71^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([2.71])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    2.71^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 17, 1, 13, 1, 17],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default precision for string",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    2.71~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "2.71" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default list precision for string",
+        expr: '"%s".format([[2.71]])',
+        container: "ext",
+        value: { stringValue: "[2.710000]" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      2.71^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([[2.71]])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    [\n      2.71^#5[1,14]#\n    ]^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 18, 1, 14, 1, 18],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { doubleValue: 2.71 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default list precision for string",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13, "5": 14 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    [\n      2.71~double\n    ]~list(double)\n  ]~list(list(double))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13, "5": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { doubleValue: 2.71 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "21", max: "21" },
+      result: { value: { stringValue: "[2.710000]" } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        name: "default scientific notation for string",
+        expr: '"%s".format([0.000000002])',
+        container: "ext",
+        value: { stringValue: "2e-09" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    2e-09^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([2e-09.0])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    2e-09^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 24, 1, 13, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2e-9 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default scientific notation for string",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    2e-09~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2e-9 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "2e-09" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default list scientific notation for string",
+        expr: '"%s".format([[0.000000002]])',
+        container: "ext",
+        value: { stringValue: "[0.000000]" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      2e-09^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([[2e-09.0]])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    [\n      2e-09^#5[1,14]#\n    ]^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 25, 1, 14, 1, 25],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { doubleValue: 2e-9 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default list scientific notation for string",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13, "5": 14 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    [\n      2e-09~double\n    ]~list(double)\n  ]~list(list(double))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13, "5": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { doubleValue: 2e-9 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "21", max: "21" },
+      result: { value: { stringValue: "[0.000000]" } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        name: "unicode output for scientific notation",
+        expr: '"unescaped unicode: %e, escaped unicode: %e".format([2.71828, 2.71828])',
+        container: "ext",
+        value: {
+          stringValue:
+            "unescaped unicode: 2.718280 × 10⁰⁰, escaped unicode: 2.718280 × 10⁰⁰",
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"unescaped unicode: %e, escaped unicode: %e"^#*expr.Constant_StringValue#.format(\n  [\n    2.71828^#*expr.Constant_DoubleValue#,\n    2.71828^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"unescaped unicode: %e, escaped unicode: %e".format([2.71828, 2.71828])',
+      locationAst:
+        '"unescaped unicode: %e, escaped unicode: %e"^#1[1,0]#.format(\n  [\n    2.71828^#4[1,53]#,\n    2.71828^#5[1,62]#\n  ]^#3[1,52]#\n)^#2[1,51]#',
+      positions: [
+        [1, 0, 44, 1, 0, 1, 44],
+        [2, 51, 52, 1, 51, 1, 52],
+        [3, 52, 53, 1, 52, 1, 53],
+        [4, 53, 60, 1, 53, 1, 60],
+        [5, 62, 69, 1, 62, 1, 69],
+      ],
+      lineOffsets: [72],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: {
+                stringValue: "unescaped unicode: %e, escaped unicode: %e",
+              },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { doubleValue: 2.71828 } },
+                    { id: "5", constExpr: { doubleValue: 2.71828 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "unicode output for scientific notation",
+          lineOffsets: [72],
+          positions: { "1": 0, "2": 51, "3": 52, "4": 53, "5": 62 },
+        },
+      },
+      checkedAst:
+        '"unescaped unicode: %e, escaped unicode: %e"~string.format(\n  [\n    2.71828~double,\n    2.71828~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [72],
+          positions: { "1": 0, "2": 51, "3": 52, "4": 53, "5": 62 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: {
+                stringValue: "unescaped unicode: %e, escaped unicode: %e",
+              },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { doubleValue: 2.71828 } },
+                    { id: "5", constExpr: { doubleValue: 2.71828 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "15", max: "15" },
+      result: {
+        value: {
+          stringValue:
+            "unescaped unicode: 2.718280 × 10⁰⁰, escaped unicode: 2.718280 × 10⁰⁰",
+        },
+      },
+      runtimeCost: "15",
+    },
+    {
+      original: {
+        name: "NaN support for fixed-point",
+        expr: '"%f".format(["NaN"])',
+        container: "ext",
+        value: { stringValue: "NaN" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%f"^#*expr.Constant_StringValue#.format(\n  [\n    "NaN"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%f".format(["NaN"])',
+      locationAst:
+        '"%f"^#1[1,0]#.format(\n  [\n    "NaN"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 18, 1, 13, 1, 18],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "NaN" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "NaN support for fixed-point",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%f"~string.format(\n  [\n    "NaN"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "NaN" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "NaN" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "positive infinity support for fixed-point",
+        expr: '"%f".format(["Infinity"])',
+        container: "ext",
+        value: { stringValue: "∞" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%f"^#*expr.Constant_StringValue#.format(\n  [\n    "Infinity"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%f".format(["Infinity"])',
+      locationAst:
+        '"%f"^#1[1,0]#.format(\n  [\n    "Infinity"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 23, 1, 13, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Infinity" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "positive infinity support for fixed-point",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%f"~string.format(\n  [\n    "Infinity"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Infinity" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "∞" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "negative infinity support for fixed-point",
+        expr: '"%f".format(["-Infinity"])',
+        container: "ext",
+        value: { stringValue: "-∞" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"%f"^#*expr.Constant_StringValue#.format(\n  [\n    "-Infinity"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%f".format(["-Infinity"])',
+      locationAst:
+        '"%f"^#1[1,0]#.format(\n  [\n    "-Infinity"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 24, 1, 13, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "-Infinity" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative infinity support for fixed-point",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%f"~string.format(\n  [\n    "-Infinity"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "-Infinity" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "-∞" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "NaN support for string",
+        expr: '"%s".format([double("NaN")])',
+        container: "ext",
+        value: { stringValue: "NaN" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "NaN"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([double("NaN")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    double(\n      "NaN"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 25, 1, 20, 1, 25],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "NaN" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "NaN support for string",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    double(\n      "NaN"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "NaN" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "NaN" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "positive infinity support for string",
+        expr: '"%s".format([double("Inf")])',
+        container: "ext",
+        value: { stringValue: "+Inf" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "Inf"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([double("Inf")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    double(\n      "Inf"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 25, 1, 20, 1, 25],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "Inf" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "positive infinity support for string",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    double(\n      "Inf"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "Inf" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "+Inf" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "negative infinity support for string",
+        expr: '"%s".format([double("-Inf")])',
+        container: "ext",
+        value: { stringValue: "-Inf" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "-Inf"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([double("-Inf")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    double(\n      "-Inf"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 26, 1, 20, 1, 26],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "-Inf" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative infinity support for string",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    double(\n      "-Inf"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "-Inf" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "-Inf" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "infinity list support for string",
+        expr: '"%s".format([[double("NaN"),double("+Inf"), double("-Inf")]])',
+        container: "ext",
+        value: { stringValue: '["NaN", "+Inf", "-Inf"]' },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      double(\n        "NaN"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      double(\n        "+Inf"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      double(\n        "-Inf"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%s".format([[double("NaN"), double("+Inf"), double("-Inf")]])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    [\n      double(\n        "NaN"^#6[1,21]#\n      )^#5[1,20]#,\n      double(\n        "+Inf"^#8[1,35]#\n      )^#7[1,34]#,\n      double(\n        "-Inf"^#10[1,51]#\n      )^#9[1,50]#\n    ]^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 21, 26, 1, 21, 1, 26],
+        [7, 34, 35, 1, 34, 1, 35],
+        [8, 35, 41, 1, 35, 1, 41],
+        [9, 50, 51, 1, 50, 1, 51],
+        [10, 51, 57, 1, 51, 1, 57],
+      ],
+      lineOffsets: [62],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                { id: "6", constExpr: { stringValue: "NaN" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "7",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                { id: "8", constExpr: { stringValue: "+Inf" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                {
+                                  id: "10",
+                                  constExpr: { stringValue: "-Inf" },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "infinity list support for string",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 20,
+            "6": 21,
+            "7": 34,
+            "8": 35,
+            "9": 50,
+            "10": 51,
+          },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    [\n      double(\n        "NaN"~string\n      )~double^string_to_double,\n      double(\n        "+Inf"~string\n      )~double^string_to_double,\n      double(\n        "-Inf"~string\n      )~double^string_to_double\n    ]~list(double)\n  ]~list(list(double))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "5": { overloadId: ["string_to_double"] },
+          "7": { overloadId: ["string_to_double"] },
+          "9": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 20,
+            "6": 21,
+            "7": 34,
+            "8": 35,
+            "9": 50,
+            "10": 51,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                { id: "6", constExpr: { stringValue: "NaN" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "7",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                { id: "8", constExpr: { stringValue: "+Inf" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                {
+                                  id: "10",
+                                  constExpr: { stringValue: "-Inf" },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "24", max: "24" },
+      result: { value: { stringValue: '["NaN", "+Inf", "-Inf"]' } },
+      runtimeCost: "24",
+    },
+    {
+      original: {
+        name: "uint support for decimal clause",
+        expr: '"%d".format([uint(64)])',
+        container: "ext",
+        value: { stringValue: "64" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%d"^#*expr.Constant_StringValue#.format(\n  [\n    uint(\n      64^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%d".format([uint(64)])',
+      locationAst:
+        '"%d"^#1[1,0]#.format(\n  [\n    uint(\n      64^#5[1,18]#\n    )^#4[1,17]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "64" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uint support for decimal clause",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 18 },
+        },
+      },
+      checkedAst:
+        '"%d"~string.format(\n  [\n    uint(\n      64~int\n    )~uint^int64_to_uint64\n  ]~list(uint)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["int64_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 18 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "64" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "64" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "null support for string",
+        expr: '"null: %s".format([null])',
+        container: "ext",
+        value: { stringValue: "null: null" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"null: %s"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %s".format([null])',
+      locationAst:
+        '"null: %s"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null support for string",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      checkedAst:
+        '"null: %s"~string.format(\n  [\n    null~null\n  ]~list(null)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { null: null } } },
+          "4": { null: null },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "null: null" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "int support for string",
+        expr: '"%s".format([999999999999])',
+        container: "ext",
+        value: { stringValue: "999999999999" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    999999999999^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([999999999999])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    999999999999^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 25, 1, 13, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "999999999999" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "int support for string",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    999999999999~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "999999999999" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "999999999999" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "bytes support for string",
+        expr: '"some bytes: %s".format([b"xyz"])',
+        container: "ext",
+        value: { stringValue: "some bytes: xyz" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"some bytes: %s"^#*expr.Constant_StringValue#.format(\n  [\n    b"xyz"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"some bytes: %s".format([b"\\170\\171\\172"])',
+      locationAst:
+        '"some bytes: %s"^#1[1,0]#.format(\n  [\n    b"xyz"^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 31, 1, 25, 1, 31],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "some bytes: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { bytesValue: "eHl6" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "bytes support for string",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      checkedAst:
+        '"some bytes: %s"~string.format(\n  [\n    b"xyz"~bytes\n  ]~list(bytes)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "some bytes: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { bytesValue: "eHl6" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "some bytes: xyz" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "type() support for string",
+        expr: '"type is %s".format([type("test string")])',
+        container: "ext",
+        value: { stringValue: "type is string" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"type is %s"^#*expr.Constant_StringValue#.format(\n  [\n    type(\n      "test string"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"type is %s".format([type("test string")])',
+      locationAst:
+        '"type is %s"^#1[1,0]#.format(\n  [\n    type(\n      "test string"^#5[1,26]#\n    )^#4[1,25]#\n  ]^#3[1,20]#\n)^#2[1,19]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 26, 39, 1, 26, 1, 39],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "type is %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "type",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: { stringValue: "test string" },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "type() support for string",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 25, "5": 26 },
+        },
+      },
+      checkedAst:
+        '"type is %s"~string.format(\n  [\n    type(\n      "test string"~string\n    )~type(string)^type\n  ]~list(type(string))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["type"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { type: { primitive: "STRING" } } } },
+          "4": { type: { primitive: "STRING" } },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 25, "5": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "type is %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "type",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: { stringValue: "test string" },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "type is string" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "timestamp support for string",
+        expr: '"%s".format([timestamp("2023-02-03T23:31:20+00:00")])',
+        container: "ext",
+        value: { stringValue: "2023-02-03T23:31:20Z" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    timestamp(\n      "2023-02-03T23:31:20+00:00"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([timestamp("2023-02-03T23:31:20+00:00")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    timestamp(\n      "2023-02-03T23:31:20+00:00"^#5[1,23]#\n    )^#4[1,22]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 23, 50, 1, 23, 1, 50],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "timestamp",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: {
+                              stringValue: "2023-02-03T23:31:20+00:00",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp support for string",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 22, "5": 23 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    timestamp(\n      "2023-02-03T23:31:20+00:00"~string\n    )~timestamp^string_to_timestamp\n  ]~list(timestamp)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { wellKnown: "TIMESTAMP" } } },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 22, "5": 23 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "timestamp",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: {
+                              stringValue: "2023-02-03T23:31:20+00:00",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "2023-02-03T23:31:20Z" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "duration support for string",
+        expr: '"%s".format([duration("1h45m47s")])',
+        container: "ext",
+        value: { stringValue: "6347s" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    duration(\n      "1h45m47s"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([duration("1h45m47s")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    duration(\n      "1h45m47s"^#5[1,22]#\n    )^#4[1,21]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 32, 1, 22, 1, 32],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "1h45m47s" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "duration support for string",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    duration(\n      "1h45m47s"~string\n    )~duration^string_to_duration\n  ]~list(duration)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_duration"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { wellKnown: "DURATION" } } },
+          "4": { wellKnown: "DURATION" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "1h45m47s" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "6347s" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "small duration support for string",
+        expr: '"%s".format([duration("2ns")])',
+        container: "ext",
+        value: { stringValue: "0.000000002s" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    duration(\n      "2ns"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([duration("2ns")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    duration(\n      "2ns"^#5[1,22]#\n    )^#4[1,21]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 27, 1, 22, 1, 27],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [{ id: "5", constExpr: { stringValue: "2ns" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "small duration support for string",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    duration(\n      "2ns"~string\n    )~duration^string_to_duration\n  ]~list(duration)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_duration"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { wellKnown: "DURATION" } } },
+          "4": { wellKnown: "DURATION" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [{ id: "5", constExpr: { stringValue: "2ns" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "0.000000002s" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "list support for string",
+        expr: '"%s".format([["abc", 3.14, null, [9, 8, 7, 6], timestamp("2023-02-03T23:31:20Z")]])',
+        container: "ext",
+        value: {
+          stringValue:
+            '["abc", 3.140000, null, [9, 8, 7, 6], timestamp("2023-02-03T23:31:20Z")]',
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      "abc"^#*expr.Constant_StringValue#,\n      3.14^#*expr.Constant_DoubleValue#,\n      null^#*expr.Constant_NullValue#,\n      [\n        9^#*expr.Constant_Int64Value#,\n        8^#*expr.Constant_Int64Value#,\n        7^#*expr.Constant_Int64Value#,\n        6^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#,\n      timestamp(\n        "2023-02-03T23:31:20Z"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%s".format([["abc", 3.14, null, [9, 8, 7, 6], timestamp("2023-02-03T23:31:20Z")]])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    [\n      "abc"^#5[1,14]#,\n      3.14^#6[1,21]#,\n      null^#7[1,27]#,\n      [\n        9^#9[1,34]#,\n        8^#10[1,37]#,\n        7^#11[1,40]#,\n        6^#12[1,43]#\n      ]^#8[1,33]#,\n      timestamp(\n        "2023-02-03T23:31:20Z"^#14[1,57]#\n      )^#13[1,56]#\n    ]^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 19, 1, 14, 1, 19],
+        [6, 21, 25, 1, 21, 1, 25],
+        [7, 27, 31, 1, 27, 1, 31],
+        [8, 33, 34, 1, 33, 1, 34],
+        [9, 34, 35, 1, 34, 1, 35],
+        [10, 37, 38, 1, 37, 1, 38],
+        [11, 40, 41, 1, 40, 1, 41],
+        [12, 43, 44, 1, 43, 1, 44],
+        [13, 56, 57, 1, 56, 1, 57],
+        [14, 57, 79, 1, 57, 1, 79],
+      ],
+      lineOffsets: [84],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { stringValue: "abc" } },
+                          { id: "6", constExpr: { doubleValue: 3.14 } },
+                          { id: "7", constExpr: { nullValue: null } },
+                          {
+                            id: "8",
+                            listExpr: {
+                              elements: [
+                                { id: "9", constExpr: { int64Value: "9" } },
+                                { id: "10", constExpr: { int64Value: "8" } },
+                                { id: "11", constExpr: { int64Value: "7" } },
+                                { id: "12", constExpr: { int64Value: "6" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "timestamp",
+                              args: [
+                                {
+                                  id: "14",
+                                  constExpr: {
+                                    stringValue: "2023-02-03T23:31:20Z",
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list support for string",
+          lineOffsets: [84],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 14,
+            "6": 21,
+            "7": 27,
+            "8": 33,
+            "9": 34,
+            "10": 37,
+            "11": 40,
+            "12": 43,
+            "13": 56,
+            "14": 57,
+          },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    [\n      "abc"~string,\n      3.14~double,\n      null~null,\n      [\n        9~int,\n        8~int,\n        7~int,\n        6~int\n      ]~list(int),\n      timestamp(\n        "2023-02-03T23:31:20Z"~string\n      )~timestamp^string_to_timestamp\n    ]~list(dyn)\n  ]~list(list(dyn))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "13": { overloadId: ["string_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "DOUBLE" },
+          "7": { null: null },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { wellKnown: "TIMESTAMP" },
+          "14": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [84],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 14,
+            "6": 21,
+            "7": 27,
+            "8": 33,
+            "9": 34,
+            "10": 37,
+            "11": 40,
+            "12": 43,
+            "13": 56,
+            "14": 57,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { stringValue: "abc" } },
+                          { id: "6", constExpr: { doubleValue: 3.14 } },
+                          { id: "7", constExpr: { nullValue: null } },
+                          {
+                            id: "8",
+                            listExpr: {
+                              elements: [
+                                { id: "9", constExpr: { int64Value: "9" } },
+                                { id: "10", constExpr: { int64Value: "8" } },
+                                { id: "11", constExpr: { int64Value: "7" } },
+                                { id: "12", constExpr: { int64Value: "6" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "timestamp",
+                              args: [
+                                {
+                                  id: "14",
+                                  constExpr: {
+                                    stringValue: "2023-02-03T23:31:20Z",
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "32", max: "32" },
+      result: {
+        value: {
+          stringValue:
+            '["abc", 3.140000, null, [9, 8, 7, 6], timestamp("2023-02-03T23:31:20Z")]',
+        },
+      },
+      runtimeCost: "32",
+    },
+    {
+      original: {
+        name: "map support for string",
+        expr: '"%s".format([{"key1": b"xyz", "key5": null, "key2": duration("2h"), "key4": true, "key3": 2.71828}])',
+        container: "ext",
+        value: {
+          stringValue:
+            '{"key1":b"xyz", "key2":duration("7200s"), "key3":2.718280, "key4":true, "key5":null}',
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "nl_NL",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    {\n      "key1"^#*expr.Constant_StringValue#:b"xyz"^#*expr.Constant_BytesValue#^#*expr.Expr_CreateStruct_Entry#,\n      "key5"^#*expr.Constant_StringValue#:null^#*expr.Constant_NullValue#^#*expr.Expr_CreateStruct_Entry#,\n      "key2"^#*expr.Constant_StringValue#:duration(\n        "2h"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#,\n      "key4"^#*expr.Constant_StringValue#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#,\n      "key3"^#*expr.Constant_StringValue#:2.71828^#*expr.Constant_DoubleValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%s".format([{"key1": b"\\170\\171\\172", "key5": null, "key2": duration("2h"), "key4": true, "key3": 2.71828}])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    {\n      "key1"^#6[1,14]#:b"xyz"^#7[1,22]#^#5[1,20]#,\n      "key5"^#9[1,30]#:null^#10[1,38]#^#8[1,36]#,\n      "key2"^#12[1,44]#:duration(\n        "2h"^#14[1,61]#\n      )^#13[1,60]#^#11[1,50]#,\n      "key4"^#16[1,68]#:true^#17[1,76]#^#15[1,74]#,\n      "key3"^#19[1,82]#:2.71828^#20[1,90]#^#18[1,88]#\n    }^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 14, 20, 1, 14, 1, 20],
+        [7, 22, 28, 1, 22, 1, 28],
+        [8, 36, 37, 1, 36, 1, 37],
+        [9, 30, 36, 1, 30, 1, 36],
+        [10, 38, 42, 1, 38, 1, 42],
+        [11, 50, 51, 1, 50, 1, 51],
+        [12, 44, 50, 1, 44, 1, 50],
+        [13, 60, 61, 1, 60, 1, 61],
+        [14, 61, 65, 1, 61, 1, 65],
+        [15, 74, 75, 1, 74, 1, 75],
+        [16, 68, 74, 1, 68, 1, 74],
+        [17, 76, 80, 1, 76, 1, 80],
+        [18, 88, 89, 1, 88, 1, 89],
+        [19, 82, 88, 1, 82, 1, 88],
+        [20, 90, 97, 1, 90, 1, 97],
+      ],
+      lineOffsets: [101],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: {
+                              id: "6",
+                              constExpr: { stringValue: "key1" },
+                            },
+                            value: {
+                              id: "7",
+                              constExpr: { bytesValue: "eHl6" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              constExpr: { stringValue: "key5" },
+                            },
+                            value: { id: "10", constExpr: { nullValue: null } },
+                          },
+                          {
+                            id: "11",
+                            mapKey: {
+                              id: "12",
+                              constExpr: { stringValue: "key2" },
+                            },
+                            value: {
+                              id: "13",
+                              callExpr: {
+                                function: "duration",
+                                args: [
+                                  {
+                                    id: "14",
+                                    constExpr: { stringValue: "2h" },
+                                  },
+                                ],
+                              },
+                            },
+                          },
+                          {
+                            id: "15",
+                            mapKey: {
+                              id: "16",
+                              constExpr: { stringValue: "key4" },
+                            },
+                            value: { id: "17", constExpr: { boolValue: true } },
+                          },
+                          {
+                            id: "18",
+                            mapKey: {
+                              id: "19",
+                              constExpr: { stringValue: "key3" },
+                            },
+                            value: {
+                              id: "20",
+                              constExpr: { doubleValue: 2.71828 },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "map support for string",
+          lineOffsets: [101],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 20,
+            "6": 14,
+            "7": 22,
+            "8": 36,
+            "9": 30,
+            "10": 38,
+            "11": 50,
+            "12": 44,
+            "13": 60,
+            "14": 61,
+            "15": 74,
+            "16": 68,
+            "17": 76,
+            "18": 88,
+            "19": 82,
+            "20": 90,
+          },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    {\n      "key1"~string:b"xyz"~bytes,\n      "key5"~string:null~null,\n      "key2"~string:duration(\n        "2h"~string\n      )~duration^string_to_duration,\n      "key4"~string:true~bool,\n      "key3"~string:2.71828~double\n    }~map(string, dyn)\n  ]~list(map(string, dyn))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "13": { overloadId: ["string_to_duration"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { dyn: {} },
+                },
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BYTES" },
+          "9": { primitive: "STRING" },
+          "10": { null: null },
+          "12": { primitive: "STRING" },
+          "13": { wellKnown: "DURATION" },
+          "14": { primitive: "STRING" },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "BOOL" },
+          "19": { primitive: "STRING" },
+          "20": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [101],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 20,
+            "6": 14,
+            "7": 22,
+            "8": 36,
+            "9": 30,
+            "10": 38,
+            "11": 50,
+            "12": 44,
+            "13": 60,
+            "14": 61,
+            "15": 74,
+            "16": 68,
+            "17": 76,
+            "18": 88,
+            "19": 82,
+            "20": 90,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: {
+                              id: "6",
+                              constExpr: { stringValue: "key1" },
+                            },
+                            value: {
+                              id: "7",
+                              constExpr: { bytesValue: "eHl6" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              constExpr: { stringValue: "key5" },
+                            },
+                            value: { id: "10", constExpr: { nullValue: null } },
+                          },
+                          {
+                            id: "11",
+                            mapKey: {
+                              id: "12",
+                              constExpr: { stringValue: "key2" },
+                            },
+                            value: {
+                              id: "13",
+                              callExpr: {
+                                function: "duration",
+                                args: [
+                                  {
+                                    id: "14",
+                                    constExpr: { stringValue: "2h" },
+                                  },
+                                ],
+                              },
+                            },
+                          },
+                          {
+                            id: "15",
+                            mapKey: {
+                              id: "16",
+                              constExpr: { stringValue: "key4" },
+                            },
+                            value: { id: "17", constExpr: { boolValue: true } },
+                          },
+                          {
+                            id: "18",
+                            mapKey: {
+                              id: "19",
+                              constExpr: { stringValue: "key3" },
+                            },
+                            value: {
+                              id: "20",
+                              constExpr: { doubleValue: 2.71828 },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "42", max: "42" },
+      result: {
+        value: {
+          stringValue:
+            '{"key1":b"xyz", "key2":duration("7200s"), "key3":2.718280, "key4":true, "key5":null}',
+        },
+      },
+      runtimeCost: "42",
+    },
+    {
+      original: {
+        name: "map support (all key types)",
+        expr: '"map with multiple key types: %s".format([{1: "value1", uint(2): "value2", true: double("NaN")}])',
+        container: "ext",
+        value: {
+          stringValue:
+            'map with multiple key types: {1:"value1", 2:"value2", true:"NaN"}',
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"map with multiple key types: %s"^#*expr.Constant_StringValue#.format(\n  [\n    {\n      1^#*expr.Constant_Int64Value#:"value1"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      uint(\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#:"value2"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      true^#*expr.Constant_BoolValue#:double(\n        "NaN"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"map with multiple key types: %s".format([{1: "value1", uint(2): "value2", true: double("NaN")}])',
+      locationAst:
+        '"map with multiple key types: %s"^#1[1,0]#.format(\n  [\n    {\n      1^#6[1,43]#:"value1"^#7[1,46]#^#5[1,44]#,\n      uint(\n        2^#10[1,61]#\n      )^#9[1,60]#:"value2"^#11[1,65]#^#8[1,63]#,\n      true^#13[1,75]#:double(\n        "NaN"^#15[1,88]#\n      )^#14[1,87]#^#12[1,79]#\n    }^#4[1,42]#\n  ]^#3[1,41]#\n)^#2[1,40]#',
+      positions: [
+        [1, 0, 33, 1, 0, 1, 33],
+        [2, 40, 41, 1, 40, 1, 41],
+        [3, 41, 42, 1, 41, 1, 42],
+        [4, 42, 43, 1, 42, 1, 43],
+        [5, 44, 45, 1, 44, 1, 45],
+        [6, 43, 44, 1, 43, 1, 44],
+        [7, 46, 54, 1, 46, 1, 54],
+        [8, 63, 64, 1, 63, 1, 64],
+        [9, 60, 61, 1, 60, 1, 61],
+        [10, 61, 62, 1, 61, 1, 62],
+        [11, 65, 73, 1, 65, 1, 73],
+        [12, 79, 80, 1, 79, 1, 80],
+        [13, 75, 79, 1, 75, 1, 79],
+        [14, 87, 88, 1, 87, 1, 88],
+        [15, 88, 93, 1, 88, 1, 93],
+      ],
+      lineOffsets: [98],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "map with multiple key types: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "value1" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              callExpr: {
+                                function: "uint",
+                                args: [
+                                  { id: "10", constExpr: { int64Value: "2" } },
+                                ],
+                              },
+                            },
+                            value: {
+                              id: "11",
+                              constExpr: { stringValue: "value2" },
+                            },
+                          },
+                          {
+                            id: "12",
+                            mapKey: {
+                              id: "13",
+                              constExpr: { boolValue: true },
+                            },
+                            value: {
+                              id: "14",
+                              callExpr: {
+                                function: "double",
+                                args: [
+                                  {
+                                    id: "15",
+                                    constExpr: { stringValue: "NaN" },
+                                  },
+                                ],
+                              },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "map support (all key types)",
+          lineOffsets: [98],
+          positions: {
+            "1": 0,
+            "2": 40,
+            "3": 41,
+            "4": 42,
+            "5": 44,
+            "6": 43,
+            "7": 46,
+            "8": 63,
+            "9": 60,
+            "10": 61,
+            "11": 65,
+            "12": 79,
+            "13": 75,
+            "14": 87,
+            "15": 88,
+          },
+        },
+      },
+      checkedAst:
+        '"map with multiple key types: %s"~string.format(\n  [\n    {\n      1~int:"value1"~string,\n      uint(\n        2~int\n      )~uint^int64_to_uint64:"value2"~string,\n      true~bool:double(\n        "NaN"~string\n      )~double^string_to_double\n    }~map(dyn, dyn)\n  ]~list(map(dyn, dyn))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "9": { overloadId: ["int64_to_uint64"] },
+          "14": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: {
+                mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } },
+              },
+            },
+          },
+          "4": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "9": { primitive: "UINT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "STRING" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "DOUBLE" },
+          "15": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [98],
+          positions: {
+            "1": 0,
+            "2": 40,
+            "3": 41,
+            "4": 42,
+            "5": 44,
+            "6": 43,
+            "7": 46,
+            "8": 63,
+            "9": 60,
+            "10": 61,
+            "11": 65,
+            "12": 79,
+            "13": 75,
+            "14": 87,
+            "15": 88,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "map with multiple key types: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "value1" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              callExpr: {
+                                function: "uint",
+                                args: [
+                                  { id: "10", constExpr: { int64Value: "2" } },
+                                ],
+                              },
+                            },
+                            value: {
+                              id: "11",
+                              constExpr: { stringValue: "value2" },
+                            },
+                          },
+                          {
+                            id: "12",
+                            mapKey: {
+                              id: "13",
+                              constExpr: { boolValue: true },
+                            },
+                            value: {
+                              id: "14",
+                              callExpr: {
+                                function: "double",
+                                args: [
+                                  {
+                                    id: "15",
+                                    constExpr: { stringValue: "NaN" },
+                                  },
+                                ],
+                              },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "46", max: "46" },
+      result: {
+        value: {
+          stringValue:
+            'map with multiple key types: {1:"value1", 2:"value2", true:"NaN"}',
+        },
+      },
+      runtimeCost: "46",
+    },
+    {
+      original: {
+        name: "boolean support for %s",
+        expr: '"true bool: %s, false bool: %s".format([true, false])',
+        container: "ext",
+        value: { stringValue: "true bool: true, false bool: false" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"true bool: %s, false bool: %s"^#*expr.Constant_StringValue#.format(\n  [\n    true^#*expr.Constant_BoolValue#,\n    false^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"true bool: %s, false bool: %s".format([true, false])',
+      locationAst:
+        '"true bool: %s, false bool: %s"^#1[1,0]#.format(\n  [\n    true^#4[1,40]#,\n    false^#5[1,46]#\n  ]^#3[1,39]#\n)^#2[1,38]#',
+      positions: [
+        [1, 0, 31, 1, 0, 1, 31],
+        [2, 38, 39, 1, 38, 1, 39],
+        [3, 39, 40, 1, 39, 1, 40],
+        [4, 40, 44, 1, 40, 1, 44],
+        [5, 46, 51, 1, 46, 1, 51],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "true bool: %s, false bool: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { boolValue: true } },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "boolean support for %s",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 38, "3": 39, "4": 40, "5": 46 },
+        },
+      },
+      checkedAst:
+        '"true bool: %s, false bool: %s"~string.format(\n  [\n    true~bool,\n    false~bool\n  ]~list(bool)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BOOL" } } },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 38, "3": 39, "4": 40, "5": 46 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "true bool: %s, false bool: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { boolValue: true } },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "true bool: true, false bool: false" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for string formatting clause",
+        expr: '"dynamic string: %s".format([dynStr])',
+        typeEnv: [{ name: "dynStr", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynStr: { value: { stringValue: "a string" } } },
+        value: { stringValue: "dynamic string: a string" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dynamic string: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynStr^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic string: %s".format([dynStr])',
+      locationAst:
+        '"dynamic string: %s"^#1[1,0]#.format(\n  [\n    dynStr^#4[1,29]#\n  ]^#3[1,28]#\n)^#2[1,27]#',
+      positions: [
+        [1, 0, 20, 1, 0, 1, 20],
+        [2, 27, 28, 1, 27, 1, 28],
+        [3, 28, 29, 1, 28, 1, 29],
+        [4, 29, 35, 1, 29, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic string: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynStr" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for string formatting clause",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 27, "3": 28, "4": 29 },
+        },
+      },
+      checkedAst:
+        '"dynamic string: %s"~string.format(\n  [\n    dynStr~dyn^dynStr\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynStr" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 27, "3": 28, "4": 29 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic string: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynStr" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic string: a string" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for numbers with string formatting clause",
+        expr: '"dynIntStr: %s dynDoubleStr: %s".format([dynIntStr, dynDoubleStr])',
+        typeEnv: [
+          { name: "dynDoubleStr", ident: { type: { dyn: {} } } },
+          { name: "dynIntStr", ident: { type: { dyn: {} } } },
+        ],
+        container: "ext",
+        bindings: {
+          dynDoubleStr: { value: { doubleValue: 56.8 } },
+          dynIntStr: { value: { int64Value: "32" } },
+        },
+        value: { stringValue: "dynIntStr: 32 dynDoubleStr: 56.8" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"dynIntStr: %s dynDoubleStr: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynIntStr^#*expr.Expr_IdentExpr#,\n    dynDoubleStr^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"dynIntStr: %s dynDoubleStr: %s".format([dynIntStr, dynDoubleStr])',
+      locationAst:
+        '"dynIntStr: %s dynDoubleStr: %s"^#1[1,0]#.format(\n  [\n    dynIntStr^#4[1,41]#,\n    dynDoubleStr^#5[1,52]#\n  ]^#3[1,40]#\n)^#2[1,39]#',
+      positions: [
+        [1, 0, 32, 1, 0, 1, 32],
+        [2, 39, 40, 1, 39, 1, 40],
+        [3, 40, 41, 1, 40, 1, 41],
+        [4, 41, 50, 1, 41, 1, 50],
+        [5, 52, 64, 1, 52, 1, 64],
+      ],
+      lineOffsets: [67],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynIntStr: %s dynDoubleStr: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynIntStr" } },
+                    { id: "5", identExpr: { name: "dynDoubleStr" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for numbers with string formatting clause",
+          lineOffsets: [67],
+          positions: { "1": 0, "2": 39, "3": 40, "4": 41, "5": 52 },
+        },
+      },
+      checkedAst:
+        '"dynIntStr: %s dynDoubleStr: %s"~string.format(\n  [\n    dynIntStr~dyn^dynIntStr,\n    dynDoubleStr~dyn^dynDoubleStr\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynIntStr" },
+          "5": { name: "dynDoubleStr" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: { "1": 0, "2": 39, "3": 40, "4": 41, "5": 52 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynIntStr: %s dynDoubleStr: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynIntStr" } },
+                    { id: "5", identExpr: { name: "dynDoubleStr" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "15", max: "15" },
+      result: { value: { stringValue: "dynIntStr: 32 dynDoubleStr: 56.8" } },
+      runtimeCost: "15",
+    },
+    {
+      original: {
+        name: "dyntype support for integer formatting clause",
+        expr: '"dynamic int: %d".format([dynInt])',
+        typeEnv: [{ name: "dynInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynInt: { value: { int64Value: "128" } } },
+        value: { stringValue: "dynamic int: 128" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dynamic int: %d"^#*expr.Constant_StringValue#.format(\n  [\n    dynInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic int: %d".format([dynInt])',
+      locationAst:
+        '"dynamic int: %d"^#1[1,0]#.format(\n  [\n    dynInt^#4[1,26]#\n  ]^#3[1,25]#\n)^#2[1,24]#',
+      positions: [
+        [1, 0, 17, 1, 0, 1, 17],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 25, 26, 1, 25, 1, 26],
+        [4, 26, 32, 1, 26, 1, 32],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dynamic int: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynInt" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for integer formatting clause",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+      },
+      checkedAst:
+        '"dynamic int: %d"~string.format(\n  [\n    dynInt~dyn^dynInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dynamic int: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynInt" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic int: 128" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for integer formatting clause (unsigned)",
+        expr: '"dynamic unsigned int: %d".format([dynUnsignedInt])',
+        typeEnv: [{ name: "dynUnsignedInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynUnsignedInt: { value: { uint64Value: "256" } } },
+        value: { stringValue: "dynamic unsigned int: 256" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dynamic unsigned int: %d"^#*expr.Constant_StringValue#.format(\n  [\n    dynUnsignedInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic unsigned int: %d".format([dynUnsignedInt])',
+      locationAst:
+        '"dynamic unsigned int: %d"^#1[1,0]#.format(\n  [\n    dynUnsignedInt^#4[1,35]#\n  ]^#3[1,34]#\n)^#2[1,33]#',
+      positions: [
+        [1, 0, 26, 1, 0, 1, 26],
+        [2, 33, 34, 1, 33, 1, 34],
+        [3, 34, 35, 1, 34, 1, 35],
+        [4, 35, 49, 1, 35, 1, 49],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic unsigned int: %d" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynUnsignedInt" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for integer formatting clause (unsigned)",
+          lineOffsets: [52],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+      },
+      checkedAst:
+        '"dynamic unsigned int: %d"~string.format(\n  [\n    dynUnsignedInt~dyn^dynUnsignedInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynUnsignedInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic unsigned int: %d" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynUnsignedInt" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "dynamic unsigned int: 256" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "dyntype support for hex formatting clause",
+        expr: '"dynamic hex int: %x".format([dynHexInt])',
+        typeEnv: [{ name: "dynHexInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynHexInt: { value: { int64Value: "22" } } },
+        value: { stringValue: "dynamic hex int: 16" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dynamic hex int: %x"^#*expr.Constant_StringValue#.format(\n  [\n    dynHexInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic hex int: %x".format([dynHexInt])',
+      locationAst:
+        '"dynamic hex int: %x"^#1[1,0]#.format(\n  [\n    dynHexInt^#4[1,30]#\n  ]^#3[1,29]#\n)^#2[1,28]#',
+      positions: [
+        [1, 0, 21, 1, 0, 1, 21],
+        [2, 28, 29, 1, 28, 1, 29],
+        [3, 29, 30, 1, 29, 1, 30],
+        [4, 30, 39, 1, 30, 1, 39],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %x" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynHexInt" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for hex formatting clause",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 28, "3": 29, "4": 30 },
+        },
+      },
+      checkedAst:
+        '"dynamic hex int: %x"~string.format(\n  [\n    dynHexInt~dyn^dynHexInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynHexInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 28, "3": 29, "4": 30 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %x" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynHexInt" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic hex int: 16" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for hex formatting clause (uppercase)",
+        expr: '"dynamic hex int: %X (uppercase)".format([dynHexInt])',
+        typeEnv: [{ name: "dynHexInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynHexInt: { value: { int64Value: "26" } } },
+        value: { stringValue: "dynamic hex int: 1A (uppercase)" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dynamic hex int: %X (uppercase)"^#*expr.Constant_StringValue#.format(\n  [\n    dynHexInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic hex int: %X (uppercase)".format([dynHexInt])',
+      locationAst:
+        '"dynamic hex int: %X (uppercase)"^#1[1,0]#.format(\n  [\n    dynHexInt^#4[1,42]#\n  ]^#3[1,41]#\n)^#2[1,40]#',
+      positions: [
+        [1, 0, 33, 1, 0, 1, 33],
+        [2, 40, 41, 1, 40, 1, 41],
+        [3, 41, 42, 1, 41, 1, 42],
+        [4, 42, 51, 1, 42, 1, 51],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %X (uppercase)" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynHexInt" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for hex formatting clause (uppercase)",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 40, "3": 41, "4": 42 },
+        },
+      },
+      checkedAst:
+        '"dynamic hex int: %X (uppercase)"~string.format(\n  [\n    dynHexInt~dyn^dynHexInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynHexInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 40, "3": 41, "4": 42 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %X (uppercase)" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynHexInt" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "15", max: "15" },
+      result: { value: { stringValue: "dynamic hex int: 1A (uppercase)" } },
+      runtimeCost: "15",
+    },
+    {
+      original: {
+        name: "dyntype support for unsigned hex formatting clause",
+        expr: '"dynamic hex int: %x (unsigned)".format([dynUnsignedHexInt])',
+        typeEnv: [{ name: "dynUnsignedHexInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynUnsignedHexInt: { value: { uint64Value: "500" } } },
+        value: { stringValue: "dynamic hex int: 1f4 (unsigned)" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dynamic hex int: %x (unsigned)"^#*expr.Constant_StringValue#.format(\n  [\n    dynUnsignedHexInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic hex int: %x (unsigned)".format([dynUnsignedHexInt])',
+      locationAst:
+        '"dynamic hex int: %x (unsigned)"^#1[1,0]#.format(\n  [\n    dynUnsignedHexInt^#4[1,41]#\n  ]^#3[1,40]#\n)^#2[1,39]#',
+      positions: [
+        [1, 0, 32, 1, 0, 1, 32],
+        [2, 39, 40, 1, 39, 1, 40],
+        [3, 40, 41, 1, 40, 1, 41],
+        [4, 41, 58, 1, 41, 1, 58],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %x (unsigned)" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynUnsignedHexInt" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for unsigned hex formatting clause",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 39, "3": 40, "4": 41 },
+        },
+      },
+      checkedAst:
+        '"dynamic hex int: %x (unsigned)"~string.format(\n  [\n    dynUnsignedHexInt~dyn^dynUnsignedHexInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynUnsignedHexInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 39, "3": 40, "4": 41 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %x (unsigned)" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynUnsignedHexInt" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "dynamic hex int: 1f4 (unsigned)" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "dyntype support for fixed-point formatting clause",
+        expr: '"dynamic double: %.3f".format([dynDouble])',
+        typeEnv: [{ name: "dynDouble", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynDouble: { value: { doubleValue: 4.5 } } },
+        value: { stringValue: "dynamic double: 4.500" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"dynamic double: %.3f"^#*expr.Constant_StringValue#.format(\n  [\n    dynDouble^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic double: %.3f".format([dynDouble])',
+      locationAst:
+        '"dynamic double: %.3f"^#1[1,0]#.format(\n  [\n    dynDouble^#4[1,31]#\n  ]^#3[1,30]#\n)^#2[1,29]#',
+      positions: [
+        [1, 0, 22, 1, 0, 1, 22],
+        [2, 29, 30, 1, 29, 1, 30],
+        [3, 30, 31, 1, 30, 1, 31],
+        [4, 31, 40, 1, 31, 1, 40],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic double: %.3f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDouble" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for fixed-point formatting clause",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 29, "3": 30, "4": 31 },
+        },
+      },
+      checkedAst:
+        '"dynamic double: %.3f"~string.format(\n  [\n    dynDouble~dyn^dynDouble\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynDouble" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 29, "3": 30, "4": 31 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic double: %.3f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDouble" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic double: 4.500" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for fixed-point formatting clause (comma separator locale)",
+        expr: '"dynamic double: %f".format([dynDouble])',
+        typeEnv: [{ name: "dynDouble", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynDouble: { value: { doubleValue: 4.5 } } },
+        value: { stringValue: "dynamic double: 4,500000" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "fr_FR",
+      ast: '"dynamic double: %f"^#*expr.Constant_StringValue#.format(\n  [\n    dynDouble^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic double: %f".format([dynDouble])',
+      locationAst:
+        '"dynamic double: %f"^#1[1,0]#.format(\n  [\n    dynDouble^#4[1,29]#\n  ]^#3[1,28]#\n)^#2[1,27]#',
+      positions: [
+        [1, 0, 20, 1, 0, 1, 20],
+        [2, 27, 28, 1, 27, 1, 28],
+        [3, 28, 29, 1, 28, 1, 29],
+        [4, 29, 38, 1, 29, 1, 38],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic double: %f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDouble" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location:
+            "dyntype support for fixed-point formatting clause (comma separator locale)",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 27, "3": 28, "4": 29 },
+        },
+      },
+      checkedAst:
+        '"dynamic double: %f"~string.format(\n  [\n    dynDouble~dyn^dynDouble\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynDouble" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 27, "3": 28, "4": 29 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic double: %f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDouble" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic double: 4,500000" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for scientific notation",
+        expr: '"(dyntype) e: %e".format([dynE])',
+        typeEnv: [{ name: "dynE", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynE: { value: { doubleValue: 2.71828 } } },
+        value: { stringValue: "(dyntype) e: 2.718280 × 10⁰⁰" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      locale: "en_US",
+      ast: '"(dyntype) e: %e"^#*expr.Constant_StringValue#.format(\n  [\n    dynE^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"(dyntype) e: %e".format([dynE])',
+      locationAst:
+        '"(dyntype) e: %e"^#1[1,0]#.format(\n  [\n    dynE^#4[1,26]#\n  ]^#3[1,25]#\n)^#2[1,24]#',
+      positions: [
+        [1, 0, 17, 1, 0, 1, 17],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 25, 26, 1, 25, 1, 26],
+        [4, 26, 30, 1, 26, 1, 30],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "(dyntype) e: %e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynE" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for scientific notation",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+      },
+      checkedAst:
+        '"(dyntype) e: %e"~string.format(\n  [\n    dynE~dyn^dynE\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynE" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "(dyntype) e: %e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynE" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "(dyntype) e: 2.718280 × 10⁰⁰" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype NaN/infinity support for fixed-point",
+        expr: '"NaN: %f, infinity: %f".format([dynNaN, dynInf])',
+        typeEnv: [
+          { name: "dynInf", ident: { type: { dyn: {} } } },
+          { name: "dynNaN", ident: { type: { dyn: {} } } },
+        ],
+        container: "ext",
+        bindings: {
+          dynInf: { value: { doubleValue: "Infinity" } },
+          dynNaN: { value: { doubleValue: "NaN" } },
+        },
+        value: { stringValue: "NaN: NaN, infinity: ∞" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"NaN: %f, infinity: %f"^#*expr.Constant_StringValue#.format(\n  [\n    dynNaN^#*expr.Expr_IdentExpr#,\n    dynInf^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"NaN: %f, infinity: %f".format([dynNaN, dynInf])',
+      locationAst:
+        '"NaN: %f, infinity: %f"^#1[1,0]#.format(\n  [\n    dynNaN^#4[1,32]#,\n    dynInf^#5[1,40]#\n  ]^#3[1,31]#\n)^#2[1,30]#',
+      positions: [
+        [1, 0, 23, 1, 0, 1, 23],
+        [2, 30, 31, 1, 30, 1, 31],
+        [3, 31, 32, 1, 31, 1, 32],
+        [4, 32, 38, 1, 32, 1, 38],
+        [5, 40, 46, 1, 40, 1, 46],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "NaN: %f, infinity: %f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynNaN" } },
+                    { id: "5", identExpr: { name: "dynInf" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype NaN/infinity support for fixed-point",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32, "5": 40 },
+        },
+      },
+      checkedAst:
+        '"NaN: %f, infinity: %f"~string.format(\n  [\n    dynNaN~dyn^dynNaN,\n    dynInf~dyn^dynInf\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynNaN" },
+          "5": { name: "dynInf" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32, "5": 40 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "NaN: %f, infinity: %f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynNaN" } },
+                    { id: "5", identExpr: { name: "dynInf" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "15", max: "15" },
+      result: { value: { stringValue: "NaN: NaN, infinity: ∞" } },
+      runtimeCost: "15",
+    },
+    {
+      original: {
+        name: "dyntype support for timestamp",
+        expr: '"dyntype timestamp: %s".format([dynTime])',
+        typeEnv: [{ name: "dynTime", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: {
+          dynTime: {
+            value: {
+              objectValue: {
+                "@type": "type.googleapis.com/google.protobuf.Timestamp",
+                value: "2009-11-10T23:00:00Z",
+              },
+            },
+          },
+        },
+        value: { stringValue: "dyntype timestamp: 2009-11-10T23:00:00Z" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dyntype timestamp: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynTime^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dyntype timestamp: %s".format([dynTime])',
+      locationAst:
+        '"dyntype timestamp: %s"^#1[1,0]#.format(\n  [\n    dynTime^#4[1,32]#\n  ]^#3[1,31]#\n)^#2[1,30]#',
+      positions: [
+        [1, 0, 23, 1, 0, 1, 23],
+        [2, 30, 31, 1, 30, 1, 31],
+        [3, 31, 32, 1, 31, 1, 32],
+        [4, 32, 39, 1, 32, 1, 39],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dyntype timestamp: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynTime" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for timestamp",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32 },
+        },
+      },
+      checkedAst:
+        '"dyntype timestamp: %s"~string.format(\n  [\n    dynTime~dyn^dynTime\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynTime" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dyntype timestamp: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynTime" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: {
+        value: { stringValue: "dyntype timestamp: 2009-11-10T23:00:00Z" },
+      },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "dyntype support for duration",
+        expr: '"dyntype duration: %s".format([dynDuration])',
+        typeEnv: [{ name: "dynDuration", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: {
+          dynDuration: {
+            value: {
+              objectValue: {
+                "@type": "type.googleapis.com/google.protobuf.Duration",
+                value: "8747s",
+              },
+            },
+          },
+        },
+        value: { stringValue: "dyntype duration: 8747s" },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dyntype duration: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynDuration^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dyntype duration: %s".format([dynDuration])',
+      locationAst:
+        '"dyntype duration: %s"^#1[1,0]#.format(\n  [\n    dynDuration^#4[1,31]#\n  ]^#3[1,30]#\n)^#2[1,29]#',
+      positions: [
+        [1, 0, 22, 1, 0, 1, 22],
+        [2, 29, 30, 1, 29, 1, 30],
+        [3, 30, 31, 1, 30, 1, 31],
+        [4, 31, 42, 1, 31, 1, 42],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dyntype duration: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDuration" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for duration",
+          lineOffsets: [45],
+          positions: { "1": 0, "2": 29, "3": 30, "4": 31 },
+        },
+      },
+      checkedAst:
+        '"dyntype duration: %s"~string.format(\n  [\n    dynDuration~dyn^dynDuration\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynDuration" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: { "1": 0, "2": 29, "3": 30, "4": 31 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dyntype duration: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDuration" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dyntype duration: 8747s" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for lists",
+        expr: '"dyntype list: %s".format([dynList])',
+        typeEnv: [{ name: "dynList", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: {
+          dynList: {
+            value: {
+              listValue: {
+                values: [
+                  { int64Value: "6" },
+                  { doubleValue: 4.2 },
+                  { stringValue: "a string" },
+                ],
+              },
+            },
+          },
+        },
+        value: { stringValue: 'dyntype list: [6, 4.200000, "a string"]' },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dyntype list: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynList^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dyntype list: %s".format([dynList])',
+      locationAst:
+        '"dyntype list: %s"^#1[1,0]#.format(\n  [\n    dynList^#4[1,27]#\n  ]^#3[1,26]#\n)^#2[1,25]#',
+      positions: [
+        [1, 0, 18, 1, 0, 1, 18],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 27, 1, 26, 1, 27],
+        [4, 27, 34, 1, 27, 1, 34],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dyntype list: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynList" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for lists",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 27 },
+        },
+      },
+      checkedAst:
+        '"dyntype list: %s"~string.format(\n  [\n    dynList~dyn^dynList\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynList" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 27 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dyntype list: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynList" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: {
+        value: { stringValue: 'dyntype list: [6, 4.200000, "a string"]' },
+      },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for maps",
+        expr: '"dyntype map: %s".format([dynMap])',
+        typeEnv: [{ name: "dynMap", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: {
+          dynMap: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "strKey" },
+                    value: { stringValue: "x" },
+                  },
+                  { key: { boolValue: true }, value: { int64Value: "42" } },
+                  {
+                    key: { int64Value: "6" },
+                    value: {
+                      objectValue: {
+                        "@type": "type.googleapis.com/google.protobuf.Duration",
+                        value: "422s",
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: {
+          stringValue:
+            'dyntype map: {"strKey":"x", 6:duration("422s"), true:42}',
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"dyntype map: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynMap^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dyntype map: %s".format([dynMap])',
+      locationAst:
+        '"dyntype map: %s"^#1[1,0]#.format(\n  [\n    dynMap^#4[1,26]#\n  ]^#3[1,25]#\n)^#2[1,24]#',
+      positions: [
+        [1, 0, 17, 1, 0, 1, 17],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 25, 26, 1, 25, 1, 26],
+        [4, 26, 32, 1, 26, 1, 32],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dyntype map: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynMap" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for maps",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+      },
+      checkedAst:
+        '"dyntype map: %s"~string.format(\n  [\n    dynMap~dyn^dynMap\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynMap" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dyntype map: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynMap" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: {
+        value: {
+          stringValue:
+            'dyntype map: {"strKey":"x", 6:duration("422s"), true:42}',
+        },
+      },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "unrecognized formatting clause",
+        expr: '"%a".format([1])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                'could not parse formatting clause: unrecognized formatting clause "a"',
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%a"^#*expr.Constant_StringValue#.format(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%a".format([1])',
+      locationAst:
+        '"%a"^#1[1,0]#.format(\n  [\n    1^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%a" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "unrecognized formatting clause",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:12: could not parse formatting clause: unrecognized formatting clause "a"\n | "%a".format([1])\n | ...........^',
+      errors: [
+        {
+          category: "other",
+          message:
+            'could not parse formatting clause: unrecognized formatting clause "a"',
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "out of bounds arg index",
+        expr: '"%d %d %d".format([0, 1])',
+        container: "ext",
+        evalError: { errors: [{ message: "index 2 out of range" }] },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%d %d %d"^#*expr.Constant_StringValue#.format(\n  [\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%d %d %d".format([0, 1])',
+      locationAst:
+        '"%d %d %d"^#1[1,0]#.format(\n  [\n    0^#4[1,19]#,\n    1^#5[1,22]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%d %d %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "0" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "out of bounds arg index",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19, "5": 22 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:18: index 2 out of range\n | "%d %d %d".format([0, 1])\n | .................^',
+      errors: [
+        {
+          category: "other",
+          message: "index 2 out of range",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "string substitution is not allowed with binary clause",
+        expr: '"string is %b".format(["abc"])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only integers and bools can be formatted as binary, was given string",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"string is %b"^#*expr.Constant_StringValue#.format(\n  [\n    "abc"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"string is %b".format(["abc"])',
+      locationAst:
+        '"string is %b"^#1[1,0]#.format(\n  [\n    "abc"^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 28, 1, 23, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "string is %b" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "abc" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "string substitution is not allowed with binary clause",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: only integers and bools can be formatted as binary, was given string\n | "string is %b".format(["abc"])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only integers and bools can be formatted as binary, was given string",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "duration substitution not allowed with decimal clause",
+        expr: '"%d".format([duration("30m2s")])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: decimal clause can only be used on integers, was given google.protobuf.Duration",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%d"^#*expr.Constant_StringValue#.format(\n  [\n    duration(\n      "30m2s"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%d".format([duration("30m2s")])',
+      locationAst:
+        '"%d"^#1[1,0]#.format(\n  [\n    duration(\n      "30m2s"^#5[1,22]#\n    )^#4[1,21]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 29, 1, 22, 1, 29],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "30m2s" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "duration substitution not allowed with decimal clause",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:22: error during formatting: decimal clause can only be used on integers, was given google.protobuf.Duration\n | "%d".format([duration("30m2s")])\n | .....................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: decimal clause can only be used on integers, was given google.protobuf.Duration",
+          id: 4,
+          offset: 21,
+          line: 1,
+          column: 21,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "string substitution not allowed with octal clause",
+        expr: '"octal: %o".format(["a string"])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: octal clause can only be used on integers, was given string",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"octal: %o"^#*expr.Constant_StringValue#.format(\n  [\n    "a string"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"octal: %o".format(["a string"])',
+      locationAst:
+        '"octal: %o"^#1[1,0]#.format(\n  [\n    "a string"^#4[1,20]#\n  ]^#3[1,19]#\n)^#2[1,18]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 20, 1, 19, 1, 20],
+        [4, 20, 30, 1, 20, 1, 30],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "octal: %o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "a string" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "string substitution not allowed with octal clause",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 20 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:21: error during formatting: octal clause can only be used on integers, was given string\n | "octal: %o".format(["a string"])\n | ....................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: octal clause can only be used on integers, was given string",
+          id: 4,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "double substitution not allowed with hex clause",
+        expr: '"double is %x".format([0.5])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given double",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"double is %x"^#*expr.Constant_StringValue#.format(\n  [\n    0.5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %x".format([0.5])',
+      locationAst:
+        '"double is %x"^#1[1,0]#.format(\n  [\n    0.5^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 26, 1, 23, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 0.5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "double substitution not allowed with hex clause",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given double\n | "double is %x".format([0.5])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given double",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "uppercase not allowed for scientific clause",
+        expr: '"double is %E".format([0.5])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                'could not parse formatting clause: unrecognized formatting clause "E"',
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"double is %E"^#*expr.Constant_StringValue#.format(\n  [\n    0.5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %E".format([0.5])',
+      locationAst:
+        '"double is %E"^#1[1,0]#.format(\n  [\n    0.5^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 26, 1, 23, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %E" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 0.5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uppercase not allowed for scientific clause",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:22: could not parse formatting clause: unrecognized formatting clause "E"\n | "double is %E".format([0.5])\n | .....................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            'could not parse formatting clause: unrecognized formatting clause "E"',
+          id: 2,
+          offset: 21,
+          line: 1,
+          column: 21,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %d",
+        expr: '"null: %d".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: decimal clause can only be used on integers, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"null: %d"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %d".format([null])',
+      locationAst:
+        '"null: %d"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %d",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: decimal clause can only be used on integers, was given null_type\n | "null: %d".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: decimal clause can only be used on integers, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %e",
+        expr: '"null: %e".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: scientific clause can only be used on doubles, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"null: %e"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %e".format([null])',
+      locationAst:
+        '"null: %e"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: scientific clause can only be used on doubles, was given null_type\n | "null: %e".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: scientific clause can only be used on doubles, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %f",
+        expr: '"null: %f".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: fixed-point clause can only be used on doubles, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"null: %f"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %f".format([null])',
+      locationAst:
+        '"null: %f"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %f",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: fixed-point clause can only be used on doubles, was given null_type\n | "null: %f".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: fixed-point clause can only be used on doubles, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %x",
+        expr: '"null: %x".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"null: %x"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %x".format([null])',
+      locationAst:
+        '"null: %x"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %x",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given null_type\n | "null: %x".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %X",
+        expr: '"null: %X".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"null: %X"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %X".format([null])',
+      locationAst:
+        '"null: %X"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %X",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given null_type\n | "null: %X".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %b",
+        expr: '"null: %b".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only integers and bools can be formatted as binary, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"null: %b"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %b".format([null])',
+      locationAst:
+        '"null: %b"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %b" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %b",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: only integers and bools can be formatted as binary, was given null_type\n | "null: %b".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only integers and bools can be formatted as binary, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %o",
+        expr: '"null: %o".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: octal clause can only be used on integers, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"null: %o"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %o".format([null])',
+      locationAst:
+        '"null: %o"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %o",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: octal clause can only be used on integers, was given null_type\n | "null: %o".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: octal clause can only be used on integers, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time cardinality check (too few for string)",
+        expr: '"%s %s".format(["abc"])',
+        container: "ext",
+        evalError: { errors: [{ message: "index 1 out of range" }] },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s %s"^#*expr.Constant_StringValue#.format(\n  [\n    "abc"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s %s".format(["abc"])',
+      locationAst:
+        '"%s %s"^#1[1,0]#.format(\n  [\n    "abc"^#4[1,16]#\n  ]^#3[1,15]#\n)^#2[1,14]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 21, 1, 16, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "abc" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time cardinality check (too few for string)",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 14, "3": 15, "4": 16 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:15: index 1 out of range\n | "%s %s".format(["abc"])\n | ..............^',
+      errors: [
+        {
+          category: "other",
+          message: "index 1 out of range",
+          id: 2,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time cardinality check (too many for string)",
+        expr: '"%s %s".format(["abc", "def", "ghi"])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "too many arguments supplied to string.format (expected 2, got 3)",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%s %s"^#*expr.Constant_StringValue#.format(\n  [\n    "abc"^#*expr.Constant_StringValue#,\n    "def"^#*expr.Constant_StringValue#,\n    "ghi"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s %s".format(["abc", "def", "ghi"])',
+      locationAst:
+        '"%s %s"^#1[1,0]#.format(\n  [\n    "abc"^#4[1,16]#,\n    "def"^#5[1,23]#,\n    "ghi"^#6[1,30]#\n  ]^#3[1,15]#\n)^#2[1,14]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 21, 1, 16, 1, 21],
+        [5, 23, 28, 1, 23, 1, 28],
+        [6, 30, 35, 1, 30, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "abc" } },
+                    { id: "5", constExpr: { stringValue: "def" } },
+                    { id: "6", constExpr: { stringValue: "ghi" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time cardinality check (too many for string)",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 14, "3": 15, "4": 16, "5": 23, "6": 30 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:15: too many arguments supplied to string.format (expected 2, got 3)\n | "%s %s".format(["abc", "def", "ghi"])\n | ..............^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "too many arguments supplied to string.format (expected 2, got 3)",
+          id: 2,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time syntax check (unexpected end of string)",
+        expr: '"filler %".format([])',
+        container: "ext",
+        evalError: { errors: [{ message: "unexpected end of string" }] },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"filler %"^#*expr.Constant_StringValue#.format(\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"filler %".format([])',
+      locationAst: '"filler %"^#1[1,0]#.format(\n  []^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "filler %" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time syntax check (unexpected end of string)",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 17, "3": 18 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:18: unexpected end of string\n | "filler %".format([])\n | .................^',
+      errors: [
+        {
+          category: "other",
+          message: "unexpected end of string",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time syntax check (unrecognized formatting clause)",
+        expr: '"%j".format([123])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                'could not parse formatting clause: unrecognized formatting clause "j"',
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%j"^#*expr.Constant_StringValue#.format(\n  [\n    123^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%j".format([123])',
+      locationAst:
+        '"%j"^#1[1,0]#.format(\n  [\n    123^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 16, 1, 13, 1, 16],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%j" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "123" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location:
+            "compile-time syntax check (unrecognized formatting clause)",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:12: could not parse formatting clause: unrecognized formatting clause "j"\n | "%j".format([123])\n | ...........^',
+      errors: [
+        {
+          category: "other",
+          message:
+            'could not parse formatting clause: unrecognized formatting clause "j"',
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %d check",
+        expr: '"int is %d".format([5.2])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: decimal clause can only be used on integers",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"int is %d"^#*expr.Constant_StringValue#.format(\n  [\n    5.2^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"int is %d".format([5.2])',
+      locationAst:
+        '"int is %d"^#1[1,0]#.format(\n  [\n    5.2^#4[1,20]#\n  ]^#3[1,19]#\n)^#2[1,18]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 20, 1, 19, 1, 20],
+        [4, 20, 23, 1, 20, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "int is %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 5.2 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %d check",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 20 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:21: error during formatting: decimal clause can only be used on integers, was given double\n | "int is %d".format([5.2])\n | ....................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: decimal clause can only be used on integers, was given double",
+          id: 4,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %f check",
+        expr: '"double is %f".format([true])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: fixed-point clause can only be used on doubles",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"double is %f"^#*expr.Constant_StringValue#.format(\n  [\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %f".format([true])',
+      locationAst:
+        '"double is %f"^#1[1,0]#.format(\n  [\n    true^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { boolValue: true } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %f check",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: fixed-point clause can only be used on doubles, was given bool\n | "double is %f".format([true])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: fixed-point clause can only be used on doubles, was given bool",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time precision syntax check",
+        expr: '"double is %.34".format([5.0])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "could not parse formatting clause: error while parsing precision: could not find end of precision specifier",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"double is %.34"^#*expr.Constant_StringValue#.format(\n  [\n    5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %.34".format([5.0])',
+      locationAst:
+        '"double is %.34"^#1[1,0]#.format(\n  [\n    5^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %.34" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time precision syntax check",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: could not parse formatting clause: error while parsing precision: could not find end of precision specifier\n | "double is %.34".format([5.0])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "could not parse formatting clause: error while parsing precision: could not find end of precision specifier",
+          id: 2,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %e check",
+        expr: '"double is %e".format([true])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: scientific clause can only be used on doubles",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"double is %e"^#*expr.Constant_StringValue#.format(\n  [\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %e".format([true])',
+      locationAst:
+        '"double is %e"^#1[1,0]#.format(\n  [\n    true^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { boolValue: true } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %e check",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: scientific clause can only be used on doubles, was given bool\n | "double is %e".format([true])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: scientific clause can only be used on doubles, was given bool",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %b check",
+        expr: '"string is %b".format(["a string"])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only integers and bools can be formatted as binary",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"string is %b"^#*expr.Constant_StringValue#.format(\n  [\n    "a string"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"string is %b".format(["a string"])',
+      locationAst:
+        '"string is %b"^#1[1,0]#.format(\n  [\n    "a string"^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 33, 1, 23, 1, 33],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "string is %b" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "a string" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %b check",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: only integers and bools can be formatted as binary, was given string\n | "string is %b".format(["a string"])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only integers and bools can be formatted as binary, was given string",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %x check",
+        expr: '"%x is a double".format([2.5])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only integers, byte buffers, and strings can be formatted as hex",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%x is a double"^#*expr.Constant_StringValue#.format(\n  [\n    2.5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%x is a double".format([2.5])',
+      locationAst:
+        '"%x is a double"^#1[1,0]#.format(\n  [\n    2.5^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x is a double" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %x check",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:26: error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given double\n | "%x is a double".format([2.5])\n | .........................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given double",
+          id: 4,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %X check",
+        expr: '"%X is a double".format([2.5])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only integers, byte buffers, and strings can be formatted as hex",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"%X is a double"^#*expr.Constant_StringValue#.format(\n  [\n    2.5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%X is a double".format([2.5])',
+      locationAst:
+        '"%X is a double"^#1[1,0]#.format(\n  [\n    2.5^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X is a double" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %X check",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:26: error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given double\n | "%X is a double".format([2.5])\n | .........................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only integers, byte buffers, and strings can be formatted as hex, was given double",
+          id: 4,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %o check",
+        expr: '"an octal: %o".format([3.14])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: octal clause can only be used on integers",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormat",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"an octal: %o"^#*expr.Constant_StringValue#.format(\n  [\n    3.14^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"an octal: %o".format([3.14])',
+      locationAst:
+        '"an octal: %o"^#1[1,0]#.format(\n  [\n    3.14^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "an octal: %o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 3.14 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %o check",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: octal clause can only be used on integers, was given double\n | "an octal: %o".format([3.14])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: octal clause can only be used on integers, was given double",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        expr: '"list: %s".format([[[1, 2, [3.0, 4]]]])',
+        value: { stringValue: "list: [[1, 2, [3.000000, 4]]]" },
+      },
+      section: "TestStringFormatHeterogeneousLiterals",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"list: %s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#,\n        [\n          3^#*expr.Constant_DoubleValue#,\n          4^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"list: %s".format([[[1, 2, [3.0, 4]]]])',
+      locationAst:
+        '"list: %s"^#1[1,0]#.format(\n  [\n    [\n      [\n        1^#6[1,21]#,\n        2^#7[1,24]#,\n        [\n          3^#9[1,28]#,\n          4^#10[1,33]#\n        ]^#8[1,27]#\n      ]^#5[1,20]#\n    ]^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 28, 31, 1, 28, 1, 31],
+        [10, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          {
+                            id: "5",
+                            listExpr: {
+                              elements: [
+                                { id: "6", constExpr: { int64Value: "1" } },
+                                { id: "7", constExpr: { int64Value: "2" } },
+                                {
+                                  id: "8",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "9",
+                                        constExpr: { doubleValue: 3 },
+                                      },
+                                      {
+                                        id: "10",
+                                        constExpr: { int64Value: "4" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 17,
+            "3": 18,
+            "4": 19,
+            "5": 20,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 33,
+          },
+        },
+      },
+      checkedAst:
+        '"list: %s"~string.format(\n  [\n    [\n      [\n        1~int,\n        2~int,\n        [\n          3~double,\n          4~int\n        ]~list(dyn)\n      ]~list(dyn)\n    ]~list(list(dyn))\n  ]~list(list(list(dyn)))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: {
+                listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+              },
+            },
+          },
+          "4": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 17,
+            "3": 18,
+            "4": 19,
+            "5": 20,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 33,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          {
+                            id: "5",
+                            listExpr: {
+                              elements: [
+                                { id: "6", constExpr: { int64Value: "1" } },
+                                { id: "7", constExpr: { int64Value: "2" } },
+                                {
+                                  id: "8",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "9",
+                                        constExpr: { doubleValue: 3 },
+                                      },
+                                      {
+                                        id: "10",
+                                        constExpr: { int64Value: "4" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "41", max: "41" },
+      result: { value: { stringValue: "list: [[1, 2, [3.000000, 4]]]" } },
+      runtimeCost: "41",
+    },
+    {
+      original: {
+        expr: '"list size: %d".format([[[1, 2, [3.0, 4]]].size()])',
+        value: { stringValue: "list size: 1" },
+      },
+      section: "TestStringFormatHeterogeneousLiterals",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"list size: %d"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#,\n        [\n          3^#*expr.Constant_DoubleValue#,\n          4^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#.size()^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"list size: %d".format([[[1, 2, [3.0, 4]]].size()])',
+      locationAst:
+        '"list size: %d"^#1[1,0]#.format(\n  [\n    [\n      [\n        1^#6[1,26]#,\n        2^#7[1,29]#,\n        [\n          3^#9[1,33]#,\n          4^#10[1,38]#\n        ]^#8[1,32]#\n      ]^#5[1,25]#\n    ]^#4[1,24]#.size()^#11[1,47]#\n  ]^#3[1,23]#\n)^#2[1,22]#',
+      positions: [
+        [1, 0, 15, 1, 0, 1, 15],
+        [2, 22, 23, 1, 22, 1, 23],
+        [3, 23, 24, 1, 23, 1, 24],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 25, 26, 1, 25, 1, 26],
+        [6, 26, 27, 1, 26, 1, 27],
+        [7, 29, 30, 1, 29, 1, 30],
+        [8, 32, 33, 1, 32, 1, 33],
+        [9, 33, 36, 1, 33, 1, 36],
+        [10, 38, 39, 1, 38, 1, 39],
+        [11, 47, 48, 1, 47, 1, 48],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list size: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        target: {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "1" } },
+                                    { id: "7", constExpr: { int64Value: "2" } },
+                                    {
+                                      id: "8",
+                                      listExpr: {
+                                        elements: [
+                                          {
+                                            id: "9",
+                                            constExpr: { doubleValue: 3 },
+                                          },
+                                          {
+                                            id: "10",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        function: "size",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 22,
+            "3": 23,
+            "4": 24,
+            "5": 25,
+            "6": 26,
+            "7": 29,
+            "8": 32,
+            "9": 33,
+            "10": 38,
+            "11": 47,
+          },
+        },
+      },
+      checkedAst:
+        '"list size: %d"~string.format(\n  [\n    [\n      [\n        1~int,\n        2~int,\n        [\n          3~double,\n          4~int\n        ]~list(dyn)\n      ]~list(dyn)\n    ]~list(list(dyn)).size()~int^list_size\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "11": { overloadId: ["list_size"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 22,
+            "3": 23,
+            "4": 24,
+            "5": 25,
+            "6": 26,
+            "7": 29,
+            "8": 32,
+            "9": 33,
+            "10": 38,
+            "11": 47,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list size: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        target: {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "1" } },
+                                    { id: "7", constExpr: { int64Value: "2" } },
+                                    {
+                                      id: "8",
+                                      listExpr: {
+                                        elements: [
+                                          {
+                                            id: "9",
+                                            constExpr: { doubleValue: 3 },
+                                          },
+                                          {
+                                            id: "10",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        function: "size",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "43", max: "43" },
+      result: { value: { stringValue: "list size: 1" } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: '"list element: %s".format([[[1, 2, [3.0, 4]]][0]])',
+        value: { stringValue: "list element: [1, 2, [3.000000, 4]]" },
+      },
+      section: "TestStringFormatHeterogeneousLiterals",
+      library: "strings",
+      libraryVersion: 3,
+      ast: '"list element: %s"^#*expr.Constant_StringValue#.format(\n  [\n    _[_](\n      [\n        [\n          1^#*expr.Constant_Int64Value#,\n          2^#*expr.Constant_Int64Value#,\n          [\n            3^#*expr.Constant_DoubleValue#,\n            4^#*expr.Constant_Int64Value#\n          ]^#*expr.Expr_ListExpr#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"list element: %s".format([[[1, 2, [3.0, 4]]][0]])',
+      locationAst:
+        '"list element: %s"^#1[1,0]#.format(\n  [\n    _[_](\n      [\n        [\n          1^#6[1,29]#,\n          2^#7[1,32]#,\n          [\n            3^#9[1,36]#,\n            4^#10[1,41]#\n          ]^#8[1,35]#\n        ]^#5[1,28]#\n      ]^#4[1,27]#,\n      0^#12[1,46]#\n    )^#11[1,45]#\n  ]^#3[1,26]#\n)^#2[1,25]#',
+      positions: [
+        [1, 0, 18, 1, 0, 1, 18],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 27, 1, 26, 1, 27],
+        [4, 27, 28, 1, 27, 1, 28],
+        [5, 28, 29, 1, 28, 1, 29],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 32, 33, 1, 32, 1, 33],
+        [8, 35, 36, 1, 35, 1, 36],
+        [9, 36, 39, 1, 36, 1, 39],
+        [10, 41, 42, 1, 41, 1, 42],
+        [11, 45, 46, 1, 45, 1, 46],
+        [12, 46, 47, 1, 46, 1, 47],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list element: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "4",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "5",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "6",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                      {
+                                        id: "7",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "8",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "9",
+                                              constExpr: { doubleValue: 3 },
+                                            },
+                                            {
+                                              id: "10",
+                                              constExpr: { int64Value: "4" },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "12", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 27,
+            "5": 28,
+            "6": 29,
+            "7": 32,
+            "8": 35,
+            "9": 36,
+            "10": 41,
+            "11": 45,
+            "12": 46,
+          },
+        },
+      },
+      checkedAst:
+        '"list element: %s"~string.format(\n  [\n    _[_](\n      [\n        [\n          1~int,\n          2~int,\n          [\n            3~double,\n            4~int\n          ]~list(dyn)\n        ]~list(dyn)\n      ]~list(list(dyn)),\n      0~int\n    )~list(dyn)^index_list\n  ]~list(list(dyn))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "11": { overloadId: ["index_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "4": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "INT64" },
+          "11": { listType: { elemType: { dyn: {} } } },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 27,
+            "5": 28,
+            "6": 29,
+            "7": 32,
+            "8": 35,
+            "9": 36,
+            "10": 41,
+            "11": 45,
+            "12": 46,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list element: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "4",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "5",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "6",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                      {
+                                        id: "7",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "8",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "9",
+                                              constExpr: { doubleValue: 3 },
+                                            },
+                                            {
+                                              id: "10",
+                                              constExpr: { int64Value: "4" },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "12", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "43", max: "43" },
+      result: { value: { stringValue: "list element: [1, 2, [3.000000, 4]]" } },
+      runtimeCost: "44",
+    },
+    {
+      original: {
+        name: "no-op",
+        expr: '"no substitution".format([])',
+        container: "ext",
+        value: { stringValue: "no substitution" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"no substitution"^#*expr.Constant_StringValue#.format(\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"no substitution".format([])',
+      locationAst:
+        '"no substitution"^#1[1,0]#.format(\n  []^#3[1,25]#\n)^#2[1,24]#',
+      positions: [
+        [1, 0, 17, 1, 0, 1, 17],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "no substitution" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+        sourceInfo: {
+          location: "no-op",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 24, "3": 25 },
+        },
+      },
+      checkedAst:
+        '"no substitution"~string.format(\n  []~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 24, "3": 25 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "no substitution" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "no substitution" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "mid-string substitution",
+        expr: '"str is %s and some more".format(["filler"])',
+        container: "ext",
+        value: { stringValue: "str is filler and some more" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"str is %s and some more"^#*expr.Constant_StringValue#.format(\n  [\n    "filler"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"str is %s and some more".format(["filler"])',
+      locationAst:
+        '"str is %s and some more"^#1[1,0]#.format(\n  [\n    "filler"^#4[1,34]#\n  ]^#3[1,33]#\n)^#2[1,32]#',
+      positions: [
+        [1, 0, 25, 1, 0, 1, 25],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 33, 34, 1, 33, 1, 34],
+        [4, 34, 42, 1, 34, 1, 42],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "str is %s and some more" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "filler" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "mid-string substitution",
+          lineOffsets: [45],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+      },
+      checkedAst:
+        '"str is %s and some more"~string.format(\n  [\n    "filler"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "str is %s and some more" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "filler" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "str is filler and some more" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "percent escaping",
+        expr: '"%% and also %%".format([])',
+        container: "ext",
+        value: { stringValue: "% and also %" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%% and also %%"^#*expr.Constant_StringValue#.format(\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%% and also %%".format([])',
+      locationAst:
+        '"%% and also %%"^#1[1,0]#.format(\n  []^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%% and also %%" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+        sourceInfo: {
+          location: "percent escaping",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 23, "3": 24 },
+        },
+      },
+      checkedAst:
+        '"%% and also %%"~string.format(\n  []~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 23, "3": 24 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%% and also %%" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "% and also %" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "substution inside escaped percent signs",
+        expr: '"%%%s%%".format(["text"])',
+        container: "ext",
+        value: { stringValue: "%text%" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%%%s%%"^#*expr.Constant_StringValue#.format(\n  [\n    "text"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%%%s%%".format(["text"])',
+      locationAst:
+        '"%%%s%%"^#1[1,0]#.format(\n  [\n    "text"^#4[1,17]#\n  ]^#3[1,16]#\n)^#2[1,15]#',
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 23, 1, 17, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%%s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "text" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "substution inside escaped percent signs",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 17 },
+        },
+      },
+      checkedAst:
+        '"%%%s%%"~string.format(\n  [\n    "text"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 17 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%%s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "text" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "%text%" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "substitution with one escaped percent sign on the right",
+        expr: '"%s%%".format(["percent on the right"])',
+        container: "ext",
+        value: { stringValue: "percent on the right%" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s%%"^#*expr.Constant_StringValue#.format(\n  [\n    "percent on the right"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s%%".format(["percent on the right"])',
+      locationAst:
+        '"%s%%"^#1[1,0]#.format(\n  [\n    "percent on the right"^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 37, 1, 15, 1, 37],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "percent on the right" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "substitution with one escaped percent sign on the right",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%s%%"~string.format(\n  [\n    "percent on the right"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "percent on the right" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "percent on the right%" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "substitution with one escaped percent sign on the left",
+        expr: '"%%%s".format(["percent on the left"])',
+        container: "ext",
+        value: { stringValue: "%percent on the left" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%%%s"^#*expr.Constant_StringValue#.format(\n  [\n    "percent on the left"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%%%s".format(["percent on the left"])',
+      locationAst:
+        '"%%%s"^#1[1,0]#.format(\n  [\n    "percent on the left"^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 36, 1, 15, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "percent on the left" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "substitution with one escaped percent sign on the left",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%%%s"~string.format(\n  [\n    "percent on the left"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "percent on the left" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "%percent on the left" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "multiple substitutions",
+        expr: '"%d %d %d, %s %s %s, %d %d %d, %s %s %s".format([1, 2, 3, "A", "B", "C", 4, 5, 6, "D", "E", "F"])',
+        container: "ext",
+        value: { stringValue: "1 2 3, A B C, 4 5 6, D E F" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%d %d %d, %s %s %s, %d %d %d, %s %s %s"^#*expr.Constant_StringValue#.format(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    "A"^#*expr.Constant_StringValue#,\n    "B"^#*expr.Constant_StringValue#,\n    "C"^#*expr.Constant_StringValue#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#,\n    6^#*expr.Constant_Int64Value#,\n    "D"^#*expr.Constant_StringValue#,\n    "E"^#*expr.Constant_StringValue#,\n    "F"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%d %d %d, %s %s %s, %d %d %d, %s %s %s".format([1, 2, 3, "A", "B", "C", 4, 5, 6, "D", "E", "F"])',
+      locationAst:
+        '"%d %d %d, %s %s %s, %d %d %d, %s %s %s"^#1[1,0]#.format(\n  [\n    1^#4[1,49]#,\n    2^#5[1,52]#,\n    3^#6[1,55]#,\n    "A"^#7[1,58]#,\n    "B"^#8[1,63]#,\n    "C"^#9[1,68]#,\n    4^#10[1,73]#,\n    5^#11[1,76]#,\n    6^#12[1,79]#,\n    "D"^#13[1,82]#,\n    "E"^#14[1,87]#,\n    "F"^#15[1,92]#\n  ]^#3[1,48]#\n)^#2[1,47]#',
+      positions: [
+        [1, 0, 40, 1, 0, 1, 40],
+        [2, 47, 48, 1, 47, 1, 48],
+        [3, 48, 49, 1, 48, 1, 49],
+        [4, 49, 50, 1, 49, 1, 50],
+        [5, 52, 53, 1, 52, 1, 53],
+        [6, 55, 56, 1, 55, 1, 56],
+        [7, 58, 61, 1, 58, 1, 61],
+        [8, 63, 66, 1, 63, 1, 66],
+        [9, 68, 71, 1, 68, 1, 71],
+        [10, 73, 74, 1, 73, 1, 74],
+        [11, 76, 77, 1, 76, 1, 77],
+        [12, 79, 80, 1, 79, 1, 80],
+        [13, 82, 85, 1, 82, 1, 85],
+        [14, 87, 90, 1, 87, 1, 90],
+        [15, 92, 95, 1, 92, 1, 95],
+      ],
+      lineOffsets: [98],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: {
+                stringValue: "%d %d %d, %s %s %s, %d %d %d, %s %s %s",
+              },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                    { id: "7", constExpr: { stringValue: "A" } },
+                    { id: "8", constExpr: { stringValue: "B" } },
+                    { id: "9", constExpr: { stringValue: "C" } },
+                    { id: "10", constExpr: { int64Value: "4" } },
+                    { id: "11", constExpr: { int64Value: "5" } },
+                    { id: "12", constExpr: { int64Value: "6" } },
+                    { id: "13", constExpr: { stringValue: "D" } },
+                    { id: "14", constExpr: { stringValue: "E" } },
+                    { id: "15", constExpr: { stringValue: "F" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "multiple substitutions",
+          lineOffsets: [98],
+          positions: {
+            "1": 0,
+            "2": 47,
+            "3": 48,
+            "4": 49,
+            "5": 52,
+            "6": 55,
+            "7": 58,
+            "8": 63,
+            "9": 68,
+            "10": 73,
+            "11": 76,
+            "12": 79,
+            "13": 82,
+            "14": 87,
+            "15": 92,
+          },
+        },
+      },
+      checkedAst:
+        '"%d %d %d, %s %s %s, %d %d %d, %s %s %s"~string.format(\n  [\n    1~int,\n    2~int,\n    3~int,\n    "A"~string,\n    "B"~string,\n    "C"~string,\n    4~int,\n    5~int,\n    6~int,\n    "D"~string,\n    "E"~string,\n    "F"~string\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "STRING" },
+          "15": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [98],
+          positions: {
+            "1": 0,
+            "2": 47,
+            "3": 48,
+            "4": 49,
+            "5": 52,
+            "6": 55,
+            "7": 58,
+            "8": 63,
+            "9": 68,
+            "10": 73,
+            "11": 76,
+            "12": 79,
+            "13": 82,
+            "14": 87,
+            "15": 92,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: {
+                stringValue: "%d %d %d, %s %s %s, %d %d %d, %s %s %s",
+              },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                    { id: "7", constExpr: { stringValue: "A" } },
+                    { id: "8", constExpr: { stringValue: "B" } },
+                    { id: "9", constExpr: { stringValue: "C" } },
+                    { id: "10", constExpr: { int64Value: "4" } },
+                    { id: "11", constExpr: { int64Value: "5" } },
+                    { id: "12", constExpr: { int64Value: "6" } },
+                    { id: "13", constExpr: { stringValue: "D" } },
+                    { id: "14", constExpr: { stringValue: "E" } },
+                    { id: "15", constExpr: { stringValue: "F" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "1 2 3, A B C, 4 5 6, D E F" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "percent sign escape sequence support",
+        expr: '"%%escaped %s%%".format(["percent"])',
+        container: "ext",
+        value: { stringValue: "%escaped percent%" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%%escaped %s%%"^#*expr.Constant_StringValue#.format(\n  [\n    "percent"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%%escaped %s%%".format(["percent"])',
+      locationAst:
+        '"%%escaped %s%%"^#1[1,0]#.format(\n  [\n    "percent"^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 34, 1, 25, 1, 34],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%escaped %s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "percent" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "percent sign escape sequence support",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      checkedAst:
+        '"%%escaped %s%%"~string.format(\n  [\n    "percent"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%%escaped %s%%" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "percent" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "%escaped percent%" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "fixed point formatting clause",
+        expr: '"%.3f".format([1.2345])',
+        container: "ext",
+        value: { stringValue: "1.234" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%.3f"^#*expr.Constant_StringValue#.format(\n  [\n    1.2345^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%.3f".format([1.2345])',
+      locationAst:
+        '"%.3f"^#1[1,0]#.format(\n  [\n    1.2345^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 21, 1, 15, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.3f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 1.2345 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "fixed point formatting clause",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%.3f"~string.format(\n  [\n    1.2345~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.3f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 1.2345 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "1.234" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "binary formatting clause",
+        expr: '"this is 5 in binary: %b".format([5])',
+        container: "ext",
+        value: { stringValue: "this is 5 in binary: 101" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"this is 5 in binary: %b"^#*expr.Constant_StringValue#.format(\n  [\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"this is 5 in binary: %b".format([5])',
+      locationAst:
+        '"this is 5 in binary: %b"^#1[1,0]#.format(\n  [\n    5^#4[1,34]#\n  ]^#3[1,33]#\n)^#2[1,32]#',
+      positions: [
+        [1, 0, 25, 1, 0, 1, 25],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 33, 34, 1, 33, 1, 34],
+        [4, 34, 35, 1, 34, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is 5 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "5" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "binary formatting clause",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+      },
+      checkedAst:
+        '"this is 5 in binary: %b"~string.format(\n  [\n    5~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is 5 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "5" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "this is 5 in binary: 101" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "negative binary formatting clause",
+        expr: '"this is -5 in binary: %b".format([-5])',
+        container: "ext",
+        value: { stringValue: "this is -5 in binary: -101" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"this is -5 in binary: %b"^#*expr.Constant_StringValue#.format(\n  [\n    -5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"this is -5 in binary: %b".format([-5])',
+      locationAst:
+        '"this is -5 in binary: %b"^#1[1,0]#.format(\n  [\n    -5^#4[1,35]#\n  ]^#3[1,34]#\n)^#2[1,33]#',
+      positions: [
+        [1, 0, 26, 1, 0, 1, 26],
+        [2, 33, 34, 1, 33, 1, 34],
+        [3, 34, 35, 1, 34, 1, 35],
+        [4, 35, 37, 1, 35, 1, 37],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is -5 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-5" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative binary formatting clause",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+      },
+      checkedAst:
+        '"this is -5 in binary: %b"~string.format(\n  [\n    -5~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is -5 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-5" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "this is -5 in binary: -101" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "uint support for binary formatting",
+        expr: '"unsigned 64 in binary: %b".format([uint(64)])',
+        container: "ext",
+        value: { stringValue: "unsigned 64 in binary: 1000000" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"unsigned 64 in binary: %b"^#*expr.Constant_StringValue#.format(\n  [\n    uint(\n      64^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"unsigned 64 in binary: %b".format([uint(64)])',
+      locationAst:
+        '"unsigned 64 in binary: %b"^#1[1,0]#.format(\n  [\n    uint(\n      64^#5[1,41]#\n    )^#4[1,40]#\n  ]^#3[1,35]#\n)^#2[1,34]#',
+      positions: [
+        [1, 0, 27, 1, 0, 1, 27],
+        [2, 34, 35, 1, 34, 1, 35],
+        [3, 35, 36, 1, 35, 1, 36],
+        [4, 40, 41, 1, 40, 1, 41],
+        [5, 41, 43, 1, 41, 1, 43],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "unsigned 64 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "64" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uint support for binary formatting",
+          lineOffsets: [47],
+          positions: { "1": 0, "2": 34, "3": 35, "4": 40, "5": 41 },
+        },
+      },
+      checkedAst:
+        '"unsigned 64 in binary: %b"~string.format(\n  [\n    uint(\n      64~int\n    )~uint^int64_to_uint64\n  ]~list(uint)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["int64_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: { "1": 0, "2": 34, "3": 35, "4": 40, "5": 41 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "unsigned 64 in binary: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "64" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "unsigned 64 in binary: 1000000" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "bool support for binary formatting",
+        expr: '"bit set from bool: %b".format([true])',
+        container: "ext",
+        value: { stringValue: "bit set from bool: 1" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"bit set from bool: %b"^#*expr.Constant_StringValue#.format(\n  [\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"bit set from bool: %b".format([true])',
+      locationAst:
+        '"bit set from bool: %b"^#1[1,0]#.format(\n  [\n    true^#4[1,32]#\n  ]^#3[1,31]#\n)^#2[1,30]#',
+      positions: [
+        [1, 0, 23, 1, 0, 1, 23],
+        [2, 30, 31, 1, 30, 1, 31],
+        [3, 31, 32, 1, 31, 1, 32],
+        [4, 32, 36, 1, 32, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "bit set from bool: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { boolValue: true } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "bool support for binary formatting",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32 },
+        },
+      },
+      checkedAst:
+        '"bit set from bool: %b"~string.format(\n  [\n    true~bool\n  ]~list(bool)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BOOL" } } },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "bit set from bool: %b" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { boolValue: true } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "bit set from bool: 1" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "octal formatting clause",
+        expr: '"%o".format([11])',
+        container: "ext",
+        value: { stringValue: "13" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%o"^#*expr.Constant_StringValue#.format(\n  [\n    11^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%o".format([11])',
+      locationAst:
+        '"%o"^#1[1,0]#.format(\n  [\n    11^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "11" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "octal formatting clause",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%o"~string.format(\n  [\n    11~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "11" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "13" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "negative octal formatting clause",
+        expr: '"%o".format([-11])',
+        container: "ext",
+        value: { stringValue: "-13" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%o"^#*expr.Constant_StringValue#.format(\n  [\n    -11^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%o".format([-11])',
+      locationAst:
+        '"%o"^#1[1,0]#.format(\n  [\n    -11^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 16, 1, 13, 1, 16],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-11" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative octal formatting clause",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%o"~string.format(\n  [\n    -11~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-11" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "-13" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "uint support for octal formatting clause",
+        expr: '"this is an unsigned octal: %o".format([uint(65535)])',
+        container: "ext",
+        value: { stringValue: "this is an unsigned octal: 177777" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"this is an unsigned octal: %o"^#*expr.Constant_StringValue#.format(\n  [\n    uint(\n      65535^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"this is an unsigned octal: %o".format([uint(65535)])',
+      locationAst:
+        '"this is an unsigned octal: %o"^#1[1,0]#.format(\n  [\n    uint(\n      65535^#5[1,45]#\n    )^#4[1,44]#\n  ]^#3[1,39]#\n)^#2[1,38]#',
+      positions: [
+        [1, 0, 31, 1, 0, 1, 31],
+        [2, 38, 39, 1, 38, 1, 39],
+        [3, 39, 40, 1, 39, 1, 40],
+        [4, 44, 45, 1, 44, 1, 45],
+        [5, 45, 50, 1, 45, 1, 50],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is an unsigned octal: %o" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "65535" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uint support for octal formatting clause",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 38, "3": 39, "4": 44, "5": 45 },
+        },
+      },
+      checkedAst:
+        '"this is an unsigned octal: %o"~string.format(\n  [\n    uint(\n      65535~int\n    )~uint^int64_to_uint64\n  ]~list(uint)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["int64_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 38, "3": 39, "4": 44, "5": 45 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "this is an unsigned octal: %o" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "65535" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "this is an unsigned octal: 177777" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "lowercase hexadecimal formatting clause",
+        expr: '"%x is 30 in hexadecimal".format([30])',
+        container: "ext",
+        value: { stringValue: "1e is 30 in hexadecimal" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%x is 30 in hexadecimal"^#*expr.Constant_StringValue#.format(\n  [\n    30^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%x is 30 in hexadecimal".format([30])',
+      locationAst:
+        '"%x is 30 in hexadecimal"^#1[1,0]#.format(\n  [\n    30^#4[1,34]#\n  ]^#3[1,33]#\n)^#2[1,32]#',
+      positions: [
+        [1, 0, 25, 1, 0, 1, 25],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 33, 34, 1, 33, 1, 34],
+        [4, 34, 36, 1, 34, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%x is 30 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "30" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "lowercase hexadecimal formatting clause",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+      },
+      checkedAst:
+        '"%x is 30 in hexadecimal"~string.format(\n  [\n    30~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%x is 30 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "30" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "1e is 30 in hexadecimal" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "uppercase hexadecimal formatting clause",
+        expr: '"%X is 20 in hexadecimal".format([30])',
+        container: "ext",
+        value: { stringValue: "1E is 20 in hexadecimal" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%X is 20 in hexadecimal"^#*expr.Constant_StringValue#.format(\n  [\n    30^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%X is 20 in hexadecimal".format([30])',
+      locationAst:
+        '"%X is 20 in hexadecimal"^#1[1,0]#.format(\n  [\n    30^#4[1,34]#\n  ]^#3[1,33]#\n)^#2[1,32]#',
+      positions: [
+        [1, 0, 25, 1, 0, 1, 25],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 33, 34, 1, 33, 1, 34],
+        [4, 34, 36, 1, 34, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%X is 20 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "30" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uppercase hexadecimal formatting clause",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+      },
+      checkedAst:
+        '"%X is 20 in hexadecimal"~string.format(\n  [\n    30~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 32, "3": 33, "4": 34 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%X is 20 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "30" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "1E is 20 in hexadecimal" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "negative hexadecimal formatting clause",
+        expr: '"%x is -30 in hexadecimal".format([-30])',
+        container: "ext",
+        value: { stringValue: "-1e is -30 in hexadecimal" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%x is -30 in hexadecimal"^#*expr.Constant_StringValue#.format(\n  [\n    -30^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%x is -30 in hexadecimal".format([-30])',
+      locationAst:
+        '"%x is -30 in hexadecimal"^#1[1,0]#.format(\n  [\n    -30^#4[1,35]#\n  ]^#3[1,34]#\n)^#2[1,33]#',
+      positions: [
+        [1, 0, 26, 1, 0, 1, 26],
+        [2, 33, 34, 1, 33, 1, 34],
+        [3, 34, 35, 1, 34, 1, 35],
+        [4, 35, 38, 1, 35, 1, 38],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%x is -30 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-30" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative hexadecimal formatting clause",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+      },
+      checkedAst:
+        '"%x is -30 in hexadecimal"~string.format(\n  [\n    -30~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%x is -30 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "-30" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "-1e is -30 in hexadecimal" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "unsigned support for hexadecimal formatting clause",
+        expr: '"%X is 6000 in hexadecimal".format([uint(6000)])',
+        container: "ext",
+        value: { stringValue: "1770 is 6000 in hexadecimal" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%X is 6000 in hexadecimal"^#*expr.Constant_StringValue#.format(\n  [\n    uint(\n      6000^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%X is 6000 in hexadecimal".format([uint(6000)])',
+      locationAst:
+        '"%X is 6000 in hexadecimal"^#1[1,0]#.format(\n  [\n    uint(\n      6000^#5[1,41]#\n    )^#4[1,40]#\n  ]^#3[1,35]#\n)^#2[1,34]#',
+      positions: [
+        [1, 0, 27, 1, 0, 1, 27],
+        [2, 34, 35, 1, 34, 1, 35],
+        [3, 35, 36, 1, 35, 1, 36],
+        [4, 40, 41, 1, 40, 1, 41],
+        [5, 41, 45, 1, 41, 1, 45],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%X is 6000 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "6000" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "unsigned support for hexadecimal formatting clause",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 34, "3": 35, "4": 40, "5": 41 },
+        },
+      },
+      checkedAst:
+        '"%X is 6000 in hexadecimal"~string.format(\n  [\n    uint(\n      6000~int\n    )~uint^int64_to_uint64\n  ]~list(uint)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["int64_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 34, "3": 35, "4": 40, "5": 41 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "%X is 6000 in hexadecimal" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "6000" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "1770 is 6000 in hexadecimal" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "string support with hexadecimal formatting clause",
+        expr: '"%x".format(["Hello world!"])',
+        container: "ext",
+        value: { stringValue: "48656c6c6f20776f726c6421" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%x"^#*expr.Constant_StringValue#.format(\n  [\n    "Hello world!"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%x".format(["Hello world!"])',
+      locationAst:
+        '"%x"^#1[1,0]#.format(\n  [\n    "Hello world!"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 27, 1, 13, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Hello world!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "string support with hexadecimal formatting clause",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%x"~string.format(\n  [\n    "Hello world!"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Hello world!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "48656c6c6f20776f726c6421" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "string support with uppercase hexadecimal formatting clause",
+        expr: '"%X".format(["Hello world!"])',
+        container: "ext",
+        value: { stringValue: "48656C6C6F20776F726C6421" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%X"^#*expr.Constant_StringValue#.format(\n  [\n    "Hello world!"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%X".format(["Hello world!"])',
+      locationAst:
+        '"%X"^#1[1,0]#.format(\n  [\n    "Hello world!"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 27, 1, 13, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Hello world!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location:
+            "string support with uppercase hexadecimal formatting clause",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%X"~string.format(\n  [\n    "Hello world!"~string\n  ]~list(string)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "Hello world!" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "48656C6C6F20776F726C6421" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "byte support with hexadecimal formatting clause",
+        expr: '"%x".format([b"byte string"])',
+        container: "ext",
+        value: { stringValue: "6279746520737472696e67" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%x"^#*expr.Constant_StringValue#.format(\n  [\n    b"byte string"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%x".format([b"\\142\\171\\164\\145\\040\\163\\164\\162\\151\\156\\147"])',
+      locationAst:
+        '"%x"^#1[1,0]#.format(\n  [\n    b"byte string"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 27, 1, 13, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "Ynl0ZSBzdHJpbmc=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "byte support with hexadecimal formatting clause",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%x"~string.format(\n  [\n    b"byte string"~bytes\n  ]~list(bytes)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "Ynl0ZSBzdHJpbmc=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "6279746520737472696e67" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "byte support with hexadecimal formatting clause leading zero",
+        expr: '"%x".format([b"\\x00\\x00byte string\\x00"])',
+        container: "ext",
+        value: { stringValue: "00006279746520737472696e6700" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%x"^#*expr.Constant_StringValue#.format(\n  [\n    b"\\x00\\x00byte string\\x00"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%x".format([b"\\000\\000\\142\\171\\164\\145\\040\\163\\164\\162\\151\\156\\147\\000"])',
+      locationAst:
+        '"%x"^#1[1,0]#.format(\n  [\n    b"\\x00\\x00byte string\\x00"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 39, 1, 13, 1, 39],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { bytesValue: "AABieXRlIHN0cmluZwA=" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location:
+            "byte support with hexadecimal formatting clause leading zero",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%x"~string.format(\n  [\n    b"\\x00\\x00byte string\\x00"~bytes\n  ]~list(bytes)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      constExpr: { bytesValue: "AABieXRlIHN0cmluZwA=" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "00006279746520737472696e6700" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "byte support with uppercase hexadecimal formatting clause",
+        expr: '"%X".format([b"byte string"])',
+        container: "ext",
+        value: { stringValue: "6279746520737472696E67" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%X"^#*expr.Constant_StringValue#.format(\n  [\n    b"byte string"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%X".format([b"\\142\\171\\164\\145\\040\\163\\164\\162\\151\\156\\147"])',
+      locationAst:
+        '"%X"^#1[1,0]#.format(\n  [\n    b"byte string"^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 27, 1, 13, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "Ynl0ZSBzdHJpbmc=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "byte support with uppercase hexadecimal formatting clause",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%X"~string.format(\n  [\n    b"byte string"~bytes\n  ]~list(bytes)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "Ynl0ZSBzdHJpbmc=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "6279746520737472696E67" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "scientific notation formatting clause",
+        expr: '"%.6e".format([1052.032911275])',
+        container: "ext",
+        value: { stringValue: "1.052033e+03" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%.6e"^#*expr.Constant_StringValue#.format(\n  [\n    1052.032911275^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%.6e".format([1052.032911275])',
+      locationAst:
+        '"%.6e"^#1[1,0]#.format(\n  [\n    1052.032911275^#4[1,15]#\n  ]^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 29, 1, 15, 1, 29],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.6e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { doubleValue: 1052.032911275 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "scientific notation formatting clause",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '"%.6e"~string.format(\n  [\n    1052.032911275~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%.6e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { doubleValue: 1052.032911275 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "1.052033e+03" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default precision for fixed-point clause",
+        expr: '"%f".format([2.71828])',
+        container: "ext",
+        value: { stringValue: "2.718280" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%f"^#*expr.Constant_StringValue#.format(\n  [\n    2.71828^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%f".format([2.71828])',
+      locationAst:
+        '"%f"^#1[1,0]#.format(\n  [\n    2.71828^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 20, 1, 13, 1, 20],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71828 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default precision for fixed-point clause",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%f"~string.format(\n  [\n    2.71828~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71828 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "2.718280" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default precision for scientific notation",
+        expr: '"%e".format([2.71828])',
+        container: "ext",
+        value: { stringValue: "2.718280e+00" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%e"^#*expr.Constant_StringValue#.format(\n  [\n    2.71828^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%e".format([2.71828])',
+      locationAst:
+        '"%e"^#1[1,0]#.format(\n  [\n    2.71828^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 20, 1, 13, 1, 20],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71828 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default precision for scientific notation",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%e"~string.format(\n  [\n    2.71828~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71828 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "2.718280e+00" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default precision for string",
+        expr: '"%s".format([2.71])',
+        container: "ext",
+        value: { stringValue: "2.71" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    2.71^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([2.71])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    2.71^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 17, 1, 13, 1, 17],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default precision for string",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    2.71~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.71 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "2.71" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default list precision for string",
+        expr: '"%s".format([[2.71]])',
+        container: "ext",
+        value: { stringValue: "[2.71]" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      2.71^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([[2.71]])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    [\n      2.71^#5[1,14]#\n    ]^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 18, 1, 14, 1, 18],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { doubleValue: 2.71 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default list precision for string",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13, "5": 14 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    [\n      2.71~double\n    ]~list(double)\n  ]~list(list(double))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13, "5": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { doubleValue: 2.71 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "21", max: "21" },
+      result: { value: { stringValue: "[2.71]" } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        name: "default format for string",
+        expr: '"%s".format([0.000000002])',
+        container: "ext",
+        value: { stringValue: "0.000000002" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    2e-09^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([2e-09.0])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    2e-09^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 24, 1, 13, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2e-9 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default format for string",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    2e-09~double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2e-9 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "0.000000002" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "default list scientific notation for string",
+        expr: '"%s".format([[0.000000002]])',
+        container: "ext",
+        value: { stringValue: "[0.000000002]" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      2e-09^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([[2e-09.0]])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    [\n      2e-09^#5[1,14]#\n    ]^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 25, 1, 14, 1, 25],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { doubleValue: 2e-9 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "default list scientific notation for string",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13, "5": 14 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    [\n      2e-09~double\n    ]~list(double)\n  ]~list(list(double))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13, "5": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { doubleValue: 2e-9 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "21", max: "21" },
+      result: { value: { stringValue: "[0.000000002]" } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        name: "NaN support for fixed-point",
+        expr: '"%f".format([double("NaN")])',
+        container: "ext",
+        value: { stringValue: "NaN" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%f"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "NaN"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%f".format([double("NaN")])',
+      locationAst:
+        '"%f"^#1[1,0]#.format(\n  [\n    double(\n      "NaN"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 25, 1, 20, 1, 25],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "NaN" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "NaN support for fixed-point",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%f"~string.format(\n  [\n    double(\n      "NaN"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "NaN" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "NaN" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "positive infinity support for fixed-point",
+        expr: '"%f".format([double("Infinity")])',
+        container: "ext",
+        value: { stringValue: "Infinity" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%f"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "Infinity"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%f".format([double("Infinity")])',
+      locationAst:
+        '"%f"^#1[1,0]#.format(\n  [\n    double(\n      "Infinity"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 30, 1, 20, 1, 30],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "Infinity" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "positive infinity support for fixed-point",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%f"~string.format(\n  [\n    double(\n      "Infinity"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "Infinity" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "Infinity" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "negative infinity support for fixed-point",
+        expr: '"%f".format([double("-Infinity")])',
+        container: "ext",
+        value: { stringValue: "-Infinity" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%f"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "-Infinity"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%f".format([double("-Infinity")])',
+      locationAst:
+        '"%f"^#1[1,0]#.format(\n  [\n    double(\n      "-Infinity"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 31, 1, 20, 1, 31],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "-Infinity" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative infinity support for fixed-point",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%f"~string.format(\n  [\n    double(\n      "-Infinity"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "-Infinity" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "-Infinity" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "NaN support for string",
+        expr: '"%s".format([double("NaN")])',
+        container: "ext",
+        value: { stringValue: "NaN" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "NaN"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([double("NaN")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    double(\n      "NaN"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 25, 1, 20, 1, 25],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "NaN" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "NaN support for string",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    double(\n      "NaN"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [{ id: "5", constExpr: { stringValue: "NaN" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "NaN" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "positive infinity support for string",
+        expr: '"%s".format([double("Infinity")])',
+        container: "ext",
+        value: { stringValue: "Infinity" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "Infinity"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([double("Infinity")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    double(\n      "Infinity"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 30, 1, 20, 1, 30],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "Infinity" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "positive infinity support for string",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    double(\n      "Infinity"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "Infinity" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "Infinity" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "negative infinity support for string",
+        expr: '"%s".format([double("-Infinity")])',
+        container: "ext",
+        value: { stringValue: "-Infinity" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    double(\n      "-Infinity"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([double("-Infinity")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    double(\n      "-Infinity"^#5[1,20]#\n    )^#4[1,19]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 31, 1, 20, 1, 31],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "-Infinity" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "negative infinity support for string",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    double(\n      "-Infinity"~string\n    )~double^string_to_double\n  ]~list(double)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "double",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "-Infinity" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "-Infinity" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "infinity list support for string",
+        expr: '"%s".format([[double("NaN"),double("+Infinity"), double("-Infinity")]])',
+        container: "ext",
+        value: { stringValue: "[NaN, Infinity, -Infinity]" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      double(\n        "NaN"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      double(\n        "+Infinity"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      double(\n        "-Infinity"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%s".format([[double("NaN"), double("+Infinity"), double("-Infinity")]])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    [\n      double(\n        "NaN"^#6[1,21]#\n      )^#5[1,20]#,\n      double(\n        "+Infinity"^#8[1,35]#\n      )^#7[1,34]#,\n      double(\n        "-Infinity"^#10[1,56]#\n      )^#9[1,55]#\n    ]^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 21, 26, 1, 21, 1, 26],
+        [7, 34, 35, 1, 34, 1, 35],
+        [8, 35, 46, 1, 35, 1, 46],
+        [9, 55, 56, 1, 55, 1, 56],
+        [10, 56, 67, 1, 56, 1, 67],
+      ],
+      lineOffsets: [72],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                { id: "6", constExpr: { stringValue: "NaN" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "7",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                {
+                                  id: "8",
+                                  constExpr: { stringValue: "+Infinity" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                {
+                                  id: "10",
+                                  constExpr: { stringValue: "-Infinity" },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "infinity list support for string",
+          lineOffsets: [72],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 20,
+            "6": 21,
+            "7": 34,
+            "8": 35,
+            "9": 55,
+            "10": 56,
+          },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    [\n      double(\n        "NaN"~string\n      )~double^string_to_double,\n      double(\n        "+Infinity"~string\n      )~double^string_to_double,\n      double(\n        "-Infinity"~string\n      )~double^string_to_double\n    ]~list(double)\n  ]~list(list(double))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "5": { overloadId: ["string_to_double"] },
+          "7": { overloadId: ["string_to_double"] },
+          "9": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [72],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 20,
+            "6": 21,
+            "7": 34,
+            "8": 35,
+            "9": 55,
+            "10": 56,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                { id: "6", constExpr: { stringValue: "NaN" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "7",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                {
+                                  id: "8",
+                                  constExpr: { stringValue: "+Infinity" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "double",
+                              args: [
+                                {
+                                  id: "10",
+                                  constExpr: { stringValue: "-Infinity" },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "24", max: "24" },
+      result: { value: { stringValue: "[NaN, Infinity, -Infinity]" } },
+      runtimeCost: "24",
+    },
+    {
+      original: {
+        name: "uint support for decimal clause",
+        expr: '"%d".format([uint(64)])',
+        container: "ext",
+        value: { stringValue: "64" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%d"^#*expr.Constant_StringValue#.format(\n  [\n    uint(\n      64^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%d".format([uint(64)])',
+      locationAst:
+        '"%d"^#1[1,0]#.format(\n  [\n    uint(\n      64^#5[1,18]#\n    )^#4[1,17]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "64" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uint support for decimal clause",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 18 },
+        },
+      },
+      checkedAst:
+        '"%d"~string.format(\n  [\n    uint(\n      64~int\n    )~uint^int64_to_uint64\n  ]~list(uint)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["int64_to_uint64"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 18 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "uint",
+                        args: [{ id: "5", constExpr: { int64Value: "64" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "64" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "null support for string",
+        expr: '"null: %s".format([null])',
+        container: "ext",
+        value: { stringValue: "null: null" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"null: %s"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %s".format([null])',
+      locationAst:
+        '"null: %s"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null support for string",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      checkedAst:
+        '"null: %s"~string.format(\n  [\n    null~null\n  ]~list(null)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { null: null } } },
+          "4": { null: null },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "null: null" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "int support for string",
+        expr: '"%s".format([999999999999])',
+        container: "ext",
+        value: { stringValue: "999999999999" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    999999999999^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([999999999999])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    999999999999^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 25, 1, 13, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "999999999999" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "int support for string",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    999999999999~int\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "999999999999" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "11", max: "11" },
+      result: { value: { stringValue: "999999999999" } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "bytes support for string",
+        expr: '"some bytes: %s".format([b"xyz"])',
+        container: "ext",
+        value: { stringValue: "some bytes: xyz" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"some bytes: %s"^#*expr.Constant_StringValue#.format(\n  [\n    b"xyz"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"some bytes: %s".format([b"\\170\\171\\172"])',
+      locationAst:
+        '"some bytes: %s"^#1[1,0]#.format(\n  [\n    b"xyz"^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 31, 1, 25, 1, 31],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "some bytes: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { bytesValue: "eHl6" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "bytes support for string",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      checkedAst:
+        '"some bytes: %s"~string.format(\n  [\n    b"xyz"~bytes\n  ]~list(bytes)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "some bytes: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { bytesValue: "eHl6" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "some bytes: xyz" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "type() support for string",
+        expr: '"type is %s".format([type("test string")])',
+        container: "ext",
+        value: { stringValue: "type is string" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"type is %s"^#*expr.Constant_StringValue#.format(\n  [\n    type(\n      "test string"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"type is %s".format([type("test string")])',
+      locationAst:
+        '"type is %s"^#1[1,0]#.format(\n  [\n    type(\n      "test string"^#5[1,26]#\n    )^#4[1,25]#\n  ]^#3[1,20]#\n)^#2[1,19]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 26, 39, 1, 26, 1, 39],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "type is %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "type",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: { stringValue: "test string" },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "type() support for string",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 25, "5": 26 },
+        },
+      },
+      checkedAst:
+        '"type is %s"~string.format(\n  [\n    type(\n      "test string"~string\n    )~type(string)^type\n  ]~list(type(string))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["type"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { type: { primitive: "STRING" } } } },
+          "4": { type: { primitive: "STRING" } },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 25, "5": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "type is %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "type",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: { stringValue: "test string" },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "type is string" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "timestamp support for string",
+        expr: '"%s".format([timestamp("2023-02-03T23:31:20+00:00")])',
+        container: "ext",
+        value: { stringValue: "2023-02-03T23:31:20Z" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    timestamp(\n      "2023-02-03T23:31:20+00:00"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([timestamp("2023-02-03T23:31:20+00:00")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    timestamp(\n      "2023-02-03T23:31:20+00:00"^#5[1,23]#\n    )^#4[1,22]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 23, 50, 1, 23, 1, 50],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "timestamp",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: {
+                              stringValue: "2023-02-03T23:31:20+00:00",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp support for string",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 22, "5": 23 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    timestamp(\n      "2023-02-03T23:31:20+00:00"~string\n    )~timestamp^string_to_timestamp\n  ]~list(timestamp)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { wellKnown: "TIMESTAMP" } } },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 22, "5": 23 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "timestamp",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: {
+                              stringValue: "2023-02-03T23:31:20+00:00",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "2023-02-03T23:31:20Z" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "duration support for string",
+        expr: '"%s".format([duration("1h45m47s")])',
+        container: "ext",
+        value: { stringValue: "6347s" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    duration(\n      "1h45m47s"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([duration("1h45m47s")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    duration(\n      "1h45m47s"^#5[1,22]#\n    )^#4[1,21]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 32, 1, 22, 1, 32],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "1h45m47s" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "duration support for string",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    duration(\n      "1h45m47s"~string\n    )~duration^string_to_duration\n  ]~list(duration)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_duration"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { wellKnown: "DURATION" } } },
+          "4": { wellKnown: "DURATION" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "1h45m47s" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "6347s" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "small duration support for string",
+        expr: '"%s".format([duration("2ns")])',
+        container: "ext",
+        value: { stringValue: "0.000000002s" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    duration(\n      "2ns"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s".format([duration("2ns")])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    duration(\n      "2ns"^#5[1,22]#\n    )^#4[1,21]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 27, 1, 22, 1, 27],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [{ id: "5", constExpr: { stringValue: "2ns" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "small duration support for string",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    duration(\n      "2ns"~string\n    )~duration^string_to_duration\n  ]~list(duration)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { overloadId: ["string_to_duration"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { wellKnown: "DURATION" } } },
+          "4": { wellKnown: "DURATION" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [{ id: "5", constExpr: { stringValue: "2ns" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "12", max: "12" },
+      result: { value: { stringValue: "0.000000002s" } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "list support for string",
+        expr: '"%s".format([["abc", 3.14, null, [9, 8, 7, 6], timestamp("2023-02-03T23:31:20Z")]])',
+        container: "ext",
+        value: {
+          stringValue: "[abc, 3.14, null, [9, 8, 7, 6], 2023-02-03T23:31:20Z]",
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      "abc"^#*expr.Constant_StringValue#,\n      3.14^#*expr.Constant_DoubleValue#,\n      null^#*expr.Constant_NullValue#,\n      [\n        9^#*expr.Constant_Int64Value#,\n        8^#*expr.Constant_Int64Value#,\n        7^#*expr.Constant_Int64Value#,\n        6^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#,\n      timestamp(\n        "2023-02-03T23:31:20Z"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%s".format([["abc", 3.14, null, [9, 8, 7, 6], timestamp("2023-02-03T23:31:20Z")]])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    [\n      "abc"^#5[1,14]#,\n      3.14^#6[1,21]#,\n      null^#7[1,27]#,\n      [\n        9^#9[1,34]#,\n        8^#10[1,37]#,\n        7^#11[1,40]#,\n        6^#12[1,43]#\n      ]^#8[1,33]#,\n      timestamp(\n        "2023-02-03T23:31:20Z"^#14[1,57]#\n      )^#13[1,56]#\n    ]^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 19, 1, 14, 1, 19],
+        [6, 21, 25, 1, 21, 1, 25],
+        [7, 27, 31, 1, 27, 1, 31],
+        [8, 33, 34, 1, 33, 1, 34],
+        [9, 34, 35, 1, 34, 1, 35],
+        [10, 37, 38, 1, 37, 1, 38],
+        [11, 40, 41, 1, 40, 1, 41],
+        [12, 43, 44, 1, 43, 1, 44],
+        [13, 56, 57, 1, 56, 1, 57],
+        [14, 57, 79, 1, 57, 1, 79],
+      ],
+      lineOffsets: [84],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { stringValue: "abc" } },
+                          { id: "6", constExpr: { doubleValue: 3.14 } },
+                          { id: "7", constExpr: { nullValue: null } },
+                          {
+                            id: "8",
+                            listExpr: {
+                              elements: [
+                                { id: "9", constExpr: { int64Value: "9" } },
+                                { id: "10", constExpr: { int64Value: "8" } },
+                                { id: "11", constExpr: { int64Value: "7" } },
+                                { id: "12", constExpr: { int64Value: "6" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "timestamp",
+                              args: [
+                                {
+                                  id: "14",
+                                  constExpr: {
+                                    stringValue: "2023-02-03T23:31:20Z",
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list support for string",
+          lineOffsets: [84],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 14,
+            "6": 21,
+            "7": 27,
+            "8": 33,
+            "9": 34,
+            "10": 37,
+            "11": 40,
+            "12": 43,
+            "13": 56,
+            "14": 57,
+          },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    [\n      "abc"~string,\n      3.14~double,\n      null~null,\n      [\n        9~int,\n        8~int,\n        7~int,\n        6~int\n      ]~list(int),\n      timestamp(\n        "2023-02-03T23:31:20Z"~string\n      )~timestamp^string_to_timestamp\n    ]~list(dyn)\n  ]~list(list(dyn))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "13": { overloadId: ["string_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "DOUBLE" },
+          "7": { null: null },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { wellKnown: "TIMESTAMP" },
+          "14": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [84],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 14,
+            "6": 21,
+            "7": 27,
+            "8": 33,
+            "9": 34,
+            "10": 37,
+            "11": 40,
+            "12": 43,
+            "13": 56,
+            "14": 57,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { stringValue: "abc" } },
+                          { id: "6", constExpr: { doubleValue: 3.14 } },
+                          { id: "7", constExpr: { nullValue: null } },
+                          {
+                            id: "8",
+                            listExpr: {
+                              elements: [
+                                { id: "9", constExpr: { int64Value: "9" } },
+                                { id: "10", constExpr: { int64Value: "8" } },
+                                { id: "11", constExpr: { int64Value: "7" } },
+                                { id: "12", constExpr: { int64Value: "6" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "timestamp",
+                              args: [
+                                {
+                                  id: "14",
+                                  constExpr: {
+                                    stringValue: "2023-02-03T23:31:20Z",
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "32", max: "32" },
+      result: {
+        value: {
+          stringValue: "[abc, 3.14, null, [9, 8, 7, 6], 2023-02-03T23:31:20Z]",
+        },
+      },
+      runtimeCost: "32",
+    },
+    {
+      original: {
+        name: "map support for string",
+        expr: '"%s".format([{"key1": b"xyz", "key5": null, "key2": duration("2h"), "key4": true, "key3": 2.71828}])',
+        container: "ext",
+        value: {
+          stringValue:
+            "{key1: xyz, key2: 7200s, key3: 2.71828, key4: true, key5: null}",
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s"^#*expr.Constant_StringValue#.format(\n  [\n    {\n      "key1"^#*expr.Constant_StringValue#:b"xyz"^#*expr.Constant_BytesValue#^#*expr.Expr_CreateStruct_Entry#,\n      "key5"^#*expr.Constant_StringValue#:null^#*expr.Constant_NullValue#^#*expr.Expr_CreateStruct_Entry#,\n      "key2"^#*expr.Constant_StringValue#:duration(\n        "2h"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#,\n      "key4"^#*expr.Constant_StringValue#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#,\n      "key3"^#*expr.Constant_StringValue#:2.71828^#*expr.Constant_DoubleValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"%s".format([{"key1": b"\\170\\171\\172", "key5": null, "key2": duration("2h"), "key4": true, "key3": 2.71828}])',
+      locationAst:
+        '"%s"^#1[1,0]#.format(\n  [\n    {\n      "key1"^#6[1,14]#:b"xyz"^#7[1,22]#^#5[1,20]#,\n      "key5"^#9[1,30]#:null^#10[1,38]#^#8[1,36]#,\n      "key2"^#12[1,44]#:duration(\n        "2h"^#14[1,61]#\n      )^#13[1,60]#^#11[1,50]#,\n      "key4"^#16[1,68]#:true^#17[1,76]#^#15[1,74]#,\n      "key3"^#19[1,82]#:2.71828^#20[1,90]#^#18[1,88]#\n    }^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 14, 20, 1, 14, 1, 20],
+        [7, 22, 28, 1, 22, 1, 28],
+        [8, 36, 37, 1, 36, 1, 37],
+        [9, 30, 36, 1, 30, 1, 36],
+        [10, 38, 42, 1, 38, 1, 42],
+        [11, 50, 51, 1, 50, 1, 51],
+        [12, 44, 50, 1, 44, 1, 50],
+        [13, 60, 61, 1, 60, 1, 61],
+        [14, 61, 65, 1, 61, 1, 65],
+        [15, 74, 75, 1, 74, 1, 75],
+        [16, 68, 74, 1, 68, 1, 74],
+        [17, 76, 80, 1, 76, 1, 80],
+        [18, 88, 89, 1, 88, 1, 89],
+        [19, 82, 88, 1, 82, 1, 88],
+        [20, 90, 97, 1, 90, 1, 97],
+      ],
+      lineOffsets: [101],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: {
+                              id: "6",
+                              constExpr: { stringValue: "key1" },
+                            },
+                            value: {
+                              id: "7",
+                              constExpr: { bytesValue: "eHl6" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              constExpr: { stringValue: "key5" },
+                            },
+                            value: { id: "10", constExpr: { nullValue: null } },
+                          },
+                          {
+                            id: "11",
+                            mapKey: {
+                              id: "12",
+                              constExpr: { stringValue: "key2" },
+                            },
+                            value: {
+                              id: "13",
+                              callExpr: {
+                                function: "duration",
+                                args: [
+                                  {
+                                    id: "14",
+                                    constExpr: { stringValue: "2h" },
+                                  },
+                                ],
+                              },
+                            },
+                          },
+                          {
+                            id: "15",
+                            mapKey: {
+                              id: "16",
+                              constExpr: { stringValue: "key4" },
+                            },
+                            value: { id: "17", constExpr: { boolValue: true } },
+                          },
+                          {
+                            id: "18",
+                            mapKey: {
+                              id: "19",
+                              constExpr: { stringValue: "key3" },
+                            },
+                            value: {
+                              id: "20",
+                              constExpr: { doubleValue: 2.71828 },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "map support for string",
+          lineOffsets: [101],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 20,
+            "6": 14,
+            "7": 22,
+            "8": 36,
+            "9": 30,
+            "10": 38,
+            "11": 50,
+            "12": 44,
+            "13": 60,
+            "14": 61,
+            "15": 74,
+            "16": 68,
+            "17": 76,
+            "18": 88,
+            "19": 82,
+            "20": 90,
+          },
+        },
+      },
+      checkedAst:
+        '"%s"~string.format(\n  [\n    {\n      "key1"~string:b"xyz"~bytes,\n      "key5"~string:null~null,\n      "key2"~string:duration(\n        "2h"~string\n      )~duration^string_to_duration,\n      "key4"~string:true~bool,\n      "key3"~string:2.71828~double\n    }~map(string, dyn)\n  ]~list(map(string, dyn))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "13": { overloadId: ["string_to_duration"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { dyn: {} },
+                },
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BYTES" },
+          "9": { primitive: "STRING" },
+          "10": { null: null },
+          "12": { primitive: "STRING" },
+          "13": { wellKnown: "DURATION" },
+          "14": { primitive: "STRING" },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "BOOL" },
+          "19": { primitive: "STRING" },
+          "20": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [101],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 13,
+            "5": 20,
+            "6": 14,
+            "7": 22,
+            "8": 36,
+            "9": 30,
+            "10": 38,
+            "11": 50,
+            "12": 44,
+            "13": 60,
+            "14": 61,
+            "15": 74,
+            "16": 68,
+            "17": 76,
+            "18": 88,
+            "19": 82,
+            "20": 90,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: {
+                              id: "6",
+                              constExpr: { stringValue: "key1" },
+                            },
+                            value: {
+                              id: "7",
+                              constExpr: { bytesValue: "eHl6" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              constExpr: { stringValue: "key5" },
+                            },
+                            value: { id: "10", constExpr: { nullValue: null } },
+                          },
+                          {
+                            id: "11",
+                            mapKey: {
+                              id: "12",
+                              constExpr: { stringValue: "key2" },
+                            },
+                            value: {
+                              id: "13",
+                              callExpr: {
+                                function: "duration",
+                                args: [
+                                  {
+                                    id: "14",
+                                    constExpr: { stringValue: "2h" },
+                                  },
+                                ],
+                              },
+                            },
+                          },
+                          {
+                            id: "15",
+                            mapKey: {
+                              id: "16",
+                              constExpr: { stringValue: "key4" },
+                            },
+                            value: { id: "17", constExpr: { boolValue: true } },
+                          },
+                          {
+                            id: "18",
+                            mapKey: {
+                              id: "19",
+                              constExpr: { stringValue: "key3" },
+                            },
+                            value: {
+                              id: "20",
+                              constExpr: { doubleValue: 2.71828 },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "42", max: "42" },
+      result: {
+        value: {
+          stringValue:
+            "{key1: xyz, key2: 7200s, key3: 2.71828, key4: true, key5: null}",
+        },
+      },
+      runtimeCost: "42",
+    },
+    {
+      original: {
+        name: "map support (all key types)",
+        expr: '"map with multiple key types: %s".format([{1: "value1", uint(2): "value2", true: double("NaN")}])',
+        container: "ext",
+        value: {
+          stringValue:
+            "map with multiple key types: {1: value1, 2: value2, true: NaN}",
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"map with multiple key types: %s"^#*expr.Constant_StringValue#.format(\n  [\n    {\n      1^#*expr.Constant_Int64Value#:"value1"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      uint(\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#:"value2"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      true^#*expr.Constant_BoolValue#:double(\n        "NaN"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"map with multiple key types: %s".format([{1: "value1", uint(2): "value2", true: double("NaN")}])',
+      locationAst:
+        '"map with multiple key types: %s"^#1[1,0]#.format(\n  [\n    {\n      1^#6[1,43]#:"value1"^#7[1,46]#^#5[1,44]#,\n      uint(\n        2^#10[1,61]#\n      )^#9[1,60]#:"value2"^#11[1,65]#^#8[1,63]#,\n      true^#13[1,75]#:double(\n        "NaN"^#15[1,88]#\n      )^#14[1,87]#^#12[1,79]#\n    }^#4[1,42]#\n  ]^#3[1,41]#\n)^#2[1,40]#',
+      positions: [
+        [1, 0, 33, 1, 0, 1, 33],
+        [2, 40, 41, 1, 40, 1, 41],
+        [3, 41, 42, 1, 41, 1, 42],
+        [4, 42, 43, 1, 42, 1, 43],
+        [5, 44, 45, 1, 44, 1, 45],
+        [6, 43, 44, 1, 43, 1, 44],
+        [7, 46, 54, 1, 46, 1, 54],
+        [8, 63, 64, 1, 63, 1, 64],
+        [9, 60, 61, 1, 60, 1, 61],
+        [10, 61, 62, 1, 61, 1, 62],
+        [11, 65, 73, 1, 65, 1, 73],
+        [12, 79, 80, 1, 79, 1, 80],
+        [13, 75, 79, 1, 75, 1, 79],
+        [14, 87, 88, 1, 87, 1, 88],
+        [15, 88, 93, 1, 88, 1, 93],
+      ],
+      lineOffsets: [98],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "map with multiple key types: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "value1" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              callExpr: {
+                                function: "uint",
+                                args: [
+                                  { id: "10", constExpr: { int64Value: "2" } },
+                                ],
+                              },
+                            },
+                            value: {
+                              id: "11",
+                              constExpr: { stringValue: "value2" },
+                            },
+                          },
+                          {
+                            id: "12",
+                            mapKey: {
+                              id: "13",
+                              constExpr: { boolValue: true },
+                            },
+                            value: {
+                              id: "14",
+                              callExpr: {
+                                function: "double",
+                                args: [
+                                  {
+                                    id: "15",
+                                    constExpr: { stringValue: "NaN" },
+                                  },
+                                ],
+                              },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "map support (all key types)",
+          lineOffsets: [98],
+          positions: {
+            "1": 0,
+            "2": 40,
+            "3": 41,
+            "4": 42,
+            "5": 44,
+            "6": 43,
+            "7": 46,
+            "8": 63,
+            "9": 60,
+            "10": 61,
+            "11": 65,
+            "12": 79,
+            "13": 75,
+            "14": 87,
+            "15": 88,
+          },
+        },
+      },
+      checkedAst:
+        '"map with multiple key types: %s"~string.format(\n  [\n    {\n      1~int:"value1"~string,\n      uint(\n        2~int\n      )~uint^int64_to_uint64:"value2"~string,\n      true~bool:double(\n        "NaN"~string\n      )~double^string_to_double\n    }~map(dyn, dyn)\n  ]~list(map(dyn, dyn))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "9": { overloadId: ["int64_to_uint64"] },
+          "14": { overloadId: ["string_to_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: {
+                mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } },
+              },
+            },
+          },
+          "4": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "9": { primitive: "UINT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "STRING" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "DOUBLE" },
+          "15": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [98],
+          positions: {
+            "1": 0,
+            "2": 40,
+            "3": 41,
+            "4": 42,
+            "5": 44,
+            "6": 43,
+            "7": 46,
+            "8": 63,
+            "9": 60,
+            "10": 61,
+            "11": 65,
+            "12": 79,
+            "13": 75,
+            "14": 87,
+            "15": 88,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "map with multiple key types: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "value1" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              callExpr: {
+                                function: "uint",
+                                args: [
+                                  { id: "10", constExpr: { int64Value: "2" } },
+                                ],
+                              },
+                            },
+                            value: {
+                              id: "11",
+                              constExpr: { stringValue: "value2" },
+                            },
+                          },
+                          {
+                            id: "12",
+                            mapKey: {
+                              id: "13",
+                              constExpr: { boolValue: true },
+                            },
+                            value: {
+                              id: "14",
+                              callExpr: {
+                                function: "double",
+                                args: [
+                                  {
+                                    id: "15",
+                                    constExpr: { stringValue: "NaN" },
+                                  },
+                                ],
+                              },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "46", max: "46" },
+      result: {
+        value: {
+          stringValue:
+            "map with multiple key types: {1: value1, 2: value2, true: NaN}",
+        },
+      },
+      runtimeCost: "46",
+    },
+    {
+      original: {
+        name: "boolean support for %s",
+        expr: '"true bool: %s, false bool: %s".format([true, false])',
+        container: "ext",
+        value: { stringValue: "true bool: true, false bool: false" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"true bool: %s, false bool: %s"^#*expr.Constant_StringValue#.format(\n  [\n    true^#*expr.Constant_BoolValue#,\n    false^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"true bool: %s, false bool: %s".format([true, false])',
+      locationAst:
+        '"true bool: %s, false bool: %s"^#1[1,0]#.format(\n  [\n    true^#4[1,40]#,\n    false^#5[1,46]#\n  ]^#3[1,39]#\n)^#2[1,38]#',
+      positions: [
+        [1, 0, 31, 1, 0, 1, 31],
+        [2, 38, 39, 1, 38, 1, 39],
+        [3, 39, 40, 1, 39, 1, 40],
+        [4, 40, 44, 1, 40, 1, 44],
+        [5, 46, 51, 1, 46, 1, 51],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "true bool: %s, false bool: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { boolValue: true } },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "boolean support for %s",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 38, "3": 39, "4": 40, "5": 46 },
+        },
+      },
+      checkedAst:
+        '"true bool: %s, false bool: %s"~string.format(\n  [\n    true~bool,\n    false~bool\n  ]~list(bool)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "BOOL" } } },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 38, "3": 39, "4": 40, "5": 46 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "true bool: %s, false bool: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { boolValue: true } },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "true bool: true, false bool: false" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for string formatting clause",
+        expr: '"dynamic string: %s".format([dynStr])',
+        typeEnv: [{ name: "dynStr", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynStr: { value: { stringValue: "a string" } } },
+        value: { stringValue: "dynamic string: a string" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dynamic string: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynStr^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic string: %s".format([dynStr])',
+      locationAst:
+        '"dynamic string: %s"^#1[1,0]#.format(\n  [\n    dynStr^#4[1,29]#\n  ]^#3[1,28]#\n)^#2[1,27]#',
+      positions: [
+        [1, 0, 20, 1, 0, 1, 20],
+        [2, 27, 28, 1, 27, 1, 28],
+        [3, 28, 29, 1, 28, 1, 29],
+        [4, 29, 35, 1, 29, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic string: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynStr" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for string formatting clause",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 27, "3": 28, "4": 29 },
+        },
+      },
+      checkedAst:
+        '"dynamic string: %s"~string.format(\n  [\n    dynStr~dyn^dynStr\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynStr" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 27, "3": 28, "4": 29 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic string: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynStr" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic string: a string" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for numbers with string formatting clause",
+        expr: '"dynIntStr: %s dynDoubleStr: %s".format([dynIntStr, dynDoubleStr])',
+        typeEnv: [
+          { name: "dynDoubleStr", ident: { type: { dyn: {} } } },
+          { name: "dynIntStr", ident: { type: { dyn: {} } } },
+        ],
+        container: "ext",
+        bindings: {
+          dynDoubleStr: { value: { doubleValue: 56.8 } },
+          dynIntStr: { value: { int64Value: "32" } },
+        },
+        value: { stringValue: "dynIntStr: 32 dynDoubleStr: 56.8" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dynIntStr: %s dynDoubleStr: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynIntStr^#*expr.Expr_IdentExpr#,\n    dynDoubleStr^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"dynIntStr: %s dynDoubleStr: %s".format([dynIntStr, dynDoubleStr])',
+      locationAst:
+        '"dynIntStr: %s dynDoubleStr: %s"^#1[1,0]#.format(\n  [\n    dynIntStr^#4[1,41]#,\n    dynDoubleStr^#5[1,52]#\n  ]^#3[1,40]#\n)^#2[1,39]#',
+      positions: [
+        [1, 0, 32, 1, 0, 1, 32],
+        [2, 39, 40, 1, 39, 1, 40],
+        [3, 40, 41, 1, 40, 1, 41],
+        [4, 41, 50, 1, 41, 1, 50],
+        [5, 52, 64, 1, 52, 1, 64],
+      ],
+      lineOffsets: [67],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynIntStr: %s dynDoubleStr: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynIntStr" } },
+                    { id: "5", identExpr: { name: "dynDoubleStr" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for numbers with string formatting clause",
+          lineOffsets: [67],
+          positions: { "1": 0, "2": 39, "3": 40, "4": 41, "5": 52 },
+        },
+      },
+      checkedAst:
+        '"dynIntStr: %s dynDoubleStr: %s"~string.format(\n  [\n    dynIntStr~dyn^dynIntStr,\n    dynDoubleStr~dyn^dynDoubleStr\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynIntStr" },
+          "5": { name: "dynDoubleStr" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: { "1": 0, "2": 39, "3": 40, "4": 41, "5": 52 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynIntStr: %s dynDoubleStr: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynIntStr" } },
+                    { id: "5", identExpr: { name: "dynDoubleStr" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "15", max: "15" },
+      result: { value: { stringValue: "dynIntStr: 32 dynDoubleStr: 56.8" } },
+      runtimeCost: "15",
+    },
+    {
+      original: {
+        name: "dyntype support for integer formatting clause",
+        expr: '"dynamic int: %d".format([dynInt])',
+        typeEnv: [{ name: "dynInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynInt: { value: { int64Value: "128" } } },
+        value: { stringValue: "dynamic int: 128" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dynamic int: %d"^#*expr.Constant_StringValue#.format(\n  [\n    dynInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic int: %d".format([dynInt])',
+      locationAst:
+        '"dynamic int: %d"^#1[1,0]#.format(\n  [\n    dynInt^#4[1,26]#\n  ]^#3[1,25]#\n)^#2[1,24]#',
+      positions: [
+        [1, 0, 17, 1, 0, 1, 17],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 25, 26, 1, 25, 1, 26],
+        [4, 26, 32, 1, 26, 1, 32],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dynamic int: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynInt" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for integer formatting clause",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+      },
+      checkedAst:
+        '"dynamic int: %d"~string.format(\n  [\n    dynInt~dyn^dynInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dynamic int: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynInt" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic int: 128" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for integer formatting clause (unsigned)",
+        expr: '"dynamic unsigned int: %d".format([dynUnsignedInt])',
+        typeEnv: [{ name: "dynUnsignedInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynUnsignedInt: { value: { uint64Value: "256" } } },
+        value: { stringValue: "dynamic unsigned int: 256" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dynamic unsigned int: %d"^#*expr.Constant_StringValue#.format(\n  [\n    dynUnsignedInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic unsigned int: %d".format([dynUnsignedInt])',
+      locationAst:
+        '"dynamic unsigned int: %d"^#1[1,0]#.format(\n  [\n    dynUnsignedInt^#4[1,35]#\n  ]^#3[1,34]#\n)^#2[1,33]#',
+      positions: [
+        [1, 0, 26, 1, 0, 1, 26],
+        [2, 33, 34, 1, 33, 1, 34],
+        [3, 34, 35, 1, 34, 1, 35],
+        [4, 35, 49, 1, 35, 1, 49],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic unsigned int: %d" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynUnsignedInt" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for integer formatting clause (unsigned)",
+          lineOffsets: [52],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+      },
+      checkedAst:
+        '"dynamic unsigned int: %d"~string.format(\n  [\n    dynUnsignedInt~dyn^dynUnsignedInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynUnsignedInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: { "1": 0, "2": 33, "3": 34, "4": 35 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic unsigned int: %d" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynUnsignedInt" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "dynamic unsigned int: 256" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "dyntype support for hex formatting clause",
+        expr: '"dynamic hex int: %x".format([dynHexInt])',
+        typeEnv: [{ name: "dynHexInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynHexInt: { value: { int64Value: "22" } } },
+        value: { stringValue: "dynamic hex int: 16" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dynamic hex int: %x"^#*expr.Constant_StringValue#.format(\n  [\n    dynHexInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic hex int: %x".format([dynHexInt])',
+      locationAst:
+        '"dynamic hex int: %x"^#1[1,0]#.format(\n  [\n    dynHexInt^#4[1,30]#\n  ]^#3[1,29]#\n)^#2[1,28]#',
+      positions: [
+        [1, 0, 21, 1, 0, 1, 21],
+        [2, 28, 29, 1, 28, 1, 29],
+        [3, 29, 30, 1, 29, 1, 30],
+        [4, 30, 39, 1, 30, 1, 39],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %x" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynHexInt" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for hex formatting clause",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 28, "3": 29, "4": 30 },
+        },
+      },
+      checkedAst:
+        '"dynamic hex int: %x"~string.format(\n  [\n    dynHexInt~dyn^dynHexInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynHexInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 28, "3": 29, "4": 30 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %x" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynHexInt" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic hex int: 16" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for hex formatting clause (uppercase)",
+        expr: '"dynamic hex int: %X (uppercase)".format([dynHexInt])',
+        typeEnv: [{ name: "dynHexInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynHexInt: { value: { int64Value: "26" } } },
+        value: { stringValue: "dynamic hex int: 1A (uppercase)" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dynamic hex int: %X (uppercase)"^#*expr.Constant_StringValue#.format(\n  [\n    dynHexInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic hex int: %X (uppercase)".format([dynHexInt])',
+      locationAst:
+        '"dynamic hex int: %X (uppercase)"^#1[1,0]#.format(\n  [\n    dynHexInt^#4[1,42]#\n  ]^#3[1,41]#\n)^#2[1,40]#',
+      positions: [
+        [1, 0, 33, 1, 0, 1, 33],
+        [2, 40, 41, 1, 40, 1, 41],
+        [3, 41, 42, 1, 41, 1, 42],
+        [4, 42, 51, 1, 42, 1, 51],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %X (uppercase)" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynHexInt" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for hex formatting clause (uppercase)",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 40, "3": 41, "4": 42 },
+        },
+      },
+      checkedAst:
+        '"dynamic hex int: %X (uppercase)"~string.format(\n  [\n    dynHexInt~dyn^dynHexInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynHexInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 40, "3": 41, "4": 42 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %X (uppercase)" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynHexInt" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "15", max: "15" },
+      result: { value: { stringValue: "dynamic hex int: 1A (uppercase)" } },
+      runtimeCost: "15",
+    },
+    {
+      original: {
+        name: "dyntype support for unsigned hex formatting clause",
+        expr: '"dynamic hex int: %x (unsigned)".format([dynUnsignedHexInt])',
+        typeEnv: [{ name: "dynUnsignedHexInt", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynUnsignedHexInt: { value: { uint64Value: "500" } } },
+        value: { stringValue: "dynamic hex int: 1f4 (unsigned)" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dynamic hex int: %x (unsigned)"^#*expr.Constant_StringValue#.format(\n  [\n    dynUnsignedHexInt^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic hex int: %x (unsigned)".format([dynUnsignedHexInt])',
+      locationAst:
+        '"dynamic hex int: %x (unsigned)"^#1[1,0]#.format(\n  [\n    dynUnsignedHexInt^#4[1,41]#\n  ]^#3[1,40]#\n)^#2[1,39]#',
+      positions: [
+        [1, 0, 32, 1, 0, 1, 32],
+        [2, 39, 40, 1, 39, 1, 40],
+        [3, 40, 41, 1, 40, 1, 41],
+        [4, 41, 58, 1, 41, 1, 58],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %x (unsigned)" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynUnsignedHexInt" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for unsigned hex formatting clause",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 39, "3": 40, "4": 41 },
+        },
+      },
+      checkedAst:
+        '"dynamic hex int: %x (unsigned)"~string.format(\n  [\n    dynUnsignedHexInt~dyn^dynUnsignedHexInt\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynUnsignedHexInt" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 39, "3": 40, "4": 41 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic hex int: %x (unsigned)" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynUnsignedHexInt" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: { value: { stringValue: "dynamic hex int: 1f4 (unsigned)" } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "dyntype support for fixed-point formatting clause",
+        expr: '"dynamic double: %.3f".format([dynDouble])',
+        typeEnv: [{ name: "dynDouble", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynDouble: { value: { doubleValue: 4.5 } } },
+        value: { stringValue: "dynamic double: 4.500" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dynamic double: %.3f"^#*expr.Constant_StringValue#.format(\n  [\n    dynDouble^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dynamic double: %.3f".format([dynDouble])',
+      locationAst:
+        '"dynamic double: %.3f"^#1[1,0]#.format(\n  [\n    dynDouble^#4[1,31]#\n  ]^#3[1,30]#\n)^#2[1,29]#',
+      positions: [
+        [1, 0, 22, 1, 0, 1, 22],
+        [2, 29, 30, 1, 29, 1, 30],
+        [3, 30, 31, 1, 30, 1, 31],
+        [4, 31, 40, 1, 31, 1, 40],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic double: %.3f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDouble" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for fixed-point formatting clause",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 29, "3": 30, "4": 31 },
+        },
+      },
+      checkedAst:
+        '"dynamic double: %.3f"~string.format(\n  [\n    dynDouble~dyn^dynDouble\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynDouble" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 29, "3": 30, "4": 31 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dynamic double: %.3f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDouble" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dynamic double: 4.500" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for scientific notation",
+        expr: '"(dyntype) e: %e".format([dynE])',
+        typeEnv: [{ name: "dynE", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: { dynE: { value: { doubleValue: 2.71828 } } },
+        value: { stringValue: "(dyntype) e: 2.718280e+00" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"(dyntype) e: %e"^#*expr.Constant_StringValue#.format(\n  [\n    dynE^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"(dyntype) e: %e".format([dynE])',
+      locationAst:
+        '"(dyntype) e: %e"^#1[1,0]#.format(\n  [\n    dynE^#4[1,26]#\n  ]^#3[1,25]#\n)^#2[1,24]#',
+      positions: [
+        [1, 0, 17, 1, 0, 1, 17],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 25, 26, 1, 25, 1, 26],
+        [4, 26, 30, 1, 26, 1, 30],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "(dyntype) e: %e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynE" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for scientific notation",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+      },
+      checkedAst:
+        '"(dyntype) e: %e"~string.format(\n  [\n    dynE~dyn^dynE\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynE" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "(dyntype) e: %e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynE" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "(dyntype) e: 2.718280e+00" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype NaN/infinity support for fixed-point",
+        expr: '"NaN: %f, infinity: %f".format([dynNaN, dynInf])',
+        typeEnv: [
+          { name: "dynInf", ident: { type: { dyn: {} } } },
+          { name: "dynNaN", ident: { type: { dyn: {} } } },
+        ],
+        container: "ext",
+        bindings: {
+          dynInf: { value: { doubleValue: "Infinity" } },
+          dynNaN: { value: { doubleValue: "NaN" } },
+        },
+        value: { stringValue: "NaN: NaN, infinity: Infinity" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"NaN: %f, infinity: %f"^#*expr.Constant_StringValue#.format(\n  [\n    dynNaN^#*expr.Expr_IdentExpr#,\n    dynInf^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"NaN: %f, infinity: %f".format([dynNaN, dynInf])',
+      locationAst:
+        '"NaN: %f, infinity: %f"^#1[1,0]#.format(\n  [\n    dynNaN^#4[1,32]#,\n    dynInf^#5[1,40]#\n  ]^#3[1,31]#\n)^#2[1,30]#',
+      positions: [
+        [1, 0, 23, 1, 0, 1, 23],
+        [2, 30, 31, 1, 30, 1, 31],
+        [3, 31, 32, 1, 31, 1, 32],
+        [4, 32, 38, 1, 32, 1, 38],
+        [5, 40, 46, 1, 40, 1, 46],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "NaN: %f, infinity: %f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynNaN" } },
+                    { id: "5", identExpr: { name: "dynInf" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype NaN/infinity support for fixed-point",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32, "5": 40 },
+        },
+      },
+      checkedAst:
+        '"NaN: %f, infinity: %f"~string.format(\n  [\n    dynNaN~dyn^dynNaN,\n    dynInf~dyn^dynInf\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynNaN" },
+          "5": { name: "dynInf" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32, "5": 40 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "NaN: %f, infinity: %f" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", identExpr: { name: "dynNaN" } },
+                    { id: "5", identExpr: { name: "dynInf" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "15", max: "15" },
+      result: { value: { stringValue: "NaN: NaN, infinity: Infinity" } },
+      runtimeCost: "15",
+    },
+    {
+      original: {
+        name: "dyntype support for timestamp",
+        expr: '"dyntype timestamp: %s".format([dynTime])',
+        typeEnv: [{ name: "dynTime", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: {
+          dynTime: {
+            value: {
+              objectValue: {
+                "@type": "type.googleapis.com/google.protobuf.Timestamp",
+                value: "2009-11-10T23:00:00Z",
+              },
+            },
+          },
+        },
+        value: { stringValue: "dyntype timestamp: 2009-11-10T23:00:00Z" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dyntype timestamp: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynTime^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dyntype timestamp: %s".format([dynTime])',
+      locationAst:
+        '"dyntype timestamp: %s"^#1[1,0]#.format(\n  [\n    dynTime^#4[1,32]#\n  ]^#3[1,31]#\n)^#2[1,30]#',
+      positions: [
+        [1, 0, 23, 1, 0, 1, 23],
+        [2, 30, 31, 1, 30, 1, 31],
+        [3, 31, 32, 1, 31, 1, 32],
+        [4, 32, 39, 1, 32, 1, 39],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dyntype timestamp: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynTime" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for timestamp",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32 },
+        },
+      },
+      checkedAst:
+        '"dyntype timestamp: %s"~string.format(\n  [\n    dynTime~dyn^dynTime\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynTime" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 30, "3": 31, "4": 32 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dyntype timestamp: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynTime" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "14", max: "14" },
+      result: {
+        value: { stringValue: "dyntype timestamp: 2009-11-10T23:00:00Z" },
+      },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "dyntype support for duration",
+        expr: '"dyntype duration: %s".format([dynDuration])',
+        typeEnv: [{ name: "dynDuration", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: {
+          dynDuration: {
+            value: {
+              objectValue: {
+                "@type": "type.googleapis.com/google.protobuf.Duration",
+                value: "8747s",
+              },
+            },
+          },
+        },
+        value: { stringValue: "dyntype duration: 8747s" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dyntype duration: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynDuration^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dyntype duration: %s".format([dynDuration])',
+      locationAst:
+        '"dyntype duration: %s"^#1[1,0]#.format(\n  [\n    dynDuration^#4[1,31]#\n  ]^#3[1,30]#\n)^#2[1,29]#',
+      positions: [
+        [1, 0, 22, 1, 0, 1, 22],
+        [2, 29, 30, 1, 29, 1, 30],
+        [3, 30, 31, 1, 30, 1, 31],
+        [4, 31, 42, 1, 31, 1, 42],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dyntype duration: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDuration" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for duration",
+          lineOffsets: [45],
+          positions: { "1": 0, "2": 29, "3": 30, "4": 31 },
+        },
+      },
+      checkedAst:
+        '"dyntype duration: %s"~string.format(\n  [\n    dynDuration~dyn^dynDuration\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynDuration" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: { "1": 0, "2": 29, "3": 30, "4": 31 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: {
+              id: "1",
+              constExpr: { stringValue: "dyntype duration: %s" },
+            },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynDuration" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dyntype duration: 8747s" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for lists",
+        expr: '"dyntype list: %s".format([dynList])',
+        typeEnv: [{ name: "dynList", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: {
+          dynList: {
+            value: {
+              listValue: {
+                values: [
+                  { int64Value: "6" },
+                  { doubleValue: 4.2 },
+                  { stringValue: "a string" },
+                ],
+              },
+            },
+          },
+        },
+        value: { stringValue: "dyntype list: [6, 4.2, a string]" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dyntype list: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynList^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dyntype list: %s".format([dynList])',
+      locationAst:
+        '"dyntype list: %s"^#1[1,0]#.format(\n  [\n    dynList^#4[1,27]#\n  ]^#3[1,26]#\n)^#2[1,25]#',
+      positions: [
+        [1, 0, 18, 1, 0, 1, 18],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 27, 1, 26, 1, 27],
+        [4, 27, 34, 1, 27, 1, 34],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dyntype list: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynList" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for lists",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 27 },
+        },
+      },
+      checkedAst:
+        '"dyntype list: %s"~string.format(\n  [\n    dynList~dyn^dynList\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynList" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 27 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dyntype list: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynList" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: { value: { stringValue: "dyntype list: [6, 4.2, a string]" } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "dyntype support for maps",
+        expr: '"dyntype map: %s".format([dynMap])',
+        typeEnv: [{ name: "dynMap", ident: { type: { dyn: {} } } }],
+        container: "ext",
+        bindings: {
+          dynMap: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "strKey" },
+                    value: { stringValue: "x" },
+                  },
+                  { key: { boolValue: true }, value: { int64Value: "42" } },
+                  {
+                    key: { int64Value: "6" },
+                    value: {
+                      objectValue: {
+                        "@type": "type.googleapis.com/google.protobuf.Duration",
+                        value: "422s",
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { stringValue: "dyntype map: {6: 422s, strKey: x, true: 42}" },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"dyntype map: %s"^#*expr.Constant_StringValue#.format(\n  [\n    dynMap^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"dyntype map: %s".format([dynMap])',
+      locationAst:
+        '"dyntype map: %s"^#1[1,0]#.format(\n  [\n    dynMap^#4[1,26]#\n  ]^#3[1,25]#\n)^#2[1,24]#',
+      positions: [
+        [1, 0, 17, 1, 0, 1, 17],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 25, 26, 1, 25, 1, 26],
+        [4, 26, 32, 1, 26, 1, 32],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dyntype map: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynMap" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "dyntype support for maps",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+      },
+      checkedAst:
+        '"dyntype map: %s"~string.format(\n  [\n    dynMap~dyn^dynMap\n  ]~list(dyn)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "4": { name: "dynMap" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 24, "3": 25, "4": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "dyntype map: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", identExpr: { name: "dynMap" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "13", max: "13" },
+      result: {
+        value: { stringValue: "dyntype map: {6: 422s, strKey: x, true: 42}" },
+      },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "unrecognized formatting clause",
+        expr: '"%a".format([1])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                'could not parse formatting clause: unrecognized formatting clause "a"',
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%a"^#*expr.Constant_StringValue#.format(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%a".format([1])',
+      locationAst:
+        '"%a"^#1[1,0]#.format(\n  [\n    1^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%a" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "unrecognized formatting clause",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:12: could not parse formatting clause: unrecognized formatting clause "a"\n | "%a".format([1])\n | ...........^',
+      errors: [
+        {
+          category: "other",
+          message:
+            'could not parse formatting clause: unrecognized formatting clause "a"',
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "out of bounds arg index",
+        expr: '"%d %d %d".format([0, 1])',
+        container: "ext",
+        evalError: { errors: [{ message: "index 2 out of range" }] },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%d %d %d"^#*expr.Constant_StringValue#.format(\n  [\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%d %d %d".format([0, 1])',
+      locationAst:
+        '"%d %d %d"^#1[1,0]#.format(\n  [\n    0^#4[1,19]#,\n    1^#5[1,22]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%d %d %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "0" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "out of bounds arg index",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19, "5": 22 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:18: index 2 out of range\n | "%d %d %d".format([0, 1])\n | .................^',
+      errors: [
+        {
+          category: "other",
+          message: "index 2 out of range",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "string substitution is not allowed with binary clause",
+        expr: '"string is %b".format(["abc"])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only ints, uints, and bools can be formatted as binary, was given string",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"string is %b"^#*expr.Constant_StringValue#.format(\n  [\n    "abc"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"string is %b".format(["abc"])',
+      locationAst:
+        '"string is %b"^#1[1,0]#.format(\n  [\n    "abc"^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 28, 1, 23, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "string is %b" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "abc" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "string substitution is not allowed with binary clause",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: only ints, uints, and bools can be formatted as binary, was given string\n | "string is %b".format(["abc"])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only ints, uints, and bools can be formatted as binary, was given string",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "duration substitution not allowed with decimal clause",
+        expr: '"%d".format([duration("30m2s")])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: decimal clause can only be used on ints, uints, and doubles, was given google.protobuf.Duration",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%d"^#*expr.Constant_StringValue#.format(\n  [\n    duration(\n      "30m2s"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%d".format([duration("30m2s")])',
+      locationAst:
+        '"%d"^#1[1,0]#.format(\n  [\n    duration(\n      "30m2s"^#5[1,22]#\n    )^#4[1,21]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 29, 1, 22, 1, 29],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [
+                          { id: "5", constExpr: { stringValue: "30m2s" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "duration substitution not allowed with decimal clause",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 21, "5": 22 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:22: error during formatting: decimal clause can only be used on ints, uints, and doubles, was given google.protobuf.Duration\n | "%d".format([duration("30m2s")])\n | .....................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: decimal clause can only be used on ints, uints, and doubles, was given google.protobuf.Duration",
+          id: 4,
+          offset: 21,
+          line: 1,
+          column: 21,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "string substitution not allowed with octal clause",
+        expr: '"octal: %o".format(["a string"])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: octal clause can only be used on ints and uints, was given string",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"octal: %o"^#*expr.Constant_StringValue#.format(\n  [\n    "a string"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"octal: %o".format(["a string"])',
+      locationAst:
+        '"octal: %o"^#1[1,0]#.format(\n  [\n    "a string"^#4[1,20]#\n  ]^#3[1,19]#\n)^#2[1,18]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 20, 1, 19, 1, 20],
+        [4, 20, 30, 1, 20, 1, 30],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "octal: %o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "a string" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "string substitution not allowed with octal clause",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 20 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:21: error during formatting: octal clause can only be used on ints and uints, was given string\n | "octal: %o".format(["a string"])\n | ....................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: octal clause can only be used on ints and uints, was given string",
+          id: 4,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "double substitution not allowed with hex clause",
+        expr: '"double is %x".format([0.5])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"double is %x"^#*expr.Constant_StringValue#.format(\n  [\n    0.5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %x".format([0.5])',
+      locationAst:
+        '"double is %x"^#1[1,0]#.format(\n  [\n    0.5^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 26, 1, 23, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 0.5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "double substitution not allowed with hex clause",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double\n | "double is %x".format([0.5])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "uppercase not allowed for scientific clause",
+        expr: '"double is %E".format([0.5])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                'could not parse formatting clause: unrecognized formatting clause "E"',
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"double is %E"^#*expr.Constant_StringValue#.format(\n  [\n    0.5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %E".format([0.5])',
+      locationAst:
+        '"double is %E"^#1[1,0]#.format(\n  [\n    0.5^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 26, 1, 23, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %E" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 0.5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "uppercase not allowed for scientific clause",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:22: could not parse formatting clause: unrecognized formatting clause "E"\n | "double is %E".format([0.5])\n | .....................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            'could not parse formatting clause: unrecognized formatting clause "E"',
+          id: 2,
+          offset: 21,
+          line: 1,
+          column: 21,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %d",
+        expr: '"null: %d".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: decimal clause can only be used on ints, uints, and doubles, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"null: %d"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %d".format([null])',
+      locationAst:
+        '"null: %d"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %d",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: decimal clause can only be used on ints, uints, and doubles, was given null_type\n | "null: %d".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: decimal clause can only be used on ints, uints, and doubles, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %e",
+        expr: '"null: %e".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: scientific clause can only be used on ints, uints, and doubles, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"null: %e"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %e".format([null])',
+      locationAst:
+        '"null: %e"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: scientific clause can only be used on ints, uints, and doubles, was given null_type\n | "null: %e".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: scientific clause can only be used on ints, uints, and doubles, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %f",
+        expr: '"null: %f".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: fixed-point clause can only be used on ints, uints, and doubles, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"null: %f"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %f".format([null])',
+      locationAst:
+        '"null: %f"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %f",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: fixed-point clause can only be used on ints, uints, and doubles, was given null_type\n | "null: %f".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: fixed-point clause can only be used on ints, uints, and doubles, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %x",
+        expr: '"null: %x".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"null: %x"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %x".format([null])',
+      locationAst:
+        '"null: %x"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %x" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %x",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given null_type\n | "null: %x".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %X",
+        expr: '"null: %X".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"null: %X"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %X".format([null])',
+      locationAst:
+        '"null: %X"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %X" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %X",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given null_type\n | "null: %X".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %b",
+        expr: '"null: %b".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only ints, uints, and bools can be formatted as binary, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"null: %b"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %b".format([null])',
+      locationAst:
+        '"null: %b"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %b" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %b",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: only ints, uints, and bools can be formatted as binary, was given null_type\n | "null: %b".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only ints, uints, and bools can be formatted as binary, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "null not allowed for %o",
+        expr: '"null: %o".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: octal clause can only be used on ints and uints, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"null: %o"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"null: %o".format([null])',
+      locationAst:
+        '"null: %o"^#1[1,0]#.format(\n  [\n    null^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "null: %o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "null not allowed for %o",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 19 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:20: error during formatting: octal clause can only be used on ints and uints, was given null_type\n | "null: %o".format([null])\n | ...................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: octal clause can only be used on ints and uints, was given null_type",
+          id: 4,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time cardinality check (too few for string)",
+        expr: '"%s %s".format(["abc"])',
+        container: "ext",
+        evalError: { errors: [{ message: "index 1 out of range" }] },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s %s"^#*expr.Constant_StringValue#.format(\n  [\n    "abc"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s %s".format(["abc"])',
+      locationAst:
+        '"%s %s"^#1[1,0]#.format(\n  [\n    "abc"^#4[1,16]#\n  ]^#3[1,15]#\n)^#2[1,14]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 21, 1, 16, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { stringValue: "abc" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time cardinality check (too few for string)",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 14, "3": 15, "4": 16 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:15: index 1 out of range\n | "%s %s".format(["abc"])\n | ..............^',
+      errors: [
+        {
+          category: "other",
+          message: "index 1 out of range",
+          id: 2,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time cardinality check (too many for string)",
+        expr: '"%s %s".format(["abc", "def", "ghi"])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "too many arguments supplied to string.format (expected 2, got 3)",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%s %s"^#*expr.Constant_StringValue#.format(\n  [\n    "abc"^#*expr.Constant_StringValue#,\n    "def"^#*expr.Constant_StringValue#,\n    "ghi"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%s %s".format(["abc", "def", "ghi"])',
+      locationAst:
+        '"%s %s"^#1[1,0]#.format(\n  [\n    "abc"^#4[1,16]#,\n    "def"^#5[1,23]#,\n    "ghi"^#6[1,30]#\n  ]^#3[1,15]#\n)^#2[1,14]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 21, 1, 16, 1, 21],
+        [5, 23, 28, 1, 23, 1, 28],
+        [6, 30, 35, 1, 30, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%s %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "abc" } },
+                    { id: "5", constExpr: { stringValue: "def" } },
+                    { id: "6", constExpr: { stringValue: "ghi" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time cardinality check (too many for string)",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 14, "3": 15, "4": 16, "5": 23, "6": 30 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:15: too many arguments supplied to string.format (expected 2, got 3)\n | "%s %s".format(["abc", "def", "ghi"])\n | ..............^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "too many arguments supplied to string.format (expected 2, got 3)",
+          id: 2,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time syntax check (unexpected end of string)",
+        expr: '"filler %".format([])',
+        container: "ext",
+        evalError: { errors: [{ message: "unexpected end of string" }] },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"filler %"^#*expr.Constant_StringValue#.format(\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"filler %".format([])',
+      locationAst: '"filler %"^#1[1,0]#.format(\n  []^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "filler %" } },
+            function: "format",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time syntax check (unexpected end of string)",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 17, "3": 18 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:18: unexpected end of string\n | "filler %".format([])\n | .................^',
+      errors: [
+        {
+          category: "other",
+          message: "unexpected end of string",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time syntax check (unrecognized formatting clause)",
+        expr: '"%j".format([123])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                'could not parse formatting clause: unrecognized formatting clause "j"',
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%j"^#*expr.Constant_StringValue#.format(\n  [\n    123^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%j".format([123])',
+      locationAst:
+        '"%j"^#1[1,0]#.format(\n  [\n    123^#4[1,13]#\n  ]^#3[1,12]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 16, 1, 13, 1, 16],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%j" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "123" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location:
+            "compile-time syntax check (unrecognized formatting clause)",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 13 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:12: could not parse formatting clause: unrecognized formatting clause "j"\n | "%j".format([123])\n | ...........^',
+      errors: [
+        {
+          category: "other",
+          message:
+            'could not parse formatting clause: unrecognized formatting clause "j"',
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %d check",
+        expr: '"int is %d".format([null])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: decimal clause can only be used on ints, uints, and doubles, was given null_type",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"int is %d"^#*expr.Constant_StringValue#.format(\n  [\n    null^#*expr.Constant_NullValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"int is %d".format([null])',
+      locationAst:
+        '"int is %d"^#1[1,0]#.format(\n  [\n    null^#4[1,20]#\n  ]^#3[1,19]#\n)^#2[1,18]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 20, 1, 19, 1, 20],
+        [4, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "int is %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { nullValue: null } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %d check",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 20 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:21: error during formatting: decimal clause can only be used on ints, uints, and doubles, was given null_type\n | "int is %d".format([null])\n | ....................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: decimal clause can only be used on ints, uints, and doubles, was given null_type",
+          id: 4,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %f check",
+        expr: '"double is %f".format([true])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: fixed-point clause can only be used on ints, uints, and doubles, was given bool",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"double is %f"^#*expr.Constant_StringValue#.format(\n  [\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %f".format([true])',
+      locationAst:
+        '"double is %f"^#1[1,0]#.format(\n  [\n    true^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %f" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { boolValue: true } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %f check",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: fixed-point clause can only be used on ints, uints, and doubles, was given bool\n | "double is %f".format([true])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: fixed-point clause can only be used on ints, uints, and doubles, was given bool",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time precision syntax check",
+        expr: '"double is %.34".format([5.0])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "could not parse formatting clause: error while parsing precision: could not find end of precision specifier",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"double is %.34"^#*expr.Constant_StringValue#.format(\n  [\n    5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %.34".format([5.0])',
+      locationAst:
+        '"double is %.34"^#1[1,0]#.format(\n  [\n    5^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %.34" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time precision syntax check",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: could not parse formatting clause: error while parsing precision: could not find end of precision specifier\n | "double is %.34".format([5.0])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "could not parse formatting clause: error while parsing precision: could not find end of precision specifier",
+          id: 2,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %e check",
+        expr: '"double is %e".format([true])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: scientific clause can only be used on ints, uints, and doubles, was given bool",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"double is %e"^#*expr.Constant_StringValue#.format(\n  [\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"double is %e".format([true])',
+      locationAst:
+        '"double is %e"^#1[1,0]#.format(\n  [\n    true^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "double is %e" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { boolValue: true } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %e check",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: scientific clause can only be used on ints, uints, and doubles, was given bool\n | "double is %e".format([true])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: scientific clause can only be used on ints, uints, and doubles, was given bool",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %b check",
+        expr: '"string is %b".format(["a string"])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only ints, uints, and bools can be formatted as binary, was given string",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"string is %b"^#*expr.Constant_StringValue#.format(\n  [\n    "a string"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"string is %b".format(["a string"])',
+      locationAst:
+        '"string is %b"^#1[1,0]#.format(\n  [\n    "a string"^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 33, 1, 23, 1, 33],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "string is %b" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "a string" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %b check",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: only ints, uints, and bools can be formatted as binary, was given string\n | "string is %b".format(["a string"])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only ints, uints, and bools can be formatted as binary, was given string",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %x check",
+        expr: '"%x is a double".format([2.5])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%x is a double"^#*expr.Constant_StringValue#.format(\n  [\n    2.5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%x is a double".format([2.5])',
+      locationAst:
+        '"%x is a double"^#1[1,0]#.format(\n  [\n    2.5^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%x is a double" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %x check",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:26: error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double\n | "%x is a double".format([2.5])\n | .........................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double",
+          id: 4,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %X check",
+        expr: '"%X is a double".format([2.5])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"%X is a double"^#*expr.Constant_StringValue#.format(\n  [\n    2.5^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"%X is a double".format([2.5])',
+      locationAst:
+        '"%X is a double"^#1[1,0]#.format(\n  [\n    2.5^#4[1,25]#\n  ]^#3[1,24]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 23, 24, 1, 23, 1, 24],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "%X is a double" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 2.5 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %X check",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 23, "3": 24, "4": 25 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:26: error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double\n | "%X is a double".format([2.5])\n | .........................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: only ints, uints, bytes, and strings can be formatted as hex, was given double",
+          id: 4,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+      ],
+    },
+    {
+      original: {
+        name: "compile-time %o check",
+        expr: '"an octal: %o".format([3.14])',
+        container: "ext",
+        evalError: {
+          errors: [
+            {
+              message:
+                "octal clause can only be used on ints and uints, was given double",
+            },
+          ],
+        },
+      },
+      section: "TestStringFormatV2",
+      library: "strings",
+      ast: '"an octal: %o"^#*expr.Constant_StringValue#.format(\n  [\n    3.14^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"an octal: %o".format([3.14])',
+      locationAst:
+        '"an octal: %o"^#1[1,0]#.format(\n  [\n    3.14^#4[1,23]#\n  ]^#3[1,22]#\n)^#2[1,21]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "an octal: %o" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { doubleValue: 3.14 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "compile-time %o check",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 23 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:24: error during formatting: octal clause can only be used on ints and uints, was given double\n | "an octal: %o".format([3.14])\n | .......................^',
+      errors: [
+        {
+          category: "other",
+          message:
+            "error during formatting: octal clause can only be used on ints and uints, was given double",
+          id: 4,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+    },
+    {
+      original: {
+        expr: '"list: %s".format([[[1, 2, [3.0, 4]]]])',
+        value: { stringValue: "list: [[1, 2, [3, 4]]]" },
+      },
+      section: "TestStringFormatHeterogeneousLiteralsV2",
+      library: "strings",
+      ast: '"list: %s"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#,\n        [\n          3^#*expr.Constant_DoubleValue#,\n          4^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"list: %s".format([[[1, 2, [3.0, 4]]]])',
+      locationAst:
+        '"list: %s"^#1[1,0]#.format(\n  [\n    [\n      [\n        1^#6[1,21]#,\n        2^#7[1,24]#,\n        [\n          3^#9[1,28]#,\n          4^#10[1,33]#\n        ]^#8[1,27]#\n      ]^#5[1,20]#\n    ]^#4[1,19]#\n  ]^#3[1,18]#\n)^#2[1,17]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 28, 31, 1, 28, 1, 31],
+        [10, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          {
+                            id: "5",
+                            listExpr: {
+                              elements: [
+                                { id: "6", constExpr: { int64Value: "1" } },
+                                { id: "7", constExpr: { int64Value: "2" } },
+                                {
+                                  id: "8",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "9",
+                                        constExpr: { doubleValue: 3 },
+                                      },
+                                      {
+                                        id: "10",
+                                        constExpr: { int64Value: "4" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 17,
+            "3": 18,
+            "4": 19,
+            "5": 20,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 33,
+          },
+        },
+      },
+      checkedAst:
+        '"list: %s"~string.format(\n  [\n    [\n      [\n        1~int,\n        2~int,\n        [\n          3~double,\n          4~int\n        ]~list(dyn)\n      ]~list(dyn)\n    ]~list(list(dyn))\n  ]~list(list(list(dyn)))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["string_format"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: {
+              elemType: {
+                listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+              },
+            },
+          },
+          "4": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 17,
+            "3": 18,
+            "4": 19,
+            "5": 20,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 33,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          {
+                            id: "5",
+                            listExpr: {
+                              elements: [
+                                { id: "6", constExpr: { int64Value: "1" } },
+                                { id: "7", constExpr: { int64Value: "2" } },
+                                {
+                                  id: "8",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "9",
+                                        constExpr: { doubleValue: 3 },
+                                      },
+                                      {
+                                        id: "10",
+                                        constExpr: { int64Value: "4" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "41", max: "41" },
+      result: { value: { stringValue: "list: [[1, 2, [3, 4]]]" } },
+      runtimeCost: "41",
+    },
+    {
+      original: {
+        expr: '"list size: %d".format([[[1, 2, [3.0, 4]]].size()])',
+        value: { stringValue: "list size: 1" },
+      },
+      section: "TestStringFormatHeterogeneousLiteralsV2",
+      library: "strings",
+      ast: '"list size: %d"^#*expr.Constant_StringValue#.format(\n  [\n    [\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#,\n        [\n          3^#*expr.Constant_DoubleValue#,\n          4^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#.size()^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"list size: %d".format([[[1, 2, [3.0, 4]]].size()])',
+      locationAst:
+        '"list size: %d"^#1[1,0]#.format(\n  [\n    [\n      [\n        1^#6[1,26]#,\n        2^#7[1,29]#,\n        [\n          3^#9[1,33]#,\n          4^#10[1,38]#\n        ]^#8[1,32]#\n      ]^#5[1,25]#\n    ]^#4[1,24]#.size()^#11[1,47]#\n  ]^#3[1,23]#\n)^#2[1,22]#',
+      positions: [
+        [1, 0, 15, 1, 0, 1, 15],
+        [2, 22, 23, 1, 22, 1, 23],
+        [3, 23, 24, 1, 23, 1, 24],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 25, 26, 1, 25, 1, 26],
+        [6, 26, 27, 1, 26, 1, 27],
+        [7, 29, 30, 1, 29, 1, 30],
+        [8, 32, 33, 1, 32, 1, 33],
+        [9, 33, 36, 1, 33, 1, 36],
+        [10, 38, 39, 1, 38, 1, 39],
+        [11, 47, 48, 1, 47, 1, 48],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list size: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        target: {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "1" } },
+                                    { id: "7", constExpr: { int64Value: "2" } },
+                                    {
+                                      id: "8",
+                                      listExpr: {
+                                        elements: [
+                                          {
+                                            id: "9",
+                                            constExpr: { doubleValue: 3 },
+                                          },
+                                          {
+                                            id: "10",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        function: "size",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 22,
+            "3": 23,
+            "4": 24,
+            "5": 25,
+            "6": 26,
+            "7": 29,
+            "8": 32,
+            "9": 33,
+            "10": 38,
+            "11": 47,
+          },
+        },
+      },
+      checkedAst:
+        '"list size: %d"~string.format(\n  [\n    [\n      [\n        1~int,\n        2~int,\n        [\n          3~double,\n          4~int\n        ]~list(dyn)\n      ]~list(dyn)\n    ]~list(list(dyn)).size()~int^list_size\n  ]~list(int)\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "11": { overloadId: ["list_size"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 22,
+            "3": 23,
+            "4": 24,
+            "5": 25,
+            "6": 26,
+            "7": 29,
+            "8": 32,
+            "9": 33,
+            "10": 38,
+            "11": 47,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list size: %d" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        target: {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "1" } },
+                                    { id: "7", constExpr: { int64Value: "2" } },
+                                    {
+                                      id: "8",
+                                      listExpr: {
+                                        elements: [
+                                          {
+                                            id: "9",
+                                            constExpr: { doubleValue: 3 },
+                                          },
+                                          {
+                                            id: "10",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        function: "size",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "43", max: "43" },
+      result: { value: { stringValue: "list size: 1" } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: '"list element: %s".format([[[1, 2, [3.0, 4]]][0]])',
+        value: { stringValue: "list element: [1, 2, [3, 4]]" },
+      },
+      section: "TestStringFormatHeterogeneousLiteralsV2",
+      library: "strings",
+      ast: '"list element: %s"^#*expr.Constant_StringValue#.format(\n  [\n    _[_](\n      [\n        [\n          1^#*expr.Constant_Int64Value#,\n          2^#*expr.Constant_Int64Value#,\n          [\n            3^#*expr.Constant_DoubleValue#,\n            4^#*expr.Constant_Int64Value#\n          ]^#*expr.Expr_ListExpr#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"list element: %s".format([[[1, 2, [3.0, 4]]][0]])',
+      locationAst:
+        '"list element: %s"^#1[1,0]#.format(\n  [\n    _[_](\n      [\n        [\n          1^#6[1,29]#,\n          2^#7[1,32]#,\n          [\n            3^#9[1,36]#,\n            4^#10[1,41]#\n          ]^#8[1,35]#\n        ]^#5[1,28]#\n      ]^#4[1,27]#,\n      0^#12[1,46]#\n    )^#11[1,45]#\n  ]^#3[1,26]#\n)^#2[1,25]#',
+      positions: [
+        [1, 0, 18, 1, 0, 1, 18],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 27, 1, 26, 1, 27],
+        [4, 27, 28, 1, 27, 1, 28],
+        [5, 28, 29, 1, 28, 1, 29],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 32, 33, 1, 32, 1, 33],
+        [8, 35, 36, 1, 35, 1, 36],
+        [9, 36, 39, 1, 36, 1, 39],
+        [10, 41, 42, 1, 41, 1, 42],
+        [11, 45, 46, 1, 45, 1, 46],
+        [12, 46, 47, 1, 46, 1, 47],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list element: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "4",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "5",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "6",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                      {
+                                        id: "7",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "8",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "9",
+                                              constExpr: { doubleValue: 3 },
+                                            },
+                                            {
+                                              id: "10",
+                                              constExpr: { int64Value: "4" },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "12", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 27,
+            "5": 28,
+            "6": 29,
+            "7": 32,
+            "8": 35,
+            "9": 36,
+            "10": 41,
+            "11": 45,
+            "12": 46,
+          },
+        },
+      },
+      checkedAst:
+        '"list element: %s"~string.format(\n  [\n    _[_](\n      [\n        [\n          1~int,\n          2~int,\n          [\n            3~double,\n            4~int\n          ]~list(dyn)\n        ]~list(dyn)\n      ]~list(list(dyn)),\n      0~int\n    )~list(dyn)^index_list\n  ]~list(list(dyn))\n)~string^string_format',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "11": { overloadId: ["index_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "4": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "INT64" },
+          "11": { listType: { elemType: { dyn: {} } } },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 27,
+            "5": 28,
+            "6": 29,
+            "7": 32,
+            "8": 35,
+            "9": 36,
+            "10": 41,
+            "11": 45,
+            "12": 46,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "list element: %s" } },
+            function: "format",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "4",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "5",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "6",
+                                        constExpr: { int64Value: "1" },
+                                      },
+                                      {
+                                        id: "7",
+                                        constExpr: { int64Value: "2" },
+                                      },
+                                      {
+                                        id: "8",
+                                        listExpr: {
+                                          elements: [
+                                            {
+                                              id: "9",
+                                              constExpr: { doubleValue: 3 },
+                                            },
+                                            {
+                                              id: "10",
+                                              constExpr: { int64Value: "4" },
+                                            },
+                                          ],
+                                        },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "12", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "43", max: "43" },
+      result: { value: { stringValue: "list element: [1, 2, [3, 4]]" } },
+      runtimeCost: "44",
+    },
+  ],
+} as const;
@@ -0,0 +1,7084 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/cel/inlining_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "inlining",
+  tests: [
+    {
+      original: {
+        expr: "a || b",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          {
+            name: "productsToConsumers",
+            function: {
+              overloads: [
+                {
+                  overloadId: "productsToConsumers_list",
+                  params: [{ listType: { elemType: { primitive: "INT64" } } }],
+                  resultType: {
+                    listType: { elemType: { primitive: "INT64" } },
+                  },
+                },
+              ],
+            },
+          },
+        ],
+      },
+      section: "TestInliningOptimizer",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "b", alias: "bravo", expr: "'hello'.contains('lo')" },
+      ],
+      ast: "_||_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a || b",
+      locationAst: "_||_(\n  a^#1[1,0]#,\n  b^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
+      checkedAst: "_||_(\n  a~bool^a,\n  b~bool^b\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "b" },
+          "3": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", identExpr: { name: "b" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "2" },
+      inlinedAst:
+        '_||_(\n  a~bool^a,\n  "hello"~string.contains(\n    "lo"~string\n  )~bool^contains_string\n)~bool^logical_or',
+      inlined: 'a || "hello".contains("lo")',
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedInlined: 'a || "hello".contains("lo")',
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "a + [a]",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          {
+            name: "productsToConsumers",
+            function: {
+              overloads: [
+                {
+                  overloadId: "productsToConsumers_list",
+                  params: [{ listType: { elemType: { primitive: "INT64" } } }],
+                  resultType: {
+                    listType: { elemType: { primitive: "INT64" } },
+                  },
+                },
+              ],
+            },
+          },
+        ],
+      },
+      section: "TestInliningOptimizer",
+      optionalSyntax: true,
+      inlineVariables: [{ name: "a", alias: "alpha", expr: "dyn([1, 2])" }],
+      ast: "_+_(\n  a^#*expr.Expr_IdentExpr#,\n  [\n    a^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a + [a]",
+      locationAst:
+        "_+_(\n  a^#1[1,0]#,\n  [\n    a^#4[1,5]#\n  ]^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "3",
+                listExpr: { elements: [{ id: "4", identExpr: { name: "a" } }] },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 5 },
+        },
+      },
+      checkedAst:
+        "_+_(\n  a~dyn^a,\n  [\n    a~dyn^a\n  ]~list(dyn)\n)~list(dyn)^add_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { overloadId: ["add_list"] },
+          "4": { name: "a" },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { listType: { elemType: { dyn: {} } } },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "3",
+                listExpr: { elements: [{ id: "4", identExpr: { name: "a" } }] },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "13", max: "13" },
+      inlinedAst:
+        "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  alpha,\n  // Init\n  dyn(\n    [\n      1~int,\n      2~int\n    ]~list(int)\n  )~dyn^to_dyn,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  alpha~dyn^alpha,\n  // Result\n  _+_(\n    alpha~dyn^alpha,\n    [\n      alpha~dyn^alpha\n    ]~list(dyn)\n  )~list(dyn)^add_list)~list(dyn)",
+      inlined: "cel.bind(alpha, dyn([1, 2]), alpha + [alpha])",
+      foldedAst:
+        "[\n  1~int,\n  2~int,\n  [\n    1~int,\n    2~int\n  ]~list(int)\n]~list(dyn)",
+      folded: "[1, 2, [1, 2]]",
+      expectedInlined: "cel.bind(alpha, dyn([1, 2]), alpha + [alpha])",
+      expectedFolded: "[1, 2, [1, 2]]",
+    },
+    {
+      original: {
+        expr: "a \u0026\u0026 (a || b)",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          {
+            name: "productsToConsumers",
+            function: {
+              overloads: [
+                {
+                  overloadId: "productsToConsumers_list",
+                  params: [{ listType: { elemType: { primitive: "INT64" } } }],
+                  resultType: {
+                    listType: { elemType: { primitive: "INT64" } },
+                  },
+                },
+              ],
+            },
+          },
+        ],
+      },
+      section: "TestInliningOptimizer",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "a", alias: "alpha", expr: "'hello'.contains('lo')" },
+      ],
+      ast: "_\u0026\u0026_(\n  a^#*expr.Expr_IdentExpr#,\n  _||_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 (a || b)",
+      locationAst:
+        "_\u0026\u0026_(\n  a^#1[1,0]#,\n  _||_(\n    a^#2[1,6]#,\n    b^#3[1,11]#\n  )^#4[1,8]#\n)^#5[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 8, 10, 1, 8, 1, 10],
+        [5, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "2", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 6, "3": 11, "4": 8, "5": 2 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  a~bool^a,\n  _||_(\n    a~bool^a,\n    b~bool^b\n  )~bool^logical_or\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "a" },
+          "3": { name: "b" },
+          "4": { overloadId: ["logical_or"] },
+          "5": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 6, "3": 11, "4": 8, "5": 2 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "2", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "3" },
+      inlinedAst:
+        '__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  alpha,\n  // Init\n  "hello"~string.contains(\n    "lo"~string\n  )~bool^contains_string,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  alpha~bool^alpha,\n  // Result\n  _\u0026\u0026_(\n    alpha~bool^alpha,\n    _||_(\n      alpha~bool^alpha,\n      b~bool^b\n    )~bool^logical_or\n  )~bool^logical_and)~bool',
+      inlined:
+        'cel.bind(alpha, "hello".contains("lo"), alpha \u0026\u0026 (alpha || b))',
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedInlined:
+        'cel.bind(alpha, "hello".contains("lo"), alpha \u0026\u0026 (alpha || b))',
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "a \u0026\u0026 b \u0026\u0026 a",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          {
+            name: "productsToConsumers",
+            function: {
+              overloads: [
+                {
+                  overloadId: "productsToConsumers_list",
+                  params: [{ listType: { elemType: { primitive: "INT64" } } }],
+                  resultType: {
+                    listType: { elemType: { primitive: "INT64" } },
+                  },
+                },
+              ],
+            },
+          },
+        ],
+      },
+      section: "TestInliningOptimizer",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "a", alias: "alpha", expr: "'hello'.contains('lo')" },
+      ],
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 b \u0026\u0026 a",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a^#1[1,0]#,\n    b^#2[1,5]#\n  )^#3[1,2]#,\n  a^#4[1,10]#\n)^#5[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              { id: "4", identExpr: { name: "a" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 5, "3": 2, "4": 10, "5": 7 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a~bool^a,\n    b~bool^b\n  )~bool^logical_and,\n  a~bool^a\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "b" },
+          "3": { overloadId: ["logical_and"] },
+          "4": { name: "a" },
+          "5": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 5, "3": 2, "4": 10, "5": 7 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              { id: "4", identExpr: { name: "a" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "3" },
+      inlinedAst:
+        '__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  alpha,\n  // Init\n  "hello"~string.contains(\n    "lo"~string\n  )~bool^contains_string,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  alpha~bool^alpha,\n  // Result\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      alpha~bool^alpha,\n      b~bool^b\n    )~bool^logical_and,\n    alpha~bool^alpha\n  )~bool^logical_and)~bool',
+      inlined:
+        'cel.bind(alpha, "hello".contains("lo"), alpha \u0026\u0026 b \u0026\u0026 alpha)',
+      foldedAst:
+        "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  alpha,\n  // Init\n  true~bool,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  alpha~bool^alpha,\n  // Result\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      alpha~bool^alpha,\n      b~bool^b\n    )~bool^logical_and,\n    alpha~bool^alpha\n  )~bool^logical_and)~bool",
+      folded: "cel.bind(alpha, true, alpha \u0026\u0026 b \u0026\u0026 alpha)",
+      expectedInlined:
+        'cel.bind(alpha, "hello".contains("lo"), alpha \u0026\u0026 b \u0026\u0026 alpha)',
+      expectedFolded:
+        "cel.bind(alpha, true, alpha \u0026\u0026 b \u0026\u0026 alpha)",
+    },
+    {
+      original: {
+        expr: "(c || d) || (a \u0026\u0026 (a || b))",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          { name: "c", ident: { type: { primitive: "BOOL" } } },
+          { name: "d", ident: { type: { primitive: "BOOL" } } },
+          {
+            name: "productsToConsumers",
+            function: {
+              overloads: [
+                {
+                  overloadId: "productsToConsumers_list",
+                  params: [{ listType: { elemType: { primitive: "INT64" } } }],
+                  resultType: {
+                    listType: { elemType: { primitive: "INT64" } },
+                  },
+                },
+              ],
+            },
+          },
+        ],
+      },
+      section: "TestInliningOptimizer",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "a", alias: "alpha", expr: "'hello'.contains('lo')" },
+        { name: "d", expr: "!false" },
+      ],
+      ast: "_||_(\n  _||_(\n    c^#*expr.Expr_IdentExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    a^#*expr.Expr_IdentExpr#,\n    _||_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "c || d || a \u0026\u0026 (a || b)",
+      locationAst:
+        "_||_(\n  _||_(\n    c^#1[1,1]#,\n    d^#2[1,6]#\n  )^#3[1,3]#,\n  _\u0026\u0026_(\n    a^#4[1,13]#,\n    _||_(\n      a^#5[1,19]#,\n      b^#6[1,24]#\n    )^#7[1,21]#\n  )^#8[1,15]#\n)^#9[1,9]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 3, 5, 1, 3, 1, 5],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 21, 23, 1, 21, 1, 23],
+        [8, 15, 17, 1, 15, 1, 17],
+        [9, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", identExpr: { name: "c" } },
+                    { id: "2", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "4", identExpr: { name: "a" } },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_||_",
+                        args: [
+                          { id: "5", identExpr: { name: "a" } },
+                          { id: "6", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 1,
+            "2": 6,
+            "3": 3,
+            "4": 13,
+            "5": 19,
+            "6": 24,
+            "7": 21,
+            "8": 15,
+            "9": 9,
+          },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _||_(\n    c~bool^c,\n    d~bool^d\n  )~bool^logical_or,\n  _\u0026\u0026_(\n    a~bool^a,\n    _||_(\n      a~bool^a,\n      b~bool^b\n    )~bool^logical_or\n  )~bool^logical_and\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "c" },
+          "2": { name: "d" },
+          "3": { overloadId: ["logical_or"] },
+          "4": { name: "a" },
+          "5": { name: "a" },
+          "6": { name: "b" },
+          "7": { overloadId: ["logical_or"] },
+          "8": { overloadId: ["logical_and"] },
+          "9": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 1,
+            "2": 6,
+            "3": 3,
+            "4": 13,
+            "5": 19,
+            "6": 24,
+            "7": 21,
+            "8": 15,
+            "9": 9,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", identExpr: { name: "c" } },
+                    { id: "2", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "4", identExpr: { name: "a" } },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_||_",
+                        args: [
+                          { id: "5", identExpr: { name: "a" } },
+                          { id: "6", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "5" },
+      inlinedAst:
+        '_||_(\n  _||_(\n    c~bool^c,\n    !_(\n      false~bool\n    )~bool^logical_not\n  )~bool^logical_or,\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    alpha,\n    // Init\n    "hello"~string.contains(\n      "lo"~string\n    )~bool^contains_string,\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    alpha~bool^alpha,\n    // Result\n    _\u0026\u0026_(\n      alpha~bool^alpha,\n      _||_(\n        alpha~bool^alpha,\n        b~bool^b\n      )~bool^logical_or\n    )~bool^logical_and)~bool\n)~bool^logical_or',
+      inlined:
+        'c || !false || cel.bind(alpha, "hello".contains("lo"), alpha \u0026\u0026 (alpha || b))',
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedInlined:
+        'c || !false || cel.bind(alpha, "hello".contains("lo"), alpha \u0026\u0026 (alpha || b))',
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "a \u0026\u0026 (a || b)",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          {
+            name: "productsToConsumers",
+            function: {
+              overloads: [
+                {
+                  overloadId: "productsToConsumers_list",
+                  params: [{ listType: { elemType: { primitive: "INT64" } } }],
+                  resultType: {
+                    listType: { elemType: { primitive: "INT64" } },
+                  },
+                },
+              ],
+            },
+          },
+        ],
+      },
+      section: "TestInliningOptimizer",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "b", alias: "bravo", expr: "'hello'.contains('lo')" },
+      ],
+      ast: "_\u0026\u0026_(\n  a^#*expr.Expr_IdentExpr#,\n  _||_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 (a || b)",
+      locationAst:
+        "_\u0026\u0026_(\n  a^#1[1,0]#,\n  _||_(\n    a^#2[1,6]#,\n    b^#3[1,11]#\n  )^#4[1,8]#\n)^#5[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 8, 10, 1, 8, 1, 10],
+        [5, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "2", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 6, "3": 11, "4": 8, "5": 2 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  a~bool^a,\n  _||_(\n    a~bool^a,\n    b~bool^b\n  )~bool^logical_or\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "a" },
+          "3": { name: "b" },
+          "4": { overloadId: ["logical_or"] },
+          "5": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 6, "3": 11, "4": 8, "5": 2 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "2", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "3" },
+      inlinedAst:
+        '_\u0026\u0026_(\n  a~bool^a,\n  _||_(\n    a~bool^a,\n    "hello"~string.contains(\n      "lo"~string\n    )~bool^contains_string\n  )~bool^logical_or\n)~bool^logical_and',
+      inlined: 'a \u0026\u0026 (a || "hello".contains("lo"))',
+      foldedAst: "a~bool^a",
+      folded: "a",
+      expectedInlined: 'a \u0026\u0026 (a || "hello".contains("lo"))',
+      expectedFolded: "a",
+    },
+    {
+      original: {
+        expr: "a \u0026\u0026 b",
+        checkOnly: true,
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          {
+            name: "productsToConsumers",
+            function: {
+              overloads: [
+                {
+                  overloadId: "productsToConsumers_list",
+                  params: [{ listType: { elemType: { primitive: "INT64" } } }],
+                  resultType: {
+                    listType: { elemType: { primitive: "INT64" } },
+                  },
+                },
+              ],
+            },
+          },
+        ],
+      },
+      section: "TestInliningOptimizer",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "a", alias: "alpha", expr: "!'hello'.contains('lo')" },
+      ],
+      ast: "_\u0026\u0026_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 b",
+      locationAst: "_\u0026\u0026_(\n  a^#1[1,0]#,\n  b^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  a~bool^a,\n  b~bool^b\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "b" },
+          "3": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", identExpr: { name: "b" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "2" },
+      inlinedAst:
+        '_\u0026\u0026_(\n  !_(\n    "hello"~string.contains(\n      "lo"~string\n    )~bool^contains_string\n  )~bool^logical_not,\n  b~bool^b\n)~bool^logical_and',
+      inlined: '!"hello".contains("lo") \u0026\u0026 b',
+      foldedAst: "false~bool",
+      folded: "false",
+      expectedInlined: '!"hello".contains("lo") \u0026\u0026 b',
+      expectedFolded: "false",
+    },
+    {
+      original: {
+        expr: "operation.system.consumers + operation.destination_consumers",
+        checkOnly: true,
+        typeEnv: [
+          { name: "operation.system", ident: { type: { dyn: {} } } },
+          {
+            name: "operation.destination_consumers",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "operation.destination_products",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "productsToConsumers",
+            function: {
+              overloads: [
+                {
+                  overloadId: "productsToConsumers_list",
+                  params: [{ listType: { elemType: { primitive: "INT64" } } }],
+                  resultType: {
+                    listType: { elemType: { primitive: "INT64" } },
+                  },
+                },
+              ],
+            },
+          },
+        ],
+      },
+      section: "TestInliningOptimizer",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "operation.destination_consumers",
+          expr: "productsToConsumers(operation.destination_products)",
+        },
+        {
+          name: "operation.destination_products",
+          expr: "operation.system.products",
+        },
+      ],
+      ast: "_+_(\n  operation^#*expr.Expr_IdentExpr#.system^#*expr.Expr_SelectExpr#.consumers^#*expr.Expr_SelectExpr#,\n  operation^#*expr.Expr_IdentExpr#.destination_consumers^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "operation.system.consumers + operation.destination_consumers",
+      locationAst:
+        "_+_(\n  operation^#1[1,0]#.system^#2[1,9]#.consumers^#3[1,16]#,\n  operation^#5[1,29]#.destination_consumers^#6[1,38]#\n)^#4[1,27]#",
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 27, 28, 1, 27, 1, 28],
+        [5, 29, 38, 1, 29, 1, 38],
+        [6, 38, 39, 1, 38, 1, 39],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "3",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    selectExpr: {
+                      operand: { id: "1", identExpr: { name: "operation" } },
+                      field: "system",
+                    },
+                  },
+                  field: "consumers",
+                },
+              },
+              {
+                id: "6",
+                selectExpr: {
+                  operand: { id: "5", identExpr: { name: "operation" } },
+                  field: "destination_consumers",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 9, "3": 16, "4": 27, "5": 29, "6": 38 },
+        },
+      },
+      checkedAst:
+        "_+_(\n  operation.system~dyn^operation.system.consumers~dyn,\n  operation.destination_consumers~list(int)^operation.destination_consumers\n)~list(int)^add_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "operation.system" },
+          "4": { overloadId: ["add_list"] },
+          "6": { name: "operation.destination_consumers" },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 9, "3": 16, "4": 27, "5": 29, "6": 38 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "3",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "operation.system" } },
+                  field: "consumers",
+                },
+              },
+              {
+                id: "6",
+                identExpr: { name: "operation.destination_consumers" },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "3", max: "3" },
+      inlinedAst:
+        "_+_(\n  operation.system~dyn^operation.system.consumers~dyn,\n  productsToConsumers(\n    operation.system~dyn^operation.system.products~dyn\n  )~list(int)^productsToConsumers_list\n)~list(int)^add_list",
+      inlined:
+        "operation.system.consumers + productsToConsumers(operation.system.products)",
+      foldedAst:
+        "_+_(\n  operation.system~dyn^operation.system.consumers~dyn,\n  productsToConsumers(\n    operation.system~dyn^operation.system.products~dyn\n  )~list(int)^productsToConsumers_list\n)~list(int)^add_list",
+      folded:
+        "operation.system.consumers + productsToConsumers(operation.system.products)",
+      expectedInlined:
+        "operation.system.consumers + productsToConsumers(operation.system.products)",
+      expectedFolded:
+        "operation.system.consumers + productsToConsumers(operation.system.products)",
+    },
+    {
+      original: {
+        expr: "has(a.b)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "a",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [{ name: "a.b", alias: "alpha", expr: "a.b_long" }],
+      ast: "a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(a.b)",
+      locationAst: "a^#2[1,4]#.b~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "a" } },
+            field: "b",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "2": 4, "3": 5, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst: "a~map(string, string)^a.b~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "a" } },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "2": 4, "3": 5, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "a" } },
+            field: "b",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      inlinedAst: "a~map(string, string)^a.b_long~test-only~~bool",
+      inlined: "has(a.b_long)",
+      foldedAst: "a~map(string, string)^a.b_long~test-only~~bool",
+      folded: "has(a.b_long)",
+      expectedInlined: "has(a.b_long)",
+      expectedFolded: "has(a.b_long)",
+    },
+    {
+      original: {
+        expr: "has(a.b) ? a.b : 'default'",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "a",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [{ name: "a.b", alias: "alpha", expr: "'hello'" }],
+      ast: '_?_:_(\n  a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n  a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#,\n  "default"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'has(a.b) ? a.b : "default"',
+      locationAst:
+        '_?_:_(\n  a^#2[1,4]#.b~test-only~^#4[1,3]#,\n  a^#6[1,11]#.b^#7[1,12]#,\n  "default"^#8[1,17]#\n)^#5[1,9]#',
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 17, 26, 1, 17, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "a" } },
+                  field: "b",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "a" } },
+                  field: "b",
+                },
+              },
+              { id: "8", constExpr: { stringValue: "default" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "5": 9,
+            "6": 11,
+            "7": 12,
+            "8": 17,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_?_:_(\n  a~map(string, string)^a.b~test-only~~bool,\n  a~map(string, string)^a.b~string,\n  "default"~string\n)~string^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "a" },
+          "5": { overloadId: ["conditional"] },
+          "6": { name: "a" },
+        },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+          "6": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "5": 9,
+            "6": 11,
+            "7": 12,
+            "8": 17,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "a" } },
+                  field: "b",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "a" } },
+                  field: "b",
+                },
+              },
+              { id: "8", constExpr: { stringValue: "default" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "2", max: "4" },
+      inlinedAst:
+        '__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  alpha,\n  // Init\n  "hello"~string,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  alpha~string^alpha,\n  // Result\n  _?_:_(\n    _!=_(\n      alpha~string^alpha.size()~int^string_size,\n      0~int\n    )~bool^not_equals,\n    alpha~string^alpha,\n    "default"~string\n  )~string^conditional)~string',
+      inlined:
+        'cel.bind(alpha, "hello", (alpha.size() != 0) ? alpha : "default")',
+      foldedAst: '"hello"~string',
+      folded: '"hello"',
+      expectedInlined:
+        'cel.bind(alpha, "hello", (alpha.size() != 0) ? alpha : "default")',
+      expectedFolded: '"hello"',
+    },
+    {
+      original: {
+        expr: "has(a.b) ? a.b : ['default']",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "a",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    listType: { elemType: { primitive: "STRING" } },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [{ name: "a.b", alias: "alpha", expr: "['hello']" }],
+      ast: '_?_:_(\n  a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n  a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#,\n  [\n    "default"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'has(a.b) ? a.b : ["default"]',
+      locationAst:
+        '_?_:_(\n  a^#2[1,4]#.b~test-only~^#4[1,3]#,\n  a^#6[1,11]#.b^#7[1,12]#,\n  [\n    "default"^#9[1,18]#\n  ]^#8[1,17]#\n)^#5[1,9]#',
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 17, 18, 1, 17, 1, 18],
+        [9, 18, 27, 1, 18, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "a" } },
+                  field: "b",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "a" } },
+                  field: "b",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { stringValue: "default" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "5": 9,
+            "6": 11,
+            "7": 12,
+            "8": 17,
+            "9": 18,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_?_:_(\n  a~map(string, list(string))^a.b~test-only~~bool,\n  a~map(string, list(string))^a.b~list(string),\n  [\n    "default"~string\n  ]~list(string)\n)~list(string)^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "a" },
+          "5": { overloadId: ["conditional"] },
+          "6": { name: "a" },
+        },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "STRING" } } },
+          "6": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+          "7": { listType: { elemType: { primitive: "STRING" } } },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "5": 9,
+            "6": 11,
+            "7": 12,
+            "8": 17,
+            "9": 18,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "a" } },
+                  field: "b",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "a" } },
+                  field: "b",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { stringValue: "default" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(string)",
+      cost: { min: "4", max: "12" },
+      inlinedAst:
+        '__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  alpha,\n  // Init\n  [\n    "hello"~string\n  ]~list(string),\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  alpha~list(string)^alpha,\n  // Result\n  _?_:_(\n    _!=_(\n      alpha~list(string)^alpha.size()~int^list_size,\n      0~int\n    )~bool^not_equals,\n    alpha~list(string)^alpha,\n    [\n      "default"~string\n    ]~list(string)\n  )~list(string)^conditional)~list(string)',
+      inlined:
+        'cel.bind(alpha, ["hello"], (alpha.size() != 0) ? alpha : ["default"])',
+      foldedAst: '[\n  "hello"~string\n]~list(string)',
+      folded: '["hello"]',
+      expectedInlined:
+        'cel.bind(alpha, ["hello"], (alpha.size() != 0) ? alpha : ["default"])',
+      expectedFolded: '["hello"]',
+    },
+    {
+      original: {
+        expr: "0 in msg.map_int64_nested_type",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          {
+            name: "nested_map",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "INT64" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.NestedTestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "msg.map_int64_nested_type", expr: "nested_map" },
+      ],
+      ast: "@in(\n  0^#*expr.Constant_Int64Value#,\n  msg^#*expr.Expr_IdentExpr#.map_int64_nested_type^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "0 in msg.map_int64_nested_type",
+      locationAst:
+        "@in(\n  0^#1[1,0]#,\n  msg^#3[1,5]#.map_int64_nested_type^#4[1,8]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 8, 1, 5, 1, 8],
+        [4, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "0" } },
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "3", identExpr: { name: "msg" } },
+                  field: "map_int64_nested_type",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 8 },
+        },
+      },
+      checkedAst:
+        "@in(\n  0~int,\n  msg~google.expr.proto3.test.TestAllTypes^msg.map_int64_nested_type~map(int, google.expr.proto3.test.NestedTestAllTypes)\n)~bool^in_map",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_map"] }, "3": { name: "msg" } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: {
+                messageType: "google.expr.proto3.test.NestedTestAllTypes",
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 8 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "0" } },
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "3", identExpr: { name: "msg" } },
+                  field: "map_int64_nested_type",
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      inlinedAst:
+        "@in(\n  0~int,\n  nested_map~map(int, google.expr.proto3.test.NestedTestAllTypes)^nested_map\n)~bool^in_map",
+      inlined: "0 in nested_map",
+      foldedAst:
+        "@in(\n  0~int,\n  nested_map~map(int, google.expr.proto3.test.NestedTestAllTypes)^nested_map\n)~bool^in_map",
+      folded: "0 in nested_map",
+      expectedInlined: "0 in nested_map",
+      expectedFolded: "0 in nested_map",
+    },
+    {
+      original: {
+        expr: "has(msg.single_any)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          { name: "unpacked_wrapper", ident: { type: { wrapper: "STRING" } } },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [{ name: "msg.single_any", expr: "unpacked_wrapper" }],
+      ast: "msg^#*expr.Expr_IdentExpr#.single_any~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(msg.single_any)",
+      locationAst: "msg^#2[1,4]#.single_any~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "msg" } },
+            field: "single_any",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "2": 4, "3": 7, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "msg" } },
+                field: "single_any",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "msg~google.expr.proto3.test.TestAllTypes^msg.single_any~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "msg" } },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "2": 4, "3": 7, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "msg" } },
+            field: "single_any",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      inlinedAst:
+        "_!=_(\n  unpacked_wrapper~wrapper(string)^unpacked_wrapper,\n  null~null\n)~bool^not_equals",
+      inlined: "unpacked_wrapper != null",
+      foldedAst:
+        "_!=_(\n  unpacked_wrapper~wrapper(string)^unpacked_wrapper,\n  null~null\n)~bool^not_equals",
+      folded: "unpacked_wrapper != null",
+      expectedInlined: "unpacked_wrapper != null",
+      expectedFolded: "unpacked_wrapper != null",
+    },
+    {
+      original: {
+        expr: "has(msg.single_any) ? msg.single_any : '10'",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          { name: "unpacked_wrapper", ident: { type: { wrapper: "STRING" } } },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "msg.single_any", alias: "wrapped", expr: "unpacked_wrapper" },
+      ],
+      ast: '_?_:_(\n  msg^#*expr.Expr_IdentExpr#.single_any~test-only~^#*expr.Expr_SelectExpr#,\n  msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#,\n  "10"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'has(msg.single_any) ? msg.single_any : "10"',
+      locationAst:
+        '_?_:_(\n  msg^#2[1,4]#.single_any~test-only~^#4[1,3]#,\n  msg^#6[1,22]#.single_any^#7[1,25]#,\n  "10"^#8[1,39]#\n)^#5[1,20]#',
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 22, 25, 1, 22, 1, 25],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 39, 43, 1, 39, 1, 43],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "msg" } },
+                  field: "single_any",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              { id: "8", constExpr: { stringValue: "10" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 3,
+            "5": 20,
+            "6": 22,
+            "7": 25,
+            "8": 39,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "msg" } },
+                field: "single_any",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_?_:_(\n  msg~google.expr.proto3.test.TestAllTypes^msg.single_any~test-only~~bool,\n  msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any,\n  "10"~string\n)~any^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "msg" },
+          "5": { overloadId: ["conditional"] },
+          "6": { name: "msg" },
+        },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { primitive: "BOOL" },
+          "5": { wellKnown: "ANY" },
+          "6": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "7": { wellKnown: "ANY" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 3,
+            "5": 20,
+            "6": 22,
+            "7": 25,
+            "8": 39,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "msg" } },
+                  field: "single_any",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              { id: "8", constExpr: { stringValue: "10" } },
+            ],
+          },
+        },
+      },
+      type: "any",
+      cost: { min: "2", max: "4" },
+      inlinedAst:
+        '__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  wrapped,\n  // Init\n  unpacked_wrapper~wrapper(string)^unpacked_wrapper,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  wrapped~wrapper(string)^wrapped,\n  // Result\n  _?_:_(\n    _!=_(\n      wrapped~wrapper(string)^wrapped,\n      null~null\n    )~bool^not_equals,\n    wrapped~wrapper(string)^wrapped,\n    "10"~string\n  )~wrapper(string)^conditional)~wrapper(string)',
+      inlined:
+        'cel.bind(wrapped, unpacked_wrapper, (wrapped != null) ? wrapped : "10")',
+      foldedAst:
+        '__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  wrapped,\n  // Init\n  unpacked_wrapper~wrapper(string)^unpacked_wrapper,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  wrapped~wrapper(string)^wrapped,\n  // Result\n  _?_:_(\n    _!=_(\n      wrapped~wrapper(string)^wrapped,\n      null~null\n    )~bool^not_equals,\n    wrapped~wrapper(string)^wrapped,\n    "10"~string\n  )~wrapper(string)^conditional)~wrapper(string)',
+      folded:
+        'cel.bind(wrapped, unpacked_wrapper, (wrapped != null) ? wrapped : "10")',
+      expectedInlined:
+        'cel.bind(wrapped, unpacked_wrapper, (wrapped != null) ? wrapped : "10")',
+      expectedFolded:
+        'cel.bind(wrapped, unpacked_wrapper, (wrapped != null) ? wrapped : "10")',
+    },
+    {
+      original: {
+        expr: "has(msg.child.payload.single_int32_wrapper)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: {
+                messageType: "google.expr.proto3.test.NestedTestAllTypes",
+              },
+            },
+          },
+          {
+            name: "unpacked_child",
+            ident: {
+              type: {
+                messageType: "google.expr.proto3.test.NestedTestAllTypes",
+              },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "msg.child.payload",
+          alias: "payload",
+          expr: "unpacked_child.payload",
+        },
+      ],
+      ast: "msg^#*expr.Expr_IdentExpr#.child^#*expr.Expr_SelectExpr#.payload^#*expr.Expr_SelectExpr#.single_int32_wrapper~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(msg.child.payload.single_int32_wrapper)",
+      locationAst:
+        "msg^#2[1,4]#.child^#3[1,7]#.payload^#4[1,13]#.single_int32_wrapper~test-only~^#6[1,3]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "msg" } },
+                    field: "child",
+                  },
+                },
+                field: "payload",
+              },
+            },
+            field: "single_int32_wrapper",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "2": 4, "3": 7, "4": 13, "5": 21, "6": 3 },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "4",
+                        selectExpr: {
+                          operand: {
+                            id: "3",
+                            selectExpr: {
+                              operand: { id: "2", identExpr: { name: "msg" } },
+                              field: "child",
+                            },
+                          },
+                          field: "payload",
+                        },
+                      },
+                      field: "single_int32_wrapper",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 6,
+          function: "has",
+          args: [
+            {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "4",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "msg" } },
+                        field: "child",
+                      },
+                    },
+                    field: "payload",
+                  },
+                },
+                field: "single_int32_wrapper",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "msg~google.expr.proto3.test.NestedTestAllTypes^msg.child~google.expr.proto3.test.NestedTestAllTypes.payload~google.expr.proto3.test.TestAllTypes.single_int32_wrapper~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "msg" } },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "3": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "4": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "6": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "2": 4, "3": 7, "4": 13, "5": 21, "6": 3 },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "4",
+                        selectExpr: {
+                          operand: {
+                            id: "3",
+                            selectExpr: {
+                              operand: { id: "2", identExpr: { name: "msg" } },
+                              field: "child",
+                            },
+                          },
+                          field: "payload",
+                        },
+                      },
+                      field: "single_int32_wrapper",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "msg" } },
+                    field: "child",
+                  },
+                },
+                field: "payload",
+              },
+            },
+            field: "single_int32_wrapper",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "4" },
+      inlinedAst:
+        "unpacked_child~google.expr.proto3.test.NestedTestAllTypes^unpacked_child.payload~google.expr.proto3.test.TestAllTypes.single_int32_wrapper~test-only~~bool",
+      inlined: "has(unpacked_child.payload.single_int32_wrapper)",
+      foldedAst:
+        "unpacked_child~google.expr.proto3.test.NestedTestAllTypes^unpacked_child.payload~google.expr.proto3.test.TestAllTypes.single_int32_wrapper~test-only~~bool",
+      folded: "has(unpacked_child.payload.single_int32_wrapper)",
+      expectedInlined: "has(unpacked_child.payload.single_int32_wrapper)",
+      expectedFolded: "has(unpacked_child.payload.single_int32_wrapper)",
+    },
+    {
+      original: {
+        expr: "has(msg.child.payload.single_int32_wrapper)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: {
+                messageType: "google.expr.proto3.test.NestedTestAllTypes",
+              },
+            },
+          },
+          {
+            name: "unpacked_payload",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "msg.child.payload.single_int32_wrapper",
+          alias: "payload",
+          expr: "unpacked_payload.single_int32_wrapper",
+        },
+      ],
+      ast: "msg^#*expr.Expr_IdentExpr#.child^#*expr.Expr_SelectExpr#.payload^#*expr.Expr_SelectExpr#.single_int32_wrapper~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(msg.child.payload.single_int32_wrapper)",
+      locationAst:
+        "msg^#2[1,4]#.child^#3[1,7]#.payload^#4[1,13]#.single_int32_wrapper~test-only~^#6[1,3]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "msg" } },
+                    field: "child",
+                  },
+                },
+                field: "payload",
+              },
+            },
+            field: "single_int32_wrapper",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "2": 4, "3": 7, "4": 13, "5": 21, "6": 3 },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "4",
+                        selectExpr: {
+                          operand: {
+                            id: "3",
+                            selectExpr: {
+                              operand: { id: "2", identExpr: { name: "msg" } },
+                              field: "child",
+                            },
+                          },
+                          field: "payload",
+                        },
+                      },
+                      field: "single_int32_wrapper",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 6,
+          function: "has",
+          args: [
+            {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "4",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "msg" } },
+                        field: "child",
+                      },
+                    },
+                    field: "payload",
+                  },
+                },
+                field: "single_int32_wrapper",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "msg~google.expr.proto3.test.NestedTestAllTypes^msg.child~google.expr.proto3.test.NestedTestAllTypes.payload~google.expr.proto3.test.TestAllTypes.single_int32_wrapper~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "msg" } },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "3": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "4": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "6": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "2": 4, "3": 7, "4": 13, "5": 21, "6": 3 },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "4",
+                        selectExpr: {
+                          operand: {
+                            id: "3",
+                            selectExpr: {
+                              operand: { id: "2", identExpr: { name: "msg" } },
+                              field: "child",
+                            },
+                          },
+                          field: "payload",
+                        },
+                      },
+                      field: "single_int32_wrapper",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "msg" } },
+                    field: "child",
+                  },
+                },
+                field: "payload",
+              },
+            },
+            field: "single_int32_wrapper",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "4" },
+      inlinedAst:
+        "unpacked_payload~google.expr.proto3.test.TestAllTypes^unpacked_payload.single_int32_wrapper~test-only~~bool",
+      inlined: "has(unpacked_payload.single_int32_wrapper)",
+      foldedAst:
+        "unpacked_payload~google.expr.proto3.test.TestAllTypes^unpacked_payload.single_int32_wrapper~test-only~~bool",
+      folded: "has(unpacked_payload.single_int32_wrapper)",
+      expectedInlined: "has(unpacked_payload.single_int32_wrapper)",
+      expectedFolded: "has(unpacked_payload.single_int32_wrapper)",
+    },
+    {
+      original: {
+        expr: "has(msg.child.payload.single_int32_wrapper) ? msg.child.payload.single_int32_wrapper : 1",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: {
+                messageType: "google.expr.proto3.test.NestedTestAllTypes",
+              },
+            },
+          },
+          {
+            name: "unpacked_payload",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "msg.child.payload.single_int32_wrapper",
+          alias: "nullable_int",
+          expr: "unpacked_payload.single_int32_wrapper",
+        },
+      ],
+      ast: "_?_:_(\n  msg^#*expr.Expr_IdentExpr#.child^#*expr.Expr_SelectExpr#.payload^#*expr.Expr_SelectExpr#.single_int32_wrapper~test-only~^#*expr.Expr_SelectExpr#,\n  msg^#*expr.Expr_IdentExpr#.child^#*expr.Expr_SelectExpr#.payload^#*expr.Expr_SelectExpr#.single_int32_wrapper^#*expr.Expr_SelectExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "has(msg.child.payload.single_int32_wrapper) ? msg.child.payload.single_int32_wrapper : 1",
+      locationAst:
+        "_?_:_(\n  msg^#2[1,4]#.child^#3[1,7]#.payload^#4[1,13]#.single_int32_wrapper~test-only~^#6[1,3]#,\n  msg^#8[1,46]#.child^#9[1,49]#.payload^#10[1,55]#.single_int32_wrapper^#11[1,63]#,\n  1^#12[1,87]#\n)^#7[1,44]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 3, 3, 1, 3, 1, 3],
+        [7, 44, 45, 1, 44, 1, 45],
+        [8, 46, 49, 1, 46, 1, 49],
+        [9, 49, 50, 1, 49, 1, 50],
+        [10, 55, 56, 1, 55, 1, 56],
+        [11, 63, 64, 1, 63, 1, 64],
+        [12, 87, 88, 1, 87, 1, 88],
+      ],
+      lineOffsets: [89],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "6",
+                selectExpr: {
+                  operand: {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "child",
+                        },
+                      },
+                      field: "payload",
+                    },
+                  },
+                  field: "single_int32_wrapper",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "11",
+                selectExpr: {
+                  operand: {
+                    id: "10",
+                    selectExpr: {
+                      operand: {
+                        id: "9",
+                        selectExpr: {
+                          operand: { id: "8", identExpr: { name: "msg" } },
+                          field: "child",
+                        },
+                      },
+                      field: "payload",
+                    },
+                  },
+                  field: "single_int32_wrapper",
+                },
+              },
+              { id: "12", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [89],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 13,
+            "5": 21,
+            "6": 3,
+            "7": 44,
+            "8": 46,
+            "9": 49,
+            "10": 55,
+            "11": 63,
+            "12": 87,
+          },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "4",
+                        selectExpr: {
+                          operand: {
+                            id: "3",
+                            selectExpr: {
+                              operand: { id: "2", identExpr: { name: "msg" } },
+                              field: "child",
+                            },
+                          },
+                          field: "payload",
+                        },
+                      },
+                      field: "single_int32_wrapper",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 6,
+          function: "has",
+          args: [
+            {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "4",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "msg" } },
+                        field: "child",
+                      },
+                    },
+                    field: "payload",
+                  },
+                },
+                field: "single_int32_wrapper",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_?_:_(\n  msg~google.expr.proto3.test.NestedTestAllTypes^msg.child~google.expr.proto3.test.NestedTestAllTypes.payload~google.expr.proto3.test.TestAllTypes.single_int32_wrapper~test-only~~bool,\n  msg~google.expr.proto3.test.NestedTestAllTypes^msg.child~google.expr.proto3.test.NestedTestAllTypes.payload~google.expr.proto3.test.TestAllTypes.single_int32_wrapper~wrapper(int),\n  1~int\n)~wrapper(int)^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "msg" },
+          "7": { overloadId: ["conditional"] },
+          "8": { name: "msg" },
+        },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "3": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "4": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "6": { primitive: "BOOL" },
+          "7": { wrapper: "INT64" },
+          "8": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "9": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "10": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "11": { wrapper: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [89],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 13,
+            "5": 21,
+            "6": 3,
+            "7": 44,
+            "8": 46,
+            "9": 49,
+            "10": 55,
+            "11": 63,
+            "12": 87,
+          },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "4",
+                        selectExpr: {
+                          operand: {
+                            id: "3",
+                            selectExpr: {
+                              operand: { id: "2", identExpr: { name: "msg" } },
+                              field: "child",
+                            },
+                          },
+                          field: "payload",
+                        },
+                      },
+                      field: "single_int32_wrapper",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "6",
+                selectExpr: {
+                  operand: {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "child",
+                        },
+                      },
+                      field: "payload",
+                    },
+                  },
+                  field: "single_int32_wrapper",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "11",
+                selectExpr: {
+                  operand: {
+                    id: "10",
+                    selectExpr: {
+                      operand: {
+                        id: "9",
+                        selectExpr: {
+                          operand: { id: "8", identExpr: { name: "msg" } },
+                          field: "child",
+                        },
+                      },
+                      field: "payload",
+                    },
+                  },
+                  field: "single_int32_wrapper",
+                },
+              },
+              { id: "12", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "wrapper(int)",
+      cost: { min: "4", max: "8" },
+      inlinedAst:
+        "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  nullable_int,\n  // Init\n  unpacked_payload~google.expr.proto3.test.TestAllTypes^unpacked_payload.single_int32_wrapper~wrapper(int),\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  nullable_int~wrapper(int)^nullable_int,\n  // Result\n  _?_:_(\n    _!=_(\n      nullable_int~wrapper(int)^nullable_int,\n      null~null\n    )~bool^not_equals,\n    nullable_int~wrapper(int)^nullable_int,\n    1~int\n  )~wrapper(int)^conditional)~wrapper(int)",
+      inlined:
+        "cel.bind(nullable_int, unpacked_payload.single_int32_wrapper, (nullable_int != null) ? nullable_int : 1)",
+      foldedAst:
+        "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  nullable_int,\n  // Init\n  unpacked_payload~google.expr.proto3.test.TestAllTypes^unpacked_payload.single_int32_wrapper~wrapper(int),\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  nullable_int~wrapper(int)^nullable_int,\n  // Result\n  _?_:_(\n    _!=_(\n      nullable_int~wrapper(int)^nullable_int,\n      null~null\n    )~bool^not_equals,\n    nullable_int~wrapper(int)^nullable_int,\n    1~int\n  )~wrapper(int)^conditional)~wrapper(int)",
+      folded:
+        "cel.bind(nullable_int, unpacked_payload.single_int32_wrapper, (nullable_int != null) ? nullable_int : 1)",
+      expectedInlined:
+        "cel.bind(nullable_int, unpacked_payload.single_int32_wrapper, (nullable_int != null) ? nullable_int : 1)",
+      expectedFolded:
+        "cel.bind(nullable_int, unpacked_payload.single_int32_wrapper, (nullable_int != null) ? nullable_int : 1)",
+    },
+    {
+      original: {
+        expr: "has(msg.single_value) ? msg.single_value : null",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "msg.single_value", alias: "nullable_float", expr: "dyn(1.5)" },
+      ],
+      ast: "_?_:_(\n  msg^#*expr.Expr_IdentExpr#.single_value~test-only~^#*expr.Expr_SelectExpr#,\n  msg^#*expr.Expr_IdentExpr#.single_value^#*expr.Expr_SelectExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(msg.single_value) ? msg.single_value : null",
+      locationAst:
+        "_?_:_(\n  msg^#2[1,4]#.single_value~test-only~^#4[1,3]#,\n  msg^#6[1,24]#.single_value^#7[1,27]#,\n  null^#8[1,43]#\n)^#5[1,22]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 22, 23, 1, 22, 1, 23],
+        [6, 24, 27, 1, 24, 1, 27],
+        [7, 27, 28, 1, 27, 1, 28],
+        [8, 43, 47, 1, 43, 1, 47],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "msg" } },
+                  field: "single_value",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "msg" } },
+                  field: "single_value",
+                },
+              },
+              { id: "8", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 3,
+            "5": 22,
+            "6": 24,
+            "7": 27,
+            "8": 43,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_value",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "msg" } },
+                field: "single_value",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_?_:_(\n  msg~google.expr.proto3.test.TestAllTypes^msg.single_value~test-only~~bool,\n  msg~google.expr.proto3.test.TestAllTypes^msg.single_value~dyn,\n  null~null\n)~dyn^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "msg" },
+          "5": { overloadId: ["conditional"] },
+          "6": { name: "msg" },
+        },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { primitive: "BOOL" },
+          "5": { dyn: {} },
+          "6": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "7": { dyn: {} },
+          "8": { null: null },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 3,
+            "5": 22,
+            "6": 24,
+            "7": 27,
+            "8": 43,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_value",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "msg" } },
+                  field: "single_value",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "msg" } },
+                  field: "single_value",
+                },
+              },
+              { id: "8", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "4" },
+      inlinedAst:
+        "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  nullable_float,\n  // Init\n  dyn(\n    1.5~double\n  )~dyn^to_dyn,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  nullable_float~dyn^nullable_float,\n  // Result\n  _?_:_(\n    _!=_(\n      nullable_float~dyn^nullable_float,\n      null~null\n    )~bool^not_equals,\n    nullable_float~dyn^nullable_float,\n    null~null\n  )~dyn^conditional)~dyn",
+      inlined:
+        "cel.bind(nullable_float, dyn(1.5), (nullable_float != null) ? nullable_float : null)",
+      foldedAst: "1.5~double",
+      folded: "1.5",
+      expectedInlined:
+        "cel.bind(nullable_float, dyn(1.5), (nullable_float != null) ? nullable_float : null)",
+      expectedFolded: "1.5",
+    },
+    {
+      original: {
+        expr: "has(msg.single_any) ? msg.single_any : 42",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "msg.single_any",
+          alias: "unpacked_nested",
+          expr: "proto3.test.NestedTestAllTypes{}.payload.single_int32",
+        },
+      ],
+      ast: "_?_:_(\n  msg^#*expr.Expr_IdentExpr#.single_any~test-only~^#*expr.Expr_SelectExpr#,\n  msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#,\n  42^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(msg.single_any) ? msg.single_any : 42",
+      locationAst:
+        "_?_:_(\n  msg^#2[1,4]#.single_any~test-only~^#4[1,3]#,\n  msg^#6[1,22]#.single_any^#7[1,25]#,\n  42^#8[1,39]#\n)^#5[1,20]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 22, 25, 1, 22, 1, 25],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 39, 41, 1, 39, 1, 41],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "msg" } },
+                  field: "single_any",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              { id: "8", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 3,
+            "5": 20,
+            "6": 22,
+            "7": 25,
+            "8": 39,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "msg" } },
+                field: "single_any",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_?_:_(\n  msg~google.expr.proto3.test.TestAllTypes^msg.single_any~test-only~~bool,\n  msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any,\n  42~int\n)~any^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "msg" },
+          "5": { overloadId: ["conditional"] },
+          "6": { name: "msg" },
+        },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { primitive: "BOOL" },
+          "5": { wellKnown: "ANY" },
+          "6": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "7": { wellKnown: "ANY" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 3,
+            "5": 20,
+            "6": 22,
+            "7": 25,
+            "8": 39,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "msg" } },
+                  field: "single_any",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "7",
+                selectExpr: {
+                  operand: { id: "6", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              { id: "8", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+      },
+      type: "any",
+      cost: { min: "2", max: "4" },
+      inlinedAst:
+        "_?_:_(\n  google.expr.proto3.test.NestedTestAllTypes{}~google.expr.proto3.test.NestedTestAllTypes^google.expr.proto3.test.NestedTestAllTypes.payload~google.expr.proto3.test.TestAllTypes.single_int32~test-only~~bool,\n  google.expr.proto3.test.NestedTestAllTypes{}~google.expr.proto3.test.NestedTestAllTypes^google.expr.proto3.test.NestedTestAllTypes.payload~google.expr.proto3.test.TestAllTypes.single_int32~int,\n  42~int\n)~int^conditional",
+      inlined:
+        "has(google.expr.proto3.test.NestedTestAllTypes{}.payload.single_int32) ? google.expr.proto3.test.NestedTestAllTypes{}.payload.single_int32 : 42",
+      foldedAst: "42~int",
+      folded: "42",
+      expectedInlined:
+        "has(google.expr.proto3.test.NestedTestAllTypes{}.payload.single_int32) ? google.expr.proto3.test.NestedTestAllTypes{}.payload.single_int32 : 42",
+      expectedFolded: "42",
+    },
+    {
+      original: {
+        expr: "has(msg.single_any.processing_purpose)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          {
+            name: "unpacked_purpose",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "msg.single_any.processing_purpose",
+          alias: "unpacked_purpose",
+          expr: "[1, 2, 3].map(i, i * 2)",
+        },
+      ],
+      ast: "msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.processing_purpose~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(msg.single_any.processing_purpose)",
+      locationAst:
+        "msg^#2[1,4]#.single_any^#3[1,7]#.processing_purpose~test-only~^#5[1,3]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "msg" } },
+                field: "single_any",
+              },
+            },
+            field: "processing_purpose",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "2": 4, "3": 7, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "processing_purpose",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "msg" } },
+                    field: "single_any",
+                  },
+                },
+                field: "processing_purpose",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.processing_purpose~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "msg" } },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { wellKnown: "ANY" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "2": 4, "3": 7, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "processing_purpose",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "msg" } },
+                field: "single_any",
+              },
+            },
+            field: "processing_purpose",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      inlinedAst:
+        "_!=_(\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        _*_(\n          i~int^i,\n          2~int\n        )~int^multiply_int64\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int).size()~int^list_size,\n  0~int\n)~bool^not_equals",
+      inlined: "[1, 2, 3].map(i, i * 2).size() != 0",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedInlined: "[1, 2, 3].map(i, i * 2).size() != 0",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "has(msg.single_any.processing_purpose) ? msg.single_any.processing_purpose[0] : 42",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          {
+            name: "unpacked_purpose",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "msg.single_any.processing_purpose",
+          alias: "unpacked_purpose",
+          expr: "[1, 2, 3].map(i, i * 2)",
+        },
+      ],
+      ast: "_?_:_(\n  msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.processing_purpose~test-only~^#*expr.Expr_SelectExpr#,\n  _[_](\n    msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.processing_purpose^#*expr.Expr_SelectExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  42^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "has(msg.single_any.processing_purpose) ? (msg.single_any.processing_purpose[0]) : 42",
+      locationAst:
+        "_?_:_(\n  msg^#2[1,4]#.single_any^#3[1,7]#.processing_purpose~test-only~^#5[1,3]#,\n  _[_](\n    msg^#7[1,41]#.single_any^#8[1,44]#.processing_purpose^#9[1,55]#,\n    0^#11[1,75]#\n  )^#10[1,74]#,\n  42^#12[1,80]#\n)^#6[1,39]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 41, 44, 1, 41, 1, 44],
+        [8, 44, 45, 1, 44, 1, 45],
+        [9, 55, 56, 1, 55, 1, 56],
+        [10, 74, 75, 1, 74, 1, 75],
+        [11, 75, 76, 1, 75, 1, 76],
+        [12, 80, 82, 1, 80, 1, 82],
+      ],
+      lineOffsets: [83],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "5",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                  field: "processing_purpose",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: { id: "7", identExpr: { name: "msg" } },
+                            field: "single_any",
+                          },
+                        },
+                        field: "processing_purpose",
+                      },
+                    },
+                    { id: "11", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "12", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [83],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 18,
+            "5": 3,
+            "6": 39,
+            "7": 41,
+            "8": 44,
+            "9": 55,
+            "10": 74,
+            "11": 75,
+            "12": 80,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "processing_purpose",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "msg" } },
+                    field: "single_any",
+                  },
+                },
+                field: "processing_purpose",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_?_:_(\n  msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.processing_purpose~test-only~~bool,\n  _[_](\n    msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.processing_purpose~dyn,\n    0~int\n  )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n  42~int\n)~dyn^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "msg" },
+          "6": { overloadId: ["conditional"] },
+          "7": { name: "msg" },
+          "10": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+        },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { wellKnown: "ANY" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "8": { wellKnown: "ANY" },
+          "9": { dyn: {} },
+          "10": { dyn: {} },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [83],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 18,
+            "5": 3,
+            "6": 39,
+            "7": 41,
+            "8": 44,
+            "9": 55,
+            "10": 74,
+            "11": 75,
+            "12": 80,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "processing_purpose",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "5",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                  field: "processing_purpose",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: { id: "7", identExpr: { name: "msg" } },
+                            field: "single_any",
+                          },
+                        },
+                        field: "processing_purpose",
+                      },
+                    },
+                    { id: "11", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "12", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "3", max: "6" },
+      inlinedAst:
+        "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  unpacked_purpose,\n  // Init\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        _*_(\n          i~int^i,\n          2~int\n        )~int^multiply_int64\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  unpacked_purpose~list(int)^unpacked_purpose,\n  // Result\n  _?_:_(\n    _!=_(\n      unpacked_purpose~list(int)^unpacked_purpose.size()~int^list_size,\n      0~int\n    )~bool^not_equals,\n    _[_](\n      unpacked_purpose~list(int)^unpacked_purpose,\n      0~int\n    )~int^index_list,\n    42~int\n  )~int^conditional)~int",
+      inlined:
+        "cel.bind(unpacked_purpose, [1, 2, 3].map(i, i * 2), (unpacked_purpose.size() != 0) ? (unpacked_purpose[0]) : 42)",
+      foldedAst: "2~int",
+      folded: "2",
+      expectedInlined:
+        "cel.bind(unpacked_purpose, [1, 2, 3].map(i, i * 2), (unpacked_purpose.size() != 0) ? (unpacked_purpose[0]) : 42)",
+      expectedFolded: "2",
+    },
+    {
+      original: {
+        expr: "has(msg.single_any.processing_purpose) ? msg.single_any.processing_purpose.map(i, i * 2)[0] : 42",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          {
+            name: "unpacked_purpose",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "msg.single_any.processing_purpose",
+          alias: "unpacked_purpose",
+          expr: "[1, 2, 3].map(i, i * 2)",
+        },
+      ],
+      ast: "_?_:_(\n  msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.processing_purpose~test-only~^#*expr.Expr_SelectExpr#,\n  _[_](\n    __comprehension__(\n      // Variable\n      i,\n      // Target\n      msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.processing_purpose^#*expr.Expr_SelectExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          _*_(\n            i^#*expr.Expr_IdentExpr#,\n            2^#*expr.Constant_Int64Value#\n          )^#*expr.Expr_CallExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  42^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "has(msg.single_any.processing_purpose) ? (msg.single_any.processing_purpose.map(i, i * 2)[0]) : 42",
+      locationAst:
+        "_?_:_(\n  msg^#2[1,4]#.single_any^#3[1,7]#.processing_purpose~test-only~^#5[1,3]#,\n  _[_](\n    __comprehension__(\n      // Variable\n      i,\n      // Target\n      msg^#7[1,41]#.single_any^#8[1,44]#.processing_purpose^#9[1,55]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#15[1,78]#,\n      // LoopCondition\n      true^#16[1,78]#,\n      // LoopStep\n      _+_(\n        @result^#17[1,78]#,\n        [\n          _*_(\n            i^#12[1,82]#,\n            2^#14[1,86]#\n          )^#13[1,84]#\n        ]^#18[1,78]#\n      )^#19[1,78]#,\n      // Result\n      @result^#20[1,78]#)^#21[1,78]#,\n    0^#23[1,89]#\n  )^#22[1,88]#,\n  42^#24[1,94]#\n)^#6[1,39]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 41, 44, 1, 41, 1, 44],
+        [8, 44, 45, 1, 44, 1, 45],
+        [9, 55, 56, 1, 55, 1, 56],
+        [11, 79, 80, 1, 79, 1, 80],
+        [12, 82, 83, 1, 82, 1, 83],
+        [13, 84, 85, 1, 84, 1, 85],
+        [14, 86, 87, 1, 86, 1, 87],
+        [15, 78, 78, 1, 78, 1, 78],
+        [16, 78, 78, 1, 78, 1, 78],
+        [17, 78, 78, 1, 78, 1, 78],
+        [18, 78, 78, 1, 78, 1, 78],
+        [19, 78, 78, 1, 78, 1, 78],
+        [20, 78, 78, 1, 78, 1, 78],
+        [21, 78, 78, 1, 78, 1, 78],
+        [22, 88, 89, 1, 88, 1, 89],
+        [23, 89, 90, 1, 89, 1, 90],
+        [24, 94, 96, 1, 94, 1, 96],
+      ],
+      lineOffsets: [97],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "5",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                  field: "processing_purpose",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "22",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "21",
+                      comprehensionExpr: {
+                        iterVar: "i",
+                        iterRange: {
+                          id: "9",
+                          selectExpr: {
+                            operand: {
+                              id: "8",
+                              selectExpr: {
+                                operand: {
+                                  id: "7",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "processing_purpose",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "15", listExpr: {} },
+                        loopCondition: {
+                          id: "16",
+                          constExpr: { boolValue: true },
+                        },
+                        loopStep: {
+                          id: "19",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "17", identExpr: { name: "@result" } },
+                              {
+                                id: "18",
+                                listExpr: {
+                                  elements: [
+                                    {
+                                      id: "13",
+                                      callExpr: {
+                                        function: "_*_",
+                                        args: [
+                                          {
+                                            id: "12",
+                                            identExpr: { name: "i" },
+                                          },
+                                          {
+                                            id: "14",
+                                            constExpr: { int64Value: "2" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "20", identExpr: { name: "@result" } },
+                      },
+                    },
+                    { id: "23", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "24", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [97],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 18,
+            "5": 3,
+            "6": 39,
+            "7": 41,
+            "8": 44,
+            "9": 55,
+            "11": 79,
+            "12": 82,
+            "13": 84,
+            "14": 86,
+            "15": 78,
+            "16": 78,
+            "17": 78,
+            "18": 78,
+            "19": 78,
+            "20": 78,
+            "21": 78,
+            "22": 88,
+            "23": 89,
+            "24": 94,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "processing_purpose",
+                    },
+                  },
+                ],
+              },
+            },
+            "21": {
+              callExpr: {
+                target: {
+                  id: "9",
+                  selectExpr: {
+                    operand: {
+                      id: "8",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "processing_purpose",
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "11", identExpr: { name: "i" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "12", identExpr: { name: "i" } },
+                        { id: "14", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "msg" } },
+                    field: "single_any",
+                  },
+                },
+                field: "processing_purpose",
+              },
+            },
+          ],
+        },
+        {
+          id: 21,
+          function: "map",
+          target: {
+            id: "9",
+            selectExpr: {
+              operand: {
+                id: "8",
+                selectExpr: {
+                  operand: { id: "7", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              field: "processing_purpose",
+            },
+          },
+          args: [
+            { id: "11", identExpr: { name: "i" } },
+            {
+              id: "13",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "12", identExpr: { name: "i" } },
+                  { id: "14", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_?_:_(\n  msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.processing_purpose~test-only~~bool,\n  _[_](\n    __comprehension__(\n      // Variable\n      i,\n      // Target\n      msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.processing_purpose~dyn,\n      // Accumulator\n      @result,\n      // Init\n      []~list(int),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _+_(\n        @result~list(int)^@result,\n        [\n          _*_(\n            i~dyn^i,\n            2~int\n          )~int^multiply_int64\n        ]~list(int)\n      )~list(int)^add_list,\n      // Result\n      @result~list(int)^@result)~list(int),\n    0~int\n  )~int^index_list,\n  42~int\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "msg" },
+          "6": { overloadId: ["conditional"] },
+          "7": { name: "msg" },
+          "12": { name: "i" },
+          "13": { overloadId: ["multiply_int64"] },
+          "17": { name: "@result" },
+          "19": { overloadId: ["add_list"] },
+          "20": { name: "@result" },
+          "22": { overloadId: ["index_list"] },
+        },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { wellKnown: "ANY" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+          "7": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "8": { wellKnown: "ANY" },
+          "9": { dyn: {} },
+          "12": { dyn: {} },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { primitive: "BOOL" },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "INT64" },
+          "24": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [97],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 18,
+            "5": 3,
+            "6": 39,
+            "7": 41,
+            "8": 44,
+            "9": 55,
+            "11": 79,
+            "12": 82,
+            "13": 84,
+            "14": 86,
+            "15": 78,
+            "16": 78,
+            "17": 78,
+            "18": 78,
+            "19": 78,
+            "20": 78,
+            "21": 78,
+            "22": 88,
+            "23": 89,
+            "24": 94,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "processing_purpose",
+                    },
+                  },
+                ],
+              },
+            },
+            "21": {
+              callExpr: {
+                target: {
+                  id: "9",
+                  selectExpr: {
+                    operand: {
+                      id: "8",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "processing_purpose",
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "11", identExpr: { name: "i" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "12", identExpr: { name: "i" } },
+                        { id: "14", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "5",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "single_any",
+                    },
+                  },
+                  field: "processing_purpose",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "22",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "21",
+                      comprehensionExpr: {
+                        iterVar: "i",
+                        iterRange: {
+                          id: "9",
+                          selectExpr: {
+                            operand: {
+                              id: "8",
+                              selectExpr: {
+                                operand: {
+                                  id: "7",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "processing_purpose",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "15", listExpr: {} },
+                        loopCondition: {
+                          id: "16",
+                          constExpr: { boolValue: true },
+                        },
+                        loopStep: {
+                          id: "19",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "17", identExpr: { name: "@result" } },
+                              {
+                                id: "18",
+                                listExpr: {
+                                  elements: [
+                                    {
+                                      id: "13",
+                                      callExpr: {
+                                        function: "_*_",
+                                        args: [
+                                          {
+                                            id: "12",
+                                            identExpr: { name: "i" },
+                                          },
+                                          {
+                                            id: "14",
+                                            constExpr: { int64Value: "2" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "20", identExpr: { name: "@result" } },
+                      },
+                    },
+                    { id: "23", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "24", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "3", max: "18446744073709551615" },
+      inlinedAst:
+        "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  unpacked_purpose,\n  // Init\n  __comprehension__(\n    // Variable\n    i,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        _*_(\n          i~int^i,\n          2~int\n        )~int^multiply_int64\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  unpacked_purpose~list(int)^unpacked_purpose,\n  // Result\n  _?_:_(\n    _!=_(\n      unpacked_purpose~list(int)^unpacked_purpose.size()~int^list_size,\n      0~int\n    )~bool^not_equals,\n    _[_](\n      __comprehension__(\n        // Variable\n        i,\n        // Target\n        unpacked_purpose~list(int)^unpacked_purpose,\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            _*_(\n              i~int^i,\n              2~int\n            )~int^multiply_int64\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int),\n      0~int\n    )~int^index_list,\n    42~int\n  )~int^conditional)~int",
+      inlined:
+        "cel.bind(unpacked_purpose, [1, 2, 3].map(i, i * 2), (unpacked_purpose.size() != 0) ? (unpacked_purpose.map(i, i * 2)[0]) : 42)",
+      foldedAst: "4~int",
+      folded: "4",
+      expectedInlined:
+        "cel.bind(unpacked_purpose, [1, 2, 3].map(i, i * 2), (unpacked_purpose.size() != 0) ? (unpacked_purpose.map(i, i * 2)[0]) : 42)",
+      expectedFolded: "4",
+    },
+    {
+      original: {
+        expr: "msg.single_any.processing_purpose.filter(j,\n\t\t\t\t\t\t\tj \u003c msg.single_any.processing_purpose.size()) == [2]",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          {
+            name: "unpacked_purpose",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        {
+          name: "msg.single_any.processing_purpose",
+          alias: "unpacked_purpose",
+          expr: "[1, 2, 3].map(i, i * 2)",
+        },
+      ],
+      ast: "_==_(\n  __comprehension__(\n    // Variable\n    j,\n    // Target\n    msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.processing_purpose^#*expr.Expr_SelectExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _?_:_(\n      _\u003c_(\n        j^#*expr.Expr_IdentExpr#,\n        msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.processing_purpose^#*expr.Expr_SelectExpr#.size()^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          j^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  [\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "msg.single_any.processing_purpose.filter(j, j \u003c msg.single_any.processing_purpose.size()) == [2]",
+      locationAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    j,\n    // Target\n    msg^#1[1,0]#.single_any^#2[1,3]#.processing_purpose^#3[1,14]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#12[1,40]#,\n    // LoopCondition\n    true^#13[1,40]#,\n    // LoopStep\n    _?_:_(\n      _\u003c_(\n        j^#6[2,7]#,\n        msg^#8[2,11]#.single_any^#9[2,14]#.processing_purpose^#10[2,25]#.size()^#11[2,49]#\n      )^#7[2,9]#,\n      _+_(\n        @result^#14[1,40]#,\n        [\n          j^#5[1,41]#\n        ]^#15[1,40]#\n      )^#16[1,40]#,\n      @result^#17[1,40]#\n    )^#18[1,40]#,\n    // Result\n    @result^#19[1,40]#)^#20[1,40]#,\n  [\n    2^#23[2,57]#\n  ]^#22[2,56]#\n)^#21[2,53]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 14, 15, 1, 14, 1, 15],
+        [5, 41, 42, 1, 41, 1, 42],
+        [6, 51, 52, 2, 7, 2, 8],
+        [7, 53, 54, 2, 9, 2, 10],
+        [8, 55, 58, 2, 11, 2, 14],
+        [9, 58, 59, 2, 14, 2, 15],
+        [10, 69, 70, 2, 25, 2, 26],
+        [11, 93, 94, 2, 49, 2, 50],
+        [12, 40, 40, 1, 40, 1, 40],
+        [13, 40, 40, 1, 40, 1, 40],
+        [14, 40, 40, 1, 40, 1, 40],
+        [15, 40, 40, 1, 40, 1, 40],
+        [16, 40, 40, 1, 40, 1, 40],
+        [17, 40, 40, 1, 40, 1, 40],
+        [18, 40, 40, 1, 40, 1, 40],
+        [19, 40, 40, 1, 40, 1, 40],
+        [20, 40, 40, 1, 40, 1, 40],
+        [21, 97, 99, 2, 53, 2, 55],
+        [22, 100, 101, 2, 56, 2, 57],
+        [23, 101, 102, 2, 57, 2, 58],
+      ],
+      lineOffsets: [44, 104],
+      parsedExpr: {
+        expr: {
+          id: "21",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "20",
+                comprehensionExpr: {
+                  iterVar: "j",
+                  iterRange: {
+                    id: "3",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        selectExpr: {
+                          operand: { id: "1", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "processing_purpose",
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "12", listExpr: {} },
+                  loopCondition: { id: "13", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "18",
+                    callExpr: {
+                      function: "_?_:_",
+                      args: [
+                        {
+                          id: "7",
+                          callExpr: {
+                            function: "_\u003c_",
+                            args: [
+                              { id: "6", identExpr: { name: "j" } },
+                              {
+                                id: "11",
+                                callExpr: {
+                                  target: {
+                                    id: "10",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "9",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "8",
+                                            identExpr: { name: "msg" },
+                                          },
+                                          field: "single_any",
+                                        },
+                                      },
+                                      field: "processing_purpose",
+                                    },
+                                  },
+                                  function: "size",
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "16",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "14", identExpr: { name: "@result" } },
+                              {
+                                id: "15",
+                                listExpr: {
+                                  elements: [
+                                    { id: "5", identExpr: { name: "j" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        { id: "17", identExpr: { name: "@result" } },
+                      ],
+                    },
+                  },
+                  result: { id: "19", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "22",
+                listExpr: {
+                  elements: [{ id: "23", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44, 104],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 14,
+            "5": 41,
+            "6": 51,
+            "7": 53,
+            "8": 55,
+            "9": 58,
+            "10": 69,
+            "11": 93,
+            "12": 40,
+            "13": 40,
+            "14": 40,
+            "15": 40,
+            "16": 40,
+            "17": 40,
+            "18": 40,
+            "19": 40,
+            "20": 40,
+            "21": 97,
+            "22": 100,
+            "23": 101,
+          },
+          macroCalls: {
+            "20": {
+              callExpr: {
+                target: {
+                  id: "3",
+                  selectExpr: {
+                    operand: {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "processing_purpose",
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "5", identExpr: { name: "j" } },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "6", identExpr: { name: "j" } },
+                        {
+                          id: "11",
+                          callExpr: {
+                            target: {
+                              id: "10",
+                              selectExpr: {
+                                operand: {
+                                  id: "9",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "8",
+                                      identExpr: { name: "msg" },
+                                    },
+                                    field: "single_any",
+                                  },
+                                },
+                                field: "processing_purpose",
+                              },
+                            },
+                            function: "size",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 20,
+          function: "filter",
+          target: {
+            id: "3",
+            selectExpr: {
+              operand: {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              field: "processing_purpose",
+            },
+          },
+          args: [
+            { id: "5", identExpr: { name: "j" } },
+            {
+              id: "7",
+              callExpr: {
+                function: "_\u003c_",
+                args: [
+                  { id: "6", identExpr: { name: "j" } },
+                  {
+                    id: "11",
+                    callExpr: {
+                      target: {
+                        id: "10",
+                        selectExpr: {
+                          operand: {
+                            id: "9",
+                            selectExpr: {
+                              operand: { id: "8", identExpr: { name: "msg" } },
+                              field: "single_any",
+                            },
+                          },
+                          field: "processing_purpose",
+                        },
+                      },
+                      function: "size",
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    j,\n    // Target\n    msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.processing_purpose~dyn,\n    // Accumulator\n    @result,\n    // Init\n    []~list(dyn),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _?_:_(\n      _\u003c_(\n        j~dyn^j,\n        msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.processing_purpose~dyn.size()~int^bytes_size|list_size|map_size|string_size\n      )~bool^less_double_int64|less_int64|less_uint64_int64,\n      _+_(\n        @result~list(dyn)^@result,\n        [\n          j~dyn^j\n        ]~list(dyn)\n      )~list(dyn)^add_list,\n      @result~list(dyn)^@result\n    )~list(dyn)^conditional,\n    // Result\n    @result~list(dyn)^@result)~list(dyn),\n  [\n    2~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "msg" },
+          "5": { name: "j" },
+          "6": { name: "j" },
+          "7": {
+            overloadId: [
+              "less_double_int64",
+              "less_int64",
+              "less_uint64_int64",
+            ],
+          },
+          "8": { name: "msg" },
+          "11": {
+            overloadId: ["bytes_size", "list_size", "map_size", "string_size"],
+          },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_list"] },
+          "17": { name: "@result" },
+          "18": { overloadId: ["conditional"] },
+          "19": { name: "@result" },
+          "21": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wellKnown: "ANY" },
+          "3": { dyn: {} },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "9": { wellKnown: "ANY" },
+          "10": { dyn: {} },
+          "11": { primitive: "INT64" },
+          "12": { listType: { elemType: { dyn: {} } } },
+          "13": { primitive: "BOOL" },
+          "14": { listType: { elemType: { dyn: {} } } },
+          "15": { listType: { elemType: { dyn: {} } } },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "17": { listType: { elemType: { dyn: {} } } },
+          "18": { listType: { elemType: { dyn: {} } } },
+          "19": { listType: { elemType: { dyn: {} } } },
+          "20": { listType: { elemType: { dyn: {} } } },
+          "21": { primitive: "BOOL" },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44, 104],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 14,
+            "5": 41,
+            "6": 51,
+            "7": 53,
+            "8": 55,
+            "9": 58,
+            "10": 69,
+            "11": 93,
+            "12": 40,
+            "13": 40,
+            "14": 40,
+            "15": 40,
+            "16": 40,
+            "17": 40,
+            "18": 40,
+            "19": 40,
+            "20": 40,
+            "21": 97,
+            "22": 100,
+            "23": 101,
+          },
+          macroCalls: {
+            "20": {
+              callExpr: {
+                target: {
+                  id: "3",
+                  selectExpr: {
+                    operand: {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "processing_purpose",
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "5", identExpr: { name: "j" } },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_\u003c_",
+                      args: [
+                        { id: "6", identExpr: { name: "j" } },
+                        {
+                          id: "11",
+                          callExpr: {
+                            target: {
+                              id: "10",
+                              selectExpr: {
+                                operand: {
+                                  id: "9",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "8",
+                                      identExpr: { name: "msg" },
+                                    },
+                                    field: "single_any",
+                                  },
+                                },
+                                field: "processing_purpose",
+                              },
+                            },
+                            function: "size",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "21",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "20",
+                comprehensionExpr: {
+                  iterVar: "j",
+                  iterRange: {
+                    id: "3",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        selectExpr: {
+                          operand: { id: "1", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "processing_purpose",
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "12", listExpr: {} },
+                  loopCondition: { id: "13", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "18",
+                    callExpr: {
+                      function: "_?_:_",
+                      args: [
+                        {
+                          id: "7",
+                          callExpr: {
+                            function: "_\u003c_",
+                            args: [
+                              { id: "6", identExpr: { name: "j" } },
+                              {
+                                id: "11",
+                                callExpr: {
+                                  target: {
+                                    id: "10",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "9",
+                                        selectExpr: {
+                                          operand: {
+                                            id: "8",
+                                            identExpr: { name: "msg" },
+                                          },
+                                          field: "single_any",
+                                        },
+                                      },
+                                      field: "processing_purpose",
+                                    },
+                                  },
+                                  function: "size",
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "16",
+                          callExpr: {
+                            function: "_+_",
+                            args: [
+                              { id: "14", identExpr: { name: "@result" } },
+                              {
+                                id: "15",
+                                listExpr: {
+                                  elements: [
+                                    { id: "5", identExpr: { name: "j" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        { id: "17", identExpr: { name: "@result" } },
+                      ],
+                    },
+                  },
+                  result: { id: "19", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "22",
+                listExpr: {
+                  elements: [{ id: "23", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "24", max: "18446744073709551615" },
+      inlinedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    unpacked_purpose,\n    // Init\n    __comprehension__(\n      // Variable\n      i,\n      // Target\n      [\n        1~int,\n        2~int,\n        3~int\n      ]~list(int),\n      // Accumulator\n      @result,\n      // Init\n      []~list(int),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _+_(\n        @result~list(int)^@result,\n        [\n          _*_(\n            i~int^i,\n            2~int\n          )~int^multiply_int64\n        ]~list(int)\n      )~list(int)^add_list,\n      // Result\n      @result~list(int)^@result)~list(int),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    unpacked_purpose~list(int)^unpacked_purpose,\n    // Result\n    __comprehension__(\n      // Variable\n      j,\n      // Target\n      unpacked_purpose~list(int)^unpacked_purpose,\n      // Accumulator\n      @result,\n      // Init\n      []~list(int),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _?_:_(\n        _\u003c_(\n          j~int^j,\n          unpacked_purpose~list(int)^unpacked_purpose.size()~int^list_size\n        )~bool^less_int64,\n        _+_(\n          @result~list(int)^@result,\n          [\n            j~int^j\n          ]~list(int)\n        )~list(int)^add_list,\n        @result~list(int)^@result\n      )~list(int)^conditional,\n      // Result\n      @result~list(int)^@result)~list(int))~list(int),\n  [\n    2~int\n  ]~list(int)\n)~bool^equals",
+      inlined:
+        "cel.bind(unpacked_purpose, [1, 2, 3].map(i, i * 2), unpacked_purpose.filter(j, j \u003c unpacked_purpose.size())) == [2]",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedInlined:
+        "cel.bind(unpacked_purpose, [1, 2, 3].map(i, i * 2), unpacked_purpose.filter(j, j \u003c unpacked_purpose.size())) == [2]",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "has(msg.single_any.listA) \u0026\u0026 msg.single_any.listB.size() \u003e 0 \u0026\u0026\n\t\t\t\t   msg.single_any.listB.all(b, b == msg.single_any.listA[0]) \u0026\u0026\n\t\t\t\t   msg.single_any.listA.all(a, a == msg.single_any.listB[0])",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          {
+            name: "listA",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "listB",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "msg.single_any.listA", alias: "listA", expr: "[1, 1]" },
+        { name: "msg.single_any.listB", alias: "listB", expr: "[1, 1, 1]" },
+      ],
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listA~test-only~^#*expr.Expr_SelectExpr#,\n    _\u003e_(\n      msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listB^#*expr.Expr_SelectExpr#.size()^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    __comprehension__(\n      // Variable\n      b,\n      // Target\n      msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listB^#*expr.Expr_SelectExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        _==_(\n          b^#*expr.Expr_IdentExpr#,\n          _[_](\n            msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listA^#*expr.Expr_SelectExpr#,\n            0^#*expr.Constant_Int64Value#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    __comprehension__(\n      // Variable\n      a,\n      // Target\n      msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listA^#*expr.Expr_SelectExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        _==_(\n          a^#*expr.Expr_IdentExpr#,\n          _[_](\n            msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listB^#*expr.Expr_SelectExpr#,\n            0^#*expr.Constant_Int64Value#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "has(msg.single_any.listA) \u0026\u0026 msg.single_any.listB.size() \u003e 0 \u0026\u0026 msg.single_any.listB.all(b, b == msg.single_any.listA[0]) \u0026\u0026\nmsg.single_any.listA.all(a, a == msg.single_any.listB[0])",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    msg^#2[1,4]#.single_any^#3[1,7]#.listA~test-only~^#5[1,3]#,\n    _\u003e_(\n      msg^#6[1,29]#.single_any^#7[1,32]#.listB^#8[1,43]#.size()^#9[1,54]#,\n      0^#11[1,59]#\n    )^#10[1,57]#\n  )^#12[1,26]#,\n  _\u0026\u0026_(\n    __comprehension__(\n      // Variable\n      b,\n      // Target\n      msg^#13[2,7]#.single_any^#14[2,10]#.listB^#15[2,21]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#25[2,31]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#26[2,31]#\n      )^#27[2,31]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#28[2,31]#,\n        _==_(\n          b^#18[2,35]#,\n          _[_](\n            msg^#20[2,40]#.single_any^#21[2,43]#.listA^#22[2,54]#,\n            0^#24[2,61]#\n          )^#23[2,60]#\n        )^#19[2,37]#\n      )^#29[2,31]#,\n      // Result\n      @result^#30[2,31]#)^#31[2,31]#,\n    __comprehension__(\n      // Variable\n      a,\n      // Target\n      msg^#33[3,7]#.single_any^#34[3,10]#.listA^#35[3,21]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#45[3,31]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#46[3,31]#\n      )^#47[3,31]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#48[3,31]#,\n        _==_(\n          a^#38[3,35]#,\n          _[_](\n            msg^#40[3,40]#.single_any^#41[3,43]#.listB^#42[3,54]#,\n            0^#44[3,61]#\n          )^#43[3,60]#\n        )^#39[3,37]#\n      )^#49[3,31]#,\n      // Result\n      @result^#50[3,31]#)^#51[3,31]#\n  )^#52[2,65]#\n)^#32[1,61]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+        [6, 29, 32, 1, 29, 1, 32],
+        [7, 32, 33, 1, 32, 1, 33],
+        [8, 43, 44, 1, 43, 1, 44],
+        [9, 54, 55, 1, 54, 1, 55],
+        [10, 57, 58, 1, 57, 1, 58],
+        [11, 59, 60, 1, 59, 1, 60],
+        [12, 26, 28, 1, 26, 1, 28],
+        [13, 71, 74, 2, 7, 2, 10],
+        [14, 74, 75, 2, 10, 2, 11],
+        [15, 85, 86, 2, 21, 2, 22],
+        [17, 96, 97, 2, 32, 2, 33],
+        [18, 99, 100, 2, 35, 2, 36],
+        [19, 101, 103, 2, 37, 2, 39],
+        [20, 104, 107, 2, 40, 2, 43],
+        [21, 107, 108, 2, 43, 2, 44],
+        [22, 118, 119, 2, 54, 2, 55],
+        [23, 124, 125, 2, 60, 2, 61],
+        [24, 125, 126, 2, 61, 2, 62],
+        [25, 95, 95, 2, 31, 2, 31],
+        [26, 95, 95, 2, 31, 2, 31],
+        [27, 95, 95, 2, 31, 2, 31],
+        [28, 95, 95, 2, 31, 2, 31],
+        [29, 95, 95, 2, 31, 2, 31],
+        [30, 95, 95, 2, 31, 2, 31],
+        [31, 95, 95, 2, 31, 2, 31],
+        [32, 61, 63, 1, 61, 1, 63],
+        [33, 139, 142, 3, 7, 3, 10],
+        [34, 142, 143, 3, 10, 3, 11],
+        [35, 153, 154, 3, 21, 3, 22],
+        [37, 164, 165, 3, 32, 3, 33],
+        [38, 167, 168, 3, 35, 3, 36],
+        [39, 169, 171, 3, 37, 3, 39],
+        [40, 172, 175, 3, 40, 3, 43],
+        [41, 175, 176, 3, 43, 3, 44],
+        [42, 186, 187, 3, 54, 3, 55],
+        [43, 192, 193, 3, 60, 3, 61],
+        [44, 193, 194, 3, 61, 3, 62],
+        [45, 163, 163, 3, 31, 3, 31],
+        [46, 163, 163, 3, 31, 3, 31],
+        [47, 163, 163, 3, 31, 3, 31],
+        [48, 163, 163, 3, 31, 3, 31],
+        [49, 163, 163, 3, 31, 3, 31],
+        [50, 163, 163, 3, 31, 3, 31],
+        [51, 163, 163, 3, 31, 3, 31],
+        [52, 129, 131, 2, 65, 2, 67],
+      ],
+      lineOffsets: [64, 132, 197],
+      parsedExpr: {
+        expr: {
+          id: "32",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "12",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: {
+                          id: "3",
+                          selectExpr: {
+                            operand: { id: "2", identExpr: { name: "msg" } },
+                            field: "single_any",
+                          },
+                        },
+                        field: "listA",
+                        testOnly: true,
+                      },
+                    },
+                    {
+                      id: "10",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          {
+                            id: "9",
+                            callExpr: {
+                              target: {
+                                id: "8",
+                                selectExpr: {
+                                  operand: {
+                                    id: "7",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "6",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listB",
+                                },
+                              },
+                              function: "size",
+                            },
+                          },
+                          { id: "11", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "52",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "31",
+                      comprehensionExpr: {
+                        iterVar: "b",
+                        iterRange: {
+                          id: "15",
+                          selectExpr: {
+                            operand: {
+                              id: "14",
+                              selectExpr: {
+                                operand: {
+                                  id: "13",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "listB",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "25", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "27",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              { id: "26", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "29",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "28", identExpr: { name: "@result" } },
+                              {
+                                id: "19",
+                                callExpr: {
+                                  function: "_==_",
+                                  args: [
+                                    { id: "18", identExpr: { name: "b" } },
+                                    {
+                                      id: "23",
+                                      callExpr: {
+                                        function: "_[_]",
+                                        args: [
+                                          {
+                                            id: "22",
+                                            selectExpr: {
+                                              operand: {
+                                                id: "21",
+                                                selectExpr: {
+                                                  operand: {
+                                                    id: "20",
+                                                    identExpr: { name: "msg" },
+                                                  },
+                                                  field: "single_any",
+                                                },
+                                              },
+                                              field: "listA",
+                                            },
+                                          },
+                                          {
+                                            id: "24",
+                                            constExpr: { int64Value: "0" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "30", identExpr: { name: "@result" } },
+                      },
+                    },
+                    {
+                      id: "51",
+                      comprehensionExpr: {
+                        iterVar: "a",
+                        iterRange: {
+                          id: "35",
+                          selectExpr: {
+                            operand: {
+                              id: "34",
+                              selectExpr: {
+                                operand: {
+                                  id: "33",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "listA",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "45", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "47",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              { id: "46", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "49",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "48", identExpr: { name: "@result" } },
+                              {
+                                id: "39",
+                                callExpr: {
+                                  function: "_==_",
+                                  args: [
+                                    { id: "38", identExpr: { name: "a" } },
+                                    {
+                                      id: "43",
+                                      callExpr: {
+                                        function: "_[_]",
+                                        args: [
+                                          {
+                                            id: "42",
+                                            selectExpr: {
+                                              operand: {
+                                                id: "41",
+                                                selectExpr: {
+                                                  operand: {
+                                                    id: "40",
+                                                    identExpr: { name: "msg" },
+                                                  },
+                                                  field: "single_any",
+                                                },
+                                              },
+                                              field: "listB",
+                                            },
+                                          },
+                                          {
+                                            id: "44",
+                                            constExpr: { int64Value: "0" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "50", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64, 132, 197],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 18,
+            "5": 3,
+            "6": 29,
+            "7": 32,
+            "8": 43,
+            "9": 54,
+            "10": 57,
+            "11": 59,
+            "12": 26,
+            "13": 71,
+            "14": 74,
+            "15": 85,
+            "17": 96,
+            "18": 99,
+            "19": 101,
+            "20": 104,
+            "21": 107,
+            "22": 118,
+            "23": 124,
+            "24": 125,
+            "25": 95,
+            "26": 95,
+            "27": 95,
+            "28": 95,
+            "29": 95,
+            "30": 95,
+            "31": 95,
+            "32": 61,
+            "33": 139,
+            "34": 142,
+            "35": 153,
+            "37": 164,
+            "38": 167,
+            "39": 169,
+            "40": 172,
+            "41": 175,
+            "42": 186,
+            "43": 192,
+            "44": 193,
+            "45": 163,
+            "46": 163,
+            "47": 163,
+            "48": 163,
+            "49": 163,
+            "50": 163,
+            "51": 163,
+            "52": 129,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "listA",
+                    },
+                  },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: {
+                  id: "15",
+                  selectExpr: {
+                    operand: {
+                      id: "14",
+                      selectExpr: {
+                        operand: { id: "13", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "listB",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "17", identExpr: { name: "b" } },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "18", identExpr: { name: "b" } },
+                        {
+                          id: "23",
+                          callExpr: {
+                            function: "_[_]",
+                            args: [
+                              {
+                                id: "22",
+                                selectExpr: {
+                                  operand: {
+                                    id: "21",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "20",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listA",
+                                },
+                              },
+                              { id: "24", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "51": {
+              callExpr: {
+                target: {
+                  id: "35",
+                  selectExpr: {
+                    operand: {
+                      id: "34",
+                      selectExpr: {
+                        operand: { id: "33", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "listA",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "37", identExpr: { name: "a" } },
+                  {
+                    id: "39",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "38", identExpr: { name: "a" } },
+                        {
+                          id: "43",
+                          callExpr: {
+                            function: "_[_]",
+                            args: [
+                              {
+                                id: "42",
+                                selectExpr: {
+                                  operand: {
+                                    id: "41",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "40",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listB",
+                                },
+                              },
+                              { id: "44", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "msg" } },
+                    field: "single_any",
+                  },
+                },
+                field: "listA",
+              },
+            },
+          ],
+        },
+        {
+          id: 31,
+          function: "all",
+          target: {
+            id: "15",
+            selectExpr: {
+              operand: {
+                id: "14",
+                selectExpr: {
+                  operand: { id: "13", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              field: "listB",
+            },
+          },
+          args: [
+            { id: "17", identExpr: { name: "b" } },
+            {
+              id: "19",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "18", identExpr: { name: "b" } },
+                  {
+                    id: "23",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        {
+                          id: "22",
+                          selectExpr: {
+                            operand: {
+                              id: "21",
+                              selectExpr: {
+                                operand: {
+                                  id: "20",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "listA",
+                          },
+                        },
+                        { id: "24", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 51,
+          function: "all",
+          target: {
+            id: "35",
+            selectExpr: {
+              operand: {
+                id: "34",
+                selectExpr: {
+                  operand: { id: "33", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              field: "listA",
+            },
+          },
+          args: [
+            { id: "37", identExpr: { name: "a" } },
+            {
+              id: "39",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "38", identExpr: { name: "a" } },
+                  {
+                    id: "43",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        {
+                          id: "42",
+                          selectExpr: {
+                            operand: {
+                              id: "41",
+                              selectExpr: {
+                                operand: {
+                                  id: "40",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "listB",
+                          },
+                        },
+                        { id: "44", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listA~test-only~~bool,\n    _\u003e_(\n      msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listB~dyn.size()~int^bytes_size|list_size|map_size|string_size,\n      0~int\n    )~bool^greater_int64\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    __comprehension__(\n      // Variable\n      b,\n      // Target\n      msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listB~dyn,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        _==_(\n          b~dyn^b,\n          _[_](\n            msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listA~dyn,\n            0~int\n          )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value\n        )~bool^equals\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool,\n    __comprehension__(\n      // Variable\n      a,\n      // Target\n      msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listA~dyn,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        _==_(\n          a~dyn^a,\n          _[_](\n            msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listB~dyn,\n            0~int\n          )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value\n        )~bool^equals\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "msg" },
+          "6": { name: "msg" },
+          "9": {
+            overloadId: ["bytes_size", "list_size", "map_size", "string_size"],
+          },
+          "10": { overloadId: ["greater_int64"] },
+          "12": { overloadId: ["logical_and"] },
+          "13": { name: "msg" },
+          "18": { name: "b" },
+          "19": { overloadId: ["equals"] },
+          "20": { name: "msg" },
+          "23": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "26": { name: "@result" },
+          "27": { overloadId: ["not_strictly_false"] },
+          "28": { name: "@result" },
+          "29": { overloadId: ["logical_and"] },
+          "30": { name: "@result" },
+          "32": { overloadId: ["logical_and"] },
+          "33": { name: "msg" },
+          "38": { name: "a" },
+          "39": { overloadId: ["equals"] },
+          "40": { name: "msg" },
+          "43": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "46": { name: "@result" },
+          "47": { overloadId: ["not_strictly_false"] },
+          "48": { name: "@result" },
+          "49": { overloadId: ["logical_and"] },
+          "50": { name: "@result" },
+          "52": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { wellKnown: "ANY" },
+          "5": { primitive: "BOOL" },
+          "6": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "7": { wellKnown: "ANY" },
+          "8": { dyn: {} },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "BOOL" },
+          "13": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "14": { wellKnown: "ANY" },
+          "15": { dyn: {} },
+          "18": { dyn: {} },
+          "19": { primitive: "BOOL" },
+          "20": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "21": { wellKnown: "ANY" },
+          "22": { dyn: {} },
+          "23": { dyn: {} },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "34": { wellKnown: "ANY" },
+          "35": { dyn: {} },
+          "38": { dyn: {} },
+          "39": { primitive: "BOOL" },
+          "40": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "41": { wellKnown: "ANY" },
+          "42": { dyn: {} },
+          "43": { dyn: {} },
+          "44": { primitive: "INT64" },
+          "45": { primitive: "BOOL" },
+          "46": { primitive: "BOOL" },
+          "47": { primitive: "BOOL" },
+          "48": { primitive: "BOOL" },
+          "49": { primitive: "BOOL" },
+          "50": { primitive: "BOOL" },
+          "51": { primitive: "BOOL" },
+          "52": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64, 132, 197],
+          positions: {
+            "2": 4,
+            "3": 7,
+            "4": 18,
+            "5": 3,
+            "6": 29,
+            "7": 32,
+            "8": 43,
+            "9": 54,
+            "10": 57,
+            "11": 59,
+            "12": 26,
+            "13": 71,
+            "14": 74,
+            "15": 85,
+            "17": 96,
+            "18": 99,
+            "19": 101,
+            "20": 104,
+            "21": 107,
+            "22": 118,
+            "23": 124,
+            "24": 125,
+            "25": 95,
+            "26": 95,
+            "27": 95,
+            "28": 95,
+            "29": 95,
+            "30": 95,
+            "31": 95,
+            "32": 61,
+            "33": 139,
+            "34": 142,
+            "35": 153,
+            "37": 164,
+            "38": 167,
+            "39": 169,
+            "40": 172,
+            "41": 175,
+            "42": 186,
+            "43": 192,
+            "44": 193,
+            "45": 163,
+            "46": 163,
+            "47": 163,
+            "48": 163,
+            "49": 163,
+            "50": 163,
+            "51": 163,
+            "52": 129,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "msg" } },
+                          field: "single_any",
+                        },
+                      },
+                      field: "listA",
+                    },
+                  },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: {
+                  id: "15",
+                  selectExpr: {
+                    operand: {
+                      id: "14",
+                      selectExpr: {
+                        operand: { id: "13", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "listB",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "17", identExpr: { name: "b" } },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "18", identExpr: { name: "b" } },
+                        {
+                          id: "23",
+                          callExpr: {
+                            function: "_[_]",
+                            args: [
+                              {
+                                id: "22",
+                                selectExpr: {
+                                  operand: {
+                                    id: "21",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "20",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listA",
+                                },
+                              },
+                              { id: "24", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "51": {
+              callExpr: {
+                target: {
+                  id: "35",
+                  selectExpr: {
+                    operand: {
+                      id: "34",
+                      selectExpr: {
+                        operand: { id: "33", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "listA",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "37", identExpr: { name: "a" } },
+                  {
+                    id: "39",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "38", identExpr: { name: "a" } },
+                        {
+                          id: "43",
+                          callExpr: {
+                            function: "_[_]",
+                            args: [
+                              {
+                                id: "42",
+                                selectExpr: {
+                                  operand: {
+                                    id: "41",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "40",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listB",
+                                },
+                              },
+                              { id: "44", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "32",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "12",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: {
+                          id: "3",
+                          selectExpr: {
+                            operand: { id: "2", identExpr: { name: "msg" } },
+                            field: "single_any",
+                          },
+                        },
+                        field: "listA",
+                        testOnly: true,
+                      },
+                    },
+                    {
+                      id: "10",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          {
+                            id: "9",
+                            callExpr: {
+                              target: {
+                                id: "8",
+                                selectExpr: {
+                                  operand: {
+                                    id: "7",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "6",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listB",
+                                },
+                              },
+                              function: "size",
+                            },
+                          },
+                          { id: "11", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "52",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "31",
+                      comprehensionExpr: {
+                        iterVar: "b",
+                        iterRange: {
+                          id: "15",
+                          selectExpr: {
+                            operand: {
+                              id: "14",
+                              selectExpr: {
+                                operand: {
+                                  id: "13",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "listB",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "25", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "27",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              { id: "26", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "29",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "28", identExpr: { name: "@result" } },
+                              {
+                                id: "19",
+                                callExpr: {
+                                  function: "_==_",
+                                  args: [
+                                    { id: "18", identExpr: { name: "b" } },
+                                    {
+                                      id: "23",
+                                      callExpr: {
+                                        function: "_[_]",
+                                        args: [
+                                          {
+                                            id: "22",
+                                            selectExpr: {
+                                              operand: {
+                                                id: "21",
+                                                selectExpr: {
+                                                  operand: {
+                                                    id: "20",
+                                                    identExpr: { name: "msg" },
+                                                  },
+                                                  field: "single_any",
+                                                },
+                                              },
+                                              field: "listA",
+                                            },
+                                          },
+                                          {
+                                            id: "24",
+                                            constExpr: { int64Value: "0" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "30", identExpr: { name: "@result" } },
+                      },
+                    },
+                    {
+                      id: "51",
+                      comprehensionExpr: {
+                        iterVar: "a",
+                        iterRange: {
+                          id: "35",
+                          selectExpr: {
+                            operand: {
+                              id: "34",
+                              selectExpr: {
+                                operand: {
+                                  id: "33",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "listA",
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "45", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "47",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              { id: "46", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "49",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "48", identExpr: { name: "@result" } },
+                              {
+                                id: "39",
+                                callExpr: {
+                                  function: "_==_",
+                                  args: [
+                                    { id: "38", identExpr: { name: "a" } },
+                                    {
+                                      id: "43",
+                                      callExpr: {
+                                        function: "_[_]",
+                                        args: [
+                                          {
+                                            id: "42",
+                                            selectExpr: {
+                                              operand: {
+                                                id: "41",
+                                                selectExpr: {
+                                                  operand: {
+                                                    id: "40",
+                                                    identExpr: { name: "msg" },
+                                                  },
+                                                  field: "single_any",
+                                                },
+                                              },
+                                              field: "listB",
+                                            },
+                                          },
+                                          {
+                                            id: "44",
+                                            constExpr: { int64Value: "0" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "50", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "18446744073709551615" },
+      inlinedAst:
+        "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  listA,\n  // Init\n  [\n    1~int,\n    1~int\n  ]~list(int),\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  listA~list(int)^listA,\n  // Result\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    listB,\n    // Init\n    [\n      1~int,\n      1~int,\n      1~int\n    ]~list(int),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    listB~list(int)^listB,\n    // Result\n    _\u0026\u0026_(\n      _\u0026\u0026_(\n        _!=_(\n          listA~list(int)^listA.size()~int^list_size,\n          0~int\n        )~bool^not_equals,\n        _\u003e_(\n          listB~list(int)^listB.size()~int^list_size,\n          0~int\n        )~bool^greater_int64\n      )~bool^logical_and,\n      _\u0026\u0026_(\n        __comprehension__(\n          // Variable\n          b,\n          // Target\n          listB~list(int)^listB,\n          // Accumulator\n          @result,\n          // Init\n          true~bool,\n          // LoopCondition\n          @not_strictly_false(\n            @result~bool^@result\n          )~bool^not_strictly_false,\n          // LoopStep\n          _\u0026\u0026_(\n            @result~bool^@result,\n            _==_(\n              b~int^b,\n              _[_](\n                listA~list(int)^listA,\n                0~int\n              )~int^index_list\n            )~bool^equals\n          )~bool^logical_and,\n          // Result\n          @result~bool^@result)~bool,\n        __comprehension__(\n          // Variable\n          a,\n          // Target\n          listA~list(int)^listA,\n          // Accumulator\n          @result,\n          // Init\n          true~bool,\n          // LoopCondition\n          @not_strictly_false(\n            @result~bool^@result\n          )~bool^not_strictly_false,\n          // LoopStep\n          _\u0026\u0026_(\n            @result~bool^@result,\n            _==_(\n              a~int^a,\n              _[_](\n                listB~list(int)^listB,\n                0~int\n              )~int^index_list\n            )~bool^equals\n          )~bool^logical_and,\n          // Result\n          @result~bool^@result)~bool\n      )~bool^logical_and\n    )~bool^logical_and)~bool)~bool",
+      inlined:
+        "cel.bind(listA, [1, 1], cel.bind(listB, [1, 1, 1], listA.size() != 0 \u0026\u0026 listB.size() \u003e 0 \u0026\u0026\nlistB.all(b, b == listA[0]) \u0026\u0026 listA.all(a, a == listB[0])))",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedInlined:
+        "cel.bind(listA, [1, 1], cel.bind(listB, [1, 1, 1], listA.size() != 0 \u0026\u0026 listB.size() \u003e 0 \u0026\u0026\nlistB.all(b, b == listA[0]) \u0026\u0026 listA.all(a, a == listB[0])))",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "((msg.single_any.listB.all(b, b == msg.single_any.listA[0]) \u0026\u0026\n\t\t\t\t   msg.single_any.listA.all(a, a == msg.single_any.listB[0])) ||\n\t\t\t\t   msg.single_any.listA.size() == 0) ||\n\t\t\t\t   false",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          {
+            name: "listA",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "listB",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "msg.single_any.listA", alias: "listA", expr: "[1, 1]" },
+        { name: "msg.single_any.listB", alias: "listB", expr: "[1, 1, 1]" },
+      ],
+      ast: "_||_(\n  _||_(\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        b,\n        // Target\n        msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listB^#*expr.Expr_SelectExpr#,\n        // Accumulator\n        @result,\n        // Init\n        true^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#*expr.Expr_IdentExpr#,\n          _==_(\n            b^#*expr.Expr_IdentExpr#,\n            _[_](\n              msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listA^#*expr.Expr_SelectExpr#,\n              0^#*expr.Constant_Int64Value#\n            )^#*expr.Expr_CallExpr#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n      __comprehension__(\n        // Variable\n        a,\n        // Target\n        msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listA^#*expr.Expr_SelectExpr#,\n        // Accumulator\n        @result,\n        // Init\n        true^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#*expr.Expr_IdentExpr#,\n          _==_(\n            a^#*expr.Expr_IdentExpr#,\n            _[_](\n              msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listB^#*expr.Expr_SelectExpr#,\n              0^#*expr.Constant_Int64Value#\n            )^#*expr.Expr_CallExpr#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      msg^#*expr.Expr_IdentExpr#.single_any^#*expr.Expr_SelectExpr#.listA^#*expr.Expr_SelectExpr#.size()^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "msg.single_any.listB.all(b, b == msg.single_any.listA[0]) \u0026\u0026 msg.single_any.listA.all(a, a == msg.single_any.listB[0]) ||\nmsg.single_any.listA.size() == 0 || false",
+      locationAst:
+        "_||_(\n  _||_(\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        b,\n        // Target\n        msg^#1[1,2]#.single_any^#2[1,5]#.listB^#3[1,16]#,\n        // Accumulator\n        @result,\n        // Init\n        true^#13[1,26]#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#14[1,26]#\n        )^#15[1,26]#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#16[1,26]#,\n          _==_(\n            b^#6[1,30]#,\n            _[_](\n              msg^#8[1,35]#.single_any^#9[1,38]#.listA^#10[1,49]#,\n              0^#12[1,56]#\n            )^#11[1,55]#\n          )^#7[1,32]#\n        )^#17[1,26]#,\n        // Result\n        @result^#18[1,26]#)^#19[1,26]#,\n      __comprehension__(\n        // Variable\n        a,\n        // Target\n        msg^#20[2,7]#.single_any^#21[2,10]#.listA^#22[2,21]#,\n        // Accumulator\n        @result,\n        // Init\n        true^#32[2,31]#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#33[2,31]#\n        )^#34[2,31]#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#35[2,31]#,\n          _==_(\n            a^#25[2,35]#,\n            _[_](\n              msg^#27[2,40]#.single_any^#28[2,43]#.listB^#29[2,54]#,\n              0^#31[2,61]#\n            )^#30[2,60]#\n          )^#26[2,37]#\n        )^#36[2,31]#,\n        // Result\n        @result^#37[2,31]#)^#38[2,31]#\n    )^#39[1,60]#,\n    _==_(\n      msg^#40[3,7]#.single_any^#41[3,10]#.listA^#42[3,21]#.size()^#43[3,32]#,\n      0^#45[3,38]#\n    )^#44[3,35]#\n  )^#46[2,66]#,\n  false^#47[4,7]#\n)^#48[3,41]#",
+      positions: [
+        [1, 2, 5, 1, 2, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 16, 17, 1, 16, 1, 17],
+        [5, 27, 28, 1, 27, 1, 28],
+        [6, 30, 31, 1, 30, 1, 31],
+        [7, 32, 34, 1, 32, 1, 34],
+        [8, 35, 38, 1, 35, 1, 38],
+        [9, 38, 39, 1, 38, 1, 39],
+        [10, 49, 50, 1, 49, 1, 50],
+        [11, 55, 56, 1, 55, 1, 56],
+        [12, 56, 57, 1, 56, 1, 57],
+        [13, 26, 26, 1, 26, 1, 26],
+        [14, 26, 26, 1, 26, 1, 26],
+        [15, 26, 26, 1, 26, 1, 26],
+        [16, 26, 26, 1, 26, 1, 26],
+        [17, 26, 26, 1, 26, 1, 26],
+        [18, 26, 26, 1, 26, 1, 26],
+        [19, 26, 26, 1, 26, 1, 26],
+        [20, 70, 73, 2, 7, 2, 10],
+        [21, 73, 74, 2, 10, 2, 11],
+        [22, 84, 85, 2, 21, 2, 22],
+        [24, 95, 96, 2, 32, 2, 33],
+        [25, 98, 99, 2, 35, 2, 36],
+        [26, 100, 102, 2, 37, 2, 39],
+        [27, 103, 106, 2, 40, 2, 43],
+        [28, 106, 107, 2, 43, 2, 44],
+        [29, 117, 118, 2, 54, 2, 55],
+        [30, 123, 124, 2, 60, 2, 61],
+        [31, 124, 125, 2, 61, 2, 62],
+        [32, 94, 94, 2, 31, 2, 31],
+        [33, 94, 94, 2, 31, 2, 31],
+        [34, 94, 94, 2, 31, 2, 31],
+        [35, 94, 94, 2, 31, 2, 31],
+        [36, 94, 94, 2, 31, 2, 31],
+        [37, 94, 94, 2, 31, 2, 31],
+        [38, 94, 94, 2, 31, 2, 31],
+        [39, 60, 62, 1, 60, 1, 62],
+        [40, 139, 142, 3, 7, 3, 10],
+        [41, 142, 143, 3, 10, 3, 11],
+        [42, 153, 154, 3, 21, 3, 22],
+        [43, 164, 165, 3, 32, 3, 33],
+        [44, 167, 169, 3, 35, 3, 37],
+        [45, 170, 171, 3, 38, 3, 39],
+        [46, 129, 131, 2, 66, 2, 68],
+        [47, 183, 188, 4, 7, 4, 12],
+        [48, 173, 175, 3, 41, 3, 43],
+      ],
+      lineOffsets: [63, 132, 176, 189],
+      parsedExpr: {
+        expr: {
+          id: "48",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "46",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "39",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "19",
+                            comprehensionExpr: {
+                              iterVar: "b",
+                              iterRange: {
+                                id: "3",
+                                selectExpr: {
+                                  operand: {
+                                    id: "2",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "1",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listB",
+                                },
+                              },
+                              accuVar: "@result",
+                              accuInit: {
+                                id: "13",
+                                constExpr: { boolValue: true },
+                              },
+                              loopCondition: {
+                                id: "15",
+                                callExpr: {
+                                  function: "@not_strictly_false",
+                                  args: [
+                                    {
+                                      id: "14",
+                                      identExpr: { name: "@result" },
+                                    },
+                                  ],
+                                },
+                              },
+                              loopStep: {
+                                id: "17",
+                                callExpr: {
+                                  function: "_\u0026\u0026_",
+                                  args: [
+                                    {
+                                      id: "16",
+                                      identExpr: { name: "@result" },
+                                    },
+                                    {
+                                      id: "7",
+                                      callExpr: {
+                                        function: "_==_",
+                                        args: [
+                                          { id: "6", identExpr: { name: "b" } },
+                                          {
+                                            id: "11",
+                                            callExpr: {
+                                              function: "_[_]",
+                                              args: [
+                                                {
+                                                  id: "10",
+                                                  selectExpr: {
+                                                    operand: {
+                                                      id: "9",
+                                                      selectExpr: {
+                                                        operand: {
+                                                          id: "8",
+                                                          identExpr: {
+                                                            name: "msg",
+                                                          },
+                                                        },
+                                                        field: "single_any",
+                                                      },
+                                                    },
+                                                    field: "listA",
+                                                  },
+                                                },
+                                                {
+                                                  id: "12",
+                                                  constExpr: {
+                                                    int64Value: "0",
+                                                  },
+                                                },
+                                              ],
+                                            },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              result: {
+                                id: "18",
+                                identExpr: { name: "@result" },
+                              },
+                            },
+                          },
+                          {
+                            id: "38",
+                            comprehensionExpr: {
+                              iterVar: "a",
+                              iterRange: {
+                                id: "22",
+                                selectExpr: {
+                                  operand: {
+                                    id: "21",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "20",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listA",
+                                },
+                              },
+                              accuVar: "@result",
+                              accuInit: {
+                                id: "32",
+                                constExpr: { boolValue: true },
+                              },
+                              loopCondition: {
+                                id: "34",
+                                callExpr: {
+                                  function: "@not_strictly_false",
+                                  args: [
+                                    {
+                                      id: "33",
+                                      identExpr: { name: "@result" },
+                                    },
+                                  ],
+                                },
+                              },
+                              loopStep: {
+                                id: "36",
+                                callExpr: {
+                                  function: "_\u0026\u0026_",
+                                  args: [
+                                    {
+                                      id: "35",
+                                      identExpr: { name: "@result" },
+                                    },
+                                    {
+                                      id: "26",
+                                      callExpr: {
+                                        function: "_==_",
+                                        args: [
+                                          {
+                                            id: "25",
+                                            identExpr: { name: "a" },
+                                          },
+                                          {
+                                            id: "30",
+                                            callExpr: {
+                                              function: "_[_]",
+                                              args: [
+                                                {
+                                                  id: "29",
+                                                  selectExpr: {
+                                                    operand: {
+                                                      id: "28",
+                                                      selectExpr: {
+                                                        operand: {
+                                                          id: "27",
+                                                          identExpr: {
+                                                            name: "msg",
+                                                          },
+                                                        },
+                                                        field: "single_any",
+                                                      },
+                                                    },
+                                                    field: "listB",
+                                                  },
+                                                },
+                                                {
+                                                  id: "31",
+                                                  constExpr: {
+                                                    int64Value: "0",
+                                                  },
+                                                },
+                                              ],
+                                            },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              result: {
+                                id: "37",
+                                identExpr: { name: "@result" },
+                              },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "44",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "43",
+                            callExpr: {
+                              target: {
+                                id: "42",
+                                selectExpr: {
+                                  operand: {
+                                    id: "41",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "40",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listA",
+                                },
+                              },
+                              function: "size",
+                            },
+                          },
+                          { id: "45", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "47", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63, 132, 176, 189],
+          positions: {
+            "1": 2,
+            "2": 5,
+            "3": 16,
+            "5": 27,
+            "6": 30,
+            "7": 32,
+            "8": 35,
+            "9": 38,
+            "10": 49,
+            "11": 55,
+            "12": 56,
+            "13": 26,
+            "14": 26,
+            "15": 26,
+            "16": 26,
+            "17": 26,
+            "18": 26,
+            "19": 26,
+            "20": 70,
+            "21": 73,
+            "22": 84,
+            "24": 95,
+            "25": 98,
+            "26": 100,
+            "27": 103,
+            "28": 106,
+            "29": 117,
+            "30": 123,
+            "31": 124,
+            "32": 94,
+            "33": 94,
+            "34": 94,
+            "35": 94,
+            "36": 94,
+            "37": 94,
+            "38": 94,
+            "39": 60,
+            "40": 139,
+            "41": 142,
+            "42": 153,
+            "43": 164,
+            "44": 167,
+            "45": 170,
+            "46": 129,
+            "47": 183,
+            "48": 173,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "3",
+                  selectExpr: {
+                    operand: {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "listB",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "5", identExpr: { name: "b" } },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "6", identExpr: { name: "b" } },
+                        {
+                          id: "11",
+                          callExpr: {
+                            function: "_[_]",
+                            args: [
+                              {
+                                id: "10",
+                                selectExpr: {
+                                  operand: {
+                                    id: "9",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "8",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listA",
+                                },
+                              },
+                              { id: "12", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: {
+                  id: "22",
+                  selectExpr: {
+                    operand: {
+                      id: "21",
+                      selectExpr: {
+                        operand: { id: "20", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "listA",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "24", identExpr: { name: "a" } },
+                  {
+                    id: "26",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "25", identExpr: { name: "a" } },
+                        {
+                          id: "30",
+                          callExpr: {
+                            function: "_[_]",
+                            args: [
+                              {
+                                id: "29",
+                                selectExpr: {
+                                  operand: {
+                                    id: "28",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "27",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listB",
+                                },
+                              },
+                              { id: "31", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "all",
+          target: {
+            id: "3",
+            selectExpr: {
+              operand: {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              field: "listB",
+            },
+          },
+          args: [
+            { id: "5", identExpr: { name: "b" } },
+            {
+              id: "7",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "6", identExpr: { name: "b" } },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        {
+                          id: "10",
+                          selectExpr: {
+                            operand: {
+                              id: "9",
+                              selectExpr: {
+                                operand: {
+                                  id: "8",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "listA",
+                          },
+                        },
+                        { id: "12", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 38,
+          function: "all",
+          target: {
+            id: "22",
+            selectExpr: {
+              operand: {
+                id: "21",
+                selectExpr: {
+                  operand: { id: "20", identExpr: { name: "msg" } },
+                  field: "single_any",
+                },
+              },
+              field: "listA",
+            },
+          },
+          args: [
+            { id: "24", identExpr: { name: "a" } },
+            {
+              id: "26",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "25", identExpr: { name: "a" } },
+                  {
+                    id: "30",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        {
+                          id: "29",
+                          selectExpr: {
+                            operand: {
+                              id: "28",
+                              selectExpr: {
+                                operand: {
+                                  id: "27",
+                                  identExpr: { name: "msg" },
+                                },
+                                field: "single_any",
+                              },
+                            },
+                            field: "listB",
+                          },
+                        },
+                        { id: "31", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  _||_(\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        b,\n        // Target\n        msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listB~dyn,\n        // Accumulator\n        @result,\n        // Init\n        true~bool,\n        // LoopCondition\n        @not_strictly_false(\n          @result~bool^@result\n        )~bool^not_strictly_false,\n        // LoopStep\n        _\u0026\u0026_(\n          @result~bool^@result,\n          _==_(\n            b~dyn^b,\n            _[_](\n              msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listA~dyn,\n              0~int\n            )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value\n          )~bool^equals\n        )~bool^logical_and,\n        // Result\n        @result~bool^@result)~bool,\n      __comprehension__(\n        // Variable\n        a,\n        // Target\n        msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listA~dyn,\n        // Accumulator\n        @result,\n        // Init\n        true~bool,\n        // LoopCondition\n        @not_strictly_false(\n          @result~bool^@result\n        )~bool^not_strictly_false,\n        // LoopStep\n        _\u0026\u0026_(\n          @result~bool^@result,\n          _==_(\n            a~dyn^a,\n            _[_](\n              msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listB~dyn,\n              0~int\n            )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value\n          )~bool^equals\n        )~bool^logical_and,\n        // Result\n        @result~bool^@result)~bool\n    )~bool^logical_and,\n    _==_(\n      msg~google.expr.proto3.test.TestAllTypes^msg.single_any~any.listA~dyn.size()~int^bytes_size|list_size|map_size|string_size,\n      0~int\n    )~bool^equals\n  )~bool^logical_or,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "msg" },
+          "6": { name: "b" },
+          "7": { overloadId: ["equals"] },
+          "8": { name: "msg" },
+          "11": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "14": { name: "@result" },
+          "15": { overloadId: ["not_strictly_false"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_and"] },
+          "18": { name: "@result" },
+          "20": { name: "msg" },
+          "25": { name: "a" },
+          "26": { overloadId: ["equals"] },
+          "27": { name: "msg" },
+          "30": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "33": { name: "@result" },
+          "34": { overloadId: ["not_strictly_false"] },
+          "35": { name: "@result" },
+          "36": { overloadId: ["logical_and"] },
+          "37": { name: "@result" },
+          "39": { overloadId: ["logical_and"] },
+          "40": { name: "msg" },
+          "43": {
+            overloadId: ["bytes_size", "list_size", "map_size", "string_size"],
+          },
+          "44": { overloadId: ["equals"] },
+          "46": { overloadId: ["logical_or"] },
+          "48": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wellKnown: "ANY" },
+          "3": { dyn: {} },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "9": { wellKnown: "ANY" },
+          "10": { dyn: {} },
+          "11": { dyn: {} },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "21": { wellKnown: "ANY" },
+          "22": { dyn: {} },
+          "25": { dyn: {} },
+          "26": { primitive: "BOOL" },
+          "27": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "28": { wellKnown: "ANY" },
+          "29": { dyn: {} },
+          "30": { dyn: {} },
+          "31": { primitive: "INT64" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "BOOL" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "BOOL" },
+          "36": { primitive: "BOOL" },
+          "37": { primitive: "BOOL" },
+          "38": { primitive: "BOOL" },
+          "39": { primitive: "BOOL" },
+          "40": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "41": { wellKnown: "ANY" },
+          "42": { dyn: {} },
+          "43": { primitive: "INT64" },
+          "44": { primitive: "BOOL" },
+          "45": { primitive: "INT64" },
+          "46": { primitive: "BOOL" },
+          "47": { primitive: "BOOL" },
+          "48": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63, 132, 176, 189],
+          positions: {
+            "1": 2,
+            "2": 5,
+            "3": 16,
+            "5": 27,
+            "6": 30,
+            "7": 32,
+            "8": 35,
+            "9": 38,
+            "10": 49,
+            "11": 55,
+            "12": 56,
+            "13": 26,
+            "14": 26,
+            "15": 26,
+            "16": 26,
+            "17": 26,
+            "18": 26,
+            "19": 26,
+            "20": 70,
+            "21": 73,
+            "22": 84,
+            "24": 95,
+            "25": 98,
+            "26": 100,
+            "27": 103,
+            "28": 106,
+            "29": 117,
+            "30": 123,
+            "31": 124,
+            "32": 94,
+            "33": 94,
+            "34": 94,
+            "35": 94,
+            "36": 94,
+            "37": 94,
+            "38": 94,
+            "39": 60,
+            "40": 139,
+            "41": 142,
+            "42": 153,
+            "43": 164,
+            "44": 167,
+            "45": 170,
+            "46": 129,
+            "47": 183,
+            "48": 173,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "3",
+                  selectExpr: {
+                    operand: {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "listB",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "5", identExpr: { name: "b" } },
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "6", identExpr: { name: "b" } },
+                        {
+                          id: "11",
+                          callExpr: {
+                            function: "_[_]",
+                            args: [
+                              {
+                                id: "10",
+                                selectExpr: {
+                                  operand: {
+                                    id: "9",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "8",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listA",
+                                },
+                              },
+                              { id: "12", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: {
+                  id: "22",
+                  selectExpr: {
+                    operand: {
+                      id: "21",
+                      selectExpr: {
+                        operand: { id: "20", identExpr: { name: "msg" } },
+                        field: "single_any",
+                      },
+                    },
+                    field: "listA",
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "24", identExpr: { name: "a" } },
+                  {
+                    id: "26",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "25", identExpr: { name: "a" } },
+                        {
+                          id: "30",
+                          callExpr: {
+                            function: "_[_]",
+                            args: [
+                              {
+                                id: "29",
+                                selectExpr: {
+                                  operand: {
+                                    id: "28",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "27",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listB",
+                                },
+                              },
+                              { id: "31", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "48",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "46",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "39",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "19",
+                            comprehensionExpr: {
+                              iterVar: "b",
+                              iterRange: {
+                                id: "3",
+                                selectExpr: {
+                                  operand: {
+                                    id: "2",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "1",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listB",
+                                },
+                              },
+                              accuVar: "@result",
+                              accuInit: {
+                                id: "13",
+                                constExpr: { boolValue: true },
+                              },
+                              loopCondition: {
+                                id: "15",
+                                callExpr: {
+                                  function: "@not_strictly_false",
+                                  args: [
+                                    {
+                                      id: "14",
+                                      identExpr: { name: "@result" },
+                                    },
+                                  ],
+                                },
+                              },
+                              loopStep: {
+                                id: "17",
+                                callExpr: {
+                                  function: "_\u0026\u0026_",
+                                  args: [
+                                    {
+                                      id: "16",
+                                      identExpr: { name: "@result" },
+                                    },
+                                    {
+                                      id: "7",
+                                      callExpr: {
+                                        function: "_==_",
+                                        args: [
+                                          { id: "6", identExpr: { name: "b" } },
+                                          {
+                                            id: "11",
+                                            callExpr: {
+                                              function: "_[_]",
+                                              args: [
+                                                {
+                                                  id: "10",
+                                                  selectExpr: {
+                                                    operand: {
+                                                      id: "9",
+                                                      selectExpr: {
+                                                        operand: {
+                                                          id: "8",
+                                                          identExpr: {
+                                                            name: "msg",
+                                                          },
+                                                        },
+                                                        field: "single_any",
+                                                      },
+                                                    },
+                                                    field: "listA",
+                                                  },
+                                                },
+                                                {
+                                                  id: "12",
+                                                  constExpr: {
+                                                    int64Value: "0",
+                                                  },
+                                                },
+                                              ],
+                                            },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              result: {
+                                id: "18",
+                                identExpr: { name: "@result" },
+                              },
+                            },
+                          },
+                          {
+                            id: "38",
+                            comprehensionExpr: {
+                              iterVar: "a",
+                              iterRange: {
+                                id: "22",
+                                selectExpr: {
+                                  operand: {
+                                    id: "21",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "20",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listA",
+                                },
+                              },
+                              accuVar: "@result",
+                              accuInit: {
+                                id: "32",
+                                constExpr: { boolValue: true },
+                              },
+                              loopCondition: {
+                                id: "34",
+                                callExpr: {
+                                  function: "@not_strictly_false",
+                                  args: [
+                                    {
+                                      id: "33",
+                                      identExpr: { name: "@result" },
+                                    },
+                                  ],
+                                },
+                              },
+                              loopStep: {
+                                id: "36",
+                                callExpr: {
+                                  function: "_\u0026\u0026_",
+                                  args: [
+                                    {
+                                      id: "35",
+                                      identExpr: { name: "@result" },
+                                    },
+                                    {
+                                      id: "26",
+                                      callExpr: {
+                                        function: "_==_",
+                                        args: [
+                                          {
+                                            id: "25",
+                                            identExpr: { name: "a" },
+                                          },
+                                          {
+                                            id: "30",
+                                            callExpr: {
+                                              function: "_[_]",
+                                              args: [
+                                                {
+                                                  id: "29",
+                                                  selectExpr: {
+                                                    operand: {
+                                                      id: "28",
+                                                      selectExpr: {
+                                                        operand: {
+                                                          id: "27",
+                                                          identExpr: {
+                                                            name: "msg",
+                                                          },
+                                                        },
+                                                        field: "single_any",
+                                                      },
+                                                    },
+                                                    field: "listB",
+                                                  },
+                                                },
+                                                {
+                                                  id: "31",
+                                                  constExpr: {
+                                                    int64Value: "0",
+                                                  },
+                                                },
+                                              ],
+                                            },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              result: {
+                                id: "37",
+                                identExpr: { name: "@result" },
+                              },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "44",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "43",
+                            callExpr: {
+                              target: {
+                                id: "42",
+                                selectExpr: {
+                                  operand: {
+                                    id: "41",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "40",
+                                        identExpr: { name: "msg" },
+                                      },
+                                      field: "single_any",
+                                    },
+                                  },
+                                  field: "listA",
+                                },
+                              },
+                              function: "size",
+                            },
+                          },
+                          { id: "45", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "47", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "18446744073709551615" },
+      inlinedAst:
+        "_||_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    listA,\n    // Init\n    [\n      1~int,\n      1~int\n    ]~list(int),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    listA~list(int)^listA,\n    // Result\n    _||_(\n      __comprehension__(\n        // Variable\n        #unused,\n        // Target\n        []~list(dyn),\n        // Accumulator\n        listB,\n        // Init\n        [\n          1~int,\n          1~int,\n          1~int\n        ]~list(int),\n        // LoopCondition\n        false~bool,\n        // LoopStep\n        listB~list(int)^listB,\n        // Result\n        _\u0026\u0026_(\n          __comprehension__(\n            // Variable\n            b,\n            // Target\n            listB~list(int)^listB,\n            // Accumulator\n            @result,\n            // Init\n            true~bool,\n            // LoopCondition\n            @not_strictly_false(\n              @result~bool^@result\n            )~bool^not_strictly_false,\n            // LoopStep\n            _\u0026\u0026_(\n              @result~bool^@result,\n              _==_(\n                b~int^b,\n                _[_](\n                  listA~list(int)^listA,\n                  0~int\n                )~int^index_list\n              )~bool^equals\n            )~bool^logical_and,\n            // Result\n            @result~bool^@result)~bool,\n          __comprehension__(\n            // Variable\n            a,\n            // Target\n            listA~list(int)^listA,\n            // Accumulator\n            @result,\n            // Init\n            true~bool,\n            // LoopCondition\n            @not_strictly_false(\n              @result~bool^@result\n            )~bool^not_strictly_false,\n            // LoopStep\n            _\u0026\u0026_(\n              @result~bool^@result,\n              _==_(\n                a~int^a,\n                _[_](\n                  listB~list(int)^listB,\n                  0~int\n                )~int^index_list\n              )~bool^equals\n            )~bool^logical_and,\n            // Result\n            @result~bool^@result)~bool\n        )~bool^logical_and)~bool,\n      _==_(\n        listA~list(int)^listA.size()~int^list_size,\n        0~int\n      )~bool^equals\n    )~bool^logical_or)~bool,\n  false~bool\n)~bool^logical_or",
+      inlined:
+        "cel.bind(listA, [1, 1], cel.bind(listB, [1, 1, 1], listB.all(b, b == listA[0]) \u0026\u0026\nlistA.all(a, a == listB[0])) || listA.size() == 0) || false",
+      foldedAst: "true~bool",
+      folded: "true",
+      expectedInlined:
+        "cel.bind(listA, [1, 1], cel.bind(listB, [1, 1, 1], listB.all(b, b == listA[0]) \u0026\u0026\nlistA.all(a, a == listB[0])) || listA.size() == 0) || false",
+      expectedFolded: "true",
+    },
+    {
+      original: {
+        expr: "has(m.child) \u0026\u0026 has(m.child.payload)",
+        checkOnly: true,
+        typeEnv: [
+          {
+            name: "m",
+            ident: {
+              type: {
+                messageType: "google.expr.proto3.test.NestedTestAllTypes",
+              },
+            },
+          },
+          {
+            name: "m_view",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.NestedTestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+        ],
+        container: "google.expr",
+      },
+      section: "TestInliningOptimizerMultiStage",
+      optionalSyntax: true,
+      inlineVariables: [
+        { name: "m.child", alias: "child", expr: "m_view.nested.child" },
+      ],
+      ast: "_\u0026\u0026_(\n  m^#*expr.Expr_IdentExpr#.child~test-only~^#*expr.Expr_SelectExpr#,\n  m^#*expr.Expr_IdentExpr#.child^#*expr.Expr_SelectExpr#.payload~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(m.child) \u0026\u0026 has(m.child.payload)",
+      locationAst:
+        "_\u0026\u0026_(\n  m^#2[1,4]#.child~test-only~^#4[1,3]#,\n  m^#6[1,20]#.child^#7[1,21]#.payload~test-only~^#9[1,19]#\n)^#10[1,13]#",
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 19, 19, 1, 19, 1, 19],
+        [10, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "m" } },
+                  field: "child",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "9",
+                selectExpr: {
+                  operand: {
+                    id: "7",
+                    selectExpr: {
+                      operand: { id: "6", identExpr: { name: "m" } },
+                      field: "child",
+                    },
+                  },
+                  field: "payload",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "6": 20,
+            "7": 21,
+            "8": 27,
+            "9": 19,
+            "10": 13,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "m" } },
+                      field: "child",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: { id: "6", identExpr: { name: "m" } },
+                          field: "child",
+                        },
+                      },
+                      field: "payload",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "m" } },
+                field: "child",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: {
+                  id: "7",
+                  selectExpr: {
+                    operand: { id: "6", identExpr: { name: "m" } },
+                    field: "child",
+                  },
+                },
+                field: "payload",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_\u0026\u0026_(\n  m~google.expr.proto3.test.NestedTestAllTypes^m.child~test-only~~bool,\n  m~google.expr.proto3.test.NestedTestAllTypes^m.child~google.expr.proto3.test.NestedTestAllTypes.payload~test-only~~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "m" },
+          "6": { name: "m" },
+          "10": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "2": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "4": { primitive: "BOOL" },
+          "6": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "7": { messageType: "google.expr.proto3.test.NestedTestAllTypes" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "6": 20,
+            "7": 21,
+            "8": 27,
+            "9": 19,
+            "10": 13,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "m" } },
+                      field: "child",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: { id: "6", identExpr: { name: "m" } },
+                          field: "child",
+                        },
+                      },
+                      field: "payload",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "m" } },
+                  field: "child",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "9",
+                selectExpr: {
+                  operand: {
+                    id: "7",
+                    selectExpr: {
+                      operand: { id: "6", identExpr: { name: "m" } },
+                      field: "child",
+                    },
+                  },
+                  field: "payload",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      inlinedAst:
+        "_\u0026\u0026_(\n  m_view~map(string, google.expr.proto3.test.NestedTestAllTypes)^m_view.nested~google.expr.proto3.test.NestedTestAllTypes.child~test-only~~bool,\n  m_view~map(string, google.expr.proto3.test.NestedTestAllTypes)^m_view.nested~google.expr.proto3.test.NestedTestAllTypes.child~google.expr.proto3.test.NestedTestAllTypes.payload~test-only~~bool\n)~bool^logical_and",
+      inlined:
+        "has(m_view.nested.child) \u0026\u0026 has(m_view.nested.child.payload)",
+      foldedAst:
+        "_\u0026\u0026_(\n  m_view~map(string, google.expr.proto3.test.NestedTestAllTypes)^m_view.nested~google.expr.proto3.test.NestedTestAllTypes.child~test-only~~bool,\n  m_view~map(string, google.expr.proto3.test.NestedTestAllTypes)^m_view.nested~google.expr.proto3.test.NestedTestAllTypes.child~google.expr.proto3.test.NestedTestAllTypes.payload~test-only~~bool\n)~bool^logical_and",
+      folded:
+        "has(m_view.nested.child) \u0026\u0026 has(m_view.nested.child.payload)",
+      expectedInlined:
+        "has(m_view.nested.child) \u0026\u0026 has(m_view.nested.child.payload)",
+      expectedFolded:
+        "has(m_view.nested.child) \u0026\u0026 has(m_view.nested.child.payload)",
+    },
+  ],
+} as const;
@@ -0,0 +1,22934 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/interpreter/interpreter_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "interpreter",
+  tests: [
+    {
+      original: {
+        name: "double_ne_nan",
+        expr: "0.0/0.0 == 0.0/0.0",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_==_(\n  _/_(\n    0^#*expr.Constant_DoubleValue#,\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _/_(\n    0^#*expr.Constant_DoubleValue#,\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "0.0 / 0.0 == 0.0 / 0.0",
+      locationAst:
+        "_==_(\n  _/_(\n    0^#1[1,0]#,\n    0^#3[1,4]#\n  )^#2[1,3]#,\n  _/_(\n    0^#5[1,11]#,\n    0^#7[1,15]#\n  )^#6[1,14]#\n)^#4[1,8]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 4, 7, 1, 4, 1, 7],
+        [4, 8, 10, 1, 8, 1, 10],
+        [5, 11, 14, 1, 11, 1, 14],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 15, 18, 1, 15, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "1", constExpr: { doubleValue: 0 } },
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                    { id: "7", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "double_ne_nan",
+          lineOffsets: [19],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 4,
+            "4": 8,
+            "5": 11,
+            "6": 14,
+            "7": 15,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _/_(\n    0~double,\n    0~double\n  )~double^divide_double,\n  _/_(\n    0~double,\n    0~double\n  )~double^divide_double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["divide_double"] },
+          "4": { overloadId: ["equals"] },
+          "6": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "DOUBLE" },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "double_ne_nan",
+          lineOffsets: [19],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 4,
+            "4": 8,
+            "5": 11,
+            "6": 14,
+            "7": 15,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "1", constExpr: { doubleValue: 0 } },
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                    { id: "7", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "and_false_1st",
+        expr: "false \u0026\u0026 true",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  false^#*expr.Constant_BoolValue#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false \u0026\u0026 true",
+      locationAst:
+        "_\u0026\u0026_(\n  false^#1[1,0]#,\n  true^#2[1,9]#\n)^#3[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 13, 1, 9, 1, 13],
+        [3, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and_false_1st",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  false~bool,\n  true~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_and"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "and_false_1st",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+    },
+    {
+      original: {
+        name: "and_false_2nd",
+        expr: "true \u0026\u0026 false",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 false",
+      locationAst:
+        "_\u0026\u0026_(\n  true^#1[1,0]#,\n  false^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and_false_2nd",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  true~bool,\n  false~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_and"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "and_false_2nd",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+    },
+    {
+      original: {
+        name: "and_error_1st_false",
+        expr: "1/0 != 0 \u0026\u0026 false",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  _!=_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 / 0 != 0 \u0026\u0026 false",
+      locationAst:
+        "_\u0026\u0026_(\n  _!=_(\n    _/_(\n      1^#1[1,0]#,\n      0^#3[1,2]#\n    )^#2[1,1]#,\n    0^#5[1,7]#\n  )^#4[1,4]#,\n  false^#6[1,12]#\n)^#7[1,9]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 6, 1, 4, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 12, 17, 1, 12, 1, 17],
+        [7, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and_error_1st_false",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 12,
+            "7": 9,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _!=_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^not_equals,\n  false~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["divide_int64"] },
+          "4": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "and_error_1st_false",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 12,
+            "7": 9,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "and_error_2nd_false",
+        expr: "false \u0026\u0026 1/0 != 0",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  false^#*expr.Constant_BoolValue#,\n  _!=_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false \u0026\u0026 1 / 0 != 0",
+      locationAst:
+        "_\u0026\u0026_(\n  false^#1[1,0]#,\n  _!=_(\n    _/_(\n      1^#2[1,9]#,\n      0^#4[1,11]#\n    )^#3[1,10]#,\n    0^#6[1,16]#\n  )^#5[1,13]#\n)^#7[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 13, 15, 1, 13, 1, 15],
+        [6, 16, 17, 1, 16, 1, 17],
+        [7, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and_error_2nd_false",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 11,
+            "5": 13,
+            "6": 16,
+            "7": 6,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  false~bool,\n  _!=_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^not_equals\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["divide_int64"] },
+          "5": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "and_error_2nd_false",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 11,
+            "5": 13,
+            "6": 16,
+            "7": 6,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "2" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+    },
+    {
+      original: {
+        name: "and_error_1st_error",
+        expr: "1/0 != 0 \u0026\u0026 true",
+        evalError: { errors: [{ message: "division by zero" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  _!=_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 / 0 != 0 \u0026\u0026 true",
+      locationAst:
+        "_\u0026\u0026_(\n  _!=_(\n    _/_(\n      1^#1[1,0]#,\n      0^#3[1,2]#\n    )^#2[1,1]#,\n    0^#5[1,7]#\n  )^#4[1,4]#,\n  true^#6[1,12]#\n)^#7[1,9]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 6, 1, 4, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 12, 16, 1, 12, 1, 16],
+        [7, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and_error_1st_error",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 12,
+            "7": 9,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _!=_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^not_equals,\n  true~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["divide_int64"] },
+          "4": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "and_error_1st_error",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 12,
+            "7": 9,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { error: { errors: [{ code: 2, message: "division by zero" }] } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "and_error_2nd_error",
+        expr: "true \u0026\u0026 1/0 != 0",
+        evalError: { errors: [{ message: "division by zero" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  true^#*expr.Constant_BoolValue#,\n  _!=_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 1 / 0 != 0",
+      locationAst:
+        "_\u0026\u0026_(\n  true^#1[1,0]#,\n  _!=_(\n    _/_(\n      1^#2[1,8]#,\n      0^#4[1,10]#\n    )^#3[1,9]#,\n    0^#6[1,15]#\n  )^#5[1,12]#\n)^#7[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 14, 1, 12, 1, 14],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and_error_2nd_error",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 10,
+            "5": 12,
+            "6": 15,
+            "7": 5,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  true~bool,\n  _!=_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^not_equals\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["divide_int64"] },
+          "5": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "and_error_2nd_error",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 10,
+            "5": 12,
+            "6": 15,
+            "7": 5,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "2" },
+      result: { error: { errors: [{ code: 2, message: "division by zero" }] } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "complex",
+        expr: '\n\t\t\t!(headers.ip in ["10.0.1.4", "10.0.1.5"]) \u0026\u0026\n\t\t\t\t((headers.path.startsWith("v1") \u0026\u0026 headers.token in ["v1", "v2", "admin"]) ||\n\t\t\t\t(headers.path.startsWith("v2") \u0026\u0026 headers.token in ["v2", "admin"]) ||\n\t\t\t\t(headers.path.startsWith("/admin") \u0026\u0026 headers.token == "admin" \u0026\u0026 headers.ip in ["10.0.1.2", "10.0.1.2", "10.0.1.2"]))\n\t\t\t',
+        typeEnv: [
+          {
+            name: "headers",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          headers: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "ip" },
+                    value: { stringValue: "10.0.1.2" },
+                  },
+                  {
+                    key: { stringValue: "path" },
+                    value: { stringValue: "/admin/edit" },
+                  },
+                  {
+                    key: { stringValue: "token" },
+                    value: { stringValue: "admin" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  !_(\n    @in(\n      headers^#*expr.Expr_IdentExpr#.ip^#*expr.Expr_SelectExpr#,\n      [\n        "10.0.1.4"^#*expr.Constant_StringValue#,\n        "10.0.1.5"^#*expr.Constant_StringValue#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    _\u0026\u0026_(\n      headers^#*expr.Expr_IdentExpr#.path^#*expr.Expr_SelectExpr#.startsWith(\n        "v1"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      @in(\n        headers^#*expr.Expr_IdentExpr#.token^#*expr.Expr_SelectExpr#,\n        [\n          "v1"^#*expr.Constant_StringValue#,\n          "v2"^#*expr.Constant_StringValue#,\n          "admin"^#*expr.Constant_StringValue#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      headers^#*expr.Expr_IdentExpr#.path^#*expr.Expr_SelectExpr#.startsWith(\n        "v2"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      @in(\n        headers^#*expr.Expr_IdentExpr#.token^#*expr.Expr_SelectExpr#,\n        [\n          "v2"^#*expr.Constant_StringValue#,\n          "admin"^#*expr.Constant_StringValue#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      headers^#*expr.Expr_IdentExpr#.path^#*expr.Expr_SelectExpr#.startsWith(\n        "/admin"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      _==_(\n        headers^#*expr.Expr_IdentExpr#.token^#*expr.Expr_SelectExpr#,\n        "admin"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      @in(\n        headers^#*expr.Expr_IdentExpr#.ip^#*expr.Expr_SelectExpr#,\n        [\n          "10.0.1.2"^#*expr.Constant_StringValue#,\n          "10.0.1.2"^#*expr.Constant_StringValue#,\n          "10.0.1.2"^#*expr.Constant_StringValue#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '!(headers.ip in ["10.0.1.4", "10.0.1.5"]) \u0026\u0026 (headers.path.startsWith("v1") \u0026\u0026 headers.token in ["v1", "v2", "admin"] ||\nheaders.path.startsWith("v2") \u0026\u0026 headers.token in ["v2", "admin"])',
+      locationAst:
+        '_\u0026\u0026_(\n  !_(\n    @in(\n      headers^#2[2,5]#.ip^#3[2,12]#,\n      [\n        "10.0.1.4"^#6[2,20]#,\n        "10.0.1.5"^#7[2,32]#\n      ]^#5[2,19]#\n    )^#4[2,16]#\n  )^#1[2,3]#,\n  _||_(\n    _\u0026\u0026_(\n      headers^#8[3,6]#.path^#9[3,13]#.startsWith(\n        "v1"^#11[3,30]#\n      )^#10[3,29]#,\n      @in(\n        headers^#12[3,39]#.token^#13[3,46]#,\n        [\n          "v1"^#16[3,57]#,\n          "v2"^#17[3,63]#,\n          "admin"^#18[3,69]#\n        ]^#15[3,56]#\n      )^#14[3,53]#\n    )^#19[3,36]#,\n    _\u0026\u0026_(\n      headers^#20[4,5]#.path^#21[4,12]#.startsWith(\n        "v2"^#23[4,29]#\n      )^#22[4,28]#,\n      @in(\n        headers^#24[4,38]#.token^#25[4,45]#,\n        [\n          "v2"^#28[4,56]#,\n          "admin"^#29[4,62]#\n        ]^#27[4,55]#\n      )^#26[4,52]#\n    )^#30[4,35]#,\n    _\u0026\u0026_(\n      headers^#32[5,5]#.path^#33[5,12]#.startsWith(\n        "/admin"^#35[5,29]#\n      )^#34[5,28]#,\n      _==_(\n        headers^#36[5,42]#.token^#37[5,49]#,\n        "admin"^#39[5,59]#\n      )^#38[5,56]#,\n      @in(\n        headers^#41[5,70]#.ip^#42[5,77]#,\n        [\n          "10.0.1.2"^#45[5,85]#,\n          "10.0.1.2"^#46[5,97]#,\n          "10.0.1.2"^#47[5,109]#\n        ]^#44[5,84]#\n      )^#43[5,81]#\n    )^#40[5,39]#\n  )^#31[3,79]#\n)^#50[2,45]#',
+      positions: [
+        [1, 4, 5, 2, 3, 2, 4],
+        [2, 6, 13, 2, 5, 2, 12],
+        [3, 13, 14, 2, 12, 2, 13],
+        [4, 17, 19, 2, 16, 2, 18],
+        [5, 20, 21, 2, 19, 2, 20],
+        [6, 21, 31, 2, 20, 2, 30],
+        [7, 33, 43, 2, 32, 2, 42],
+        [8, 55, 62, 3, 6, 3, 13],
+        [9, 62, 63, 3, 13, 3, 14],
+        [10, 78, 79, 3, 29, 3, 30],
+        [11, 79, 83, 3, 30, 3, 34],
+        [12, 88, 95, 3, 39, 3, 46],
+        [13, 95, 96, 3, 46, 3, 47],
+        [14, 102, 104, 3, 53, 3, 55],
+        [15, 105, 106, 3, 56, 3, 57],
+        [16, 106, 110, 3, 57, 3, 61],
+        [17, 112, 116, 3, 63, 3, 67],
+        [18, 118, 125, 3, 69, 3, 76],
+        [19, 85, 87, 3, 36, 3, 38],
+        [20, 136, 143, 4, 5, 4, 12],
+        [21, 143, 144, 4, 12, 4, 13],
+        [22, 159, 160, 4, 28, 4, 29],
+        [23, 160, 164, 4, 29, 4, 33],
+        [24, 169, 176, 4, 38, 4, 45],
+        [25, 176, 177, 4, 45, 4, 46],
+        [26, 183, 185, 4, 52, 4, 54],
+        [27, 186, 187, 4, 55, 4, 56],
+        [28, 187, 191, 4, 56, 4, 60],
+        [29, 193, 200, 4, 62, 4, 69],
+        [30, 166, 168, 4, 35, 4, 37],
+        [31, 128, 130, 3, 79, 3, 81],
+        [32, 211, 218, 5, 5, 5, 12],
+        [33, 218, 219, 5, 12, 5, 13],
+        [34, 234, 235, 5, 28, 5, 29],
+        [35, 235, 243, 5, 29, 5, 37],
+        [36, 248, 255, 5, 42, 5, 49],
+        [37, 255, 256, 5, 49, 5, 50],
+        [38, 262, 264, 5, 56, 5, 58],
+        [39, 265, 272, 5, 59, 5, 66],
+        [40, 245, 247, 5, 39, 5, 41],
+        [41, 276, 283, 5, 70, 5, 77],
+        [42, 283, 284, 5, 77, 5, 78],
+        [43, 287, 289, 5, 81, 5, 83],
+        [44, 290, 291, 5, 84, 5, 85],
+        [45, 291, 301, 5, 85, 5, 95],
+        [46, 303, 313, 5, 97, 5, 107],
+        [47, 315, 325, 5, 109, 5, 119],
+        [48, 273, 275, 5, 67, 5, 69],
+        [49, 203, 205, 4, 72, 4, 74],
+        [50, 46, 48, 2, 45, 2, 47],
+      ],
+      lineOffsets: [1, 49, 131, 206, 329, 333],
+      parsedExpr: {
+        expr: {
+          id: "50",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          {
+                            id: "3",
+                            selectExpr: {
+                              operand: {
+                                id: "2",
+                                identExpr: { name: "headers" },
+                              },
+                              field: "ip",
+                            },
+                          },
+                          {
+                            id: "5",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "6",
+                                  constExpr: { stringValue: "10.0.1.4" },
+                                },
+                                {
+                                  id: "7",
+                                  constExpr: { stringValue: "10.0.1.5" },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "31",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "10",
+                            callExpr: {
+                              target: {
+                                id: "9",
+                                selectExpr: {
+                                  operand: {
+                                    id: "8",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                { id: "11", constExpr: { stringValue: "v1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "14",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "13",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "12",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "15",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "16",
+                                        constExpr: { stringValue: "v1" },
+                                      },
+                                      {
+                                        id: "17",
+                                        constExpr: { stringValue: "v2" },
+                                      },
+                                      {
+                                        id: "18",
+                                        constExpr: { stringValue: "admin" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "22",
+                            callExpr: {
+                              target: {
+                                id: "21",
+                                selectExpr: {
+                                  operand: {
+                                    id: "20",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                { id: "23", constExpr: { stringValue: "v2" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "26",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "25",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "24",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "27",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "28",
+                                        constExpr: { stringValue: "v2" },
+                                      },
+                                      {
+                                        id: "29",
+                                        constExpr: { stringValue: "admin" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "40",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "34",
+                            callExpr: {
+                              target: {
+                                id: "33",
+                                selectExpr: {
+                                  operand: {
+                                    id: "32",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                {
+                                  id: "35",
+                                  constExpr: { stringValue: "/admin" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "38",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "37",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "36",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "39",
+                                  constExpr: { stringValue: "admin" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "43",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "42",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "41",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "ip",
+                                  },
+                                },
+                                {
+                                  id: "44",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "45",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                      {
+                                        id: "46",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                      {
+                                        id: "47",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "complex",
+          lineOffsets: [1, 49, 131, 206, 329, 333],
+          positions: {
+            "1": 4,
+            "2": 6,
+            "3": 13,
+            "4": 17,
+            "5": 20,
+            "6": 21,
+            "7": 33,
+            "8": 55,
+            "9": 62,
+            "10": 78,
+            "11": 79,
+            "12": 88,
+            "13": 95,
+            "14": 102,
+            "15": 105,
+            "16": 106,
+            "17": 112,
+            "18": 118,
+            "19": 85,
+            "20": 136,
+            "21": 143,
+            "22": 159,
+            "23": 160,
+            "24": 169,
+            "25": 176,
+            "26": 183,
+            "27": 186,
+            "28": 187,
+            "29": 193,
+            "30": 166,
+            "31": 128,
+            "32": 211,
+            "33": 218,
+            "34": 234,
+            "35": 235,
+            "36": 248,
+            "37": 255,
+            "38": 262,
+            "39": 265,
+            "40": 245,
+            "41": 276,
+            "42": 283,
+            "43": 287,
+            "44": 290,
+            "45": 291,
+            "46": 303,
+            "47": 315,
+            "48": 273,
+            "49": 203,
+            "50": 46,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  !_(\n    @in(\n      headers~map(string, string)^headers.ip~string,\n      [\n        "10.0.1.4"~string,\n        "10.0.1.5"~string\n      ]~list(string)\n    )~bool^in_list\n  )~bool^logical_not,\n  _||_(\n    _\u0026\u0026_(\n      headers~map(string, string)^headers.path~string.startsWith(\n        "v1"~string\n      )~bool^starts_with_string,\n      @in(\n        headers~map(string, string)^headers.token~string,\n        [\n          "v1"~string,\n          "v2"~string,\n          "admin"~string\n        ]~list(string)\n      )~bool^in_list\n    )~bool^logical_and,\n    _\u0026\u0026_(\n      headers~map(string, string)^headers.path~string.startsWith(\n        "v2"~string\n      )~bool^starts_with_string,\n      @in(\n        headers~map(string, string)^headers.token~string,\n        [\n          "v2"~string,\n          "admin"~string\n        ]~list(string)\n      )~bool^in_list\n    )~bool^logical_and,\n    _\u0026\u0026_(\n      headers~map(string, string)^headers.path~string.startsWith(\n        "/admin"~string\n      )~bool^starts_with_string,\n      _==_(\n        headers~map(string, string)^headers.token~string,\n        "admin"~string\n      )~bool^equals,\n      @in(\n        headers~map(string, string)^headers.ip~string,\n        [\n          "10.0.1.2"~string,\n          "10.0.1.2"~string,\n          "10.0.1.2"~string\n        ]~list(string)\n      )~bool^in_list\n    )~bool^logical_and\n  )~bool^logical_or\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "2": { name: "headers" },
+          "4": { overloadId: ["in_list"] },
+          "8": { name: "headers" },
+          "10": { overloadId: ["starts_with_string"] },
+          "12": { name: "headers" },
+          "14": { overloadId: ["in_list"] },
+          "19": { overloadId: ["logical_and"] },
+          "20": { name: "headers" },
+          "22": { overloadId: ["starts_with_string"] },
+          "24": { name: "headers" },
+          "26": { overloadId: ["in_list"] },
+          "30": { overloadId: ["logical_and"] },
+          "31": { overloadId: ["logical_or"] },
+          "32": { name: "headers" },
+          "34": { overloadId: ["starts_with_string"] },
+          "36": { name: "headers" },
+          "38": { overloadId: ["equals"] },
+          "40": { overloadId: ["logical_and"] },
+          "41": { name: "headers" },
+          "43": { overloadId: ["in_list"] },
+          "50": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "STRING" } } },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "STRING" },
+          "12": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "BOOL" },
+          "15": { listType: { elemType: { primitive: "STRING" } } },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "STRING" },
+          "19": { primitive: "BOOL" },
+          "20": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "21": { primitive: "STRING" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "STRING" },
+          "24": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "25": { primitive: "STRING" },
+          "26": { primitive: "BOOL" },
+          "27": { listType: { elemType: { primitive: "STRING" } } },
+          "28": { primitive: "STRING" },
+          "29": { primitive: "STRING" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "33": { primitive: "STRING" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "STRING" },
+          "36": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "37": { primitive: "STRING" },
+          "38": { primitive: "BOOL" },
+          "39": { primitive: "STRING" },
+          "40": { primitive: "BOOL" },
+          "41": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "42": { primitive: "STRING" },
+          "43": { primitive: "BOOL" },
+          "44": { listType: { elemType: { primitive: "STRING" } } },
+          "45": { primitive: "STRING" },
+          "46": { primitive: "STRING" },
+          "47": { primitive: "STRING" },
+          "50": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "complex",
+          lineOffsets: [1, 49, 131, 206, 329, 333],
+          positions: {
+            "1": 4,
+            "2": 6,
+            "3": 13,
+            "4": 17,
+            "5": 20,
+            "6": 21,
+            "7": 33,
+            "8": 55,
+            "9": 62,
+            "10": 78,
+            "11": 79,
+            "12": 88,
+            "13": 95,
+            "14": 102,
+            "15": 105,
+            "16": 106,
+            "17": 112,
+            "18": 118,
+            "19": 85,
+            "20": 136,
+            "21": 143,
+            "22": 159,
+            "23": 160,
+            "24": 169,
+            "25": 176,
+            "26": 183,
+            "27": 186,
+            "28": 187,
+            "29": 193,
+            "30": 166,
+            "31": 128,
+            "32": 211,
+            "33": 218,
+            "34": 234,
+            "35": 235,
+            "36": 248,
+            "37": 255,
+            "38": 262,
+            "39": 265,
+            "40": 245,
+            "41": 276,
+            "42": 283,
+            "43": 287,
+            "44": 290,
+            "45": 291,
+            "46": 303,
+            "47": 315,
+            "48": 273,
+            "49": 203,
+            "50": 46,
+          },
+        },
+        expr: {
+          id: "50",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          {
+                            id: "3",
+                            selectExpr: {
+                              operand: {
+                                id: "2",
+                                identExpr: { name: "headers" },
+                              },
+                              field: "ip",
+                            },
+                          },
+                          {
+                            id: "5",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "6",
+                                  constExpr: { stringValue: "10.0.1.4" },
+                                },
+                                {
+                                  id: "7",
+                                  constExpr: { stringValue: "10.0.1.5" },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "31",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "10",
+                            callExpr: {
+                              target: {
+                                id: "9",
+                                selectExpr: {
+                                  operand: {
+                                    id: "8",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                { id: "11", constExpr: { stringValue: "v1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "14",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "13",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "12",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "15",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "16",
+                                        constExpr: { stringValue: "v1" },
+                                      },
+                                      {
+                                        id: "17",
+                                        constExpr: { stringValue: "v2" },
+                                      },
+                                      {
+                                        id: "18",
+                                        constExpr: { stringValue: "admin" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "22",
+                            callExpr: {
+                              target: {
+                                id: "21",
+                                selectExpr: {
+                                  operand: {
+                                    id: "20",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                { id: "23", constExpr: { stringValue: "v2" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "26",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "25",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "24",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "27",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "28",
+                                        constExpr: { stringValue: "v2" },
+                                      },
+                                      {
+                                        id: "29",
+                                        constExpr: { stringValue: "admin" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "40",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "34",
+                            callExpr: {
+                              target: {
+                                id: "33",
+                                selectExpr: {
+                                  operand: {
+                                    id: "32",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                {
+                                  id: "35",
+                                  constExpr: { stringValue: "/admin" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "38",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "37",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "36",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "39",
+                                  constExpr: { stringValue: "admin" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "43",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "42",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "41",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "ip",
+                                  },
+                                },
+                                {
+                                  id: "44",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "45",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                      {
+                                        id: "46",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                      {
+                                        id: "47",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "15", max: "50" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "45",
+    },
+    {
+      original: {
+        name: "complex_qual_vars",
+        expr: '\n\t\t\t!(headers.ip in ["10.0.1.4", "10.0.1.5"]) \u0026\u0026\n\t\t\t\t((headers.path.startsWith("v1") \u0026\u0026 headers.token in ["v1", "v2", "admin"]) ||\n\t\t\t\t(headers.path.startsWith("v2") \u0026\u0026 headers.token in ["v2", "admin"]) ||\n\t\t\t\t(headers.path.startsWith("/admin") \u0026\u0026 headers.token == "admin" \u0026\u0026 headers.ip in ["10.0.1.2", "10.0.1.2", "10.0.1.2"]))\n\t\t\t',
+        typeEnv: [
+          { name: "headers.ip", ident: { type: { primitive: "STRING" } } },
+          { name: "headers.path", ident: { type: { primitive: "STRING" } } },
+          { name: "headers.token", ident: { type: { primitive: "STRING" } } },
+        ],
+        bindings: {
+          "headers.ip": { value: { stringValue: "10.0.1.2" } },
+          "headers.path": { value: { stringValue: "/admin/edit" } },
+          "headers.token": { value: { stringValue: "admin" } },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  !_(\n    @in(\n      headers^#*expr.Expr_IdentExpr#.ip^#*expr.Expr_SelectExpr#,\n      [\n        "10.0.1.4"^#*expr.Constant_StringValue#,\n        "10.0.1.5"^#*expr.Constant_StringValue#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    _\u0026\u0026_(\n      headers^#*expr.Expr_IdentExpr#.path^#*expr.Expr_SelectExpr#.startsWith(\n        "v1"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      @in(\n        headers^#*expr.Expr_IdentExpr#.token^#*expr.Expr_SelectExpr#,\n        [\n          "v1"^#*expr.Constant_StringValue#,\n          "v2"^#*expr.Constant_StringValue#,\n          "admin"^#*expr.Constant_StringValue#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      headers^#*expr.Expr_IdentExpr#.path^#*expr.Expr_SelectExpr#.startsWith(\n        "v2"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      @in(\n        headers^#*expr.Expr_IdentExpr#.token^#*expr.Expr_SelectExpr#,\n        [\n          "v2"^#*expr.Constant_StringValue#,\n          "admin"^#*expr.Constant_StringValue#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      headers^#*expr.Expr_IdentExpr#.path^#*expr.Expr_SelectExpr#.startsWith(\n        "/admin"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      _==_(\n        headers^#*expr.Expr_IdentExpr#.token^#*expr.Expr_SelectExpr#,\n        "admin"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      @in(\n        headers^#*expr.Expr_IdentExpr#.ip^#*expr.Expr_SelectExpr#,\n        [\n          "10.0.1.2"^#*expr.Constant_StringValue#,\n          "10.0.1.2"^#*expr.Constant_StringValue#,\n          "10.0.1.2"^#*expr.Constant_StringValue#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '!(headers.ip in ["10.0.1.4", "10.0.1.5"]) \u0026\u0026 (headers.path.startsWith("v1") \u0026\u0026 headers.token in ["v1", "v2", "admin"] ||\nheaders.path.startsWith("v2") \u0026\u0026 headers.token in ["v2", "admin"])',
+      locationAst:
+        '_\u0026\u0026_(\n  !_(\n    @in(\n      headers^#2[2,5]#.ip^#3[2,12]#,\n      [\n        "10.0.1.4"^#6[2,20]#,\n        "10.0.1.5"^#7[2,32]#\n      ]^#5[2,19]#\n    )^#4[2,16]#\n  )^#1[2,3]#,\n  _||_(\n    _\u0026\u0026_(\n      headers^#8[3,6]#.path^#9[3,13]#.startsWith(\n        "v1"^#11[3,30]#\n      )^#10[3,29]#,\n      @in(\n        headers^#12[3,39]#.token^#13[3,46]#,\n        [\n          "v1"^#16[3,57]#,\n          "v2"^#17[3,63]#,\n          "admin"^#18[3,69]#\n        ]^#15[3,56]#\n      )^#14[3,53]#\n    )^#19[3,36]#,\n    _\u0026\u0026_(\n      headers^#20[4,5]#.path^#21[4,12]#.startsWith(\n        "v2"^#23[4,29]#\n      )^#22[4,28]#,\n      @in(\n        headers^#24[4,38]#.token^#25[4,45]#,\n        [\n          "v2"^#28[4,56]#,\n          "admin"^#29[4,62]#\n        ]^#27[4,55]#\n      )^#26[4,52]#\n    )^#30[4,35]#,\n    _\u0026\u0026_(\n      headers^#32[5,5]#.path^#33[5,12]#.startsWith(\n        "/admin"^#35[5,29]#\n      )^#34[5,28]#,\n      _==_(\n        headers^#36[5,42]#.token^#37[5,49]#,\n        "admin"^#39[5,59]#\n      )^#38[5,56]#,\n      @in(\n        headers^#41[5,70]#.ip^#42[5,77]#,\n        [\n          "10.0.1.2"^#45[5,85]#,\n          "10.0.1.2"^#46[5,97]#,\n          "10.0.1.2"^#47[5,109]#\n        ]^#44[5,84]#\n      )^#43[5,81]#\n    )^#40[5,39]#\n  )^#31[3,79]#\n)^#50[2,45]#',
+      positions: [
+        [1, 4, 5, 2, 3, 2, 4],
+        [2, 6, 13, 2, 5, 2, 12],
+        [3, 13, 14, 2, 12, 2, 13],
+        [4, 17, 19, 2, 16, 2, 18],
+        [5, 20, 21, 2, 19, 2, 20],
+        [6, 21, 31, 2, 20, 2, 30],
+        [7, 33, 43, 2, 32, 2, 42],
+        [8, 55, 62, 3, 6, 3, 13],
+        [9, 62, 63, 3, 13, 3, 14],
+        [10, 78, 79, 3, 29, 3, 30],
+        [11, 79, 83, 3, 30, 3, 34],
+        [12, 88, 95, 3, 39, 3, 46],
+        [13, 95, 96, 3, 46, 3, 47],
+        [14, 102, 104, 3, 53, 3, 55],
+        [15, 105, 106, 3, 56, 3, 57],
+        [16, 106, 110, 3, 57, 3, 61],
+        [17, 112, 116, 3, 63, 3, 67],
+        [18, 118, 125, 3, 69, 3, 76],
+        [19, 85, 87, 3, 36, 3, 38],
+        [20, 136, 143, 4, 5, 4, 12],
+        [21, 143, 144, 4, 12, 4, 13],
+        [22, 159, 160, 4, 28, 4, 29],
+        [23, 160, 164, 4, 29, 4, 33],
+        [24, 169, 176, 4, 38, 4, 45],
+        [25, 176, 177, 4, 45, 4, 46],
+        [26, 183, 185, 4, 52, 4, 54],
+        [27, 186, 187, 4, 55, 4, 56],
+        [28, 187, 191, 4, 56, 4, 60],
+        [29, 193, 200, 4, 62, 4, 69],
+        [30, 166, 168, 4, 35, 4, 37],
+        [31, 128, 130, 3, 79, 3, 81],
+        [32, 211, 218, 5, 5, 5, 12],
+        [33, 218, 219, 5, 12, 5, 13],
+        [34, 234, 235, 5, 28, 5, 29],
+        [35, 235, 243, 5, 29, 5, 37],
+        [36, 248, 255, 5, 42, 5, 49],
+        [37, 255, 256, 5, 49, 5, 50],
+        [38, 262, 264, 5, 56, 5, 58],
+        [39, 265, 272, 5, 59, 5, 66],
+        [40, 245, 247, 5, 39, 5, 41],
+        [41, 276, 283, 5, 70, 5, 77],
+        [42, 283, 284, 5, 77, 5, 78],
+        [43, 287, 289, 5, 81, 5, 83],
+        [44, 290, 291, 5, 84, 5, 85],
+        [45, 291, 301, 5, 85, 5, 95],
+        [46, 303, 313, 5, 97, 5, 107],
+        [47, 315, 325, 5, 109, 5, 119],
+        [48, 273, 275, 5, 67, 5, 69],
+        [49, 203, 205, 4, 72, 4, 74],
+        [50, 46, 48, 2, 45, 2, 47],
+      ],
+      lineOffsets: [1, 49, 131, 206, 329, 333],
+      parsedExpr: {
+        expr: {
+          id: "50",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          {
+                            id: "3",
+                            selectExpr: {
+                              operand: {
+                                id: "2",
+                                identExpr: { name: "headers" },
+                              },
+                              field: "ip",
+                            },
+                          },
+                          {
+                            id: "5",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "6",
+                                  constExpr: { stringValue: "10.0.1.4" },
+                                },
+                                {
+                                  id: "7",
+                                  constExpr: { stringValue: "10.0.1.5" },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "31",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "10",
+                            callExpr: {
+                              target: {
+                                id: "9",
+                                selectExpr: {
+                                  operand: {
+                                    id: "8",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                { id: "11", constExpr: { stringValue: "v1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "14",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "13",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "12",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "15",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "16",
+                                        constExpr: { stringValue: "v1" },
+                                      },
+                                      {
+                                        id: "17",
+                                        constExpr: { stringValue: "v2" },
+                                      },
+                                      {
+                                        id: "18",
+                                        constExpr: { stringValue: "admin" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "22",
+                            callExpr: {
+                              target: {
+                                id: "21",
+                                selectExpr: {
+                                  operand: {
+                                    id: "20",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                { id: "23", constExpr: { stringValue: "v2" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "26",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "25",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "24",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "27",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "28",
+                                        constExpr: { stringValue: "v2" },
+                                      },
+                                      {
+                                        id: "29",
+                                        constExpr: { stringValue: "admin" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "40",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "34",
+                            callExpr: {
+                              target: {
+                                id: "33",
+                                selectExpr: {
+                                  operand: {
+                                    id: "32",
+                                    identExpr: { name: "headers" },
+                                  },
+                                  field: "path",
+                                },
+                              },
+                              function: "startsWith",
+                              args: [
+                                {
+                                  id: "35",
+                                  constExpr: { stringValue: "/admin" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "38",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "37",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "36",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "token",
+                                  },
+                                },
+                                {
+                                  id: "39",
+                                  constExpr: { stringValue: "admin" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "43",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "42",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "41",
+                                      identExpr: { name: "headers" },
+                                    },
+                                    field: "ip",
+                                  },
+                                },
+                                {
+                                  id: "44",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "45",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                      {
+                                        id: "46",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                      {
+                                        id: "47",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "complex_qual_vars",
+          lineOffsets: [1, 49, 131, 206, 329, 333],
+          positions: {
+            "1": 4,
+            "2": 6,
+            "3": 13,
+            "4": 17,
+            "5": 20,
+            "6": 21,
+            "7": 33,
+            "8": 55,
+            "9": 62,
+            "10": 78,
+            "11": 79,
+            "12": 88,
+            "13": 95,
+            "14": 102,
+            "15": 105,
+            "16": 106,
+            "17": 112,
+            "18": 118,
+            "19": 85,
+            "20": 136,
+            "21": 143,
+            "22": 159,
+            "23": 160,
+            "24": 169,
+            "25": 176,
+            "26": 183,
+            "27": 186,
+            "28": 187,
+            "29": 193,
+            "30": 166,
+            "31": 128,
+            "32": 211,
+            "33": 218,
+            "34": 234,
+            "35": 235,
+            "36": 248,
+            "37": 255,
+            "38": 262,
+            "39": 265,
+            "40": 245,
+            "41": 276,
+            "42": 283,
+            "43": 287,
+            "44": 290,
+            "45": 291,
+            "46": 303,
+            "47": 315,
+            "48": 273,
+            "49": 203,
+            "50": 46,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  !_(\n    @in(\n      headers.ip~string^headers.ip,\n      [\n        "10.0.1.4"~string,\n        "10.0.1.5"~string\n      ]~list(string)\n    )~bool^in_list\n  )~bool^logical_not,\n  _||_(\n    _\u0026\u0026_(\n      headers.path~string^headers.path.startsWith(\n        "v1"~string\n      )~bool^starts_with_string,\n      @in(\n        headers.token~string^headers.token,\n        [\n          "v1"~string,\n          "v2"~string,\n          "admin"~string\n        ]~list(string)\n      )~bool^in_list\n    )~bool^logical_and,\n    _\u0026\u0026_(\n      headers.path~string^headers.path.startsWith(\n        "v2"~string\n      )~bool^starts_with_string,\n      @in(\n        headers.token~string^headers.token,\n        [\n          "v2"~string,\n          "admin"~string\n        ]~list(string)\n      )~bool^in_list\n    )~bool^logical_and,\n    _\u0026\u0026_(\n      headers.path~string^headers.path.startsWith(\n        "/admin"~string\n      )~bool^starts_with_string,\n      _==_(\n        headers.token~string^headers.token,\n        "admin"~string\n      )~bool^equals,\n      @in(\n        headers.ip~string^headers.ip,\n        [\n          "10.0.1.2"~string,\n          "10.0.1.2"~string,\n          "10.0.1.2"~string\n        ]~list(string)\n      )~bool^in_list\n    )~bool^logical_and\n  )~bool^logical_or\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { name: "headers.ip" },
+          "4": { overloadId: ["in_list"] },
+          "9": { name: "headers.path" },
+          "10": { overloadId: ["starts_with_string"] },
+          "13": { name: "headers.token" },
+          "14": { overloadId: ["in_list"] },
+          "19": { overloadId: ["logical_and"] },
+          "21": { name: "headers.path" },
+          "22": { overloadId: ["starts_with_string"] },
+          "25": { name: "headers.token" },
+          "26": { overloadId: ["in_list"] },
+          "30": { overloadId: ["logical_and"] },
+          "31": { overloadId: ["logical_or"] },
+          "33": { name: "headers.path" },
+          "34": { overloadId: ["starts_with_string"] },
+          "37": { name: "headers.token" },
+          "38": { overloadId: ["equals"] },
+          "40": { overloadId: ["logical_and"] },
+          "42": { name: "headers.ip" },
+          "43": { overloadId: ["in_list"] },
+          "50": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "STRING" } } },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "STRING" },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "BOOL" },
+          "15": { listType: { elemType: { primitive: "STRING" } } },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "STRING" },
+          "19": { primitive: "BOOL" },
+          "21": { primitive: "STRING" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "STRING" },
+          "25": { primitive: "STRING" },
+          "26": { primitive: "BOOL" },
+          "27": { listType: { elemType: { primitive: "STRING" } } },
+          "28": { primitive: "STRING" },
+          "29": { primitive: "STRING" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "33": { primitive: "STRING" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "STRING" },
+          "37": { primitive: "STRING" },
+          "38": { primitive: "BOOL" },
+          "39": { primitive: "STRING" },
+          "40": { primitive: "BOOL" },
+          "42": { primitive: "STRING" },
+          "43": { primitive: "BOOL" },
+          "44": { listType: { elemType: { primitive: "STRING" } } },
+          "45": { primitive: "STRING" },
+          "46": { primitive: "STRING" },
+          "47": { primitive: "STRING" },
+          "50": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "complex_qual_vars",
+          lineOffsets: [1, 49, 131, 206, 329, 333],
+          positions: {
+            "1": 4,
+            "2": 6,
+            "3": 13,
+            "4": 17,
+            "5": 20,
+            "6": 21,
+            "7": 33,
+            "8": 55,
+            "9": 62,
+            "10": 78,
+            "11": 79,
+            "12": 88,
+            "13": 95,
+            "14": 102,
+            "15": 105,
+            "16": 106,
+            "17": 112,
+            "18": 118,
+            "19": 85,
+            "20": 136,
+            "21": 143,
+            "22": 159,
+            "23": 160,
+            "24": 169,
+            "25": 176,
+            "26": 183,
+            "27": 186,
+            "28": 187,
+            "29": 193,
+            "30": 166,
+            "31": 128,
+            "32": 211,
+            "33": 218,
+            "34": 234,
+            "35": 235,
+            "36": 248,
+            "37": 255,
+            "38": 262,
+            "39": 265,
+            "40": 245,
+            "41": 276,
+            "42": 283,
+            "43": 287,
+            "44": 290,
+            "45": 291,
+            "46": 303,
+            "47": 315,
+            "48": 273,
+            "49": 203,
+            "50": 46,
+          },
+        },
+        expr: {
+          id: "50",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          { id: "3", identExpr: { name: "headers.ip" } },
+                          {
+                            id: "5",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "6",
+                                  constExpr: { stringValue: "10.0.1.4" },
+                                },
+                                {
+                                  id: "7",
+                                  constExpr: { stringValue: "10.0.1.5" },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "31",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "19",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "10",
+                            callExpr: {
+                              target: {
+                                id: "9",
+                                identExpr: { name: "headers.path" },
+                              },
+                              function: "startsWith",
+                              args: [
+                                { id: "11", constExpr: { stringValue: "v1" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "14",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "13",
+                                  identExpr: { name: "headers.token" },
+                                },
+                                {
+                                  id: "15",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "16",
+                                        constExpr: { stringValue: "v1" },
+                                      },
+                                      {
+                                        id: "17",
+                                        constExpr: { stringValue: "v2" },
+                                      },
+                                      {
+                                        id: "18",
+                                        constExpr: { stringValue: "admin" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "22",
+                            callExpr: {
+                              target: {
+                                id: "21",
+                                identExpr: { name: "headers.path" },
+                              },
+                              function: "startsWith",
+                              args: [
+                                { id: "23", constExpr: { stringValue: "v2" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "26",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "25",
+                                  identExpr: { name: "headers.token" },
+                                },
+                                {
+                                  id: "27",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "28",
+                                        constExpr: { stringValue: "v2" },
+                                      },
+                                      {
+                                        id: "29",
+                                        constExpr: { stringValue: "admin" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "40",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "34",
+                            callExpr: {
+                              target: {
+                                id: "33",
+                                identExpr: { name: "headers.path" },
+                              },
+                              function: "startsWith",
+                              args: [
+                                {
+                                  id: "35",
+                                  constExpr: { stringValue: "/admin" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "38",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                {
+                                  id: "37",
+                                  identExpr: { name: "headers.token" },
+                                },
+                                {
+                                  id: "39",
+                                  constExpr: { stringValue: "admin" },
+                                },
+                              ],
+                            },
+                          },
+                          {
+                            id: "43",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                { id: "42", identExpr: { name: "headers.ip" } },
+                                {
+                                  id: "44",
+                                  listExpr: {
+                                    elements: [
+                                      {
+                                        id: "45",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                      {
+                                        id: "46",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                      {
+                                        id: "47",
+                                        constExpr: { stringValue: "10.0.1.2" },
+                                      },
+                                    ],
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "45" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "39",
+    },
+    {
+      original: {
+        name: "cond",
+        expr: "a ? b \u003c 1.2 : c == ['hello']",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "DOUBLE" } } },
+          {
+            name: "c",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { boolValue: true } },
+          b: { value: { doubleValue: 2 } },
+          c: { value: { listValue: { values: [{ stringValue: "hello" }] } } },
+        },
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  _\u003c_(\n    b^#*expr.Expr_IdentExpr#,\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    c^#*expr.Expr_IdentExpr#,\n    [\n      "hello"^#*expr.Constant_StringValue#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'a ? (b \u003c 1.2) : (c == ["hello"])',
+      locationAst:
+        '_?_:_(\n  a^#1[1,0]#,\n  _\u003c_(\n    b^#3[1,4]#,\n    1.2^#5[1,8]#\n  )^#4[1,6]#,\n  _==_(\n    c^#6[1,14]#,\n    [\n      "hello"^#9[1,20]#\n    ]^#8[1,19]#\n  )^#7[1,16]#\n)^#2[1,2]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 11, 1, 8, 1, 11],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 16, 18, 1, 16, 1, 18],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 20, 27, 1, 20, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "3", identExpr: { name: "b" } },
+                    { id: "5", constExpr: { doubleValue: 1.2 } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "6", identExpr: { name: "c" } },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { stringValue: "hello" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 14,
+            "7": 16,
+            "8": 19,
+            "9": 20,
+          },
+        },
+      },
+      checkedAst:
+        '_?_:_(\n  a~bool^a,\n  _\u003c_(\n    b~double^b,\n    1.2~double\n  )~bool^less_double,\n  _==_(\n    c~list(string)^c,\n    [\n      "hello"~string\n    ]~list(string)\n  )~bool^equals\n)~bool^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { overloadId: ["conditional"] },
+          "3": { name: "b" },
+          "4": { overloadId: ["less_double"] },
+          "6": { name: "c" },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "cond",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 14,
+            "7": 16,
+            "8": 19,
+            "9": 20,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "3", identExpr: { name: "b" } },
+                    { id: "5", constExpr: { doubleValue: 1.2 } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "6", identExpr: { name: "c" } },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { stringValue: "hello" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "13" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "cond_attr_out_of_bounds_error",
+        expr: "m[(x ? 0 : 1)] \u003e= 0",
+        typeEnv: [
+          {
+            name: "m",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          { name: "x", ident: { type: { primitive: "BOOL" } } },
+        ],
+        bindings: {
+          m: { value: { listValue: { values: [{ int64Value: "-1" }] } } },
+          x: { value: { boolValue: false } },
+        },
+        evalError: { errors: [{ message: "index out of bounds: 1" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u003e=_(\n  _[_](\n    m^#*expr.Expr_IdentExpr#,\n    _?_:_(\n      x^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "m[x ? 0 : 1] \u003e= 0",
+      locationAst:
+        "_\u003e=_(\n  _[_](\n    m^#1[1,0]#,\n    _?_:_(\n      x^#3[1,3]#,\n      0^#5[1,7]#,\n      1^#6[1,11]#\n    )^#4[1,5]#\n  )^#2[1,1]#,\n  0^#8[1,18]#\n)^#7[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 15, 17, 1, 15, 1, 17],
+        [8, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "1", identExpr: { name: "m" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_?_:_",
+                        args: [
+                          { id: "3", identExpr: { name: "x" } },
+                          { id: "5", constExpr: { int64Value: "0" } },
+                          { id: "6", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_attr_out_of_bounds_error",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 11,
+            "7": 15,
+            "8": 18,
+          },
+        },
+      },
+      checkedAst:
+        "_\u003e=_(\n  _[_](\n    m~list(int)^m,\n    _?_:_(\n      x~bool^x,\n      0~int,\n      1~int\n    )~int^conditional\n  )~int^index_list,\n  0~int\n)~bool^greater_equals_int64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "m" },
+          "2": { overloadId: ["index_list"] },
+          "3": { name: "x" },
+          "4": { overloadId: ["conditional"] },
+          "7": { overloadId: ["greater_equals_int64"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "cond_attr_out_of_bounds_error",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 11,
+            "7": 15,
+            "8": 18,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "1", identExpr: { name: "m" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_?_:_",
+                        args: [
+                          { id: "3", identExpr: { name: "x" } },
+                          { id: "5", constExpr: { int64Value: "0" } },
+                          { id: "6", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "4" },
+      result: {
+        error: { errors: [{ code: 2, message: "index out of bounds: 1" }] },
+      },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        name: "cond_attr_qualify_bad_type_error",
+        expr: "m[(x ? a : b)] \u003e= 0",
+        typeEnv: [
+          {
+            name: "m",
+            ident: { type: { listType: { elemType: { dyn: {} } } } },
+          },
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { dyn: {} } } },
+          { name: "x", ident: { type: { primitive: "BOOL" } } },
+        ],
+        bindings: {
+          a: {
+            value: {
+              objectValue: {
+                "@type": "type.googleapis.com/google.protobuf.Duration",
+                value: "0.001s",
+              },
+            },
+          },
+          b: {
+            value: {
+              objectValue: {
+                "@type": "type.googleapis.com/google.protobuf.Duration",
+                value: "0.001s",
+              },
+            },
+          },
+          m: { value: { listValue: { values: [{ int64Value: "1" }] } } },
+          x: { value: { boolValue: false } },
+        },
+        evalError: { errors: [{ message: "invalid qualifier type" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u003e=_(\n  _[_](\n    m^#*expr.Expr_IdentExpr#,\n    _?_:_(\n      x^#*expr.Expr_IdentExpr#,\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "m[x ? a : b] \u003e= 0",
+      locationAst:
+        "_\u003e=_(\n  _[_](\n    m^#1[1,0]#,\n    _?_:_(\n      x^#3[1,3]#,\n      a^#5[1,7]#,\n      b^#6[1,11]#\n    )^#4[1,5]#\n  )^#2[1,1]#,\n  0^#8[1,18]#\n)^#7[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 15, 17, 1, 15, 1, 17],
+        [8, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "1", identExpr: { name: "m" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_?_:_",
+                        args: [
+                          { id: "3", identExpr: { name: "x" } },
+                          { id: "5", identExpr: { name: "a" } },
+                          { id: "6", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_attr_qualify_bad_type_error",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 11,
+            "7": 15,
+            "8": 18,
+          },
+        },
+      },
+      checkedAst:
+        "_\u003e=_(\n  _[_](\n    m~list(dyn)^m,\n    _?_:_(\n      x~bool^x,\n      a~dyn^a,\n      b~dyn^b\n    )~dyn^conditional\n  )~dyn^index_list,\n  0~int\n)~bool^greater_equals_int64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "m" },
+          "2": { overloadId: ["index_list"] },
+          "3": { name: "x" },
+          "4": { overloadId: ["conditional"] },
+          "5": { name: "a" },
+          "6": { name: "b" },
+          "7": { overloadId: ["greater_equals_int64"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "cond_attr_qualify_bad_type_error",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 11,
+            "7": 15,
+            "8": 18,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "1", identExpr: { name: "m" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_?_:_",
+                        args: [
+                          { id: "3", identExpr: { name: "x" } },
+                          { id: "5", identExpr: { name: "a" } },
+                          { id: "6", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "invalid qualifier type: types.Duration" },
+          ],
+        },
+      },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        name: "cond_attr_qualify_bad_field_error",
+        expr: "m[(x ? a : b).c] \u003e= 0",
+        typeEnv: [
+          {
+            name: "m",
+            ident: { type: { listType: { elemType: { dyn: {} } } } },
+          },
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { dyn: {} } } },
+          { name: "x", ident: { type: { primitive: "BOOL" } } },
+        ],
+        bindings: {
+          a: { value: { int64Value: "1" } },
+          b: { value: { int64Value: "2" } },
+          m: { value: { listValue: { values: [{ int64Value: "1" }] } } },
+          x: { value: { boolValue: false } },
+        },
+        evalError: { errors: [{ message: "no such key: c" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u003e=_(\n  _[_](\n    m^#*expr.Expr_IdentExpr#,\n    _?_:_(\n      x^#*expr.Expr_IdentExpr#,\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#.c^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "m[(x ? a : b).c] \u003e= 0",
+      locationAst:
+        "_\u003e=_(\n  _[_](\n    m^#1[1,0]#,\n    _?_:_(\n      x^#3[1,3]#,\n      a^#5[1,7]#,\n      b^#6[1,11]#\n    )^#4[1,5]#.c^#7[1,13]#\n  )^#2[1,1]#,\n  0^#9[1,20]#\n)^#8[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "1", identExpr: { name: "m" } },
+                    {
+                      id: "7",
+                      selectExpr: {
+                        operand: {
+                          id: "4",
+                          callExpr: {
+                            function: "_?_:_",
+                            args: [
+                              { id: "3", identExpr: { name: "x" } },
+                              { id: "5", identExpr: { name: "a" } },
+                              { id: "6", identExpr: { name: "b" } },
+                            ],
+                          },
+                        },
+                        field: "c",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_attr_qualify_bad_field_error",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 11,
+            "7": 13,
+            "8": 17,
+            "9": 20,
+          },
+        },
+      },
+      checkedAst:
+        "_\u003e=_(\n  _[_](\n    m~list(dyn)^m,\n    _?_:_(\n      x~bool^x,\n      a~dyn^a,\n      b~dyn^b\n    )~dyn^conditional.c~dyn\n  )~dyn^index_list,\n  0~int\n)~bool^greater_equals_int64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "m" },
+          "2": { overloadId: ["index_list"] },
+          "3": { name: "x" },
+          "4": { overloadId: ["conditional"] },
+          "5": { name: "a" },
+          "6": { name: "b" },
+          "8": { overloadId: ["greater_equals_int64"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "7": { dyn: {} },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "cond_attr_qualify_bad_field_error",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 11,
+            "7": 13,
+            "8": 17,
+            "9": 20,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "1", identExpr: { name: "m" } },
+                    {
+                      id: "7",
+                      selectExpr: {
+                        operand: {
+                          id: "4",
+                          callExpr: {
+                            function: "_?_:_",
+                            args: [
+                              { id: "3", identExpr: { name: "x" } },
+                              { id: "5", identExpr: { name: "a" } },
+                              { id: "6", identExpr: { name: "b" } },
+                            ],
+                          },
+                        },
+                        field: "c",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      result: { error: { errors: [{ code: 2, message: "no such key: c" }] } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        name: "in_empty_list",
+        expr: "6 in []",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  6^#*expr.Constant_Int64Value#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "6 in []",
+      locationAst: "@in(\n  6^#1[1,0]#,\n  []^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              { id: "3", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_empty_list",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      checkedAst: "@in(\n  6~int,\n  []~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "in_empty_list",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              { id: "3", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "10", max: "10" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "10",
+    },
+    {
+      original: {
+        name: "in_constant_list",
+        expr: "6 in [2, 12, 6]",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  6^#*expr.Constant_Int64Value#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    12^#*expr.Constant_Int64Value#,\n    6^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "6 in [2, 12, 6]",
+      locationAst:
+        "@in(\n  6^#1[1,0]#,\n  [\n    2^#4[1,6]#,\n    12^#5[1,9]#,\n    6^#6[1,13]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 11, 1, 9, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "12" } },
+                    { id: "6", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_list",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 13 },
+        },
+      },
+      checkedAst:
+        "@in(\n  6~int,\n  [\n    2~int,\n    12~int,\n    6~int\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_list",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "12" } },
+                    { id: "6", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "bytes_in_constant_list",
+        expr: "b'hello' in [b'world', b'universe', b'hello']",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '@in(\n  b"hello"^#*expr.Constant_BytesValue#,\n  [\n    b"world"^#*expr.Constant_BytesValue#,\n    b"universe"^#*expr.Constant_BytesValue#,\n    b"hello"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'b"\\150\\145\\154\\154\\157" in [b"\\167\\157\\162\\154\\144", b"\\165\\156\\151\\166\\145\\162\\163\\145", b"\\150\\145\\154\\154\\157"]',
+      locationAst:
+        '@in(\n  b"hello"^#1[1,0]#,\n  [\n    b"world"^#4[1,13]#,\n    b"universe"^#5[1,23]#,\n    b"hello"^#6[1,36]#\n  ]^#3[1,12]#\n)^#2[1,9]#',
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 9, 11, 1, 9, 1, 11],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 21, 1, 13, 1, 21],
+        [5, 23, 34, 1, 23, 1, 34],
+        [6, 36, 44, 1, 36, 1, 44],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { bytesValue: "aGVsbG8=" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "d29ybGQ=" } },
+                    { id: "5", constExpr: { bytesValue: "dW5pdmVyc2U=" } },
+                    { id: "6", constExpr: { bytesValue: "aGVsbG8=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "bytes_in_constant_list",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 9, "3": 12, "4": 13, "5": 23, "6": 36 },
+        },
+      },
+      checkedAst:
+        '@in(\n  b"hello"~bytes,\n  [\n    b"world"~bytes,\n    b"universe"~bytes,\n    b"hello"~bytes\n  ]~list(bytes)\n)~bool^in_list',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { primitive: "BYTES" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { primitive: "BYTES" },
+          "5": { primitive: "BYTES" },
+          "6": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "bytes_in_constant_list",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 9, "3": 12, "4": 13, "5": 23, "6": 36 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { bytesValue: "aGVsbG8=" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { bytesValue: "d29ybGQ=" } },
+                    { id: "5", constExpr: { bytesValue: "dW5pdmVyc2U=" } },
+                    { id: "6", constExpr: { bytesValue: "aGVsbG8=" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        name: "list_in_constant_list",
+        expr: "[6] in [2, 12, [6]]",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  [\n    6^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    12^#*expr.Constant_Int64Value#,\n    [\n      6^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[6] in [2, 12, [6]]",
+      locationAst:
+        "@in(\n  [\n    6^#2[1,1]#\n  ]^#1[1,0]#,\n  [\n    2^#5[1,8]#,\n    12^#6[1,11]#,\n    [\n      6^#8[1,16]#\n    ]^#7[1,15]#\n  ]^#4[1,7]#\n)^#3[1,4]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 6, 1, 4, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 11, 13, 1, 11, 1, 13],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [{ id: "2", constExpr: { int64Value: "6" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "12" } },
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [{ id: "8", constExpr: { int64Value: "6" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_in_constant_list",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 8,
+            "6": 11,
+            "7": 15,
+            "8": 16,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  [\n    6~int\n  ]~list(int),\n  [\n    2~int,\n    12~int,\n    [\n      6~int\n    ]~list(int)\n  ]~list(dyn)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "list_in_constant_list",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 8,
+            "6": 11,
+            "7": 15,
+            "8": 16,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [{ id: "2", constExpr: { int64Value: "6" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "12" } },
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [{ id: "8", constExpr: { int64Value: "6" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "33",
+    },
+    {
+      original: {
+        name: "in_constant_list_cross_type_uint_int",
+        expr: "dyn(12u) in [2, 12, 6]",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  dyn(\n    12u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    12^#*expr.Constant_Int64Value#,\n    6^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn(12u) in [2, 12, 6]",
+      locationAst:
+        "@in(\n  dyn(\n    12u^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    2^#5[1,13]#,\n    12^#6[1,16]#,\n    6^#7[1,20]#\n  ]^#4[1,12]#\n)^#3[1,9]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 9, 11, 1, 9, 1, 11],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 13, 14, 1, 13, 1, 14],
+        [6, 16, 18, 1, 16, 1, 18],
+        [7, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { uint64Value: "12" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "12" } },
+                    { id: "7", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_uint_int",
+          lineOffsets: [23],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 9,
+            "4": 12,
+            "5": 13,
+            "6": 16,
+            "7": 20,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  dyn(\n    12u~uint\n  )~dyn^to_dyn,\n  [\n    2~int,\n    12~int,\n    6~int\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_uint_int",
+          lineOffsets: [23],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 9,
+            "4": 12,
+            "5": 13,
+            "6": 16,
+            "7": 20,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { uint64Value: "12" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "12" } },
+                    { id: "7", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_constant_list_cross_type_double_int",
+        expr: "dyn(6.0) in [2, 12, 6]",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  dyn(\n    6^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    12^#*expr.Constant_Int64Value#,\n    6^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn(6.0) in [2, 12, 6]",
+      locationAst:
+        "@in(\n  dyn(\n    6^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    2^#5[1,13]#,\n    12^#6[1,16]#,\n    6^#7[1,20]#\n  ]^#4[1,12]#\n)^#3[1,9]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 9, 11, 1, 9, 1, 11],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 13, 14, 1, 13, 1, 14],
+        [6, 16, 18, 1, 16, 1, 18],
+        [7, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { doubleValue: 6 } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "12" } },
+                    { id: "7", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_double_int",
+          lineOffsets: [23],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 9,
+            "4": 12,
+            "5": 13,
+            "6": 16,
+            "7": 20,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  dyn(\n    6~double\n  )~dyn^to_dyn,\n  [\n    2~int,\n    12~int,\n    6~int\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_double_int",
+          lineOffsets: [23],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 9,
+            "4": 12,
+            "5": 13,
+            "6": 16,
+            "7": 20,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { doubleValue: 6 } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "12" } },
+                    { id: "7", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_constant_list_cross_type_int_double",
+        expr: "dyn(6) in [2.1, 12.0, 6.0]",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  dyn(\n    6^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2.1^#*expr.Constant_DoubleValue#,\n    12^#*expr.Constant_DoubleValue#,\n    6^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn(6) in [2.1, 12.0, 6.0]",
+      locationAst:
+        "@in(\n  dyn(\n    6^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    2.1^#5[1,11]#,\n    12^#6[1,16]#,\n    6^#7[1,22]#\n  ]^#4[1,10]#\n)^#3[1,7]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 7, 9, 1, 7, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 11, 14, 1, 11, 1, 14],
+        [6, 16, 20, 1, 16, 1, 20],
+        [7, 22, 25, 1, 22, 1, 25],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { int64Value: "6" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { doubleValue: 2.1 } },
+                    { id: "6", constExpr: { doubleValue: 12 } },
+                    { id: "7", constExpr: { doubleValue: 6 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_int_double",
+          lineOffsets: [27],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 7,
+            "4": 10,
+            "5": 11,
+            "6": 16,
+            "7": 22,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  dyn(\n    6~int\n  )~dyn^to_dyn,\n  [\n    2.1~double,\n    12~double,\n    6~double\n  ]~list(double)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_int_double",
+          lineOffsets: [27],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 7,
+            "4": 10,
+            "5": 11,
+            "6": 16,
+            "7": 22,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { int64Value: "6" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { doubleValue: 2.1 } },
+                    { id: "6", constExpr: { doubleValue: 12 } },
+                    { id: "7", constExpr: { doubleValue: 6 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "not_in_constant_list_cross_type_int_double",
+        expr: "dyn(2) in [2.1, 12.0, 6.0]",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  dyn(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2.1^#*expr.Constant_DoubleValue#,\n    12^#*expr.Constant_DoubleValue#,\n    6^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn(2) in [2.1, 12.0, 6.0]",
+      locationAst:
+        "@in(\n  dyn(\n    2^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    2.1^#5[1,11]#,\n    12^#6[1,16]#,\n    6^#7[1,22]#\n  ]^#4[1,10]#\n)^#3[1,7]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 7, 9, 1, 7, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 11, 14, 1, 11, 1, 14],
+        [6, 16, 20, 1, 16, 1, 20],
+        [7, 22, 25, 1, 22, 1, 25],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { doubleValue: 2.1 } },
+                    { id: "6", constExpr: { doubleValue: 12 } },
+                    { id: "7", constExpr: { doubleValue: 6 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "not_in_constant_list_cross_type_int_double",
+          lineOffsets: [27],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 7,
+            "4": 10,
+            "5": 11,
+            "6": 16,
+            "7": 22,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  dyn(\n    2~int\n  )~dyn^to_dyn,\n  [\n    2.1~double,\n    12~double,\n    6~double\n  ]~list(double)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "not_in_constant_list_cross_type_int_double",
+          lineOffsets: [27],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 7,
+            "4": 10,
+            "5": 11,
+            "6": 16,
+            "7": 22,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { doubleValue: 2.1 } },
+                    { id: "6", constExpr: { doubleValue: 12 } },
+                    { id: "7", constExpr: { doubleValue: 6 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_constant_list_cross_type_int_uint",
+        expr: "dyn(6) in [2u, 12u, 6u]",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  dyn(\n    6^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2u^#*expr.Constant_Uint64Value#,\n    12u^#*expr.Constant_Uint64Value#,\n    6u^#*expr.Constant_Uint64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn(6) in [2u, 12u, 6u]",
+      locationAst:
+        "@in(\n  dyn(\n    6^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    2u^#5[1,11]#,\n    12u^#6[1,15]#,\n    6u^#7[1,20]#\n  ]^#4[1,10]#\n)^#3[1,7]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 7, 9, 1, 7, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 11, 13, 1, 11, 1, 13],
+        [6, 15, 18, 1, 15, 1, 18],
+        [7, 20, 22, 1, 20, 1, 22],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { int64Value: "6" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { uint64Value: "2" } },
+                    { id: "6", constExpr: { uint64Value: "12" } },
+                    { id: "7", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_int_uint",
+          lineOffsets: [24],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 7,
+            "4": 10,
+            "5": 11,
+            "6": 15,
+            "7": 20,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  dyn(\n    6~int\n  )~dyn^to_dyn,\n  [\n    2u~uint,\n    12u~uint,\n    6u~uint\n  ]~list(uint)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "UINT64" } } },
+          "5": { primitive: "UINT64" },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_int_uint",
+          lineOffsets: [24],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 7,
+            "4": 10,
+            "5": 11,
+            "6": 15,
+            "7": 20,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { int64Value: "6" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { uint64Value: "2" } },
+                    { id: "6", constExpr: { uint64Value: "12" } },
+                    { id: "7", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_constant_list_cross_type_negative_int_uint",
+        expr: "dyn(-6) in [2u, 12u, 6u]",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  dyn(\n    -6^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2u^#*expr.Constant_Uint64Value#,\n    12u^#*expr.Constant_Uint64Value#,\n    6u^#*expr.Constant_Uint64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn(-6) in [2u, 12u, 6u]",
+      locationAst:
+        "@in(\n  dyn(\n    -6^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    2u^#5[1,12]#,\n    12u^#6[1,16]#,\n    6u^#7[1,21]#\n  ]^#4[1,11]#\n)^#3[1,8]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 6, 1, 4, 1, 6],
+        [3, 8, 10, 1, 8, 1, 10],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 12, 14, 1, 12, 1, 14],
+        [6, 16, 19, 1, 16, 1, 19],
+        [7, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { int64Value: "-6" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { uint64Value: "2" } },
+                    { id: "6", constExpr: { uint64Value: "12" } },
+                    { id: "7", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_negative_int_uint",
+          lineOffsets: [25],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 8,
+            "4": 11,
+            "5": 12,
+            "6": 16,
+            "7": 21,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  dyn(\n    -6~int\n  )~dyn^to_dyn,\n  [\n    2u~uint,\n    12u~uint,\n    6u~uint\n  ]~list(uint)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "UINT64" } } },
+          "5": { primitive: "UINT64" },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_negative_int_uint",
+          lineOffsets: [25],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 8,
+            "4": 11,
+            "5": 12,
+            "6": 16,
+            "7": 21,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { int64Value: "-6" } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { uint64Value: "2" } },
+                    { id: "6", constExpr: { uint64Value: "12" } },
+                    { id: "7", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_constant_list_cross_type_negative_double_uint",
+        expr: "dyn(-6.1) in [2u, 12u, 6u]",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  dyn(\n    -6.1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2u^#*expr.Constant_Uint64Value#,\n    12u^#*expr.Constant_Uint64Value#,\n    6u^#*expr.Constant_Uint64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn(-6.1) in [2u, 12u, 6u]",
+      locationAst:
+        "@in(\n  dyn(\n    -6.1^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    2u^#5[1,14]#,\n    12u^#6[1,18]#,\n    6u^#7[1,23]#\n  ]^#4[1,13]#\n)^#3[1,10]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 8, 1, 4, 1, 8],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 16, 1, 14, 1, 16],
+        [6, 18, 21, 1, 18, 1, 21],
+        [7, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { doubleValue: -6.1 } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { uint64Value: "2" } },
+                    { id: "6", constExpr: { uint64Value: "12" } },
+                    { id: "7", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_negative_double_uint",
+          lineOffsets: [27],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 10,
+            "4": 13,
+            "5": 14,
+            "6": 18,
+            "7": 23,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  dyn(\n    -6.1~double\n  )~dyn^to_dyn,\n  [\n    2u~uint,\n    12u~uint,\n    6u~uint\n  ]~list(uint)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "UINT64" } } },
+          "5": { primitive: "UINT64" },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_list_cross_type_negative_double_uint",
+          lineOffsets: [27],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 10,
+            "4": 13,
+            "5": 14,
+            "6": 18,
+            "7": 23,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { doubleValue: -6.1 } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { uint64Value: "2" } },
+                    { id: "6", constExpr: { uint64Value: "12" } },
+                    { id: "7", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_var_list_int",
+        expr: "6 in [2, 12, x]",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+        bindings: { x: { value: { int64Value: "6" } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  6^#*expr.Constant_Int64Value#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    12^#*expr.Constant_Int64Value#,\n    x^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "6 in [2, 12, x]",
+      locationAst:
+        "@in(\n  6^#1[1,0]#,\n  [\n    2^#4[1,6]#,\n    12^#5[1,9]#,\n    x^#6[1,13]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 11, 1, 9, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "12" } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_var_list_int",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 13 },
+        },
+      },
+      checkedAst:
+        "@in(\n  6~int,\n  [\n    2~int,\n    12~int,\n    x~dyn^x\n  ]~list(dyn)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] }, "6": { name: "x" } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "in_var_list_int",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "12" } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_var_list_uint",
+        expr: "6 in [2, 12, x]",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+        bindings: { x: { value: { uint64Value: "6" } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  6^#*expr.Constant_Int64Value#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    12^#*expr.Constant_Int64Value#,\n    x^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "6 in [2, 12, x]",
+      locationAst:
+        "@in(\n  6^#1[1,0]#,\n  [\n    2^#4[1,6]#,\n    12^#5[1,9]#,\n    x^#6[1,13]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 11, 1, 9, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "12" } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_var_list_uint",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 13 },
+        },
+      },
+      checkedAst:
+        "@in(\n  6~int,\n  [\n    2~int,\n    12~int,\n    x~dyn^x\n  ]~list(dyn)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] }, "6": { name: "x" } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "in_var_list_uint",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "12" } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_var_list_double",
+        expr: "6 in [2, 12, x]",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+        bindings: { x: { value: { doubleValue: 6 } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  6^#*expr.Constant_Int64Value#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    12^#*expr.Constant_Int64Value#,\n    x^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "6 in [2, 12, x]",
+      locationAst:
+        "@in(\n  6^#1[1,0]#,\n  [\n    2^#4[1,6]#,\n    12^#5[1,9]#,\n    x^#6[1,13]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 11, 1, 9, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "12" } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_var_list_double",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 13 },
+        },
+      },
+      checkedAst:
+        "@in(\n  6~int,\n  [\n    2~int,\n    12~int,\n    x~dyn^x\n  ]~list(dyn)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] }, "6": { name: "x" } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "in_var_list_double",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "6" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "12" } },
+                    { id: "6", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "in_var_list_double_double",
+        expr: "dyn(6.0) in [2, 12, x]",
+        typeEnv: [{ name: "x", ident: { type: { primitive: "INT64" } } }],
+        bindings: { x: { value: { int64Value: "6" } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  dyn(\n    6^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    12^#*expr.Constant_Int64Value#,\n    x^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn(6.0) in [2, 12, x]",
+      locationAst:
+        "@in(\n  dyn(\n    6^#2[1,4]#\n  )^#1[1,3]#,\n  [\n    2^#5[1,13]#,\n    12^#6[1,16]#,\n    x^#7[1,20]#\n  ]^#4[1,12]#\n)^#3[1,9]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 9, 11, 1, 9, 1, 11],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 13, 14, 1, 13, 1, 14],
+        [6, 16, 18, 1, 16, 1, 18],
+        [7, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { doubleValue: 6 } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "12" } },
+                    { id: "7", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_var_list_double_double",
+          lineOffsets: [23],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 9,
+            "4": 12,
+            "5": 13,
+            "6": 16,
+            "7": 20,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  dyn(\n    6~double\n  )~dyn^to_dyn,\n  [\n    2~int,\n    12~int,\n    x~int^x\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["in_list"] },
+          "7": { name: "x" },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_var_list_double_double",
+          lineOffsets: [23],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 9,
+            "4": 12,
+            "5": 13,
+            "6": 16,
+            "7": 20,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "2", constExpr: { doubleValue: 6 } }],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "12" } },
+                    { id: "7", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "15", max: "15" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "15",
+    },
+    {
+      original: {
+        name: "in_constant_map",
+        expr: "'other-key' in {'key': null, 'other-key': 42}",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '@in(\n  "other-key"^#*expr.Constant_StringValue#,\n  {\n    "key"^#*expr.Constant_StringValue#:null^#*expr.Constant_NullValue#^#*expr.Expr_CreateStruct_Entry#,\n    "other-key"^#*expr.Constant_StringValue#:42^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"other-key" in {"key": null, "other-key": 42}',
+      locationAst:
+        '@in(\n  "other-key"^#1[1,0]#,\n  {\n    "key"^#5[1,16]#:null^#6[1,23]#^#4[1,21]#,\n    "other-key"^#8[1,29]#:42^#9[1,42]#^#7[1,40]#\n  }^#3[1,15]#\n)^#2[1,12]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 12, 14, 1, 12, 1, 14],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 16, 21, 1, 16, 1, 21],
+        [6, 23, 27, 1, 23, 1, 27],
+        [7, 40, 41, 1, 40, 1, 41],
+        [8, 29, 40, 1, 29, 1, 40],
+        [9, 42, 44, 1, 42, 1, 44],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "other-key" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { stringValue: "key" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: {
+                        id: "8",
+                        constExpr: { stringValue: "other-key" },
+                      },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_map",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 15,
+            "4": 21,
+            "5": 16,
+            "6": 23,
+            "7": 40,
+            "8": 29,
+            "9": 42,
+          },
+        },
+      },
+      checkedAst:
+        '@in(\n  "other-key"~string,\n  {\n    "key"~string:null~null,\n    "other-key"~string:42~int\n  }~map(string, dyn)\n)~bool^in_map',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_map"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { null: null },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_map",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 15,
+            "4": 21,
+            "5": 16,
+            "6": 23,
+            "7": 40,
+            "8": 29,
+            "9": 42,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "other-key" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { stringValue: "key" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: {
+                        id: "8",
+                        constExpr: { stringValue: "other-key" },
+                      },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "31", max: "31" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "31",
+    },
+    {
+      original: {
+        name: "in_constant_map_cross_type_string_number",
+        expr: "'other-key' in {1: null, 2u: 42}",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '@in(\n  "other-key"^#*expr.Constant_StringValue#,\n  {\n    1^#*expr.Constant_Int64Value#:null^#*expr.Constant_NullValue#^#*expr.Expr_CreateStruct_Entry#,\n    2u^#*expr.Constant_Uint64Value#:42^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"other-key" in {1: null, 2u: 42}',
+      locationAst:
+        '@in(\n  "other-key"^#1[1,0]#,\n  {\n    1^#5[1,16]#:null^#6[1,19]#^#4[1,17]#,\n    2u^#8[1,25]#:42^#9[1,29]#^#7[1,27]#\n  }^#3[1,15]#\n)^#2[1,12]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 12, 14, 1, 12, 1, 14],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 19, 23, 1, 19, 1, 23],
+        [7, 27, 28, 1, 27, 1, 28],
+        [8, 25, 27, 1, 25, 1, 27],
+        [9, 29, 31, 1, 29, 1, 31],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "other-key" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { uint64Value: "2" } },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_map_cross_type_string_number",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 15,
+            "4": 17,
+            "5": 16,
+            "6": 19,
+            "7": 27,
+            "8": 25,
+            "9": 29,
+          },
+        },
+      },
+      checkedAst:
+        '@in(\n  "other-key"~string,\n  {\n    1~int:null~null,\n    2u~uint:42~int\n  }~map(dyn, dyn)\n)~bool^in_map',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_map"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "5": { primitive: "INT64" },
+          "6": { null: null },
+          "8": { primitive: "UINT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_map_cross_type_string_number",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 15,
+            "4": 17,
+            "5": 16,
+            "6": 19,
+            "7": 27,
+            "8": 25,
+            "9": 29,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "other-key" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { uint64Value: "2" } },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "31", max: "31" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "31",
+    },
+    {
+      original: {
+        name: "in_constant_map_cross_type_double_int",
+        expr: "2.0 in {1: null, 2u: 42}",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  2^#*expr.Constant_DoubleValue#,\n  {\n    1^#*expr.Constant_Int64Value#:null^#*expr.Constant_NullValue#^#*expr.Expr_CreateStruct_Entry#,\n    2u^#*expr.Constant_Uint64Value#:42^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2.0 in {1: null, 2u: 42}",
+      locationAst:
+        "@in(\n  2^#1[1,0]#,\n  {\n    1^#5[1,8]#:null^#6[1,11]#^#4[1,9]#,\n    2u^#8[1,17]#:42^#9[1,21]#^#7[1,19]#\n  }^#3[1,7]#\n)^#2[1,4]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 4, 6, 1, 4, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 11, 15, 1, 11, 1, 15],
+        [7, 19, 20, 1, 19, 1, 20],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { doubleValue: 2 } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { uint64Value: "2" } },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_map_cross_type_double_int",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 7,
+            "4": 9,
+            "5": 8,
+            "6": 11,
+            "7": 19,
+            "8": 17,
+            "9": 21,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  2~double,\n  {\n    1~int:null~null,\n    2u~uint:42~int\n  }~map(dyn, dyn)\n)~bool^in_map",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_map"] } },
+        typeMap: {
+          "1": { primitive: "DOUBLE" },
+          "2": { primitive: "BOOL" },
+          "3": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "5": { primitive: "INT64" },
+          "6": { null: null },
+          "8": { primitive: "UINT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_constant_map_cross_type_double_int",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 7,
+            "4": 9,
+            "5": 8,
+            "6": 11,
+            "7": 19,
+            "8": 17,
+            "9": 21,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { doubleValue: 2 } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { uint64Value: "2" } },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "31", max: "31" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "31",
+    },
+    {
+      original: {
+        name: "not_in_constant_map_cross_type_double_int",
+        expr: "2.1 in {1: null, 2u: 42}",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  2.1^#*expr.Constant_DoubleValue#,\n  {\n    1^#*expr.Constant_Int64Value#:null^#*expr.Constant_NullValue#^#*expr.Expr_CreateStruct_Entry#,\n    2u^#*expr.Constant_Uint64Value#:42^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2.1 in {1: null, 2u: 42}",
+      locationAst:
+        "@in(\n  2.1^#1[1,0]#,\n  {\n    1^#5[1,8]#:null^#6[1,11]#^#4[1,9]#,\n    2u^#8[1,17]#:42^#9[1,21]#^#7[1,19]#\n  }^#3[1,7]#\n)^#2[1,4]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 4, 6, 1, 4, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 11, 15, 1, 11, 1, 15],
+        [7, 19, 20, 1, 19, 1, 20],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { doubleValue: 2.1 } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { uint64Value: "2" } },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "not_in_constant_map_cross_type_double_int",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 7,
+            "4": 9,
+            "5": 8,
+            "6": 11,
+            "7": 19,
+            "8": 17,
+            "9": 21,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  2.1~double,\n  {\n    1~int:null~null,\n    2u~uint:42~int\n  }~map(dyn, dyn)\n)~bool^in_map",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_map"] } },
+        typeMap: {
+          "1": { primitive: "DOUBLE" },
+          "2": { primitive: "BOOL" },
+          "3": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "5": { primitive: "INT64" },
+          "6": { null: null },
+          "8": { primitive: "UINT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "not_in_constant_map_cross_type_double_int",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 7,
+            "4": 9,
+            "5": 8,
+            "6": 11,
+            "7": 19,
+            "8": 17,
+            "9": 21,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { doubleValue: 2.1 } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { uint64Value: "2" } },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "31", max: "31" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "31",
+    },
+    {
+      original: {
+        name: "in_constant_heterogeneous_map",
+        expr: "'hello' in {1: 'one', false: true, 'hello': 'world'}",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '@in(\n  "hello"^#*expr.Constant_StringValue#,\n  {\n    1^#*expr.Constant_Int64Value#:"one"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n    false^#*expr.Constant_BoolValue#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#,\n    "hello"^#*expr.Constant_StringValue#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello" in {1: "one", false: true, "hello": "world"}',
+      locationAst:
+        '@in(\n  "hello"^#1[1,0]#,\n  {\n    1^#5[1,12]#:"one"^#6[1,15]#^#4[1,13]#,\n    false^#8[1,22]#:true^#9[1,29]#^#7[1,27]#,\n    "hello"^#11[1,35]#:"world"^#12[1,44]#^#10[1,42]#\n  }^#3[1,11]#\n)^#2[1,8]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 8, 10, 1, 8, 1, 10],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 15, 20, 1, 15, 1, 20],
+        [7, 27, 28, 1, 27, 1, 28],
+        [8, 22, 27, 1, 22, 1, 27],
+        [9, 29, 33, 1, 29, 1, 33],
+        [10, 42, 43, 1, 42, 1, 43],
+        [11, 35, 42, 1, 35, 1, 42],
+        [12, 44, 51, 1, 44, 1, 51],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "hello" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", constExpr: { stringValue: "one" } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { boolValue: false } },
+                      value: { id: "9", constExpr: { boolValue: true } },
+                    },
+                    {
+                      id: "10",
+                      mapKey: { id: "11", constExpr: { stringValue: "hello" } },
+                      value: { id: "12", constExpr: { stringValue: "world" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_constant_heterogeneous_map",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 11,
+            "4": 13,
+            "5": 12,
+            "6": 15,
+            "7": 27,
+            "8": 22,
+            "9": 29,
+            "10": 42,
+            "11": 35,
+            "12": 44,
+          },
+        },
+      },
+      checkedAst:
+        '@in(\n  "hello"~string,\n  {\n    1~int:"one"~string,\n    false~bool:true~bool,\n    "hello"~string:"world"~string\n  }~map(dyn, dyn)\n)~bool^in_map',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_map"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "11": { primitive: "STRING" },
+          "12": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "in_constant_heterogeneous_map",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 11,
+            "4": 13,
+            "5": 12,
+            "6": 15,
+            "7": 27,
+            "8": 22,
+            "9": 29,
+            "10": 42,
+            "11": 35,
+            "12": 44,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "hello" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", constExpr: { stringValue: "one" } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { boolValue: false } },
+                      value: { id: "9", constExpr: { boolValue: true } },
+                    },
+                    {
+                      id: "10",
+                      mapKey: { id: "11", constExpr: { stringValue: "hello" } },
+                      value: { id: "12", constExpr: { stringValue: "world" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "31", max: "31" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "31",
+    },
+    {
+      original: {
+        name: "not_in_constant_heterogeneous_map",
+        expr: "!('hello' in {1: 'one', false: true})",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '!_(\n  @in(\n    "hello"^#*expr.Constant_StringValue#,\n    {\n      1^#*expr.Constant_Int64Value#:"one"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      false^#*expr.Constant_BoolValue#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '!("hello" in {1: "one", false: true})',
+      locationAst:
+        '!_(\n  @in(\n    "hello"^#2[1,2]#,\n    {\n      1^#6[1,14]#:"one"^#7[1,17]#^#5[1,15]#,\n      false^#9[1,24]#:true^#10[1,31]#^#8[1,29]#\n    }^#4[1,13]#\n  )^#3[1,10]#\n)^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 9, 1, 2, 1, 9],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 15, 16, 1, 15, 1, 16],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 22, 1, 17, 1, 22],
+        [8, 29, 30, 1, 29, 1, 30],
+        [9, 24, 29, 1, 24, 1, 29],
+        [10, 31, 35, 1, 31, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    { id: "2", constExpr: { stringValue: "hello" } },
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "one" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              constExpr: { boolValue: false },
+                            },
+                            value: { id: "10", constExpr: { boolValue: true } },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "not_in_constant_heterogeneous_map",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 10,
+            "4": 13,
+            "5": 15,
+            "6": 14,
+            "7": 17,
+            "8": 29,
+            "9": 24,
+            "10": 31,
+          },
+        },
+      },
+      checkedAst:
+        '!_(\n  @in(\n    "hello"~string,\n    {\n      1~int:"one"~string,\n      false~bool:true~bool\n    }~map(dyn, dyn)\n  )~bool^in_map\n)~bool^logical_not',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["in_map"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "not_in_constant_heterogeneous_map",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 10,
+            "4": 13,
+            "5": 15,
+            "6": 14,
+            "7": 17,
+            "8": 29,
+            "9": 24,
+            "10": 31,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    { id: "2", constExpr: { stringValue: "hello" } },
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "one" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              constExpr: { boolValue: false },
+                            },
+                            value: { id: "10", constExpr: { boolValue: true } },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "32", max: "32" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "32",
+    },
+    {
+      original: {
+        name: "not_in_constant_heterogeneous_map_with_same_key_type",
+        expr: "!('hello' in {1: 'one', 'world': true})",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '!_(\n  @in(\n    "hello"^#*expr.Constant_StringValue#,\n    {\n      1^#*expr.Constant_Int64Value#:"one"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      "world"^#*expr.Constant_StringValue#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '!("hello" in {1: "one", "world": true})',
+      locationAst:
+        '!_(\n  @in(\n    "hello"^#2[1,2]#,\n    {\n      1^#6[1,14]#:"one"^#7[1,17]#^#5[1,15]#,\n      "world"^#9[1,24]#:true^#10[1,33]#^#8[1,31]#\n    }^#4[1,13]#\n  )^#3[1,10]#\n)^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 9, 1, 2, 1, 9],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 15, 16, 1, 15, 1, 16],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 22, 1, 17, 1, 22],
+        [8, 31, 32, 1, 31, 1, 32],
+        [9, 24, 31, 1, 24, 1, 31],
+        [10, 33, 37, 1, 33, 1, 37],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    { id: "2", constExpr: { stringValue: "hello" } },
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "one" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              constExpr: { stringValue: "world" },
+                            },
+                            value: { id: "10", constExpr: { boolValue: true } },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "not_in_constant_heterogeneous_map_with_same_key_type",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 10,
+            "4": 13,
+            "5": 15,
+            "6": 14,
+            "7": 17,
+            "8": 31,
+            "9": 24,
+            "10": 33,
+          },
+        },
+      },
+      checkedAst:
+        '!_(\n  @in(\n    "hello"~string,\n    {\n      1~int:"one"~string,\n      "world"~string:true~bool\n    }~map(dyn, dyn)\n  )~bool^in_map\n)~bool^logical_not',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["in_map"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "not_in_constant_heterogeneous_map_with_same_key_type",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 10,
+            "4": 13,
+            "5": 15,
+            "6": 14,
+            "7": 17,
+            "8": 31,
+            "9": 24,
+            "10": 33,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    { id: "2", constExpr: { stringValue: "hello" } },
+                    {
+                      id: "4",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "one" },
+                            },
+                          },
+                          {
+                            id: "8",
+                            mapKey: {
+                              id: "9",
+                              constExpr: { stringValue: "world" },
+                            },
+                            value: { id: "10", constExpr: { boolValue: true } },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "32", max: "32" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "32",
+    },
+    {
+      original: {
+        name: "in_var_key_map",
+        expr: "'other-key' in {x: null, y: 42}",
+        typeEnv: [
+          { name: "x", ident: { type: { primitive: "STRING" } } },
+          { name: "y", ident: { type: { primitive: "INT64" } } },
+        ],
+        bindings: {
+          x: { value: { stringValue: "other-key" } },
+          y: { value: { int64Value: "2" } },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '@in(\n  "other-key"^#*expr.Constant_StringValue#,\n  {\n    x^#*expr.Expr_IdentExpr#:null^#*expr.Constant_NullValue#^#*expr.Expr_CreateStruct_Entry#,\n    y^#*expr.Expr_IdentExpr#:42^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"other-key" in {x: null, y: 42}',
+      locationAst:
+        '@in(\n  "other-key"^#1[1,0]#,\n  {\n    x^#5[1,16]#:null^#6[1,19]#^#4[1,17]#,\n    y^#8[1,25]#:42^#9[1,28]#^#7[1,26]#\n  }^#3[1,15]#\n)^#2[1,12]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 12, 14, 1, 12, 1, 14],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 19, 23, 1, 19, 1, 23],
+        [7, 26, 27, 1, 26, 1, 27],
+        [8, 25, 26, 1, 25, 1, 26],
+        [9, 28, 30, 1, 28, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "other-key" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", identExpr: { name: "x" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", identExpr: { name: "y" } },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_var_key_map",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 15,
+            "4": 17,
+            "5": 16,
+            "6": 19,
+            "7": 26,
+            "8": 25,
+            "9": 28,
+          },
+        },
+      },
+      checkedAst:
+        '@in(\n  "other-key"~string,\n  {\n    x~string^x:null~null,\n    y~int^y:42~int\n  }~map(dyn, dyn)\n)~bool^in_map',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_map"] },
+          "5": { name: "x" },
+          "8": { name: "y" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "5": { primitive: "STRING" },
+          "6": { null: null },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_var_key_map",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 15,
+            "4": 17,
+            "5": 16,
+            "6": 19,
+            "7": 26,
+            "8": 25,
+            "9": 28,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "other-key" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", identExpr: { name: "x" } },
+                      value: { id: "6", constExpr: { nullValue: null } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", identExpr: { name: "y" } },
+                      value: { id: "9", constExpr: { int64Value: "42" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "33",
+    },
+    {
+      original: {
+        name: "in_var_value_map",
+        expr: "'other-key' in {1: x, 2u: y}",
+        typeEnv: [
+          { name: "x", ident: { type: { primitive: "STRING" } } },
+          { name: "y", ident: { type: { primitive: "INT64" } } },
+        ],
+        bindings: {
+          x: { value: { stringValue: "other-value" } },
+          y: { value: { int64Value: "2" } },
+        },
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '@in(\n  "other-key"^#*expr.Constant_StringValue#,\n  {\n    1^#*expr.Constant_Int64Value#:x^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#,\n    2u^#*expr.Constant_Uint64Value#:y^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"other-key" in {1: x, 2u: y}',
+      locationAst:
+        '@in(\n  "other-key"^#1[1,0]#,\n  {\n    1^#5[1,16]#:x^#6[1,19]#^#4[1,17]#,\n    2u^#8[1,22]#:y^#9[1,26]#^#7[1,24]#\n  }^#3[1,15]#\n)^#2[1,12]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 12, 14, 1, 12, 1, 14],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 22, 24, 1, 22, 1, 24],
+        [9, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "other-key" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", identExpr: { name: "x" } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { uint64Value: "2" } },
+                      value: { id: "9", identExpr: { name: "y" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in_var_value_map",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 15,
+            "4": 17,
+            "5": 16,
+            "6": 19,
+            "7": 24,
+            "8": 22,
+            "9": 26,
+          },
+        },
+      },
+      checkedAst:
+        '@in(\n  "other-key"~string,\n  {\n    1~int:x~string^x,\n    2u~uint:y~int^y\n  }~map(dyn, dyn)\n)~bool^in_map',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_map"] },
+          "6": { name: "x" },
+          "9": { name: "y" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "8": { primitive: "UINT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "in_var_value_map",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 15,
+            "4": 17,
+            "5": 16,
+            "6": 19,
+            "7": 24,
+            "8": 22,
+            "9": 26,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "other-key" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { int64Value: "1" } },
+                      value: { id: "6", identExpr: { name: "x" } },
+                    },
+                    {
+                      id: "7",
+                      mapKey: { id: "8", constExpr: { uint64Value: "2" } },
+                      value: { id: "9", identExpr: { name: "y" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "33",
+    },
+    {
+      original: {
+        name: "index",
+        expr: "m['key'][1] == 42u \u0026\u0026 m['null'] == null \u0026\u0026 m[string(0)] == 10",
+        typeEnv: [
+          {
+            name: "m",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { dyn: {} },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          m: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "key" },
+                    value: {
+                      listValue: {
+                        values: [{ uint64Value: "21" }, { uint64Value: "42" }],
+                      },
+                    },
+                  },
+                  { key: { stringValue: "null" }, value: { nullValue: null } },
+                  { key: { stringValue: "0" }, value: { int64Value: "10" } },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  _==_(\n    _[_](\n      _[_](\n        m^#*expr.Expr_IdentExpr#,\n        "key"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    42u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#,\n      "null"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    null^#*expr.Constant_NullValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#,\n      string(\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    10^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'm["key"][1] == 42u \u0026\u0026 m["null"] == null',
+      locationAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      _[_](\n        m^#1[1,0]#,\n        "key"^#3[1,2]#\n      )^#2[1,1]#,\n      1^#5[1,9]#\n    )^#4[1,8]#,\n    42u^#7[1,15]#\n  )^#6[1,12]#,\n  _==_(\n    _[_](\n      m^#8[1,22]#,\n      "null"^#10[1,24]#\n    )^#9[1,23]#,\n    null^#12[1,35]#\n  )^#11[1,32]#,\n  _==_(\n    _[_](\n      m^#14[1,43]#,\n      string(\n        0^#17[1,52]#\n      )^#16[1,51]#\n    )^#15[1,44]#,\n    10^#19[1,59]#\n  )^#18[1,56]#\n)^#13[1,19]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 7, 1, 2, 1, 7],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 12, 14, 1, 12, 1, 14],
+        [7, 15, 18, 1, 15, 1, 18],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 23, 24, 1, 23, 1, 24],
+        [10, 24, 30, 1, 24, 1, 30],
+        [11, 32, 34, 1, 32, 1, 34],
+        [12, 35, 39, 1, 35, 1, 39],
+        [13, 19, 21, 1, 19, 1, 21],
+        [14, 43, 44, 1, 43, 1, 44],
+        [15, 44, 45, 1, 44, 1, 45],
+        [16, 51, 52, 1, 51, 1, 52],
+        [17, 52, 53, 1, 52, 1, 53],
+        [18, 56, 58, 1, 56, 1, 58],
+        [19, 59, 61, 1, 59, 1, 61],
+        [20, 40, 42, 1, 40, 1, 42],
+      ],
+      lineOffsets: [62],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                { id: "1", identExpr: { name: "m" } },
+                                { id: "3", constExpr: { stringValue: "key" } },
+                              ],
+                            },
+                          },
+                          { id: "5", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    { id: "7", constExpr: { uint64Value: "42" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "8", identExpr: { name: "m" } },
+                          { id: "10", constExpr: { stringValue: "null" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { nullValue: null } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "15",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "14", identExpr: { name: "m" } },
+                          {
+                            id: "16",
+                            callExpr: {
+                              function: "string",
+                              args: [
+                                { id: "17", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { int64Value: "10" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 8,
+            "5": 9,
+            "6": 12,
+            "7": 15,
+            "8": 22,
+            "9": 23,
+            "10": 24,
+            "11": 32,
+            "12": 35,
+            "13": 19,
+            "14": 43,
+            "15": 44,
+            "16": 51,
+            "17": 52,
+            "18": 56,
+            "19": 59,
+            "20": 40,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      _[_](\n        m~map(string, dyn)^m,\n        "key"~string\n      )~dyn^index_map,\n      1~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    42u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m,\n      "null"~string\n    )~dyn^index_map,\n    null~null\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m,\n      string(\n        0~int\n      )~string^int64_to_string\n    )~dyn^index_map,\n    10~int\n  )~bool^equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "m" },
+          "2": { overloadId: ["index_map"] },
+          "4": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "6": { overloadId: ["equals"] },
+          "8": { name: "m" },
+          "9": { overloadId: ["index_map"] },
+          "11": { overloadId: ["equals"] },
+          "13": { overloadId: ["logical_and"] },
+          "14": { name: "m" },
+          "15": { overloadId: ["index_map"] },
+          "16": { overloadId: ["int64_to_string"] },
+          "18": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "2": { dyn: {} },
+          "3": { primitive: "STRING" },
+          "4": { dyn: {} },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "UINT64" },
+          "8": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "9": { dyn: {} },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "BOOL" },
+          "12": { null: null },
+          "13": { primitive: "BOOL" },
+          "14": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "15": { dyn: {} },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "index",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 8,
+            "5": 9,
+            "6": 12,
+            "7": 15,
+            "8": 22,
+            "9": 23,
+            "10": 24,
+            "11": 32,
+            "12": 35,
+            "13": 19,
+            "14": 43,
+            "15": 44,
+            "16": 51,
+            "17": 52,
+            "18": 56,
+            "19": 59,
+            "20": 40,
+          },
+        },
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_[_]",
+                              args: [
+                                { id: "1", identExpr: { name: "m" } },
+                                { id: "3", constExpr: { stringValue: "key" } },
+                              ],
+                            },
+                          },
+                          { id: "5", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    { id: "7", constExpr: { uint64Value: "42" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "8", identExpr: { name: "m" } },
+                          { id: "10", constExpr: { stringValue: "null" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { nullValue: null } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "15",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "14", identExpr: { name: "m" } },
+                          {
+                            id: "16",
+                            callExpr: {
+                              function: "string",
+                              args: [
+                                { id: "17", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { int64Value: "10" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "7" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        name: "index_cross_type_float_uint",
+        expr: "{1: 'hello'}[x] == 'hello' \u0026\u0026 {2: 'world'}[y] == 'world'",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          { name: "y", ident: { type: { dyn: {} } } },
+        ],
+        bindings: {
+          x: { value: { doubleValue: 1 } },
+          y: { value: { uint64Value: "2" } },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  _==_(\n    _[_](\n      {\n        1^#*expr.Constant_Int64Value#:"hello"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      {\n        2^#*expr.Constant_Int64Value#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#,\n      y^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    "world"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '{1: "hello"}[x] == "hello" \u0026\u0026 {2: "world"}[y] == "world"',
+      locationAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      {\n        1^#3[1,1]#:"hello"^#4[1,4]#^#2[1,2]#\n      }^#1[1,0]#,\n      x^#6[1,13]#\n    )^#5[1,12]#,\n    "hello"^#8[1,19]#\n  )^#7[1,16]#,\n  _==_(\n    _[_](\n      {\n        2^#11[1,31]#:"world"^#12[1,34]#^#10[1,32]#\n      }^#9[1,30]#,\n      y^#14[1,43]#\n    )^#13[1,42]#,\n    "world"^#16[1,49]#\n  )^#15[1,46]#\n)^#17[1,27]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 11, 1, 4, 1, 11],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 18, 1, 16, 1, 18],
+        [8, 19, 26, 1, 19, 1, 26],
+        [9, 30, 31, 1, 30, 1, 31],
+        [10, 32, 33, 1, 32, 1, 33],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 34, 41, 1, 34, 1, 41],
+        [13, 42, 43, 1, 42, 1, 43],
+        [14, 43, 44, 1, 43, 1, 44],
+        [15, 46, 48, 1, 46, 1, 48],
+        [16, 49, 56, 1, 49, 1, 56],
+        [17, 27, 29, 1, 27, 1, 29],
+      ],
+      lineOffsets: [57],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "1",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "2",
+                                  mapKey: {
+                                    id: "3",
+                                    constExpr: { int64Value: "1" },
+                                  },
+                                  value: {
+                                    id: "4",
+                                    constExpr: { stringValue: "hello" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "6", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "13",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "9",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "10",
+                                  mapKey: {
+                                    id: "11",
+                                    constExpr: { int64Value: "2" },
+                                  },
+                                  value: {
+                                    id: "12",
+                                    constExpr: { stringValue: "world" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "14", identExpr: { name: "y" } },
+                        ],
+                      },
+                    },
+                    { id: "16", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_cross_type_float_uint",
+          lineOffsets: [57],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 12,
+            "6": 13,
+            "7": 16,
+            "8": 19,
+            "9": 30,
+            "10": 32,
+            "11": 31,
+            "12": 34,
+            "13": 42,
+            "14": 43,
+            "15": 46,
+            "16": 49,
+            "17": 27,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      {\n        1~int:"hello"~string\n      }~map(int, string),\n      x~dyn^x\n    )~string^index_map,\n    "hello"~string\n  )~bool^equals,\n  _==_(\n    _[_](\n      {\n        2~int:"world"~string\n      }~map(int, string),\n      y~dyn^y\n    )~string^index_map,\n    "world"~string\n  )~bool^equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "x" },
+          "7": { overloadId: ["equals"] },
+          "13": { overloadId: ["index_map"] },
+          "14": { name: "y" },
+          "15": { overloadId: ["equals"] },
+          "17": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+          "9": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "STRING" },
+          "13": { primitive: "STRING" },
+          "14": { dyn: {} },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "index_cross_type_float_uint",
+          lineOffsets: [57],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 12,
+            "6": 13,
+            "7": 16,
+            "8": 19,
+            "9": 30,
+            "10": 32,
+            "11": 31,
+            "12": 34,
+            "13": 42,
+            "14": 43,
+            "15": 46,
+            "16": 49,
+            "17": 27,
+          },
+        },
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "1",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "2",
+                                  mapKey: {
+                                    id: "3",
+                                    constExpr: { int64Value: "1" },
+                                  },
+                                  value: {
+                                    id: "4",
+                                    constExpr: { stringValue: "hello" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "6", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "13",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "9",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "10",
+                                  mapKey: {
+                                    id: "11",
+                                    constExpr: { int64Value: "2" },
+                                  },
+                                  value: {
+                                    id: "12",
+                                    constExpr: { stringValue: "world" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "14", identExpr: { name: "y" } },
+                        ],
+                      },
+                    },
+                    { id: "16", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "66" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "66",
+    },
+    {
+      original: {
+        name: "no_index_cross_type_float_uint",
+        expr: "{1: 'hello'}[x] == 'hello' \u0026\u0026 ['world'][y] == 'world'",
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          { name: "y", ident: { type: { dyn: {} } } },
+        ],
+        bindings: {
+          x: { value: { doubleValue: 2 } },
+          y: { value: { uint64Value: "3" } },
+        },
+        evalError: { errors: [{ message: "no such key: 2" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  _==_(\n    _[_](\n      {\n        1^#*expr.Constant_Int64Value#:"hello"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n      }^#*expr.Expr_StructExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      [\n        "world"^#*expr.Constant_StringValue#\n      ]^#*expr.Expr_ListExpr#,\n      y^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    "world"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '{1: "hello"}[x] == "hello" \u0026\u0026 ["world"][y] == "world"',
+      locationAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      {\n        1^#3[1,1]#:"hello"^#4[1,4]#^#2[1,2]#\n      }^#1[1,0]#,\n      x^#6[1,13]#\n    )^#5[1,12]#,\n    "hello"^#8[1,19]#\n  )^#7[1,16]#,\n  _==_(\n    _[_](\n      [\n        "world"^#10[1,31]#\n      ]^#9[1,30]#,\n      y^#12[1,40]#\n    )^#11[1,39]#,\n    "world"^#14[1,46]#\n  )^#13[1,43]#\n)^#15[1,27]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 11, 1, 4, 1, 11],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 18, 1, 16, 1, 18],
+        [8, 19, 26, 1, 19, 1, 26],
+        [9, 30, 31, 1, 30, 1, 31],
+        [10, 31, 38, 1, 31, 1, 38],
+        [11, 39, 40, 1, 39, 1, 40],
+        [12, 40, 41, 1, 40, 1, 41],
+        [13, 43, 45, 1, 43, 1, 45],
+        [14, 46, 53, 1, 46, 1, 53],
+        [15, 27, 29, 1, 27, 1, 29],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "1",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "2",
+                                  mapKey: {
+                                    id: "3",
+                                    constExpr: { int64Value: "1" },
+                                  },
+                                  value: {
+                                    id: "4",
+                                    constExpr: { stringValue: "hello" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "6", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "9",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "10",
+                                  constExpr: { stringValue: "world" },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "12", identExpr: { name: "y" } },
+                        ],
+                      },
+                    },
+                    { id: "14", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "no_index_cross_type_float_uint",
+          lineOffsets: [54],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 12,
+            "6": 13,
+            "7": 16,
+            "8": 19,
+            "9": 30,
+            "10": 31,
+            "11": 39,
+            "12": 40,
+            "13": 43,
+            "14": 46,
+            "15": 27,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      {\n        1~int:"hello"~string\n      }~map(int, string),\n      x~dyn^x\n    )~string^index_map,\n    "hello"~string\n  )~bool^equals,\n  _==_(\n    _[_](\n      [\n        "world"~string\n      ]~list(string),\n      y~dyn^y\n    )~string^index_list,\n    "world"~string\n  )~bool^equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "x" },
+          "7": { overloadId: ["equals"] },
+          "11": { overloadId: ["index_list"] },
+          "12": { name: "y" },
+          "13": { overloadId: ["equals"] },
+          "15": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+          "9": { listType: { elemType: { primitive: "STRING" } } },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "STRING" },
+          "12": { dyn: {} },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "STRING" },
+          "15": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "no_index_cross_type_float_uint",
+          lineOffsets: [54],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 12,
+            "6": 13,
+            "7": 16,
+            "8": 19,
+            "9": 30,
+            "10": 31,
+            "11": 39,
+            "12": 40,
+            "13": 43,
+            "14": 46,
+            "15": 27,
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "1",
+                            structExpr: {
+                              entries: [
+                                {
+                                  id: "2",
+                                  mapKey: {
+                                    id: "3",
+                                    constExpr: { int64Value: "1" },
+                                  },
+                                  value: {
+                                    id: "4",
+                                    constExpr: { stringValue: "hello" },
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "6", identExpr: { name: "x" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "9",
+                            listExpr: {
+                              elements: [
+                                {
+                                  id: "10",
+                                  constExpr: { stringValue: "world" },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "12", identExpr: { name: "y" } },
+                        ],
+                      },
+                    },
+                    { id: "14", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "46" },
+      result: { error: { errors: [{ code: 2, message: "no such key: 2" }] } },
+      runtimeCost: "46",
+    },
+    {
+      original: {
+        name: "index_cross_type_double",
+        expr: "{1: 'hello', 2: 'world'}[x] == 'hello'",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+        bindings: { x: { value: { doubleValue: 1 } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    {\n      1^#*expr.Constant_Int64Value#:"hello"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      2^#*expr.Constant_Int64Value#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  "hello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{1: "hello", 2: "world"}[x] == "hello"',
+      locationAst:
+        '_==_(\n  _[_](\n    {\n      1^#3[1,1]#:"hello"^#4[1,4]#^#2[1,2]#,\n      2^#6[1,13]#:"world"^#7[1,16]#^#5[1,14]#\n    }^#1[1,0]#,\n    x^#9[1,25]#\n  )^#8[1,24]#,\n  "hello"^#11[1,31]#\n)^#10[1,28]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 11, 1, 4, 1, 11],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 23, 1, 16, 1, 23],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 28, 30, 1, 28, 1, 30],
+        [11, 31, 38, 1, 31, 1, 38],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hello" },
+                            },
+                          },
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "9", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { stringValue: "hello" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_cross_type_double",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 14,
+            "6": 13,
+            "7": 16,
+            "8": 24,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  _[_](\n    {\n      1~int:"hello"~string,\n      2~int:"world"~string\n    }~map(int, string),\n    x~dyn^x\n  )~string^index_map,\n  "hello"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["index_map"] },
+          "9": { name: "x" },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "index_cross_type_double",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 14,
+            "6": 13,
+            "7": 16,
+            "8": 24,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hello" },
+                            },
+                          },
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "9", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { stringValue: "hello" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "33",
+    },
+    {
+      original: {
+        name: "index_cross_type_double_const",
+        expr: "{1: 'hello', 2: 'world'}[dyn(2.0)] == 'world'",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    {\n      1^#*expr.Constant_Int64Value#:"hello"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      2^#*expr.Constant_Int64Value#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    dyn(\n      2^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  "world"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{1: "hello", 2: "world"}[dyn(2.0)] == "world"',
+      locationAst:
+        '_==_(\n  _[_](\n    {\n      1^#3[1,1]#:"hello"^#4[1,4]#^#2[1,2]#,\n      2^#6[1,13]#:"world"^#7[1,16]#^#5[1,14]#\n    }^#1[1,0]#,\n    dyn(\n      2^#10[1,29]#\n    )^#9[1,28]#\n  )^#8[1,24]#,\n  "world"^#12[1,38]#\n)^#11[1,35]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 11, 1, 4, 1, 11],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 23, 1, 16, 1, 23],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 29, 32, 1, 29, 1, 32],
+        [11, 35, 37, 1, 35, 1, 37],
+        [12, 38, 45, 1, 38, 1, 45],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hello" },
+                            },
+                          },
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "10", constExpr: { doubleValue: 2 } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "12", constExpr: { stringValue: "world" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_cross_type_double_const",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 14,
+            "6": 13,
+            "7": 16,
+            "8": 24,
+            "9": 28,
+            "10": 29,
+            "11": 35,
+            "12": 38,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  _[_](\n    {\n      1~int:"hello"~string,\n      2~int:"world"~string\n    }~map(int, string),\n    dyn(\n      2~double\n    )~dyn^to_dyn\n  )~string^index_map,\n  "world"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["index_map"] },
+          "9": { overloadId: ["to_dyn"] },
+          "11": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { dyn: {} },
+          "10": { primitive: "DOUBLE" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "index_cross_type_double_const",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 14,
+            "6": 13,
+            "7": 16,
+            "8": 24,
+            "9": 28,
+            "10": 29,
+            "11": 35,
+            "12": 38,
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hello" },
+                            },
+                          },
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "10", constExpr: { doubleValue: 2 } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "12", constExpr: { stringValue: "world" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "34",
+    },
+    {
+      original: {
+        name: "index_cross_type_uint",
+        expr: "{1: 'hello', 2: 'world'}[dyn(2u)] == 'world'",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    {\n      1^#*expr.Constant_Int64Value#:"hello"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      2^#*expr.Constant_Int64Value#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    dyn(\n      2u^#*expr.Constant_Uint64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  "world"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{1: "hello", 2: "world"}[dyn(2u)] == "world"',
+      locationAst:
+        '_==_(\n  _[_](\n    {\n      1^#3[1,1]#:"hello"^#4[1,4]#^#2[1,2]#,\n      2^#6[1,13]#:"world"^#7[1,16]#^#5[1,14]#\n    }^#1[1,0]#,\n    dyn(\n      2u^#10[1,29]#\n    )^#9[1,28]#\n  )^#8[1,24]#,\n  "world"^#12[1,37]#\n)^#11[1,34]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 11, 1, 4, 1, 11],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 23, 1, 16, 1, 23],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 29, 31, 1, 29, 1, 31],
+        [11, 34, 36, 1, 34, 1, 36],
+        [12, 37, 44, 1, 37, 1, 44],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hello" },
+                            },
+                          },
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "10", constExpr: { uint64Value: "2" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "12", constExpr: { stringValue: "world" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_cross_type_uint",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 14,
+            "6": 13,
+            "7": 16,
+            "8": 24,
+            "9": 28,
+            "10": 29,
+            "11": 34,
+            "12": 37,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  _[_](\n    {\n      1~int:"hello"~string,\n      2~int:"world"~string\n    }~map(int, string),\n    dyn(\n      2u~uint\n    )~dyn^to_dyn\n  )~string^index_map,\n  "world"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["index_map"] },
+          "9": { overloadId: ["to_dyn"] },
+          "11": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { dyn: {} },
+          "10": { primitive: "UINT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "index_cross_type_uint",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 14,
+            "6": 13,
+            "7": 16,
+            "8": 24,
+            "9": 28,
+            "10": 29,
+            "11": 34,
+            "12": 37,
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hello" },
+                            },
+                          },
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "10", constExpr: { uint64Value: "2" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "12", constExpr: { stringValue: "world" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "34",
+    },
+    {
+      original: {
+        name: "index_cross_type_bad_qualifier",
+        expr: "{1: 'hello', 2: 'world'}[x] == 'world'",
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+        bindings: {
+          x: {
+            value: {
+              objectValue: {
+                "@type": "type.googleapis.com/google.protobuf.Duration",
+                value: "0.001s",
+              },
+            },
+          },
+        },
+        evalError: { errors: [{ message: "invalid qualifier type" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    {\n      1^#*expr.Constant_Int64Value#:"hello"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n      2^#*expr.Constant_Int64Value#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  "world"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{1: "hello", 2: "world"}[x] == "world"',
+      locationAst:
+        '_==_(\n  _[_](\n    {\n      1^#3[1,1]#:"hello"^#4[1,4]#^#2[1,2]#,\n      2^#6[1,13]#:"world"^#7[1,16]#^#5[1,14]#\n    }^#1[1,0]#,\n    x^#9[1,25]#\n  )^#8[1,24]#,\n  "world"^#11[1,31]#\n)^#10[1,28]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 11, 1, 4, 1, 11],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 23, 1, 16, 1, 23],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 28, 30, 1, 28, 1, 30],
+        [11, 31, 38, 1, 31, 1, 38],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hello" },
+                            },
+                          },
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "9", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { stringValue: "world" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_cross_type_bad_qualifier",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 14,
+            "6": 13,
+            "7": 16,
+            "8": 24,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  _[_](\n    {\n      1~int:"hello"~string,\n      2~int:"world"~string\n    }~map(int, string),\n    x~dyn^x\n  )~string^index_map,\n  "world"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["index_map"] },
+          "9": { name: "x" },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "index_cross_type_bad_qualifier",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 14,
+            "6": 13,
+            "7": 16,
+            "8": 24,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "2",
+                            mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                            value: {
+                              id: "4",
+                              constExpr: { stringValue: "hello" },
+                            },
+                          },
+                          {
+                            id: "5",
+                            mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                            value: {
+                              id: "7",
+                              constExpr: { stringValue: "world" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "9", identExpr: { name: "x" } },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { stringValue: "world" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "invalid qualifier type: types.Duration" },
+          ],
+        },
+      },
+      runtimeCost: "33",
+    },
+    {
+      original: {
+        name: "index_list_int_double_type_index",
+        expr: "[7, 8, 9][dyn(0.0)] == 7",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_==_(\n  _[_](\n    [\n      7^#*expr.Constant_Int64Value#,\n      8^#*expr.Constant_Int64Value#,\n      9^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    dyn(\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  7^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[7, 8, 9][dyn(0.0)] == 7",
+      locationAst:
+        "_==_(\n  _[_](\n    [\n      7^#2[1,1]#,\n      8^#3[1,4]#,\n      9^#4[1,7]#\n    ]^#1[1,0]#,\n    dyn(\n      0^#7[1,14]#\n    )^#6[1,13]#\n  )^#5[1,9]#,\n  7^#9[1,23]#\n)^#8[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 14, 17, 1, 14, 1, 17],
+        [8, 20, 22, 1, 20, 1, 22],
+        [9, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "7" } },
+                          { id: "3", constExpr: { int64Value: "8" } },
+                          { id: "4", constExpr: { int64Value: "9" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "7", constExpr: { doubleValue: 0 } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_list_int_double_type_index",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 14,
+            "8": 20,
+            "9": 23,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _[_](\n    [\n      7~int,\n      8~int,\n      9~int\n    ]~list(int),\n    dyn(\n      0~double\n    )~dyn^to_dyn\n  )~int^index_list,\n  7~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["index_list"] },
+          "6": { overloadId: ["to_dyn"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "index_list_int_double_type_index",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 14,
+            "8": 20,
+            "9": 23,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "7" } },
+                          { id: "3", constExpr: { int64Value: "8" } },
+                          { id: "4", constExpr: { int64Value: "9" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "7", constExpr: { doubleValue: 0 } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "index_list_int_uint_type_index",
+        expr: "[7, 8, 9][dyn(0u)] == 7",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_==_(\n  _[_](\n    [\n      7^#*expr.Constant_Int64Value#,\n      8^#*expr.Constant_Int64Value#,\n      9^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    dyn(\n      0u^#*expr.Constant_Uint64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  7^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[7, 8, 9][dyn(0u)] == 7",
+      locationAst:
+        "_==_(\n  _[_](\n    [\n      7^#2[1,1]#,\n      8^#3[1,4]#,\n      9^#4[1,7]#\n    ]^#1[1,0]#,\n    dyn(\n      0u^#7[1,14]#\n    )^#6[1,13]#\n  )^#5[1,9]#,\n  7^#9[1,22]#\n)^#8[1,19]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 14, 16, 1, 14, 1, 16],
+        [8, 19, 21, 1, 19, 1, 21],
+        [9, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "7" } },
+                          { id: "3", constExpr: { int64Value: "8" } },
+                          { id: "4", constExpr: { int64Value: "9" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "7", constExpr: { uint64Value: "0" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_list_int_uint_type_index",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 14,
+            "8": 19,
+            "9": 22,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _[_](\n    [\n      7~int,\n      8~int,\n      9~int\n    ]~list(int),\n    dyn(\n      0u~uint\n    )~dyn^to_dyn\n  )~int^index_list,\n  7~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["index_list"] },
+          "6": { overloadId: ["to_dyn"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+          "7": { primitive: "UINT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "index_list_int_uint_type_index",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 14,
+            "8": 19,
+            "9": 22,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "7" } },
+                          { id: "3", constExpr: { int64Value: "8" } },
+                          { id: "4", constExpr: { int64Value: "9" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "7", constExpr: { uint64Value: "0" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "index_list_int_bad_double_type_index",
+        expr: "[7, 8, 9][dyn(0.1)] == 7",
+        evalError: { errors: [{ message: "unsupported index value" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_==_(\n  _[_](\n    [\n      7^#*expr.Constant_Int64Value#,\n      8^#*expr.Constant_Int64Value#,\n      9^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    dyn(\n      0.1^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  7^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[7, 8, 9][dyn(0.1)] == 7",
+      locationAst:
+        "_==_(\n  _[_](\n    [\n      7^#2[1,1]#,\n      8^#3[1,4]#,\n      9^#4[1,7]#\n    ]^#1[1,0]#,\n    dyn(\n      0.1^#7[1,14]#\n    )^#6[1,13]#\n  )^#5[1,9]#,\n  7^#9[1,23]#\n)^#8[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 14, 17, 1, 14, 1, 17],
+        [8, 20, 22, 1, 20, 1, 22],
+        [9, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "7" } },
+                          { id: "3", constExpr: { int64Value: "8" } },
+                          { id: "4", constExpr: { int64Value: "9" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "7", constExpr: { doubleValue: 0.1 } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_list_int_bad_double_type_index",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 14,
+            "8": 20,
+            "9": 23,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _[_](\n    [\n      7~int,\n      8~int,\n      9~int\n    ]~list(int),\n    dyn(\n      0.1~double\n    )~dyn^to_dyn\n  )~int^index_list,\n  7~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["index_list"] },
+          "6": { overloadId: ["to_dyn"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "index_list_int_bad_double_type_index",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 14,
+            "8": 20,
+            "9": 23,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "7" } },
+                          { id: "3", constExpr: { int64Value: "8" } },
+                          { id: "4", constExpr: { int64Value: "9" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "7", constExpr: { doubleValue: 0.1 } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "unsupported index value 0.1 in list" }],
+        },
+      },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "index_relative",
+        expr: "([[[1]], [[2]], [[3]]][0][0] + [2, 3, {'four': {'five': 'six'}}])[3].four.five == 'six'",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    _+_(\n      _[_](\n        _[_](\n          [\n            [\n              [\n                1^#*expr.Constant_Int64Value#\n              ]^#*expr.Expr_ListExpr#\n            ]^#*expr.Expr_ListExpr#,\n            [\n              [\n                2^#*expr.Constant_Int64Value#\n              ]^#*expr.Expr_ListExpr#\n            ]^#*expr.Expr_ListExpr#,\n            [\n              [\n                3^#*expr.Constant_Int64Value#\n              ]^#*expr.Expr_ListExpr#\n            ]^#*expr.Expr_ListExpr#\n          ]^#*expr.Expr_ListExpr#,\n          0^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      [\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#,\n        {\n          "four"^#*expr.Constant_StringValue#:{\n            "five"^#*expr.Constant_StringValue#:"six"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n          }^#*expr.Expr_StructExpr#^#*expr.Expr_CreateStruct_Entry#\n        }^#*expr.Expr_StructExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#.four^#*expr.Expr_SelectExpr#.five^#*expr.Expr_SelectExpr#,\n  "six"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '([[[1]], [[2]], [[3]]][0][0] + [2, 3, {"four": {"five": "six"}}])[3].four.five == "six"',
+      locationAst:
+        '_==_(\n  _[_](\n    _+_(\n      _[_](\n        _[_](\n          [\n            [\n              [\n                1^#4[1,4]#\n              ]^#3[1,3]#\n            ]^#2[1,2]#,\n            [\n              [\n                2^#7[1,11]#\n              ]^#6[1,10]#\n            ]^#5[1,9]#,\n            [\n              [\n                3^#10[1,18]#\n              ]^#9[1,17]#\n            ]^#8[1,16]#\n          ]^#1[1,1]#,\n          0^#12[1,23]#\n        )^#11[1,22]#,\n        0^#14[1,26]#\n      )^#13[1,25]#,\n      [\n        2^#17[1,32]#,\n        3^#18[1,35]#,\n        {\n          "four"^#21[1,39]#:{\n            "five"^#24[1,48]#:"six"^#25[1,56]#^#23[1,54]#\n          }^#22[1,47]#^#20[1,45]#\n        }^#19[1,38]#\n      ]^#16[1,31]#\n    )^#15[1,29]#,\n    3^#27[1,66]#\n  )^#26[1,65]#.four^#28[1,68]#.five^#29[1,73]#,\n  "six"^#31[1,82]#\n)^#30[1,79]#',
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 11, 12, 1, 11, 1, 12],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 17, 18, 1, 17, 1, 18],
+        [10, 18, 19, 1, 18, 1, 19],
+        [11, 22, 23, 1, 22, 1, 23],
+        [12, 23, 24, 1, 23, 1, 24],
+        [13, 25, 26, 1, 25, 1, 26],
+        [14, 26, 27, 1, 26, 1, 27],
+        [15, 29, 30, 1, 29, 1, 30],
+        [16, 31, 32, 1, 31, 1, 32],
+        [17, 32, 33, 1, 32, 1, 33],
+        [18, 35, 36, 1, 35, 1, 36],
+        [19, 38, 39, 1, 38, 1, 39],
+        [20, 45, 46, 1, 45, 1, 46],
+        [21, 39, 45, 1, 39, 1, 45],
+        [22, 47, 48, 1, 47, 1, 48],
+        [23, 54, 55, 1, 54, 1, 55],
+        [24, 48, 54, 1, 48, 1, 54],
+        [25, 56, 61, 1, 56, 1, 61],
+        [26, 65, 66, 1, 65, 1, 66],
+        [27, 66, 67, 1, 66, 1, 67],
+        [28, 68, 69, 1, 68, 1, 69],
+        [29, 73, 74, 1, 73, 1, 74],
+        [30, 79, 81, 1, 79, 1, 81],
+        [31, 82, 87, 1, 82, 1, 87],
+      ],
+      lineOffsets: [88],
+      parsedExpr: {
+        expr: {
+          id: "30",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "29",
+                selectExpr: {
+                  operand: {
+                    id: "28",
+                    selectExpr: {
+                      operand: {
+                        id: "26",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            {
+                              id: "15",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  {
+                                    id: "13",
+                                    callExpr: {
+                                      function: "_[_]",
+                                      args: [
+                                        {
+                                          id: "11",
+                                          callExpr: {
+                                            function: "_[_]",
+                                            args: [
+                                              {
+                                                id: "1",
+                                                listExpr: {
+                                                  elements: [
+                                                    {
+                                                      id: "2",
+                                                      listExpr: {
+                                                        elements: [
+                                                          {
+                                                            id: "3",
+                                                            listExpr: {
+                                                              elements: [
+                                                                {
+                                                                  id: "4",
+                                                                  constExpr: {
+                                                                    int64Value:
+                                                                      "1",
+                                                                  },
+                                                                },
+                                                              ],
+                                                            },
+                                                          },
+                                                        ],
+                                                      },
+                                                    },
+                                                    {
+                                                      id: "5",
+                                                      listExpr: {
+                                                        elements: [
+                                                          {
+                                                            id: "6",
+                                                            listExpr: {
+                                                              elements: [
+                                                                {
+                                                                  id: "7",
+                                                                  constExpr: {
+                                                                    int64Value:
+                                                                      "2",
+                                                                  },
+                                                                },
+                                                              ],
+                                                            },
+                                                          },
+                                                        ],
+                                                      },
+                                                    },
+                                                    {
+                                                      id: "8",
+                                                      listExpr: {
+                                                        elements: [
+                                                          {
+                                                            id: "9",
+                                                            listExpr: {
+                                                              elements: [
+                                                                {
+                                                                  id: "10",
+                                                                  constExpr: {
+                                                                    int64Value:
+                                                                      "3",
+                                                                  },
+                                                                },
+                                                              ],
+                                                            },
+                                                          },
+                                                        ],
+                                                      },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                              {
+                                                id: "12",
+                                                constExpr: { int64Value: "0" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        {
+                                          id: "14",
+                                          constExpr: { int64Value: "0" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  {
+                                    id: "16",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "17",
+                                          constExpr: { int64Value: "2" },
+                                        },
+                                        {
+                                          id: "18",
+                                          constExpr: { int64Value: "3" },
+                                        },
+                                        {
+                                          id: "19",
+                                          structExpr: {
+                                            entries: [
+                                              {
+                                                id: "20",
+                                                mapKey: {
+                                                  id: "21",
+                                                  constExpr: {
+                                                    stringValue: "four",
+                                                  },
+                                                },
+                                                value: {
+                                                  id: "22",
+                                                  structExpr: {
+                                                    entries: [
+                                                      {
+                                                        id: "23",
+                                                        mapKey: {
+                                                          id: "24",
+                                                          constExpr: {
+                                                            stringValue: "five",
+                                                          },
+                                                        },
+                                                        value: {
+                                                          id: "25",
+                                                          constExpr: {
+                                                            stringValue: "six",
+                                                          },
+                                                        },
+                                                      },
+                                                    ],
+                                                  },
+                                                },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            { id: "27", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                      field: "four",
+                    },
+                  },
+                  field: "five",
+                },
+              },
+              { id: "31", constExpr: { stringValue: "six" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_relative",
+          lineOffsets: [88],
+          positions: {
+            "1": 1,
+            "2": 2,
+            "3": 3,
+            "4": 4,
+            "5": 9,
+            "6": 10,
+            "7": 11,
+            "8": 16,
+            "9": 17,
+            "10": 18,
+            "11": 22,
+            "12": 23,
+            "13": 25,
+            "14": 26,
+            "15": 29,
+            "16": 31,
+            "17": 32,
+            "18": 35,
+            "19": 38,
+            "20": 45,
+            "21": 39,
+            "22": 47,
+            "23": 54,
+            "24": 48,
+            "25": 56,
+            "26": 65,
+            "27": 66,
+            "28": 68,
+            "29": 73,
+            "30": 79,
+            "31": 82,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  _[_](\n    _+_(\n      _[_](\n        _[_](\n          [\n            [\n              [\n                1~int\n              ]~list(int)\n            ]~list(list(int)),\n            [\n              [\n                2~int\n              ]~list(int)\n            ]~list(list(int)),\n            [\n              [\n                3~int\n              ]~list(int)\n            ]~list(list(int))\n          ]~list(list(list(int))),\n          0~int\n        )~list(list(int))^index_list,\n        0~int\n      )~list(int)^index_list,\n      [\n        2~int,\n        3~int,\n        {\n          "four"~string:{\n            "five"~string:"six"~string\n          }~map(string, string)\n        }~map(string, map(string, string))\n      ]~list(dyn)\n    )~list(dyn)^add_list,\n    3~int\n  )~dyn^index_list.four~dyn.five~dyn,\n  "six"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "11": { overloadId: ["index_list"] },
+          "13": { overloadId: ["index_list"] },
+          "15": { overloadId: ["add_list"] },
+          "26": { overloadId: ["index_list"] },
+          "30": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: { listType: { elemType: { primitive: "INT64" } } },
+                },
+              },
+            },
+          },
+          "2": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { primitive: "INT64" },
+          "15": { listType: { elemType: { dyn: {} } } },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "INT64" },
+          "19": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "21": { primitive: "STRING" },
+          "22": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "24": { primitive: "STRING" },
+          "25": { primitive: "STRING" },
+          "26": { dyn: {} },
+          "27": { primitive: "INT64" },
+          "28": { dyn: {} },
+          "29": { dyn: {} },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "index_relative",
+          lineOffsets: [88],
+          positions: {
+            "1": 1,
+            "2": 2,
+            "3": 3,
+            "4": 4,
+            "5": 9,
+            "6": 10,
+            "7": 11,
+            "8": 16,
+            "9": 17,
+            "10": 18,
+            "11": 22,
+            "12": 23,
+            "13": 25,
+            "14": 26,
+            "15": 29,
+            "16": 31,
+            "17": 32,
+            "18": 35,
+            "19": 38,
+            "20": 45,
+            "21": 39,
+            "22": 47,
+            "23": 54,
+            "24": 48,
+            "25": 56,
+            "26": 65,
+            "27": 66,
+            "28": 68,
+            "29": 73,
+            "30": 79,
+            "31": 82,
+          },
+        },
+        expr: {
+          id: "30",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "29",
+                selectExpr: {
+                  operand: {
+                    id: "28",
+                    selectExpr: {
+                      operand: {
+                        id: "26",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            {
+                              id: "15",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  {
+                                    id: "13",
+                                    callExpr: {
+                                      function: "_[_]",
+                                      args: [
+                                        {
+                                          id: "11",
+                                          callExpr: {
+                                            function: "_[_]",
+                                            args: [
+                                              {
+                                                id: "1",
+                                                listExpr: {
+                                                  elements: [
+                                                    {
+                                                      id: "2",
+                                                      listExpr: {
+                                                        elements: [
+                                                          {
+                                                            id: "3",
+                                                            listExpr: {
+                                                              elements: [
+                                                                {
+                                                                  id: "4",
+                                                                  constExpr: {
+                                                                    int64Value:
+                                                                      "1",
+                                                                  },
+                                                                },
+                                                              ],
+                                                            },
+                                                          },
+                                                        ],
+                                                      },
+                                                    },
+                                                    {
+                                                      id: "5",
+                                                      listExpr: {
+                                                        elements: [
+                                                          {
+                                                            id: "6",
+                                                            listExpr: {
+                                                              elements: [
+                                                                {
+                                                                  id: "7",
+                                                                  constExpr: {
+                                                                    int64Value:
+                                                                      "2",
+                                                                  },
+                                                                },
+                                                              ],
+                                                            },
+                                                          },
+                                                        ],
+                                                      },
+                                                    },
+                                                    {
+                                                      id: "8",
+                                                      listExpr: {
+                                                        elements: [
+                                                          {
+                                                            id: "9",
+                                                            listExpr: {
+                                                              elements: [
+                                                                {
+                                                                  id: "10",
+                                                                  constExpr: {
+                                                                    int64Value:
+                                                                      "3",
+                                                                  },
+                                                                },
+                                                              ],
+                                                            },
+                                                          },
+                                                        ],
+                                                      },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                              {
+                                                id: "12",
+                                                constExpr: { int64Value: "0" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        {
+                                          id: "14",
+                                          constExpr: { int64Value: "0" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  {
+                                    id: "16",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "17",
+                                          constExpr: { int64Value: "2" },
+                                        },
+                                        {
+                                          id: "18",
+                                          constExpr: { int64Value: "3" },
+                                        },
+                                        {
+                                          id: "19",
+                                          structExpr: {
+                                            entries: [
+                                              {
+                                                id: "20",
+                                                mapKey: {
+                                                  id: "21",
+                                                  constExpr: {
+                                                    stringValue: "four",
+                                                  },
+                                                },
+                                                value: {
+                                                  id: "22",
+                                                  structExpr: {
+                                                    entries: [
+                                                      {
+                                                        id: "23",
+                                                        mapKey: {
+                                                          id: "24",
+                                                          constExpr: {
+                                                            stringValue: "five",
+                                                          },
+                                                        },
+                                                        value: {
+                                                          id: "25",
+                                                          constExpr: {
+                                                            stringValue: "six",
+                                                          },
+                                                        },
+                                                      },
+                                                    ],
+                                                  },
+                                                },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            { id: "27", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                      field: "four",
+                    },
+                  },
+                  field: "five",
+                },
+              },
+              { id: "31", constExpr: { stringValue: "six" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "145", max: "145" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "149",
+    },
+    {
+      original: {
+        name: "list_eq_false_with_error",
+        expr: "['string', 1] == [2, 3]",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  [\n    "string"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["string", 1] == [2, 3]',
+      locationAst:
+        '_==_(\n  [\n    "string"^#2[1,1]#,\n    1^#3[1,11]#\n  ]^#1[1,0]#,\n  [\n    2^#6[1,18]#,\n    3^#7[1,21]#\n  ]^#5[1,17]#\n)^#4[1,14]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 9, 1, 1, 1, 9],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { stringValue: "string" } },
+                    { id: "3", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_eq_false_with_error",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 11,
+            "4": 14,
+            "5": 17,
+            "6": 18,
+            "7": 21,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "string"~string,\n    1~int\n  ]~list(dyn),\n  [\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: { "4": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "list_eq_false_with_error",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 11,
+            "4": 14,
+            "5": 17,
+            "6": 18,
+            "7": 21,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { stringValue: "string" } },
+                    { id: "3", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        name: "list_eq_error",
+        expr: "['string', true] == [2, 3]",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  [\n    "string"^#*expr.Constant_StringValue#,\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["string", true] == [2, 3]',
+      locationAst:
+        '_==_(\n  [\n    "string"^#2[1,1]#,\n    true^#3[1,11]#\n  ]^#1[1,0]#,\n  [\n    2^#6[1,21]#,\n    3^#7[1,24]#\n  ]^#5[1,20]#\n)^#4[1,17]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 9, 1, 1, 1, 9],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { stringValue: "string" } },
+                    { id: "3", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_eq_error",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 11,
+            "4": 17,
+            "5": 20,
+            "6": 21,
+            "7": 24,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "string"~string,\n    true~bool\n  ]~list(dyn),\n  [\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: { "4": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "list_eq_error",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 11,
+            "4": 17,
+            "5": 20,
+            "6": 21,
+            "7": 24,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { stringValue: "string" } },
+                    { id: "3", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        name: "literal_bool_false",
+        expr: "false",
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "false^#*expr.Constant_BoolValue#",
+      unparsed: "false",
+      locationAst: "false^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: false } },
+        sourceInfo: {
+          location: "literal_bool_false",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "false~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "literal_bool_false",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { boolValue: false } },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+    },
+    {
+      original: {
+        name: "literal_bool_true",
+        expr: "true",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "true^#*expr.Constant_BoolValue#",
+      unparsed: "true",
+      locationAst: "true^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: true } },
+        sourceInfo: {
+          location: "literal_bool_true",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "true~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "literal_bool_true",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { boolValue: true } },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+    },
+    {
+      original: {
+        name: "literal_null",
+        expr: "null",
+        value: { nullValue: null },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "null^#*expr.Constant_NullValue#",
+      unparsed: "null",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "literal_null",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "literal_null",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
+      type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+    },
+    {
+      original: {
+        name: "literal_list",
+        expr: "[1, 2, 3]",
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+            ],
+          },
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 2, 3]",
+      locationAst: "[\n  1^#2[1,1]#,\n  2^#3[1,4]#,\n  3^#4[1,7]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_list",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+      },
+      checkedAst: "[\n  1~int,\n  2~int,\n  3~int\n]~list(int)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "literal_list",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+    },
+    {
+      original: {
+        name: "literal_map",
+        expr: "{'hi': 21, 'world': 42u}",
+        value: {
+          mapValue: {
+            entries: [
+              { key: { stringValue: "hi" }, value: { int64Value: "21" } },
+              { key: { stringValue: "world" }, value: { uint64Value: "42" } },
+            ],
+          },
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '{\n  "hi"^#*expr.Constant_StringValue#:21^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  "world"^#*expr.Constant_StringValue#:42u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{"hi": 21, "world": 42u}',
+      locationAst:
+        '{\n  "hi"^#3[1,1]#:21^#4[1,7]#^#2[1,5]#,\n  "world"^#6[1,11]#:42u^#7[1,20]#^#5[1,18]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 1, 5, 1, 1, 1, 5],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 11, 18, 1, 11, 1, 18],
+        [7, 20, 23, 1, 20, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "hi" } },
+                value: { id: "4", constExpr: { int64Value: "21" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { stringValue: "world" } },
+                value: { id: "7", constExpr: { uint64Value: "42" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_map",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 1,
+            "4": 7,
+            "5": 18,
+            "6": 11,
+            "7": 20,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  "hi"~string:21~int,\n  "world"~string:42u~uint\n}~map(string, dyn)',
+      checkedExpr: {
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "literal_map",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 1,
+            "4": 7,
+            "5": 18,
+            "6": 11,
+            "7": 20,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "hi" } },
+                value: { id: "4", constExpr: { int64Value: "21" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { stringValue: "world" } },
+                value: { id: "7", constExpr: { uint64Value: "42" } },
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, dyn)",
+      cost: { min: "30", max: "30" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              { key: { stringValue: "hi" }, value: { int64Value: "21" } },
+              { key: { stringValue: "world" }, value: { uint64Value: "42" } },
+            ],
+          },
+        },
+      },
+      runtimeCost: "30",
+    },
+    {
+      original: {
+        name: "literal_equiv_string_bytes",
+        expr: "string(bytes(\"\\303\\277\")) == '''\\303\\277'''",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  string(\n    bytes(\n      "Ã¿"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  "Ã¿"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'string(bytes("Ã¿")) == "Ã¿"',
+      locationAst:
+        '_==_(\n  string(\n    bytes(\n      "Ã¿"^#3[1,13]#\n    )^#2[1,12]#\n  )^#1[1,6]#,\n  "Ã¿"^#5[1,29]#\n)^#4[1,26]#',
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 23, 1, 13, 1, 23],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 29, 43, 1, 29, 1, 43],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "bytes",
+                        args: [{ id: "3", constExpr: { stringValue: "Ã¿" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "Ã¿" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_equiv_string_bytes",
+          lineOffsets: [44],
+          positions: { "1": 6, "2": 12, "3": 13, "4": 26, "5": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  string(\n    bytes(\n      "Ã¿"~string\n    )~bytes^string_to_bytes\n  )~string^bytes_to_string,\n  "Ã¿"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["bytes_to_string"] },
+          "2": { overloadId: ["string_to_bytes"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BYTES" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "literal_equiv_string_bytes",
+          lineOffsets: [44],
+          positions: { "1": 6, "2": 12, "3": 13, "4": 26, "5": 29 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "bytes",
+                        args: [{ id: "3", constExpr: { stringValue: "Ã¿" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "Ã¿" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "literal_not_equiv_string_bytes",
+        expr: "string(b\"\\303\\277\") != '''\\303\\277'''",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_!=_(\n  string(\n    b"ÿ"^#*expr.Constant_BytesValue#\n  )^#*expr.Expr_CallExpr#,\n  "Ã¿"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'string(b"\\303\\277") != "Ã¿"',
+      locationAst:
+        '_!=_(\n  string(\n    b"ÿ"^#2[1,7]#\n  )^#1[1,6]#,\n  "Ã¿"^#4[1,23]#\n)^#3[1,20]#',
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 18, 1, 7, 1, 18],
+        [3, 20, 22, 1, 20, 1, 22],
+        [4, 23, 37, 1, 23, 1, 37],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "2", constExpr: { bytesValue: "w78=" } }],
+                },
+              },
+              { id: "4", constExpr: { stringValue: "Ã¿" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_not_equiv_string_bytes",
+          lineOffsets: [38],
+          positions: { "1": 6, "2": 7, "3": 20, "4": 23 },
+        },
+      },
+      checkedAst:
+        '_!=_(\n  string(\n    b"ÿ"~bytes\n  )~string^bytes_to_string,\n  "Ã¿"~string\n)~bool^not_equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["bytes_to_string"] },
+          "3": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BYTES" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "literal_not_equiv_string_bytes",
+          lineOffsets: [38],
+          positions: { "1": 6, "2": 7, "3": 20, "4": 23 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "2", constExpr: { bytesValue: "w78=" } }],
+                },
+              },
+              { id: "4", constExpr: { stringValue: "Ã¿" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "literal_equiv_bytes_string",
+        expr: "string(b\"\\303\\277\") == 'ÿ'",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  string(\n    b"ÿ"^#*expr.Constant_BytesValue#\n  )^#*expr.Expr_CallExpr#,\n  "ÿ"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'string(b"\\303\\277") == "ÿ"',
+      locationAst:
+        '_==_(\n  string(\n    b"ÿ"^#2[1,7]#\n  )^#1[1,6]#,\n  "ÿ"^#4[1,23]#\n)^#3[1,20]#',
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 18, 1, 7, 1, 18],
+        [3, 20, 22, 1, 20, 1, 22],
+        [4, 23, 27, 1, 23, 2, 0],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "2", constExpr: { bytesValue: "w78=" } }],
+                },
+              },
+              { id: "4", constExpr: { stringValue: "ÿ" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_equiv_bytes_string",
+          lineOffsets: [27],
+          positions: { "1": 6, "2": 7, "3": 20, "4": 23 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  string(\n    b"ÿ"~bytes\n  )~string^bytes_to_string,\n  "ÿ"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["bytes_to_string"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BYTES" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "literal_equiv_bytes_string",
+          lineOffsets: [27],
+          positions: { "1": 6, "2": 7, "3": 20, "4": 23 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "string",
+                  args: [{ id: "2", constExpr: { bytesValue: "w78=" } }],
+                },
+              },
+              { id: "4", constExpr: { stringValue: "ÿ" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "literal_bytes_string",
+        expr: "string(b'aaa\"bbb')",
+        value: { stringValue: 'aaa"bbb' },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: 'string(\n  b"aaa\\"bbb"^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'string(b"\\141\\141\\141\\042\\142\\142\\142")',
+      locationAst: 'string(\n  b"aaa\\"bbb"^#2[1,7]#\n)^#1[1,6]#',
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 17, 1, 7, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", constExpr: { bytesValue: "YWFhImJiYg==" } }],
+          },
+        },
+        sourceInfo: {
+          location: "literal_bytes_string",
+          lineOffsets: [19],
+          positions: { "1": 6, "2": 7 },
+        },
+      },
+      checkedAst: 'string(\n  b"aaa\\"bbb"~bytes\n)~string^bytes_to_string',
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["bytes_to_string"] } },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "literal_bytes_string",
+          lineOffsets: [19],
+          positions: { "1": 6, "2": 7 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", constExpr: { bytesValue: "YWFhImJiYg==" } }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1" },
+      result: { value: { stringValue: 'aaa"bbb' } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "literal_bytes_string2",
+        expr: 'string(b"""Kim\\t""")',
+        value: { stringValue: "Kim\t" },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: 'string(\n  b"Kim\\t"^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'string(b"\\113\\151\\155\\011")',
+      locationAst: 'string(\n  b"Kim\\t"^#2[1,7]#\n)^#1[1,6]#',
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 19, 1, 7, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", constExpr: { bytesValue: "S2ltCQ==" } }],
+          },
+        },
+        sourceInfo: {
+          location: "literal_bytes_string2",
+          lineOffsets: [21],
+          positions: { "1": 6, "2": 7 },
+        },
+      },
+      checkedAst: 'string(\n  b"Kim\\t"~bytes\n)~string^bytes_to_string',
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["bytes_to_string"] } },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "literal_bytes_string2",
+          lineOffsets: [21],
+          positions: { "1": 6, "2": 7 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", constExpr: { bytesValue: "S2ltCQ==" } }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1" },
+      result: { value: { stringValue: "Kim\t" } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "literal_pb_wrapper_assign_roundtrip",
+        expr: "TestAllTypes{\n\t\t\t\tsingle_int32_wrapper: TestAllTypes{}.single_int32_wrapper,\n\t\t\t}.single_int32_wrapper == null",
+        container: "google.expr.proto3.test",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_==_(\n  TestAllTypes{\n    single_int32_wrapper:TestAllTypes{}^#*expr.Expr_StructExpr#.single_int32_wrapper^#*expr.Expr_SelectExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.single_int32_wrapper^#*expr.Expr_SelectExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "TestAllTypes{single_int32_wrapper: TestAllTypes{}.single_int32_wrapper}.single_int32_wrapper == null",
+      locationAst:
+        "_==_(\n  TestAllTypes{\n    single_int32_wrapper:TestAllTypes{}^#3[2,38]#.single_int32_wrapper^#4[2,40]#^#2[2,24]#\n  }^#1[1,12]#.single_int32_wrapper^#5[3,4]#,\n  null^#7[3,29]#\n)^#6[3,26]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 38, 39, 2, 24, 2, 25],
+        [3, 52, 53, 2, 38, 2, 39],
+        [4, 54, 55, 2, 40, 2, 41],
+        [5, 81, 82, 3, 4, 3, 5],
+        [6, 103, 105, 3, 26, 3, 28],
+        [7, 106, 110, 3, 29, 3, 33],
+      ],
+      lineOffsets: [14, 77, 111],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                selectExpr: {
+                  operand: {
+                    id: "1",
+                    structExpr: {
+                      messageName: "TestAllTypes",
+                      entries: [
+                        {
+                          id: "2",
+                          fieldKey: "single_int32_wrapper",
+                          value: {
+                            id: "4",
+                            selectExpr: {
+                              operand: {
+                                id: "3",
+                                structExpr: { messageName: "TestAllTypes" },
+                              },
+                              field: "single_int32_wrapper",
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  field: "single_int32_wrapper",
+                },
+              },
+              { id: "7", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_pb_wrapper_assign_roundtrip",
+          lineOffsets: [14, 77, 111],
+          positions: {
+            "1": 12,
+            "2": 38,
+            "3": 52,
+            "4": 54,
+            "5": 81,
+            "6": 103,
+            "7": 106,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  google.expr.proto3.test.TestAllTypes{\n    single_int32_wrapper:google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes.single_int32_wrapper~wrapper(int)\n  }~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes.single_int32_wrapper~wrapper(int),\n  null~null\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto3.test.TestAllTypes" },
+          "3": { name: "google.expr.proto3.test.TestAllTypes" },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "4": { wrapper: "INT64" },
+          "5": { wrapper: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { null: null },
+        },
+        sourceInfo: {
+          location: "literal_pb_wrapper_assign_roundtrip",
+          lineOffsets: [14, 77, 111],
+          positions: {
+            "1": 12,
+            "2": 38,
+            "3": 52,
+            "4": 54,
+            "5": 81,
+            "6": 103,
+            "7": 106,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                selectExpr: {
+                  operand: {
+                    id: "1",
+                    structExpr: {
+                      messageName: "google.expr.proto3.test.TestAllTypes",
+                      entries: [
+                        {
+                          id: "2",
+                          fieldKey: "single_int32_wrapper",
+                          value: {
+                            id: "4",
+                            selectExpr: {
+                              operand: {
+                                id: "3",
+                                structExpr: {
+                                  messageName:
+                                    "google.expr.proto3.test.TestAllTypes",
+                                },
+                              },
+                              field: "single_int32_wrapper",
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  field: "single_int32_wrapper",
+                },
+              },
+              { id: "7", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "83", max: "83" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "85",
+    },
+    {
+      original: {
+        name: "literal_pb_list_assign_null_wrapper",
+        expr: "TestAllTypes{\n\t\t\t\trepeated_int32: [123, 456, TestAllTypes{}.single_int32_wrapper],\n\t\t\t}",
+        container: "google.expr.proto3.test",
+        evalError: { errors: [{ message: "field type conversion error" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "TestAllTypes{\n  repeated_int32:[\n    123^#*expr.Constant_Int64Value#,\n    456^#*expr.Constant_Int64Value#,\n    TestAllTypes{}^#*expr.Expr_StructExpr#.single_int32_wrapper^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed:
+        "TestAllTypes{repeated_int32: [123, 456, TestAllTypes{}.single_int32_wrapper]}",
+      locationAst:
+        "TestAllTypes{\n  repeated_int32:[\n    123^#4[2,21]#,\n    456^#5[2,26]#,\n    TestAllTypes{}^#6[2,43]#.single_int32_wrapper^#7[2,45]#\n  ]^#3[2,20]#^#2[2,18]#\n}^#1[1,12]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 32, 33, 2, 18, 2, 19],
+        [3, 34, 35, 2, 20, 2, 21],
+        [4, 35, 38, 2, 21, 2, 24],
+        [5, 40, 43, 2, 26, 2, 29],
+        [6, 57, 58, 2, 43, 2, 44],
+        [7, 59, 60, 2, 45, 2, 46],
+      ],
+      lineOffsets: [14, 83, 88],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "repeated_int32",
+                value: {
+                  id: "3",
+                  listExpr: {
+                    elements: [
+                      { id: "4", constExpr: { int64Value: "123" } },
+                      { id: "5", constExpr: { int64Value: "456" } },
+                      {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            structExpr: { messageName: "TestAllTypes" },
+                          },
+                          field: "single_int32_wrapper",
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_pb_list_assign_null_wrapper",
+          lineOffsets: [14, 83, 88],
+          positions: {
+            "1": 12,
+            "2": 32,
+            "3": 34,
+            "4": 35,
+            "5": 40,
+            "6": 57,
+            "7": 59,
+          },
+        },
+      },
+      checkedAst:
+        "google.expr.proto3.test.TestAllTypes{\n  repeated_int32:[\n    123~int,\n    456~int,\n    google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes.single_int32_wrapper~wrapper(int)\n  ]~list(int)\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto3.test.TestAllTypes" },
+          "6": { name: "google.expr.proto3.test.TestAllTypes" },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "7": { wrapper: "INT64" },
+        },
+        sourceInfo: {
+          location: "literal_pb_list_assign_null_wrapper",
+          lineOffsets: [14, 83, 88],
+          positions: {
+            "1": 12,
+            "2": 32,
+            "3": 34,
+            "4": 35,
+            "5": 40,
+            "6": 57,
+            "7": 59,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "repeated_int32",
+                value: {
+                  id: "3",
+                  listExpr: {
+                    elements: [
+                      { id: "4", constExpr: { int64Value: "123" } },
+                      { id: "5", constExpr: { int64Value: "456" } },
+                      {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            structExpr: {
+                              messageName:
+                                "google.expr.proto3.test.TestAllTypes",
+                            },
+                          },
+                          field: "single_int32_wrapper",
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "91", max: "91" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "field type conversion error for google.expr.proto3.test.TestAllTypes.repeated_int32 value type: type conversion error from 'null_type' to 'int32'",
+            },
+          ],
+        },
+      },
+      runtimeCost: "92",
+    },
+    {
+      original: {
+        name: "literal_pb_map_assign_null_entry_value",
+        expr: "TestAllTypes{\n\t\t\t\tmap_string_string: {\n\t\t\t\t\t'hello': 'world',\n\t\t\t\t\t'goodbye': TestAllTypes{}.single_string_wrapper,\n\t\t\t\t},\n\t\t\t}",
+        container: "google.expr.proto3.test",
+        evalError: { errors: [{ message: "field type conversion error" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: 'TestAllTypes{\n  map_string_string:{\n    "hello"^#*expr.Constant_StringValue#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#,\n    "goodbye"^#*expr.Constant_StringValue#:TestAllTypes{}^#*expr.Expr_StructExpr#.single_string_wrapper^#*expr.Expr_SelectExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed:
+        'TestAllTypes{map_string_string: {"hello": "world", "goodbye": TestAllTypes{}.single_string_wrapper}}',
+      locationAst:
+        'TestAllTypes{\n  map_string_string:{\n    "hello"^#5[3,5]#:"world"^#6[3,14]#^#4[3,12]#,\n    "goodbye"^#8[4,5]#:TestAllTypes{}^#9[4,28]#.single_string_wrapper^#10[4,30]#^#7[4,14]#\n  }^#3[2,23]#^#2[2,21]#\n}^#1[1,12]#',
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 35, 36, 2, 21, 2, 22],
+        [3, 37, 38, 2, 23, 2, 24],
+        [4, 51, 52, 3, 12, 3, 13],
+        [5, 44, 51, 3, 5, 3, 12],
+        [6, 53, 60, 3, 14, 3, 21],
+        [7, 76, 77, 4, 14, 4, 15],
+        [8, 67, 76, 4, 5, 4, 14],
+        [9, 90, 91, 4, 28, 4, 29],
+        [10, 92, 93, 4, 30, 4, 31],
+      ],
+      lineOffsets: [14, 39, 62, 116, 123, 128],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "map_string_string",
+                value: {
+                  id: "3",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "4",
+                        mapKey: {
+                          id: "5",
+                          constExpr: { stringValue: "hello" },
+                        },
+                        value: { id: "6", constExpr: { stringValue: "world" } },
+                      },
+                      {
+                        id: "7",
+                        mapKey: {
+                          id: "8",
+                          constExpr: { stringValue: "goodbye" },
+                        },
+                        value: {
+                          id: "10",
+                          selectExpr: {
+                            operand: {
+                              id: "9",
+                              structExpr: { messageName: "TestAllTypes" },
+                            },
+                            field: "single_string_wrapper",
+                          },
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_pb_map_assign_null_entry_value",
+          lineOffsets: [14, 39, 62, 116, 123, 128],
+          positions: {
+            "1": 12,
+            "2": 35,
+            "3": 37,
+            "4": 51,
+            "5": 44,
+            "6": 53,
+            "7": 76,
+            "8": 67,
+            "9": 90,
+            "10": 92,
+          },
+        },
+      },
+      checkedAst:
+        'google.expr.proto3.test.TestAllTypes{\n  map_string_string:{\n    "hello"~string:"world"~string,\n    "goodbye"~string:google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes.single_string_wrapper~wrapper(string)\n  }~map(string, string)\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto3.test.TestAllTypes" },
+          "9": { name: "google.expr.proto3.test.TestAllTypes" },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "10": { wrapper: "STRING" },
+        },
+        sourceInfo: {
+          location: "literal_pb_map_assign_null_entry_value",
+          lineOffsets: [14, 39, 62, 116, 123, 128],
+          positions: {
+            "1": 12,
+            "2": 35,
+            "3": 37,
+            "4": 51,
+            "5": 44,
+            "6": 53,
+            "7": 76,
+            "8": 67,
+            "9": 90,
+            "10": 92,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "map_string_string",
+                value: {
+                  id: "3",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "4",
+                        mapKey: {
+                          id: "5",
+                          constExpr: { stringValue: "hello" },
+                        },
+                        value: { id: "6", constExpr: { stringValue: "world" } },
+                      },
+                      {
+                        id: "7",
+                        mapKey: {
+                          id: "8",
+                          constExpr: { stringValue: "goodbye" },
+                        },
+                        value: {
+                          id: "10",
+                          selectExpr: {
+                            operand: {
+                              id: "9",
+                              structExpr: {
+                                messageName:
+                                  "google.expr.proto3.test.TestAllTypes",
+                              },
+                            },
+                            field: "single_string_wrapper",
+                          },
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "111", max: "111" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "field type conversion error for google.expr.proto3.test.TestAllTypes.map_string_string value type: type conversion error from 'null_type' to 'string'",
+            },
+          ],
+        },
+      },
+      runtimeCost: "112",
+    },
+    {
+      original: {
+        name: "unset_wrapper_access",
+        expr: "TestAllTypes{}.single_string_wrapper",
+        container: "google.expr.proto3.test",
+        value: { nullValue: null },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "TestAllTypes{}^#*expr.Expr_StructExpr#.single_string_wrapper^#*expr.Expr_SelectExpr#",
+      unparsed: "TestAllTypes{}.single_string_wrapper",
+      locationAst: "TestAllTypes{}^#1[1,12]#.single_string_wrapper^#2[1,14]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", structExpr: { messageName: "TestAllTypes" } },
+            field: "single_string_wrapper",
+          },
+        },
+        sourceInfo: {
+          location: "unset_wrapper_access",
+          lineOffsets: [37],
+          positions: { "1": 12, "2": 14 },
+        },
+      },
+      checkedAst:
+        "google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes.single_string_wrapper~wrapper(string)",
+      checkedExpr: {
+        referenceMap: { "1": { name: "google.expr.proto3.test.TestAllTypes" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { wrapper: "STRING" },
+        },
+        sourceInfo: {
+          location: "unset_wrapper_access",
+          lineOffsets: [37],
+          positions: { "1": 12, "2": 14 },
+        },
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: {
+              id: "1",
+              structExpr: {
+                messageName: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+            field: "single_string_wrapper",
+          },
+        },
+      },
+      type: "wrapper(string)",
+      cost: { min: "41", max: "41" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "42",
+    },
+    {
+      original: {
+        name: "timestamp_eq_timestamp",
+        expr: "timestamp(0) == timestamp(0)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_==_(\n  timestamp(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  timestamp(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "timestamp(0) == timestamp(0)",
+      locationAst:
+        "_==_(\n  timestamp(\n    0^#2[1,10]#\n  )^#1[1,9]#,\n  timestamp(\n    0^#5[1,26]#\n  )^#4[1,25]#\n)^#3[1,13]#",
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 13, 15, 1, 13, 1, 15],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "0" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp_eq_timestamp",
+          lineOffsets: [29],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 25, "5": 26 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  timestamp(\n    0~int\n  )~timestamp^int64_to_timestamp,\n  timestamp(\n    0~int\n  )~timestamp^int64_to_timestamp\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["int64_to_timestamp"] },
+          "3": { overloadId: ["equals"] },
+          "4": { overloadId: ["int64_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "timestamp_eq_timestamp",
+          lineOffsets: [29],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 25, "5": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "0" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "timestamp_ne_timestamp",
+        expr: "timestamp(1) != timestamp(2)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_!=_(\n  timestamp(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  timestamp(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "timestamp(1) != timestamp(2)",
+      locationAst:
+        "_!=_(\n  timestamp(\n    1^#2[1,10]#\n  )^#1[1,9]#,\n  timestamp(\n    2^#5[1,26]#\n  )^#4[1,25]#\n)^#3[1,13]#",
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 13, 15, 1, 13, 1, 15],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp_ne_timestamp",
+          lineOffsets: [29],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 25, "5": 26 },
+        },
+      },
+      checkedAst:
+        "_!=_(\n  timestamp(\n    1~int\n  )~timestamp^int64_to_timestamp,\n  timestamp(\n    2~int\n  )~timestamp^int64_to_timestamp\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["int64_to_timestamp"] },
+          "3": { overloadId: ["not_equals"] },
+          "4": { overloadId: ["int64_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "timestamp_ne_timestamp",
+          lineOffsets: [29],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 25, "5": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "timestamp_lt_timestamp",
+        expr: "timestamp(0) \u003c timestamp(1)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u003c_(\n  timestamp(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  timestamp(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "timestamp(0) \u003c timestamp(1)",
+      locationAst:
+        "_\u003c_(\n  timestamp(\n    0^#2[1,10]#\n  )^#1[1,9]#,\n  timestamp(\n    1^#5[1,25]#\n  )^#4[1,24]#\n)^#3[1,13]#",
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "0" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp_lt_timestamp",
+          lineOffsets: [28],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 24, "5": 25 },
+        },
+      },
+      checkedAst:
+        "_\u003c_(\n  timestamp(\n    0~int\n  )~timestamp^int64_to_timestamp,\n  timestamp(\n    1~int\n  )~timestamp^int64_to_timestamp\n)~bool^less_timestamp",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["int64_to_timestamp"] },
+          "3": { overloadId: ["less_timestamp"] },
+          "4": { overloadId: ["int64_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "timestamp_lt_timestamp",
+          lineOffsets: [28],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 24, "5": 25 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "0" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "timestamp_le_timestamp",
+        expr: "timestamp(2) \u003c= timestamp(2)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u003c=_(\n  timestamp(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  timestamp(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "timestamp(2) \u003c= timestamp(2)",
+      locationAst:
+        "_\u003c=_(\n  timestamp(\n    2^#2[1,10]#\n  )^#1[1,9]#,\n  timestamp(\n    2^#5[1,26]#\n  )^#4[1,25]#\n)^#3[1,13]#",
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 13, 15, 1, 13, 1, 15],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003c=_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp_le_timestamp",
+          lineOffsets: [29],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 25, "5": 26 },
+        },
+      },
+      checkedAst:
+        "_\u003c=_(\n  timestamp(\n    2~int\n  )~timestamp^int64_to_timestamp,\n  timestamp(\n    2~int\n  )~timestamp^int64_to_timestamp\n)~bool^less_equals_timestamp",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["int64_to_timestamp"] },
+          "3": { overloadId: ["less_equals_timestamp"] },
+          "4": { overloadId: ["int64_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "timestamp_le_timestamp",
+          lineOffsets: [29],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 25, "5": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003c=_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "timestamp_gt_timestamp",
+        expr: "timestamp(1) \u003e timestamp(0)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u003e_(\n  timestamp(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  timestamp(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "timestamp(1) \u003e timestamp(0)",
+      locationAst:
+        "_\u003e_(\n  timestamp(\n    1^#2[1,10]#\n  )^#1[1,9]#,\n  timestamp(\n    0^#5[1,25]#\n  )^#4[1,24]#\n)^#3[1,13]#",
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp_gt_timestamp",
+          lineOffsets: [28],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 24, "5": 25 },
+        },
+      },
+      checkedAst:
+        "_\u003e_(\n  timestamp(\n    1~int\n  )~timestamp^int64_to_timestamp,\n  timestamp(\n    0~int\n  )~timestamp^int64_to_timestamp\n)~bool^greater_timestamp",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["int64_to_timestamp"] },
+          "3": { overloadId: ["greater_timestamp"] },
+          "4": { overloadId: ["int64_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "timestamp_gt_timestamp",
+          lineOffsets: [28],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 24, "5": 25 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "timestamp_ge_timestamp",
+        expr: "timestamp(2) \u003e= timestamp(2)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u003e=_(\n  timestamp(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  timestamp(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "timestamp(2) \u003e= timestamp(2)",
+      locationAst:
+        "_\u003e=_(\n  timestamp(\n    2^#2[1,10]#\n  )^#1[1,9]#,\n  timestamp(\n    2^#5[1,26]#\n  )^#4[1,25]#\n)^#3[1,13]#",
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 13, 15, 1, 13, 1, 15],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "timestamp_ge_timestamp",
+          lineOffsets: [29],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 25, "5": 26 },
+        },
+      },
+      checkedAst:
+        "_\u003e=_(\n  timestamp(\n    2~int\n  )~timestamp^int64_to_timestamp,\n  timestamp(\n    2~int\n  )~timestamp^int64_to_timestamp\n)~bool^greater_equals_timestamp",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["int64_to_timestamp"] },
+          "3": { overloadId: ["greater_equals_timestamp"] },
+          "4": { overloadId: ["int64_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "timestamp_ge_timestamp",
+          lineOffsets: [29],
+          positions: { "1": 9, "2": 10, "3": 13, "4": 25, "5": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "2", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "2" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "string_to_timestamp",
+        expr: "timestamp('1986-04-26T01:23:40Z')",
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.protobuf.Timestamp",
+            value: "1986-04-26T01:23:40Z",
+          },
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: 'timestamp(\n  "1986-04-26T01:23:40Z"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'timestamp("1986-04-26T01:23:40Z")',
+      locationAst: 'timestamp(\n  "1986-04-26T01:23:40Z"^#2[1,10]#\n)^#1[1,9]#',
+      positions: [
+        [1, 9, 10, 1, 9, 1, 10],
+        [2, 10, 32, 1, 10, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "timestamp",
+            args: [
+              { id: "2", constExpr: { stringValue: "1986-04-26T01:23:40Z" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "string_to_timestamp",
+          lineOffsets: [34],
+          positions: { "1": 9, "2": 10 },
+        },
+      },
+      checkedAst:
+        'timestamp(\n  "1986-04-26T01:23:40Z"~string\n)~timestamp^string_to_timestamp',
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["string_to_timestamp"] } },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "string_to_timestamp",
+          lineOffsets: [34],
+          positions: { "1": 9, "2": 10 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "timestamp",
+            args: [
+              { id: "2", constExpr: { stringValue: "1986-04-26T01:23:40Z" } },
+            ],
+          },
+        },
+      },
+      type: "timestamp",
+      cost: { min: "1", max: "1" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.protobuf.Timestamp",
+            value: "1986-04-26T01:23:40Z",
+          },
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "macro_all_non_strict",
+        expr: "![0, 2, 4].all(x, 4/x != 2 \u0026\u0026 4/(4-x) != 2)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "!_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      0^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    true^#*expr.Constant_BoolValue#,\n    // LoopCondition\n    @not_strictly_false(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    // LoopStep\n    _\u0026\u0026_(\n      @result^#*expr.Expr_IdentExpr#,\n      _\u0026\u0026_(\n        _!=_(\n          _/_(\n            4^#*expr.Constant_Int64Value#,\n            x^#*expr.Expr_IdentExpr#\n          )^#*expr.Expr_CallExpr#,\n          2^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#,\n        _!=_(\n          _/_(\n            4^#*expr.Constant_Int64Value#,\n            _-_(\n              4^#*expr.Constant_Int64Value#,\n              x^#*expr.Expr_IdentExpr#\n            )^#*expr.Expr_CallExpr#\n          )^#*expr.Expr_CallExpr#,\n          2^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "![0, 2, 4].all(x, 4 / x != 2 \u0026\u0026 4 / (4 - x) != 2)",
+      locationAst:
+        "!_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      0^#3[1,2]#,\n      2^#4[1,5]#,\n      4^#5[1,8]#\n    ]^#2[1,1]#,\n    // Accumulator\n    @result,\n    // Init\n    true^#21[1,14]#,\n    // LoopCondition\n    @not_strictly_false(\n      @result^#22[1,14]#\n    )^#23[1,14]#,\n    // LoopStep\n    _\u0026\u0026_(\n      @result^#24[1,14]#,\n      _\u0026\u0026_(\n        _!=_(\n          _/_(\n            4^#8[1,18]#,\n            x^#10[1,20]#\n          )^#9[1,19]#,\n          2^#12[1,25]#\n        )^#11[1,22]#,\n        _!=_(\n          _/_(\n            4^#13[1,30]#,\n            _-_(\n              4^#15[1,33]#,\n              x^#17[1,35]#\n            )^#16[1,34]#\n          )^#14[1,31]#,\n          2^#19[1,41]#\n        )^#18[1,38]#\n      )^#20[1,27]#\n    )^#25[1,14]#,\n    // Result\n    @result^#26[1,14]#)^#27[1,14]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 8, 9, 1, 8, 1, 9],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 18, 19, 1, 18, 1, 19],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 20, 21, 1, 20, 1, 21],
+        [11, 22, 24, 1, 22, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+        [13, 30, 31, 1, 30, 1, 31],
+        [14, 31, 32, 1, 31, 1, 32],
+        [15, 33, 34, 1, 33, 1, 34],
+        [16, 34, 35, 1, 34, 1, 35],
+        [17, 35, 36, 1, 35, 1, 36],
+        [18, 38, 40, 1, 38, 1, 40],
+        [19, 41, 42, 1, 41, 1, 42],
+        [20, 27, 29, 1, 27, 1, 29],
+        [21, 14, 14, 1, 14, 1, 14],
+        [22, 14, 14, 1, 14, 1, 14],
+        [23, 14, 14, 1, 14, 1, 14],
+        [24, 14, 14, 1, 14, 1, 14],
+        [25, 14, 14, 1, 14, 1, 14],
+        [26, 14, 14, 1, 14, 1, 14],
+        [27, 14, 14, 1, 14, 1, 14],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "27",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "2",
+                    listExpr: {
+                      elements: [
+                        { id: "3", constExpr: { int64Value: "0" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "21", constExpr: { boolValue: true } },
+                  loopCondition: {
+                    id: "23",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [{ id: "22", identExpr: { name: "@result" } }],
+                    },
+                  },
+                  loopStep: {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "24", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              {
+                                id: "11",
+                                callExpr: {
+                                  function: "_!=_",
+                                  args: [
+                                    {
+                                      id: "9",
+                                      callExpr: {
+                                        function: "_/_",
+                                        args: [
+                                          {
+                                            id: "8",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "10",
+                                            identExpr: { name: "x" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                    {
+                                      id: "12",
+                                      constExpr: { int64Value: "2" },
+                                    },
+                                  ],
+                                },
+                              },
+                              {
+                                id: "18",
+                                callExpr: {
+                                  function: "_!=_",
+                                  args: [
+                                    {
+                                      id: "14",
+                                      callExpr: {
+                                        function: "_/_",
+                                        args: [
+                                          {
+                                            id: "13",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "16",
+                                            callExpr: {
+                                              function: "_-_",
+                                              args: [
+                                                {
+                                                  id: "15",
+                                                  constExpr: {
+                                                    int64Value: "4",
+                                                  },
+                                                },
+                                                {
+                                                  id: "17",
+                                                  identExpr: { name: "x" },
+                                                },
+                                              ],
+                                            },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                    {
+                                      id: "19",
+                                      constExpr: { int64Value: "2" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "26", identExpr: { name: "@result" } },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "macro_all_non_strict",
+          lineOffsets: [44],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 8,
+            "7": 15,
+            "8": 18,
+            "9": 19,
+            "10": 20,
+            "11": 22,
+            "12": 25,
+            "13": 30,
+            "14": 31,
+            "15": 33,
+            "16": 34,
+            "17": 35,
+            "18": 38,
+            "19": 41,
+            "20": 27,
+            "21": 14,
+            "22": 14,
+            "23": 14,
+            "24": 14,
+            "25": 14,
+            "26": 14,
+            "27": 14,
+          },
+          macroCalls: {
+            "27": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  listExpr: {
+                    elements: [
+                      { id: "3", constExpr: { int64Value: "0" } },
+                      { id: "4", constExpr: { int64Value: "2" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "11",
+                          callExpr: {
+                            function: "_!=_",
+                            args: [
+                              {
+                                id: "9",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    { id: "8", constExpr: { int64Value: "4" } },
+                                    { id: "10", identExpr: { name: "x" } },
+                                  ],
+                                },
+                              },
+                              { id: "12", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            function: "_!=_",
+                            args: [
+                              {
+                                id: "14",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    {
+                                      id: "13",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                    {
+                                      id: "16",
+                                      callExpr: {
+                                        function: "_-_",
+                                        args: [
+                                          {
+                                            id: "15",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "17",
+                                            identExpr: { name: "x" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "19", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 27,
+          function: "all",
+          target: {
+            id: "2",
+            listExpr: {
+              elements: [
+                { id: "3", constExpr: { int64Value: "0" } },
+                { id: "4", constExpr: { int64Value: "2" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+              ],
+            },
+          },
+          args: [
+            { id: "7", identExpr: { name: "x" } },
+            {
+              id: "20",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_!=_",
+                      args: [
+                        {
+                          id: "9",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "8", constExpr: { int64Value: "4" } },
+                              { id: "10", identExpr: { name: "x" } },
+                            ],
+                          },
+                        },
+                        { id: "12", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_!=_",
+                      args: [
+                        {
+                          id: "14",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "13", constExpr: { int64Value: "4" } },
+                              {
+                                id: "16",
+                                callExpr: {
+                                  function: "_-_",
+                                  args: [
+                                    {
+                                      id: "15",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                    { id: "17", identExpr: { name: "x" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        { id: "19", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "!_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      0~int,\n      2~int,\n      4~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    true~bool,\n    // LoopCondition\n    @not_strictly_false(\n      @result~bool^@result\n    )~bool^not_strictly_false,\n    // LoopStep\n    _\u0026\u0026_(\n      @result~bool^@result,\n      _\u0026\u0026_(\n        _!=_(\n          _/_(\n            4~int,\n            x~int^x\n          )~int^divide_int64,\n          2~int\n        )~bool^not_equals,\n        _!=_(\n          _/_(\n            4~int,\n            _-_(\n              4~int,\n              x~int^x\n            )~int^subtract_int64\n          )~int^divide_int64,\n          2~int\n        )~bool^not_equals\n      )~bool^logical_and\n    )~bool^logical_and,\n    // Result\n    @result~bool^@result)~bool\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "9": { overloadId: ["divide_int64"] },
+          "10": { name: "x" },
+          "11": { overloadId: ["not_equals"] },
+          "14": { overloadId: ["divide_int64"] },
+          "16": { overloadId: ["subtract_int64"] },
+          "17": { name: "x" },
+          "18": { overloadId: ["not_equals"] },
+          "20": { overloadId: ["logical_and"] },
+          "22": { name: "@result" },
+          "23": { overloadId: ["not_strictly_false"] },
+          "24": { name: "@result" },
+          "25": { overloadId: ["logical_and"] },
+          "26": { name: "@result" },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "INT64" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "macro_all_non_strict",
+          lineOffsets: [44],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 8,
+            "7": 15,
+            "8": 18,
+            "9": 19,
+            "10": 20,
+            "11": 22,
+            "12": 25,
+            "13": 30,
+            "14": 31,
+            "15": 33,
+            "16": 34,
+            "17": 35,
+            "18": 38,
+            "19": 41,
+            "20": 27,
+            "21": 14,
+            "22": 14,
+            "23": 14,
+            "24": 14,
+            "25": 14,
+            "26": 14,
+            "27": 14,
+          },
+          macroCalls: {
+            "27": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  listExpr: {
+                    elements: [
+                      { id: "3", constExpr: { int64Value: "0" } },
+                      { id: "4", constExpr: { int64Value: "2" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "11",
+                          callExpr: {
+                            function: "_!=_",
+                            args: [
+                              {
+                                id: "9",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    { id: "8", constExpr: { int64Value: "4" } },
+                                    { id: "10", identExpr: { name: "x" } },
+                                  ],
+                                },
+                              },
+                              { id: "12", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            function: "_!=_",
+                            args: [
+                              {
+                                id: "14",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    {
+                                      id: "13",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                    {
+                                      id: "16",
+                                      callExpr: {
+                                        function: "_-_",
+                                        args: [
+                                          {
+                                            id: "15",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "17",
+                                            identExpr: { name: "x" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "19", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "27",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "2",
+                    listExpr: {
+                      elements: [
+                        { id: "3", constExpr: { int64Value: "0" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "21", constExpr: { boolValue: true } },
+                  loopCondition: {
+                    id: "23",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [{ id: "22", identExpr: { name: "@result" } }],
+                    },
+                  },
+                  loopStep: {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "24", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              {
+                                id: "11",
+                                callExpr: {
+                                  function: "_!=_",
+                                  args: [
+                                    {
+                                      id: "9",
+                                      callExpr: {
+                                        function: "_/_",
+                                        args: [
+                                          {
+                                            id: "8",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "10",
+                                            identExpr: { name: "x" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                    {
+                                      id: "12",
+                                      constExpr: { int64Value: "2" },
+                                    },
+                                  ],
+                                },
+                              },
+                              {
+                                id: "18",
+                                callExpr: {
+                                  function: "_!=_",
+                                  args: [
+                                    {
+                                      id: "14",
+                                      callExpr: {
+                                        function: "_/_",
+                                        args: [
+                                          {
+                                            id: "13",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "16",
+                                            callExpr: {
+                                              function: "_-_",
+                                              args: [
+                                                {
+                                                  id: "15",
+                                                  constExpr: {
+                                                    int64Value: "4",
+                                                  },
+                                                },
+                                                {
+                                                  id: "17",
+                                                  identExpr: { name: "x" },
+                                                },
+                                              ],
+                                            },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                    {
+                                      id: "19",
+                                      constExpr: { int64Value: "2" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "26", identExpr: { name: "@result" } },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "30",
+    },
+    {
+      original: {
+        name: "macro_all_non_strict_var",
+        expr: 'code == "111" \u0026\u0026 ["a", "b"].all(x, x in tags)\n\t\t\t\t|| code == "222" \u0026\u0026 ["a", "b"].all(x, x in tags)',
+        typeEnv: [
+          { name: "code", ident: { type: { primitive: "STRING" } } },
+          {
+            name: "tags",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+        ],
+        bindings: {
+          code: { value: { stringValue: "222" } },
+          tags: {
+            value: {
+              listValue: {
+                values: [{ stringValue: "a" }, { stringValue: "b" }],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_||_(\n  _\u0026\u0026_(\n    _==_(\n      code^#*expr.Expr_IdentExpr#,\n      "111"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        "a"^#*expr.Constant_StringValue#,\n        "b"^#*expr.Constant_StringValue#\n      ]^#*expr.Expr_ListExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        @in(\n          x^#*expr.Expr_IdentExpr#,\n          tags^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _==_(\n      code^#*expr.Expr_IdentExpr#,\n      "222"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        "a"^#*expr.Constant_StringValue#,\n        "b"^#*expr.Constant_StringValue#\n      ]^#*expr.Expr_ListExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        @in(\n          x^#*expr.Expr_IdentExpr#,\n          tags^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'code == "111" \u0026\u0026 ["a", "b"].all(x, x in tags) || code == "222" \u0026\u0026 ["a", "b"].all(x, x in tags)',
+      locationAst:
+        '_||_(\n  _\u0026\u0026_(\n    _==_(\n      code^#1[1,0]#,\n      "111"^#3[1,8]#\n    )^#2[1,5]#,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        "a"^#5[1,18]#,\n        "b"^#6[1,23]#\n      ]^#4[1,17]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#12[1,31]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#13[1,31]#\n      )^#14[1,31]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#15[1,31]#,\n        @in(\n          x^#9[1,35]#,\n          tags^#11[1,40]#\n        )^#10[1,37]#\n      )^#16[1,31]#,\n      // Result\n      @result^#17[1,31]#)^#18[1,31]#\n  )^#19[1,14]#,\n  _\u0026\u0026_(\n    _==_(\n      code^#20[2,7]#,\n      "222"^#22[2,15]#\n    )^#21[2,12]#,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        "a"^#24[2,25]#,\n        "b"^#25[2,30]#\n      ]^#23[2,24]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#31[2,38]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#32[2,38]#\n      )^#33[2,38]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#34[2,38]#,\n        @in(\n          x^#28[2,42]#,\n          tags^#30[2,47]#\n        )^#29[2,44]#\n      )^#35[2,38]#,\n      // Result\n      @result^#36[2,38]#)^#37[2,38]#\n  )^#38[2,21]#\n)^#39[2,4]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 13, 1, 8, 1, 13],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 21, 1, 18, 1, 21],
+        [6, 23, 26, 1, 23, 1, 26],
+        [8, 32, 33, 1, 32, 1, 33],
+        [9, 35, 36, 1, 35, 1, 36],
+        [10, 37, 39, 1, 37, 1, 39],
+        [11, 40, 44, 1, 40, 1, 44],
+        [12, 31, 31, 1, 31, 1, 31],
+        [13, 31, 31, 1, 31, 1, 31],
+        [14, 31, 31, 1, 31, 1, 31],
+        [15, 31, 31, 1, 31, 1, 31],
+        [16, 31, 31, 1, 31, 1, 31],
+        [17, 31, 31, 1, 31, 1, 31],
+        [18, 31, 31, 1, 31, 1, 31],
+        [19, 14, 16, 1, 14, 1, 16],
+        [20, 53, 57, 2, 7, 2, 11],
+        [21, 58, 60, 2, 12, 2, 14],
+        [22, 61, 66, 2, 15, 2, 20],
+        [23, 70, 71, 2, 24, 2, 25],
+        [24, 71, 74, 2, 25, 2, 28],
+        [25, 76, 79, 2, 30, 2, 33],
+        [27, 85, 86, 2, 39, 2, 40],
+        [28, 88, 89, 2, 42, 2, 43],
+        [29, 90, 92, 2, 44, 2, 46],
+        [30, 93, 97, 2, 47, 2, 51],
+        [31, 84, 84, 2, 38, 2, 38],
+        [32, 84, 84, 2, 38, 2, 38],
+        [33, 84, 84, 2, 38, 2, 38],
+        [34, 84, 84, 2, 38, 2, 38],
+        [35, 84, 84, 2, 38, 2, 38],
+        [36, 84, 84, 2, 38, 2, 38],
+        [37, 84, 84, 2, 38, 2, 38],
+        [38, 67, 69, 2, 21, 2, 23],
+        [39, 50, 52, 2, 4, 2, 6],
+      ],
+      lineOffsets: [46, 99],
+      parsedExpr: {
+        expr: {
+          id: "39",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "19",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "1", identExpr: { name: "code" } },
+                          { id: "3", constExpr: { stringValue: "111" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "18",
+                      comprehensionExpr: {
+                        iterVar: "x",
+                        iterRange: {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              { id: "5", constExpr: { stringValue: "a" } },
+                              { id: "6", constExpr: { stringValue: "b" } },
+                            ],
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "12", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "14",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              { id: "13", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "16",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "15", identExpr: { name: "@result" } },
+                              {
+                                id: "10",
+                                callExpr: {
+                                  function: "@in",
+                                  args: [
+                                    { id: "9", identExpr: { name: "x" } },
+                                    { id: "11", identExpr: { name: "tags" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "17", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "38",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "20", identExpr: { name: "code" } },
+                          { id: "22", constExpr: { stringValue: "222" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "37",
+                      comprehensionExpr: {
+                        iterVar: "x",
+                        iterRange: {
+                          id: "23",
+                          listExpr: {
+                            elements: [
+                              { id: "24", constExpr: { stringValue: "a" } },
+                              { id: "25", constExpr: { stringValue: "b" } },
+                            ],
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "31", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "33",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              { id: "32", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "35",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "34", identExpr: { name: "@result" } },
+                              {
+                                id: "29",
+                                callExpr: {
+                                  function: "@in",
+                                  args: [
+                                    { id: "28", identExpr: { name: "x" } },
+                                    { id: "30", identExpr: { name: "tags" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "36", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "macro_all_non_strict_var",
+          lineOffsets: [46, 99],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 17,
+            "5": 18,
+            "6": 23,
+            "8": 32,
+            "9": 35,
+            "10": 37,
+            "11": 40,
+            "12": 31,
+            "13": 31,
+            "14": 31,
+            "15": 31,
+            "16": 31,
+            "17": 31,
+            "18": 31,
+            "19": 14,
+            "20": 53,
+            "21": 58,
+            "22": 61,
+            "23": 70,
+            "24": 71,
+            "25": 76,
+            "27": 85,
+            "28": 88,
+            "29": 90,
+            "30": 93,
+            "31": 84,
+            "32": 84,
+            "33": 84,
+            "34": 84,
+            "35": 84,
+            "36": 84,
+            "37": 84,
+            "38": 67,
+            "39": 50,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "4",
+                  listExpr: {
+                    elements: [
+                      { id: "5", constExpr: { stringValue: "a" } },
+                      { id: "6", constExpr: { stringValue: "b" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "tags" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "37": {
+              callExpr: {
+                target: {
+                  id: "23",
+                  listExpr: {
+                    elements: [
+                      { id: "24", constExpr: { stringValue: "a" } },
+                      { id: "25", constExpr: { stringValue: "b" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "27", identExpr: { name: "x" } },
+                  {
+                    id: "29",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "28", identExpr: { name: "x" } },
+                        { id: "30", identExpr: { name: "tags" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "all",
+          target: {
+            id: "4",
+            listExpr: {
+              elements: [
+                { id: "5", constExpr: { stringValue: "a" } },
+                { id: "6", constExpr: { stringValue: "b" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "@in",
+                args: [
+                  { id: "9", identExpr: { name: "x" } },
+                  { id: "11", identExpr: { name: "tags" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 37,
+          function: "all",
+          target: {
+            id: "23",
+            listExpr: {
+              elements: [
+                { id: "24", constExpr: { stringValue: "a" } },
+                { id: "25", constExpr: { stringValue: "b" } },
+              ],
+            },
+          },
+          args: [
+            { id: "27", identExpr: { name: "x" } },
+            {
+              id: "29",
+              callExpr: {
+                function: "@in",
+                args: [
+                  { id: "28", identExpr: { name: "x" } },
+                  { id: "30", identExpr: { name: "tags" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_||_(\n  _\u0026\u0026_(\n    _==_(\n      code~string^code,\n      "111"~string\n    )~bool^equals,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        "a"~string,\n        "b"~string\n      ]~list(string),\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        @in(\n          x~string^x,\n          tags~list(string)^tags\n        )~bool^in_list\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    _==_(\n      code~string^code,\n      "222"~string\n    )~bool^equals,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        "a"~string,\n        "b"~string\n      ]~list(string),\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        @in(\n          x~string^x,\n          tags~list(string)^tags\n        )~bool^in_list\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and\n)~bool^logical_or',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "code" },
+          "2": { overloadId: ["equals"] },
+          "9": { name: "x" },
+          "10": { overloadId: ["in_list"] },
+          "11": { name: "tags" },
+          "13": { name: "@result" },
+          "14": { overloadId: ["not_strictly_false"] },
+          "15": { name: "@result" },
+          "16": { overloadId: ["logical_and"] },
+          "17": { name: "@result" },
+          "19": { overloadId: ["logical_and"] },
+          "20": { name: "code" },
+          "21": { overloadId: ["equals"] },
+          "28": { name: "x" },
+          "29": { overloadId: ["in_list"] },
+          "30": { name: "tags" },
+          "32": { name: "@result" },
+          "33": { overloadId: ["not_strictly_false"] },
+          "34": { name: "@result" },
+          "35": { overloadId: ["logical_and"] },
+          "36": { name: "@result" },
+          "38": { overloadId: ["logical_and"] },
+          "39": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "4": { listType: { elemType: { primitive: "STRING" } } },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { primitive: "STRING" } } },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "STRING" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "STRING" },
+          "23": { listType: { elemType: { primitive: "STRING" } } },
+          "24": { primitive: "STRING" },
+          "25": { primitive: "STRING" },
+          "28": { primitive: "STRING" },
+          "29": { primitive: "BOOL" },
+          "30": { listType: { elemType: { primitive: "STRING" } } },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "BOOL" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "BOOL" },
+          "36": { primitive: "BOOL" },
+          "37": { primitive: "BOOL" },
+          "38": { primitive: "BOOL" },
+          "39": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "macro_all_non_strict_var",
+          lineOffsets: [46, 99],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 17,
+            "5": 18,
+            "6": 23,
+            "8": 32,
+            "9": 35,
+            "10": 37,
+            "11": 40,
+            "12": 31,
+            "13": 31,
+            "14": 31,
+            "15": 31,
+            "16": 31,
+            "17": 31,
+            "18": 31,
+            "19": 14,
+            "20": 53,
+            "21": 58,
+            "22": 61,
+            "23": 70,
+            "24": 71,
+            "25": 76,
+            "27": 85,
+            "28": 88,
+            "29": 90,
+            "30": 93,
+            "31": 84,
+            "32": 84,
+            "33": 84,
+            "34": 84,
+            "35": 84,
+            "36": 84,
+            "37": 84,
+            "38": 67,
+            "39": 50,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "4",
+                  listExpr: {
+                    elements: [
+                      { id: "5", constExpr: { stringValue: "a" } },
+                      { id: "6", constExpr: { stringValue: "b" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "tags" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "37": {
+              callExpr: {
+                target: {
+                  id: "23",
+                  listExpr: {
+                    elements: [
+                      { id: "24", constExpr: { stringValue: "a" } },
+                      { id: "25", constExpr: { stringValue: "b" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "27", identExpr: { name: "x" } },
+                  {
+                    id: "29",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "28", identExpr: { name: "x" } },
+                        { id: "30", identExpr: { name: "tags" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "39",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "19",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "1", identExpr: { name: "code" } },
+                          { id: "3", constExpr: { stringValue: "111" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "18",
+                      comprehensionExpr: {
+                        iterVar: "x",
+                        iterRange: {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              { id: "5", constExpr: { stringValue: "a" } },
+                              { id: "6", constExpr: { stringValue: "b" } },
+                            ],
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "12", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "14",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              { id: "13", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "16",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "15", identExpr: { name: "@result" } },
+                              {
+                                id: "10",
+                                callExpr: {
+                                  function: "@in",
+                                  args: [
+                                    { id: "9", identExpr: { name: "x" } },
+                                    { id: "11", identExpr: { name: "tags" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "17", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "38",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          { id: "20", identExpr: { name: "code" } },
+                          { id: "22", constExpr: { stringValue: "222" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "37",
+                      comprehensionExpr: {
+                        iterVar: "x",
+                        iterRange: {
+                          id: "23",
+                          listExpr: {
+                            elements: [
+                              { id: "24", constExpr: { stringValue: "a" } },
+                              { id: "25", constExpr: { stringValue: "b" } },
+                            ],
+                          },
+                        },
+                        accuVar: "@result",
+                        accuInit: { id: "31", constExpr: { boolValue: true } },
+                        loopCondition: {
+                          id: "33",
+                          callExpr: {
+                            function: "@not_strictly_false",
+                            args: [
+                              { id: "32", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                        loopStep: {
+                          id: "35",
+                          callExpr: {
+                            function: "_\u0026\u0026_",
+                            args: [
+                              { id: "34", identExpr: { name: "@result" } },
+                              {
+                                id: "29",
+                                callExpr: {
+                                  function: "@in",
+                                  args: [
+                                    { id: "28", identExpr: { name: "x" } },
+                                    { id: "30", identExpr: { name: "tags" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        result: { id: "36", identExpr: { name: "@result" } },
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "29",
+    },
+    {
+      original: {
+        name: "macro_exists_lit",
+        expr: "[1, 2, 3, 4, 5u, 1.0].exists(e, type(e) == uint)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  e,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    5u^#*expr.Constant_Uint64Value#,\n    1^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      type(\n        e^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      uint^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5u, 1.0].exists(e, type(e) == uint)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  e,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#,\n    4^#5[1,10]#,\n    5u^#6[1,13]#,\n    1^#7[1,17]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#14[1,28]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#15[1,28]#\n    )^#16[1,28]#\n  )^#17[1,28]#,\n  // LoopStep\n  _||_(\n    @result^#18[1,28]#,\n    _==_(\n      type(\n        e^#11[1,37]#\n      )^#10[1,36]#,\n      uint^#13[1,43]#\n    )^#12[1,40]#\n  )^#19[1,28]#,\n  // Result\n  @result^#20[1,28]#)^#21[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 13, 15, 1, 13, 1, 15],
+        [7, 17, 20, 1, 17, 1, 20],
+        [9, 29, 30, 1, 29, 1, 30],
+        [10, 36, 37, 1, 36, 1, 37],
+        [11, 37, 38, 1, 37, 1, 38],
+        [12, 40, 42, 1, 40, 1, 42],
+        [13, 43, 47, 1, 43, 1, 47],
+        [14, 28, 28, 1, 28, 1, 28],
+        [15, 28, 28, 1, 28, 1, 28],
+        [16, 28, 28, 1, 28, 1, 28],
+        [17, 28, 28, 1, 28, 1, 28],
+        [18, 28, 28, 1, 28, 1, 28],
+        [19, 28, 28, 1, 28, 1, 28],
+        [20, 28, 28, 1, 28, 1, 28],
+        [21, 28, 28, 1, 28, 1, 28],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "21",
+          comprehensionExpr: {
+            iterVar: "e",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { uint64Value: "5" } },
+                  { id: "7", constExpr: { doubleValue: 1 } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "14", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "17",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "15", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "19",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "18", identExpr: { name: "@result" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "11", identExpr: { name: "e" } }],
+                          },
+                        },
+                        { id: "13", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "20", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "macro_exists_lit",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 17,
+            "9": 29,
+            "10": 36,
+            "11": 37,
+            "12": 40,
+            "13": 43,
+            "14": 28,
+            "15": 28,
+            "16": 28,
+            "17": 28,
+            "18": 28,
+            "19": 28,
+            "20": 28,
+            "21": 28,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { uint64Value: "5" } },
+                      { id: "7", constExpr: { doubleValue: 1 } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "9", identExpr: { name: "e" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "11", identExpr: { name: "e" } }],
+                          },
+                        },
+                        { id: "13", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 21,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { uint64Value: "5" } },
+                { id: "7", constExpr: { doubleValue: 1 } },
+              ],
+            },
+          },
+          args: [
+            { id: "9", identExpr: { name: "e" } },
+            {
+              id: "12",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "type",
+                      args: [{ id: "11", identExpr: { name: "e" } }],
+                    },
+                  },
+                  { id: "13", identExpr: { name: "uint" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  e,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int,\n    5u~uint,\n    1~double\n  ]~list(dyn),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      type(\n        e~dyn^e\n      )~type(dyn)^type,\n      uint~type(uint)^uint\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "10": { overloadId: ["type"] },
+          "11": { name: "e" },
+          "12": { overloadId: ["equals"] },
+          "13": { name: "uint" },
+          "15": { name: "@result" },
+          "16": { overloadId: ["logical_not"] },
+          "17": { overloadId: ["not_strictly_false"] },
+          "18": { name: "@result" },
+          "19": { overloadId: ["logical_or"] },
+          "20": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "DOUBLE" },
+          "10": { type: { dyn: {} } },
+          "11": { dyn: {} },
+          "12": { primitive: "BOOL" },
+          "13": { type: { primitive: "UINT64" } },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "macro_exists_lit",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 17,
+            "9": 29,
+            "10": 36,
+            "11": 37,
+            "12": 40,
+            "13": 43,
+            "14": 28,
+            "15": 28,
+            "16": 28,
+            "17": 28,
+            "18": 28,
+            "19": 28,
+            "20": 28,
+            "21": 28,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { uint64Value: "5" } },
+                      { id: "7", constExpr: { doubleValue: 1 } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "9", identExpr: { name: "e" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "11", identExpr: { name: "e" } }],
+                          },
+                        },
+                        { id: "13", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "21",
+          comprehensionExpr: {
+            iterVar: "e",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { uint64Value: "5" } },
+                  { id: "7", constExpr: { doubleValue: 1 } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "14", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "17",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "15", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "19",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "18", identExpr: { name: "@result" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "11", identExpr: { name: "e" } }],
+                          },
+                        },
+                        { id: "13", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "20", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "35", max: "11068046444225731637" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "49",
+    },
+    {
+      original: {
+        name: "macro_exists_nonstrict",
+        expr: "[0, 2, 4].exists(x, 4/x == 2 \u0026\u0026 4/(4-x) == 2)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    0^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u0026\u0026_(\n      _==_(\n        _/_(\n          4^#*expr.Constant_Int64Value#,\n          x^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _==_(\n        _/_(\n          4^#*expr.Constant_Int64Value#,\n          _-_(\n            4^#*expr.Constant_Int64Value#,\n            x^#*expr.Expr_IdentExpr#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[0, 2, 4].exists(x, 4 / x == 2 \u0026\u0026 4 / (4 - x) == 2)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    0^#2[1,1]#,\n    2^#3[1,4]#,\n    4^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#20[1,16]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#21[1,16]#\n    )^#22[1,16]#\n  )^#23[1,16]#,\n  // LoopStep\n  _||_(\n    @result^#24[1,16]#,\n    _\u0026\u0026_(\n      _==_(\n        _/_(\n          4^#7[1,20]#,\n          x^#9[1,22]#\n        )^#8[1,21]#,\n        2^#11[1,27]#\n      )^#10[1,24]#,\n      _==_(\n        _/_(\n          4^#12[1,32]#,\n          _-_(\n            4^#14[1,35]#,\n            x^#16[1,37]#\n          )^#15[1,36]#\n        )^#13[1,33]#,\n        2^#18[1,43]#\n      )^#17[1,40]#\n    )^#19[1,29]#\n  )^#25[1,16]#,\n  // Result\n  @result^#26[1,16]#)^#27[1,16]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 20, 21, 1, 20, 1, 21],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 24, 26, 1, 24, 1, 26],
+        [11, 27, 28, 1, 27, 1, 28],
+        [12, 32, 33, 1, 32, 1, 33],
+        [13, 33, 34, 1, 33, 1, 34],
+        [14, 35, 36, 1, 35, 1, 36],
+        [15, 36, 37, 1, 36, 1, 37],
+        [16, 37, 38, 1, 37, 1, 38],
+        [17, 40, 42, 1, 40, 1, 42],
+        [18, 43, 44, 1, 43, 1, 44],
+        [19, 29, 31, 1, 29, 1, 31],
+        [20, 16, 16, 1, 16, 1, 16],
+        [21, 16, 16, 1, 16, 1, 16],
+        [22, 16, 16, 1, 16, 1, 16],
+        [23, 16, 16, 1, 16, 1, 16],
+        [24, 16, 16, 1, 16, 1, 16],
+        [25, 16, 16, 1, 16, 1, 16],
+        [26, 16, 16, 1, 16, 1, 16],
+        [27, 16, 16, 1, 16, 1, 16],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "0" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "21", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    { id: "7", constExpr: { int64Value: "4" } },
+                                    { id: "9", identExpr: { name: "x" } },
+                                  ],
+                                },
+                              },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "17",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "13",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    {
+                                      id: "12",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                    {
+                                      id: "15",
+                                      callExpr: {
+                                        function: "_-_",
+                                        args: [
+                                          {
+                                            id: "14",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "16",
+                                            identExpr: { name: "x" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "18", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "macro_exists_nonstrict",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 17,
+            "7": 20,
+            "8": 21,
+            "9": 22,
+            "10": 24,
+            "11": 27,
+            "12": 32,
+            "13": 33,
+            "14": 35,
+            "15": 36,
+            "16": 37,
+            "17": 40,
+            "18": 43,
+            "19": 29,
+            "20": 16,
+            "21": 16,
+            "22": 16,
+            "23": 16,
+            "24": 16,
+            "25": 16,
+            "26": 16,
+            "27": 16,
+          },
+          macroCalls: {
+            "27": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "0" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "4" } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    { id: "7", constExpr: { int64Value: "4" } },
+                                    { id: "9", identExpr: { name: "x" } },
+                                  ],
+                                },
+                              },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "17",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "13",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    {
+                                      id: "12",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                    {
+                                      id: "15",
+                                      callExpr: {
+                                        function: "_-_",
+                                        args: [
+                                          {
+                                            id: "14",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "16",
+                                            identExpr: { name: "x" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "18", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 27,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "0" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "4" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "19",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "8",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "7", constExpr: { int64Value: "4" } },
+                              { id: "9", identExpr: { name: "x" } },
+                            ],
+                          },
+                        },
+                        { id: "11", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "13",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "12", constExpr: { int64Value: "4" } },
+                              {
+                                id: "15",
+                                callExpr: {
+                                  function: "_-_",
+                                  args: [
+                                    {
+                                      id: "14",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                    { id: "16", identExpr: { name: "x" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        { id: "18", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    0~int,\n    2~int,\n    4~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _\u0026\u0026_(\n      _==_(\n        _/_(\n          4~int,\n          x~int^x\n        )~int^divide_int64,\n        2~int\n      )~bool^equals,\n      _==_(\n        _/_(\n          4~int,\n          _-_(\n            4~int,\n            x~int^x\n          )~int^subtract_int64\n        )~int^divide_int64,\n        2~int\n      )~bool^equals\n    )~bool^logical_and\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["divide_int64"] },
+          "9": { name: "x" },
+          "10": { overloadId: ["equals"] },
+          "13": { overloadId: ["divide_int64"] },
+          "15": { overloadId: ["subtract_int64"] },
+          "16": { name: "x" },
+          "17": { overloadId: ["equals"] },
+          "19": { overloadId: ["logical_and"] },
+          "21": { name: "@result" },
+          "22": { overloadId: ["logical_not"] },
+          "23": { overloadId: ["not_strictly_false"] },
+          "24": { name: "@result" },
+          "25": { overloadId: ["logical_or"] },
+          "26": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "INT64" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "macro_exists_nonstrict",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 17,
+            "7": 20,
+            "8": 21,
+            "9": 22,
+            "10": 24,
+            "11": 27,
+            "12": 32,
+            "13": 33,
+            "14": 35,
+            "15": 36,
+            "16": 37,
+            "17": 40,
+            "18": 43,
+            "19": 29,
+            "20": 16,
+            "21": 16,
+            "22": 16,
+            "23": 16,
+            "24": 16,
+            "25": 16,
+            "26": 16,
+            "27": 16,
+          },
+          macroCalls: {
+            "27": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "0" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "4" } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    { id: "7", constExpr: { int64Value: "4" } },
+                                    { id: "9", identExpr: { name: "x" } },
+                                  ],
+                                },
+                              },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "17",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "13",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    {
+                                      id: "12",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                    {
+                                      id: "15",
+                                      callExpr: {
+                                        function: "_-_",
+                                        args: [
+                                          {
+                                            id: "14",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "16",
+                                            identExpr: { name: "x" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "18", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "0" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "21", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    { id: "7", constExpr: { int64Value: "4" } },
+                                    { id: "9", identExpr: { name: "x" } },
+                                  ],
+                                },
+                              },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "17",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "13",
+                                callExpr: {
+                                  function: "_/_",
+                                  args: [
+                                    {
+                                      id: "12",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                    {
+                                      id: "15",
+                                      callExpr: {
+                                        function: "_-_",
+                                        args: [
+                                          {
+                                            id: "14",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                          {
+                                            id: "16",
+                                            identExpr: { name: "x" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              { id: "18", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "44" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "36",
+    },
+    {
+      original: {
+        name: "macro_exists_var",
+        expr: "elems.exists(e, type(e) == uint)",
+        typeEnv: [
+          {
+            name: "elems",
+            ident: { type: { listType: { elemType: { dyn: {} } } } },
+          },
+        ],
+        bindings: {
+          elems: {
+            value: {
+              listValue: {
+                values: [
+                  { int64Value: "0" },
+                  { int64Value: "1" },
+                  { int64Value: "2" },
+                  { int64Value: "3" },
+                  { int64Value: "4" },
+                  { uint64Value: "5" },
+                  { int64Value: "6" },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  e,\n  // Target\n  elems^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      type(\n        e^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      uint^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "elems.exists(e, type(e) == uint)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  e,\n  // Target\n  elems^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#8[1,12]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#9[1,12]#\n    )^#10[1,12]#\n  )^#11[1,12]#,\n  // LoopStep\n  _||_(\n    @result^#12[1,12]#,\n    _==_(\n      type(\n        e^#5[1,21]#\n      )^#4[1,20]#,\n      uint^#7[1,27]#\n    )^#6[1,24]#\n  )^#13[1,12]#,\n  // Result\n  @result^#14[1,12]#)^#15[1,12]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 20, 21, 1, 20, 1, 21],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 24, 26, 1, 24, 1, 26],
+        [7, 27, 31, 1, 27, 1, 31],
+        [8, 12, 12, 1, 12, 1, 12],
+        [9, 12, 12, 1, 12, 1, 12],
+        [10, 12, 12, 1, 12, 1, 12],
+        [11, 12, 12, 1, 12, 1, 12],
+        [12, 12, 12, 1, 12, 1, 12],
+        [13, 12, 12, 1, 12, 1, 12],
+        [14, 12, 12, 1, 12, 1, 12],
+        [15, 12, 12, 1, 12, 1, 12],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          comprehensionExpr: {
+            iterVar: "e",
+            iterRange: { id: "1", identExpr: { name: "elems" } },
+            accuVar: "@result",
+            accuInit: { id: "8", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "11",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "9", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "13",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "12", identExpr: { name: "@result" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "4",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "5", identExpr: { name: "e" } }],
+                          },
+                        },
+                        { id: "7", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "14", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "macro_exists_var",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 20,
+            "5": 21,
+            "6": 24,
+            "7": 27,
+            "8": 12,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "elems" } },
+                function: "exists",
+                args: [
+                  { id: "3", identExpr: { name: "e" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "4",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "5", identExpr: { name: "e" } }],
+                          },
+                        },
+                        { id: "7", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 15,
+          function: "exists",
+          target: { id: "1", identExpr: { name: "elems" } },
+          args: [
+            { id: "3", identExpr: { name: "e" } },
+            {
+              id: "6",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "type",
+                      args: [{ id: "5", identExpr: { name: "e" } }],
+                    },
+                  },
+                  { id: "7", identExpr: { name: "uint" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  e,\n  // Target\n  elems~list(dyn)^elems,\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      type(\n        e~dyn^e\n      )~type(dyn)^type,\n      uint~type(uint)^uint\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "elems" },
+          "4": { overloadId: ["type"] },
+          "5": { name: "e" },
+          "6": { overloadId: ["equals"] },
+          "7": { name: "uint" },
+          "9": { name: "@result" },
+          "10": { overloadId: ["logical_not"] },
+          "11": { overloadId: ["not_strictly_false"] },
+          "12": { name: "@result" },
+          "13": { overloadId: ["logical_or"] },
+          "14": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "4": { type: { dyn: {} } },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { type: { primitive: "UINT64" } },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "macro_exists_var",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 20,
+            "5": 21,
+            "6": 24,
+            "7": 27,
+            "8": 12,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "elems" } },
+                function: "exists",
+                args: [
+                  { id: "3", identExpr: { name: "e" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "4",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "5", identExpr: { name: "e" } }],
+                          },
+                        },
+                        { id: "7", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "15",
+          comprehensionExpr: {
+            iterVar: "e",
+            iterRange: { id: "1", identExpr: { name: "elems" } },
+            accuVar: "@result",
+            accuInit: { id: "8", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "11",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "9", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "13",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "12", identExpr: { name: "@result" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "4",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "5", identExpr: { name: "e" } }],
+                          },
+                        },
+                        { id: "7", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "14", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "47",
+    },
+    {
+      original: {
+        name: "macro_exists_one",
+        expr: "[1, 2, 3].exists_one(x, (x % 2) == 0)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  0^#*expr.Constant_Int64Value#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#*expr.Expr_IdentExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  _==_(\n    @result^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].exists_one(x, x % 2 == 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  0^#12[1,20]#,\n  // LoopCondition\n  true^#13[1,20]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#7[1,25]#,\n        2^#9[1,29]#\n      )^#8[1,27]#,\n      0^#11[1,35]#\n    )^#10[1,32]#,\n    _+_(\n      @result^#14[1,20]#,\n      1^#15[1,20]#\n    )^#16[1,20]#,\n    @result^#17[1,20]#\n  )^#18[1,20]#,\n  // Result\n  _==_(\n    @result^#19[1,20]#,\n    1^#20[1,20]#\n  )^#21[1,20]#)^#22[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 29, 30, 1, 29, 1, 30],
+        [10, 32, 34, 1, 32, 1, 34],
+        [11, 35, 36, 1, 35, 1, 36],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 20, 20, 1, 20, 1, 20],
+        [16, 20, 20, 1, 20, 1, 20],
+        [17, 20, 20, 1, 20, 1, 20],
+        [18, 20, 20, 1, 20, 1, 20],
+        [19, 20, 20, 1, 20, 1, 20],
+        [20, 20, 20, 1, 20, 1, 20],
+        [21, 20, 20, 1, 20, 1, 20],
+        [22, 20, 20, 1, 20, 1, 20],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", constExpr: { int64Value: "0" } },
+            loopCondition: { id: "13", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "18",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "8",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "7", identExpr: { name: "x" } },
+                              { id: "9", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "11", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "14", identExpr: { name: "@result" } },
+                        { id: "15", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  { id: "17", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: {
+              id: "21",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "19", identExpr: { name: "@result" } },
+                  { id: "20", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "macro_exists_one",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 21,
+            "7": 25,
+            "8": 27,
+            "9": 29,
+            "10": 32,
+            "11": 35,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+            "20": 20,
+            "21": 20,
+            "22": 20,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "exists_one",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "8",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "7", identExpr: { name: "x" } },
+                              { id: "9", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "11", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 22,
+          function: "exists_one",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_%_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "11", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  0~int,\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x~int^x,\n        2~int\n      )~int^modulo_int64,\n      0~int\n    )~bool^equals,\n    _+_(\n      @result~int^@result,\n      1~int\n    )~int^add_int64,\n    @result~int^@result\n  )~int^conditional,\n  // Result\n  _==_(\n    @result~int^@result,\n    1~int\n  )~bool^equals)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "x" },
+          "8": { overloadId: ["modulo_int64"] },
+          "10": { overloadId: ["equals"] },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_int64"] },
+          "17": { name: "@result" },
+          "18": { overloadId: ["conditional"] },
+          "19": { name: "@result" },
+          "21": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "INT64" },
+          "19": { primitive: "INT64" },
+          "20": { primitive: "INT64" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "macro_exists_one",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 21,
+            "7": 25,
+            "8": 27,
+            "9": 29,
+            "10": 32,
+            "11": 35,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+            "20": 20,
+            "21": 20,
+            "22": 20,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "exists_one",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "8",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "7", identExpr: { name: "x" } },
+                              { id: "9", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "11", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", constExpr: { int64Value: "0" } },
+            loopCondition: { id: "13", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "18",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "8",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "7", identExpr: { name: "x" } },
+                              { id: "9", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "11", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "14", identExpr: { name: "@result" } },
+                        { id: "15", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  { id: "17", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: {
+              id: "21",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "19", identExpr: { name: "@result" } },
+                  { id: "20", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "24", max: "27" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        name: "macro_filter",
+        expr: "[-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3].filter(x, x \u003e 0)",
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+            ],
+          },
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    -10^#*expr.Constant_Int64Value#,\n    -9^#*expr.Constant_Int64Value#,\n    -8^#*expr.Constant_Int64Value#,\n    -7^#*expr.Constant_Int64Value#,\n    -6^#*expr.Constant_Int64Value#,\n    -5^#*expr.Constant_Int64Value#,\n    -4^#*expr.Constant_Int64Value#,\n    -3^#*expr.Constant_Int64Value#,\n    -2^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "[-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3].filter(x, x \u003e 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    -10^#2[1,1]#,\n    -9^#3[1,6]#,\n    -8^#4[1,10]#,\n    -7^#5[1,14]#,\n    -6^#6[1,18]#,\n    -5^#7[1,22]#,\n    -4^#8[1,26]#,\n    -3^#9[1,30]#,\n    -2^#10[1,34]#,\n    -1^#11[1,38]#,\n    0^#12[1,42]#,\n    1^#13[1,45]#,\n    2^#14[1,48]#,\n    3^#15[1,51]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#21[1,60]#,\n  // LoopCondition\n  true^#22[1,60]#,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x^#18[1,64]#,\n      0^#20[1,68]#\n    )^#19[1,66]#,\n    _+_(\n      @result^#23[1,60]#,\n      [\n        x^#17[1,61]#\n      ]^#24[1,60]#\n    )^#25[1,60]#,\n    @result^#26[1,60]#\n  )^#27[1,60]#,\n  // Result\n  @result^#28[1,60]#)^#29[1,60]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 8, 1, 6, 1, 8],
+        [4, 10, 12, 1, 10, 1, 12],
+        [5, 14, 16, 1, 14, 1, 16],
+        [6, 18, 20, 1, 18, 1, 20],
+        [7, 22, 24, 1, 22, 1, 24],
+        [8, 26, 28, 1, 26, 1, 28],
+        [9, 30, 32, 1, 30, 1, 32],
+        [10, 34, 36, 1, 34, 1, 36],
+        [11, 38, 40, 1, 38, 1, 40],
+        [12, 42, 43, 1, 42, 1, 43],
+        [13, 45, 46, 1, 45, 1, 46],
+        [14, 48, 49, 1, 48, 1, 49],
+        [15, 51, 52, 1, 51, 1, 52],
+        [17, 61, 62, 1, 61, 1, 62],
+        [18, 64, 65, 1, 64, 1, 65],
+        [19, 66, 67, 1, 66, 1, 67],
+        [20, 68, 69, 1, 68, 1, 69],
+        [21, 60, 60, 1, 60, 1, 60],
+        [22, 60, 60, 1, 60, 1, 60],
+        [23, 60, 60, 1, 60, 1, 60],
+        [24, 60, 60, 1, 60, 1, 60],
+        [25, 60, 60, 1, 60, 1, 60],
+        [26, 60, 60, 1, 60, 1, 60],
+        [27, 60, 60, 1, 60, 1, 60],
+        [28, 60, 60, 1, 60, 1, 60],
+        [29, 60, 60, 1, 60, 1, 60],
+      ],
+      lineOffsets: [71],
+      parsedExpr: {
+        expr: {
+          id: "29",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "-10" } },
+                  { id: "3", constExpr: { int64Value: "-9" } },
+                  { id: "4", constExpr: { int64Value: "-8" } },
+                  { id: "5", constExpr: { int64Value: "-7" } },
+                  { id: "6", constExpr: { int64Value: "-6" } },
+                  { id: "7", constExpr: { int64Value: "-5" } },
+                  { id: "8", constExpr: { int64Value: "-4" } },
+                  { id: "9", constExpr: { int64Value: "-3" } },
+                  { id: "10", constExpr: { int64Value: "-2" } },
+                  { id: "11", constExpr: { int64Value: "-1" } },
+                  { id: "12", constExpr: { int64Value: "0" } },
+                  { id: "13", constExpr: { int64Value: "1" } },
+                  { id: "14", constExpr: { int64Value: "2" } },
+                  { id: "15", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "21", listExpr: {} },
+            loopCondition: { id: "22", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "27",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "18", identExpr: { name: "x" } },
+                        { id: "20", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "23", identExpr: { name: "@result" } },
+                        {
+                          id: "24",
+                          listExpr: {
+                            elements: [{ id: "17", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "26", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "28", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "macro_filter",
+          lineOffsets: [71],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 10,
+            "5": 14,
+            "6": 18,
+            "7": 22,
+            "8": 26,
+            "9": 30,
+            "10": 34,
+            "11": 38,
+            "12": 42,
+            "13": 45,
+            "14": 48,
+            "15": 51,
+            "17": 61,
+            "18": 64,
+            "19": 66,
+            "20": 68,
+            "21": 60,
+            "22": 60,
+            "23": 60,
+            "24": 60,
+            "25": 60,
+            "26": 60,
+            "27": 60,
+            "28": 60,
+            "29": 60,
+          },
+          macroCalls: {
+            "29": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "-10" } },
+                      { id: "3", constExpr: { int64Value: "-9" } },
+                      { id: "4", constExpr: { int64Value: "-8" } },
+                      { id: "5", constExpr: { int64Value: "-7" } },
+                      { id: "6", constExpr: { int64Value: "-6" } },
+                      { id: "7", constExpr: { int64Value: "-5" } },
+                      { id: "8", constExpr: { int64Value: "-4" } },
+                      { id: "9", constExpr: { int64Value: "-3" } },
+                      { id: "10", constExpr: { int64Value: "-2" } },
+                      { id: "11", constExpr: { int64Value: "-1" } },
+                      { id: "12", constExpr: { int64Value: "0" } },
+                      { id: "13", constExpr: { int64Value: "1" } },
+                      { id: "14", constExpr: { int64Value: "2" } },
+                      { id: "15", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "17", identExpr: { name: "x" } },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "18", identExpr: { name: "x" } },
+                        { id: "20", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 29,
+          function: "filter",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "-10" } },
+                { id: "3", constExpr: { int64Value: "-9" } },
+                { id: "4", constExpr: { int64Value: "-8" } },
+                { id: "5", constExpr: { int64Value: "-7" } },
+                { id: "6", constExpr: { int64Value: "-6" } },
+                { id: "7", constExpr: { int64Value: "-5" } },
+                { id: "8", constExpr: { int64Value: "-4" } },
+                { id: "9", constExpr: { int64Value: "-3" } },
+                { id: "10", constExpr: { int64Value: "-2" } },
+                { id: "11", constExpr: { int64Value: "-1" } },
+                { id: "12", constExpr: { int64Value: "0" } },
+                { id: "13", constExpr: { int64Value: "1" } },
+                { id: "14", constExpr: { int64Value: "2" } },
+                { id: "15", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "17", identExpr: { name: "x" } },
+            {
+              id: "19",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "20", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    -10~int,\n    -9~int,\n    -8~int,\n    -7~int,\n    -6~int,\n    -5~int,\n    -4~int,\n    -3~int,\n    -2~int,\n    -1~int,\n    0~int,\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _\u003e_(\n      x~int^x,\n      0~int\n    )~bool^greater_int64,\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "17": { name: "x" },
+          "18": { name: "x" },
+          "19": { overloadId: ["greater_int64"] },
+          "23": { name: "@result" },
+          "25": { overloadId: ["add_list"] },
+          "26": { name: "@result" },
+          "27": { overloadId: ["conditional"] },
+          "28": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "INT64" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "INT64" },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { primitive: "BOOL" },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { listType: { elemType: { primitive: "INT64" } } },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+          "28": { listType: { elemType: { primitive: "INT64" } } },
+          "29": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "macro_filter",
+          lineOffsets: [71],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 10,
+            "5": 14,
+            "6": 18,
+            "7": 22,
+            "8": 26,
+            "9": 30,
+            "10": 34,
+            "11": 38,
+            "12": 42,
+            "13": 45,
+            "14": 48,
+            "15": 51,
+            "17": 61,
+            "18": 64,
+            "19": 66,
+            "20": 68,
+            "21": 60,
+            "22": 60,
+            "23": 60,
+            "24": 60,
+            "25": 60,
+            "26": 60,
+            "27": 60,
+            "28": 60,
+            "29": 60,
+          },
+          macroCalls: {
+            "29": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "-10" } },
+                      { id: "3", constExpr: { int64Value: "-9" } },
+                      { id: "4", constExpr: { int64Value: "-8" } },
+                      { id: "5", constExpr: { int64Value: "-7" } },
+                      { id: "6", constExpr: { int64Value: "-6" } },
+                      { id: "7", constExpr: { int64Value: "-5" } },
+                      { id: "8", constExpr: { int64Value: "-4" } },
+                      { id: "9", constExpr: { int64Value: "-3" } },
+                      { id: "10", constExpr: { int64Value: "-2" } },
+                      { id: "11", constExpr: { int64Value: "-1" } },
+                      { id: "12", constExpr: { int64Value: "0" } },
+                      { id: "13", constExpr: { int64Value: "1" } },
+                      { id: "14", constExpr: { int64Value: "2" } },
+                      { id: "15", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "17", identExpr: { name: "x" } },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "18", identExpr: { name: "x" } },
+                        { id: "20", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "29",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "-10" } },
+                  { id: "3", constExpr: { int64Value: "-9" } },
+                  { id: "4", constExpr: { int64Value: "-8" } },
+                  { id: "5", constExpr: { int64Value: "-7" } },
+                  { id: "6", constExpr: { int64Value: "-6" } },
+                  { id: "7", constExpr: { int64Value: "-5" } },
+                  { id: "8", constExpr: { int64Value: "-4" } },
+                  { id: "9", constExpr: { int64Value: "-3" } },
+                  { id: "10", constExpr: { int64Value: "-2" } },
+                  { id: "11", constExpr: { int64Value: "-1" } },
+                  { id: "12", constExpr: { int64Value: "0" } },
+                  { id: "13", constExpr: { int64Value: "1" } },
+                  { id: "14", constExpr: { int64Value: "2" } },
+                  { id: "15", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "21", listExpr: {} },
+            loopCondition: { id: "22", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "27",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "18", identExpr: { name: "x" } },
+                        { id: "20", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "23", identExpr: { name: "@result" } },
+                        {
+                          id: "24",
+                          listExpr: {
+                            elements: [{ id: "17", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "26", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "28", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "63", max: "231" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "88",
+    },
+    {
+      original: {
+        name: "macro_has_map_key",
+        expr: "has({'a':1}.a) \u0026\u0026 !has({}.a)",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  {\n    "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n  !_(\n    {}^#*expr.Expr_StructExpr#.a~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'has({"a": 1}.a) \u0026\u0026 !has({}.a)',
+      locationAst:
+        '_\u0026\u0026_(\n  {\n    "a"^#4[1,5]#:1^#5[1,9]#^#3[1,8]#\n  }^#2[1,4]#.a~test-only~^#7[1,3]#,\n  !_(\n    {}^#10[1,23]#.a~test-only~^#12[1,22]#\n  )^#8[1,18]#\n)^#13[1,15]#',
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 5, 8, 1, 5, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 3, 3, 1, 3, 1, 3],
+        [8, 18, 19, 1, 18, 1, 19],
+        [10, 23, 24, 1, 23, 1, 24],
+        [11, 25, 26, 1, 25, 1, 26],
+        [12, 22, 22, 1, 22, 1, 22],
+        [13, 15, 17, 1, 15, 1, 17],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "7",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "3",
+                          mapKey: { id: "4", constExpr: { stringValue: "a" } },
+                          value: { id: "5", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "12",
+                      selectExpr: {
+                        operand: { id: "10", structExpr: {} },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "macro_has_map_key",
+          lineOffsets: [29],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 5,
+            "5": 9,
+            "6": 11,
+            "7": 3,
+            "8": 18,
+            "10": 23,
+            "11": 25,
+            "12": 22,
+            "13": 15,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "3",
+                              mapKey: {
+                                id: "4",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "5",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "12": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "11",
+                    selectExpr: {
+                      operand: { id: "10", structExpr: {} },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 7,
+          function: "has",
+          args: [
+            {
+              id: "6",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "3",
+                        mapKey: { id: "4", constExpr: { stringValue: "a" } },
+                        value: { id: "5", constExpr: { int64Value: "1" } },
+                      },
+                    ],
+                  },
+                },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 12,
+          function: "has",
+          args: [
+            {
+              id: "11",
+              selectExpr: { operand: { id: "10", structExpr: {} }, field: "a" },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_\u0026\u0026_(\n  {\n    "a"~string:1~int\n  }~map(string, int).a~test-only~~bool,\n  !_(\n    {}~map(dyn, dyn).a~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["logical_not"] },
+          "13": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "10": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "macro_has_map_key",
+          lineOffsets: [29],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 5,
+            "5": 9,
+            "6": 11,
+            "7": 3,
+            "8": 18,
+            "10": 23,
+            "11": 25,
+            "12": 22,
+            "13": 15,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "3",
+                              mapKey: {
+                                id: "4",
+                                constExpr: { stringValue: "a" },
+                              },
+                              value: {
+                                id: "5",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "12": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "11",
+                    selectExpr: {
+                      operand: { id: "10", structExpr: {} },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "7",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "3",
+                          mapKey: { id: "4", constExpr: { stringValue: "a" } },
+                          value: { id: "5", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "12",
+                      selectExpr: {
+                        operand: { id: "10", structExpr: {} },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "31", max: "63" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "65",
+    },
+    {
+      original: {
+        name: "macro_has_pb2_field_undefined",
+        expr: "has(TestAllTypes{}.invalid_field)",
+        disableCheck: true,
+        container: "google.expr.proto2.test",
+        evalError: { errors: [{ message: "no such field 'invalid_field'" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "TestAllTypes{}^#*expr.Expr_StructExpr#.invalid_field~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(TestAllTypes{}.invalid_field)",
+      locationAst: "TestAllTypes{}^#2[1,16]#.invalid_field~test-only~^#4[1,3]#",
+      positions: [
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", structExpr: { messageName: "TestAllTypes" } },
+            field: "invalid_field",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "macro_has_pb2_field_undefined",
+          lineOffsets: [34],
+          positions: { "2": 16, "3": 18, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        structExpr: { messageName: "TestAllTypes" },
+                      },
+                      field: "invalid_field",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  structExpr: { messageName: "TestAllTypes" },
+                },
+                field: "invalid_field",
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        "ERROR: \u003cinput\u003e:1:4: undefined field 'invalid_field'\n | has(TestAllTypes{}.invalid_field)\n | ...^",
+      errors: [
+        {
+          category: "undefined-field",
+          message: "undefined field 'invalid_field'",
+          id: 4,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such field 'invalid_field'" }],
+        },
+      },
+      runtimeCost: "42",
+    },
+    {
+      original: {
+        name: "macro_map",
+        expr: "[1, 2, 3].map(x, x * 2) == [2, 4, 6]",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        _*_(\n          x^#*expr.Expr_IdentExpr#,\n          2^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    6^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3].map(x, x * 2) == [2, 4, 6]",
+      locationAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,4]#,\n      3^#4[1,7]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#10[1,13]#,\n    // LoopCondition\n    true^#11[1,13]#,\n    // LoopStep\n    _+_(\n      @result^#12[1,13]#,\n      [\n        _*_(\n          x^#7[1,17]#,\n          2^#9[1,21]#\n        )^#8[1,19]#\n      ]^#13[1,13]#\n    )^#14[1,13]#,\n    // Result\n    @result^#15[1,13]#)^#16[1,13]#,\n  [\n    2^#19[1,28]#,\n    4^#20[1,31]#,\n    6^#21[1,34]#\n  ]^#18[1,27]#\n)^#17[1,24]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 21, 22, 1, 21, 1, 22],
+        [10, 13, 13, 1, 13, 1, 13],
+        [11, 13, 13, 1, 13, 1, 13],
+        [12, 13, 13, 1, 13, 1, 13],
+        [13, 13, 13, 1, 13, 1, 13],
+        [14, 13, 13, 1, 13, 1, 13],
+        [15, 13, 13, 1, 13, 1, 13],
+        [16, 13, 13, 1, 13, 1, 13],
+        [17, 24, 26, 1, 24, 1, 26],
+        [18, 27, 28, 1, 27, 1, 28],
+        [19, 28, 29, 1, 28, 1, 29],
+        [20, 31, 32, 1, 31, 1, 32],
+        [21, 34, 35, 1, 34, 1, 35],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "16",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "10", listExpr: {} },
+                  loopCondition: { id: "11", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "_*_",
+                                  args: [
+                                    { id: "7", identExpr: { name: "x" } },
+                                    { id: "9", constExpr: { int64Value: "2" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "15", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "18",
+                listExpr: {
+                  elements: [
+                    { id: "19", constExpr: { int64Value: "2" } },
+                    { id: "20", constExpr: { int64Value: "4" } },
+                    { id: "21", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "macro_map",
+          lineOffsets: [37],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 21,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "17": 24,
+            "18": 27,
+            "19": 28,
+            "20": 31,
+            "21": 34,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        _*_(\n          x~int^x,\n          2~int\n        )~int^multiply_int64\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  [\n    2~int,\n    4~int,\n    6~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "x" },
+          "8": { overloadId: ["multiply_int64"] },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "17": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "INT64" },
+          "20": { primitive: "INT64" },
+          "21": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "macro_map",
+          lineOffsets: [37],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 21,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "17": 24,
+            "18": 27,
+            "19": 28,
+            "20": 31,
+            "21": 34,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "16",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "10", listExpr: {} },
+                  loopCondition: { id: "11", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        {
+                          id: "13",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "_*_",
+                                  args: [
+                                    { id: "7", identExpr: { name: "x" } },
+                                    { id: "9", constExpr: { int64Value: "2" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "15", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "18",
+                listExpr: {
+                  elements: [
+                    { id: "19", constExpr: { int64Value: "2" } },
+                    { id: "20", constExpr: { int64Value: "4" } },
+                    { id: "21", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "74", max: "74" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "74",
+    },
+    {
+      original: {
+        name: "matches_global",
+        expr: "matches(input, 'k.*')",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+        bindings: { input: { value: { stringValue: "kathmandu" } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: 'matches(\n  input^#*expr.Expr_IdentExpr#,\n  "k.*"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'matches(input, "k.*")',
+      locationAst: 'matches(\n  input^#2[1,8]#,\n  "k.*"^#3[1,15]#\n)^#1[1,7]#',
+      positions: [
+        [1, 7, 8, 1, 7, 1, 8],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 15, 20, 1, 15, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "matches",
+            args: [
+              { id: "2", identExpr: { name: "input" } },
+              { id: "3", constExpr: { stringValue: "k.*" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "matches_global",
+          lineOffsets: [22],
+          positions: { "1": 7, "2": 8, "3": 15 },
+        },
+      },
+      checkedAst:
+        'matches(\n  input~string^input,\n  "k.*"~string\n)~bool^matches',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["matches"] },
+          "2": { name: "input" },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "matches_global",
+          lineOffsets: [22],
+          positions: { "1": 7, "2": 8, "3": 15 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "matches",
+            args: [
+              { id: "2", identExpr: { name: "input" } },
+              { id: "3", constExpr: { stringValue: "k.*" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "matches_member",
+        expr: "input.matches('k.*')\n\t\t\t\t\u0026\u0026 !'foo'.matches('k.*')\n\t\t\t\t\u0026\u0026 !'bar'.matches('k.*')\n\t\t\t\t\u0026\u0026 'kilimanjaro'.matches('.*ro')",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+        bindings: { input: { value: { stringValue: "kathmandu" } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  input^#*expr.Expr_IdentExpr#.matches(\n    "k.*"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    "foo"^#*expr.Constant_StringValue#.matches(\n      "k.*"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    "bar"^#*expr.Constant_StringValue#.matches(\n      "k.*"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  "kilimanjaro"^#*expr.Constant_StringValue#.matches(\n    ".*ro"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input.matches("k.*") \u0026\u0026 !"foo".matches("k.*")',
+      locationAst:
+        '_\u0026\u0026_(\n  input^#1[1,0]#.matches(\n    "k.*"^#3[1,14]#\n  )^#2[1,13]#,\n  !_(\n    "foo"^#5[2,8]#.matches(\n      "k.*"^#7[2,22]#\n    )^#6[2,21]#\n  )^#4[2,7]#,\n  !_(\n    "bar"^#10[3,8]#.matches(\n      "k.*"^#12[3,22]#\n    )^#11[3,21]#\n  )^#9[3,7]#,\n  "kilimanjaro"^#14[4,7]#.matches(\n    ".*ro"^#16[4,29]#\n  )^#15[4,28]#\n)^#8[2,4]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 19, 1, 14, 1, 19],
+        [4, 28, 29, 2, 7, 2, 8],
+        [5, 29, 34, 2, 8, 2, 13],
+        [6, 42, 43, 2, 21, 2, 22],
+        [7, 43, 48, 2, 22, 2, 27],
+        [8, 25, 27, 2, 4, 2, 6],
+        [9, 57, 58, 3, 7, 3, 8],
+        [10, 58, 63, 3, 8, 3, 13],
+        [11, 71, 72, 3, 21, 3, 22],
+        [12, 72, 77, 3, 22, 3, 27],
+        [13, 54, 56, 3, 4, 3, 6],
+        [14, 86, 99, 4, 7, 4, 20],
+        [15, 107, 108, 4, 28, 4, 29],
+        [16, 108, 114, 4, 29, 4, 35],
+        [17, 83, 85, 4, 4, 4, 6],
+      ],
+      lineOffsets: [21, 50, 79, 116],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "input" } },
+                  function: "matches",
+                  args: [{ id: "3", constExpr: { stringValue: "k.*" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        target: { id: "5", constExpr: { stringValue: "foo" } },
+                        function: "matches",
+                        args: [{ id: "7", constExpr: { stringValue: "k.*" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        target: { id: "10", constExpr: { stringValue: "bar" } },
+                        function: "matches",
+                        args: [{ id: "12", constExpr: { stringValue: "k.*" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  target: {
+                    id: "14",
+                    constExpr: { stringValue: "kilimanjaro" },
+                  },
+                  function: "matches",
+                  args: [{ id: "16", constExpr: { stringValue: ".*ro" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "matches_member",
+          lineOffsets: [21, 50, 79, 116],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 28,
+            "5": 29,
+            "6": 42,
+            "7": 43,
+            "8": 25,
+            "9": 57,
+            "10": 58,
+            "11": 71,
+            "12": 72,
+            "13": 54,
+            "14": 86,
+            "15": 107,
+            "16": 108,
+            "17": 83,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  input~string^input.matches(\n    "k.*"~string\n  )~bool^matches_string,\n  !_(\n    "foo"~string.matches(\n      "k.*"~string\n    )~bool^matches_string\n  )~bool^logical_not,\n  !_(\n    "bar"~string.matches(\n      "k.*"~string\n    )~bool^matches_string\n  )~bool^logical_not,\n  "kilimanjaro"~string.matches(\n    ".*ro"~string\n  )~bool^matches_string\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["matches_string"] },
+          "4": { overloadId: ["logical_not"] },
+          "6": { overloadId: ["matches_string"] },
+          "8": { overloadId: ["logical_and"] },
+          "9": { overloadId: ["logical_not"] },
+          "11": { overloadId: ["matches_string"] },
+          "15": { overloadId: ["matches_string"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "STRING" },
+          "14": { primitive: "STRING" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "matches_member",
+          lineOffsets: [21, 50, 79, 116],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 28,
+            "5": 29,
+            "6": 42,
+            "7": 43,
+            "8": 25,
+            "9": 57,
+            "10": 58,
+            "11": 71,
+            "12": 72,
+            "13": 54,
+            "14": 86,
+            "15": 107,
+            "16": 108,
+            "17": 83,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "input" } },
+                  function: "matches",
+                  args: [{ id: "3", constExpr: { stringValue: "k.*" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        target: { id: "5", constExpr: { stringValue: "foo" } },
+                        function: "matches",
+                        args: [{ id: "7", constExpr: { stringValue: "k.*" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        target: { id: "10", constExpr: { stringValue: "bar" } },
+                        function: "matches",
+                        args: [{ id: "12", constExpr: { stringValue: "k.*" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  target: {
+                    id: "14",
+                    constExpr: { stringValue: "kilimanjaro" },
+                  },
+                  function: "matches",
+                  args: [{ id: "16", constExpr: { stringValue: ".*ro" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "1844674407370955267" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "8",
+    },
+    {
+      original: {
+        name: "matches_error",
+        expr: "input.matches(')k.*')",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+        bindings: { input: { value: { stringValue: "kathmandu" } } },
+        evalError: { errors: [{ message: "unexpected ): `)k.*`" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: 'input^#*expr.Expr_IdentExpr#.matches(\n  ")k.*"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input.matches(")k.*")',
+      locationAst: 'input^#1[1,0]#.matches(\n  ")k.*"^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 20, 1, 14, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: ")k.*" } }],
+          },
+        },
+        sourceInfo: {
+          location: "matches_error",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        'input~string^input.matches(\n  ")k.*"~string\n)~bool^matches_string',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["matches_string"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "matches_error",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: ")k.*" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "1844674407370955265" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "error parsing regexp: unexpected ): `)k.*`" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "or_true_1st",
+        expr: 'ai == 20 || ar["foo"] == "bar"',
+        typeEnv: [
+          { name: "ai", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "ar",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          ai: { value: { int64Value: "20" } },
+          ar: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "foo" },
+                    value: { stringValue: "bar" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_||_(\n  _==_(\n    ai^#*expr.Expr_IdentExpr#,\n    20^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      ar^#*expr.Expr_IdentExpr#,\n      "foo"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    "bar"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'ai == 20 || ar["foo"] == "bar"',
+      locationAst:
+        '_||_(\n  _==_(\n    ai^#1[1,0]#,\n    20^#3[1,6]#\n  )^#2[1,3]#,\n  _==_(\n    _[_](\n      ar^#4[1,12]#,\n      "foo"^#6[1,15]#\n    )^#5[1,14]#,\n    "bar"^#8[1,25]#\n  )^#7[1,22]#\n)^#9[1,9]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 3, 5, 1, 3, 1, 5],
+        [3, 6, 8, 1, 6, 1, 8],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 15, 20, 1, 15, 1, 20],
+        [7, 22, 24, 1, 22, 1, 24],
+        [8, 25, 30, 1, 25, 1, 30],
+        [9, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "ai" } },
+                    { id: "3", constExpr: { int64Value: "20" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "4", identExpr: { name: "ar" } },
+                          { id: "6", constExpr: { stringValue: "foo" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "bar" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or_true_1st",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 6,
+            "4": 12,
+            "5": 14,
+            "6": 15,
+            "7": 22,
+            "8": 25,
+            "9": 9,
+          },
+        },
+      },
+      checkedAst:
+        '_||_(\n  _==_(\n    ai~int^ai,\n    20~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      ar~map(string, string)^ar,\n      "foo"~string\n    )~string^index_map,\n    "bar"~string\n  )~bool^equals\n)~bool^logical_or',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "ai" },
+          "2": { overloadId: ["equals"] },
+          "4": { name: "ar" },
+          "5": { overloadId: ["index_map"] },
+          "7": { overloadId: ["equals"] },
+          "9": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "or_true_1st",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 6,
+            "4": 12,
+            "5": 14,
+            "6": 15,
+            "7": 22,
+            "8": 25,
+            "9": 9,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "ai" } },
+                    { id: "3", constExpr: { int64Value: "20" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "4", identExpr: { name: "ar" } },
+                          { id: "6", constExpr: { stringValue: "foo" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "bar" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "or_true_2nd",
+        expr: 'ai == 20 || ar["foo"] == "bar"',
+        typeEnv: [
+          { name: "ai", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "ar",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          ai: { value: { int64Value: "2" } },
+          ar: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "foo" },
+                    value: { stringValue: "bar" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_||_(\n  _==_(\n    ai^#*expr.Expr_IdentExpr#,\n    20^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      ar^#*expr.Expr_IdentExpr#,\n      "foo"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    "bar"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'ai == 20 || ar["foo"] == "bar"',
+      locationAst:
+        '_||_(\n  _==_(\n    ai^#1[1,0]#,\n    20^#3[1,6]#\n  )^#2[1,3]#,\n  _==_(\n    _[_](\n      ar^#4[1,12]#,\n      "foo"^#6[1,15]#\n    )^#5[1,14]#,\n    "bar"^#8[1,25]#\n  )^#7[1,22]#\n)^#9[1,9]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 3, 5, 1, 3, 1, 5],
+        [3, 6, 8, 1, 6, 1, 8],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 15, 20, 1, 15, 1, 20],
+        [7, 22, 24, 1, 22, 1, 24],
+        [8, 25, 30, 1, 25, 1, 30],
+        [9, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "ai" } },
+                    { id: "3", constExpr: { int64Value: "20" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "4", identExpr: { name: "ar" } },
+                          { id: "6", constExpr: { stringValue: "foo" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "bar" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or_true_2nd",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 6,
+            "4": 12,
+            "5": 14,
+            "6": 15,
+            "7": 22,
+            "8": 25,
+            "9": 9,
+          },
+        },
+      },
+      checkedAst:
+        '_||_(\n  _==_(\n    ai~int^ai,\n    20~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      ar~map(string, string)^ar,\n      "foo"~string\n    )~string^index_map,\n    "bar"~string\n  )~bool^equals\n)~bool^logical_or',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "ai" },
+          "2": { overloadId: ["equals"] },
+          "4": { name: "ar" },
+          "5": { overloadId: ["index_map"] },
+          "7": { overloadId: ["equals"] },
+          "9": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "or_true_2nd",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 6,
+            "4": 12,
+            "5": 14,
+            "6": 15,
+            "7": 22,
+            "8": 25,
+            "9": 9,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "ai" } },
+                    { id: "3", constExpr: { int64Value: "20" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "4", identExpr: { name: "ar" } },
+                          { id: "6", constExpr: { stringValue: "foo" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "bar" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        name: "or_false",
+        expr: 'ai == 20 || ar["foo"] == "bar"',
+        typeEnv: [
+          { name: "ai", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "ar",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          ai: { value: { int64Value: "2" } },
+          ar: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "foo" },
+                    value: { stringValue: "baz" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: false },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_||_(\n  _==_(\n    ai^#*expr.Expr_IdentExpr#,\n    20^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      ar^#*expr.Expr_IdentExpr#,\n      "foo"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    "bar"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'ai == 20 || ar["foo"] == "bar"',
+      locationAst:
+        '_||_(\n  _==_(\n    ai^#1[1,0]#,\n    20^#3[1,6]#\n  )^#2[1,3]#,\n  _==_(\n    _[_](\n      ar^#4[1,12]#,\n      "foo"^#6[1,15]#\n    )^#5[1,14]#,\n    "bar"^#8[1,25]#\n  )^#7[1,22]#\n)^#9[1,9]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 3, 5, 1, 3, 1, 5],
+        [3, 6, 8, 1, 6, 1, 8],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 15, 20, 1, 15, 1, 20],
+        [7, 22, 24, 1, 22, 1, 24],
+        [8, 25, 30, 1, 25, 1, 30],
+        [9, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "ai" } },
+                    { id: "3", constExpr: { int64Value: "20" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "4", identExpr: { name: "ar" } },
+                          { id: "6", constExpr: { stringValue: "foo" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "bar" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or_false",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 6,
+            "4": 12,
+            "5": 14,
+            "6": 15,
+            "7": 22,
+            "8": 25,
+            "9": 9,
+          },
+        },
+      },
+      checkedAst:
+        '_||_(\n  _==_(\n    ai~int^ai,\n    20~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      ar~map(string, string)^ar,\n      "foo"~string\n    )~string^index_map,\n    "bar"~string\n  )~bool^equals\n)~bool^logical_or',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "ai" },
+          "2": { overloadId: ["equals"] },
+          "4": { name: "ar" },
+          "5": { overloadId: ["index_map"] },
+          "7": { overloadId: ["equals"] },
+          "9": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "or_false",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 6,
+            "4": 12,
+            "5": 14,
+            "6": 15,
+            "7": 22,
+            "8": 25,
+            "9": 9,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "ai" } },
+                    { id: "3", constExpr: { int64Value: "20" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          { id: "4", identExpr: { name: "ar" } },
+                          { id: "6", constExpr: { stringValue: "foo" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "bar" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        name: "or_error_1st_error",
+        expr: "1/0 != 0 || false",
+        evalError: { errors: [{ message: "division by zero" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_||_(\n  _!=_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 / 0 != 0 || false",
+      locationAst:
+        "_||_(\n  _!=_(\n    _/_(\n      1^#1[1,0]#,\n      0^#3[1,2]#\n    )^#2[1,1]#,\n    0^#5[1,7]#\n  )^#4[1,4]#,\n  false^#6[1,12]#\n)^#7[1,9]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 6, 1, 4, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 12, 17, 1, 12, 1, 17],
+        [7, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or_error_1st_error",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 12,
+            "7": 9,
+          },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _!=_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^not_equals,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["divide_int64"] },
+          "4": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "or_error_1st_error",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 12,
+            "7": 9,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { error: { errors: [{ code: 2, message: "division by zero" }] } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "or_error_2nd_error",
+        expr: "false || 1/0 != 0",
+        evalError: { errors: [{ message: "division by zero" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_||_(\n  false^#*expr.Constant_BoolValue#,\n  _!=_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false || 1 / 0 != 0",
+      locationAst:
+        "_||_(\n  false^#1[1,0]#,\n  _!=_(\n    _/_(\n      1^#2[1,9]#,\n      0^#4[1,11]#\n    )^#3[1,10]#,\n    0^#6[1,16]#\n  )^#5[1,13]#\n)^#7[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 13, 15, 1, 13, 1, 15],
+        [6, 16, 17, 1, 16, 1, 17],
+        [7, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or_error_2nd_error",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 11,
+            "5": 13,
+            "6": 16,
+            "7": 6,
+          },
+        },
+      },
+      checkedAst:
+        "_||_(\n  false~bool,\n  _!=_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^not_equals\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["divide_int64"] },
+          "5": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "or_error_2nd_error",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 11,
+            "5": 13,
+            "6": 16,
+            "7": 6,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "2" },
+      result: { error: { errors: [{ code: 2, message: "division by zero" }] } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "or_error_1st_true",
+        expr: "1/0 != 0 || true",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_||_(\n  _!=_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 / 0 != 0 || true",
+      locationAst:
+        "_||_(\n  _!=_(\n    _/_(\n      1^#1[1,0]#,\n      0^#3[1,2]#\n    )^#2[1,1]#,\n    0^#5[1,7]#\n  )^#4[1,4]#,\n  true^#6[1,12]#\n)^#7[1,9]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 6, 1, 4, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 12, 16, 1, 12, 1, 16],
+        [7, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or_error_1st_true",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 12,
+            "7": 9,
+          },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _!=_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^not_equals,\n  true~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["divide_int64"] },
+          "4": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "or_error_1st_true",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 12,
+            "7": 9,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "or_error_2nd_true",
+        expr: "true || 1/0 != 0",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_||_(\n  true^#*expr.Constant_BoolValue#,\n  _!=_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true || 1 / 0 != 0",
+      locationAst:
+        "_||_(\n  true^#1[1,0]#,\n  _!=_(\n    _/_(\n      1^#2[1,8]#,\n      0^#4[1,10]#\n    )^#3[1,9]#,\n    0^#6[1,15]#\n  )^#5[1,12]#\n)^#7[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 14, 1, 12, 1, 14],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or_error_2nd_true",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 10,
+            "5": 12,
+            "6": 15,
+            "7": 5,
+          },
+        },
+      },
+      checkedAst:
+        "_||_(\n  true~bool,\n  _!=_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^not_equals\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["divide_int64"] },
+          "5": { overloadId: ["not_equals"] },
+          "7": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "or_error_2nd_true",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 10,
+            "5": 12,
+            "6": 15,
+            "7": 5,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+    },
+    {
+      original: {
+        name: "pkg_qualified_id",
+        expr: "b.c.d != 10",
+        typeEnv: [{ name: "a.b.c.d", ident: { type: { primitive: "INT64" } } }],
+        container: "a.b",
+        bindings: { "a.b.c.d": { value: { int64Value: "9" } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_!=_(\n  b^#*expr.Expr_IdentExpr#.c^#*expr.Expr_SelectExpr#.d^#*expr.Expr_SelectExpr#,\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "b.c.d != 10",
+      locationAst:
+        "_!=_(\n  b^#1[1,0]#.c^#2[1,1]#.d^#3[1,3]#,\n  10^#5[1,9]#\n)^#4[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 6, 8, 1, 6, 1, 8],
+        [5, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "3",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    selectExpr: {
+                      operand: { id: "1", identExpr: { name: "b" } },
+                      field: "c",
+                    },
+                  },
+                  field: "d",
+                },
+              },
+              { id: "5", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "pkg_qualified_id",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 1, "3": 3, "4": 6, "5": 9 },
+        },
+      },
+      checkedAst: "_!=_(\n  a.b.c.d~int^a.b.c.d,\n  10~int\n)~bool^not_equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a.b.c.d" },
+          "4": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "pkg_qualified_id",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 1, "3": 3, "4": 6, "5": 9 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              { id: "3", identExpr: { name: "a.b.c.d" } },
+              { id: "5", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "pkg_qualified_id_unchecked",
+        expr: "c.d != 10",
+        disableCheck: true,
+        container: "a.b",
+        bindings: { "a.c.d": { value: { int64Value: "9" } } },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_!=_(\n  c^#*expr.Expr_IdentExpr#.d^#*expr.Expr_SelectExpr#,\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "c.d != 10",
+      locationAst: "_!=_(\n  c^#1[1,0]#.d^#2[1,1]#,\n  10^#4[1,7]#\n)^#3[1,4]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 6, 1, 4, 1, 6],
+        [4, 7, 9, 1, 7, 1, 9],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "c" } },
+                  field: "d",
+                },
+              },
+              { id: "4", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "pkg_qualified_id_unchecked",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'c' (in container 'a.b')\n | c.d != 10\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container 'a.b')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "pkg_qualified_index_unchecked",
+        expr: "b.c['d'] == 10",
+        disableCheck: true,
+        container: "a.b",
+        bindings: {
+          "a.b.c": {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "d" }, value: { int64Value: "10" } },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    b^#*expr.Expr_IdentExpr#.c^#*expr.Expr_SelectExpr#,\n    "d"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'b.c["d"] == 10',
+      locationAst:
+        '_==_(\n  _[_](\n    b^#1[1,0]#.c^#2[1,1]#,\n    "d"^#4[1,4]#\n  )^#3[1,3]#,\n  10^#6[1,12]#\n)^#5[1,9]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 4, 7, 1, 4, 1, 7],
+        [5, 9, 11, 1, 9, 1, 11],
+        [6, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "2",
+                      selectExpr: {
+                        operand: { id: "1", identExpr: { name: "b" } },
+                        field: "c",
+                      },
+                    },
+                    { id: "4", constExpr: { stringValue: "d" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "pkg_qualified_index_unchecked",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 1, "3": 3, "4": 4, "5": 9, "6": 12 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'b' (in container 'a.b')\n | b.c['d'] == 10\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container 'a.b')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "select_key",
+        expr: "m.strMap['val'] == 'string'\n\t\t\t\t\u0026\u0026 m.floatMap['val'] == 1.5\n\t\t\t\t\u0026\u0026 m.doubleMap['val'] == -2.0\n\t\t\t\t\u0026\u0026 m.intMap['val'] == -3\n\t\t\t\t\u0026\u0026 m.int32Map['val'] == 4\n\t\t\t\t\u0026\u0026 m.int64Map['val'] == -5\n\t\t\t\t\u0026\u0026 m.uintMap['val'] == 6u\n\t\t\t\t\u0026\u0026 m.uint32Map['val'] == 7u\n\t\t\t\t\u0026\u0026 m.uint64Map['val'] == 8u\n\t\t\t\t\u0026\u0026 m.boolMap['val'] == true\n\t\t\t\t\u0026\u0026 m.boolMap['val'] != false",
+        typeEnv: [
+          {
+            name: "m",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { dyn: {} },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          m: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "strMap" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { stringValue: "string" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "floatMap" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { doubleValue: 1.5 },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "doubleMap" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { doubleValue: -2 },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "intMap" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { int64Value: "-3" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "int32Map" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { int64Value: "4" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "int64Map" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { int64Value: "-5" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "uintMap" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { uint64Value: "6" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "uint32Map" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { uint64Value: "7" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "uint64Map" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { uint64Value: "8" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolMap" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "val" },
+                            value: { boolValue: true },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.strMap^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    "string"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.floatMap^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.doubleMap^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    -2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.intMap^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    -3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.int32Map^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.int64Map^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    -5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uintMap^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    6u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uint32Map^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    7u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uint64Map^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    8u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolMap^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  _!=_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolMap^#*expr.Expr_SelectExpr#,\n      "val"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'm.strMap["val"] == "string" \u0026\u0026 m.floatMap["val"] == 1.5',
+      locationAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m^#1[1,0]#.strMap^#2[1,1]#,\n      "val"^#4[1,9]#\n    )^#3[1,8]#,\n    "string"^#6[1,19]#\n  )^#5[1,16]#,\n  _==_(\n    _[_](\n      m^#7[2,7]#.floatMap^#8[2,8]#,\n      "val"^#10[2,18]#\n    )^#9[2,17]#,\n    1.5^#12[2,28]#\n  )^#11[2,25]#,\n  _==_(\n    _[_](\n      m^#14[3,7]#.doubleMap^#15[3,8]#,\n      "val"^#17[3,19]#\n    )^#16[3,18]#,\n    -2^#19[3,29]#\n  )^#18[3,26]#,\n  _==_(\n    _[_](\n      m^#21[4,7]#.intMap^#22[4,8]#,\n      "val"^#24[4,16]#\n    )^#23[4,15]#,\n    -3^#26[4,26]#\n  )^#25[4,23]#,\n  _==_(\n    _[_](\n      m^#28[5,7]#.int32Map^#29[5,8]#,\n      "val"^#31[5,18]#\n    )^#30[5,17]#,\n    4^#33[5,28]#\n  )^#32[5,25]#,\n  _==_(\n    _[_](\n      m^#35[6,7]#.int64Map^#36[6,8]#,\n      "val"^#38[6,18]#\n    )^#37[6,17]#,\n    -5^#40[6,28]#\n  )^#39[6,25]#,\n  _==_(\n    _[_](\n      m^#42[7,7]#.uintMap^#43[7,8]#,\n      "val"^#45[7,17]#\n    )^#44[7,16]#,\n    6u^#47[7,27]#\n  )^#46[7,24]#,\n  _==_(\n    _[_](\n      m^#49[8,7]#.uint32Map^#50[8,8]#,\n      "val"^#52[8,19]#\n    )^#51[8,18]#,\n    7u^#54[8,29]#\n  )^#53[8,26]#,\n  _==_(\n    _[_](\n      m^#56[9,7]#.uint64Map^#57[9,8]#,\n      "val"^#59[9,19]#\n    )^#58[9,18]#,\n    8u^#61[9,29]#\n  )^#60[9,26]#,\n  _==_(\n    _[_](\n      m^#63[10,7]#.boolMap^#64[10,8]#,\n      "val"^#66[10,17]#\n    )^#65[10,16]#,\n    true^#68[10,27]#\n  )^#67[10,24]#,\n  _!=_(\n    _[_](\n      m^#70[11,7]#.boolMap^#71[11,8]#,\n      "val"^#73[11,17]#\n    )^#72[11,16]#,\n    false^#75[11,27]#\n  )^#74[11,24]#\n)^#13[2,4]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 9, 14, 1, 9, 1, 14],
+        [5, 16, 18, 1, 16, 1, 18],
+        [6, 19, 27, 1, 19, 1, 27],
+        [7, 35, 36, 2, 7, 2, 8],
+        [8, 36, 37, 2, 8, 2, 9],
+        [9, 45, 46, 2, 17, 2, 18],
+        [10, 46, 51, 2, 18, 2, 23],
+        [11, 53, 55, 2, 25, 2, 27],
+        [12, 56, 59, 2, 28, 2, 31],
+        [13, 32, 34, 2, 4, 2, 6],
+        [14, 67, 68, 3, 7, 3, 8],
+        [15, 68, 69, 3, 8, 3, 9],
+        [16, 78, 79, 3, 18, 3, 19],
+        [17, 79, 84, 3, 19, 3, 24],
+        [18, 86, 88, 3, 26, 3, 28],
+        [19, 89, 93, 3, 29, 3, 33],
+        [20, 64, 66, 3, 4, 3, 6],
+        [21, 101, 102, 4, 7, 4, 8],
+        [22, 102, 103, 4, 8, 4, 9],
+        [23, 109, 110, 4, 15, 4, 16],
+        [24, 110, 115, 4, 16, 4, 21],
+        [25, 117, 119, 4, 23, 4, 25],
+        [26, 120, 122, 4, 26, 4, 28],
+        [27, 98, 100, 4, 4, 4, 6],
+        [28, 130, 131, 5, 7, 5, 8],
+        [29, 131, 132, 5, 8, 5, 9],
+        [30, 140, 141, 5, 17, 5, 18],
+        [31, 141, 146, 5, 18, 5, 23],
+        [32, 148, 150, 5, 25, 5, 27],
+        [33, 151, 152, 5, 28, 5, 29],
+        [34, 127, 129, 5, 4, 5, 6],
+        [35, 160, 161, 6, 7, 6, 8],
+        [36, 161, 162, 6, 8, 6, 9],
+        [37, 170, 171, 6, 17, 6, 18],
+        [38, 171, 176, 6, 18, 6, 23],
+        [39, 178, 180, 6, 25, 6, 27],
+        [40, 181, 183, 6, 28, 6, 30],
+        [41, 157, 159, 6, 4, 6, 6],
+        [42, 191, 192, 7, 7, 7, 8],
+        [43, 192, 193, 7, 8, 7, 9],
+        [44, 200, 201, 7, 16, 7, 17],
+        [45, 201, 206, 7, 17, 7, 22],
+        [46, 208, 210, 7, 24, 7, 26],
+        [47, 211, 213, 7, 27, 7, 29],
+        [48, 188, 190, 7, 4, 7, 6],
+        [49, 221, 222, 8, 7, 8, 8],
+        [50, 222, 223, 8, 8, 8, 9],
+        [51, 232, 233, 8, 18, 8, 19],
+        [52, 233, 238, 8, 19, 8, 24],
+        [53, 240, 242, 8, 26, 8, 28],
+        [54, 243, 245, 8, 29, 8, 31],
+        [55, 218, 220, 8, 4, 8, 6],
+        [56, 253, 254, 9, 7, 9, 8],
+        [57, 254, 255, 9, 8, 9, 9],
+        [58, 264, 265, 9, 18, 9, 19],
+        [59, 265, 270, 9, 19, 9, 24],
+        [60, 272, 274, 9, 26, 9, 28],
+        [61, 275, 277, 9, 29, 9, 31],
+        [62, 250, 252, 9, 4, 9, 6],
+        [63, 285, 286, 10, 7, 10, 8],
+        [64, 286, 287, 10, 8, 10, 9],
+        [65, 294, 295, 10, 16, 10, 17],
+        [66, 295, 300, 10, 17, 10, 22],
+        [67, 302, 304, 10, 24, 10, 26],
+        [68, 305, 309, 10, 27, 10, 31],
+        [69, 282, 284, 10, 4, 10, 6],
+        [70, 317, 318, 11, 7, 11, 8],
+        [71, 318, 319, 11, 8, 11, 9],
+        [72, 326, 327, 11, 16, 11, 17],
+        [73, 327, 332, 11, 17, 11, 22],
+        [74, 334, 336, 11, 24, 11, 26],
+        [75, 337, 342, 11, 27, 11, 32],
+        [76, 314, 316, 11, 4, 11, 6],
+      ],
+      lineOffsets: [28, 60, 94, 123, 153, 184, 214, 246, 278, 310, 343],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "m" } },
+                              field: "strMap",
+                            },
+                          },
+                          { id: "4", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "string" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: { id: "7", identExpr: { name: "m" } },
+                              field: "floatMap",
+                            },
+                          },
+                          { id: "10", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "15",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "m" } },
+                              field: "doubleMap",
+                            },
+                          },
+                          { id: "17", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { doubleValue: -2 } },
+                  ],
+                },
+              },
+              {
+                id: "25",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "23",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "22",
+                            selectExpr: {
+                              operand: { id: "21", identExpr: { name: "m" } },
+                              field: "intMap",
+                            },
+                          },
+                          { id: "24", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "26", constExpr: { int64Value: "-3" } },
+                  ],
+                },
+              },
+              {
+                id: "32",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "29",
+                            selectExpr: {
+                              operand: { id: "28", identExpr: { name: "m" } },
+                              field: "int32Map",
+                            },
+                          },
+                          { id: "31", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "33", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "39",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "37",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "36",
+                            selectExpr: {
+                              operand: { id: "35", identExpr: { name: "m" } },
+                              field: "int64Map",
+                            },
+                          },
+                          { id: "38", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "40", constExpr: { int64Value: "-5" } },
+                  ],
+                },
+              },
+              {
+                id: "46",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "44",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "43",
+                            selectExpr: {
+                              operand: { id: "42", identExpr: { name: "m" } },
+                              field: "uintMap",
+                            },
+                          },
+                          { id: "45", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "47", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+              {
+                id: "53",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "51",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "50",
+                            selectExpr: {
+                              operand: { id: "49", identExpr: { name: "m" } },
+                              field: "uint32Map",
+                            },
+                          },
+                          { id: "52", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "54", constExpr: { uint64Value: "7" } },
+                  ],
+                },
+              },
+              {
+                id: "60",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "58",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "57",
+                            selectExpr: {
+                              operand: { id: "56", identExpr: { name: "m" } },
+                              field: "uint64Map",
+                            },
+                          },
+                          { id: "59", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "61", constExpr: { uint64Value: "8" } },
+                  ],
+                },
+              },
+              {
+                id: "67",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "65",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "64",
+                            selectExpr: {
+                              operand: { id: "63", identExpr: { name: "m" } },
+                              field: "boolMap",
+                            },
+                          },
+                          { id: "66", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "68", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "74",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "72",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "71",
+                            selectExpr: {
+                              operand: { id: "70", identExpr: { name: "m" } },
+                              field: "boolMap",
+                            },
+                          },
+                          { id: "73", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "75", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select_key",
+          lineOffsets: [28, 60, 94, 123, 153, 184, 214, 246, 278, 310, 343],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 8,
+            "4": 9,
+            "5": 16,
+            "6": 19,
+            "7": 35,
+            "8": 36,
+            "9": 45,
+            "10": 46,
+            "11": 53,
+            "12": 56,
+            "13": 32,
+            "14": 67,
+            "15": 68,
+            "16": 78,
+            "17": 79,
+            "18": 86,
+            "19": 89,
+            "20": 64,
+            "21": 101,
+            "22": 102,
+            "23": 109,
+            "24": 110,
+            "25": 117,
+            "26": 120,
+            "27": 98,
+            "28": 130,
+            "29": 131,
+            "30": 140,
+            "31": 141,
+            "32": 148,
+            "33": 151,
+            "34": 127,
+            "35": 160,
+            "36": 161,
+            "37": 170,
+            "38": 171,
+            "39": 178,
+            "40": 181,
+            "41": 157,
+            "42": 191,
+            "43": 192,
+            "44": 200,
+            "45": 201,
+            "46": 208,
+            "47": 211,
+            "48": 188,
+            "49": 221,
+            "50": 222,
+            "51": 232,
+            "52": 233,
+            "53": 240,
+            "54": 243,
+            "55": 218,
+            "56": 253,
+            "57": 254,
+            "58": 264,
+            "59": 265,
+            "60": 272,
+            "61": 275,
+            "62": 250,
+            "63": 285,
+            "64": 286,
+            "65": 294,
+            "66": 295,
+            "67": 302,
+            "68": 305,
+            "69": 282,
+            "70": 317,
+            "71": 318,
+            "72": 326,
+            "73": 327,
+            "74": 334,
+            "75": 337,
+            "76": 314,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.strMap~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    "string"~string\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.floatMap~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    1.5~double\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.doubleMap~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    -2~double\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.intMap~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    -3~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.int32Map~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    4~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.int64Map~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    -5~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uintMap~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    6u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uint32Map~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    7u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uint64Map~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    8u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolMap~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    true~bool\n  )~bool^equals,\n  _!=_(\n    _[_](\n      m~map(string, dyn)^m.boolMap~dyn,\n      "val"~string\n    )~dyn^index_map|optional_map_index_value,\n    false~bool\n  )~bool^not_equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "m" },
+          "3": { overloadId: ["index_map", "optional_map_index_value"] },
+          "5": { overloadId: ["equals"] },
+          "7": { name: "m" },
+          "9": { overloadId: ["index_map", "optional_map_index_value"] },
+          "11": { overloadId: ["equals"] },
+          "13": { overloadId: ["logical_and"] },
+          "14": { name: "m" },
+          "16": { overloadId: ["index_map", "optional_map_index_value"] },
+          "18": { overloadId: ["equals"] },
+          "21": { name: "m" },
+          "23": { overloadId: ["index_map", "optional_map_index_value"] },
+          "25": { overloadId: ["equals"] },
+          "28": { name: "m" },
+          "30": { overloadId: ["index_map", "optional_map_index_value"] },
+          "32": { overloadId: ["equals"] },
+          "35": { name: "m" },
+          "37": { overloadId: ["index_map", "optional_map_index_value"] },
+          "39": { overloadId: ["equals"] },
+          "42": { name: "m" },
+          "44": { overloadId: ["index_map", "optional_map_index_value"] },
+          "46": { overloadId: ["equals"] },
+          "49": { name: "m" },
+          "51": { overloadId: ["index_map", "optional_map_index_value"] },
+          "53": { overloadId: ["equals"] },
+          "56": { name: "m" },
+          "58": { overloadId: ["index_map", "optional_map_index_value"] },
+          "60": { overloadId: ["equals"] },
+          "63": { name: "m" },
+          "65": { overloadId: ["index_map", "optional_map_index_value"] },
+          "67": { overloadId: ["equals"] },
+          "70": { name: "m" },
+          "72": { overloadId: ["index_map", "optional_map_index_value"] },
+          "74": { overloadId: ["not_equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "8": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "15": { dyn: {} },
+          "16": { dyn: {} },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "DOUBLE" },
+          "21": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "22": { dyn: {} },
+          "23": { dyn: {} },
+          "24": { primitive: "STRING" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "28": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "29": { dyn: {} },
+          "30": { dyn: {} },
+          "31": { primitive: "STRING" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "INT64" },
+          "35": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "36": { dyn: {} },
+          "37": { dyn: {} },
+          "38": { primitive: "STRING" },
+          "39": { primitive: "BOOL" },
+          "40": { primitive: "INT64" },
+          "42": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "43": { dyn: {} },
+          "44": { dyn: {} },
+          "45": { primitive: "STRING" },
+          "46": { primitive: "BOOL" },
+          "47": { primitive: "UINT64" },
+          "49": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "50": { dyn: {} },
+          "51": { dyn: {} },
+          "52": { primitive: "STRING" },
+          "53": { primitive: "BOOL" },
+          "54": { primitive: "UINT64" },
+          "56": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "57": { dyn: {} },
+          "58": { dyn: {} },
+          "59": { primitive: "STRING" },
+          "60": { primitive: "BOOL" },
+          "61": { primitive: "UINT64" },
+          "63": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "64": { dyn: {} },
+          "65": { dyn: {} },
+          "66": { primitive: "STRING" },
+          "67": { primitive: "BOOL" },
+          "68": { primitive: "BOOL" },
+          "70": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "71": { dyn: {} },
+          "72": { dyn: {} },
+          "73": { primitive: "STRING" },
+          "74": { primitive: "BOOL" },
+          "75": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "select_key",
+          lineOffsets: [28, 60, 94, 123, 153, 184, 214, 246, 278, 310, 343],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 8,
+            "4": 9,
+            "5": 16,
+            "6": 19,
+            "7": 35,
+            "8": 36,
+            "9": 45,
+            "10": 46,
+            "11": 53,
+            "12": 56,
+            "13": 32,
+            "14": 67,
+            "15": 68,
+            "16": 78,
+            "17": 79,
+            "18": 86,
+            "19": 89,
+            "20": 64,
+            "21": 101,
+            "22": 102,
+            "23": 109,
+            "24": 110,
+            "25": 117,
+            "26": 120,
+            "27": 98,
+            "28": 130,
+            "29": 131,
+            "30": 140,
+            "31": 141,
+            "32": 148,
+            "33": 151,
+            "34": 127,
+            "35": 160,
+            "36": 161,
+            "37": 170,
+            "38": 171,
+            "39": 178,
+            "40": 181,
+            "41": 157,
+            "42": 191,
+            "43": 192,
+            "44": 200,
+            "45": 201,
+            "46": 208,
+            "47": 211,
+            "48": 188,
+            "49": 221,
+            "50": 222,
+            "51": 232,
+            "52": 233,
+            "53": 240,
+            "54": 243,
+            "55": 218,
+            "56": 253,
+            "57": 254,
+            "58": 264,
+            "59": 265,
+            "60": 272,
+            "61": 275,
+            "62": 250,
+            "63": 285,
+            "64": 286,
+            "65": 294,
+            "66": 295,
+            "67": 302,
+            "68": 305,
+            "69": 282,
+            "70": 317,
+            "71": 318,
+            "72": 326,
+            "73": 327,
+            "74": 334,
+            "75": 337,
+            "76": 314,
+          },
+        },
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "m" } },
+                              field: "strMap",
+                            },
+                          },
+                          { id: "4", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "string" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: { id: "7", identExpr: { name: "m" } },
+                              field: "floatMap",
+                            },
+                          },
+                          { id: "10", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "15",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "m" } },
+                              field: "doubleMap",
+                            },
+                          },
+                          { id: "17", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { doubleValue: -2 } },
+                  ],
+                },
+              },
+              {
+                id: "25",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "23",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "22",
+                            selectExpr: {
+                              operand: { id: "21", identExpr: { name: "m" } },
+                              field: "intMap",
+                            },
+                          },
+                          { id: "24", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "26", constExpr: { int64Value: "-3" } },
+                  ],
+                },
+              },
+              {
+                id: "32",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "29",
+                            selectExpr: {
+                              operand: { id: "28", identExpr: { name: "m" } },
+                              field: "int32Map",
+                            },
+                          },
+                          { id: "31", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "33", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "39",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "37",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "36",
+                            selectExpr: {
+                              operand: { id: "35", identExpr: { name: "m" } },
+                              field: "int64Map",
+                            },
+                          },
+                          { id: "38", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "40", constExpr: { int64Value: "-5" } },
+                  ],
+                },
+              },
+              {
+                id: "46",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "44",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "43",
+                            selectExpr: {
+                              operand: { id: "42", identExpr: { name: "m" } },
+                              field: "uintMap",
+                            },
+                          },
+                          { id: "45", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "47", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+              {
+                id: "53",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "51",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "50",
+                            selectExpr: {
+                              operand: { id: "49", identExpr: { name: "m" } },
+                              field: "uint32Map",
+                            },
+                          },
+                          { id: "52", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "54", constExpr: { uint64Value: "7" } },
+                  ],
+                },
+              },
+              {
+                id: "60",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "58",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "57",
+                            selectExpr: {
+                              operand: { id: "56", identExpr: { name: "m" } },
+                              field: "uint64Map",
+                            },
+                          },
+                          { id: "59", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "61", constExpr: { uint64Value: "8" } },
+                  ],
+                },
+              },
+              {
+                id: "67",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "65",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "64",
+                            selectExpr: {
+                              operand: { id: "63", identExpr: { name: "m" } },
+                              field: "boolMap",
+                            },
+                          },
+                          { id: "66", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "68", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "74",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "72",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "71",
+                            selectExpr: {
+                              operand: { id: "70", identExpr: { name: "m" } },
+                              field: "boolMap",
+                            },
+                          },
+                          { id: "73", constExpr: { stringValue: "val" } },
+                        ],
+                      },
+                    },
+                    { id: "75", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "8" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "44",
+    },
+    {
+      original: {
+        name: "select_bool_key",
+        expr: "m.boolStr[true] == 'string'\n\t\t\t\t\u0026\u0026 m.boolFloat32[true] == 1.5\n\t\t\t\t\u0026\u0026 m.boolFloat64[false] == -2.1\n\t\t\t\t\u0026\u0026 m.boolInt[false] == -3\n\t\t\t\t\u0026\u0026 m.boolInt32[false] == 0\n\t\t\t\t\u0026\u0026 m.boolInt64[true] == 4\n\t\t\t\t\u0026\u0026 m.boolUint[true] == 5u\n\t\t\t\t\u0026\u0026 m.boolUint32[true] == 6u\n\t\t\t\t\u0026\u0026 m.boolUint64[false] == 7u\n\t\t\t\t\u0026\u0026 m.boolBool[true]\n\t\t\t\t\u0026\u0026 m.boolIface[false] == true",
+        typeEnv: [
+          {
+            name: "m",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { dyn: {} },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          m: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "boolStr" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: true },
+                            value: { stringValue: "string" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolFloat32" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: true },
+                            value: { doubleValue: 1.5 },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolFloat64" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: false },
+                            value: { doubleValue: -2.1 },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolInt" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: false },
+                            value: { int64Value: "-3" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolInt32" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: false },
+                            value: { int64Value: "0" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolInt64" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: true },
+                            value: { int64Value: "4" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolUint" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: true },
+                            value: { uint64Value: "5" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolUint32" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: true },
+                            value: { uint64Value: "6" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolUint64" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: false },
+                            value: { uint64Value: "7" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolBool" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: true },
+                            value: { boolValue: true },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "boolIface" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { boolValue: false },
+                            value: { boolValue: true },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolStr^#*expr.Expr_SelectExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    "string"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolFloat32^#*expr.Expr_SelectExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolFloat64^#*expr.Expr_SelectExpr#,\n      false^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    -2.1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolInt^#*expr.Expr_SelectExpr#,\n      false^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    -3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolInt32^#*expr.Expr_SelectExpr#,\n      false^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolInt64^#*expr.Expr_SelectExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolUint^#*expr.Expr_SelectExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    5u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolUint32^#*expr.Expr_SelectExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    6u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolUint64^#*expr.Expr_SelectExpr#,\n      false^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    7u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _[_](\n    m^#*expr.Expr_IdentExpr#.boolBool^#*expr.Expr_SelectExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolIface^#*expr.Expr_SelectExpr#,\n      false^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'm.boolStr[true] == "string" \u0026\u0026 m.boolFloat32[true] == 1.5',
+      locationAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m^#1[1,0]#.boolStr^#2[1,1]#,\n      true^#4[1,10]#\n    )^#3[1,9]#,\n    "string"^#6[1,19]#\n  )^#5[1,16]#,\n  _==_(\n    _[_](\n      m^#7[2,7]#.boolFloat32^#8[2,8]#,\n      true^#10[2,21]#\n    )^#9[2,20]#,\n    1.5^#12[2,30]#\n  )^#11[2,27]#,\n  _==_(\n    _[_](\n      m^#14[3,7]#.boolFloat64^#15[3,8]#,\n      false^#17[3,21]#\n    )^#16[3,20]#,\n    -2.1^#19[3,31]#\n  )^#18[3,28]#,\n  _==_(\n    _[_](\n      m^#21[4,7]#.boolInt^#22[4,8]#,\n      false^#24[4,17]#\n    )^#23[4,16]#,\n    -3^#26[4,27]#\n  )^#25[4,24]#,\n  _==_(\n    _[_](\n      m^#28[5,7]#.boolInt32^#29[5,8]#,\n      false^#31[5,19]#\n    )^#30[5,18]#,\n    0^#33[5,29]#\n  )^#32[5,26]#,\n  _==_(\n    _[_](\n      m^#35[6,7]#.boolInt64^#36[6,8]#,\n      true^#38[6,19]#\n    )^#37[6,18]#,\n    4^#40[6,28]#\n  )^#39[6,25]#,\n  _==_(\n    _[_](\n      m^#42[7,7]#.boolUint^#43[7,8]#,\n      true^#45[7,18]#\n    )^#44[7,17]#,\n    5u^#47[7,27]#\n  )^#46[7,24]#,\n  _==_(\n    _[_](\n      m^#49[8,7]#.boolUint32^#50[8,8]#,\n      true^#52[8,20]#\n    )^#51[8,19]#,\n    6u^#54[8,29]#\n  )^#53[8,26]#,\n  _==_(\n    _[_](\n      m^#56[9,7]#.boolUint64^#57[9,8]#,\n      false^#59[9,20]#\n    )^#58[9,19]#,\n    7u^#61[9,30]#\n  )^#60[9,27]#,\n  _[_](\n    m^#63[10,7]#.boolBool^#64[10,8]#,\n    true^#66[10,18]#\n  )^#65[10,17]#,\n  _==_(\n    _[_](\n      m^#68[11,7]#.boolIface^#69[11,8]#,\n      false^#71[11,19]#\n    )^#70[11,18]#,\n    true^#73[11,29]#\n  )^#72[11,26]#\n)^#13[2,4]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 10, 14, 1, 10, 1, 14],
+        [5, 16, 18, 1, 16, 1, 18],
+        [6, 19, 27, 1, 19, 1, 27],
+        [7, 35, 36, 2, 7, 2, 8],
+        [8, 36, 37, 2, 8, 2, 9],
+        [9, 48, 49, 2, 20, 2, 21],
+        [10, 49, 53, 2, 21, 2, 25],
+        [11, 55, 57, 2, 27, 2, 29],
+        [12, 58, 61, 2, 30, 2, 33],
+        [13, 32, 34, 2, 4, 2, 6],
+        [14, 69, 70, 3, 7, 3, 8],
+        [15, 70, 71, 3, 8, 3, 9],
+        [16, 82, 83, 3, 20, 3, 21],
+        [17, 83, 88, 3, 21, 3, 26],
+        [18, 90, 92, 3, 28, 3, 30],
+        [19, 93, 97, 3, 31, 3, 35],
+        [20, 66, 68, 3, 4, 3, 6],
+        [21, 105, 106, 4, 7, 4, 8],
+        [22, 106, 107, 4, 8, 4, 9],
+        [23, 114, 115, 4, 16, 4, 17],
+        [24, 115, 120, 4, 17, 4, 22],
+        [25, 122, 124, 4, 24, 4, 26],
+        [26, 125, 127, 4, 27, 4, 29],
+        [27, 102, 104, 4, 4, 4, 6],
+        [28, 135, 136, 5, 7, 5, 8],
+        [29, 136, 137, 5, 8, 5, 9],
+        [30, 146, 147, 5, 18, 5, 19],
+        [31, 147, 152, 5, 19, 5, 24],
+        [32, 154, 156, 5, 26, 5, 28],
+        [33, 157, 158, 5, 29, 5, 30],
+        [34, 132, 134, 5, 4, 5, 6],
+        [35, 166, 167, 6, 7, 6, 8],
+        [36, 167, 168, 6, 8, 6, 9],
+        [37, 177, 178, 6, 18, 6, 19],
+        [38, 178, 182, 6, 19, 6, 23],
+        [39, 184, 186, 6, 25, 6, 27],
+        [40, 187, 188, 6, 28, 6, 29],
+        [41, 163, 165, 6, 4, 6, 6],
+        [42, 196, 197, 7, 7, 7, 8],
+        [43, 197, 198, 7, 8, 7, 9],
+        [44, 206, 207, 7, 17, 7, 18],
+        [45, 207, 211, 7, 18, 7, 22],
+        [46, 213, 215, 7, 24, 7, 26],
+        [47, 216, 218, 7, 27, 7, 29],
+        [48, 193, 195, 7, 4, 7, 6],
+        [49, 226, 227, 8, 7, 8, 8],
+        [50, 227, 228, 8, 8, 8, 9],
+        [51, 238, 239, 8, 19, 8, 20],
+        [52, 239, 243, 8, 20, 8, 24],
+        [53, 245, 247, 8, 26, 8, 28],
+        [54, 248, 250, 8, 29, 8, 31],
+        [55, 223, 225, 8, 4, 8, 6],
+        [56, 258, 259, 9, 7, 9, 8],
+        [57, 259, 260, 9, 8, 9, 9],
+        [58, 270, 271, 9, 19, 9, 20],
+        [59, 271, 276, 9, 20, 9, 25],
+        [60, 278, 280, 9, 27, 9, 29],
+        [61, 281, 283, 9, 30, 9, 32],
+        [62, 255, 257, 9, 4, 9, 6],
+        [63, 291, 292, 10, 7, 10, 8],
+        [64, 292, 293, 10, 8, 10, 9],
+        [65, 301, 302, 10, 17, 10, 18],
+        [66, 302, 306, 10, 18, 10, 22],
+        [67, 288, 290, 10, 4, 10, 6],
+        [68, 315, 316, 11, 7, 11, 8],
+        [69, 316, 317, 11, 8, 11, 9],
+        [70, 326, 327, 11, 18, 11, 19],
+        [71, 327, 332, 11, 19, 11, 24],
+        [72, 334, 336, 11, 26, 11, 28],
+        [73, 337, 341, 11, 29, 11, 33],
+        [74, 312, 314, 11, 4, 11, 6],
+      ],
+      lineOffsets: [28, 62, 98, 128, 159, 189, 219, 251, 284, 308, 342],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "m" } },
+                              field: "boolStr",
+                            },
+                          },
+                          { id: "4", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "string" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: { id: "7", identExpr: { name: "m" } },
+                              field: "boolFloat32",
+                            },
+                          },
+                          { id: "10", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "15",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "m" } },
+                              field: "boolFloat64",
+                            },
+                          },
+                          { id: "17", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { doubleValue: -2.1 } },
+                  ],
+                },
+              },
+              {
+                id: "25",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "23",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "22",
+                            selectExpr: {
+                              operand: { id: "21", identExpr: { name: "m" } },
+                              field: "boolInt",
+                            },
+                          },
+                          { id: "24", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "26", constExpr: { int64Value: "-3" } },
+                  ],
+                },
+              },
+              {
+                id: "32",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "29",
+                            selectExpr: {
+                              operand: { id: "28", identExpr: { name: "m" } },
+                              field: "boolInt32",
+                            },
+                          },
+                          { id: "31", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "33", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "39",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "37",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "36",
+                            selectExpr: {
+                              operand: { id: "35", identExpr: { name: "m" } },
+                              field: "boolInt64",
+                            },
+                          },
+                          { id: "38", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "40", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "46",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "44",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "43",
+                            selectExpr: {
+                              operand: { id: "42", identExpr: { name: "m" } },
+                              field: "boolUint",
+                            },
+                          },
+                          { id: "45", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "47", constExpr: { uint64Value: "5" } },
+                  ],
+                },
+              },
+              {
+                id: "53",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "51",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "50",
+                            selectExpr: {
+                              operand: { id: "49", identExpr: { name: "m" } },
+                              field: "boolUint32",
+                            },
+                          },
+                          { id: "52", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "54", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+              {
+                id: "60",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "58",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "57",
+                            selectExpr: {
+                              operand: { id: "56", identExpr: { name: "m" } },
+                              field: "boolUint64",
+                            },
+                          },
+                          { id: "59", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "61", constExpr: { uint64Value: "7" } },
+                  ],
+                },
+              },
+              {
+                id: "65",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "64",
+                      selectExpr: {
+                        operand: { id: "63", identExpr: { name: "m" } },
+                        field: "boolBool",
+                      },
+                    },
+                    { id: "66", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "72",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "70",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "69",
+                            selectExpr: {
+                              operand: { id: "68", identExpr: { name: "m" } },
+                              field: "boolIface",
+                            },
+                          },
+                          { id: "71", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "73", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select_bool_key",
+          lineOffsets: [28, 62, 98, 128, 159, 189, 219, 251, 284, 308, 342],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 9,
+            "4": 10,
+            "5": 16,
+            "6": 19,
+            "7": 35,
+            "8": 36,
+            "9": 48,
+            "10": 49,
+            "11": 55,
+            "12": 58,
+            "13": 32,
+            "14": 69,
+            "15": 70,
+            "16": 82,
+            "17": 83,
+            "18": 90,
+            "19": 93,
+            "20": 66,
+            "21": 105,
+            "22": 106,
+            "23": 114,
+            "24": 115,
+            "25": 122,
+            "26": 125,
+            "27": 102,
+            "28": 135,
+            "29": 136,
+            "30": 146,
+            "31": 147,
+            "32": 154,
+            "33": 157,
+            "34": 132,
+            "35": 166,
+            "36": 167,
+            "37": 177,
+            "38": 178,
+            "39": 184,
+            "40": 187,
+            "41": 163,
+            "42": 196,
+            "43": 197,
+            "44": 206,
+            "45": 207,
+            "46": 213,
+            "47": 216,
+            "48": 193,
+            "49": 226,
+            "50": 227,
+            "51": 238,
+            "52": 239,
+            "53": 245,
+            "54": 248,
+            "55": 223,
+            "56": 258,
+            "57": 259,
+            "58": 270,
+            "59": 271,
+            "60": 278,
+            "61": 281,
+            "62": 255,
+            "63": 291,
+            "64": 292,
+            "65": 301,
+            "66": 302,
+            "67": 288,
+            "68": 315,
+            "69": 316,
+            "70": 326,
+            "71": 327,
+            "72": 334,
+            "73": 337,
+            "74": 312,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolStr~dyn,\n      true~bool\n    )~dyn^index_map|optional_map_index_value,\n    "string"~string\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolFloat32~dyn,\n      true~bool\n    )~dyn^index_map|optional_map_index_value,\n    1.5~double\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolFloat64~dyn,\n      false~bool\n    )~dyn^index_map|optional_map_index_value,\n    -2.1~double\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolInt~dyn,\n      false~bool\n    )~dyn^index_map|optional_map_index_value,\n    -3~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolInt32~dyn,\n      false~bool\n    )~dyn^index_map|optional_map_index_value,\n    0~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolInt64~dyn,\n      true~bool\n    )~dyn^index_map|optional_map_index_value,\n    4~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolUint~dyn,\n      true~bool\n    )~dyn^index_map|optional_map_index_value,\n    5u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolUint32~dyn,\n      true~bool\n    )~dyn^index_map|optional_map_index_value,\n    6u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolUint64~dyn,\n      false~bool\n    )~dyn^index_map|optional_map_index_value,\n    7u~uint\n  )~bool^equals,\n  _[_](\n    m~map(string, dyn)^m.boolBool~dyn,\n    true~bool\n  )~dyn^index_map|optional_map_index_value,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolIface~dyn,\n      false~bool\n    )~dyn^index_map|optional_map_index_value,\n    true~bool\n  )~bool^equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "m" },
+          "3": { overloadId: ["index_map", "optional_map_index_value"] },
+          "5": { overloadId: ["equals"] },
+          "7": { name: "m" },
+          "9": { overloadId: ["index_map", "optional_map_index_value"] },
+          "11": { overloadId: ["equals"] },
+          "13": { overloadId: ["logical_and"] },
+          "14": { name: "m" },
+          "16": { overloadId: ["index_map", "optional_map_index_value"] },
+          "18": { overloadId: ["equals"] },
+          "21": { name: "m" },
+          "23": { overloadId: ["index_map", "optional_map_index_value"] },
+          "25": { overloadId: ["equals"] },
+          "28": { name: "m" },
+          "30": { overloadId: ["index_map", "optional_map_index_value"] },
+          "32": { overloadId: ["equals"] },
+          "35": { name: "m" },
+          "37": { overloadId: ["index_map", "optional_map_index_value"] },
+          "39": { overloadId: ["equals"] },
+          "42": { name: "m" },
+          "44": { overloadId: ["index_map", "optional_map_index_value"] },
+          "46": { overloadId: ["equals"] },
+          "49": { name: "m" },
+          "51": { overloadId: ["index_map", "optional_map_index_value"] },
+          "53": { overloadId: ["equals"] },
+          "56": { name: "m" },
+          "58": { overloadId: ["index_map", "optional_map_index_value"] },
+          "60": { overloadId: ["equals"] },
+          "63": { name: "m" },
+          "65": { overloadId: ["index_map", "optional_map_index_value"] },
+          "68": { name: "m" },
+          "70": { overloadId: ["index_map", "optional_map_index_value"] },
+          "72": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "8": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "15": { dyn: {} },
+          "16": { dyn: {} },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "DOUBLE" },
+          "21": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "22": { dyn: {} },
+          "23": { dyn: {} },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "28": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "29": { dyn: {} },
+          "30": { dyn: {} },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "INT64" },
+          "35": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "36": { dyn: {} },
+          "37": { dyn: {} },
+          "38": { primitive: "BOOL" },
+          "39": { primitive: "BOOL" },
+          "40": { primitive: "INT64" },
+          "42": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "43": { dyn: {} },
+          "44": { dyn: {} },
+          "45": { primitive: "BOOL" },
+          "46": { primitive: "BOOL" },
+          "47": { primitive: "UINT64" },
+          "49": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "50": { dyn: {} },
+          "51": { dyn: {} },
+          "52": { primitive: "BOOL" },
+          "53": { primitive: "BOOL" },
+          "54": { primitive: "UINT64" },
+          "56": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "57": { dyn: {} },
+          "58": { dyn: {} },
+          "59": { primitive: "BOOL" },
+          "60": { primitive: "BOOL" },
+          "61": { primitive: "UINT64" },
+          "63": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "64": { dyn: {} },
+          "65": { dyn: {} },
+          "66": { primitive: "BOOL" },
+          "68": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "69": { dyn: {} },
+          "70": { dyn: {} },
+          "71": { primitive: "BOOL" },
+          "72": { primitive: "BOOL" },
+          "73": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "select_bool_key",
+          lineOffsets: [28, 62, 98, 128, 159, 189, 219, 251, 284, 308, 342],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 9,
+            "4": 10,
+            "5": 16,
+            "6": 19,
+            "7": 35,
+            "8": 36,
+            "9": 48,
+            "10": 49,
+            "11": 55,
+            "12": 58,
+            "13": 32,
+            "14": 69,
+            "15": 70,
+            "16": 82,
+            "17": 83,
+            "18": 90,
+            "19": 93,
+            "20": 66,
+            "21": 105,
+            "22": 106,
+            "23": 114,
+            "24": 115,
+            "25": 122,
+            "26": 125,
+            "27": 102,
+            "28": 135,
+            "29": 136,
+            "30": 146,
+            "31": 147,
+            "32": 154,
+            "33": 157,
+            "34": 132,
+            "35": 166,
+            "36": 167,
+            "37": 177,
+            "38": 178,
+            "39": 184,
+            "40": 187,
+            "41": 163,
+            "42": 196,
+            "43": 197,
+            "44": 206,
+            "45": 207,
+            "46": 213,
+            "47": 216,
+            "48": 193,
+            "49": 226,
+            "50": 227,
+            "51": 238,
+            "52": 239,
+            "53": 245,
+            "54": 248,
+            "55": 223,
+            "56": 258,
+            "57": 259,
+            "58": 270,
+            "59": 271,
+            "60": 278,
+            "61": 281,
+            "62": 255,
+            "63": 291,
+            "64": 292,
+            "65": 301,
+            "66": 302,
+            "67": 288,
+            "68": 315,
+            "69": 316,
+            "70": 326,
+            "71": 327,
+            "72": 334,
+            "73": 337,
+            "74": 312,
+          },
+        },
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "m" } },
+                              field: "boolStr",
+                            },
+                          },
+                          { id: "4", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "string" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: { id: "7", identExpr: { name: "m" } },
+                              field: "boolFloat32",
+                            },
+                          },
+                          { id: "10", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "15",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "m" } },
+                              field: "boolFloat64",
+                            },
+                          },
+                          { id: "17", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { doubleValue: -2.1 } },
+                  ],
+                },
+              },
+              {
+                id: "25",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "23",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "22",
+                            selectExpr: {
+                              operand: { id: "21", identExpr: { name: "m" } },
+                              field: "boolInt",
+                            },
+                          },
+                          { id: "24", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "26", constExpr: { int64Value: "-3" } },
+                  ],
+                },
+              },
+              {
+                id: "32",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "29",
+                            selectExpr: {
+                              operand: { id: "28", identExpr: { name: "m" } },
+                              field: "boolInt32",
+                            },
+                          },
+                          { id: "31", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "33", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "39",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "37",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "36",
+                            selectExpr: {
+                              operand: { id: "35", identExpr: { name: "m" } },
+                              field: "boolInt64",
+                            },
+                          },
+                          { id: "38", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "40", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "46",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "44",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "43",
+                            selectExpr: {
+                              operand: { id: "42", identExpr: { name: "m" } },
+                              field: "boolUint",
+                            },
+                          },
+                          { id: "45", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "47", constExpr: { uint64Value: "5" } },
+                  ],
+                },
+              },
+              {
+                id: "53",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "51",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "50",
+                            selectExpr: {
+                              operand: { id: "49", identExpr: { name: "m" } },
+                              field: "boolUint32",
+                            },
+                          },
+                          { id: "52", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    { id: "54", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+              {
+                id: "60",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "58",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "57",
+                            selectExpr: {
+                              operand: { id: "56", identExpr: { name: "m" } },
+                              field: "boolUint64",
+                            },
+                          },
+                          { id: "59", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "61", constExpr: { uint64Value: "7" } },
+                  ],
+                },
+              },
+              {
+                id: "65",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "64",
+                      selectExpr: {
+                        operand: { id: "63", identExpr: { name: "m" } },
+                        field: "boolBool",
+                      },
+                    },
+                    { id: "66", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "72",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "70",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "69",
+                            selectExpr: {
+                              operand: { id: "68", identExpr: { name: "m" } },
+                              field: "boolIface",
+                            },
+                          },
+                          { id: "71", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    { id: "73", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "8" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        name: "select_uint_key",
+        expr: "m.uintIface[1u] == 'string'\n\t\t\t\t\u0026\u0026 m.uint32Iface[2u] == 1.5\n\t\t\t\t\u0026\u0026 m.uint64Iface[3u] == -2.1\n\t\t\t\t\u0026\u0026 m.uint64String[4u] == 'three'",
+        typeEnv: [
+          {
+            name: "m",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { dyn: {} },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          m: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "uintIface" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { uint64Value: "1" },
+                            value: { stringValue: "string" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "uint32Iface" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { uint64Value: "2" },
+                            value: { doubleValue: 1.5 },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "uint64Iface" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { uint64Value: "3" },
+                            value: { doubleValue: -2.1 },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "uint64String" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { uint64Value: "4" },
+                            value: { stringValue: "three" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uintIface^#*expr.Expr_SelectExpr#,\n      1u^#*expr.Constant_Uint64Value#\n    )^#*expr.Expr_CallExpr#,\n    "string"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uint32Iface^#*expr.Expr_SelectExpr#,\n      2u^#*expr.Constant_Uint64Value#\n    )^#*expr.Expr_CallExpr#,\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uint64Iface^#*expr.Expr_SelectExpr#,\n      3u^#*expr.Constant_Uint64Value#\n    )^#*expr.Expr_CallExpr#,\n    -2.1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uint64String^#*expr.Expr_SelectExpr#,\n      4u^#*expr.Constant_Uint64Value#\n    )^#*expr.Expr_CallExpr#,\n    "three"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'm.uintIface[1u] == "string" \u0026\u0026 m.uint32Iface[2u] == 1.5',
+      locationAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m^#1[1,0]#.uintIface^#2[1,1]#,\n      1u^#4[1,12]#\n    )^#3[1,11]#,\n    "string"^#6[1,19]#\n  )^#5[1,16]#,\n  _==_(\n    _[_](\n      m^#7[2,7]#.uint32Iface^#8[2,8]#,\n      2u^#10[2,21]#\n    )^#9[2,20]#,\n    1.5^#12[2,28]#\n  )^#11[2,25]#,\n  _==_(\n    _[_](\n      m^#14[3,7]#.uint64Iface^#15[3,8]#,\n      3u^#17[3,21]#\n    )^#16[3,20]#,\n    -2.1^#19[3,28]#\n  )^#18[3,25]#,\n  _==_(\n    _[_](\n      m^#21[4,7]#.uint64String^#22[4,8]#,\n      4u^#24[4,22]#\n    )^#23[4,21]#,\n    "three"^#26[4,29]#\n  )^#25[4,26]#\n)^#13[2,4]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 16, 18, 1, 16, 1, 18],
+        [6, 19, 27, 1, 19, 1, 27],
+        [7, 35, 36, 2, 7, 2, 8],
+        [8, 36, 37, 2, 8, 2, 9],
+        [9, 48, 49, 2, 20, 2, 21],
+        [10, 49, 51, 2, 21, 2, 23],
+        [11, 53, 55, 2, 25, 2, 27],
+        [12, 56, 59, 2, 28, 2, 31],
+        [13, 32, 34, 2, 4, 2, 6],
+        [14, 67, 68, 3, 7, 3, 8],
+        [15, 68, 69, 3, 8, 3, 9],
+        [16, 80, 81, 3, 20, 3, 21],
+        [17, 81, 83, 3, 21, 3, 23],
+        [18, 85, 87, 3, 25, 3, 27],
+        [19, 88, 92, 3, 28, 3, 32],
+        [20, 64, 66, 3, 4, 3, 6],
+        [21, 100, 101, 4, 7, 4, 8],
+        [22, 101, 102, 4, 8, 4, 9],
+        [23, 114, 115, 4, 21, 4, 22],
+        [24, 115, 117, 4, 22, 4, 24],
+        [25, 119, 121, 4, 26, 4, 28],
+        [26, 122, 129, 4, 29, 4, 36],
+        [27, 97, 99, 4, 4, 4, 6],
+      ],
+      lineOffsets: [28, 60, 93, 130],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "m" } },
+                              field: "uintIface",
+                            },
+                          },
+                          { id: "4", constExpr: { uint64Value: "1" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "string" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: { id: "7", identExpr: { name: "m" } },
+                              field: "uint32Iface",
+                            },
+                          },
+                          { id: "10", constExpr: { uint64Value: "2" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "15",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "m" } },
+                              field: "uint64Iface",
+                            },
+                          },
+                          { id: "17", constExpr: { uint64Value: "3" } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { doubleValue: -2.1 } },
+                  ],
+                },
+              },
+              {
+                id: "25",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "23",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "22",
+                            selectExpr: {
+                              operand: { id: "21", identExpr: { name: "m" } },
+                              field: "uint64String",
+                            },
+                          },
+                          { id: "24", constExpr: { uint64Value: "4" } },
+                        ],
+                      },
+                    },
+                    { id: "26", constExpr: { stringValue: "three" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select_uint_key",
+          lineOffsets: [28, 60, 93, 130],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 11,
+            "4": 12,
+            "5": 16,
+            "6": 19,
+            "7": 35,
+            "8": 36,
+            "9": 48,
+            "10": 49,
+            "11": 53,
+            "12": 56,
+            "13": 32,
+            "14": 67,
+            "15": 68,
+            "16": 80,
+            "17": 81,
+            "18": 85,
+            "19": 88,
+            "20": 64,
+            "21": 100,
+            "22": 101,
+            "23": 114,
+            "24": 115,
+            "25": 119,
+            "26": 122,
+            "27": 97,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uintIface~dyn,\n      1u~uint\n    )~dyn^index_map|optional_map_index_value,\n    "string"~string\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uint32Iface~dyn,\n      2u~uint\n    )~dyn^index_map|optional_map_index_value,\n    1.5~double\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uint64Iface~dyn,\n      3u~uint\n    )~dyn^index_map|optional_map_index_value,\n    -2.1~double\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uint64String~dyn,\n      4u~uint\n    )~dyn^index_map|optional_map_index_value,\n    "three"~string\n  )~bool^equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "m" },
+          "3": { overloadId: ["index_map", "optional_map_index_value"] },
+          "5": { overloadId: ["equals"] },
+          "7": { name: "m" },
+          "9": { overloadId: ["index_map", "optional_map_index_value"] },
+          "11": { overloadId: ["equals"] },
+          "13": { overloadId: ["logical_and"] },
+          "14": { name: "m" },
+          "16": { overloadId: ["index_map", "optional_map_index_value"] },
+          "18": { overloadId: ["equals"] },
+          "21": { name: "m" },
+          "23": { overloadId: ["index_map", "optional_map_index_value"] },
+          "25": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "8": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "UINT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "15": { dyn: {} },
+          "16": { dyn: {} },
+          "17": { primitive: "UINT64" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "DOUBLE" },
+          "21": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "22": { dyn: {} },
+          "23": { dyn: {} },
+          "24": { primitive: "UINT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "select_uint_key",
+          lineOffsets: [28, 60, 93, 130],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 11,
+            "4": 12,
+            "5": 16,
+            "6": 19,
+            "7": 35,
+            "8": 36,
+            "9": 48,
+            "10": 49,
+            "11": 53,
+            "12": 56,
+            "13": 32,
+            "14": 67,
+            "15": 68,
+            "16": 80,
+            "17": 81,
+            "18": 85,
+            "19": 88,
+            "20": 64,
+            "21": 100,
+            "22": 101,
+            "23": 114,
+            "24": 115,
+            "25": 119,
+            "26": 122,
+            "27": 97,
+          },
+        },
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "m" } },
+                              field: "uintIface",
+                            },
+                          },
+                          { id: "4", constExpr: { uint64Value: "1" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "string" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: { id: "7", identExpr: { name: "m" } },
+                              field: "uint32Iface",
+                            },
+                          },
+                          { id: "10", constExpr: { uint64Value: "2" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "15",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "m" } },
+                              field: "uint64Iface",
+                            },
+                          },
+                          { id: "17", constExpr: { uint64Value: "3" } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { doubleValue: -2.1 } },
+                  ],
+                },
+              },
+              {
+                id: "25",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "23",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "22",
+                            selectExpr: {
+                              operand: { id: "21", identExpr: { name: "m" } },
+                              field: "uint64String",
+                            },
+                          },
+                          { id: "24", constExpr: { uint64Value: "4" } },
+                        ],
+                      },
+                    },
+                    { id: "26", constExpr: { stringValue: "three" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "8" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "16",
+    },
+    {
+      original: {
+        name: "select_index",
+        expr: "m.strList[0] == 'string'\n\t\t\t\t\u0026\u0026 m.floatList[0] == 1.5\n\t\t\t\t\u0026\u0026 m.doubleList[0] == -2.0\n\t\t\t\t\u0026\u0026 m.intList[0] == -3\n\t\t\t\t\u0026\u0026 m.int32List[0] == 4\n\t\t\t\t\u0026\u0026 m.int64List[0] == -5\n\t\t\t\t\u0026\u0026 m.uintList[0] == 6u\n\t\t\t\t\u0026\u0026 m.uint32List[0] == 7u\n\t\t\t\t\u0026\u0026 m.uint64List[0] == 8u\n\t\t\t\t\u0026\u0026 m.boolList[0] == true\n\t\t\t\t\u0026\u0026 m.boolList[1] != true\n\t\t\t\t\u0026\u0026 m.ifaceList[0] == {}",
+        typeEnv: [
+          {
+            name: "m",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { dyn: {} },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          m: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "strList" },
+                    value: {
+                      listValue: { values: [{ stringValue: "string" }] },
+                    },
+                  },
+                  {
+                    key: { stringValue: "floatList" },
+                    value: { listValue: { values: [{ doubleValue: 1.5 }] } },
+                  },
+                  {
+                    key: { stringValue: "doubleList" },
+                    value: { listValue: { values: [{ doubleValue: -2 }] } },
+                  },
+                  {
+                    key: { stringValue: "intList" },
+                    value: { listValue: { values: [{ int64Value: "-3" }] } },
+                  },
+                  {
+                    key: { stringValue: "int32List" },
+                    value: { listValue: { values: [{ int64Value: "4" }] } },
+                  },
+                  {
+                    key: { stringValue: "int64List" },
+                    value: { listValue: { values: [{ int64Value: "-5" }] } },
+                  },
+                  {
+                    key: { stringValue: "uintList" },
+                    value: { listValue: { values: [{ uint64Value: "6" }] } },
+                  },
+                  {
+                    key: { stringValue: "uint32List" },
+                    value: { listValue: { values: [{ uint64Value: "7" }] } },
+                  },
+                  {
+                    key: { stringValue: "uint64List" },
+                    value: { listValue: { values: [{ uint64Value: "8" }] } },
+                  },
+                  {
+                    key: { stringValue: "boolList" },
+                    value: {
+                      listValue: {
+                        values: [{ boolValue: true }, { boolValue: false }],
+                      },
+                    },
+                  },
+                  {
+                    key: { stringValue: "ifaceList" },
+                    value: { listValue: { values: [{ mapValue: {} }] } },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.strList^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    "string"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.floatList^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.doubleList^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    -2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.intList^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    -3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.int32List^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.int64List^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    -5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uintList^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    6u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uint32List^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    7u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.uint64List^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    8u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolList^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  _!=_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.boolList^#*expr.Expr_SelectExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    _[_](\n      m^#*expr.Expr_IdentExpr#.ifaceList^#*expr.Expr_SelectExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    {}^#*expr.Expr_StructExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'm.strList[0] == "string" \u0026\u0026 m.floatList[0] == 1.5',
+      locationAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m^#1[1,0]#.strList^#2[1,1]#,\n      0^#4[1,10]#\n    )^#3[1,9]#,\n    "string"^#6[1,16]#\n  )^#5[1,13]#,\n  _==_(\n    _[_](\n      m^#7[2,7]#.floatList^#8[2,8]#,\n      0^#10[2,19]#\n    )^#9[2,18]#,\n    1.5^#12[2,25]#\n  )^#11[2,22]#,\n  _==_(\n    _[_](\n      m^#14[3,7]#.doubleList^#15[3,8]#,\n      0^#17[3,20]#\n    )^#16[3,19]#,\n    -2^#19[3,26]#\n  )^#18[3,23]#,\n  _==_(\n    _[_](\n      m^#21[4,7]#.intList^#22[4,8]#,\n      0^#24[4,17]#\n    )^#23[4,16]#,\n    -3^#26[4,23]#\n  )^#25[4,20]#,\n  _==_(\n    _[_](\n      m^#28[5,7]#.int32List^#29[5,8]#,\n      0^#31[5,19]#\n    )^#30[5,18]#,\n    4^#33[5,25]#\n  )^#32[5,22]#,\n  _==_(\n    _[_](\n      m^#35[6,7]#.int64List^#36[6,8]#,\n      0^#38[6,19]#\n    )^#37[6,18]#,\n    -5^#40[6,25]#\n  )^#39[6,22]#,\n  _==_(\n    _[_](\n      m^#42[7,7]#.uintList^#43[7,8]#,\n      0^#45[7,18]#\n    )^#44[7,17]#,\n    6u^#47[7,24]#\n  )^#46[7,21]#,\n  _==_(\n    _[_](\n      m^#49[8,7]#.uint32List^#50[8,8]#,\n      0^#52[8,20]#\n    )^#51[8,19]#,\n    7u^#54[8,26]#\n  )^#53[8,23]#,\n  _==_(\n    _[_](\n      m^#56[9,7]#.uint64List^#57[9,8]#,\n      0^#59[9,20]#\n    )^#58[9,19]#,\n    8u^#61[9,26]#\n  )^#60[9,23]#,\n  _==_(\n    _[_](\n      m^#63[10,7]#.boolList^#64[10,8]#,\n      0^#66[10,18]#\n    )^#65[10,17]#,\n    true^#68[10,24]#\n  )^#67[10,21]#,\n  _!=_(\n    _[_](\n      m^#70[11,7]#.boolList^#71[11,8]#,\n      1^#73[11,18]#\n    )^#72[11,17]#,\n    true^#75[11,24]#\n  )^#74[11,21]#,\n  _==_(\n    _[_](\n      m^#77[12,7]#.ifaceList^#78[12,8]#,\n      0^#80[12,19]#\n    )^#79[12,18]#,\n    {}^#82[12,25]#\n  )^#81[12,22]#\n)^#13[2,4]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 13, 15, 1, 13, 1, 15],
+        [6, 16, 24, 1, 16, 1, 24],
+        [7, 32, 33, 2, 7, 2, 8],
+        [8, 33, 34, 2, 8, 2, 9],
+        [9, 43, 44, 2, 18, 2, 19],
+        [10, 44, 45, 2, 19, 2, 20],
+        [11, 47, 49, 2, 22, 2, 24],
+        [12, 50, 53, 2, 25, 2, 28],
+        [13, 29, 31, 2, 4, 2, 6],
+        [14, 61, 62, 3, 7, 3, 8],
+        [15, 62, 63, 3, 8, 3, 9],
+        [16, 73, 74, 3, 19, 3, 20],
+        [17, 74, 75, 3, 20, 3, 21],
+        [18, 77, 79, 3, 23, 3, 25],
+        [19, 80, 84, 3, 26, 3, 30],
+        [20, 58, 60, 3, 4, 3, 6],
+        [21, 92, 93, 4, 7, 4, 8],
+        [22, 93, 94, 4, 8, 4, 9],
+        [23, 101, 102, 4, 16, 4, 17],
+        [24, 102, 103, 4, 17, 4, 18],
+        [25, 105, 107, 4, 20, 4, 22],
+        [26, 108, 110, 4, 23, 4, 25],
+        [27, 89, 91, 4, 4, 4, 6],
+        [28, 118, 119, 5, 7, 5, 8],
+        [29, 119, 120, 5, 8, 5, 9],
+        [30, 129, 130, 5, 18, 5, 19],
+        [31, 130, 131, 5, 19, 5, 20],
+        [32, 133, 135, 5, 22, 5, 24],
+        [33, 136, 137, 5, 25, 5, 26],
+        [34, 115, 117, 5, 4, 5, 6],
+        [35, 145, 146, 6, 7, 6, 8],
+        [36, 146, 147, 6, 8, 6, 9],
+        [37, 156, 157, 6, 18, 6, 19],
+        [38, 157, 158, 6, 19, 6, 20],
+        [39, 160, 162, 6, 22, 6, 24],
+        [40, 163, 165, 6, 25, 6, 27],
+        [41, 142, 144, 6, 4, 6, 6],
+        [42, 173, 174, 7, 7, 7, 8],
+        [43, 174, 175, 7, 8, 7, 9],
+        [44, 183, 184, 7, 17, 7, 18],
+        [45, 184, 185, 7, 18, 7, 19],
+        [46, 187, 189, 7, 21, 7, 23],
+        [47, 190, 192, 7, 24, 7, 26],
+        [48, 170, 172, 7, 4, 7, 6],
+        [49, 200, 201, 8, 7, 8, 8],
+        [50, 201, 202, 8, 8, 8, 9],
+        [51, 212, 213, 8, 19, 8, 20],
+        [52, 213, 214, 8, 20, 8, 21],
+        [53, 216, 218, 8, 23, 8, 25],
+        [54, 219, 221, 8, 26, 8, 28],
+        [55, 197, 199, 8, 4, 8, 6],
+        [56, 229, 230, 9, 7, 9, 8],
+        [57, 230, 231, 9, 8, 9, 9],
+        [58, 241, 242, 9, 19, 9, 20],
+        [59, 242, 243, 9, 20, 9, 21],
+        [60, 245, 247, 9, 23, 9, 25],
+        [61, 248, 250, 9, 26, 9, 28],
+        [62, 226, 228, 9, 4, 9, 6],
+        [63, 258, 259, 10, 7, 10, 8],
+        [64, 259, 260, 10, 8, 10, 9],
+        [65, 268, 269, 10, 17, 10, 18],
+        [66, 269, 270, 10, 18, 10, 19],
+        [67, 272, 274, 10, 21, 10, 23],
+        [68, 275, 279, 10, 24, 10, 28],
+        [69, 255, 257, 10, 4, 10, 6],
+        [70, 287, 288, 11, 7, 11, 8],
+        [71, 288, 289, 11, 8, 11, 9],
+        [72, 297, 298, 11, 17, 11, 18],
+        [73, 298, 299, 11, 18, 11, 19],
+        [74, 301, 303, 11, 21, 11, 23],
+        [75, 304, 308, 11, 24, 11, 28],
+        [76, 284, 286, 11, 4, 11, 6],
+        [77, 316, 317, 12, 7, 12, 8],
+        [78, 317, 318, 12, 8, 12, 9],
+        [79, 327, 328, 12, 18, 12, 19],
+        [80, 328, 329, 12, 19, 12, 20],
+        [81, 331, 333, 12, 22, 12, 24],
+        [82, 334, 335, 12, 25, 12, 26],
+        [83, 313, 315, 12, 4, 12, 6],
+      ],
+      lineOffsets: [25, 54, 85, 111, 138, 166, 193, 222, 251, 280, 309, 337],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "m" } },
+                              field: "strList",
+                            },
+                          },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "string" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: { id: "7", identExpr: { name: "m" } },
+                              field: "floatList",
+                            },
+                          },
+                          { id: "10", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "15",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "m" } },
+                              field: "doubleList",
+                            },
+                          },
+                          { id: "17", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { doubleValue: -2 } },
+                  ],
+                },
+              },
+              {
+                id: "25",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "23",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "22",
+                            selectExpr: {
+                              operand: { id: "21", identExpr: { name: "m" } },
+                              field: "intList",
+                            },
+                          },
+                          { id: "24", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "26", constExpr: { int64Value: "-3" } },
+                  ],
+                },
+              },
+              {
+                id: "32",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "29",
+                            selectExpr: {
+                              operand: { id: "28", identExpr: { name: "m" } },
+                              field: "int32List",
+                            },
+                          },
+                          { id: "31", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "33", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "39",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "37",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "36",
+                            selectExpr: {
+                              operand: { id: "35", identExpr: { name: "m" } },
+                              field: "int64List",
+                            },
+                          },
+                          { id: "38", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "40", constExpr: { int64Value: "-5" } },
+                  ],
+                },
+              },
+              {
+                id: "46",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "44",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "43",
+                            selectExpr: {
+                              operand: { id: "42", identExpr: { name: "m" } },
+                              field: "uintList",
+                            },
+                          },
+                          { id: "45", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "47", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+              {
+                id: "53",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "51",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "50",
+                            selectExpr: {
+                              operand: { id: "49", identExpr: { name: "m" } },
+                              field: "uint32List",
+                            },
+                          },
+                          { id: "52", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "54", constExpr: { uint64Value: "7" } },
+                  ],
+                },
+              },
+              {
+                id: "60",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "58",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "57",
+                            selectExpr: {
+                              operand: { id: "56", identExpr: { name: "m" } },
+                              field: "uint64List",
+                            },
+                          },
+                          { id: "59", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "61", constExpr: { uint64Value: "8" } },
+                  ],
+                },
+              },
+              {
+                id: "67",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "65",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "64",
+                            selectExpr: {
+                              operand: { id: "63", identExpr: { name: "m" } },
+                              field: "boolList",
+                            },
+                          },
+                          { id: "66", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "68", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "74",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "72",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "71",
+                            selectExpr: {
+                              operand: { id: "70", identExpr: { name: "m" } },
+                              field: "boolList",
+                            },
+                          },
+                          { id: "73", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    { id: "75", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "81",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "79",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "78",
+                            selectExpr: {
+                              operand: { id: "77", identExpr: { name: "m" } },
+                              field: "ifaceList",
+                            },
+                          },
+                          { id: "80", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "82", structExpr: {} },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select_index",
+          lineOffsets: [
+            25, 54, 85, 111, 138, 166, 193, 222, 251, 280, 309, 337,
+          ],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 9,
+            "4": 10,
+            "5": 13,
+            "6": 16,
+            "7": 32,
+            "8": 33,
+            "9": 43,
+            "10": 44,
+            "11": 47,
+            "12": 50,
+            "13": 29,
+            "14": 61,
+            "15": 62,
+            "16": 73,
+            "17": 74,
+            "18": 77,
+            "19": 80,
+            "20": 58,
+            "21": 92,
+            "22": 93,
+            "23": 101,
+            "24": 102,
+            "25": 105,
+            "26": 108,
+            "27": 89,
+            "28": 118,
+            "29": 119,
+            "30": 129,
+            "31": 130,
+            "32": 133,
+            "33": 136,
+            "34": 115,
+            "35": 145,
+            "36": 146,
+            "37": 156,
+            "38": 157,
+            "39": 160,
+            "40": 163,
+            "41": 142,
+            "42": 173,
+            "43": 174,
+            "44": 183,
+            "45": 184,
+            "46": 187,
+            "47": 190,
+            "48": 170,
+            "49": 200,
+            "50": 201,
+            "51": 212,
+            "52": 213,
+            "53": 216,
+            "54": 219,
+            "55": 197,
+            "56": 229,
+            "57": 230,
+            "58": 241,
+            "59": 242,
+            "60": 245,
+            "61": 248,
+            "62": 226,
+            "63": 258,
+            "64": 259,
+            "65": 268,
+            "66": 269,
+            "67": 272,
+            "68": 275,
+            "69": 255,
+            "70": 287,
+            "71": 288,
+            "72": 297,
+            "73": 298,
+            "74": 301,
+            "75": 304,
+            "76": 284,
+            "77": 316,
+            "78": 317,
+            "79": 327,
+            "80": 328,
+            "81": 331,
+            "82": 334,
+            "83": 313,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.strList~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    "string"~string\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.floatList~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    1.5~double\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.doubleList~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    -2~double\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.intList~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    -3~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.int32List~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    4~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.int64List~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    -5~int\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uintList~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    6u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uint32List~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    7u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.uint64List~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    8u~uint\n  )~bool^equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.boolList~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    true~bool\n  )~bool^equals,\n  _!=_(\n    _[_](\n      m~map(string, dyn)^m.boolList~dyn,\n      1~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    true~bool\n  )~bool^not_equals,\n  _==_(\n    _[_](\n      m~map(string, dyn)^m.ifaceList~dyn,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    {}~map(dyn, dyn)\n  )~bool^equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "m" },
+          "3": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "5": { overloadId: ["equals"] },
+          "7": { name: "m" },
+          "9": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "11": { overloadId: ["equals"] },
+          "13": { overloadId: ["logical_and"] },
+          "14": { name: "m" },
+          "16": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "18": { overloadId: ["equals"] },
+          "21": { name: "m" },
+          "23": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "25": { overloadId: ["equals"] },
+          "28": { name: "m" },
+          "30": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "32": { overloadId: ["equals"] },
+          "35": { name: "m" },
+          "37": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "39": { overloadId: ["equals"] },
+          "42": { name: "m" },
+          "44": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "46": { overloadId: ["equals"] },
+          "49": { name: "m" },
+          "51": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "53": { overloadId: ["equals"] },
+          "56": { name: "m" },
+          "58": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "60": { overloadId: ["equals"] },
+          "63": { name: "m" },
+          "65": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "67": { overloadId: ["equals"] },
+          "70": { name: "m" },
+          "72": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "74": { overloadId: ["not_equals"] },
+          "77": { name: "m" },
+          "79": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "81": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+          "7": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "8": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "BOOL" },
+          "14": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "15": { dyn: {} },
+          "16": { dyn: {} },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "DOUBLE" },
+          "21": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "22": { dyn: {} },
+          "23": { dyn: {} },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "28": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "29": { dyn: {} },
+          "30": { dyn: {} },
+          "31": { primitive: "INT64" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "INT64" },
+          "35": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "36": { dyn: {} },
+          "37": { dyn: {} },
+          "38": { primitive: "INT64" },
+          "39": { primitive: "BOOL" },
+          "40": { primitive: "INT64" },
+          "42": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "43": { dyn: {} },
+          "44": { dyn: {} },
+          "45": { primitive: "INT64" },
+          "46": { primitive: "BOOL" },
+          "47": { primitive: "UINT64" },
+          "49": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "50": { dyn: {} },
+          "51": { dyn: {} },
+          "52": { primitive: "INT64" },
+          "53": { primitive: "BOOL" },
+          "54": { primitive: "UINT64" },
+          "56": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "57": { dyn: {} },
+          "58": { dyn: {} },
+          "59": { primitive: "INT64" },
+          "60": { primitive: "BOOL" },
+          "61": { primitive: "UINT64" },
+          "63": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "64": { dyn: {} },
+          "65": { dyn: {} },
+          "66": { primitive: "INT64" },
+          "67": { primitive: "BOOL" },
+          "68": { primitive: "BOOL" },
+          "70": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "71": { dyn: {} },
+          "72": { dyn: {} },
+          "73": { primitive: "INT64" },
+          "74": { primitive: "BOOL" },
+          "75": { primitive: "BOOL" },
+          "77": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { dyn: {} },
+            },
+          },
+          "78": { dyn: {} },
+          "79": { dyn: {} },
+          "80": { primitive: "INT64" },
+          "81": { primitive: "BOOL" },
+          "82": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "select_index",
+          lineOffsets: [
+            25, 54, 85, 111, 138, 166, 193, 222, 251, 280, 309, 337,
+          ],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 9,
+            "4": 10,
+            "5": 13,
+            "6": 16,
+            "7": 32,
+            "8": 33,
+            "9": 43,
+            "10": 44,
+            "11": 47,
+            "12": 50,
+            "13": 29,
+            "14": 61,
+            "15": 62,
+            "16": 73,
+            "17": 74,
+            "18": 77,
+            "19": 80,
+            "20": 58,
+            "21": 92,
+            "22": 93,
+            "23": 101,
+            "24": 102,
+            "25": 105,
+            "26": 108,
+            "27": 89,
+            "28": 118,
+            "29": 119,
+            "30": 129,
+            "31": 130,
+            "32": 133,
+            "33": 136,
+            "34": 115,
+            "35": 145,
+            "36": 146,
+            "37": 156,
+            "38": 157,
+            "39": 160,
+            "40": 163,
+            "41": 142,
+            "42": 173,
+            "43": 174,
+            "44": 183,
+            "45": 184,
+            "46": 187,
+            "47": 190,
+            "48": 170,
+            "49": 200,
+            "50": 201,
+            "51": 212,
+            "52": 213,
+            "53": 216,
+            "54": 219,
+            "55": 197,
+            "56": 229,
+            "57": 230,
+            "58": 241,
+            "59": 242,
+            "60": 245,
+            "61": 248,
+            "62": 226,
+            "63": 258,
+            "64": 259,
+            "65": 268,
+            "66": 269,
+            "67": 272,
+            "68": 275,
+            "69": 255,
+            "70": 287,
+            "71": 288,
+            "72": 297,
+            "73": 298,
+            "74": 301,
+            "75": 304,
+            "76": 284,
+            "77": 316,
+            "78": 317,
+            "79": 327,
+            "80": 328,
+            "81": 331,
+            "82": 334,
+            "83": 313,
+          },
+        },
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "m" } },
+                              field: "strList",
+                            },
+                          },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "6", constExpr: { stringValue: "string" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "8",
+                            selectExpr: {
+                              operand: { id: "7", identExpr: { name: "m" } },
+                              field: "floatList",
+                            },
+                          },
+                          { id: "10", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "16",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "15",
+                            selectExpr: {
+                              operand: { id: "14", identExpr: { name: "m" } },
+                              field: "doubleList",
+                            },
+                          },
+                          { id: "17", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "19", constExpr: { doubleValue: -2 } },
+                  ],
+                },
+              },
+              {
+                id: "25",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "23",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "22",
+                            selectExpr: {
+                              operand: { id: "21", identExpr: { name: "m" } },
+                              field: "intList",
+                            },
+                          },
+                          { id: "24", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "26", constExpr: { int64Value: "-3" } },
+                  ],
+                },
+              },
+              {
+                id: "32",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "30",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "29",
+                            selectExpr: {
+                              operand: { id: "28", identExpr: { name: "m" } },
+                              field: "int32List",
+                            },
+                          },
+                          { id: "31", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "33", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "39",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "37",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "36",
+                            selectExpr: {
+                              operand: { id: "35", identExpr: { name: "m" } },
+                              field: "int64List",
+                            },
+                          },
+                          { id: "38", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "40", constExpr: { int64Value: "-5" } },
+                  ],
+                },
+              },
+              {
+                id: "46",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "44",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "43",
+                            selectExpr: {
+                              operand: { id: "42", identExpr: { name: "m" } },
+                              field: "uintList",
+                            },
+                          },
+                          { id: "45", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "47", constExpr: { uint64Value: "6" } },
+                  ],
+                },
+              },
+              {
+                id: "53",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "51",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "50",
+                            selectExpr: {
+                              operand: { id: "49", identExpr: { name: "m" } },
+                              field: "uint32List",
+                            },
+                          },
+                          { id: "52", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "54", constExpr: { uint64Value: "7" } },
+                  ],
+                },
+              },
+              {
+                id: "60",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "58",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "57",
+                            selectExpr: {
+                              operand: { id: "56", identExpr: { name: "m" } },
+                              field: "uint64List",
+                            },
+                          },
+                          { id: "59", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "61", constExpr: { uint64Value: "8" } },
+                  ],
+                },
+              },
+              {
+                id: "67",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "65",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "64",
+                            selectExpr: {
+                              operand: { id: "63", identExpr: { name: "m" } },
+                              field: "boolList",
+                            },
+                          },
+                          { id: "66", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "68", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "74",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "72",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "71",
+                            selectExpr: {
+                              operand: { id: "70", identExpr: { name: "m" } },
+                              field: "boolList",
+                            },
+                          },
+                          { id: "73", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    { id: "75", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              {
+                id: "81",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "79",
+                      callExpr: {
+                        function: "_[_]",
+                        args: [
+                          {
+                            id: "78",
+                            selectExpr: {
+                              operand: { id: "77", identExpr: { name: "m" } },
+                              field: "ifaceList",
+                            },
+                          },
+                          { id: "80", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    { id: "82", structExpr: {} },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "8" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "77",
+    },
+    {
+      original: {
+        name: "select_pb2_primitive_fields",
+        expr: '!has(a.single_int32)\n\t\t\t\u0026\u0026 a.single_int32 == -32\n\t\t\t\u0026\u0026 a.single_int64 == -64\n\t\t\t\u0026\u0026 a.single_uint32 == 32u\n\t\t\t\u0026\u0026 a.single_uint64 == 64u\n\t\t\t\u0026\u0026 a.single_float == 3.0\n\t\t\t\u0026\u0026 a.single_double == 6.4\n\t\t\t\u0026\u0026 a.single_bool\n\t\t\t\u0026\u0026 "empty" == a.single_string',
+        typeEnv: [
+          {
+            name: "a",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.TestAllTypes" },
+            },
+          },
+        ],
+        bindings: {
+          a: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.TestAllTypes",
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '_\u0026\u0026_(\n  !_(\n    a^#*expr.Expr_IdentExpr#.single_int32~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    a^#*expr.Expr_IdentExpr#.single_int32^#*expr.Expr_SelectExpr#,\n    -32^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    a^#*expr.Expr_IdentExpr#.single_int64^#*expr.Expr_SelectExpr#,\n    -64^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    a^#*expr.Expr_IdentExpr#.single_uint32^#*expr.Expr_SelectExpr#,\n    32u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    a^#*expr.Expr_IdentExpr#.single_uint64^#*expr.Expr_SelectExpr#,\n    64u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    a^#*expr.Expr_IdentExpr#.single_float^#*expr.Expr_SelectExpr#,\n    3^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    a^#*expr.Expr_IdentExpr#.single_double^#*expr.Expr_SelectExpr#,\n    6.4^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  a^#*expr.Expr_IdentExpr#.single_bool^#*expr.Expr_SelectExpr#,\n  _==_(\n    "empty"^#*expr.Constant_StringValue#,\n    a^#*expr.Expr_IdentExpr#.single_string^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "!has(a.single_int32) \u0026\u0026 a.single_int32 == -32",
+      locationAst:
+        '_\u0026\u0026_(\n  !_(\n    a^#3[1,5]#.single_int32~test-only~^#5[1,4]#\n  )^#1[1,0]#,\n  _==_(\n    a^#6[2,6]#.single_int32^#7[2,7]#,\n    -32^#9[2,24]#\n  )^#8[2,21]#,\n  _==_(\n    a^#11[3,6]#.single_int64^#12[3,7]#,\n    -64^#14[3,24]#\n  )^#13[3,21]#,\n  _==_(\n    a^#16[4,6]#.single_uint32^#17[4,7]#,\n    32u^#19[4,25]#\n  )^#18[4,22]#,\n  _==_(\n    a^#21[5,6]#.single_uint64^#22[5,7]#,\n    64u^#24[5,25]#\n  )^#23[5,22]#,\n  _==_(\n    a^#26[6,6]#.single_float^#27[6,7]#,\n    3^#29[6,24]#\n  )^#28[6,21]#,\n  _==_(\n    a^#31[7,6]#.single_double^#32[7,7]#,\n    6.4^#34[7,25]#\n  )^#33[7,22]#,\n  a^#36[8,6]#.single_bool^#37[8,7]#,\n  _==_(\n    "empty"^#39[9,6]#,\n    a^#41[9,17]#.single_string^#42[9,18]#\n  )^#40[9,14]#\n)^#10[2,3]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 4, 4, 1, 4, 1, 4],
+        [6, 27, 28, 2, 6, 2, 7],
+        [7, 28, 29, 2, 7, 2, 8],
+        [8, 42, 44, 2, 21, 2, 23],
+        [9, 45, 48, 2, 24, 2, 27],
+        [10, 24, 26, 2, 3, 2, 5],
+        [11, 55, 56, 3, 6, 3, 7],
+        [12, 56, 57, 3, 7, 3, 8],
+        [13, 70, 72, 3, 21, 3, 23],
+        [14, 73, 76, 3, 24, 3, 27],
+        [15, 52, 54, 3, 3, 3, 5],
+        [16, 83, 84, 4, 6, 4, 7],
+        [17, 84, 85, 4, 7, 4, 8],
+        [18, 99, 101, 4, 22, 4, 24],
+        [19, 102, 105, 4, 25, 4, 28],
+        [20, 80, 82, 4, 3, 4, 5],
+        [21, 112, 113, 5, 6, 5, 7],
+        [22, 113, 114, 5, 7, 5, 8],
+        [23, 128, 130, 5, 22, 5, 24],
+        [24, 131, 134, 5, 25, 5, 28],
+        [25, 109, 111, 5, 3, 5, 5],
+        [26, 141, 142, 6, 6, 6, 7],
+        [27, 142, 143, 6, 7, 6, 8],
+        [28, 156, 158, 6, 21, 6, 23],
+        [29, 159, 162, 6, 24, 6, 27],
+        [30, 138, 140, 6, 3, 6, 5],
+        [31, 169, 170, 7, 6, 7, 7],
+        [32, 170, 171, 7, 7, 7, 8],
+        [33, 185, 187, 7, 22, 7, 24],
+        [34, 188, 191, 7, 25, 7, 28],
+        [35, 166, 168, 7, 3, 7, 5],
+        [36, 198, 199, 8, 6, 8, 7],
+        [37, 199, 200, 8, 7, 8, 8],
+        [38, 195, 197, 8, 3, 8, 5],
+        [39, 218, 225, 9, 6, 9, 13],
+        [40, 226, 228, 9, 14, 9, 16],
+        [41, 229, 230, 9, 17, 9, 18],
+        [42, 230, 231, 9, 18, 9, 19],
+        [43, 215, 217, 9, 3, 9, 5],
+      ],
+      lineOffsets: [21, 49, 77, 106, 135, 163, 192, 212, 245],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: { id: "3", identExpr: { name: "a" } },
+                        field: "single_int32",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "7",
+                      selectExpr: {
+                        operand: { id: "6", identExpr: { name: "a" } },
+                        field: "single_int32",
+                      },
+                    },
+                    { id: "9", constExpr: { int64Value: "-32" } },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "12",
+                      selectExpr: {
+                        operand: { id: "11", identExpr: { name: "a" } },
+                        field: "single_int64",
+                      },
+                    },
+                    { id: "14", constExpr: { int64Value: "-64" } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "17",
+                      selectExpr: {
+                        operand: { id: "16", identExpr: { name: "a" } },
+                        field: "single_uint32",
+                      },
+                    },
+                    { id: "19", constExpr: { uint64Value: "32" } },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "22",
+                      selectExpr: {
+                        operand: { id: "21", identExpr: { name: "a" } },
+                        field: "single_uint64",
+                      },
+                    },
+                    { id: "24", constExpr: { uint64Value: "64" } },
+                  ],
+                },
+              },
+              {
+                id: "28",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "27",
+                      selectExpr: {
+                        operand: { id: "26", identExpr: { name: "a" } },
+                        field: "single_float",
+                      },
+                    },
+                    { id: "29", constExpr: { doubleValue: 3 } },
+                  ],
+                },
+              },
+              {
+                id: "33",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "32",
+                      selectExpr: {
+                        operand: { id: "31", identExpr: { name: "a" } },
+                        field: "single_double",
+                      },
+                    },
+                    { id: "34", constExpr: { doubleValue: 6.4 } },
+                  ],
+                },
+              },
+              {
+                id: "37",
+                selectExpr: {
+                  operand: { id: "36", identExpr: { name: "a" } },
+                  field: "single_bool",
+                },
+              },
+              {
+                id: "40",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "39", constExpr: { stringValue: "empty" } },
+                    {
+                      id: "42",
+                      selectExpr: {
+                        operand: { id: "41", identExpr: { name: "a" } },
+                        field: "single_string",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select_pb2_primitive_fields",
+          lineOffsets: [21, 49, 77, 106, 135, 163, 192, 212, 245],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "6": 27,
+            "7": 28,
+            "8": 42,
+            "9": 45,
+            "10": 24,
+            "11": 55,
+            "12": 56,
+            "13": 70,
+            "14": 73,
+            "15": 52,
+            "16": 83,
+            "17": 84,
+            "18": 99,
+            "19": 102,
+            "20": 80,
+            "21": 112,
+            "22": 113,
+            "23": 128,
+            "24": 131,
+            "25": 109,
+            "26": 141,
+            "27": 142,
+            "28": 156,
+            "29": 159,
+            "30": 138,
+            "31": 169,
+            "32": 170,
+            "33": 185,
+            "34": 188,
+            "35": 166,
+            "36": 198,
+            "37": 199,
+            "38": 195,
+            "39": 218,
+            "40": 226,
+            "41": 229,
+            "42": 230,
+            "43": 215,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: { id: "3", identExpr: { name: "a" } },
+                field: "single_int32",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_\u0026\u0026_(\n  !_(\n    a~google.expr.proto2.test.TestAllTypes^a.single_int32~test-only~~bool\n  )~bool^logical_not,\n  _==_(\n    a~google.expr.proto2.test.TestAllTypes^a.single_int32~int,\n    -32~int\n  )~bool^equals,\n  _==_(\n    a~google.expr.proto2.test.TestAllTypes^a.single_int64~int,\n    -64~int\n  )~bool^equals,\n  _==_(\n    a~google.expr.proto2.test.TestAllTypes^a.single_uint32~uint,\n    32u~uint\n  )~bool^equals,\n  _==_(\n    a~google.expr.proto2.test.TestAllTypes^a.single_uint64~uint,\n    64u~uint\n  )~bool^equals,\n  _==_(\n    a~google.expr.proto2.test.TestAllTypes^a.single_float~double,\n    3~double\n  )~bool^equals,\n  _==_(\n    a~google.expr.proto2.test.TestAllTypes^a.single_double~double,\n    6.4~double\n  )~bool^equals,\n  a~google.expr.proto2.test.TestAllTypes^a.single_bool~bool,\n  _==_(\n    "empty"~string,\n    a~google.expr.proto2.test.TestAllTypes^a.single_string~string\n  )~bool^equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { name: "a" },
+          "6": { name: "a" },
+          "8": { overloadId: ["equals"] },
+          "10": { overloadId: ["logical_and"] },
+          "11": { name: "a" },
+          "13": { overloadId: ["equals"] },
+          "16": { name: "a" },
+          "18": { overloadId: ["equals"] },
+          "21": { name: "a" },
+          "23": { overloadId: ["equals"] },
+          "26": { name: "a" },
+          "28": { overloadId: ["equals"] },
+          "31": { name: "a" },
+          "33": { overloadId: ["equals"] },
+          "36": { name: "a" },
+          "40": { overloadId: ["equals"] },
+          "41": { name: "a" },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "5": { primitive: "BOOL" },
+          "6": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "INT64" },
+          "16": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "17": { primitive: "UINT64" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "UINT64" },
+          "21": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "22": { primitive: "UINT64" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "UINT64" },
+          "26": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "27": { primitive: "DOUBLE" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "DOUBLE" },
+          "31": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "32": { primitive: "DOUBLE" },
+          "33": { primitive: "BOOL" },
+          "34": { primitive: "DOUBLE" },
+          "36": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "37": { primitive: "BOOL" },
+          "39": { primitive: "STRING" },
+          "40": { primitive: "BOOL" },
+          "41": { messageType: "google.expr.proto2.test.TestAllTypes" },
+          "42": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "select_pb2_primitive_fields",
+          lineOffsets: [21, 49, 77, 106, 135, 163, 192, 212, 245],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "6": 27,
+            "7": 28,
+            "8": 42,
+            "9": 45,
+            "10": 24,
+            "11": 55,
+            "12": 56,
+            "13": 70,
+            "14": 73,
+            "15": 52,
+            "16": 83,
+            "17": 84,
+            "18": 99,
+            "19": 102,
+            "20": 80,
+            "21": 112,
+            "22": 113,
+            "23": 128,
+            "24": 131,
+            "25": 109,
+            "26": 141,
+            "27": 142,
+            "28": 156,
+            "29": 159,
+            "30": 138,
+            "31": 169,
+            "32": 170,
+            "33": 185,
+            "34": 188,
+            "35": 166,
+            "36": 198,
+            "37": 199,
+            "38": 195,
+            "39": 218,
+            "40": 226,
+            "41": 229,
+            "42": 230,
+            "43": 215,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: { id: "3", identExpr: { name: "a" } },
+                        field: "single_int32",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "7",
+                      selectExpr: {
+                        operand: { id: "6", identExpr: { name: "a" } },
+                        field: "single_int32",
+                      },
+                    },
+                    { id: "9", constExpr: { int64Value: "-32" } },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "12",
+                      selectExpr: {
+                        operand: { id: "11", identExpr: { name: "a" } },
+                        field: "single_int64",
+                      },
+                    },
+                    { id: "14", constExpr: { int64Value: "-64" } },
+                  ],
+                },
+              },
+              {
+                id: "18",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "17",
+                      selectExpr: {
+                        operand: { id: "16", identExpr: { name: "a" } },
+                        field: "single_uint32",
+                      },
+                    },
+                    { id: "19", constExpr: { uint64Value: "32" } },
+                  ],
+                },
+              },
+              {
+                id: "23",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "22",
+                      selectExpr: {
+                        operand: { id: "21", identExpr: { name: "a" } },
+                        field: "single_uint64",
+                      },
+                    },
+                    { id: "24", constExpr: { uint64Value: "64" } },
+                  ],
+                },
+              },
+              {
+                id: "28",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "27",
+                      selectExpr: {
+                        operand: { id: "26", identExpr: { name: "a" } },
+                        field: "single_float",
+                      },
+                    },
+                    { id: "29", constExpr: { doubleValue: 3 } },
+                  ],
+                },
+              },
+              {
+                id: "33",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "32",
+                      selectExpr: {
+                        operand: { id: "31", identExpr: { name: "a" } },
+                        field: "single_double",
+                      },
+                    },
+                    { id: "34", constExpr: { doubleValue: 6.4 } },
+                  ],
+                },
+              },
+              {
+                id: "37",
+                selectExpr: {
+                  operand: { id: "36", identExpr: { name: "a" } },
+                  field: "single_bool",
+                },
+              },
+              {
+                id: "40",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "39", constExpr: { stringValue: "empty" } },
+                    {
+                      id: "42",
+                      selectExpr: {
+                        operand: { id: "41", identExpr: { name: "a" } },
+                        field: "single_string",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "6" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "26",
+    },
+    {
+      original: {
+        name: "select_pb3_compare",
+        expr: "a.single_uint64 \u003e 3u",
+        typeEnv: [
+          {
+            name: "a",
+            ident: {
+              type: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+        ],
+        container: "google.expr.proto3.test",
+        bindings: {
+          a: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                singleUint64: "10",
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_\u003e_(\n  a^#*expr.Expr_IdentExpr#.single_uint64^#*expr.Expr_SelectExpr#,\n  3u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a.single_uint64 \u003e 3u",
+      locationAst:
+        "_\u003e_(\n  a^#1[1,0]#.single_uint64^#2[1,1]#,\n  3u^#4[1,18]#\n)^#3[1,16]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "a" } },
+                  field: "single_uint64",
+                },
+              },
+              { id: "4", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select_pb3_compare",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 1, "3": 16, "4": 18 },
+        },
+      },
+      checkedAst:
+        "_\u003e_(\n  a~google.expr.proto3.test.TestAllTypes^a.single_uint64~uint,\n  3u~uint\n)~bool^greater_uint64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "3": { overloadId: ["greater_uint64"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "select_pb3_compare",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 1, "3": 16, "4": 18 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "a" } },
+                  field: "single_uint64",
+                },
+              },
+              { id: "4", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "select_subsumed_field",
+        expr: "a.b.c",
+        typeEnv: [
+          { name: "a.b.c", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "a.b",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          "a.b": {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "c" }, value: { stringValue: "ten" } },
+                ],
+              },
+            },
+          },
+          "a.b.c": { value: { int64Value: "10" } },
+        },
+        value: { int64Value: "10" },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#.c^#*expr.Expr_SelectExpr#",
+      unparsed: "a.b.c",
+      locationAst: "a^#1[1,0]#.b^#2[1,1]#.c^#3[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          selectExpr: {
+            operand: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+            field: "c",
+          },
+        },
+        sourceInfo: {
+          location: "select_subsumed_field",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+      },
+      checkedAst: "a.b.c~int^a.b.c",
+      checkedExpr: {
+        referenceMap: { "3": { name: "a.b.c" } },
+        typeMap: { "3": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "select_subsumed_field",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+        expr: { id: "3", identExpr: { name: "a.b.c" } },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "10" } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "select_empty_repeated_nested",
+        expr: "TestAllTypes{}.repeated_nested_message.size() == 0",
+        container: "google.expr.proto3.test",
+        value: { boolValue: true },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_==_(\n  TestAllTypes{}^#*expr.Expr_StructExpr#.repeated_nested_message^#*expr.Expr_SelectExpr#.size()^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "TestAllTypes{}.repeated_nested_message.size() == 0",
+      locationAst:
+        "_==_(\n  TestAllTypes{}^#1[1,12]#.repeated_nested_message^#2[1,14]#.size()^#3[1,43]#,\n  0^#5[1,49]#\n)^#4[1,46]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 43, 44, 1, 43, 1, 44],
+        [4, 46, 48, 1, 46, 1, 48],
+        [5, 49, 50, 1, 49, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    selectExpr: {
+                      operand: {
+                        id: "1",
+                        structExpr: { messageName: "TestAllTypes" },
+                      },
+                      field: "repeated_nested_message",
+                    },
+                  },
+                  function: "size",
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select_empty_repeated_nested",
+          lineOffsets: [51],
+          positions: { "1": 12, "2": 14, "3": 43, "4": 46, "5": 49 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  google.expr.proto3.test.TestAllTypes{}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes.repeated_nested_message~list(google.expr.proto3.test.TestAllTypes.NestedMessage).size()~int^list_size,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto3.test.TestAllTypes" },
+          "3": { overloadId: ["list_size"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "2": {
+            listType: {
+              elemType: {
+                messageType:
+                  "google.expr.proto3.test.TestAllTypes.NestedMessage",
+              },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "select_empty_repeated_nested",
+          lineOffsets: [51],
+          positions: { "1": 12, "2": 14, "3": 43, "4": 46, "5": 49 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    selectExpr: {
+                      operand: {
+                        id: "1",
+                        structExpr: {
+                          messageName: "google.expr.proto3.test.TestAllTypes",
+                        },
+                      },
+                      field: "repeated_nested_message",
+                    },
+                  },
+                  function: "size",
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "43", max: "43" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "44",
+    },
+    {
+      original: {
+        name: "literal_map_optional_field",
+        expr: "{?'hi': {}.?missing,\n\t\t\t        ?'world': {'present': 42u}.?present}",
+        value: {
+          mapValue: {
+            entries: [
+              { key: { stringValue: "world" }, value: { uint64Value: "42" } },
+            ],
+          },
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '{\n  ?"hi"^#*expr.Constant_StringValue#:_?._(\n    {}^#*expr.Expr_StructExpr#,\n    "missing"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#,\n  ?"world"^#*expr.Constant_StringValue#:_?._(\n    {\n      "present"^#*expr.Constant_StringValue#:42u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "present"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{?"hi": {}.?missing, ?"world": {"present": 42u}.?present}',
+      locationAst:
+        '{\n  ?"hi"^#3[1,2]#:_?._(\n    {}^#4[1,8]#,\n    "missing"^#5[1,12]#\n  )^#6[1,10]#^#2[1,6]#,\n  ?"world"^#8[2,12]#:_?._(\n    {\n      "present"^#11[2,22]#:42u^#12[2,33]#^#10[2,31]#\n    }^#9[2,21]#,\n    "present"^#13[2,39]#\n  )^#14[2,37]#^#7[2,19]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 2, 6, 1, 2, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 12, 19, 1, 12, 1, 19],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 40, 41, 2, 19, 2, 20],
+        [8, 33, 40, 2, 12, 2, 19],
+        [9, 42, 43, 2, 21, 2, 22],
+        [10, 52, 53, 2, 31, 2, 32],
+        [11, 43, 52, 2, 22, 2, 31],
+        [12, 54, 57, 2, 33, 2, 36],
+        [13, 60, 67, 2, 39, 2, 46],
+        [14, 58, 59, 2, 37, 2, 38],
+      ],
+      lineOffsets: [21, 69],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "hi" } },
+                value: {
+                  id: "6",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "4", structExpr: {} },
+                      { id: "5", constExpr: { stringValue: "missing" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+              {
+                id: "7",
+                mapKey: { id: "8", constExpr: { stringValue: "world" } },
+                value: {
+                  id: "14",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      {
+                        id: "9",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "10",
+                              mapKey: {
+                                id: "11",
+                                constExpr: { stringValue: "present" },
+                              },
+                              value: {
+                                id: "12",
+                                constExpr: { uint64Value: "42" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "13", constExpr: { stringValue: "present" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_map_optional_field",
+          lineOffsets: [21, 69],
+          positions: {
+            "1": 0,
+            "2": 6,
+            "3": 2,
+            "4": 8,
+            "5": 12,
+            "6": 10,
+            "7": 40,
+            "8": 33,
+            "9": 42,
+            "10": 52,
+            "11": 43,
+            "12": 54,
+            "13": 60,
+            "14": 58,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  ?"hi"~string:_?._(\n    {}~map(dyn, uint),\n    "missing"\n  )~optional_type(uint)^select_optional_field,\n  ?"world"~string:_?._(\n    {\n      "present"~string:42u~uint\n    }~map(string, uint),\n    "present"\n  )~optional_type(uint)^select_optional_field\n}~map(string, uint)',
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["select_optional_field"] },
+          "14": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "UINT64" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": {
+            mapType: {
+              keyType: { dyn: {} },
+              valueType: { primitive: "UINT64" },
+            },
+          },
+          "6": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "UINT64" }],
+            },
+          },
+          "8": { primitive: "STRING" },
+          "9": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "UINT64" },
+            },
+          },
+          "11": { primitive: "STRING" },
+          "12": { primitive: "UINT64" },
+          "14": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "UINT64" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "literal_map_optional_field",
+          lineOffsets: [21, 69],
+          positions: {
+            "1": 0,
+            "2": 6,
+            "3": 2,
+            "4": 8,
+            "5": 12,
+            "6": 10,
+            "7": 40,
+            "8": 33,
+            "9": 42,
+            "10": 52,
+            "11": 43,
+            "12": 54,
+            "13": 60,
+            "14": 58,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "hi" } },
+                value: {
+                  id: "6",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "4", structExpr: {} },
+                      { id: "5", constExpr: { stringValue: "missing" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+              {
+                id: "7",
+                mapKey: { id: "8", constExpr: { stringValue: "world" } },
+                value: {
+                  id: "14",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      {
+                        id: "9",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "10",
+                              mapKey: {
+                                id: "11",
+                                constExpr: { stringValue: "present" },
+                              },
+                              value: {
+                                id: "12",
+                                constExpr: { uint64Value: "42" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "13", constExpr: { stringValue: "present" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, uint)",
+      cost: { min: "92", max: "92" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              { key: { stringValue: "world" }, value: { uint64Value: "42" } },
+            ],
+          },
+        },
+      },
+      runtimeCost: "93",
+    },
+    {
+      original: {
+        name: "literal_map_optional_field_bad_init",
+        expr: "{?'hi': 'world'}",
+        disableCheck: true,
+        evalError: {
+          errors: [
+            {
+              message:
+                "cannot initialize optional entry 'hi' from non-optional",
+            },
+          ],
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '{\n  ?"hi"^#*expr.Constant_StringValue#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{?"hi": "world"}',
+      locationAst: '{\n  ?"hi"^#3[1,2]#:"world"^#4[1,8]#^#2[1,6]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 2, 6, 1, 2, 1, 6],
+        [4, 8, 15, 1, 8, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "hi" } },
+                value: { id: "4", constExpr: { stringValue: "world" } },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_map_optional_field_bad_init",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 6, "3": 2, "4": 8 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:9: expected type 'optional_type(string)' but found 'string'\n | {?'hi': 'world'}\n | ........^",
+      errors: [
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(string)' but found 'string'",
+          id: 4,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "cannot initialize optional entry 'hi' from non-optional value world",
+            },
+          ],
+        },
+      },
+      runtimeCost: "30",
+    },
+    {
+      original: {
+        name: "literal_pb_optional_field",
+        expr: "TestAllTypes{?single_int32: {'value': 1}.?value, ?single_string: {}.?missing}",
+        container: "google.expr.proto3.test",
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+            singleInt32: 1,
+          },
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: 'TestAllTypes{\n  ?single_int32:_?._(\n    {\n      "value"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "value"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#,\n  ?single_string:_?._(\n    {}^#*expr.Expr_StructExpr#,\n    "missing"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed:
+        'TestAllTypes{?single_int32: {"value": 1}.?value, ?single_string: {}.?missing}',
+      locationAst:
+        'TestAllTypes{\n  ?single_int32:_?._(\n    {\n      "value"^#5[1,29]#:1^#6[1,38]#^#4[1,36]#\n    }^#3[1,28]#,\n    "value"^#7[1,42]#\n  )^#8[1,40]#^#2[1,26]#,\n  ?single_string:_?._(\n    {}^#10[1,65]#,\n    "missing"^#11[1,69]#\n  )^#12[1,67]#^#9[1,63]#\n}^#1[1,12]#',
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 26, 27, 1, 26, 1, 27],
+        [3, 28, 29, 1, 28, 1, 29],
+        [4, 36, 37, 1, 36, 1, 37],
+        [5, 29, 36, 1, 29, 1, 36],
+        [6, 38, 39, 1, 38, 1, 39],
+        [7, 42, 47, 1, 42, 1, 47],
+        [8, 40, 41, 1, 40, 1, 41],
+        [9, 63, 64, 1, 63, 1, 64],
+        [10, 65, 66, 1, 65, 1, 66],
+        [11, 69, 76, 1, 69, 1, 76],
+        [12, 67, 68, 1, 67, 1, 68],
+      ],
+      lineOffsets: [78],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: {
+                  id: "8",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      {
+                        id: "3",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "4",
+                              mapKey: {
+                                id: "5",
+                                constExpr: { stringValue: "value" },
+                              },
+                              value: {
+                                id: "6",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "7", constExpr: { stringValue: "value" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+              {
+                id: "9",
+                fieldKey: "single_string",
+                value: {
+                  id: "12",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "10", structExpr: {} },
+                      { id: "11", constExpr: { stringValue: "missing" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_pb_optional_field",
+          lineOffsets: [78],
+          positions: {
+            "1": 12,
+            "2": 26,
+            "3": 28,
+            "4": 36,
+            "5": 29,
+            "6": 38,
+            "7": 42,
+            "8": 40,
+            "9": 63,
+            "10": 65,
+            "11": 69,
+            "12": 67,
+          },
+        },
+      },
+      checkedAst:
+        'google.expr.proto3.test.TestAllTypes{\n  ?single_int32:_?._(\n    {\n      "value"~string:1~int\n    }~map(string, int),\n    "value"\n  )~optional_type(int)^select_optional_field,\n  ?single_string:_?._(\n    {}~map(dyn, string),\n    "missing"\n  )~optional_type(string)^select_optional_field\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto3.test.TestAllTypes" },
+          "8": { overloadId: ["select_optional_field"] },
+          "12": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "8": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "10": {
+            mapType: {
+              keyType: { dyn: {} },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "12": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "literal_pb_optional_field",
+          lineOffsets: [78],
+          positions: {
+            "1": 12,
+            "2": 26,
+            "3": 28,
+            "4": 36,
+            "5": 29,
+            "6": 38,
+            "7": 42,
+            "8": 40,
+            "9": 63,
+            "10": 65,
+            "11": 69,
+            "12": 67,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: {
+                  id: "8",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      {
+                        id: "3",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "4",
+                              mapKey: {
+                                id: "5",
+                                constExpr: { stringValue: "value" },
+                              },
+                              value: {
+                                id: "6",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "7", constExpr: { stringValue: "value" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+              {
+                id: "9",
+                fieldKey: "single_string",
+                value: {
+                  id: "12",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "10", structExpr: {} },
+                      { id: "11", constExpr: { stringValue: "missing" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "102", max: "102" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+            singleInt32: 1,
+          },
+        },
+      },
+      runtimeCost: "103",
+    },
+    {
+      original: {
+        name: "literal_pb_optional_field_bad_init",
+        expr: "TestAllTypes{?single_int32: 1}",
+        disableCheck: true,
+        container: "google.expr.proto3.test",
+        evalError: {
+          errors: [
+            {
+              message:
+                "cannot initialize optional entry 'single_int32' from non-optional",
+            },
+          ],
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "TestAllTypes{\n  ?single_int32:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "TestAllTypes{?single_int32: 1}",
+      locationAst:
+        "TestAllTypes{\n  ?single_int32:1^#3[1,28]#^#2[1,26]#\n}^#1[1,12]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 26, 27, 1, 26, 1, 27],
+        [3, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "literal_pb_optional_field_bad_init",
+          lineOffsets: [31],
+          positions: { "1": 12, "2": 26, "3": 28 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:29: expected type 'optional_type(int)' but found 'int'\n | TestAllTypes{?single_int32: 1}\n | ............................^",
+      errors: [
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(int)' but found 'int'",
+          id: 3,
+          offset: 28,
+          line: 1,
+          column: 28,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "cannot initialize optional entry 'single_int32' from non-optional value 1",
+            },
+          ],
+        },
+      },
+      runtimeCost: "40",
+    },
+    {
+      original: {
+        name: "literal_list_optional_element",
+        expr: "[?{}.?missing, ?{'present': 42u}.?present]",
+        value: { listValue: { values: [{ uint64Value: "42" }] } },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: '[\n  _?._(\n    {}^#*expr.Expr_StructExpr#,\n    "missing"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  _?._(\n    {\n      "present"^#*expr.Constant_StringValue#:42u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    "present"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#',
+      unparsed: '[?{}.?missing, ?{"present": 42u}.?present]',
+      locationAst:
+        '[\n  _?._(\n    {}^#2[1,2]#,\n    "missing"^#3[1,6]#\n  )^#4[1,4]#,\n  _?._(\n    {\n      "present"^#7[1,17]#:42u^#8[1,28]#^#6[1,26]#\n    }^#5[1,16]#,\n    "present"^#9[1,34]#\n  )^#10[1,32]#\n]^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 6, 13, 1, 6, 1, 13],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 26, 27, 1, 26, 1, 27],
+        [7, 17, 26, 1, 17, 1, 26],
+        [8, 28, 31, 1, 28, 1, 31],
+        [9, 34, 41, 1, 34, 1, 41],
+        [10, 32, 33, 1, 32, 1, 33],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_?._",
+                  args: [
+                    { id: "2", structExpr: {} },
+                    { id: "3", constExpr: { stringValue: "missing" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_?._",
+                  args: [
+                    {
+                      id: "5",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "6",
+                            mapKey: {
+                              id: "7",
+                              constExpr: { stringValue: "present" },
+                            },
+                            value: {
+                              id: "8",
+                              constExpr: { uint64Value: "42" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "9", constExpr: { stringValue: "present" } },
+                  ],
+                },
+              },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+        sourceInfo: {
+          location: "literal_list_optional_element",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 6,
+            "4": 4,
+            "5": 16,
+            "6": 26,
+            "7": 17,
+            "8": 28,
+            "9": 34,
+            "10": 32,
+          },
+        },
+      },
+      checkedAst:
+        '[\n  _?._(\n    {}~map(dyn, uint),\n    "missing"\n  )~optional_type(uint)^select_optional_field,\n  _?._(\n    {\n      "present"~string:42u~uint\n    }~map(string, uint),\n    "present"\n  )~optional_type(uint)^select_optional_field\n]~list(uint)',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["select_optional_field"] },
+          "10": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "UINT64" } } },
+          "2": {
+            mapType: {
+              keyType: { dyn: {} },
+              valueType: { primitive: "UINT64" },
+            },
+          },
+          "4": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "UINT64" }],
+            },
+          },
+          "5": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "UINT64" },
+            },
+          },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "UINT64" },
+          "10": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "UINT64" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "literal_list_optional_element",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 6,
+            "4": 4,
+            "5": 16,
+            "6": 26,
+            "7": 17,
+            "8": 28,
+            "9": 34,
+            "10": 32,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_?._",
+                  args: [
+                    { id: "2", structExpr: {} },
+                    { id: "3", constExpr: { stringValue: "missing" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_?._",
+                  args: [
+                    {
+                      id: "5",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "6",
+                            mapKey: {
+                              id: "7",
+                              constExpr: { stringValue: "present" },
+                            },
+                            value: {
+                              id: "8",
+                              constExpr: { uint64Value: "42" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "9", constExpr: { stringValue: "present" } },
+                  ],
+                },
+              },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+      },
+      type: "list(uint)",
+      cost: { min: "72", max: "72" },
+      result: { value: { listValue: { values: [{ uint64Value: "42" }] } } },
+      runtimeCost: "73",
+    },
+    {
+      original: {
+        name: "literal_list_optional_bad_element",
+        expr: "[?123]",
+        disableCheck: true,
+        evalError: {
+          errors: [
+            {
+              message:
+                "cannot initialize optional list element from non-optional value 123",
+            },
+          ],
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "[\n  123^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[?123]",
+      locationAst: "[\n  123^#2[1,2]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 5, 1, 2, 1, 5],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [{ id: "2", constExpr: { int64Value: "123" } }],
+            optionalIndices: [0],
+          },
+        },
+        sourceInfo: {
+          location: "literal_list_optional_bad_element",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:3: expected type 'optional_type(int)' but found 'int'\n | [?123]\n | ..^",
+      errors: [
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(int)' but found 'int'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "cannot initialize optional list element from non-optional value 123",
+            },
+          ],
+        },
+      },
+      runtimeCost: "10",
+    },
+    {
+      original: {
+        name: "bad_argument_in_optimized_list",
+        expr: "1/0 in [1, 2, 3]",
+        evalError: { errors: [{ message: "division by zero" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "@in(\n  _/_(\n    1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 / 0 in [1, 2, 3]",
+      locationAst:
+        "@in(\n  _/_(\n    1^#1[1,0]#,\n    0^#3[1,2]#\n  )^#2[1,1]#,\n  [\n    1^#6[1,8]#,\n    2^#7[1,11]#,\n    3^#8[1,14]#\n  ]^#5[1,7]#\n)^#4[1,4]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 6, 1, 4, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 8, 9, 1, 8, 1, 9],
+        [7, 11, 12, 1, 11, 1, 12],
+        [8, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "1", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                    { id: "8", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "bad_argument_in_optimized_list",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 8,
+            "7": 11,
+            "8": 14,
+          },
+        },
+      },
+      checkedAst:
+        "@in(\n  _/_(\n    1~int,\n    0~int\n  )~int^divide_int64,\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["divide_int64"] },
+          "4": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "bad_argument_in_optimized_list",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 4,
+            "5": 7,
+            "6": 8,
+            "7": 11,
+            "8": 14,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "1", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                    { id: "8", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { error: { errors: [{ code: 2, message: "division by zero" }] } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        name: "list_index_error",
+        expr: "mylistundef[0]",
+        disableCheck: true,
+        evalError: {
+          errors: [{ message: "no such attribute(s): mylistundef" }],
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_[_](\n  mylistundef^#*expr.Expr_IdentExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "mylistundef[0]",
+      locationAst: "_[_](\n  mylistundef^#1[1,0]#,\n  0^#3[1,12]#\n)^#2[1,11]#",
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "mylistundef" } },
+              { id: "3", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_index_error",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 11, "3": 12 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'mylistundef' (in container '')\n | mylistundef[0]\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'mylistundef' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such attribute(s): mylistundef" }],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "pkg_list_index_error",
+        expr: "pkg.mylistundef[0]",
+        disableCheck: true,
+        container: "goog",
+        evalError: {
+          errors: [
+            {
+              message:
+                "no such attribute(s): goog.pkg.mylistundef, pkg.mylistundef",
+            },
+          ],
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "_[_](\n  pkg^#*expr.Expr_IdentExpr#.mylistundef^#*expr.Expr_SelectExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "pkg.mylistundef[0]",
+      locationAst:
+        "_[_](\n  pkg^#1[1,0]#.mylistundef^#2[1,3]#,\n  0^#4[1,16]#\n)^#3[1,15]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "pkg" } },
+                  field: "mylistundef",
+                },
+              },
+              { id: "4", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "pkg_list_index_error",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 3, "3": 15, "4": 16 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'pkg' (in container 'goog')\n | pkg.mylistundef[0]\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'pkg' (in container 'goog')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "no such attribute(s): goog.pkg.mylistundef, pkg.mylistundef",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "invalid_presence_test_on_int_literal",
+        expr: "has(dyn(1).invalid)",
+        evalError: { errors: [{ message: "no such key: invalid" }] },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "dyn(\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#.invalid~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(dyn(1).invalid)",
+      locationAst: "dyn(\n  1^#3[1,8]#\n)^#2[1,7]#.invalid~test-only~^#5[1,3]#",
+      positions: [
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "2",
+              callExpr: {
+                function: "dyn",
+                args: [{ id: "3", constExpr: { int64Value: "1" } }],
+              },
+            },
+            field: "invalid",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "invalid_presence_test_on_int_literal",
+          lineOffsets: [20],
+          positions: { "2": 7, "3": 8, "4": 10, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        callExpr: {
+                          function: "dyn",
+                          args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                        },
+                      },
+                      field: "invalid",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  callExpr: {
+                    function: "dyn",
+                    args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                field: "invalid",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst: "dyn(\n  1~int\n)~dyn^to_dyn.invalid~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["to_dyn"] } },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "invalid_presence_test_on_int_literal",
+          lineOffsets: [20],
+          positions: { "2": 7, "3": 8, "4": 10, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        callExpr: {
+                          function: "dyn",
+                          args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                        },
+                      },
+                      field: "invalid",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "2",
+              callExpr: {
+                function: "dyn",
+                args: [{ id: "3", constExpr: { int64Value: "1" } }],
+              },
+            },
+            field: "invalid",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "no such key: invalid" }] },
+      },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "invalid_presence_test_on_list_literal",
+        expr: "has(dyn([]).invalid)",
+        evalError: {
+          errors: [{ message: "unsupported index type 'string' in list" }],
+        },
+      },
+      variadicAsts: true,
+      optionalSyntax: true,
+      ast: "dyn(\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#.invalid~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(dyn([]).invalid)",
+      locationAst:
+        "dyn(\n  []^#3[1,8]#\n)^#2[1,7]#.invalid~test-only~^#5[1,3]#",
+      positions: [
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "2",
+              callExpr: { function: "dyn", args: [{ id: "3", listExpr: {} }] },
+            },
+            field: "invalid",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "invalid_presence_test_on_list_literal",
+          lineOffsets: [21],
+          positions: { "2": 7, "3": 8, "4": 11, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        callExpr: {
+                          function: "dyn",
+                          args: [{ id: "3", listExpr: {} }],
+                        },
+                      },
+                      field: "invalid",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  callExpr: {
+                    function: "dyn",
+                    args: [{ id: "3", listExpr: {} }],
+                  },
+                },
+                field: "invalid",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst: "dyn(\n  []~list(dyn)\n)~dyn^to_dyn.invalid~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["to_dyn"] } },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "invalid_presence_test_on_list_literal",
+          lineOffsets: [21],
+          positions: { "2": 7, "3": 8, "4": 11, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "2",
+                        callExpr: {
+                          function: "dyn",
+                          args: [{ id: "3", listExpr: {} }],
+                        },
+                      },
+                      field: "invalid",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "2",
+              callExpr: { function: "dyn", args: [{ id: "3", listExpr: {} }] },
+            },
+            field: "invalid",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "unsupported index type 'string' in list" },
+          ],
+        },
+      },
+      runtimeCost: "13",
+    },
+  ],
+} as const;
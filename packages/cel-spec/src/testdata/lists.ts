@@ -0,0 +1,11945 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/lists_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "lists",
+  tests: [
+    {
+      original: {
+        expr: "lists.range(4) == [0,1,2,3]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  lists^#*expr.Expr_IdentExpr#.range(\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "lists.range(4) == [0, 1, 2, 3]",
+      locationAst:
+        "_==_(\n  lists^#1[1,0]#.range(\n    4^#3[1,12]#\n  )^#2[1,11]#,\n  [\n    0^#6[1,19]#,\n    1^#7[1,21]#,\n    2^#8[1,23]#,\n    3^#9[1,25]#\n  ]^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 23, 24, 1, 23, 1, 24],
+        [9, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "lists" } },
+                  function: "range",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "0" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 15,
+            "5": 18,
+            "6": 19,
+            "7": 21,
+            "8": 23,
+            "9": 25,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  lists.range(\n    4~int\n  )~list(int)^lists_range,\n  [\n    0~int,\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["lists_range"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 15,
+            "5": 18,
+            "6": 19,
+            "7": 21,
+            "8": 23,
+            "9": 25,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "lists.range",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "0" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "lists.range(0) == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  lists^#*expr.Expr_IdentExpr#.range(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "lists.range(0) == []",
+      locationAst:
+        "_==_(\n  lists^#1[1,0]#.range(\n    0^#3[1,12]#\n  )^#2[1,11]#,\n  []^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "lists" } },
+                  function: "range",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  lists.range(\n    0~int\n  )~list(int)^lists_range,\n  []~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["lists_range"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "lists.range",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "11" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: "[5,1,2,3].reverse() == [3,2,1,5]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    5^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.reverse()^#*expr.Expr_CallExpr#,\n  [\n    3^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[5, 1, 2, 3].reverse() == [3, 2, 1, 5]",
+      locationAst:
+        "_==_(\n  [\n    5^#2[1,1]#,\n    1^#3[1,3]#,\n    2^#4[1,5]#,\n    3^#5[1,7]#\n  ]^#1[1,0]#.reverse()^#6[1,17]#,\n  [\n    3^#9[1,24]#,\n    2^#10[1,26]#,\n    1^#11[1,28]#,\n    5^#12[1,30]#\n  ]^#8[1,23]#\n)^#7[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 20, 22, 1, 20, 1, 22],
+        [8, 23, 24, 1, 23, 1, 24],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 26, 27, 1, 26, 1, 27],
+        [11, 28, 29, 1, 28, 1, 29],
+        [12, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "5" } },
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "2" } },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 17,
+            "7": 20,
+            "8": 23,
+            "9": 24,
+            "10": 26,
+            "11": 28,
+            "12": 30,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    5~int,\n    1~int,\n    2~int,\n    3~int\n  ]~list(int).reverse()~list(int)^list_reverse,\n  [\n    3~int,\n    2~int,\n    1~int,\n    5~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_reverse"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 17,
+            "7": 20,
+            "8": 23,
+            "9": 24,
+            "10": 26,
+            "11": 28,
+            "12": 30,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "5" } },
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "2" } },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[].reverse() == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  []^#*expr.Expr_ListExpr#.reverse()^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[].reverse() == []",
+      locationAst:
+        "_==_(\n  []^#1[1,0]#.reverse()^#2[1,10]#,\n  []^#4[1,16]#\n)^#3[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 13, 15, 1, 13, 1, 15],
+        [4, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "reverse",
+                },
+              },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 10, "3": 13, "4": 16 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  []~list(dyn).reverse()~list(dyn)^list_reverse,\n  []~list(dyn)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["list_reverse"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { listType: { elemType: { dyn: {} } } },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 10, "3": 13, "4": 16 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "reverse",
+                },
+              },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        expr: "[1].reverse() == [1]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.reverse()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1].reverse() == [1]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#\n  ]^#1[1,0]#.reverse()^#3[1,11]#,\n  [\n    1^#6[1,18]#\n  ]^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 14, "5": 17, "6": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int\n  ]~list(int).reverse()~list(int)^list_reverse,\n  [\n    1~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["list_reverse"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 14, "5": 17, "6": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "['are', 'you', 'as', 'bored', 'as', 'I', 'am'].reverse() == ['am', 'I', 'as', 'bored', 'as', 'you', 'are']",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    "are"^#*expr.Constant_StringValue#,\n    "you"^#*expr.Constant_StringValue#,\n    "as"^#*expr.Constant_StringValue#,\n    "bored"^#*expr.Constant_StringValue#,\n    "as"^#*expr.Constant_StringValue#,\n    "I"^#*expr.Constant_StringValue#,\n    "am"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.reverse()^#*expr.Expr_CallExpr#,\n  [\n    "am"^#*expr.Constant_StringValue#,\n    "I"^#*expr.Constant_StringValue#,\n    "as"^#*expr.Constant_StringValue#,\n    "bored"^#*expr.Constant_StringValue#,\n    "as"^#*expr.Constant_StringValue#,\n    "you"^#*expr.Constant_StringValue#,\n    "are"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '["are", "you", "as", "bored", "as", "I", "am"].reverse() == ["am", "I", "as", "bored", "as", "you", "are"]',
+      locationAst:
+        '_==_(\n  [\n    "are"^#2[1,1]#,\n    "you"^#3[1,8]#,\n    "as"^#4[1,15]#,\n    "bored"^#5[1,21]#,\n    "as"^#6[1,30]#,\n    "I"^#7[1,36]#,\n    "am"^#8[1,41]#\n  ]^#1[1,0]#.reverse()^#9[1,54]#,\n  [\n    "am"^#12[1,61]#,\n    "I"^#13[1,67]#,\n    "as"^#14[1,72]#,\n    "bored"^#15[1,78]#,\n    "as"^#16[1,87]#,\n    "you"^#17[1,93]#,\n    "are"^#18[1,100]#\n  ]^#11[1,60]#\n)^#10[1,57]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 6, 1, 1, 1, 6],
+        [3, 8, 13, 1, 8, 1, 13],
+        [4, 15, 19, 1, 15, 1, 19],
+        [5, 21, 28, 1, 21, 1, 28],
+        [6, 30, 34, 1, 30, 1, 34],
+        [7, 36, 39, 1, 36, 1, 39],
+        [8, 41, 45, 1, 41, 1, 45],
+        [9, 54, 55, 1, 54, 1, 55],
+        [10, 57, 59, 1, 57, 1, 59],
+        [11, 60, 61, 1, 60, 1, 61],
+        [12, 61, 65, 1, 61, 1, 65],
+        [13, 67, 70, 1, 67, 1, 70],
+        [14, 72, 76, 1, 72, 1, 76],
+        [15, 78, 85, 1, 78, 1, 85],
+        [16, 87, 91, 1, 87, 1, 91],
+        [17, 93, 98, 1, 93, 1, 98],
+        [18, 100, 105, 1, 100, 1, 105],
+      ],
+      lineOffsets: [107],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "are" } },
+                        { id: "3", constExpr: { stringValue: "you" } },
+                        { id: "4", constExpr: { stringValue: "as" } },
+                        { id: "5", constExpr: { stringValue: "bored" } },
+                        { id: "6", constExpr: { stringValue: "as" } },
+                        { id: "7", constExpr: { stringValue: "I" } },
+                        { id: "8", constExpr: { stringValue: "am" } },
+                      ],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { stringValue: "am" } },
+                    { id: "13", constExpr: { stringValue: "I" } },
+                    { id: "14", constExpr: { stringValue: "as" } },
+                    { id: "15", constExpr: { stringValue: "bored" } },
+                    { id: "16", constExpr: { stringValue: "as" } },
+                    { id: "17", constExpr: { stringValue: "you" } },
+                    { id: "18", constExpr: { stringValue: "are" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [107],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 8,
+            "4": 15,
+            "5": 21,
+            "6": 30,
+            "7": 36,
+            "8": 41,
+            "9": 54,
+            "10": 57,
+            "11": 60,
+            "12": 61,
+            "13": 67,
+            "14": 72,
+            "15": 78,
+            "16": 87,
+            "17": 93,
+            "18": 100,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "are"~string,\n    "you"~string,\n    "as"~string,\n    "bored"~string,\n    "as"~string,\n    "I"~string,\n    "am"~string\n  ]~list(string).reverse()~list(string)^list_reverse,\n  [\n    "am"~string,\n    "I"~string,\n    "as"~string,\n    "bored"~string,\n    "as"~string,\n    "you"~string,\n    "are"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "9": { overloadId: ["list_reverse"] },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { listType: { elemType: { primitive: "STRING" } } },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { primitive: "STRING" } } },
+          "12": { primitive: "STRING" },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "STRING" },
+          "15": { primitive: "STRING" },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [107],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 8,
+            "4": 15,
+            "5": 21,
+            "6": 30,
+            "7": 36,
+            "8": 41,
+            "9": 54,
+            "10": 57,
+            "11": 60,
+            "12": 61,
+            "13": 67,
+            "14": 72,
+            "15": 78,
+            "16": 87,
+            "17": 93,
+            "18": 100,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "are" } },
+                        { id: "3", constExpr: { stringValue: "you" } },
+                        { id: "4", constExpr: { stringValue: "as" } },
+                        { id: "5", constExpr: { stringValue: "bored" } },
+                        { id: "6", constExpr: { stringValue: "as" } },
+                        { id: "7", constExpr: { stringValue: "I" } },
+                        { id: "8", constExpr: { stringValue: "am" } },
+                      ],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { stringValue: "am" } },
+                    { id: "13", constExpr: { stringValue: "I" } },
+                    { id: "14", constExpr: { stringValue: "as" } },
+                    { id: "15", constExpr: { stringValue: "bored" } },
+                    { id: "16", constExpr: { stringValue: "as" } },
+                    { id: "17", constExpr: { stringValue: "you" } },
+                    { id: "18", constExpr: { stringValue: "are" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[false, true, true].reverse().reverse() == [false, true, true]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    false^#*expr.Constant_BoolValue#,\n    true^#*expr.Constant_BoolValue#,\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#.reverse()^#*expr.Expr_CallExpr#.reverse()^#*expr.Expr_CallExpr#,\n  [\n    false^#*expr.Constant_BoolValue#,\n    true^#*expr.Constant_BoolValue#,\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "[false, true, true].reverse().reverse() == [false, true, true]",
+      locationAst:
+        "_==_(\n  [\n    false^#2[1,1]#,\n    true^#3[1,8]#,\n    true^#4[1,14]#\n  ]^#1[1,0]#.reverse()^#5[1,27]#.reverse()^#6[1,37]#,\n  [\n    false^#9[1,44]#,\n    true^#10[1,51]#,\n    true^#11[1,57]#\n  ]^#8[1,43]#\n)^#7[1,40]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 6, 1, 1, 1, 6],
+        [3, 8, 12, 1, 8, 1, 12],
+        [4, 14, 18, 1, 14, 1, 18],
+        [5, 27, 28, 1, 27, 1, 28],
+        [6, 37, 38, 1, 37, 1, 38],
+        [7, 40, 42, 1, 40, 1, 42],
+        [8, 43, 44, 1, 43, 1, 44],
+        [9, 44, 49, 1, 44, 1, 49],
+        [10, 51, 55, 1, 51, 1, 55],
+        [11, 57, 61, 1, 57, 1, 61],
+      ],
+      lineOffsets: [63],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "5",
+                    callExpr: {
+                      target: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { boolValue: false } },
+                            { id: "3", constExpr: { boolValue: true } },
+                            { id: "4", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      function: "reverse",
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { boolValue: false } },
+                    { id: "10", constExpr: { boolValue: true } },
+                    { id: "11", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 8,
+            "4": 14,
+            "5": 27,
+            "6": 37,
+            "7": 40,
+            "8": 43,
+            "9": 44,
+            "10": 51,
+            "11": 57,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    false~bool,\n    true~bool,\n    true~bool\n  ]~list(bool).reverse()~list(bool)^list_reverse.reverse()~list(bool)^list_reverse,\n  [\n    false~bool,\n    true~bool,\n    true~bool\n  ]~list(bool)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["list_reverse"] },
+          "6": { overloadId: ["list_reverse"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "BOOL" } } },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "BOOL" } } },
+          "6": { listType: { elemType: { primitive: "BOOL" } } },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "BOOL" } } },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 8,
+            "4": 14,
+            "5": 27,
+            "6": 37,
+            "7": 40,
+            "8": 43,
+            "9": 44,
+            "10": 51,
+            "11": 57,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "5",
+                    callExpr: {
+                      target: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { boolValue: false } },
+                            { id: "3", constExpr: { boolValue: true } },
+                            { id: "4", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      function: "reverse",
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { boolValue: false } },
+                    { id: "10", constExpr: { boolValue: true } },
+                    { id: "11", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(0, 4) == [1,2,3,4]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.slice(\n    0^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(0, 4) == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#\n  ]^#1[1,0]#.slice(\n    0^#7[1,16]#,\n    4^#8[1,19]#\n  )^#6[1,15]#,\n  [\n    1^#11[1,26]#,\n    2^#12[1,28]#,\n    3^#13[1,30]#,\n    4^#14[1,32]#\n  ]^#10[1,25]#\n)^#9[1,22]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 24, 1, 22, 1, 24],
+        [10, 25, 26, 1, 25, 1, 26],
+        [11, 26, 27, 1, 26, 1, 27],
+        [12, 28, 29, 1, 28, 1, 29],
+        [13, 30, 31, 1, 30, 1, 31],
+        [14, 32, 33, 1, 32, 1, 33],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "0" } },
+                    { id: "8", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    { id: "13", constExpr: { int64Value: "3" } },
+                    { id: "14", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 26,
+            "12": 28,
+            "13": 30,
+            "14": 32,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int).slice(\n    0~int,\n    4~int\n  )~list(int)^list_slice,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_slice"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 26,
+            "12": 28,
+            "13": 30,
+            "14": 32,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "0" } },
+                    { id: "8", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    { id: "13", constExpr: { int64Value: "3" } },
+                    { id: "14", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(0, 0) == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.slice(\n    0^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(0, 0) == []",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#\n  ]^#1[1,0]#.slice(\n    0^#7[1,16]#,\n    0^#8[1,19]#\n  )^#6[1,15]#,\n  []^#10[1,25]#\n)^#9[1,22]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 24, 1, 22, 1, 24],
+        [10, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "0" } },
+                    { id: "8", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "10", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int).slice(\n    0~int,\n    0~int\n  )~list(int)^list_slice,\n  []~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_slice"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "0" } },
+                    { id: "8", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "10", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(1, 1) == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.slice(\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(1, 1) == []",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#\n  ]^#1[1,0]#.slice(\n    1^#7[1,16]#,\n    1^#8[1,19]#\n  )^#6[1,15]#,\n  []^#10[1,25]#\n)^#9[1,22]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 24, 1, 22, 1, 24],
+        [10, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "10", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int).slice(\n    1~int,\n    1~int\n  )~list(int)^list_slice,\n  []~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_slice"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "10", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(4, 4) == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.slice(\n    4^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(4, 4) == []",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#\n  ]^#1[1,0]#.slice(\n    4^#7[1,16]#,\n    4^#8[1,19]#\n  )^#6[1,15]#,\n  []^#10[1,25]#\n)^#9[1,22]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 24, 1, 22, 1, 24],
+        [10, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "4" } },
+                    { id: "8", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "10", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int).slice(\n    4~int,\n    4~int\n  )~list(int)^list_slice,\n  []~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_slice"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "4" } },
+                    { id: "8", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "10", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(1, 3) == [2, 3]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.slice(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(1, 3) == [2, 3]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#\n  ]^#1[1,0]#.slice(\n    1^#7[1,16]#,\n    3^#8[1,19]#\n  )^#6[1,15]#,\n  [\n    2^#11[1,26]#,\n    3^#12[1,29]#\n  ]^#10[1,25]#\n)^#9[1,22]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 24, 1, 22, 1, 24],
+        [10, 25, 26, 1, 25, 1, 26],
+        [11, 26, 27, 1, 26, 1, 27],
+        [12, 29, 30, 1, 29, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "2" } },
+                    { id: "12", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 26,
+            "12": 29,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int).slice(\n    1~int,\n    3~int\n  )~list(int)^list_slice,\n  [\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_slice"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 26,
+            "12": 29,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "2" } },
+                    { id: "12", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(3, 0)",
+        container: "google.expr.proto2.test",
+        evalError: {
+          errors: [
+            {
+              message:
+                "cannot slice(3, 0), start index must be less than or equal to end index",
+            },
+          ],
+        },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#.slice(\n  3^#*expr.Constant_Int64Value#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(3, 0)",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  2^#3[1,3]#,\n  3^#4[1,5]#,\n  4^#5[1,7]#\n]^#1[1,0]#.slice(\n  3^#7[1,16]#,\n  0^#8[1,19]#\n)^#6[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            function: "slice",
+            args: [
+              { id: "7", constExpr: { int64Value: "3" } },
+              { id: "8", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  2~int,\n  3~int,\n  4~int\n]~list(int).slice(\n  3~int,\n  0~int\n)~list(int)^list_slice",
+      checkedExpr: {
+        referenceMap: { "6": { overloadId: ["list_slice"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            function: "slice",
+            args: [
+              { id: "7", constExpr: { int64Value: "3" } },
+              { id: "8", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "11", max: "11" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "cannot slice(3, 0), start index must be less than or equal to end index",
+            },
+          ],
+        },
+      },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(0, 10)",
+        container: "google.expr.proto2.test",
+        evalError: {
+          errors: [{ message: "cannot slice(0, 10), list is length 4" }],
+        },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#.slice(\n  0^#*expr.Constant_Int64Value#,\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(0, 10)",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  2^#3[1,3]#,\n  3^#4[1,5]#,\n  4^#5[1,7]#\n]^#1[1,0]#.slice(\n  0^#7[1,16]#,\n  10^#8[1,19]#\n)^#6[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 19, 21, 1, 19, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            function: "slice",
+            args: [
+              { id: "7", constExpr: { int64Value: "0" } },
+              { id: "8", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  2~int,\n  3~int,\n  4~int\n]~list(int).slice(\n  0~int,\n  10~int\n)~list(int)^list_slice",
+      checkedExpr: {
+        referenceMap: { "6": { overloadId: ["list_slice"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 19,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            function: "slice",
+            args: [
+              { id: "7", constExpr: { int64Value: "0" } },
+              { id: "8", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "11", max: "11" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "cannot slice(0, 10), list is length 4" },
+          ],
+        },
+      },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(-5, 10)",
+        container: "google.expr.proto2.test",
+        evalError: {
+          errors: [
+            { message: "cannot slice(-5, 10), negative indexes not supported" },
+          ],
+        },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#.slice(\n  -5^#*expr.Constant_Int64Value#,\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(-5, 10)",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  2^#3[1,3]#,\n  3^#4[1,5]#,\n  4^#5[1,7]#\n]^#1[1,0]#.slice(\n  -5^#7[1,16]#,\n  10^#8[1,20]#\n)^#6[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 18, 1, 16, 1, 18],
+        [8, 20, 22, 1, 20, 1, 22],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            function: "slice",
+            args: [
+              { id: "7", constExpr: { int64Value: "-5" } },
+              { id: "8", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 20,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  2~int,\n  3~int,\n  4~int\n]~list(int).slice(\n  -5~int,\n  10~int\n)~list(int)^list_slice",
+      checkedExpr: {
+        referenceMap: { "6": { overloadId: ["list_slice"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 20,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            function: "slice",
+            args: [
+              { id: "7", constExpr: { int64Value: "-5" } },
+              { id: "8", constExpr: { int64Value: "10" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "11", max: "11" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "cannot slice(-5, 10), negative indexes not supported",
+            },
+          ],
+        },
+      },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: "[1,2,3,4].slice(-5, -3)",
+        container: "google.expr.proto2.test",
+        evalError: {
+          errors: [
+            { message: "cannot slice(-5, -3), negative indexes not supported" },
+          ],
+        },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 0,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#.slice(\n  -5^#*expr.Constant_Int64Value#,\n  -3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4].slice(-5, -3)",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  2^#3[1,3]#,\n  3^#4[1,5]#,\n  4^#5[1,7]#\n]^#1[1,0]#.slice(\n  -5^#7[1,16]#,\n  -3^#8[1,20]#\n)^#6[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 16, 18, 1, 16, 1, 18],
+        [8, 20, 22, 1, 20, 1, 22],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            function: "slice",
+            args: [
+              { id: "7", constExpr: { int64Value: "-5" } },
+              { id: "8", constExpr: { int64Value: "-3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 20,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  2~int,\n  3~int,\n  4~int\n]~list(int).slice(\n  -5~int,\n  -3~int\n)~list(int)^list_slice",
+      checkedExpr: {
+        referenceMap: { "6": { overloadId: ["list_slice"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 15,
+            "7": 16,
+            "8": 20,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+            function: "slice",
+            args: [
+              { id: "7", constExpr: { int64Value: "-5" } },
+              { id: "8", constExpr: { int64Value: "-3" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "11", max: "11" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "cannot slice(-5, -3), negative indexes not supported",
+            },
+          ],
+        },
+      },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: "dyn([]).flatten() == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  dyn(\n    []^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#.flatten()^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn([]).flatten() == []",
+      locationAst:
+        "_==_(\n  dyn(\n    []^#2[1,4]#\n  )^#1[1,3]#.flatten()^#3[1,15]#,\n  []^#5[1,21]#\n)^#4[1,18]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "2", listExpr: {} }],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              { id: "5", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 3, "2": 4, "3": 15, "4": 18, "5": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  dyn(\n    []~list(dyn)\n  )~dyn^to_dyn.flatten()~list(dyn)^list_flatten,\n  []~list(dyn)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["list_flatten"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { listType: { elemType: { dyn: {} } } },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 3, "2": 4, "3": 15, "4": 18, "5": 21 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "2", listExpr: {} }],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              { id: "5", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "dyn([1,2,3,4]).flatten() == [1,2,3,4]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  dyn(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#.flatten()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn([1, 2, 3, 4]).flatten() == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  dyn(\n    [\n      1^#3[1,5]#,\n      2^#4[1,7]#,\n      3^#5[1,9]#,\n      4^#6[1,11]#\n    ]^#2[1,4]#\n  )^#1[1,3]#.flatten()^#7[1,22]#,\n  [\n    1^#10[1,29]#,\n    2^#11[1,31]#,\n    3^#12[1,33]#,\n    4^#13[1,35]#\n  ]^#9[1,28]#\n)^#8[1,25]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 25, 27, 1, 25, 1, 27],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 29, 30, 1, 29, 1, 30],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 33, 34, 1, 33, 1, 34],
+        [13, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    callExpr: {
+                      function: "dyn",
+                      args: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              { id: "5", constExpr: { int64Value: "3" } },
+                              { id: "6", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    { id: "10", constExpr: { int64Value: "1" } },
+                    { id: "11", constExpr: { int64Value: "2" } },
+                    { id: "12", constExpr: { int64Value: "3" } },
+                    { id: "13", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 11,
+            "7": 22,
+            "8": 25,
+            "9": 28,
+            "10": 29,
+            "11": 31,
+            "12": 33,
+            "13": 35,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  dyn(\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int\n    ]~list(int)\n  )~dyn^to_dyn.flatten()~list(int)^list_flatten,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "7": { overloadId: ["list_flatten"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "BOOL" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 3,
+            "2": 4,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 11,
+            "7": 22,
+            "8": 25,
+            "9": 28,
+            "10": 29,
+            "11": 31,
+            "12": 33,
+            "13": 35,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    callExpr: {
+                      function: "dyn",
+                      args: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              { id: "5", constExpr: { int64Value: "3" } },
+                              { id: "6", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    { id: "10", constExpr: { int64Value: "1" } },
+                    { id: "11", constExpr: { int64Value: "2" } },
+                    { id: "12", constExpr: { int64Value: "3" } },
+                    { id: "13", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "[1,[2,[3,4]]].flatten() == [1,2,[3,4]]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      [\n        3^#*expr.Constant_Int64Value#,\n        4^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#.flatten()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    [\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, [2, [3, 4]]].flatten() == [1, 2, [3, 4]]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    [\n      2^#4[1,4]#,\n      [\n        3^#6[1,7]#,\n        4^#7[1,9]#\n      ]^#5[1,6]#\n    ]^#3[1,3]#\n  ]^#1[1,0]#.flatten()^#8[1,21]#,\n  [\n    1^#11[1,28]#,\n    2^#12[1,30]#,\n    [\n      3^#14[1,33]#,\n      4^#15[1,35]#\n    ]^#13[1,32]#\n  ]^#10[1,27]#\n)^#9[1,24]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 6, 7, 1, 6, 1, 7],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 9, 10, 1, 9, 1, 10],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 24, 26, 1, 24, 1, 26],
+        [10, 27, 28, 1, 27, 1, 28],
+        [11, 28, 29, 1, 28, 1, 29],
+        [12, 30, 31, 1, 30, 1, 31],
+        [13, 32, 33, 1, 32, 1, 33],
+        [14, 33, 34, 1, 33, 1, 34],
+        [15, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        {
+                          id: "3",
+                          listExpr: {
+                            elements: [
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "3" } },
+                                    { id: "7", constExpr: { int64Value: "4" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    {
+                      id: "13",
+                      listExpr: {
+                        elements: [
+                          { id: "14", constExpr: { int64Value: "3" } },
+                          { id: "15", constExpr: { int64Value: "4" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 4,
+            "5": 6,
+            "6": 7,
+            "7": 9,
+            "8": 21,
+            "9": 24,
+            "10": 27,
+            "11": 28,
+            "12": 30,
+            "13": 32,
+            "14": 33,
+            "15": 35,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    [\n      2~int,\n      [\n        3~int,\n        4~int\n      ]~list(int)\n    ]~list(dyn)\n  ]~list(dyn).flatten()~list(dyn)^list_flatten,\n  [\n    1~int,\n    2~int,\n    [\n      3~int,\n      4~int\n    ]~list(int)\n  ]~list(dyn)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["list_flatten"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { dyn: {} } } },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 4,
+            "5": 6,
+            "6": 7,
+            "7": 9,
+            "8": 21,
+            "9": 24,
+            "10": 27,
+            "11": 28,
+            "12": 30,
+            "13": 32,
+            "14": 33,
+            "15": 35,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        {
+                          id: "3",
+                          listExpr: {
+                            elements: [
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "3" } },
+                                    { id: "7", constExpr: { int64Value: "4" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    {
+                      id: "13",
+                      listExpr: {
+                        elements: [
+                          { id: "14", constExpr: { int64Value: "3" } },
+                          { id: "15", constExpr: { int64Value: "4" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "52", max: "52" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "52",
+    },
+    {
+      original: {
+        expr: "[1,2,[],[],[3,4]].flatten() == [1,2,3,4]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    []^#*expr.Expr_ListExpr#,\n    []^#*expr.Expr_ListExpr#,\n    [\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#.flatten()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, [], [], [3, 4]].flatten() == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    []^#4[1,5]#,\n    []^#5[1,8]#,\n    [\n      3^#7[1,12]#,\n      4^#8[1,14]#\n    ]^#6[1,11]#\n  ]^#1[1,0]#.flatten()^#9[1,25]#,\n  [\n    1^#12[1,32]#,\n    2^#13[1,34]#,\n    3^#14[1,36]#,\n    4^#15[1,38]#\n  ]^#11[1,31]#\n)^#10[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 28, 30, 1, 28, 1, 30],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 32, 33, 1, 32, 1, 33],
+        [13, 34, 35, 1, 34, 1, 35],
+        [14, 36, 37, 1, 36, 1, 37],
+        [15, 38, 39, 1, 38, 1, 39],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", listExpr: {} },
+                        { id: "5", listExpr: {} },
+                        {
+                          id: "6",
+                          listExpr: {
+                            elements: [
+                              { id: "7", constExpr: { int64Value: "3" } },
+                              { id: "8", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "1" } },
+                    { id: "13", constExpr: { int64Value: "2" } },
+                    { id: "14", constExpr: { int64Value: "3" } },
+                    { id: "15", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 8,
+            "6": 11,
+            "7": 12,
+            "8": 14,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+            "12": 32,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    []~list(dyn),\n    []~list(dyn),\n    [\n      3~int,\n      4~int\n    ]~list(int)\n  ]~list(dyn).flatten()~list(int)^list_flatten,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "9": { overloadId: ["list_flatten"] },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 8,
+            "6": 11,
+            "7": 12,
+            "8": 14,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+            "12": 32,
+            "13": 34,
+            "14": 36,
+            "15": 38,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", listExpr: {} },
+                        { id: "5", listExpr: {} },
+                        {
+                          id: "6",
+                          listExpr: {
+                            elements: [
+                              { id: "7", constExpr: { int64Value: "3" } },
+                              { id: "8", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "1" } },
+                    { id: "13", constExpr: { int64Value: "2" } },
+                    { id: "14", constExpr: { int64Value: "3" } },
+                    { id: "15", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "52", max: "52" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "52",
+    },
+    {
+      original: {
+        expr: "[1,[2,[3,4]]].flatten(2) == [1,2,3,4]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      [\n        3^#*expr.Constant_Int64Value#,\n        4^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#.flatten(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, [2, [3, 4]]].flatten(2) == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    [\n      2^#4[1,4]#,\n      [\n        3^#6[1,7]#,\n        4^#7[1,9]#\n      ]^#5[1,6]#\n    ]^#3[1,3]#\n  ]^#1[1,0]#.flatten(\n    2^#9[1,22]#\n  )^#8[1,21]#,\n  [\n    1^#12[1,29]#,\n    2^#13[1,31]#,\n    3^#14[1,33]#,\n    4^#15[1,35]#\n  ]^#11[1,28]#\n)^#10[1,25]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 6, 7, 1, 6, 1, 7],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 9, 10, 1, 9, 1, 10],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 25, 27, 1, 25, 1, 27],
+        [11, 28, 29, 1, 28, 1, 29],
+        [12, 29, 30, 1, 29, 1, 30],
+        [13, 31, 32, 1, 31, 1, 32],
+        [14, 33, 34, 1, 33, 1, 34],
+        [15, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        {
+                          id: "3",
+                          listExpr: {
+                            elements: [
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "3" } },
+                                    { id: "7", constExpr: { int64Value: "4" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                  args: [{ id: "9", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "1" } },
+                    { id: "13", constExpr: { int64Value: "2" } },
+                    { id: "14", constExpr: { int64Value: "3" } },
+                    { id: "15", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 4,
+            "5": 6,
+            "6": 7,
+            "7": 9,
+            "8": 21,
+            "9": 22,
+            "10": 25,
+            "11": 28,
+            "12": 29,
+            "13": 31,
+            "14": 33,
+            "15": 35,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    [\n      2~int,\n      [\n        3~int,\n        4~int\n      ]~list(int)\n    ]~list(dyn)\n  ]~list(dyn).flatten(\n    2~int\n  )~list(dyn)^list_flatten_int,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["list_flatten_int"] },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 4,
+            "5": 6,
+            "6": 7,
+            "7": 9,
+            "8": 21,
+            "9": 22,
+            "10": 25,
+            "11": 28,
+            "12": 29,
+            "13": 31,
+            "14": 33,
+            "15": 35,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        {
+                          id: "3",
+                          listExpr: {
+                            elements: [
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "3" } },
+                                    { id: "7", constExpr: { int64Value: "4" } },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                  args: [{ id: "9", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "1" } },
+                    { id: "13", constExpr: { int64Value: "2" } },
+                    { id: "14", constExpr: { int64Value: "3" } },
+                    { id: "15", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "42", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "42",
+    },
+    {
+      original: {
+        expr: "[1,[2,[3,[4]]]].flatten(-1) == [1,2,3,4]",
+        container: "google.expr.proto2.test",
+        evalError: { errors: [{ message: "level must be non-negative" }] },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      [\n        3^#*expr.Constant_Int64Value#,\n        [\n          4^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#.flatten(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, [2, [3, [4]]]].flatten(-1) == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    [\n      2^#4[1,4]#,\n      [\n        3^#6[1,7]#,\n        [\n          4^#8[1,10]#\n        ]^#7[1,9]#\n      ]^#5[1,6]#\n    ]^#3[1,3]#\n  ]^#1[1,0]#.flatten(\n    -1^#10[1,24]#\n  )^#9[1,23]#,\n  [\n    1^#13[1,32]#,\n    2^#14[1,34]#,\n    3^#15[1,36]#,\n    4^#16[1,38]#\n  ]^#12[1,31]#\n)^#11[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 6, 7, 1, 6, 1, 7],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 9, 10, 1, 9, 1, 10],
+        [8, 10, 11, 1, 10, 1, 11],
+        [9, 23, 24, 1, 23, 1, 24],
+        [10, 24, 26, 1, 24, 1, 26],
+        [11, 28, 30, 1, 28, 1, 30],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 32, 33, 1, 32, 1, 33],
+        [14, 34, 35, 1, 34, 1, 35],
+        [15, 36, 37, 1, 36, 1, 37],
+        [16, 38, 39, 1, 38, 1, 39],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        {
+                          id: "3",
+                          listExpr: {
+                            elements: [
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "3" } },
+                                    {
+                                      id: "7",
+                                      listExpr: {
+                                        elements: [
+                                          {
+                                            id: "8",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                  args: [{ id: "10", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              {
+                id: "12",
+                listExpr: {
+                  elements: [
+                    { id: "13", constExpr: { int64Value: "1" } },
+                    { id: "14", constExpr: { int64Value: "2" } },
+                    { id: "15", constExpr: { int64Value: "3" } },
+                    { id: "16", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 4,
+            "5": 6,
+            "6": 7,
+            "7": 9,
+            "8": 10,
+            "9": 23,
+            "10": 24,
+            "11": 28,
+            "12": 31,
+            "13": 32,
+            "14": 34,
+            "15": 36,
+            "16": 38,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    [\n      2~int,\n      [\n        3~int,\n        [\n          4~int\n        ]~list(int)\n      ]~list(dyn)\n    ]~list(dyn)\n  ]~list(dyn).flatten(\n    -1~int\n  )~list(dyn)^list_flatten_int,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "9": { overloadId: ["list_flatten_int"] },
+          "11": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { listType: { elemType: { dyn: {} } } },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 4,
+            "5": 6,
+            "6": 7,
+            "7": 9,
+            "8": 10,
+            "9": 23,
+            "10": 24,
+            "11": 28,
+            "12": 31,
+            "13": 32,
+            "14": 34,
+            "15": 36,
+            "16": 38,
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        {
+                          id: "3",
+                          listExpr: {
+                            elements: [
+                              { id: "4", constExpr: { int64Value: "2" } },
+                              {
+                                id: "5",
+                                listExpr: {
+                                  elements: [
+                                    { id: "6", constExpr: { int64Value: "3" } },
+                                    {
+                                      id: "7",
+                                      listExpr: {
+                                        elements: [
+                                          {
+                                            id: "8",
+                                            constExpr: { int64Value: "4" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                  args: [{ id: "10", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              {
+                id: "12",
+                listExpr: {
+                  elements: [
+                    { id: "13", constExpr: { int64Value: "1" } },
+                    { id: "14", constExpr: { int64Value: "2" } },
+                    { id: "15", constExpr: { int64Value: "3" } },
+                    { id: "16", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "52", max: "52" },
+      result: {
+        error: { errors: [{ code: 2, message: "level must be non-negative" }] },
+      },
+      runtimeCost: "52",
+    },
+    {
+      original: {
+        expr: "[].sort() == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  []^#*expr.Expr_ListExpr#.sort()^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[].sort() == []",
+      locationAst:
+        "_==_(\n  []^#1[1,0]#.sort()^#2[1,7]#,\n  []^#4[1,13]#\n)^#3[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "sort",
+                },
+              },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 7, "3": 10, "4": 13 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  []~list(int).sort()~list(int)^list_int_sort,\n  []~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["list_int_sort"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 7, "3": 10, "4": 13 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "sort",
+                },
+              },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        expr: "[1].sort() == [1]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.sort()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1].sort() == [1]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#\n  ]^#1[1,0]#.sort()^#3[1,8]#,\n  [\n    1^#6[1,15]#\n  ]^#5[1,14]#\n)^#4[1,11]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 11, 13, 1, 11, 1, 13],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 15, 16, 1, 15, 1, 16],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 1, "3": 8, "4": 11, "5": 14, "6": 15 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int\n  ]~list(int).sort()~list(int)^list_int_sort,\n  [\n    1~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["list_int_sort"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 1, "3": 8, "4": 11, "5": 14, "6": 15 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[4, 3, 2, 1].sort() == [1, 2, 3, 4]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    4^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.sort()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[4, 3, 2, 1].sort() == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  [\n    4^#2[1,1]#,\n    3^#3[1,4]#,\n    2^#4[1,7]#,\n    1^#5[1,10]#\n  ]^#1[1,0]#.sort()^#6[1,17]#,\n  [\n    1^#9[1,24]#,\n    2^#10[1,27]#,\n    3^#11[1,30]#,\n    4^#12[1,33]#\n  ]^#8[1,23]#\n)^#7[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 20, 22, 1, 20, 1, 22],
+        [8, 23, 24, 1, 23, 1, 24],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 27, 28, 1, 27, 1, 28],
+        [11, 30, 31, 1, 30, 1, 31],
+        [12, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "4" } },
+                        { id: "3", constExpr: { int64Value: "3" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "1" } },
+                    { id: "10", constExpr: { int64Value: "2" } },
+                    { id: "11", constExpr: { int64Value: "3" } },
+                    { id: "12", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 17,
+            "7": 20,
+            "8": 23,
+            "9": 24,
+            "10": 27,
+            "11": 30,
+            "12": 33,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    4~int,\n    3~int,\n    2~int,\n    1~int\n  ]~list(int).sort()~list(int)^list_int_sort,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_int_sort"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 17,
+            "7": 20,
+            "8": 23,
+            "9": 24,
+            "10": 27,
+            "11": 30,
+            "12": 33,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "4" } },
+                        { id: "3", constExpr: { int64Value: "3" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "1" } },
+                    { id: "10", constExpr: { int64Value: "2" } },
+                    { id: "11", constExpr: { int64Value: "3" } },
+                    { id: "12", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: '["d", "a", "b", "c"].sort() == ["a", "b", "c", "d"]',
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    "d"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.sort()^#*expr.Expr_CallExpr#,\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#,\n    "d"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["d", "a", "b", "c"].sort() == ["a", "b", "c", "d"]',
+      locationAst:
+        '_==_(\n  [\n    "d"^#2[1,1]#,\n    "a"^#3[1,6]#,\n    "b"^#4[1,11]#,\n    "c"^#5[1,16]#\n  ]^#1[1,0]#.sort()^#6[1,25]#,\n  [\n    "a"^#9[1,32]#,\n    "b"^#10[1,37]#,\n    "c"^#11[1,42]#,\n    "d"^#12[1,47]#\n  ]^#8[1,31]#\n)^#7[1,28]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 11, 14, 1, 11, 1, 14],
+        [5, 16, 19, 1, 16, 1, 19],
+        [6, 25, 26, 1, 25, 1, 26],
+        [7, 28, 30, 1, 28, 1, 30],
+        [8, 31, 32, 1, 31, 1, 32],
+        [9, 32, 35, 1, 32, 1, 35],
+        [10, 37, 40, 1, 37, 1, 40],
+        [11, 42, 45, 1, 42, 1, 45],
+        [12, 47, 50, 1, 47, 1, 50],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "d" } },
+                        { id: "3", constExpr: { stringValue: "a" } },
+                        { id: "4", constExpr: { stringValue: "b" } },
+                        { id: "5", constExpr: { stringValue: "c" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { stringValue: "a" } },
+                    { id: "10", constExpr: { stringValue: "b" } },
+                    { id: "11", constExpr: { stringValue: "c" } },
+                    { id: "12", constExpr: { stringValue: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 11,
+            "5": 16,
+            "6": 25,
+            "7": 28,
+            "8": 31,
+            "9": 32,
+            "10": 37,
+            "11": 42,
+            "12": 47,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "d"~string,\n    "a"~string,\n    "b"~string,\n    "c"~string\n  ]~list(string).sort()~list(string)^list_string_sort,\n  [\n    "a"~string,\n    "b"~string,\n    "c"~string,\n    "d"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_string_sort"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "STRING" },
+          "12": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 11,
+            "5": 16,
+            "6": 25,
+            "7": 28,
+            "8": 31,
+            "9": 32,
+            "10": 37,
+            "11": 42,
+            "12": 47,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "d" } },
+                        { id: "3", constExpr: { stringValue: "a" } },
+                        { id: "4", constExpr: { stringValue: "b" } },
+                        { id: "5", constExpr: { stringValue: "c" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { stringValue: "a" } },
+                    { id: "10", constExpr: { stringValue: "b" } },
+                    { id: "11", constExpr: { stringValue: "c" } },
+                    { id: "12", constExpr: { stringValue: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: '["d", 3, 2, "c"].sort() == ["a", "b", "c", "d"]',
+        container: "google.expr.proto2.test",
+        evalError: {
+          errors: [{ message: "list elements must have the same type" }],
+        },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    "d"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    "c"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.sort()^#*expr.Expr_CallExpr#,\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#,\n    "d"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["d", 3, 2, "c"].sort() == ["a", "b", "c", "d"]',
+      locationAst:
+        '_==_(\n  [\n    "d"^#2[1,1]#,\n    3^#3[1,6]#,\n    2^#4[1,9]#,\n    "c"^#5[1,12]#\n  ]^#1[1,0]#.sort()^#6[1,21]#,\n  [\n    "a"^#9[1,28]#,\n    "b"^#10[1,33]#,\n    "c"^#11[1,38]#,\n    "d"^#12[1,43]#\n  ]^#8[1,27]#\n)^#7[1,24]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 12, 15, 1, 12, 1, 15],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 26, 1, 24, 1, 26],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 28, 31, 1, 28, 1, 31],
+        [10, 33, 36, 1, 33, 1, 36],
+        [11, 38, 41, 1, 38, 1, 41],
+        [12, 43, 46, 1, 43, 1, 46],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "d" } },
+                        { id: "3", constExpr: { int64Value: "3" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { stringValue: "c" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { stringValue: "a" } },
+                    { id: "10", constExpr: { stringValue: "b" } },
+                    { id: "11", constExpr: { stringValue: "c" } },
+                    { id: "12", constExpr: { stringValue: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 9,
+            "5": 12,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 33,
+            "11": 38,
+            "12": 43,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "d"~string,\n    3~int,\n    2~int,\n    "c"~string\n  ]~list(dyn).sort()~dyn^list_bool_sort|list_bytes_sort|list_double_sort|list_google.protobuf.Duration_sort|list_google.protobuf.Timestamp_sort|list_int_sort|list_string_sort|list_uint_sort,\n  [\n    "a"~string,\n    "b"~string,\n    "c"~string,\n    "d"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "6": {
+            overloadId: [
+              "list_bool_sort",
+              "list_bytes_sort",
+              "list_double_sort",
+              "list_google.protobuf.Duration_sort",
+              "list_google.protobuf.Timestamp_sort",
+              "list_int_sort",
+              "list_string_sort",
+              "list_uint_sort",
+            ],
+          },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "STRING" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "STRING" },
+          "12": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 9,
+            "5": 12,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 33,
+            "11": 38,
+            "12": 43,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "d" } },
+                        { id: "3", constExpr: { int64Value: "3" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { stringValue: "c" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { stringValue: "a" } },
+                    { id: "10", constExpr: { stringValue: "b" } },
+                    { id: "11", constExpr: { stringValue: "c" } },
+                    { id: "12", constExpr: { stringValue: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "list elements must have the same type" },
+          ],
+        },
+      },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[].sortBy(e, e) == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  []^#*expr.Expr_ListExpr#.sortBy(\n    e^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[].sortBy(e, e) == []",
+      locationAst:
+        "_==_(\n  []^#1[1,0]#.sortBy(\n    e^#3[1,10]#,\n    e^#4[1,13]#\n  )^#2[1,9]#,\n  []^#6[1,19]#\n)^#5[1,16]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 16, 18, 1, 16, 1, 18],
+        [6, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "sortBy",
+                  args: [
+                    { id: "3", identExpr: { name: "e" } },
+                    { id: "4", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+              { id: "6", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16, "6": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    @__sortBy_input__,\n    // Init\n    []~list(int),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    @__sortBy_input__~list(int)^@__sortBy_input__,\n    // Result\n    @__sortBy_input__~list(int)^@__sortBy_input__.@sortByAssociatedKeys(\n      __comprehension__(\n        // Variable\n        e,\n        // Target\n        @__sortBy_input__~list(int)^@__sortBy_input__,\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            e~int^e\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int)\n    )~list(int)^list_int_sortByAssociatedKeys)~list(int),\n  []~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { name: "e" },
+          "5": { name: "@__sortBy_input__" },
+          "6": { name: "@__sortBy_input__" },
+          "9": { name: "@result" },
+          "11": { overloadId: ["add_list"] },
+          "12": { name: "@result" },
+          "14": { name: "@__sortBy_input__" },
+          "15": { overloadId: ["list_int_sortByAssociatedKeys"] },
+          "19": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "BOOL" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "BOOL" },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 9,
+            "6": 9,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+            "14": 9,
+            "15": 9,
+            "16": 9,
+            "17": 9,
+            "18": 9,
+            "19": 16,
+            "20": 19,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: { id: "1", listExpr: {} },
+                function: "sortBy",
+                args: [
+                  { id: "3", identExpr: { name: "e" } },
+                  { id: "4", identExpr: { name: "e" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "19",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "18",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "16", listExpr: {} },
+                  accuVar: "@__sortBy_input__",
+                  accuInit: { id: "1", listExpr: {} },
+                  loopCondition: { id: "17", constExpr: { boolValue: false } },
+                  loopStep: {
+                    id: "5",
+                    identExpr: { name: "@__sortBy_input__" },
+                  },
+                  result: {
+                    id: "15",
+                    callExpr: {
+                      target: {
+                        id: "14",
+                        identExpr: { name: "@__sortBy_input__" },
+                      },
+                      function: "@sortByAssociatedKeys",
+                      args: [
+                        {
+                          id: "13",
+                          comprehensionExpr: {
+                            iterVar: "e",
+                            iterRange: {
+                              id: "6",
+                              identExpr: { name: "@__sortBy_input__" },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "7", listExpr: {} },
+                            loopCondition: {
+                              id: "8",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "11",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "9", identExpr: { name: "@result" } },
+                                  {
+                                    id: "10",
+                                    listExpr: {
+                                      elements: [
+                                        { id: "4", identExpr: { name: "e" } },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "12",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              { id: "20", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "44", max: "44" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "44",
+    },
+    {
+      original: {
+        expr: '["a"].sortBy(e, e) == ["a"]',
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    "a"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.sortBy(\n    e^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "a"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["a"].sortBy(e, e) == ["a"]',
+      locationAst:
+        '_==_(\n  [\n    "a"^#2[1,1]#\n  ]^#1[1,0]#.sortBy(\n    e^#4[1,13]#,\n    e^#5[1,16]#\n  )^#3[1,12]#,\n  [\n    "a"^#8[1,23]#\n  ]^#7[1,22]#\n)^#6[1,19]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 19, 21, 1, 19, 1, 21],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 23, 26, 1, 23, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [{ id: "2", constExpr: { stringValue: "a" } }],
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "4", identExpr: { name: "e" } },
+                    { id: "5", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [{ id: "8", constExpr: { stringValue: "a" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 12,
+            "4": 13,
+            "5": 16,
+            "6": 19,
+            "7": 22,
+            "8": 23,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    @__sortBy_input__,\n    // Init\n    [\n      "a"~string\n    ]~list(string),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    @__sortBy_input__~list(string)^@__sortBy_input__,\n    // Result\n    @__sortBy_input__~list(string)^@__sortBy_input__.@sortByAssociatedKeys(\n      __comprehension__(\n        // Variable\n        e,\n        // Target\n        @__sortBy_input__~list(string)^@__sortBy_input__,\n        // Accumulator\n        @result,\n        // Init\n        []~list(string),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(string)^@result,\n          [\n            e~string^e\n          ]~list(string)\n        )~list(string)^add_list,\n        // Result\n        @result~list(string)^@result)~list(string)\n    )~list(string)^list_string_sortByAssociatedKeys)~list(string),\n  [\n    "a"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "5": { name: "e" },
+          "6": { name: "@__sortBy_input__" },
+          "7": { name: "@__sortBy_input__" },
+          "10": { name: "@result" },
+          "12": { overloadId: ["add_list"] },
+          "13": { name: "@result" },
+          "15": { name: "@__sortBy_input__" },
+          "16": { overloadId: ["list_string_sortByAssociatedKeys"] },
+          "20": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { listType: { elemType: { primitive: "STRING" } } },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { primitive: "STRING" } } },
+          "11": { listType: { elemType: { primitive: "STRING" } } },
+          "12": { listType: { elemType: { primitive: "STRING" } } },
+          "13": { listType: { elemType: { primitive: "STRING" } } },
+          "14": { listType: { elemType: { primitive: "STRING" } } },
+          "15": { listType: { elemType: { primitive: "STRING" } } },
+          "16": { listType: { elemType: { primitive: "STRING" } } },
+          "17": { listType: { elemType: { dyn: {} } } },
+          "18": { primitive: "BOOL" },
+          "19": { listType: { elemType: { primitive: "STRING" } } },
+          "20": { primitive: "BOOL" },
+          "21": { listType: { elemType: { primitive: "STRING" } } },
+          "22": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 13,
+            "5": 16,
+            "6": 12,
+            "7": 12,
+            "8": 12,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+            "16": 12,
+            "17": 12,
+            "18": 12,
+            "19": 12,
+            "20": 19,
+            "21": 22,
+            "22": 23,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [{ id: "2", constExpr: { stringValue: "a" } }],
+                  },
+                },
+                function: "sortBy",
+                args: [
+                  { id: "4", identExpr: { name: "e" } },
+                  { id: "5", identExpr: { name: "e" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "20",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "19",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "17", listExpr: {} },
+                  accuVar: "@__sortBy_input__",
+                  accuInit: {
+                    id: "1",
+                    listExpr: {
+                      elements: [{ id: "2", constExpr: { stringValue: "a" } }],
+                    },
+                  },
+                  loopCondition: { id: "18", constExpr: { boolValue: false } },
+                  loopStep: {
+                    id: "6",
+                    identExpr: { name: "@__sortBy_input__" },
+                  },
+                  result: {
+                    id: "16",
+                    callExpr: {
+                      target: {
+                        id: "15",
+                        identExpr: { name: "@__sortBy_input__" },
+                      },
+                      function: "@sortByAssociatedKeys",
+                      args: [
+                        {
+                          id: "14",
+                          comprehensionExpr: {
+                            iterVar: "e",
+                            iterRange: {
+                              id: "7",
+                              identExpr: { name: "@__sortBy_input__" },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "8", listExpr: {} },
+                            loopCondition: {
+                              id: "9",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "12",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "10", identExpr: { name: "@result" } },
+                                  {
+                                    id: "11",
+                                    listExpr: {
+                                      elements: [
+                                        { id: "5", identExpr: { name: "e" } },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "13",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "21",
+                listExpr: {
+                  elements: [{ id: "22", constExpr: { stringValue: "a" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "58", max: "58" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "58",
+    },
+    {
+      original: {
+        expr: "[-3, 1, -5, -2, 4].sortBy(e, -(e * e)) == [-5, 4, -3, -2, 1]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    -3^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    -5^#*expr.Constant_Int64Value#,\n    -2^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.sortBy(\n    e^#*expr.Expr_IdentExpr#,\n    -_(\n      _*_(\n        e^#*expr.Expr_IdentExpr#,\n        e^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    -5^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    -3^#*expr.Constant_Int64Value#,\n    -2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[-3, 1, -5, -2, 4].sortBy(e, -(e * e)) == [-5, 4, -3, -2, 1]",
+      locationAst:
+        "_==_(\n  [\n    -3^#2[1,1]#,\n    1^#3[1,5]#,\n    -5^#4[1,8]#,\n    -2^#5[1,12]#,\n    4^#6[1,16]#\n  ]^#1[1,0]#.sortBy(\n    e^#8[1,26]#,\n    -_(\n      _*_(\n        e^#10[1,31]#,\n        e^#12[1,35]#\n      )^#11[1,33]#\n    )^#9[1,29]#\n  )^#7[1,25]#,\n  [\n    -5^#15[1,43]#,\n    4^#16[1,47]#,\n    -3^#17[1,50]#,\n    -2^#18[1,54]#,\n    1^#19[1,58]#\n  ]^#14[1,42]#\n)^#13[1,39]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 3, 1, 1, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 10, 1, 8, 1, 10],
+        [5, 12, 14, 1, 12, 1, 14],
+        [6, 16, 17, 1, 16, 1, 17],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 29, 30, 1, 29, 1, 30],
+        [10, 31, 32, 1, 31, 1, 32],
+        [11, 33, 34, 1, 33, 1, 34],
+        [12, 35, 36, 1, 35, 1, 36],
+        [13, 39, 41, 1, 39, 1, 41],
+        [14, 42, 43, 1, 42, 1, 43],
+        [15, 43, 45, 1, 43, 1, 45],
+        [16, 47, 48, 1, 47, 1, 48],
+        [17, 50, 52, 1, 50, 1, 52],
+        [18, 54, 56, 1, 54, 1, 56],
+        [19, 58, 59, 1, 58, 1, 59],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "-3" } },
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "-5" } },
+                        { id: "5", constExpr: { int64Value: "-2" } },
+                        { id: "6", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "8", identExpr: { name: "e" } },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "-_",
+                        args: [
+                          {
+                            id: "11",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "10", identExpr: { name: "e" } },
+                                { id: "12", identExpr: { name: "e" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "14",
+                listExpr: {
+                  elements: [
+                    { id: "15", constExpr: { int64Value: "-5" } },
+                    { id: "16", constExpr: { int64Value: "4" } },
+                    { id: "17", constExpr: { int64Value: "-3" } },
+                    { id: "18", constExpr: { int64Value: "-2" } },
+                    { id: "19", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 16,
+            "7": 25,
+            "8": 26,
+            "9": 29,
+            "10": 31,
+            "11": 33,
+            "12": 35,
+            "13": 39,
+            "14": 42,
+            "15": 43,
+            "16": 47,
+            "17": 50,
+            "18": 54,
+            "19": 58,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    @__sortBy_input__,\n    // Init\n    [\n      -3~int,\n      1~int,\n      -5~int,\n      -2~int,\n      4~int\n    ]~list(int),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    @__sortBy_input__~list(int)^@__sortBy_input__,\n    // Result\n    @__sortBy_input__~list(int)^@__sortBy_input__.@sortByAssociatedKeys(\n      __comprehension__(\n        // Variable\n        e,\n        // Target\n        @__sortBy_input__~list(int)^@__sortBy_input__,\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            -_(\n              _*_(\n                e~int^e,\n                e~int^e\n              )~int^multiply_int64\n            )~int^negate_int64\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int)\n    )~list(int)^list_int_sortByAssociatedKeys)~list(int),\n  [\n    -5~int,\n    4~int,\n    -3~int,\n    -2~int,\n    1~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "9": { overloadId: ["negate_int64"] },
+          "10": { name: "e" },
+          "11": { overloadId: ["multiply_int64"] },
+          "12": { name: "e" },
+          "13": { name: "@__sortBy_input__" },
+          "14": { name: "@__sortBy_input__" },
+          "17": { name: "@result" },
+          "19": { overloadId: ["add_list"] },
+          "20": { name: "@result" },
+          "22": { name: "@__sortBy_input__" },
+          "23": { overloadId: ["list_int_sortByAssociatedKeys"] },
+          "27": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { primitive: "BOOL" },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { dyn: {} } } },
+          "25": { primitive: "BOOL" },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+          "27": { primitive: "BOOL" },
+          "28": { listType: { elemType: { primitive: "INT64" } } },
+          "29": { primitive: "INT64" },
+          "30": { primitive: "INT64" },
+          "31": { primitive: "INT64" },
+          "32": { primitive: "INT64" },
+          "33": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 16,
+            "8": 26,
+            "9": 29,
+            "10": 31,
+            "11": 33,
+            "12": 35,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 25,
+            "18": 25,
+            "19": 25,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+            "27": 39,
+            "28": 42,
+            "29": 43,
+            "30": 47,
+            "31": 50,
+            "32": 54,
+            "33": 58,
+          },
+          macroCalls: {
+            "26": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "-3" } },
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "4", constExpr: { int64Value: "-5" } },
+                      { id: "5", constExpr: { int64Value: "-2" } },
+                      { id: "6", constExpr: { int64Value: "4" } },
+                    ],
+                  },
+                },
+                function: "sortBy",
+                args: [
+                  { id: "8", identExpr: { name: "e" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "-_",
+                      args: [
+                        {
+                          id: "11",
+                          callExpr: {
+                            function: "_*_",
+                            args: [
+                              { id: "10", identExpr: { name: "e" } },
+                              { id: "12", identExpr: { name: "e" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "26",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "24", listExpr: {} },
+                  accuVar: "@__sortBy_input__",
+                  accuInit: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "-3" } },
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "-5" } },
+                        { id: "5", constExpr: { int64Value: "-2" } },
+                        { id: "6", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                  loopCondition: { id: "25", constExpr: { boolValue: false } },
+                  loopStep: {
+                    id: "13",
+                    identExpr: { name: "@__sortBy_input__" },
+                  },
+                  result: {
+                    id: "23",
+                    callExpr: {
+                      target: {
+                        id: "22",
+                        identExpr: { name: "@__sortBy_input__" },
+                      },
+                      function: "@sortByAssociatedKeys",
+                      args: [
+                        {
+                          id: "21",
+                          comprehensionExpr: {
+                            iterVar: "e",
+                            iterRange: {
+                              id: "14",
+                              identExpr: { name: "@__sortBy_input__" },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "15", listExpr: {} },
+                            loopCondition: {
+                              id: "16",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "19",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "17", identExpr: { name: "@result" } },
+                                  {
+                                    id: "18",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "9",
+                                          callExpr: {
+                                            function: "-_",
+                                            args: [
+                                              {
+                                                id: "11",
+                                                callExpr: {
+                                                  function: "_*_",
+                                                  args: [
+                                                    {
+                                                      id: "10",
+                                                      identExpr: { name: "e" },
+                                                    },
+                                                    {
+                                                      id: "12",
+                                                      identExpr: { name: "e" },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "20",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "28",
+                listExpr: {
+                  elements: [
+                    { id: "29", constExpr: { int64Value: "-5" } },
+                    { id: "30", constExpr: { int64Value: "4" } },
+                    { id: "31", constExpr: { int64Value: "-3" } },
+                    { id: "32", constExpr: { int64Value: "-2" } },
+                    { id: "33", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "125", max: "125" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "125",
+    },
+    {
+      original: {
+        expr: "[-3, 1, -5, -2, 4].map(e, e * 2).sortBy(e, -(e * e)) == [-10, 8, -6, -4, 2]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    [\n      -3^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#,\n      -5^#*expr.Constant_Int64Value#,\n      -2^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        _*_(\n          e^#*expr.Expr_IdentExpr#,\n          2^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#.sortBy(\n    e^#*expr.Expr_IdentExpr#,\n    -_(\n      _*_(\n        e^#*expr.Expr_IdentExpr#,\n        e^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    -10^#*expr.Constant_Int64Value#,\n    8^#*expr.Constant_Int64Value#,\n    -6^#*expr.Constant_Int64Value#,\n    -4^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "[-3, 1, -5, -2, 4].map(e, e * 2).sortBy(e, -(e * e)) == [-10, 8, -6, -4, 2]",
+      locationAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    e,\n    // Target\n    [\n      -3^#2[1,1]#,\n      1^#3[1,5]#,\n      -5^#4[1,8]#,\n      -2^#5[1,12]#,\n      4^#6[1,16]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#12[1,22]#,\n    // LoopCondition\n    true^#13[1,22]#,\n    // LoopStep\n    _+_(\n      @result^#14[1,22]#,\n      [\n        _*_(\n          e^#9[1,26]#,\n          2^#11[1,30]#\n        )^#10[1,28]#\n      ]^#15[1,22]#\n    )^#16[1,22]#,\n    // Result\n    @result^#17[1,22]#)^#18[1,22]#.sortBy(\n    e^#20[1,40]#,\n    -_(\n      _*_(\n        e^#22[1,45]#,\n        e^#24[1,49]#\n      )^#23[1,47]#\n    )^#21[1,43]#\n  )^#19[1,39]#,\n  [\n    -10^#27[1,57]#,\n    8^#28[1,62]#,\n    -6^#29[1,65]#,\n    -4^#30[1,69]#,\n    2^#31[1,73]#\n  ]^#26[1,56]#\n)^#25[1,53]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 3, 1, 1, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 10, 1, 8, 1, 10],
+        [5, 12, 14, 1, 12, 1, 14],
+        [6, 16, 17, 1, 16, 1, 17],
+        [8, 23, 24, 1, 23, 1, 24],
+        [9, 26, 27, 1, 26, 1, 27],
+        [10, 28, 29, 1, 28, 1, 29],
+        [11, 30, 31, 1, 30, 1, 31],
+        [12, 22, 22, 1, 22, 1, 22],
+        [13, 22, 22, 1, 22, 1, 22],
+        [14, 22, 22, 1, 22, 1, 22],
+        [15, 22, 22, 1, 22, 1, 22],
+        [16, 22, 22, 1, 22, 1, 22],
+        [17, 22, 22, 1, 22, 1, 22],
+        [18, 22, 22, 1, 22, 1, 22],
+        [19, 39, 40, 1, 39, 1, 40],
+        [20, 40, 41, 1, 40, 1, 41],
+        [21, 43, 44, 1, 43, 1, 44],
+        [22, 45, 46, 1, 45, 1, 46],
+        [23, 47, 48, 1, 47, 1, 48],
+        [24, 49, 50, 1, 49, 1, 50],
+        [25, 53, 55, 1, 53, 1, 55],
+        [26, 56, 57, 1, 56, 1, 57],
+        [27, 57, 60, 1, 57, 1, 60],
+        [28, 62, 63, 1, 62, 1, 63],
+        [29, 65, 67, 1, 65, 1, 67],
+        [30, 69, 71, 1, 69, 1, 71],
+        [31, 73, 74, 1, 73, 1, 74],
+      ],
+      lineOffsets: [76],
+      parsedExpr: {
+        expr: {
+          id: "25",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "19",
+                callExpr: {
+                  target: {
+                    id: "18",
+                    comprehensionExpr: {
+                      iterVar: "e",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "-3" } },
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "4", constExpr: { int64Value: "-5" } },
+                            { id: "5", constExpr: { int64Value: "-2" } },
+                            { id: "6", constExpr: { int64Value: "4" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "12", listExpr: {} },
+                      loopCondition: {
+                        id: "13",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "16",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "14", identExpr: { name: "@result" } },
+                            {
+                              id: "15",
+                              listExpr: {
+                                elements: [
+                                  {
+                                    id: "10",
+                                    callExpr: {
+                                      function: "_*_",
+                                      args: [
+                                        { id: "9", identExpr: { name: "e" } },
+                                        {
+                                          id: "11",
+                                          constExpr: { int64Value: "2" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "17", identExpr: { name: "@result" } },
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "20", identExpr: { name: "e" } },
+                    {
+                      id: "21",
+                      callExpr: {
+                        function: "-_",
+                        args: [
+                          {
+                            id: "23",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "22", identExpr: { name: "e" } },
+                                { id: "24", identExpr: { name: "e" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "26",
+                listExpr: {
+                  elements: [
+                    { id: "27", constExpr: { int64Value: "-10" } },
+                    { id: "28", constExpr: { int64Value: "8" } },
+                    { id: "29", constExpr: { int64Value: "-6" } },
+                    { id: "30", constExpr: { int64Value: "-4" } },
+                    { id: "31", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [76],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 16,
+            "8": 23,
+            "9": 26,
+            "10": 28,
+            "11": 30,
+            "12": 22,
+            "13": 22,
+            "14": 22,
+            "15": 22,
+            "16": 22,
+            "17": 22,
+            "18": 22,
+            "19": 39,
+            "20": 40,
+            "21": 43,
+            "22": 45,
+            "23": 47,
+            "24": 49,
+            "25": 53,
+            "26": 56,
+            "27": 57,
+            "28": 62,
+            "29": 65,
+            "30": 69,
+            "31": 73,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "-3" } },
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "4", constExpr: { int64Value: "-5" } },
+                      { id: "5", constExpr: { int64Value: "-2" } },
+                      { id: "6", constExpr: { int64Value: "4" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "e" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "9", identExpr: { name: "e" } },
+                        { id: "11", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "-3" } },
+                { id: "3", constExpr: { int64Value: "1" } },
+                { id: "4", constExpr: { int64Value: "-5" } },
+                { id: "5", constExpr: { int64Value: "-2" } },
+                { id: "6", constExpr: { int64Value: "4" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "e" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "9", identExpr: { name: "e" } },
+                  { id: "11", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    @__sortBy_input__,\n    // Init\n    __comprehension__(\n      // Variable\n      e,\n      // Target\n      [\n        -3~int,\n        1~int,\n        -5~int,\n        -2~int,\n        4~int\n      ]~list(int),\n      // Accumulator\n      @result,\n      // Init\n      []~list(int),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _+_(\n        @result~list(int)^@result,\n        [\n          _*_(\n            e~int^e,\n            2~int\n          )~int^multiply_int64\n        ]~list(int)\n      )~list(int)^add_list,\n      // Result\n      @result~list(int)^@result)~list(int),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    @__sortBy_input__~list(int)^@__sortBy_input__,\n    // Result\n    @__sortBy_input__~list(int)^@__sortBy_input__.@sortByAssociatedKeys(\n      __comprehension__(\n        // Variable\n        e,\n        // Target\n        @__sortBy_input__~list(int)^@__sortBy_input__,\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            -_(\n              _*_(\n                e~int^e,\n                e~int^e\n              )~int^multiply_int64\n            )~int^negate_int64\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int)\n    )~list(int)^list_int_sortByAssociatedKeys)~list(int),\n  [\n    -10~int,\n    8~int,\n    -6~int,\n    -4~int,\n    2~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "e" },
+          "10": { overloadId: ["multiply_int64"] },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_list"] },
+          "17": { name: "@result" },
+          "21": { overloadId: ["negate_int64"] },
+          "22": { name: "e" },
+          "23": { overloadId: ["multiply_int64"] },
+          "24": { name: "e" },
+          "25": { name: "@__sortBy_input__" },
+          "26": { name: "@__sortBy_input__" },
+          "29": { name: "@result" },
+          "31": { overloadId: ["add_list"] },
+          "32": { name: "@result" },
+          "34": { name: "@__sortBy_input__" },
+          "35": { overloadId: ["list_int_sortByAssociatedKeys"] },
+          "39": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { primitive: "BOOL" },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { primitive: "INT64" },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "INT64" },
+          "24": { primitive: "INT64" },
+          "25": { listType: { elemType: { primitive: "INT64" } } },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+          "28": { primitive: "BOOL" },
+          "29": { listType: { elemType: { primitive: "INT64" } } },
+          "30": { listType: { elemType: { primitive: "INT64" } } },
+          "31": { listType: { elemType: { primitive: "INT64" } } },
+          "32": { listType: { elemType: { primitive: "INT64" } } },
+          "33": { listType: { elemType: { primitive: "INT64" } } },
+          "34": { listType: { elemType: { primitive: "INT64" } } },
+          "35": { listType: { elemType: { primitive: "INT64" } } },
+          "36": { listType: { elemType: { dyn: {} } } },
+          "37": { primitive: "BOOL" },
+          "38": { listType: { elemType: { primitive: "INT64" } } },
+          "39": { primitive: "BOOL" },
+          "40": { listType: { elemType: { primitive: "INT64" } } },
+          "41": { primitive: "INT64" },
+          "42": { primitive: "INT64" },
+          "43": { primitive: "INT64" },
+          "44": { primitive: "INT64" },
+          "45": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [76],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 16,
+            "8": 23,
+            "9": 26,
+            "10": 28,
+            "11": 30,
+            "12": 22,
+            "13": 22,
+            "14": 22,
+            "15": 22,
+            "16": 22,
+            "17": 22,
+            "18": 22,
+            "20": 40,
+            "21": 43,
+            "22": 45,
+            "23": 47,
+            "24": 49,
+            "25": 39,
+            "26": 39,
+            "27": 39,
+            "28": 39,
+            "29": 39,
+            "30": 39,
+            "31": 39,
+            "32": 39,
+            "33": 39,
+            "34": 39,
+            "35": 39,
+            "36": 39,
+            "37": 39,
+            "38": 39,
+            "39": 53,
+            "40": 56,
+            "41": 57,
+            "42": 62,
+            "43": 65,
+            "44": 69,
+            "45": 73,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "-3" } },
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "4", constExpr: { int64Value: "-5" } },
+                      { id: "5", constExpr: { int64Value: "-2" } },
+                      { id: "6", constExpr: { int64Value: "4" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "e" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "9", identExpr: { name: "e" } },
+                        { id: "11", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "38": {
+              callExpr: {
+                target: { id: "18" },
+                function: "sortBy",
+                args: [
+                  { id: "20", identExpr: { name: "e" } },
+                  {
+                    id: "21",
+                    callExpr: {
+                      function: "-_",
+                      args: [
+                        {
+                          id: "23",
+                          callExpr: {
+                            function: "_*_",
+                            args: [
+                              { id: "22", identExpr: { name: "e" } },
+                              { id: "24", identExpr: { name: "e" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "39",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "38",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "36", listExpr: {} },
+                  accuVar: "@__sortBy_input__",
+                  accuInit: {
+                    id: "18",
+                    comprehensionExpr: {
+                      iterVar: "e",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "-3" } },
+                            { id: "3", constExpr: { int64Value: "1" } },
+                            { id: "4", constExpr: { int64Value: "-5" } },
+                            { id: "5", constExpr: { int64Value: "-2" } },
+                            { id: "6", constExpr: { int64Value: "4" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "12", listExpr: {} },
+                      loopCondition: {
+                        id: "13",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "16",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "14", identExpr: { name: "@result" } },
+                            {
+                              id: "15",
+                              listExpr: {
+                                elements: [
+                                  {
+                                    id: "10",
+                                    callExpr: {
+                                      function: "_*_",
+                                      args: [
+                                        { id: "9", identExpr: { name: "e" } },
+                                        {
+                                          id: "11",
+                                          constExpr: { int64Value: "2" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "17", identExpr: { name: "@result" } },
+                    },
+                  },
+                  loopCondition: { id: "37", constExpr: { boolValue: false } },
+                  loopStep: {
+                    id: "25",
+                    identExpr: { name: "@__sortBy_input__" },
+                  },
+                  result: {
+                    id: "35",
+                    callExpr: {
+                      target: {
+                        id: "34",
+                        identExpr: { name: "@__sortBy_input__" },
+                      },
+                      function: "@sortByAssociatedKeys",
+                      args: [
+                        {
+                          id: "33",
+                          comprehensionExpr: {
+                            iterVar: "e",
+                            iterRange: {
+                              id: "26",
+                              identExpr: { name: "@__sortBy_input__" },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "27", listExpr: {} },
+                            loopCondition: {
+                              id: "28",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "31",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "29", identExpr: { name: "@result" } },
+                                  {
+                                    id: "30",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "21",
+                                          callExpr: {
+                                            function: "-_",
+                                            args: [
+                                              {
+                                                id: "23",
+                                                callExpr: {
+                                                  function: "_*_",
+                                                  args: [
+                                                    {
+                                                      id: "22",
+                                                      identExpr: { name: "e" },
+                                                    },
+                                                    {
+                                                      id: "24",
+                                                      identExpr: { name: "e" },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "32",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "40",
+                listExpr: {
+                  elements: [
+                    { id: "41", constExpr: { int64Value: "-10" } },
+                    { id: "42", constExpr: { int64Value: "8" } },
+                    { id: "43", constExpr: { int64Value: "-6" } },
+                    { id: "44", constExpr: { int64Value: "-4" } },
+                    { id: "45", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "206", max: "206" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "206",
+    },
+    {
+      original: {
+        expr: "lists.range(3).sortBy(e, -e) == [2, 1, 0]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  lists^#*expr.Expr_IdentExpr#.range(\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#.sortBy(\n    e^#*expr.Expr_IdentExpr#,\n    -_(\n      e^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "lists.range(3).sortBy(e, -e) == [2, 1, 0]",
+      locationAst:
+        "_==_(\n  lists^#1[1,0]#.range(\n    3^#3[1,12]#\n  )^#2[1,11]#.sortBy(\n    e^#5[1,22]#,\n    -_(\n      e^#7[1,26]#\n    )^#6[1,25]#\n  )^#4[1,21]#,\n  [\n    2^#10[1,33]#,\n    1^#11[1,36]#,\n    0^#12[1,39]#\n  ]^#9[1,32]#\n)^#8[1,29]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 23, 1, 22, 1, 23],
+        [6, 25, 26, 1, 25, 1, 26],
+        [7, 26, 27, 1, 26, 1, 27],
+        [8, 29, 31, 1, 29, 1, 31],
+        [9, 32, 33, 1, 32, 1, 33],
+        [10, 33, 34, 1, 33, 1, 34],
+        [11, 36, 37, 1, 36, 1, 37],
+        [12, 39, 40, 1, 39, 1, 40],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      target: { id: "1", identExpr: { name: "lists" } },
+                      function: "range",
+                      args: [{ id: "3", constExpr: { int64Value: "3" } }],
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "5", identExpr: { name: "e" } },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "-_",
+                        args: [{ id: "7", identExpr: { name: "e" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    { id: "10", constExpr: { int64Value: "2" } },
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 21,
+            "5": 22,
+            "6": 25,
+            "7": 26,
+            "8": 29,
+            "9": 32,
+            "10": 33,
+            "11": 36,
+            "12": 39,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    @__sortBy_input__,\n    // Init\n    lists.range(\n      3~int\n    )~list(int)^lists_range,\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    @__sortBy_input__~list(int)^@__sortBy_input__,\n    // Result\n    @__sortBy_input__~list(int)^@__sortBy_input__.@sortByAssociatedKeys(\n      __comprehension__(\n        // Variable\n        e,\n        // Target\n        @__sortBy_input__~list(int)^@__sortBy_input__,\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            -_(\n              e~int^e\n            )~int^negate_int64\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int)\n    )~list(int)^list_int_sortByAssociatedKeys)~list(int),\n  [\n    2~int,\n    1~int,\n    0~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["lists_range"] },
+          "6": { overloadId: ["negate_int64"] },
+          "7": { name: "e" },
+          "8": { name: "@__sortBy_input__" },
+          "9": { name: "@__sortBy_input__" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "17": { name: "@__sortBy_input__" },
+          "18": { overloadId: ["list_int_sortByAssociatedKeys"] },
+          "22": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { dyn: {} } } },
+          "20": { primitive: "BOOL" },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { primitive: "BOOL" },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "INT64" },
+          "26": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "5": 22,
+            "6": 25,
+            "7": 26,
+            "8": 21,
+            "9": 21,
+            "10": 21,
+            "11": 21,
+            "12": 21,
+            "13": 21,
+            "14": 21,
+            "15": 21,
+            "16": 21,
+            "17": 21,
+            "18": 21,
+            "19": 21,
+            "20": 21,
+            "21": 21,
+            "22": 29,
+            "23": 32,
+            "24": 33,
+            "25": 36,
+            "26": 39,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  callExpr: {
+                    target: { id: "1", identExpr: { name: "lists" } },
+                    function: "range",
+                    args: [{ id: "3", constExpr: { int64Value: "3" } }],
+                  },
+                },
+                function: "sortBy",
+                args: [
+                  { id: "5", identExpr: { name: "e" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "-_",
+                      args: [{ id: "7", identExpr: { name: "e" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "21",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "19", listExpr: {} },
+                  accuVar: "@__sortBy_input__",
+                  accuInit: {
+                    id: "2",
+                    callExpr: {
+                      function: "lists.range",
+                      args: [{ id: "3", constExpr: { int64Value: "3" } }],
+                    },
+                  },
+                  loopCondition: { id: "20", constExpr: { boolValue: false } },
+                  loopStep: {
+                    id: "8",
+                    identExpr: { name: "@__sortBy_input__" },
+                  },
+                  result: {
+                    id: "18",
+                    callExpr: {
+                      target: {
+                        id: "17",
+                        identExpr: { name: "@__sortBy_input__" },
+                      },
+                      function: "@sortByAssociatedKeys",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "e",
+                            iterRange: {
+                              id: "9",
+                              identExpr: { name: "@__sortBy_input__" },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "10", listExpr: {} },
+                            loopCondition: {
+                              id: "11",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "12", identExpr: { name: "@result" } },
+                                  {
+                                    id: "13",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "6",
+                                          callExpr: {
+                                            function: "-_",
+                                            args: [
+                                              {
+                                                id: "7",
+                                                identExpr: { name: "e" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "23",
+                listExpr: {
+                  elements: [
+                    { id: "24", constExpr: { int64Value: "2" } },
+                    { id: "25", constExpr: { int64Value: "1" } },
+                    { id: "26", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "36", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "78",
+    },
+    {
+      original: {
+        expr: '["a", "c", "b", "first"].sortBy(e, e == "first" ? "" : e) == ["first", "a", "b", "c"]',
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#,\n    "first"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.sortBy(\n    e^#*expr.Expr_IdentExpr#,\n    _?_:_(\n      _==_(\n        e^#*expr.Expr_IdentExpr#,\n        "first"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#,\n      ""^#*expr.Constant_StringValue#,\n      e^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "first"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '["a", "c", "b", "first"].sortBy(e, (e == "first") ? "" : e) == ["first", "a", "b", "c"]',
+      locationAst:
+        '_==_(\n  [\n    "a"^#2[1,1]#,\n    "c"^#3[1,6]#,\n    "b"^#4[1,11]#,\n    "first"^#5[1,16]#\n  ]^#1[1,0]#.sortBy(\n    e^#7[1,32]#,\n    _?_:_(\n      _==_(\n        e^#8[1,35]#,\n        "first"^#10[1,40]#\n      )^#9[1,37]#,\n      ""^#12[1,50]#,\n      e^#13[1,55]#\n    )^#11[1,48]#\n  )^#6[1,31]#,\n  [\n    "first"^#16[1,62]#,\n    "a"^#17[1,71]#,\n    "b"^#18[1,76]#,\n    "c"^#19[1,81]#\n  ]^#15[1,61]#\n)^#14[1,58]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 11, 14, 1, 11, 1, 14],
+        [5, 16, 23, 1, 16, 1, 23],
+        [6, 31, 32, 1, 31, 1, 32],
+        [7, 32, 33, 1, 32, 1, 33],
+        [8, 35, 36, 1, 35, 1, 36],
+        [9, 37, 39, 1, 37, 1, 39],
+        [10, 40, 47, 1, 40, 1, 47],
+        [11, 48, 49, 1, 48, 1, 49],
+        [12, 50, 52, 1, 50, 1, 52],
+        [13, 55, 56, 1, 55, 1, 56],
+        [14, 58, 60, 1, 58, 1, 60],
+        [15, 61, 62, 1, 61, 1, 62],
+        [16, 62, 69, 1, 62, 1, 69],
+        [17, 71, 74, 1, 71, 1, 74],
+        [18, 76, 79, 1, 76, 1, 79],
+        [19, 81, 84, 1, 81, 1, 84],
+      ],
+      lineOffsets: [86],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "c" } },
+                        { id: "4", constExpr: { stringValue: "b" } },
+                        { id: "5", constExpr: { stringValue: "first" } },
+                      ],
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "7", identExpr: { name: "e" } },
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_?_:_",
+                        args: [
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                { id: "8", identExpr: { name: "e" } },
+                                {
+                                  id: "10",
+                                  constExpr: { stringValue: "first" },
+                                },
+                              ],
+                            },
+                          },
+                          { id: "12", constExpr: { stringValue: "" } },
+                          { id: "13", identExpr: { name: "e" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                listExpr: {
+                  elements: [
+                    { id: "16", constExpr: { stringValue: "first" } },
+                    { id: "17", constExpr: { stringValue: "a" } },
+                    { id: "18", constExpr: { stringValue: "b" } },
+                    { id: "19", constExpr: { stringValue: "c" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [86],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 11,
+            "5": 16,
+            "6": 31,
+            "7": 32,
+            "8": 35,
+            "9": 37,
+            "10": 40,
+            "11": 48,
+            "12": 50,
+            "13": 55,
+            "14": 58,
+            "15": 61,
+            "16": 62,
+            "17": 71,
+            "18": 76,
+            "19": 81,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    @__sortBy_input__,\n    // Init\n    [\n      "a"~string,\n      "c"~string,\n      "b"~string,\n      "first"~string\n    ]~list(string),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    @__sortBy_input__~list(string)^@__sortBy_input__,\n    // Result\n    @__sortBy_input__~list(string)^@__sortBy_input__.@sortByAssociatedKeys(\n      __comprehension__(\n        // Variable\n        e,\n        // Target\n        @__sortBy_input__~list(string)^@__sortBy_input__,\n        // Accumulator\n        @result,\n        // Init\n        []~list(string),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(string)^@result,\n          [\n            _?_:_(\n              _==_(\n                e~string^e,\n                "first"~string\n              )~bool^equals,\n              ""~string,\n              e~string^e\n            )~string^conditional\n          ]~list(string)\n        )~list(string)^add_list,\n        // Result\n        @result~list(string)^@result)~list(string)\n    )~list(string)^list_string_sortByAssociatedKeys)~list(string),\n  [\n    "first"~string,\n    "a"~string,\n    "b"~string,\n    "c"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "8": { name: "e" },
+          "9": { overloadId: ["equals"] },
+          "11": { overloadId: ["conditional"] },
+          "13": { name: "e" },
+          "14": { name: "@__sortBy_input__" },
+          "15": { name: "@__sortBy_input__" },
+          "18": { name: "@result" },
+          "20": { overloadId: ["add_list"] },
+          "21": { name: "@result" },
+          "23": { name: "@__sortBy_input__" },
+          "24": { overloadId: ["list_string_sortByAssociatedKeys"] },
+          "28": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "STRING" },
+          "12": { primitive: "STRING" },
+          "13": { primitive: "STRING" },
+          "14": { listType: { elemType: { primitive: "STRING" } } },
+          "15": { listType: { elemType: { primitive: "STRING" } } },
+          "16": { listType: { elemType: { primitive: "STRING" } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "STRING" } } },
+          "19": { listType: { elemType: { primitive: "STRING" } } },
+          "20": { listType: { elemType: { primitive: "STRING" } } },
+          "21": { listType: { elemType: { primitive: "STRING" } } },
+          "22": { listType: { elemType: { primitive: "STRING" } } },
+          "23": { listType: { elemType: { primitive: "STRING" } } },
+          "24": { listType: { elemType: { primitive: "STRING" } } },
+          "25": { listType: { elemType: { dyn: {} } } },
+          "26": { primitive: "BOOL" },
+          "27": { listType: { elemType: { primitive: "STRING" } } },
+          "28": { primitive: "BOOL" },
+          "29": { listType: { elemType: { primitive: "STRING" } } },
+          "30": { primitive: "STRING" },
+          "31": { primitive: "STRING" },
+          "32": { primitive: "STRING" },
+          "33": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [86],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 11,
+            "5": 16,
+            "7": 32,
+            "8": 35,
+            "9": 37,
+            "10": 40,
+            "11": 48,
+            "12": 50,
+            "13": 55,
+            "14": 31,
+            "15": 31,
+            "16": 31,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 31,
+            "26": 31,
+            "27": 31,
+            "28": 58,
+            "29": 61,
+            "30": 62,
+            "31": 71,
+            "32": 76,
+            "33": 81,
+          },
+          macroCalls: {
+            "27": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { stringValue: "a" } },
+                      { id: "3", constExpr: { stringValue: "c" } },
+                      { id: "4", constExpr: { stringValue: "b" } },
+                      { id: "5", constExpr: { stringValue: "first" } },
+                    ],
+                  },
+                },
+                function: "sortBy",
+                args: [
+                  { id: "7", identExpr: { name: "e" } },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_?_:_",
+                      args: [
+                        {
+                          id: "9",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "8", identExpr: { name: "e" } },
+                              { id: "10", constExpr: { stringValue: "first" } },
+                            ],
+                          },
+                        },
+                        { id: "12", constExpr: { stringValue: "" } },
+                        { id: "13", identExpr: { name: "e" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "28",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "27",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "25", listExpr: {} },
+                  accuVar: "@__sortBy_input__",
+                  accuInit: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "c" } },
+                        { id: "4", constExpr: { stringValue: "b" } },
+                        { id: "5", constExpr: { stringValue: "first" } },
+                      ],
+                    },
+                  },
+                  loopCondition: { id: "26", constExpr: { boolValue: false } },
+                  loopStep: {
+                    id: "14",
+                    identExpr: { name: "@__sortBy_input__" },
+                  },
+                  result: {
+                    id: "24",
+                    callExpr: {
+                      target: {
+                        id: "23",
+                        identExpr: { name: "@__sortBy_input__" },
+                      },
+                      function: "@sortByAssociatedKeys",
+                      args: [
+                        {
+                          id: "22",
+                          comprehensionExpr: {
+                            iterVar: "e",
+                            iterRange: {
+                              id: "15",
+                              identExpr: { name: "@__sortBy_input__" },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "16", listExpr: {} },
+                            loopCondition: {
+                              id: "17",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "20",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "18", identExpr: { name: "@result" } },
+                                  {
+                                    id: "19",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "11",
+                                          callExpr: {
+                                            function: "_?_:_",
+                                            args: [
+                                              {
+                                                id: "9",
+                                                callExpr: {
+                                                  function: "_==_",
+                                                  args: [
+                                                    {
+                                                      id: "8",
+                                                      identExpr: { name: "e" },
+                                                    },
+                                                    {
+                                                      id: "10",
+                                                      constExpr: {
+                                                        stringValue: "first",
+                                                      },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                              {
+                                                id: "12",
+                                                constExpr: { stringValue: "" },
+                                              },
+                                              {
+                                                id: "13",
+                                                identExpr: { name: "e" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "21",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "29",
+                listExpr: {
+                  elements: [
+                    { id: "30", constExpr: { stringValue: "first" } },
+                    { id: "31", constExpr: { stringValue: "a" } },
+                    { id: "32", constExpr: { stringValue: "b" } },
+                    { id: "33", constExpr: { stringValue: "c" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "101", max: "105" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "101",
+    },
+    {
+      original: {
+        expr: "[ExampleType{name: 'foo'}, ExampleType{name: 'bar'}, ExampleType{name: 'baz'}].sortBy(e, e.name) == [ExampleType{name: 'bar'}, ExampleType{name: 'baz'}, ExampleType{name: 'foo'}]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    ExampleType{\n      name:"foo"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    ExampleType{\n      name:"bar"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    ExampleType{\n      name:"baz"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#.sortBy(\n    e^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#.name^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    ExampleType{\n      name:"bar"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    ExampleType{\n      name:"baz"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    ExampleType{\n      name:"foo"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '[ExampleType{name: "foo"}, ExampleType{name: "bar"}, ExampleType{name: "baz"}].sortBy(e, e.name) == [ExampleType{name: "bar"}, ExampleType{name: "baz"}, ExampleType{name: "foo"}]',
+      locationAst:
+        '_==_(\n  [\n    ExampleType{\n      name:"foo"^#4[1,19]#^#3[1,17]#\n    }^#2[1,12]#,\n    ExampleType{\n      name:"bar"^#7[1,45]#^#6[1,43]#\n    }^#5[1,38]#,\n    ExampleType{\n      name:"baz"^#10[1,71]#^#9[1,69]#\n    }^#8[1,64]#\n  ]^#1[1,0]#.sortBy(\n    e^#12[1,86]#,\n    e^#13[1,89]#.name^#14[1,90]#\n  )^#11[1,85]#,\n  [\n    ExampleType{\n      name:"bar"^#19[1,119]#^#18[1,117]#\n    }^#17[1,112]#,\n    ExampleType{\n      name:"baz"^#22[1,145]#^#21[1,143]#\n    }^#20[1,138]#,\n    ExampleType{\n      name:"foo"^#25[1,171]#^#24[1,169]#\n    }^#23[1,164]#\n  ]^#16[1,100]#\n)^#15[1,97]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 17, 18, 1, 17, 1, 18],
+        [4, 19, 24, 1, 19, 1, 24],
+        [5, 38, 39, 1, 38, 1, 39],
+        [6, 43, 44, 1, 43, 1, 44],
+        [7, 45, 50, 1, 45, 1, 50],
+        [8, 64, 65, 1, 64, 1, 65],
+        [9, 69, 70, 1, 69, 1, 70],
+        [10, 71, 76, 1, 71, 1, 76],
+        [11, 85, 86, 1, 85, 1, 86],
+        [12, 86, 87, 1, 86, 1, 87],
+        [13, 89, 90, 1, 89, 1, 90],
+        [14, 90, 91, 1, 90, 1, 91],
+        [15, 97, 99, 1, 97, 1, 99],
+        [16, 100, 101, 1, 100, 1, 101],
+        [17, 112, 113, 1, 112, 1, 113],
+        [18, 117, 118, 1, 117, 1, 118],
+        [19, 119, 124, 1, 119, 1, 124],
+        [20, 138, 139, 1, 138, 1, 139],
+        [21, 143, 144, 1, 143, 1, 144],
+        [22, 145, 150, 1, 145, 1, 150],
+        [23, 164, 165, 1, 164, 1, 165],
+        [24, 169, 170, 1, 169, 1, 170],
+        [25, 171, 176, 1, 171, 1, 176],
+      ],
+      lineOffsets: [179],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            messageName: "ExampleType",
+                            entries: [
+                              {
+                                id: "3",
+                                fieldKey: "name",
+                                value: {
+                                  id: "4",
+                                  constExpr: { stringValue: "foo" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          structExpr: {
+                            messageName: "ExampleType",
+                            entries: [
+                              {
+                                id: "6",
+                                fieldKey: "name",
+                                value: {
+                                  id: "7",
+                                  constExpr: { stringValue: "bar" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "8",
+                          structExpr: {
+                            messageName: "ExampleType",
+                            entries: [
+                              {
+                                id: "9",
+                                fieldKey: "name",
+                                value: {
+                                  id: "10",
+                                  constExpr: { stringValue: "baz" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "12", identExpr: { name: "e" } },
+                    {
+                      id: "14",
+                      selectExpr: {
+                        operand: { id: "13", identExpr: { name: "e" } },
+                        field: "name",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "16",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "17",
+                      structExpr: {
+                        messageName: "ExampleType",
+                        entries: [
+                          {
+                            id: "18",
+                            fieldKey: "name",
+                            value: {
+                              id: "19",
+                              constExpr: { stringValue: "bar" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "20",
+                      structExpr: {
+                        messageName: "ExampleType",
+                        entries: [
+                          {
+                            id: "21",
+                            fieldKey: "name",
+                            value: {
+                              id: "22",
+                              constExpr: { stringValue: "baz" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "23",
+                      structExpr: {
+                        messageName: "ExampleType",
+                        entries: [
+                          {
+                            id: "24",
+                            fieldKey: "name",
+                            value: {
+                              id: "25",
+                              constExpr: { stringValue: "foo" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [179],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 17,
+            "4": 19,
+            "5": 38,
+            "6": 43,
+            "7": 45,
+            "8": 64,
+            "9": 69,
+            "10": 71,
+            "11": 85,
+            "12": 86,
+            "13": 89,
+            "14": 90,
+            "15": 97,
+            "16": 100,
+            "17": 112,
+            "18": 117,
+            "19": 119,
+            "20": 138,
+            "21": 143,
+            "22": 145,
+            "23": 164,
+            "24": 169,
+            "25": 171,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    @__sortBy_input__,\n    // Init\n    [\n      google.expr.proto2.test.ExampleType{\n        name:"foo"~string\n      }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType,\n      google.expr.proto2.test.ExampleType{\n        name:"bar"~string\n      }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType,\n      google.expr.proto2.test.ExampleType{\n        name:"baz"~string\n      }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType\n    ]~list(google.expr.proto2.test.ExampleType),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    @__sortBy_input__~list(google.expr.proto2.test.ExampleType)^@__sortBy_input__,\n    // Result\n    @__sortBy_input__~list(google.expr.proto2.test.ExampleType)^@__sortBy_input__.@sortByAssociatedKeys(\n      __comprehension__(\n        // Variable\n        e,\n        // Target\n        @__sortBy_input__~list(google.expr.proto2.test.ExampleType)^@__sortBy_input__,\n        // Accumulator\n        @result,\n        // Init\n        []~list(string),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(string)^@result,\n          [\n            e~google.expr.proto2.test.ExampleType^e.name~string\n          ]~list(string)\n        )~list(string)^add_list,\n        // Result\n        @result~list(string)^@result)~list(string)\n    )~list(google.expr.proto2.test.ExampleType)^list_string_sortByAssociatedKeys)~list(google.expr.proto2.test.ExampleType),\n  [\n    google.expr.proto2.test.ExampleType{\n      name:"bar"~string\n    }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType,\n    google.expr.proto2.test.ExampleType{\n      name:"baz"~string\n    }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType,\n    google.expr.proto2.test.ExampleType{\n      name:"foo"~string\n    }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType\n  ]~list(google.expr.proto2.test.ExampleType)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "google.expr.proto2.test.ExampleType" },
+          "5": { name: "google.expr.proto2.test.ExampleType" },
+          "8": { name: "google.expr.proto2.test.ExampleType" },
+          "13": { name: "e" },
+          "15": { name: "@__sortBy_input__" },
+          "16": { name: "@__sortBy_input__" },
+          "19": { name: "@result" },
+          "21": { overloadId: ["add_list"] },
+          "22": { name: "@result" },
+          "24": { name: "@__sortBy_input__" },
+          "25": { overloadId: ["list_string_sortByAssociatedKeys"] },
+          "29": { overloadId: ["equals"] },
+          "31": { name: "google.expr.proto2.test.ExampleType" },
+          "34": { name: "google.expr.proto2.test.ExampleType" },
+          "37": { name: "google.expr.proto2.test.ExampleType" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "2": { messageType: "google.expr.proto2.test.ExampleType" },
+          "4": { primitive: "STRING" },
+          "5": { messageType: "google.expr.proto2.test.ExampleType" },
+          "7": { primitive: "STRING" },
+          "8": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { primitive: "STRING" },
+          "13": { messageType: "google.expr.proto2.test.ExampleType" },
+          "14": { primitive: "STRING" },
+          "15": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "16": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "17": { listType: { elemType: { primitive: "STRING" } } },
+          "18": { primitive: "BOOL" },
+          "19": { listType: { elemType: { primitive: "STRING" } } },
+          "20": { listType: { elemType: { primitive: "STRING" } } },
+          "21": { listType: { elemType: { primitive: "STRING" } } },
+          "22": { listType: { elemType: { primitive: "STRING" } } },
+          "23": { listType: { elemType: { primitive: "STRING" } } },
+          "24": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "25": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "26": { listType: { elemType: { dyn: {} } } },
+          "27": { primitive: "BOOL" },
+          "28": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "29": { primitive: "BOOL" },
+          "30": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "31": { messageType: "google.expr.proto2.test.ExampleType" },
+          "33": { primitive: "STRING" },
+          "34": { messageType: "google.expr.proto2.test.ExampleType" },
+          "36": { primitive: "STRING" },
+          "37": { messageType: "google.expr.proto2.test.ExampleType" },
+          "39": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [179],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 17,
+            "4": 19,
+            "5": 38,
+            "6": 43,
+            "7": 45,
+            "8": 64,
+            "9": 69,
+            "10": 71,
+            "12": 86,
+            "13": 89,
+            "14": 90,
+            "15": 85,
+            "16": 85,
+            "17": 85,
+            "18": 85,
+            "19": 85,
+            "20": 85,
+            "21": 85,
+            "22": 85,
+            "23": 85,
+            "24": 85,
+            "25": 85,
+            "26": 85,
+            "27": 85,
+            "28": 85,
+            "29": 97,
+            "30": 100,
+            "31": 112,
+            "32": 117,
+            "33": 119,
+            "34": 138,
+            "35": 143,
+            "36": 145,
+            "37": 164,
+            "38": 169,
+            "39": 171,
+          },
+          macroCalls: {
+            "28": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        structExpr: {
+                          messageName: "google.expr.proto2.test.ExampleType",
+                          entries: [
+                            {
+                              id: "3",
+                              fieldKey: "name",
+                              value: {
+                                id: "4",
+                                constExpr: { stringValue: "foo" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "5",
+                        structExpr: {
+                          messageName: "google.expr.proto2.test.ExampleType",
+                          entries: [
+                            {
+                              id: "6",
+                              fieldKey: "name",
+                              value: {
+                                id: "7",
+                                constExpr: { stringValue: "bar" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "8",
+                        structExpr: {
+                          messageName: "google.expr.proto2.test.ExampleType",
+                          entries: [
+                            {
+                              id: "9",
+                              fieldKey: "name",
+                              value: {
+                                id: "10",
+                                constExpr: { stringValue: "baz" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "sortBy",
+                args: [
+                  { id: "12", identExpr: { name: "e" } },
+                  {
+                    id: "14",
+                    selectExpr: {
+                      operand: { id: "13", identExpr: { name: "e" } },
+                      field: "name",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "29",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "28",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "26", listExpr: {} },
+                  accuVar: "@__sortBy_input__",
+                  accuInit: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            messageName: "google.expr.proto2.test.ExampleType",
+                            entries: [
+                              {
+                                id: "3",
+                                fieldKey: "name",
+                                value: {
+                                  id: "4",
+                                  constExpr: { stringValue: "foo" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          structExpr: {
+                            messageName: "google.expr.proto2.test.ExampleType",
+                            entries: [
+                              {
+                                id: "6",
+                                fieldKey: "name",
+                                value: {
+                                  id: "7",
+                                  constExpr: { stringValue: "bar" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "8",
+                          structExpr: {
+                            messageName: "google.expr.proto2.test.ExampleType",
+                            entries: [
+                              {
+                                id: "9",
+                                fieldKey: "name",
+                                value: {
+                                  id: "10",
+                                  constExpr: { stringValue: "baz" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopCondition: { id: "27", constExpr: { boolValue: false } },
+                  loopStep: {
+                    id: "15",
+                    identExpr: { name: "@__sortBy_input__" },
+                  },
+                  result: {
+                    id: "25",
+                    callExpr: {
+                      target: {
+                        id: "24",
+                        identExpr: { name: "@__sortBy_input__" },
+                      },
+                      function: "@sortByAssociatedKeys",
+                      args: [
+                        {
+                          id: "23",
+                          comprehensionExpr: {
+                            iterVar: "e",
+                            iterRange: {
+                              id: "16",
+                              identExpr: { name: "@__sortBy_input__" },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "17", listExpr: {} },
+                            loopCondition: {
+                              id: "18",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "21",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "19", identExpr: { name: "@result" } },
+                                  {
+                                    id: "20",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "14",
+                                          selectExpr: {
+                                            operand: {
+                                              id: "13",
+                                              identExpr: { name: "e" },
+                                            },
+                                            field: "name",
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "22",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "30",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "31",
+                      structExpr: {
+                        messageName: "google.expr.proto2.test.ExampleType",
+                        entries: [
+                          {
+                            id: "32",
+                            fieldKey: "name",
+                            value: {
+                              id: "33",
+                              constExpr: { stringValue: "bar" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "34",
+                      structExpr: {
+                        messageName: "google.expr.proto2.test.ExampleType",
+                        entries: [
+                          {
+                            id: "35",
+                            fieldKey: "name",
+                            value: {
+                              id: "36",
+                              constExpr: { stringValue: "baz" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "37",
+                      structExpr: {
+                        messageName: "google.expr.proto2.test.ExampleType",
+                        entries: [
+                          {
+                            id: "38",
+                            fieldKey: "name",
+                            value: {
+                              id: "39",
+                              constExpr: { stringValue: "foo" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "327", max: "327" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "327",
+    },
+    {
+      original: {
+        expr: "[].distinct() == []",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  []^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[].distinct() == []",
+      locationAst:
+        "_==_(\n  []^#1[1,0]#.distinct()^#2[1,11]#,\n  []^#4[1,17]#\n)^#3[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "distinct",
+                },
+              },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 11, "3": 14, "4": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  []~list(dyn).distinct()~list(dyn)^list_distinct,\n  []~list(dyn)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["list_distinct"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { listType: { elemType: { dyn: {} } } },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 11, "3": 14, "4": 17 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "distinct",
+                },
+              },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        expr: "[1].distinct() == [1]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1].distinct() == [1]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#\n  ]^#1[1,0]#.distinct()^#3[1,12]#,\n  [\n    1^#6[1,19]#\n  ]^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 1, "3": 12, "4": 15, "5": 18, "6": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int\n  ]~list(int).distinct()~list(int)^list_distinct,\n  [\n    1~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["list_distinct"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 1, "3": 12, "4": 15, "5": 18, "6": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [{ id: "2", constExpr: { int64Value: "1" } }],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[-2, 5, -2, 1, 1, 5, -2, 1].distinct() == [-2, 5, 1]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    -2^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#,\n    -2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#,\n    -2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    -2^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[-2, 5, -2, 1, 1, 5, -2, 1].distinct() == [-2, 5, 1]",
+      locationAst:
+        "_==_(\n  [\n    -2^#2[1,1]#,\n    5^#3[1,5]#,\n    -2^#4[1,8]#,\n    1^#5[1,12]#,\n    1^#6[1,15]#,\n    5^#7[1,18]#,\n    -2^#8[1,21]#,\n    1^#9[1,25]#\n  ]^#1[1,0]#.distinct()^#10[1,36]#,\n  [\n    -2^#13[1,43]#,\n    5^#14[1,47]#,\n    1^#15[1,50]#\n  ]^#12[1,42]#\n)^#11[1,39]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 3, 1, 1, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 10, 1, 8, 1, 10],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 18, 19, 1, 18, 1, 19],
+        [8, 21, 23, 1, 21, 1, 23],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 36, 37, 1, 36, 1, 37],
+        [11, 39, 41, 1, 39, 1, 41],
+        [12, 42, 43, 1, 42, 1, 43],
+        [13, 43, 45, 1, 43, 1, 45],
+        [14, 47, 48, 1, 47, 1, 48],
+        [15, 50, 51, 1, 50, 1, 51],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "-2" } },
+                        { id: "3", constExpr: { int64Value: "5" } },
+                        { id: "4", constExpr: { int64Value: "-2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                        { id: "6", constExpr: { int64Value: "1" } },
+                        { id: "7", constExpr: { int64Value: "5" } },
+                        { id: "8", constExpr: { int64Value: "-2" } },
+                        { id: "9", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "12",
+                listExpr: {
+                  elements: [
+                    { id: "13", constExpr: { int64Value: "-2" } },
+                    { id: "14", constExpr: { int64Value: "5" } },
+                    { id: "15", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 15,
+            "7": 18,
+            "8": 21,
+            "9": 25,
+            "10": 36,
+            "11": 39,
+            "12": 42,
+            "13": 43,
+            "14": 47,
+            "15": 50,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    -2~int,\n    5~int,\n    -2~int,\n    1~int,\n    1~int,\n    5~int,\n    -2~int,\n    1~int\n  ]~list(int).distinct()~list(int)^list_distinct,\n  [\n    -2~int,\n    5~int,\n    1~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "10": { overloadId: ["list_distinct"] },
+          "11": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 15,
+            "7": 18,
+            "8": 21,
+            "9": 25,
+            "10": 36,
+            "11": 39,
+            "12": 42,
+            "13": 43,
+            "14": 47,
+            "15": 50,
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "-2" } },
+                        { id: "3", constExpr: { int64Value: "5" } },
+                        { id: "4", constExpr: { int64Value: "-2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                        { id: "6", constExpr: { int64Value: "1" } },
+                        { id: "7", constExpr: { int64Value: "5" } },
+                        { id: "8", constExpr: { int64Value: "-2" } },
+                        { id: "9", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "12",
+                listExpr: {
+                  elements: [
+                    { id: "13", constExpr: { int64Value: "-2" } },
+                    { id: "14", constExpr: { int64Value: "5" } },
+                    { id: "15", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "['c', 'a', 'a', 'b', 'a', 'b', 'c', 'c'].distinct() == ['c', 'a', 'b']",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    "c"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    "c"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '["c", "a", "a", "b", "a", "b", "c", "c"].distinct() == ["c", "a", "b"]',
+      locationAst:
+        '_==_(\n  [\n    "c"^#2[1,1]#,\n    "a"^#3[1,6]#,\n    "a"^#4[1,11]#,\n    "b"^#5[1,16]#,\n    "a"^#6[1,21]#,\n    "b"^#7[1,26]#,\n    "c"^#8[1,31]#,\n    "c"^#9[1,36]#\n  ]^#1[1,0]#.distinct()^#10[1,49]#,\n  [\n    "c"^#13[1,56]#,\n    "a"^#14[1,61]#,\n    "b"^#15[1,66]#\n  ]^#12[1,55]#\n)^#11[1,52]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 11, 14, 1, 11, 1, 14],
+        [5, 16, 19, 1, 16, 1, 19],
+        [6, 21, 24, 1, 21, 1, 24],
+        [7, 26, 29, 1, 26, 1, 29],
+        [8, 31, 34, 1, 31, 1, 34],
+        [9, 36, 39, 1, 36, 1, 39],
+        [10, 49, 50, 1, 49, 1, 50],
+        [11, 52, 54, 1, 52, 1, 54],
+        [12, 55, 56, 1, 55, 1, 56],
+        [13, 56, 59, 1, 56, 1, 59],
+        [14, 61, 64, 1, 61, 1, 64],
+        [15, 66, 69, 1, 66, 1, 69],
+      ],
+      lineOffsets: [71],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "c" } },
+                        { id: "3", constExpr: { stringValue: "a" } },
+                        { id: "4", constExpr: { stringValue: "a" } },
+                        { id: "5", constExpr: { stringValue: "b" } },
+                        { id: "6", constExpr: { stringValue: "a" } },
+                        { id: "7", constExpr: { stringValue: "b" } },
+                        { id: "8", constExpr: { stringValue: "c" } },
+                        { id: "9", constExpr: { stringValue: "c" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "12",
+                listExpr: {
+                  elements: [
+                    { id: "13", constExpr: { stringValue: "c" } },
+                    { id: "14", constExpr: { stringValue: "a" } },
+                    { id: "15", constExpr: { stringValue: "b" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [71],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 11,
+            "5": 16,
+            "6": 21,
+            "7": 26,
+            "8": 31,
+            "9": 36,
+            "10": 49,
+            "11": 52,
+            "12": 55,
+            "13": 56,
+            "14": 61,
+            "15": 66,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "c"~string,\n    "a"~string,\n    "a"~string,\n    "b"~string,\n    "a"~string,\n    "b"~string,\n    "c"~string,\n    "c"~string\n  ]~list(string).distinct()~list(string)^list_distinct,\n  [\n    "c"~string,\n    "a"~string,\n    "b"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "10": { overloadId: ["list_distinct"] },
+          "11": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { listType: { elemType: { primitive: "STRING" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "STRING" } } },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "STRING" },
+          "15": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [71],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 11,
+            "5": 16,
+            "6": 21,
+            "7": 26,
+            "8": 31,
+            "9": 36,
+            "10": 49,
+            "11": 52,
+            "12": 55,
+            "13": 56,
+            "14": 61,
+            "15": 66,
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "c" } },
+                        { id: "3", constExpr: { stringValue: "a" } },
+                        { id: "4", constExpr: { stringValue: "a" } },
+                        { id: "5", constExpr: { stringValue: "b" } },
+                        { id: "6", constExpr: { stringValue: "a" } },
+                        { id: "7", constExpr: { stringValue: "b" } },
+                        { id: "8", constExpr: { stringValue: "c" } },
+                        { id: "9", constExpr: { stringValue: "c" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "12",
+                listExpr: {
+                  elements: [
+                    { id: "13", constExpr: { stringValue: "c" } },
+                    { id: "14", constExpr: { stringValue: "a" } },
+                    { id: "15", constExpr: { stringValue: "b" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: '[1, 2.0, "c", 3, "c", 1].distinct() == [1, 2.0, "c", 3]',
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_DoubleValue#,\n    "c"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#,\n    "c"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_DoubleValue#,\n    "c"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '[1, 2.0, "c", 3, "c", 1].distinct() == [1, 2.0, "c", 3]',
+      locationAst:
+        '_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    "c"^#4[1,9]#,\n    3^#5[1,14]#,\n    "c"^#6[1,17]#,\n    1^#7[1,22]#\n  ]^#1[1,0]#.distinct()^#8[1,33]#,\n  [\n    1^#11[1,40]#,\n    2^#12[1,43]#,\n    "c"^#13[1,48]#,\n    3^#14[1,53]#\n  ]^#10[1,39]#\n)^#9[1,36]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 7, 1, 4, 1, 7],
+        [4, 9, 12, 1, 9, 1, 12],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 17, 20, 1, 17, 1, 20],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 33, 34, 1, 33, 1, 34],
+        [9, 36, 38, 1, 36, 1, 38],
+        [10, 39, 40, 1, 39, 1, 40],
+        [11, 40, 41, 1, 40, 1, 41],
+        [12, 43, 46, 1, 43, 1, 46],
+        [13, 48, 51, 1, 48, 1, 51],
+        [14, 53, 54, 1, 53, 1, 54],
+      ],
+      lineOffsets: [56],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { doubleValue: 2 } },
+                        { id: "4", constExpr: { stringValue: "c" } },
+                        { id: "5", constExpr: { int64Value: "3" } },
+                        { id: "6", constExpr: { stringValue: "c" } },
+                        { id: "7", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { doubleValue: 2 } },
+                    { id: "13", constExpr: { stringValue: "c" } },
+                    { id: "14", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 9,
+            "5": 14,
+            "6": 17,
+            "7": 22,
+            "8": 33,
+            "9": 36,
+            "10": 39,
+            "11": 40,
+            "12": 43,
+            "13": 48,
+            "14": 53,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    1~int,\n    2~double,\n    "c"~string,\n    3~int,\n    "c"~string,\n    1~int\n  ]~list(dyn).distinct()~list(dyn)^list_distinct,\n  [\n    1~int,\n    2~double,\n    "c"~string,\n    3~int\n  ]~list(dyn)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["list_distinct"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { dyn: {} } } },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "DOUBLE" },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 9,
+            "5": 14,
+            "6": 17,
+            "7": 22,
+            "8": 33,
+            "9": 36,
+            "10": 39,
+            "11": 40,
+            "12": 43,
+            "13": 48,
+            "14": 53,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { doubleValue: 2 } },
+                        { id: "4", constExpr: { stringValue: "c" } },
+                        { id: "5", constExpr: { int64Value: "3" } },
+                        { id: "6", constExpr: { stringValue: "c" } },
+                        { id: "7", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { doubleValue: 2 } },
+                    { id: "13", constExpr: { stringValue: "c" } },
+                    { id: "14", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[1, 1.0, 2].distinct() == [1, 2]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_DoubleValue#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 1.0, 2].distinct() == [1, 2]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    1^#3[1,4]#,\n    2^#4[1,9]#\n  ]^#1[1,0]#.distinct()^#5[1,20]#,\n  [\n    1^#8[1,27]#,\n    2^#9[1,30]#\n  ]^#7[1,26]#\n)^#6[1,23]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 7, 1, 4, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 23, 25, 1, 23, 1, 25],
+        [7, 26, 27, 1, 26, 1, 27],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { doubleValue: 1 } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "1" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 9,
+            "5": 20,
+            "6": 23,
+            "7": 26,
+            "8": 27,
+            "9": 30,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    1~double,\n    2~int\n  ]~list(dyn).distinct()~list(dyn)^list_distinct,\n  [\n    1~int,\n    2~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["list_distinct"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "BOOL" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 9,
+            "5": 20,
+            "6": 23,
+            "7": 26,
+            "8": 27,
+            "9": 30,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { doubleValue: 1 } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "1" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "[[1], [1], [2]].distinct() == [[1], [2]]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[[1], [1], [2]].distinct() == [[1], [2]]",
+      locationAst:
+        "_==_(\n  [\n    [\n      1^#3[1,2]#\n    ]^#2[1,1]#,\n    [\n      1^#5[1,7]#\n    ]^#4[1,6]#,\n    [\n      2^#7[1,12]#\n    ]^#6[1,11]#\n  ]^#1[1,0]#.distinct()^#8[1,24]#,\n  [\n    [\n      1^#12[1,32]#\n    ]^#11[1,31]#,\n    [\n      2^#14[1,37]#\n    ]^#13[1,36]#\n  ]^#10[1,30]#\n)^#9[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 27, 29, 1, 27, 1, 29],
+        [10, 30, 31, 1, 30, 1, 31],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 32, 33, 1, 32, 1, 33],
+        [13, 36, 37, 1, 36, 1, 37],
+        [14, 37, 38, 1, 37, 1, 38],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              { id: "5", constExpr: { int64Value: "1" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "6",
+                          listExpr: {
+                            elements: [
+                              { id: "7", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      listExpr: {
+                        elements: [
+                          { id: "12", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "13",
+                      listExpr: {
+                        elements: [
+                          { id: "14", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 6,
+            "5": 7,
+            "6": 11,
+            "7": 12,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+            "11": 31,
+            "12": 32,
+            "13": 36,
+            "14": 37,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    [\n      1~int\n    ]~list(int),\n    [\n      1~int\n    ]~list(int),\n    [\n      2~int\n    ]~list(int)\n  ]~list(list(int)).distinct()~list(list(int))^list_distinct,\n  [\n    [\n      1~int\n    ]~list(int),\n    [\n      2~int\n    ]~list(int)\n  ]~list(list(int))\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["list_distinct"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "9": { primitive: "BOOL" },
+          "10": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 6,
+            "5": 7,
+            "6": 11,
+            "7": 12,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+            "11": 31,
+            "12": 32,
+            "13": 36,
+            "14": 37,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              { id: "5", constExpr: { int64Value: "1" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "6",
+                          listExpr: {
+                            elements: [
+                              { id: "7", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "11",
+                      listExpr: {
+                        elements: [
+                          { id: "12", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "13",
+                      listExpr: {
+                        elements: [
+                          { id: "14", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "72", max: "72" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "72",
+    },
+    {
+      original: {
+        expr: "[ExampleType{name: 'a'}, ExampleType{name: 'b'}, ExampleType{name: 'a'}].distinct() == [ExampleType{name: 'a'}, ExampleType{name: 'b'}]",
+        container: "google.expr.proto2.test",
+        value: { boolValue: true },
+      },
+      section: "TestLists",
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    ExampleType{\n      name:"a"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    ExampleType{\n      name:"b"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    ExampleType{\n      name:"a"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    ExampleType{\n      name:"a"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    ExampleType{\n      name:"b"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '[ExampleType{name: "a"}, ExampleType{name: "b"}, ExampleType{name: "a"}].distinct() == [ExampleType{name: "a"}, ExampleType{name: "b"}]',
+      locationAst:
+        '_==_(\n  [\n    ExampleType{\n      name:"a"^#4[1,19]#^#3[1,17]#\n    }^#2[1,12]#,\n    ExampleType{\n      name:"b"^#7[1,43]#^#6[1,41]#\n    }^#5[1,36]#,\n    ExampleType{\n      name:"a"^#10[1,67]#^#9[1,65]#\n    }^#8[1,60]#\n  ]^#1[1,0]#.distinct()^#11[1,81]#,\n  [\n    ExampleType{\n      name:"a"^#16[1,106]#^#15[1,104]#\n    }^#14[1,99]#,\n    ExampleType{\n      name:"b"^#19[1,130]#^#18[1,128]#\n    }^#17[1,123]#\n  ]^#13[1,87]#\n)^#12[1,84]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 17, 18, 1, 17, 1, 18],
+        [4, 19, 22, 1, 19, 1, 22],
+        [5, 36, 37, 1, 36, 1, 37],
+        [6, 41, 42, 1, 41, 1, 42],
+        [7, 43, 46, 1, 43, 1, 46],
+        [8, 60, 61, 1, 60, 1, 61],
+        [9, 65, 66, 1, 65, 1, 66],
+        [10, 67, 70, 1, 67, 1, 70],
+        [11, 81, 82, 1, 81, 1, 82],
+        [12, 84, 86, 1, 84, 1, 86],
+        [13, 87, 88, 1, 87, 1, 88],
+        [14, 99, 100, 1, 99, 1, 100],
+        [15, 104, 105, 1, 104, 1, 105],
+        [16, 106, 109, 1, 106, 1, 109],
+        [17, 123, 124, 1, 123, 1, 124],
+        [18, 128, 129, 1, 128, 1, 129],
+        [19, 130, 133, 1, 130, 1, 133],
+      ],
+      lineOffsets: [136],
+      parsedExpr: {
+        expr: {
+          id: "12",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            messageName: "ExampleType",
+                            entries: [
+                              {
+                                id: "3",
+                                fieldKey: "name",
+                                value: {
+                                  id: "4",
+                                  constExpr: { stringValue: "a" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          structExpr: {
+                            messageName: "ExampleType",
+                            entries: [
+                              {
+                                id: "6",
+                                fieldKey: "name",
+                                value: {
+                                  id: "7",
+                                  constExpr: { stringValue: "b" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "8",
+                          structExpr: {
+                            messageName: "ExampleType",
+                            entries: [
+                              {
+                                id: "9",
+                                fieldKey: "name",
+                                value: {
+                                  id: "10",
+                                  constExpr: { stringValue: "a" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "13",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "14",
+                      structExpr: {
+                        messageName: "ExampleType",
+                        entries: [
+                          {
+                            id: "15",
+                            fieldKey: "name",
+                            value: {
+                              id: "16",
+                              constExpr: { stringValue: "a" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "17",
+                      structExpr: {
+                        messageName: "ExampleType",
+                        entries: [
+                          {
+                            id: "18",
+                            fieldKey: "name",
+                            value: {
+                              id: "19",
+                              constExpr: { stringValue: "b" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [136],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 17,
+            "4": 19,
+            "5": 36,
+            "6": 41,
+            "7": 43,
+            "8": 60,
+            "9": 65,
+            "10": 67,
+            "11": 81,
+            "12": 84,
+            "13": 87,
+            "14": 99,
+            "15": 104,
+            "16": 106,
+            "17": 123,
+            "18": 128,
+            "19": 130,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    google.expr.proto2.test.ExampleType{\n      name:"a"~string\n    }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType,\n    google.expr.proto2.test.ExampleType{\n      name:"b"~string\n    }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType,\n    google.expr.proto2.test.ExampleType{\n      name:"a"~string\n    }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType\n  ]~list(google.expr.proto2.test.ExampleType).distinct()~list(google.expr.proto2.test.ExampleType)^list_distinct,\n  [\n    google.expr.proto2.test.ExampleType{\n      name:"a"~string\n    }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType,\n    google.expr.proto2.test.ExampleType{\n      name:"b"~string\n    }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType\n  ]~list(google.expr.proto2.test.ExampleType)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "google.expr.proto2.test.ExampleType" },
+          "5": { name: "google.expr.proto2.test.ExampleType" },
+          "8": { name: "google.expr.proto2.test.ExampleType" },
+          "11": { overloadId: ["list_distinct"] },
+          "12": { overloadId: ["equals"] },
+          "14": { name: "google.expr.proto2.test.ExampleType" },
+          "17": { name: "google.expr.proto2.test.ExampleType" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "2": { messageType: "google.expr.proto2.test.ExampleType" },
+          "4": { primitive: "STRING" },
+          "5": { messageType: "google.expr.proto2.test.ExampleType" },
+          "7": { primitive: "STRING" },
+          "8": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { primitive: "STRING" },
+          "11": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "12": { primitive: "BOOL" },
+          "13": {
+            listType: {
+              elemType: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+          "14": { messageType: "google.expr.proto2.test.ExampleType" },
+          "16": { primitive: "STRING" },
+          "17": { messageType: "google.expr.proto2.test.ExampleType" },
+          "19": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [136],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 17,
+            "4": 19,
+            "5": 36,
+            "6": 41,
+            "7": 43,
+            "8": 60,
+            "9": 65,
+            "10": 67,
+            "11": 81,
+            "12": 84,
+            "13": 87,
+            "14": 99,
+            "15": 104,
+            "16": 106,
+            "17": 123,
+            "18": 128,
+            "19": 130,
+          },
+        },
+        expr: {
+          id: "12",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            messageName: "google.expr.proto2.test.ExampleType",
+                            entries: [
+                              {
+                                id: "3",
+                                fieldKey: "name",
+                                value: {
+                                  id: "4",
+                                  constExpr: { stringValue: "a" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          structExpr: {
+                            messageName: "google.expr.proto2.test.ExampleType",
+                            entries: [
+                              {
+                                id: "6",
+                                fieldKey: "name",
+                                value: {
+                                  id: "7",
+                                  constExpr: { stringValue: "b" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "8",
+                          structExpr: {
+                            messageName: "google.expr.proto2.test.ExampleType",
+                            entries: [
+                              {
+                                id: "9",
+                                fieldKey: "name",
+                                value: {
+                                  id: "10",
+                                  constExpr: { stringValue: "a" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "13",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "14",
+                      structExpr: {
+                        messageName: "google.expr.proto2.test.ExampleType",
+                        entries: [
+                          {
+                            id: "15",
+                            fieldKey: "name",
+                            value: {
+                              id: "16",
+                              constExpr: { stringValue: "a" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "17",
+                      structExpr: {
+                        messageName: "google.expr.proto2.test.ExampleType",
+                        entries: [
+                          {
+                            id: "18",
+                            fieldKey: "name",
+                            value: {
+                              id: "19",
+                              constExpr: { stringValue: "b" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "222", max: "222" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "222",
+    },
+    {
+      original: {
+        expr: "dyn({}).flatten()",
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      section: "TestListsRuntimeErrors",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "dyn(\n  {}^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#.flatten()^#*expr.Expr_CallExpr#",
+      unparsed: "dyn({}).flatten()",
+      locationAst: "dyn(\n  {}^#2[1,4]#\n)^#1[1,3]#.flatten()^#3[1,15]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 15, 16, 1, 15, 1, 16],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            target: {
+              id: "1",
+              callExpr: {
+                function: "dyn",
+                args: [{ id: "2", structExpr: {} }],
+              },
+            },
+            function: "flatten",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 3, "2": 4, "3": 15 },
+        },
+      },
+      checkedAst:
+        "dyn(\n  {}~map(dyn, dyn)\n)~dyn^to_dyn.flatten()~list(dyn)^list_flatten",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["list_flatten"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "3": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 3, "2": 4, "3": 15 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            target: {
+              id: "1",
+              callExpr: {
+                function: "dyn",
+                args: [{ id: "2", structExpr: {} }],
+              },
+            },
+            function: "flatten",
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "32", max: "32" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: map(dyn, dyn).flatten()" },
+          ],
+        },
+      },
+      runtimeCost: "32",
+    },
+    {
+      original: {
+        expr: "dyn({}).flatten(0)",
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      section: "TestListsRuntimeErrors",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "dyn(\n  {}^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#.flatten(\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "dyn({}).flatten(0)",
+      locationAst:
+        "dyn(\n  {}^#2[1,4]#\n)^#1[1,3]#.flatten(\n  0^#4[1,16]#\n)^#3[1,15]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            target: {
+              id: "1",
+              callExpr: {
+                function: "dyn",
+                args: [{ id: "2", structExpr: {} }],
+              },
+            },
+            function: "flatten",
+            args: [{ id: "4", constExpr: { int64Value: "0" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 3, "2": 4, "3": 15, "4": 16 },
+        },
+      },
+      checkedAst:
+        "dyn(\n  {}~map(dyn, dyn)\n)~dyn^to_dyn.flatten(\n  0~int\n)~list(dyn)^list_flatten_int",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["to_dyn"] },
+          "3": { overloadId: ["list_flatten_int"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 3, "2": 4, "3": 15, "4": 16 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            target: {
+              id: "1",
+              callExpr: {
+                function: "dyn",
+                args: [{ id: "2", structExpr: {} }],
+              },
+            },
+            function: "flatten",
+            args: [{ id: "4", constExpr: { int64Value: "0" } }],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "32", max: "32" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: map(dyn, dyn).flatten(int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "32",
+    },
+    {
+      original: {
+        expr: "[].flatten(-1)",
+        evalError: { errors: [{ message: "level must be non-negative" }] },
+      },
+      section: "TestListsRuntimeErrors",
+      library: "lists",
+      libraryVersion: 1,
+      ast: "[]^#*expr.Expr_ListExpr#.flatten(\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[].flatten(-1)",
+      locationAst: "[]^#1[1,0]#.flatten(\n  -1^#3[1,11]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", listExpr: {} },
+            function: "flatten",
+            args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 10, "3": 11 },
+        },
+      },
+      checkedAst:
+        "[]~list(dyn).flatten(\n  -1~int\n)~list(dyn)^list_flatten_int",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_flatten_int"] } },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { listType: { elemType: { dyn: {} } } },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 10, "3": 11 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", listExpr: {} },
+            function: "flatten",
+            args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "11", max: "11" },
+      result: {
+        error: { errors: [{ code: 2, message: "level must be non-negative" }] },
+      },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: "[].flatten(dyn('1'))",
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      section: "TestListsRuntimeErrors",
+      library: "lists",
+      libraryVersion: 1,
+      ast: '[]^#*expr.Expr_ListExpr#.flatten(\n  dyn(\n    "1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '[].flatten(dyn("1"))',
+      locationAst:
+        '[]^#1[1,0]#.flatten(\n  dyn(\n    "1"^#4[1,15]#\n  )^#3[1,14]#\n)^#2[1,10]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 18, 1, 15, 1, 18],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", listExpr: {} },
+            function: "flatten",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        '[]~list(dyn).flatten(\n  dyn(\n    "1"~string\n  )~dyn^to_dyn\n)~list(dyn)^list_flatten_int',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["list_flatten_int"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { listType: { elemType: { dyn: {} } } },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", listExpr: {} },
+            function: "flatten",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "12" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: list(dyn).flatten(string)" },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=0/slice-supported=true",
+        expr: "[1, 2, 3, 4, 5].slice(2, 4) == [3, 4]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.slice(\n    2^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4, 5].slice(2, 4) == [3, 4]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#,\n    4^#5[1,10]#,\n    5^#6[1,13]#\n  ]^#1[1,0]#.slice(\n    2^#8[1,22]#,\n    4^#9[1,25]#\n  )^#7[1,21]#,\n  [\n    3^#12[1,32]#,\n    4^#13[1,35]#\n  ]^#11[1,31]#\n)^#10[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 28, 30, 1, 28, 1, 30],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 32, 33, 1, 32, 1, 33],
+        [13, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                        { id: "6", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "3" } },
+                    { id: "13", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/slice-supported=true",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 21,
+            "8": 22,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+            "12": 32,
+            "13": 35,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int,\n    5~int\n  ]~list(int).slice(\n    2~int,\n    4~int\n  )~list(int)^list_slice,\n  [\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["list_slice"] },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 21,
+            "8": 22,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+            "12": 32,
+            "13": 35,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                        { id: "6", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "3" } },
+                    { id: "13", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        name: "version=0/flatten-supported=false",
+        expr: "[[1, 2], [3, 4]].flatten() == [1, 2, 3, 4]",
+      },
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#.flatten()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[[1, 2], [3, 4]].flatten() == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  [\n    [\n      1^#3[1,2]#,\n      2^#4[1,5]#\n    ]^#2[1,1]#,\n    [\n      3^#6[1,10]#,\n      4^#7[1,13]#\n    ]^#5[1,9]#\n  ]^#1[1,0]#.flatten()^#8[1,24]#,\n  [\n    1^#11[1,31]#,\n    2^#12[1,34]#,\n    3^#13[1,37]#,\n    4^#14[1,40]#\n  ]^#10[1,30]#\n)^#9[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 27, 29, 1, 27, 1, 29],
+        [10, 30, 31, 1, 30, 1, 31],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 34, 35, 1, 34, 1, 35],
+        [13, 37, 38, 1, 37, 1, 38],
+        [14, 40, 41, 1, 40, 1, 41],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          listExpr: {
+                            elements: [
+                              { id: "6", constExpr: { int64Value: "3" } },
+                              { id: "7", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    { id: "13", constExpr: { int64Value: "3" } },
+                    { id: "14", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/flatten-supported=false",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "7": 13,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+            "11": 31,
+            "12": 34,
+            "13": 37,
+            "14": 40,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:25: undeclared reference to 'flatten' (in container '')\n | [[1, 2], [3, 4]].flatten() == [1, 2, 3, 4]\n | ........................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'flatten' (in container '')",
+          id: 8,
+          offset: 24,
+          line: 1,
+          column: 24,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/distinct-supported=false",
+        expr: "[1, 2, 2, 1].distinct() == [1, 2]",
+      },
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 2, 1].distinct() == [1, 2]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    2^#4[1,7]#,\n    1^#5[1,10]#\n  ]^#1[1,0]#.distinct()^#6[1,21]#,\n  [\n    1^#9[1,28]#,\n    2^#10[1,31]#\n  ]^#8[1,27]#\n)^#7[1,24]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 26, 1, 24, 1, 26],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "1" } },
+                    { id: "10", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/distinct-supported=false",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 31,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:22: undeclared reference to 'distinct' (in container '')\n | [1, 2, 2, 1].distinct() == [1, 2]\n | .....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'distinct' (in container '')",
+          id: 6,
+          offset: 21,
+          line: 1,
+          column: 21,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/range-supported=false",
+        expr: "lists.range(5) == [0, 1, 2, 3, 4]",
+      },
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  lists^#*expr.Expr_IdentExpr#.range(\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "lists.range(5) == [0, 1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  lists^#1[1,0]#.range(\n    5^#3[1,12]#\n  )^#2[1,11]#,\n  [\n    0^#6[1,19]#,\n    1^#7[1,22]#,\n    2^#8[1,25]#,\n    3^#9[1,28]#,\n    4^#10[1,31]#\n  ]^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 25, 26, 1, 25, 1, 26],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "lists" } },
+                  function: "range",
+                  args: [{ id: "3", constExpr: { int64Value: "5" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "0" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/range-supported=false",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 15,
+            "5": 18,
+            "6": 19,
+            "7": 22,
+            "8": 25,
+            "9": 28,
+            "10": 31,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'lists' (in container '')\n | lists.range(5) == [0, 1, 2, 3, 4]\n | ^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'range' (in container '')\n | lists.range(5) == [0, 1, 2, 3, 4]\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'lists' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'range' (in container '')",
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/reverse-supported=false",
+        expr: "[1, 2, 3].reverse() == [3, 2, 1]",
+      },
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.reverse()^#*expr.Expr_CallExpr#,\n  [\n    3^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3].reverse() == [3, 2, 1]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#.reverse()^#5[1,17]#,\n  [\n    3^#8[1,24]#,\n    2^#9[1,27]#,\n    1^#10[1,30]#\n  ]^#7[1,23]#\n)^#6[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 20, 22, 1, 20, 1, 22],
+        [7, 23, 24, 1, 23, 1, 24],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 27, 28, 1, 27, 1, 28],
+        [10, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "3" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/reverse-supported=false",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 17,
+            "6": 20,
+            "7": 23,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:18: undeclared reference to 'reverse' (in container '')\n | [1, 2, 3].reverse() == [3, 2, 1]\n | .................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'reverse' (in container '')",
+          id: 5,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/sort-supported=false",
+        expr: "[2, 1, 3].sort() == [1, 2, 3]",
+      },
+      library: "lists",
+      libraryVersion: 0,
+      ast: "_==_(\n  [\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.sort()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[2, 1, 3].sort() == [1, 2, 3]",
+      locationAst:
+        "_==_(\n  [\n    2^#2[1,1]#,\n    1^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#.sort()^#5[1,14]#,\n  [\n    1^#8[1,21]#,\n    2^#9[1,24]#,\n    3^#10[1,27]#\n  ]^#7[1,20]#\n)^#6[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 17, 19, 1, 17, 1, 19],
+        [7, 20, 21, 1, 20, 1, 21],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "2" } },
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "1" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/sort-supported=false",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 14,
+            "6": 17,
+            "7": 20,
+            "8": 21,
+            "9": 24,
+            "10": 27,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: undeclared reference to 'sort' (in container '')\n | [2, 1, 3].sort() == [1, 2, 3]\n | ..............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'sort' (in container '')",
+          id: 5,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/sortBy-supported=false",
+        expr: "[{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]",
+      },
+      library: "lists",
+      libraryVersion: 0,
+      ast: '_==_(\n  [\n    {\n      "field"^#*expr.Constant_StringValue#:"lo"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "field"^#*expr.Constant_StringValue#:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#.sortBy(\n    m^#*expr.Expr_IdentExpr#,\n    m^#*expr.Expr_IdentExpr#.field^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    {\n      "field"^#*expr.Constant_StringValue#:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "field"^#*expr.Constant_StringValue#:"lo"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '[{"field": "lo"}, {"field": "hi"}].sortBy(m, m.field) == [{"field": "hi"}, {"field": "lo"}]',
+      locationAst:
+        '_==_(\n  [\n    {\n      "field"^#4[1,2]#:"lo"^#5[1,11]#^#3[1,9]#\n    }^#2[1,1]#,\n    {\n      "field"^#8[1,19]#:"hi"^#9[1,28]#^#7[1,26]#\n    }^#6[1,18]#\n  ]^#1[1,0]#.sortBy(\n    m^#11[1,42]#,\n    m^#12[1,45]#.field^#13[1,46]#\n  )^#10[1,41]#,\n  [\n    {\n      "field"^#18[1,59]#:"hi"^#19[1,68]#^#17[1,66]#\n    }^#16[1,58]#,\n    {\n      "field"^#22[1,76]#:"lo"^#23[1,85]#^#21[1,83]#\n    }^#20[1,75]#\n  ]^#15[1,57]#\n)^#14[1,54]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 2, 9, 1, 2, 1, 9],
+        [5, 11, 15, 1, 11, 1, 15],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 26, 27, 1, 26, 1, 27],
+        [8, 19, 26, 1, 19, 1, 26],
+        [9, 28, 32, 1, 28, 1, 32],
+        [10, 41, 42, 1, 41, 1, 42],
+        [11, 42, 43, 1, 42, 1, 43],
+        [12, 45, 46, 1, 45, 1, 46],
+        [13, 46, 47, 1, 46, 1, 47],
+        [14, 54, 56, 1, 54, 1, 56],
+        [15, 57, 58, 1, 57, 1, 58],
+        [16, 58, 59, 1, 58, 1, 59],
+        [17, 66, 67, 1, 66, 1, 67],
+        [18, 59, 66, 1, 59, 1, 66],
+        [19, 68, 72, 1, 68, 1, 72],
+        [20, 75, 76, 1, 75, 1, 76],
+        [21, 83, 84, 1, 83, 1, 84],
+        [22, 76, 83, 1, 76, 1, 83],
+        [23, 85, 89, 1, 85, 1, 89],
+      ],
+      lineOffsets: [92],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "3",
+                                mapKey: {
+                                  id: "4",
+                                  constExpr: { stringValue: "field" },
+                                },
+                                value: {
+                                  id: "5",
+                                  constExpr: { stringValue: "lo" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "6",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "7",
+                                mapKey: {
+                                  id: "8",
+                                  constExpr: { stringValue: "field" },
+                                },
+                                value: {
+                                  id: "9",
+                                  constExpr: { stringValue: "hi" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "11", identExpr: { name: "m" } },
+                    {
+                      id: "13",
+                      selectExpr: {
+                        operand: { id: "12", identExpr: { name: "m" } },
+                        field: "field",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "16",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "17",
+                            mapKey: {
+                              id: "18",
+                              constExpr: { stringValue: "field" },
+                            },
+                            value: {
+                              id: "19",
+                              constExpr: { stringValue: "hi" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "20",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "21",
+                            mapKey: {
+                              id: "22",
+                              constExpr: { stringValue: "field" },
+                            },
+                            value: {
+                              id: "23",
+                              constExpr: { stringValue: "lo" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/sortBy-supported=false",
+          lineOffsets: [92],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 9,
+            "4": 2,
+            "5": 11,
+            "6": 18,
+            "7": 26,
+            "8": 19,
+            "9": 28,
+            "10": 41,
+            "11": 42,
+            "12": 45,
+            "13": 46,
+            "14": 54,
+            "15": 57,
+            "16": 58,
+            "17": 66,
+            "18": 59,
+            "19": 68,
+            "20": 75,
+            "21": 83,
+            "22": 76,
+            "23": 85,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:42: undeclared reference to 'sortBy' (in container '')\n | [{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]\n | .........................................^\nERROR: \u003cinput\u003e:1:43: undeclared reference to 'm' (in container '')\n | [{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]\n | ..........................................^\nERROR: \u003cinput\u003e:1:46: undeclared reference to 'm' (in container '')\n | [{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]\n | .............................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'sortBy' (in container '')",
+          id: 10,
+          offset: 41,
+          line: 1,
+          column: 41,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 11,
+          offset: 42,
+          line: 1,
+          column: 42,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 12,
+          offset: 45,
+          line: 1,
+          column: 45,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=1/slice-supported=true",
+        expr: "[1, 2, 3, 4, 5].slice(2, 4) == [3, 4]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.slice(\n    2^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4, 5].slice(2, 4) == [3, 4]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#,\n    4^#5[1,10]#,\n    5^#6[1,13]#\n  ]^#1[1,0]#.slice(\n    2^#8[1,22]#,\n    4^#9[1,25]#\n  )^#7[1,21]#,\n  [\n    3^#12[1,32]#,\n    4^#13[1,35]#\n  ]^#11[1,31]#\n)^#10[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 28, 30, 1, 28, 1, 30],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 32, 33, 1, 32, 1, 33],
+        [13, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                        { id: "6", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "3" } },
+                    { id: "13", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/slice-supported=true",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 21,
+            "8": 22,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+            "12": 32,
+            "13": 35,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int,\n    5~int\n  ]~list(int).slice(\n    2~int,\n    4~int\n  )~list(int)^list_slice,\n  [\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["list_slice"] },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 21,
+            "8": 22,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+            "12": 32,
+            "13": 35,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                        { id: "6", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "3" } },
+                    { id: "13", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        name: "version=1/flatten-supported=true",
+        expr: "[[1, 2], [3, 4]].flatten() == [1, 2, 3, 4]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#.flatten()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[[1, 2], [3, 4]].flatten() == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  [\n    [\n      1^#3[1,2]#,\n      2^#4[1,5]#\n    ]^#2[1,1]#,\n    [\n      3^#6[1,10]#,\n      4^#7[1,13]#\n    ]^#5[1,9]#\n  ]^#1[1,0]#.flatten()^#8[1,24]#,\n  [\n    1^#11[1,31]#,\n    2^#12[1,34]#,\n    3^#13[1,37]#,\n    4^#14[1,40]#\n  ]^#10[1,30]#\n)^#9[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 27, 29, 1, 27, 1, 29],
+        [10, 30, 31, 1, 30, 1, 31],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 34, 35, 1, 34, 1, 35],
+        [13, 37, 38, 1, 37, 1, 38],
+        [14, 40, 41, 1, 40, 1, 41],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          listExpr: {
+                            elements: [
+                              { id: "6", constExpr: { int64Value: "3" } },
+                              { id: "7", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    { id: "13", constExpr: { int64Value: "3" } },
+                    { id: "14", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/flatten-supported=true",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "7": 13,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+            "11": 31,
+            "12": 34,
+            "13": 37,
+            "14": 40,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      3~int,\n      4~int\n    ]~list(int)\n  ]~list(list(int)).flatten()~list(int)^list_flatten,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["list_flatten"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "7": 13,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+            "11": 31,
+            "12": 34,
+            "13": 37,
+            "14": 40,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          listExpr: {
+                            elements: [
+                              { id: "6", constExpr: { int64Value: "3" } },
+                              { id: "7", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    { id: "13", constExpr: { int64Value: "3" } },
+                    { id: "14", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "42", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "42",
+    },
+    {
+      original: {
+        name: "version=1/distinct-supported=false",
+        expr: "[1, 2, 2, 1].distinct() == [1, 2]",
+      },
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 2, 1].distinct() == [1, 2]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    2^#4[1,7]#,\n    1^#5[1,10]#\n  ]^#1[1,0]#.distinct()^#6[1,21]#,\n  [\n    1^#9[1,28]#,\n    2^#10[1,31]#\n  ]^#8[1,27]#\n)^#7[1,24]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 26, 1, 24, 1, 26],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "1" } },
+                    { id: "10", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/distinct-supported=false",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 31,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:22: undeclared reference to 'distinct' (in container '')\n | [1, 2, 2, 1].distinct() == [1, 2]\n | .....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'distinct' (in container '')",
+          id: 6,
+          offset: 21,
+          line: 1,
+          column: 21,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=1/range-supported=false",
+        expr: "lists.range(5) == [0, 1, 2, 3, 4]",
+      },
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  lists^#*expr.Expr_IdentExpr#.range(\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "lists.range(5) == [0, 1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  lists^#1[1,0]#.range(\n    5^#3[1,12]#\n  )^#2[1,11]#,\n  [\n    0^#6[1,19]#,\n    1^#7[1,22]#,\n    2^#8[1,25]#,\n    3^#9[1,28]#,\n    4^#10[1,31]#\n  ]^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 25, 26, 1, 25, 1, 26],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "lists" } },
+                  function: "range",
+                  args: [{ id: "3", constExpr: { int64Value: "5" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "0" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/range-supported=false",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 15,
+            "5": 18,
+            "6": 19,
+            "7": 22,
+            "8": 25,
+            "9": 28,
+            "10": 31,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'lists' (in container '')\n | lists.range(5) == [0, 1, 2, 3, 4]\n | ^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'range' (in container '')\n | lists.range(5) == [0, 1, 2, 3, 4]\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'lists' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'range' (in container '')",
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=1/reverse-supported=false",
+        expr: "[1, 2, 3].reverse() == [3, 2, 1]",
+      },
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.reverse()^#*expr.Expr_CallExpr#,\n  [\n    3^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3].reverse() == [3, 2, 1]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#.reverse()^#5[1,17]#,\n  [\n    3^#8[1,24]#,\n    2^#9[1,27]#,\n    1^#10[1,30]#\n  ]^#7[1,23]#\n)^#6[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 20, 22, 1, 20, 1, 22],
+        [7, 23, 24, 1, 23, 1, 24],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 27, 28, 1, 27, 1, 28],
+        [10, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "3" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/reverse-supported=false",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 17,
+            "6": 20,
+            "7": 23,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:18: undeclared reference to 'reverse' (in container '')\n | [1, 2, 3].reverse() == [3, 2, 1]\n | .................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'reverse' (in container '')",
+          id: 5,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=1/sort-supported=false",
+        expr: "[2, 1, 3].sort() == [1, 2, 3]",
+      },
+      library: "lists",
+      libraryVersion: 1,
+      ast: "_==_(\n  [\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.sort()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[2, 1, 3].sort() == [1, 2, 3]",
+      locationAst:
+        "_==_(\n  [\n    2^#2[1,1]#,\n    1^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#.sort()^#5[1,14]#,\n  [\n    1^#8[1,21]#,\n    2^#9[1,24]#,\n    3^#10[1,27]#\n  ]^#7[1,20]#\n)^#6[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 17, 19, 1, 17, 1, 19],
+        [7, 20, 21, 1, 20, 1, 21],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "2" } },
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "1" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/sort-supported=false",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 14,
+            "6": 17,
+            "7": 20,
+            "8": 21,
+            "9": 24,
+            "10": 27,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: undeclared reference to 'sort' (in container '')\n | [2, 1, 3].sort() == [1, 2, 3]\n | ..............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'sort' (in container '')",
+          id: 5,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=1/sortBy-supported=false",
+        expr: "[{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]",
+      },
+      library: "lists",
+      libraryVersion: 1,
+      ast: '_==_(\n  [\n    {\n      "field"^#*expr.Constant_StringValue#:"lo"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "field"^#*expr.Constant_StringValue#:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#.sortBy(\n    m^#*expr.Expr_IdentExpr#,\n    m^#*expr.Expr_IdentExpr#.field^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    {\n      "field"^#*expr.Constant_StringValue#:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "field"^#*expr.Constant_StringValue#:"lo"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '[{"field": "lo"}, {"field": "hi"}].sortBy(m, m.field) == [{"field": "hi"}, {"field": "lo"}]',
+      locationAst:
+        '_==_(\n  [\n    {\n      "field"^#4[1,2]#:"lo"^#5[1,11]#^#3[1,9]#\n    }^#2[1,1]#,\n    {\n      "field"^#8[1,19]#:"hi"^#9[1,28]#^#7[1,26]#\n    }^#6[1,18]#\n  ]^#1[1,0]#.sortBy(\n    m^#11[1,42]#,\n    m^#12[1,45]#.field^#13[1,46]#\n  )^#10[1,41]#,\n  [\n    {\n      "field"^#18[1,59]#:"hi"^#19[1,68]#^#17[1,66]#\n    }^#16[1,58]#,\n    {\n      "field"^#22[1,76]#:"lo"^#23[1,85]#^#21[1,83]#\n    }^#20[1,75]#\n  ]^#15[1,57]#\n)^#14[1,54]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 2, 9, 1, 2, 1, 9],
+        [5, 11, 15, 1, 11, 1, 15],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 26, 27, 1, 26, 1, 27],
+        [8, 19, 26, 1, 19, 1, 26],
+        [9, 28, 32, 1, 28, 1, 32],
+        [10, 41, 42, 1, 41, 1, 42],
+        [11, 42, 43, 1, 42, 1, 43],
+        [12, 45, 46, 1, 45, 1, 46],
+        [13, 46, 47, 1, 46, 1, 47],
+        [14, 54, 56, 1, 54, 1, 56],
+        [15, 57, 58, 1, 57, 1, 58],
+        [16, 58, 59, 1, 58, 1, 59],
+        [17, 66, 67, 1, 66, 1, 67],
+        [18, 59, 66, 1, 59, 1, 66],
+        [19, 68, 72, 1, 68, 1, 72],
+        [20, 75, 76, 1, 75, 1, 76],
+        [21, 83, 84, 1, 83, 1, 84],
+        [22, 76, 83, 1, 76, 1, 83],
+        [23, 85, 89, 1, 85, 1, 89],
+      ],
+      lineOffsets: [92],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "3",
+                                mapKey: {
+                                  id: "4",
+                                  constExpr: { stringValue: "field" },
+                                },
+                                value: {
+                                  id: "5",
+                                  constExpr: { stringValue: "lo" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "6",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "7",
+                                mapKey: {
+                                  id: "8",
+                                  constExpr: { stringValue: "field" },
+                                },
+                                value: {
+                                  id: "9",
+                                  constExpr: { stringValue: "hi" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "11", identExpr: { name: "m" } },
+                    {
+                      id: "13",
+                      selectExpr: {
+                        operand: { id: "12", identExpr: { name: "m" } },
+                        field: "field",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "16",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "17",
+                            mapKey: {
+                              id: "18",
+                              constExpr: { stringValue: "field" },
+                            },
+                            value: {
+                              id: "19",
+                              constExpr: { stringValue: "hi" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "20",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "21",
+                            mapKey: {
+                              id: "22",
+                              constExpr: { stringValue: "field" },
+                            },
+                            value: {
+                              id: "23",
+                              constExpr: { stringValue: "lo" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/sortBy-supported=false",
+          lineOffsets: [92],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 9,
+            "4": 2,
+            "5": 11,
+            "6": 18,
+            "7": 26,
+            "8": 19,
+            "9": 28,
+            "10": 41,
+            "11": 42,
+            "12": 45,
+            "13": 46,
+            "14": 54,
+            "15": 57,
+            "16": 58,
+            "17": 66,
+            "18": 59,
+            "19": 68,
+            "20": 75,
+            "21": 83,
+            "22": 76,
+            "23": 85,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:42: undeclared reference to 'sortBy' (in container '')\n | [{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]\n | .........................................^\nERROR: \u003cinput\u003e:1:43: undeclared reference to 'm' (in container '')\n | [{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]\n | ..........................................^\nERROR: \u003cinput\u003e:1:46: undeclared reference to 'm' (in container '')\n | [{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]\n | .............................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'sortBy' (in container '')",
+          id: 10,
+          offset: 41,
+          line: 1,
+          column: 41,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 11,
+          offset: 42,
+          line: 1,
+          column: 42,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 12,
+          offset: 45,
+          line: 1,
+          column: 45,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=2/slice-supported=true",
+        expr: "[1, 2, 3, 4, 5].slice(2, 4) == [3, 4]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.slice(\n    2^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4, 5].slice(2, 4) == [3, 4]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#,\n    4^#5[1,10]#,\n    5^#6[1,13]#\n  ]^#1[1,0]#.slice(\n    2^#8[1,22]#,\n    4^#9[1,25]#\n  )^#7[1,21]#,\n  [\n    3^#12[1,32]#,\n    4^#13[1,35]#\n  ]^#11[1,31]#\n)^#10[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 28, 30, 1, 28, 1, 30],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 32, 33, 1, 32, 1, 33],
+        [13, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                        { id: "6", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "3" } },
+                    { id: "13", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/slice-supported=true",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 21,
+            "8": 22,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+            "12": 32,
+            "13": 35,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int,\n    5~int\n  ]~list(int).slice(\n    2~int,\n    4~int\n  )~list(int)^list_slice,\n  [\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["list_slice"] },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 21,
+            "8": 22,
+            "9": 25,
+            "10": 28,
+            "11": 31,
+            "12": 32,
+            "13": 35,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                        { id: "5", constExpr: { int64Value: "4" } },
+                        { id: "6", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                  function: "slice",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { int64Value: "3" } },
+                    { id: "13", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        name: "version=2/flatten-supported=true",
+        expr: "[[1, 2], [3, 4]].flatten() == [1, 2, 3, 4]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#.flatten()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[[1, 2], [3, 4]].flatten() == [1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  [\n    [\n      1^#3[1,2]#,\n      2^#4[1,5]#\n    ]^#2[1,1]#,\n    [\n      3^#6[1,10]#,\n      4^#7[1,13]#\n    ]^#5[1,9]#\n  ]^#1[1,0]#.flatten()^#8[1,24]#,\n  [\n    1^#11[1,31]#,\n    2^#12[1,34]#,\n    3^#13[1,37]#,\n    4^#14[1,40]#\n  ]^#10[1,30]#\n)^#9[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 13, 14, 1, 13, 1, 14],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 27, 29, 1, 27, 1, 29],
+        [10, 30, 31, 1, 30, 1, 31],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 34, 35, 1, 34, 1, 35],
+        [13, 37, 38, 1, 37, 1, 38],
+        [14, 40, 41, 1, 40, 1, 41],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          listExpr: {
+                            elements: [
+                              { id: "6", constExpr: { int64Value: "3" } },
+                              { id: "7", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    { id: "13", constExpr: { int64Value: "3" } },
+                    { id: "14", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/flatten-supported=true",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "7": 13,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+            "11": 31,
+            "12": 34,
+            "13": 37,
+            "14": 40,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      3~int,\n      4~int\n    ]~list(int)\n  ]~list(list(int)).flatten()~list(int)^list_flatten,\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "8": { overloadId: ["list_flatten"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "7": 13,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+            "11": 31,
+            "12": 34,
+            "13": 37,
+            "14": 40,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          listExpr: {
+                            elements: [
+                              { id: "3", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        {
+                          id: "5",
+                          listExpr: {
+                            elements: [
+                              { id: "6", constExpr: { int64Value: "3" } },
+                              { id: "7", constExpr: { int64Value: "4" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "flatten",
+                },
+              },
+              {
+                id: "10",
+                listExpr: {
+                  elements: [
+                    { id: "11", constExpr: { int64Value: "1" } },
+                    { id: "12", constExpr: { int64Value: "2" } },
+                    { id: "13", constExpr: { int64Value: "3" } },
+                    { id: "14", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "42", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "42",
+    },
+    {
+      original: {
+        name: "version=2/distinct-supported=true",
+        expr: "[1, 2, 2, 1].distinct() == [1, 2]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.distinct()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 2, 1].distinct() == [1, 2]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    2^#4[1,7]#,\n    1^#5[1,10]#\n  ]^#1[1,0]#.distinct()^#6[1,21]#,\n  [\n    1^#9[1,28]#,\n    2^#10[1,31]#\n  ]^#8[1,27]#\n)^#7[1,24]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 26, 1, 24, 1, 26],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "1" } },
+                    { id: "10", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/distinct-supported=true",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 31,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    2~int,\n    1~int\n  ]~list(int).distinct()~list(int)^list_distinct,\n  [\n    1~int,\n    2~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "6": { overloadId: ["list_distinct"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 10,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 28,
+            "10": 31,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "distinct",
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "1" } },
+                    { id: "10", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        name: "version=2/range-supported=true",
+        expr: "lists.range(5) == [0, 1, 2, 3, 4]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  lists^#*expr.Expr_IdentExpr#.range(\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "lists.range(5) == [0, 1, 2, 3, 4]",
+      locationAst:
+        "_==_(\n  lists^#1[1,0]#.range(\n    5^#3[1,12]#\n  )^#2[1,11]#,\n  [\n    0^#6[1,19]#,\n    1^#7[1,22]#,\n    2^#8[1,25]#,\n    3^#9[1,28]#,\n    4^#10[1,31]#\n  ]^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 25, 26, 1, 25, 1, 26],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "lists" } },
+                  function: "range",
+                  args: [{ id: "3", constExpr: { int64Value: "5" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "0" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/range-supported=true",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 15,
+            "5": 18,
+            "6": 19,
+            "7": 22,
+            "8": 25,
+            "9": 28,
+            "10": 31,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  lists.range(\n    5~int\n  )~list(int)^lists_range,\n  [\n    0~int,\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["lists_range"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 12,
+            "4": 15,
+            "5": 18,
+            "6": 19,
+            "7": 22,
+            "8": 25,
+            "9": 28,
+            "10": 31,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "lists.range",
+                  args: [{ id: "3", constExpr: { int64Value: "5" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "0" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=2/reverse-supported=true",
+        expr: "[1, 2, 3].reverse() == [3, 2, 1]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.reverse()^#*expr.Expr_CallExpr#,\n  [\n    3^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3].reverse() == [3, 2, 1]",
+      locationAst:
+        "_==_(\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#.reverse()^#5[1,17]#,\n  [\n    3^#8[1,24]#,\n    2^#9[1,27]#,\n    1^#10[1,30]#\n  ]^#7[1,23]#\n)^#6[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 20, 22, 1, 20, 1, 22],
+        [7, 23, 24, 1, 23, 1, 24],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 27, 28, 1, 27, 1, 28],
+        [10, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "3" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/reverse-supported=true",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 17,
+            "6": 20,
+            "7": 23,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int).reverse()~list(int)^list_reverse,\n  [\n    3~int,\n    2~int,\n    1~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["list_reverse"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "BOOL" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 17,
+            "6": 20,
+            "7": 23,
+            "8": 24,
+            "9": 27,
+            "10": 30,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "reverse",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "3" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        name: "version=2/sort-supported=true",
+        expr: "[2, 1, 3].sort() == [1, 2, 3]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 2,
+      ast: "_==_(\n  [\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#.sort()^#*expr.Expr_CallExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[2, 1, 3].sort() == [1, 2, 3]",
+      locationAst:
+        "_==_(\n  [\n    2^#2[1,1]#,\n    1^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#.sort()^#5[1,14]#,\n  [\n    1^#8[1,21]#,\n    2^#9[1,24]#,\n    3^#10[1,27]#\n  ]^#7[1,20]#\n)^#6[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 14, 15, 1, 14, 1, 15],
+        [6, 17, 19, 1, 17, 1, 19],
+        [7, 20, 21, 1, 20, 1, 21],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "2" } },
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "1" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/sort-supported=true",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 14,
+            "6": 17,
+            "7": 20,
+            "8": 21,
+            "9": 24,
+            "10": 27,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  [\n    2~int,\n    1~int,\n    3~int\n  ]~list(int).sort()~list(int)^list_int_sort,\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["list_int_sort"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "BOOL" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 14,
+            "6": 17,
+            "7": 20,
+            "8": 21,
+            "9": 24,
+            "10": 27,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { int64Value: "2" } },
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  function: "sort",
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { int64Value: "1" } },
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        name: "version=2/sortBy-supported=true",
+        expr: "[{'field': 'lo'}, {'field': 'hi'}].sortBy(m, m.field) == [{'field': 'hi'}, {'field': 'lo'}]",
+        value: { boolValue: true },
+      },
+      library: "lists",
+      libraryVersion: 2,
+      ast: '_==_(\n  [\n    {\n      "field"^#*expr.Constant_StringValue#:"lo"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "field"^#*expr.Constant_StringValue#:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#.sortBy(\n    m^#*expr.Expr_IdentExpr#,\n    m^#*expr.Expr_IdentExpr#.field^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    {\n      "field"^#*expr.Constant_StringValue#:"hi"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "field"^#*expr.Constant_StringValue#:"lo"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '[{"field": "lo"}, {"field": "hi"}].sortBy(m, m.field) == [{"field": "hi"}, {"field": "lo"}]',
+      locationAst:
+        '_==_(\n  [\n    {\n      "field"^#4[1,2]#:"lo"^#5[1,11]#^#3[1,9]#\n    }^#2[1,1]#,\n    {\n      "field"^#8[1,19]#:"hi"^#9[1,28]#^#7[1,26]#\n    }^#6[1,18]#\n  ]^#1[1,0]#.sortBy(\n    m^#11[1,42]#,\n    m^#12[1,45]#.field^#13[1,46]#\n  )^#10[1,41]#,\n  [\n    {\n      "field"^#18[1,59]#:"hi"^#19[1,68]#^#17[1,66]#\n    }^#16[1,58]#,\n    {\n      "field"^#22[1,76]#:"lo"^#23[1,85]#^#21[1,83]#\n    }^#20[1,75]#\n  ]^#15[1,57]#\n)^#14[1,54]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 2, 9, 1, 2, 1, 9],
+        [5, 11, 15, 1, 11, 1, 15],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 26, 27, 1, 26, 1, 27],
+        [8, 19, 26, 1, 19, 1, 26],
+        [9, 28, 32, 1, 28, 1, 32],
+        [10, 41, 42, 1, 41, 1, 42],
+        [11, 42, 43, 1, 42, 1, 43],
+        [12, 45, 46, 1, 45, 1, 46],
+        [13, 46, 47, 1, 46, 1, 47],
+        [14, 54, 56, 1, 54, 1, 56],
+        [15, 57, 58, 1, 57, 1, 58],
+        [16, 58, 59, 1, 58, 1, 59],
+        [17, 66, 67, 1, 66, 1, 67],
+        [18, 59, 66, 1, 59, 1, 66],
+        [19, 68, 72, 1, 68, 1, 72],
+        [20, 75, 76, 1, 75, 1, 76],
+        [21, 83, 84, 1, 83, 1, 84],
+        [22, 76, 83, 1, 76, 1, 83],
+        [23, 85, 89, 1, 85, 1, 89],
+      ],
+      lineOffsets: [92],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "3",
+                                mapKey: {
+                                  id: "4",
+                                  constExpr: { stringValue: "field" },
+                                },
+                                value: {
+                                  id: "5",
+                                  constExpr: { stringValue: "lo" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "6",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "7",
+                                mapKey: {
+                                  id: "8",
+                                  constExpr: { stringValue: "field" },
+                                },
+                                value: {
+                                  id: "9",
+                                  constExpr: { stringValue: "hi" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  function: "sortBy",
+                  args: [
+                    { id: "11", identExpr: { name: "m" } },
+                    {
+                      id: "13",
+                      selectExpr: {
+                        operand: { id: "12", identExpr: { name: "m" } },
+                        field: "field",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "16",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "17",
+                            mapKey: {
+                              id: "18",
+                              constExpr: { stringValue: "field" },
+                            },
+                            value: {
+                              id: "19",
+                              constExpr: { stringValue: "hi" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "20",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "21",
+                            mapKey: {
+                              id: "22",
+                              constExpr: { stringValue: "field" },
+                            },
+                            value: {
+                              id: "23",
+                              constExpr: { stringValue: "lo" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/sortBy-supported=true",
+          lineOffsets: [92],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 9,
+            "4": 2,
+            "5": 11,
+            "6": 18,
+            "7": 26,
+            "8": 19,
+            "9": 28,
+            "10": 41,
+            "11": 42,
+            "12": 45,
+            "13": 46,
+            "14": 54,
+            "15": 57,
+            "16": 58,
+            "17": 66,
+            "18": 59,
+            "19": 68,
+            "20": 75,
+            "21": 83,
+            "22": 76,
+            "23": 85,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    #unused,\n    // Target\n    []~list(dyn),\n    // Accumulator\n    @__sortBy_input__,\n    // Init\n    [\n      {\n        "field"~string:"lo"~string\n      }~map(string, string),\n      {\n        "field"~string:"hi"~string\n      }~map(string, string)\n    ]~list(map(string, string)),\n    // LoopCondition\n    false~bool,\n    // LoopStep\n    @__sortBy_input__~list(map(string, string))^@__sortBy_input__,\n    // Result\n    @__sortBy_input__~list(map(string, string))^@__sortBy_input__.@sortByAssociatedKeys(\n      __comprehension__(\n        // Variable\n        m,\n        // Target\n        @__sortBy_input__~list(map(string, string))^@__sortBy_input__,\n        // Accumulator\n        @result,\n        // Init\n        []~list(string),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(string)^@result,\n          [\n            m~map(string, string)^m.field~string\n          ]~list(string)\n        )~list(string)^add_list,\n        // Result\n        @result~list(string)^@result)~list(string)\n    )~list(map(string, string))^list_string_sortByAssociatedKeys)~list(map(string, string)),\n  [\n    {\n      "field"~string:"hi"~string\n    }~map(string, string),\n    {\n      "field"~string:"lo"~string\n    }~map(string, string)\n  ]~list(map(string, string))\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "12": { name: "m" },
+          "14": { name: "@__sortBy_input__" },
+          "15": { name: "@__sortBy_input__" },
+          "18": { name: "@result" },
+          "20": { overloadId: ["add_list"] },
+          "21": { name: "@result" },
+          "23": { name: "@__sortBy_input__" },
+          "24": { overloadId: ["list_string_sortByAssociatedKeys"] },
+          "28": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "12": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "13": { primitive: "STRING" },
+          "14": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "15": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "16": { listType: { elemType: { primitive: "STRING" } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "STRING" } } },
+          "19": { listType: { elemType: { primitive: "STRING" } } },
+          "20": { listType: { elemType: { primitive: "STRING" } } },
+          "21": { listType: { elemType: { primitive: "STRING" } } },
+          "22": { listType: { elemType: { primitive: "STRING" } } },
+          "23": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "24": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "25": { listType: { elemType: { dyn: {} } } },
+          "26": { primitive: "BOOL" },
+          "27": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "28": { primitive: "BOOL" },
+          "29": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+          "30": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "32": { primitive: "STRING" },
+          "33": { primitive: "STRING" },
+          "34": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "36": { primitive: "STRING" },
+          "37": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [92],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 9,
+            "4": 2,
+            "5": 11,
+            "6": 18,
+            "7": 26,
+            "8": 19,
+            "9": 28,
+            "11": 42,
+            "12": 45,
+            "13": 46,
+            "14": 41,
+            "15": 41,
+            "16": 41,
+            "17": 41,
+            "18": 41,
+            "19": 41,
+            "20": 41,
+            "21": 41,
+            "22": 41,
+            "23": 41,
+            "24": 41,
+            "25": 41,
+            "26": 41,
+            "27": 41,
+            "28": 54,
+            "29": 57,
+            "30": 58,
+            "31": 66,
+            "32": 59,
+            "33": 68,
+            "34": 75,
+            "35": 83,
+            "36": 76,
+            "37": 85,
+          },
+          macroCalls: {
+            "27": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "3",
+                              mapKey: {
+                                id: "4",
+                                constExpr: { stringValue: "field" },
+                              },
+                              value: {
+                                id: "5",
+                                constExpr: { stringValue: "lo" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "7",
+                              mapKey: {
+                                id: "8",
+                                constExpr: { stringValue: "field" },
+                              },
+                              value: {
+                                id: "9",
+                                constExpr: { stringValue: "hi" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "sortBy",
+                args: [
+                  { id: "11", identExpr: { name: "m" } },
+                  {
+                    id: "13",
+                    selectExpr: {
+                      operand: { id: "12", identExpr: { name: "m" } },
+                      field: "field",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "28",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "27",
+                comprehensionExpr: {
+                  iterVar: "#unused",
+                  iterRange: { id: "25", listExpr: {} },
+                  accuVar: "@__sortBy_input__",
+                  accuInit: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "2",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "3",
+                                mapKey: {
+                                  id: "4",
+                                  constExpr: { stringValue: "field" },
+                                },
+                                value: {
+                                  id: "5",
+                                  constExpr: { stringValue: "lo" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                        {
+                          id: "6",
+                          structExpr: {
+                            entries: [
+                              {
+                                id: "7",
+                                mapKey: {
+                                  id: "8",
+                                  constExpr: { stringValue: "field" },
+                                },
+                                value: {
+                                  id: "9",
+                                  constExpr: { stringValue: "hi" },
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopCondition: { id: "26", constExpr: { boolValue: false } },
+                  loopStep: {
+                    id: "14",
+                    identExpr: { name: "@__sortBy_input__" },
+                  },
+                  result: {
+                    id: "24",
+                    callExpr: {
+                      target: {
+                        id: "23",
+                        identExpr: { name: "@__sortBy_input__" },
+                      },
+                      function: "@sortByAssociatedKeys",
+                      args: [
+                        {
+                          id: "22",
+                          comprehensionExpr: {
+                            iterVar: "m",
+                            iterRange: {
+                              id: "15",
+                              identExpr: { name: "@__sortBy_input__" },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "16", listExpr: {} },
+                            loopCondition: {
+                              id: "17",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "20",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "18", identExpr: { name: "@result" } },
+                                  {
+                                    id: "19",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "13",
+                                          selectExpr: {
+                                            operand: {
+                                              id: "12",
+                                              identExpr: { name: "m" },
+                                            },
+                                            field: "field",
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "21",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              },
+              {
+                id: "29",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "30",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "31",
+                            mapKey: {
+                              id: "32",
+                              constExpr: { stringValue: "field" },
+                            },
+                            value: {
+                              id: "33",
+                              constExpr: { stringValue: "hi" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "34",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "35",
+                            mapKey: {
+                              id: "36",
+                              constExpr: { stringValue: "field" },
+                            },
+                            value: {
+                              id: "37",
+                              constExpr: { stringValue: "lo" },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "193", max: "193" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "193",
+    },
+  ],
+} as const;
@@ -0,0 +1,21340 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/math_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "math",
+  tests: [
+    {
+      original: {
+        expr: "math.least(-0.5) == -0.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    -0.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -0.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(-0.5) == -0.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    -0.5^#3[1,11]#\n  )^#2[1,10]#,\n  -0.5^#5[1,20]#\n)^#4[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [{ id: "3", constExpr: { doubleValue: -0.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -0.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    -0.5~double\n  )~double^math_@min_double,\n  -0.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["math_@min_double"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "3": 11, "4": 10, "5": 17, "6": 20 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [{ id: "3", constExpr: { doubleValue: -0.5 } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.@min",
+                  args: [{ id: "3", constExpr: { doubleValue: -0.5 } }],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: -0.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(-1) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(-1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    -1^#3[1,11]#\n  )^#2[1,10]#,\n  -1^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    -1~int\n  )~int^math_@min_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["math_@min_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "3": 11, "4": 10, "5": 15, "6": 18 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.@min",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(1u) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1u) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1u^#3[1,11]#\n  )^#2[1,10]#,\n  1u^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [{ id: "3", constExpr: { uint64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1u~uint\n  )~uint^math_@min_uint,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["math_@min_uint"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "3": 11, "4": 10, "5": 15, "6": 18 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [{ id: "3", constExpr: { uint64Value: "1" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.@min",
+                  args: [{ id: "3", constExpr: { uint64Value: "1" } }],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(42.0, -0.5) == -0.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    42^#*expr.Constant_DoubleValue#,\n    -0.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -0.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(42.0, -0.5) == -0.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    42^#3[1,11]#,\n    -0.5^#4[1,17]#\n  )^#2[1,10]#,\n  -0.5^#6[1,26]#\n)^#5[1,23]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 17, 21, 1, 17, 1, 21],
+        [5, 23, 25, 1, 23, 1, 25],
+        [6, 26, 30, 1, 26, 1, 30],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 42 } },
+                    { id: "4", constExpr: { doubleValue: -0.5 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: -0.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 23, "6": 26 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    42~double,\n    -0.5~double\n  )~double^math_@min_double_double,\n  -0.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_double_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "3": 11, "4": 17, "5": 10, "6": 23, "7": 26 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 42 } },
+                  { id: "4", constExpr: { doubleValue: -0.5 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 42 } },
+                    { id: "4", constExpr: { doubleValue: -0.5 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: -0.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(-1, 0) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    -1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(-1, 0) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    -1^#3[1,11]#,\n    0^#4[1,15]#\n  )^#2[1,10]#,\n  -1^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    -1~int,\n    0~int\n  )~int^math_@min_int_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_int_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "3": 11, "4": 15, "5": 10, "6": 18, "7": 21 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { int64Value: "-1" } },
+                  { id: "4", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(-1, -1) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    -1^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(-1, -1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    -1^#3[1,11]#,\n    -1^#4[1,15]#\n  )^#2[1,10]#,\n  -1^#6[1,22]#\n)^#5[1,19]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 24, 1, 22, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 19, "6": 22 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    -1~int,\n    -1~int\n  )~int^math_@min_int_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_int_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "3": 11, "4": 15, "5": 10, "6": 19, "7": 22 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { int64Value: "-1" } },
+                  { id: "4", constExpr: { int64Value: "-1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(1u, 42u) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1u^#*expr.Constant_Uint64Value#,\n    42u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1u, 42u) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1u^#3[1,11]#,\n    42u^#4[1,15]#\n  )^#2[1,10]#,\n  1u^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 18, 1, 15, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "42" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1u~uint,\n    42u~uint\n  )~uint^math_@min_uint_uint,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_uint_uint"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "UINT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 11, "4": 15, "5": 10, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  { id: "4", constExpr: { uint64Value: "42" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "42" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(42.0, -0.5, -0.25) == -0.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    42^#*expr.Constant_DoubleValue#,\n    -0.5^#*expr.Constant_DoubleValue#,\n    -0.25^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -0.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(42.0, -0.5, -0.25) == -0.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    42^#3[1,11]#,\n    -0.5^#4[1,17]#,\n    -0.25^#5[1,23]#\n  )^#2[1,10]#,\n  -0.5^#7[1,33]#\n)^#6[1,30]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 17, 21, 1, 17, 1, 21],
+        [5, 23, 28, 1, 23, 1, 28],
+        [6, 30, 32, 1, 30, 1, 32],
+        [7, 33, 37, 1, 33, 1, 37],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 42 } },
+                    { id: "4", constExpr: { doubleValue: -0.5 } },
+                    { id: "5", constExpr: { doubleValue: -0.25 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: -0.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 17,
+            "5": 23,
+            "6": 30,
+            "7": 33,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    [\n      42~double,\n      -0.5~double,\n      -0.25~double\n    ]~list(double)\n  )~double^math_@min_list_double,\n  -0.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@min_list_double"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "3": 11,
+            "4": 17,
+            "5": 23,
+            "6": 10,
+            "7": 10,
+            "8": 30,
+            "9": 33,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 42 } },
+                  { id: "4", constExpr: { doubleValue: -0.5 } },
+                  { id: "5", constExpr: { doubleValue: -0.25 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { doubleValue: 42 } },
+                          { id: "4", constExpr: { doubleValue: -0.5 } },
+                          { id: "5", constExpr: { doubleValue: -0.25 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { doubleValue: -0.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.least(-1, 0, 1) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    -1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(-1, 0, 1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    -1^#3[1,11]#,\n    0^#4[1,15]#,\n    1^#5[1,18]#\n  )^#2[1,10]#,\n  -1^#7[1,24]#\n)^#6[1,21]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 21, 23, 1, 21, 1, 23],
+        [7, 24, 26, 1, 24, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 15,
+            "5": 18,
+            "6": 21,
+            "7": 24,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    [\n      -1~int,\n      0~int,\n      1~int\n    ]~list(int)\n  )~int^math_@min_list_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@min_list_int"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "3": 11,
+            "4": 15,
+            "5": 18,
+            "6": 10,
+            "7": 10,
+            "8": 21,
+            "9": 24,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { int64Value: "-1" } },
+                  { id: "4", constExpr: { int64Value: "0" } },
+                  { id: "5", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "-1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                          { id: "5", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.least(-1, -1, -1) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    -1^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(-1, -1, -1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    -1^#3[1,11]#,\n    -1^#4[1,15]#,\n    -1^#5[1,19]#\n  )^#2[1,10]#,\n  -1^#7[1,26]#\n)^#6[1,23]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 23, 25, 1, 23, 1, 25],
+        [7, 26, 28, 1, 26, 1, 28],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                    { id: "5", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 15,
+            "5": 19,
+            "6": 23,
+            "7": 26,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    [\n      -1~int,\n      -1~int,\n      -1~int\n    ]~list(int)\n  )~int^math_@min_list_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@min_list_int"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "3": 11,
+            "4": 15,
+            "5": 19,
+            "6": 10,
+            "7": 10,
+            "8": 23,
+            "9": 26,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { int64Value: "-1" } },
+                  { id: "4", constExpr: { int64Value: "-1" } },
+                  { id: "5", constExpr: { int64Value: "-1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "-1" } },
+                          { id: "4", constExpr: { int64Value: "-1" } },
+                          { id: "5", constExpr: { int64Value: "-1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.least(1u, 42u, 0u) == 0u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1u^#*expr.Constant_Uint64Value#,\n    42u^#*expr.Constant_Uint64Value#,\n    0u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1u, 42u, 0u) == 0u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1u^#3[1,11]#,\n    42u^#4[1,15]#,\n    0u^#5[1,20]#\n  )^#2[1,10]#,\n  0u^#7[1,27]#\n)^#6[1,24]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 18, 1, 15, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 24, 26, 1, 24, 1, 26],
+        [7, 27, 29, 1, 27, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "42" } },
+                    { id: "5", constExpr: { uint64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 15,
+            "5": 20,
+            "6": 24,
+            "7": 27,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    [\n      1u~uint,\n      42u~uint,\n      0u~uint\n    ]~list(uint)\n  )~uint^math_@min_list_uint,\n  0u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@min_list_uint"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "UINT64" },
+          "6": { listType: { elemType: { primitive: "UINT64" } } },
+          "7": { primitive: "UINT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "3": 11,
+            "4": 15,
+            "5": 20,
+            "6": 10,
+            "7": 10,
+            "8": 24,
+            "9": 27,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  { id: "4", constExpr: { uint64Value: "42" } },
+                  { id: "5", constExpr: { uint64Value: "0" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { uint64Value: "1" } },
+                          { id: "4", constExpr: { uint64Value: "42" } },
+                          { id: "5", constExpr: { uint64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.least(1, 1.0) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1, 1.0) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1^#3[1,11]#,\n    1^#4[1,14]#\n  )^#2[1,10]#,\n  1^#6[1,22]#\n)^#5[1,19]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 17, 1, 14, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 19, "6": 22 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1~int,\n    1~double\n  )~dyn^math_@min_int_double,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_int_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "DOUBLE" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "3": 11, "4": 14, "5": 10, "6": 19, "7": 22 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { doubleValue: 1 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(1, -2.0) == -2.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1^#*expr.Constant_Int64Value#,\n    -2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1, -2.0) == -2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1^#3[1,11]#,\n    -2^#4[1,14]#\n  )^#2[1,10]#,\n  -2^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 18, 1, 14, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { doubleValue: -2 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: -2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1~int,\n    -2~double\n  )~dyn^math_@min_int_double,\n  -2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_int_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "DOUBLE" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "3": 11, "4": 14, "5": 10, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { doubleValue: -2 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { doubleValue: -2 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: -2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(2, 1u) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    2^#*expr.Constant_Int64Value#,\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(2, 1u) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    2^#3[1,11]#,\n    1u^#4[1,14]#\n  )^#2[1,10]#,\n  1u^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    2~int,\n    1u~uint\n  )~dyn^math_@min_int_uint,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_int_uint"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "UINT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "3": 11, "4": 14, "5": 10, "6": 18, "7": 21 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { uint64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(1.5, 2) == 1.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1.5^#*expr.Constant_DoubleValue#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1.5, 2) == 1.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1.5^#3[1,11]#,\n    2^#4[1,16]#\n  )^#2[1,10]#,\n  1.5^#6[1,22]#\n)^#5[1,19]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 25, 1, 22, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1.5 } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: 1.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19, "6": 22 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1.5~double,\n    2~int\n  )~dyn^math_@min_double_int,\n  1.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_double_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 11, "4": 16, "5": 10, "6": 19, "7": 22 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 1.5 } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1.5 } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 1.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(1.5, -2) == -2",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1.5^#*expr.Constant_DoubleValue#,\n    -2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1.5, -2) == -2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1.5^#3[1,11]#,\n    -2^#4[1,16]#\n  )^#2[1,10]#,\n  -2^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1.5 } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1.5~double,\n    -2~int\n  )~dyn^math_@min_double_int,\n  -2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_double_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 11, "4": 16, "5": 10, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 1.5 } },
+                  { id: "4", constExpr: { int64Value: "-2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1.5 } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "-2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(2.5, 1u) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    2.5^#*expr.Constant_DoubleValue#,\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(2.5, 1u) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    2.5^#3[1,11]#,\n    1u^#4[1,16]#\n  )^#2[1,10]#,\n  1u^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.5 } },
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    2.5~double,\n    1u~uint\n  )~dyn^math_@min_double_uint,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_double_uint"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "UINT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 11, "4": 16, "5": 10, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 2.5 } },
+                  { id: "4", constExpr: { uint64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.5 } },
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(1u, 2) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1u^#*expr.Constant_Uint64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1u, 2) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1u^#3[1,11]#,\n    2^#4[1,15]#\n  )^#2[1,10]#,\n  1u^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1u~uint,\n    2~int\n  )~dyn^math_@min_uint_int,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_uint_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "3": 11, "4": 15, "5": 10, "6": 18, "7": 21 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(1u, -2) == -2",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1u^#*expr.Constant_Uint64Value#,\n    -2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1u, -2) == -2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1u^#3[1,11]#,\n    -2^#4[1,15]#\n  )^#2[1,10]#,\n  -2^#6[1,22]#\n)^#5[1,19]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 24, 1, 22, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 19, "6": 22 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1u~uint,\n    -2~int\n  )~dyn^math_@min_uint_int,\n  -2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_uint_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "3": 11, "4": 15, "5": 10, "6": 19, "7": 22 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "-2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "-2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(2u, 2.5) == 2u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    2u^#*expr.Constant_Uint64Value#,\n    2.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(2u, 2.5) == 2u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    2u^#3[1,11]#,\n    2.5^#4[1,15]#\n  )^#2[1,10]#,\n  2u^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 18, 1, 15, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "2" } },
+                    { id: "4", constExpr: { doubleValue: 2.5 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    2u~uint,\n    2.5~double\n  )~dyn^math_@min_uint_double,\n  2u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_uint_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "DOUBLE" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 11, "4": 15, "5": 10, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "2" } },
+                  { id: "4", constExpr: { doubleValue: 2.5 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "2" } },
+                    { id: "4", constExpr: { doubleValue: 2.5 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(1u, dyn(42)) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1u^#*expr.Constant_Uint64Value#,\n    dyn(\n      42^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1u, dyn(42)) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1u^#3[1,11]#,\n    dyn(\n      42^#5[1,19]#\n    )^#4[1,18]#\n  )^#2[1,10]#,\n  1^#7[1,27]#\n)^#6[1,24]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 24, 26, 1, 24, 1, 26],
+        [7, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "5", constExpr: { int64Value: "42" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 18,
+            "5": 19,
+            "6": 24,
+            "7": 27,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    1u~uint,\n    dyn(\n      42~int\n    )~dyn^to_dyn\n  )~dyn^math_@min_uint_double|math_@min_uint_int|math_@min_uint_uint,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["to_dyn"] },
+          "6": {
+            overloadId: [
+              "math_@min_uint_double",
+              "math_@min_uint_int",
+              "math_@min_uint_uint",
+            ],
+          },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { dyn: {} },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "3": 11,
+            "4": 18,
+            "5": 19,
+            "6": 10,
+            "7": 24,
+            "8": 27,
+          },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "5", constExpr: { int64Value: "42" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "5", constExpr: { int64Value: "42" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.least(1u, dyn(42), dyn(0.0)) == 0u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    1u^#*expr.Constant_Uint64Value#,\n    dyn(\n      42^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    dyn(\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1u, dyn(42), dyn(0.0)) == 0u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    1u^#3[1,11]#,\n    dyn(\n      42^#5[1,19]#\n    )^#4[1,18]#,\n    dyn(\n      0^#7[1,28]#\n    )^#6[1,27]#\n  )^#2[1,10]#,\n  0u^#9[1,37]#\n)^#8[1,34]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 27, 28, 1, 27, 1, 28],
+        [7, 28, 31, 1, 28, 1, 31],
+        [8, 34, 36, 1, 34, 1, 36],
+        [9, 37, 39, 1, 37, 1, 39],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "5", constExpr: { int64Value: "42" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "7", constExpr: { doubleValue: 0 } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 18,
+            "5": 19,
+            "6": 27,
+            "7": 28,
+            "8": 34,
+            "9": 37,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    [\n      1u~uint,\n      dyn(\n        42~int\n      )~dyn^to_dyn,\n      dyn(\n        0~double\n      )~dyn^to_dyn\n    ]~list(dyn)\n  )~dyn^math_@min_list_double|math_@min_list_int|math_@min_list_uint,\n  0u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["to_dyn"] },
+          "6": { overloadId: ["to_dyn"] },
+          "9": {
+            overloadId: [
+              "math_@min_list_double",
+              "math_@min_list_int",
+              "math_@min_list_uint",
+            ],
+          },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { dyn: {} },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+          "7": { primitive: "DOUBLE" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "3": 11,
+            "4": 18,
+            "5": 19,
+            "6": 27,
+            "7": 28,
+            "8": 10,
+            "9": 10,
+            "10": 34,
+            "11": 37,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "5", constExpr: { int64Value: "42" } }],
+                    },
+                  },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "7", constExpr: { doubleValue: 0 } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { uint64Value: "1" } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "dyn",
+                              args: [
+                                { id: "5", constExpr: { int64Value: "42" } },
+                              ],
+                            },
+                          },
+                          {
+                            id: "6",
+                            callExpr: {
+                              function: "dyn",
+                              args: [
+                                { id: "7", constExpr: { doubleValue: 0 } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "14", max: "14" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        expr: "math.least([1u, 42u, 0u]) == 0u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    [\n      1u^#*expr.Constant_Uint64Value#,\n      42u^#*expr.Constant_Uint64Value#,\n      0u^#*expr.Constant_Uint64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least([1u, 42u, 0u]) == 0u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    [\n      1u^#4[1,12]#,\n      42u^#5[1,16]#,\n      0u^#6[1,21]#\n    ]^#3[1,11]#\n  )^#2[1,10]#,\n  0u^#8[1,29]#\n)^#7[1,26]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 16, 19, 1, 16, 1, 19],
+        [6, 21, 23, 1, 21, 1, 23],
+        [7, 26, 28, 1, 26, 1, 28],
+        [8, 29, 31, 1, 29, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [
+                          { id: "4", constExpr: { uint64Value: "1" } },
+                          { id: "5", constExpr: { uint64Value: "42" } },
+                          { id: "6", constExpr: { uint64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 12,
+            "5": 16,
+            "6": 21,
+            "7": 26,
+            "8": 29,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    [\n      1u~uint,\n      42u~uint,\n      0u~uint\n    ]~list(uint)\n  )~uint^math_@min_list_uint,\n  0u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@min_list_uint"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { listType: { elemType: { primitive: "UINT64" } } },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "UINT64" },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "UINT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "3": 11,
+            "4": 12,
+            "5": 16,
+            "6": 21,
+            "7": 10,
+            "8": 26,
+            "9": 29,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  {
+                    id: "3",
+                    listExpr: {
+                      elements: [
+                        { id: "4", constExpr: { uint64Value: "1" } },
+                        { id: "5", constExpr: { uint64Value: "42" } },
+                        { id: "6", constExpr: { uint64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [
+                          { id: "4", constExpr: { uint64Value: "1" } },
+                          { id: "5", constExpr: { uint64Value: "42" } },
+                          { id: "6", constExpr: { uint64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.least(a, b) == a",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { int64Value: "1" } },
+          b: { value: { int64Value: "2" } },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(a, b) == a",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    a^#3[1,11]#,\n    b^#4[1,14]#\n  )^#2[1,10]#,\n  a^#6[1,20]#\n)^#5[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 17, 19, 1, 17, 1, 19],
+        [6, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    { id: "4", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              { id: "6", identExpr: { name: "a" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    a~int^a,\n    b~int^b\n  )~int^math_@min_int_int,\n  a~int^a\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": { name: "b" },
+          "5": { overloadId: ["math_@min_int_int"] },
+          "6": { overloadId: ["equals"] },
+          "7": { name: "a" },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "3": 11, "4": 14, "5": 10, "6": 17, "7": 20 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  { id: "4", identExpr: { name: "b" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    { id: "4", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              { id: "7", identExpr: { name: "a" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        expr: "math.least(numbers) == dyn(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { int64Value: "-21" } },
+          numbers: {
+            value: {
+              listValue: {
+                values: [
+                  { doubleValue: -21 },
+                  { doubleValue: -10.5 },
+                  { doubleValue: 1 },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    numbers^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  dyn(\n    a^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(numbers) == dyn(a)",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    numbers^#3[1,11]#\n  )^#2[1,10]#,\n  dyn(\n    a^#6[1,27]#\n  )^#5[1,26]#\n)^#4[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 18, 1, 11, 1, 18],
+        [4, 20, 22, 1, 20, 1, 22],
+        [5, 26, 27, 1, 26, 1, 27],
+        [6, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [{ id: "3", identExpr: { name: "numbers" } }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "6", identExpr: { name: "a" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 20, "5": 26, "6": 27 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    numbers~list(double)^numbers\n  )~double^math_@min_list_double,\n  dyn(\n    a~int^a\n  )~dyn^to_dyn\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "numbers" },
+          "4": { overloadId: ["math_@min_list_double"] },
+          "5": { overloadId: ["equals"] },
+          "6": { overloadId: ["to_dyn"] },
+          "7": { name: "a" },
+        },
+        typeMap: {
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "3": 11, "4": 10, "5": 20, "6": 26, "7": 27 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [{ id: "3", identExpr: { name: "numbers" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.@min",
+                  args: [{ id: "3", identExpr: { name: "numbers" } }],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "7", identExpr: { name: "a" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        expr: "math.greatest(-0.5) == -0.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    -0.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -0.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(-0.5) == -0.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    -0.5^#3[1,14]#\n  )^#2[1,13]#,\n  -0.5^#5[1,23]#\n)^#4[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 18, 1, 14, 1, 18],
+        [4, 20, 22, 1, 20, 1, 22],
+        [5, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [{ id: "3", constExpr: { doubleValue: -0.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -0.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 20, "5": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    -0.5~double\n  )~double^math_@max_double,\n  -0.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["math_@max_double"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "3": 14, "4": 13, "5": 20, "6": 23 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [{ id: "3", constExpr: { doubleValue: -0.5 } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.@max",
+                  args: [{ id: "3", constExpr: { doubleValue: -0.5 } }],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: -0.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(-1) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(-1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    -1^#3[1,14]#\n  )^#2[1,13]#,\n  -1^#5[1,21]#\n)^#4[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    -1~int\n  )~int^math_@max_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["math_@max_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "3": 14, "4": 13, "5": 18, "6": 21 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.@max",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(1u) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1u) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1u^#3[1,14]#\n  )^#2[1,13]#,\n  1u^#5[1,21]#\n)^#4[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [{ id: "3", constExpr: { uint64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1u~uint\n  )~uint^math_@max_uint,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["math_@max_uint"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "3": 14, "4": 13, "5": 18, "6": 21 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [{ id: "3", constExpr: { uint64Value: "1" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.@max",
+                  args: [{ id: "3", constExpr: { uint64Value: "1" } }],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(42.0, -0.5) == 42.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    42^#*expr.Constant_DoubleValue#,\n    -0.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  42^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(42.0, -0.5) == 42.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    42^#3[1,14]#,\n    -0.5^#4[1,20]#\n  )^#2[1,13]#,\n  42^#6[1,29]#\n)^#5[1,26]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 18, 1, 14, 1, 18],
+        [4, 20, 24, 1, 20, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 33, 1, 29, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 42 } },
+                    { id: "4", constExpr: { doubleValue: -0.5 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: 42 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 20, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    42~double,\n    -0.5~double\n  )~double^math_@max_double_double,\n  42~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_double_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "3": 14, "4": 20, "5": 13, "6": 26, "7": 29 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 42 } },
+                  { id: "4", constExpr: { doubleValue: -0.5 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 42 } },
+                    { id: "4", constExpr: { doubleValue: -0.5 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 42 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(-1, 0) == 0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    -1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(-1, 0) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    -1^#3[1,14]#,\n    0^#4[1,18]#\n  )^#2[1,13]#,\n  0^#6[1,24]#\n)^#5[1,21]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 21, 23, 1, 21, 1, 23],
+        [6, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 21, "6": 24 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    -1~int,\n    0~int\n  )~int^math_@max_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_int_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 14, "4": 18, "5": 13, "6": 21, "7": 24 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "-1" } },
+                  { id: "4", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(-1, -1) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    -1^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(-1, -1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    -1^#3[1,14]#,\n    -1^#4[1,18]#\n  )^#2[1,13]#,\n  -1^#6[1,25]#\n)^#5[1,22]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 25, 27, 1, 25, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 22, "6": 25 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    -1~int,\n    -1~int\n  )~int^math_@max_int_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_int_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "3": 14, "4": 18, "5": 13, "6": 22, "7": 25 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "-1" } },
+                  { id: "4", constExpr: { int64Value: "-1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(1u, 42u) == 42u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1u^#*expr.Constant_Uint64Value#,\n    42u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  42u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1u, 42u) == 42u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1u^#3[1,14]#,\n    42u^#4[1,18]#\n  )^#2[1,13]#,\n  42u^#6[1,26]#\n)^#5[1,23]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 21, 1, 18, 1, 21],
+        [5, 23, 25, 1, 23, 1, 25],
+        [6, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "42" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "42" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 23, "6": 26 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1u~uint,\n    42u~uint\n  )~uint^math_@max_uint_uint,\n  42u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_uint_uint"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "UINT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "3": 14, "4": 18, "5": 13, "6": 23, "7": 26 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  { id: "4", constExpr: { uint64Value: "42" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "42" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "42" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(42.0, -0.5, -0.25) == 42.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    42^#*expr.Constant_DoubleValue#,\n    -0.5^#*expr.Constant_DoubleValue#,\n    -0.25^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  42^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(42.0, -0.5, -0.25) == 42.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    42^#3[1,14]#,\n    -0.5^#4[1,20]#,\n    -0.25^#5[1,26]#\n  )^#2[1,13]#,\n  42^#7[1,36]#\n)^#6[1,33]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 18, 1, 14, 1, 18],
+        [4, 20, 24, 1, 20, 1, 24],
+        [5, 26, 31, 1, 26, 1, 31],
+        [6, 33, 35, 1, 33, 1, 35],
+        [7, 36, 40, 1, 36, 1, 40],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 42 } },
+                    { id: "4", constExpr: { doubleValue: -0.5 } },
+                    { id: "5", constExpr: { doubleValue: -0.25 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 42 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 20,
+            "5": 26,
+            "6": 33,
+            "7": 36,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    [\n      42~double,\n      -0.5~double,\n      -0.25~double\n    ]~list(double)\n  )~double^math_@max_list_double,\n  42~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@max_list_double"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 20,
+            "5": 26,
+            "6": 13,
+            "7": 13,
+            "8": 33,
+            "9": 36,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 42 } },
+                  { id: "4", constExpr: { doubleValue: -0.5 } },
+                  { id: "5", constExpr: { doubleValue: -0.25 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { doubleValue: 42 } },
+                          { id: "4", constExpr: { doubleValue: -0.5 } },
+                          { id: "5", constExpr: { doubleValue: -0.25 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { doubleValue: 42 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.greatest(-1, 0, 1) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    -1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(-1, 0, 1) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    -1^#3[1,14]#,\n    0^#4[1,18]#,\n    1^#5[1,21]#\n  )^#2[1,13]#,\n  1^#7[1,27]#\n)^#6[1,24]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 24, 26, 1, 24, 1, 26],
+        [7, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 18,
+            "5": 21,
+            "6": 24,
+            "7": 27,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    [\n      -1~int,\n      0~int,\n      1~int\n    ]~list(int)\n  )~int^math_@max_list_int,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@max_list_int"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 18,
+            "5": 21,
+            "6": 13,
+            "7": 13,
+            "8": 24,
+            "9": 27,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "-1" } },
+                  { id: "4", constExpr: { int64Value: "0" } },
+                  { id: "5", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "-1" } },
+                          { id: "4", constExpr: { int64Value: "0" } },
+                          { id: "5", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.greatest(-1, -1, -1) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    -1^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(-1, -1, -1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    -1^#3[1,14]#,\n    -1^#4[1,18]#,\n    -1^#5[1,22]#\n  )^#2[1,13]#,\n  -1^#7[1,29]#\n)^#6[1,26]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 26, 28, 1, 26, 1, 28],
+        [7, 29, 31, 1, 29, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                    { id: "5", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 18,
+            "5": 22,
+            "6": 26,
+            "7": 29,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    [\n      -1~int,\n      -1~int,\n      -1~int\n    ]~list(int)\n  )~int^math_@max_list_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@max_list_int"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 18,
+            "5": 22,
+            "6": 13,
+            "7": 13,
+            "8": 26,
+            "9": 29,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "-1" } },
+                  { id: "4", constExpr: { int64Value: "-1" } },
+                  { id: "5", constExpr: { int64Value: "-1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { int64Value: "-1" } },
+                          { id: "4", constExpr: { int64Value: "-1" } },
+                          { id: "5", constExpr: { int64Value: "-1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.greatest(1u, 42u, 0u) == 42u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1u^#*expr.Constant_Uint64Value#,\n    42u^#*expr.Constant_Uint64Value#,\n    0u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  42u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1u, 42u, 0u) == 42u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1u^#3[1,14]#,\n    42u^#4[1,18]#,\n    0u^#5[1,23]#\n  )^#2[1,13]#,\n  42u^#7[1,30]#\n)^#6[1,27]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 21, 1, 18, 1, 21],
+        [5, 23, 25, 1, 23, 1, 25],
+        [6, 27, 29, 1, 27, 1, 29],
+        [7, 30, 33, 1, 30, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "42" } },
+                    { id: "5", constExpr: { uint64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "42" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 18,
+            "5": 23,
+            "6": 27,
+            "7": 30,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    [\n      1u~uint,\n      42u~uint,\n      0u~uint\n    ]~list(uint)\n  )~uint^math_@max_list_uint,\n  42u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "7": { overloadId: ["math_@max_list_uint"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "UINT64" },
+          "6": { listType: { elemType: { primitive: "UINT64" } } },
+          "7": { primitive: "UINT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 18,
+            "5": 23,
+            "6": 13,
+            "7": 13,
+            "8": 27,
+            "9": 30,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  { id: "4", constExpr: { uint64Value: "42" } },
+                  { id: "5", constExpr: { uint64Value: "0" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { uint64Value: "1" } },
+                          { id: "4", constExpr: { uint64Value: "42" } },
+                          { id: "5", constExpr: { uint64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { uint64Value: "42" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.greatest(1, 1.0) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1, 1.0) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1^#3[1,14]#,\n    1^#4[1,17]#\n  )^#2[1,13]#,\n  1^#6[1,25]#\n)^#5[1,22]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 20, 1, 17, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 22, "6": 25 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1~int,\n    1~double\n  )~dyn^math_@max_int_double,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_int_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "DOUBLE" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13, "6": 22, "7": 25 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { doubleValue: 1 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(1, -2.0) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1^#*expr.Constant_Int64Value#,\n    -2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1, -2.0) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1^#3[1,14]#,\n    -2^#4[1,17]#\n  )^#2[1,13]#,\n  1^#6[1,26]#\n)^#5[1,23]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 21, 1, 17, 1, 21],
+        [5, 23, 25, 1, 23, 1, 25],
+        [6, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { doubleValue: -2 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 23, "6": 26 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1~int,\n    -2~double\n  )~dyn^math_@max_int_double,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_int_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "DOUBLE" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13, "6": 23, "7": 26 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { doubleValue: -2 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { doubleValue: -2 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(2, 1u) == 2",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    2^#*expr.Constant_Int64Value#,\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(2, 1u) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    2^#3[1,14]#,\n    1u^#4[1,17]#\n  )^#2[1,13]#,\n  2^#6[1,24]#\n)^#5[1,21]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 21, 23, 1, 21, 1, 23],
+        [6, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 21, "6": 24 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    2~int,\n    1u~uint\n  )~dyn^math_@max_int_uint,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_int_uint"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "UINT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13, "6": 21, "7": 24 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { uint64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(1.5, 2) == 2",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1.5^#*expr.Constant_DoubleValue#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1.5, 2) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1.5^#3[1,14]#,\n    2^#4[1,19]#\n  )^#2[1,13]#,\n  2^#6[1,25]#\n)^#5[1,22]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 17, 1, 14, 1, 17],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1.5 } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 19, "5": 22, "6": 25 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1.5~double,\n    2~int\n  )~dyn^math_@max_double_int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_double_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "3": 14, "4": 19, "5": 13, "6": 22, "7": 25 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 1.5 } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1.5 } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(1.5, -2) == 1.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1.5^#*expr.Constant_DoubleValue#,\n    -2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1.5, -2) == 1.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1.5^#3[1,14]#,\n    -2^#4[1,19]#\n  )^#2[1,13]#,\n  1.5^#6[1,26]#\n)^#5[1,23]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 17, 1, 14, 1, 17],
+        [4, 19, 21, 1, 19, 1, 21],
+        [5, 23, 25, 1, 23, 1, 25],
+        [6, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1.5 } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: 1.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 19, "5": 23, "6": 26 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1.5~double,\n    -2~int\n  )~dyn^math_@max_double_int,\n  1.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_double_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "3": 14, "4": 19, "5": 13, "6": 23, "7": 26 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 1.5 } },
+                  { id: "4", constExpr: { int64Value: "-2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1.5 } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 1.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(2.5, 1u) == 2.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    2.5^#*expr.Constant_DoubleValue#,\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  2.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(2.5, 1u) == 2.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    2.5^#3[1,14]#,\n    1u^#4[1,19]#\n  )^#2[1,13]#,\n  2.5^#6[1,26]#\n)^#5[1,23]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 17, 1, 14, 1, 17],
+        [4, 19, 21, 1, 19, 1, 21],
+        [5, 23, 25, 1, 23, 1, 25],
+        [6, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.5 } },
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: 2.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 19, "5": 23, "6": 26 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    2.5~double,\n    1u~uint\n  )~dyn^math_@max_double_uint,\n  2.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_double_uint"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "UINT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "3": 14, "4": 19, "5": 13, "6": 23, "7": 26 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 2.5 } },
+                  { id: "4", constExpr: { uint64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.5 } },
+                    { id: "4", constExpr: { uint64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 2.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(1u, 2) == 2",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1u^#*expr.Constant_Uint64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1u, 2) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1u^#3[1,14]#,\n    2^#4[1,18]#\n  )^#2[1,13]#,\n  2^#6[1,24]#\n)^#5[1,21]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 21, 23, 1, 21, 1, 23],
+        [6, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 21, "6": 24 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1u~uint,\n    2~int\n  )~dyn^math_@max_uint_int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_uint_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 14, "4": 18, "5": 13, "6": 21, "7": 24 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(1u, -2) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1u^#*expr.Constant_Uint64Value#,\n    -2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1u, -2) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1u^#3[1,14]#,\n    -2^#4[1,18]#\n  )^#2[1,13]#,\n  1u^#6[1,25]#\n)^#5[1,22]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 25, 27, 1, 25, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 22, "6": 25 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1u~uint,\n    -2~int\n  )~dyn^math_@max_uint_int,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_uint_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "3": 14, "4": 18, "5": 13, "6": 22, "7": 25 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "-2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(2u, 2.5) == 2.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    2u^#*expr.Constant_Uint64Value#,\n    2.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(2u, 2.5) == 2.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    2u^#3[1,14]#,\n    2.5^#4[1,18]#\n  )^#2[1,13]#,\n  2.5^#6[1,26]#\n)^#5[1,23]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 21, 1, 18, 1, 21],
+        [5, 23, 25, 1, 23, 1, 25],
+        [6, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "2" } },
+                    { id: "4", constExpr: { doubleValue: 2.5 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: 2.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 23, "6": 26 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    2u~uint,\n    2.5~double\n  )~dyn^math_@max_uint_double,\n  2.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_uint_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "DOUBLE" },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "3": 14, "4": 18, "5": 13, "6": 23, "7": 26 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "2" } },
+                  { id: "4", constExpr: { doubleValue: 2.5 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "2" } },
+                    { id: "4", constExpr: { doubleValue: 2.5 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 2.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(1u, dyn(42)) == 42.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1u^#*expr.Constant_Uint64Value#,\n    dyn(\n      42^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  42^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1u, dyn(42)) == 42.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1u^#3[1,14]#,\n    dyn(\n      42^#5[1,22]#\n    )^#4[1,21]#\n  )^#2[1,13]#,\n  42^#7[1,30]#\n)^#6[1,27]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 27, 29, 1, 27, 1, 29],
+        [7, 30, 34, 1, 30, 1, 34],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "5", constExpr: { int64Value: "42" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 42 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 22,
+            "6": 27,
+            "7": 30,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1u~uint,\n    dyn(\n      42~int\n    )~dyn^to_dyn\n  )~dyn^math_@max_uint_double|math_@max_uint_int|math_@max_uint_uint,\n  42~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["to_dyn"] },
+          "6": {
+            overloadId: [
+              "math_@max_uint_double",
+              "math_@max_uint_int",
+              "math_@max_uint_uint",
+            ],
+          },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { dyn: {} },
+          "5": { primitive: "INT64" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 21,
+            "5": 22,
+            "6": 13,
+            "7": 27,
+            "8": 30,
+          },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "5", constExpr: { int64Value: "42" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "5", constExpr: { int64Value: "42" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { doubleValue: 42 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.greatest(1u, dyn(0.0), 0u) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1u^#*expr.Constant_Uint64Value#,\n    dyn(\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#,\n    0u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1u, dyn(0.0), 0u) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1u^#3[1,14]#,\n    dyn(\n      0^#5[1,22]#\n    )^#4[1,21]#,\n    0u^#6[1,28]#\n  )^#2[1,13]#,\n  1^#8[1,35]#\n)^#7[1,32]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 25, 1, 22, 1, 25],
+        [6, 28, 30, 1, 28, 1, 30],
+        [7, 32, 34, 1, 32, 1, 34],
+        [8, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "dyn",
+                        args: [{ id: "5", constExpr: { doubleValue: 0 } }],
+                      },
+                    },
+                    { id: "6", constExpr: { uint64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 22,
+            "6": 28,
+            "7": 32,
+            "8": 35,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    [\n      1u~uint,\n      dyn(\n        0~double\n      )~dyn^to_dyn,\n      0u~uint\n    ]~list(dyn)\n  )~dyn^math_@max_list_double|math_@max_list_int|math_@max_list_uint,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["to_dyn"] },
+          "8": {
+            overloadId: [
+              "math_@max_list_double",
+              "math_@max_list_int",
+              "math_@max_list_uint",
+            ],
+          },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "UINT64" },
+          "4": { dyn: {} },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "UINT64" },
+          "7": { listType: { elemType: { dyn: {} } } },
+          "8": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 21,
+            "5": 22,
+            "6": 28,
+            "7": 13,
+            "8": 13,
+            "9": 32,
+            "10": 35,
+          },
+          macroCalls: {
+            "8": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "5", constExpr: { doubleValue: 0 } }],
+                    },
+                  },
+                  { id: "6", constExpr: { uint64Value: "0" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "3", constExpr: { uint64Value: "1" } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "dyn",
+                              args: [
+                                { id: "5", constExpr: { doubleValue: 0 } },
+                              ],
+                            },
+                          },
+                          { id: "6", constExpr: { uint64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        expr: "math.greatest([1u, dyn(0.0), 0u]) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    [\n      1u^#*expr.Constant_Uint64Value#,\n      dyn(\n        0^#*expr.Constant_DoubleValue#\n      )^#*expr.Expr_CallExpr#,\n      0u^#*expr.Constant_Uint64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest([1u, dyn(0.0), 0u]) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    [\n      1u^#4[1,15]#,\n      dyn(\n        0^#6[1,23]#\n      )^#5[1,22]#,\n      0u^#7[1,29]#\n    ]^#3[1,14]#\n  )^#2[1,13]#,\n  1^#9[1,37]#\n)^#8[1,34]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 22, 23, 1, 22, 1, 23],
+        [6, 23, 26, 1, 23, 1, 26],
+        [7, 29, 31, 1, 29, 1, 31],
+        [8, 34, 36, 1, 34, 1, 36],
+        [9, 37, 38, 1, 37, 1, 38],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [
+                          { id: "4", constExpr: { uint64Value: "1" } },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "dyn",
+                              args: [
+                                { id: "6", constExpr: { doubleValue: 0 } },
+                              ],
+                            },
+                          },
+                          { id: "7", constExpr: { uint64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 22,
+            "6": 23,
+            "7": 29,
+            "8": 34,
+            "9": 37,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    [\n      1u~uint,\n      dyn(\n        0~double\n      )~dyn^to_dyn,\n      0u~uint\n    ]~list(dyn)\n  )~dyn^math_@max_list_double|math_@max_list_int|math_@max_list_uint,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["to_dyn"] },
+          "8": {
+            overloadId: [
+              "math_@max_list_double",
+              "math_@max_list_int",
+              "math_@max_list_uint",
+            ],
+          },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "UINT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "UINT64" },
+          "8": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "3": 14,
+            "4": 15,
+            "5": 22,
+            "6": 23,
+            "7": 29,
+            "8": 13,
+            "9": 34,
+            "10": 37,
+          },
+          macroCalls: {
+            "8": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  {
+                    id: "3",
+                    listExpr: {
+                      elements: [
+                        { id: "4", constExpr: { uint64Value: "1" } },
+                        {
+                          id: "5",
+                          callExpr: {
+                            function: "dyn",
+                            args: [{ id: "6", constExpr: { doubleValue: 0 } }],
+                          },
+                        },
+                        { id: "7", constExpr: { uint64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "8",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [
+                          { id: "4", constExpr: { uint64Value: "1" } },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "dyn",
+                              args: [
+                                { id: "6", constExpr: { doubleValue: 0 } },
+                              ],
+                            },
+                          },
+                          { id: "7", constExpr: { uint64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        expr: "math.greatest(a, b) == b",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { int64Value: "1" } },
+          b: { value: { int64Value: "2" } },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(a, b) == b",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    a^#3[1,14]#,\n    b^#4[1,17]#\n  )^#2[1,13]#,\n  b^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    { id: "4", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              { id: "6", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    a~int^a,\n    b~int^b\n  )~int^math_@max_int_int,\n  b~int^b\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": { name: "b" },
+          "5": { overloadId: ["math_@max_int_int"] },
+          "6": { overloadId: ["equals"] },
+          "7": { name: "b" },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  { id: "4", identExpr: { name: "b" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", identExpr: { name: "a" } },
+                    { id: "4", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              { id: "7", identExpr: { name: "b" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        expr: "math.greatest(numbers) == dyn(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { int64Value: "1" } },
+          numbers: {
+            value: {
+              listValue: {
+                values: [
+                  { doubleValue: -21 },
+                  { doubleValue: -10.5 },
+                  { doubleValue: 1 },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    numbers^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  dyn(\n    a^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(numbers) == dyn(a)",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    numbers^#3[1,14]#\n  )^#2[1,13]#,\n  dyn(\n    a^#6[1,30]#\n  )^#5[1,29]#\n)^#4[1,23]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 29, 30, 1, 29, 1, 30],
+        [6, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [{ id: "3", identExpr: { name: "numbers" } }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "6", identExpr: { name: "a" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 23, "5": 29, "6": 30 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    numbers~list(double)^numbers\n  )~double^math_@max_list_double,\n  dyn(\n    a~int^a\n  )~dyn^to_dyn\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "numbers" },
+          "4": { overloadId: ["math_@max_list_double"] },
+          "5": { overloadId: ["equals"] },
+          "6": { overloadId: ["to_dyn"] },
+          "7": { name: "a" },
+        },
+        typeMap: {
+          "3": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "3": 14, "4": 13, "5": 23, "6": 29, "7": 30 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [{ id: "3", identExpr: { name: "numbers" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.@max",
+                  args: [{ id: "3", identExpr: { name: "numbers" } }],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "7", identExpr: { name: "a" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        expr: "math.bitAnd(1, 2) == 0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitAnd(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitAnd(1, 2) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitAnd(\n    1^#3[1,12]#,\n    2^#4[1,15]#\n  )^#2[1,11]#,\n  0^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitAnd(\n    1~int,\n    2~int\n  )~int^math_bitAnd_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitAnd_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitAnd(1, -1) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitAnd(\n    1^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitAnd(1, -1) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitAnd(\n    1^#3[1,12]#,\n    -1^#4[1,15]#\n  )^#2[1,11]#,\n  1^#6[1,22]#\n)^#5[1,19]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 19, "6": 22 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitAnd(\n    1~int,\n    -1~int\n  )~int^math_bitAnd_int_int,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitAnd_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 19, "6": 22 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitAnd(1, 3) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitAnd(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitAnd(1, 3) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitAnd(\n    1^#3[1,12]#,\n    3^#4[1,15]#\n  )^#2[1,11]#,\n  1^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitAnd(\n    1~int,\n    3~int\n  )~int^math_bitAnd_int_int,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitAnd_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitOr(1, 2) == 3",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitOr(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitOr(1, 2) == 3",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitOr(\n    1^#3[1,11]#,\n    2^#4[1,14]#\n  )^#2[1,10]#,\n  3^#6[1,20]#\n)^#5[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 17, 19, 1, 17, 1, 19],
+        [6, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitOr",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitOr(\n    1~int,\n    2~int\n  )~int^math_bitOr_int_int,\n  3~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitOr_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitOr",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitXor(1, 3) == 2",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitXor(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(1, 3) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitXor(\n    1^#3[1,12]#,\n    3^#4[1,15]#\n  )^#2[1,11]#,\n  2^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitXor(\n    1~int,\n    3~int\n  )~int^math_bitXor_int_int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitXor_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitXor(3, 5) == 6",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitXor(\n    3^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  6^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(3, 5) == 6",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitXor(\n    3^#3[1,12]#,\n    5^#4[1,15]#\n  )^#2[1,11]#,\n  6^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "3" } },
+                    { id: "4", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "6" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitXor(\n    3~int,\n    5~int\n  )~int^math_bitXor_int_int,\n  6~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitXor_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "3" } },
+                    { id: "4", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "6" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitNot(1) == -2",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitNot(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(1) == -2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitNot(\n    1^#3[1,12]#\n  )^#2[1,11]#,\n  -2^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitNot(\n    1~int\n  )~int^math_bitNot_int_int,\n  -2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitNot_int_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitNot(0) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitNot(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(0) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitNot(\n    0^#3[1,12]#\n  )^#2[1,11]#,\n  -1^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitNot(\n    0~int\n  )~int^math_bitNot_int_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitNot_int_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitNot(-1) == 0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitNot(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(-1) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitNot(\n    -1^#3[1,12]#\n  )^#2[1,11]#,\n  0^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitNot(\n    -1~int\n  )~int^math_bitNot_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitNot_int_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftLeft(1, 2) == 4",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(1, 2) == 4",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    1^#3[1,18]#,\n    2^#4[1,21]#\n  )^#2[1,17]#,\n  4^#6[1,27]#\n)^#5[1,24]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 24, 26, 1, 24, 1, 26],
+        [6, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 24, "6": 27 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    1~int,\n    2~int\n  )~int^math_bitShiftLeft_int_int,\n  4~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 24, "6": 27 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftLeft(1, 200) == 0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    1^#*expr.Constant_Int64Value#,\n    200^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(1, 200) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    1^#3[1,18]#,\n    200^#4[1,21]#\n  )^#2[1,17]#,\n  0^#6[1,29]#\n)^#5[1,26]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 21, 24, 1, 21, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 30, 1, 29, 1, 30],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "200" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    1~int,\n    200~int\n  )~int^math_bitShiftLeft_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "200" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftLeft(-1, 200) == 0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    -1^#*expr.Constant_Int64Value#,\n    200^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(-1, 200) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    -1^#3[1,18]#,\n    200^#4[1,22]#\n  )^#2[1,17]#,\n  0^#6[1,30]#\n)^#5[1,27]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 20, 1, 18, 1, 20],
+        [4, 22, 25, 1, 22, 1, 25],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "200" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 27, "6": 30 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    -1~int,\n    200~int\n  )~int^math_bitShiftLeft_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1" } },
+                    { id: "4", constExpr: { int64Value: "200" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(1024, 2) == 256",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    1024^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  256^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(1024, 2) == 256",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    1024^#3[1,19]#,\n    2^#4[1,25]#\n  )^#2[1,18]#,\n  256^#6[1,31]#\n)^#5[1,28]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 23, 1, 19, 1, 23],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 28, 30, 1, 28, 1, 30],
+        [6, 31, 34, 1, 31, 1, 34],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "256" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 25, "5": 28, "6": 31 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    1024~int,\n    2~int\n  )~int^math_bitShiftRight_int_int,\n  256~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 25, "5": 28, "6": 31 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "256" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(1024, 64) == 0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    1024^#*expr.Constant_Int64Value#,\n    64^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(1024, 64) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    1024^#3[1,19]#,\n    64^#4[1,25]#\n  )^#2[1,18]#,\n  0^#6[1,32]#\n)^#5[1,29]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 23, 1, 19, 1, 23],
+        [4, 25, 27, 1, 25, 1, 27],
+        [5, 29, 31, 1, 29, 1, 31],
+        [6, 32, 33, 1, 32, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "64" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 25, "5": 29, "6": 32 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    1024~int,\n    64~int\n  )~int^math_bitShiftRight_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 25, "5": 29, "6": 32 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "64" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(-1024, 3) == 2305843009213693824",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    -1024^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2305843009213693824^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(-1024, 3) == 2305843009213693824",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    -1024^#3[1,19]#,\n    3^#4[1,26]#\n  )^#2[1,18]#,\n  2305843009213693824^#6[1,32]#\n)^#5[1,29]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 24, 1, 19, 1, 24],
+        [4, 26, 27, 1, 26, 1, 27],
+        [5, 29, 31, 1, 29, 1, 31],
+        [6, 32, 51, 1, 32, 1, 51],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1024" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2305843009213693824" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 29, "6": 32 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    -1024~int,\n    3~int\n  )~int^math_bitShiftRight_int_int,\n  2305843009213693824~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 29, "6": 32 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1024" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2305843009213693824" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(-1024, 64) == 0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    -1024^#*expr.Constant_Int64Value#,\n    64^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(-1024, 64) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    -1024^#3[1,19]#,\n    64^#4[1,26]#\n  )^#2[1,18]#,\n  0^#6[1,33]#\n)^#5[1,30]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 24, 1, 19, 1, 24],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 30, 32, 1, 30, 1, 32],
+        [6, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1024" } },
+                    { id: "4", constExpr: { int64Value: "64" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 30, "6": 33 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    -1024~int,\n    64~int\n  )~int^math_bitShiftRight_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 30, "6": 33 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1024" } },
+                    { id: "4", constExpr: { int64Value: "64" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitAnd(1u, 2u) == 0u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitAnd(\n    1u^#*expr.Constant_Uint64Value#,\n    2u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitAnd(1u, 2u) == 0u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitAnd(\n    1u^#3[1,12]#,\n    2u^#4[1,16]#\n  )^#2[1,11]#,\n  0u^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitAnd",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitAnd(\n    1u~uint,\n    2u~uint\n  )~uint^math_bitAnd_uint_uint,\n  0u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitAnd_uint_uint"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 20, "6": 23 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitAnd",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitAnd(1u, 3u) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitAnd(\n    1u^#*expr.Constant_Uint64Value#,\n    3u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitAnd(1u, 3u) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitAnd(\n    1u^#3[1,12]#,\n    3u^#4[1,16]#\n  )^#2[1,11]#,\n  1u^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitAnd",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitAnd(\n    1u~uint,\n    3u~uint\n  )~uint^math_bitAnd_uint_uint,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitAnd_uint_uint"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 20, "6": 23 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitAnd",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitOr(1u, 2u) == 3u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitOr(\n    1u^#*expr.Constant_Uint64Value#,\n    2u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  3u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitOr(1u, 2u) == 3u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitOr(\n    1u^#3[1,11]#,\n    2u^#4[1,15]#\n  )^#2[1,10]#,\n  3u^#6[1,22]#\n)^#5[1,19]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 13, 1, 11, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 24, 1, 22, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitOr",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 19, "6": 22 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitOr(\n    1u~uint,\n    2u~uint\n  )~uint^math_bitOr_uint_uint,\n  3u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitOr_uint_uint"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 15, "5": 19, "6": 22 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitOr",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitXor(1u, 3u) == 2u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitXor(\n    1u^#*expr.Constant_Uint64Value#,\n    3u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  2u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(1u, 3u) == 2u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitXor(\n    1u^#3[1,12]#,\n    3u^#4[1,16]#\n  )^#2[1,11]#,\n  2u^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitXor",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitXor(\n    1u~uint,\n    3u~uint\n  )~uint^math_bitXor_uint_uint,\n  2u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitXor_uint_uint"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 20, "6": 23 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitXor",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { uint64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitXor(3u, 5u) == 6u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitXor(\n    3u^#*expr.Constant_Uint64Value#,\n    5u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  6u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(3u, 5u) == 6u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitXor(\n    3u^#3[1,12]#,\n    5u^#4[1,16]#\n  )^#2[1,11]#,\n  6u^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitXor",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "3" } },
+                    { id: "4", constExpr: { uint64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "6" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitXor(\n    3u~uint,\n    5u~uint\n  )~uint^math_bitXor_uint_uint,\n  6u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitXor_uint_uint"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 20, "6": 23 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitXor",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "3" } },
+                    { id: "4", constExpr: { uint64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "6" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitNot(1u) == 18446744073709551614u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitNot(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  18446744073709551614u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(1u) == 18446744073709551614u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitNot(\n    1u^#3[1,12]#\n  )^#2[1,11]#,\n  18446744073709551614u^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 40, 1, 19, 1, 40],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitNot",
+                  args: [{ id: "3", constExpr: { uint64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "18446744073709551614" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitNot(\n    1u~uint\n  )~uint^math_bitNot_uint_uint,\n  18446744073709551614u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitNot_uint_uint"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitNot",
+                  args: [{ id: "3", constExpr: { uint64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "18446744073709551614" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitNot(0u) == 18446744073709551615u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitNot(\n    0u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  18446744073709551615u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(0u) == 18446744073709551615u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitNot(\n    0u^#3[1,12]#\n  )^#2[1,11]#,\n  18446744073709551615u^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 40, 1, 19, 1, 40],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitNot",
+                  args: [{ id: "3", constExpr: { uint64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "18446744073709551615" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitNot(\n    0u~uint\n  )~uint^math_bitNot_uint_uint,\n  18446744073709551615u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitNot_uint_uint"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitNot",
+                  args: [{ id: "3", constExpr: { uint64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "18446744073709551615" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftLeft(1u, 2) == 4u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    1u^#*expr.Constant_Uint64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  4u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(1u, 2) == 4u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    1u^#3[1,18]#,\n    2^#4[1,22]#\n  )^#2[1,17]#,\n  4u^#6[1,28]#\n)^#5[1,25]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 20, 1, 18, 1, 20],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 25, 27, 1, 25, 1, 27],
+        [6, 28, 30, 1, 28, 1, 30],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 25, "6": 28 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    1u~uint,\n    2~int\n  )~uint^math_bitShiftLeft_uint_int,\n  4u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_uint_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 25, "6": 28 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftLeft(1u, 200) == 0u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    1u^#*expr.Constant_Uint64Value#,\n    200^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(1u, 200) == 0u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    1u^#3[1,18]#,\n    200^#4[1,22]#\n  )^#2[1,17]#,\n  0u^#6[1,30]#\n)^#5[1,27]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 20, 1, 18, 1, 20],
+        [4, 22, 25, 1, 22, 1, 25],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "200" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 27, "6": 30 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    1u~uint,\n    200~int\n  )~uint^math_bitShiftLeft_uint_int,\n  0u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_uint_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "200" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(1024u, 2) == 256u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    1024u^#*expr.Constant_Uint64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  256u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(1024u, 2) == 256u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    1024u^#3[1,19]#,\n    2^#4[1,26]#\n  )^#2[1,18]#,\n  256u^#6[1,32]#\n)^#5[1,29]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 24, 1, 19, 1, 24],
+        [4, 26, 27, 1, 26, 1, 27],
+        [5, 29, 31, 1, 29, 1, 31],
+        [6, 32, 36, 1, 32, 1, 36],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "256" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 29, "6": 32 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    1024u~uint,\n    2~int\n  )~uint^math_bitShiftRight_uint_int,\n  256u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_uint_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 29, "6": 32 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "256" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(1024u, 64) == 0u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    1024u^#*expr.Constant_Uint64Value#,\n    64^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(1024u, 64) == 0u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    1024u^#3[1,19]#,\n    64^#4[1,26]#\n  )^#2[1,18]#,\n  0u^#6[1,33]#\n)^#5[1,30]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 24, 1, 19, 1, 24],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 30, 32, 1, 30, 1, 32],
+        [6, 33, 35, 1, 33, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "64" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 30, "6": 33 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    1024u~uint,\n    64~int\n  )~uint^math_bitShiftRight_uint_int,\n  0u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_uint_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 30, "6": 33 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "64" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.isNaN(0.0/0.0)",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isNaN(\n  _/_(\n    0^#*expr.Constant_DoubleValue#,\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isNaN(0.0 / 0.0)",
+      locationAst:
+        "math^#1[1,0]#.isNaN(\n  _/_(\n    0^#3[1,11]#,\n    0^#5[1,15]#\n  )^#4[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 15, 18, 1, 15, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+      },
+      checkedAst:
+        "math.isNaN(\n  _/_(\n    0~double,\n    0~double\n  )~double^divide_double\n)~bool^math_isNaN_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isNaN_double"] },
+          "4": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "!math.isNaN(1.0/0.0)",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "!_(\n  math^#*expr.Expr_IdentExpr#.isNaN(\n    _/_(\n      1^#*expr.Constant_DoubleValue#,\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!math.isNaN(1.0 / 0.0)",
+      locationAst:
+        "!_(\n  math^#2[1,1]#.isNaN(\n    _/_(\n      1^#4[1,12]#,\n      0^#6[1,16]#\n    )^#5[1,15]#\n  )^#3[1,11]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 12, 15, 1, 12, 1, 15],
+        [5, 15, 16, 1, 15, 1, 16],
+        [6, 16, 19, 1, 16, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "math" } },
+                  function: "isNaN",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "4", constExpr: { doubleValue: 1 } },
+                          { id: "6", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 12, "5": 15, "6": 16 },
+        },
+      },
+      checkedAst:
+        "!_(\n  math.isNaN(\n    _/_(\n      1~double,\n      0~double\n    )~double^divide_double\n  )~bool^math_isNaN_double\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["math_isNaN_double"] },
+          "5": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 12, "5": 15, "6": 16 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "math.isNaN",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "4", constExpr: { doubleValue: 1 } },
+                          { id: "6", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.isFinite(1.0/1.5)",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isFinite(\n  _/_(\n    1^#*expr.Constant_DoubleValue#,\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isFinite(1.0 / 1.5)",
+      locationAst:
+        "math^#1[1,0]#.isFinite(\n  _/_(\n    1^#3[1,14]#,\n    1.5^#5[1,18]#\n  )^#4[1,17]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 17, 1, 14, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isFinite",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1 } },
+                    { id: "5", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 18 },
+        },
+      },
+      checkedAst:
+        "math.isFinite(\n  _/_(\n    1~double,\n    1.5~double\n  )~double^divide_double\n)~bool^math_isFinite_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isFinite_double"] },
+          "4": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 18 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isFinite",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1 } },
+                    { id: "5", constExpr: { doubleValue: 1.5 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "!math.isFinite(1.0/0.0)",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "!_(\n  math^#*expr.Expr_IdentExpr#.isFinite(\n    _/_(\n      1^#*expr.Constant_DoubleValue#,\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!math.isFinite(1.0 / 0.0)",
+      locationAst:
+        "!_(\n  math^#2[1,1]#.isFinite(\n    _/_(\n      1^#4[1,15]#,\n      0^#6[1,19]#\n    )^#5[1,18]#\n  )^#3[1,14]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 18, 1, 15, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "math" } },
+                  function: "isFinite",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "4", constExpr: { doubleValue: 1 } },
+                          { id: "6", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 1, "3": 14, "4": 15, "5": 18, "6": 19 },
+        },
+      },
+      checkedAst:
+        "!_(\n  math.isFinite(\n    _/_(\n      1~double,\n      0~double\n    )~double^divide_double\n  )~bool^math_isFinite_double\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["math_isFinite_double"] },
+          "5": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 1, "3": 14, "4": 15, "5": 18, "6": 19 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "math.isFinite",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "4", constExpr: { doubleValue: 1 } },
+                          { id: "6", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.isInf(1.0/0.0)",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isInf(\n  _/_(\n    1^#*expr.Constant_DoubleValue#,\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isInf(1.0 / 0.0)",
+      locationAst:
+        "math^#1[1,0]#.isInf(\n  _/_(\n    1^#3[1,11]#,\n    0^#5[1,15]#\n  )^#4[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 15, 18, 1, 15, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isInf",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+      },
+      checkedAst:
+        "math.isInf(\n  _/_(\n    1~double,\n    0~double\n  )~double^divide_double\n)~bool^math_isInf_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isInf_double"] },
+          "4": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isInf",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 1 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.ceil(1.2) == 2.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.ceil(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.ceil(1.2) == 2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.ceil(\n    1.2^#3[1,10]#\n  )^#2[1,9]#,\n  2^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.ceil(\n    1.2~double\n  )~double^math_ceil_double,\n  2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_ceil_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.ceil(-1.2) == -1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.ceil(\n    -1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.ceil(-1.2) == -1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.ceil(\n    -1.2^#3[1,10]#\n  )^#2[1,9]#,\n  -1^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 14, 1, 10, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.ceil(\n    -1.2~double\n  )~double^math_ceil_double,\n  -1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_ceil_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.floor(1.2) == 1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.floor(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.floor(1.2) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.floor(\n    1.2^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "floor",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.floor(\n    1.2~double\n  )~double^math_floor_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_floor_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.floor",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.floor(-1.2) == -2.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.floor(\n    -1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.floor(-1.2) == -2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.floor(\n    -1.2^#3[1,11]#\n  )^#2[1,10]#,\n  -2^#5[1,20]#\n)^#4[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "floor",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.floor(\n    -1.2~double\n  )~double^math_floor_double,\n  -2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_floor_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.floor",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.round(1.2) == 1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.round(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.round(1.2) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.round(\n    1.2^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.round(\n    1.2~double\n  )~double^math_round_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_round_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.round(1.5) == 2.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.round(\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.round(1.5) == 2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.round(\n    1.5^#3[1,11]#\n  )^#2[1,10]#,\n  2^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.round(\n    1.5~double\n  )~double^math_round_double,\n  2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_round_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.round(-1.5) == -2.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.round(\n    -1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.round(-1.5) == -2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.round(\n    -1.5^#3[1,11]#\n  )^#2[1,10]#,\n  -2^#5[1,20]#\n)^#4[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "round",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.round(\n    -1.5~double\n  )~double^math_round_double,\n  -2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_round_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.round",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.isNaN(math.round(0.0/0.0))",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isNaN(\n  math^#*expr.Expr_IdentExpr#.round(\n    _/_(\n      0^#*expr.Constant_DoubleValue#,\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isNaN(math.round(0.0 / 0.0))",
+      locationAst:
+        "math^#1[1,0]#.isNaN(\n  math^#3[1,11]#.round(\n    _/_(\n      0^#5[1,22]#,\n      0^#7[1,26]#\n    )^#6[1,25]#\n  )^#4[1,21]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 22, 25, 1, 22, 1, 25],
+        [6, 25, 26, 1, 25, 1, 26],
+        [7, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: { id: "3", identExpr: { name: "math" } },
+                  function: "round",
+                  args: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "5", constExpr: { doubleValue: 0 } },
+                          { id: "7", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 21,
+            "5": 22,
+            "6": 25,
+            "7": 26,
+          },
+        },
+      },
+      checkedAst:
+        "math.isNaN(\n  math.round(\n    _/_(\n      0~double,\n      0~double\n    )~double^divide_double\n  )~double^math_round_double\n)~bool^math_isNaN_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isNaN_double"] },
+          "4": { overloadId: ["math_round_double"] },
+          "6": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 21,
+            "5": 22,
+            "6": 25,
+            "7": 26,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.round",
+                  args: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "5", constExpr: { doubleValue: 0 } },
+                          { id: "7", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.round(-1.2) == -1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.round(\n    -1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.round(-1.2) == -1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.round(\n    -1.2^#3[1,11]#\n  )^#2[1,10]#,\n  -1^#5[1,20]#\n)^#4[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "round",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.round(\n    -1.2~double\n  )~double^math_round_double,\n  -1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_round_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.round",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.trunc(-1.3) == -1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.trunc(\n    -1.3^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.trunc(-1.3) == -1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.trunc(\n    -1.3^#3[1,11]#\n  )^#2[1,10]#,\n  -1^#5[1,20]#\n)^#4[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.3 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.trunc(\n    -1.3~double\n  )~double^math_trunc_double,\n  -1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_trunc_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 17, "5": 20 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: -1.3 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.trunc(1.3) == 1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.trunc(\n    1.3^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.trunc(1.3) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.trunc(\n    1.3^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.3 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.trunc(\n    1.3~double\n  )~double^math_trunc_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_trunc_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.3 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sign(-42) == -1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    -42^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(-42) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    -42^#3[1,10]#\n  )^#2[1,9]#,\n  -1^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { int64Value: "-42" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    -42~int\n  )~int^math_sign_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { int64Value: "-42" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sign(0) == 0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(0) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    0^#3[1,10]#\n  )^#2[1,9]#,\n  0^#5[1,16]#\n)^#4[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    0~int\n  )~int^math_sign_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sign(42) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    42^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(42) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    42^#3[1,10]#\n  )^#2[1,9]#,\n  1^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { int64Value: "42" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    42~int\n  )~int^math_sign_int,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { int64Value: "42" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sign(0u) == 0u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    0u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(0u) == 0u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    0u^#3[1,10]#\n  )^#2[1,9]#,\n  0u^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { uint64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    0u~uint\n  )~uint^math_sign_uint,\n  0u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_uint"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { uint64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sign(42u) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    42u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(42u) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    42u^#3[1,10]#\n  )^#2[1,9]#,\n  1u^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { uint64Value: "42" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    42u~uint\n  )~uint^math_sign_uint,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_uint"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { uint64Value: "42" } }],
+                },
+              },
+              { id: "5", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sign(-0.3) == -1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    -0.3^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(-0.3) == -1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    -0.3^#3[1,10]#\n  )^#2[1,9]#,\n  -1^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 14, 1, 10, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 23, 1, 19, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { doubleValue: -0.3 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    -0.3~double\n  )~double^math_sign_double,\n  -1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { doubleValue: -0.3 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sign(0.0) == 0.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(0.0) == 0.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    0^#3[1,10]#\n  )^#2[1,9]#,\n  0^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { doubleValue: 0 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 0 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    0~double\n  )~double^math_sign_double,\n  0~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { doubleValue: 0 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 0 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.isNaN(math.sign(0.0/0.0))",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isNaN(\n  math^#*expr.Expr_IdentExpr#.sign(\n    _/_(\n      0^#*expr.Constant_DoubleValue#,\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isNaN(math.sign(0.0 / 0.0))",
+      locationAst:
+        "math^#1[1,0]#.isNaN(\n  math^#3[1,11]#.sign(\n    _/_(\n      0^#5[1,21]#,\n      0^#7[1,25]#\n    )^#6[1,24]#\n  )^#4[1,20]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 20, 21, 1, 20, 1, 21],
+        [5, 21, 24, 1, 21, 1, 24],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: { id: "3", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "5", constExpr: { doubleValue: 0 } },
+                          { id: "7", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 20,
+            "5": 21,
+            "6": 24,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "math.isNaN(\n  math.sign(\n    _/_(\n      0~double,\n      0~double\n    )~double^divide_double\n  )~double^math_sign_double\n)~bool^math_isNaN_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isNaN_double"] },
+          "4": { overloadId: ["math_sign_double"] },
+          "6": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 20,
+            "5": 21,
+            "6": 24,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.sign",
+                  args: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "5", constExpr: { doubleValue: 0 } },
+                          { id: "7", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.sign(1.0/0.0) == 1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    _/_(\n      1^#*expr.Constant_DoubleValue#,\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(1.0 / 0.0) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    _/_(\n      1^#3[1,10]#,\n      0^#5[1,14]#\n    )^#4[1,13]#\n  )^#2[1,9]#,\n  1^#7[1,22]#\n)^#6[1,19]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 17, 1, 14, 1, 17],
+        [6, 19, 21, 1, 19, 1, 21],
+        [7, 22, 25, 1, 22, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "3", constExpr: { doubleValue: 1 } },
+                          { id: "5", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 13,
+            "5": 14,
+            "6": 19,
+            "7": 22,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    _/_(\n      1~double,\n      0~double\n    )~double^divide_double\n  )~double^math_sign_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_double"] },
+          "4": { overloadId: ["divide_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 13,
+            "5": 14,
+            "6": 19,
+            "7": 22,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "3", constExpr: { doubleValue: 1 } },
+                          { id: "5", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.sign(-1.0/0.0) == -1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    _/_(\n      -1^#*expr.Constant_DoubleValue#,\n      0^#*expr.Constant_DoubleValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(-1.0 / 0.0) == -1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    _/_(\n      -1^#3[1,10]#,\n      0^#5[1,15]#\n    )^#4[1,14]#\n  )^#2[1,9]#,\n  -1^#7[1,23]#\n)^#6[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 14, 1, 10, 1, 14],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 15, 18, 1, 15, 1, 18],
+        [6, 20, 22, 1, 20, 1, 22],
+        [7, 23, 27, 1, 23, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "3", constExpr: { doubleValue: -1 } },
+                          { id: "5", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 14,
+            "5": 15,
+            "6": 20,
+            "7": 23,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    _/_(\n      -1~double,\n      0~double\n    )~double^divide_double\n  )~double^math_sign_double,\n  -1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_double"] },
+          "4": { overloadId: ["divide_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 14,
+            "5": 15,
+            "6": 20,
+            "7": 23,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "3", constExpr: { doubleValue: -1 } },
+                          { id: "5", constExpr: { doubleValue: 0 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.sign(0.3) == 1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    0.3^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(0.3) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    0.3^#3[1,10]#\n  )^#2[1,9]#,\n  1^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { doubleValue: 0.3 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    0.3~double\n  )~double^math_sign_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { doubleValue: 0.3 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.abs(-1) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.abs(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.abs(-1) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.abs(\n    -1^#3[1,9]#\n  )^#2[1,8]#,\n  1^#5[1,16]#\n)^#4[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 11, 1, 9, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "abs",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 13, "5": 16 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.abs(\n    -1~int\n  )~int^math_abs_int,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_abs_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 13, "5": 16 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.abs",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.abs(1) == 1",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.abs(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.abs(1) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.abs(\n    1^#3[1,9]#\n  )^#2[1,8]#,\n  1^#5[1,15]#\n)^#4[1,12]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 14, 1, 12, 1, 14],
+        [5, 15, 16, 1, 15, 1, 16],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "abs",
+                  args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 12, "5": 15 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.abs(\n    1~int\n  )~int^math_abs_int,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_abs_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 12, "5": 15 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.abs",
+                  args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.abs(-234.5) == 234.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.abs(\n    -234.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  234.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.abs(-234.5) == 234.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.abs(\n    -234.5^#3[1,9]#\n  )^#2[1,8]#,\n  234.5^#5[1,20]#\n)^#4[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 15, 1, 9, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 25, 1, 20, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "abs",
+                  args: [{ id: "3", constExpr: { doubleValue: -234.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 234.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.abs(\n    -234.5~double\n  )~double^math_abs_double,\n  234.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_abs_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 17, "5": 20 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.abs",
+                  args: [{ id: "3", constExpr: { doubleValue: -234.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 234.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.abs(234.5) == 234.5",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.abs(\n    234.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  234.5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.abs(234.5) == 234.5",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.abs(\n    234.5^#3[1,9]#\n  )^#2[1,8]#,\n  234.5^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 14, 1, 9, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 24, 1, 19, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "abs",
+                  args: [{ id: "3", constExpr: { doubleValue: 234.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 234.5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.abs(\n    234.5~double\n  )~double^math_abs_double,\n  234.5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_abs_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.abs",
+                  args: [{ id: "3", constExpr: { doubleValue: 234.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 234.5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sqrt(49.0) == 7.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    49^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  7^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(49.0) == 7.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    49^#3[1,10]#\n  )^#2[1,9]#,\n  7^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 14, 1, 10, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { doubleValue: 49 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 7 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sqrt(\n    49~double\n  )~double^math_sqrt_double,\n  7~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sqrt_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sqrt",
+                  args: [{ id: "3", constExpr: { doubleValue: 49 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 7 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sqrt(0) == 0.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(0) == 0.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    0^#3[1,10]#\n  )^#2[1,9]#,\n  0^#5[1,16]#\n)^#4[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 19, 1, 16, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 0 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sqrt(\n    0~int\n  )~double^math_sqrt_int,\n  0~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sqrt_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 0 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sqrt(1) == 1.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(1) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    1^#3[1,10]#\n  )^#2[1,9]#,\n  1^#5[1,16]#\n)^#4[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 19, 1, 16, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sqrt(\n    1~int\n  )~double^math_sqrt_int,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sqrt_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sqrt(25u) == 5.0",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    25u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#,\n  5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(25u) == 5.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    25u^#3[1,10]#\n  )^#2[1,9]#,\n  5^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { uint64Value: "25" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sqrt(\n    25u~uint\n  )~double^math_sqrt_uint,\n  5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sqrt_uint"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sqrt",
+                  args: [{ id: "3", constExpr: { uint64Value: "25" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sqrt(82) == 9.055385138137417",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    82^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  9.055385138137417^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(82) == 9.055385138137417",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    82^#3[1,10]#\n  )^#2[1,9]#,\n  9.055385138137417^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 34, 1, 17, 1, 34],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "82" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 9.055385138137417 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sqrt(\n    82~int\n  )~double^math_sqrt_int,\n  9.055385138137417~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sqrt_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "82" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 9.055385138137417 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sqrt(985.25) == 31.388692231439016",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    985.25^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  31.388692231439016^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(985.25) == 31.388692231439016",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    985.25^#3[1,10]#\n  )^#2[1,9]#,\n  31.388692231439016^#5[1,21]#\n)^#4[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 16, 1, 10, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 21, 39, 1, 21, 1, 39],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { doubleValue: 985.25 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 31.388692231439016 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 18, "5": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sqrt(\n    985.25~double\n  )~double^math_sqrt_double,\n  31.388692231439016~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sqrt_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 18, "5": 21 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sqrt",
+                  args: [{ id: "3", constExpr: { doubleValue: 985.25 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 31.388692231439016 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.isNaN(math.sqrt(-15.34))",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "INT64" } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        value: { boolValue: true },
+      },
+      section: "TestMath",
+      library: "math",
+      libraryVersion: 2,
+      ast: "math^#*expr.Expr_IdentExpr#.isNaN(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    -15.34^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isNaN(math.sqrt(-15.34))",
+      locationAst:
+        "math^#1[1,0]#.isNaN(\n  math^#3[1,11]#.sqrt(\n    -15.34^#5[1,21]#\n  )^#4[1,20]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 20, 21, 1, 20, 1, 21],
+        [5, 21, 27, 1, 21, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: { id: "3", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "5", constExpr: { doubleValue: -15.34 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 20, "5": 21 },
+        },
+      },
+      checkedAst:
+        "math.isNaN(\n  math.sqrt(\n    -15.34~double\n  )~double^math_sqrt_double\n)~bool^math_isNaN_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isNaN_double"] },
+          "4": { overloadId: ["math_sqrt_double"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 20, "5": 21 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "math.sqrt",
+                  args: [{ id: "5", constExpr: { doubleValue: -15.34 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: { expr: "math.least()" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least()^#*expr.Expr_CallExpr#",
+      unparsed: "math.least()",
+      locationAst: "math^#1[1,0]#.least()^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 10 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: math.least() requires at least one argument\n | math.least()\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.least() requires at least one argument",
+          id: 2,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError: "math.least() requires at least one argument",
+    },
+    {
+      original: { expr: "math.least('hello')" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: 'math^#*expr.Expr_IdentExpr#.least(\n  "hello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.least("hello")',
+      locationAst: 'math^#1[1,0]#.least(\n  "hello"^#3[1,11]#\n)^#2[1,10]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 18, 1, 11, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [{ id: "3", constExpr: { stringValue: "hello" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:12: math.least() invalid single argument value\n | math.least('hello')\n | ...........^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.least() invalid single argument value",
+          id: 4,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError: "math.least() invalid single argument value",
+    },
+    {
+      original: { expr: "math.least({})" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  {}^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least({})",
+      locationAst: "math^#1[1,0]#.least(\n  {}^#3[1,11]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [{ id: "3", structExpr: {} }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 10, "3": 11 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:12: math.least() invalid single argument value\n | math.least({})\n | ...........^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.least() invalid single argument value",
+          id: 4,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError: "math.least() invalid single argument value",
+    },
+    {
+      original: { expr: "math.least(1, true)" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  1^#*expr.Constant_Int64Value#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1, true)",
+      locationAst:
+        "math^#1[1,0]#.least(\n  1^#3[1,11]#,\n  true^#4[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 18, 1, 14, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [
+              { id: "3", constExpr: { int64Value: "1" } },
+              { id: "4", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: math.least() simple literal arguments must be numeric\n | math.least(1, true)\n | ..............^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.least() simple literal arguments must be numeric",
+          id: 5,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError: "math.least() simple literal arguments must be numeric",
+    },
+    {
+      original: { expr: "math.least(1, 2, true)" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(1, 2, true)",
+      locationAst:
+        "math^#1[1,0]#.least(\n  1^#3[1,11]#,\n  2^#4[1,14]#,\n  true^#5[1,17]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 17, 21, 1, 17, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [
+              { id: "3", constExpr: { int64Value: "1" } },
+              { id: "4", constExpr: { int64Value: "2" } },
+              { id: "5", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:18: math.least() simple literal arguments must be numeric\n | math.least(1, 2, true)\n | .................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.least() simple literal arguments must be numeric",
+          id: 6,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+      expectedError: "math.least() simple literal arguments must be numeric",
+    },
+    {
+      original: { expr: "math.greatest()" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest()^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest()",
+      locationAst: "math^#1[1,0]#.greatest()^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 13 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: math.greatest() requires at least one argument\n | math.greatest()\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.greatest() requires at least one argument",
+          id: 2,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError: "math.greatest() requires at least one argument",
+    },
+    {
+      original: { expr: "math.greatest(true)" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(true)",
+      locationAst: "math^#1[1,0]#.greatest(\n  true^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 18, 1, 14, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [{ id: "3", constExpr: { boolValue: true } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: math.greatest() invalid single argument value\n | math.greatest(true)\n | ..............^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.greatest() invalid single argument value",
+          id: 4,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError: "math.greatest() invalid single argument value",
+    },
+    {
+      original: { expr: "math.greatest([])" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest([])",
+      locationAst: "math^#1[1,0]#.greatest(\n  []^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [{ id: "3", listExpr: {} }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: math.greatest() invalid single argument value\n | math.greatest([])\n | ..............^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.greatest() invalid single argument value",
+          id: 4,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError: "math.greatest() invalid single argument value",
+    },
+    {
+      original: { expr: "math.greatest([1, true])" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  [\n    1^#*expr.Constant_Int64Value#,\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest([1, true])",
+      locationAst:
+        "math^#1[1,0]#.greatest(\n  [\n    1^#4[1,15]#,\n    true^#5[1,18]#\n  ]^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 22, 1, 18, 1, 22],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 18 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: math.greatest() invalid single argument value\n | math.greatest([1, true])\n | ..............^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.greatest() invalid single argument value",
+          id: 6,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError: "math.greatest() invalid single argument value",
+    },
+    {
+      original: { expr: "math.greatest(1, true)" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  1^#*expr.Constant_Int64Value#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1, true)",
+      locationAst:
+        "math^#1[1,0]#.greatest(\n  1^#3[1,14]#,\n  true^#4[1,17]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 21, 1, 17, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [
+              { id: "3", constExpr: { int64Value: "1" } },
+              { id: "4", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:18: math.greatest() simple literal arguments must be numeric\n | math.greatest(1, true)\n | .................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.greatest() simple literal arguments must be numeric",
+          id: 5,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+      expectedError: "math.greatest() simple literal arguments must be numeric",
+    },
+    {
+      original: { expr: "math.greatest(1, 2, true)" },
+      section: "TestMathStaticErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1, 2, true)",
+      locationAst:
+        "math^#1[1,0]#.greatest(\n  1^#3[1,14]#,\n  2^#4[1,17]#,\n  true^#5[1,20]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [
+              { id: "3", constExpr: { int64Value: "1" } },
+              { id: "4", constExpr: { int64Value: "2" } },
+              { id: "5", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 20 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:21: math.greatest() simple literal arguments must be numeric\n | math.greatest(1, 2, true)\n | ....................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "math.greatest() simple literal arguments must be numeric",
+          id: 6,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedError: "math.greatest() simple literal arguments must be numeric",
+    },
+    {
+      original: {
+        expr: "math.least(a, b)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { listValue: {} } },
+          b: { value: { int64Value: "1" } },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@min" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(a, b)",
+      locationAst:
+        "math^#1[1,0]#.least(\n  a^#3[1,11]#,\n  b^#4[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [
+              { id: "3", identExpr: { name: "a" } },
+              { id: "4", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14 },
+        },
+      },
+      checkedAst:
+        "math.@min(\n  a~dyn^a,\n  b~int^b\n)~dyn^math_@min_double_int|math_@min_int_int|math_@min_uint_int",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": { name: "b" },
+          "5": {
+            overloadId: [
+              "math_@min_double_int",
+              "math_@min_int_int",
+              "math_@min_uint_int",
+            ],
+          },
+        },
+        typeMap: {
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "3": 11, "4": 14, "5": 10 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  { id: "4", identExpr: { name: "b" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "math.@min",
+            args: [
+              { id: "3", identExpr: { name: "a" } },
+              { id: "4", identExpr: { name: "b" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.@min(list, int)" },
+          ],
+        },
+      },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.least(b, a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { listValue: {} } },
+          b: { value: { int64Value: "1" } },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@min" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  b^#*expr.Expr_IdentExpr#,\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(b, a)",
+      locationAst:
+        "math^#1[1,0]#.least(\n  b^#3[1,11]#,\n  a^#4[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "a" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14 },
+        },
+      },
+      checkedAst:
+        "math.@min(\n  b~int^b,\n  a~dyn^a\n)~dyn^math_@min_int_double|math_@min_int_int|math_@min_int_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "b" },
+          "4": { name: "a" },
+          "5": {
+            overloadId: [
+              "math_@min_int_double",
+              "math_@min_int_int",
+              "math_@min_int_uint",
+            ],
+          },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "3": 11, "4": 14, "5": 10 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", identExpr: { name: "b" } },
+                  { id: "4", identExpr: { name: "a" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "math.@min",
+            args: [
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "a" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.@min(int, list)" },
+          ],
+        },
+      },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.least(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: { a: { value: { listValue: {} } } },
+        evalError: {
+          errors: [{ message: "math.@min(list) argument must not be empty" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(a)",
+      locationAst: "math^#1[1,0]#.least(\n  a^#3[1,11]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 10, "3": 11 },
+        },
+      },
+      checkedAst:
+        "math.@min(\n  a~dyn^a\n)~dyn^math_@min_double|math_@min_int|math_@min_list_double|math_@min_list_int|math_@min_list_uint|math_@min_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": {
+            overloadId: [
+              "math_@min_double",
+              "math_@min_int",
+              "math_@min_list_double",
+              "math_@min_list_int",
+              "math_@min_list_uint",
+              "math_@min_uint",
+            ],
+          },
+        },
+        typeMap: { "3": { dyn: {} }, "4": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "3": 11, "4": 10 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [{ id: "3", identExpr: { name: "a" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "math.@min",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "math.@min(list) argument must not be empty" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { listValue: { values: [{ stringValue: "hello" }] } } },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@min" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(a)",
+      locationAst: "math^#1[1,0]#.least(\n  a^#3[1,11]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 10, "3": 11 },
+        },
+      },
+      checkedAst:
+        "math.@min(\n  a~dyn^a\n)~dyn^math_@min_double|math_@min_int|math_@min_list_double|math_@min_list_int|math_@min_list_uint|math_@min_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": {
+            overloadId: [
+              "math_@min_double",
+              "math_@min_int",
+              "math_@min_list_double",
+              "math_@min_list_int",
+              "math_@min_list_uint",
+              "math_@min_uint",
+            ],
+          },
+        },
+        typeMap: { "3": { dyn: {} }, "4": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "3": 11, "4": 10 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [{ id: "3", identExpr: { name: "a" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "math.@min",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.@min(list)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: {
+            value: {
+              listValue: { values: [{ listValue: {} }, { listValue: {} }] },
+            },
+          },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@min" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(a)",
+      locationAst: "math^#1[1,0]#.least(\n  a^#3[1,11]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 10, "3": 11 },
+        },
+      },
+      checkedAst:
+        "math.@min(\n  a~dyn^a\n)~dyn^math_@min_double|math_@min_int|math_@min_list_double|math_@min_list_int|math_@min_list_uint|math_@min_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": {
+            overloadId: [
+              "math_@min_double",
+              "math_@min_int",
+              "math_@min_list_double",
+              "math_@min_list_int",
+              "math_@min_list_uint",
+              "math_@min_uint",
+            ],
+          },
+        },
+        typeMap: { "3": { dyn: {} }, "4": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "3": 11, "4": 10 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [{ id: "3", identExpr: { name: "a" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "math.@min",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.@min(list)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: {
+            value: {
+              listValue: {
+                values: [
+                  { int64Value: "1" },
+                  { boolValue: true },
+                  { int64Value: "2" },
+                ],
+              },
+            },
+          },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@min" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.least(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(a)",
+      locationAst: "math^#1[1,0]#.least(\n  a^#3[1,11]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 10, "3": 11 },
+        },
+      },
+      checkedAst:
+        "math.@min(\n  a~dyn^a\n)~dyn^math_@min_double|math_@min_int|math_@min_list_double|math_@min_list_int|math_@min_list_uint|math_@min_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": {
+            overloadId: [
+              "math_@min_double",
+              "math_@min_int",
+              "math_@min_list_double",
+              "math_@min_list_int",
+              "math_@min_list_uint",
+              "math_@min_uint",
+            ],
+          },
+        },
+        typeMap: { "3": { dyn: {} }, "4": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "3": 11, "4": 10 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [{ id: "3", identExpr: { name: "a" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "math.@min",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.@min" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.least(dyn('string'))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: { errors: [{ message: "no such overload: math.@min" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: 'math^#*expr.Expr_IdentExpr#.least(\n  dyn(\n    "string"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.least(dyn("string"))',
+      locationAst:
+        'math^#1[1,0]#.least(\n  dyn(\n    "string"^#4[1,15]#\n  )^#3[1,14]#\n)^#2[1,10]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 23, 1, 15, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "least",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "string" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        'math.@min(\n  dyn(\n    "string"~string\n  )~dyn^to_dyn\n)~dyn^math_@min_double|math_@min_int|math_@min_list_double|math_@min_list_int|math_@min_list_uint|math_@min_uint',
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["to_dyn"] },
+          "5": {
+            overloadId: [
+              "math_@min_double",
+              "math_@min_int",
+              "math_@min_list_double",
+              "math_@min_list_int",
+              "math_@min_list_uint",
+              "math_@min_uint",
+            ],
+          },
+        },
+        typeMap: {
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "3": 14, "4": 15, "5": 10 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "4", constExpr: { stringValue: "string" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "math.@min",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "string" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.@min(string)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(a, b)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { listValue: {} } },
+          b: { value: { int64Value: "1" } },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@max" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(a, b)",
+      locationAst:
+        "math^#1[1,0]#.greatest(\n  a^#3[1,14]#,\n  b^#4[1,17]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [
+              { id: "3", identExpr: { name: "a" } },
+              { id: "4", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17 },
+        },
+      },
+      checkedAst:
+        "math.@max(\n  a~dyn^a,\n  b~int^b\n)~dyn^math_@max_double_int|math_@max_int_int|math_@max_uint_int",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": { name: "b" },
+          "5": {
+            overloadId: [
+              "math_@max_double_int",
+              "math_@max_int_int",
+              "math_@max_uint_int",
+            ],
+          },
+        },
+        typeMap: {
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", identExpr: { name: "a" } },
+                  { id: "4", identExpr: { name: "b" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "math.@max",
+            args: [
+              { id: "3", identExpr: { name: "a" } },
+              { id: "4", identExpr: { name: "b" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.@max(list, int)" },
+          ],
+        },
+      },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.greatest(b, a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { listValue: {} } },
+          b: { value: { int64Value: "1" } },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@max" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  b^#*expr.Expr_IdentExpr#,\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(b, a)",
+      locationAst:
+        "math^#1[1,0]#.greatest(\n  b^#3[1,14]#,\n  a^#4[1,17]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "a" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17 },
+        },
+      },
+      checkedAst:
+        "math.@max(\n  b~int^b,\n  a~dyn^a\n)~dyn^math_@max_int_double|math_@max_int_int|math_@max_int_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "b" },
+          "4": { name: "a" },
+          "5": {
+            overloadId: [
+              "math_@max_int_double",
+              "math_@max_int_int",
+              "math_@max_int_uint",
+            ],
+          },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", identExpr: { name: "b" } },
+                  { id: "4", identExpr: { name: "a" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "math.@max",
+            args: [
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "a" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.@max(int, list)" },
+          ],
+        },
+      },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.greatest(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: { a: { value: { listValue: {} } } },
+        evalError: {
+          errors: [{ message: "math.@max(list) argument must not be empty" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(a)",
+      locationAst: "math^#1[1,0]#.greatest(\n  a^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        "math.@max(\n  a~dyn^a\n)~dyn^math_@max_double|math_@max_int|math_@max_list_double|math_@max_list_int|math_@max_list_uint|math_@max_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": {
+            overloadId: [
+              "math_@max_double",
+              "math_@max_int",
+              "math_@max_list_double",
+              "math_@max_list_int",
+              "math_@max_list_uint",
+              "math_@max_uint",
+            ],
+          },
+        },
+        typeMap: { "3": { dyn: {} }, "4": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "3": 14, "4": 13 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [{ id: "3", identExpr: { name: "a" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "math.@max",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "math.@max(list) argument must not be empty" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: { value: { listValue: { values: [{ boolValue: true }] } } },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@max" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(a)",
+      locationAst: "math^#1[1,0]#.greatest(\n  a^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        "math.@max(\n  a~dyn^a\n)~dyn^math_@max_double|math_@max_int|math_@max_list_double|math_@max_list_int|math_@max_list_uint|math_@max_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": {
+            overloadId: [
+              "math_@max_double",
+              "math_@max_int",
+              "math_@max_list_double",
+              "math_@max_list_int",
+              "math_@max_list_uint",
+              "math_@max_uint",
+            ],
+          },
+        },
+        typeMap: { "3": { dyn: {} }, "4": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "3": 14, "4": 13 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [{ id: "3", identExpr: { name: "a" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "math.@max",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.@max(list)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(a)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        bindings: {
+          a: {
+            value: {
+              listValue: {
+                values: [
+                  { int64Value: "1" },
+                  { boolValue: true },
+                  { int64Value: "2" },
+                ],
+              },
+            },
+          },
+        },
+        evalError: { errors: [{ message: "no such overload: math.@max" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.greatest(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(a)",
+      locationAst: "math^#1[1,0]#.greatest(\n  a^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        "math.@max(\n  a~dyn^a\n)~dyn^math_@max_double|math_@max_int|math_@max_list_double|math_@max_list_int|math_@max_list_uint|math_@max_uint",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": {
+            overloadId: [
+              "math_@max_double",
+              "math_@max_int",
+              "math_@max_list_double",
+              "math_@max_list_int",
+              "math_@max_list_uint",
+              "math_@max_uint",
+            ],
+          },
+        },
+        typeMap: { "3": { dyn: {} }, "4": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "3": 14, "4": 13 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [{ id: "3", identExpr: { name: "a" } }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "math.@max",
+            args: [{ id: "3", identExpr: { name: "a" } }],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.@max" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.greatest(dyn('string'))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: { errors: [{ message: "no such overload: math.@max" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 0,
+      ast: 'math^#*expr.Expr_IdentExpr#.greatest(\n  dyn(\n    "string"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.greatest(dyn("string"))',
+      locationAst:
+        'math^#1[1,0]#.greatest(\n  dyn(\n    "string"^#4[1,18]#\n  )^#3[1,17]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 17, 18, 1, 17, 1, 18],
+        [4, 18, 26, 1, 18, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "greatest",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "string" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 13, "3": 17, "4": 18 },
+        },
+      },
+      checkedAst:
+        'math.@max(\n  dyn(\n    "string"~string\n  )~dyn^to_dyn\n)~dyn^math_@max_double|math_@max_int|math_@max_list_double|math_@max_list_int|math_@max_list_uint|math_@max_uint',
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["to_dyn"] },
+          "5": {
+            overloadId: [
+              "math_@max_double",
+              "math_@max_int",
+              "math_@max_list_double",
+              "math_@max_list_int",
+              "math_@max_list_uint",
+              "math_@max_uint",
+            ],
+          },
+        },
+        typeMap: {
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+          "5": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "3": 17, "4": 18, "5": 13 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "dyn",
+                      args: [{ id: "4", constExpr: { stringValue: "string" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "math.@max",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "string" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.@max(string)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftLeft(1, -2) == 4",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "math.bitShiftLeft() negative offset" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    1^#*expr.Constant_Int64Value#,\n    -2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(1, -2) == 4",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    1^#3[1,18]#,\n    -2^#4[1,21]#\n  )^#2[1,17]#,\n  4^#6[1,28]#\n)^#5[1,25]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 25, 27, 1, 25, 1, 27],
+        [6, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 25, "6": 28 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    1~int,\n    -2~int\n  )~int^math_bitShiftLeft_int_int,\n  4~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 25, "6": 28 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "math.bitShiftLeft() negative offset: -2" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftLeft(1u, -2) == 0u",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "math.bitShiftLeft() negative offset" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    1u^#*expr.Constant_Uint64Value#,\n    -2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(1u, -2) == 0u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    1u^#3[1,18]#,\n    -2^#4[1,22]#\n  )^#2[1,17]#,\n  0u^#6[1,29]#\n)^#5[1,26]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 20, 1, 18, 1, 20],
+        [4, 22, 24, 1, 22, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 31, 1, 29, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    1u~uint,\n    -2~int\n  )~uint^math_bitShiftLeft_uint_int,\n  0u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_uint_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "-2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "math.bitShiftLeft() negative offset: -2" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(-1024, -3) == -128",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "math.bitShiftRight() negative offset" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    -1024^#*expr.Constant_Int64Value#,\n    -3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -128^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(-1024, -3) == -128",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    -1024^#3[1,19]#,\n    -3^#4[1,26]#\n  )^#2[1,18]#,\n  -128^#6[1,33]#\n)^#5[1,30]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 24, 1, 19, 1, 24],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 30, 32, 1, 30, 1, 32],
+        [6, 33, 37, 1, 33, 1, 37],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1024" } },
+                    { id: "4", constExpr: { int64Value: "-3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-128" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 30, "6": 33 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    -1024~int,\n    -3~int\n  )~int^math_bitShiftRight_int_int,\n  -128~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 30, "6": 33 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "-1024" } },
+                    { id: "4", constExpr: { int64Value: "-3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-128" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "math.bitShiftRight() negative offset: -3" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(1024u, -4) == 1u",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "math.bitShiftRight() negative offset" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    1024u^#*expr.Constant_Uint64Value#,\n    -4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(1024u, -4) == 1u",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    1024u^#3[1,19]#,\n    -4^#4[1,26]#\n  )^#2[1,18]#,\n  1u^#6[1,33]#\n)^#5[1,30]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 24, 1, 19, 1, 24],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 30, 32, 1, 30, 1, 32],
+        [6, 33, 35, 1, 33, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "-4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 30, "6": 33 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    1024u~uint,\n    -4~int\n  )~uint^math_bitShiftRight_uint_int,\n  1u~uint\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_uint_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 26, "5": 30, "6": 33 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { uint64Value: "1024" } },
+                    { id: "4", constExpr: { int64Value: "-4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { uint64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "math.bitShiftRight() negative offset: -4" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.abs(-9223372036854775808)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: { errors: [{ message: "overflow" }] },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.abs(\n  -9223372036854775808^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.abs(-9223372036854775808)",
+      locationAst:
+        "math^#1[1,0]#.abs(\n  -9223372036854775808^#3[1,9]#\n)^#2[1,8]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 29, 1, 9, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "abs",
+            args: [
+              { id: "3", constExpr: { int64Value: "-9223372036854775808" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 8, "3": 9 },
+        },
+      },
+      checkedAst: "math.abs(\n  -9223372036854775808~int\n)~int^math_abs_int",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["math_abs_int"] } },
+        typeMap: { "2": { primitive: "INT64" }, "3": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 8, "3": 9 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.abs",
+            args: [
+              { id: "3", constExpr: { int64Value: "-9223372036854775808" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { error: { errors: [{ code: 2, message: "integer overflow" }] } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "math.bitOr(dyn(1.2), 1)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.bitOr(double, int)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.bitOr(\n  dyn(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitOr(dyn(1.2), 1)",
+      locationAst:
+        "math^#1[1,0]#.bitOr(\n  dyn(\n    1.2^#4[1,15]#\n  )^#3[1,14]#,\n  1^#5[1,21]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 18, 1, 15, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "bitOr",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15, "5": 21 },
+        },
+      },
+      checkedAst:
+        "math.bitOr(\n  dyn(\n    1.2~double\n  )~dyn^to_dyn,\n  1~int\n)~int^math_bitOr_int_int",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitOr_int_int"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { dyn: {} },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15, "5": 21 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.bitOr",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.bitOr(double, int)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitAnd(2u, dyn(''))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.bitAnd(uint, string)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: 'math^#*expr.Expr_IdentExpr#.bitAnd(\n  2u^#*expr.Constant_Uint64Value#,\n  dyn(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.bitAnd(2u, dyn(""))',
+      locationAst:
+        'math^#1[1,0]#.bitAnd(\n  2u^#3[1,12]#,\n  dyn(\n    ""^#5[1,20]#\n  )^#4[1,19]#\n)^#2[1,11]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 22, 1, 20, 1, 22],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "bitAnd",
+            args: [
+              { id: "3", constExpr: { uint64Value: "2" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "5", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        'math.bitAnd(\n  2u~uint,\n  dyn(\n    ""~string\n  )~dyn^to_dyn\n)~uint^math_bitAnd_uint_uint',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitAnd_uint_uint"] },
+          "4": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { dyn: {} },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.bitAnd",
+            args: [
+              { id: "3", constExpr: { uint64Value: "2" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "5", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "uint",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.bitAnd(uint, string)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.bitXor(dyn(1), dyn(1u))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.bitXor(int, uint)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.bitXor(\n  dyn(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  dyn(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(dyn(1), dyn(1u))",
+      locationAst:
+        "math^#1[1,0]#.bitXor(\n  dyn(\n    1^#4[1,16]#\n  )^#3[1,15]#,\n  dyn(\n    1u^#6[1,24]#\n  )^#5[1,23]#\n)^#2[1,11]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 23, 24, 1, 23, 1, 24],
+        [6, 24, 26, 1, 24, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "bitXor",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "6", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 15, "4": 16, "5": 23, "6": 24 },
+        },
+      },
+      checkedAst:
+        "math.bitXor(\n  dyn(\n    1~int\n  )~dyn^to_dyn,\n  dyn(\n    1u~uint\n  )~dyn^to_dyn\n)~dyn^math_bitXor_int_int|math_bitXor_uint_uint",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitXor_int_int", "math_bitXor_uint_uint"] },
+          "3": { overloadId: ["to_dyn"] },
+          "5": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 11, "3": 15, "4": 16, "5": 23, "6": 24 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.bitXor",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "6", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "3", max: "3" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.bitXor(int, uint)" },
+          ],
+        },
+      },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "math.bitXor(dyn([]), dyn([1]))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.bitXor(list, list)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.bitXor(\n  dyn(\n    []^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  dyn(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(dyn([]), dyn([1]))",
+      locationAst:
+        "math^#1[1,0]#.bitXor(\n  dyn(\n    []^#4[1,16]#\n  )^#3[1,15]#,\n  dyn(\n    [\n      1^#7[1,26]#\n    ]^#6[1,25]#\n  )^#5[1,24]#\n)^#2[1,11]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 25, 26, 1, 25, 1, 26],
+        [7, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "bitXor",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", listExpr: {} }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 15,
+            "4": 16,
+            "5": 24,
+            "6": 25,
+            "7": 26,
+          },
+        },
+      },
+      checkedAst:
+        "math.bitXor(\n  dyn(\n    []~list(dyn)\n  )~dyn^to_dyn,\n  dyn(\n    [\n      1~int\n    ]~list(int)\n  )~dyn^to_dyn\n)~dyn^math_bitXor_int_int|math_bitXor_uint_uint",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitXor_int_int", "math_bitXor_uint_uint"] },
+          "3": { overloadId: ["to_dyn"] },
+          "5": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { dyn: {} },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 11,
+            "3": 15,
+            "4": 16,
+            "5": 24,
+            "6": 25,
+            "7": 26,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.bitXor",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", listExpr: {} }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "23", max: "23" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.bitXor(list, list)" },
+          ],
+        },
+      },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "math.bitNot(dyn([1]))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.bitNot(list)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.bitNot(\n  dyn(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(dyn([1]))",
+      locationAst:
+        "math^#1[1,0]#.bitNot(\n  dyn(\n    [\n      1^#5[1,17]#\n    ]^#4[1,16]#\n  )^#3[1,15]#\n)^#2[1,11]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "bitNot",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 15, "4": 16, "5": 17 },
+        },
+      },
+      checkedAst:
+        "math.bitNot(\n  dyn(\n    [\n      1~int\n    ]~list(int)\n  )~dyn^to_dyn\n)~dyn^math_bitNot_int_int|math_bitNot_uint_uint",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitNot_int_int", "math_bitNot_uint_uint"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 15, "4": 16, "5": 17 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.bitNot",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "12", max: "12" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.bitNot(list)" }],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.bitShiftLeft(dyn([1]), 1)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [
+            { message: "no such overload: math.bitShiftLeft(list, int)" },
+          ],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n  dyn(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(dyn([1]), 1)",
+      locationAst:
+        "math^#1[1,0]#.bitShiftLeft(\n  dyn(\n    [\n      1^#5[1,23]#\n    ]^#4[1,22]#\n  )^#3[1,21]#,\n  1^#6[1,28]#\n)^#2[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 21, 22, 1, 21, 1, 22],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 23, 24, 1, 23, 1, 24],
+        [6, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "bitShiftLeft",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 21, "4": 22, "5": 23, "6": 28 },
+        },
+      },
+      checkedAst:
+        "math.bitShiftLeft(\n  dyn(\n    [\n      1~int\n    ]~list(int)\n  )~dyn^to_dyn,\n  1~int\n)~dyn^math_bitShiftLeft_int_int|math_bitShiftLeft_uint_int",
+      checkedExpr: {
+        referenceMap: {
+          "2": {
+            overloadId: [
+              "math_bitShiftLeft_int_int",
+              "math_bitShiftLeft_uint_int",
+            ],
+          },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 21, "4": 22, "5": 23, "6": 28 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.bitShiftLeft",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "12", max: "12" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: math.bitShiftLeft(list, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "math.bitShiftRight(dyn({}), 1)",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [
+            { message: "no such overload: math.bitShiftRight(map, int)" },
+          ],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.bitShiftRight(\n  dyn(\n    {}^#*expr.Expr_StructExpr#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(dyn({}), 1)",
+      locationAst:
+        "math^#1[1,0]#.bitShiftRight(\n  dyn(\n    {}^#4[1,23]#\n  )^#3[1,22]#,\n  1^#5[1,28]#\n)^#2[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 22, 23, 1, 22, 1, 23],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "bitShiftRight",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", structExpr: {} }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 18, "3": 22, "4": 23, "5": 28 },
+        },
+      },
+      checkedAst:
+        "math.bitShiftRight(\n  dyn(\n    {}~map(dyn, dyn)\n  )~dyn^to_dyn,\n  1~int\n)~dyn^math_bitShiftRight_int_int|math_bitShiftRight_uint_int",
+      checkedExpr: {
+        referenceMap: {
+          "2": {
+            overloadId: [
+              "math_bitShiftRight_int_int",
+              "math_bitShiftRight_uint_int",
+            ],
+          },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 18, "3": 22, "4": 23, "5": 28 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.bitShiftRight",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", structExpr: {} }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "32", max: "32" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: math.bitShiftRight(map, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "32",
+    },
+    {
+      original: {
+        expr: "math.isInf(dyn(1u))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.isInf(uint)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isInf(\n  dyn(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isInf(dyn(1u))",
+      locationAst:
+        "math^#1[1,0]#.isInf(\n  dyn(\n    1u^#4[1,15]#\n  )^#3[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 17, 1, 15, 1, 17],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isInf",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        "math.isInf(\n  dyn(\n    1u~uint\n  )~dyn^to_dyn\n)~bool^math_isInf_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isInf_double"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { dyn: {} },
+          "4": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isInf",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.isInf(uint)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.isFinite(dyn(1u))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.isFinite(uint)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isFinite(\n  dyn(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isFinite(dyn(1u))",
+      locationAst:
+        "math^#1[1,0]#.isFinite(\n  dyn(\n    1u^#4[1,18]#\n  )^#3[1,17]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 17, 18, 1, 17, 1, 18],
+        [4, 18, 20, 1, 18, 1, 20],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isFinite",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 17, "4": 18 },
+        },
+      },
+      checkedAst:
+        "math.isFinite(\n  dyn(\n    1u~uint\n  )~dyn^to_dyn\n)~bool^math_isFinite_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isFinite_double"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { dyn: {} },
+          "4": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 17, "4": 18 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isFinite",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.isFinite(uint)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.isNaN(dyn(1u))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.isNaN(uint)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isNaN(\n  dyn(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isNaN(dyn(1u))",
+      locationAst:
+        "math^#1[1,0]#.isNaN(\n  dyn(\n    1u^#4[1,15]#\n  )^#3[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 17, 1, 15, 1, 17],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isNaN",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        "math.isNaN(\n  dyn(\n    1u~uint\n  )~dyn^to_dyn\n)~bool^math_isNaN_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isNaN_double"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { dyn: {} },
+          "4": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isNaN",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.isNaN(uint)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sign(dyn(''))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.sign(string)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: 'math^#*expr.Expr_IdentExpr#.sign(\n  dyn(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.sign(dyn(""))',
+      locationAst:
+        'math^#1[1,0]#.sign(\n  dyn(\n    ""^#4[1,14]#\n  )^#3[1,13]#\n)^#2[1,9]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 14, 16, 1, 14, 1, 16],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "sign",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 13, "4": 14 },
+        },
+      },
+      checkedAst:
+        'math.sign(\n  dyn(\n    ""~string\n  )~dyn^to_dyn\n)~dyn^math_sign_double|math_sign_int|math_sign_uint',
+      checkedExpr: {
+        referenceMap: {
+          "2": {
+            overloadId: ["math_sign_double", "math_sign_int", "math_sign_uint"],
+          },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 13, "4": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.sign",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.sign(string)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.abs(dyn(''))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.abs(string)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: 'math^#*expr.Expr_IdentExpr#.abs(\n  dyn(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.abs(dyn(""))',
+      locationAst:
+        'math^#1[1,0]#.abs(\n  dyn(\n    ""^#4[1,13]#\n  )^#3[1,12]#\n)^#2[1,8]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "abs",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 8, "3": 12, "4": 13 },
+        },
+      },
+      checkedAst:
+        'math.abs(\n  dyn(\n    ""~string\n  )~dyn^to_dyn\n)~dyn^math_abs_double|math_abs_int|math_abs_uint',
+      checkedExpr: {
+        referenceMap: {
+          "2": {
+            overloadId: ["math_abs_double", "math_abs_int", "math_abs_uint"],
+          },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 8, "3": 12, "4": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.abs",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.abs(string)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.ceil(dyn(''))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.ceil(string)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: 'math^#*expr.Expr_IdentExpr#.ceil(\n  dyn(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.ceil(dyn(""))',
+      locationAst:
+        'math^#1[1,0]#.ceil(\n  dyn(\n    ""^#4[1,14]#\n  )^#3[1,13]#\n)^#2[1,9]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 14, 16, 1, 14, 1, 16],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "ceil",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 13, "4": 14 },
+        },
+      },
+      checkedAst:
+        'math.ceil(\n  dyn(\n    ""~string\n  )~dyn^to_dyn\n)~double^math_ceil_double',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_ceil_double"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 13, "4": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.ceil",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "double",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.ceil(string)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.floor(dyn(''))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.floor(string)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: 'math^#*expr.Expr_IdentExpr#.floor(\n  dyn(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.floor(dyn(""))',
+      locationAst:
+        'math^#1[1,0]#.floor(\n  dyn(\n    ""^#4[1,15]#\n  )^#3[1,14]#\n)^#2[1,10]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 17, 1, 15, 1, 17],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "floor",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        'math.floor(\n  dyn(\n    ""~string\n  )~dyn^to_dyn\n)~double^math_floor_double',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_floor_double"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.floor",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "double",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: math.floor(string)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.round(dyn(1))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.round(int)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.round(\n  dyn(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.round(dyn(1))",
+      locationAst:
+        "math^#1[1,0]#.round(\n  dyn(\n    1^#4[1,15]#\n  )^#3[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "round",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        "math.round(\n  dyn(\n    1~int\n  )~dyn^to_dyn\n)~double^math_round_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_round_double"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.round",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "double",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.round(int)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.trunc(dyn(1u))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.trunc(uint)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.trunc(\n  dyn(\n    1u^#*expr.Constant_Uint64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.trunc(dyn(1u))",
+      locationAst:
+        "math^#1[1,0]#.trunc(\n  dyn(\n    1u^#4[1,15]#\n  )^#3[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 17, 1, 15, 1, 17],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "trunc",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+      },
+      checkedAst:
+        "math.trunc(\n  dyn(\n    1u~uint\n  )~dyn^to_dyn\n)~double^math_trunc_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_trunc_double"] },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { dyn: {} },
+          "4": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 14, "4": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.trunc",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { uint64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "double",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.trunc(uint)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "math.sqrt(dyn(''))",
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "numbers",
+            ident: {
+              type: { listType: { elemType: { primitive: "DOUBLE" } } },
+            },
+          },
+        ],
+        evalError: {
+          errors: [{ message: "no such overload: math.sqrt(string)" }],
+        },
+      },
+      section: "TestMathRuntimeErrors",
+      library: "math",
+      libraryVersion: 2,
+      ast: 'math^#*expr.Expr_IdentExpr#.sqrt(\n  dyn(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'math.sqrt(dyn(""))',
+      locationAst:
+        'math^#1[1,0]#.sqrt(\n  dyn(\n    ""^#4[1,14]#\n  )^#3[1,13]#\n)^#2[1,9]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 14, 16, 1, 14, 1, 16],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "sqrt",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 13, "4": 14 },
+        },
+      },
+      checkedAst:
+        'math.sqrt(\n  dyn(\n    ""~string\n  )~dyn^to_dyn\n)~double^math_sqrt_double|math_sqrt_int|math_sqrt_uint',
+      checkedExpr: {
+        referenceMap: {
+          "2": {
+            overloadId: ["math_sqrt_double", "math_sqrt_int", "math_sqrt_uint"],
+          },
+          "3": { overloadId: ["to_dyn"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 13, "4": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.sqrt",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "dyn",
+                  args: [{ id: "4", constExpr: { stringValue: "" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "double",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: math.sqrt(string)" }],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/greatest-supported=true",
+        expr: "math.greatest(1, 2) == 2",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1, 2) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1^#3[1,14]#,\n    2^#4[1,17]#\n  )^#2[1,13]#,\n  2^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/greatest-supported=true",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1~int,\n    2~int\n  )~int^math_@max_int_int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_int_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/least-supported=true",
+        expr: "math.least(2.1, -1.0) == -1.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    2.1^#*expr.Constant_DoubleValue#,\n    -1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(2.1, -1.0) == -1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    2.1^#3[1,11]#,\n    -1^#4[1,16]#\n  )^#2[1,10]#,\n  -1^#6[1,25]#\n)^#5[1,22]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 20, 1, 16, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 25, 29, 1, 25, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.1 } },
+                    { id: "4", constExpr: { doubleValue: -1 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/least-supported=true",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 22, "6": 25 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    2.1~double,\n    -1~double\n  )~double^math_@min_double_double,\n  -1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_double_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "3": 11, "4": 16, "5": 10, "6": 22, "7": 25 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 2.1 } },
+                  { id: "4", constExpr: { doubleValue: -1 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.1 } },
+                    { id: "4", constExpr: { doubleValue: -1 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/ceil-supported=false",
+        expr: "math.ceil(1.5) == 2.0",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.ceil(\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.ceil(1.5) == 2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.ceil(\n    1.5^#3[1,10]#\n  )^#2[1,9]#,\n  2^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/ceil-supported=false",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.ceil(1.5) == 2.0\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'ceil' (in container '')\n | math.ceil(1.5) == 2.0\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'ceil' (in container '')",
+          id: 2,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/floor-supported=false",
+        expr: "math.floor(1.2) == 1.0",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.floor(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.floor(1.2) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.floor(\n    1.2^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "floor",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/floor-supported=false",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.floor(1.2) == 1.0\n | ^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'floor' (in container '')\n | math.floor(1.2) == 1.0\n | ..........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'floor' (in container '')",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/round-supported=false",
+        expr: "math.round(1.5) == 2.0",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.round(\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.round(1.5) == 2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.round(\n    1.5^#3[1,11]#\n  )^#2[1,10]#,\n  2^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/round-supported=false",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.round(1.5) == 2.0\n | ^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'round' (in container '')\n | math.round(1.5) == 2.0\n | ..........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'round' (in container '')",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/trunc-supported=false",
+        expr: "math.trunc(1.222) == 1.0",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.trunc(\n    1.222^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.trunc(1.222) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.trunc(\n    1.222^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,21]#\n)^#4[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 16, 1, 11, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 21, 24, 1, 21, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.222 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/trunc-supported=false",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 18, "5": 21 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.trunc(1.222) == 1.0\n | ^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'trunc' (in container '')\n | math.trunc(1.222) == 1.0\n | ..........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'trunc' (in container '')",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/isInf-supported=false",
+        expr: "!math.isInf(0.0)",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "!_(\n  math^#*expr.Expr_IdentExpr#.isInf(\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!math.isInf(0.0)",
+      locationAst:
+        "!_(\n  math^#2[1,1]#.isInf(\n    0^#4[1,12]#\n  )^#3[1,11]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 12, 15, 1, 12, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "math" } },
+                  function: "isInf",
+                  args: [{ id: "4", constExpr: { doubleValue: 0 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/isInf-supported=false",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 12 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'math' (in container '')\n | !math.isInf(0.0)\n | .^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'isInf' (in container '')\n | !math.isInf(0.0)\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'isInf' (in container '')",
+          id: 3,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/isNaN-supported=false",
+        expr: "math.isNaN(0.0/0.0)",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.isNaN(\n  _/_(\n    0^#*expr.Constant_DoubleValue#,\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isNaN(0.0 / 0.0)",
+      locationAst:
+        "math^#1[1,0]#.isNaN(\n  _/_(\n    0^#3[1,11]#,\n    0^#5[1,15]#\n  )^#4[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 15, 18, 1, 15, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/isNaN-supported=false",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.isNaN(0.0/0.0)\n | ^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'isNaN' (in container '')\n | math.isNaN(0.0/0.0)\n | ..........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'isNaN' (in container '')",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/isFinite-supported=false",
+        expr: "math.isFinite(0.0)",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "math^#*expr.Expr_IdentExpr#.isFinite(\n  0^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isFinite(0.0)",
+      locationAst: "math^#1[1,0]#.isFinite(\n  0^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 17, 1, 14, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isFinite",
+            args: [{ id: "3", constExpr: { doubleValue: 0 } }],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/isFinite-supported=false",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.isFinite(0.0)\n | ^\nERROR: \u003cinput\u003e:1:14: undeclared reference to 'isFinite' (in container '')\n | math.isFinite(0.0)\n | .............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'isFinite' (in container '')",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/abs-supported=false",
+        expr: "math.abs(1.2) == 1.2",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.abs(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1.2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.abs(1.2) == 1.2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.abs(\n    1.2^#3[1,9]#\n  )^#2[1,8]#,\n  1.2^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 12, 1, 9, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 20, 1, 17, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "abs",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1.2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/abs-supported=false",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 14, "5": 17 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.abs(1.2) == 1.2\n | ^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'abs' (in container '')\n | math.abs(1.2) == 1.2\n | ........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'abs' (in container '')",
+          id: 2,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/sign-supported=false",
+        expr: "math.sign(-1) == -1",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(-1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    -1^#3[1,10]#\n  )^#2[1,9]#,\n  -1^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/sign-supported=false",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.sign(-1) == -1\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'sign' (in container '')\n | math.sign(-1) == -1\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'sign' (in container '')",
+          id: 2,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/bitAnd-supported=false",
+        expr: "math.bitAnd(1, 2) == 0",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitAnd(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitAnd(1, 2) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitAnd(\n    1^#3[1,12]#,\n    2^#4[1,15]#\n  )^#2[1,11]#,\n  0^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/bitAnd-supported=false",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.bitAnd(1, 2) == 0\n | ^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'bitAnd' (in container '')\n | math.bitAnd(1, 2) == 0\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'bitAnd' (in container '')",
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/bitOr-supported=false",
+        expr: "math.bitOr(1, 2) == 3",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitOr(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitOr(1, 2) == 3",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitOr(\n    1^#3[1,11]#,\n    2^#4[1,14]#\n  )^#2[1,10]#,\n  3^#6[1,20]#\n)^#5[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 17, 19, 1, 17, 1, 19],
+        [6, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitOr",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/bitOr-supported=false",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.bitOr(1, 2) == 3\n | ^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'bitOr' (in container '')\n | math.bitOr(1, 2) == 3\n | ..........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'bitOr' (in container '')",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/bitXor-supported=false",
+        expr: "math.bitXor(1, 3) == 2",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitXor(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(1, 3) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitXor(\n    1^#3[1,12]#,\n    3^#4[1,15]#\n  )^#2[1,11]#,\n  2^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/bitXor-supported=false",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.bitXor(1, 3) == 2\n | ^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'bitXor' (in container '')\n | math.bitXor(1, 3) == 2\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'bitXor' (in container '')",
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/bitNot-supported=false",
+        expr: "math.bitNot(-1) == 0",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitNot(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(-1) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitNot(\n    -1^#3[1,12]#\n  )^#2[1,11]#,\n  0^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/bitNot-supported=false",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.bitNot(-1) == 0\n | ^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'bitNot' (in container '')\n | math.bitNot(-1) == 0\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'bitNot' (in container '')",
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/bitShiftLeft-supported=false",
+        expr: "math.bitShiftLeft(4, 2) == 16",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    4^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  16^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(4, 2) == 16",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    4^#3[1,18]#,\n    2^#4[1,21]#\n  )^#2[1,17]#,\n  16^#6[1,27]#\n)^#5[1,24]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 24, 26, 1, 24, 1, 26],
+        [6, 27, 29, 1, 27, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "16" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/bitShiftLeft-supported=false",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 24, "6": 27 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.bitShiftLeft(4, 2) == 16\n | ^\nERROR: \u003cinput\u003e:1:18: undeclared reference to 'bitShiftLeft' (in container '')\n | math.bitShiftLeft(4, 2) == 16\n | .................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'bitShiftLeft' (in container '')",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/bitShiftRight-supported=false",
+        expr: "math.bitShiftRight(4, 2) == 1",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    4^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(4, 2) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    4^#3[1,19]#,\n    2^#4[1,22]#\n  )^#2[1,18]#,\n  1^#6[1,28]#\n)^#5[1,25]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 20, 1, 19, 1, 20],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 25, 27, 1, 25, 1, 27],
+        [6, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/bitShiftRight-supported=false",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 22, "5": 25, "6": 28 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.bitShiftRight(4, 2) == 1\n | ^\nERROR: \u003cinput\u003e:1:19: undeclared reference to 'bitShiftRight' (in container '')\n | math.bitShiftRight(4, 2) == 1\n | ..................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'bitShiftRight' (in container '')",
+          id: 2,
+          offset: 18,
+          line: 1,
+          column: 18,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/sqrt-supported=false",
+        expr: "math.sqrt(25) == 5.0",
+      },
+      library: "math",
+      libraryVersion: 0,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    25^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(25) == 5.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    25^#3[1,10]#\n  )^#2[1,9]#,\n  5^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 20, 1, 17, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "25" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/sqrt-supported=false",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.sqrt(25) == 5.0\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'sqrt' (in container '')\n | math.sqrt(25) == 5.0\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'sqrt' (in container '')",
+          id: 2,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=1/greatest-supported=true",
+        expr: "math.greatest(1, 2) == 2",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1, 2) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1^#3[1,14]#,\n    2^#4[1,17]#\n  )^#2[1,13]#,\n  2^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/greatest-supported=true",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1~int,\n    2~int\n  )~int^math_@max_int_int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_int_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/least-supported=true",
+        expr: "math.least(2.1, -1.0) == -1.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    2.1^#*expr.Constant_DoubleValue#,\n    -1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(2.1, -1.0) == -1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    2.1^#3[1,11]#,\n    -1^#4[1,16]#\n  )^#2[1,10]#,\n  -1^#6[1,25]#\n)^#5[1,22]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 20, 1, 16, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 25, 29, 1, 25, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.1 } },
+                    { id: "4", constExpr: { doubleValue: -1 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/least-supported=true",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 22, "6": 25 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    2.1~double,\n    -1~double\n  )~double^math_@min_double_double,\n  -1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_double_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "3": 11, "4": 16, "5": 10, "6": 22, "7": 25 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 2.1 } },
+                  { id: "4", constExpr: { doubleValue: -1 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.1 } },
+                    { id: "4", constExpr: { doubleValue: -1 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/ceil-supported=true",
+        expr: "math.ceil(1.5) == 2.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.ceil(\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.ceil(1.5) == 2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.ceil(\n    1.5^#3[1,10]#\n  )^#2[1,9]#,\n  2^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/ceil-supported=true",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.ceil(\n    1.5~double\n  )~double^math_ceil_double,\n  2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_ceil_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/floor-supported=true",
+        expr: "math.floor(1.2) == 1.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.floor(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.floor(1.2) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.floor(\n    1.2^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "floor",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/floor-supported=true",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.floor(\n    1.2~double\n  )~double^math_floor_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_floor_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.floor",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/round-supported=true",
+        expr: "math.round(1.5) == 2.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.round(\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.round(1.5) == 2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.round(\n    1.5^#3[1,11]#\n  )^#2[1,10]#,\n  2^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/round-supported=true",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.round(\n    1.5~double\n  )~double^math_round_double,\n  2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_round_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/trunc-supported=true",
+        expr: "math.trunc(1.222) == 1.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.trunc(\n    1.222^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.trunc(1.222) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.trunc(\n    1.222^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,21]#\n)^#4[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 16, 1, 11, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 21, 24, 1, 21, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.222 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/trunc-supported=true",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 18, "5": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.trunc(\n    1.222~double\n  )~double^math_trunc_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_trunc_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 18, "5": 21 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.222 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/isInf-supported=true",
+        expr: "!math.isInf(0.0)",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "!_(\n  math^#*expr.Expr_IdentExpr#.isInf(\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!math.isInf(0.0)",
+      locationAst:
+        "!_(\n  math^#2[1,1]#.isInf(\n    0^#4[1,12]#\n  )^#3[1,11]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 12, 15, 1, 12, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "math" } },
+                  function: "isInf",
+                  args: [{ id: "4", constExpr: { doubleValue: 0 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/isInf-supported=true",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 12 },
+        },
+      },
+      checkedAst:
+        "!_(\n  math.isInf(\n    0~double\n  )~bool^math_isInf_double\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["math_isInf_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 12 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "math.isInf",
+                  args: [{ id: "4", constExpr: { doubleValue: 0 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/isNaN-supported=true",
+        expr: "math.isNaN(0.0/0.0)",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isNaN(\n  _/_(\n    0^#*expr.Constant_DoubleValue#,\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isNaN(0.0 / 0.0)",
+      locationAst:
+        "math^#1[1,0]#.isNaN(\n  _/_(\n    0^#3[1,11]#,\n    0^#5[1,15]#\n  )^#4[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 15, 18, 1, 15, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/isNaN-supported=true",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+      },
+      checkedAst:
+        "math.isNaN(\n  _/_(\n    0~double,\n    0~double\n  )~double^divide_double\n)~bool^math_isNaN_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isNaN_double"] },
+          "4": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/isFinite-supported=true",
+        expr: "math.isFinite(0.0)",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "math^#*expr.Expr_IdentExpr#.isFinite(\n  0^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isFinite(0.0)",
+      locationAst: "math^#1[1,0]#.isFinite(\n  0^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 17, 1, 14, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isFinite",
+            args: [{ id: "3", constExpr: { doubleValue: 0 } }],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/isFinite-supported=true",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst: "math.isFinite(\n  0~double\n)~bool^math_isFinite_double",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["math_isFinite_double"] } },
+        typeMap: { "2": { primitive: "BOOL" }, "3": { primitive: "DOUBLE" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isFinite",
+            args: [{ id: "3", constExpr: { doubleValue: 0 } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "version=1/abs-supported=true",
+        expr: "math.abs(1.2) == 1.2",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.abs(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1.2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.abs(1.2) == 1.2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.abs(\n    1.2^#3[1,9]#\n  )^#2[1,8]#,\n  1.2^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 12, 1, 9, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 20, 1, 17, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "abs",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1.2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/abs-supported=true",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 14, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.abs(\n    1.2~double\n  )~double^math_abs_double,\n  1.2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_abs_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 14, "5": 17 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.abs",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1.2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/sign-supported=true",
+        expr: "math.sign(-1) == -1",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(-1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    -1^#3[1,10]#\n  )^#2[1,9]#,\n  -1^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/sign-supported=true",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    -1~int\n  )~int^math_sign_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/bitAnd-supported=true",
+        expr: "math.bitAnd(1, 2) == 0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitAnd(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitAnd(1, 2) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitAnd(\n    1^#3[1,12]#,\n    2^#4[1,15]#\n  )^#2[1,11]#,\n  0^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/bitAnd-supported=true",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitAnd(\n    1~int,\n    2~int\n  )~int^math_bitAnd_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitAnd_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/bitOr-supported=true",
+        expr: "math.bitOr(1, 2) == 3",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitOr(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitOr(1, 2) == 3",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitOr(\n    1^#3[1,11]#,\n    2^#4[1,14]#\n  )^#2[1,10]#,\n  3^#6[1,20]#\n)^#5[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 17, 19, 1, 17, 1, 19],
+        [6, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitOr",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/bitOr-supported=true",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitOr(\n    1~int,\n    2~int\n  )~int^math_bitOr_int_int,\n  3~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitOr_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitOr",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/bitXor-supported=true",
+        expr: "math.bitXor(1, 3) == 2",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitXor(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(1, 3) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitXor(\n    1^#3[1,12]#,\n    3^#4[1,15]#\n  )^#2[1,11]#,\n  2^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/bitXor-supported=true",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitXor(\n    1~int,\n    3~int\n  )~int^math_bitXor_int_int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitXor_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/bitNot-supported=true",
+        expr: "math.bitNot(-1) == 0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitNot(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(-1) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitNot(\n    -1^#3[1,12]#\n  )^#2[1,11]#,\n  0^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/bitNot-supported=true",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitNot(\n    -1~int\n  )~int^math_bitNot_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitNot_int_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/bitShiftLeft-supported=true",
+        expr: "math.bitShiftLeft(4, 2) == 16",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    4^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  16^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(4, 2) == 16",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    4^#3[1,18]#,\n    2^#4[1,21]#\n  )^#2[1,17]#,\n  16^#6[1,27]#\n)^#5[1,24]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 24, 26, 1, 24, 1, 26],
+        [6, 27, 29, 1, 27, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "16" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/bitShiftLeft-supported=true",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 24, "6": 27 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    4~int,\n    2~int\n  )~int^math_bitShiftLeft_int_int,\n  16~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 24, "6": 27 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "16" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/bitShiftRight-supported=true",
+        expr: "math.bitShiftRight(4, 2) == 1",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    4^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(4, 2) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    4^#3[1,19]#,\n    2^#4[1,22]#\n  )^#2[1,18]#,\n  1^#6[1,28]#\n)^#5[1,25]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 20, 1, 19, 1, 20],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 25, 27, 1, 25, 1, 27],
+        [6, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/bitShiftRight-supported=true",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 22, "5": 25, "6": 28 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    4~int,\n    2~int\n  )~int^math_bitShiftRight_int_int,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 22, "5": 25, "6": 28 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/sqrt-supported=false",
+        expr: "math.sqrt(25) == 5.0",
+      },
+      library: "math",
+      libraryVersion: 1,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    25^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(25) == 5.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    25^#3[1,10]#\n  )^#2[1,9]#,\n  5^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 20, 1, 17, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "25" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/sqrt-supported=false",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'math' (in container '')\n | math.sqrt(25) == 5.0\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'sqrt' (in container '')\n | math.sqrt(25) == 5.0\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'math' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'sqrt' (in container '')",
+          id: 2,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=2/greatest-supported=true",
+        expr: "math.greatest(1, 2) == 2",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.greatest(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.greatest(1, 2) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.greatest(\n    1^#3[1,14]#,\n    2^#4[1,17]#\n  )^#2[1,13]#,\n  2^#6[1,23]#\n)^#5[1,20]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "greatest",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/greatest-supported=true",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 17, "5": 20, "6": 23 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@max(\n    1~int,\n    2~int\n  )~int^math_@max_int_int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@max_int_int"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "3": 14, "4": 17, "5": 13, "6": 20, "7": 23 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "greatest",
+                args: [
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@max",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/least-supported=true",
+        expr: "math.least(2.1, -1.0) == -1.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.least(\n    2.1^#*expr.Constant_DoubleValue#,\n    -1^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.least(2.1, -1.0) == -1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.least(\n    2.1^#3[1,11]#,\n    -1^#4[1,16]#\n  )^#2[1,10]#,\n  -1^#6[1,25]#\n)^#5[1,22]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 20, 1, 16, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 25, 29, 1, 25, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "least",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.1 } },
+                    { id: "4", constExpr: { doubleValue: -1 } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/least-supported=true",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 22, "6": 25 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.@min(\n    2.1~double,\n    -1~double\n  )~double^math_@min_double_double,\n  -1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["math_@min_double_double"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "3": 11, "4": 16, "5": 10, "6": 22, "7": 25 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "math" } },
+                function: "least",
+                args: [
+                  { id: "3", constExpr: { doubleValue: 2.1 } },
+                  { id: "4", constExpr: { doubleValue: -1 } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "math.@min",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 2.1 } },
+                    { id: "4", constExpr: { doubleValue: -1 } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { doubleValue: -1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/ceil-supported=true",
+        expr: "math.ceil(1.5) == 2.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.ceil(\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.ceil(1.5) == 2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.ceil(\n    1.5^#3[1,10]#\n  )^#2[1,9]#,\n  2^#5[1,18]#\n)^#4[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 13, 1, 10, 1, 13],
+        [4, 15, 17, 1, 15, 1, 17],
+        [5, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/ceil-supported=true",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.ceil(\n    1.5~double\n  )~double^math_ceil_double,\n  2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_ceil_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 15, "5": 18 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.ceil",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/floor-supported=true",
+        expr: "math.floor(1.2) == 1.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.floor(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.floor(1.2) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.floor(\n    1.2^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "floor",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/floor-supported=true",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.floor(\n    1.2~double\n  )~double^math_floor_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_floor_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.floor",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/round-supported=true",
+        expr: "math.round(1.5) == 2.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.round(\n    1.5^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.round(1.5) == 2.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.round(\n    1.5^#3[1,11]#\n  )^#2[1,10]#,\n  2^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 22, 1, 19, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/round-supported=true",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.round(\n    1.5~double\n  )~double^math_round_double,\n  2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_round_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.round",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.5 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/trunc-supported=true",
+        expr: "math.trunc(1.222) == 1.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.trunc(\n    1.222^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.trunc(1.222) == 1.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.trunc(\n    1.222^#3[1,11]#\n  )^#2[1,10]#,\n  1^#5[1,21]#\n)^#4[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 16, 1, 11, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 21, 24, 1, 21, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.222 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/trunc-supported=true",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 18, "5": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.trunc(\n    1.222~double\n  )~double^math_trunc_double,\n  1~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_trunc_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 18, "5": 21 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.trunc",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.222 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/isInf-supported=true",
+        expr: "!math.isInf(0.0)",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "!_(\n  math^#*expr.Expr_IdentExpr#.isInf(\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!math.isInf(0.0)",
+      locationAst:
+        "!_(\n  math^#2[1,1]#.isInf(\n    0^#4[1,12]#\n  )^#3[1,11]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 12, 15, 1, 12, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "math" } },
+                  function: "isInf",
+                  args: [{ id: "4", constExpr: { doubleValue: 0 } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/isInf-supported=true",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 12 },
+        },
+      },
+      checkedAst:
+        "!_(\n  math.isInf(\n    0~double\n  )~bool^math_isInf_double\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["math_isInf_double"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 1, "3": 11, "4": 12 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "math.isInf",
+                  args: [{ id: "4", constExpr: { doubleValue: 0 } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/isNaN-supported=true",
+        expr: "math.isNaN(0.0/0.0)",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "math^#*expr.Expr_IdentExpr#.isNaN(\n  _/_(\n    0^#*expr.Constant_DoubleValue#,\n    0^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isNaN(0.0 / 0.0)",
+      locationAst:
+        "math^#1[1,0]#.isNaN(\n  _/_(\n    0^#3[1,11]#,\n    0^#5[1,15]#\n  )^#4[1,14]#\n)^#2[1,10]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 15, 18, 1, 15, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/isNaN-supported=true",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+      },
+      checkedAst:
+        "math.isNaN(\n  _/_(\n    0~double,\n    0~double\n  )~double^divide_double\n)~bool^math_isNaN_double",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_isNaN_double"] },
+          "4": { overloadId: ["divide_double"] },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isNaN",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    { id: "3", constExpr: { doubleValue: 0 } },
+                    { id: "5", constExpr: { doubleValue: 0 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/isFinite-supported=true",
+        expr: "math.isFinite(0.0)",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "math^#*expr.Expr_IdentExpr#.isFinite(\n  0^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.isFinite(0.0)",
+      locationAst: "math^#1[1,0]#.isFinite(\n  0^#3[1,14]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 17, 1, 14, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "math" } },
+            function: "isFinite",
+            args: [{ id: "3", constExpr: { doubleValue: 0 } }],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/isFinite-supported=true",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst: "math.isFinite(\n  0~double\n)~bool^math_isFinite_double",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["math_isFinite_double"] } },
+        typeMap: { "2": { primitive: "BOOL" }, "3": { primitive: "DOUBLE" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "math.isFinite",
+            args: [{ id: "3", constExpr: { doubleValue: 0 } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "version=2/abs-supported=true",
+        expr: "math.abs(1.2) == 1.2",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.abs(\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  1.2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.abs(1.2) == 1.2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.abs(\n    1.2^#3[1,9]#\n  )^#2[1,8]#,\n  1.2^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 12, 1, 9, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 20, 1, 17, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "abs",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1.2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/abs-supported=true",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 14, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.abs(\n    1.2~double\n  )~double^math_abs_double,\n  1.2~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_abs_double"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 14, "5": 17 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.abs",
+                  args: [{ id: "3", constExpr: { doubleValue: 1.2 } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 1.2 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/sign-supported=true",
+        expr: "math.sign(-1) == -1",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sign(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sign(-1) == -1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sign(\n    -1^#3[1,10]#\n  )^#2[1,9]#,\n  -1^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sign",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/sign-supported=true",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sign(\n    -1~int\n  )~int^math_sign_int,\n  -1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sign_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sign",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/bitAnd-supported=true",
+        expr: "math.bitAnd(1, 2) == 0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitAnd(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitAnd(1, 2) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitAnd(\n    1^#3[1,12]#,\n    2^#4[1,15]#\n  )^#2[1,11]#,\n  0^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/bitAnd-supported=true",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitAnd(\n    1~int,\n    2~int\n  )~int^math_bitAnd_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitAnd_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitAnd",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/bitOr-supported=true",
+        expr: "math.bitOr(1, 2) == 3",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitOr(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitOr(1, 2) == 3",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitOr(\n    1^#3[1,11]#,\n    2^#4[1,14]#\n  )^#2[1,10]#,\n  3^#6[1,20]#\n)^#5[1,17]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 17, 19, 1, 17, 1, 19],
+        [6, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitOr",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/bitOr-supported=true",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitOr(\n    1~int,\n    2~int\n  )~int^math_bitOr_int_int,\n  3~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitOr_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitOr",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/bitXor-supported=true",
+        expr: "math.bitXor(1, 3) == 2",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitXor(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitXor(1, 3) == 2",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitXor(\n    1^#3[1,12]#,\n    3^#4[1,15]#\n  )^#2[1,11]#,\n  2^#6[1,21]#\n)^#5[1,18]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/bitXor-supported=true",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitXor(\n    1~int,\n    3~int\n  )~int^math_bitXor_int_int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitXor_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitXor",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/bitNot-supported=true",
+        expr: "math.bitNot(-1) == 0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitNot(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitNot(-1) == 0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitNot(\n    -1^#3[1,12]#\n  )^#2[1,11]#,\n  0^#5[1,19]#\n)^#4[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 14, 1, 12, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/bitNot-supported=true",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitNot(\n    -1~int\n  )~int^math_bitNot_int_int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitNot_int_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 16, "5": 19 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitNot",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/bitShiftLeft-supported=true",
+        expr: "math.bitShiftLeft(4, 2) == 16",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftLeft(\n    4^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  16^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftLeft(4, 2) == 16",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftLeft(\n    4^#3[1,18]#,\n    2^#4[1,21]#\n  )^#2[1,17]#,\n  16^#6[1,27]#\n)^#5[1,24]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 24, 26, 1, 24, 1, 26],
+        [6, 27, 29, 1, 27, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "16" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/bitShiftLeft-supported=true",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 24, "6": 27 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftLeft(\n    4~int,\n    2~int\n  )~int^math_bitShiftLeft_int_int,\n  16~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftLeft_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 21, "5": 24, "6": 27 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftLeft",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "16" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/bitShiftRight-supported=true",
+        expr: "math.bitShiftRight(4, 2) == 1",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.bitShiftRight(\n    4^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.bitShiftRight(4, 2) == 1",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.bitShiftRight(\n    4^#3[1,19]#,\n    2^#4[1,22]#\n  )^#2[1,18]#,\n  1^#6[1,28]#\n)^#5[1,25]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 20, 1, 19, 1, 20],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 25, 27, 1, 25, 1, 27],
+        [6, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/bitShiftRight-supported=true",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 22, "5": 25, "6": 28 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.bitShiftRight(\n    4~int,\n    2~int\n  )~int^math_bitShiftRight_int_int,\n  1~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_bitShiftRight_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 22, "5": 25, "6": 28 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.bitShiftRight",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=2/sqrt-supported=true",
+        expr: "math.sqrt(25) == 5.0",
+        value: { boolValue: true },
+      },
+      library: "math",
+      libraryVersion: 2,
+      ast: "_==_(\n  math^#*expr.Expr_IdentExpr#.sqrt(\n    25^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  5^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "math.sqrt(25) == 5.0",
+      locationAst:
+        "_==_(\n  math^#1[1,0]#.sqrt(\n    25^#3[1,10]#\n  )^#2[1,9]#,\n  5^#5[1,17]#\n)^#4[1,14]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 20, 1, 17, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "math" } },
+                  function: "sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "25" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 5 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=2/sqrt-supported=true",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  math.sqrt(\n    25~int\n  )~double^math_sqrt_int,\n  5~double\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["math_sqrt_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 14, "5": 17 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "math.sqrt",
+                  args: [{ id: "3", constExpr: { int64Value: "25" } }],
+                },
+              },
+              { id: "5", constExpr: { doubleValue: 5 } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+  ],
+} as const;
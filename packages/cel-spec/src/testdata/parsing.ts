@@ -20,1077 +20,9664 @@ export const tests: SerializedIncrementalTestSuite = {
     {
       original: { expr: '"A"' },
       ast: '"A"^#*expr.Constant_StringValue#',
+      unparsed: '"A"',
+      locationAst: '"A"^#1[1,0]#',
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "A" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"A"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "A" } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "A" } },
+      runtimeCost: "0",
+      expectedAst: '"A"^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: "true" },
       ast: "true^#*expr.Constant_BoolValue#",
+      unparsed: "true",
+      locationAst: "true^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: true } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "true~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { boolValue: true } },
+      },
       type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      expectedAst: "true^#1:*expr.Constant_BoolValue#",
     },
     {
       original: { expr: "false" },
       ast: "false^#*expr.Constant_BoolValue#",
+      unparsed: "false",
+      locationAst: "false^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: false } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "false~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { boolValue: false } },
+      },
       type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedAst: "false^#1:*expr.Constant_BoolValue#",
     },
     {
       original: { expr: "0" },
       ast: "0^#*expr.Constant_Int64Value#",
+      unparsed: "0",
+      locationAst: "0^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [2],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { int64Value: "0" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [2],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "0~int",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [2],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { int64Value: "0" } },
+      },
       type: "int",
+      cost: { min: "0", max: "0" },
+      result: { value: { int64Value: "0" } },
+      runtimeCost: "0",
+      expectedAst: "0^#1:*expr.Constant_Int64Value#",
     },
     {
       original: { expr: "42" },
       ast: "42^#*expr.Constant_Int64Value#",
+      unparsed: "42",
+      locationAst: "42^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { int64Value: "42" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "42~int",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { int64Value: "42" } },
+      },
       type: "int",
+      cost: { min: "0", max: "0" },
+      result: { value: { int64Value: "42" } },
+      runtimeCost: "0",
+      expectedAst: "42^#1:*expr.Constant_Int64Value#",
     },
     {
       original: { expr: "0xF" },
       ast: "15^#*expr.Constant_Int64Value#",
+      unparsed: "15",
+      locationAst: "15^#1[1,0]#",
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { int64Value: "15" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "15~int",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { int64Value: "15" } },
+      },
       type: "int",
+      cost: { min: "0", max: "0" },
+      result: { value: { int64Value: "15" } },
+      runtimeCost: "0",
+      expectedAst: "15^#1:*expr.Constant_Int64Value#",
     },
     {
       original: { expr: "0u" },
       ast: "0u^#*expr.Constant_Uint64Value#",
+      unparsed: "0u",
+      locationAst: "0u^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { uint64Value: "0" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "0u~uint",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "UINT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { uint64Value: "0" } },
+      },
       type: "uint",
+      cost: { min: "0", max: "0" },
+      result: { value: { uint64Value: "0" } },
+      runtimeCost: "0",
+      expectedAst: "0u^#1:*expr.Constant_Uint64Value#",
     },
     {
       original: { expr: "23u" },
       ast: "23u^#*expr.Constant_Uint64Value#",
+      unparsed: "23u",
+      locationAst: "23u^#1[1,0]#",
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { uint64Value: "23" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "23u~uint",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "UINT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { uint64Value: "23" } },
+      },
       type: "uint",
+      cost: { min: "0", max: "0" },
+      result: { value: { uint64Value: "23" } },
+      runtimeCost: "0",
+      expectedAst: "23u^#1:*expr.Constant_Uint64Value#",
     },
     {
       original: { expr: "24u" },
       ast: "24u^#*expr.Constant_Uint64Value#",
+      unparsed: "24u",
+      locationAst: "24u^#1[1,0]#",
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { uint64Value: "24" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "24u~uint",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "UINT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { uint64Value: "24" } },
+      },
       type: "uint",
+      cost: { min: "0", max: "0" },
+      result: { value: { uint64Value: "24" } },
+      runtimeCost: "0",
+      expectedAst: "24u^#1:*expr.Constant_Uint64Value#",
     },
     {
       original: { expr: "0xFu" },
       ast: "15u^#*expr.Constant_Uint64Value#",
+      unparsed: "15u",
+      locationAst: "15u^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { uint64Value: "15" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "15u~uint",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "UINT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { uint64Value: "15" } },
+      },
       type: "uint",
+      cost: { min: "0", max: "0" },
+      result: { value: { uint64Value: "15" } },
+      runtimeCost: "0",
+      expectedAst: "15u^#1:*expr.Constant_Uint64Value#",
     },
     {
       original: { expr: "-1" },
       ast: "-1^#*expr.Constant_Int64Value#",
+      unparsed: "-1",
+      locationAst: "-1^#1[1,0]#",
+      positions: [[1, 0, 2, 1, 0, 1, 2]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { int64Value: "-1" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "-1~int",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { int64Value: "-1" } },
+      },
       type: "int",
+      cost: { min: "0", max: "0" },
+      result: { value: { int64Value: "-1" } },
+      runtimeCost: "0",
+      expectedAst: "-1^#1:*expr.Constant_Int64Value#",
     },
     {
       original: { expr: "4--4" },
       ast: "_-_(\n  4^#*expr.Constant_Int64Value#,\n  -4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "4 - -4",
+      locationAst: "_-_(\n  4^#1[1,0]#,\n  -4^#3[1,2]#\n)^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", constExpr: { int64Value: "4" } },
+              { id: "3", constExpr: { int64Value: "-4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 1, "3": 2 },
+        },
+      },
       checkedAst: "_-_(\n  4~int,\n  -4~int\n)~int^subtract_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["subtract_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 1, "3": 2 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", constExpr: { int64Value: "4" } },
+              { id: "3", constExpr: { int64Value: "-4" } },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "8" } },
+      runtimeCost: "1",
+      expectedAst:
+        "_-_(\n\t\t\t4^#1:*expr.Constant_Int64Value#,\n\t\t\t-4^#3:*expr.Constant_Int64Value#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "4--4.1" },
       ast: "_-_(\n  4^#*expr.Constant_Int64Value#,\n  -4.1^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "4 - -4.1",
+      locationAst: "_-_(\n  4^#1[1,0]#,\n  -4.1^#3[1,2]#\n)^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 6, 1, 2, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", constExpr: { int64Value: "4" } },
+              { id: "3", constExpr: { doubleValue: -4.1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 1, "3": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: found no matching overload for '_-_' applied to '(int, double)'\n | 4--4.1\n | .^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_-_' applied to '(int, double)'",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedAst:
+        "_-_(\n\t\t\t4^#1:*expr.Constant_Int64Value#,\n\t\t\t-4.1^#3:*expr.Constant_DoubleValue#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: 'b"abc"' },
       ast: 'b"abc"^#*expr.Constant_BytesValue#',
+      unparsed: 'b"\\141\\142\\143"',
+      locationAst: 'b"abc"^#1[1,0]#',
+      positions: [[1, 0, 6, 1, 0, 1, 6]],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { bytesValue: "YWJj" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: 'b"abc"~bytes',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { bytesValue: "YWJj" } },
+      },
       type: "bytes",
+      cost: { min: "0", max: "0" },
+      result: { value: { bytesValue: "YWJj" } },
+      runtimeCost: "0",
+      expectedAst: 'b"abc"^#1:*expr.Constant_BytesValue#',
     },
     {
       original: { expr: "23.39" },
       ast: "23.39^#*expr.Constant_DoubleValue#",
+      unparsed: "23.39",
+      locationAst: "23.39^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { doubleValue: 23.39 } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "23.39~double",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "DOUBLE" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { doubleValue: 23.39 } },
+      },
       type: "double",
+      cost: { min: "0", max: "0" },
+      result: { value: { doubleValue: 23.39 } },
+      runtimeCost: "0",
+      expectedAst: "23.39^#1:*expr.Constant_DoubleValue#",
     },
     {
       original: { expr: "!a" },
       ast: "!_(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!a",
+      locationAst: "!_(\n  a^#2[1,1]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | !a\n | .^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedAst:
+        "!_(\n\t\t\ta^#2:*expr.Expr_IdentExpr#\n\t\t)^#1:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "null" },
       ast: "null^#*expr.Constant_NullValue#",
+      unparsed: "null",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
       type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+      expectedAst: "null^#1:*expr.Constant_NullValue#",
     },
     {
       original: { expr: "a" },
       ast: "a^#*expr.Expr_IdentExpr#",
+      unparsed: "a",
+      locationAst: "a^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [2],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "a" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [2],
+          positions: { "1": 0 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#",
     },
     {
       original: { expr: "a?b:c" },
       ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ? b : c",
+      locationAst:
+        "_?_:_(\n  a^#1[1,0]#,\n  b^#3[1,2]#,\n  c^#4[1,4]#\n)^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a?b:c\n | ^\nERROR: \u003cinput\u003e:1:3: undeclared reference to 'b' (in container '')\n | a?b:c\n | ..^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'c' (in container '')\n | a?b:c\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "_?_:_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#,\n\t\t\tc^#4:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a || b" },
       ast: "_||_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a || b",
+      locationAst: "_||_(\n  a^#1[1,0]#,\n  b^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a || b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a || b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        "_||_(\n    \t\t  a^#1:*expr.Expr_IdentExpr#,\n    \t\t  b^#2:*expr.Expr_IdentExpr#\n\t\t\t)^#3:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a || b || c || d || e || f " },
       ast: "_||_(\n  _||_(\n    _||_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    _||_(\n      d^#*expr.Expr_IdentExpr#,\n      e^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    f^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a || b || c || d || e || f",
+      locationAst:
+        "_||_(\n  _||_(\n    _||_(\n      a^#1[1,0]#,\n      b^#2[1,5]#\n    )^#3[1,2]#,\n    c^#4[1,10]#\n  )^#5[1,7]#,\n  _||_(\n    _||_(\n      d^#6[1,15]#,\n      e^#8[1,20]#\n    )^#9[1,17]#,\n    f^#10[1,25]#\n  )^#11[1,22]#\n)^#7[1,12]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 17, 19, 1, 17, 1, 19],
+        [10, 25, 26, 1, 25, 1, 26],
+        [11, 22, 24, 1, 22, 1, 24],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_||_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "2", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "4", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_||_",
+                        args: [
+                          { id: "6", identExpr: { name: "d" } },
+                          { id: "8", identExpr: { name: "e" } },
+                        ],
+                      },
+                    },
+                    { id: "10", identExpr: { name: "f" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+            "8": 20,
+            "9": 17,
+            "10": 25,
+            "11": 22,
+          },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a || b || c || d || e || f \n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a || b || c || d || e || f \n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a || b || c || d || e || f \n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a || b || c || d || e || f \n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a || b || c || d || e || f \n | ....................^\nERROR: \u003cinput\u003e:1:26: undeclared reference to 'f' (in container '')\n | a || b || c || d || e || f \n | .........................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 8,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 10,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+      ],
+      expectedAst:
+        " _||_(\n\t\t\t_||_(\n\t\t\t  _||_(\n\t\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\t\tb^#2:*expr.Expr_IdentExpr#\n\t\t\t  )^#3:*expr.Expr_CallExpr#,\n\t\t\t  c^#4:*expr.Expr_IdentExpr#\n\t\t\t)^#5:*expr.Expr_CallExpr#,\n\t\t\t_||_(\n\t\t\t  _||_(\n\t\t\t\td^#6:*expr.Expr_IdentExpr#,\n\t\t\t\te^#8:*expr.Expr_IdentExpr#\n\t\t\t  )^#9:*expr.Expr_CallExpr#,\n\t\t\t  f^#10:*expr.Expr_IdentExpr#\n\t\t\t)^#11:*expr.Expr_CallExpr#\n\t\t  )^#7:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a \u0026\u0026 b" },
       ast: "_\u0026\u0026_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 b",
+      locationAst: "_\u0026\u0026_(\n  a^#1[1,0]#,\n  b^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u0026\u0026 b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u0026\u0026 b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        "_\u0026\u0026_(\n    \t\t  a^#1:*expr.Expr_IdentExpr#,\n    \t\t  b^#2:*expr.Expr_IdentExpr#\n\t\t\t)^#3:*expr.Expr_CallExpr#",
     },
     {
       original: {
         expr: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g",
       },
       ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      c^#*expr.Expr_IdentExpr#,\n      d^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      e^#*expr.Expr_IdentExpr#,\n      f^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    g^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      a^#1[1,0]#,\n      b^#2[1,5]#\n    )^#3[1,2]#,\n    _\u0026\u0026_(\n      c^#4[1,10]#,\n      d^#6[1,15]#\n    )^#7[1,12]#\n  )^#5[1,7]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      e^#8[1,20]#,\n      f^#10[1,25]#\n    )^#11[1,22]#,\n    g^#12[1,30]#\n  )^#13[1,27]#\n)^#9[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 17, 19, 1, 17, 1, 19],
+        [10, 25, 26, 1, 25, 1, 26],
+        [11, 22, 24, 1, 22, 1, 24],
+        [12, 30, 31, 1, 30, 1, 31],
+        [13, 27, 29, 1, 27, 1, 29],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "2", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "4", identExpr: { name: "c" } },
+                          { id: "6", identExpr: { name: "d" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "13",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "11",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "8", identExpr: { name: "e" } },
+                          { id: "10", identExpr: { name: "f" } },
+                        ],
+                      },
+                    },
+                    { id: "12", identExpr: { name: "g" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+            "8": 20,
+            "9": 17,
+            "10": 25,
+            "11": 22,
+            "12": 30,
+            "13": 27,
+          },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g\n | ....................^\nERROR: \u003cinput\u003e:1:26: undeclared reference to 'f' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g\n | .........................^\nERROR: \u003cinput\u003e:1:31: undeclared reference to 'g' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e \u0026\u0026 f \u0026\u0026 g\n | ..............................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 8,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 10,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'g' (in container '')",
+          id: 12,
+          offset: 30,
+          line: 1,
+          column: 30,
+        },
+      ],
+      expectedAst:
+        "_\u0026\u0026_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\t\tb^#2:*expr.Expr_IdentExpr#\n\t\t\t  )^#3:*expr.Expr_CallExpr#,\n\t\t\t  _\u0026\u0026_(\n\t\t\t\tc^#4:*expr.Expr_IdentExpr#,\n\t\t\t\td^#6:*expr.Expr_IdentExpr#\n\t\t\t  )^#7:*expr.Expr_CallExpr#\n\t\t\t)^#5:*expr.Expr_CallExpr#,\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\te^#8:*expr.Expr_IdentExpr#,\n\t\t\t\tf^#10:*expr.Expr_IdentExpr#\n\t\t\t  )^#11:*expr.Expr_CallExpr#,\n\t\t\t  g^#12:*expr.Expr_IdentExpr#\n\t\t\t)^#13:*expr.Expr_CallExpr#\n\t\t  )^#9:*expr.Expr_CallExpr#",
     },
     {
       original: {
         expr: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h",
       },
       ast: "_||_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      c^#*expr.Expr_IdentExpr#,\n      d^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      e^#*expr.Expr_IdentExpr#,\n      f^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _\u0026\u0026_(\n      g^#*expr.Expr_IdentExpr#,\n      h^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h",
+      locationAst:
+        "_||_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      a^#1[1,0]#,\n      b^#2[1,5]#\n    )^#3[1,2]#,\n    _\u0026\u0026_(\n      c^#4[1,10]#,\n      d^#6[1,15]#\n    )^#7[1,12]#\n  )^#5[1,7]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      e^#8[1,20]#,\n      f^#9[1,25]#\n    )^#10[1,22]#,\n    _\u0026\u0026_(\n      g^#11[1,30]#,\n      h^#13[1,35]#\n    )^#14[1,32]#\n  )^#12[1,27]#\n)^#15[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 25, 26, 1, 25, 1, 26],
+        [10, 22, 24, 1, 22, 1, 24],
+        [11, 30, 31, 1, 30, 1, 31],
+        [12, 27, 29, 1, 27, 1, 29],
+        [13, 35, 36, 1, 35, 1, 36],
+        [14, 32, 34, 1, 32, 1, 34],
+        [15, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "2", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "4", identExpr: { name: "c" } },
+                          { id: "6", identExpr: { name: "d" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "10",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "8", identExpr: { name: "e" } },
+                          { id: "9", identExpr: { name: "f" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "14",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "11", identExpr: { name: "g" } },
+                          { id: "13", identExpr: { name: "h" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+            "8": 20,
+            "9": 25,
+            "10": 22,
+            "11": 30,
+            "12": 27,
+            "13": 35,
+            "14": 32,
+            "15": 17,
+          },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h\n | ....................^\nERROR: \u003cinput\u003e:1:26: undeclared reference to 'f' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h\n | .........................^\nERROR: \u003cinput\u003e:1:31: undeclared reference to 'g' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h\n | ..............................^\nERROR: \u003cinput\u003e:1:36: undeclared reference to 'h' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d || e \u0026\u0026 f \u0026\u0026 g \u0026\u0026 h\n | ...................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 8,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 9,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'g' (in container '')",
+          id: 11,
+          offset: 30,
+          line: 1,
+          column: 30,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'h' (in container '')",
+          id: 13,
+          offset: 35,
+          line: 1,
+          column: 35,
+        },
+      ],
+      expectedAst:
+        "_||_(\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\t\tb^#2:*expr.Expr_IdentExpr#\n\t\t\t  )^#3:*expr.Expr_CallExpr#,\n\t\t\t  _\u0026\u0026_(\n\t\t\t\tc^#4:*expr.Expr_IdentExpr#,\n\t\t\t\td^#6:*expr.Expr_IdentExpr#\n\t\t\t  )^#7:*expr.Expr_CallExpr#\n\t\t\t)^#5:*expr.Expr_CallExpr#,\n\t\t\t_\u0026\u0026_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\te^#8:*expr.Expr_IdentExpr#,\n\t\t\t\tf^#9:*expr.Expr_IdentExpr#\n\t\t\t  )^#10:*expr.Expr_CallExpr#,\n\t\t\t  _\u0026\u0026_(\n\t\t\t\tg^#11:*expr.Expr_IdentExpr#,\n\t\t\t\th^#13:*expr.Expr_IdentExpr#\n\t\t\t  )^#14:*expr.Expr_CallExpr#\n\t\t\t)^#12:*expr.Expr_CallExpr#\n\t\t  )^#15:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a + b" },
       ast: "_+_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a + b",
+      locationAst: "_+_(\n  a^#1[1,0]#,\n  b^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a + b\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a + b\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "_+_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a - b" },
       ast: "_-_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a - b",
+      locationAst: "_-_(\n  a^#1[1,0]#,\n  b^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a - b\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a - b\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "_-_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a * b" },
       ast: "_*_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a * b",
+      locationAst: "_*_(\n  a^#1[1,0]#,\n  b^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_*_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a * b\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a * b\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "_*_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a / b" },
       ast: "_/_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a / b",
+      locationAst: "_/_(\n  a^#1[1,0]#,\n  b^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_/_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a / b\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a / b\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "_/_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a % b" },
       ast: "_%_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a % b",
+      locationAst: "_%_(\n  a^#1[1,0]#,\n  b^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_%_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a % b\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a % b\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "_%_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a in b" },
       ast: "@in(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a in b",
+      locationAst: "@in(\n  a^#1[1,0]#,\n  b^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a in b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a in b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        "@in(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a == b" },
       ast: "_==_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a == b",
+      locationAst: "_==_(\n  a^#1[1,0]#,\n  b^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a == b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a == b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        "_==_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a != b" },
       ast: "_!=_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a != b",
+      locationAst: "_!=_(\n  a^#1[1,0]#,\n  b^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a != b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a != b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        " _!=_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a \u003e b" },
       ast: "_\u003e_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003e b",
+      locationAst: "_\u003e_(\n  a^#1[1,0]#,\n  b^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003e b\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a \u003e b\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "_\u003e_(\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a \u003e= b" },
       ast: "_\u003e=_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003e= b",
+      locationAst: "_\u003e=_(\n  a^#1[1,0]#,\n  b^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003e= b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u003e= b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        "_\u003e=_(\n    \t\t  a^#1:*expr.Expr_IdentExpr#,\n    \t\t  b^#3:*expr.Expr_IdentExpr#\n\t\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a \u003c b" },
       ast: "_\u003c_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003c b",
+      locationAst: "_\u003c_(\n  a^#1[1,0]#,\n  b^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003c b\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a \u003c b\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "_\u003c_(\n    \t\t  a^#1:*expr.Expr_IdentExpr#,\n    \t\t  b^#3:*expr.Expr_IdentExpr#\n\t\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a \u003c= b" },
       ast: "_\u003c=_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003c= b",
+      locationAst: "_\u003c=_(\n  a^#1[1,0]#,\n  b^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c=_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003c= b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u003c= b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        "_\u003c=_(\n    \t\t  a^#1:*expr.Expr_IdentExpr#,\n    \t\t  b^#3:*expr.Expr_IdentExpr#\n\t\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a.b" },
       ast: "a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#",
+      unparsed: "a.b",
+      locationAst: "a^#1[1,0]#.b^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "a" } },
+            field: "b",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.b\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#.b^#2:*expr.Expr_SelectExpr#",
     },
     {
       original: { expr: "a.b.c" },
       ast: "a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#.c^#*expr.Expr_SelectExpr#",
+      unparsed: "a.b.c",
+      locationAst: "a^#1[1,0]#.b^#2[1,1]#.c^#3[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          selectExpr: {
+            operand: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+            field: "c",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.b.c\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst:
+        "a^#1:*expr.Expr_IdentExpr#.b^#2:*expr.Expr_SelectExpr#.c^#3:*expr.Expr_SelectExpr#",
     },
     {
       original: { expr: "a[b]" },
       ast: "_[_](\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a[b]",
+      locationAst: "_[_](\n  a^#1[1,0]#,\n  b^#3[1,2]#\n)^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 1, "3": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a[b]\n | ^\nERROR: \u003cinput\u003e:1:3: undeclared reference to 'b' (in container '')\n | a[b]\n | ..^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedAst:
+        "_[_](\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "foo{ }" },
       ast: "foo{}^#*expr.Expr_StructExpr#",
+      unparsed: "foo{}",
+      locationAst: "foo{}^#1[1,3]#",
+      positions: [[1, 3, 4, 1, 3, 1, 4]],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: { id: "1", structExpr: { messageName: "foo" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 3 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'foo' (in container '')\n | foo{ }\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'foo' (in container '')",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedAst: "foo{}^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "foo{ a:b }" },
       ast: "foo{\n  a:b^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "foo{a: b}",
+      locationAst: "foo{\n  a:b^#3[1,7]#^#2[1,6]#\n}^#1[1,3]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "foo",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "a",
+                value: { id: "3", identExpr: { name: "b" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 3, "2": 6, "3": 7 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'foo' (in container '')\n | foo{ a:b }\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'foo' (in container '')",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedAst:
+        "foo{\n\t\t\ta:b^#3:*expr.Expr_IdentExpr#^#2:*expr.Expr_CreateStruct_Entry#\n\t\t}^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "foo{ a:b, c:d }" },
       ast: "foo{\n  a:b^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#,\n  c:d^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "foo{a: b, c: d}",
+      locationAst:
+        "foo{\n  a:b^#3[1,7]#^#2[1,6]#,\n  c:d^#5[1,12]#^#4[1,11]#\n}^#1[1,3]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "foo",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "a",
+                value: { id: "3", identExpr: { name: "b" } },
+              },
+              {
+                id: "4",
+                fieldKey: "c",
+                value: { id: "5", identExpr: { name: "d" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 3, "2": 6, "3": 7, "4": 11, "5": 12 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'foo' (in container '')\n | foo{ a:b, c:d }\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'foo' (in container '')",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedAst:
+        "foo{\n\t\t\ta:b^#3:*expr.Expr_IdentExpr#^#2:*expr.Expr_CreateStruct_Entry#,\n\t\t\tc:d^#5:*expr.Expr_IdentExpr#^#4:*expr.Expr_CreateStruct_Entry#\n\t\t}^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "{}" },
       ast: "{}^#*expr.Expr_StructExpr#",
+      unparsed: "{}",
+      locationAst: "{}^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", structExpr: {} },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "{}~map(dyn, dyn)",
+      checkedExpr: {
+        typeMap: {
+          "1": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", structExpr: {} },
+      },
       type: "map(dyn, dyn)",
+      cost: { min: "30", max: "30" },
+      result: { value: { mapValue: {} } },
+      runtimeCost: "30",
+      expectedAst: "{}^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "{a:b, c:d}" },
       ast: "{\n  a^#*expr.Expr_IdentExpr#:b^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#,\n  c^#*expr.Expr_IdentExpr#:d^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "{a: b, c: d}",
+      locationAst:
+        "{\n  a^#3[1,1]#:b^#4[1,3]#^#2[1,2]#,\n  c^#6[1,6]#:d^#7[1,8]#^#5[1,7]#\n}^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 3, 4, 1, 3, 1, 4],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 6, 7, 1, 6, 1, 7],
+        [7, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", identExpr: { name: "a" } },
+                value: { id: "4", identExpr: { name: "b" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", identExpr: { name: "c" } },
+                value: { id: "7", identExpr: { name: "d" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 2, "3": 1, "4": 3, "5": 7, "6": 6, "7": 8 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | {a:b, c:d}\n | .^\nERROR: \u003cinput\u003e:1:4: undeclared reference to 'b' (in container '')\n | {a:b, c:d}\n | ...^\nERROR: \u003cinput\u003e:1:7: undeclared reference to 'c' (in container '')\n | {a:b, c:d}\n | ......^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'd' (in container '')\n | {a:b, c:d}\n | ........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 4,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 6,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedAst:
+        "{\n\t\t\ta^#3:*expr.Expr_IdentExpr#:b^#4:*expr.Expr_IdentExpr#^#2:*expr.Expr_CreateStruct_Entry#,\n\t\t\tc^#6:*expr.Expr_IdentExpr#:d^#7:*expr.Expr_IdentExpr#^#5:*expr.Expr_CreateStruct_Entry#\n\t\t}^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "[]" },
       ast: "[]^#*expr.Expr_ListExpr#",
+      unparsed: "[]",
+      locationAst: "[]^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", listExpr: {} },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: "[]~list(dyn)",
+      checkedExpr: {
+        typeMap: { "1": { listType: { elemType: { dyn: {} } } } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", listExpr: {} },
+      },
       type: "list(dyn)",
+      cost: { min: "10", max: "10" },
+      result: { value: { listValue: {} } },
+      runtimeCost: "10",
+      expectedAst: "[]^#1:*expr.Expr_ListExpr#",
     },
     {
       original: { expr: "[a]" },
       ast: "[\n  a^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[a]",
+      locationAst: "[\n  a^#2[1,1]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: { elements: [{ id: "2", identExpr: { name: "a" } }] },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | [a]\n | .^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedAst:
+        "[\n\t\t\ta^#2:*expr.Expr_IdentExpr#\n\t\t]^#1:*expr.Expr_ListExpr#",
     },
     {
       original: { expr: "[a, b, c]" },
       ast: "[\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[a, b, c]",
+      locationAst: "[\n  a^#2[1,1]#,\n  b^#3[1,4]#,\n  c^#4[1,7]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | [a, b, c]\n | .^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | [a, b, c]\n | ....^\nERROR: \u003cinput\u003e:1:8: undeclared reference to 'c' (in container '')\n | [a, b, c]\n | .......^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 7,
+          line: 1,
+          column: 7,
+        },
+      ],
+      expectedAst:
+        "[\n\t\t\ta^#2:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#,\n\t\t\tc^#4:*expr.Expr_IdentExpr#\n\t\t]^#1:*expr.Expr_ListExpr#",
     },
     {
       original: { expr: "(a)" },
       ast: "a^#*expr.Expr_IdentExpr#",
+      unparsed: "a",
+      locationAst: "a^#1[1,1]#",
+      positions: [[1, 1, 2, 1, 1, 1, 2]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "a" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 1 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | (a)\n | .^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#",
     },
     {
       original: { expr: "((a))" },
       ast: "a^#*expr.Expr_IdentExpr#",
+      unparsed: "a",
+      locationAst: "a^#1[1,2]#",
+      positions: [[1, 2, 3, 1, 2, 1, 3]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "a" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:3: undeclared reference to 'a' (in container '')\n | ((a))\n | ..^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#",
     },
     {
       original: { expr: "a()" },
       ast: "a()^#*expr.Expr_CallExpr#",
+      unparsed: "a()",
+      locationAst: "a()^#1[1,1]#",
+      positions: [[1, 1, 2, 1, 1, 1, 2]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", callExpr: { function: "a" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 1 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | a()\n | .^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedAst: "a()^#1:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a(b)" },
       ast: "a(\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a(b)",
+      locationAst: "a(\n  b^#2[1,2]#\n)^#1[1,1]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 2, 3, 1, 2, 1, 3],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "a",
+            args: [{ id: "2", identExpr: { name: "b" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 1, "2": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | a(b)\n | .^\nERROR: \u003cinput\u003e:1:3: undeclared reference to 'b' (in container '')\n | a(b)\n | ..^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedAst:
+        "a(\n\t\t\tb^#2:*expr.Expr_IdentExpr#\n\t\t)^#1:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a(b, c)" },
       ast: "a(\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a(b, c)",
+      locationAst: "a(\n  b^#2[1,2]#,\n  c^#3[1,5]#\n)^#1[1,1]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "a",
+            args: [
+              { id: "2", identExpr: { name: "b" } },
+              { id: "3", identExpr: { name: "c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 1, "2": 2, "3": 5 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | a(b, c)\n | .^\nERROR: \u003cinput\u003e:1:3: undeclared reference to 'b' (in container '')\n | a(b, c)\n | ..^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'c' (in container '')\n | a(b, c)\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        "a(\n\t\t\tb^#2:*expr.Expr_IdentExpr#,\n\t\t\tc^#3:*expr.Expr_IdentExpr#\n\t\t)^#1:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a.b()" },
       ast: "a^#*expr.Expr_IdentExpr#.b()^#*expr.Expr_CallExpr#",
+      unparsed: "a.b()",
+      locationAst: "a^#1[1,0]#.b()^#2[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "a" } },
+            function: "b",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 3 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.b()\n | ^\nERROR: \u003cinput\u003e:1:4: undeclared reference to 'b' (in container '')\n | a.b()\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#.b()^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a.b(c)" },
       ast: "a^#*expr.Expr_IdentExpr#.b(\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a.b(c)",
+      locationAst: "a^#1[1,0]#.b(\n  c^#3[1,4]#\n)^#2[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "a" } },
+            function: "b",
+            args: [{ id: "3", identExpr: { name: "c" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 3, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.b(c)\n | ^\nERROR: \u003cinput\u003e:1:4: undeclared reference to 'b' (in container '')\n | a.b(c)\n | ...^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'c' (in container '')\n | a.b(c)\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "a^#1:*expr.Expr_IdentExpr#.b(\n\t\t\tc^#3:*expr.Expr_IdentExpr#\n\t\t)^#2:*expr.Expr_CallExpr#",
+      expectedLocationAst:
+        "a^#1[1,0]#.b(\n    \t\t  c^#3[1,4]#\n    \t\t)^#2[1,3]#",
     },
     {
       original: { expr: "0xFFFFFFFFFFFFFFFFF" },
-      error: "ERROR: :1:1: invalid int literal\n | 0xFFFFFFFFFFFFFFFFF\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: invalid int literal\n | 0xFFFFFFFFFFFFFFFFF\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "invalid int literal",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: invalid int literal\n\t\t| 0xFFFFFFFFFFFFFFFFF\n\t\t| ^",
     },
     {
       original: { expr: "0xFFFFFFFFFFFFFFFFFu" },
-      error: "ERROR: :1:1: invalid uint literal\n | 0xFFFFFFFFFFFFFFFFFu\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: invalid uint literal\n | 0xFFFFFFFFFFFFFFFFFu\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "invalid uint literal",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: invalid uint literal\n\t\t| 0xFFFFFFFFFFFFFFFFFu\n\t\t| ^",
     },
     {
       original: { expr: "1.99e90000009" },
-      error: "ERROR: :1:1: invalid double literal\n | 1.99e90000009\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: invalid double literal\n | 1.99e90000009\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "invalid double literal",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: invalid double literal\n\t\t| 1.99e90000009\n\t\t| ^",
     },
     {
       original: { expr: "*@a | b" },
       error:
-        "ERROR: :1:1: Syntax error: extraneous input '*' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | *@a | b\n | ^\nERROR: :1:2: Syntax error: token recognition error at: '@'\n | *@a | b\n | .^\nERROR: :1:5: Syntax error: token recognition error at: '| '\n | *@a | b\n | ....^\nERROR: :1:7: Syntax error: extraneous input 'b' expecting \u003cEOF\u003e\n | *@a | b\n | ......^",
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: extraneous input '*' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | *@a | b\n | ^\nERROR: \u003cinput\u003e:1:2: Syntax error: token recognition error at: '@'\n | *@a | b\n | .^\nERROR: \u003cinput\u003e:1:5: Syntax error: token recognition error at: '| '\n | *@a | b\n | ....^\nERROR: \u003cinput\u003e:1:7: Syntax error: extraneous input 'b' expecting \u003cEOF\u003e\n | *@a | b\n | ......^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input '*' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '@'",
+          id: 0,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '| '",
+          id: 0,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input 'b' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: extraneous input '*' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| *@a | b\n\t\t| ^\n\t\tERROR: \u003cinput\u003e:1:2: Syntax error: token recognition error at: '@'\n\t\t| *@a | b\n\t\t| .^\n\t\tERROR: \u003cinput\u003e:1:5: Syntax error: token recognition error at: '| '\n\t\t| *@a | b\n\t\t| ....^\n\t\tERROR: \u003cinput\u003e:1:7: Syntax error: extraneous input 'b' expecting \u003cEOF\u003e\n\t\t| *@a | b\n\t\t| ......^",
     },
     {
       original: { expr: "a | b" },
       error:
-        "ERROR: :1:3: Syntax error: token recognition error at: '| '\n | a | b\n | ..^\nERROR: :1:5: Syntax error: extraneous input 'b' expecting \u003cEOF\u003e\n | a | b\n | ....^",
+        "ERROR: \u003cinput\u003e:1:3: Syntax error: token recognition error at: '| '\n | a | b\n | ..^\nERROR: \u003cinput\u003e:1:5: Syntax error: extraneous input 'b' expecting \u003cEOF\u003e\n | a | b\n | ....^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '| '",
+          id: 0,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input 'b' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:3: Syntax error: token recognition error at: '| '\n\t\t| a | b\n\t\t| ..^\n\t\tERROR: \u003cinput\u003e:1:5: Syntax error: extraneous input 'b' expecting \u003cEOF\u003e\n\t\t| a | b\n\t\t| ....^",
     },
     {
       original: { expr: "has(m.f)" },
       ast: "m^#*expr.Expr_IdentExpr#.f~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(m.f)",
+      locationAst: "m^#2[1,4]#.f~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "m" } },
+            field: "f",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "2": 4, "3": 5, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "m" } },
+                      field: "f",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "m" } },
+                field: "f",
+              },
+            },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:5: undeclared reference to 'm' (in container '')\n | has(m.f)\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 2,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "m^#2:*expr.Expr_IdentExpr#.f~test-only~^#4:*expr.Expr_SelectExpr#",
+      expectedLocationAst: "m^#2[1,4]#.f~test-only~^#4[1,3]#",
+      expectedMacroCalls:
+        "has(\n\t\t\tm^#2:*expr.Expr_IdentExpr#.f^#3:*expr.Expr_SelectExpr#\n\t\t  )^#4:has#",
     },
     {
       original: { expr: "has(m)" },
       error:
-        "ERROR: :1:5: invalid argument to has() macro\n | has(m)\n | ....^",
+        "ERROR: \u003cinput\u003e:1:5: invalid argument to has() macro\n | has(m)\n | ....^",
+      errors: [
+        {
+          category: "syntax",
+          message: "invalid argument to has() macro",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:5: invalid argument to has() macro\n             | has(m)\n             | ....^",
     },
     {
       original: { expr: "m.exists(v, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    f^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.exists(v, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#5[1,8]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#6[1,8]#\n    )^#7[1,8]#\n  )^#8[1,8]#,\n  // LoopStep\n  _||_(\n    @result^#9[1,8]#,\n    f^#4[1,12]#\n  )^#10[1,8]#,\n  // Result\n  @result^#11[1,8]#)^#12[1,8]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 8, 8, 1, 8, 1, 8],
+        [6, 8, 8, 1, 8, 1, 8],
+        [7, 8, 8, 1, 8, 1, 8],
+        [8, 8, 8, 1, 8, 1, 8],
+        [9, 8, 8, 1, 8, 1, 8],
+        [10, 8, 8, 1, 8, 1, 8],
+        [11, 8, 8, 1, 8, 1, 8],
+        [12, 8, 8, 1, 8, 1, 8],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "12",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "8",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "6", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "10",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "9", identExpr: { name: "@result" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+            result: { id: "11", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 8,
+            "6": 8,
+            "7": 8,
+            "8": 8,
+            "9": 8,
+            "10": 8,
+            "11": 8,
+            "12": 8,
+          },
+          macroCalls: {
+            "12": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "exists",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 12,
+          function: "exists",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.exists(v, f)\n | ^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'f' (in container '')\n | m.exists(v, f)\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 4,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tv,\n\t\t\t// Target\n\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\tfalse^#5:*expr.Constant_BoolValue#,\n\t\t\t// LoopCondition\n\t\t\t@not_strictly_false(\n                !_(\n                  @result^#6:*expr.Expr_IdentExpr#\n                )^#7:*expr.Expr_CallExpr#\n\t\t\t)^#8:*expr.Expr_CallExpr#,\n\t\t\t// LoopStep\n\t\t\t_||_(\n                @result^#9:*expr.Expr_IdentExpr#,\n                f^#4:*expr.Expr_IdentExpr#\n\t\t\t)^#10:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#11:*expr.Expr_IdentExpr#)^#12:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.exists(\n\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t  \t)^#12:exists#",
     },
     {
       original: { expr: "m.all(v, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    f^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.all(v, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#5[1,5]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#6[1,5]#\n  )^#7[1,5]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#8[1,5]#,\n    f^#4[1,9]#\n  )^#9[1,5]#,\n  // Result\n  @result^#10[1,5]#)^#11[1,5]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 5, 5, 1, 5, 1, 5],
+        [6, 5, 5, 1, 5, 1, 5],
+        [7, 5, 5, 1, 5, 1, 5],
+        [8, 5, 5, 1, 5, 1, 5],
+        [9, 5, 5, 1, 5, 1, 5],
+        [10, 5, 5, 1, 5, 1, 5],
+        [11, 5, 5, 1, 5, 1, 5],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "7",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "6", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "8", identExpr: { name: "@result" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: {
+            "1": 0,
+            "3": 6,
+            "4": 9,
+            "5": 5,
+            "6": 5,
+            "7": 5,
+            "8": 5,
+            "9": 5,
+            "10": 5,
+            "11": 5,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 11,
+          function: "all",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.all(v, f)\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'f' (in container '')\n | m.all(v, f)\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tv,\n\t\t\t// Target\n\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\ttrue^#5:*expr.Constant_BoolValue#,\n\t\t\t// LoopCondition\n\t\t\t@not_strictly_false(\n                @result^#6:*expr.Expr_IdentExpr#\n            )^#7:*expr.Expr_CallExpr#,\n\t\t\t// LoopStep\n\t\t\t_\u0026\u0026_(\n                @result^#8:*expr.Expr_IdentExpr#,\n                f^#4:*expr.Expr_IdentExpr#\n            )^#9:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#10:*expr.Expr_IdentExpr#)^#11:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.all(\n\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t  \t)^#11:all#",
     },
     {
       original: { expr: "m.existsOne(v, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  0^#*expr.Constant_Int64Value#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    f^#*expr.Expr_IdentExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  _==_(\n    @result^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.existsOne(v, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  0^#5[1,11]#,\n  // LoopCondition\n  true^#6[1,11]#,\n  // LoopStep\n  _?_:_(\n    f^#4[1,15]#,\n    _+_(\n      @result^#7[1,11]#,\n      1^#8[1,11]#\n    )^#9[1,11]#,\n    @result^#10[1,11]#\n  )^#11[1,11]#,\n  // Result\n  _==_(\n    @result^#12[1,11]#,\n    1^#13[1,11]#\n  )^#14[1,11]#)^#15[1,11]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 11, 11, 1, 11, 1, 11],
+        [6, 11, 11, 1, 11, 1, 11],
+        [7, 11, 11, 1, 11, 1, 11],
+        [8, 11, 11, 1, 11, 1, 11],
+        [9, 11, 11, 1, 11, 1, 11],
+        [10, 11, 11, 1, 11, 1, 11],
+        [11, 11, 11, 1, 11, 1, 11],
+        [12, 11, 11, 1, 11, 1, 11],
+        [13, 11, 11, 1, 11, 1, 11],
+        [14, 11, 11, 1, 11, 1, 11],
+        [15, 11, 11, 1, 11, 1, 11],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { int64Value: "0" } },
+            loopCondition: { id: "6", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "4", identExpr: { name: "f" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "7", identExpr: { name: "@result" } },
+                        { id: "8", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  { id: "10", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: {
+              id: "14",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "12", identExpr: { name: "@result" } },
+                  { id: "13", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "3": 12,
+            "4": 15,
+            "5": 11,
+            "6": 11,
+            "7": 11,
+            "8": 11,
+            "9": 11,
+            "10": 11,
+            "11": 11,
+            "12": 11,
+            "13": 11,
+            "14": 11,
+            "15": 11,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "existsOne",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 15,
+          function: "existsOne",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.existsOne(v, f)\n | ^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'existsOne' (in container '')\n | m.existsOne(v, f)\n | ...........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'v' (in container '')\n | m.existsOne(v, f)\n | ............^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'f' (in container '')\n | m.existsOne(v, f)\n | ...............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'existsOne' (in container '')",
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'v' (in container '')",
+          id: 3,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 4,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tv,\n\t\t\t// Target\n\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t0^#5:*expr.Constant_Int64Value#,\n\t\t\t// LoopCondition\n\t\t\ttrue^#6:*expr.Constant_BoolValue#,\n\t\t\t// LoopStep\n\t\t\t_?_:_(\n\t\t\t\tf^#4:*expr.Expr_IdentExpr#,\n\t\t\t\t_+_(\n\t\t\t\t\t  @result^#7:*expr.Expr_IdentExpr#,\n\t\t\t\t  1^#8:*expr.Constant_Int64Value#\n\t\t\t\t)^#9:*expr.Expr_CallExpr#,\n\t\t\t\t@result^#10:*expr.Expr_IdentExpr#\n\t\t\t)^#11:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t_==_(\n\t\t\t\t@result^#12:*expr.Expr_IdentExpr#,\n\t\t\t\t1^#13:*expr.Constant_Int64Value#\n\t\t\t)^#14:*expr.Expr_CallExpr#)^#15:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.existsOne(\n\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t  \t)^#15:existsOne#",
     },
     {
       original: { expr: "[].existsOne(__result__, __result__)" },
       error:
-        "ERROR: :1:14: iteration variable overwrites accumulator variable\n | [].existsOne(__result__, __result__)\n | .............^",
+        "ERROR: \u003cinput\u003e:1:14: iteration variable overwrites accumulator variable\n | [].existsOne(__result__, __result__)\n | .............^",
+      errors: [
+        {
+          category: "syntax",
+          message: "iteration variable overwrites accumulator variable",
+          id: 5,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:14: iteration variable overwrites accumulator variable\n             | [].existsOne(__result__, __result__)\n             | .............^",
     },
     {
       original: { expr: "m.map(v, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      f^#*expr.Expr_IdentExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.map(v, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#5[1,5]#,\n  // LoopCondition\n  true^#6[1,5]#,\n  // LoopStep\n  _+_(\n    @result^#7[1,5]#,\n    [\n      f^#4[1,9]#\n    ]^#8[1,5]#\n  )^#9[1,5]#,\n  // Result\n  @result^#10[1,5]#)^#11[1,5]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 5, 5, 1, 5, 1, 5],
+        [6, 5, 5, 1, 5, 1, 5],
+        [7, 5, 5, 1, 5, 1, 5],
+        [8, 5, 5, 1, 5, 1, 5],
+        [9, 5, 5, 1, 5, 1, 5],
+        [10, 5, 5, 1, 5, 1, 5],
+        [11, 5, 5, 1, 5, 1, 5],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", listExpr: {} },
+            loopCondition: { id: "6", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "7", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    listExpr: {
+                      elements: [{ id: "4", identExpr: { name: "f" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: {
+            "1": 0,
+            "3": 6,
+            "4": 9,
+            "5": 5,
+            "6": 5,
+            "7": 5,
+            "8": 5,
+            "9": 5,
+            "10": 5,
+            "11": 5,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "map",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 11,
+          function: "map",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.map(v, f)\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'f' (in container '')\n | m.map(v, f)\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tv,\n\t\t\t// Target\n\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]^#5:*expr.Expr_ListExpr#,\n\t\t\t// LoopCondition\n\t\t\ttrue^#6:*expr.Constant_BoolValue#,\n\t\t\t// LoopStep\n\t\t\t_+_(\n\t\t\t\t@result^#7:*expr.Expr_IdentExpr#,\n\t\t\t\t[\n\t\t\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t\t\t]^#8:*expr.Expr_ListExpr#\n\t\t\t)^#9:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#10:*expr.Expr_IdentExpr#)^#11:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.map(\n\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t  \t)^#11:map#",
     },
     {
       original: { expr: "m.map(__result__, __result__)" },
       error:
-        "ERROR: :1:7: iteration variable overwrites accumulator variable\n | m.map(__result__, __result__)\n | ......^",
+        "ERROR: \u003cinput\u003e:1:7: iteration variable overwrites accumulator variable\n | m.map(__result__, __result__)\n | ......^",
+      errors: [
+        {
+          category: "syntax",
+          message: "iteration variable overwrites accumulator variable",
+          id: 5,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:7: iteration variable overwrites accumulator variable\n             | m.map(__result__, __result__)\n             | ......^",
     },
     {
       original: { expr: "m.map(v, p, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    p^#*expr.Expr_IdentExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        f^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.map(v, p, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#6[1,5]#,\n  // LoopCondition\n  true^#7[1,5]#,\n  // LoopStep\n  _?_:_(\n    p^#4[1,9]#,\n    _+_(\n      @result^#8[1,5]#,\n      [\n        f^#5[1,12]#\n      ]^#9[1,5]#\n    )^#10[1,5]#,\n    @result^#11[1,5]#\n  )^#12[1,5]#,\n  // Result\n  @result^#13[1,5]#)^#14[1,5]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 5, 5, 1, 5, 1, 5],
+        [7, 5, 5, 1, 5, 1, 5],
+        [8, 5, 5, 1, 5, 1, 5],
+        [9, 5, 5, 1, 5, 1, 5],
+        [10, 5, 5, 1, 5, 1, 5],
+        [11, 5, 5, 1, 5, 1, 5],
+        [12, 5, 5, 1, 5, 1, 5],
+        [13, 5, 5, 1, 5, 1, 5],
+        [14, 5, 5, 1, 5, 1, 5],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "6", listExpr: {} },
+            loopCondition: { id: "7", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "12",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "4", identExpr: { name: "p" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "8", identExpr: { name: "@result" } },
+                        {
+                          id: "9",
+                          listExpr: {
+                            elements: [{ id: "5", identExpr: { name: "f" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "11", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "13", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: {
+            "1": 0,
+            "3": 6,
+            "4": 9,
+            "5": 12,
+            "6": 5,
+            "7": 5,
+            "8": 5,
+            "9": 5,
+            "10": 5,
+            "11": 5,
+            "12": 5,
+            "13": 5,
+            "14": 5,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "map",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "p" } },
+                  { id: "5", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "map",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "p" } },
+            { id: "5", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.map(v, p, f)\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'p' (in container '')\n | m.map(v, p, f)\n | .........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'f' (in container '')\n | m.map(v, p, f)\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'p' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 5,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tv,\n\t\t\t// Target\n\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]^#6:*expr.Expr_ListExpr#,\n\t\t\t// LoopCondition\n\t\t\ttrue^#7:*expr.Constant_BoolValue#,\n\t\t\t// LoopStep\n\t\t\t_?_:_(\n\t\t\t\tp^#4:*expr.Expr_IdentExpr#,\n\t\t\t\t_+_(\n\t\t\t\t\t@result^#8:*expr.Expr_IdentExpr#,\n\t\t\t\t\t[\n\t\t\t\t\t\tf^#5:*expr.Expr_IdentExpr#\n\t\t\t\t\t]^#9:*expr.Expr_ListExpr#\n\t\t\t\t)^#10:*expr.Expr_CallExpr#,\n\t\t\t\t@result^#11:*expr.Expr_IdentExpr#\n\t\t\t)^#12:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#13:*expr.Expr_IdentExpr#)^#14:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.map(\n\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\tp^#4:*expr.Expr_IdentExpr#,\n\t\t\tf^#5:*expr.Expr_IdentExpr#\n\t\t  \t)^#14:map#",
     },
     {
       original: { expr: "m.filter(v, p)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    p^#*expr.Expr_IdentExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        v^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.filter(v, p)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#5[1,8]#,\n  // LoopCondition\n  true^#6[1,8]#,\n  // LoopStep\n  _?_:_(\n    p^#4[1,12]#,\n    _+_(\n      @result^#7[1,8]#,\n      [\n        v^#3[1,9]#\n      ]^#8[1,8]#\n    )^#9[1,8]#,\n    @result^#10[1,8]#\n  )^#11[1,8]#,\n  // Result\n  @result^#12[1,8]#)^#13[1,8]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 8, 8, 1, 8, 1, 8],
+        [6, 8, 8, 1, 8, 1, 8],
+        [7, 8, 8, 1, 8, 1, 8],
+        [8, 8, 8, 1, 8, 1, 8],
+        [9, 8, 8, 1, 8, 1, 8],
+        [10, 8, 8, 1, 8, 1, 8],
+        [11, 8, 8, 1, 8, 1, 8],
+        [12, 8, 8, 1, 8, 1, 8],
+        [13, 8, 8, 1, 8, 1, 8],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", listExpr: {} },
+            loopCondition: { id: "6", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "4", identExpr: { name: "p" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "7", identExpr: { name: "@result" } },
+                        {
+                          id: "8",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "v" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "10", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 8,
+            "6": 8,
+            "7": 8,
+            "8": 8,
+            "9": 8,
+            "10": 8,
+            "11": 8,
+            "12": 8,
+            "13": 8,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "p" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "p" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.filter(v, p)\n | ^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'p' (in container '')\n | m.filter(v, p)\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'p' (in container '')",
+          id: 4,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tv,\n\t\t\t// Target\n\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]^#5:*expr.Expr_ListExpr#,\n\t\t\t// LoopCondition\n\t\t\ttrue^#6:*expr.Constant_BoolValue#,\n\t\t\t// LoopStep\n\t\t\t_?_:_(\n\t\t\t\tp^#4:*expr.Expr_IdentExpr#,\n\t\t\t\t_+_(\n\t\t\t\t\t@result^#7:*expr.Expr_IdentExpr#,\n\t\t\t\t\t[\n\t\t\t\t\t\tv^#3:*expr.Expr_IdentExpr#\n\t\t\t\t\t]^#8:*expr.Expr_ListExpr#\n\t\t\t\t)^#9:*expr.Expr_CallExpr#,\n\t\t\t\t@result^#10:*expr.Expr_IdentExpr#\n\t\t\t)^#11:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#12:*expr.Expr_IdentExpr#)^#13:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.filter(\n\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\tp^#4:*expr.Expr_IdentExpr#\n\t\t  \t)^#13:filter#",
     },
     {
       original: { expr: "m.filter(__result__, false)" },
       error:
-        "ERROR: :1:10: iteration variable overwrites accumulator variable\n | m.filter(__result__, false)\n | .........^",
+        "ERROR: \u003cinput\u003e:1:10: iteration variable overwrites accumulator variable\n | m.filter(__result__, false)\n | .........^",
+      errors: [
+        {
+          category: "syntax",
+          message: "iteration variable overwrites accumulator variable",
+          id: 5,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:10: iteration variable overwrites accumulator variable\n             | m.filter(__result__, false)\n             | .........^",
     },
     {
       original: { expr: "m.filter(a.b, false)" },
       error:
-        "ERROR: :1:11: argument is not an identifier\n | m.filter(a.b, false)\n | ..........^",
+        "ERROR: \u003cinput\u003e:1:11: argument is not an identifier\n | m.filter(a.b, false)\n | ..........^",
+      errors: [
+        {
+          category: "syntax",
+          message: "argument is not an identifier",
+          id: 6,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:11: argument is not an identifier\n             | m.filter(a.b, false)\n             | ..........^",
     },
     {
       original: { expr: "x * 2" },
       ast: "_*_(\n  x^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x * 2",
+      locationAst: "_*_(\n  x^#1[1,0]#,\n  2^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_*_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x * 2\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst:
+        "_*_(\n\t\t\tx^#1:*expr.Expr_IdentExpr#,\n\t\t\t2^#3:*expr.Constant_Int64Value#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "x * 2u" },
       ast: "_*_(\n  x^#*expr.Expr_IdentExpr#,\n  2u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x * 2u",
+      locationAst: "_*_(\n  x^#1[1,0]#,\n  2u^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 6, 1, 4, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_*_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "3", constExpr: { uint64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x * 2u\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst:
+        "_*_(\n\t\t\tx^#1:*expr.Expr_IdentExpr#,\n\t\t\t2u^#3:*expr.Constant_Uint64Value#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "x * 2.0" },
       ast: "_*_(\n  x^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x * 2.0",
+      locationAst: "_*_(\n  x^#1[1,0]#,\n  2^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 7, 1, 4, 1, 7],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_*_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "3", constExpr: { doubleValue: 2 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x * 2.0\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst:
+        "_*_(\n\t\t\tx^#1:*expr.Expr_IdentExpr#,\n\t\t\t2^#3:*expr.Constant_DoubleValue#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: '"\\u2764"' },
       ast: '"❤"^#*expr.Constant_StringValue#',
+      unparsed: '"❤"',
+      locationAst: '"❤"^#1[1,0]#',
+      positions: [[1, 0, 8, 1, 0, 1, 8]],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "❤" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"❤"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "❤" } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "❤" } },
+      runtimeCost: "0",
+      expectedAst: '"❤"^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: '"❤"' },
       ast: '"❤"^#*expr.Constant_StringValue#',
+      unparsed: '"❤"',
+      locationAst: '"❤"^#1[1,0]#',
+      positions: [[1, 0, 5, 1, 0, 2, 1]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "❤" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"❤"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "❤" } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "❤" } },
+      runtimeCost: "0",
+      expectedAst: '"❤"^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: "! false" },
       ast: "!_(\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!false",
+      locationAst: "!_(\n  false^#2[1,2]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 7, 1, 2, 1, 7],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", constExpr: { boolValue: false } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2 },
+        },
+      },
       checkedAst: "!_(\n  false~bool\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["logical_not"] } },
+        typeMap: { "1": { primitive: "BOOL" }, "2": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 2 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", constExpr: { boolValue: false } }],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+      expectedAst:
+        "!_(\n\t\t\tfalse^#2:*expr.Constant_BoolValue#\n\t\t)^#1:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "-a" },
       ast: "-_(\n  a^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "-a",
+      locationAst: "-_(\n  a^#2[1,1]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "-_",
+            args: [{ id: "2", identExpr: { name: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | -a\n | .^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedAst:
+        "-_(\n\t\t\ta^#2:*expr.Expr_IdentExpr#\n\t\t)^#1:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a.b(5)" },
       ast: "a^#*expr.Expr_IdentExpr#.b(\n  5^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a.b(5)",
+      locationAst: "a^#1[1,0]#.b(\n  5^#3[1,4]#\n)^#2[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "a" } },
+            function: "b",
+            args: [{ id: "3", constExpr: { int64Value: "5" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 3, "3": 4 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.b(5)\n | ^\nERROR: \u003cinput\u003e:1:4: undeclared reference to 'b' (in container '')\n | a.b(5)\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedAst:
+        "a^#1:*expr.Expr_IdentExpr#.b(\n\t\t\t5^#3:*expr.Constant_Int64Value#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a[3]" },
       ast: "_[_](\n  a^#*expr.Expr_IdentExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a[3]",
+      locationAst: "_[_](\n  a^#1[1,0]#,\n  3^#3[1,2]#\n)^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 1, "3": 2 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a[3]\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst:
+        "_[_](\n\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\t3^#3:*expr.Constant_Int64Value#\n\t\t)^#2:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: 'SomeMessage{foo: 5, bar: "xyz"}' },
       ast: 'SomeMessage{\n  foo:5^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  bar:"xyz"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: 'SomeMessage{foo: 5, bar: "xyz"}',
+      locationAst:
+        'SomeMessage{\n  foo:5^#3[1,17]#^#2[1,15]#,\n  bar:"xyz"^#5[1,25]#^#4[1,23]#\n}^#1[1,11]#',
+      positions: [
+        [1, 11, 12, 1, 11, 1, 12],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 17, 18, 1, 17, 1, 18],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 25, 30, 1, 25, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "SomeMessage",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "foo",
+                value: { id: "3", constExpr: { int64Value: "5" } },
+              },
+              {
+                id: "4",
+                fieldKey: "bar",
+                value: { id: "5", constExpr: { stringValue: "xyz" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 11, "2": 15, "3": 17, "4": 23, "5": 25 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:12: undeclared reference to 'SomeMessage' (in container '')\n | SomeMessage{foo: 5, bar: \"xyz\"}\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'SomeMessage' (in container '')",
+          id: 1,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedAst:
+        'SomeMessage{\n\t\t\tfoo:5^#3:*expr.Constant_Int64Value#^#2:*expr.Expr_CreateStruct_Entry#,\n\t\t\tbar:"xyz"^#5:*expr.Constant_StringValue#^#4:*expr.Expr_CreateStruct_Entry#\n\t\t}^#1:*expr.Expr_StructExpr#',
     },
     {
       original: { expr: "[3, 4, 5]" },
       ast: "[\n  3^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#,\n  5^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[3, 4, 5]",
+      locationAst: "[\n  3^#2[1,1]#,\n  4^#3[1,4]#,\n  5^#4[1,7]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "3" } },
+              { id: "3", constExpr: { int64Value: "4" } },
+              { id: "4", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+      },
       checkedAst: "[\n  3~int,\n  4~int,\n  5~int\n]~list(int)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "3" } },
+              { id: "3", constExpr: { int64Value: "4" } },
+              { id: "4", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+      },
       type: "list(int)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "3" },
+              { int64Value: "4" },
+              { int64Value: "5" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedAst:
+        "[\n\t\t\t3^#2:*expr.Constant_Int64Value#,\n\t\t\t4^#3:*expr.Constant_Int64Value#,\n\t\t\t5^#4:*expr.Constant_Int64Value#\n\t\t]^#1:*expr.Expr_ListExpr#",
     },
     {
       original: { expr: "[3, 4, 5,]" },
       ast: "[\n  3^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#,\n  5^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[3, 4, 5]",
+      locationAst: "[\n  3^#2[1,1]#,\n  4^#3[1,4]#,\n  5^#4[1,7]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "3" } },
+              { id: "3", constExpr: { int64Value: "4" } },
+              { id: "4", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+      },
       checkedAst: "[\n  3~int,\n  4~int,\n  5~int\n]~list(int)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "3" } },
+              { id: "3", constExpr: { int64Value: "4" } },
+              { id: "4", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+      },
       type: "list(int)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "3" },
+              { int64Value: "4" },
+              { int64Value: "5" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedAst:
+        "[\n\t\t\t3^#2:*expr.Constant_Int64Value#,\n\t\t\t4^#3:*expr.Constant_Int64Value#,\n\t\t\t5^#4:*expr.Constant_Int64Value#\n\t\t]^#1:*expr.Expr_ListExpr#",
     },
     {
       original: { expr: '{foo: 5, bar: "xyz"}' },
       ast: '{\n  foo^#*expr.Expr_IdentExpr#:5^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  bar^#*expr.Expr_IdentExpr#:"xyz"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{foo: 5, bar: "xyz"}',
+      locationAst:
+        '{\n  foo^#3[1,1]#:5^#4[1,6]#^#2[1,4]#,\n  bar^#6[1,9]#:"xyz"^#7[1,14]#^#5[1,12]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 9, 12, 1, 9, 1, 12],
+        [7, 14, 19, 1, 14, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", identExpr: { name: "foo" } },
+                value: { id: "4", constExpr: { int64Value: "5" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", identExpr: { name: "bar" } },
+                value: { id: "7", constExpr: { stringValue: "xyz" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 12,
+            "6": 9,
+            "7": 14,
+          },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'foo' (in container '')\n | {foo: 5, bar: \"xyz\"}\n | .^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'bar' (in container '')\n | {foo: 5, bar: \"xyz\"}\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'foo' (in container '')",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'bar' (in container '')",
+          id: 6,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedAst:
+        '{\n\t\t\tfoo^#3:*expr.Expr_IdentExpr#:5^#4:*expr.Constant_Int64Value#^#2:*expr.Expr_CreateStruct_Entry#,\n\t\t\tbar^#6:*expr.Expr_IdentExpr#:"xyz"^#7:*expr.Constant_StringValue#^#5:*expr.Expr_CreateStruct_Entry#\n\t\t}^#1:*expr.Expr_StructExpr#',
     },
     {
       original: { expr: '{foo: 5, bar: "xyz", }' },
       ast: '{\n  foo^#*expr.Expr_IdentExpr#:5^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  bar^#*expr.Expr_IdentExpr#:"xyz"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{foo: 5, bar: "xyz"}',
+      locationAst:
+        '{\n  foo^#3[1,1]#:5^#4[1,6]#^#2[1,4]#,\n  bar^#6[1,9]#:"xyz"^#7[1,14]#^#5[1,12]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 9, 12, 1, 9, 1, 12],
+        [7, 14, 19, 1, 14, 1, 19],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", identExpr: { name: "foo" } },
+                value: { id: "4", constExpr: { int64Value: "5" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", identExpr: { name: "bar" } },
+                value: { id: "7", constExpr: { stringValue: "xyz" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 12,
+            "6": 9,
+            "7": 14,
+          },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'foo' (in container '')\n | {foo: 5, bar: \"xyz\", }\n | .^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'bar' (in container '')\n | {foo: 5, bar: \"xyz\", }\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'foo' (in container '')",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'bar' (in container '')",
+          id: 6,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedAst:
+        '{\n\t\t\tfoo^#3:*expr.Expr_IdentExpr#:5^#4:*expr.Constant_Int64Value#^#2:*expr.Expr_CreateStruct_Entry#,\n\t\t\tbar^#6:*expr.Expr_IdentExpr#:"xyz"^#7:*expr.Constant_StringValue#^#5:*expr.Expr_CreateStruct_Entry#\n\t\t}^#1:*expr.Expr_StructExpr#',
     },
     {
       original: { expr: "a \u003e 5 \u0026\u0026 a \u003c 10" },
       ast: "_\u0026\u0026_(\n  _\u003e_(\n    a^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _\u003c_(\n    a^#*expr.Expr_IdentExpr#,\n    10^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003e 5 \u0026\u0026 a \u003c 10",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u003e_(\n    a^#1[1,0]#,\n    5^#3[1,4]#\n  )^#2[1,2]#,\n  _\u003c_(\n    a^#4[1,9]#,\n    10^#6[1,13]#\n  )^#5[1,11]#\n)^#7[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 11, 12, 1, 11, 1, 12],
+        [6, 13, 15, 1, 13, 1, 15],
+        [7, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "4", identExpr: { name: "a" } },
+                    { id: "6", constExpr: { int64Value: "10" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 9,
+            "5": 11,
+            "6": 13,
+            "7": 6,
+          },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003e 5 \u0026\u0026 a \u003c 10\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'a' (in container '')\n | a \u003e 5 \u0026\u0026 a \u003c 10\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedAst:
+        "_\u0026\u0026_(\n\t\t\t_\u003e_(\n\t\t\t  a^#1:*expr.Expr_IdentExpr#,\n\t\t\t  5^#3:*expr.Constant_Int64Value#\n\t\t\t)^#2:*expr.Expr_CallExpr#,\n\t\t\t_\u003c_(\n\t\t\t  a^#4:*expr.Expr_IdentExpr#,\n\t\t\t  10^#6:*expr.Constant_Int64Value#\n\t\t\t)^#5:*expr.Expr_CallExpr#\n\t\t)^#7:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "a \u003c 5 || a \u003e 10" },
       ast: "_||_(\n  _\u003c_(\n    a^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e_(\n    a^#*expr.Expr_IdentExpr#,\n    10^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003c 5 || a \u003e 10",
+      locationAst:
+        "_||_(\n  _\u003c_(\n    a^#1[1,0]#,\n    5^#3[1,4]#\n  )^#2[1,2]#,\n  _\u003e_(\n    a^#4[1,9]#,\n    10^#6[1,13]#\n  )^#5[1,11]#\n)^#7[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 11, 12, 1, 11, 1, 12],
+        [6, 13, 15, 1, 13, 1, 15],
+        [7, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "4", identExpr: { name: "a" } },
+                    { id: "6", constExpr: { int64Value: "10" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 9,
+            "5": 11,
+            "6": 13,
+            "7": 6,
+          },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003c 5 || a \u003e 10\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'a' (in container '')\n | a \u003c 5 || a \u003e 10\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedAst:
+        "_||_(\n\t\t\t_\u003c_(\n\t\t\t  a^#1:*expr.Expr_IdentExpr#,\n\t\t\t  5^#3:*expr.Constant_Int64Value#\n\t\t\t)^#2:*expr.Expr_CallExpr#,\n\t\t\t_\u003e_(\n\t\t\t  a^#4:*expr.Expr_IdentExpr#,\n\t\t\t  10^#6:*expr.Constant_Int64Value#\n\t\t\t)^#5:*expr.Expr_CallExpr#\n\t\t)^#7:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "{" },
       error:
-        "ERROR: :1:2: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '}', '(', '.', ',', '-', '!', '?', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | {\n | .^",
+        "ERROR: \u003cinput\u003e:1:2: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '}', '(', '.', ',', '-', '!', '?', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | {\n | .^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '}', '(', '.', ',', '-', '!', '?', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:2: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '}', '(', '.', ',', '-', '!', '?', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t | {\n\t\t | .^",
     },
     {
       original: { expr: "[] + [1,2,3,] + [4]" },
       ast: "_+_(\n  _+_(\n    []^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[] + [1, 2, 3] + [4]",
+      locationAst:
+        "_+_(\n  _+_(\n    []^#1[1,0]#,\n    [\n      1^#4[1,6]#,\n      2^#5[1,8]#,\n      3^#6[1,10]#\n    ]^#3[1,5]#\n  )^#2[1,3]#,\n  [\n    4^#9[1,17]#\n  ]^#8[1,16]#\n)^#7[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 14, 15, 1, 14, 1, 15],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", listExpr: {} },
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [
+                          { id: "4", constExpr: { int64Value: "1" } },
+                          { id: "5", constExpr: { int64Value: "2" } },
+                          { id: "6", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [{ id: "9", constExpr: { int64Value: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 5,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 14,
+            "8": 16,
+            "9": 17,
+          },
+        },
+      },
       checkedAst:
         "_+_(\n  _+_(\n    []~list(int),\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int)\n  )~list(int)^add_list,\n  [\n    4~int\n  ]~list(int)\n)~list(int)^add_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_list"] },
+          "7": { overloadId: ["add_list"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 5,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 14,
+            "8": 16,
+            "9": 17,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", listExpr: {} },
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [
+                          { id: "4", constExpr: { int64Value: "1" } },
+                          { id: "5", constExpr: { int64Value: "2" } },
+                          { id: "6", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [{ id: "9", constExpr: { int64Value: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "list(int)",
+      cost: { min: "32", max: "32" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+              { int64Value: "4" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "32",
+      expectedAst:
+        "_+_(\n\t\t\t_+_(\n\t\t\t\t[]^#1:*expr.Expr_ListExpr#,\n\t\t\t\t[\n\t\t\t\t\t1^#4:*expr.Constant_Int64Value#,\n\t\t\t\t\t2^#5:*expr.Constant_Int64Value#,\n\t\t\t\t\t3^#6:*expr.Constant_Int64Value#\n\t\t\t\t]^#3:*expr.Expr_ListExpr#\n\t\t\t)^#2:*expr.Expr_CallExpr#,\n\t\t\t[\n\t\t\t\t4^#9:*expr.Constant_Int64Value#\n\t\t\t]^#8:*expr.Expr_ListExpr#\n\t\t)^#7:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "{1:2u, 2:3u}" },
       ast: "{\n  1^#*expr.Constant_Int64Value#:2u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#,\n  2^#*expr.Constant_Int64Value#:3u^#*expr.Constant_Uint64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "{1: 2u, 2: 3u}",
+      locationAst:
+        "{\n  1^#3[1,1]#:2u^#4[1,3]#^#2[1,2]#,\n  2^#6[1,7]#:3u^#7[1,9]#^#5[1,8]#\n}^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 3, 5, 1, 3, 1, 5],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                value: { id: "4", constExpr: { uint64Value: "2" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                value: { id: "7", constExpr: { uint64Value: "3" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 2, "3": 1, "4": 3, "5": 8, "6": 7, "7": 9 },
+        },
+      },
       checkedAst: "{\n  1~int:2u~uint,\n  2~int:3u~uint\n}~map(int, uint)",
+      checkedExpr: {
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "UINT64" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "UINT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 2, "3": 1, "4": 3, "5": 8, "6": 7, "7": 9 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                value: { id: "4", constExpr: { uint64Value: "2" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { int64Value: "2" } },
+                value: { id: "7", constExpr: { uint64Value: "3" } },
+              },
+            ],
+          },
+        },
+      },
       type: "map(int, uint)",
+      cost: { min: "30", max: "30" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              { key: { int64Value: "1" }, value: { uint64Value: "2" } },
+              { key: { int64Value: "2" }, value: { uint64Value: "3" } },
+            ],
+          },
+        },
+      },
+      runtimeCost: "30",
+      expectedAst:
+        "{\n\t\t\t1^#3:*expr.Constant_Int64Value#:2u^#4:*expr.Constant_Uint64Value#^#2:*expr.Expr_CreateStruct_Entry#,\n\t\t\t2^#6:*expr.Constant_Int64Value#:3u^#7:*expr.Constant_Uint64Value#^#5:*expr.Expr_CreateStruct_Entry#\n\t\t}^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "TestAllTypes{single_int32: 1, single_int64: 2}" },
       ast: "TestAllTypes{\n  single_int32:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  single_int64:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "TestAllTypes{single_int32: 1, single_int64: 2}",
+      locationAst:
+        "TestAllTypes{\n  single_int32:1^#3[1,27]#^#2[1,25]#,\n  single_int64:2^#5[1,44]#^#4[1,42]#\n}^#1[1,12]#",
+      positions: [
+        [1, 12, 13, 1, 12, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 27, 28, 1, 27, 1, 28],
+        [4, 42, 43, 1, 42, 1, 43],
+        [5, 44, 45, 1, 44, 1, 45],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "single_int64",
+                value: { id: "5", constExpr: { int64Value: "2" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: { "1": 12, "2": 25, "3": 27, "4": 42, "5": 44 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:13: undeclared reference to 'TestAllTypes' (in container '')\n | TestAllTypes{single_int32: 1, single_int64: 2}\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'TestAllTypes' (in container '')",
+          id: 1,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedAst:
+        "TestAllTypes{\n\t\t\tsingle_int32:1^#3:*expr.Constant_Int64Value#^#2:*expr.Expr_CreateStruct_Entry#,\n\t\t\tsingle_int64:2^#5:*expr.Constant_Int64Value#^#4:*expr.Expr_CreateStruct_Entry#\n\t\t}^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "TestAllTypes(){}" },
       error:
-        "ERROR: :1:15: Syntax error: mismatched input '{' expecting \u003cEOF\u003e\n | TestAllTypes(){}\n | ..............^",
+        "ERROR: \u003cinput\u003e:1:15: Syntax error: mismatched input '{' expecting \u003cEOF\u003e\n | TestAllTypes(){}\n | ..............^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '{' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:15: Syntax error: mismatched input '{' expecting \u003cEOF\u003e\n\t\t| TestAllTypes(){}\n\t\t| ..............^",
     },
     {
       original: { expr: "TestAllTypes{}()" },
       error:
-        "ERROR: :1:15: Syntax error: mismatched input '(' expecting \u003cEOF\u003e\n | TestAllTypes{}()\n | ..............^",
+        "ERROR: \u003cinput\u003e:1:15: Syntax error: mismatched input '(' expecting \u003cEOF\u003e\n | TestAllTypes{}()\n | ..............^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '(' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:15: Syntax error: mismatched input '(' expecting \u003cEOF\u003e\n\t\t| TestAllTypes{}()\n\t\t| ..............^",
     },
     {
       original: { expr: "size(x) == x.size()" },
       ast: "_==_(\n  size(\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "size(x) == x.size()",
+      locationAst:
+        "_==_(\n  size(\n    x^#2[1,5]#\n  )^#1[1,4]#,\n  x^#4[1,11]#.size()^#5[1,17]#\n)^#3[1,8]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 8, 10, 1, 8, 1, 10],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "size",
+                  args: [{ id: "2", identExpr: { name: "x" } }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  target: { id: "4", identExpr: { name: "x" } },
+                  function: "size",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 4, "2": 5, "3": 8, "4": 11, "5": 17 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:6: undeclared reference to 'x' (in container '')\n | size(x) == x.size()\n | .....^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'x' (in container '')\n | size(x) == x.size()\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 4,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedAst:
+        "_==_(\n\t\t\tsize(\n\t\t\t\tx^#2:*expr.Expr_IdentExpr#\n\t\t\t)^#1:*expr.Expr_CallExpr#,\n\t\t\tx^#4:*expr.Expr_IdentExpr#.size()^#5:*expr.Expr_CallExpr#\n\t\t)^#3:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "1 + $" },
       error:
-        "ERROR: :1:5: Syntax error: token recognition error at: '$'\n | 1 + $\n | ....^\nERROR: :1:6: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | 1 + $\n | .....^",
+        "ERROR: \u003cinput\u003e:1:5: Syntax error: token recognition error at: '$'\n | 1 + $\n | ....^\nERROR: \u003cinput\u003e:1:6: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | 1 + $\n | .....^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '$'",
+          id: 0,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:5: Syntax error: token recognition error at: '$'\n\t\t| 1 + $\n\t\t| ....^\n\t\tERROR: \u003cinput\u003e:1:6: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| 1 + $\n\t\t| .....^",
     },
     {
       original: { expr: "1 + 2\n3 +" },
       error:
-        "ERROR: :2:1: Syntax error: mismatched input '3' expecting \u003cEOF\u003e\n | 3 +\n | ^",
+        "ERROR: \u003cinput\u003e:2:1: Syntax error: mismatched input '3' expecting \u003cEOF\u003e\n | 3 +\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '3' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 6,
+          line: 2,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:2:1: Syntax error: mismatched input '3' expecting \u003cEOF\u003e\n\t\t| 3 +\n\t\t| ^",
     },
     {
       original: { expr: '"\\""' },
       ast: '"\\""^#*expr.Constant_StringValue#',
+      unparsed: '"\\""',
+      locationAst: '"\\""^#1[1,0]#',
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: '"' } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"\\""~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: '"' } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: '"' } },
+      runtimeCost: "0",
+      expectedAst: '"\\""^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: "[1,3,4][0]" },
       ast: "_[_](\n  [\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 3, 4][0]",
+      locationAst:
+        "_[_](\n  [\n    1^#2[1,1]#,\n    3^#3[1,3]#,\n    4^#4[1,5]#\n  ]^#1[1,0]#,\n  0^#6[1,8]#\n)^#5[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "3" } },
+                    { id: "4", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 1, "3": 3, "4": 5, "5": 7, "6": 8 },
+        },
+      },
       checkedAst:
         "_[_](\n  [\n    1~int,\n    3~int,\n    4~int\n  ]~list(int),\n  0~int\n)~int^index_list",
+      checkedExpr: {
+        referenceMap: { "5": { overloadId: ["index_list"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 1, "3": 3, "4": 5, "5": 7, "6": 8 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "3" } },
+                    { id: "4", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "11", max: "11" },
+      result: { value: { int64Value: "1" } },
+      runtimeCost: "12",
+      expectedAst:
+        "_[_](\n\t\t\t[\n\t\t\t\t1^#2:*expr.Constant_Int64Value#,\n\t\t\t\t3^#3:*expr.Constant_Int64Value#,\n\t\t\t\t4^#4:*expr.Constant_Int64Value#\n\t\t\t]^#1:*expr.Expr_ListExpr#,\n\t\t\t0^#6:*expr.Constant_Int64Value#\n\t\t)^#5:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "1.all(2, 3)" },
       error:
-        "ERROR: :1:7: argument must be a simple name\n | 1.all(2, 3)\n | ......^",
+        "ERROR: \u003cinput\u003e:1:7: argument must be a simple name\n | 1.all(2, 3)\n | ......^",
+      errors: [
+        {
+          category: "syntax",
+          message: "argument must be a simple name",
+          id: 5,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:7: argument must be a simple name\n\t\t| 1.all(2, 3)\n\t\t| ......^",
     },
     {
       original: { expr: 'x["a"].single_int32 == 23' },
       ast: '_==_(\n  _[_](\n    x^#*expr.Expr_IdentExpr#,\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#.single_int32^#*expr.Expr_SelectExpr#,\n  23^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'x["a"].single_int32 == 23',
+      locationAst:
+        '_==_(\n  _[_](\n    x^#1[1,0]#,\n    "a"^#3[1,2]#\n  )^#2[1,1]#.single_int32^#4[1,6]#,\n  23^#6[1,23]#\n)^#5[1,20]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 5, 1, 2, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { stringValue: "a" } },
+                      ],
+                    },
+                  },
+                  field: "single_int32",
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 6, "5": 20, "6": 23 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x[\"a\"].single_int32 == 23\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst:
+        '_==_(\n\t\t\t_[_](\n\t\t\t\tx^#1:*expr.Expr_IdentExpr#,\n\t\t\t\t"a"^#3:*expr.Constant_StringValue#\n\t\t\t)^#2:*expr.Expr_CallExpr#.single_int32^#4:*expr.Expr_SelectExpr#,\n\t\t\t23^#6:*expr.Constant_Int64Value#\n\t\t)^#5:*expr.Expr_CallExpr#',
     },
     {
       original: { expr: "x.single_nested_message != null" },
       ast: "_!=_(\n  x^#*expr.Expr_IdentExpr#.single_nested_message^#*expr.Expr_SelectExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "x.single_nested_message != null",
+      locationAst:
+        "_!=_(\n  x^#1[1,0]#.single_nested_message^#2[1,1]#,\n  null^#4[1,27]#\n)^#3[1,24]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 24, 26, 1, 24, 1, 26],
+        [4, 27, 31, 1, 27, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "x" } },
+                  field: "single_nested_message",
+                },
+              },
+              { id: "4", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 1, "3": 24, "4": 27 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x.single_nested_message != null\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst:
+        "_!=_(\n\t\t\tx^#1:*expr.Expr_IdentExpr#.single_nested_message^#2:*expr.Expr_SelectExpr#,\n\t\t\tnull^#4:*expr.Constant_NullValue#\n\t\t)^#3:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "false \u0026\u0026 !true || false ? 2 : 3" },
       ast: "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false^#*expr.Constant_BoolValue#,\n      !_(\n        true^#*expr.Constant_BoolValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(false \u0026\u0026 !true || false) ? 2 : 3",
+      locationAst:
+        "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false^#1[1,0]#,\n      !_(\n        true^#3[1,10]#\n      )^#2[1,9]#\n    )^#4[1,6]#,\n    false^#5[1,18]#\n  )^#6[1,15]#,\n  2^#8[1,26]#,\n  3^#9[1,30]#\n)^#7[1,24]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 14, 1, 10, 1, 14],
+        [4, 6, 8, 1, 6, 1, 8],
+        [5, 18, 23, 1, 18, 1, 23],
+        [6, 15, 17, 1, 15, 1, 17],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", constExpr: { boolValue: false } },
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                { id: "3", constExpr: { boolValue: true } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "2" } },
+              { id: "9", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 6,
+            "5": 18,
+            "6": 15,
+            "7": 24,
+            "8": 26,
+            "9": 30,
+          },
+        },
+      },
       checkedAst:
         "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false~bool,\n      !_(\n        true~bool\n      )~bool^logical_not\n    )~bool^logical_and,\n    false~bool\n  )~bool^logical_or,\n  2~int,\n  3~int\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["logical_not"] },
+          "4": { overloadId: ["logical_and"] },
+          "6": { overloadId: ["logical_or"] },
+          "7": { overloadId: ["conditional"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 10,
+            "4": 6,
+            "5": 18,
+            "6": 15,
+            "7": 24,
+            "8": 26,
+            "9": 30,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", constExpr: { boolValue: false } },
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                { id: "3", constExpr: { boolValue: true } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "2" } },
+              { id: "9", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
       type: "int",
+      cost: { min: "0", max: "1" },
+      result: { value: { int64Value: "3" } },
+      runtimeCost: "0",
+      expectedAst:
+        "_?_:_(\n\t\t\t_||_(\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t\tfalse^#1:*expr.Constant_BoolValue#,\n\t\t\t\t\t!_(\n\t\t\t\t\t\ttrue^#3:*expr.Constant_BoolValue#\n\t\t\t\t\t)^#2:*expr.Expr_CallExpr#\n\t\t\t\t)^#4:*expr.Expr_CallExpr#,\n\t\t\t\tfalse^#5:*expr.Constant_BoolValue#\n\t\t\t)^#6:*expr.Expr_CallExpr#,\n\t\t\t2^#8:*expr.Constant_Int64Value#,\n\t\t\t3^#9:*expr.Constant_Int64Value#\n\t\t)^#7:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: 'b"abc" + B"def"' },
       ast: '_+_(\n  b"abc"^#*expr.Constant_BytesValue#,\n  b"def"^#*expr.Constant_BytesValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'b"\\141\\142\\143" + b"\\144\\145\\146"',
+      locationAst: '_+_(\n  b"abc"^#1[1,0]#,\n  b"def"^#3[1,9]#\n)^#2[1,7]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 9, 15, 1, 9, 1, 15],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { bytesValue: "YWJj" } },
+              { id: "3", constExpr: { bytesValue: "ZGVm" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 7, "3": 9 },
+        },
+      },
       checkedAst: '_+_(\n  b"abc"~bytes,\n  b"def"~bytes\n)~bytes^add_bytes',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["add_bytes"] } },
+        typeMap: {
+          "1": { primitive: "BYTES" },
+          "2": { primitive: "BYTES" },
+          "3": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 7, "3": 9 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { bytesValue: "YWJj" } },
+              { id: "3", constExpr: { bytesValue: "ZGVm" } },
+            ],
+          },
+        },
+      },
       type: "bytes",
+      cost: { min: "1", max: "1" },
+      result: { value: { bytesValue: "YWJjZGVm" } },
+      runtimeCost: "1",
+      expectedAst:
+        '_+_(\n\t\t\tb"abc"^#1:*expr.Constant_BytesValue#,\n\t\t\tb"def"^#3:*expr.Constant_BytesValue#\n\t\t)^#2:*expr.Expr_CallExpr#',
     },
     {
       original: { expr: "1 + 2 * 3 - 1 / 2 == 6 % 1" },
       ast: "_==_(\n  _-_(\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      _*_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _%_(\n    6^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + 2 * 3 - 1 / 2 == 6 % 1",
+      locationAst:
+        "_==_(\n  _-_(\n    _+_(\n      1^#1[1,0]#,\n      _*_(\n        2^#3[1,4]#,\n        3^#5[1,8]#\n      )^#4[1,6]#\n    )^#2[1,2]#,\n    _/_(\n      1^#7[1,12]#,\n      2^#9[1,16]#\n    )^#8[1,14]#\n  )^#6[1,10]#,\n  _%_(\n    6^#11[1,21]#,\n    1^#13[1,25]#\n  )^#12[1,23]#\n)^#10[1,18]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+        [10, 18, 20, 1, 18, 1, 20],
+        [11, 21, 22, 1, 21, 1, 22],
+        [12, 23, 24, 1, 23, 1, 24],
+        [13, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { int64Value: "2" } },
+                                { id: "5", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "7", constExpr: { int64Value: "1" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_%_",
+                  args: [
+                    { id: "11", constExpr: { int64Value: "6" } },
+                    { id: "13", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+            "10": 18,
+            "11": 21,
+            "12": 23,
+            "13": 25,
+          },
+        },
+      },
       checkedAst:
         "_==_(\n  _-_(\n    _+_(\n      1~int,\n      _*_(\n        2~int,\n        3~int\n      )~int^multiply_int64\n    )~int^add_int64,\n    _/_(\n      1~int,\n      2~int\n    )~int^divide_int64\n  )~int^subtract_int64,\n  _%_(\n    6~int,\n    1~int\n  )~int^modulo_int64\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_int64"] },
+          "4": { overloadId: ["multiply_int64"] },
+          "6": { overloadId: ["subtract_int64"] },
+          "8": { overloadId: ["divide_int64"] },
+          "10": { overloadId: ["equals"] },
+          "12": { overloadId: ["modulo_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+            "10": 18,
+            "11": 21,
+            "12": 23,
+            "13": 25,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "3", constExpr: { int64Value: "2" } },
+                                { id: "5", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "7", constExpr: { int64Value: "1" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                callExpr: {
+                  function: "_%_",
+                  args: [
+                    { id: "11", constExpr: { int64Value: "6" } },
+                    { id: "13", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "6", max: "6" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "6",
+      expectedAst:
+        "_==_(\n\t\t\t_-_(\n\t\t\t\t_+_(\n\t\t\t\t\t1^#1:*expr.Constant_Int64Value#,\n\t\t\t\t\t_*_(\n\t\t\t\t\t\t2^#3:*expr.Constant_Int64Value#,\n\t\t\t\t\t\t3^#5:*expr.Constant_Int64Value#\n\t\t\t\t\t)^#4:*expr.Expr_CallExpr#\n\t\t\t\t)^#2:*expr.Expr_CallExpr#,\n\t\t\t\t_/_(\n\t\t\t\t\t1^#7:*expr.Constant_Int64Value#,\n\t\t\t\t\t2^#9:*expr.Constant_Int64Value#\n\t\t\t\t)^#8:*expr.Expr_CallExpr#\n\t\t\t)^#6:*expr.Expr_CallExpr#,\n\t\t\t_%_(\n\t\t\t\t6^#11:*expr.Constant_Int64Value#,\n\t\t\t\t1^#13:*expr.Constant_Int64Value#\n\t\t\t)^#12:*expr.Expr_CallExpr#\n\t\t)^#10:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "1 + +" },
       error:
-        "ERROR: :1:5: Syntax error: mismatched input '+' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | 1 + +\n | ....^\nERROR: :1:6: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | 1 + +\n | .....^",
+        "ERROR: \u003cinput\u003e:1:5: Syntax error: mismatched input '+' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | 1 + +\n | ....^\nERROR: \u003cinput\u003e:1:6: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | 1 + +\n | .....^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '+' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:5: Syntax error: mismatched input '+' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| 1 + +\n\t\t| ....^\n\t\tERROR: \u003cinput\u003e:1:6: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| 1 + +\n\t\t| .....^",
     },
     {
       original: { expr: '"abc" + "def"' },
       ast: '_+_(\n  "abc"^#*expr.Constant_StringValue#,\n  "def"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"abc" + "def"',
+      locationAst: '_+_(\n  "abc"^#1[1,0]#,\n  "def"^#3[1,8]#\n)^#2[1,6]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 8, 13, 1, 8, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { stringValue: "abc" } },
+              { id: "3", constExpr: { stringValue: "def" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 6, "3": 8 },
+        },
+      },
       checkedAst: '_+_(\n  "abc"~string,\n  "def"~string\n)~string^add_string',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["add_string"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 6, "3": 8 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { stringValue: "abc" } },
+              { id: "3", constExpr: { stringValue: "def" } },
+            ],
+          },
+        },
+      },
       type: "string",
+      cost: { min: "1", max: "1" },
+      result: { value: { stringValue: "abcdef" } },
+      runtimeCost: "1",
+      expectedAst:
+        '_+_(\n\t\t\t"abc"^#1:*expr.Constant_StringValue#,\n\t\t\t"def"^#3:*expr.Constant_StringValue#\n\t\t)^#2:*expr.Expr_CallExpr#',
     },
     {
       original: { expr: '{"a": 1}."a"' },
       error:
-        'ERROR: :1:10: Syntax error: no viable alternative at input \'."a"\'\n | {"a": 1}."a"\n | .........^',
+        'ERROR: \u003cinput\u003e:1:10: Syntax error: no viable alternative at input \'."a"\'\n | {"a": 1}."a"\n | .........^',
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: no viable alternative at input '.\"a\"'",
+          id: 0,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError:
+        'ERROR: \u003cinput\u003e:1:10: Syntax error: no viable alternative at input \'."a"\'\n\t\t| {"a": 1}."a"\n\t\t| .........^',
     },
     {
       original: { expr: '"\\xC3\\XBF"' },
       ast: '"Ã¿"^#*expr.Constant_StringValue#',
+      unparsed: '"Ã¿"',
+      locationAst: '"Ã¿"^#1[1,0]#',
+      positions: [[1, 0, 10, 1, 0, 1, 10]],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "Ã¿" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"Ã¿"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "Ã¿" } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "Ã¿" } },
+      runtimeCost: "0",
+      expectedAst: '"Ã¿"^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: '"\\303\\277"' },
       ast: '"Ã¿"^#*expr.Constant_StringValue#',
+      unparsed: '"Ã¿"',
+      locationAst: '"Ã¿"^#1[1,0]#',
+      positions: [[1, 0, 10, 1, 0, 1, 10]],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "Ã¿" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"Ã¿"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "Ã¿" } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "Ã¿" } },
+      runtimeCost: "0",
+      expectedAst: '"Ã¿"^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: '"hi\\u263A \\u263Athere"' },
       ast: '"hi☺ ☺there"^#*expr.Constant_StringValue#',
+      unparsed: '"hi☺ ☺there"',
+      locationAst: '"hi☺ ☺there"^#1[1,0]#',
+      positions: [[1, 0, 22, 1, 0, 1, 22]],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "hi☺ ☺there" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"hi☺ ☺there"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "hi☺ ☺there" } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "hi☺ ☺there" } },
+      runtimeCost: "0",
+      expectedAst: '"hi☺ ☺there"^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: '"\\U000003A8\\?"' },
       ast: '"Ψ?"^#*expr.Constant_StringValue#',
+      unparsed: '"Ψ?"',
+      locationAst: '"Ψ?"^#1[1,0]#',
+      positions: [[1, 0, 14, 1, 0, 1, 14]],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "Ψ?" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"Ψ?"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "Ψ?" } },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "Ψ?" } },
+      runtimeCost: "0",
+      expectedAst: '"Ψ?"^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: '"\\a\\b\\f\\n\\r\\t\\v\'\\"\\\\\\? Legal escapes"' },
       ast: '"\\a\\b\\f\\n\\r\\t\\v\'\\"\\\\? Legal escapes"^#*expr.Constant_StringValue#',
+      unparsed: '"\\a\\b\\f\\n\\r\\t\\v\'\\"\\\\? Legal escapes"',
+      locationAst: '"\\a\\b\\f\\n\\r\\t\\v\'\\"\\\\? Legal escapes"^#1[1,0]#',
+      positions: [[1, 0, 37, 1, 0, 1, 37]],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          constExpr: {
+            stringValue: "\u0007\b\f\n\r\t\u000b'\"\\? Legal escapes",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0 },
+        },
+      },
       checkedAst: '"\\a\\b\\f\\n\\r\\t\\v\'\\"\\\\? Legal escapes"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0 },
+        },
+        expr: {
+          id: "1",
+          constExpr: {
+            stringValue: "\u0007\b\f\n\r\t\u000b'\"\\? Legal escapes",
+          },
+        },
+      },
       type: "string",
+      cost: { min: "0", max: "0" },
+      result: {
+        value: { stringValue: "\u0007\b\f\n\r\t\u000b'\"\\? Legal escapes" },
+      },
+      runtimeCost: "0",
+      expectedAst:
+        '"\\a\\b\\f\\n\\r\\t\\v\'\\"\\\\? Legal escapes"^#1:*expr.Constant_StringValue#',
     },
     {
       original: { expr: '"\\xFh"' },
       error:
-        "ERROR: :1:1: Syntax error: token recognition error at: '\"\\xFh'\n | \"\\xFh\"\n | ^\nERROR: :1:6: Syntax error: token recognition error at: '\"'\n | \"\\xFh\"\n | .....^\nERROR: :1:7: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | \"\\xFh\"\n | ......^",
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: token recognition error at: '\"\\xFh'\n | \"\\xFh\"\n | ^\nERROR: \u003cinput\u003e:1:6: Syntax error: token recognition error at: '\"'\n | \"\\xFh\"\n | .....^\nERROR: \u003cinput\u003e:1:7: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | \"\\xFh\"\n | ......^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '\"\\xFh'",
+          id: 0,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '\"'",
+          id: 0,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: token recognition error at: '\"\\xFh'\n\t\t| \"\\xFh\"\n\t\t| ^\n\t\tERROR: \u003cinput\u003e:1:6: Syntax error: token recognition error at: '\"'\n\t\t| \"\\xFh\"\n\t\t| .....^\n\t\tERROR: \u003cinput\u003e:1:7: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| \"\\xFh\"\n\t\t| ......^",
     },
     {
       original: {
         expr: '"\\a\\b\\f\\n\\r\\t\\v\\\'\\"\\\\\\? Illegal escape \\\u003e"',
       },
       error:
-        "ERROR: :1:1: Syntax error: token recognition error at: '\"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e'\n | \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n | ^\nERROR: :1:42: Syntax error: token recognition error at: '\"'\n | \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n | .........................................^\nERROR: :1:43: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n | ..........................................^",
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: token recognition error at: '\"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e'\n | \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n | ^\nERROR: \u003cinput\u003e:1:42: Syntax error: token recognition error at: '\"'\n | \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n | .........................................^\nERROR: \u003cinput\u003e:1:43: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n | ..........................................^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: token recognition error at: '\"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e'",
+          id: 0,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '\"'",
+          id: 0,
+          offset: 41,
+          line: 1,
+          column: 41,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 42,
+          line: 1,
+          column: 42,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: token recognition error at: '\"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e'\n\t\t| \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n\t\t| ^\n\t\tERROR: \u003cinput\u003e:1:42: Syntax error: token recognition error at: '\"'\n\t\t| \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n\t\t| .........................................^\n\t\tERROR: \u003cinput\u003e:1:43: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| \"\\a\\b\\f\\n\\r\\t\\v\\'\\\"\\\\\\? Illegal escape \\\u003e\"\n\t\t| ..........................................^",
     },
     {
       original: { expr: '"😁" in ["😁", "😑", "😦"]' },
       ast: '@in(\n  "😁"^#*expr.Constant_StringValue#,\n  [\n    "😁"^#*expr.Constant_StringValue#,\n    "😑"^#*expr.Constant_StringValue#,\n    "😦"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"😁" in ["😁", "😑", "😦"]',
+      locationAst:
+        '@in(\n  "😁"^#1[1,0]#,\n  [\n    "😁"^#4[1,8]#,\n    "😑"^#5[1,13]#,\n    "😦"^#6[1,18]#\n  ]^#3[1,7]#\n)^#2[1,4]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 4, 6, 1, 4, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 8, 14, 1, 8, 1, 14],
+        [5, 13, 19, 1, 13, 1, 19],
+        [6, 18, 24, 1, 18, 2, 1],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "😁" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "😁" } },
+                    { id: "5", constExpr: { stringValue: "😑" } },
+                    { id: "6", constExpr: { stringValue: "😦" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 4, "3": 7, "4": 8, "5": 13, "6": 18 },
+        },
+      },
       checkedAst:
         '@in(\n  "😁"~string,\n  [\n    "😁"~string,\n    "😑"~string,\n    "😦"~string\n  ]~list(string)\n)~bool^in_list',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "STRING" } } },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 4, "3": 7, "4": 8, "5": 13, "6": 18 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "😁" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { stringValue: "😁" } },
+                    { id: "5", constExpr: { stringValue: "😑" } },
+                    { id: "6", constExpr: { stringValue: "😦" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
       type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+      expectedAst:
+        '@in(\n\t\t\t"😁"^#1:*expr.Constant_StringValue#,\n\t\t\t[\n\t\t\t\t"😁"^#4:*expr.Constant_StringValue#,\n\t\t\t\t"😑"^#5:*expr.Constant_StringValue#,\n\t\t\t\t"😦"^#6:*expr.Constant_StringValue#\n\t\t\t]^#3:*expr.Expr_ListExpr#\n\t\t)^#2:*expr.Expr_CallExpr#',
     },
     {
       original: {
         expr: "      '😁' in ['😁', '😑', '😦']\n\t\t\t\u0026\u0026 in.😁",
       },
       error:
-        "ERROR: :2:7: Syntax error: extraneous input 'in' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n |    \u0026\u0026 in.😁\n | ......^\nERROR: :2:10: Syntax error: token recognition error at: '😁'\n |    \u0026\u0026 in.😁\n | .........＾\nERROR: :2:11: Syntax error: no viable alternative at input '.'\n |    \u0026\u0026 in.😁\n | .........．^",
+        "ERROR: \u003cinput\u003e:2:7: Syntax error: extraneous input 'in' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n |    \u0026\u0026 in.😁\n | ......^\nERROR: \u003cinput\u003e:2:10: Syntax error: token recognition error at: '😁'\n |    \u0026\u0026 in.😁\n | .........＾\nERROR: \u003cinput\u003e:2:11: Syntax error: no viable alternative at input '.'\n |    \u0026\u0026 in.😁\n | .........．^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input 'in' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 35,
+          line: 2,
+          column: 6,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '😁'",
+          id: 0,
+          offset: 38,
+          line: 2,
+          column: 9,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: no viable alternative at input '.'",
+          id: 0,
+          offset: 39,
+          line: 2,
+          column: 10,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:2:7: Syntax error: extraneous input 'in' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t|    \u0026\u0026 in.😁\n\t\t| ......^\n\t    ERROR: \u003cinput\u003e:2:10: Syntax error: token recognition error at: '😁'\n\t\t|    \u0026\u0026 in.😁\n\t\t| .........＾\n\t\tERROR: \u003cinput\u003e:2:11: Syntax error: no viable alternative at input '.'\n\t\t|    \u0026\u0026 in.😁\n\t\t| .........．^",
     },
     {
       original: { expr: "as" },
-      error: "ERROR: :1:1: reserved identifier: as\n | as\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: as\n | as\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: as",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: as\n\t\t| as\n\t\t| ^",
     },
     {
       original: { expr: "break" },
-      error: "ERROR: :1:1: reserved identifier: break\n | break\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: break\n | break\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: break",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: break\n\t\t| break\n\t\t| ^",
     },
     {
       original: { expr: "const" },
-      error: "ERROR: :1:1: reserved identifier: const\n | const\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: const\n | const\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: const",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: const\n\t\t| const\n\t\t| ^",
     },
     {
       original: { expr: "continue" },
-      error: "ERROR: :1:1: reserved identifier: continue\n | continue\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: continue\n | continue\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: continue",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: continue\n\t\t| continue\n\t\t| ^",
     },
     {
       original: { expr: "else" },
-      error: "ERROR: :1:1: reserved identifier: else\n | else\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: else\n | else\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: else",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: else\n\t\t| else\n\t\t| ^",
     },
     {
       original: { expr: "for" },
-      error: "ERROR: :1:1: reserved identifier: for\n | for\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: for\n | for\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: for",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: for\n\t\t| for\n\t\t| ^",
     },
     {
       original: { expr: "function" },
-      error: "ERROR: :1:1: reserved identifier: function\n | function\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: function\n | function\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: function",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: function\n\t\t| function\n\t\t| ^",
     },
     {
       original: { expr: "if" },
-      error: "ERROR: :1:1: reserved identifier: if\n | if\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: if\n | if\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: if",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: if\n\t\t| if\n\t\t| ^",
     },
     {
       original: { expr: "import" },
-      error: "ERROR: :1:1: reserved identifier: import\n | import\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: import\n | import\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: import",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: import\n\t\t| import\n\t\t| ^",
     },
     {
       original: { expr: "in" },
       error:
-        "ERROR: :1:1: Syntax error: mismatched input 'in' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | in\n | ^\nERROR: :1:3: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | in\n | ..^",
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: mismatched input 'in' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | in\n | ^\nERROR: \u003cinput\u003e:1:3: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | in\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input 'in' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: mismatched input 'in' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| in\n\t\t| ^\n        ERROR: \u003cinput\u003e:1:3: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| in\n\t\t| ..^",
     },
     {
       original: { expr: "let" },
-      error: "ERROR: :1:1: reserved identifier: let\n | let\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: let\n | let\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: let",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: let\n\t\t| let\n\t\t| ^",
     },
     {
       original: { expr: "loop" },
-      error: "ERROR: :1:1: reserved identifier: loop\n | loop\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: loop\n | loop\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: loop",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: loop\n\t\t| loop\n\t\t| ^",
     },
     {
       original: { expr: "package" },
-      error: "ERROR: :1:1: reserved identifier: package\n | package\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: package\n | package\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: package",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: package\n\t\t| package\n\t\t| ^",
     },
     {
       original: { expr: "namespace" },
-      error: "ERROR: :1:1: reserved identifier: namespace\n | namespace\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: namespace\n | namespace\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: namespace",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: namespace\n\t\t| namespace\n\t\t| ^",
     },
     {
       original: { expr: "return" },
-      error: "ERROR: :1:1: reserved identifier: return\n | return\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: return\n | return\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: return",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: return\n\t\t| return\n\t\t| ^",
     },
     {
       original: { expr: "var" },
-      error: "ERROR: :1:1: reserved identifier: var\n | var\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: var\n | var\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: var",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: var\n\t\t| var\n\t\t| ^",
     },
     {
       original: { expr: "void" },
-      error: "ERROR: :1:1: reserved identifier: void\n | void\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: void\n | void\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: void",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: void\n\t\t| void\n\t\t| ^",
     },
     {
       original: { expr: "while" },
-      error: "ERROR: :1:1: reserved identifier: while\n | while\n | ^",
+      error:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: while\n | while\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: while",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: reserved identifier: while\n\t\t| while\n\t\t| ^",
     },
     {
       original: { expr: "[1, 2, 3].map(var, var * var)" },
       error:
-        "ERROR: :1:15: reserved identifier: var\n | [1, 2, 3].map(var, var * var)\n | ..............^\nERROR: :1:15: argument is not an identifier\n | [1, 2, 3].map(var, var * var)\n | ..............^\nERROR: :1:20: reserved identifier: var\n | [1, 2, 3].map(var, var * var)\n | ...................^\nERROR: :1:26: reserved identifier: var\n | [1, 2, 3].map(var, var * var)\n | .........................^",
+        "ERROR: \u003cinput\u003e:1:15: reserved identifier: var\n | [1, 2, 3].map(var, var * var)\n | ..............^\nERROR: \u003cinput\u003e:1:15: argument is not an identifier\n | [1, 2, 3].map(var, var * var)\n | ..............^\nERROR: \u003cinput\u003e:1:20: reserved identifier: var\n | [1, 2, 3].map(var, var * var)\n | ...................^\nERROR: \u003cinput\u003e:1:26: reserved identifier: var\n | [1, 2, 3].map(var, var * var)\n | .........................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "reserved identifier: var",
+          id: 6,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+        {
+          category: "syntax",
+          message: "argument is not an identifier",
+          id: 10,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+        {
+          category: "syntax",
+          message: "reserved identifier: var",
+          id: 7,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+        {
+          category: "syntax",
+          message: "reserved identifier: var",
+          id: 9,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:15: reserved identifier: var\n\t\t| [1, 2, 3].map(var, var * var)\n\t\t| ..............^\n\t\tERROR: \u003cinput\u003e:1:15: argument is not an identifier\n\t\t| [1, 2, 3].map(var, var * var)\n\t\t| ..............^\n\t\tERROR: \u003cinput\u003e:1:20: reserved identifier: var\n\t\t| [1, 2, 3].map(var, var * var)\n\t\t| ...................^\n\t\tERROR: \u003cinput\u003e:1:26: reserved identifier: var\n\t\t| [1, 2, 3].map(var, var * var)\n\t\t| .........................^",
     },
     {
       original: { expr: "func{{a}}" },
       error:
-        "ERROR: :1:6: Syntax error: extraneous input '{' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n | func{{a}}\n | .....^\nERROR: :1:8: Syntax error: mismatched input '}' expecting ':'\n | func{{a}}\n | .......^\nERROR: :1:9: Syntax error: extraneous input '}' expecting \u003cEOF\u003e\n | func{{a}}\n | ........^",
+        "ERROR: \u003cinput\u003e:1:6: Syntax error: extraneous input '{' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n | func{{a}}\n | .....^\nERROR: \u003cinput\u003e:1:8: Syntax error: mismatched input '}' expecting ':'\n | func{{a}}\n | .......^\nERROR: \u003cinput\u003e:1:9: Syntax error: extraneous input '}' expecting \u003cEOF\u003e\n | func{{a}}\n | ........^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input '{' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}",
+          id: 0,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: mismatched input '}' expecting ':'",
+          id: 0,
+          offset: 7,
+          line: 1,
+          column: 7,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input '}' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:6: Syntax error: extraneous input '{' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n\t\t| func{{a}}\n\t\t| .....^\n\t    ERROR: \u003cinput\u003e:1:8: Syntax error: mismatched input '}' expecting ':'\n\t\t| func{{a}}\n\t\t| .......^\n\t    ERROR: \u003cinput\u003e:1:9: Syntax error: extraneous input '}' expecting \u003cEOF\u003e\n\t\t| func{{a}}\n\t\t| ........^",
     },
     {
       original: { expr: "msg{:a}" },
       error:
-        "ERROR: :1:5: Syntax error: extraneous input ':' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n | msg{:a}\n | ....^\nERROR: :1:7: Syntax error: mismatched input '}' expecting ':'\n | msg{:a}\n | ......^",
+        "ERROR: \u003cinput\u003e:1:5: Syntax error: extraneous input ':' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n | msg{:a}\n | ....^\nERROR: \u003cinput\u003e:1:7: Syntax error: mismatched input '}' expecting ':'\n | msg{:a}\n | ......^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input ':' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}",
+          id: 0,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: mismatched input '}' expecting ':'",
+          id: 0,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:5: Syntax error: extraneous input ':' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n\t\t| msg{:a}\n\t\t| ....^\n\t    ERROR: \u003cinput\u003e:1:7: Syntax error: mismatched input '}' expecting ':'\n\t\t| msg{:a}\n\t\t| ......^",
     },
     {
       original: { expr: "{a}" },
       error:
-        "ERROR: :1:3: Syntax error: mismatched input '}' expecting ':'\n | {a}\n | ..^",
+        "ERROR: \u003cinput\u003e:1:3: Syntax error: mismatched input '}' expecting ':'\n | {a}\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: mismatched input '}' expecting ':'",
+          id: 0,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:3: Syntax error: mismatched input '}' expecting ':'\n\t\t| {a}\n\t\t| ..^",
     },
     {
       original: { expr: "{:a}" },
       error:
-        "ERROR: :1:2: Syntax error: extraneous input ':' expecting {'[', '{', '}', '(', '.', ',', '-', '!', '?', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | {:a}\n | .^\nERROR: :1:4: Syntax error: mismatched input '}' expecting ':'\n | {:a}\n | ...^",
+        "ERROR: \u003cinput\u003e:1:2: Syntax error: extraneous input ':' expecting {'[', '{', '}', '(', '.', ',', '-', '!', '?', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | {:a}\n | .^\nERROR: \u003cinput\u003e:1:4: Syntax error: mismatched input '}' expecting ':'\n | {:a}\n | ...^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input ':' expecting {'[', '{', '}', '(', '.', ',', '-', '!', '?', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: mismatched input '}' expecting ':'",
+          id: 0,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:2: Syntax error: extraneous input ':' expecting {'[', '{', '}', '(', '.', ',', '-', '!', '?', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| {:a}\n\t\t| .^\n\t    ERROR: \u003cinput\u003e:1:4: Syntax error: mismatched input '}' expecting ':'\n\t\t| {:a}\n\t\t| ...^",
     },
     {
       original: { expr: "ind[a{b}]" },
       error:
-        "ERROR: :1:8: Syntax error: mismatched input '}' expecting ':'\n | ind[a{b}]\n | .......^",
+        "ERROR: \u003cinput\u003e:1:8: Syntax error: mismatched input '}' expecting ':'\n | ind[a{b}]\n | .......^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: mismatched input '}' expecting ':'",
+          id: 0,
+          offset: 7,
+          line: 1,
+          column: 7,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:8: Syntax error: mismatched input '}' expecting ':'\n\t\t| ind[a{b}]\n\t\t| .......^",
     },
     {
       original: { expr: "--" },
       error:
-        "ERROR: :1:3: Syntax error: no viable alternative at input '-'\n | --\n | ..^\nERROR: :1:3: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | --\n | ..^",
+        "ERROR: \u003cinput\u003e:1:3: Syntax error: no viable alternative at input '-'\n | --\n | ..^\nERROR: \u003cinput\u003e:1:3: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | --\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: no viable alternative at input '-'",
+          id: 0,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:3: Syntax error: no viable alternative at input '-'\n\t\t| --\n\t\t| ..^\n\t    ERROR: \u003cinput\u003e:1:3: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| --\n\t\t| ..^",
     },
     {
       original: { expr: "?" },
       error:
-        "ERROR: :1:1: Syntax error: mismatched input '?' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | ?\n | ^\nERROR: :1:2: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | ?\n | .^",
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: mismatched input '?' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | ?\n | ^\nERROR: \u003cinput\u003e:1:2: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | ?\n | .^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '?' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: mismatched input '?' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| ?\n\t\t| ^\n\t    ERROR: \u003cinput\u003e:1:2: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| ?\n\t\t| .^",
     },
     {
       original: { expr: "a ? b ((?))" },
       error:
-        "ERROR: :1:9: Syntax error: mismatched input '?' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | a ? b ((?))\n | ........^\nERROR: :1:10: Syntax error: mismatched input ')' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | a ? b ((?))\n | .........^\nERROR: :1:12: Syntax error: error recovery attempt limit exceeded: 4\n | a ? b ((?))\n | ...........^",
+        "ERROR: \u003cinput\u003e:1:9: Syntax error: mismatched input '?' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | a ? b ((?))\n | ........^\nERROR: \u003cinput\u003e:1:10: Syntax error: mismatched input ')' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | a ? b ((?))\n | .........^\nERROR: \u003cinput\u003e:1:12: Syntax error: error recovery attempt limit exceeded: 4\n | a ? b ((?))\n | ...........^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '?' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input ')' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: error recovery attempt limit exceeded: 4",
+          id: 0,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:9: Syntax error: mismatched input '?' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| a ? b ((?))\n\t\t| ........^\n\t    ERROR: \u003cinput\u003e:1:10: Syntax error: mismatched input ')' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t| a ? b ((?))\n\t\t| .........^\n\t    ERROR: \u003cinput\u003e:1:12: Syntax error: error recovery attempt limit exceeded: 4\n\t\t| a ? b ((?))\n\t\t| ...........^",
     },
     {
       original: {
         expr: "[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[\n\t\t\t[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[['too many']]]]]]]]]]]]]]]]]]]]]]]]]]]]\n\t\t\t]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]",
       },
-      error: "ERROR: :-1:0: expression recursion limit exceeded: 32",
+      error:
+        "ERROR: \u003cinput\u003e:-1:0: expression recursion limit exceeded: 32",
+      errors: [
+        {
+          category: "syntax",
+          message: "expression recursion limit exceeded: 32",
+          id: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: expression recursion limit exceeded: 32",
     },
     {
       original: {
         expr: "-[-1--1--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n\t\t--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--3--1--1--0--1--1--1--1--0--1--1--1\n\t\t--3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n\t\t--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n\t\t--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n\t\t--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n\t\t--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--3--1--1--0--1--1--1--1--0--1--1--1\n\t\t--3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n\t\t--3-[-1--1--1--1---1-1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1-À1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1--1--0--1--1--1--1--0--3--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n\t\t--1--1---1--1--1--0--1--1--1--1--0--3--1--1--0--1--1--1\n\t\t--1--0--1--1--1--3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1\n\t\t--1--0--1--1--1--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1\n\t\t--1--0--1--1--1--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1\n\t\t--1--0--1--1--1--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1\n\t\t--1--0--1--1--1--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--3--1--1--0--1--1--1\n\t\t--1--0--1--1--1--3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1\n\t\t--1--0--1--1--1--3-[-1--1--1--1---1--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0--1--1--1--1--0--3--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1--1\n\t\t--1---1--1--1--0--1--1--1--1--0--3--1--1--0--1",
       },
       error:
-        "ERROR: :-1:0: expression recursion limit exceeded: 32\nERROR: :3:33: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n |   --3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n | ................................^\nERROR: :8:33: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n |   --3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n | ................................^\nERROR: :11:17: Syntax error: token recognition error at: 'À'\n |   --1--1---1--1-À1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n | ................＾\nERROR: :14:23: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n |   --1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n | ......................^",
+        "ERROR: \u003cinput\u003e:-1:0: expression recursion limit exceeded: 32\nERROR: \u003cinput\u003e:3:33: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n |   --3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n | ................................^\nERROR: \u003cinput\u003e:8:33: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n |   --3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n | ................................^\nERROR: \u003cinput\u003e:11:17: Syntax error: token recognition error at: 'À'\n |   --1--1---1--1-À1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n | ................＾\nERROR: \u003cinput\u003e:14:23: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n |   --1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n | ......................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "expression recursion limit exceeded: 32",
+          id: 0,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 193,
+          line: 3,
+          column: 32,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 608,
+          line: 8,
+          column: 32,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: 'À'",
+          id: 0,
+          offset: 841,
+          line: 11,
+          column: 16,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 1096,
+          line: 14,
+          column: 22,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: expression recursion limit exceeded: 32\n        ERROR: \u003cinput\u003e:3:33: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n        |   --3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n        | ................................^\n        ERROR: \u003cinput\u003e:8:33: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n        |   --3-[-1--1--1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1\n        | ................................^\n        ERROR: \u003cinput\u003e:11:17: Syntax error: token recognition error at: 'À'\n        |   --1--1---1--1-À1--0--1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n        | ................＾\n        ERROR: \u003cinput\u003e:14:23: Syntax error: extraneous input '/' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n        |   --1--1---1--1--1--0-/1--1--1--1--0--2--1--1--0--1--1--1--1--0--1--1--1--3-[-1--1\n        | ......................^",
     },
     {
       original: {
         expr: 'ó ¢\n\t\tó 0 \n\t\t0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"',
       },
       error:
-        'ERROR: :-1:0: error recovery token lookahead limit exceeded: 4\nERROR: :1:1: Syntax error: token recognition error at: \'ó\'\n | ó ¢\n | ＾\nERROR: :1:2: Syntax error: token recognition error at: \' \'\n | ó ¢\n | ．＾\nERROR: :1:3: Syntax error: token recognition error at: \'¢\'\n | ó ¢\n | ．．＾\nERROR: :2:3: Syntax error: token recognition error at: \'ó\'\n |   ó 0 \n | ..＾\nERROR: :2:4: Syntax error: token recognition error at: \' \'\n |   ó 0 \n | ..．＾\nERROR: :2:6: Syntax error: token recognition error at: \' \'\n |   ó 0 \n | ..．．.＾\nERROR: :3:3: Syntax error: token recognition error at: \'\'\n |   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n | ..^\nERROR: :3:4: Syntax error: mismatched input \'0\' expecting \u003cEOF\u003e\n |   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n | ...^\nERROR: :3:11: Syntax error: token recognition error at: \'\\\'\n |   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n | ..........^',
+        'ERROR: \u003cinput\u003e:-1:0: error recovery token lookahead limit exceeded: 4\nERROR: \u003cinput\u003e:1:1: Syntax error: token recognition error at: \'ó\'\n | ó ¢\n | ＾\nERROR: \u003cinput\u003e:1:2: Syntax error: token recognition error at: \' \'\n | ó ¢\n | ．＾\nERROR: \u003cinput\u003e:1:3: Syntax error: token recognition error at: \'¢\'\n | ó ¢\n | ．．＾\nERROR: \u003cinput\u003e:2:3: Syntax error: token recognition error at: \'ó\'\n |   ó 0 \n | ..＾\nERROR: \u003cinput\u003e:2:4: Syntax error: token recognition error at: \' \'\n |   ó 0 \n | ..．＾\nERROR: \u003cinput\u003e:2:6: Syntax error: token recognition error at: \' \'\n |   ó 0 \n | ..．．.＾\nERROR: \u003cinput\u003e:3:3: Syntax error: token recognition error at: \'\'\n |   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n | ..^\nERROR: \u003cinput\u003e:3:4: Syntax error: mismatched input \'0\' expecting \u003cEOF\u003e\n |   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n | ...^\nERROR: \u003cinput\u003e:3:11: Syntax error: token recognition error at: \'\\\'\n |   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n | ..........^',
+      errors: [
+        {
+          category: "syntax",
+          message: "error recovery token lookahead limit exceeded: 4",
+          id: 0,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: 'ó'",
+          id: 0,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: ' '",
+          id: 0,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '¢'",
+          id: 0,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: 'ó'",
+          id: 0,
+          offset: 6,
+          line: 2,
+          column: 2,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: ' '",
+          id: 0,
+          offset: 7,
+          line: 2,
+          column: 3,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: ' '",
+          id: 0,
+          offset: 9,
+          line: 2,
+          column: 5,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: ''",
+          id: 0,
+          offset: 13,
+          line: 3,
+          column: 2,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '0' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 14,
+          line: 3,
+          column: 3,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '\\'",
+          id: 0,
+          offset: 21,
+          line: 3,
+          column: 10,
+        },
+      ],
+      expectedError:
+        'ERROR: \u003cinput\u003e:-1:0: error recovery token lookahead limit exceeded: 4\n\t\tERROR: \u003cinput\u003e:1:1: Syntax error: token recognition error at: \'ó\'\n\t    | ó ¢\n\t\t| ＾\n\t\tERROR: \u003cinput\u003e:1:2: Syntax error: token recognition error at: \' \'\n\t\t| ó ¢\n\t\t| ．＾\n\t\tERROR: \u003cinput\u003e:1:3: Syntax error: token recognition error at: \'¢\'\n\t\t| ó ¢\n\t\t| ．．＾\n\t\tERROR: \u003cinput\u003e:2:3: Syntax error: token recognition error at: \'ó\'\n\t\t|   ó 0 \n\t\t| ..＾\n\t\tERROR: \u003cinput\u003e:2:4: Syntax error: token recognition error at: \' \'\n\t\t|   ó 0 \n\t\t| ..．＾\n\t\tERROR: \u003cinput\u003e:2:6: Syntax error: token recognition error at: \' \'\n\t\t|   ó 0 \n\t\t| ..．．.＾\n\t\tERROR: \u003cinput\u003e:3:3: Syntax error: token recognition error at: \'\'\n\t\t|   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n\t\t| ..^\n\t\tERROR: \u003cinput\u003e:3:4: Syntax error: mismatched input \'0\' expecting \u003cEOF\u003e\n\t\t|   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n\t\t| ...^\n\t\tERROR: \u003cinput\u003e:3:11: Syntax error: token recognition error at: \'\\\'\n\t\t|   0"""\\""\\"""\\""\\"""\\""\\"""\\""\\"""\\"\\"""\\""\\"""\\""\\"""\\""\\"""\\"!\\"""\\""\\"""\\""\\"\n\t\t| ..........^',
     },
     {
       original: { expr: "x.filter(y, y.filter(z, z \u003e 0))" },
       ast: "__comprehension__(\n  // Variable\n  y,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    __comprehension__(\n      // Variable\n      z,\n      // Target\n      y^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _?_:_(\n        _\u003e_(\n          z^#*expr.Expr_IdentExpr#,\n          0^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#,\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            z^#*expr.Expr_IdentExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        y^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "x.filter(y, y.filter(z, z \u003e 0))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  x^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#19[1,8]#,\n  // LoopCondition\n  true^#20[1,8]#,\n  // LoopStep\n  _?_:_(\n    __comprehension__(\n      // Variable\n      z,\n      // Target\n      y^#4[1,12]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#10[1,20]#,\n      // LoopCondition\n      true^#11[1,20]#,\n      // LoopStep\n      _?_:_(\n        _\u003e_(\n          z^#7[1,24]#,\n          0^#9[1,28]#\n        )^#8[1,26]#,\n        _+_(\n          @result^#12[1,20]#,\n          [\n            z^#6[1,21]#\n          ]^#13[1,20]#\n        )^#14[1,20]#,\n        @result^#15[1,20]#\n      )^#16[1,20]#,\n      // Result\n      @result^#17[1,20]#)^#18[1,20]#,\n    _+_(\n      @result^#21[1,8]#,\n      [\n        y^#3[1,9]#\n      ]^#22[1,8]#\n    )^#23[1,8]#,\n    @result^#24[1,8]#\n  )^#25[1,8]#,\n  // Result\n  @result^#26[1,8]#)^#27[1,8]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 20, 20, 1, 20, 1, 20],
+        [11, 20, 20, 1, 20, 1, 20],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 20, 20, 1, 20, 1, 20],
+        [16, 20, 20, 1, 20, 1, 20],
+        [17, 20, 20, 1, 20, 1, 20],
+        [18, 20, 20, 1, 20, 1, 20],
+        [19, 8, 8, 1, 8, 1, 8],
+        [20, 8, 8, 1, 8, 1, 8],
+        [21, 8, 8, 1, 8, 1, 8],
+        [22, 8, 8, 1, 8, 1, 8],
+        [23, 8, 8, 1, 8, 1, 8],
+        [24, 8, 8, 1, 8, 1, 8],
+        [25, 8, 8, 1, 8, 1, 8],
+        [26, 8, 8, 1, 8, 1, 8],
+        [27, 8, 8, 1, 8, 1, 8],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: { id: "1", identExpr: { name: "x" } },
+            accuVar: "@result",
+            accuInit: { id: "19", listExpr: {} },
+            loopCondition: { id: "20", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "18",
+                    comprehensionExpr: {
+                      iterVar: "z",
+                      iterRange: { id: "4", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "16",
+                        callExpr: {
+                          function: "_?_:_",
+                          args: [
+                            {
+                              id: "8",
+                              callExpr: {
+                                function: "_\u003e_",
+                                args: [
+                                  { id: "7", identExpr: { name: "z" } },
+                                  { id: "9", constExpr: { int64Value: "0" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "14",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "12", identExpr: { name: "@result" } },
+                                  {
+                                    id: "13",
+                                    listExpr: {
+                                      elements: [
+                                        { id: "6", identExpr: { name: "z" } },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            { id: "15", identExpr: { name: "@result" } },
+                          ],
+                        },
+                      },
+                      result: { id: "17", identExpr: { name: "@result" } },
+                    },
+                  },
+                  {
+                    id: "23",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "21", identExpr: { name: "@result" } },
+                        {
+                          id: "22",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "y" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "24", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "6": 21,
+            "7": 24,
+            "8": 26,
+            "9": 28,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 8,
+            "20": 8,
+            "21": 8,
+            "22": 8,
+            "23": 8,
+            "24": 8,
+            "25": 8,
+            "26": 8,
+            "27": 8,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: { id: "4", identExpr: { name: "y" } },
+                function: "filter",
+                args: [
+                  { id: "6", identExpr: { name: "z" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "z" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "x" } },
+                function: "filter",
+                args: [{ id: "3", identExpr: { name: "y" } }, { id: "18" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "filter",
+          target: { id: "4", identExpr: { name: "y" } },
+          args: [
+            { id: "6", identExpr: { name: "z" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "7", identExpr: { name: "z" } },
+                  { id: "9", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 27,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "x" } },
+          args: [{ id: "3", identExpr: { name: "y" } }, { id: "18" }],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x.filter(y, y.filter(z, z \u003e 0))\n | ^\nERROR: \u003cinput\u003e:1:9: found no matching overload for '_?_:_' applied to '(list(dyn), list(dyn), list(dyn))'\n | x.filter(y, y.filter(z, z \u003e 0))\n | ........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_?_:_' applied to '(list(dyn), list(dyn), list(dyn))'",
+          id: 25,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\ty,\n\t\t\t// Target\n\t\t\tx^#1:*expr.Expr_IdentExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]^#19:*expr.Expr_ListExpr#,\n\t\t\t// LoopCondition\n\t\t\ttrue^#20:*expr.Constant_BoolValue#,\n\t\t\t// LoopStep\n\t\t\t_?_:_(\n\t\t\t  __comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tz,\n\t\t\t\t// Target\n\t\t\t\ty^#4:*expr.Expr_IdentExpr#,\n\t\t\t\t// Accumulator\n\t\t\t\t@result,\n\t\t\t\t// Init\n\t\t\t\t[]^#10:*expr.Expr_ListExpr#,\n\t\t\t\t// LoopCondition\n\t\t\t\ttrue^#11:*expr.Constant_BoolValue#,\n\t\t\t\t// LoopStep\n\t\t\t\t_?_:_(\n\t\t\t\t  _\u003e_(\n\t\t\t\t\tz^#7:*expr.Expr_IdentExpr#,\n\t\t\t\t\t0^#9:*expr.Constant_Int64Value#\n\t\t\t\t  )^#8:*expr.Expr_CallExpr#,\n\t\t\t\t  _+_(\n\t\t\t\t\t@result^#12:*expr.Expr_IdentExpr#,\n\t\t\t\t\t[\n\t\t\t\t\t  z^#6:*expr.Expr_IdentExpr#\n\t\t\t\t\t]^#13:*expr.Expr_ListExpr#\n\t\t\t\t  )^#14:*expr.Expr_CallExpr#,\n\t\t\t\t  @result^#15:*expr.Expr_IdentExpr#\n\t\t\t\t)^#16:*expr.Expr_CallExpr#,\n\t\t\t\t// Result\n\t\t\t\t@result^#17:*expr.Expr_IdentExpr#)^#18:*expr.Expr_ComprehensionExpr#,\n\t\t\t  _+_(\n\t\t\t\t@result^#21:*expr.Expr_IdentExpr#,\n\t\t\t\t[\n\t\t\t\t  y^#3:*expr.Expr_IdentExpr#\n\t\t\t\t]^#22:*expr.Expr_ListExpr#\n\t\t\t  )^#23:*expr.Expr_CallExpr#,\n\t\t\t  @result^#24:*expr.Expr_IdentExpr#\n\t\t\t)^#25:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#26:*expr.Expr_IdentExpr#)^#27:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "x^#1:*expr.Expr_IdentExpr#.filter(\n\t\t\ty^#3:*expr.Expr_IdentExpr#,\n\t\t\t^#18:filter#\n\t\t  )^#27:filter#,\n\t\t  y^#4:*expr.Expr_IdentExpr#.filter(\n\t\t\tz^#6:*expr.Expr_IdentExpr#,\n\t\t\t_\u003e_(\n\t\t\t  z^#7:*expr.Expr_IdentExpr#,\n\t\t\t  0^#9:*expr.Constant_Int64Value#\n\t\t\t)^#8:*expr.Expr_CallExpr#\n\t\t  )^#18:filter#",
     },
     {
       original: { expr: "has(a.b).filter(c, c)" },
       ast: "__comprehension__(\n  // Variable\n  c,\n  // Target\n  a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    c^#*expr.Expr_IdentExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        c^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "has(a.b).filter(c, c)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  c,\n  // Target\n  a^#2[1,4]#.b~test-only~^#4[1,3]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#8[1,15]#,\n  // LoopCondition\n  true^#9[1,15]#,\n  // LoopStep\n  _?_:_(\n    c^#7[1,19]#,\n    _+_(\n      @result^#10[1,15]#,\n      [\n        c^#6[1,16]#\n      ]^#11[1,15]#\n    )^#12[1,15]#,\n    @result^#13[1,15]#\n  )^#14[1,15]#,\n  // Result\n  @result^#15[1,15]#)^#16[1,15]#",
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+        [6, 16, 17, 1, 16, 1, 17],
+        [7, 19, 20, 1, 19, 1, 20],
+        [8, 15, 15, 1, 15, 1, 15],
+        [9, 15, 15, 1, 15, 1, 15],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "c",
+            iterRange: {
+              id: "4",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "a" } },
+                field: "b",
+                testOnly: true,
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "8", listExpr: {} },
+            loopCondition: { id: "9", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "7", identExpr: { name: "c" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "10", identExpr: { name: "@result" } },
+                        {
+                          id: "11",
+                          listExpr: {
+                            elements: [{ id: "6", identExpr: { name: "c" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "13", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "6": 16,
+            "7": 19,
+            "8": 15,
+            "9": 15,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "16": {
+              callExpr: {
+                target: { id: "4" },
+                function: "filter",
+                args: [
+                  { id: "6", identExpr: { name: "c" } },
+                  { id: "7", identExpr: { name: "c" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 16,
+          function: "filter",
+          target: { id: "4" },
+          args: [
+            { id: "6", identExpr: { name: "c" } },
+            { id: "7", identExpr: { name: "c" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:4: expression of type 'bool' cannot be range of a comprehension (must be list, map, or dynamic)\n | has(a.b).filter(c, c)\n | ...^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'a' (in container '')\n | has(a.b).filter(c, c)\n | ....^",
+      errors: [
+        {
+          category: "other",
+          message:
+            "expression of type 'bool' cannot be range of a comprehension (must be list, map, or dynamic)",
+          id: 4,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tc,\n\t\t\t// Target\n\t\t\ta^#2:*expr.Expr_IdentExpr#.b~test-only~^#4:*expr.Expr_SelectExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]^#8:*expr.Expr_ListExpr#,\n\t\t\t// LoopCondition\n\t\t\ttrue^#9:*expr.Constant_BoolValue#,\n\t\t\t// LoopStep\n\t\t\t_?_:_(\n\t\t\t  c^#7:*expr.Expr_IdentExpr#,\n\t\t\t  _+_(\n\t\t\t\t@result^#10:*expr.Expr_IdentExpr#,\n\t\t\t\t[\n\t\t\t\t  c^#6:*expr.Expr_IdentExpr#\n\t\t\t\t]^#11:*expr.Expr_ListExpr#\n\t\t\t  )^#12:*expr.Expr_CallExpr#,\n\t\t\t  @result^#13:*expr.Expr_IdentExpr#\n\t\t\t)^#14:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#15:*expr.Expr_IdentExpr#)^#16:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "^#4:has#.filter(\n\t\t\tc^#6:*expr.Expr_IdentExpr#,\n\t\t\tc^#7:*expr.Expr_IdentExpr#\n\t\t\t)^#16:filter#,\n\t\t\thas(\n\t\t\t\ta^#2:*expr.Expr_IdentExpr#.b^#3:*expr.Expr_SelectExpr#\n\t\t\t)^#4:has#",
     },
     {
       original: {
         expr: "x.filter(y, y.exists(z, has(z.a)) \u0026\u0026 y.exists(z, has(z.b)))",
       },
       ast: "__comprehension__(\n  // Variable\n  y,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        z,\n        // Target\n        y^#*expr.Expr_IdentExpr#,\n        // Accumulator\n        @result,\n        // Init\n        false^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          !_(\n            @result^#*expr.Expr_IdentExpr#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _||_(\n          @result^#*expr.Expr_IdentExpr#,\n          z^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n      __comprehension__(\n        // Variable\n        z,\n        // Target\n        y^#*expr.Expr_IdentExpr#,\n        // Accumulator\n        @result,\n        // Init\n        false^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          !_(\n            @result^#*expr.Expr_IdentExpr#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _||_(\n          @result^#*expr.Expr_IdentExpr#,\n          z^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        y^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "x.filter(y, y.exists(z, has(z.a)) \u0026\u0026 y.exists(z, has(z.b)))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  x^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#35[1,8]#,\n  // LoopCondition\n  true^#36[1,8]#,\n  // LoopStep\n  _?_:_(\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        z,\n        // Target\n        y^#4[1,12]#,\n        // Accumulator\n        @result,\n        // Init\n        false^#11[1,20]#,\n        // LoopCondition\n        @not_strictly_false(\n          !_(\n            @result^#12[1,20]#\n          )^#13[1,20]#\n        )^#14[1,20]#,\n        // LoopStep\n        _||_(\n          @result^#15[1,20]#,\n          z^#8[1,28]#.a~test-only~^#10[1,27]#\n        )^#16[1,20]#,\n        // Result\n        @result^#17[1,20]#)^#18[1,20]#,\n      __comprehension__(\n        // Variable\n        z,\n        // Target\n        y^#19[1,37]#,\n        // Accumulator\n        @result,\n        // Init\n        false^#26[1,45]#,\n        // LoopCondition\n        @not_strictly_false(\n          !_(\n            @result^#27[1,45]#\n          )^#28[1,45]#\n        )^#29[1,45]#,\n        // LoopStep\n        _||_(\n          @result^#30[1,45]#,\n          z^#23[1,53]#.b~test-only~^#25[1,52]#\n        )^#31[1,45]#,\n        // Result\n        @result^#32[1,45]#)^#33[1,45]#\n    )^#34[1,34]#,\n    _+_(\n      @result^#37[1,8]#,\n      [\n        y^#3[1,9]#\n      ]^#38[1,8]#\n    )^#39[1,8]#,\n    @result^#40[1,8]#\n  )^#41[1,8]#,\n  // Result\n  @result^#42[1,8]#)^#43[1,8]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [6, 21, 22, 1, 21, 1, 22],
+        [8, 28, 29, 1, 28, 1, 29],
+        [9, 29, 30, 1, 29, 1, 30],
+        [10, 27, 27, 1, 27, 1, 27],
+        [11, 20, 20, 1, 20, 1, 20],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 20, 20, 1, 20, 1, 20],
+        [16, 20, 20, 1, 20, 1, 20],
+        [17, 20, 20, 1, 20, 1, 20],
+        [18, 20, 20, 1, 20, 1, 20],
+        [19, 37, 38, 1, 37, 1, 38],
+        [21, 46, 47, 1, 46, 1, 47],
+        [23, 53, 54, 1, 53, 1, 54],
+        [24, 54, 55, 1, 54, 1, 55],
+        [25, 52, 52, 1, 52, 1, 52],
+        [26, 45, 45, 1, 45, 1, 45],
+        [27, 45, 45, 1, 45, 1, 45],
+        [28, 45, 45, 1, 45, 1, 45],
+        [29, 45, 45, 1, 45, 1, 45],
+        [30, 45, 45, 1, 45, 1, 45],
+        [31, 45, 45, 1, 45, 1, 45],
+        [32, 45, 45, 1, 45, 1, 45],
+        [33, 45, 45, 1, 45, 1, 45],
+        [34, 34, 36, 1, 34, 1, 36],
+        [35, 8, 8, 1, 8, 1, 8],
+        [36, 8, 8, 1, 8, 1, 8],
+        [37, 8, 8, 1, 8, 1, 8],
+        [38, 8, 8, 1, 8, 1, 8],
+        [39, 8, 8, 1, 8, 1, 8],
+        [40, 8, 8, 1, 8, 1, 8],
+        [41, 8, 8, 1, 8, 1, 8],
+        [42, 8, 8, 1, 8, 1, 8],
+        [43, 8, 8, 1, 8, 1, 8],
+      ],
+      lineOffsets: [60],
+      parsedExpr: {
+        expr: {
+          id: "43",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: { id: "1", identExpr: { name: "x" } },
+            accuVar: "@result",
+            accuInit: { id: "35", listExpr: {} },
+            loopCondition: { id: "36", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "41",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "34",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "18",
+                          comprehensionExpr: {
+                            iterVar: "z",
+                            iterRange: { id: "4", identExpr: { name: "y" } },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "11",
+                              constExpr: { boolValue: false },
+                            },
+                            loopCondition: {
+                              id: "14",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  {
+                                    id: "13",
+                                    callExpr: {
+                                      function: "!_",
+                                      args: [
+                                        {
+                                          id: "12",
+                                          identExpr: { name: "@result" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "16",
+                              callExpr: {
+                                function: "_||_",
+                                args: [
+                                  { id: "15", identExpr: { name: "@result" } },
+                                  {
+                                    id: "10",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "8",
+                                        identExpr: { name: "z" },
+                                      },
+                                      field: "a",
+                                      testOnly: true,
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "17",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "33",
+                          comprehensionExpr: {
+                            iterVar: "z",
+                            iterRange: { id: "19", identExpr: { name: "y" } },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "26",
+                              constExpr: { boolValue: false },
+                            },
+                            loopCondition: {
+                              id: "29",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  {
+                                    id: "28",
+                                    callExpr: {
+                                      function: "!_",
+                                      args: [
+                                        {
+                                          id: "27",
+                                          identExpr: { name: "@result" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "31",
+                              callExpr: {
+                                function: "_||_",
+                                args: [
+                                  { id: "30", identExpr: { name: "@result" } },
+                                  {
+                                    id: "25",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "23",
+                                        identExpr: { name: "z" },
+                                      },
+                                      field: "b",
+                                      testOnly: true,
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "32",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "39",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "37", identExpr: { name: "@result" } },
+                        {
+                          id: "38",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "y" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "40", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "42", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [60],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "6": 21,
+            "8": 28,
+            "9": 29,
+            "10": 27,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 37,
+            "21": 46,
+            "23": 53,
+            "24": 54,
+            "25": 52,
+            "26": 45,
+            "27": 45,
+            "28": 45,
+            "29": 45,
+            "30": 45,
+            "31": 45,
+            "32": 45,
+            "33": 45,
+            "34": 34,
+            "35": 8,
+            "36": 8,
+            "37": 8,
+            "38": 8,
+            "39": 8,
+            "40": 8,
+            "41": 8,
+            "42": 8,
+            "43": 8,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "8", identExpr: { name: "z" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "18": {
+              callExpr: {
+                target: { id: "4", identExpr: { name: "y" } },
+                function: "exists",
+                args: [{ id: "6", identExpr: { name: "z" } }, { id: "10" }],
+              },
+            },
+            "25": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "24",
+                    selectExpr: {
+                      operand: { id: "23", identExpr: { name: "z" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "19", identExpr: { name: "y" } },
+                function: "exists",
+                args: [{ id: "21", identExpr: { name: "z" } }, { id: "25" }],
+              },
+            },
+            "43": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "x" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "y" } },
+                  {
+                    id: "34",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [{ id: "18" }, { id: "33" }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 10,
+          function: "has",
+          args: [
+            {
+              id: "9",
+              selectExpr: {
+                operand: { id: "8", identExpr: { name: "z" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 18,
+          function: "exists",
+          target: { id: "4", identExpr: { name: "y" } },
+          args: [{ id: "6", identExpr: { name: "z" } }, { id: "10" }],
+        },
+        {
+          id: 25,
+          function: "has",
+          args: [
+            {
+              id: "24",
+              selectExpr: {
+                operand: { id: "23", identExpr: { name: "z" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 33,
+          function: "exists",
+          target: { id: "19", identExpr: { name: "y" } },
+          args: [{ id: "21", identExpr: { name: "z" } }, { id: "25" }],
+        },
+        {
+          id: 43,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "x" } },
+          args: [
+            { id: "3", identExpr: { name: "y" } },
+            {
+              id: "34",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [{ id: "18" }, { id: "33" }],
+              },
+            },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x.filter(y, y.exists(z, has(z.a)) \u0026\u0026 y.exists(z, has(z.b)))\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\ty,\n\t\t\t// Target\n\t\t\tx^#1:*expr.Expr_IdentExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\t[]^#35:*expr.Expr_ListExpr#,\n\t\t\t// LoopCondition\n\t\t\ttrue^#36:*expr.Constant_BoolValue#,\n\t\t\t// LoopStep\n\t\t\t_?_:_(\n\t\t\t  _\u0026\u0026_(\n\t\t\t\t__comprehension__(\n\t\t\t\t  // Variable\n\t\t\t\t  z,\n\t\t\t\t  // Target\n\t\t\t\t  y^#4:*expr.Expr_IdentExpr#,\n\t\t\t\t  // Accumulator\n\t\t\t\t  @result,\n\t\t\t\t  // Init\n\t\t\t\t  false^#11:*expr.Constant_BoolValue#,\n\t\t\t\t  // LoopCondition\n\t\t\t\t  @not_strictly_false(\n\t\t\t\t\t!_(\n\t\t\t\t\t  @result^#12:*expr.Expr_IdentExpr#\n\t\t\t\t\t)^#13:*expr.Expr_CallExpr#\n\t\t\t\t  )^#14:*expr.Expr_CallExpr#,\n\t\t\t\t  // LoopStep\n\t\t\t\t  _||_(\n\t\t\t\t\t@result^#15:*expr.Expr_IdentExpr#,\n\t\t\t\t\tz^#8:*expr.Expr_IdentExpr#.a~test-only~^#10:*expr.Expr_SelectExpr#\n\t\t\t\t  )^#16:*expr.Expr_CallExpr#,\n\t\t\t\t  // Result\n\t\t\t\t  @result^#17:*expr.Expr_IdentExpr#)^#18:*expr.Expr_ComprehensionExpr#,\n\t\t\t\t__comprehension__(\n\t\t\t\t  // Variable\n\t\t\t\t  z,\n\t\t\t\t  // Target\n\t\t\t\t  y^#19:*expr.Expr_IdentExpr#,\n\t\t\t\t  // Accumulator\n\t\t\t\t  @result,\n\t\t\t\t  // Init\n\t\t\t\t  false^#26:*expr.Constant_BoolValue#,\n\t\t\t\t  // LoopCondition\n\t\t\t\t  @not_strictly_false(\n\t\t\t\t\t!_(\n\t\t\t\t\t  @result^#27:*expr.Expr_IdentExpr#\n\t\t\t\t\t)^#28:*expr.Expr_CallExpr#\n\t\t\t\t  )^#29:*expr.Expr_CallExpr#,\n\t\t\t\t  // LoopStep\n\t\t\t\t  _||_(\n\t\t\t\t\t@result^#30:*expr.Expr_IdentExpr#,\n\t\t\t\t\tz^#23:*expr.Expr_IdentExpr#.b~test-only~^#25:*expr.Expr_SelectExpr#\n\t\t\t\t  )^#31:*expr.Expr_CallExpr#,\n\t\t\t\t  // Result\n\t\t\t\t  @result^#32:*expr.Expr_IdentExpr#)^#33:*expr.Expr_ComprehensionExpr#\n\t\t\t  )^#34:*expr.Expr_CallExpr#,\n\t\t\t  _+_(\n\t\t\t\t@result^#37:*expr.Expr_IdentExpr#,\n\t\t\t\t[\n\t\t\t\t  y^#3:*expr.Expr_IdentExpr#\n\t\t\t\t]^#38:*expr.Expr_ListExpr#\n\t\t\t  )^#39:*expr.Expr_CallExpr#,\n\t\t\t  @result^#40:*expr.Expr_IdentExpr#\n\t\t\t)^#41:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#42:*expr.Expr_IdentExpr#)^#43:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "x^#1:*expr.Expr_IdentExpr#.filter(\n\t\t\ty^#3:*expr.Expr_IdentExpr#,\n\t\t\t_\u0026\u0026_(\n\t\t\t  ^#18:exists#,\n\t\t\t  ^#33:exists#\n\t\t\t)^#34:*expr.Expr_CallExpr#\n\t\t\t)^#43:filter#,\n\t\t\ty^#19:*expr.Expr_IdentExpr#.exists(\n\t\t\t\tz^#21:*expr.Expr_IdentExpr#,\n\t\t\t\t^#25:has#\n\t\t\t)^#33:exists#,\n\t\t\thas(\n\t\t\t\tz^#23:*expr.Expr_IdentExpr#.b^#24:*expr.Expr_SelectExpr#\n\t\t\t)^#25:has#,\n\t\t\ty^#4:*expr.Expr_IdentExpr#.exists(\n\t\t\t\tz^#6:*expr.Expr_IdentExpr#,\n\t\t\t\t^#10:has#\n\t\t\t)^#18:exists#,\n\t\t\thas(\n\t\t\t\tz^#8:*expr.Expr_IdentExpr#.a^#9:*expr.Expr_SelectExpr#\n\t\t\t)^#10:has#",
     },
     {
       original: { expr: "(has(a.b) || has(c.d)).string()" },
       ast: "_||_(\n  a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n  c^#*expr.Expr_IdentExpr#.d~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#.string()^#*expr.Expr_CallExpr#",
+      unparsed: "(has(a.b) || has(c.d)).string()",
+      locationAst:
+        "_||_(\n  a^#2[1,5]#.b~test-only~^#4[1,4]#,\n  c^#6[1,17]#.d~test-only~^#8[1,16]#\n)^#9[1,10]#.string()^#10[1,29]#",
+      positions: [
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 4, 4, 1, 4, 1, 4],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 18, 19, 1, 18, 1, 19],
+        [8, 16, 16, 1, 16, 1, 16],
+        [9, 10, 12, 1, 10, 1, 12],
+        [10, 29, 30, 1, 29, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            target: {
+              id: "9",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "6", identExpr: { name: "c" } },
+                      field: "d",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            function: "string",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "2": 5,
+            "3": 6,
+            "4": 4,
+            "6": 17,
+            "7": 18,
+            "8": 16,
+            "9": 10,
+            "10": 29,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "8": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "7",
+                    selectExpr: {
+                      operand: { id: "6", identExpr: { name: "c" } },
+                      field: "d",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 8,
+          function: "has",
+          args: [
+            {
+              id: "7",
+              selectExpr: {
+                operand: { id: "6", identExpr: { name: "c" } },
+                field: "d",
+              },
+            },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:6: undeclared reference to 'a' (in container '')\n | (has(a.b) || has(c.d)).string()\n | .....^\nERROR: \u003cinput\u003e:1:18: undeclared reference to 'c' (in container '')\n | (has(a.b) || has(c.d)).string()\n | .................^\nERROR: \u003cinput\u003e:1:30: found no matching overload for 'string' applied to 'bool.()'\n | (has(a.b) || has(c.d)).string()\n | .............................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 6,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'string' applied to 'bool.()'",
+          id: 10,
+          offset: 29,
+          line: 1,
+          column: 29,
+        },
+      ],
+      expectedAst:
+        "_||_(\n\t\t\t  a^#2:*expr.Expr_IdentExpr#.b~test-only~^#4:*expr.Expr_SelectExpr#,\n\t\t\t  c^#6:*expr.Expr_IdentExpr#.d~test-only~^#8:*expr.Expr_SelectExpr#\n\t\t    )^#9:*expr.Expr_CallExpr#.string()^#10:*expr.Expr_CallExpr#",
+      expectedMacroCalls:
+        "has(\n\t\t\t  c^#6:*expr.Expr_IdentExpr#.d^#7:*expr.Expr_SelectExpr#\n\t\t\t)^#8:has#,\n\t\t\thas(\n\t\t\t  a^#2:*expr.Expr_IdentExpr#.b^#3:*expr.Expr_SelectExpr#\n\t\t\t)^#4:has#",
     },
     {
       original: { expr: "has(a.b).asList().exists(c, c)" },
       ast: "__comprehension__(\n  // Variable\n  c,\n  // Target\n  a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#.asList()^#*expr.Expr_CallExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "has(a.b).asList().exists(c, c)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  c,\n  // Target\n  a^#2[1,4]#.b~test-only~^#4[1,3]#.asList()^#5[1,15]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#9[1,24]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#10[1,24]#\n    )^#11[1,24]#\n  )^#12[1,24]#,\n  // LoopStep\n  _||_(\n    @result^#13[1,24]#,\n    c^#8[1,28]#\n  )^#14[1,24]#,\n  // Result\n  @result^#15[1,24]#)^#16[1,24]#",
+      positions: [
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 15, 16, 1, 15, 1, 16],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 28, 29, 1, 28, 1, 29],
+        [9, 24, 24, 1, 24, 1, 24],
+        [10, 24, 24, 1, 24, 1, 24],
+        [11, 24, 24, 1, 24, 1, 24],
+        [12, 24, 24, 1, 24, 1, 24],
+        [13, 24, 24, 1, 24, 1, 24],
+        [14, 24, 24, 1, 24, 1, 24],
+        [15, 24, 24, 1, 24, 1, 24],
+        [16, 24, 24, 1, 24, 1, 24],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "c",
+            iterRange: {
+              id: "5",
+              callExpr: {
+                target: {
+                  id: "4",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "a" } },
+                    field: "b",
+                    testOnly: true,
+                  },
+                },
+                function: "asList",
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "9", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "10", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  { id: "8", identExpr: { name: "c" } },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "2": 4,
+            "3": 5,
+            "4": 3,
+            "5": 15,
+            "7": 25,
+            "8": 28,
+            "9": 24,
+            "10": 24,
+            "11": 24,
+            "12": 24,
+            "13": 24,
+            "14": 24,
+            "15": 24,
+            "16": 24,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: { target: { id: "4" }, function: "asList" },
+                },
+                function: "exists",
+                args: [
+                  { id: "7", identExpr: { name: "c" } },
+                  { id: "8", identExpr: { name: "c" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 16,
+          function: "exists",
+          target: {
+            id: "5",
+            callExpr: { target: { id: "4" }, function: "asList" },
+          },
+          args: [
+            { id: "7", identExpr: { name: "c" } },
+            { id: "8", identExpr: { name: "c" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:5: undeclared reference to 'a' (in container '')\n | has(a.b).asList().exists(c, c)\n | ....^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'asList' (in container '')\n | has(a.b).asList().exists(c, c)\n | ...............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'asList' (in container '')",
+          id: 5,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\tc,\n\t\t\t// Target\n\t\t\ta^#2:*expr.Expr_IdentExpr#.b~test-only~^#4:*expr.Expr_SelectExpr#.asList()^#5:*expr.Expr_CallExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\tfalse^#9:*expr.Constant_BoolValue#,\n\t\t\t// LoopCondition\n\t\t\t@not_strictly_false(\n\t\t\t  !_(\n\t\t\t\t@result^#10:*expr.Expr_IdentExpr#\n\t\t\t  )^#11:*expr.Expr_CallExpr#\n\t\t\t)^#12:*expr.Expr_CallExpr#,\n\t\t\t// LoopStep\n\t\t\t_||_(\n\t\t\t  @result^#13:*expr.Expr_IdentExpr#,\n\t\t\t  c^#8:*expr.Expr_IdentExpr#\n\t\t\t)^#14:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#15:*expr.Expr_IdentExpr#)^#16:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "^#4:has#.asList()^#5:*expr.Expr_CallExpr#.exists(\n\t\t\tc^#7:*expr.Expr_IdentExpr#,\n\t\t\tc^#8:*expr.Expr_IdentExpr#\n\t\t  )^#16:exists#,\n\t\t  has(\n\t\t\ta^#2:*expr.Expr_IdentExpr#.b^#3:*expr.Expr_SelectExpr#\n\t\t  )^#4:has#",
     },
     {
       original: { expr: "[has(a.b), has(c.d)].exists(e, e)" },
       ast: "__comprehension__(\n  // Variable\n  e,\n  // Target\n  [\n    a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n    c^#*expr.Expr_IdentExpr#.d~test-only~^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[has(a.b), has(c.d)].exists(e, e)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  e,\n  // Target\n  [\n    a^#3[1,5]#.b~test-only~^#5[1,4]#,\n    c^#7[1,15]#.d~test-only~^#9[1,14]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#13[1,27]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#14[1,27]#\n    )^#15[1,27]#\n  )^#16[1,27]#,\n  // LoopStep\n  _||_(\n    @result^#17[1,27]#,\n    e^#12[1,31]#\n  )^#18[1,27]#,\n  // Result\n  @result^#19[1,27]#)^#20[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 4, 4, 1, 4, 1, 4],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 14, 14, 1, 14, 1, 14],
+        [11, 28, 29, 1, 28, 1, 29],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 27, 27, 1, 27, 1, 27],
+        [14, 27, 27, 1, 27, 1, 27],
+        [15, 27, 27, 1, 27, 1, 27],
+        [16, 27, 27, 1, 27, 1, 27],
+        [17, 27, 27, 1, 27, 1, 27],
+        [18, 27, 27, 1, 27, 1, 27],
+        [19, 27, 27, 1, 27, 1, 27],
+        [20, 27, 27, 1, 27, 1, 27],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "20",
+          comprehensionExpr: {
+            iterVar: "e",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "13", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "16",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "14", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "18",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "17", identExpr: { name: "@result" } },
+                  { id: "12", identExpr: { name: "e" } },
+                ],
+              },
+            },
+            result: { id: "19", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "7": 15,
+            "8": 16,
+            "9": 14,
+            "11": 28,
+            "12": 31,
+            "13": 27,
+            "14": 27,
+            "15": 27,
+            "16": 27,
+            "17": 27,
+            "18": 27,
+            "19": 27,
+            "20": 27,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                    },
+                  },
+                ],
+              },
+            },
+            "20": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+                },
+                function: "exists",
+                args: [
+                  { id: "11", identExpr: { name: "e" } },
+                  { id: "12", identExpr: { name: "e" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: { id: "3", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "c" } },
+                field: "d",
+              },
+            },
+          ],
+        },
+        {
+          id: 20,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+          },
+          args: [
+            { id: "11", identExpr: { name: "e" } },
+            { id: "12", identExpr: { name: "e" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:6: undeclared reference to 'a' (in container '')\n | [has(a.b), has(c.d)].exists(e, e)\n | .....^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'c' (in container '')\n | [has(a.b), has(c.d)].exists(e, e)\n | ...............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 7,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t// Variable\n\t\t\te,\n\t\t\t// Target\n\t\t\t[\n\t\t\t  a^#3:*expr.Expr_IdentExpr#.b~test-only~^#5:*expr.Expr_SelectExpr#,\n\t\t\t  c^#7:*expr.Expr_IdentExpr#.d~test-only~^#9:*expr.Expr_SelectExpr#\n\t\t\t]^#1:*expr.Expr_ListExpr#,\n\t\t\t// Accumulator\n\t\t\t@result,\n\t\t\t// Init\n\t\t\tfalse^#13:*expr.Constant_BoolValue#,\n\t\t\t// LoopCondition\n\t\t\t@not_strictly_false(\n\t\t\t  !_(\n\t\t\t\t@result^#14:*expr.Expr_IdentExpr#\n\t\t\t  )^#15:*expr.Expr_CallExpr#\n\t\t\t)^#16:*expr.Expr_CallExpr#,\n\t\t\t// LoopStep\n\t\t\t_||_(\n\t\t\t  @result^#17:*expr.Expr_IdentExpr#,\n\t\t\t  e^#12:*expr.Expr_IdentExpr#\n\t\t\t)^#18:*expr.Expr_CallExpr#,\n\t\t\t// Result\n\t\t\t@result^#19:*expr.Expr_IdentExpr#)^#20:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "[\n\t\t\t^#5:has#,\n\t\t\t^#9:has#\n\t\t  ]^#1:*expr.Expr_ListExpr#.exists(\n\t\t\te^#11:*expr.Expr_IdentExpr#,\n\t\t\te^#12:*expr.Expr_IdentExpr#\n\t\t  )^#20:exists#,\n\t\t  has(\n\t\t\tc^#7:*expr.Expr_IdentExpr#.d^#8:*expr.Expr_SelectExpr#\n\t\t  )^#9:has#,\n\t\t  has(\n\t\t\ta^#3:*expr.Expr_IdentExpr#.b^#4:*expr.Expr_SelectExpr#\n\t\t  )^#5:has#",
     },
     {
       original: {
         expr: "y!=y!=y!=y!=y!=y!=y!=y!=y!=-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y\n\t\t!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y\n\t\t!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y\n\t\t!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y\n\t\t!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y\n\t\t!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y!=-y!=-y-y!=-y",
       },
-      error: "ERROR: :-1:0: max recursion depth exceeded",
+      error: "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
+      errors: [
+        { category: "syntax", message: "max recursion depth exceeded", id: 0 },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
     },
     {
       original: {
         expr: "[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[['not fine']]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]",
       },
-      error: "ERROR: :-1:0: expression recursion limit exceeded: 32",
+      error:
+        "ERROR: \u003cinput\u003e:-1:0: expression recursion limit exceeded: 32",
+      errors: [
+        {
+          category: "syntax",
+          message: "expression recursion limit exceeded: 32",
+          id: 0,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: expression recursion limit exceeded: 32",
     },
     {
       original: {
         expr: "1 + 2 + 3 + 4 + 5 + 6 + 7 + 8 + 9 + 10\n\t\t+ 11 + 12 + 13 + 14 + 15 + 16 + 17 + 18 + 19 + 20\n\t\t+ 21 + 22 + 23 + 24 + 25 + 26 + 27 + 28 + 29 + 30\n\t\t+ 31 + 32 + 33 + 34",
       },
-      error: "ERROR: :-1:0: max recursion depth exceeded",
+      error: "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
+      errors: [
+        { category: "syntax", message: "max recursion depth exceeded", id: 0 },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
     },
     {
       original: {
         expr: "a.b.c.d.e.f.g.h.i.j.k.l.m.n.o.p.q.r.s.t.u.v.w.x.y.z.A.B.C.D.E.F.G.H",
       },
-      error: "ERROR: :-1:0: max recursion depth exceeded",
+      error: "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
+      errors: [
+        { category: "syntax", message: "max recursion depth exceeded", id: 0 },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
     },
     {
       original: {
         expr: "a[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20]\n\t\t     [21][22][23][24][25][26][27][28][29][30][31][32][33]",
       },
-      error: "ERROR: :-1:0: max recursion depth exceeded",
+      error: "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
+      errors: [
+        { category: "syntax", message: "max recursion depth exceeded", id: 0 },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
     },
     {
       original: {
         expr: "a \u003c 1 \u003c 2 \u003c 3 \u003c 4 \u003c 5 \u003c 6 \u003c 7 \u003c 8 \u003c 9 \u003c 10 \u003c 11\n\t\t      \u003c 12 \u003c 13 \u003c 14 \u003c 15 \u003c 16 \u003c 17 \u003c 18 \u003c 19 \u003c 20 \u003c 21\n\t\t\t  \u003c 22 \u003c 23 \u003c 24 \u003c 25 \u003c 26 \u003c 27 \u003c 28 \u003c 29 \u003c 30 \u003c 31\n\t\t\t  \u003c 32 \u003c 33",
       },
-      error: "ERROR: :-1:0: max recursion depth exceeded",
+      error: "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
+      errors: [
+        { category: "syntax", message: "max recursion depth exceeded", id: 0 },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
     },
     {
       original: {
         expr: "a[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20] !=\n\t\ta[1][2][3][4][5][6][7][8][9][10][11][12][13][14][15][16][17][18][19][20]",
       },
-      error: "ERROR: :-1:0: max recursion depth exceeded",
+      error: "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
+      errors: [
+        { category: "syntax", message: "max recursion depth exceeded", id: 0 },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:-1:0: max recursion depth exceeded",
     },
     {
       original: { expr: "self.true == 1" },
       error:
-        "ERROR: :1:6: Syntax error: mismatched input 'true' expecting IDENTIFIER\n | self.true == 1\n | .....^",
+        "ERROR: \u003cinput\u003e:1:6: Syntax error: mismatched input 'true' expecting IDENTIFIER\n | self.true == 1\n | .....^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: mismatched input 'true' expecting IDENTIFIER",
+          id: 0,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:6: Syntax error: mismatched input 'true' expecting IDENTIFIER\n\t\t| self.true == 1\n\t\t| .....^",
     },
     {
       original: { expr: "a.?b \u0026\u0026 a[?b]" },
       error:
-        "ERROR: :1:2: unsupported syntax '.?'\n | a.?b \u0026\u0026 a[?b]\n | .^\nERROR: :1:10: unsupported syntax '[?'\n | a.?b \u0026\u0026 a[?b]\n | .........^",
+        "ERROR: \u003cinput\u003e:1:2: unsupported syntax '.?'\n | a.?b \u0026\u0026 a[?b]\n | .^\nERROR: \u003cinput\u003e:1:10: unsupported syntax '[?'\n | a.?b \u0026\u0026 a[?b]\n | .........^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax '.?'",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "syntax",
+          message: "unsupported syntax '[?'",
+          id: 6,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:2: unsupported syntax '.?'\n        | a.?b \u0026\u0026 a[?b]\n        | .^\n        ERROR: \u003cinput\u003e:1:10: unsupported syntax '[?'\n        | a.?b \u0026\u0026 a[?b]\n\t\t| .........^",
     },
     {
       original: { expr: "a.?b[?0] \u0026\u0026 a[?c]" },
       error:
-        "ERROR: :1:2: unsupported syntax '.?'\n | a.?b[?0] \u0026\u0026 a[?c]\n | .^\nERROR: :1:5: unsupported syntax '[?'\n | a.?b[?0] \u0026\u0026 a[?c]\n | ....^\nERROR: :1:14: unsupported syntax '[?'\n | a.?b[?0] \u0026\u0026 a[?c]\n | .............^",
+        "ERROR: \u003cinput\u003e:1:2: unsupported syntax '.?'\n | a.?b[?0] \u0026\u0026 a[?c]\n | .^\nERROR: \u003cinput\u003e:1:5: unsupported syntax '[?'\n | a.?b[?0] \u0026\u0026 a[?c]\n | ....^\nERROR: \u003cinput\u003e:1:14: unsupported syntax '[?'\n | a.?b[?0] \u0026\u0026 a[?c]\n | .............^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax '.?'",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "syntax",
+          message: "unsupported syntax '[?'",
+          id: 5,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "syntax",
+          message: "unsupported syntax '[?'",
+          id: 9,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedAst:
+        '_\u0026\u0026_(\n\t\t\t_[?_](\n\t\t\t  _?._(\n\t\t\t\ta^#1:*expr.Expr_IdentExpr#,\n\t\t\t\t"b"^#2:*expr.Constant_StringValue#\n\t\t\t  )^#3:*expr.Expr_CallExpr#,\n\t\t\t  0^#5:*expr.Constant_Int64Value#\n\t\t\t)^#4:*expr.Expr_CallExpr#,\n\t\t\t_[?_](\n\t\t\t  a^#6:*expr.Expr_IdentExpr#,\n\t\t\t  c^#8:*expr.Expr_IdentExpr#\n\t\t\t)^#7:*expr.Expr_CallExpr#\n\t\t  )^#9:*expr.Expr_CallExpr#',
     },
     {
       original: { expr: "{?'key': value}" },
-      error: "ERROR: :1:2: unsupported syntax '?'\n | {?'key': value}\n | .^",
+      error:
+        "ERROR: \u003cinput\u003e:1:2: unsupported syntax '?'\n | {?'key': value}\n | .^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedAst:
+        '{\n\t\t\t?"key"^#3:*expr.Constant_StringValue#:value^#4:*expr.Expr_IdentExpr#^#2:*expr.Expr_CreateStruct_Entry#\n\t\t  }^#1:*expr.Expr_StructExpr#',
     },
     {
       original: { expr: "[?a, ?b]" },
       error:
-        "ERROR: :1:2: unsupported syntax '?'\n | [?a, ?b]\n | .^\nERROR: :1:6: unsupported syntax '?'\n | [?a, ?b]\n | .....^",
+        "ERROR: \u003cinput\u003e:1:2: unsupported syntax '?'\n | [?a, ?b]\n | .^\nERROR: \u003cinput\u003e:1:6: unsupported syntax '?'\n | [?a, ?b]\n | .....^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 5,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedAst:
+        "[\n\t\t\ta^#2:*expr.Expr_IdentExpr#,\n\t\t\tb^#3:*expr.Expr_IdentExpr#\n\t\t  ]^#1:*expr.Expr_ListExpr#",
     },
     {
       original: { expr: "[?a[?b]]" },
       error:
-        "ERROR: :1:2: unsupported syntax '?'\n | [?a[?b]]\n | .^\nERROR: :1:4: unsupported syntax '[?'\n | [?a[?b]]\n | ...^",
+        "ERROR: \u003cinput\u003e:1:2: unsupported syntax '?'\n | [?a[?b]]\n | .^\nERROR: \u003cinput\u003e:1:4: unsupported syntax '[?'\n | [?a[?b]]\n | ...^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 6,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "syntax",
+          message: "unsupported syntax '[?'",
+          id: 5,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedAst:
+        "[\n\t\t\t_[?_](\n\t\t\t  a^#2:*expr.Expr_IdentExpr#,\n\t\t\t  b^#4:*expr.Expr_IdentExpr#\n\t\t\t)^#3:*expr.Expr_CallExpr#\n\t\t  ]^#1:*expr.Expr_ListExpr#",
     },
     {
       original: { expr: "[?a, ?b]" },
       error:
-        "ERROR: :1:2: unsupported syntax '?'\n | [?a, ?b]\n | .^\nERROR: :1:6: unsupported syntax '?'\n | [?a, ?b]\n | .....^",
+        "ERROR: \u003cinput\u003e:1:2: unsupported syntax '?'\n | [?a, ?b]\n | .^\nERROR: \u003cinput\u003e:1:6: unsupported syntax '?'\n | [?a, ?b]\n | .....^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 5,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedError:
+        "\n\t    ERROR: \u003cinput\u003e:1:2: unsupported syntax '?'\n\t\t | [?a, ?b]\n\t\t | .^\n\t    ERROR: \u003cinput\u003e:1:6: unsupported syntax '?'\n\t\t | [?a, ?b]\n\t\t | .....^",
     },
     {
       original: { expr: "Msg{?field: value}" },
       error:
-        "ERROR: :1:5: unsupported syntax '?'\n | Msg{?field: value}\n | ....^",
+        "ERROR: \u003cinput\u003e:1:5: unsupported syntax '?'\n | Msg{?field: value}\n | ....^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedAst:
+        "Msg{\n\t\t\t?field:value^#3:*expr.Expr_IdentExpr#^#2:*expr.Expr_CreateStruct_Entry#\n\t\t  }^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "Msg{?field: value} \u0026\u0026 {?'key': value}" },
       error:
-        "ERROR: :1:5: unsupported syntax '?'\n | Msg{?field: value} \u0026\u0026 {?'key': value}\n | ....^\nERROR: :1:24: unsupported syntax '?'\n | Msg{?field: value} \u0026\u0026 {?'key': value}\n | .......................^",
+        "ERROR: \u003cinput\u003e:1:5: unsupported syntax '?'\n | Msg{?field: value} \u0026\u0026 {?'key': value}\n | ....^\nERROR: \u003cinput\u003e:1:24: unsupported syntax '?'\n | Msg{?field: value} \u0026\u0026 {?'key': value}\n | .......................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "syntax",
+          message: "unsupported syntax '?'",
+          id: 6,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:5: unsupported syntax '?'\n\t \t | Msg{?field: value} \u0026\u0026 {?'key': value}\n\t\t | ....^\n\t    ERROR: \u003cinput\u003e:1:24: unsupported syntax '?'\n\t\t | Msg{?field: value} \u0026\u0026 {?'key': value}\n\t\t | .......................^",
     },
     {
       original: { expr: "a.`b-c`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`b-c`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`b-c`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#.b-c^#2:*expr.Expr_SelectExpr#",
     },
     {
       original: { expr: "a.`b c`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`b c`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`b c`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#.b c^#2:*expr.Expr_SelectExpr#",
     },
     {
       original: { expr: "a.`b.c`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`b.c`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`b.c`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#.b.c^#2:*expr.Expr_SelectExpr#",
     },
     {
       original: { expr: "a.`in`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`in`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`in`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#.in^#2:*expr.Expr_SelectExpr#",
     },
     {
       original: { expr: "a.`/foo`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`/foo`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`/foo`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedAst: "a^#1:*expr.Expr_IdentExpr#./foo^#2:*expr.Expr_SelectExpr#",
     },
     {
       original: { expr: "Message{`in`: true}" },
       error:
-        "ERROR: :1:9: unsupported syntax: '`'\n | Message{`in`: true}\n | ........^",
+        "ERROR: \u003cinput\u003e:1:9: unsupported syntax: '`'\n | Message{`in`: true}\n | ........^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 3,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedAst:
+        "Message{\n\t\t\tin:true^#3:*expr.Constant_BoolValue#^#2:*expr.Expr_CreateStruct_Entry#\n\t\t  }^#1:*expr.Expr_StructExpr#",
     },
     {
       original: { expr: "`b-c`" },
       error:
-        "ERROR: :1:1: Syntax error: mismatched input '`b-c`' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | `b-c`\n | ^",
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: mismatched input '`b-c`' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | `b-c`\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '`b-c`' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
     },
     {
       original: { expr: "`b-c`()" },
       error:
-        "ERROR: :1:1: Syntax error: extraneous input '`b-c`' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | `b-c`()\n | ^\nERROR: :1:7: Syntax error: mismatched input ')' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | `b-c`()\n | ......^",
+        "ERROR: \u003cinput\u003e:1:1: Syntax error: extraneous input '`b-c`' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | `b-c`()\n | ^\nERROR: \u003cinput\u003e:1:7: Syntax error: mismatched input ')' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | `b-c`()\n | ......^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input '`b-c`' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input ')' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+      ],
     },
     {
       original: { expr: "a.`$b`" },
       error:
-        "ERROR: :1:3: Syntax error: token recognition error at: '`$'\n | a.`$b`\n | ..^\nERROR: :1:6: Syntax error: token recognition error at: '`'\n | a.`$b`\n | .....^",
+        "ERROR: \u003cinput\u003e:1:3: Syntax error: token recognition error at: '`$'\n | a.`$b`\n | ..^\nERROR: \u003cinput\u003e:1:6: Syntax error: token recognition error at: '`'\n | a.`$b`\n | .....^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '`$'",
+          id: 0,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '`'",
+          id: 0,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
     },
     {
       original: { expr: "a.`b.c`()" },
       error:
-        "ERROR: :1:3: unsupported syntax: '`'\n | a.`b.c`()\n | ..^\nERROR: :1:8: Syntax error: mismatched input '(' expecting \u003cEOF\u003e\n | a.`b.c`()\n | .......^",
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`b.c`()\n | ..^\nERROR: \u003cinput\u003e:1:8: Syntax error: mismatched input '(' expecting \u003cEOF\u003e\n | a.`b.c`()\n | .......^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '(' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 7,
+          line: 1,
+          column: 7,
+        },
+      ],
     },
     {
       original: { expr: "a.`b-c`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`b-c`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`b-c`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
     },
     {
       original: { expr: "a.`b.c`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`b.c`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`b.c`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
     },
     {
       original: { expr: "a.`in`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`in`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`in`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
     },
     {
       original: { expr: "a.`/foo`" },
-      error: "ERROR: :1:3: unsupported syntax: '`'\n | a.`/foo`\n | ..^",
+      error:
+        "ERROR: \u003cinput\u003e:1:3: unsupported syntax: '`'\n | a.`/foo`\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
     },
     {
       original: { expr: "Message{`in`: true}" },
       error:
-        "ERROR: :1:9: unsupported syntax: '`'\n | Message{`in`: true}\n | ........^",
+        "ERROR: \u003cinput\u003e:1:9: unsupported syntax: '`'\n | Message{`in`: true}\n | ........^",
+      errors: [
+        {
+          category: "syntax",
+          message: "unsupported syntax: '`'",
+          id: 3,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
     },
     {
       original: { expr: "noop_macro(123)" },
       ast: "noop_macro(\n  123^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "noop_macro(123)",
+      locationAst: "noop_macro(\n  123^#2[1,11]#\n)^#1[1,10]#",
+      positions: [
+        [1, 10, 11, 1, 10, 1, 11],
+        [2, 11, 14, 1, 11, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "noop_macro",
+            args: [{ id: "2", constExpr: { int64Value: "123" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 10, "2": 11 },
+        },
+      },
       error:
         "ERROR: \u003cinput\u003e:1:11: undeclared reference to 'noop_macro' (in container '')\n | noop_macro(123)\n | ..........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'noop_macro' (in container '')",
+          id: 1,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedAst:
+        "noop_macro(\n\t\t\t123^#2:*expr.Constant_Int64Value#\n\t\t  )^#1:*expr.Expr_CallExpr#",
     },
     {
       original: { expr: "x{?." },
       error:
-        "ERROR: :1:4: Syntax error: mismatched input '.' expecting {IDENTIFIER, ESC_IDENTIFIER}\n | x{?.\n | ...^\nERROR: :1:4: Syntax error: error recovery attempt limit exceeded: 4\n | x{?.\n | ...^",
+        "ERROR: \u003cinput\u003e:1:4: Syntax error: mismatched input '.' expecting {IDENTIFIER, ESC_IDENTIFIER}\n | x{?.\n | ...^\nERROR: \u003cinput\u003e:1:4: Syntax error: error recovery attempt limit exceeded: 4\n | x{?.\n | ...^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '.' expecting {IDENTIFIER, ESC_IDENTIFIER}",
+          id: 0,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: error recovery attempt limit exceeded: 4",
+          id: 0,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:3: unsupported syntax '?'\n\t\t | x{?.\n\t\t | ..^\n\t    ERROR: \u003cinput\u003e:1:4: Syntax error: mismatched input '.' expecting {IDENTIFIER, ESC_IDENTIFIER}\n\t\t | x{?.\n\t\t | ...^",
     },
     {
       original: { expr: "x{." },
       error:
-        "ERROR: :1:3: Syntax error: mismatched input '.' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n | x{.\n | ..^",
+        "ERROR: \u003cinput\u003e:1:3: Syntax error: mismatched input '.' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n | x{.\n | ..^",
+      errors: [
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '.' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}",
+          id: 0,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:3: Syntax error: mismatched input '.' expecting {'}', ',', '?', IDENTIFIER, ESC_IDENTIFIER}\n\t\t | x{.\n\t\t | ..^",
     },
     {
       original: { expr: "'3# \u003c 10\" '\u0026 tru ^^" },
       error:
-        "ERROR: :1:12: Syntax error: token recognition error at: '\u0026 '\n | '3# \u003c 10\" '\u0026 tru ^^\n | ...........^\nERROR: :1:14: Syntax error: extraneous input 'tru' expecting \u003cEOF\u003e\n | '3# \u003c 10\" '\u0026 tru ^^\n | .............^\nERROR: :1:18: Syntax error: token recognition error at: '^'\n | '3# \u003c 10\" '\u0026 tru ^^\n | .................^\nERROR: :1:19: Syntax error: token recognition error at: '^'\n | '3# \u003c 10\" '\u0026 tru ^^\n | ..................^",
+        "ERROR: \u003cinput\u003e:1:12: Syntax error: token recognition error at: '\u0026 '\n | '3# \u003c 10\" '\u0026 tru ^^\n | ...........^\nERROR: \u003cinput\u003e:1:14: Syntax error: extraneous input 'tru' expecting \u003cEOF\u003e\n | '3# \u003c 10\" '\u0026 tru ^^\n | .............^\nERROR: \u003cinput\u003e:1:18: Syntax error: token recognition error at: '^'\n | '3# \u003c 10\" '\u0026 tru ^^\n | .................^\nERROR: \u003cinput\u003e:1:19: Syntax error: token recognition error at: '^'\n | '3# \u003c 10\" '\u0026 tru ^^\n | ..................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '\u0026 '",
+          id: 0,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: extraneous input 'tru' expecting \u003cEOF\u003e",
+          id: 0,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '^'",
+          id: 0,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+        {
+          category: "syntax",
+          message: "Syntax error: token recognition error at: '^'",
+          id: 0,
+          offset: 18,
+          line: 1,
+          column: 18,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:12: Syntax error: token recognition error at: '\u0026 '\n\t\t | '3# \u003c 10\" '\u0026 tru ^^\n\t\t | ...........^\n\t\tERROR: \u003cinput\u003e:1:18: Syntax error: token recognition error at: '^'\n\t\t | '3# \u003c 10\" '\u0026 tru ^^\n\t\t | .................^\n\t\tERROR: \u003cinput\u003e:1:19: Syntax error: More than 2 syntax errors\n\t\t | '3# \u003c 10\" '\u0026 tru ^^\n\t\t | ..................^\n\t\t",
     },
     {
       original: { expr: "'\\udead' == '\\ufffd'" },
       error:
-        "ERROR: :1:1: invalid unicode code point\n | '\\udead' == '\\ufffd'\n | ^",
+        "ERROR: \u003cinput\u003e:1:1: invalid unicode code point\n | '\\udead' == '\\ufffd'\n | ^",
+      errors: [
+        {
+          category: "syntax",
+          message: "invalid unicode code point",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedError:
+        "\n\t\tERROR: \u003cinput\u003e:1:1: invalid unicode code point\n         | '\\udead' == '\\ufffd'\n         | ^",
     },
     {
       original: { expr: "m.exists(v, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    f^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.exists(v, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#5[1,8]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#6[1,8]#\n    )^#7[1,8]#\n  )^#8[1,8]#,\n  // LoopStep\n  _||_(\n    @result^#9[1,8]#,\n    f^#4[1,12]#\n  )^#10[1,8]#,\n  // Result\n  @result^#11[1,8]#)^#12[1,8]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 8, 8, 1, 8, 1, 8],
+        [6, 8, 8, 1, 8, 1, 8],
+        [7, 8, 8, 1, 8, 1, 8],
+        [8, 8, 8, 1, 8, 1, 8],
+        [9, 8, 8, 1, 8, 1, 8],
+        [10, 8, 8, 1, 8, 1, 8],
+        [11, 8, 8, 1, 8, 1, 8],
+        [12, 8, 8, 1, 8, 1, 8],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "12",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "8",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "7",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "6", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "10",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "9", identExpr: { name: "@result" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+            result: { id: "11", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 8,
+            "6": 8,
+            "7": 8,
+            "8": 8,
+            "9": 8,
+            "10": 8,
+            "11": 8,
+            "12": 8,
+          },
+          macroCalls: {
+            "12": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "exists",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 12,
+          function: "exists",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.exists(v, f)\n | ^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'f' (in container '')\n | m.exists(v, f)\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 4,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tv,\n\t\t\t\t// Target\n\t\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t\t// Accumulator\n\t\t\t\t__result__,\n\t\t\t\t// Init\n\t\t\t\tfalse^#5:*expr.Constant_BoolValue#,\n\t\t\t\t// LoopCondition\n\t\t\t\t@not_strictly_false(\n\t\t\t\t\t!_(\n\t\t\t\t\t  __result__^#6:*expr.Expr_IdentExpr#\n\t\t\t\t\t)^#7:*expr.Expr_CallExpr#\n\t\t\t\t)^#8:*expr.Expr_CallExpr#,\n\t\t\t\t// LoopStep\n\t\t\t\t_||_(\n\t\t\t\t\t__result__^#9:*expr.Expr_IdentExpr#,\n\t\t\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t\t\t)^#10:*expr.Expr_CallExpr#,\n\t\t\t\t// Result\n\t\t\t\t__result__^#11:*expr.Expr_IdentExpr#)^#12:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.exists(\n\t\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t\t\t  )^#12:exists#",
     },
     {
       original: { expr: "m.all(v, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    f^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.all(v, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#5[1,5]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#6[1,5]#\n  )^#7[1,5]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#8[1,5]#,\n    f^#4[1,9]#\n  )^#9[1,5]#,\n  // Result\n  @result^#10[1,5]#)^#11[1,5]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 5, 5, 1, 5, 1, 5],
+        [6, 5, 5, 1, 5, 1, 5],
+        [7, 5, 5, 1, 5, 1, 5],
+        [8, 5, 5, 1, 5, 1, 5],
+        [9, 5, 5, 1, 5, 1, 5],
+        [10, 5, 5, 1, 5, 1, 5],
+        [11, 5, 5, 1, 5, 1, 5],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "7",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "6", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "8", identExpr: { name: "@result" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: {
+            "1": 0,
+            "3": 6,
+            "4": 9,
+            "5": 5,
+            "6": 5,
+            "7": 5,
+            "8": 5,
+            "9": 5,
+            "10": 5,
+            "11": 5,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 11,
+          function: "all",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.all(v, f)\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'f' (in container '')\n | m.all(v, f)\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tv,\n\t\t\t\t// Target\n\t\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t\t// Accumulator\n\t\t\t\t__result__,\n\t\t\t\t// Init\n\t\t\t\ttrue^#5:*expr.Constant_BoolValue#,\n\t\t\t\t// LoopCondition\n\t\t\t\t@not_strictly_false(\n\t\t\t\t\t__result__^#6:*expr.Expr_IdentExpr#\n\t\t\t\t)^#7:*expr.Expr_CallExpr#,\n\t\t\t\t// LoopStep\n\t\t\t\t_\u0026\u0026_(\n\t\t\t\t\t__result__^#8:*expr.Expr_IdentExpr#,\n\t\t\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t\t\t)^#9:*expr.Expr_CallExpr#,\n\t\t\t\t// Result\n\t\t\t\t__result__^#10:*expr.Expr_IdentExpr#)^#11:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.all(\n\t\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t\t\t  )^#11:all#",
     },
     {
       original: { expr: "m.existsOne(v, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  0^#*expr.Constant_Int64Value#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    f^#*expr.Expr_IdentExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  _==_(\n    @result^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.existsOne(v, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  0^#5[1,11]#,\n  // LoopCondition\n  true^#6[1,11]#,\n  // LoopStep\n  _?_:_(\n    f^#4[1,15]#,\n    _+_(\n      @result^#7[1,11]#,\n      1^#8[1,11]#\n    )^#9[1,11]#,\n    @result^#10[1,11]#\n  )^#11[1,11]#,\n  // Result\n  _==_(\n    @result^#12[1,11]#,\n    1^#13[1,11]#\n  )^#14[1,11]#)^#15[1,11]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 11, 11, 1, 11, 1, 11],
+        [6, 11, 11, 1, 11, 1, 11],
+        [7, 11, 11, 1, 11, 1, 11],
+        [8, 11, 11, 1, 11, 1, 11],
+        [9, 11, 11, 1, 11, 1, 11],
+        [10, 11, 11, 1, 11, 1, 11],
+        [11, 11, 11, 1, 11, 1, 11],
+        [12, 11, 11, 1, 11, 1, 11],
+        [13, 11, 11, 1, 11, 1, 11],
+        [14, 11, 11, 1, 11, 1, 11],
+        [15, 11, 11, 1, 11, 1, 11],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { int64Value: "0" } },
+            loopCondition: { id: "6", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "4", identExpr: { name: "f" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "7", identExpr: { name: "@result" } },
+                        { id: "8", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  { id: "10", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: {
+              id: "14",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "12", identExpr: { name: "@result" } },
+                  { id: "13", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "3": 12,
+            "4": 15,
+            "5": 11,
+            "6": 11,
+            "7": 11,
+            "8": 11,
+            "9": 11,
+            "10": 11,
+            "11": 11,
+            "12": 11,
+            "13": 11,
+            "14": 11,
+            "15": 11,
+          },
+          macroCalls: {
+            "15": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "existsOne",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 15,
+          function: "existsOne",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.existsOne(v, f)\n | ^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'existsOne' (in container '')\n | m.existsOne(v, f)\n | ...........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'v' (in container '')\n | m.existsOne(v, f)\n | ............^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'f' (in container '')\n | m.existsOne(v, f)\n | ...............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'existsOne' (in container '')",
+          id: 2,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'v' (in container '')",
+          id: 3,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 4,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tv,\n\t\t\t\t// Target\n\t\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t\t// Accumulator\n\t\t\t\t__result__,\n\t\t\t\t// Init\n\t\t\t\t0^#5:*expr.Constant_Int64Value#,\n\t\t\t\t// LoopCondition\n\t\t\t\ttrue^#6:*expr.Constant_BoolValue#,\n\t\t\t\t// LoopStep\n\t\t\t\t_?_:_(\n\t\t\t\t\tf^#4:*expr.Expr_IdentExpr#,\n\t\t\t\t\t_+_(\n\t\t\t\t\t\t  __result__^#7:*expr.Expr_IdentExpr#,\n\t\t\t\t\t  1^#8:*expr.Constant_Int64Value#\n\t\t\t\t\t)^#9:*expr.Expr_CallExpr#,\n\t\t\t\t\t__result__^#10:*expr.Expr_IdentExpr#\n\t\t\t\t)^#11:*expr.Expr_CallExpr#,\n\t\t\t\t// Result\n\t\t\t\t_==_(\n\t\t\t\t\t__result__^#12:*expr.Expr_IdentExpr#,\n\t\t\t\t\t1^#13:*expr.Constant_Int64Value#\n\t\t\t\t)^#14:*expr.Expr_CallExpr#)^#15:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.existsOne(\n\t\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t\t\t  )^#15:existsOne#",
     },
     {
       original: { expr: "m.map(v, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      f^#*expr.Expr_IdentExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.map(v, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#5[1,5]#,\n  // LoopCondition\n  true^#6[1,5]#,\n  // LoopStep\n  _+_(\n    @result^#7[1,5]#,\n    [\n      f^#4[1,9]#\n    ]^#8[1,5]#\n  )^#9[1,5]#,\n  // Result\n  @result^#10[1,5]#)^#11[1,5]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 5, 5, 1, 5, 1, 5],
+        [6, 5, 5, 1, 5, 1, 5],
+        [7, 5, 5, 1, 5, 1, 5],
+        [8, 5, 5, 1, 5, 1, 5],
+        [9, 5, 5, 1, 5, 1, 5],
+        [10, 5, 5, 1, 5, 1, 5],
+        [11, 5, 5, 1, 5, 1, 5],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", listExpr: {} },
+            loopCondition: { id: "6", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "7", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    listExpr: {
+                      elements: [{ id: "4", identExpr: { name: "f" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: {
+            "1": 0,
+            "3": 6,
+            "4": 9,
+            "5": 5,
+            "6": 5,
+            "7": 5,
+            "8": 5,
+            "9": 5,
+            "10": 5,
+            "11": 5,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "map",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 11,
+          function: "map",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.map(v, f)\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'f' (in container '')\n | m.map(v, f)\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tv,\n\t\t\t\t// Target\n\t\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t\t// Accumulator\n\t\t\t\t__result__,\n\t\t\t\t// Init\n\t\t\t\t[]^#5:*expr.Expr_ListExpr#,\n\t\t\t\t// LoopCondition\n\t\t\t\ttrue^#6:*expr.Constant_BoolValue#,\n\t\t\t\t// LoopStep\n\t\t\t\t_+_(\n\t\t\t\t\t__result__^#7:*expr.Expr_IdentExpr#,\n\t\t\t\t\t[\n\t\t\t\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t\t\t\t]^#8:*expr.Expr_ListExpr#\n\t\t\t\t)^#9:*expr.Expr_CallExpr#,\n\t\t\t\t// Result\n\t\t\t\t__result__^#10:*expr.Expr_IdentExpr#)^#11:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.map(\n\t\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\t\tf^#4:*expr.Expr_IdentExpr#\n\t\t\t\t  )^#11:map#",
     },
     {
       original: { expr: "m.map(v, p, f)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    p^#*expr.Expr_IdentExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        f^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.map(v, p, f)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#6[1,5]#,\n  // LoopCondition\n  true^#7[1,5]#,\n  // LoopStep\n  _?_:_(\n    p^#4[1,9]#,\n    _+_(\n      @result^#8[1,5]#,\n      [\n        f^#5[1,12]#\n      ]^#9[1,5]#\n    )^#10[1,5]#,\n    @result^#11[1,5]#\n  )^#12[1,5]#,\n  // Result\n  @result^#13[1,5]#)^#14[1,5]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 5, 5, 1, 5, 1, 5],
+        [7, 5, 5, 1, 5, 1, 5],
+        [8, 5, 5, 1, 5, 1, 5],
+        [9, 5, 5, 1, 5, 1, 5],
+        [10, 5, 5, 1, 5, 1, 5],
+        [11, 5, 5, 1, 5, 1, 5],
+        [12, 5, 5, 1, 5, 1, 5],
+        [13, 5, 5, 1, 5, 1, 5],
+        [14, 5, 5, 1, 5, 1, 5],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "6", listExpr: {} },
+            loopCondition: { id: "7", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "12",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "4", identExpr: { name: "p" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "8", identExpr: { name: "@result" } },
+                        {
+                          id: "9",
+                          listExpr: {
+                            elements: [{ id: "5", identExpr: { name: "f" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "11", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "13", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: {
+            "1": 0,
+            "3": 6,
+            "4": 9,
+            "5": 12,
+            "6": 5,
+            "7": 5,
+            "8": 5,
+            "9": 5,
+            "10": 5,
+            "11": 5,
+            "12": 5,
+            "13": 5,
+            "14": 5,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "map",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "p" } },
+                  { id: "5", identExpr: { name: "f" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "map",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "p" } },
+            { id: "5", identExpr: { name: "f" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.map(v, p, f)\n | ^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'p' (in container '')\n | m.map(v, p, f)\n | .........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'f' (in container '')\n | m.map(v, p, f)\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'p' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'f' (in container '')",
+          id: 5,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tv,\n\t\t\t\t// Target\n\t\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t\t// Accumulator\n\t\t\t\t__result__,\n\t\t\t\t// Init\n\t\t\t\t[]^#6:*expr.Expr_ListExpr#,\n\t\t\t\t// LoopCondition\n\t\t\t\ttrue^#7:*expr.Constant_BoolValue#,\n\t\t\t\t// LoopStep\n\t\t\t\t_?_:_(\n\t\t\t\t\tp^#4:*expr.Expr_IdentExpr#,\n\t\t\t\t\t_+_(\n\t\t\t\t\t\t__result__^#8:*expr.Expr_IdentExpr#,\n\t\t\t\t\t\t[\n\t\t\t\t\t\t\tf^#5:*expr.Expr_IdentExpr#\n\t\t\t\t\t\t]^#9:*expr.Expr_ListExpr#\n\t\t\t\t\t)^#10:*expr.Expr_CallExpr#,\n\t\t\t\t\t__result__^#11:*expr.Expr_IdentExpr#\n\t\t\t\t)^#12:*expr.Expr_CallExpr#,\n\t\t\t\t// Result\n\t\t\t\t__result__^#13:*expr.Expr_IdentExpr#)^#14:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.map(\n\t\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\t\tp^#4:*expr.Expr_IdentExpr#,\n\t\t\t\tf^#5:*expr.Expr_IdentExpr#\n\t\t\t\t  )^#14:map#",
     },
     {
       original: { expr: "m.filter(v, p)" },
       ast: "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    p^#*expr.Expr_IdentExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        v^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "m.filter(v, p)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  v,\n  // Target\n  m^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#5[1,8]#,\n  // LoopCondition\n  true^#6[1,8]#,\n  // LoopStep\n  _?_:_(\n    p^#4[1,12]#,\n    _+_(\n      @result^#7[1,8]#,\n      [\n        v^#3[1,9]#\n      ]^#8[1,8]#\n    )^#9[1,8]#,\n    @result^#10[1,8]#\n  )^#11[1,8]#,\n  // Result\n  @result^#12[1,8]#)^#13[1,8]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 8, 8, 1, 8, 1, 8],
+        [6, 8, 8, 1, 8, 1, 8],
+        [7, 8, 8, 1, 8, 1, 8],
+        [8, 8, 8, 1, 8, 1, 8],
+        [9, 8, 8, 1, 8, 1, 8],
+        [10, 8, 8, 1, 8, 1, 8],
+        [11, 8, 8, 1, 8, 1, 8],
+        [12, 8, 8, 1, 8, 1, 8],
+        [13, 8, 8, 1, 8, 1, 8],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "v",
+            iterRange: { id: "1", identExpr: { name: "m" } },
+            accuVar: "@result",
+            accuInit: { id: "5", listExpr: {} },
+            loopCondition: { id: "6", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "4", identExpr: { name: "p" } },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "7", identExpr: { name: "@result" } },
+                        {
+                          id: "8",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "v" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "10", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: {
+            "1": 0,
+            "3": 9,
+            "4": 12,
+            "5": 8,
+            "6": 8,
+            "7": 8,
+            "8": 8,
+            "9": 8,
+            "10": 8,
+            "11": 8,
+            "12": 8,
+            "13": 8,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "m" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "v" } },
+                  { id: "4", identExpr: { name: "p" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "m" } },
+          args: [
+            { id: "3", identExpr: { name: "v" } },
+            { id: "4", identExpr: { name: "p" } },
+          ],
+        },
+      ],
       error:
         "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'm' (in container '')\n | m.filter(v, p)\n | ^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'p' (in container '')\n | m.filter(v, p)\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'm' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'p' (in container '')",
+          id: 4,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedAst:
+        "__comprehension__(\n\t\t\t\t// Variable\n\t\t\t\tv,\n\t\t\t\t// Target\n\t\t\t\tm^#1:*expr.Expr_IdentExpr#,\n\t\t\t\t// Accumulator\n\t\t\t\t__result__,\n\t\t\t\t// Init\n\t\t\t\t[]^#5:*expr.Expr_ListExpr#,\n\t\t\t\t// LoopCondition\n\t\t\t\ttrue^#6:*expr.Constant_BoolValue#,\n\t\t\t\t// LoopStep\n\t\t\t\t_?_:_(\n\t\t\t\t\tp^#4:*expr.Expr_IdentExpr#,\n\t\t\t\t\t_+_(\n\t\t\t\t\t\t__result__^#7:*expr.Expr_IdentExpr#,\n\t\t\t\t\t\t[\n\t\t\t\t\t\t\tv^#3:*expr.Expr_IdentExpr#\n\t\t\t\t\t\t]^#8:*expr.Expr_ListExpr#\n\t\t\t\t\t)^#9:*expr.Expr_CallExpr#,\n\t\t\t\t\t__result__^#10:*expr.Expr_IdentExpr#\n\t\t\t\t)^#11:*expr.Expr_CallExpr#,\n\t\t\t\t// Result\n\t\t\t\t__result__^#12:*expr.Expr_IdentExpr#)^#13:*expr.Expr_ComprehensionExpr#",
+      expectedMacroCalls:
+        "m^#1:*expr.Expr_IdentExpr#.filter(\n\t\t\t\tv^#3:*expr.Expr_IdentExpr#,\n\t\t\t\tp^#4:*expr.Expr_IdentExpr#\n\t\t\t\t  )^#13:filter#",
     },
   ],
 } as const;
@@ -0,0 +1,4081 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/protos_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "protos",
+  tests: [
+    {
+      original: {
+        expr: "proto.getExt(ExampleType{}, google.expr.proto2.test.int32_ext) == 0",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    ExampleType{}^#*expr.Expr_StructExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.int32_ext^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "proto.getExt(ExampleType{}, google.expr.proto2.test.int32_ext) == 0",
+      locationAst:
+        "_==_(\n  proto^#1[1,0]#.getExt(\n    ExampleType{}^#3[1,24]#,\n    google^#4[1,28]#.expr^#5[1,34]#.proto2^#6[1,39]#.test^#7[1,46]#.int32_ext^#8[1,51]#\n  )^#2[1,12]#,\n  0^#10[1,66]#\n)^#9[1,63]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 28, 34, 1, 28, 1, 34],
+        [5, 34, 35, 1, 34, 1, 35],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 46, 47, 1, 46, 1, 47],
+        [8, 51, 52, 1, 51, 1, 52],
+        [9, 63, 65, 1, 63, 1, 65],
+        [10, 66, 67, 1, 66, 1, 67],
+      ],
+      lineOffsets: [68],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", structExpr: { messageName: "ExampleType" } },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "6",
+                              selectExpr: {
+                                operand: {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "4",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto2",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "int32_ext",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [68],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 63,
+            "10": 66,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  google.expr.proto2.test.ExampleType{}~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType.google.expr.proto2.test.int32_ext~int,\n  0~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "google.expr.proto2.test.ExampleType" },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [68],
+          positions: {
+            "1": 0,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 12,
+            "10": 63,
+            "11": 66,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                selectExpr: {
+                                  operand: {
+                                    id: "4",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "int32_ext",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  field: "google.expr.proto2.test.int32_ext",
+                },
+              },
+              { id: "11", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "42", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: "!proto.hasExt(ExampleType{}, google.expr.proto2.test.int32_wrapper_ext)",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "!_(\n  proto^#*expr.Expr_IdentExpr#.hasExt(\n    ExampleType{}^#*expr.Expr_StructExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.int32_wrapper_ext^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "!(proto.hasExt(ExampleType{}, google.expr.proto2.test.int32_wrapper_ext))",
+      locationAst:
+        "!_(\n  proto^#2[1,1]#.hasExt(\n    ExampleType{}^#4[1,25]#,\n    google^#5[1,29]#.expr^#6[1,35]#.proto2^#7[1,40]#.test^#8[1,47]#.int32_wrapper_ext^#9[1,52]#\n  )^#3[1,13]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 6, 1, 1, 1, 6],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 29, 35, 1, 29, 1, 35],
+        [6, 35, 36, 1, 35, 1, 36],
+        [7, 40, 41, 1, 40, 1, 41],
+        [8, 47, 48, 1, 47, 1, 48],
+        [9, 52, 53, 1, 52, 1, 53],
+      ],
+      lineOffsets: [72],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "proto" } },
+                  function: "hasExt",
+                  args: [
+                    { id: "4", structExpr: { messageName: "ExampleType" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: {
+                                  id: "6",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "5",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto2",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "int32_wrapper_ext",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [72],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 13,
+            "4": 25,
+            "5": 29,
+            "6": 35,
+            "7": 40,
+            "8": 47,
+            "9": 52,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  google.expr.proto2.test.ExampleType{}~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType.google.expr.proto2.test.int32_wrapper_ext~test-only~~bool\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "4": { name: "google.expr.proto2.test.ExampleType" },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "4": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [72],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 25,
+            "5": 29,
+            "6": 35,
+            "7": 40,
+            "8": 47,
+            "9": 52,
+            "10": 13,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                target: { id: "2", identExpr: { name: "proto" } },
+                function: "hasExt",
+                args: [
+                  {
+                    id: "4",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: {
+                        id: "8",
+                        selectExpr: {
+                          operand: {
+                            id: "7",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                selectExpr: {
+                                  operand: {
+                                    id: "5",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "int32_wrapper_ext",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "10",
+                selectExpr: {
+                  operand: {
+                    id: "4",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  field: "google.expr.proto2.test.int32_wrapper_ext",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "42", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: "proto.getExt(ExampleType{}, google.expr.proto2.test.int32_wrapper_ext) == null",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    ExampleType{}^#*expr.Expr_StructExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.int32_wrapper_ext^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "proto.getExt(ExampleType{}, google.expr.proto2.test.int32_wrapper_ext) == null",
+      locationAst:
+        "_==_(\n  proto^#1[1,0]#.getExt(\n    ExampleType{}^#3[1,24]#,\n    google^#4[1,28]#.expr^#5[1,34]#.proto2^#6[1,39]#.test^#7[1,46]#.int32_wrapper_ext^#8[1,51]#\n  )^#2[1,12]#,\n  null^#10[1,74]#\n)^#9[1,71]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 28, 34, 1, 28, 1, 34],
+        [5, 34, 35, 1, 34, 1, 35],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 46, 47, 1, 46, 1, 47],
+        [8, 51, 52, 1, 51, 1, 52],
+        [9, 71, 73, 1, 71, 1, 73],
+        [10, 74, 78, 1, 74, 1, 78],
+      ],
+      lineOffsets: [79],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", structExpr: { messageName: "ExampleType" } },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "6",
+                              selectExpr: {
+                                operand: {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "4",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto2",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "int32_wrapper_ext",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [79],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 71,
+            "10": 74,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  google.expr.proto2.test.ExampleType{}~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType.google.expr.proto2.test.int32_wrapper_ext~wrapper(int),\n  null~null\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "google.expr.proto2.test.ExampleType" },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "9": { wrapper: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { null: null },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [79],
+          positions: {
+            "1": 0,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 12,
+            "10": 71,
+            "11": 74,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                selectExpr: {
+                                  operand: {
+                                    id: "4",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "int32_wrapper_ext",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  field: "google.expr.proto2.test.int32_wrapper_ext",
+                },
+              },
+              { id: "11", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "42", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: "!proto.hasExt(ExampleType{}, google.expr.proto2.test.nested_example)",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "!_(\n  proto^#*expr.Expr_IdentExpr#.hasExt(\n    ExampleType{}^#*expr.Expr_StructExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.nested_example^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "!(proto.hasExt(ExampleType{}, google.expr.proto2.test.nested_example))",
+      locationAst:
+        "!_(\n  proto^#2[1,1]#.hasExt(\n    ExampleType{}^#4[1,25]#,\n    google^#5[1,29]#.expr^#6[1,35]#.proto2^#7[1,40]#.test^#8[1,47]#.nested_example^#9[1,52]#\n  )^#3[1,13]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 6, 1, 1, 1, 6],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 29, 35, 1, 29, 1, 35],
+        [6, 35, 36, 1, 35, 1, 36],
+        [7, 40, 41, 1, 40, 1, 41],
+        [8, 47, 48, 1, 47, 1, 48],
+        [9, 52, 53, 1, 52, 1, 53],
+      ],
+      lineOffsets: [69],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "proto" } },
+                  function: "hasExt",
+                  args: [
+                    { id: "4", structExpr: { messageName: "ExampleType" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: {
+                                  id: "6",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "5",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto2",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "nested_example",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 13,
+            "4": 25,
+            "5": 29,
+            "6": 35,
+            "7": 40,
+            "8": 47,
+            "9": 52,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  google.expr.proto2.test.ExampleType{}~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType.google.expr.proto2.test.nested_example~test-only~~bool\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "4": { name: "google.expr.proto2.test.ExampleType" },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "4": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "4": 25,
+            "5": 29,
+            "6": 35,
+            "7": 40,
+            "8": 47,
+            "9": 52,
+            "10": 13,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                target: { id: "2", identExpr: { name: "proto" } },
+                function: "hasExt",
+                args: [
+                  {
+                    id: "4",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: {
+                        id: "8",
+                        selectExpr: {
+                          operand: {
+                            id: "7",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                selectExpr: {
+                                  operand: {
+                                    id: "5",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "nested_example",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "10",
+                selectExpr: {
+                  operand: {
+                    id: "4",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  field: "google.expr.proto2.test.nested_example",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "42", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: "proto.getExt(ExampleType{}, google.expr.proto2.test.nested_example) == ExampleType{}",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    ExampleType{}^#*expr.Expr_StructExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.nested_example^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  ExampleType{}^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "proto.getExt(ExampleType{}, google.expr.proto2.test.nested_example) == ExampleType{}",
+      locationAst:
+        "_==_(\n  proto^#1[1,0]#.getExt(\n    ExampleType{}^#3[1,24]#,\n    google^#4[1,28]#.expr^#5[1,34]#.proto2^#6[1,39]#.test^#7[1,46]#.nested_example^#8[1,51]#\n  )^#2[1,12]#,\n  ExampleType{}^#10[1,82]#\n)^#9[1,68]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 28, 34, 1, 28, 1, 34],
+        [5, 34, 35, 1, 34, 1, 35],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 46, 47, 1, 46, 1, 47],
+        [8, 51, 52, 1, 51, 1, 52],
+        [9, 68, 70, 1, 68, 1, 70],
+        [10, 82, 83, 1, 82, 1, 83],
+      ],
+      lineOffsets: [85],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", structExpr: { messageName: "ExampleType" } },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "6",
+                              selectExpr: {
+                                operand: {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "4",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto2",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "nested_example",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", structExpr: { messageName: "ExampleType" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [85],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 68,
+            "10": 82,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  google.expr.proto2.test.ExampleType{}~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType.google.expr.proto2.test.nested_example~google.expr.proto2.test.ExampleType,\n  google.expr.proto2.test.ExampleType{}~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "google.expr.proto2.test.ExampleType" },
+          "10": { overloadId: ["equals"] },
+          "11": { name: "google.expr.proto2.test.ExampleType" },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "9": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { primitive: "BOOL" },
+          "11": { messageType: "google.expr.proto2.test.ExampleType" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [85],
+          positions: {
+            "1": 0,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 12,
+            "10": 68,
+            "11": 82,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                selectExpr: {
+                                  operand: {
+                                    id: "4",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "nested_example",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  field: "google.expr.proto2.test.nested_example",
+                },
+              },
+              {
+                id: "11",
+                structExpr: {
+                  messageName: "google.expr.proto2.test.ExampleType",
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "82", max: "1844674407370955345" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "83",
+    },
+    {
+      original: {
+        expr: "proto.getExt(ExampleType{}, google.expr.proto2.test.ExtendedExampleType.extended_examples) == []",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    ExampleType{}^#*expr.Expr_StructExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ExtendedExampleType^#*expr.Expr_SelectExpr#.extended_examples^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "proto.getExt(ExampleType{}, google.expr.proto2.test.ExtendedExampleType.extended_examples) == []",
+      locationAst:
+        "_==_(\n  proto^#1[1,0]#.getExt(\n    ExampleType{}^#3[1,24]#,\n    google^#4[1,28]#.expr^#5[1,34]#.proto2^#6[1,39]#.test^#7[1,46]#.ExtendedExampleType^#8[1,51]#.extended_examples^#9[1,71]#\n  )^#2[1,12]#,\n  []^#11[1,94]#\n)^#10[1,91]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 28, 34, 1, 28, 1, 34],
+        [5, 34, 35, 1, 34, 1, 35],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 46, 47, 1, 46, 1, 47],
+        [8, 51, 52, 1, 51, 1, 52],
+        [9, 71, 72, 1, 71, 1, 72],
+        [10, 91, 93, 1, 91, 1, 93],
+        [11, 94, 95, 1, 94, 1, 95],
+      ],
+      lineOffsets: [97],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", structExpr: { messageName: "ExampleType" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: {
+                                  id: "6",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "5",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "4",
+                                          identExpr: { name: "google" },
+                                        },
+                                        field: "expr",
+                                      },
+                                    },
+                                    field: "proto2",
+                                  },
+                                },
+                                field: "test",
+                              },
+                            },
+                            field: "ExtendedExampleType",
+                          },
+                        },
+                        field: "extended_examples",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "11", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [97],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 71,
+            "10": 91,
+            "11": 94,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  google.expr.proto2.test.ExampleType{}~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType.google.expr.proto2.test.ExtendedExampleType.extended_examples~list(string),\n  []~list(string)\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "google.expr.proto2.test.ExampleType" },
+          "11": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { listType: { elemType: { primitive: "STRING" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "STRING" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [97],
+          positions: {
+            "1": 0,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 71,
+            "10": 12,
+            "11": 91,
+            "12": 94,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: {
+                        id: "8",
+                        selectExpr: {
+                          operand: {
+                            id: "7",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                selectExpr: {
+                                  operand: {
+                                    id: "5",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "4",
+                                        identExpr: { name: "google" },
+                                      },
+                                      field: "expr",
+                                    },
+                                  },
+                                  field: "proto2",
+                                },
+                              },
+                              field: "test",
+                            },
+                          },
+                          field: "ExtendedExampleType",
+                        },
+                      },
+                      field: "extended_examples",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  field:
+                    "google.expr.proto2.test.ExtendedExampleType.extended_examples",
+                },
+              },
+              { id: "12", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "51", max: "51" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "52",
+    },
+    {
+      original: {
+        expr: "proto.getExt(ExampleType{}, google.expr.proto2.test.ExtendedExampleType.enum_ext) == GlobalEnum.GOO",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    ExampleType{}^#*expr.Expr_StructExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ExtendedExampleType^#*expr.Expr_SelectExpr#.enum_ext^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  GlobalEnum^#*expr.Expr_IdentExpr#.GOO^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "proto.getExt(ExampleType{}, google.expr.proto2.test.ExtendedExampleType.enum_ext) == GlobalEnum.GOO",
+      locationAst:
+        "_==_(\n  proto^#1[1,0]#.getExt(\n    ExampleType{}^#3[1,24]#,\n    google^#4[1,28]#.expr^#5[1,34]#.proto2^#6[1,39]#.test^#7[1,46]#.ExtendedExampleType^#8[1,51]#.enum_ext^#9[1,71]#\n  )^#2[1,12]#,\n  GlobalEnum^#11[1,85]#.GOO^#12[1,95]#\n)^#10[1,82]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 24, 25, 1, 24, 1, 25],
+        [4, 28, 34, 1, 28, 1, 34],
+        [5, 34, 35, 1, 34, 1, 35],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 46, 47, 1, 46, 1, 47],
+        [8, 51, 52, 1, 51, 1, 52],
+        [9, 71, 72, 1, 71, 1, 72],
+        [10, 82, 84, 1, 82, 1, 84],
+        [11, 85, 95, 1, 85, 1, 95],
+        [12, 95, 96, 1, 95, 1, 96],
+      ],
+      lineOffsets: [100],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", structExpr: { messageName: "ExampleType" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: {
+                                  id: "6",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "5",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "4",
+                                          identExpr: { name: "google" },
+                                        },
+                                        field: "expr",
+                                      },
+                                    },
+                                    field: "proto2",
+                                  },
+                                },
+                                field: "test",
+                              },
+                            },
+                            field: "ExtendedExampleType",
+                          },
+                        },
+                        field: "enum_ext",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                selectExpr: {
+                  operand: { id: "11", identExpr: { name: "GlobalEnum" } },
+                  field: "GOO",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [100],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 71,
+            "10": 82,
+            "11": 85,
+            "12": 95,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  google.expr.proto2.test.ExampleType{}~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType.google.expr.proto2.test.ExtendedExampleType.enum_ext~int,\n  google.expr.proto2.test.GlobalEnum.GOO~int^google.expr.proto2.test.GlobalEnum.GOO\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "google.expr.proto2.test.ExampleType" },
+          "11": { overloadId: ["equals"] },
+          "13": {
+            name: "google.expr.proto2.test.GlobalEnum.GOO",
+            value: { int64Value: "0" },
+          },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "13": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [100],
+          positions: {
+            "1": 0,
+            "3": 24,
+            "4": 28,
+            "5": 34,
+            "6": 39,
+            "7": 46,
+            "8": 51,
+            "9": 71,
+            "10": 12,
+            "11": 82,
+            "12": 85,
+            "13": 95,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: {
+                        id: "8",
+                        selectExpr: {
+                          operand: {
+                            id: "7",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                selectExpr: {
+                                  operand: {
+                                    id: "5",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "4",
+                                        identExpr: { name: "google" },
+                                      },
+                                      field: "expr",
+                                    },
+                                  },
+                                  field: "proto2",
+                                },
+                              },
+                              field: "test",
+                            },
+                          },
+                          field: "ExtendedExampleType",
+                        },
+                      },
+                      field: "enum_ext",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                    },
+                  },
+                  field: "google.expr.proto2.test.ExtendedExampleType.enum_ext",
+                },
+              },
+              {
+                id: "13",
+                identExpr: { name: "google.expr.proto2.test.GlobalEnum.GOO" },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "43", max: "43" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: "ExampleType{`in`: 64}.`in` == 64",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  ExampleType{\n    in:64^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.in^#*expr.Expr_SelectExpr#,\n  64^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "ExampleType{`in`: 64}.`in` == 64",
+      locationAst:
+        "_==_(\n  ExampleType{\n    in:64^#3[1,18]#^#2[1,16]#\n  }^#1[1,11]#.in^#4[1,21]#,\n  64^#6[1,30]#\n)^#5[1,27]#",
+      positions: [
+        [1, 11, 12, 1, 11, 1, 12],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 18, 20, 1, 18, 1, 20],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: {
+                    id: "1",
+                    structExpr: {
+                      messageName: "ExampleType",
+                      entries: [
+                        {
+                          id: "2",
+                          fieldKey: "in",
+                          value: { id: "3", constExpr: { int64Value: "64" } },
+                        },
+                      ],
+                    },
+                  },
+                  field: "in",
+                },
+              },
+              { id: "6", constExpr: { int64Value: "64" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 11, "2": 16, "3": 18, "4": 21, "5": 27, "6": 30 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  google.expr.proto2.test.ExampleType{\n    in:64~int\n  }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType.in~int,\n  64~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "google.expr.proto2.test.ExampleType" },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto2.test.ExampleType" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 11, "2": 16, "3": 18, "4": 21, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: {
+                    id: "1",
+                    structExpr: {
+                      messageName: "google.expr.proto2.test.ExampleType",
+                      entries: [
+                        {
+                          id: "2",
+                          fieldKey: "in",
+                          value: { id: "3", constExpr: { int64Value: "64" } },
+                        },
+                      ],
+                    },
+                  },
+                  field: "in",
+                },
+              },
+              { id: "6", constExpr: { int64Value: "64" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "42", max: "42" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: "proto.getExt(msg, google.expr.proto2.test.int32_ext) == 42",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    msg^#*expr.Expr_IdentExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.int32_ext^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  42^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "proto.getExt(msg, google.expr.proto2.test.int32_ext) == 42",
+      locationAst:
+        "_==_(\n  proto^#1[1,0]#.getExt(\n    msg^#3[1,13]#,\n    google^#4[1,18]#.expr^#5[1,24]#.proto2^#6[1,29]#.test^#7[1,36]#.int32_ext^#8[1,41]#\n  )^#2[1,12]#,\n  42^#10[1,56]#\n)^#9[1,53]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 24, 1, 18, 1, 24],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 36, 37, 1, 36, 1, 37],
+        [8, 41, 42, 1, 41, 1, 42],
+        [9, 53, 55, 1, 53, 1, 55],
+        [10, 56, 58, 1, 56, 1, 58],
+      ],
+      lineOffsets: [59],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", identExpr: { name: "msg" } },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "6",
+                              selectExpr: {
+                                operand: {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "4",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto2",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "int32_ext",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 53,
+            "10": 56,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.int32_ext~int,\n  42~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "msg" },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 12,
+            "10": 53,
+            "11": 56,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  { id: "3", identExpr: { name: "msg" } },
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                selectExpr: {
+                                  operand: {
+                                    id: "4",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "int32_ext",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                selectExpr: {
+                  operand: { id: "3", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.int32_ext",
+                },
+              },
+              { id: "11", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "msg.`google.expr.proto2.test.int32_ext` == 42",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  msg^#*expr.Expr_IdentExpr#.google.expr.proto2.test.int32_ext^#*expr.Expr_SelectExpr#,\n  42^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "msg.`google.expr.proto2.test.int32_ext` == 42",
+      locationAst:
+        "_==_(\n  msg^#1[1,0]#.google.expr.proto2.test.int32_ext^#2[1,3]#,\n  42^#4[1,43]#\n)^#3[1,40]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 40, 42, 1, 40, 1, 42],
+        [4, 43, 45, 1, 43, 1, 45],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.int32_ext",
+                },
+              },
+              { id: "4", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 3, "3": 40, "4": 43 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.int32_ext~int,\n  42~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: { "1": { name: "msg" }, "3": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto2.test.ExampleType" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 3, "3": 40, "4": 43 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.int32_ext",
+                },
+              },
+              { id: "4", constExpr: { int64Value: "42" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "proto.getExt(msg, google.expr.proto2.test.int32_wrapper_ext) == 21",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    msg^#*expr.Expr_IdentExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.int32_wrapper_ext^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  21^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "proto.getExt(msg, google.expr.proto2.test.int32_wrapper_ext) == 21",
+      locationAst:
+        "_==_(\n  proto^#1[1,0]#.getExt(\n    msg^#3[1,13]#,\n    google^#4[1,18]#.expr^#5[1,24]#.proto2^#6[1,29]#.test^#7[1,36]#.int32_wrapper_ext^#8[1,41]#\n  )^#2[1,12]#,\n  21^#10[1,64]#\n)^#9[1,61]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 24, 1, 18, 1, 24],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 36, 37, 1, 36, 1, 37],
+        [8, 41, 42, 1, 41, 1, 42],
+        [9, 61, 63, 1, 61, 1, 63],
+        [10, 64, 66, 1, 64, 1, 66],
+      ],
+      lineOffsets: [67],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", identExpr: { name: "msg" } },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "6",
+                              selectExpr: {
+                                operand: {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "4",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto2",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "int32_wrapper_ext",
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { int64Value: "21" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 61,
+            "10": 64,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.int32_wrapper_ext~wrapper(int),\n  21~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "msg" },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "9": { wrapper: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 12,
+            "10": 61,
+            "11": 64,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  { id: "3", identExpr: { name: "msg" } },
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                selectExpr: {
+                                  operand: {
+                                    id: "4",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "int32_wrapper_ext",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                selectExpr: {
+                  operand: { id: "3", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.int32_wrapper_ext",
+                },
+              },
+              { id: "11", constExpr: { int64Value: "21" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "msg.`google.expr.proto2.test.int32_wrapper_ext` == 21",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  msg^#*expr.Expr_IdentExpr#.google.expr.proto2.test.int32_wrapper_ext^#*expr.Expr_SelectExpr#,\n  21^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "msg.`google.expr.proto2.test.int32_wrapper_ext` == 21",
+      locationAst:
+        "_==_(\n  msg^#1[1,0]#.google.expr.proto2.test.int32_wrapper_ext^#2[1,3]#,\n  21^#4[1,51]#\n)^#3[1,48]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 48, 50, 1, 48, 1, 50],
+        [4, 51, 53, 1, 51, 1, 53],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.int32_wrapper_ext",
+                },
+              },
+              { id: "4", constExpr: { int64Value: "21" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 3, "3": 48, "4": 51 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.int32_wrapper_ext~wrapper(int),\n  21~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: { "1": { name: "msg" }, "3": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto2.test.ExampleType" },
+          "2": { wrapper: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 3, "3": 48, "4": 51 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.int32_wrapper_ext",
+                },
+              },
+              { id: "4", constExpr: { int64Value: "21" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "proto.hasExt(msg, google.expr.proto2.test.nested_example)",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "proto^#*expr.Expr_IdentExpr#.hasExt(\n  msg^#*expr.Expr_IdentExpr#,\n  google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.nested_example^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "proto.hasExt(msg, google.expr.proto2.test.nested_example)",
+      locationAst:
+        "proto^#1[1,0]#.hasExt(\n  msg^#3[1,13]#,\n  google^#4[1,18]#.expr^#5[1,24]#.proto2^#6[1,29]#.test^#7[1,36]#.nested_example^#8[1,41]#\n)^#2[1,12]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 24, 1, 18, 1, 24],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 36, 37, 1, 36, 1, 37],
+        [8, 41, 42, 1, 41, 1, 42],
+      ],
+      lineOffsets: [58],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "proto" } },
+            function: "hasExt",
+            args: [
+              { id: "3", identExpr: { name: "msg" } },
+              {
+                id: "8",
+                selectExpr: {
+                  operand: {
+                    id: "7",
+                    selectExpr: {
+                      operand: {
+                        id: "6",
+                        selectExpr: {
+                          operand: {
+                            id: "5",
+                            selectExpr: {
+                              operand: {
+                                id: "4",
+                                identExpr: { name: "google" },
+                              },
+                              field: "expr",
+                            },
+                          },
+                          field: "proto2",
+                        },
+                      },
+                      field: "test",
+                    },
+                  },
+                  field: "nested_example",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+          },
+        },
+      },
+      checkedAst:
+        "msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.nested_example~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "3": { name: "msg" } },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "9": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 12,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "hasExt",
+                args: [
+                  { id: "3", identExpr: { name: "msg" } },
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                selectExpr: {
+                                  operand: {
+                                    id: "4",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "nested_example",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "9",
+          selectExpr: {
+            operand: { id: "3", identExpr: { name: "msg" } },
+            field: "google.expr.proto2.test.nested_example",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "has(msg.`google.expr.proto2.test.nested_example`)",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "msg^#*expr.Expr_IdentExpr#.google.expr.proto2.test.nested_example~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(msg.`google.expr.proto2.test.nested_example`)",
+      locationAst:
+        "msg^#2[1,4]#.google.expr.proto2.test.nested_example~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 7, 1, 4, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [50],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "msg" } },
+            field: "google.expr.proto2.test.nested_example",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: { "2": 4, "3": 7, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "google.expr.proto2.test.nested_example",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "msg" } },
+                field: "google.expr.proto2.test.nested_example",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.nested_example~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "msg" } },
+        typeMap: {
+          "2": { messageType: "google.expr.proto2.test.ExampleType" },
+          "4": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: { "2": 4, "3": 7, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "msg" } },
+                      field: "google.expr.proto2.test.nested_example",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "msg" } },
+            field: "google.expr.proto2.test.nested_example",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "proto.getExt(msg, google.expr.proto2.test.nested_example) == ExampleType{name: 'nested'}",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: '_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    msg^#*expr.Expr_IdentExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.nested_example^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  ExampleType{\n    name:"nested"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'proto.getExt(msg, google.expr.proto2.test.nested_example) == ExampleType{name: "nested"}',
+      locationAst:
+        '_==_(\n  proto^#1[1,0]#.getExt(\n    msg^#3[1,13]#,\n    google^#4[1,18]#.expr^#5[1,24]#.proto2^#6[1,29]#.test^#7[1,36]#.nested_example^#8[1,41]#\n  )^#2[1,12]#,\n  ExampleType{\n    name:"nested"^#12[1,79]#^#11[1,77]#\n  }^#10[1,72]#\n)^#9[1,58]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 24, 1, 18, 1, 24],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 36, 37, 1, 36, 1, 37],
+        [8, 41, 42, 1, 41, 1, 42],
+        [9, 58, 60, 1, 58, 1, 60],
+        [10, 72, 73, 1, 72, 1, 73],
+        [11, 77, 78, 1, 77, 1, 78],
+        [12, 79, 87, 1, 79, 1, 87],
+      ],
+      lineOffsets: [89],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", identExpr: { name: "msg" } },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "6",
+                              selectExpr: {
+                                operand: {
+                                  id: "5",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "4",
+                                      identExpr: { name: "google" },
+                                    },
+                                    field: "expr",
+                                  },
+                                },
+                                field: "proto2",
+                              },
+                            },
+                            field: "test",
+                          },
+                        },
+                        field: "nested_example",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                structExpr: {
+                  messageName: "ExampleType",
+                  entries: [
+                    {
+                      id: "11",
+                      fieldKey: "name",
+                      value: { id: "12", constExpr: { stringValue: "nested" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [89],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 58,
+            "10": 72,
+            "11": 77,
+            "12": 79,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.nested_example~google.expr.proto2.test.ExampleType,\n  google.expr.proto2.test.ExampleType{\n    name:"nested"~string\n  }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "msg" },
+          "10": { overloadId: ["equals"] },
+          "11": { name: "google.expr.proto2.test.ExampleType" },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "9": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { primitive: "BOOL" },
+          "11": { messageType: "google.expr.proto2.test.ExampleType" },
+          "13": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [89],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 12,
+            "10": 58,
+            "11": 72,
+            "12": 77,
+            "13": 79,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  { id: "3", identExpr: { name: "msg" } },
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                selectExpr: {
+                                  operand: {
+                                    id: "4",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "nested_example",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "9",
+                selectExpr: {
+                  operand: { id: "3", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.nested_example",
+                },
+              },
+              {
+                id: "11",
+                structExpr: {
+                  messageName: "google.expr.proto2.test.ExampleType",
+                  entries: [
+                    {
+                      id: "12",
+                      fieldKey: "name",
+                      value: { id: "13", constExpr: { stringValue: "nested" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "43", max: "1844674407370955306" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: "msg.`google.expr.proto2.test.nested_example` == ExampleType{name: 'nested'}",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: '_==_(\n  msg^#*expr.Expr_IdentExpr#.google.expr.proto2.test.nested_example^#*expr.Expr_SelectExpr#,\n  ExampleType{\n    name:"nested"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'msg.`google.expr.proto2.test.nested_example` == ExampleType{name: "nested"}',
+      locationAst:
+        '_==_(\n  msg^#1[1,0]#.google.expr.proto2.test.nested_example^#2[1,3]#,\n  ExampleType{\n    name:"nested"^#6[1,66]#^#5[1,64]#\n  }^#4[1,59]#\n)^#3[1,45]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 45, 47, 1, 45, 1, 47],
+        [4, 59, 60, 1, 59, 1, 60],
+        [5, 64, 65, 1, 64, 1, 65],
+        [6, 66, 74, 1, 66, 1, 74],
+      ],
+      lineOffsets: [76],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.nested_example",
+                },
+              },
+              {
+                id: "4",
+                structExpr: {
+                  messageName: "ExampleType",
+                  entries: [
+                    {
+                      id: "5",
+                      fieldKey: "name",
+                      value: { id: "6", constExpr: { stringValue: "nested" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [76],
+          positions: { "1": 0, "2": 3, "3": 45, "4": 59, "5": 64, "6": 66 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.nested_example~google.expr.proto2.test.ExampleType,\n  google.expr.proto2.test.ExampleType{\n    name:"nested"~string\n  }~google.expr.proto2.test.ExampleType^google.expr.proto2.test.ExampleType\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "msg" },
+          "3": { overloadId: ["equals"] },
+          "4": { name: "google.expr.proto2.test.ExampleType" },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto2.test.ExampleType" },
+          "2": { messageType: "google.expr.proto2.test.ExampleType" },
+          "3": { primitive: "BOOL" },
+          "4": { messageType: "google.expr.proto2.test.ExampleType" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [76],
+          positions: { "1": 0, "2": 3, "3": 45, "4": 59, "5": 64, "6": 66 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.nested_example",
+                },
+              },
+              {
+                id: "4",
+                structExpr: {
+                  messageName: "google.expr.proto2.test.ExampleType",
+                  entries: [
+                    {
+                      id: "5",
+                      fieldKey: "name",
+                      value: { id: "6", constExpr: { stringValue: "nested" } },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "43", max: "1844674407370955306" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "43",
+    },
+    {
+      original: {
+        expr: "proto.getExt(msg, google.expr.proto2.test.ExtendedExampleType.extended_examples) == ['example1', 'example2']",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: '_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    msg^#*expr.Expr_IdentExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ExtendedExampleType^#*expr.Expr_SelectExpr#.extended_examples^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "example1"^#*expr.Constant_StringValue#,\n    "example2"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'proto.getExt(msg, google.expr.proto2.test.ExtendedExampleType.extended_examples) == ["example1", "example2"]',
+      locationAst:
+        '_==_(\n  proto^#1[1,0]#.getExt(\n    msg^#3[1,13]#,\n    google^#4[1,18]#.expr^#5[1,24]#.proto2^#6[1,29]#.test^#7[1,36]#.ExtendedExampleType^#8[1,41]#.extended_examples^#9[1,61]#\n  )^#2[1,12]#,\n  [\n    "example1"^#12[1,85]#,\n    "example2"^#13[1,97]#\n  ]^#11[1,84]#\n)^#10[1,81]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 24, 1, 18, 1, 24],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 36, 37, 1, 36, 1, 37],
+        [8, 41, 42, 1, 41, 1, 42],
+        [9, 61, 62, 1, 61, 1, 62],
+        [10, 81, 83, 1, 81, 1, 83],
+        [11, 84, 85, 1, 84, 1, 85],
+        [12, 85, 95, 1, 85, 1, 95],
+        [13, 97, 107, 1, 97, 1, 107],
+      ],
+      lineOffsets: [109],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", identExpr: { name: "msg" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: {
+                                  id: "6",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "5",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "4",
+                                          identExpr: { name: "google" },
+                                        },
+                                        field: "expr",
+                                      },
+                                    },
+                                    field: "proto2",
+                                  },
+                                },
+                                field: "test",
+                              },
+                            },
+                            field: "ExtendedExampleType",
+                          },
+                        },
+                        field: "extended_examples",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                listExpr: {
+                  elements: [
+                    { id: "12", constExpr: { stringValue: "example1" } },
+                    { id: "13", constExpr: { stringValue: "example2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [109],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 61,
+            "10": 81,
+            "11": 84,
+            "12": 85,
+            "13": 97,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.ExtendedExampleType.extended_examples~list(string),\n  [\n    "example1"~string,\n    "example2"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "msg" },
+          "11": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { listType: { elemType: { primitive: "STRING" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "STRING" } } },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [109],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 61,
+            "10": 12,
+            "11": 81,
+            "12": 84,
+            "13": 85,
+            "14": 97,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  { id: "3", identExpr: { name: "msg" } },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: {
+                        id: "8",
+                        selectExpr: {
+                          operand: {
+                            id: "7",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                selectExpr: {
+                                  operand: {
+                                    id: "5",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "4",
+                                        identExpr: { name: "google" },
+                                      },
+                                      field: "expr",
+                                    },
+                                  },
+                                  field: "proto2",
+                                },
+                              },
+                              field: "test",
+                            },
+                          },
+                          field: "ExtendedExampleType",
+                        },
+                      },
+                      field: "extended_examples",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                selectExpr: {
+                  operand: { id: "3", identExpr: { name: "msg" } },
+                  field:
+                    "google.expr.proto2.test.ExtendedExampleType.extended_examples",
+                },
+              },
+              {
+                id: "12",
+                listExpr: {
+                  elements: [
+                    { id: "13", constExpr: { stringValue: "example1" } },
+                    { id: "14", constExpr: { stringValue: "example2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        expr: "msg.`google.expr.proto2.test.ExtendedExampleType.extended_examples` == ['example1', 'example2']",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: '_==_(\n  msg^#*expr.Expr_IdentExpr#.google.expr.proto2.test.ExtendedExampleType.extended_examples^#*expr.Expr_SelectExpr#,\n  [\n    "example1"^#*expr.Constant_StringValue#,\n    "example2"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'msg.`google.expr.proto2.test.ExtendedExampleType.extended_examples` == ["example1", "example2"]',
+      locationAst:
+        '_==_(\n  msg^#1[1,0]#.google.expr.proto2.test.ExtendedExampleType.extended_examples^#2[1,3]#,\n  [\n    "example1"^#5[1,72]#,\n    "example2"^#6[1,84]#\n  ]^#4[1,71]#\n)^#3[1,68]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 68, 70, 1, 68, 1, 70],
+        [4, 71, 72, 1, 71, 1, 72],
+        [5, 72, 82, 1, 72, 1, 82],
+        [6, 84, 94, 1, 84, 1, 94],
+      ],
+      lineOffsets: [96],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field:
+                    "google.expr.proto2.test.ExtendedExampleType.extended_examples",
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { stringValue: "example1" } },
+                    { id: "6", constExpr: { stringValue: "example2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [96],
+          positions: { "1": 0, "2": 3, "3": 68, "4": 71, "5": 72, "6": 84 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.ExtendedExampleType.extended_examples~list(string),\n  [\n    "example1"~string,\n    "example2"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: { "1": { name: "msg" }, "3": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto2.test.ExampleType" },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "STRING" } } },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [96],
+          positions: { "1": 0, "2": 3, "3": 68, "4": 71, "5": 72, "6": 84 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field:
+                    "google.expr.proto2.test.ExtendedExampleType.extended_examples",
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { stringValue: "example1" } },
+                    { id: "6", constExpr: { stringValue: "example2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+    },
+    {
+      original: {
+        expr: "proto.getExt(msg, google.expr.proto2.test.ExtendedExampleType.enum_ext) == GlobalEnum.GAZ",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  proto^#*expr.Expr_IdentExpr#.getExt(\n    msg^#*expr.Expr_IdentExpr#,\n    google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.ExtendedExampleType^#*expr.Expr_SelectExpr#.enum_ext^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  GlobalEnum^#*expr.Expr_IdentExpr#.GAZ^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "proto.getExt(msg, google.expr.proto2.test.ExtendedExampleType.enum_ext) == GlobalEnum.GAZ",
+      locationAst:
+        "_==_(\n  proto^#1[1,0]#.getExt(\n    msg^#3[1,13]#,\n    google^#4[1,18]#.expr^#5[1,24]#.proto2^#6[1,29]#.test^#7[1,36]#.ExtendedExampleType^#8[1,41]#.enum_ext^#9[1,61]#\n  )^#2[1,12]#,\n  GlobalEnum^#11[1,75]#.GAZ^#12[1,85]#\n)^#10[1,72]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 24, 1, 18, 1, 24],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 36, 37, 1, 36, 1, 37],
+        [8, 41, 42, 1, 41, 1, 42],
+        [9, 61, 62, 1, 61, 1, 62],
+        [10, 72, 74, 1, 72, 1, 74],
+        [11, 75, 85, 1, 75, 1, 85],
+        [12, 85, 86, 1, 85, 1, 86],
+      ],
+      lineOffsets: [90],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "proto" } },
+                  function: "getExt",
+                  args: [
+                    { id: "3", identExpr: { name: "msg" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: {
+                                  id: "6",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "5",
+                                      selectExpr: {
+                                        operand: {
+                                          id: "4",
+                                          identExpr: { name: "google" },
+                                        },
+                                        field: "expr",
+                                      },
+                                    },
+                                    field: "proto2",
+                                  },
+                                },
+                                field: "test",
+                              },
+                            },
+                            field: "ExtendedExampleType",
+                          },
+                        },
+                        field: "enum_ext",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "12",
+                selectExpr: {
+                  operand: { id: "11", identExpr: { name: "GlobalEnum" } },
+                  field: "GAZ",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [90],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 61,
+            "10": 72,
+            "11": 75,
+            "12": 85,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.ExtendedExampleType.enum_ext~int,\n  google.expr.proto2.test.GlobalEnum.GAZ~int^google.expr.proto2.test.GlobalEnum.GAZ\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "msg" },
+          "11": { overloadId: ["equals"] },
+          "13": {
+            name: "google.expr.proto2.test.GlobalEnum.GAZ",
+            value: { int64Value: "2" },
+          },
+        },
+        typeMap: {
+          "3": { messageType: "google.expr.proto2.test.ExampleType" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "13": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [90],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 18,
+            "5": 24,
+            "6": 29,
+            "7": 36,
+            "8": 41,
+            "9": 61,
+            "10": 12,
+            "11": 72,
+            "12": 75,
+            "13": 85,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "proto" } },
+                function: "getExt",
+                args: [
+                  { id: "3", identExpr: { name: "msg" } },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: {
+                        id: "8",
+                        selectExpr: {
+                          operand: {
+                            id: "7",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                selectExpr: {
+                                  operand: {
+                                    id: "5",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "4",
+                                        identExpr: { name: "google" },
+                                      },
+                                      field: "expr",
+                                    },
+                                  },
+                                  field: "proto2",
+                                },
+                              },
+                              field: "test",
+                            },
+                          },
+                          field: "ExtendedExampleType",
+                        },
+                      },
+                      field: "enum_ext",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "10",
+                selectExpr: {
+                  operand: { id: "3", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.ExtendedExampleType.enum_ext",
+                },
+              },
+              {
+                id: "13",
+                identExpr: { name: "google.expr.proto2.test.GlobalEnum.GAZ" },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "msg.`google.expr.proto2.test.ExtendedExampleType.enum_ext` == GlobalEnum.GAZ",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+        bindings: {
+          msg: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto2.test.ExampleType",
+                name: "example0",
+                "[google.expr.proto2.test.ExtendedExampleType.enum_ext]": "GAZ",
+                "[google.expr.proto2.test.ExtendedExampleType.extended_examples]":
+                  ["example1", "example2"],
+                "[google.expr.proto2.test.int32_ext]": 42,
+                "[google.expr.proto2.test.int32_wrapper_ext]": 21,
+                "[google.expr.proto2.test.nested_example]": { name: "nested" },
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestProtos",
+      library: "protos",
+      ast: "_==_(\n  msg^#*expr.Expr_IdentExpr#.google.expr.proto2.test.ExtendedExampleType.enum_ext^#*expr.Expr_SelectExpr#,\n  GlobalEnum^#*expr.Expr_IdentExpr#.GAZ^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "msg.`google.expr.proto2.test.ExtendedExampleType.enum_ext` == GlobalEnum.GAZ",
+      locationAst:
+        "_==_(\n  msg^#1[1,0]#.google.expr.proto2.test.ExtendedExampleType.enum_ext^#2[1,3]#,\n  GlobalEnum^#4[1,62]#.GAZ^#5[1,72]#\n)^#3[1,59]#",
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 59, 61, 1, 59, 1, 61],
+        [4, 62, 72, 1, 62, 1, 72],
+        [5, 72, 73, 1, 72, 1, 73],
+      ],
+      lineOffsets: [77],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.ExtendedExampleType.enum_ext",
+                },
+              },
+              {
+                id: "5",
+                selectExpr: {
+                  operand: { id: "4", identExpr: { name: "GlobalEnum" } },
+                  field: "GAZ",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [77],
+          positions: { "1": 0, "2": 3, "3": 59, "4": 62, "5": 72 },
+        },
+      },
+      checkedAst:
+        "_==_(\n  msg~google.expr.proto2.test.ExampleType^msg.google.expr.proto2.test.ExtendedExampleType.enum_ext~int,\n  google.expr.proto2.test.GlobalEnum.GAZ~int^google.expr.proto2.test.GlobalEnum.GAZ\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "msg" },
+          "3": { overloadId: ["equals"] },
+          "5": {
+            name: "google.expr.proto2.test.GlobalEnum.GAZ",
+            value: { int64Value: "2" },
+          },
+        },
+        typeMap: {
+          "1": { messageType: "google.expr.proto2.test.ExampleType" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [77],
+          positions: { "1": 0, "2": 3, "3": 59, "4": 62, "5": 72 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                selectExpr: {
+                  operand: { id: "1", identExpr: { name: "msg" } },
+                  field: "google.expr.proto2.test.ExtendedExampleType.enum_ext",
+                },
+              },
+              {
+                id: "5",
+                identExpr: { name: "google.expr.proto2.test.GlobalEnum.GAZ" },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "proto.getExt(ExtendedExampleType{}, enum_ext)",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+      },
+      section: "TestProtosParseErrors",
+      library: "protos",
+      ast: "proto^#*expr.Expr_IdentExpr#.getExt(\n  ExtendedExampleType{}^#*expr.Expr_StructExpr#,\n  enum_ext^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "proto.getExt(ExtendedExampleType{}, enum_ext)",
+      locationAst:
+        "proto^#1[1,0]#.getExt(\n  ExtendedExampleType{}^#3[1,32]#,\n  enum_ext^#4[1,36]#\n)^#2[1,12]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 32, 33, 1, 32, 1, 33],
+        [4, 36, 44, 1, 36, 1, 44],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "proto" } },
+            function: "getExt",
+            args: [
+              { id: "3", structExpr: { messageName: "ExtendedExampleType" } },
+              { id: "4", identExpr: { name: "enum_ext" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 12, "3": 32, "4": 36 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:37: invalid extension field\n | proto.getExt(ExtendedExampleType{}, enum_ext)\n | ....................................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "invalid extension field",
+          id: 5,
+          offset: 36,
+          line: 1,
+          column: 36,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:37: invalid extension field\n\t\t| proto.getExt(ExtendedExampleType{}, enum_ext)\n\t\t| ....................................^",
+    },
+    {
+      original: {
+        expr: "proto.hasExt(ExtendedExampleType{}, call().enum_ext)",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+      },
+      section: "TestProtosParseErrors",
+      library: "protos",
+      ast: "proto^#*expr.Expr_IdentExpr#.hasExt(\n  ExtendedExampleType{}^#*expr.Expr_StructExpr#,\n  call()^#*expr.Expr_CallExpr#.enum_ext^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "proto.hasExt(ExtendedExampleType{}, call().enum_ext)",
+      locationAst:
+        "proto^#1[1,0]#.hasExt(\n  ExtendedExampleType{}^#3[1,32]#,\n  call()^#4[1,40]#.enum_ext^#5[1,42]#\n)^#2[1,12]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 32, 33, 1, 32, 1, 33],
+        [4, 40, 41, 1, 40, 1, 41],
+        [5, 42, 43, 1, 42, 1, 43],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "proto" } },
+            function: "hasExt",
+            args: [
+              { id: "3", structExpr: { messageName: "ExtendedExampleType" } },
+              {
+                id: "5",
+                selectExpr: {
+                  operand: { id: "4", callExpr: { function: "call" } },
+                  field: "enum_ext",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: { "1": 0, "2": 12, "3": 32, "4": 40, "5": 42 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:43: invalid extension field\n | proto.hasExt(ExtendedExampleType{}, call().enum_ext)\n | ..........................................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "invalid extension field",
+          id: 6,
+          offset: 42,
+          line: 1,
+          column: 42,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:43: invalid extension field\n\t\t| proto.hasExt(ExtendedExampleType{}, call().enum_ext)\n\t\t| ..........................................^",
+    },
+    {
+      original: {
+        expr: "proto.getExt(ExtendedExampleType{}, has(google.expr.proto2.test.int32_ext))",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+      },
+      section: "TestProtosParseErrors",
+      library: "protos",
+      ast: "proto^#*expr.Expr_IdentExpr#.getExt(\n  ExtendedExampleType{}^#*expr.Expr_StructExpr#,\n  google^#*expr.Expr_IdentExpr#.expr^#*expr.Expr_SelectExpr#.proto2^#*expr.Expr_SelectExpr#.test^#*expr.Expr_SelectExpr#.int32_ext~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "proto.getExt(ExtendedExampleType{}, has(google.expr.proto2.test.int32_ext))",
+      locationAst:
+        "proto^#1[1,0]#.getExt(\n  ExtendedExampleType{}^#3[1,32]#,\n  google^#5[1,40]#.expr^#6[1,46]#.proto2^#7[1,51]#.test^#8[1,58]#.int32_ext~test-only~^#10[1,39]#\n)^#2[1,12]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 32, 33, 1, 32, 1, 33],
+        [5, 40, 46, 1, 40, 1, 46],
+        [6, 46, 47, 1, 46, 1, 47],
+        [7, 51, 52, 1, 51, 1, 52],
+        [8, 58, 59, 1, 58, 1, 59],
+        [9, 63, 64, 1, 63, 1, 64],
+        [10, 39, 39, 1, 39, 1, 39],
+      ],
+      lineOffsets: [76],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "proto" } },
+            function: "getExt",
+            args: [
+              { id: "3", structExpr: { messageName: "ExtendedExampleType" } },
+              {
+                id: "10",
+                selectExpr: {
+                  operand: {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: {
+                            id: "6",
+                            selectExpr: {
+                              operand: {
+                                id: "5",
+                                identExpr: { name: "google" },
+                              },
+                              field: "expr",
+                            },
+                          },
+                          field: "proto2",
+                        },
+                      },
+                      field: "test",
+                    },
+                  },
+                  field: "int32_ext",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [76],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 32,
+            "5": 40,
+            "6": 46,
+            "7": 51,
+            "8": 58,
+            "9": 63,
+            "10": 39,
+          },
+          macroCalls: {
+            "10": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: {
+                        id: "8",
+                        selectExpr: {
+                          operand: {
+                            id: "7",
+                            selectExpr: {
+                              operand: {
+                                id: "6",
+                                selectExpr: {
+                                  operand: {
+                                    id: "5",
+                                    identExpr: { name: "google" },
+                                  },
+                                  field: "expr",
+                                },
+                              },
+                              field: "proto2",
+                            },
+                          },
+                          field: "test",
+                        },
+                      },
+                      field: "int32_ext",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 10,
+          function: "has",
+          args: [
+            {
+              id: "9",
+              selectExpr: {
+                operand: {
+                  id: "8",
+                  selectExpr: {
+                    operand: {
+                      id: "7",
+                      selectExpr: {
+                        operand: {
+                          id: "6",
+                          selectExpr: {
+                            operand: { id: "5", identExpr: { name: "google" } },
+                            field: "expr",
+                          },
+                        },
+                        field: "proto2",
+                      },
+                    },
+                    field: "test",
+                  },
+                },
+                field: "int32_ext",
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        "ERROR: \u003cinput\u003e:1:40: invalid extension field\n | proto.getExt(ExtendedExampleType{}, has(google.expr.proto2.test.int32_ext))\n | .......................................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "invalid extension field",
+          id: 11,
+          offset: 39,
+          line: 1,
+          column: 39,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:40: invalid extension field\n\t\t| proto.getExt(ExtendedExampleType{}, has(google.expr.proto2.test.int32_ext))\n\t\t| .......................................^",
+    },
+    {
+      original: {
+        expr: "ExampleType{}.in",
+        typeEnv: [
+          {
+            name: "msg",
+            ident: {
+              type: { messageType: "google.expr.proto2.test.ExampleType" },
+            },
+          },
+        ],
+        container: "google.expr.proto2.test",
+      },
+      section: "TestProtosParseErrors",
+      library: "protos",
+      error:
+        "ERROR: \u003cinput\u003e:1:15: Syntax error: no viable alternative at input '.in'\n | ExampleType{}.in\n | ..............^\nERROR: \u003cinput\u003e:1:17: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n | ExampleType{}.in\n | ................^",
+      errors: [
+        {
+          category: "syntax",
+          message: "Syntax error: no viable alternative at input '.in'",
+          id: 0,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+        {
+          category: "syntax",
+          message:
+            "Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}",
+          id: 0,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedError:
+        "ERROR: \u003cinput\u003e:1:15: Syntax error: no viable alternative at input '.in'\n\t\t\t| ExampleType{}.in\n\t\t\t| ..............^\n\t\t   ERROR: \u003cinput\u003e:1:17: Syntax error: mismatched input '\u003cEOF\u003e' expecting {'[', '{', '(', '.', '-', '!', 'true', 'false', 'null', NUM_FLOAT, NUM_INT, NUM_UINT, STRING, BYTES, IDENTIFIER}\n\t\t\t| ExampleType{}.in\n\t\t\t| ................^",
+    },
+  ],
+} as const;
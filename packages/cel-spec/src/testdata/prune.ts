@@ -0,0 +1,13780 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/interpreter/prune_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "prune",
+  tests: [
+    {
+      original: {
+        expr: "{{'nested_key': true}.nested_key: true}",
+        disableCheck: true,
+      },
+      optionalSyntax: true,
+      ast: '{\n  {\n    "nested_key"^#*expr.Constant_StringValue#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.nested_key^#*expr.Expr_SelectExpr#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{{"nested_key": true}.nested_key: true}',
+      locationAst:
+        '{\n  {\n    "nested_key"^#5[1,2]#:true^#6[1,16]#^#4[1,14]#\n  }^#3[1,1]#.nested_key^#7[1,21]#:true^#8[1,34]#^#2[1,32]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 32, 33, 1, 32, 1, 33],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 2, 14, 1, 2, 1, 14],
+        [6, 16, 20, 1, 16, 1, 20],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 34, 38, 1, 34, 1, 38],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: {
+                  id: "7",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "4",
+                            mapKey: {
+                              id: "5",
+                              constExpr: { stringValue: "nested_key" },
+                            },
+                            value: { id: "6", constExpr: { boolValue: true } },
+                          },
+                        ],
+                      },
+                    },
+                    field: "nested_key",
+                  },
+                },
+                value: { id: "8", constExpr: { boolValue: true } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 32,
+            "3": 1,
+            "4": 14,
+            "5": 2,
+            "6": 16,
+            "7": 21,
+            "8": 34,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  {\n    "nested_key"~string:true~bool\n  }~map(string, bool).nested_key~bool:true~bool\n}~map(bool, bool)',
+      checkedExpr: {
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "BOOL" },
+              valueType: { primitive: "BOOL" },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "BOOL" },
+            },
+          },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 32,
+            "3": 1,
+            "4": 14,
+            "5": 2,
+            "6": 16,
+            "7": 21,
+            "8": 34,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: {
+                  id: "7",
+                  selectExpr: {
+                    operand: {
+                      id: "3",
+                      structExpr: {
+                        entries: [
+                          {
+                            id: "4",
+                            mapKey: {
+                              id: "5",
+                              constExpr: { stringValue: "nested_key" },
+                            },
+                            value: { id: "6", constExpr: { boolValue: true } },
+                          },
+                        ],
+                      },
+                    },
+                    field: "nested_key",
+                  },
+                },
+                value: { id: "8", constExpr: { boolValue: true } },
+              },
+            ],
+          },
+        },
+      },
+      type: "map(bool, bool)",
+      cost: { min: "61", max: "61" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [{ key: { boolValue: true }, value: { boolValue: true } }],
+          },
+        },
+      },
+      residualAst:
+        "{\n  true^#*expr.Constant_BoolValue#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      residual: "{true: true}",
+      expectedResidual: "{true: true}",
+    },
+    {
+      original: {
+        expr: "msg",
+        disableCheck: true,
+        typeEnv: [{ name: "msg", ident: { type: { dyn: {} } } }],
+        bindings: {
+          msg: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "foo" },
+                    value: { stringValue: "bar" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      ast: "msg^#*expr.Expr_IdentExpr#",
+      unparsed: "msg",
+      locationAst: "msg^#1[1,0]#",
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "msg" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "msg~dyn^msg",
+      checkedExpr: {
+        referenceMap: { "1": { name: "msg" } },
+        typeMap: { "1": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "msg" } },
+      },
+      type: "dyn",
+      cost: { min: "1", max: "1" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              { key: { stringValue: "foo" }, value: { stringValue: "bar" } },
+            ],
+          },
+        },
+      },
+      residualAst:
+        '{\n  "foo"^#*expr.Constant_StringValue#:"bar"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      residual: '{"foo": "bar"}',
+      expectedResidual: '{"foo": "bar"}',
+    },
+    {
+      original: { expr: "true \u0026\u0026 false", disableCheck: true },
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 false",
+      locationAst:
+        "_\u0026\u0026_(\n  true^#1[1,0]#,\n  false^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  true~bool,\n  false~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_and"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      residualAst: "false^#*expr.Constant_BoolValue#",
+      residual: "false",
+      expectedResidual: "false",
+    },
+    {
+      original: {
+        expr: "(true || false) \u0026\u0026 x",
+        disableCheck: true,
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "x" }],
+      ast: "_\u0026\u0026_(\n  _||_(\n    true^#*expr.Constant_BoolValue#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(true || false) \u0026\u0026 x",
+      locationAst:
+        "_\u0026\u0026_(\n  _||_(\n    true^#1[1,1]#,\n    false^#2[1,9]#\n  )^#3[1,6]#,\n  x^#4[1,19]#\n)^#5[1,16]#",
+      positions: [
+        [1, 1, 5, 1, 1, 1, 5],
+        [2, 9, 14, 1, 9, 1, 14],
+        [3, 6, 8, 1, 6, 1, 8],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 16, 18, 1, 16, 1, 18],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: true } },
+                    { id: "2", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "4", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 1, "2": 9, "3": 6, "4": 19, "5": 16 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _||_(\n    true~bool,\n    false~bool\n  )~bool^logical_or,\n  x~dyn^x\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["logical_or"] },
+          "4": { name: "x" },
+          "5": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { dyn: {} },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 1, "2": 9, "3": 6, "4": 19, "5": 16 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: true } },
+                    { id: "2", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "4", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { unknown: { exprs: ["4"] } },
+      unknownAttributes: [{ id: 4, variable: "x" }],
+      residualAst: "x^#*expr.Expr_IdentExpr#",
+      residual: "x",
+      expectedResidual: "x",
+    },
+    {
+      original: {
+        expr: "(false || false) \u0026\u0026 x",
+        disableCheck: true,
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "x" }],
+      ast: "_\u0026\u0026_(\n  _||_(\n    false^#*expr.Constant_BoolValue#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(false || false) \u0026\u0026 x",
+      locationAst:
+        "_\u0026\u0026_(\n  _||_(\n    false^#1[1,1]#,\n    false^#2[1,10]#\n  )^#3[1,7]#,\n  x^#4[1,20]#\n)^#5[1,17]#",
+      positions: [
+        [1, 1, 6, 1, 1, 1, 6],
+        [2, 10, 15, 1, 10, 1, 15],
+        [3, 7, 9, 1, 7, 1, 9],
+        [4, 20, 21, 1, 20, 1, 21],
+        [5, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    { id: "2", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "4", identExpr: { name: "x" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 1, "2": 10, "3": 7, "4": 20, "5": 17 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _||_(\n    false~bool,\n    false~bool\n  )~bool^logical_or,\n  x~dyn^x\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["logical_or"] },
+          "4": { name: "x" },
+          "5": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { dyn: {} },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 1, "2": 10, "3": 7, "4": 20, "5": 17 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    { id: "2", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "4", identExpr: { name: "x" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { value: { boolValue: false } },
+      residualAst: "false^#*expr.Constant_BoolValue#",
+      residual: "false",
+      expectedResidual: "false",
+    },
+    {
+      original: {
+        expr: "a \u0026\u0026 [1, 1u, 1.0].exists(x, type(x) == uint)",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "a" }],
+      ast: "_\u0026\u0026_(\n  a^#*expr.Expr_IdentExpr#,\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      1u^#*expr.Constant_Uint64Value#,\n      1^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    false^#*expr.Constant_BoolValue#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // LoopStep\n    _||_(\n      @result^#*expr.Expr_IdentExpr#,\n      _==_(\n        type(\n          x^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        uint^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 [1, 1u, 1.0].exists(x, type(x) == uint)",
+      locationAst:
+        "_\u0026\u0026_(\n  a^#1[1,0]#,\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#3[1,6]#,\n      1u^#4[1,9]#,\n      1^#5[1,13]#\n    ]^#2[1,5]#,\n    // Accumulator\n    @result,\n    // Init\n    false^#12[1,24]#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#13[1,24]#\n      )^#14[1,24]#\n    )^#15[1,24]#,\n    // LoopStep\n    _||_(\n      @result^#16[1,24]#,\n      _==_(\n        type(\n          x^#9[1,33]#\n        )^#8[1,32]#,\n        uint^#11[1,39]#\n      )^#10[1,36]#\n    )^#17[1,24]#,\n    // Result\n    @result^#18[1,24]#)^#19[1,24]#\n)^#20[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 11, 1, 9, 1, 11],
+        [5, 13, 16, 1, 13, 1, 16],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 32, 33, 1, 32, 1, 33],
+        [9, 33, 34, 1, 33, 1, 34],
+        [10, 36, 38, 1, 36, 1, 38],
+        [11, 39, 43, 1, 39, 1, 43],
+        [12, 24, 24, 1, 24, 1, 24],
+        [13, 24, 24, 1, 24, 1, 24],
+        [14, 24, 24, 1, 24, 1, 24],
+        [15, 24, 24, 1, 24, 1, 24],
+        [16, 24, 24, 1, 24, 1, 24],
+        [17, 24, 24, 1, 24, 1, 24],
+        [18, 24, 24, 1, 24, 1, 24],
+        [19, 24, 24, 1, 24, 1, 24],
+        [20, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "20",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "19",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "2",
+                    listExpr: {
+                      elements: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { uint64Value: "1" } },
+                        { id: "5", constExpr: { doubleValue: 1 } },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "12", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "15",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "14",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "13", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "17",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "16", identExpr: { name: "@result" } },
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "type",
+                                  args: [{ id: "9", identExpr: { name: "x" } }],
+                                },
+                              },
+                              { id: "11", identExpr: { name: "uint" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "18", identExpr: { name: "@result" } },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 6,
+            "4": 9,
+            "5": 13,
+            "7": 25,
+            "8": 32,
+            "9": 33,
+            "10": 36,
+            "11": 39,
+            "12": 24,
+            "13": 24,
+            "14": 24,
+            "15": 24,
+            "16": 24,
+            "17": 24,
+            "18": 24,
+            "19": 24,
+            "20": 2,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  listExpr: {
+                    elements: [
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "4", constExpr: { uint64Value: "1" } },
+                      { id: "5", constExpr: { doubleValue: 1 } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "8",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "9", identExpr: { name: "x" } }],
+                          },
+                        },
+                        { id: "11", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "exists",
+          target: {
+            id: "2",
+            listExpr: {
+              elements: [
+                { id: "3", constExpr: { int64Value: "1" } },
+                { id: "4", constExpr: { uint64Value: "1" } },
+                { id: "5", constExpr: { doubleValue: 1 } },
+              ],
+            },
+          },
+          args: [
+            { id: "7", identExpr: { name: "x" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "type",
+                      args: [{ id: "9", identExpr: { name: "x" } }],
+                    },
+                  },
+                  { id: "11", identExpr: { name: "uint" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_\u0026\u0026_(\n  a~dyn^a,\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      1u~uint,\n      1~double\n    ]~list(dyn),\n    // Accumulator\n    @result,\n    // Init\n    false~bool,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result~bool^@result\n      )~bool^logical_not\n    )~bool^not_strictly_false,\n    // LoopStep\n    _||_(\n      @result~bool^@result,\n      _==_(\n        type(\n          x~dyn^x\n        )~type(dyn)^type,\n        uint~type(uint)^uint\n      )~bool^equals\n    )~bool^logical_or,\n    // Result\n    @result~bool^@result)~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "8": { overloadId: ["type"] },
+          "9": { name: "x" },
+          "10": { overloadId: ["equals"] },
+          "11": { name: "uint" },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_not"] },
+          "15": { overloadId: ["not_strictly_false"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_or"] },
+          "18": { name: "@result" },
+          "20": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { listType: { elemType: { dyn: {} } } },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "UINT64" },
+          "5": { primitive: "DOUBLE" },
+          "8": { type: { dyn: {} } },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { type: { primitive: "UINT64" } },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 6,
+            "4": 9,
+            "5": 13,
+            "7": 25,
+            "8": 32,
+            "9": 33,
+            "10": 36,
+            "11": 39,
+            "12": 24,
+            "13": 24,
+            "14": 24,
+            "15": 24,
+            "16": 24,
+            "17": 24,
+            "18": 24,
+            "19": 24,
+            "20": 2,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  listExpr: {
+                    elements: [
+                      { id: "3", constExpr: { int64Value: "1" } },
+                      { id: "4", constExpr: { uint64Value: "1" } },
+                      { id: "5", constExpr: { doubleValue: 1 } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "8",
+                          callExpr: {
+                            function: "type",
+                            args: [{ id: "9", identExpr: { name: "x" } }],
+                          },
+                        },
+                        { id: "11", identExpr: { name: "uint" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "20",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "19",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "2",
+                    listExpr: {
+                      elements: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { uint64Value: "1" } },
+                        { id: "5", constExpr: { doubleValue: 1 } },
+                      ],
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "12", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "15",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "14",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "13", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "17",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "16", identExpr: { name: "@result" } },
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              {
+                                id: "8",
+                                callExpr: {
+                                  function: "type",
+                                  args: [{ id: "9", identExpr: { name: "x" } }],
+                                },
+                              },
+                              { id: "11", identExpr: { name: "uint" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "18", identExpr: { name: "@result" } },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "5534023222112865825" },
+      result: { unknown: { exprs: ["1"] } },
+      unknownAttributes: [{ id: 1, variable: "a" }],
+      residualAst: "a^#*expr.Expr_IdentExpr#",
+      residual: "a",
+      expectedResidual: "a",
+    },
+    {
+      original: {
+        expr: "this in []",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "@in(\n  this^#*expr.Expr_IdentExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "this in []",
+      locationAst: "@in(\n  this^#1[1,0]#,\n  []^#3[1,8]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "this" } },
+              { id: "3", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 8 },
+        },
+      },
+      checkedAst: "@in(\n  this~dyn^this,\n  []~list(dyn)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "this" },
+          "2": { overloadId: ["in_list"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 8 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "this" } },
+              { id: "3", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "11" },
+      result: { unknown: { exprs: ["1"] } },
+      unknownAttributes: [{ id: 1, variable: "this" }],
+      residualAst: "false^#*expr.Constant_BoolValue#",
+      residual: "false",
+      expectedResidual: "false",
+    },
+    {
+      original: {
+        expr: "has(this.a) || !has(this.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: {
+          this: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "b" },
+                    value: { stringValue: "exists" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "_||_(\n  this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(this.a) || !has(this.b)",
+      locationAst:
+        "_||_(\n  this^#2[1,4]#.a~test-only~^#4[1,3]#,\n  !_(\n    this^#7[1,20]#.b~test-only~^#9[1,19]#\n  )^#5[1,15]#\n)^#10[1,12]#",
+      positions: [
+        [2, 4, 8, 1, 4, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 15, 16, 1, 15, 1, 16],
+        [7, 20, 24, 1, 20, 1, 24],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 19, 19, 1, 19, 1, 19],
+        [10, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 15,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 12,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  this~dyn^this.a~test-only~~bool,\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "this" },
+          "5": { overloadId: ["logical_not"] },
+          "7": { name: "this" },
+          "10": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 15,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 12,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { unknown: { exprs: ["4", "9"] } },
+      unknownAttributes: [
+        { id: 4, variable: "this" },
+        { id: 9, variable: "this" },
+      ],
+      residualAst:
+        "_||_(\n  this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "has(this.a) || !has(this.b)",
+      expectedResidual: "has(this.a) || !has(this.b)",
+    },
+    {
+      original: {
+        expr: "has(this.a) || !has(this.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: {
+          this: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "b" },
+                    value: { stringValue: "exists" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this", qualifiers: [{ string: "a" }] }],
+      ast: "_||_(\n  this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(this.a) || !has(this.b)",
+      locationAst:
+        "_||_(\n  this^#2[1,4]#.a~test-only~^#4[1,3]#,\n  !_(\n    this^#7[1,20]#.b~test-only~^#9[1,19]#\n  )^#5[1,15]#\n)^#10[1,12]#",
+      positions: [
+        [2, 4, 8, 1, 4, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 15, 16, 1, 15, 1, 16],
+        [7, 20, 24, 1, 20, 1, 24],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 19, 19, 1, 19, 1, 19],
+        [10, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 15,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 12,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  this~dyn^this.a~test-only~~bool,\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "this" },
+          "5": { overloadId: ["logical_not"] },
+          "7": { name: "this" },
+          "10": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 15,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 12,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { unknown: { exprs: ["4"] } },
+      unknownAttributes: [
+        { id: 4, variable: "this", qualifiers: [{ string: "a" }] },
+      ],
+      residualAst:
+        "this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#",
+      residual: "has(this.a)",
+      expectedResidual: "has(this.a)",
+    },
+    {
+      original: {
+        expr: "!has(this.b) || has(this.a)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: {
+          this: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "b" },
+                    value: { stringValue: "exists" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this", qualifiers: [{ string: "a" }] }],
+      ast: "_||_(\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!has(this.b) || has(this.a)",
+      locationAst:
+        "_||_(\n  !_(\n    this^#3[1,5]#.b~test-only~^#5[1,4]#\n  )^#1[1,0]#,\n  this^#7[1,20]#.a~test-only~^#9[1,19]#\n)^#10[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 5, 9, 1, 5, 1, 9],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 4, 4, 1, 4, 1, 4],
+        [7, 20, 24, 1, 20, 1, 24],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 19, 19, 1, 19, 1, 19],
+        [10, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: { id: "3", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                selectExpr: {
+                  operand: { id: "7", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 9,
+            "5": 4,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 13,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: { id: "3", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not,\n  this~dyn^this.a~test-only~~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { name: "this" },
+          "7": { name: "this" },
+          "10": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { dyn: {} },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 9,
+            "5": 4,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 13,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: { id: "3", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                selectExpr: {
+                  operand: { id: "7", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "5" },
+      result: { unknown: { exprs: ["9"] } },
+      unknownAttributes: [
+        { id: 9, variable: "this", qualifiers: [{ string: "a" }] },
+      ],
+      residualAst:
+        "this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#",
+      residual: "has(this.a)",
+      expectedResidual: "has(this.a)",
+    },
+    {
+      original: {
+        expr: "(!(this.a in []) || has(this.a)) || !has(this.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: { this: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "_||_(\n  _||_(\n    !_(\n      @in(\n        this^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#,\n        []^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(this.a in []) || has(this.a) || !has(this.b)",
+      locationAst:
+        "_||_(\n  _||_(\n    !_(\n      @in(\n        this^#2[1,3]#.a^#3[1,7]#,\n        []^#5[1,13]#\n      )^#4[1,10]#\n    )^#1[1,1]#,\n    this^#7[1,24]#.a~test-only~^#9[1,23]#\n  )^#10[1,17]#,\n  !_(\n    this^#13[1,41]#.b~test-only~^#15[1,40]#\n  )^#11[1,36]#\n)^#16[1,33]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 7, 1, 3, 1, 7],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 10, 12, 1, 10, 1, 12],
+        [5, 13, 14, 1, 13, 1, 14],
+        [7, 24, 28, 1, 24, 1, 28],
+        [8, 28, 29, 1, 28, 1, 29],
+        [9, 23, 23, 1, 23, 1, 23],
+        [10, 17, 19, 1, 17, 1, 19],
+        [11, 36, 37, 1, 36, 1, 37],
+        [13, 41, 45, 1, 41, 1, 45],
+        [14, 45, 46, 1, 45, 1, 46],
+        [15, 40, 40, 1, 40, 1, 40],
+        [16, 33, 35, 1, 33, 1, 35],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "1",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "3",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "2",
+                                      identExpr: { name: "this" },
+                                    },
+                                    field: "a",
+                                  },
+                                },
+                                { id: "5", listExpr: {} },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "15",
+                      selectExpr: {
+                        operand: { id: "13", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 7,
+            "4": 10,
+            "5": 13,
+            "7": 24,
+            "8": 28,
+            "9": 23,
+            "10": 17,
+            "11": 36,
+            "13": 41,
+            "14": 45,
+            "15": 40,
+            "16": 33,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "15": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "14",
+                    selectExpr: {
+                      operand: { id: "13", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 15,
+          function: "has",
+          args: [
+            {
+              id: "14",
+              selectExpr: {
+                operand: { id: "13", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  _||_(\n    !_(\n      @in(\n        this~dyn^this.a~dyn,\n        []~list(dyn)\n      )~bool^in_list\n    )~bool^logical_not,\n    this~dyn^this.a~test-only~~bool\n  )~bool^logical_or,\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "2": { name: "this" },
+          "4": { overloadId: ["in_list"] },
+          "7": { name: "this" },
+          "10": { overloadId: ["logical_or"] },
+          "11": { overloadId: ["logical_not"] },
+          "13": { name: "this" },
+          "16": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "13": { dyn: {} },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 7,
+            "4": 10,
+            "5": 13,
+            "7": 24,
+            "8": 28,
+            "9": 23,
+            "10": 17,
+            "11": 36,
+            "13": 41,
+            "14": 45,
+            "15": 40,
+            "16": 33,
+          },
+          macroCalls: {
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "15": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "14",
+                    selectExpr: {
+                      operand: { id: "13", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "1",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "4",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "3",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "2",
+                                      identExpr: { name: "this" },
+                                    },
+                                    field: "a",
+                                  },
+                                },
+                                { id: "5", listExpr: {} },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "15",
+                      selectExpr: {
+                        operand: { id: "13", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "17" },
+      result: { unknown: { exprs: ["3", "9", "15"] } },
+      unknownAttributes: [
+        { id: 3, variable: "this" },
+        { id: 9, variable: "this" },
+        { id: 15, variable: "this" },
+      ],
+      residualAst: "true^#*expr.Constant_BoolValue#",
+      residual: "true",
+      expectedResidual: "true",
+    },
+    {
+      original: {
+        expr: "has(this.a) || !has(this.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: { this: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "_||_(\n  this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(this.a) || !has(this.b)",
+      locationAst:
+        "_||_(\n  this^#2[1,4]#.a~test-only~^#4[1,3]#,\n  !_(\n    this^#7[1,20]#.b~test-only~^#9[1,19]#\n  )^#5[1,15]#\n)^#10[1,12]#",
+      positions: [
+        [2, 4, 8, 1, 4, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 15, 16, 1, 15, 1, 16],
+        [7, 20, 24, 1, 20, 1, 24],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 19, 19, 1, 19, 1, 19],
+        [10, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 15,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 12,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  this~dyn^this.a~test-only~~bool,\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "this" },
+          "5": { overloadId: ["logical_not"] },
+          "7": { name: "this" },
+          "10": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 15,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 12,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { unknown: { exprs: ["4", "9"] } },
+      unknownAttributes: [
+        { id: 4, variable: "this" },
+        { id: 9, variable: "this" },
+      ],
+      residualAst:
+        "_||_(\n  this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "has(this.a) || !has(this.b)",
+      expectedResidual: "has(this.a) || !has(this.b)",
+    },
+    {
+      original: {
+        expr: "(has(this.a) || !(this.a in [])) || !has(this.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: { this: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "_||_(\n  _||_(\n    this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n    !_(\n      @in(\n        this^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#,\n        []^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(this.a) || !(this.a in []) || !has(this.b)",
+      locationAst:
+        "_||_(\n  _||_(\n    this^#2[1,5]#.a~test-only~^#4[1,4]#,\n    !_(\n      @in(\n        this^#6[1,18]#.a^#7[1,22]#,\n        []^#9[1,28]#\n      )^#8[1,25]#\n    )^#5[1,16]#\n  )^#10[1,13]#,\n  !_(\n    this^#13[1,41]#.b~test-only~^#15[1,40]#\n  )^#11[1,36]#\n)^#16[1,33]#",
+      positions: [
+        [2, 5, 9, 1, 5, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 4, 4, 1, 4, 1, 4],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 18, 22, 1, 18, 1, 22],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 25, 27, 1, 25, 1, 27],
+        [9, 28, 29, 1, 28, 1, 29],
+        [10, 13, 15, 1, 13, 1, 15],
+        [11, 36, 37, 1, 36, 1, 37],
+        [13, 41, 45, 1, 41, 1, 45],
+        [14, 45, 46, 1, 45, 1, 46],
+        [15, 40, 40, 1, 40, 1, 40],
+        [16, 33, 35, 1, 33, 1, 35],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "4",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "this" } },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "8",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "7",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "6",
+                                      identExpr: { name: "this" },
+                                    },
+                                    field: "a",
+                                  },
+                                },
+                                { id: "9", listExpr: {} },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "15",
+                      selectExpr: {
+                        operand: { id: "13", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "2": 5,
+            "3": 9,
+            "4": 4,
+            "5": 16,
+            "6": 18,
+            "7": 22,
+            "8": 25,
+            "9": 28,
+            "10": 13,
+            "11": 36,
+            "13": 41,
+            "14": 45,
+            "15": 40,
+            "16": 33,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "15": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "14",
+                    selectExpr: {
+                      operand: { id: "13", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 15,
+          function: "has",
+          args: [
+            {
+              id: "14",
+              selectExpr: {
+                operand: { id: "13", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  _||_(\n    this~dyn^this.a~test-only~~bool,\n    !_(\n      @in(\n        this~dyn^this.a~dyn,\n        []~list(dyn)\n      )~bool^in_list\n    )~bool^logical_not\n  )~bool^logical_or,\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "this" },
+          "5": { overloadId: ["logical_not"] },
+          "6": { name: "this" },
+          "8": { overloadId: ["in_list"] },
+          "10": { overloadId: ["logical_or"] },
+          "11": { overloadId: ["logical_not"] },
+          "13": { name: "this" },
+          "16": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { dyn: {} },
+          "8": { primitive: "BOOL" },
+          "9": { listType: { elemType: { dyn: {} } } },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "13": { dyn: {} },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "2": 5,
+            "3": 9,
+            "4": 4,
+            "5": 16,
+            "6": 18,
+            "7": 22,
+            "8": 25,
+            "9": 28,
+            "10": 13,
+            "11": 36,
+            "13": 41,
+            "14": 45,
+            "15": 40,
+            "16": 33,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "15": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "14",
+                    selectExpr: {
+                      operand: { id: "13", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "10",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "4",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "this" } },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "8",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                {
+                                  id: "7",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "6",
+                                      identExpr: { name: "this" },
+                                    },
+                                    field: "a",
+                                  },
+                                },
+                                { id: "9", listExpr: {} },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "15",
+                      selectExpr: {
+                        operand: { id: "13", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "17" },
+      result: { unknown: { exprs: ["4", "7", "15"] } },
+      unknownAttributes: [
+        { id: 4, variable: "this" },
+        { id: 7, variable: "this" },
+        { id: 15, variable: "this" },
+      ],
+      residualAst: "true^#*expr.Constant_BoolValue#",
+      residual: "true",
+      expectedResidual: "true",
+    },
+    {
+      original: {
+        expr: "has(this.a) \u0026\u0026 !has(this.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: {
+          this: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "a" },
+                    value: { stringValue: "exists" },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this", qualifiers: [{ string: "b" }] }],
+      ast: "_\u0026\u0026_(\n  this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(this.a) \u0026\u0026 !has(this.b)",
+      locationAst:
+        "_\u0026\u0026_(\n  this^#2[1,4]#.a~test-only~^#4[1,3]#,\n  !_(\n    this^#7[1,20]#.b~test-only~^#9[1,19]#\n  )^#5[1,15]#\n)^#10[1,12]#",
+      positions: [
+        [2, 4, 8, 1, 4, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 15, 16, 1, 15, 1, 16],
+        [7, 20, 24, 1, 20, 1, 24],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 19, 19, 1, 19, 1, 19],
+        [10, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 15,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 12,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_\u0026\u0026_(\n  this~dyn^this.a~test-only~~bool,\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "this" },
+          "5": { overloadId: ["logical_not"] },
+          "7": { name: "this" },
+          "10": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 15,
+            "7": 20,
+            "8": 24,
+            "9": 19,
+            "10": 12,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "a",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { unknown: { exprs: ["9"] } },
+      unknownAttributes: [
+        { id: 9, variable: "this", qualifiers: [{ string: "b" }] },
+      ],
+      residualAst:
+        "!_(\n  this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "!has(this.b)",
+      expectedResidual: "!has(this.b)",
+    },
+    {
+      original: {
+        expr: "(has(this.a) \u0026\u0026 this.a in []) || !has(this.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: { this: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "_||_(\n  _\u0026\u0026_(\n    this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#,\n    @in(\n      this^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#,\n      []^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(this.a) \u0026\u0026 this.a in [] || !has(this.b)",
+      locationAst:
+        "_||_(\n  _\u0026\u0026_(\n    this^#2[1,5]#.a~test-only~^#4[1,4]#,\n    @in(\n      this^#5[1,16]#.a^#6[1,20]#,\n      []^#8[1,26]#\n    )^#7[1,23]#\n  )^#9[1,13]#,\n  !_(\n    this^#12[1,38]#.b~test-only~^#14[1,37]#\n  )^#10[1,33]#\n)^#15[1,30]#",
+      positions: [
+        [2, 5, 9, 1, 5, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 4, 4, 1, 4, 1, 4],
+        [5, 16, 20, 1, 16, 1, 20],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 23, 25, 1, 23, 1, 25],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 13, 15, 1, 13, 1, 15],
+        [10, 33, 34, 1, 33, 1, 34],
+        [12, 38, 42, 1, 38, 1, 42],
+        [13, 42, 43, 1, 42, 1, 43],
+        [14, 37, 37, 1, 37, 1, 37],
+        [15, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "4",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "this" } },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          {
+                            id: "6",
+                            selectExpr: {
+                              operand: { id: "5", identExpr: { name: "this" } },
+                              field: "a",
+                            },
+                          },
+                          { id: "8", listExpr: {} },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "14",
+                      selectExpr: {
+                        operand: { id: "12", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "2": 5,
+            "3": 9,
+            "4": 4,
+            "5": 16,
+            "6": 20,
+            "7": 23,
+            "8": 26,
+            "9": 13,
+            "10": 33,
+            "12": 38,
+            "13": 42,
+            "14": 37,
+            "15": 30,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "14": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "13",
+                    selectExpr: {
+                      operand: { id: "12", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 14,
+          function: "has",
+          args: [
+            {
+              id: "13",
+              selectExpr: {
+                operand: { id: "12", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  _\u0026\u0026_(\n    this~dyn^this.a~test-only~~bool,\n    @in(\n      this~dyn^this.a~dyn,\n      []~list(dyn)\n    )~bool^in_list\n  )~bool^logical_and,\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "this" },
+          "5": { name: "this" },
+          "7": { overloadId: ["in_list"] },
+          "9": { overloadId: ["logical_and"] },
+          "10": { overloadId: ["logical_not"] },
+          "12": { name: "this" },
+          "15": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "12": { dyn: {} },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "2": 5,
+            "3": 9,
+            "4": 4,
+            "5": 16,
+            "6": 20,
+            "7": 23,
+            "8": 26,
+            "9": 13,
+            "10": 33,
+            "12": 38,
+            "13": 42,
+            "14": 37,
+            "15": 30,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "14": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "13",
+                    selectExpr: {
+                      operand: { id: "12", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "4",
+                      selectExpr: {
+                        operand: { id: "2", identExpr: { name: "this" } },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          {
+                            id: "6",
+                            selectExpr: {
+                              operand: { id: "5", identExpr: { name: "this" } },
+                              field: "a",
+                            },
+                          },
+                          { id: "8", listExpr: {} },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "14",
+                      selectExpr: {
+                        operand: { id: "12", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "16" },
+      result: { unknown: { exprs: ["4", "6", "14"] } },
+      unknownAttributes: [
+        { id: 4, variable: "this" },
+        { id: 6, variable: "this" },
+        { id: 14, variable: "this" },
+      ],
+      residualAst:
+        "!_(\n  this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "!has(this.b)",
+      expectedResidual: "!has(this.b)",
+    },
+    {
+      original: {
+        expr: "(this.a in [] \u0026\u0026 has(this.a)) || !has(this.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: { this: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "_||_(\n  _\u0026\u0026_(\n    @in(\n      this^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#,\n      []^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    this^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "this.a in [] \u0026\u0026 has(this.a) || !has(this.b)",
+      locationAst:
+        "_||_(\n  _\u0026\u0026_(\n    @in(\n      this^#1[1,1]#.a^#2[1,5]#,\n      []^#4[1,11]#\n    )^#3[1,8]#,\n    this^#6[1,21]#.a~test-only~^#8[1,20]#\n  )^#9[1,14]#,\n  !_(\n    this^#12[1,38]#.b~test-only~^#14[1,37]#\n  )^#10[1,33]#\n)^#15[1,30]#",
+      positions: [
+        [1, 1, 5, 1, 1, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 8, 10, 1, 8, 1, 10],
+        [4, 11, 12, 1, 11, 1, 12],
+        [6, 21, 25, 1, 21, 1, 25],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 20, 20, 1, 20, 1, 20],
+        [9, 14, 16, 1, 14, 1, 16],
+        [10, 33, 34, 1, 33, 1, 34],
+        [12, 38, 42, 1, 38, 1, 42],
+        [13, 42, 43, 1, 42, 1, 43],
+        [14, 37, 37, 1, 37, 1, 37],
+        [15, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "this" } },
+                              field: "a",
+                            },
+                          },
+                          { id: "4", listExpr: {} },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: { id: "6", identExpr: { name: "this" } },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "14",
+                      selectExpr: {
+                        operand: { id: "12", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 1,
+            "2": 5,
+            "3": 8,
+            "4": 11,
+            "6": 21,
+            "7": 25,
+            "8": 20,
+            "9": 14,
+            "10": 33,
+            "12": 38,
+            "13": 42,
+            "14": 37,
+            "15": 30,
+          },
+          macroCalls: {
+            "8": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "7",
+                    selectExpr: {
+                      operand: { id: "6", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "14": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "13",
+                    selectExpr: {
+                      operand: { id: "12", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 8,
+          function: "has",
+          args: [
+            {
+              id: "7",
+              selectExpr: {
+                operand: { id: "6", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+          ],
+        },
+        {
+          id: 14,
+          function: "has",
+          args: [
+            {
+              id: "13",
+              selectExpr: {
+                operand: { id: "12", identExpr: { name: "this" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_||_(\n  _\u0026\u0026_(\n    @in(\n      this~dyn^this.a~dyn,\n      []~list(dyn)\n    )~bool^in_list,\n    this~dyn^this.a~test-only~~bool\n  )~bool^logical_and,\n  !_(\n    this~dyn^this.b~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "this" },
+          "3": { overloadId: ["in_list"] },
+          "6": { name: "this" },
+          "9": { overloadId: ["logical_and"] },
+          "10": { overloadId: ["logical_not"] },
+          "12": { name: "this" },
+          "15": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "6": { dyn: {} },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "12": { dyn: {} },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 1,
+            "2": 5,
+            "3": 8,
+            "4": 11,
+            "6": 21,
+            "7": 25,
+            "8": 20,
+            "9": 14,
+            "10": 33,
+            "12": 38,
+            "13": 42,
+            "14": 37,
+            "15": 30,
+          },
+          macroCalls: {
+            "8": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "7",
+                    selectExpr: {
+                      operand: { id: "6", identExpr: { name: "this" } },
+                      field: "a",
+                    },
+                  },
+                ],
+              },
+            },
+            "14": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "13",
+                    selectExpr: {
+                      operand: { id: "12", identExpr: { name: "this" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          {
+                            id: "2",
+                            selectExpr: {
+                              operand: { id: "1", identExpr: { name: "this" } },
+                              field: "a",
+                            },
+                          },
+                          { id: "4", listExpr: {} },
+                        ],
+                      },
+                    },
+                    {
+                      id: "8",
+                      selectExpr: {
+                        operand: { id: "6", identExpr: { name: "this" } },
+                        field: "a",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "14",
+                      selectExpr: {
+                        operand: { id: "12", identExpr: { name: "this" } },
+                        field: "b",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "16" },
+      result: { unknown: { exprs: ["2", "8", "14"] } },
+      unknownAttributes: [
+        { id: 2, variable: "this" },
+        { id: 8, variable: "this" },
+        { id: 14, variable: "this" },
+      ],
+      residualAst:
+        "!_(\n  this^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "!has(this.b)",
+      expectedResidual: "!has(this.b)",
+    },
+    {
+      original: {
+        expr: "has(this.a.b)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: {
+          this: {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "a" }, value: { mapValue: {} } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this", qualifiers: [{ string: "a" }] }],
+      ast: "this^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#.b~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(this.a.b)",
+      locationAst: "this^#2[1,4]#.a^#3[1,8]#.b~test-only~^#5[1,3]#",
+      positions: [
+        [2, 4, 8, 1, 4, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+            field: "b",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "2": 4, "3": 8, "4": 10, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "this" } },
+                          field: "a",
+                        },
+                      },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "this" } },
+                    field: "a",
+                  },
+                },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst: "this~dyn^this.a~dyn.b~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "this" } },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "2": 4, "3": 8, "4": 10, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "this" } },
+                          field: "a",
+                        },
+                      },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "a",
+              },
+            },
+            field: "b",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { unknown: { exprs: ["3"] } },
+      unknownAttributes: [
+        { id: 3, variable: "this", qualifiers: [{ string: "a" }] },
+      ],
+      residualAst:
+        "this^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#.b~test-only~^#*expr.Expr_SelectExpr#",
+      residual: "has(this.a.b)",
+      expectedResidual: "has(this.a.b)",
+    },
+    {
+      original: {
+        expr: 'has(this["a"].b)',
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: {
+          this: {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "a" }, value: { mapValue: {} } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this", qualifiers: [{ string: "a" }] }],
+      ast: '_[_](\n  this^#*expr.Expr_IdentExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#.b~test-only~^#*expr.Expr_SelectExpr#',
+      unparsed: 'has(this["a"].b)',
+      locationAst:
+        '_[_](\n  this^#2[1,4]#,\n  "a"^#4[1,9]#\n)^#3[1,8]#.b~test-only~^#6[1,3]#',
+      positions: [
+        [2, 4, 8, 1, 4, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 9, 12, 1, 9, 1, 12],
+        [5, 13, 14, 1, 13, 1, 14],
+        [6, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "3",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "2", identExpr: { name: "this" } },
+                  { id: "4", constExpr: { stringValue: "a" } },
+                ],
+              },
+            },
+            field: "b",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "2": 4, "3": 8, "4": 9, "5": 13, "6": 3 },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "2", identExpr: { name: "this" } },
+                            { id: "4", constExpr: { stringValue: "a" } },
+                          ],
+                        },
+                      },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 6,
+          function: "has",
+          args: [
+            {
+              id: "5",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "2", identExpr: { name: "this" } },
+                      { id: "4", constExpr: { stringValue: "a" } },
+                    ],
+                  },
+                },
+                field: "b",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_[_](\n  this~dyn^this,\n  "a"~string\n)~dyn^index_map|optional_map_index_value.b~test-only~~bool',
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "this" },
+          "3": { overloadId: ["index_map", "optional_map_index_value"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: { "2": 4, "3": 8, "4": 9, "5": 13, "6": 3 },
+          macroCalls: {
+            "6": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "2", identExpr: { name: "this" } },
+                            { id: "4", constExpr: { stringValue: "a" } },
+                          ],
+                        },
+                      },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "3",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "2", identExpr: { name: "this" } },
+                  { id: "4", constExpr: { stringValue: "a" } },
+                ],
+              },
+            },
+            field: "b",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { unknown: { exprs: ["3"] } },
+      unknownAttributes: [
+        { id: 3, variable: "this", qualifiers: [{ string: "a" }] },
+      ],
+      residualAst:
+        '_[_](\n  this^#*expr.Expr_IdentExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#.b~test-only~^#*expr.Expr_SelectExpr#',
+      residual: 'has(this["a"].b)',
+      expectedResidual: 'has(this["a"].b)',
+    },
+    {
+      original: {
+        expr: "has(this.single_int32) \u0026\u0026 !has(this.single_int64)",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+        bindings: {
+          this: {
+            value: {
+              objectValue: {
+                "@type":
+                  "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                singleInt64: "1",
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [
+        { variable: "this", qualifiers: [{ string: "single_int64" }] },
+      ],
+      ast: "_\u0026\u0026_(\n  this^#*expr.Expr_IdentExpr#.single_int32~test-only~^#*expr.Expr_SelectExpr#,\n  !_(\n    this^#*expr.Expr_IdentExpr#.single_int64~test-only~^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "has(this.single_int32) \u0026\u0026 !has(this.single_int64)",
+      locationAst:
+        "_\u0026\u0026_(\n  this^#2[1,4]#.single_int32~test-only~^#4[1,3]#,\n  !_(\n    this^#7[1,31]#.single_int64~test-only~^#9[1,30]#\n  )^#5[1,26]#\n)^#10[1,23]#",
+      positions: [
+        [2, 4, 8, 1, 4, 1, 8],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 3, 3, 1, 3, 1, 3],
+        [5, 26, 27, 1, 26, 1, 27],
+        [7, 31, 35, 1, 31, 1, 35],
+        [8, 35, 36, 1, 35, 1, 36],
+        [9, 30, 30, 1, 30, 1, 30],
+        [10, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [50],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "single_int32",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "single_int64",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 26,
+            "7": 31,
+            "8": 35,
+            "9": 30,
+            "10": 23,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "single_int64",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "this" } },
+                field: "single_int32",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "this" } },
+                field: "single_int64",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_\u0026\u0026_(\n  this~dyn^this.single_int32~test-only~~bool,\n  !_(\n    this~dyn^this.single_int64~test-only~~bool\n  )~bool^logical_not\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "this" },
+          "5": { overloadId: ["logical_not"] },
+          "7": { name: "this" },
+          "10": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "2": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "2": 4,
+            "3": 8,
+            "4": 3,
+            "5": 26,
+            "7": 31,
+            "8": 35,
+            "9": 30,
+            "10": 23,
+          },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "this" } },
+                      field: "single_int32",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "this" } },
+                      field: "single_int64",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: { id: "2", identExpr: { name: "this" } },
+                  field: "single_int32",
+                  testOnly: true,
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "7", identExpr: { name: "this" } },
+                        field: "single_int64",
+                        testOnly: true,
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { value: { boolValue: false } },
+      residualAst: "false^#*expr.Constant_BoolValue#",
+      residual: "false",
+      expectedResidual: "false",
+    },
+    {
+      original: {
+        expr: "this in {}",
+        disableCheck: true,
+        typeEnv: [{ name: "this", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "@in(\n  this^#*expr.Expr_IdentExpr#,\n  {}^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "this in {}",
+      locationAst: "@in(\n  this^#1[1,0]#,\n  {}^#3[1,8]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "this" } },
+              { id: "3", structExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 8 },
+        },
+      },
+      checkedAst: "@in(\n  this~dyn^this,\n  {}~map(dyn, dyn)\n)~bool^in_map",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "this" },
+          "2": { overloadId: ["in_map"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 8 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "this" } },
+              { id: "3", structExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "32", max: "32" },
+      result: { unknown: { exprs: ["1"] } },
+      unknownAttributes: [{ id: 1, variable: "this" }],
+      residualAst: "false^#*expr.Constant_BoolValue#",
+      residual: "false",
+      expectedResidual: "false",
+    },
+    {
+      original: {
+        expr: "this in rules",
+        disableCheck: true,
+        typeEnv: [
+          { name: "rules", ident: { type: { dyn: {} } } },
+          { name: "this", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { rules: { value: { listValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "@in(\n  this^#*expr.Expr_IdentExpr#,\n  rules^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "this in rules",
+      locationAst: "@in(\n  this^#1[1,0]#,\n  rules^#3[1,8]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 13, 1, 8, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "this" } },
+              { id: "3", identExpr: { name: "rules" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 5, "3": 8 },
+        },
+      },
+      checkedAst:
+        "@in(\n  this~dyn^this,\n  rules~dyn^rules\n)~bool^in_list|in_map",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "this" },
+          "2": { overloadId: ["in_list", "in_map"] },
+          "3": { name: "rules" },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 5, "3": 8 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "this" } },
+              { id: "3", identExpr: { name: "rules" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { unknown: { exprs: ["1"] } },
+      unknownAttributes: [{ id: 1, variable: "this" }],
+      residualAst: "false^#*expr.Constant_BoolValue#",
+      residual: "false",
+      expectedResidual: "false",
+    },
+    {
+      original: {
+        expr: "this.size() \u003e 0 ? this in rules.not_in : !(this in rules.not_in)",
+        disableCheck: true,
+        typeEnv: [
+          { name: "rules", ident: { type: { dyn: {} } } },
+          { name: "this", ident: { type: { dyn: {} } } },
+        ],
+        bindings: {
+          rules: {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "not_in" }, value: { listValue: {} } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "_?_:_(\n  _\u003e_(\n    this^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  @in(\n    this^#*expr.Expr_IdentExpr#,\n    rules^#*expr.Expr_IdentExpr#.not_in^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    @in(\n      this^#*expr.Expr_IdentExpr#,\n      rules^#*expr.Expr_IdentExpr#.not_in^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "(this.size() \u003e 0) ? (this in rules.not_in) : !(this in rules.not_in)",
+      locationAst:
+        "_?_:_(\n  _\u003e_(\n    this^#1[1,0]#.size()^#2[1,9]#,\n    0^#4[1,14]#\n  )^#3[1,12]#,\n  @in(\n    this^#6[1,18]#,\n    rules^#8[1,26]#.not_in^#9[1,31]#\n  )^#7[1,23]#,\n  !_(\n    @in(\n      this^#11[1,43]#,\n      rules^#13[1,51]#.not_in^#14[1,56]#\n    )^#12[1,48]#\n  )^#10[1,41]#\n)^#5[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 18, 22, 1, 18, 1, 22],
+        [7, 23, 25, 1, 23, 1, 25],
+        [8, 26, 31, 1, 26, 1, 31],
+        [9, 31, 32, 1, 31, 1, 32],
+        [10, 41, 42, 1, 41, 1, 42],
+        [11, 43, 47, 1, 43, 1, 47],
+        [12, 48, 50, 1, 48, 1, 50],
+        [13, 51, 56, 1, 51, 1, 56],
+        [14, 56, 57, 1, 56, 1, 57],
+      ],
+      lineOffsets: [65],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        target: { id: "1", identExpr: { name: "this" } },
+                        function: "size",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    { id: "6", identExpr: { name: "this" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "8", identExpr: { name: "rules" } },
+                        field: "not_in",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          { id: "11", identExpr: { name: "this" } },
+                          {
+                            id: "14",
+                            selectExpr: {
+                              operand: {
+                                id: "13",
+                                identExpr: { name: "rules" },
+                              },
+                              field: "not_in",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [65],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 12,
+            "4": 14,
+            "5": 16,
+            "6": 18,
+            "7": 23,
+            "8": 26,
+            "9": 31,
+            "10": 41,
+            "11": 43,
+            "12": 48,
+            "13": 51,
+            "14": 56,
+          },
+        },
+      },
+      checkedAst:
+        "_?_:_(\n  _\u003e_(\n    this~dyn^this.size()~int^bytes_size|list_size|map_size|string_size,\n    0~int\n  )~bool^greater_int64,\n  @in(\n    this~dyn^this,\n    rules~dyn^rules.not_in~dyn\n  )~bool^in_list|in_map,\n  !_(\n    @in(\n      this~dyn^this,\n      rules~dyn^rules.not_in~dyn\n    )~bool^in_list|in_map\n  )~bool^logical_not\n)~bool^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "this" },
+          "2": {
+            overloadId: ["bytes_size", "list_size", "map_size", "string_size"],
+          },
+          "3": { overloadId: ["greater_int64"] },
+          "5": { overloadId: ["conditional"] },
+          "6": { name: "this" },
+          "7": { overloadId: ["in_list", "in_map"] },
+          "8": { name: "rules" },
+          "10": { overloadId: ["logical_not"] },
+          "11": { name: "this" },
+          "12": { overloadId: ["in_list", "in_map"] },
+          "13": { name: "rules" },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { dyn: {} },
+          "12": { primitive: "BOOL" },
+          "13": { dyn: {} },
+          "14": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [65],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 12,
+            "4": 14,
+            "5": 16,
+            "6": 18,
+            "7": 23,
+            "8": 26,
+            "9": 31,
+            "10": 41,
+            "11": 43,
+            "12": 48,
+            "13": 51,
+            "14": 56,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        target: { id: "1", identExpr: { name: "this" } },
+                        function: "size",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    { id: "6", identExpr: { name: "this" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "8", identExpr: { name: "rules" } },
+                        field: "not_in",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          { id: "11", identExpr: { name: "this" } },
+                          {
+                            id: "14",
+                            selectExpr: {
+                              operand: {
+                                id: "13",
+                                identExpr: { name: "rules" },
+                              },
+                              field: "not_in",
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "18446744073709551615" },
+      result: { unknown: { exprs: ["1"] } },
+      unknownAttributes: [{ id: 1, variable: "this" }],
+      residualAst:
+        "_?_:_(\n  _\u003e_(\n    this^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      residual: "(this.size() \u003e 0) ? false : true",
+      expectedResidual: "(this.size() \u003e 0) ? false : true",
+    },
+    {
+      original: {
+        expr: "this.size() \u003e 0 ? this in rules.not_in :\n\t\t\t\t!(this in rules.not_in) ? true : false",
+        disableCheck: true,
+        typeEnv: [
+          { name: "rules", ident: { type: { dyn: {} } } },
+          { name: "this", ident: { type: { dyn: {} } } },
+        ],
+        bindings: {
+          rules: {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "not_in" }, value: { listValue: {} } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "this" }],
+      ast: "_?_:_(\n  _\u003e_(\n    this^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  @in(\n    this^#*expr.Expr_IdentExpr#,\n    rules^#*expr.Expr_IdentExpr#.not_in^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  _?_:_(\n    !_(\n      @in(\n        this^#*expr.Expr_IdentExpr#,\n        rules^#*expr.Expr_IdentExpr#.not_in^#*expr.Expr_SelectExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    true^#*expr.Constant_BoolValue#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "(this.size() \u003e 0) ? (this in rules.not_in) : (!(this in rules.not_in) ? true : false)",
+      locationAst:
+        "_?_:_(\n  _\u003e_(\n    this^#1[1,0]#.size()^#2[1,9]#,\n    0^#4[1,14]#\n  )^#3[1,12]#,\n  @in(\n    this^#6[1,18]#,\n    rules^#8[1,26]#.not_in^#9[1,31]#\n  )^#7[1,23]#,\n  _?_:_(\n    !_(\n      @in(\n        this^#11[2,6]#,\n        rules^#13[2,14]#.not_in^#14[2,19]#\n      )^#12[2,11]#\n    )^#10[2,4]#,\n    true^#16[2,30]#,\n    false^#17[2,37]#\n  )^#15[2,28]#\n)^#5[1,16]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 12, 13, 1, 12, 1, 13],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 18, 22, 1, 18, 1, 22],
+        [7, 23, 25, 1, 23, 1, 25],
+        [8, 26, 31, 1, 26, 1, 31],
+        [9, 31, 32, 1, 31, 1, 32],
+        [10, 45, 46, 2, 4, 2, 5],
+        [11, 47, 51, 2, 6, 2, 10],
+        [12, 52, 54, 2, 11, 2, 13],
+        [13, 55, 60, 2, 14, 2, 19],
+        [14, 60, 61, 2, 19, 2, 20],
+        [15, 69, 70, 2, 28, 2, 29],
+        [16, 71, 75, 2, 30, 2, 34],
+        [17, 78, 83, 2, 37, 2, 42],
+      ],
+      lineOffsets: [41, 84],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        target: { id: "1", identExpr: { name: "this" } },
+                        function: "size",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    { id: "6", identExpr: { name: "this" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "8", identExpr: { name: "rules" } },
+                        field: "not_in",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    {
+                      id: "10",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "12",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                { id: "11", identExpr: { name: "this" } },
+                                {
+                                  id: "14",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "13",
+                                      identExpr: { name: "rules" },
+                                    },
+                                    field: "not_in",
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "16", constExpr: { boolValue: true } },
+                    { id: "17", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41, 84],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 12,
+            "4": 14,
+            "5": 16,
+            "6": 18,
+            "7": 23,
+            "8": 26,
+            "9": 31,
+            "10": 45,
+            "11": 47,
+            "12": 52,
+            "13": 55,
+            "14": 60,
+            "15": 69,
+            "16": 71,
+            "17": 78,
+          },
+        },
+      },
+      checkedAst:
+        "_?_:_(\n  _\u003e_(\n    this~dyn^this.size()~int^bytes_size|list_size|map_size|string_size,\n    0~int\n  )~bool^greater_int64,\n  @in(\n    this~dyn^this,\n    rules~dyn^rules.not_in~dyn\n  )~bool^in_list|in_map,\n  _?_:_(\n    !_(\n      @in(\n        this~dyn^this,\n        rules~dyn^rules.not_in~dyn\n      )~bool^in_list|in_map\n    )~bool^logical_not,\n    true~bool,\n    false~bool\n  )~bool^conditional\n)~bool^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "this" },
+          "2": {
+            overloadId: ["bytes_size", "list_size", "map_size", "string_size"],
+          },
+          "3": { overloadId: ["greater_int64"] },
+          "5": { overloadId: ["conditional"] },
+          "6": { name: "this" },
+          "7": { overloadId: ["in_list", "in_map"] },
+          "8": { name: "rules" },
+          "10": { overloadId: ["logical_not"] },
+          "11": { name: "this" },
+          "12": { overloadId: ["in_list", "in_map"] },
+          "13": { name: "rules" },
+          "15": { overloadId: ["conditional"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { dyn: {} },
+          "12": { primitive: "BOOL" },
+          "13": { dyn: {} },
+          "14": { dyn: {} },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41, 84],
+          positions: {
+            "1": 0,
+            "2": 9,
+            "3": 12,
+            "4": 14,
+            "5": 16,
+            "6": 18,
+            "7": 23,
+            "8": 26,
+            "9": 31,
+            "10": 45,
+            "11": 47,
+            "12": 52,
+            "13": 55,
+            "14": 60,
+            "15": 69,
+            "16": 71,
+            "17": 78,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        target: { id: "1", identExpr: { name: "this" } },
+                        function: "size",
+                      },
+                    },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    { id: "6", identExpr: { name: "this" } },
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: { id: "8", identExpr: { name: "rules" } },
+                        field: "not_in",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    {
+                      id: "10",
+                      callExpr: {
+                        function: "!_",
+                        args: [
+                          {
+                            id: "12",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                { id: "11", identExpr: { name: "this" } },
+                                {
+                                  id: "14",
+                                  selectExpr: {
+                                    operand: {
+                                      id: "13",
+                                      identExpr: { name: "rules" },
+                                    },
+                                    field: "not_in",
+                                  },
+                                },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "16", constExpr: { boolValue: true } },
+                    { id: "17", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "18446744073709551615" },
+      result: { unknown: { exprs: ["1"] } },
+      unknownAttributes: [{ id: 1, variable: "this" }],
+      residualAst:
+        "_?_:_(\n  _\u003e_(\n    this^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      residual: "(this.size() \u003e 0) ? false : true",
+      expectedResidual: "(this.size() \u003e 0) ? false : true",
+    },
+    {
+      original: { expr: "{'hello': 'world'.size()}", disableCheck: true },
+      optionalSyntax: true,
+      ast: '{\n  "hello"^#*expr.Constant_StringValue#:"world"^#*expr.Constant_StringValue#.size()^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{"hello": "world".size()}',
+      locationAst:
+        '{\n  "hello"^#3[1,1]#:"world"^#4[1,10]#.size()^#5[1,22]#^#2[1,8]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 1, 8, 1, 1, 1, 8],
+        [4, 10, 17, 1, 10, 1, 17],
+        [5, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "hello" } },
+                value: {
+                  id: "5",
+                  callExpr: {
+                    target: { id: "4", constExpr: { stringValue: "world" } },
+                    function: "size",
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 8, "3": 1, "4": 10, "5": 22 },
+        },
+      },
+      checkedAst:
+        '{\n  "hello"~string:"world"~string.size()~int^string_size\n}~map(string, int)',
+      checkedExpr: {
+        referenceMap: { "5": { overloadId: ["string_size"] } },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 8, "3": 1, "4": 10, "5": 22 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "hello" } },
+                value: {
+                  id: "5",
+                  callExpr: {
+                    target: { id: "4", constExpr: { stringValue: "world" } },
+                    function: "size",
+                  },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, int)",
+      cost: { min: "31", max: "31" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              { key: { stringValue: "hello" }, value: { int64Value: "5" } },
+            ],
+          },
+        },
+      },
+      residualAst:
+        '{\n  "hello"^#*expr.Constant_StringValue#:5^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      residual: '{"hello": 5}',
+      expectedResidual: '{"hello": 5}',
+    },
+    {
+      original: { expr: "[b'bytes-string']", disableCheck: true },
+      optionalSyntax: true,
+      ast: '[\n  b"bytes-string"^#*expr.Constant_BytesValue#\n]^#*expr.Expr_ListExpr#',
+      unparsed:
+        '[b"\\142\\171\\164\\145\\163\\055\\163\\164\\162\\151\\156\\147"]',
+      locationAst: '[\n  b"bytes-string"^#2[1,1]#\n]^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 16, 1, 1, 1, 16],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { bytesValue: "Ynl0ZXMtc3RyaW5n" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      checkedAst: '[\n  b"bytes-string"~bytes\n]~list(bytes)',
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "BYTES" } } },
+          "2": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { bytesValue: "Ynl0ZXMtc3RyaW5n" } },
+            ],
+          },
+        },
+      },
+      type: "list(bytes)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: { listValue: { values: [{ bytesValue: "Ynl0ZXMtc3RyaW5n" }] } },
+      },
+      residualAst:
+        '[\n  b"bytes-string"^#*expr.Constant_BytesValue#\n]^#*expr.Expr_ListExpr#',
+      residual:
+        '[b"\\142\\171\\164\\145\\163\\055\\163\\164\\162\\151\\156\\147"]',
+      expectedResidual:
+        '[b"\\142\\171\\164\\145\\163\\055\\163\\164\\162\\151\\156\\147"]',
+    },
+    {
+      original: { expr: "[b'bytes'] + [b'-' + b'string']", disableCheck: true },
+      optionalSyntax: true,
+      ast: '_+_(\n  [\n    b"bytes"^#*expr.Constant_BytesValue#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    _+_(\n      b"-"^#*expr.Constant_BytesValue#,\n      b"string"^#*expr.Constant_BytesValue#\n    )^#*expr.Expr_CallExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '[b"\\142\\171\\164\\145\\163"] + [b"\\055" + b"\\163\\164\\162\\151\\156\\147"]',
+      locationAst:
+        '_+_(\n  [\n    b"bytes"^#2[1,1]#\n  ]^#1[1,0]#,\n  [\n    _+_(\n      b"-"^#5[1,14]#,\n      b"string"^#7[1,21]#\n    )^#6[1,19]#\n  ]^#4[1,13]#\n)^#3[1,11]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 9, 1, 1, 1, 9],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 14, 18, 1, 14, 1, 18],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 21, 30, 1, 21, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { bytesValue: "Ynl0ZXM=" } },
+                  ],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { bytesValue: "LQ==" } },
+                          { id: "7", constExpr: { bytesValue: "c3RyaW5n" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 11,
+            "4": 13,
+            "5": 14,
+            "6": 19,
+            "7": 21,
+          },
+        },
+      },
+      checkedAst:
+        '_+_(\n  [\n    b"bytes"~bytes\n  ]~list(bytes),\n  [\n    _+_(\n      b"-"~bytes,\n      b"string"~bytes\n    )~bytes^add_bytes\n  ]~list(bytes)\n)~list(bytes)^add_list',
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["add_list"] },
+          "6": { overloadId: ["add_bytes"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "BYTES" } } },
+          "2": { primitive: "BYTES" },
+          "3": { listType: { elemType: { primitive: "BYTES" } } },
+          "4": { listType: { elemType: { primitive: "BYTES" } } },
+          "5": { primitive: "BYTES" },
+          "6": { primitive: "BYTES" },
+          "7": { primitive: "BYTES" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 11,
+            "4": 13,
+            "5": 14,
+            "6": 19,
+            "7": 21,
+          },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { bytesValue: "Ynl0ZXM=" } },
+                  ],
+                },
+              },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "5", constExpr: { bytesValue: "LQ==" } },
+                          { id: "7", constExpr: { bytesValue: "c3RyaW5n" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(bytes)",
+      cost: { min: "22", max: "22" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { bytesValue: "Ynl0ZXM=" },
+              { bytesValue: "LXN0cmluZw==" },
+            ],
+          },
+        },
+      },
+      residualAst:
+        '[\n  b"bytes"^#*expr.Constant_BytesValue#,\n  b"-string"^#*expr.Constant_BytesValue#\n]^#*expr.Expr_ListExpr#',
+      residual:
+        '[b"\\142\\171\\164\\145\\163", b"\\055\\163\\164\\162\\151\\156\\147"]',
+      expectedResidual:
+        '[b"\\142\\171\\164\\145\\163", b"\\055\\163\\164\\162\\151\\156\\147"]',
+    },
+    {
+      original: { expr: "1u + 3u", disableCheck: true },
+      optionalSyntax: true,
+      ast: "_+_(\n  1u^#*expr.Constant_Uint64Value#,\n  3u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1u + 3u",
+      locationAst: "_+_(\n  1u^#1[1,0]#,\n  3u^#3[1,5]#\n)^#2[1,3]#",
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { uint64Value: "1" } },
+              { id: "3", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 3, "3": 5 },
+        },
+      },
+      checkedAst: "_+_(\n  1u~uint,\n  3u~uint\n)~uint^add_uint64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["add_uint64"] } },
+        typeMap: {
+          "1": { primitive: "UINT64" },
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 3, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { uint64Value: "1" } },
+              { id: "3", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "uint",
+      cost: { min: "1", max: "1" },
+      result: { value: { uint64Value: "4" } },
+      residualAst: "4u^#*expr.Constant_Uint64Value#",
+      residual: "4u",
+      expectedResidual: "4u",
+    },
+    {
+      original: { expr: "2 \u003c 3", disableCheck: true },
+      optionalSyntax: true,
+      ast: "_\u003c_(\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2 \u003c 3",
+      locationAst: "_\u003c_(\n  2^#1[1,0]#,\n  3^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_\u003c_(\n  2~int,\n  3~int\n)~bool^less_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["less_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      residualAst: "true^#*expr.Constant_BoolValue#",
+      residual: "true",
+      expectedResidual: "true",
+    },
+    {
+      original: { expr: "!false", disableCheck: true },
+      optionalSyntax: true,
+      ast: "!_(\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!false",
+      locationAst: "!_(\n  false^#2[1,1]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 6, 1, 1, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", constExpr: { boolValue: false } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      checkedAst: "!_(\n  false~bool\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["logical_not"] } },
+        typeMap: { "1": { primitive: "BOOL" }, "2": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", constExpr: { boolValue: false } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      residualAst: "true^#*expr.Constant_BoolValue#",
+      residual: "true",
+      expectedResidual: "true",
+    },
+    {
+      original: {
+        expr: "!y",
+        disableCheck: true,
+        typeEnv: [{ name: "y", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "y" }],
+      ast: "!_(\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!y",
+      locationAst: "!_(\n  y^#2[1,1]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", identExpr: { name: "y" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      checkedAst: "!_(\n  y~dyn^y\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "2": { name: "y" },
+        },
+        typeMap: { "1": { primitive: "BOOL" }, "2": { dyn: {} } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", identExpr: { name: "y" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { unknown: { exprs: ["2"] } },
+      unknownAttributes: [{ id: 2, variable: "y" }],
+      residualAst: "!_(\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "!y",
+      expectedResidual: "!y",
+    },
+    {
+      original: {
+        expr: "optional.of(y)",
+        disableCheck: true,
+        typeEnv: [{ name: "y", ident: { type: { dyn: {} } } }],
+        bindings: { y: { value: { int64Value: "10" } } },
+      },
+      optionalSyntax: true,
+      ast: "optional^#*expr.Expr_IdentExpr#.of(\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "optional.of(y)",
+      locationAst: "optional^#1[1,0]#.of(\n  y^#3[1,12]#\n)^#2[1,11]#",
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "optional" } },
+            function: "of",
+            args: [{ id: "3", identExpr: { name: "y" } }],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 11, "3": 12 },
+        },
+      },
+      checkedAst: "optional.of(\n  y~dyn^y\n)~optional_type(dyn)^optional_of",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["optional_of"] },
+          "3": { name: "y" },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+          "3": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 11, "3": 12 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "optional.of",
+            args: [{ id: "3", identExpr: { name: "y" } }],
+          },
+        },
+      },
+      type: "optional_type(dyn)",
+      cost: { min: "2", max: "2" },
+      residualAst:
+        "optional^#*expr.Expr_IdentExpr#.of(\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      residual: "optional.of(10)",
+      expectedResidual: "optional.of(10)",
+    },
+    {
+      original: {
+        expr: "a.?b",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "a" }],
+      ast: '_?._(\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "a.?b",
+      locationAst: '_?._(\n  a^#1[1,0]#,\n  "b"^#2[1,3]#\n)^#3[1,1]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+      },
+      checkedAst:
+        '_?._(\n  a~dyn^a,\n  "b"\n)~optional_type(dyn)^select_optional_field',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "3": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+      },
+      type: "optional_type(dyn)",
+      cost: { min: "2", max: "2" },
+      result: { unknown: { exprs: ["3"] } },
+      unknownAttributes: [{ id: 3, variable: "a" }],
+      residualAst:
+        '_?._(\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      residual: "a.?b",
+      expectedResidual: "a.?b",
+    },
+    {
+      original: {
+        expr: "a.?b",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+        bindings: {
+          a: {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "b" }, value: { int64Value: "10" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      ast: '_?._(\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "a.?b",
+      locationAst: '_?._(\n  a^#1[1,0]#,\n  "b"^#2[1,3]#\n)^#3[1,1]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+      },
+      checkedAst:
+        '_?._(\n  a~dyn^a,\n  "b"\n)~optional_type(dyn)^select_optional_field',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "3": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+      },
+      type: "optional_type(dyn)",
+      cost: { min: "2", max: "2" },
+      residualAst:
+        "optional^#*expr.Expr_IdentExpr#.of(\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      residual: "optional.of(10)",
+      expectedResidual: "optional.of(10)",
+    },
+    {
+      original: {
+        expr: 'a[?"b"]',
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+        bindings: {
+          a: {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "b" }, value: { int64Value: "10" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      ast: '_[?_](\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'a[?"b"]',
+      locationAst: '_[?_](\n  a^#1[1,0]#,\n  "b"^#3[1,3]#\n)^#2[1,1]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 6, 1, 3, 1, 6],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[?_]",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+      },
+      checkedAst:
+        '_[?_](\n  a~dyn^a,\n  "b"~string\n)~dyn^map_optindex_optional_value|optional_map_optindex_optional_value',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": {
+            overloadId: [
+              "map_optindex_optional_value",
+              "optional_map_optindex_optional_value",
+            ],
+          },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { dyn: {} },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[?_]",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      residualAst:
+        "optional^#*expr.Expr_IdentExpr#.of(\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      residual: "optional.of(10)",
+      expectedResidual: "optional.of(10)",
+    },
+    {
+      original: { expr: "{'b': optional.of(10)}.?b", disableCheck: true },
+      optionalSyntax: true,
+      ast: '_?._(\n  {\n    "b"^#*expr.Constant_StringValue#:optional^#*expr.Expr_IdentExpr#.of(\n      10^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{"b": optional.of(10)}.?b',
+      locationAst:
+        '_?._(\n  {\n    "b"^#3[1,1]#:optional^#4[1,6]#.of(\n      10^#6[1,18]#\n    )^#5[1,17]#^#2[1,4]#\n  }^#1[1,0]#,\n  "b"^#7[1,24]#\n)^#8[1,22]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 6, 14, 1, 6, 1, 14],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 18, 20, 1, 18, 1, 20],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_?._",
+            args: [
+              {
+                id: "1",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "2",
+                      mapKey: { id: "3", constExpr: { stringValue: "b" } },
+                      value: {
+                        id: "5",
+                        callExpr: {
+                          target: { id: "4", identExpr: { name: "optional" } },
+                          function: "of",
+                          args: [{ id: "6", constExpr: { int64Value: "10" } }],
+                        },
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 17,
+            "6": 18,
+            "7": 24,
+            "8": 22,
+          },
+        },
+      },
+      checkedAst:
+        '_?._(\n  {\n    "b"~string:optional.of(\n      10~int\n    )~optional_type(int)^optional_of\n  }~map(string, optional_type(int)),\n  "b"\n)~optional_type(optional_type(int))^select_optional_field',
+      checkedExpr: {
+        referenceMap: {
+          "5": { overloadId: ["optional_of"] },
+          "8": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                abstractType: {
+                  name: "optional_type",
+                  parameterTypes: [{ primitive: "INT64" }],
+                },
+              },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "6": { primitive: "INT64" },
+          "8": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [
+                {
+                  abstractType: {
+                    name: "optional_type",
+                    parameterTypes: [{ primitive: "INT64" }],
+                  },
+                },
+              ],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 17,
+            "6": 18,
+            "7": 24,
+            "8": 22,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_?._",
+            args: [
+              {
+                id: "1",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "2",
+                      mapKey: { id: "3", constExpr: { stringValue: "b" } },
+                      value: {
+                        id: "5",
+                        callExpr: {
+                          function: "optional.of",
+                          args: [{ id: "6", constExpr: { int64Value: "10" } }],
+                        },
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+      },
+      type: "optional_type(optional_type(int))",
+      cost: { min: "32", max: "32" },
+      residualAst:
+        "optional^#*expr.Expr_IdentExpr#.of(\n  optional^#*expr.Expr_IdentExpr#.of(\n    10^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "optional.of(optional.of(10))",
+      expectedResidual: "optional.of(optional.of(10))",
+    },
+    {
+      original: {
+        expr: "a.?b",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+        bindings: { a: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      ast: '_?._(\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "a.?b",
+      locationAst: '_?._(\n  a^#1[1,0]#,\n  "b"^#2[1,3]#\n)^#3[1,1]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+      },
+      checkedAst:
+        '_?._(\n  a~dyn^a,\n  "b"\n)~optional_type(dyn)^select_optional_field',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "3": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+      },
+      type: "optional_type(dyn)",
+      cost: { min: "2", max: "2" },
+      residualAst:
+        "optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#",
+      residual: "optional.none()",
+      expectedResidual: "optional.none()",
+    },
+    {
+      original: { expr: "[10].last()", disableCheck: true },
+      optionalSyntax: true,
+      ast: "[\n  10^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#.last()^#*expr.Expr_CallExpr#",
+      unparsed: "[10].last()",
+      locationAst: "[\n  10^#2[1,1]#\n]^#1[1,0]#.last()^#3[1,9]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 3, 1, 1, 1, 3],
+        [3, 9, 10, 1, 9, 1, 10],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [{ id: "2", constExpr: { int64Value: "10" } }],
+              },
+            },
+            function: "last",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 1, "3": 9 },
+        },
+      },
+      checkedAst:
+        "[\n  10~int\n]~list(int).last()~optional_type(int)^list_last",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["list_last"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 1, "3": 9 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            target: {
+              id: "1",
+              listExpr: {
+                elements: [{ id: "2", constExpr: { int64Value: "10" } }],
+              },
+            },
+            function: "last",
+          },
+        },
+      },
+      type: "optional_type(int)",
+      cost: { min: "11", max: "11" },
+      residualAst:
+        "optional^#*expr.Expr_IdentExpr#.of(\n  10^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      residual: "optional.of(10)",
+      expectedResidual: "optional.of(10)",
+    },
+    {
+      original: { expr: "[].last()", disableCheck: true },
+      optionalSyntax: true,
+      ast: "[]^#*expr.Expr_ListExpr#.last()^#*expr.Expr_CallExpr#",
+      unparsed: "[].last()",
+      locationAst: "[]^#1[1,0]#.last()^#2[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: { target: { id: "1", listExpr: {} }, function: "last" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 7 },
+        },
+      },
+      checkedAst: "[]~list(dyn).last()~optional_type(dyn)^list_last",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_last"] } },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 7 },
+        },
+        expr: {
+          id: "2",
+          callExpr: { target: { id: "1", listExpr: {} }, function: "last" },
+        },
+      },
+      type: "optional_type(dyn)",
+      cost: { min: "11", max: "11" },
+      residualAst:
+        "optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#",
+      residual: "optional.none()",
+      expectedResidual: "optional.none()",
+    },
+    {
+      original: {
+        expr: 'a[?"b"]',
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "a" }],
+      ast: '_[?_](\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'a[?"b"]',
+      locationAst: '_[?_](\n  a^#1[1,0]#,\n  "b"^#3[1,3]#\n)^#2[1,1]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 6, 1, 3, 1, 6],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[?_]",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+      },
+      checkedAst:
+        '_[?_](\n  a~dyn^a,\n  "b"~string\n)~dyn^map_optindex_optional_value|optional_map_optindex_optional_value',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": {
+            overloadId: [
+              "map_optindex_optional_value",
+              "optional_map_optindex_optional_value",
+            ],
+          },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { dyn: {} },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[?_]",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+      },
+      type: "dyn",
+      cost: { min: "2", max: "2" },
+      result: { unknown: { exprs: ["2"] } },
+      unknownAttributes: [{ id: 2, variable: "a" }],
+      residualAst:
+        '_[?_](\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      residual: 'a[?"b"]',
+      expectedResidual: 'a[?"b"]',
+    },
+    {
+      original: { expr: "[1, 2, 3, ?optional.none()]", disableCheck: true },
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#,\n  optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 2, 3, ?optional.none()]",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  2^#3[1,4]#,\n  3^#4[1,7]#,\n  optional^#5[1,11]#.none()^#6[1,24]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 11, 19, 1, 11, 1, 19],
+        [6, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+              {
+                id: "6",
+                callExpr: {
+                  target: { id: "5", identExpr: { name: "optional" } },
+                  function: "none",
+                },
+              },
+            ],
+            optionalIndices: [3],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7, "5": 11, "6": 24 },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  2~int,\n  3~int,\n  optional.none()~optional_type(int)^optional_none\n]~list(int)",
+      checkedExpr: {
+        referenceMap: { "6": { overloadId: ["optional_none"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "6": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7, "5": 11, "6": 24 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+              { id: "6", callExpr: { function: "optional.none" } },
+            ],
+            optionalIndices: [3],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "11", max: "11" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+            ],
+          },
+        },
+      },
+      residualAst:
+        "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      residual: "[1, 2, 3]",
+      expectedResidual: "[1, 2, 3]",
+    },
+    {
+      original: { expr: "[1, 2, 3, ?optional.of(10)]", disableCheck: true },
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#,\n  optional^#*expr.Expr_IdentExpr#.of(\n    10^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 2, 3, ?optional.of(10)]",
+      locationAst:
+        "[\n  1^#2[1,1]#,\n  2^#3[1,4]#,\n  3^#4[1,7]#,\n  optional^#5[1,11]#.of(\n    10^#7[1,23]#\n  )^#6[1,22]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 11, 19, 1, 11, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+              {
+                id: "6",
+                callExpr: {
+                  target: { id: "5", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [{ id: "7", constExpr: { int64Value: "10" } }],
+                },
+              },
+            ],
+            optionalIndices: [3],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 11,
+            "6": 22,
+            "7": 23,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  1~int,\n  2~int,\n  3~int,\n  optional.of(\n    10~int\n  )~optional_type(int)^optional_of\n]~list(int)",
+      checkedExpr: {
+        referenceMap: { "6": { overloadId: ["optional_of"] } },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "6": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "5": 11,
+            "6": 22,
+            "7": 23,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+              {
+                id: "6",
+                callExpr: {
+                  function: "optional.of",
+                  args: [{ id: "7", constExpr: { int64Value: "10" } }],
+                },
+              },
+            ],
+            optionalIndices: [3],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "11", max: "11" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+              { int64Value: "10" },
+            ],
+          },
+        },
+      },
+      residualAst:
+        "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#,\n  10^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      residual: "[1, 2, 3, 10]",
+      expectedResidual: "[1, 2, 3, 10]",
+    },
+    {
+      original: { expr: "{1: 2, ?3: optional.none()}", disableCheck: true },
+      optionalSyntax: true,
+      ast: "{\n  1^#*expr.Constant_Int64Value#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  ?3^#*expr.Constant_Int64Value#:optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "{1: 2, ?3: optional.none()}",
+      locationAst:
+        "{\n  1^#3[1,1]#:2^#4[1,4]#^#2[1,2]#,\n  ?3^#6[1,8]#:optional^#7[1,11]#.none()^#8[1,24]#^#5[1,9]#\n}^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 8, 9, 1, 8, 1, 9],
+        [7, 11, 19, 1, 11, 1, 19],
+        [8, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                value: { id: "4", constExpr: { int64Value: "2" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { int64Value: "3" } },
+                value: {
+                  id: "8",
+                  callExpr: {
+                    target: { id: "7", identExpr: { name: "optional" } },
+                    function: "none",
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 9,
+            "6": 8,
+            "7": 11,
+            "8": 24,
+          },
+        },
+      },
+      checkedAst:
+        "{\n  1~int:2~int,\n  ?3~int:optional.none()~optional_type(int)^optional_none\n}~map(int, int)",
+      checkedExpr: {
+        referenceMap: { "8": { overloadId: ["optional_none"] } },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "INT64" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "8": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 9,
+            "6": 8,
+            "7": 11,
+            "8": 24,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { int64Value: "1" } },
+                value: { id: "4", constExpr: { int64Value: "2" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { int64Value: "3" } },
+                value: { id: "8", callExpr: { function: "optional.none" } },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "map(int, int)",
+      cost: { min: "31", max: "31" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [{ key: { int64Value: "1" }, value: { int64Value: "2" } }],
+          },
+        },
+      },
+      residualAst:
+        "{\n  1^#*expr.Constant_Int64Value#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      residual: "{1: 2}",
+      expectedResidual: "{1: 2}",
+    },
+    {
+      original: {
+        expr: "[?optional.none(), a, 2, 3]",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "a" }],
+      ast: "[\n  optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#,\n  a^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[?optional.none(), a, 2, 3]",
+      locationAst:
+        "[\n  optional^#2[1,2]#.none()^#3[1,15]#,\n  a^#4[1,19]#,\n  2^#5[1,22]#,\n  3^#6[1,25]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 10, 1, 2, 1, 10],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 22, 23, 1, 22, 1, 23],
+        [6, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "optional" } },
+                  function: "none",
+                },
+              },
+              { id: "4", identExpr: { name: "a" } },
+              { id: "5", constExpr: { int64Value: "2" } },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+            optionalIndices: [0],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 2, "3": 15, "4": 19, "5": 22, "6": 25 },
+        },
+      },
+      checkedAst:
+        "[\n  optional.none()~optional_type(dyn)^optional_none,\n  a~dyn^a,\n  2~int,\n  3~int\n]~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["optional_none"] },
+          "4": { name: "a" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+          "4": { dyn: {} },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 2, "3": 15, "4": 19, "5": 22, "6": 25 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "3", callExpr: { function: "optional.none" } },
+              { id: "4", identExpr: { name: "a" } },
+              { id: "5", constExpr: { int64Value: "2" } },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+            optionalIndices: [0],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "12" },
+      result: { unknown: { exprs: ["4"] } },
+      unknownAttributes: [{ id: 4, variable: "a" }],
+      residualAst:
+        "[\n  a^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      residual: "[a, 2, 3]",
+      expectedResidual: "[a, 2, 3]",
+    },
+    {
+      original: {
+        expr: "[?optional.of(10), ?a, 2, 3]",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "a" }],
+      ast: "[\n  optional^#*expr.Expr_IdentExpr#.of(\n    10^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  a^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[?optional.of(10), ?a, 2, 3]",
+      locationAst:
+        "[\n  optional^#2[1,2]#.of(\n    10^#4[1,14]#\n  )^#3[1,13]#,\n  a^#5[1,20]#,\n  2^#6[1,23]#,\n  3^#7[1,26]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 10, 1, 2, 1, 10],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 23, 24, 1, 23, 1, 24],
+        [7, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [{ id: "4", constExpr: { int64Value: "10" } }],
+                },
+              },
+              { id: "5", identExpr: { name: "a" } },
+              { id: "6", constExpr: { int64Value: "2" } },
+              { id: "7", constExpr: { int64Value: "3" } },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 13,
+            "4": 14,
+            "5": 20,
+            "6": 23,
+            "7": 26,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  optional.of(\n    10~int\n  )~optional_type(int)^optional_of,\n  a~dyn^a,\n  2~int,\n  3~int\n]~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["optional_of"] },
+          "5": { name: "a" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 13,
+            "4": 14,
+            "5": 20,
+            "6": 23,
+            "7": 26,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "optional.of",
+                  args: [{ id: "4", constExpr: { int64Value: "10" } }],
+                },
+              },
+              { id: "5", identExpr: { name: "a" } },
+              { id: "6", constExpr: { int64Value: "2" } },
+              { id: "7", constExpr: { int64Value: "3" } },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "12" },
+      result: { unknown: { exprs: ["5"] } },
+      unknownAttributes: [{ id: 5, variable: "a" }],
+      residualAst:
+        "[\n  10^#*expr.Constant_Int64Value#,\n  a^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      residual: "[10, ?a, 2, 3]",
+      expectedResidual: "[10, ?a, 2, 3]",
+    },
+    {
+      original: {
+        expr: "[?optional.of(10), a, 2, 3]",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "a" }],
+      ast: "[\n  optional^#*expr.Expr_IdentExpr#.of(\n    10^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  a^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[?optional.of(10), a, 2, 3]",
+      locationAst:
+        "[\n  optional^#2[1,2]#.of(\n    10^#4[1,14]#\n  )^#3[1,13]#,\n  a^#5[1,19]#,\n  2^#6[1,22]#,\n  3^#7[1,25]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 10, 1, 2, 1, 10],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [{ id: "4", constExpr: { int64Value: "10" } }],
+                },
+              },
+              { id: "5", identExpr: { name: "a" } },
+              { id: "6", constExpr: { int64Value: "2" } },
+              { id: "7", constExpr: { int64Value: "3" } },
+            ],
+            optionalIndices: [0],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 13,
+            "4": 14,
+            "5": 19,
+            "6": 22,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  optional.of(\n    10~int\n  )~optional_type(int)^optional_of,\n  a~dyn^a,\n  2~int,\n  3~int\n]~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["optional_of"] },
+          "5": { name: "a" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "3": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "INT64" }],
+            },
+          },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 13,
+            "4": 14,
+            "5": 19,
+            "6": 22,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "optional.of",
+                  args: [{ id: "4", constExpr: { int64Value: "10" } }],
+                },
+              },
+              { id: "5", identExpr: { name: "a" } },
+              { id: "6", constExpr: { int64Value: "2" } },
+              { id: "7", constExpr: { int64Value: "3" } },
+            ],
+            optionalIndices: [0],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "12" },
+      result: { unknown: { exprs: ["5"] } },
+      unknownAttributes: [{ id: 5, variable: "a" }],
+      residualAst:
+        "[\n  10^#*expr.Constant_Int64Value#,\n  a^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      residual: "[10, a, 2, 3]",
+      expectedResidual: "[10, a, 2, 3]",
+    },
+    {
+      original: {
+        expr: "{?a: b.?c}",
+        disableCheck: true,
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { a: { value: { stringValue: "hi" } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "b" }],
+      ast: '{\n  ?a^#*expr.Expr_IdentExpr#:_?._(\n    b^#*expr.Expr_IdentExpr#,\n    "c"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: "{?a: b.?c}",
+      locationAst:
+        '{\n  ?a^#3[1,2]#:_?._(\n    b^#4[1,5]#,\n    "c"^#5[1,8]#\n  )^#6[1,6]#^#2[1,3]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 6, 7, 1, 6, 1, 7],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", identExpr: { name: "a" } },
+                value: {
+                  id: "6",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "4", identExpr: { name: "b" } },
+                      { id: "5", constExpr: { stringValue: "c" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 3, "3": 2, "4": 5, "5": 8, "6": 6 },
+        },
+      },
+      checkedAst:
+        '{\n  ?a~dyn^a:_?._(\n    b~dyn^b,\n    "c"\n  )~optional_type(dyn)^select_optional_field\n}~map(dyn, dyn)',
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "4": { name: "b" },
+          "6": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "3": { dyn: {} },
+          "4": { dyn: {} },
+          "6": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 3, "3": 2, "4": 5, "5": 8, "6": 6 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", identExpr: { name: "a" } },
+                value: {
+                  id: "6",
+                  callExpr: {
+                    function: "_?._",
+                    args: [
+                      { id: "4", identExpr: { name: "b" } },
+                      { id: "5", constExpr: { stringValue: "c" } },
+                    ],
+                  },
+                },
+                optionalEntry: true,
+              },
+            ],
+          },
+        },
+      },
+      type: "map(dyn, dyn)",
+      cost: { min: "33", max: "33" },
+      result: { unknown: { exprs: ["6"] } },
+      unknownAttributes: [{ id: 6, variable: "b" }],
+      residualAst:
+        '{\n  ?"hi"^#*expr.Constant_StringValue#:_?._(\n    b^#*expr.Expr_IdentExpr#,\n    "c"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      residual: '{?"hi": b.?c}',
+      expectedResidual: '{?"hi": b.?c}',
+    },
+    {
+      original: {
+        expr: '"hi" in {?a: b.?c}',
+        disableCheck: true,
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { a: { value: { stringValue: "hi" } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "b" }],
+      ast: '@in(\n  "hi"^#*expr.Constant_StringValue#,\n  {\n    ?a^#*expr.Expr_IdentExpr#:_?._(\n      b^#*expr.Expr_IdentExpr#,\n      "c"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hi" in {?a: b.?c}',
+      locationAst:
+        '@in(\n  "hi"^#1[1,0]#,\n  {\n    ?a^#5[1,10]#:_?._(\n      b^#6[1,13]#,\n      "c"^#7[1,16]#\n    )^#8[1,14]#^#4[1,11]#\n  }^#3[1,8]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "hi" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", identExpr: { name: "a" } },
+                      value: {
+                        id: "8",
+                        callExpr: {
+                          function: "_?._",
+                          args: [
+                            { id: "6", identExpr: { name: "b" } },
+                            { id: "7", constExpr: { stringValue: "c" } },
+                          ],
+                        },
+                      },
+                      optionalEntry: true,
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 11,
+            "5": 10,
+            "6": 13,
+            "7": 16,
+            "8": 14,
+          },
+        },
+      },
+      checkedAst:
+        '@in(\n  "hi"~string,\n  {\n    ?a~dyn^a:_?._(\n      b~dyn^b,\n      "c"\n    )~optional_type(dyn)^select_optional_field\n  }~map(dyn, dyn)\n)~bool^in_map',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_map"] },
+          "5": { name: "a" },
+          "6": { name: "b" },
+          "8": { overloadId: ["select_optional_field"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "8": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ dyn: {} }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 11,
+            "5": 10,
+            "6": 13,
+            "7": 16,
+            "8": 14,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "hi" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", identExpr: { name: "a" } },
+                      value: {
+                        id: "8",
+                        callExpr: {
+                          function: "_?._",
+                          args: [
+                            { id: "6", identExpr: { name: "b" } },
+                            { id: "7", constExpr: { stringValue: "c" } },
+                          ],
+                        },
+                      },
+                      optionalEntry: true,
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "34", max: "34" },
+      result: { unknown: { exprs: ["8"] } },
+      unknownAttributes: [{ id: 8, variable: "b" }],
+      residualAst:
+        '@in(\n  "hi"^#*expr.Constant_StringValue#,\n  {\n    ?"hi"^#*expr.Constant_StringValue#:_?._(\n      b^#*expr.Expr_IdentExpr#,\n      "c"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      residual: '"hi" in {?"hi": b.?c}',
+      expectedResidual: '"hi" in {?"hi": b.?c}',
+    },
+    {
+      original: {
+        expr: '"hi" in {?a: optional.of("world")}',
+        disableCheck: true,
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { a: { value: { stringValue: "hi" } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "b" }],
+      ast: '@in(\n  "hi"^#*expr.Constant_StringValue#,\n  {\n    ?a^#*expr.Expr_IdentExpr#:optional^#*expr.Expr_IdentExpr#.of(\n      "world"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hi" in {?a: optional.of("world")}',
+      locationAst:
+        '@in(\n  "hi"^#1[1,0]#,\n  {\n    ?a^#5[1,10]#:optional^#6[1,13]#.of(\n      "world"^#8[1,25]#\n    )^#7[1,24]#^#4[1,11]#\n  }^#3[1,8]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 13, 21, 1, 13, 1, 21],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 25, 32, 1, 25, 1, 32],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "hi" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", identExpr: { name: "a" } },
+                      value: {
+                        id: "7",
+                        callExpr: {
+                          target: { id: "6", identExpr: { name: "optional" } },
+                          function: "of",
+                          args: [
+                            { id: "8", constExpr: { stringValue: "world" } },
+                          ],
+                        },
+                      },
+                      optionalEntry: true,
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 11,
+            "5": 10,
+            "6": 13,
+            "7": 24,
+            "8": 25,
+          },
+        },
+      },
+      checkedAst:
+        '@in(\n  "hi"~string,\n  {\n    ?a~dyn^a:optional.of(\n      "world"~string\n    )~optional_type(string)^optional_of\n  }~map(dyn, string)\n)~bool^in_map',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["in_map"] },
+          "5": { name: "a" },
+          "7": { overloadId: ["optional_of"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": {
+            mapType: {
+              keyType: { dyn: {} },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "5": { dyn: {} },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 11,
+            "5": 10,
+            "6": 13,
+            "7": 24,
+            "8": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { stringValue: "hi" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", identExpr: { name: "a" } },
+                      value: {
+                        id: "7",
+                        callExpr: {
+                          function: "optional.of",
+                          args: [
+                            { id: "8", constExpr: { stringValue: "world" } },
+                          ],
+                        },
+                      },
+                      optionalEntry: true,
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "33", max: "33" },
+      result: { value: { boolValue: true } },
+      residualAst: "true^#*expr.Constant_BoolValue#",
+      residual: "true",
+      expectedResidual: "true",
+    },
+    {
+      original: {
+        expr: '{?a: optional.of("world")}[b]',
+        disableCheck: true,
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "b", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { a: { value: { stringValue: "hi" } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "b" }],
+      ast: '_[_](\n  {\n    ?a^#*expr.Expr_IdentExpr#:optional^#*expr.Expr_IdentExpr#.of(\n      "world"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '{?a: optional.of("world")}[b]',
+      locationAst:
+        '_[_](\n  {\n    ?a^#3[1,2]#:optional^#4[1,5]#.of(\n      "world"^#6[1,17]#\n    )^#5[1,16]#^#2[1,3]#\n  }^#1[1,0]#,\n  b^#8[1,27]#\n)^#7[1,26]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 13, 1, 5, 1, 13],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 17, 24, 1, 17, 1, 24],
+        [7, 26, 27, 1, 26, 1, 27],
+        [8, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "1",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "2",
+                      mapKey: { id: "3", identExpr: { name: "a" } },
+                      value: {
+                        id: "5",
+                        callExpr: {
+                          target: { id: "4", identExpr: { name: "optional" } },
+                          function: "of",
+                          args: [
+                            { id: "6", constExpr: { stringValue: "world" } },
+                          ],
+                        },
+                      },
+                      optionalEntry: true,
+                    },
+                  ],
+                },
+              },
+              { id: "8", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 2,
+            "4": 5,
+            "5": 16,
+            "6": 17,
+            "7": 26,
+            "8": 27,
+          },
+        },
+      },
+      checkedAst:
+        '_[_](\n  {\n    ?a~dyn^a:optional.of(\n      "world"~string\n    )~optional_type(string)^optional_of\n  }~map(dyn, string),\n  b~dyn^b\n)~string^index_map',
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "5": { overloadId: ["optional_of"] },
+          "7": { overloadId: ["index_map"] },
+          "8": { name: "b" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { dyn: {} },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "3": { dyn: {} },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 2,
+            "4": 5,
+            "5": 16,
+            "6": 17,
+            "7": 26,
+            "8": 27,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "1",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "2",
+                      mapKey: { id: "3", identExpr: { name: "a" } },
+                      value: {
+                        id: "5",
+                        callExpr: {
+                          function: "optional.of",
+                          args: [
+                            { id: "6", constExpr: { stringValue: "world" } },
+                          ],
+                        },
+                      },
+                      optionalEntry: true,
+                    },
+                  ],
+                },
+              },
+              { id: "8", identExpr: { name: "b" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "34", max: "34" },
+      result: { unknown: { exprs: ["8"] } },
+      unknownAttributes: [{ id: 8, variable: "b" }],
+      residualAst:
+        '_[_](\n  {\n    "hi"^#*expr.Constant_StringValue#:"world"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      residual: '{"hi": "world"}[b]',
+      expectedResidual: '{"hi": "world"}[b]',
+    },
+    {
+      original: {
+        expr: "duration('1h') + duration('2h') \u003e y",
+        disableCheck: true,
+        typeEnv: [{ name: "y", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "y" }],
+      ast: '_\u003e_(\n  _+_(\n    duration(\n      "1h"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    duration(\n      "2h"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'duration("1h") + duration("2h") \u003e y',
+      locationAst:
+        '_\u003e_(\n  _+_(\n    duration(\n      "1h"^#2[1,9]#\n    )^#1[1,8]#,\n    duration(\n      "2h"^#5[1,26]#\n    )^#4[1,25]#\n  )^#3[1,15]#,\n  y^#7[1,34]#\n)^#6[1,32]#',
+      positions: [
+        [1, 8, 9, 1, 8, 1, 9],
+        [2, 9, 13, 1, 9, 1, 13],
+        [3, 15, 16, 1, 15, 1, 16],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 26, 30, 1, 26, 1, 30],
+        [6, 32, 33, 1, 32, 1, 33],
+        [7, 34, 35, 1, 34, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "1",
+                      callExpr: {
+                        function: "duration",
+                        args: [{ id: "2", constExpr: { stringValue: "1h" } }],
+                      },
+                    },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [{ id: "5", constExpr: { stringValue: "2h" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", identExpr: { name: "y" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 8,
+            "2": 9,
+            "3": 15,
+            "4": 25,
+            "5": 26,
+            "6": 32,
+            "7": 34,
+          },
+        },
+      },
+      checkedAst:
+        '_\u003e_(\n  _+_(\n    duration(\n      "1h"~string\n    )~duration^string_to_duration,\n    duration(\n      "2h"~string\n    )~duration^string_to_duration\n  )~duration^add_duration_duration,\n  y~dyn^y\n)~bool^greater_duration',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["string_to_duration"] },
+          "3": { overloadId: ["add_duration_duration"] },
+          "4": { overloadId: ["string_to_duration"] },
+          "6": { overloadId: ["greater_duration"] },
+          "7": { name: "y" },
+        },
+        typeMap: {
+          "1": { wellKnown: "DURATION" },
+          "2": { primitive: "STRING" },
+          "3": { wellKnown: "DURATION" },
+          "4": { wellKnown: "DURATION" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 8,
+            "2": 9,
+            "3": 15,
+            "4": 25,
+            "5": 26,
+            "6": 32,
+            "7": 34,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "1",
+                      callExpr: {
+                        function: "duration",
+                        args: [{ id: "2", constExpr: { stringValue: "1h" } }],
+                      },
+                    },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "duration",
+                        args: [{ id: "5", constExpr: { stringValue: "2h" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", identExpr: { name: "y" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "5" },
+      result: { unknown: { exprs: ["7"] } },
+      unknownAttributes: [{ id: 7, variable: "y" }],
+      residualAst:
+        '_\u003e_(\n  duration(\n    "10800s"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+      residual: 'duration("10800s") \u003e y',
+      expectedResidual: 'duration("10800s") \u003e y',
+    },
+    {
+      original: {
+        expr: "[x, timestamp(0)]",
+        disableCheck: true,
+        typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "x" }],
+      ast: "[\n  x^#*expr.Expr_IdentExpr#,\n  timestamp(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[x, timestamp(0)]",
+      locationAst:
+        "[\n  x^#2[1,1]#,\n  timestamp(\n    0^#4[1,14]#\n  )^#3[1,13]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", identExpr: { name: "x" } },
+              {
+                id: "3",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "4", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 1, "3": 13, "4": 14 },
+        },
+      },
+      checkedAst:
+        "[\n  x~dyn^x,\n  timestamp(\n    0~int\n  )~timestamp^int64_to_timestamp\n]~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "x" },
+          "3": { overloadId: ["int64_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { dyn: {} },
+          "3": { wellKnown: "TIMESTAMP" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 1, "3": 13, "4": 14 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", identExpr: { name: "x" } },
+              {
+                id: "3",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "4", constExpr: { int64Value: "0" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "12" },
+      result: { unknown: { exprs: ["2"] } },
+      unknownAttributes: [{ id: 2, variable: "x" }],
+      residualAst:
+        "[\n  x^#*expr.Expr_IdentExpr#,\n  timestamp(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      residual: "[x, timestamp(0)]",
+      expectedResidual: "[x, timestamp(0)]",
+    },
+    {
+      original: { expr: "[timestamp(0), timestamp(1)]", disableCheck: true },
+      optionalSyntax: true,
+      ast: "[\n  timestamp(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  timestamp(\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[timestamp(0), timestamp(1)]",
+      locationAst:
+        "[\n  timestamp(\n    0^#3[1,11]#\n  )^#2[1,10]#,\n  timestamp(\n    1^#5[1,25]#\n  )^#4[1,24]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 24, "5": 25 },
+        },
+      },
+      checkedAst:
+        "[\n  timestamp(\n    0~int\n  )~timestamp^int64_to_timestamp,\n  timestamp(\n    1~int\n  )~timestamp^int64_to_timestamp\n]~list(timestamp)",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["int64_to_timestamp"] },
+          "4": { overloadId: ["int64_to_timestamp"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { wellKnown: "TIMESTAMP" } } },
+          "2": { wellKnown: "TIMESTAMP" },
+          "3": { primitive: "INT64" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 24, "5": 25 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              {
+                id: "4",
+                callExpr: {
+                  function: "timestamp",
+                  args: [{ id: "5", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(timestamp)",
+      cost: { min: "12", max: "12" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              {
+                objectValue: {
+                  "@type": "type.googleapis.com/google.protobuf.Timestamp",
+                  value: "1970-01-01T00:00:00Z",
+                },
+              },
+              {
+                objectValue: {
+                  "@type": "type.googleapis.com/google.protobuf.Timestamp",
+                  value: "1970-01-01T00:00:01Z",
+                },
+              },
+            ],
+          },
+        },
+      },
+      residualAst:
+        '[\n  timestamp(\n    "1970-01-01T00:00:00Z"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  timestamp(\n    "1970-01-01T00:00:01Z"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n]^#*expr.Expr_ListExpr#',
+      residual:
+        '[timestamp("1970-01-01T00:00:00Z"), timestamp("1970-01-01T00:00:01Z")]',
+      expectedResidual:
+        '[timestamp("1970-01-01T00:00:00Z"), timestamp("1970-01-01T00:00:01Z")]',
+    },
+    {
+      original: { expr: '{"epoch": timestamp(0)}', disableCheck: true },
+      optionalSyntax: true,
+      ast: '{\n  "epoch"^#*expr.Constant_StringValue#:timestamp(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{"epoch": timestamp(0)}',
+      locationAst:
+        '{\n  "epoch"^#3[1,1]#:timestamp(\n    0^#5[1,20]#\n  )^#4[1,19]#^#2[1,8]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 1, 8, 1, 1, 1, 8],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "epoch" } },
+                value: {
+                  id: "4",
+                  callExpr: {
+                    function: "timestamp",
+                    args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 8, "3": 1, "4": 19, "5": 20 },
+        },
+      },
+      checkedAst:
+        '{\n  "epoch"~string:timestamp(\n    0~int\n  )~timestamp^int64_to_timestamp\n}~map(string, timestamp)',
+      checkedExpr: {
+        referenceMap: { "4": { overloadId: ["int64_to_timestamp"] } },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { wellKnown: "TIMESTAMP" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { wellKnown: "TIMESTAMP" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 8, "3": 1, "4": 19, "5": 20 },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "epoch" } },
+                value: {
+                  id: "4",
+                  callExpr: {
+                    function: "timestamp",
+                    args: [{ id: "5", constExpr: { int64Value: "0" } }],
+                  },
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, timestamp)",
+      cost: { min: "31", max: "31" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              {
+                key: { stringValue: "epoch" },
+                value: {
+                  objectValue: {
+                    "@type": "type.googleapis.com/google.protobuf.Timestamp",
+                    value: "1970-01-01T00:00:00Z",
+                  },
+                },
+              },
+            ],
+          },
+        },
+      },
+      residualAst:
+        '{\n  "epoch"^#*expr.Constant_StringValue#:timestamp(\n    "1970-01-01T00:00:00Z"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      residual: '{"epoch": timestamp("1970-01-01T00:00:00Z")}',
+      expectedResidual: '{"epoch": timestamp("1970-01-01T00:00:00Z")}',
+    },
+    {
+      original: {
+        expr: "!y \u0026\u0026 !x",
+        disableCheck: true,
+        typeEnv: [
+          { name: "x", ident: { type: { dyn: {} } } },
+          { name: "y", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { x: { value: { boolValue: false } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "y" }],
+      ast: "_\u0026\u0026_(\n  !_(\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!y \u0026\u0026 !x",
+      locationAst:
+        "_\u0026\u0026_(\n  !_(\n    y^#2[1,1]#\n  )^#1[1,0]#,\n  !_(\n    x^#4[1,7]#\n  )^#3[1,6]#\n)^#5[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 3, 5, 1, 3, 1, 5],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [{ id: "2", identExpr: { name: "y" } }],
+                },
+              },
+              {
+                id: "3",
+                callExpr: {
+                  function: "!_",
+                  args: [{ id: "4", identExpr: { name: "x" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 7, "5": 3 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  !_(\n    y~dyn^y\n  )~bool^logical_not,\n  !_(\n    x~dyn^x\n  )~bool^logical_not\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "2": { name: "y" },
+          "3": { overloadId: ["logical_not"] },
+          "4": { name: "x" },
+          "5": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+          "4": { dyn: {} },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 7, "5": 3 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [{ id: "2", identExpr: { name: "y" } }],
+                },
+              },
+              {
+                id: "3",
+                callExpr: {
+                  function: "!_",
+                  args: [{ id: "4", identExpr: { name: "x" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "4" },
+      result: { unknown: { exprs: ["2"] } },
+      unknownAttributes: [{ id: 2, variable: "y" }],
+      residualAst: "!_(\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "!y",
+      expectedResidual: "!y",
+    },
+    {
+      original: { expr: "!y \u0026\u0026 !(1/0 \u003c 0)", disableCheck: true },
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  !_(\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    _\u003c_(\n      _/_(\n        1^#*expr.Constant_Int64Value#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!y \u0026\u0026 !(1 / 0 \u003c 0)",
+      locationAst:
+        "_\u0026\u0026_(\n  !_(\n    y^#2[1,1]#\n  )^#1[1,0]#,\n  !_(\n    _\u003c_(\n      _/_(\n        1^#4[1,8]#,\n        0^#6[1,10]#\n      )^#5[1,9]#,\n      0^#8[1,14]#\n    )^#7[1,12]#\n  )^#3[1,6]#\n)^#9[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 3, 5, 1, 3, 1, 5],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [{ id: "2", identExpr: { name: "y" } }],
+                },
+              },
+              {
+                id: "3",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_/_",
+                              args: [
+                                { id: "4", constExpr: { int64Value: "1" } },
+                                { id: "6", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          { id: "8", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 8,
+            "5": 9,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 3,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'y' (in container '')\n | !y \u0026\u0026 !(1/0 \u003c 0)\n | .^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'y' (in container '')",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): y" }] },
+      },
+      residualAst:
+        "_\u0026\u0026_(\n  !_(\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    _\u003c_(\n      _/_(\n        1^#*expr.Constant_Int64Value#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "!y \u0026\u0026 !(1 / 0 \u003c 0)",
+      expectedResidual: "!y \u0026\u0026 !(1/0 \u003c 0)",
+    },
+    {
+      original: {
+        expr: "!y \u0026\u0026 !(1/0 \u003c 0)",
+        disableCheck: true,
+        typeEnv: [{ name: "y", ident: { type: { dyn: {} } } }],
+        bindings: { y: { value: { boolValue: false } } },
+      },
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  !_(\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  !_(\n    _\u003c_(\n      _/_(\n        1^#*expr.Constant_Int64Value#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!y \u0026\u0026 !(1 / 0 \u003c 0)",
+      locationAst:
+        "_\u0026\u0026_(\n  !_(\n    y^#2[1,1]#\n  )^#1[1,0]#,\n  !_(\n    _\u003c_(\n      _/_(\n        1^#4[1,8]#,\n        0^#6[1,10]#\n      )^#5[1,9]#,\n      0^#8[1,14]#\n    )^#7[1,12]#\n  )^#3[1,6]#\n)^#9[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 3, 5, 1, 3, 1, 5],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [{ id: "2", identExpr: { name: "y" } }],
+                },
+              },
+              {
+                id: "3",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_/_",
+                              args: [
+                                { id: "4", constExpr: { int64Value: "1" } },
+                                { id: "6", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          { id: "8", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 8,
+            "5": 9,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 3,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  !_(\n    y~dyn^y\n  )~bool^logical_not,\n  !_(\n    _\u003c_(\n      _/_(\n        1~int,\n        0~int\n      )~int^divide_int64,\n      0~int\n    )~bool^less_int64\n  )~bool^logical_not\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "2": { name: "y" },
+          "3": { overloadId: ["logical_not"] },
+          "5": { overloadId: ["divide_int64"] },
+          "7": { overloadId: ["less_int64"] },
+          "9": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { dyn: {} },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 8,
+            "5": 9,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 3,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "!_",
+                  args: [{ id: "2", identExpr: { name: "y" } }],
+                },
+              },
+              {
+                id: "3",
+                callExpr: {
+                  function: "!_",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_/_",
+                              args: [
+                                { id: "4", constExpr: { int64Value: "1" } },
+                                { id: "6", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          { id: "8", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { error: { errors: [{ code: 2, message: "division by zero" }] } },
+      residualAst:
+        "!_(\n  _\u003c_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "!(1 / 0 \u003c 0)",
+      expectedResidual: "!(1/0 \u003c 0)",
+    },
+    {
+      original: { expr: "test == null", disableCheck: true },
+      optionalSyntax: true,
+      ast: "_==_(\n  test^#*expr.Expr_IdentExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "test == null",
+      locationAst: "_==_(\n  test^#1[1,0]#,\n  null^#3[1,8]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 12, 1, 8, 1, 12],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", identExpr: { name: "test" } },
+              { id: "3", constExpr: { nullValue: null } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 8 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'test' (in container '')\n | test == null\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'test' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): test" }] },
+      },
+      residualAst:
+        "_==_(\n  test^#*expr.Expr_IdentExpr#,\n  null^#*expr.Constant_NullValue#\n)^#*expr.Expr_CallExpr#",
+      residual: "test == null",
+      expectedResidual: "test == null",
+    },
+    {
+      original: { expr: "test == null || true", disableCheck: true },
+      optionalSyntax: true,
+      ast: "_||_(\n  _==_(\n    test^#*expr.Expr_IdentExpr#,\n    null^#*expr.Constant_NullValue#\n  )^#*expr.Expr_CallExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "test == null || true",
+      locationAst:
+        "_||_(\n  _==_(\n    test^#1[1,0]#,\n    null^#3[1,8]#\n  )^#2[1,5]#,\n  true^#4[1,16]#\n)^#5[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 12, 1, 8, 1, 12],
+        [4, 16, 20, 1, 16, 1, 20],
+        [5, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "test" } },
+                    { id: "3", constExpr: { nullValue: null } },
+                  ],
+                },
+              },
+              { id: "4", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 5, "3": 8, "4": 16, "5": 13 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'test' (in container '')\n | test == null || true\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'test' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: { value: { boolValue: true } },
+      residualAst: "true^#*expr.Constant_BoolValue#",
+      residual: "true",
+      expectedResidual: "true",
+    },
+    {
+      original: { expr: "test == null \u0026\u0026 false", disableCheck: true },
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  _==_(\n    test^#*expr.Expr_IdentExpr#,\n    null^#*expr.Constant_NullValue#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "test == null \u0026\u0026 false",
+      locationAst:
+        "_\u0026\u0026_(\n  _==_(\n    test^#1[1,0]#,\n    null^#3[1,8]#\n  )^#2[1,5]#,\n  false^#4[1,16]#\n)^#5[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 12, 1, 8, 1, 12],
+        [4, 16, 21, 1, 16, 1, 21],
+        [5, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "test" } },
+                    { id: "3", constExpr: { nullValue: null } },
+                  ],
+                },
+              },
+              { id: "4", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 5, "3": 8, "4": 16, "5": 13 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'test' (in container '')\n | test == null \u0026\u0026 false\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'test' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: { value: { boolValue: false } },
+      residualAst: "false^#*expr.Constant_BoolValue#",
+      residual: "false",
+      expectedResidual: "false",
+    },
+    {
+      original: {
+        expr: "true ? b \u003c 1.2 : c == ['hello']",
+        disableCheck: true,
+        typeEnv: [
+          { name: "b", ident: { type: { dyn: {} } } },
+          { name: "c", ident: { type: { dyn: {} } } },
+        ],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "b" }, { variable: "c" }],
+      ast: '_?_:_(\n  true^#*expr.Constant_BoolValue#,\n  _\u003c_(\n    b^#*expr.Expr_IdentExpr#,\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    c^#*expr.Expr_IdentExpr#,\n    [\n      "hello"^#*expr.Constant_StringValue#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'true ? (b \u003c 1.2) : (c == ["hello"])',
+      locationAst:
+        '_?_:_(\n  true^#1[1,0]#,\n  _\u003c_(\n    b^#3[1,7]#,\n    1.2^#5[1,11]#\n  )^#4[1,9]#,\n  _==_(\n    c^#6[1,17]#,\n    [\n      "hello"^#9[1,23]#\n    ]^#8[1,22]#\n  )^#7[1,19]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 11, 14, 1, 11, 1, 14],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 19, 21, 1, 19, 1, 21],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 23, 30, 1, 23, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "3", identExpr: { name: "b" } },
+                    { id: "5", constExpr: { doubleValue: 1.2 } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "6", identExpr: { name: "c" } },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { stringValue: "hello" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 7,
+            "4": 9,
+            "5": 11,
+            "6": 17,
+            "7": 19,
+            "8": 22,
+            "9": 23,
+          },
+        },
+      },
+      checkedAst:
+        '_?_:_(\n  true~bool,\n  _\u003c_(\n    b~dyn^b,\n    1.2~double\n  )~bool^less_double,\n  _==_(\n    c~dyn^c,\n    [\n      "hello"~string\n    ]~list(string)\n  )~bool^equals\n)~bool^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["conditional"] },
+          "3": { name: "b" },
+          "4": { overloadId: ["less_double"] },
+          "6": { name: "c" },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 7,
+            "4": 9,
+            "5": 11,
+            "6": 17,
+            "7": 19,
+            "8": 22,
+            "9": 23,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "3", identExpr: { name: "b" } },
+                    { id: "5", constExpr: { doubleValue: 1.2 } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "6", identExpr: { name: "c" } },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { stringValue: "hello" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "12" },
+      result: { unknown: { exprs: ["3"] } },
+      unknownAttributes: [{ id: 3, variable: "b" }],
+      residualAst:
+        "_\u003c_(\n  b^#*expr.Expr_IdentExpr#,\n  1.2^#*expr.Constant_DoubleValue#\n)^#*expr.Expr_CallExpr#",
+      residual: "b \u003c 1.2",
+      expectedResidual: "b \u003c 1.2",
+    },
+    {
+      original: {
+        expr: "false ? b \u003c 1.2 : c == ['hello']",
+        disableCheck: true,
+        typeEnv: [
+          { name: "b", ident: { type: { dyn: {} } } },
+          { name: "c", ident: { type: { dyn: {} } } },
+        ],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "b" }, { variable: "c" }],
+      ast: '_?_:_(\n  false^#*expr.Constant_BoolValue#,\n  _\u003c_(\n    b^#*expr.Expr_IdentExpr#,\n    1.2^#*expr.Constant_DoubleValue#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    c^#*expr.Expr_IdentExpr#,\n    [\n      "hello"^#*expr.Constant_StringValue#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'false ? (b \u003c 1.2) : (c == ["hello"])',
+      locationAst:
+        '_?_:_(\n  false^#1[1,0]#,\n  _\u003c_(\n    b^#3[1,8]#,\n    1.2^#5[1,12]#\n  )^#4[1,10]#,\n  _==_(\n    c^#6[1,18]#,\n    [\n      "hello"^#9[1,24]#\n    ]^#8[1,23]#\n  )^#7[1,20]#\n)^#2[1,6]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 15, 1, 12, 1, 15],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 20, 22, 1, 20, 1, 22],
+        [8, 23, 24, 1, 23, 1, 24],
+        [9, 24, 31, 1, 24, 1, 31],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "3", identExpr: { name: "b" } },
+                    { id: "5", constExpr: { doubleValue: 1.2 } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "6", identExpr: { name: "c" } },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { stringValue: "hello" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 6,
+            "3": 8,
+            "4": 10,
+            "5": 12,
+            "6": 18,
+            "7": 20,
+            "8": 23,
+            "9": 24,
+          },
+        },
+      },
+      checkedAst:
+        '_?_:_(\n  false~bool,\n  _\u003c_(\n    b~dyn^b,\n    1.2~double\n  )~bool^less_double,\n  _==_(\n    c~dyn^c,\n    [\n      "hello"~string\n    ]~list(string)\n  )~bool^equals\n)~bool^conditional',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["conditional"] },
+          "3": { name: "b" },
+          "4": { overloadId: ["less_double"] },
+          "6": { name: "c" },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { dyn: {} },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "DOUBLE" },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 6,
+            "3": 8,
+            "4": 10,
+            "5": 12,
+            "6": 18,
+            "7": 20,
+            "8": 23,
+            "9": 24,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "3", identExpr: { name: "b" } },
+                    { id: "5", constExpr: { doubleValue: 1.2 } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "6", identExpr: { name: "c" } },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { stringValue: "hello" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "12" },
+      result: { unknown: { exprs: ["6"] } },
+      unknownAttributes: [{ id: 6, variable: "c" }],
+      residualAst:
+        '_==_(\n  c^#*expr.Expr_IdentExpr#,\n  [\n    "hello"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      residual: 'c == ["hello"]',
+      expectedResidual: 'c == ["hello"]',
+    },
+    {
+      original: { expr: "[1+3, 2+2, 3+1, four]", disableCheck: true },
+      optionalSyntax: true,
+      ast: "[\n  _+_(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    2^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    3^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  four^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1 + 3, 2 + 2, 3 + 1, four]",
+      locationAst:
+        "[\n  _+_(\n    1^#2[1,1]#,\n    3^#4[1,3]#\n  )^#3[1,2]#,\n  _+_(\n    2^#5[1,6]#,\n    2^#7[1,8]#\n  )^#6[1,7]#,\n  _+_(\n    3^#8[1,11]#,\n    1^#10[1,13]#\n  )^#9[1,12]#,\n  four^#11[1,16]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 3, 4, 1, 3, 1, 4],
+        [5, 6, 7, 1, 6, 1, 7],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 8, 9, 1, 8, 1, 9],
+        [8, 11, 12, 1, 11, 1, 12],
+        [9, 12, 13, 1, 12, 1, 13],
+        [10, 13, 14, 1, 13, 1, 14],
+        [11, 16, 20, 1, 16, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "11", identExpr: { name: "four" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 3,
+            "5": 6,
+            "6": 7,
+            "7": 8,
+            "8": 11,
+            "9": 12,
+            "10": 13,
+            "11": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:17: undeclared reference to 'four' (in container '')\n | [1+3, 2+2, 3+1, four]\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'four' (in container '')",
+          id: 11,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): four" }] },
+      },
+      residualAst:
+        "[\n  4^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#,\n  four^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      residual: "[4, 4, 4, four]",
+      expectedResidual: "[4, 4, 4, four]",
+    },
+    {
+      original: {
+        expr: "test == {'a': 1, 'field': 2}.field",
+        disableCheck: true,
+      },
+      optionalSyntax: true,
+      ast: '_==_(\n  test^#*expr.Expr_IdentExpr#,\n  {\n    "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n    "field"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.field^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'test == {"a": 1, "field": 2}.field',
+      locationAst:
+        '_==_(\n  test^#1[1,0]#,\n  {\n    "a"^#5[1,9]#:1^#6[1,14]#^#4[1,12]#,\n    "field"^#8[1,17]#:2^#9[1,26]#^#7[1,24]#\n  }^#3[1,8]#.field^#10[1,28]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 9, 12, 1, 9, 1, 12],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 17, 24, 1, 17, 1, 24],
+        [9, 26, 27, 1, 26, 1, 27],
+        [10, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", identExpr: { name: "test" } },
+              {
+                id: "10",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                        {
+                          id: "7",
+                          mapKey: {
+                            id: "8",
+                            constExpr: { stringValue: "field" },
+                          },
+                          value: { id: "9", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                  field: "field",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 12,
+            "5": 9,
+            "6": 14,
+            "7": 24,
+            "8": 17,
+            "9": 26,
+            "10": 28,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'test' (in container '')\n | test == {'a': 1, 'field': 2}.field\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'test' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): test" }] },
+      },
+      residualAst:
+        "_==_(\n  test^#*expr.Expr_IdentExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      residual: "test == 2",
+      expectedResidual: "test == 2",
+    },
+    {
+      original: {
+        expr: "test in {'a': 1, 'field': [2, 3]}.field",
+        disableCheck: true,
+      },
+      optionalSyntax: true,
+      ast: '@in(\n  test^#*expr.Expr_IdentExpr#,\n  {\n    "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n    "field"^#*expr.Constant_StringValue#:[\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.field^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'test in {"a": 1, "field": [2, 3]}.field',
+      locationAst:
+        '@in(\n  test^#1[1,0]#,\n  {\n    "a"^#5[1,9]#:1^#6[1,14]#^#4[1,12]#,\n    "field"^#8[1,17]#:[\n      2^#10[1,27]#,\n      3^#11[1,30]#\n    ]^#9[1,26]#^#7[1,24]#\n  }^#3[1,8]#.field^#12[1,33]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 9, 12, 1, 9, 1, 12],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 17, 24, 1, 17, 1, 24],
+        [9, 26, 27, 1, 26, 1, 27],
+        [10, 27, 28, 1, 27, 1, 28],
+        [11, 30, 31, 1, 30, 1, 31],
+        [12, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "test" } },
+              {
+                id: "12",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                        {
+                          id: "7",
+                          mapKey: {
+                            id: "8",
+                            constExpr: { stringValue: "field" },
+                          },
+                          value: {
+                            id: "9",
+                            listExpr: {
+                              elements: [
+                                { id: "10", constExpr: { int64Value: "2" } },
+                                { id: "11", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  field: "field",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 12,
+            "5": 9,
+            "6": 14,
+            "7": 24,
+            "8": 17,
+            "9": 26,
+            "10": 27,
+            "11": 30,
+            "12": 33,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'test' (in container '')\n | test in {'a': 1, 'field': [2, 3]}.field\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'test' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): test" }] },
+      },
+      residualAst:
+        "@in(\n  test^#*expr.Expr_IdentExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      residual: "test in [2, 3]",
+      expectedResidual: "test in [2, 3]",
+    },
+    {
+      original: {
+        expr: "test == {'field': [1 + 2, 2 + 3]}",
+        disableCheck: true,
+      },
+      optionalSyntax: true,
+      ast: '_==_(\n  test^#*expr.Expr_IdentExpr#,\n  {\n    "field"^#*expr.Constant_StringValue#:[\n      _+_(\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _+_(\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'test == {"field": [1 + 2, 2 + 3]}',
+      locationAst:
+        '_==_(\n  test^#1[1,0]#,\n  {\n    "field"^#5[1,9]#:[\n      _+_(\n        1^#7[1,19]#,\n        2^#9[1,23]#\n      )^#8[1,21]#,\n      _+_(\n        2^#10[1,26]#,\n        3^#12[1,30]#\n      )^#11[1,28]#\n    ]^#6[1,18]#^#4[1,16]#\n  }^#3[1,8]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 9, 16, 1, 9, 1, 16],
+        [6, 18, 19, 1, 18, 1, 19],
+        [7, 19, 20, 1, 19, 1, 20],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 23, 24, 1, 23, 1, 24],
+        [10, 26, 27, 1, 26, 1, 27],
+        [11, 28, 29, 1, 28, 1, 29],
+        [12, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", identExpr: { name: "test" } },
+              {
+                id: "3",
+                structExpr: {
+                  entries: [
+                    {
+                      id: "4",
+                      mapKey: { id: "5", constExpr: { stringValue: "field" } },
+                      value: {
+                        id: "6",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "8",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "7", constExpr: { int64Value: "1" } },
+                                  { id: "9", constExpr: { int64Value: "2" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "11",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "10", constExpr: { int64Value: "2" } },
+                                  { id: "12", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 16,
+            "5": 9,
+            "6": 18,
+            "7": 19,
+            "8": 21,
+            "9": 23,
+            "10": 26,
+            "11": 28,
+            "12": 30,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'test' (in container '')\n | test == {'field': [1 + 2, 2 + 3]}\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'test' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): test" }] },
+      },
+      residualAst:
+        '_==_(\n  test^#*expr.Expr_IdentExpr#,\n  {\n    "field"^#*expr.Constant_StringValue#:[\n      3^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#\n)^#*expr.Expr_CallExpr#',
+      residual: 'test == {"field": [3, 5]}',
+      expectedResidual: 'test == {"field": [3, 5]}',
+    },
+    {
+      original: {
+        expr: "test in {'a': 1, 'field': [test, 3]}.field",
+        disableCheck: true,
+      },
+      optionalSyntax: true,
+      ast: '@in(\n  test^#*expr.Expr_IdentExpr#,\n  {\n    "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n    "field"^#*expr.Constant_StringValue#:[\n      test^#*expr.Expr_IdentExpr#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.field^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'test in {"a": 1, "field": [test, 3]}.field',
+      locationAst:
+        '@in(\n  test^#1[1,0]#,\n  {\n    "a"^#5[1,9]#:1^#6[1,14]#^#4[1,12]#,\n    "field"^#8[1,17]#:[\n      test^#10[1,27]#,\n      3^#11[1,33]#\n    ]^#9[1,26]#^#7[1,24]#\n  }^#3[1,8]#.field^#12[1,36]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 7, 1, 5, 1, 7],
+        [3, 8, 9, 1, 8, 1, 9],
+        [4, 12, 13, 1, 12, 1, 13],
+        [5, 9, 12, 1, 9, 1, 12],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 17, 24, 1, 17, 1, 24],
+        [9, 26, 27, 1, 26, 1, 27],
+        [10, 27, 31, 1, 27, 1, 31],
+        [11, 33, 34, 1, 33, 1, 34],
+        [12, 36, 37, 1, 36, 1, 37],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "test" } },
+              {
+                id: "12",
+                selectExpr: {
+                  operand: {
+                    id: "3",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "4",
+                          mapKey: { id: "5", constExpr: { stringValue: "a" } },
+                          value: { id: "6", constExpr: { int64Value: "1" } },
+                        },
+                        {
+                          id: "7",
+                          mapKey: {
+                            id: "8",
+                            constExpr: { stringValue: "field" },
+                          },
+                          value: {
+                            id: "9",
+                            listExpr: {
+                              elements: [
+                                { id: "10", identExpr: { name: "test" } },
+                                { id: "11", constExpr: { int64Value: "3" } },
+                              ],
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  field: "field",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 8,
+            "4": 12,
+            "5": 9,
+            "6": 14,
+            "7": 24,
+            "8": 17,
+            "9": 26,
+            "10": 27,
+            "11": 33,
+            "12": 36,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'test' (in container '')\n | test in {'a': 1, 'field': [test, 3]}.field\n | ^\nERROR: \u003cinput\u003e:1:28: undeclared reference to 'test' (in container '')\n | test in {'a': 1, 'field': [test, 3]}.field\n | ...........................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'test' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'test' (in container '')",
+          id: 10,
+          offset: 27,
+          line: 1,
+          column: 27,
+        },
+      ],
+      result: {
+        error: { errors: [{ code: 2, message: "no such attribute(s): test" }] },
+      },
+      residualAst:
+        '@in(\n  test^#*expr.Expr_IdentExpr#,\n  {\n    "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n    "field"^#*expr.Constant_StringValue#:[\n      test^#*expr.Expr_IdentExpr#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#.field^#*expr.Expr_SelectExpr#\n)^#*expr.Expr_CallExpr#',
+      residual: 'test in {"a": 1, "field": [test, 3]}.field',
+      expectedResidual: 'test in {"a": 1, "field": [test, 3]}.field',
+    },
+    {
+      original: {
+        expr: 'foo == "bar" \u0026\u0026 r.attr.loc in ["GB", "US"]',
+        disableCheck: true,
+        typeEnv: [
+          { name: "foo", ident: { type: { dyn: {} } } },
+          { name: "r.attr", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { foo: { value: { stringValue: "bar" } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "r.attr" }],
+      ast: '_\u0026\u0026_(\n  _==_(\n    foo^#*expr.Expr_IdentExpr#,\n    "bar"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  @in(\n    r^#*expr.Expr_IdentExpr#.attr^#*expr.Expr_SelectExpr#.loc^#*expr.Expr_SelectExpr#,\n    [\n      "GB"^#*expr.Constant_StringValue#,\n      "US"^#*expr.Constant_StringValue#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'foo == "bar" \u0026\u0026 r.attr.loc in ["GB", "US"]',
+      locationAst:
+        '_\u0026\u0026_(\n  _==_(\n    foo^#1[1,0]#,\n    "bar"^#3[1,7]#\n  )^#2[1,4]#,\n  @in(\n    r^#4[1,16]#.attr^#5[1,17]#.loc^#6[1,22]#,\n    [\n      "GB"^#9[1,31]#,\n      "US"^#10[1,37]#\n    ]^#8[1,30]#\n  )^#7[1,27]#\n)^#11[1,13]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 4, 6, 1, 4, 1, 6],
+        [3, 7, 12, 1, 7, 1, 12],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 27, 29, 1, 27, 1, 29],
+        [8, 30, 31, 1, 30, 1, 31],
+        [9, 31, 35, 1, 31, 1, 35],
+        [10, 37, 41, 1, 37, 1, 41],
+        [11, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "foo" } },
+                    { id: "3", constExpr: { stringValue: "bar" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    {
+                      id: "6",
+                      selectExpr: {
+                        operand: {
+                          id: "5",
+                          selectExpr: {
+                            operand: { id: "4", identExpr: { name: "r" } },
+                            field: "attr",
+                          },
+                        },
+                        field: "loc",
+                      },
+                    },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { stringValue: "GB" } },
+                          { id: "10", constExpr: { stringValue: "US" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 7,
+            "4": 16,
+            "5": 17,
+            "6": 22,
+            "7": 27,
+            "8": 30,
+            "9": 31,
+            "10": 37,
+            "11": 13,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _==_(\n    foo~dyn^foo,\n    "bar"~string\n  )~bool^equals,\n  @in(\n    r.attr~dyn^r.attr.loc~dyn,\n    [\n      "GB"~string,\n      "US"~string\n    ]~list(string)\n  )~bool^in_list\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "foo" },
+          "2": { overloadId: ["equals"] },
+          "5": { name: "r.attr" },
+          "7": { overloadId: ["in_list"] },
+          "11": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { listType: { elemType: { primitive: "STRING" } } },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 7,
+            "4": 16,
+            "5": 17,
+            "6": 22,
+            "7": 27,
+            "8": 30,
+            "9": 31,
+            "10": 37,
+            "11": 13,
+          },
+        },
+        expr: {
+          id: "11",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "1", identExpr: { name: "foo" } },
+                    { id: "3", constExpr: { stringValue: "bar" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    {
+                      id: "6",
+                      selectExpr: {
+                        operand: { id: "5", identExpr: { name: "r.attr" } },
+                        field: "loc",
+                      },
+                    },
+                    {
+                      id: "8",
+                      listExpr: {
+                        elements: [
+                          { id: "9", constExpr: { stringValue: "GB" } },
+                          { id: "10", constExpr: { stringValue: "US" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "15" },
+      result: { unknown: { exprs: ["6"] } },
+      unknownAttributes: [{ id: 6, variable: "r.attr" }],
+      residualAst:
+        '@in(\n  r^#*expr.Expr_IdentExpr#.attr^#*expr.Expr_SelectExpr#.loc^#*expr.Expr_SelectExpr#,\n  [\n    "GB"^#*expr.Constant_StringValue#,\n    "US"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      residual: 'r.attr.loc in ["GB", "US"]',
+      expectedResidual: 'r.attr.loc in ["GB", "US"]',
+    },
+    {
+      original: {
+        expr: 'users.filter(u, u.role=="MANAGER").map(u, u.name) == r.attr.authorized["managers"]',
+        disableCheck: true,
+        typeEnv: [
+          { name: "r.attr", ident: { type: { dyn: {} } } },
+          { name: "users", ident: { type: { dyn: {} } } },
+        ],
+        bindings: {
+          users: {
+            value: {
+              listValue: {
+                values: [
+                  {
+                    mapValue: {
+                      entries: [
+                        {
+                          key: { stringValue: "name" },
+                          value: { stringValue: "alice" },
+                        },
+                        {
+                          key: { stringValue: "role" },
+                          value: { stringValue: "EMPLOYEE" },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    mapValue: {
+                      entries: [
+                        {
+                          key: { stringValue: "name" },
+                          value: { stringValue: "bob" },
+                        },
+                        {
+                          key: { stringValue: "role" },
+                          value: { stringValue: "MANAGER" },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    mapValue: {
+                      entries: [
+                        {
+                          key: { stringValue: "name" },
+                          value: { stringValue: "eve" },
+                        },
+                        {
+                          key: { stringValue: "role" },
+                          value: { stringValue: "CUSTOMER" },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "r.attr" }],
+      ast: '_==_(\n  __comprehension__(\n    // Variable\n    u,\n    // Target\n    __comprehension__(\n      // Variable\n      u,\n      // Target\n      users^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _?_:_(\n        _==_(\n          u^#*expr.Expr_IdentExpr#.role^#*expr.Expr_SelectExpr#,\n          "MANAGER"^#*expr.Constant_StringValue#\n        )^#*expr.Expr_CallExpr#,\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            u^#*expr.Expr_IdentExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        u^#*expr.Expr_IdentExpr#.name^#*expr.Expr_SelectExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  _[_](\n    r^#*expr.Expr_IdentExpr#.attr^#*expr.Expr_SelectExpr#.authorized^#*expr.Expr_SelectExpr#,\n    "managers"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'users.filter(u, u.role == "MANAGER").map(u, u.name) == r.attr.authorized["managers"]',
+      locationAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    u,\n    // Target\n    __comprehension__(\n      // Variable\n      u,\n      // Target\n      users^#1[1,0]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#8[1,12]#,\n      // LoopCondition\n      true^#9[1,12]#,\n      // LoopStep\n      _?_:_(\n        _==_(\n          u^#4[1,16]#.role^#5[1,17]#,\n          "MANAGER"^#7[1,24]#\n        )^#6[1,22]#,\n        _+_(\n          @result^#10[1,12]#,\n          [\n            u^#3[1,13]#\n          ]^#11[1,12]#\n        )^#12[1,12]#,\n        @result^#13[1,12]#\n      )^#14[1,12]#,\n      // Result\n      @result^#15[1,12]#)^#16[1,12]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#21[1,38]#,\n    // LoopCondition\n    true^#22[1,38]#,\n    // LoopStep\n    _+_(\n      @result^#23[1,38]#,\n      [\n        u^#19[1,42]#.name^#20[1,43]#\n      ]^#24[1,38]#\n    )^#25[1,38]#,\n    // Result\n    @result^#26[1,38]#)^#27[1,38]#,\n  _[_](\n    r^#29[1,53]#.attr^#30[1,54]#.authorized^#31[1,59]#,\n    "managers"^#33[1,71]#\n  )^#32[1,70]#\n)^#28[1,50]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 22, 24, 1, 22, 1, 24],
+        [7, 24, 33, 1, 24, 1, 33],
+        [8, 12, 12, 1, 12, 1, 12],
+        [9, 12, 12, 1, 12, 1, 12],
+        [10, 12, 12, 1, 12, 1, 12],
+        [11, 12, 12, 1, 12, 1, 12],
+        [12, 12, 12, 1, 12, 1, 12],
+        [13, 12, 12, 1, 12, 1, 12],
+        [14, 12, 12, 1, 12, 1, 12],
+        [15, 12, 12, 1, 12, 1, 12],
+        [16, 12, 12, 1, 12, 1, 12],
+        [18, 39, 40, 1, 39, 1, 40],
+        [19, 42, 43, 1, 42, 1, 43],
+        [20, 43, 44, 1, 43, 1, 44],
+        [21, 38, 38, 1, 38, 1, 38],
+        [22, 38, 38, 1, 38, 1, 38],
+        [23, 38, 38, 1, 38, 1, 38],
+        [24, 38, 38, 1, 38, 1, 38],
+        [25, 38, 38, 1, 38, 1, 38],
+        [26, 38, 38, 1, 38, 1, 38],
+        [27, 38, 38, 1, 38, 1, 38],
+        [28, 50, 52, 1, 50, 1, 52],
+        [29, 53, 54, 1, 53, 1, 54],
+        [30, 54, 55, 1, 54, 1, 55],
+        [31, 59, 60, 1, 59, 1, 60],
+        [32, 70, 71, 1, 70, 1, 71],
+        [33, 71, 81, 1, 71, 1, 81],
+      ],
+      lineOffsets: [83],
+      parsedExpr: {
+        expr: {
+          id: "28",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "27",
+                comprehensionExpr: {
+                  iterVar: "u",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "u",
+                      iterRange: { id: "1", identExpr: { name: "users" } },
+                      accuVar: "@result",
+                      accuInit: { id: "8", listExpr: {} },
+                      loopCondition: {
+                        id: "9",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_?_:_",
+                          args: [
+                            {
+                              id: "6",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  {
+                                    id: "5",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "4",
+                                        identExpr: { name: "u" },
+                                      },
+                                      field: "role",
+                                    },
+                                  },
+                                  {
+                                    id: "7",
+                                    constExpr: { stringValue: "MANAGER" },
+                                  },
+                                ],
+                              },
+                            },
+                            {
+                              id: "12",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "10", identExpr: { name: "@result" } },
+                                  {
+                                    id: "11",
+                                    listExpr: {
+                                      elements: [
+                                        { id: "3", identExpr: { name: "u" } },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            { id: "13", identExpr: { name: "@result" } },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "21", listExpr: {} },
+                  loopCondition: { id: "22", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "25",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "23", identExpr: { name: "@result" } },
+                        {
+                          id: "24",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "20",
+                                selectExpr: {
+                                  operand: {
+                                    id: "19",
+                                    identExpr: { name: "u" },
+                                  },
+                                  field: "name",
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "26", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "32",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "31",
+                      selectExpr: {
+                        operand: {
+                          id: "30",
+                          selectExpr: {
+                            operand: { id: "29", identExpr: { name: "r" } },
+                            field: "attr",
+                          },
+                        },
+                        field: "authorized",
+                      },
+                    },
+                    { id: "33", constExpr: { stringValue: "managers" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [83],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 16,
+            "5": 17,
+            "6": 22,
+            "7": 24,
+            "8": 12,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+            "16": 12,
+            "18": 39,
+            "19": 42,
+            "20": 43,
+            "21": 38,
+            "22": 38,
+            "23": 38,
+            "24": 38,
+            "25": 38,
+            "26": 38,
+            "27": 38,
+            "28": 50,
+            "29": 53,
+            "30": 54,
+            "31": 59,
+            "32": 70,
+            "33": 71,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "users" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "u" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "5",
+                          selectExpr: {
+                            operand: { id: "4", identExpr: { name: "u" } },
+                            field: "role",
+                          },
+                        },
+                        { id: "7", constExpr: { stringValue: "MANAGER" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "u" } },
+                  {
+                    id: "20",
+                    selectExpr: {
+                      operand: { id: "19", identExpr: { name: "u" } },
+                      field: "name",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "users" } },
+          args: [
+            { id: "3", identExpr: { name: "u" } },
+            {
+              id: "6",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "4", identExpr: { name: "u" } },
+                      field: "role",
+                    },
+                  },
+                  { id: "7", constExpr: { stringValue: "MANAGER" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 27,
+          function: "map",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "u" } },
+            {
+              id: "20",
+              selectExpr: {
+                operand: { id: "19", identExpr: { name: "u" } },
+                field: "name",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        '_==_(\n  __comprehension__(\n    // Variable\n    u,\n    // Target\n    __comprehension__(\n      // Variable\n      u,\n      // Target\n      users~dyn^users,\n      // Accumulator\n      @result,\n      // Init\n      []~list(dyn),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _?_:_(\n        _==_(\n          u~dyn^u.role~dyn,\n          "MANAGER"~string\n        )~bool^equals,\n        _+_(\n          @result~list(dyn)^@result,\n          [\n            u~dyn^u\n          ]~list(dyn)\n        )~list(dyn)^add_list,\n        @result~list(dyn)^@result\n      )~list(dyn)^conditional,\n      // Result\n      @result~list(dyn)^@result)~list(dyn),\n    // Accumulator\n    @result,\n    // Init\n    []~list(dyn),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(dyn)^@result,\n      [\n        u~dyn^u.name~dyn\n      ]~list(dyn)\n    )~list(dyn)^add_list,\n    // Result\n    @result~list(dyn)^@result)~list(dyn),\n  _[_](\n    r.attr~dyn^r.attr.authorized~dyn,\n    "managers"~string\n  )~dyn^index_map|optional_map_index_value\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "users" },
+          "3": { name: "u" },
+          "4": { name: "u" },
+          "6": { overloadId: ["equals"] },
+          "10": { name: "@result" },
+          "12": { overloadId: ["add_list"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["conditional"] },
+          "15": { name: "@result" },
+          "19": { name: "u" },
+          "23": { name: "@result" },
+          "25": { overloadId: ["add_list"] },
+          "26": { name: "@result" },
+          "28": { overloadId: ["equals"] },
+          "30": { name: "r.attr" },
+          "32": { overloadId: ["index_map", "optional_map_index_value"] },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+          "8": { listType: { elemType: { dyn: {} } } },
+          "9": { primitive: "BOOL" },
+          "10": { listType: { elemType: { dyn: {} } } },
+          "11": { listType: { elemType: { dyn: {} } } },
+          "12": { listType: { elemType: { dyn: {} } } },
+          "13": { listType: { elemType: { dyn: {} } } },
+          "14": { listType: { elemType: { dyn: {} } } },
+          "15": { listType: { elemType: { dyn: {} } } },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "19": { dyn: {} },
+          "20": { dyn: {} },
+          "21": { listType: { elemType: { dyn: {} } } },
+          "22": { primitive: "BOOL" },
+          "23": { listType: { elemType: { dyn: {} } } },
+          "24": { listType: { elemType: { dyn: {} } } },
+          "25": { listType: { elemType: { dyn: {} } } },
+          "26": { listType: { elemType: { dyn: {} } } },
+          "27": { listType: { elemType: { dyn: {} } } },
+          "28": { primitive: "BOOL" },
+          "30": { dyn: {} },
+          "31": { dyn: {} },
+          "32": { dyn: {} },
+          "33": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [83],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 16,
+            "5": 17,
+            "6": 22,
+            "7": 24,
+            "8": 12,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+            "16": 12,
+            "18": 39,
+            "19": 42,
+            "20": 43,
+            "21": 38,
+            "22": 38,
+            "23": 38,
+            "24": 38,
+            "25": 38,
+            "26": 38,
+            "27": 38,
+            "28": 50,
+            "29": 53,
+            "30": 54,
+            "31": 59,
+            "32": 70,
+            "33": 71,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "users" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "u" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "5",
+                          selectExpr: {
+                            operand: { id: "4", identExpr: { name: "u" } },
+                            field: "role",
+                          },
+                        },
+                        { id: "7", constExpr: { stringValue: "MANAGER" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "u" } },
+                  {
+                    id: "20",
+                    selectExpr: {
+                      operand: { id: "19", identExpr: { name: "u" } },
+                      field: "name",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "28",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "27",
+                comprehensionExpr: {
+                  iterVar: "u",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "u",
+                      iterRange: { id: "1", identExpr: { name: "users" } },
+                      accuVar: "@result",
+                      accuInit: { id: "8", listExpr: {} },
+                      loopCondition: {
+                        id: "9",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_?_:_",
+                          args: [
+                            {
+                              id: "6",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  {
+                                    id: "5",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "4",
+                                        identExpr: { name: "u" },
+                                      },
+                                      field: "role",
+                                    },
+                                  },
+                                  {
+                                    id: "7",
+                                    constExpr: { stringValue: "MANAGER" },
+                                  },
+                                ],
+                              },
+                            },
+                            {
+                              id: "12",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "10", identExpr: { name: "@result" } },
+                                  {
+                                    id: "11",
+                                    listExpr: {
+                                      elements: [
+                                        { id: "3", identExpr: { name: "u" } },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            { id: "13", identExpr: { name: "@result" } },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "21", listExpr: {} },
+                  loopCondition: { id: "22", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "25",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "23", identExpr: { name: "@result" } },
+                        {
+                          id: "24",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "20",
+                                selectExpr: {
+                                  operand: {
+                                    id: "19",
+                                    identExpr: { name: "u" },
+                                  },
+                                  field: "name",
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "26", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "32",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    {
+                      id: "31",
+                      selectExpr: {
+                        operand: { id: "30", identExpr: { name: "r.attr" } },
+                        field: "authorized",
+                      },
+                    },
+                    { id: "33", constExpr: { stringValue: "managers" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "26", max: "18446744073709551615" },
+      result: { unknown: { exprs: ["32"] } },
+      unknownAttributes: [{ id: 32, variable: "r.attr" }],
+      residualAst:
+        '_==_(\n  [\n    "bob"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#,\n  _[_](\n    r^#*expr.Expr_IdentExpr#.attr^#*expr.Expr_SelectExpr#.authorized^#*expr.Expr_SelectExpr#,\n    "managers"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      residual: '["bob"] == r.attr.authorized["managers"]',
+      expectedResidual: '["bob"] == r.attr.authorized["managers"]',
+    },
+    {
+      original: {
+        expr: "users.filter(u, u.startsWith(r.attr.prefix))",
+        disableCheck: true,
+        typeEnv: [
+          { name: "r", ident: { type: { dyn: {} } } },
+          { name: "users", ident: { type: { dyn: {} } } },
+        ],
+        bindings: {
+          users: {
+            value: {
+              listValue: {
+                values: [{ stringValue: "alice" }, { stringValue: "bob" }],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [
+        { variable: "r", qualifiers: [{ string: "attr" }, { wildcard: true }] },
+      ],
+      ast: "__comprehension__(\n  // Variable\n  u,\n  // Target\n  users^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    u^#*expr.Expr_IdentExpr#.startsWith(\n      r^#*expr.Expr_IdentExpr#.attr^#*expr.Expr_SelectExpr#.prefix^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        u^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "users.filter(u, u.startsWith(r.attr.prefix))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  u,\n  // Target\n  users^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#9[1,12]#,\n  // LoopCondition\n  true^#10[1,12]#,\n  // LoopStep\n  _?_:_(\n    u^#4[1,16]#.startsWith(\n      r^#6[1,29]#.attr^#7[1,30]#.prefix^#8[1,35]#\n    )^#5[1,28]#,\n    _+_(\n      @result^#11[1,12]#,\n      [\n        u^#3[1,13]#\n      ]^#12[1,12]#\n    )^#13[1,12]#,\n    @result^#14[1,12]#\n  )^#15[1,12]#,\n  // Result\n  @result^#16[1,12]#)^#17[1,12]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 28, 29, 1, 28, 1, 29],
+        [6, 29, 30, 1, 29, 1, 30],
+        [7, 30, 31, 1, 30, 1, 31],
+        [8, 35, 36, 1, 35, 1, 36],
+        [9, 12, 12, 1, 12, 1, 12],
+        [10, 12, 12, 1, 12, 1, 12],
+        [11, 12, 12, 1, 12, 1, 12],
+        [12, 12, 12, 1, 12, 1, 12],
+        [13, 12, 12, 1, 12, 1, 12],
+        [14, 12, 12, 1, 12, 1, 12],
+        [15, 12, 12, 1, 12, 1, 12],
+        [16, 12, 12, 1, 12, 1, 12],
+        [17, 12, 12, 1, 12, 1, 12],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          comprehensionExpr: {
+            iterVar: "u",
+            iterRange: { id: "1", identExpr: { name: "users" } },
+            accuVar: "@result",
+            accuInit: { id: "9", listExpr: {} },
+            loopCondition: { id: "10", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "15",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "u" } },
+                      function: "startsWith",
+                      args: [
+                        {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: { id: "6", identExpr: { name: "r" } },
+                                field: "attr",
+                              },
+                            },
+                            field: "prefix",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "11", identExpr: { name: "@result" } },
+                        {
+                          id: "12",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "u" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "14", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "16", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 16,
+            "5": 28,
+            "6": 29,
+            "7": 30,
+            "8": 35,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+            "16": 12,
+            "17": 12,
+          },
+          macroCalls: {
+            "17": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "users" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "u" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "u" } },
+                      function: "startsWith",
+                      args: [
+                        {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: { id: "6", identExpr: { name: "r" } },
+                                field: "attr",
+                              },
+                            },
+                            field: "prefix",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 17,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "users" } },
+          args: [
+            { id: "3", identExpr: { name: "u" } },
+            {
+              id: "5",
+              callExpr: {
+                target: { id: "4", identExpr: { name: "u" } },
+                function: "startsWith",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: {
+                        id: "7",
+                        selectExpr: {
+                          operand: { id: "6", identExpr: { name: "r" } },
+                          field: "attr",
+                        },
+                      },
+                      field: "prefix",
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  u,\n  // Target\n  users~dyn^users,\n  // Accumulator\n  @result,\n  // Init\n  []~list(dyn),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    u~dyn^u.startsWith(\n      r~dyn^r.attr~dyn.prefix~dyn\n    )~bool^starts_with_string,\n    _+_(\n      @result~list(dyn)^@result,\n      [\n        u~dyn^u\n      ]~list(dyn)\n    )~list(dyn)^add_list,\n    @result~list(dyn)^@result\n  )~list(dyn)^conditional,\n  // Result\n  @result~list(dyn)^@result)~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "users" },
+          "3": { name: "u" },
+          "4": { name: "u" },
+          "5": { overloadId: ["starts_with_string"] },
+          "6": { name: "r" },
+          "11": { name: "@result" },
+          "13": { overloadId: ["add_list"] },
+          "14": { name: "@result" },
+          "15": { overloadId: ["conditional"] },
+          "16": { name: "@result" },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { dyn: {} },
+          "5": { primitive: "BOOL" },
+          "6": { dyn: {} },
+          "7": { dyn: {} },
+          "8": { dyn: {} },
+          "9": { listType: { elemType: { dyn: {} } } },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { dyn: {} } } },
+          "12": { listType: { elemType: { dyn: {} } } },
+          "13": { listType: { elemType: { dyn: {} } } },
+          "14": { listType: { elemType: { dyn: {} } } },
+          "15": { listType: { elemType: { dyn: {} } } },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "17": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 16,
+            "5": 28,
+            "6": 29,
+            "7": 30,
+            "8": 35,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+            "16": 12,
+            "17": 12,
+          },
+          macroCalls: {
+            "17": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "users" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "u" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "u" } },
+                      function: "startsWith",
+                      args: [
+                        {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: { id: "6", identExpr: { name: "r" } },
+                                field: "attr",
+                              },
+                            },
+                            field: "prefix",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "17",
+          comprehensionExpr: {
+            iterVar: "u",
+            iterRange: { id: "1", identExpr: { name: "users" } },
+            accuVar: "@result",
+            accuInit: { id: "9", listExpr: {} },
+            loopCondition: { id: "10", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "15",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "u" } },
+                      function: "startsWith",
+                      args: [
+                        {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: { id: "6", identExpr: { name: "r" } },
+                                field: "attr",
+                              },
+                            },
+                            field: "prefix",
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "11", identExpr: { name: "@result" } },
+                        {
+                          id: "12",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "u" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "14", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "16", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "18446744073709551615" },
+      result: { unknown: { exprs: ["8"] } },
+      unknownAttributes: [
+        {
+          id: 8,
+          variable: "r",
+          qualifiers: [{ string: "attr" }, { string: "prefix" }],
+        },
+      ],
+      residualAst:
+        '__comprehension__(\n  // Variable\n  u,\n  // Target\n  [\n    "alice"^#*expr.Constant_StringValue#,\n    "bob"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    u^#*expr.Expr_IdentExpr#.startsWith(\n      r^#*expr.Expr_IdentExpr#.attr^#*expr.Expr_SelectExpr#.prefix^#*expr.Expr_SelectExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        u^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      residual: '["alice", "bob"].filter(u, u.startsWith(r.attr.prefix))',
+      expectedResidual:
+        '["alice", "bob"].filter(u, u.startsWith(r.attr.prefix))',
+    },
+    {
+      original: {
+        expr: "users.filter(u, r.attr.prefix.endsWith(u))",
+        disableCheck: true,
+        typeEnv: [
+          { name: "r", ident: { type: { dyn: {} } } },
+          { name: "users", ident: { type: { dyn: {} } } },
+        ],
+        bindings: {
+          users: {
+            value: {
+              listValue: {
+                values: [{ stringValue: "alice" }, { stringValue: "bob" }],
+              },
+            },
+          },
+        },
+      },
+      optionalSyntax: true,
+      unknowns: [
+        { variable: "r", qualifiers: [{ string: "attr" }, { wildcard: true }] },
+      ],
+      ast: "__comprehension__(\n  // Variable\n  u,\n  // Target\n  users^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    r^#*expr.Expr_IdentExpr#.attr^#*expr.Expr_SelectExpr#.prefix^#*expr.Expr_SelectExpr#.endsWith(\n      u^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        u^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "users.filter(u, r.attr.prefix.endsWith(u))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  u,\n  // Target\n  users^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#9[1,12]#,\n  // LoopCondition\n  true^#10[1,12]#,\n  // LoopStep\n  _?_:_(\n    r^#4[1,16]#.attr^#5[1,17]#.prefix^#6[1,22]#.endsWith(\n      u^#8[1,39]#\n    )^#7[1,38]#,\n    _+_(\n      @result^#11[1,12]#,\n      [\n        u^#3[1,13]#\n      ]^#12[1,12]#\n    )^#13[1,12]#,\n    @result^#14[1,12]#\n  )^#15[1,12]#,\n  // Result\n  @result^#16[1,12]#)^#17[1,12]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 38, 39, 1, 38, 1, 39],
+        [8, 39, 40, 1, 39, 1, 40],
+        [9, 12, 12, 1, 12, 1, 12],
+        [10, 12, 12, 1, 12, 1, 12],
+        [11, 12, 12, 1, 12, 1, 12],
+        [12, 12, 12, 1, 12, 1, 12],
+        [13, 12, 12, 1, 12, 1, 12],
+        [14, 12, 12, 1, 12, 1, 12],
+        [15, 12, 12, 1, 12, 1, 12],
+        [16, 12, 12, 1, 12, 1, 12],
+        [17, 12, 12, 1, 12, 1, 12],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          comprehensionExpr: {
+            iterVar: "u",
+            iterRange: { id: "1", identExpr: { name: "users" } },
+            accuVar: "@result",
+            accuInit: { id: "9", listExpr: {} },
+            loopCondition: { id: "10", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "15",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "7",
+                    callExpr: {
+                      target: {
+                        id: "6",
+                        selectExpr: {
+                          operand: {
+                            id: "5",
+                            selectExpr: {
+                              operand: { id: "4", identExpr: { name: "r" } },
+                              field: "attr",
+                            },
+                          },
+                          field: "prefix",
+                        },
+                      },
+                      function: "endsWith",
+                      args: [{ id: "8", identExpr: { name: "u" } }],
+                    },
+                  },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "11", identExpr: { name: "@result" } },
+                        {
+                          id: "12",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "u" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "14", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "16", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 16,
+            "5": 17,
+            "6": 22,
+            "7": 38,
+            "8": 39,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+            "16": 12,
+            "17": 12,
+          },
+          macroCalls: {
+            "17": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "users" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "u" } },
+                  {
+                    id: "7",
+                    callExpr: {
+                      target: {
+                        id: "6",
+                        selectExpr: {
+                          operand: {
+                            id: "5",
+                            selectExpr: {
+                              operand: { id: "4", identExpr: { name: "r" } },
+                              field: "attr",
+                            },
+                          },
+                          field: "prefix",
+                        },
+                      },
+                      function: "endsWith",
+                      args: [{ id: "8", identExpr: { name: "u" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 17,
+          function: "filter",
+          target: { id: "1", identExpr: { name: "users" } },
+          args: [
+            { id: "3", identExpr: { name: "u" } },
+            {
+              id: "7",
+              callExpr: {
+                target: {
+                  id: "6",
+                  selectExpr: {
+                    operand: {
+                      id: "5",
+                      selectExpr: {
+                        operand: { id: "4", identExpr: { name: "r" } },
+                        field: "attr",
+                      },
+                    },
+                    field: "prefix",
+                  },
+                },
+                function: "endsWith",
+                args: [{ id: "8", identExpr: { name: "u" } }],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  u,\n  // Target\n  users~dyn^users,\n  // Accumulator\n  @result,\n  // Init\n  []~list(dyn),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    r~dyn^r.attr~dyn.prefix~dyn.endsWith(\n      u~dyn^u\n    )~bool^ends_with_string,\n    _+_(\n      @result~list(dyn)^@result,\n      [\n        u~dyn^u\n      ]~list(dyn)\n    )~list(dyn)^add_list,\n    @result~list(dyn)^@result\n  )~list(dyn)^conditional,\n  // Result\n  @result~list(dyn)^@result)~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "users" },
+          "3": { name: "u" },
+          "4": { name: "r" },
+          "7": { overloadId: ["ends_with_string"] },
+          "8": { name: "u" },
+          "11": { name: "@result" },
+          "13": { overloadId: ["add_list"] },
+          "14": { name: "@result" },
+          "15": { overloadId: ["conditional"] },
+          "16": { name: "@result" },
+        },
+        typeMap: {
+          "1": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { dyn: {} },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "7": { primitive: "BOOL" },
+          "8": { dyn: {} },
+          "9": { listType: { elemType: { dyn: {} } } },
+          "10": { primitive: "BOOL" },
+          "11": { listType: { elemType: { dyn: {} } } },
+          "12": { listType: { elemType: { dyn: {} } } },
+          "13": { listType: { elemType: { dyn: {} } } },
+          "14": { listType: { elemType: { dyn: {} } } },
+          "15": { listType: { elemType: { dyn: {} } } },
+          "16": { listType: { elemType: { dyn: {} } } },
+          "17": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "3": 13,
+            "4": 16,
+            "5": 17,
+            "6": 22,
+            "7": 38,
+            "8": 39,
+            "9": 12,
+            "10": 12,
+            "11": 12,
+            "12": 12,
+            "13": 12,
+            "14": 12,
+            "15": 12,
+            "16": 12,
+            "17": 12,
+          },
+          macroCalls: {
+            "17": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "users" } },
+                function: "filter",
+                args: [
+                  { id: "3", identExpr: { name: "u" } },
+                  {
+                    id: "7",
+                    callExpr: {
+                      target: {
+                        id: "6",
+                        selectExpr: {
+                          operand: {
+                            id: "5",
+                            selectExpr: {
+                              operand: { id: "4", identExpr: { name: "r" } },
+                              field: "attr",
+                            },
+                          },
+                          field: "prefix",
+                        },
+                      },
+                      function: "endsWith",
+                      args: [{ id: "8", identExpr: { name: "u" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "17",
+          comprehensionExpr: {
+            iterVar: "u",
+            iterRange: { id: "1", identExpr: { name: "users" } },
+            accuVar: "@result",
+            accuInit: { id: "9", listExpr: {} },
+            loopCondition: { id: "10", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "15",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "7",
+                    callExpr: {
+                      target: {
+                        id: "6",
+                        selectExpr: {
+                          operand: {
+                            id: "5",
+                            selectExpr: {
+                              operand: { id: "4", identExpr: { name: "r" } },
+                              field: "attr",
+                            },
+                          },
+                          field: "prefix",
+                        },
+                      },
+                      function: "endsWith",
+                      args: [{ id: "8", identExpr: { name: "u" } }],
+                    },
+                  },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "11", identExpr: { name: "@result" } },
+                        {
+                          id: "12",
+                          listExpr: {
+                            elements: [{ id: "3", identExpr: { name: "u" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "14", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "16", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "12", max: "18446744073709551615" },
+      result: { unknown: { exprs: ["6"] } },
+      unknownAttributes: [
+        {
+          id: 6,
+          variable: "r",
+          qualifiers: [{ string: "attr" }, { string: "prefix" }],
+        },
+      ],
+      residualAst:
+        '__comprehension__(\n  // Variable\n  u,\n  // Target\n  [\n    "alice"^#*expr.Constant_StringValue#,\n    "bob"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    r^#*expr.Expr_IdentExpr#.attr^#*expr.Expr_SelectExpr#.prefix^#*expr.Expr_SelectExpr#.endsWith(\n      u^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        u^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      residual: '["alice", "bob"].filter(u, r.attr.prefix.endsWith(u))',
+      expectedResidual: '["alice", "bob"].filter(u, r.attr.prefix.endsWith(u))',
+    },
+    {
+      original: {
+        expr: "[1+3, 2+2, 3+1, four]",
+        disableCheck: true,
+        typeEnv: [{ name: "four", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "four" }],
+      ast: "[\n  _+_(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    2^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _+_(\n    3^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  four^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1 + 3, 2 + 2, 3 + 1, four]",
+      locationAst:
+        "[\n  _+_(\n    1^#2[1,1]#,\n    3^#4[1,3]#\n  )^#3[1,2]#,\n  _+_(\n    2^#5[1,6]#,\n    2^#7[1,8]#\n  )^#6[1,7]#,\n  _+_(\n    3^#8[1,11]#,\n    1^#10[1,13]#\n  )^#9[1,12]#,\n  four^#11[1,16]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 3, 4, 1, 3, 1, 4],
+        [5, 6, 7, 1, 6, 1, 7],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 8, 9, 1, 8, 1, 9],
+        [8, 11, 12, 1, 11, 1, 12],
+        [9, 12, 13, 1, 12, 1, 13],
+        [10, 13, 14, 1, 13, 1, 14],
+        [11, 16, 20, 1, 16, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "11", identExpr: { name: "four" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 3,
+            "5": 6,
+            "6": 7,
+            "7": 8,
+            "8": 11,
+            "9": 12,
+            "10": 13,
+            "11": 16,
+          },
+        },
+      },
+      checkedAst:
+        "[\n  _+_(\n    1~int,\n    3~int\n  )~int^add_int64,\n  _+_(\n    2~int,\n    2~int\n  )~int^add_int64,\n  _+_(\n    3~int,\n    1~int\n  )~int^add_int64,\n  four~dyn^four\n]~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["add_int64"] },
+          "6": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+          "11": { name: "four" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 3,
+            "5": 6,
+            "6": 7,
+            "7": 8,
+            "8": 11,
+            "9": 12,
+            "10": 13,
+            "11": 16,
+          },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "8", constExpr: { int64Value: "3" } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "11", identExpr: { name: "four" } },
+            ],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "14", max: "14" },
+      result: { unknown: { exprs: ["11"] } },
+      unknownAttributes: [{ id: 11, variable: "four" }],
+      residualAst:
+        "[\n  4^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#,\n  4^#*expr.Constant_Int64Value#,\n  four^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      residual: "[4, 4, 4, four]",
+      expectedResidual: "[4, 4, 4, four]",
+    },
+    {
+      original: {
+        expr: "[1+3, 2+2, 3+1, four].exists(x, x == four)",
+        disableCheck: true,
+        typeEnv: [{ name: "four", ident: { type: { dyn: {} } } }],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "four" }],
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _+_(\n      1^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      2^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      3^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    four^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      four^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1 + 3, 2 + 2, 3 + 1, four].exists(x, x == four)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _+_(\n      1^#2[1,1]#,\n      3^#4[1,3]#\n    )^#3[1,2]#,\n    _+_(\n      2^#5[1,6]#,\n      2^#7[1,8]#\n    )^#6[1,7]#,\n    _+_(\n      3^#8[1,11]#,\n      1^#10[1,13]#\n    )^#9[1,12]#,\n    four^#11[1,16]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#17[1,28]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#18[1,28]#\n    )^#19[1,28]#\n  )^#20[1,28]#,\n  // LoopStep\n  _||_(\n    @result^#21[1,28]#,\n    _==_(\n      x^#14[1,32]#,\n      four^#16[1,37]#\n    )^#15[1,34]#\n  )^#22[1,28]#,\n  // Result\n  @result^#23[1,28]#)^#24[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 3, 4, 1, 3, 1, 4],
+        [5, 6, 7, 1, 6, 1, 7],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 8, 9, 1, 8, 1, 9],
+        [8, 11, 12, 1, 11, 1, 12],
+        [9, 12, 13, 1, 12, 1, 13],
+        [10, 13, 14, 1, 13, 1, 14],
+        [11, 16, 20, 1, 16, 1, 20],
+        [13, 29, 30, 1, 29, 1, 30],
+        [14, 32, 33, 1, 32, 1, 33],
+        [15, 34, 36, 1, 34, 1, 36],
+        [16, 37, 41, 1, 37, 1, 41],
+        [17, 28, 28, 1, 28, 1, 28],
+        [18, 28, 28, 1, 28, 1, 28],
+        [19, 28, 28, 1, 28, 1, 28],
+        [20, 28, 28, 1, 28, 1, 28],
+        [21, 28, 28, 1, 28, 1, 28],
+        [22, 28, 28, 1, 28, 1, 28],
+        [23, 28, 28, 1, 28, 1, 28],
+        [24, 28, 28, 1, 28, 1, 28],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "24",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "5", constExpr: { int64Value: "2" } },
+                        { id: "7", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "8", constExpr: { int64Value: "3" } },
+                        { id: "10", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  { id: "11", identExpr: { name: "four" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "20",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "18", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "22",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "21", identExpr: { name: "@result" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "14", identExpr: { name: "x" } },
+                        { id: "16", identExpr: { name: "four" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "23", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 3,
+            "5": 6,
+            "6": 7,
+            "7": 8,
+            "8": 11,
+            "9": 12,
+            "10": 13,
+            "11": 16,
+            "13": 29,
+            "14": 32,
+            "15": 34,
+            "16": 37,
+            "17": 28,
+            "18": 28,
+            "19": 28,
+            "20": 28,
+            "21": 28,
+            "22": 28,
+            "23": 28,
+            "24": 28,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "3",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "5", constExpr: { int64Value: "2" } },
+                            { id: "7", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "8", constExpr: { int64Value: "3" } },
+                            { id: "10", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      { id: "11", identExpr: { name: "four" } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "13", identExpr: { name: "x" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "14", identExpr: { name: "x" } },
+                        { id: "16", identExpr: { name: "four" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 24,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "3",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                {
+                  id: "6",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "5", constExpr: { int64Value: "2" } },
+                      { id: "7", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                {
+                  id: "9",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "8", constExpr: { int64Value: "3" } },
+                      { id: "10", constExpr: { int64Value: "1" } },
+                    ],
+                  },
+                },
+                { id: "11", identExpr: { name: "four" } },
+              ],
+            },
+          },
+          args: [
+            { id: "13", identExpr: { name: "x" } },
+            {
+              id: "15",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "14", identExpr: { name: "x" } },
+                  { id: "16", identExpr: { name: "four" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _+_(\n      1~int,\n      3~int\n    )~int^add_int64,\n    _+_(\n      2~int,\n      2~int\n    )~int^add_int64,\n    _+_(\n      3~int,\n      1~int\n    )~int^add_int64,\n    four~dyn^four\n  ]~list(dyn),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      x~dyn^x,\n      four~dyn^four\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "3": { overloadId: ["add_int64"] },
+          "6": { overloadId: ["add_int64"] },
+          "9": { overloadId: ["add_int64"] },
+          "11": { name: "four" },
+          "14": { name: "x" },
+          "15": { overloadId: ["equals"] },
+          "16": { name: "four" },
+          "18": { name: "@result" },
+          "19": { overloadId: ["logical_not"] },
+          "20": { overloadId: ["not_strictly_false"] },
+          "21": { name: "@result" },
+          "22": { overloadId: ["logical_or"] },
+          "23": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { dyn: {} },
+          "14": { dyn: {} },
+          "15": { primitive: "BOOL" },
+          "16": { dyn: {} },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 3,
+            "5": 6,
+            "6": 7,
+            "7": 8,
+            "8": 11,
+            "9": 12,
+            "10": 13,
+            "11": 16,
+            "13": 29,
+            "14": 32,
+            "15": 34,
+            "16": 37,
+            "17": 28,
+            "18": 28,
+            "19": 28,
+            "20": 28,
+            "21": 28,
+            "22": 28,
+            "23": 28,
+            "24": 28,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "3",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "5", constExpr: { int64Value: "2" } },
+                            { id: "7", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "8", constExpr: { int64Value: "3" } },
+                            { id: "10", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      { id: "11", identExpr: { name: "four" } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "13", identExpr: { name: "x" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "14", identExpr: { name: "x" } },
+                        { id: "16", identExpr: { name: "four" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "24",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "2", constExpr: { int64Value: "1" } },
+                        { id: "4", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "5", constExpr: { int64Value: "2" } },
+                        { id: "7", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "9",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "8", constExpr: { int64Value: "3" } },
+                        { id: "10", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  { id: "11", identExpr: { name: "four" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "20",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "18", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "22",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "21", identExpr: { name: "@result" } },
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "14", identExpr: { name: "x" } },
+                        { id: "16", identExpr: { name: "four" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "23", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "31", max: "7378697629483821095" },
+      result: { unknown: { exprs: ["11"] } },
+      unknownAttributes: [{ id: 11, variable: "four" }],
+      residualAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    4^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    four^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      four^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      residual: "[4, 4, 4, four].exists(x, x == four)",
+      expectedResidual: "[4, 4, 4, four].exists(x, x == four)",
+    },
+    {
+      original: {
+        expr: "[has(a.b), has(c.d)].exists(x, x == true)",
+        disableCheck: true,
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "c", ident: { type: { dyn: {} } } },
+        ],
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "a" }, { variable: "c" }],
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n    c^#*expr.Expr_IdentExpr#.d~test-only~^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[has(a.b), has(c.d)].exists(x, x == true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a^#3[1,5]#.b~test-only~^#5[1,4]#,\n    c^#7[1,15]#.d~test-only~^#9[1,14]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#15[1,27]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#16[1,27]#\n    )^#17[1,27]#\n  )^#18[1,27]#,\n  // LoopStep\n  _||_(\n    @result^#19[1,27]#,\n    _==_(\n      x^#12[1,31]#,\n      true^#14[1,36]#\n    )^#13[1,33]#\n  )^#20[1,27]#,\n  // Result\n  @result^#21[1,27]#)^#22[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 4, 4, 1, 4, 1, 4],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 14, 14, 1, 14, 1, 14],
+        [11, 28, 29, 1, 28, 1, 29],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 33, 35, 1, 33, 1, 35],
+        [14, 36, 40, 1, 36, 1, 40],
+        [15, 27, 27, 1, 27, 1, 27],
+        [16, 27, 27, 1, 27, 1, 27],
+        [17, 27, 27, 1, 27, 1, 27],
+        [18, 27, 27, 1, 27, 1, 27],
+        [19, 27, 27, 1, 27, 1, 27],
+        [20, 27, 27, 1, 27, 1, 27],
+        [21, 27, 27, 1, 27, 1, 27],
+        [22, 27, 27, 1, 27, 1, 27],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "18",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "16", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "19", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "7": 15,
+            "8": 16,
+            "9": 14,
+            "11": 28,
+            "12": 31,
+            "13": 33,
+            "14": 36,
+            "15": 27,
+            "16": 27,
+            "17": 27,
+            "18": 27,
+            "19": 27,
+            "20": 27,
+            "21": 27,
+            "22": 27,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                    },
+                  },
+                ],
+              },
+            },
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+                },
+                function: "exists",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: { id: "3", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "c" } },
+                field: "d",
+              },
+            },
+          ],
+        },
+        {
+          id: 22,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+          },
+          args: [
+            { id: "11", identExpr: { name: "x" } },
+            {
+              id: "13",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "12", identExpr: { name: "x" } },
+                  { id: "14", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a~dyn^a.b~test-only~~bool,\n    c~dyn^c.d~test-only~~bool\n  ]~list(bool),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      x~bool^x,\n      true~bool\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "7": { name: "c" },
+          "12": { name: "x" },
+          "13": { overloadId: ["equals"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_not"] },
+          "18": { overloadId: ["not_strictly_false"] },
+          "19": { name: "@result" },
+          "20": { overloadId: ["logical_or"] },
+          "21": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "BOOL" } } },
+          "3": { dyn: {} },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "7": 15,
+            "8": 16,
+            "9": 14,
+            "11": 28,
+            "12": 31,
+            "13": 33,
+            "14": 36,
+            "15": 27,
+            "16": 27,
+            "17": 27,
+            "18": 27,
+            "19": 27,
+            "20": 27,
+            "21": 27,
+            "22": 27,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                    },
+                  },
+                ],
+              },
+            },
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+                },
+                function: "exists",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "18",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "16", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "19", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "27" },
+      result: { unknown: { exprs: ["5"] } },
+      unknownAttributes: [{ id: 5, variable: "a" }],
+      residualAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n    c^#*expr.Expr_IdentExpr#.d~test-only~^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      residual: "[has(a.b), has(c.d)].exists(x, x == true)",
+      expectedResidual: "[has(a.b), has(c.d)].exists(x, x == true)",
+    },
+    {
+      original: {
+        expr: "[has(a.b), has(c.d)].exists(x, x == true)",
+        disableCheck: true,
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "c", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { a: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "c" }],
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n    c^#*expr.Expr_IdentExpr#.d~test-only~^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[has(a.b), has(c.d)].exists(x, x == true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a^#3[1,5]#.b~test-only~^#5[1,4]#,\n    c^#7[1,15]#.d~test-only~^#9[1,14]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#15[1,27]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#16[1,27]#\n    )^#17[1,27]#\n  )^#18[1,27]#,\n  // LoopStep\n  _||_(\n    @result^#19[1,27]#,\n    _==_(\n      x^#12[1,31]#,\n      true^#14[1,36]#\n    )^#13[1,33]#\n  )^#20[1,27]#,\n  // Result\n  @result^#21[1,27]#)^#22[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 4, 4, 1, 4, 1, 4],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 14, 14, 1, 14, 1, 14],
+        [11, 28, 29, 1, 28, 1, 29],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 33, 35, 1, 33, 1, 35],
+        [14, 36, 40, 1, 36, 1, 40],
+        [15, 27, 27, 1, 27, 1, 27],
+        [16, 27, 27, 1, 27, 1, 27],
+        [17, 27, 27, 1, 27, 1, 27],
+        [18, 27, 27, 1, 27, 1, 27],
+        [19, 27, 27, 1, 27, 1, 27],
+        [20, 27, 27, 1, 27, 1, 27],
+        [21, 27, 27, 1, 27, 1, 27],
+        [22, 27, 27, 1, 27, 1, 27],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "18",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "16", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "19", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "7": 15,
+            "8": 16,
+            "9": 14,
+            "11": 28,
+            "12": 31,
+            "13": 33,
+            "14": 36,
+            "15": 27,
+            "16": 27,
+            "17": 27,
+            "18": 27,
+            "19": 27,
+            "20": 27,
+            "21": 27,
+            "22": 27,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                    },
+                  },
+                ],
+              },
+            },
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+                },
+                function: "exists",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: { id: "3", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "c" } },
+                field: "d",
+              },
+            },
+          ],
+        },
+        {
+          id: 22,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+          },
+          args: [
+            { id: "11", identExpr: { name: "x" } },
+            {
+              id: "13",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "12", identExpr: { name: "x" } },
+                  { id: "14", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a~dyn^a.b~test-only~~bool,\n    c~dyn^c.d~test-only~~bool\n  ]~list(bool),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      x~bool^x,\n      true~bool\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "7": { name: "c" },
+          "12": { name: "x" },
+          "13": { overloadId: ["equals"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_not"] },
+          "18": { overloadId: ["not_strictly_false"] },
+          "19": { name: "@result" },
+          "20": { overloadId: ["logical_or"] },
+          "21": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "BOOL" } } },
+          "3": { dyn: {} },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "7": 15,
+            "8": 16,
+            "9": 14,
+            "11": 28,
+            "12": 31,
+            "13": 33,
+            "14": 36,
+            "15": 27,
+            "16": 27,
+            "17": 27,
+            "18": 27,
+            "19": 27,
+            "20": 27,
+            "21": 27,
+            "22": 27,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                    },
+                  },
+                ],
+              },
+            },
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+                },
+                function: "exists",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "18",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "16", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "19", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "27" },
+      result: { unknown: { exprs: ["9"] } },
+      unknownAttributes: [{ id: 9, variable: "c" }],
+      residualAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    false^#*expr.Constant_BoolValue#,\n    c^#*expr.Expr_IdentExpr#.d~test-only~^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      residual: "[false, has(c.d)].exists(x, x == true)",
+      expectedResidual: "[false, has(c.d)].exists(x, x == true)",
+    },
+    {
+      original: {
+        expr: "[has(a.b), has(c.d)].exists(x, x == true)",
+        disableCheck: true,
+        typeEnv: [
+          { name: "a", ident: { type: { dyn: {} } } },
+          { name: "c", ident: { type: { dyn: {} } } },
+        ],
+        bindings: { a: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "c" }],
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#,\n    c^#*expr.Expr_IdentExpr#.d~test-only~^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[has(a.b), has(c.d)].exists(x, x == true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a^#3[1,5]#.b~test-only~^#5[1,4]#,\n    c^#7[1,15]#.d~test-only~^#9[1,14]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#15[1,27]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#16[1,27]#\n    )^#17[1,27]#\n  )^#18[1,27]#,\n  // LoopStep\n  _||_(\n    @result^#19[1,27]#,\n    _==_(\n      x^#12[1,31]#,\n      true^#14[1,36]#\n    )^#13[1,33]#\n  )^#20[1,27]#,\n  // Result\n  @result^#21[1,27]#)^#22[1,27]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 4, 4, 1, 4, 1, 4],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 14, 14, 1, 14, 1, 14],
+        [11, 28, 29, 1, 28, 1, 29],
+        [12, 31, 32, 1, 31, 1, 32],
+        [13, 33, 35, 1, 33, 1, 35],
+        [14, 36, 40, 1, 36, 1, 40],
+        [15, 27, 27, 1, 27, 1, 27],
+        [16, 27, 27, 1, 27, 1, 27],
+        [17, 27, 27, 1, 27, 1, 27],
+        [18, 27, 27, 1, 27, 1, 27],
+        [19, 27, 27, 1, 27, 1, 27],
+        [20, 27, 27, 1, 27, 1, 27],
+        [21, 27, 27, 1, 27, 1, 27],
+        [22, 27, 27, 1, 27, 1, 27],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "18",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "16", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "19", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "7": 15,
+            "8": 16,
+            "9": 14,
+            "11": 28,
+            "12": 31,
+            "13": 33,
+            "14": 36,
+            "15": 27,
+            "16": 27,
+            "17": 27,
+            "18": 27,
+            "19": 27,
+            "20": 27,
+            "21": 27,
+            "22": 27,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                    },
+                  },
+                ],
+              },
+            },
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+                },
+                function: "exists",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: { id: "3", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+          ],
+        },
+        {
+          id: 9,
+          function: "has",
+          args: [
+            {
+              id: "8",
+              selectExpr: {
+                operand: { id: "7", identExpr: { name: "c" } },
+                field: "d",
+              },
+            },
+          ],
+        },
+        {
+          id: 22,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+          },
+          args: [
+            { id: "11", identExpr: { name: "x" } },
+            {
+              id: "13",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "12", identExpr: { name: "x" } },
+                  { id: "14", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a~dyn^a.b~test-only~~bool,\n    c~dyn^c.d~test-only~~bool\n  ]~list(bool),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      x~bool^x,\n      true~bool\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "3": { name: "a" },
+          "7": { name: "c" },
+          "12": { name: "x" },
+          "13": { overloadId: ["equals"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_not"] },
+          "18": { overloadId: ["not_strictly_false"] },
+          "19": { name: "@result" },
+          "20": { overloadId: ["logical_or"] },
+          "21": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "BOOL" } } },
+          "3": { dyn: {} },
+          "5": { primitive: "BOOL" },
+          "7": { dyn: {} },
+          "9": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "3": 5,
+            "4": 6,
+            "5": 4,
+            "7": 15,
+            "8": 16,
+            "9": 14,
+            "11": 28,
+            "12": 31,
+            "13": 33,
+            "14": 36,
+            "15": 27,
+            "16": 27,
+            "17": 27,
+            "18": 27,
+            "19": 27,
+            "20": 27,
+            "21": 27,
+            "22": 27,
+          },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            "9": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "8",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                    },
+                  },
+                ],
+              },
+            },
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: { elements: [{ id: "5" }, { id: "9" }] },
+                },
+                function: "exists",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "5",
+                    selectExpr: {
+                      operand: { id: "3", identExpr: { name: "a" } },
+                      field: "b",
+                      testOnly: true,
+                    },
+                  },
+                  {
+                    id: "9",
+                    selectExpr: {
+                      operand: { id: "7", identExpr: { name: "c" } },
+                      field: "d",
+                      testOnly: true,
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "18",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "16", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "19", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "27" },
+      result: { unknown: { exprs: ["9"] } },
+      unknownAttributes: [{ id: 9, variable: "c" }],
+      residualAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    false^#*expr.Constant_BoolValue#,\n    c^#*expr.Expr_IdentExpr#.d~test-only~^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      residual: "[false, has(c.d)].exists(x, x == true)",
+      expectedResidual: "[false, has(c.d)].exists(x, x == true)",
+    },
+    {
+      original: {
+        expr: "[?a[?0], a.b]",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+        bindings: { a: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      ast: "[\n  _[?_](\n    a^#*expr.Expr_IdentExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[?a[?0], a.b]",
+      locationAst:
+        "[\n  _[?_](\n    a^#2[1,2]#,\n    0^#4[1,5]#\n  )^#3[1,3]#,\n  a^#5[1,9]#.b^#6[1,10]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_[?_]",
+                  args: [
+                    { id: "2", identExpr: { name: "a" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                selectExpr: {
+                  operand: { id: "5", identExpr: { name: "a" } },
+                  field: "b",
+                },
+              },
+            ],
+            optionalIndices: [0],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 2, "3": 3, "4": 5, "5": 9, "6": 10 },
+        },
+      },
+      checkedAst:
+        "[\n  _[?_](\n    a~dyn^a,\n    0~int\n  )~dyn^list_optindex_optional_int|map_optindex_optional_value|optional_list_optindex_optional_int|optional_map_optindex_optional_value,\n  a~dyn^a.b~dyn\n]~list(dyn)",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "a" },
+          "3": {
+            overloadId: [
+              "list_optindex_optional_int",
+              "map_optindex_optional_value",
+              "optional_list_optindex_optional_int",
+              "optional_map_optindex_optional_value",
+            ],
+          },
+          "5": { name: "a" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 2, "3": 3, "4": 5, "5": 9, "6": 10 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_[?_]",
+                  args: [
+                    { id: "2", identExpr: { name: "a" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                selectExpr: {
+                  operand: { id: "5", identExpr: { name: "a" } },
+                  field: "b",
+                },
+              },
+            ],
+            optionalIndices: [0],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "13", max: "13" },
+      result: { error: { errors: [{ code: 2, message: "no such key: b" }] } },
+      residualAst:
+        "[\n  a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n]^#*expr.Expr_ListExpr#",
+      residual: "[a.b]",
+      expectedResidual: "[a.b]",
+    },
+    {
+      original: {
+        expr: "[?a[?0], a.b].exists(x, x == true)",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+        bindings: { a: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      unknowns: [{ variable: "a" }],
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[?_](\n      a^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[?a[?0], a.b].exists(x, x == true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[?_](\n      a^#2[1,2]#,\n      0^#4[1,5]#\n    )^#3[1,3]#,\n    a^#5[1,9]#.b^#6[1,10]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#12[1,20]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#13[1,20]#\n    )^#14[1,20]#\n  )^#15[1,20]#,\n  // LoopStep\n  _||_(\n    @result^#16[1,20]#,\n    _==_(\n      x^#9[1,24]#,\n      true^#11[1,29]#\n    )^#10[1,26]#\n  )^#17[1,20]#,\n  // Result\n  @result^#18[1,20]#)^#19[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 26, 28, 1, 26, 1, 28],
+        [11, 29, 33, 1, 29, 1, 33],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 20, 20, 1, 20, 1, 20],
+        [16, 20, 20, 1, 20, 1, 20],
+        [17, 20, 20, 1, 20, 1, 20],
+        [18, 20, 20, 1, 20, 1, 20],
+        [19, 20, 20, 1, 20, 1, 20],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_[?_]",
+                      args: [
+                        { id: "2", identExpr: { name: "a" } },
+                        { id: "4", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: { id: "5", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+                optionalIndices: [0],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "13", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 3,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "8": 21,
+            "9": 24,
+            "10": 26,
+            "11": 29,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "3",
+                        callExpr: {
+                          function: "_[?_]",
+                          args: [
+                            { id: "2", identExpr: { name: "a" } },
+                            { id: "4", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        selectExpr: {
+                          operand: { id: "5", identExpr: { name: "a" } },
+                          field: "b",
+                        },
+                      },
+                    ],
+                    optionalIndices: [0],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "3",
+                  callExpr: {
+                    function: "_[?_]",
+                    args: [
+                      { id: "2", identExpr: { name: "a" } },
+                      { id: "4", constExpr: { int64Value: "0" } },
+                    ],
+                  },
+                },
+                {
+                  id: "6",
+                  selectExpr: {
+                    operand: { id: "5", identExpr: { name: "a" } },
+                    field: "b",
+                  },
+                },
+              ],
+              optionalIndices: [0],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "9", identExpr: { name: "x" } },
+                  { id: "11", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[?_](\n      a~dyn^a,\n      0~int\n    )~dyn^list_optindex_optional_int|map_optindex_optional_value|optional_list_optindex_optional_int|optional_map_optindex_optional_value,\n    a~dyn^a.b~dyn\n  ]~list(dyn),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      x~dyn^x,\n      true~bool\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "a" },
+          "3": {
+            overloadId: [
+              "list_optindex_optional_int",
+              "map_optindex_optional_value",
+              "optional_list_optindex_optional_int",
+              "optional_map_optindex_optional_value",
+            ],
+          },
+          "5": { name: "a" },
+          "9": { name: "x" },
+          "10": { overloadId: ["equals"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_not"] },
+          "15": { overloadId: ["not_strictly_false"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_or"] },
+          "18": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 3,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "8": 21,
+            "9": 24,
+            "10": 26,
+            "11": 29,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "3",
+                        callExpr: {
+                          function: "_[?_]",
+                          args: [
+                            { id: "2", identExpr: { name: "a" } },
+                            { id: "4", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        selectExpr: {
+                          operand: { id: "5", identExpr: { name: "a" } },
+                          field: "b",
+                        },
+                      },
+                    ],
+                    optionalIndices: [0],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_[?_]",
+                      args: [
+                        { id: "2", identExpr: { name: "a" } },
+                        { id: "4", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: { id: "5", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+                optionalIndices: [0],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "13", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "26" },
+      result: { unknown: { exprs: ["3"] } },
+      unknownAttributes: [{ id: 3, variable: "a" }],
+      residualAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[?_](\n      a^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      residual: "[?a[?0], a.b].exists(x, x == true)",
+      expectedResidual: "[?a[?0], a.b].exists(x, x == true)",
+    },
+    {
+      original: {
+        expr: "[?a[?0], a.b].exists(x, x == true)",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+        bindings: { a: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[?_](\n      a^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[?a[?0], a.b].exists(x, x == true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[?_](\n      a^#2[1,2]#,\n      0^#4[1,5]#\n    )^#3[1,3]#,\n    a^#5[1,9]#.b^#6[1,10]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#12[1,20]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#13[1,20]#\n    )^#14[1,20]#\n  )^#15[1,20]#,\n  // LoopStep\n  _||_(\n    @result^#16[1,20]#,\n    _==_(\n      x^#9[1,24]#,\n      true^#11[1,29]#\n    )^#10[1,26]#\n  )^#17[1,20]#,\n  // Result\n  @result^#18[1,20]#)^#19[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 10, 11, 1, 10, 1, 11],
+        [8, 21, 22, 1, 21, 1, 22],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 26, 28, 1, 26, 1, 28],
+        [11, 29, 33, 1, 29, 1, 33],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 20, 20, 1, 20, 1, 20],
+        [16, 20, 20, 1, 20, 1, 20],
+        [17, 20, 20, 1, 20, 1, 20],
+        [18, 20, 20, 1, 20, 1, 20],
+        [19, 20, 20, 1, 20, 1, 20],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_[?_]",
+                      args: [
+                        { id: "2", identExpr: { name: "a" } },
+                        { id: "4", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: { id: "5", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+                optionalIndices: [0],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "13", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 3,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "8": 21,
+            "9": 24,
+            "10": 26,
+            "11": 29,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "3",
+                        callExpr: {
+                          function: "_[?_]",
+                          args: [
+                            { id: "2", identExpr: { name: "a" } },
+                            { id: "4", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        selectExpr: {
+                          operand: { id: "5", identExpr: { name: "a" } },
+                          field: "b",
+                        },
+                      },
+                    ],
+                    optionalIndices: [0],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "3",
+                  callExpr: {
+                    function: "_[?_]",
+                    args: [
+                      { id: "2", identExpr: { name: "a" } },
+                      { id: "4", constExpr: { int64Value: "0" } },
+                    ],
+                  },
+                },
+                {
+                  id: "6",
+                  selectExpr: {
+                    operand: { id: "5", identExpr: { name: "a" } },
+                    field: "b",
+                  },
+                },
+              ],
+              optionalIndices: [0],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "9", identExpr: { name: "x" } },
+                  { id: "11", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[?_](\n      a~dyn^a,\n      0~int\n    )~dyn^list_optindex_optional_int|map_optindex_optional_value|optional_list_optindex_optional_int|optional_map_optindex_optional_value,\n    a~dyn^a.b~dyn\n  ]~list(dyn),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      x~dyn^x,\n      true~bool\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "a" },
+          "3": {
+            overloadId: [
+              "list_optindex_optional_int",
+              "map_optindex_optional_value",
+              "optional_list_optindex_optional_int",
+              "optional_map_optindex_optional_value",
+            ],
+          },
+          "5": { name: "a" },
+          "9": { name: "x" },
+          "10": { overloadId: ["equals"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_not"] },
+          "15": { overloadId: ["not_strictly_false"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_or"] },
+          "18": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 3,
+            "4": 5,
+            "5": 9,
+            "6": 10,
+            "8": 21,
+            "9": 24,
+            "10": 26,
+            "11": 29,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "3",
+                        callExpr: {
+                          function: "_[?_]",
+                          args: [
+                            { id: "2", identExpr: { name: "a" } },
+                            { id: "4", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        selectExpr: {
+                          operand: { id: "5", identExpr: { name: "a" } },
+                          field: "b",
+                        },
+                      },
+                    ],
+                    optionalIndices: [0],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_[?_]",
+                      args: [
+                        { id: "2", identExpr: { name: "a" } },
+                        { id: "4", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: { id: "5", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+                optionalIndices: [0],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "13", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "26" },
+      result: { error: { errors: [{ code: 2, message: "no such key: b" }] } },
+      residualAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      residual: "[a.b].exists(x, x == true)",
+      expectedResidual: "[a.b].exists(x, x == true)",
+    },
+    {
+      original: {
+        expr: "[a[0], a.b].exists(x, x == true)",
+        disableCheck: true,
+        typeEnv: [{ name: "a", ident: { type: { dyn: {} } } }],
+        bindings: { a: { value: { mapValue: {} } } },
+      },
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[_](\n      a^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[a[0], a.b].exists(x, x == true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[_](\n      a^#2[1,1]#,\n      0^#4[1,3]#\n    )^#3[1,2]#,\n    a^#5[1,7]#.b^#6[1,8]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#12[1,18]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#13[1,18]#\n    )^#14[1,18]#\n  )^#15[1,18]#,\n  // LoopStep\n  _||_(\n    @result^#16[1,18]#,\n    _==_(\n      x^#9[1,22]#,\n      true^#11[1,27]#\n    )^#10[1,24]#\n  )^#17[1,18]#,\n  // Result\n  @result^#18[1,18]#)^#19[1,18]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 3, 4, 1, 3, 1, 4],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 8, 9, 1, 8, 1, 9],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 24, 26, 1, 24, 1, 26],
+        [11, 27, 31, 1, 27, 1, 31],
+        [12, 18, 18, 1, 18, 1, 18],
+        [13, 18, 18, 1, 18, 1, 18],
+        [14, 18, 18, 1, 18, 1, 18],
+        [15, 18, 18, 1, 18, 1, 18],
+        [16, 18, 18, 1, 18, 1, 18],
+        [17, 18, 18, 1, 18, 1, 18],
+        [18, 18, 18, 1, 18, 1, 18],
+        [19, 18, 18, 1, 18, 1, 18],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "2", identExpr: { name: "a" } },
+                        { id: "4", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: { id: "5", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "13", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 3,
+            "5": 7,
+            "6": 8,
+            "8": 19,
+            "9": 22,
+            "10": 24,
+            "11": 27,
+            "12": 18,
+            "13": 18,
+            "14": 18,
+            "15": 18,
+            "16": 18,
+            "17": 18,
+            "18": 18,
+            "19": 18,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "3",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "2", identExpr: { name: "a" } },
+                            { id: "4", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        selectExpr: {
+                          operand: { id: "5", identExpr: { name: "a" } },
+                          field: "b",
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "3",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "2", identExpr: { name: "a" } },
+                      { id: "4", constExpr: { int64Value: "0" } },
+                    ],
+                  },
+                },
+                {
+                  id: "6",
+                  selectExpr: {
+                    operand: { id: "5", identExpr: { name: "a" } },
+                    field: "b",
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "9", identExpr: { name: "x" } },
+                  { id: "11", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[_](\n      a~dyn^a,\n      0~int\n    )~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value,\n    a~dyn^a.b~dyn\n  ]~list(dyn),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      x~dyn^x,\n      true~bool\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "2": { name: "a" },
+          "3": {
+            overloadId: [
+              "index_list",
+              "index_map",
+              "optional_list_index_int",
+              "optional_map_index_value",
+            ],
+          },
+          "5": { name: "a" },
+          "9": { name: "x" },
+          "10": { overloadId: ["equals"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_not"] },
+          "15": { overloadId: ["not_strictly_false"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_or"] },
+          "18": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { dyn: {} },
+          "3": { dyn: {} },
+          "4": { primitive: "INT64" },
+          "5": { dyn: {} },
+          "6": { dyn: {} },
+          "9": { dyn: {} },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 3,
+            "5": 7,
+            "6": 8,
+            "8": 19,
+            "9": 22,
+            "10": 24,
+            "11": 27,
+            "12": 18,
+            "13": 18,
+            "14": 18,
+            "15": 18,
+            "16": 18,
+            "17": 18,
+            "18": 18,
+            "19": 18,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "3",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "2", identExpr: { name: "a" } },
+                            { id: "4", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        selectExpr: {
+                          operand: { id: "5", identExpr: { name: "a" } },
+                          field: "b",
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "2", identExpr: { name: "a" } },
+                        { id: "4", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: { id: "5", identExpr: { name: "a" } },
+                      field: "b",
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "12", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "13", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { boolValue: true } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "26" },
+      result: { error: { errors: [{ code: 2, message: "no such key: 0" }] } },
+      residualAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    _[_](\n      a^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      x^#*expr.Expr_IdentExpr#,\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      residual: "[a[0], a.b].exists(x, x == true)",
+      expectedResidual: "[a[0], a.b].exists(x, x == true)",
+    },
+  ],
+} as const;
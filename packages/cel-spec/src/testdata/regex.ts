@@ -0,0 +1,8035 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/regex_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "regex",
+  tests: [
+    {
+      original: {
+        expr: "regex.replace('abc', '^', 'start_') == 'start_abc'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "abc"^#*expr.Constant_StringValue#,\n    "^"^#*expr.Constant_StringValue#,\n    "start_"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "start_abc"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("abc", "^", "start_") == "start_abc"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "abc"^#3[1,14]#,\n    "^"^#4[1,21]#,\n    "start_"^#5[1,26]#\n  )^#2[1,13]#,\n  "start_abc"^#7[1,39]#\n)^#6[1,36]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 19, 1, 14, 1, 19],
+        [4, 21, 24, 1, 21, 1, 24],
+        [5, 26, 34, 1, 26, 1, 34],
+        [6, 36, 38, 1, 36, 1, 38],
+        [7, 39, 50, 1, 39, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc" } },
+                    { id: "4", constExpr: { stringValue: "^" } },
+                    { id: "5", constExpr: { stringValue: "start_" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "start_abc" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 26,
+            "6": 36,
+            "7": 39,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "abc"~string,\n    "^"~string,\n    "start_"~string\n  )~string^regex_replace_string_string_string,\n  "start_abc"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 26,
+            "6": 36,
+            "7": 39,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc" } },
+                    { id: "4", constExpr: { stringValue: "^" } },
+                    { id: "5", constExpr: { stringValue: "start_" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "start_abc" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('abc', '$', '_end') == 'abc_end'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "abc"^#*expr.Constant_StringValue#,\n    "$"^#*expr.Constant_StringValue#,\n    "_end"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "abc_end"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("abc", "$", "_end") == "abc_end"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "abc"^#3[1,14]#,\n    "$"^#4[1,21]#,\n    "_end"^#5[1,26]#\n  )^#2[1,13]#,\n  "abc_end"^#7[1,37]#\n)^#6[1,34]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 19, 1, 14, 1, 19],
+        [4, 21, 24, 1, 21, 1, 24],
+        [5, 26, 32, 1, 26, 1, 32],
+        [6, 34, 36, 1, 34, 1, 36],
+        [7, 37, 46, 1, 37, 1, 46],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc" } },
+                    { id: "4", constExpr: { stringValue: "$" } },
+                    { id: "5", constExpr: { stringValue: "_end" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "abc_end" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 26,
+            "6": 34,
+            "7": 37,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "abc"~string,\n    "$"~string,\n    "_end"~string\n  )~string^regex_replace_string_string_string,\n  "abc_end"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 26,
+            "6": 34,
+            "7": 37,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc" } },
+                    { id: "4", constExpr: { stringValue: "$" } },
+                    { id: "5", constExpr: { stringValue: "_end" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "abc_end" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('a-b', r'\\b', '|') == '|a|-|b|'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "a-b"^#*expr.Constant_StringValue#,\n    "\\\\b"^#*expr.Constant_StringValue#,\n    "|"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "|a|-|b|"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("a-b", "\\\\b", "|") == "|a|-|b|"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "a-b"^#3[1,14]#,\n    "\\\\b"^#4[1,21]#,\n    "|"^#5[1,28]#\n  )^#2[1,13]#,\n  "|a|-|b|"^#7[1,36]#\n)^#6[1,33]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 19, 1, 14, 1, 19],
+        [4, 21, 26, 1, 21, 1, 26],
+        [5, 28, 31, 1, 28, 1, 31],
+        [6, 33, 35, 1, 33, 1, 35],
+        [7, 36, 45, 1, 36, 1, 45],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a-b" } },
+                    { id: "4", constExpr: { stringValue: "\\b" } },
+                    { id: "5", constExpr: { stringValue: "|" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "|a|-|b|" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 28,
+            "6": 33,
+            "7": 36,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "a-b"~string,\n    "\\\\b"~string,\n    "|"~string\n  )~string^regex_replace_string_string_string,\n  "|a|-|b|"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 28,
+            "6": 33,
+            "7": 36,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a-b" } },
+                    { id: "4", constExpr: { stringValue: "\\b" } },
+                    { id: "5", constExpr: { stringValue: "|" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "|a|-|b|" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('foo bar', '(fo)o (ba)r', r'\\2 \\1') == 'ba fo'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "foo bar"^#*expr.Constant_StringValue#,\n    "(fo)o (ba)r"^#*expr.Constant_StringValue#,\n    "\\\\2 \\\\1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "ba fo"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("foo bar", "(fo)o (ba)r", "\\\\2 \\\\1") == "ba fo"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "foo bar"^#3[1,14]#,\n    "(fo)o (ba)r"^#4[1,25]#,\n    "\\\\2 \\\\1"^#5[1,40]#\n  )^#2[1,13]#,\n  "ba fo"^#7[1,53]#\n)^#6[1,50]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 38, 1, 25, 1, 38],
+        [5, 40, 48, 1, 40, 1, 48],
+        [6, 50, 52, 1, 50, 1, 52],
+        [7, 53, 60, 1, 53, 1, 60],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "foo bar" } },
+                    { id: "4", constExpr: { stringValue: "(fo)o (ba)r" } },
+                    { id: "5", constExpr: { stringValue: "\\2 \\1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "ba fo" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 40,
+            "6": 50,
+            "7": 53,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "foo bar"~string,\n    "(fo)o (ba)r"~string,\n    "\\\\2 \\\\1"~string\n  )~string^regex_replace_string_string_string,\n  "ba fo"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 40,
+            "6": 50,
+            "7": 53,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "foo bar" } },
+                    { id: "4", constExpr: { stringValue: "(fo)o (ba)r" } },
+                    { id: "5", constExpr: { stringValue: "\\2 \\1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "ba fo" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('foo bar', 'foo', r'\\\\') == '\\\\ bar'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "foo bar"^#*expr.Constant_StringValue#,\n    "foo"^#*expr.Constant_StringValue#,\n    "\\\\\\\\"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\\\ bar"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("foo bar", "foo", "\\\\\\\\") == "\\\\ bar"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "foo bar"^#3[1,14]#,\n    "foo"^#4[1,25]#,\n    "\\\\\\\\"^#5[1,32]#\n  )^#2[1,13]#,\n  "\\\\ bar"^#7[1,42]#\n)^#6[1,39]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 30, 1, 25, 1, 30],
+        [5, 32, 37, 1, 32, 1, 37],
+        [6, 39, 41, 1, 39, 1, 41],
+        [7, 42, 50, 1, 42, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "foo bar" } },
+                    { id: "4", constExpr: { stringValue: "foo" } },
+                    { id: "5", constExpr: { stringValue: "\\\\" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\ bar" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 32,
+            "6": 39,
+            "7": 42,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "foo bar"~string,\n    "foo"~string,\n    "\\\\\\\\"~string\n  )~string^regex_replace_string_string_string,\n  "\\\\ bar"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 32,
+            "6": 39,
+            "7": 42,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "foo bar" } },
+                    { id: "4", constExpr: { stringValue: "foo" } },
+                    { id: "5", constExpr: { stringValue: "\\\\" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\ bar" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('banana', 'ana', 'x') == 'bxna'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "banana"^#*expr.Constant_StringValue#,\n    "ana"^#*expr.Constant_StringValue#,\n    "x"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "bxna"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("banana", "ana", "x") == "bxna"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "banana"^#3[1,14]#,\n    "ana"^#4[1,24]#,\n    "x"^#5[1,31]#\n  )^#2[1,13]#,\n  "bxna"^#7[1,39]#\n)^#6[1,36]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 29, 1, 24, 1, 29],
+        [5, 31, 34, 1, 31, 1, 34],
+        [6, 36, 38, 1, 36, 1, 38],
+        [7, 39, 45, 1, 39, 1, 45],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "ana" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "bxna" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 31,
+            "6": 36,
+            "7": 39,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "banana"~string,\n    "ana"~string,\n    "x"~string\n  )~string^regex_replace_string_string_string,\n  "bxna"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 31,
+            "6": 36,
+            "7": 39,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "ana" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "bxna" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('abc', 'b(.)', r'x\\1') == 'axc'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "abc"^#*expr.Constant_StringValue#,\n    "b(.)"^#*expr.Constant_StringValue#,\n    "x\\\\1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "axc"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("abc", "b(.)", "x\\\\1") == "axc"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "abc"^#3[1,14]#,\n    "b(.)"^#4[1,21]#,\n    "x\\\\1"^#5[1,29]#\n  )^#2[1,13]#,\n  "axc"^#7[1,40]#\n)^#6[1,37]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 19, 1, 14, 1, 19],
+        [4, 21, 27, 1, 21, 1, 27],
+        [5, 29, 35, 1, 29, 1, 35],
+        [6, 37, 39, 1, 37, 1, 39],
+        [7, 40, 45, 1, 40, 1, 45],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc" } },
+                    { id: "4", constExpr: { stringValue: "b(.)" } },
+                    { id: "5", constExpr: { stringValue: "x\\1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "axc" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 29,
+            "6": 37,
+            "7": 40,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "abc"~string,\n    "b(.)"~string,\n    "x\\\\1"~string\n  )~string^regex_replace_string_string_string,\n  "axc"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 21,
+            "5": 29,
+            "6": 37,
+            "7": 40,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc" } },
+                    { id: "4", constExpr: { stringValue: "b(.)" } },
+                    { id: "5", constExpr: { stringValue: "x\\1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "axc" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('hello world hello', 'hello', 'hi') == 'hi world hi'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "hello world hello"^#*expr.Constant_StringValue#,\n    "hello"^#*expr.Constant_StringValue#,\n    "hi"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "hi world hi"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("hello world hello", "hello", "hi") == "hi world hi"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "hello world hello"^#3[1,14]#,\n    "hello"^#4[1,35]#,\n    "hi"^#5[1,44]#\n  )^#2[1,13]#,\n  "hi world hi"^#7[1,53]#\n)^#6[1,50]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 33, 1, 14, 1, 33],
+        [4, 35, 42, 1, 35, 1, 42],
+        [5, 44, 48, 1, 44, 1, 48],
+        [6, 50, 52, 1, 50, 1, 52],
+        [7, 53, 66, 1, 53, 1, 66],
+      ],
+      lineOffsets: [67],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "hello world hello" },
+                    },
+                    { id: "4", constExpr: { stringValue: "hello" } },
+                    { id: "5", constExpr: { stringValue: "hi" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "hi world hi" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 35,
+            "5": 44,
+            "6": 50,
+            "7": 53,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "hello world hello"~string,\n    "hello"~string,\n    "hi"~string\n  )~string^regex_replace_string_string_string,\n  "hi world hi"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 35,
+            "5": 44,
+            "6": 50,
+            "7": 53,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "hello world hello" },
+                    },
+                    { id: "4", constExpr: { stringValue: "hello" } },
+                    { id: "5", constExpr: { stringValue: "hi" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "hi world hi" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.replace('ac', 'a(b)?c', r'[\\1]') == '[]'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "ac"^#*expr.Constant_StringValue#,\n    "a(b)?c"^#*expr.Constant_StringValue#,\n    "[\\\\1]"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "[]"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("ac", "a(b)?c", "[\\\\1]") == "[]"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "ac"^#3[1,14]#,\n    "a(b)?c"^#4[1,20]#,\n    "[\\\\1]"^#5[1,30]#\n  )^#2[1,13]#,\n  "[]"^#7[1,42]#\n)^#6[1,39]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 18, 1, 14, 1, 18],
+        [4, 20, 28, 1, 20, 1, 28],
+        [5, 30, 37, 1, 30, 1, 37],
+        [6, 39, 41, 1, 39, 1, 41],
+        [7, 42, 46, 1, 42, 1, 46],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ac" } },
+                    { id: "4", constExpr: { stringValue: "a(b)?c" } },
+                    { id: "5", constExpr: { stringValue: "[\\1]" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "[]" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 20,
+            "5": 30,
+            "6": 39,
+            "7": 42,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "ac"~string,\n    "a(b)?c"~string,\n    "[\\\\1]"~string\n  )~string^regex_replace_string_string_string,\n  "[]"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 20,
+            "5": 30,
+            "6": 39,
+            "7": 42,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ac" } },
+                    { id: "4", constExpr: { stringValue: "a(b)?c" } },
+                    { id: "5", constExpr: { stringValue: "[\\1]" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "[]" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('apple pie', 'p', 'X') == 'aXXle Xie'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "apple pie"^#*expr.Constant_StringValue#,\n    "p"^#*expr.Constant_StringValue#,\n    "X"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "aXXle Xie"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("apple pie", "p", "X") == "aXXle Xie"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "apple pie"^#3[1,14]#,\n    "p"^#4[1,27]#,\n    "X"^#5[1,32]#\n  )^#2[1,13]#,\n  "aXXle Xie"^#7[1,40]#\n)^#6[1,37]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 25, 1, 14, 1, 25],
+        [4, 27, 30, 1, 27, 1, 30],
+        [5, 32, 35, 1, 32, 1, 35],
+        [6, 37, 39, 1, 37, 1, 39],
+        [7, 40, 51, 1, 40, 1, 51],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "apple pie" } },
+                    { id: "4", constExpr: { stringValue: "p" } },
+                    { id: "5", constExpr: { stringValue: "X" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "aXXle Xie" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 27,
+            "5": 32,
+            "6": 37,
+            "7": 40,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "apple pie"~string,\n    "p"~string,\n    "X"~string\n  )~string^regex_replace_string_string_string,\n  "aXXle Xie"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 27,
+            "5": 32,
+            "6": 37,
+            "7": 40,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "apple pie" } },
+                    { id: "4", constExpr: { stringValue: "p" } },
+                    { id: "5", constExpr: { stringValue: "X" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "aXXle Xie" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('remove all spaces', r'\\s', '') == 'removeallspaces'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "remove all spaces"^#*expr.Constant_StringValue#,\n    "\\\\s"^#*expr.Constant_StringValue#,\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "removeallspaces"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("remove all spaces", "\\\\s", "") == "removeallspaces"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "remove all spaces"^#3[1,14]#,\n    "\\\\s"^#4[1,35]#,\n    ""^#5[1,42]#\n  )^#2[1,13]#,\n  "removeallspaces"^#7[1,49]#\n)^#6[1,46]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 33, 1, 14, 1, 33],
+        [4, 35, 40, 1, 35, 1, 40],
+        [5, 42, 44, 1, 42, 1, 44],
+        [6, 46, 48, 1, 46, 1, 48],
+        [7, 49, 66, 1, 49, 1, 66],
+      ],
+      lineOffsets: [67],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "remove all spaces" },
+                    },
+                    { id: "4", constExpr: { stringValue: "\\s" } },
+                    { id: "5", constExpr: { stringValue: "" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "removeallspaces" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 35,
+            "5": 42,
+            "6": 46,
+            "7": 49,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "remove all spaces"~string,\n    "\\\\s"~string,\n    ""~string\n  )~string^regex_replace_string_string_string,\n  "removeallspaces"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 35,
+            "5": 42,
+            "6": 46,
+            "7": 49,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "remove all spaces" },
+                    },
+                    { id: "4", constExpr: { stringValue: "\\s" } },
+                    { id: "5", constExpr: { stringValue: "" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "removeallspaces" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.replace('digit:99919291992', r'\\d+', '3') == 'digit:3'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "digit:99919291992"^#*expr.Constant_StringValue#,\n    "\\\\d+"^#*expr.Constant_StringValue#,\n    "3"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "digit:3"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("digit:99919291992", "\\\\d+", "3") == "digit:3"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "digit:99919291992"^#3[1,14]#,\n    "\\\\d+"^#4[1,35]#,\n    "3"^#5[1,43]#\n  )^#2[1,13]#,\n  "digit:3"^#7[1,51]#\n)^#6[1,48]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 33, 1, 14, 1, 33],
+        [4, 35, 41, 1, 35, 1, 41],
+        [5, 43, 46, 1, 43, 1, 46],
+        [6, 48, 50, 1, 48, 1, 50],
+        [7, 51, 60, 1, 51, 1, 60],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "digit:99919291992" },
+                    },
+                    { id: "4", constExpr: { stringValue: "\\d+" } },
+                    { id: "5", constExpr: { stringValue: "3" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "digit:3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 35,
+            "5": 43,
+            "6": 48,
+            "7": 51,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "digit:99919291992"~string,\n    "\\\\d+"~string,\n    "3"~string\n  )~string^regex_replace_string_string_string,\n  "digit:3"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 35,
+            "5": 43,
+            "6": 48,
+            "7": 51,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "digit:99919291992" },
+                    },
+                    { id: "4", constExpr: { stringValue: "\\d+" } },
+                    { id: "5", constExpr: { stringValue: "3" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "digit:3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('foo bar baz', r'\\w+', r'(\\0)') == '(foo) (bar) (baz)'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "foo bar baz"^#*expr.Constant_StringValue#,\n    "\\\\w+"^#*expr.Constant_StringValue#,\n    "(\\\\0)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "(foo) (bar) (baz)"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("foo bar baz", "\\\\w+", "(\\\\0)") == "(foo) (bar) (baz)"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "foo bar baz"^#3[1,14]#,\n    "\\\\w+"^#4[1,29]#,\n    "(\\\\0)"^#5[1,37]#\n  )^#2[1,13]#,\n  "(foo) (bar) (baz)"^#7[1,49]#\n)^#6[1,46]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 27, 1, 14, 1, 27],
+        [4, 29, 35, 1, 29, 1, 35],
+        [5, 37, 44, 1, 37, 1, 44],
+        [6, 46, 48, 1, 46, 1, 48],
+        [7, 49, 68, 1, 49, 1, 68],
+      ],
+      lineOffsets: [69],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "foo bar baz" } },
+                    { id: "4", constExpr: { stringValue: "\\w+" } },
+                    { id: "5", constExpr: { stringValue: "(\\0)" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "(foo) (bar) (baz)" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 29,
+            "5": 37,
+            "6": 46,
+            "7": 49,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "foo bar baz"~string,\n    "\\\\w+"~string,\n    "(\\\\0)"~string\n  )~string^regex_replace_string_string_string,\n  "(foo) (bar) (baz)"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [69],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 29,
+            "5": 37,
+            "6": 46,
+            "7": 49,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "foo bar baz" } },
+                    { id: "4", constExpr: { stringValue: "\\w+" } },
+                    { id: "5", constExpr: { stringValue: "(\\0)" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "(foo) (bar) (baz)" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.replace('', 'a', 'b') == ''",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    ""^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("", "a", "b") == ""',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    ""^#3[1,14]#,\n    "a"^#4[1,18]#,\n    "b"^#5[1,23]#\n  )^#2[1,13]#,\n  ""^#7[1,31]#\n)^#6[1,28]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 21, 1, 18, 1, 21],
+        [5, 23, 26, 1, 23, 1, 26],
+        [6, 28, 30, 1, 28, 1, 30],
+        [7, 31, 33, 1, 31, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "b" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 18,
+            "5": 23,
+            "6": 28,
+            "7": 31,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    ""~string,\n    "a"~string,\n    "b"~string\n  )~string^regex_replace_string_string_string,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 18,
+            "5": 23,
+            "6": 28,
+            "7": 31,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "b" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.replace('User: Alice, Age: 30', r'User: (?P\u003cname\u003e\\w+), Age: (?P\u003cage\u003e\\d+)', '${name} is ${age} years old') == '${name} is ${age} years old'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "User: Alice, Age: 30"^#*expr.Constant_StringValue#,\n    "User: (?P\u003cname\u003e\\\\w+), Age: (?P\u003cage\u003e\\\\d+)"^#*expr.Constant_StringValue#,\n    "${name} is ${age} years old"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "${name} is ${age} years old"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("User: Alice, Age: 30", "User: (?P\u003cname\u003e\\\\w+), Age: (?P\u003cage\u003e\\\\d+)", "${name} is ${age} years old") == "${name} is ${age} years old"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "User: Alice, Age: 30"^#3[1,14]#,\n    "User: (?P\u003cname\u003e\\\\w+), Age: (?P\u003cage\u003e\\\\d+)"^#4[1,38]#,\n    "${name} is ${age} years old"^#5[1,81]#\n  )^#2[1,13]#,\n  "${name} is ${age} years old"^#7[1,115]#\n)^#6[1,112]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 36, 1, 14, 1, 36],
+        [4, 38, 79, 1, 38, 1, 79],
+        [5, 81, 110, 1, 81, 1, 110],
+        [6, 112, 114, 1, 112, 1, 114],
+        [7, 115, 144, 1, 115, 1, 144],
+      ],
+      lineOffsets: [145],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "User: Alice, Age: 30" },
+                    },
+                    {
+                      id: "4",
+                      constExpr: {
+                        stringValue:
+                          "User: (?P\u003cname\u003e\\w+), Age: (?P\u003cage\u003e\\d+)",
+                      },
+                    },
+                    {
+                      id: "5",
+                      constExpr: { stringValue: "${name} is ${age} years old" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                constExpr: { stringValue: "${name} is ${age} years old" },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [145],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 38,
+            "5": 81,
+            "6": 112,
+            "7": 115,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "User: Alice, Age: 30"~string,\n    "User: (?P\u003cname\u003e\\\\w+), Age: (?P\u003cage\u003e\\\\d+)"~string,\n    "${name} is ${age} years old"~string\n  )~string^regex_replace_string_string_string,\n  "${name} is ${age} years old"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [145],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 38,
+            "5": 81,
+            "6": 112,
+            "7": 115,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "User: Alice, Age: 30" },
+                    },
+                    {
+                      id: "4",
+                      constExpr: {
+                        stringValue:
+                          "User: (?P\u003cname\u003e\\w+), Age: (?P\u003cage\u003e\\d+)",
+                      },
+                    },
+                    {
+                      id: "5",
+                      constExpr: { stringValue: "${name} is ${age} years old" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                constExpr: { stringValue: "${name} is ${age} years old" },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: "regex.replace('User: Alice, Age: 30', r'User: (?P\u003cname\u003e\\w+), Age: (?P\u003cage\u003e\\d+)', r'\\1 is \\2 years old') == 'Alice is 30 years old'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "User: Alice, Age: 30"^#*expr.Constant_StringValue#,\n    "User: (?P\u003cname\u003e\\\\w+), Age: (?P\u003cage\u003e\\\\d+)"^#*expr.Constant_StringValue#,\n    "\\\\1 is \\\\2 years old"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "Alice is 30 years old"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("User: Alice, Age: 30", "User: (?P\u003cname\u003e\\\\w+), Age: (?P\u003cage\u003e\\\\d+)", "\\\\1 is \\\\2 years old") == "Alice is 30 years old"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "User: Alice, Age: 30"^#3[1,14]#,\n    "User: (?P\u003cname\u003e\\\\w+), Age: (?P\u003cage\u003e\\\\d+)"^#4[1,38]#,\n    "\\\\1 is \\\\2 years old"^#5[1,81]#\n  )^#2[1,13]#,\n  "Alice is 30 years old"^#7[1,107]#\n)^#6[1,104]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 36, 1, 14, 1, 36],
+        [4, 38, 79, 1, 38, 1, 79],
+        [5, 81, 102, 1, 81, 1, 102],
+        [6, 104, 106, 1, 104, 1, 106],
+        [7, 107, 130, 1, 107, 1, 130],
+      ],
+      lineOffsets: [131],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "User: Alice, Age: 30" },
+                    },
+                    {
+                      id: "4",
+                      constExpr: {
+                        stringValue:
+                          "User: (?P\u003cname\u003e\\w+), Age: (?P\u003cage\u003e\\d+)",
+                      },
+                    },
+                    {
+                      id: "5",
+                      constExpr: { stringValue: "\\1 is \\2 years old" },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "Alice is 30 years old" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [131],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 38,
+            "5": 81,
+            "6": 104,
+            "7": 107,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "User: Alice, Age: 30"~string,\n    "User: (?P\u003cname\u003e\\\\w+), Age: (?P\u003cage\u003e\\\\d+)"~string,\n    "\\\\1 is \\\\2 years old"~string\n  )~string^regex_replace_string_string_string,\n  "Alice is 30 years old"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [131],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 38,
+            "5": 81,
+            "6": 104,
+            "7": 107,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "User: Alice, Age: 30" },
+                    },
+                    {
+                      id: "4",
+                      constExpr: {
+                        stringValue:
+                          "User: (?P\u003cname\u003e\\w+), Age: (?P\u003cage\u003e\\d+)",
+                      },
+                    },
+                    {
+                      id: "5",
+                      constExpr: { stringValue: "\\1 is \\2 years old" },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "Alice is 30 years old" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: "regex.replace('hello ☃', '☃', '❄') == 'hello ❄'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "hello ☃"^#*expr.Constant_StringValue#,\n    "☃"^#*expr.Constant_StringValue#,\n    "❄"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "hello ❄"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("hello ☃", "☃", "❄") == "hello ❄"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "hello ☃"^#3[1,14]#,\n    "☃"^#4[1,25]#,\n    "❄"^#5[1,30]#\n  )^#2[1,13]#,\n  "hello ❄"^#7[1,38]#\n)^#6[1,35]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 25, 1, 14, 1, 25],
+        [4, 25, 30, 1, 25, 1, 30],
+        [5, 30, 35, 1, 30, 1, 35],
+        [6, 35, 37, 1, 35, 1, 37],
+        [7, 38, 49, 1, 38, 2, 1],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello ☃" } },
+                    { id: "4", constExpr: { stringValue: "☃" } },
+                    { id: "5", constExpr: { stringValue: "❄" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "hello ❄" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 30,
+            "6": 35,
+            "7": 38,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "hello ☃"~string,\n    "☃"~string,\n    "❄"~string\n  )~string^regex_replace_string_string_string,\n  "hello ❄"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 30,
+            "6": 35,
+            "7": 38,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello ☃" } },
+                    { id: "4", constExpr: { stringValue: "☃" } },
+                    { id: "5", constExpr: { stringValue: "❄" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "hello ❄" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('id=123', r'id=(?P\u003cvalue\u003e\\d+)', r'value: \\1') == 'value: 123'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "id=123"^#*expr.Constant_StringValue#,\n    "id=(?P\u003cvalue\u003e\\\\d+)"^#*expr.Constant_StringValue#,\n    "value: \\\\1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "value: 123"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("id=123", "id=(?P\u003cvalue\u003e\\\\d+)", "value: \\\\1") == "value: 123"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "id=123"^#3[1,14]#,\n    "id=(?P\u003cvalue\u003e\\\\d+)"^#4[1,24]#,\n    "value: \\\\1"^#5[1,46]#\n  )^#2[1,13]#,\n  "value: 123"^#7[1,63]#\n)^#6[1,60]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 44, 1, 24, 1, 44],
+        [5, 46, 58, 1, 46, 1, 58],
+        [6, 60, 62, 1, 60, 1, 62],
+        [7, 63, 75, 1, 63, 1, 75],
+      ],
+      lineOffsets: [76],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "id=123" } },
+                    {
+                      id: "4",
+                      constExpr: {
+                        stringValue: "id=(?P\u003cvalue\u003e\\d+)",
+                      },
+                    },
+                    { id: "5", constExpr: { stringValue: "value: \\1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "value: 123" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [76],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 46,
+            "6": 60,
+            "7": 63,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "id=123"~string,\n    "id=(?P\u003cvalue\u003e\\\\d+)"~string,\n    "value: \\\\1"~string\n  )~string^regex_replace_string_string_string,\n  "value: 123"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [76],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 46,
+            "6": 60,
+            "7": 63,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "id=123" } },
+                    {
+                      id: "4",
+                      constExpr: {
+                        stringValue: "id=(?P\u003cvalue\u003e\\d+)",
+                      },
+                    },
+                    { id: "5", constExpr: { stringValue: "value: \\1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "value: 123" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('banana', 'a', 'x') == 'bxnxnx'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "banana"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "x"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "bxnxnx"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("banana", "a", "x") == "bxnxnx"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "banana"^#3[1,14]#,\n    "a"^#4[1,24]#,\n    "x"^#5[1,29]#\n  )^#2[1,13]#,\n  "bxnxnx"^#7[1,37]#\n)^#6[1,34]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 27, 1, 24, 1, 27],
+        [5, 29, 32, 1, 29, 1, 32],
+        [6, 34, 36, 1, 34, 1, 36],
+        [7, 37, 45, 1, 37, 1, 45],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "bxnxnx" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 37,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "banana"~string,\n    "a"~string,\n    "x"~string\n  )~string^regex_replace_string_string_string,\n  "bxnxnx"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 37,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "bxnxnx" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace(regex.replace('%(foo) %(bar) %2', r'%\\((\\w+)\\)', r'${\\1}'),r'%(\\d+)', r'$\\1') == '${foo} ${bar} $2'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    regex^#*expr.Expr_IdentExpr#.replace(\n      "%(foo) %(bar) %2"^#*expr.Constant_StringValue#,\n      "%\\\\((\\\\w+)\\\\)"^#*expr.Constant_StringValue#,\n      "${\\\\1}"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    "%(\\\\d+)"^#*expr.Constant_StringValue#,\n    "$\\\\1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "${foo} ${bar} $2"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace(regex.replace("%(foo) %(bar) %2", "%\\\\((\\\\w+)\\\\)", "${\\\\1}"), "%(\\\\d+)", "$\\\\1") == "${foo} ${bar} $2"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    regex^#3[1,14]#.replace(\n      "%(foo) %(bar) %2"^#5[1,28]#,\n      "%\\\\((\\\\w+)\\\\)"^#6[1,48]#,\n      "${\\\\1}"^#7[1,63]#\n    )^#4[1,27]#,\n    "%(\\\\d+)"^#8[1,73]#,\n    "$\\\\1"^#9[1,84]#\n  )^#2[1,13]#,\n  "${foo} ${bar} $2"^#11[1,95]#\n)^#10[1,92]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 19, 1, 14, 1, 19],
+        [4, 27, 28, 1, 27, 1, 28],
+        [5, 28, 46, 1, 28, 1, 46],
+        [6, 48, 61, 1, 48, 1, 61],
+        [7, 63, 71, 1, 63, 1, 71],
+        [8, 73, 82, 1, 73, 1, 82],
+        [9, 84, 90, 1, 84, 1, 90],
+        [10, 92, 94, 1, 92, 1, 94],
+        [11, 95, 113, 1, 95, 1, 113],
+      ],
+      lineOffsets: [114],
+      parsedExpr: {
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        target: { id: "3", identExpr: { name: "regex" } },
+                        function: "replace",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: { stringValue: "%(foo) %(bar) %2" },
+                          },
+                          {
+                            id: "6",
+                            constExpr: { stringValue: "%\\((\\w+)\\)" },
+                          },
+                          { id: "7", constExpr: { stringValue: "${\\1}" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "%(\\d+)" } },
+                    { id: "9", constExpr: { stringValue: "$\\1" } },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { stringValue: "${foo} ${bar} $2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [114],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 27,
+            "5": 28,
+            "6": 48,
+            "7": 63,
+            "8": 73,
+            "9": 84,
+            "10": 92,
+            "11": 95,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    regex.replace(\n      "%(foo) %(bar) %2"~string,\n      "%\\\\((\\\\w+)\\\\)"~string,\n      "${\\\\1}"~string\n    )~string^regex_replace_string_string_string,\n    "%(\\\\d+)"~string,\n    "$\\\\1"~string\n  )~string^regex_replace_string_string_string,\n  "${foo} ${bar} $2"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "4": { overloadId: ["regex_replace_string_string_string"] },
+          "10": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [114],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 27,
+            "5": 28,
+            "6": 48,
+            "7": 63,
+            "8": 73,
+            "9": 84,
+            "10": 92,
+            "11": 95,
+          },
+        },
+        expr: {
+          id: "10",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "regex.replace",
+                        args: [
+                          {
+                            id: "5",
+                            constExpr: { stringValue: "%(foo) %(bar) %2" },
+                          },
+                          {
+                            id: "6",
+                            constExpr: { stringValue: "%\\((\\w+)\\)" },
+                          },
+                          { id: "7", constExpr: { stringValue: "${\\1}" } },
+                        ],
+                      },
+                    },
+                    { id: "8", constExpr: { stringValue: "%(\\d+)" } },
+                    { id: "9", constExpr: { stringValue: "$\\1" } },
+                  ],
+                },
+              },
+              { id: "11", constExpr: { stringValue: "${foo} ${bar} $2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: "regex.replace('banana', 'a', 'x', 0) == 'banana'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "banana"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "x"^#*expr.Constant_StringValue#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "banana"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("banana", "a", "x", 0) == "banana"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "banana"^#3[1,14]#,\n    "a"^#4[1,24]#,\n    "x"^#5[1,29]#,\n    0^#6[1,34]#\n  )^#2[1,13]#,\n  "banana"^#8[1,40]#\n)^#7[1,37]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 27, 1, 24, 1, 27],
+        [5, 29, 32, 1, 29, 1, 32],
+        [6, 34, 35, 1, 34, 1, 35],
+        [7, 37, 39, 1, 37, 1, 39],
+        [8, 40, 48, 1, 40, 1, 48],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "banana" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 37,
+            "8": 40,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "banana"~string,\n    "a"~string,\n    "x"~string,\n    0~int\n  )~string^regex_replace_string_string_string_int,\n  "banana"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 37,
+            "8": 40,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "banana" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('banana', 'a', 'x', 1) == 'bxnana'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "banana"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "x"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "bxnana"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("banana", "a", "x", 1) == "bxnana"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "banana"^#3[1,14]#,\n    "a"^#4[1,24]#,\n    "x"^#5[1,29]#,\n    1^#6[1,34]#\n  )^#2[1,13]#,\n  "bxnana"^#8[1,40]#\n)^#7[1,37]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 27, 1, 24, 1, 27],
+        [5, 29, 32, 1, 29, 1, 32],
+        [6, 34, 35, 1, 34, 1, 35],
+        [7, 37, 39, 1, 37, 1, 39],
+        [8, 40, 48, 1, 40, 1, 48],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnana" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 37,
+            "8": 40,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "banana"~string,\n    "a"~string,\n    "x"~string,\n    1~int\n  )~string^regex_replace_string_string_string_int,\n  "bxnana"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 37,
+            "8": 40,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnana" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('banana', 'a', 'x', 2) == 'bxnxna'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "banana"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "x"^#*expr.Constant_StringValue#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "bxnxna"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("banana", "a", "x", 2) == "bxnxna"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "banana"^#3[1,14]#,\n    "a"^#4[1,24]#,\n    "x"^#5[1,29]#,\n    2^#6[1,34]#\n  )^#2[1,13]#,\n  "bxnxna"^#8[1,40]#\n)^#7[1,37]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 27, 1, 24, 1, 27],
+        [5, 29, 32, 1, 29, 1, 32],
+        [6, 34, 35, 1, 34, 1, 35],
+        [7, 37, 39, 1, 37, 1, 39],
+        [8, 40, 48, 1, 40, 1, 48],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnxna" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 37,
+            "8": 40,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "banana"~string,\n    "a"~string,\n    "x"~string,\n    2~int\n  )~string^regex_replace_string_string_string_int,\n  "bxnxna"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 37,
+            "8": 40,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnxna" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('banana', 'a', 'x', 100) == 'bxnxnx'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "banana"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "x"^#*expr.Constant_StringValue#,\n    100^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "bxnxnx"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("banana", "a", "x", 100) == "bxnxnx"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "banana"^#3[1,14]#,\n    "a"^#4[1,24]#,\n    "x"^#5[1,29]#,\n    100^#6[1,34]#\n  )^#2[1,13]#,\n  "bxnxnx"^#8[1,42]#\n)^#7[1,39]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 27, 1, 24, 1, 27],
+        [5, 29, 32, 1, 29, 1, 32],
+        [6, 34, 37, 1, 34, 1, 37],
+        [7, 39, 41, 1, 39, 1, 41],
+        [8, 42, 50, 1, 42, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "100" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnxnx" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 39,
+            "8": 42,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "banana"~string,\n    "a"~string,\n    "x"~string,\n    100~int\n  )~string^regex_replace_string_string_string_int,\n  "bxnxnx"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 39,
+            "8": 42,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "100" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnxnx" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('banana', 'a', 'x', -1) == 'bxnxnx'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "banana"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "x"^#*expr.Constant_StringValue#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "bxnxnx"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("banana", "a", "x", -1) == "bxnxnx"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "banana"^#3[1,14]#,\n    "a"^#4[1,24]#,\n    "x"^#5[1,29]#,\n    -1^#6[1,34]#\n  )^#2[1,13]#,\n  "bxnxnx"^#8[1,41]#\n)^#7[1,38]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 27, 1, 24, 1, 27],
+        [5, 29, 32, 1, 29, 1, 32],
+        [6, 34, 36, 1, 34, 1, 36],
+        [7, 38, 40, 1, 38, 1, 40],
+        [8, 41, 49, 1, 41, 1, 49],
+      ],
+      lineOffsets: [50],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnxnx" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 38,
+            "8": 41,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "banana"~string,\n    "a"~string,\n    "x"~string,\n    -1~int\n  )~string^regex_replace_string_string_string_int,\n  "bxnxnx"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 38,
+            "8": 41,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnxnx" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('banana', 'a', 'x', -100) == 'bxnxnx'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "banana"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "x"^#*expr.Constant_StringValue#,\n    -100^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "bxnxnx"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("banana", "a", "x", -100) == "bxnxnx"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "banana"^#3[1,14]#,\n    "a"^#4[1,24]#,\n    "x"^#5[1,29]#,\n    -100^#6[1,34]#\n  )^#2[1,13]#,\n  "bxnxnx"^#8[1,43]#\n)^#7[1,40]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 27, 1, 24, 1, 27],
+        [5, 29, 32, 1, 29, 1, 32],
+        [6, 34, 38, 1, 34, 1, 38],
+        [7, 40, 42, 1, 40, 1, 42],
+        [8, 43, 51, 1, 43, 1, 51],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "-100" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnxnx" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 40,
+            "8": 43,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "banana"~string,\n    "a"~string,\n    "x"~string,\n    -100~int\n  )~string^regex_replace_string_string_string_int,\n  "bxnxnx"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 24,
+            "5": 29,
+            "6": 34,
+            "7": 40,
+            "8": 43,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banana" } },
+                    { id: "4", constExpr: { stringValue: "a" } },
+                    { id: "5", constExpr: { stringValue: "x" } },
+                    { id: "6", constExpr: { int64Value: "-100" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "bxnxnx" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('cat-dog dog-cat cat-dog dog-cat', '(cat)-(dog)', r'\\2-\\1', 1) == 'dog-cat dog-cat cat-dog dog-cat'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "cat-dog dog-cat cat-dog dog-cat"^#*expr.Constant_StringValue#,\n    "(cat)-(dog)"^#*expr.Constant_StringValue#,\n    "\\\\2-\\\\1"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "dog-cat dog-cat cat-dog dog-cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("cat-dog dog-cat cat-dog dog-cat", "(cat)-(dog)", "\\\\2-\\\\1", 1) == "dog-cat dog-cat cat-dog dog-cat"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "cat-dog dog-cat cat-dog dog-cat"^#3[1,14]#,\n    "(cat)-(dog)"^#4[1,49]#,\n    "\\\\2-\\\\1"^#5[1,64]#,\n    1^#6[1,74]#\n  )^#2[1,13]#,\n  "dog-cat dog-cat cat-dog dog-cat"^#8[1,80]#\n)^#7[1,77]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 47, 1, 14, 1, 47],
+        [4, 49, 62, 1, 49, 1, 62],
+        [5, 64, 72, 1, 64, 1, 72],
+        [6, 74, 75, 1, 74, 1, 75],
+        [7, 77, 79, 1, 77, 1, 79],
+        [8, 80, 113, 1, 80, 1, 113],
+      ],
+      lineOffsets: [114],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue: "cat-dog dog-cat cat-dog dog-cat",
+                      },
+                    },
+                    { id: "4", constExpr: { stringValue: "(cat)-(dog)" } },
+                    { id: "5", constExpr: { stringValue: "\\2-\\1" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                constExpr: { stringValue: "dog-cat dog-cat cat-dog dog-cat" },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [114],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 49,
+            "5": 64,
+            "6": 74,
+            "7": 77,
+            "8": 80,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "cat-dog dog-cat cat-dog dog-cat"~string,\n    "(cat)-(dog)"~string,\n    "\\\\2-\\\\1"~string,\n    1~int\n  )~string^regex_replace_string_string_string_int,\n  "dog-cat dog-cat cat-dog dog-cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [114],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 49,
+            "5": 64,
+            "6": 74,
+            "7": 77,
+            "8": 80,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue: "cat-dog dog-cat cat-dog dog-cat",
+                      },
+                    },
+                    { id: "4", constExpr: { stringValue: "(cat)-(dog)" } },
+                    { id: "5", constExpr: { stringValue: "\\2-\\1" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                constExpr: { stringValue: "dog-cat dog-cat cat-dog dog-cat" },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        expr: "regex.replace('cat-dog dog-cat cat-dog dog-cat', '(cat)-(dog)', r'\\2-\\1', 2) == 'dog-cat dog-cat dog-cat dog-cat'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "cat-dog dog-cat cat-dog dog-cat"^#*expr.Constant_StringValue#,\n    "(cat)-(dog)"^#*expr.Constant_StringValue#,\n    "\\\\2-\\\\1"^#*expr.Constant_StringValue#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "dog-cat dog-cat dog-cat dog-cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("cat-dog dog-cat cat-dog dog-cat", "(cat)-(dog)", "\\\\2-\\\\1", 2) == "dog-cat dog-cat dog-cat dog-cat"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "cat-dog dog-cat cat-dog dog-cat"^#3[1,14]#,\n    "(cat)-(dog)"^#4[1,49]#,\n    "\\\\2-\\\\1"^#5[1,64]#,\n    2^#6[1,74]#\n  )^#2[1,13]#,\n  "dog-cat dog-cat dog-cat dog-cat"^#8[1,80]#\n)^#7[1,77]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 47, 1, 14, 1, 47],
+        [4, 49, 62, 1, 49, 1, 62],
+        [5, 64, 72, 1, 64, 1, 72],
+        [6, 74, 75, 1, 74, 1, 75],
+        [7, 77, 79, 1, 77, 1, 79],
+        [8, 80, 113, 1, 80, 1, 113],
+      ],
+      lineOffsets: [114],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue: "cat-dog dog-cat cat-dog dog-cat",
+                      },
+                    },
+                    { id: "4", constExpr: { stringValue: "(cat)-(dog)" } },
+                    { id: "5", constExpr: { stringValue: "\\2-\\1" } },
+                    { id: "6", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                constExpr: { stringValue: "dog-cat dog-cat dog-cat dog-cat" },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [114],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 49,
+            "5": 64,
+            "6": 74,
+            "7": 77,
+            "8": 80,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "cat-dog dog-cat cat-dog dog-cat"~string,\n    "(cat)-(dog)"~string,\n    "\\\\2-\\\\1"~string,\n    2~int\n  )~string^regex_replace_string_string_string_int,\n  "dog-cat dog-cat dog-cat dog-cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [114],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 49,
+            "5": 64,
+            "6": 74,
+            "7": 77,
+            "8": 80,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue: "cat-dog dog-cat cat-dog dog-cat",
+                      },
+                    },
+                    { id: "4", constExpr: { stringValue: "(cat)-(dog)" } },
+                    { id: "5", constExpr: { stringValue: "\\2-\\1" } },
+                    { id: "6", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                constExpr: { stringValue: "dog-cat dog-cat dog-cat dog-cat" },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        expr: "regex.replace('a.b.c', r'\\.', '-', 1) == 'a-b.c'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "a.b.c"^#*expr.Constant_StringValue#,\n    "\\\\."^#*expr.Constant_StringValue#,\n    "-"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "a-b.c"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("a.b.c", "\\\\.", "-", 1) == "a-b.c"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "a.b.c"^#3[1,14]#,\n    "\\\\."^#4[1,23]#,\n    "-"^#5[1,30]#,\n    1^#6[1,35]#\n  )^#2[1,13]#,\n  "a-b.c"^#8[1,41]#\n)^#7[1,38]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+        [4, 23, 28, 1, 23, 1, 28],
+        [5, 30, 33, 1, 30, 1, 33],
+        [6, 35, 36, 1, 35, 1, 36],
+        [7, 38, 40, 1, 38, 1, 40],
+        [8, 41, 48, 1, 41, 1, 48],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a.b.c" } },
+                    { id: "4", constExpr: { stringValue: "\\." } },
+                    { id: "5", constExpr: { stringValue: "-" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "a-b.c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 23,
+            "5": 30,
+            "6": 35,
+            "7": 38,
+            "8": 41,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "a.b.c"~string,\n    "\\\\."~string,\n    "-"~string,\n    1~int\n  )~string^regex_replace_string_string_string_int,\n  "a-b.c"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 23,
+            "5": 30,
+            "6": 35,
+            "7": 38,
+            "8": 41,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a.b.c" } },
+                    { id: "4", constExpr: { stringValue: "\\." } },
+                    { id: "5", constExpr: { stringValue: "-" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "a-b.c" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('a.b.c', r'\\.', '-', -1) == 'a-b-c'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "a.b.c"^#*expr.Constant_StringValue#,\n    "\\\\."^#*expr.Constant_StringValue#,\n    "-"^#*expr.Constant_StringValue#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "a-b-c"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("a.b.c", "\\\\.", "-", -1) == "a-b-c"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "a.b.c"^#3[1,14]#,\n    "\\\\."^#4[1,23]#,\n    "-"^#5[1,30]#,\n    -1^#6[1,35]#\n  )^#2[1,13]#,\n  "a-b-c"^#8[1,42]#\n)^#7[1,39]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+        [4, 23, 28, 1, 23, 1, 28],
+        [5, 30, 33, 1, 30, 1, 33],
+        [6, 35, 37, 1, 35, 1, 37],
+        [7, 39, 41, 1, 39, 1, 41],
+        [8, 42, 49, 1, 42, 1, 49],
+      ],
+      lineOffsets: [50],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a.b.c" } },
+                    { id: "4", constExpr: { stringValue: "\\." } },
+                    { id: "5", constExpr: { stringValue: "-" } },
+                    { id: "6", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "a-b-c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 23,
+            "5": 30,
+            "6": 35,
+            "7": 39,
+            "8": 42,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "a.b.c"~string,\n    "\\\\."~string,\n    "-"~string,\n    -1~int\n  )~string^regex_replace_string_string_string_int,\n  "a-b-c"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 23,
+            "5": 30,
+            "6": 35,
+            "7": 39,
+            "8": 42,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a.b.c" } },
+                    { id: "4", constExpr: { stringValue: "\\." } },
+                    { id: "5", constExpr: { stringValue: "-" } },
+                    { id: "6", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "a-b-c" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('abc def', r'(abc)', r'\\\\1') == r'\\1 def'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "abc def"^#*expr.Constant_StringValue#,\n    "(abc)"^#*expr.Constant_StringValue#,\n    "\\\\\\\\1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\\\1 def"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("abc def", "(abc)", "\\\\\\\\1") == "\\\\1 def"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "abc def"^#3[1,14]#,\n    "(abc)"^#4[1,25]#,\n    "\\\\\\\\1"^#5[1,35]#\n  )^#2[1,13]#,\n  "\\\\1 def"^#7[1,46]#\n)^#6[1,43]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 33, 1, 25, 1, 33],
+        [5, 35, 41, 1, 35, 1, 41],
+        [6, 43, 45, 1, 43, 1, 45],
+        [7, 46, 55, 1, 46, 1, 55],
+      ],
+      lineOffsets: [56],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc def" } },
+                    { id: "4", constExpr: { stringValue: "(abc)" } },
+                    { id: "5", constExpr: { stringValue: "\\\\1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\1 def" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 35,
+            "6": 43,
+            "7": 46,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "abc def"~string,\n    "(abc)"~string,\n    "\\\\\\\\1"~string\n  )~string^regex_replace_string_string_string,\n  "\\\\1 def"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 35,
+            "6": 43,
+            "7": 46,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc def" } },
+                    { id: "4", constExpr: { stringValue: "(abc)" } },
+                    { id: "5", constExpr: { stringValue: "\\\\1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\1 def" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('abc def', r'(abc)', r'\\\\2') == r'\\2 def'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "abc def"^#*expr.Constant_StringValue#,\n    "(abc)"^#*expr.Constant_StringValue#,\n    "\\\\\\\\2"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\\\2 def"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("abc def", "(abc)", "\\\\\\\\2") == "\\\\2 def"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "abc def"^#3[1,14]#,\n    "(abc)"^#4[1,25]#,\n    "\\\\\\\\2"^#5[1,35]#\n  )^#2[1,13]#,\n  "\\\\2 def"^#7[1,46]#\n)^#6[1,43]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 33, 1, 25, 1, 33],
+        [5, 35, 41, 1, 35, 1, 41],
+        [6, 43, 45, 1, 43, 1, 45],
+        [7, 46, 55, 1, 46, 1, 55],
+      ],
+      lineOffsets: [56],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc def" } },
+                    { id: "4", constExpr: { stringValue: "(abc)" } },
+                    { id: "5", constExpr: { stringValue: "\\\\2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\2 def" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 35,
+            "6": 43,
+            "7": 46,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "abc def"~string,\n    "(abc)"~string,\n    "\\\\\\\\2"~string\n  )~string^regex_replace_string_string_string,\n  "\\\\2 def"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 35,
+            "6": 43,
+            "7": 46,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc def" } },
+                    { id: "4", constExpr: { stringValue: "(abc)" } },
+                    { id: "5", constExpr: { stringValue: "\\\\2" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\2 def" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.replace('abc def', r'(abc)', r'\\\\{word}') == '\\\\{word} def'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "abc def"^#*expr.Constant_StringValue#,\n    "(abc)"^#*expr.Constant_StringValue#,\n    "\\\\\\\\{word}"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\\\{word} def"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("abc def", "(abc)", "\\\\\\\\{word}") == "\\\\{word} def"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "abc def"^#3[1,14]#,\n    "(abc)"^#4[1,25]#,\n    "\\\\\\\\{word}"^#5[1,35]#\n  )^#2[1,13]#,\n  "\\\\{word} def"^#7[1,51]#\n)^#6[1,48]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 33, 1, 25, 1, 33],
+        [5, 35, 46, 1, 35, 1, 46],
+        [6, 48, 50, 1, 48, 1, 50],
+        [7, 51, 65, 1, 51, 1, 65],
+      ],
+      lineOffsets: [66],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc def" } },
+                    { id: "4", constExpr: { stringValue: "(abc)" } },
+                    { id: "5", constExpr: { stringValue: "\\\\{word}" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\{word} def" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [66],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 35,
+            "6": 48,
+            "7": 51,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "abc def"~string,\n    "(abc)"~string,\n    "\\\\\\\\{word}"~string\n  )~string^regex_replace_string_string_string,\n  "\\\\{word} def"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [66],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 35,
+            "6": 48,
+            "7": 51,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc def" } },
+                    { id: "4", constExpr: { stringValue: "(abc)" } },
+                    { id: "5", constExpr: { stringValue: "\\\\{word}" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\{word} def" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.replace('abc def', r'(abc)', r'\\\\word') == '\\\\word def'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.replace(\n    "abc def"^#*expr.Constant_StringValue#,\n    "(abc)"^#*expr.Constant_StringValue#,\n    "\\\\\\\\word"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\\\word def"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("abc def", "(abc)", "\\\\\\\\word") == "\\\\word def"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.replace(\n    "abc def"^#3[1,14]#,\n    "(abc)"^#4[1,25]#,\n    "\\\\\\\\word"^#5[1,35]#\n  )^#2[1,13]#,\n  "\\\\word def"^#7[1,49]#\n)^#6[1,46]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 33, 1, 25, 1, 33],
+        [5, 35, 44, 1, 35, 1, 44],
+        [6, 46, 48, 1, 46, 1, 48],
+        [7, 49, 61, 1, 49, 1, 61],
+      ],
+      lineOffsets: [62],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc def" } },
+                    { id: "4", constExpr: { stringValue: "(abc)" } },
+                    { id: "5", constExpr: { stringValue: "\\\\word" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\word def" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 35,
+            "6": 46,
+            "7": 49,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.replace(\n    "abc def"~string,\n    "(abc)"~string,\n    "\\\\\\\\word"~string\n  )~string^regex_replace_string_string_string,\n  "\\\\word def"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 25,
+            "5": 35,
+            "6": 46,
+            "7": 49,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "abc def" } },
+                    { id: "4", constExpr: { stringValue: "(abc)" } },
+                    { id: "5", constExpr: { stringValue: "\\\\word" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "\\word def" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.extract('hello world', 'hello(.*)') == optional.of(' world')",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "hello world"^#*expr.Constant_StringValue#,\n    "hello(.*)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.of(\n    " world"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extract("hello world", "hello(.*)") == optional.of(" world")',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "hello world"^#3[1,14]#,\n    "hello(.*)"^#4[1,29]#\n  )^#2[1,13]#,\n  optional^#6[1,45]#.of(\n    " world"^#8[1,57]#\n  )^#7[1,56]#\n)^#5[1,42]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 27, 1, 14, 1, 27],
+        [4, 29, 40, 1, 29, 1, 40],
+        [5, 42, 44, 1, 42, 1, 44],
+        [6, 45, 53, 1, 45, 1, 53],
+        [7, 56, 57, 1, 56, 1, 57],
+        [8, 57, 65, 1, 57, 1, 65],
+      ],
+      lineOffsets: [67],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello world" } },
+                    { id: "4", constExpr: { stringValue: "hello(.*)" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  target: { id: "6", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [{ id: "8", constExpr: { stringValue: " world" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 29,
+            "5": 42,
+            "6": 45,
+            "7": 56,
+            "8": 57,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "hello world"~string,\n    "hello(.*)"~string\n  )~optional_type(string)^regex_extract_string_string,\n  optional.of(\n    " world"~string\n  )~optional_type(string)^optional_of\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["optional_of"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 29,
+            "5": 42,
+            "6": 45,
+            "7": 56,
+            "8": 57,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello world" } },
+                    { id: "4", constExpr: { stringValue: "hello(.*)" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "optional.of",
+                  args: [{ id: "8", constExpr: { stringValue: " world" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.extract('item-A, item-B', r'item-(\\w+)') == optional.of('A')",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "item-A, item-B"^#*expr.Constant_StringValue#,\n    "item-(\\\\w+)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.of(\n    "A"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extract("item-A, item-B", "item-(\\\\w+)") == optional.of("A")',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "item-A, item-B"^#3[1,14]#,\n    "item-(\\\\w+)"^#4[1,32]#\n  )^#2[1,13]#,\n  optional^#6[1,50]#.of(\n    "A"^#8[1,62]#\n  )^#7[1,61]#\n)^#5[1,47]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 30, 1, 14, 1, 30],
+        [4, 32, 45, 1, 32, 1, 45],
+        [5, 47, 49, 1, 47, 1, 49],
+        [6, 50, 58, 1, 50, 1, 58],
+        [7, 61, 62, 1, 61, 1, 62],
+        [8, 62, 65, 1, 62, 1, 65],
+      ],
+      lineOffsets: [67],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "item-A, item-B" } },
+                    { id: "4", constExpr: { stringValue: "item-(\\w+)" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  target: { id: "6", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [{ id: "8", constExpr: { stringValue: "A" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 32,
+            "5": 47,
+            "6": 50,
+            "7": 61,
+            "8": 62,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "item-A, item-B"~string,\n    "item-(\\\\w+)"~string\n  )~optional_type(string)^regex_extract_string_string,\n  optional.of(\n    "A"~string\n  )~optional_type(string)^optional_of\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["optional_of"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 32,
+            "5": 47,
+            "6": 50,
+            "7": 61,
+            "8": 62,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "item-A, item-B" } },
+                    { id: "4", constExpr: { stringValue: "item-(\\w+)" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "optional.of",
+                  args: [{ id: "8", constExpr: { stringValue: "A" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.extract('The color is red', r'The color is (\\w+)') == optional.of('red')",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "The color is red"^#*expr.Constant_StringValue#,\n    "The color is (\\\\w+)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.of(\n    "red"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extract("The color is red", "The color is (\\\\w+)") == optional.of("red")',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "The color is red"^#3[1,14]#,\n    "The color is (\\\\w+)"^#4[1,34]#\n  )^#2[1,13]#,\n  optional^#6[1,60]#.of(\n    "red"^#8[1,72]#\n  )^#7[1,71]#\n)^#5[1,57]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 32, 1, 14, 1, 32],
+        [4, 34, 55, 1, 34, 1, 55],
+        [5, 57, 59, 1, 57, 1, 59],
+        [6, 60, 68, 1, 60, 1, 68],
+        [7, 71, 72, 1, 71, 1, 72],
+        [8, 72, 77, 1, 72, 1, 77],
+      ],
+      lineOffsets: [79],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "The color is red" } },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "The color is (\\w+)" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  target: { id: "6", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [{ id: "8", constExpr: { stringValue: "red" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [79],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 34,
+            "5": 57,
+            "6": 60,
+            "7": 71,
+            "8": 72,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "The color is red"~string,\n    "The color is (\\\\w+)"~string\n  )~optional_type(string)^regex_extract_string_string,\n  optional.of(\n    "red"~string\n  )~optional_type(string)^optional_of\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["optional_of"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [79],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 34,
+            "5": 57,
+            "6": 60,
+            "7": 71,
+            "8": 72,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "The color is red" } },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "The color is (\\w+)" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "optional.of",
+                  args: [{ id: "8", constExpr: { stringValue: "red" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.extract('The color is red', r'The color is \\w+') == optional.of('The color is red')",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "The color is red"^#*expr.Constant_StringValue#,\n    "The color is \\\\w+"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.of(\n    "The color is red"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extract("The color is red", "The color is \\\\w+") == optional.of("The color is red")',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "The color is red"^#3[1,14]#,\n    "The color is \\\\w+"^#4[1,34]#\n  )^#2[1,13]#,\n  optional^#6[1,58]#.of(\n    "The color is red"^#8[1,70]#\n  )^#7[1,69]#\n)^#5[1,55]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 32, 1, 14, 1, 32],
+        [4, 34, 53, 1, 34, 1, 53],
+        [5, 55, 57, 1, 55, 1, 57],
+        [6, 58, 66, 1, 58, 1, 66],
+        [7, 69, 70, 1, 69, 1, 70],
+        [8, 70, 88, 1, 70, 1, 88],
+      ],
+      lineOffsets: [90],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "The color is red" } },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "The color is \\w+" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  target: { id: "6", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [
+                    { id: "8", constExpr: { stringValue: "The color is red" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [90],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 34,
+            "5": 55,
+            "6": 58,
+            "7": 69,
+            "8": 70,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "The color is red"~string,\n    "The color is \\\\w+"~string\n  )~optional_type(string)^regex_extract_string_string,\n  optional.of(\n    "The color is red"~string\n  )~optional_type(string)^optional_of\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["optional_of"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [90],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 34,
+            "5": 55,
+            "6": 58,
+            "7": 69,
+            "8": 70,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "The color is red" } },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "The color is \\w+" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "optional.of",
+                  args: [
+                    { id: "8", constExpr: { stringValue: "The color is red" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: "regex.extract('brand', 'brand') == optional.of('brand')",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "brand"^#*expr.Constant_StringValue#,\n    "brand"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.of(\n    "brand"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extract("brand", "brand") == optional.of("brand")',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "brand"^#3[1,14]#,\n    "brand"^#4[1,23]#\n  )^#2[1,13]#,\n  optional^#6[1,35]#.of(\n    "brand"^#8[1,47]#\n  )^#7[1,46]#\n)^#5[1,32]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+        [4, 23, 30, 1, 23, 1, 30],
+        [5, 32, 34, 1, 32, 1, 34],
+        [6, 35, 43, 1, 35, 1, 43],
+        [7, 46, 47, 1, 46, 1, 47],
+        [8, 47, 54, 1, 47, 1, 54],
+      ],
+      lineOffsets: [56],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "brand" } },
+                    { id: "4", constExpr: { stringValue: "brand" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  target: { id: "6", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [{ id: "8", constExpr: { stringValue: "brand" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 23,
+            "5": 32,
+            "6": 35,
+            "7": 46,
+            "8": 47,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "brand"~string,\n    "brand"~string\n  )~optional_type(string)^regex_extract_string_string,\n  optional.of(\n    "brand"~string\n  )~optional_type(string)^optional_of\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["optional_of"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 23,
+            "5": 32,
+            "6": 35,
+            "7": 46,
+            "8": 47,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "brand" } },
+                    { id: "4", constExpr: { stringValue: "brand" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "optional.of",
+                  args: [{ id: "8", constExpr: { stringValue: "brand" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.extract('hello world', 'goodbye (.*)') == optional.none()",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "hello world"^#*expr.Constant_StringValue#,\n    "goodbye (.*)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extract("hello world", "goodbye (.*)") == optional.none()',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "hello world"^#3[1,14]#,\n    "goodbye (.*)"^#4[1,29]#\n  )^#2[1,13]#,\n  optional^#6[1,48]#.none()^#7[1,61]#\n)^#5[1,45]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 27, 1, 14, 1, 27],
+        [4, 29, 43, 1, 29, 1, 43],
+        [5, 45, 47, 1, 45, 1, 47],
+        [6, 48, 56, 1, 48, 1, 56],
+        [7, 61, 62, 1, 61, 1, 62],
+      ],
+      lineOffsets: [64],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello world" } },
+                    { id: "4", constExpr: { stringValue: "goodbye (.*)" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  target: { id: "6", identExpr: { name: "optional" } },
+                  function: "none",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 29,
+            "5": 45,
+            "6": 48,
+            "7": 61,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "hello world"~string,\n    "goodbye (.*)"~string\n  )~optional_type(string)^regex_extract_string_string,\n  optional.none()~optional_type(string)^optional_none\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["optional_none"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 29,
+            "5": 45,
+            "6": 48,
+            "7": 61,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello world" } },
+                    { id: "4", constExpr: { stringValue: "goodbye (.*)" } },
+                  ],
+                },
+              },
+              { id: "7", callExpr: { function: "optional.none" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.extract('HELLO', 'hello') == optional.none()",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "HELLO"^#*expr.Constant_StringValue#,\n    "hello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extract("HELLO", "hello") == optional.none()',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "HELLO"^#3[1,14]#,\n    "hello"^#4[1,23]#\n  )^#2[1,13]#,\n  optional^#6[1,35]#.none()^#7[1,48]#\n)^#5[1,32]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+        [4, 23, 30, 1, 23, 1, 30],
+        [5, 32, 34, 1, 32, 1, 34],
+        [6, 35, 43, 1, 35, 1, 43],
+        [7, 48, 49, 1, 48, 1, 49],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "HELLO" } },
+                    { id: "4", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  target: { id: "6", identExpr: { name: "optional" } },
+                  function: "none",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 23,
+            "5": 32,
+            "6": 35,
+            "7": 48,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "HELLO"~string,\n    "hello"~string\n  )~optional_type(string)^regex_extract_string_string,\n  optional.none()~optional_type(string)^optional_none\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["optional_none"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 23,
+            "5": 32,
+            "6": 35,
+            "7": 48,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "HELLO" } },
+                    { id: "4", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+              { id: "7", callExpr: { function: "optional.none" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.extract('', r'\\w+') == optional.none()",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    ""^#*expr.Constant_StringValue#,\n    "\\\\w+"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.none()^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extract("", "\\\\w+") == optional.none()',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    ""^#3[1,14]#,\n    "\\\\w+"^#4[1,18]#\n  )^#2[1,13]#,\n  optional^#6[1,29]#.none()^#7[1,42]#\n)^#5[1,26]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 24, 1, 18, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 37, 1, 29, 1, 37],
+        [7, 42, 43, 1, 42, 1, 43],
+      ],
+      lineOffsets: [45],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { stringValue: "\\w+" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  target: { id: "6", identExpr: { name: "optional" } },
+                  function: "none",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 18,
+            "5": 26,
+            "6": 29,
+            "7": 42,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    ""~string,\n    "\\\\w+"~string\n  )~optional_type(string)^regex_extract_string_string,\n  optional.none()~optional_type(string)^optional_none\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["equals"] },
+          "7": { overloadId: ["optional_none"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [45],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 18,
+            "5": 26,
+            "6": 29,
+            "7": 42,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extract",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { stringValue: "\\w+" } },
+                  ],
+                },
+              },
+              { id: "7", callExpr: { function: "optional.none" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "1844674407370955266" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.extract('4122345432', '22').or(optional.of('777')) == optional.of('22')",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "4122345432"^#*expr.Constant_StringValue#,\n    "22"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#.or(\n    optional^#*expr.Expr_IdentExpr#.of(\n      "777"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  optional^#*expr.Expr_IdentExpr#.of(\n    "22"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extract("4122345432", "22").or(optional.of("777")) == optional.of("22")',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "4122345432"^#3[1,14]#,\n    "22"^#4[1,28]#\n  )^#2[1,13]#.or(\n    optional^#6[1,37]#.of(\n      "777"^#8[1,49]#\n    )^#7[1,48]#\n  )^#5[1,36]#,\n  optional^#10[1,60]#.of(\n    "22"^#12[1,72]#\n  )^#11[1,71]#\n)^#9[1,57]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 26, 1, 14, 1, 26],
+        [4, 28, 32, 1, 28, 1, 32],
+        [5, 36, 37, 1, 36, 1, 37],
+        [6, 37, 45, 1, 37, 1, 45],
+        [7, 48, 49, 1, 48, 1, 49],
+        [8, 49, 54, 1, 49, 1, 54],
+        [9, 57, 59, 1, 57, 1, 59],
+        [10, 60, 68, 1, 60, 1, 68],
+        [11, 71, 72, 1, 71, 1, 72],
+        [12, 72, 76, 1, 72, 1, 76],
+      ],
+      lineOffsets: [78],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      target: { id: "1", identExpr: { name: "regex" } },
+                      function: "extract",
+                      args: [
+                        { id: "3", constExpr: { stringValue: "4122345432" } },
+                        { id: "4", constExpr: { stringValue: "22" } },
+                      ],
+                    },
+                  },
+                  function: "or",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        target: { id: "6", identExpr: { name: "optional" } },
+                        function: "of",
+                        args: [{ id: "8", constExpr: { stringValue: "777" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  target: { id: "10", identExpr: { name: "optional" } },
+                  function: "of",
+                  args: [{ id: "12", constExpr: { stringValue: "22" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [78],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 28,
+            "5": 36,
+            "6": 37,
+            "7": 48,
+            "8": 49,
+            "9": 57,
+            "10": 60,
+            "11": 71,
+            "12": 72,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "4122345432"~string,\n    "22"~string\n  )~optional_type(string)^regex_extract_string_string.or(\n    optional.of(\n      "777"~string\n    )~optional_type(string)^optional_of\n  )~optional_type(string)^optional_or_optional,\n  optional.of(\n    "22"~string\n  )~optional_type(string)^optional_of\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["optional_or_optional"] },
+          "7": { overloadId: ["optional_of"] },
+          "9": { overloadId: ["equals"] },
+          "11": { overloadId: ["optional_of"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "7": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "11": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "12": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [78],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 28,
+            "5": 36,
+            "6": 37,
+            "7": 48,
+            "8": 49,
+            "9": 57,
+            "10": 60,
+            "11": 71,
+            "12": 72,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      function: "regex.extract",
+                      args: [
+                        { id: "3", constExpr: { stringValue: "4122345432" } },
+                        { id: "4", constExpr: { stringValue: "22" } },
+                      ],
+                    },
+                  },
+                  function: "or",
+                  args: [
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "optional.of",
+                        args: [{ id: "8", constExpr: { stringValue: "777" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "11",
+                callExpr: {
+                  function: "optional.of",
+                  args: [{ id: "12", constExpr: { stringValue: "22" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "1844674407370955268" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "regex.extract('4122345432', '22').orValue('777') == '22'",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extract(\n    "4122345432"^#*expr.Constant_StringValue#,\n    "22"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#.orValue(\n    "777"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "22"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extract("4122345432", "22").orValue("777") == "22"',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extract(\n    "4122345432"^#3[1,14]#,\n    "22"^#4[1,28]#\n  )^#2[1,13]#.orValue(\n    "777"^#6[1,42]#\n  )^#5[1,41]#,\n  "22"^#8[1,52]#\n)^#7[1,49]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 26, 1, 14, 1, 26],
+        [4, 28, 32, 1, 28, 1, 32],
+        [5, 41, 42, 1, 41, 1, 42],
+        [6, 42, 47, 1, 42, 1, 47],
+        [7, 49, 51, 1, 49, 1, 51],
+        [8, 52, 56, 1, 52, 1, 56],
+      ],
+      lineOffsets: [57],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      target: { id: "1", identExpr: { name: "regex" } },
+                      function: "extract",
+                      args: [
+                        { id: "3", constExpr: { stringValue: "4122345432" } },
+                        { id: "4", constExpr: { stringValue: "22" } },
+                      ],
+                    },
+                  },
+                  function: "orValue",
+                  args: [{ id: "6", constExpr: { stringValue: "777" } }],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "22" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [57],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 28,
+            "5": 41,
+            "6": 42,
+            "7": 49,
+            "8": 52,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extract(\n    "4122345432"~string,\n    "22"~string\n  )~optional_type(string)^regex_extract_string_string.orValue(\n    "777"~string\n  )~string^optional_orValue_value,\n  "22"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extract_string_string"] },
+          "5": { overloadId: ["optional_orValue_value"] },
+          "7": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [57],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 28,
+            "5": 41,
+            "6": 42,
+            "7": 49,
+            "8": 52,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      function: "regex.extract",
+                      args: [
+                        { id: "3", constExpr: { stringValue: "4122345432" } },
+                        { id: "4", constExpr: { stringValue: "22" } },
+                      ],
+                    },
+                  },
+                  function: "orValue",
+                  args: [{ id: "6", constExpr: { stringValue: "777" } }],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "22" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('id:123, id:456', 'assa') == []",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "id:123, id:456"^#*expr.Constant_StringValue#,\n    "assa"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extractAll("id:123, id:456", "assa") == []',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "id:123, id:456"^#3[1,17]#,\n    "assa"^#4[1,35]#\n  )^#2[1,16]#,\n  []^#6[1,46]#\n)^#5[1,43]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 33, 1, 17, 1, 33],
+        [4, 35, 41, 1, 35, 1, 41],
+        [5, 43, 45, 1, 43, 1, 45],
+        [6, 46, 47, 1, 46, 1, 47],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "id:123, id:456" } },
+                    { id: "4", constExpr: { stringValue: "assa" } },
+                  ],
+                },
+              },
+              { id: "6", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 35, "5": 43, "6": 46 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "id:123, id:456"~string,\n    "assa"~string\n  )~list(string)^regex_extractAll_string_string,\n  []~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 35, "5": 43, "6": 46 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "id:123, id:456" } },
+                    { id: "4", constExpr: { stringValue: "assa" } },
+                  ],
+                },
+              },
+              { id: "6", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "11" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('id:123, id:456', r'id:\\d+') == ['id:123', 'id:456']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "id:123, id:456"^#*expr.Constant_StringValue#,\n    "id:\\\\d+"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "id:123"^#*expr.Constant_StringValue#,\n    "id:456"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("id:123, id:456", "id:\\\\d+") == ["id:123", "id:456"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "id:123, id:456"^#3[1,17]#,\n    "id:\\\\d+"^#4[1,35]#\n  )^#2[1,16]#,\n  [\n    "id:123"^#7[1,50]#,\n    "id:456"^#8[1,60]#\n  ]^#6[1,49]#\n)^#5[1,46]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 33, 1, 17, 1, 33],
+        [4, 35, 44, 1, 35, 1, 44],
+        [5, 46, 48, 1, 46, 1, 48],
+        [6, 49, 50, 1, 49, 1, 50],
+        [7, 50, 58, 1, 50, 1, 58],
+        [8, 60, 68, 1, 60, 1, 68],
+      ],
+      lineOffsets: [70],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "id:123, id:456" } },
+                    { id: "4", constExpr: { stringValue: "id:\\d+" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "id:123" } },
+                    { id: "8", constExpr: { stringValue: "id:456" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [70],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 35,
+            "5": 46,
+            "6": 49,
+            "7": 50,
+            "8": 60,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "id:123, id:456"~string,\n    "id:\\\\d+"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "id:123"~string,\n    "id:456"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [70],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 35,
+            "5": 46,
+            "6": 49,
+            "7": 50,
+            "8": 60,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "id:123, id:456" } },
+                    { id: "4", constExpr: { stringValue: "id:\\d+" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "id:123" } },
+                    { id: "8", constExpr: { stringValue: "id:456" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('Files: f_1.txt, f_2.csv', r'f_(\\d+)') == ['1', '2']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "Files: f_1.txt, f_2.csv"^#*expr.Constant_StringValue#,\n    "f_(\\\\d+)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "1"^#*expr.Constant_StringValue#,\n    "2"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("Files: f_1.txt, f_2.csv", "f_(\\\\d+)") == ["1", "2"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "Files: f_1.txt, f_2.csv"^#3[1,17]#,\n    "f_(\\\\d+)"^#4[1,44]#\n  )^#2[1,16]#,\n  [\n    "1"^#7[1,60]#,\n    "2"^#8[1,65]#\n  ]^#6[1,59]#\n)^#5[1,56]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 42, 1, 17, 1, 42],
+        [4, 44, 54, 1, 44, 1, 54],
+        [5, 56, 58, 1, 56, 1, 58],
+        [6, 59, 60, 1, 59, 1, 60],
+        [7, 60, 63, 1, 60, 1, 63],
+        [8, 65, 68, 1, 65, 1, 68],
+      ],
+      lineOffsets: [70],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "Files: f_1.txt, f_2.csv" },
+                    },
+                    { id: "4", constExpr: { stringValue: "f_(\\d+)" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "1" } },
+                    { id: "8", constExpr: { stringValue: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [70],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 44,
+            "5": 56,
+            "6": 59,
+            "7": 60,
+            "8": 65,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "Files: f_1.txt, f_2.csv"~string,\n    "f_(\\\\d+)"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "1"~string,\n    "2"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [70],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 44,
+            "5": 56,
+            "6": 59,
+            "7": 60,
+            "8": 65,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "Files: f_1.txt, f_2.csv" },
+                    },
+                    { id: "4", constExpr: { stringValue: "f_(\\d+)" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "1" } },
+                    { id: "8", constExpr: { stringValue: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('testuser@', '(?P\u003cusername\u003e.*)@') == ['testuser']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "testuser@"^#*expr.Constant_StringValue#,\n    "(?P\u003cusername\u003e.*)@"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "testuser"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("testuser@", "(?P\u003cusername\u003e.*)@") == ["testuser"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "testuser@"^#3[1,17]#,\n    "(?P\u003cusername\u003e.*)@"^#4[1,30]#\n  )^#2[1,16]#,\n  [\n    "testuser"^#7[1,55]#\n  ]^#6[1,54]#\n)^#5[1,51]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 28, 1, 17, 1, 28],
+        [4, 30, 49, 1, 30, 1, 49],
+        [5, 51, 53, 1, 51, 1, 53],
+        [6, 54, 55, 1, 54, 1, 55],
+        [7, 55, 65, 1, 55, 1, 65],
+      ],
+      lineOffsets: [67],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "testuser@" } },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "(?P\u003cusername\u003e.*)@" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "testuser" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 30,
+            "5": 51,
+            "6": 54,
+            "7": 55,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "testuser@"~string,\n    "(?P\u003cusername\u003e.*)@"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "testuser"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [67],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 30,
+            "5": 51,
+            "6": 54,
+            "7": 55,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "testuser@" } },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "(?P\u003cusername\u003e.*)@" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "testuser" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com', '(?P\u003cusername\u003e.*)@') == ['testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com"^#*expr.Constant_StringValue#,\n    "(?P\u003cusername\u003e.*)@"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com", "(?P\u003cusername\u003e.*)@") == ["testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com"^#3[1,17]#,\n    "(?P\u003cusername\u003e.*)@"^#4[1,78]#\n  )^#2[1,16]#,\n  [\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2"^#7[1,103]#\n  ]^#6[1,102]#\n)^#5[1,99]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 76, 1, 17, 1, 76],
+        [4, 78, 97, 1, 78, 1, 97],
+        [5, 99, 101, 1, 99, 1, 101],
+        [6, 102, 103, 1, 102, 1, 103],
+        [7, 103, 153, 1, 103, 1, 153],
+      ],
+      lineOffsets: [155],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue:
+                          "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com",
+                      },
+                    },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "(?P\u003cusername\u003e.*)@" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "7",
+                      constExpr: {
+                        stringValue:
+                          "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [155],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 78,
+            "5": 99,
+            "6": 102,
+            "7": 103,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com"~string,\n    "(?P\u003cusername\u003e.*)@"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [155],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 78,
+            "5": 99,
+            "6": 102,
+            "7": 103,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue:
+                          "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com",
+                      },
+                    },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "(?P\u003cusername\u003e.*)@" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "7",
+                      constExpr: {
+                        stringValue:
+                          "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2",
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com', r'(?P\u003cusername\u003e\\w+)@') == ['testuser', 'a', '2312321wsamkldjq2w2']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com"^#*expr.Constant_StringValue#,\n    "(?P\u003cusername\u003e\\\\w+)@"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "testuser"^#*expr.Constant_StringValue#,\n    "a"^#*expr.Constant_StringValue#,\n    "2312321wsamkldjq2w2"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com", "(?P\u003cusername\u003e\\\\w+)@") == ["testuser", "a", "2312321wsamkldjq2w2"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com"^#3[1,17]#,\n    "(?P\u003cusername\u003e\\\\w+)@"^#4[1,78]#\n  )^#2[1,16]#,\n  [\n    "testuser"^#7[1,105]#,\n    "a"^#8[1,117]#,\n    "2312321wsamkldjq2w2"^#9[1,122]#\n  ]^#6[1,104]#\n)^#5[1,101]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 76, 1, 17, 1, 76],
+        [4, 78, 99, 1, 78, 1, 99],
+        [5, 101, 103, 1, 101, 1, 103],
+        [6, 104, 105, 1, 104, 1, 105],
+        [7, 105, 115, 1, 105, 1, 115],
+        [8, 117, 120, 1, 117, 1, 120],
+        [9, 122, 143, 1, 122, 1, 143],
+      ],
+      lineOffsets: [145],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue:
+                          "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com",
+                      },
+                    },
+                    {
+                      id: "4",
+                      constExpr: {
+                        stringValue: "(?P\u003cusername\u003e\\w+)@",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "testuser" } },
+                    { id: "8", constExpr: { stringValue: "a" } },
+                    {
+                      id: "9",
+                      constExpr: { stringValue: "2312321wsamkldjq2w2" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [145],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 78,
+            "5": 101,
+            "6": 104,
+            "7": 105,
+            "8": 117,
+            "9": 122,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com"~string,\n    "(?P\u003cusername\u003e\\\\w+)@"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "testuser"~string,\n    "a"~string,\n    "2312321wsamkldjq2w2"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [145],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 78,
+            "5": 101,
+            "6": 104,
+            "7": 105,
+            "8": 117,
+            "9": 122,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue:
+                          "testuser@gmail.com, a@y.com, 2312321wsamkldjq2w2@sdad.com",
+                      },
+                    },
+                    {
+                      id: "4",
+                      constExpr: {
+                        stringValue: "(?P\u003cusername\u003e\\w+)@",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "testuser" } },
+                    { id: "8", constExpr: { stringValue: "a" } },
+                    {
+                      id: "9",
+                      constExpr: { stringValue: "2312321wsamkldjq2w2" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('banananana', '(ana)') == ['ana', 'ana']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "banananana"^#*expr.Constant_StringValue#,\n    "(ana)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "ana"^#*expr.Constant_StringValue#,\n    "ana"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extractAll("banananana", "(ana)") == ["ana", "ana"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "banananana"^#3[1,17]#,\n    "(ana)"^#4[1,31]#\n  )^#2[1,16]#,\n  [\n    "ana"^#7[1,44]#,\n    "ana"^#8[1,51]#\n  ]^#6[1,43]#\n)^#5[1,40]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 29, 1, 17, 1, 29],
+        [4, 31, 38, 1, 31, 1, 38],
+        [5, 40, 42, 1, 40, 1, 42],
+        [6, 43, 44, 1, 43, 1, 44],
+        [7, 44, 49, 1, 44, 1, 49],
+        [8, 51, 56, 1, 51, 1, 56],
+      ],
+      lineOffsets: [58],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banananana" } },
+                    { id: "4", constExpr: { stringValue: "(ana)" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "ana" } },
+                    { id: "8", constExpr: { stringValue: "ana" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 31,
+            "5": 40,
+            "6": 43,
+            "7": 44,
+            "8": 51,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "banananana"~string,\n    "(ana)"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "ana"~string,\n    "ana"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 31,
+            "5": 40,
+            "6": 43,
+            "7": 44,
+            "8": 51,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "banananana" } },
+                    { id: "4", constExpr: { stringValue: "(ana)" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "ana" } },
+                    { id: "8", constExpr: { stringValue: "ana" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('item:a1, topic:b2', r'(?:item:|topic:)([a-z]\\d)') == ['a1', 'b2']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "item:a1, topic:b2"^#*expr.Constant_StringValue#,\n    "(?:item:|topic:)([a-z]\\\\d)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "a1"^#*expr.Constant_StringValue#,\n    "b2"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("item:a1, topic:b2", "(?:item:|topic:)([a-z]\\\\d)") == ["a1", "b2"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "item:a1, topic:b2"^#3[1,17]#,\n    "(?:item:|topic:)([a-z]\\\\d)"^#4[1,38]#\n  )^#2[1,16]#,\n  [\n    "a1"^#7[1,72]#,\n    "b2"^#8[1,78]#\n  ]^#6[1,71]#\n)^#5[1,68]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 36, 1, 17, 1, 36],
+        [4, 38, 66, 1, 38, 1, 66],
+        [5, 68, 70, 1, 68, 1, 70],
+        [6, 71, 72, 1, 71, 1, 72],
+        [7, 72, 76, 1, 72, 1, 76],
+        [8, 78, 82, 1, 78, 1, 82],
+      ],
+      lineOffsets: [84],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "item:a1, topic:b2" },
+                    },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "(?:item:|topic:)([a-z]\\d)" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "a1" } },
+                    { id: "8", constExpr: { stringValue: "b2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [84],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 38,
+            "5": 68,
+            "6": 71,
+            "7": 72,
+            "8": 78,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "item:a1, topic:b2"~string,\n    "(?:item:|topic:)([a-z]\\\\d)"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "a1"~string,\n    "b2"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [84],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 38,
+            "5": 68,
+            "6": 71,
+            "7": 72,
+            "8": 78,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "item:a1, topic:b2" },
+                    },
+                    {
+                      id: "4",
+                      constExpr: { stringValue: "(?:item:|topic:)([a-z]\\d)" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "a1" } },
+                    { id: "8", constExpr: { stringValue: "b2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('val=a, val=, val=c', 'val=([^,]*)') == ['a', 'c']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "val=a, val=, val=c"^#*expr.Constant_StringValue#,\n    "val=([^,]*)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("val=a, val=, val=c", "val=([^,]*)") == ["a", "c"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "val=a, val=, val=c"^#3[1,17]#,\n    "val=([^,]*)"^#4[1,39]#\n  )^#2[1,16]#,\n  [\n    "a"^#7[1,58]#,\n    "c"^#8[1,63]#\n  ]^#6[1,57]#\n)^#5[1,54]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 37, 1, 17, 1, 37],
+        [4, 39, 52, 1, 39, 1, 52],
+        [5, 54, 56, 1, 54, 1, 56],
+        [6, 57, 58, 1, 57, 1, 58],
+        [7, 58, 61, 1, 58, 1, 61],
+        [8, 63, 66, 1, 63, 1, 66],
+      ],
+      lineOffsets: [68],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "val=a, val=, val=c" },
+                    },
+                    { id: "4", constExpr: { stringValue: "val=([^,]*)" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "a" } },
+                    { id: "8", constExpr: { stringValue: "c" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [68],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 39,
+            "5": 54,
+            "6": 57,
+            "7": 58,
+            "8": 63,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "val=a, val=, val=c"~string,\n    "val=([^,]*)"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "a"~string,\n    "c"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [68],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 39,
+            "5": 54,
+            "6": 57,
+            "7": 58,
+            "8": 63,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "val=a, val=, val=c" },
+                    },
+                    { id: "4", constExpr: { stringValue: "val=([^,]*)" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "a" } },
+                    { id: "8", constExpr: { stringValue: "c" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('key=, key=, key=', 'key=([^,]*)') == []",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "key=, key=, key="^#*expr.Constant_StringValue#,\n    "key=([^,]*)"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extractAll("key=, key=, key=", "key=([^,]*)") == []',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "key=, key=, key="^#3[1,17]#,\n    "key=([^,]*)"^#4[1,37]#\n  )^#2[1,16]#,\n  []^#6[1,55]#\n)^#5[1,52]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 35, 1, 17, 1, 35],
+        [4, 37, 50, 1, 37, 1, 50],
+        [5, 52, 54, 1, 52, 1, 54],
+        [6, 55, 56, 1, 55, 1, 56],
+      ],
+      lineOffsets: [58],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "key=, key=, key=" } },
+                    { id: "4", constExpr: { stringValue: "key=([^,]*)" } },
+                  ],
+                },
+              },
+              { id: "6", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 37, "5": 52, "6": 55 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "key=, key=, key="~string,\n    "key=([^,]*)"~string\n  )~list(string)^regex_extractAll_string_string,\n  []~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 37, "5": 52, "6": 55 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "key=, key=, key=" } },
+                    { id: "4", constExpr: { stringValue: "key=([^,]*)" } },
+                  ],
+                },
+              },
+              { id: "6", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "11" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('a b c', r'(\\S*)\\s*') == ['a', 'b', 'c']",
+        value: { boolValue: true },
+      },
+      section: "TestRegex",
+      library: "regex",
+      ast: '_==_(\n  regex^#*expr.Expr_IdentExpr#.extractAll(\n    "a b c"^#*expr.Constant_StringValue#,\n    "(\\\\S*)\\\\s*"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#,\n    "c"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("a b c", "(\\\\S*)\\\\s*") == ["a", "b", "c"]',
+      locationAst:
+        '_==_(\n  regex^#1[1,0]#.extractAll(\n    "a b c"^#3[1,17]#,\n    "(\\\\S*)\\\\s*"^#4[1,26]#\n  )^#2[1,16]#,\n  [\n    "a"^#7[1,43]#,\n    "b"^#8[1,48]#,\n    "c"^#9[1,53]#\n  ]^#6[1,42]#\n)^#5[1,39]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 24, 1, 17, 1, 24],
+        [4, 26, 37, 1, 26, 1, 37],
+        [5, 39, 41, 1, 39, 1, 41],
+        [6, 42, 43, 1, 42, 1, 43],
+        [7, 43, 46, 1, 43, 1, 46],
+        [8, 48, 51, 1, 48, 1, 51],
+        [9, 53, 56, 1, 53, 1, 56],
+      ],
+      lineOffsets: [58],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "regex" } },
+                  function: "extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a b c" } },
+                    { id: "4", constExpr: { stringValue: "(\\S*)\\s*" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "a" } },
+                    { id: "8", constExpr: { stringValue: "b" } },
+                    { id: "9", constExpr: { stringValue: "c" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 26,
+            "5": 39,
+            "6": 42,
+            "7": 43,
+            "8": 48,
+            "9": 53,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  regex.extractAll(\n    "a b c"~string,\n    "(\\\\S*)\\\\s*"~string\n  )~list(string)^regex_extractAll_string_string,\n  [\n    "a"~string,\n    "b"~string,\n    "c"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: {
+            "1": 0,
+            "2": 16,
+            "3": 17,
+            "4": 26,
+            "5": 39,
+            "6": 42,
+            "7": 43,
+            "8": 48,
+            "9": 53,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "regex.extractAll",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a b c" } },
+                    { id: "4", constExpr: { stringValue: "(\\S*)\\s*" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "a" } },
+                    { id: "8", constExpr: { stringValue: "b" } },
+                    { id: "9", constExpr: { stringValue: "c" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: { expr: "regex.replace('abc', '^', 1)" },
+      section: "TestRegexStaticErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.replace(\n  "abc"^#*expr.Constant_StringValue#,\n  "^"^#*expr.Constant_StringValue#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("abc", "^", 1)',
+      locationAst:
+        'regex^#1[1,0]#.replace(\n  "abc"^#3[1,14]#,\n  "^"^#4[1,21]#,\n  1^#5[1,26]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 19, 1, 14, 1, 19],
+        [4, 21, 24, 1, 21, 1, 24],
+        [5, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "abc" } },
+              { id: "4", constExpr: { stringValue: "^" } },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 21, "5": 26 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: found no matching overload for 'regex.replace' applied to '(string, string, int)'\n | regex.replace('abc', '^', 1)\n | .............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'regex.replace' applied to '(string, string, int)'",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedError:
+        "found no matching overload for 'regex.replace' applied to '(string, string, int)'",
+    },
+    {
+      original: { expr: "regex.replace('abc', '^', '1','')" },
+      section: "TestRegexStaticErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.replace(\n  "abc"^#*expr.Constant_StringValue#,\n  "^"^#*expr.Constant_StringValue#,\n  "1"^#*expr.Constant_StringValue#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("abc", "^", "1", "")',
+      locationAst:
+        'regex^#1[1,0]#.replace(\n  "abc"^#3[1,14]#,\n  "^"^#4[1,21]#,\n  "1"^#5[1,26]#,\n  ""^#6[1,30]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 19, 1, 14, 1, 19],
+        [4, 21, 24, 1, 21, 1, 24],
+        [5, 26, 29, 1, 26, 1, 29],
+        [6, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "abc" } },
+              { id: "4", constExpr: { stringValue: "^" } },
+              { id: "5", constExpr: { stringValue: "1" } },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 21, "5": 26, "6": 30 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: found no matching overload for 'regex.replace' applied to '(string, string, string, string)'\n | regex.replace('abc', '^', '1','')\n | .............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'regex.replace' applied to '(string, string, string, string)'",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedError:
+        "found no matching overload for 'regex.replace' applied to '(string, string, string, string)'",
+    },
+    {
+      original: { expr: "regex.extract('foo bar', 1)" },
+      section: "TestRegexStaticErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.extract(\n  "foo bar"^#*expr.Constant_StringValue#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extract("foo bar", 1)',
+      locationAst:
+        'regex^#1[1,0]#.extract(\n  "foo bar"^#3[1,14]#,\n  1^#4[1,25]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extract",
+            args: [
+              { id: "3", constExpr: { stringValue: "foo bar" } },
+              { id: "4", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 25 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: found no matching overload for 'regex.extract' applied to '(string, int)'\n | regex.extract('foo bar', 1)\n | .............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'regex.extract' applied to '(string, int)'",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedError:
+        "found no matching overload for 'regex.extract' applied to '(string, int)'",
+    },
+    {
+      original: { expr: "regex.extract('foo bar', 1, 'bar')" },
+      section: "TestRegexStaticErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.extract(\n  "foo bar"^#*expr.Constant_StringValue#,\n  1^#*expr.Constant_Int64Value#,\n  "bar"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extract("foo bar", 1, "bar")',
+      locationAst:
+        'regex^#1[1,0]#.extract(\n  "foo bar"^#3[1,14]#,\n  1^#4[1,25]#,\n  "bar"^#5[1,28]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 28, 33, 1, 28, 1, 33],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extract",
+            args: [
+              { id: "3", constExpr: { stringValue: "foo bar" } },
+              { id: "4", constExpr: { int64Value: "1" } },
+              { id: "5", constExpr: { stringValue: "bar" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 25, "5": 28 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: found no matching overload for 'regex.extract' applied to '(string, int, string)'\n | regex.extract('foo bar', 1, 'bar')\n | .............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'regex.extract' applied to '(string, int, string)'",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedError:
+        "found no matching overload for 'regex.extract' applied to '(string, int, string)'",
+    },
+    {
+      original: { expr: "regex.extractAll()" },
+      section: "TestRegexStaticErrors",
+      library: "regex",
+      ast: "regex^#*expr.Expr_IdentExpr#.extractAll()^#*expr.Expr_CallExpr#",
+      unparsed: "regex.extractAll()",
+      locationAst: "regex^#1[1,0]#.extractAll()^#2[1,16]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extractAll",
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 16 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:17: found no matching overload for 'regex.extractAll' applied to '()'\n | regex.extractAll()\n | ................^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'regex.extractAll' applied to '()'",
+          id: 2,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedError:
+        "found no matching overload for 'regex.extractAll' applied to '()'",
+    },
+    {
+      original: {
+        expr: "regex.extract('foo bar', '(')",
+        evalError: {
+          errors: [
+            {
+              message:
+                "given regex is invalid: error parsing regexp: missing closing ): `(`",
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.extract(\n  "foo bar"^#*expr.Constant_StringValue#,\n  "("^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extract("foo bar", "(")',
+      locationAst:
+        'regex^#1[1,0]#.extract(\n  "foo bar"^#3[1,14]#,\n  "("^#4[1,25]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 23, 1, 14, 1, 23],
+        [4, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extract",
+            args: [
+              { id: "3", constExpr: { stringValue: "foo bar" } },
+              { id: "4", constExpr: { stringValue: "(" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 25 },
+        },
+      },
+      checkedAst:
+        'regex.extract(\n  "foo bar"~string,\n  "("~string\n)~optional_type(string)^regex_extract_string_string',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["regex_extract_string_string"] } },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 25 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.extract",
+            args: [
+              { id: "3", constExpr: { stringValue: "foo bar" } },
+              { id: "4", constExpr: { stringValue: "(" } },
+            ],
+          },
+        },
+      },
+      type: "optional_type(string)",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "given regex is invalid: error parsing regexp: missing closing ): `(`",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('foo bar', '[a-z')",
+        evalError: {
+          errors: [
+            {
+              message:
+                "given regex is invalid: error parsing regexp: missing closing ]: `[a-z`",
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.extractAll(\n  "foo bar"^#*expr.Constant_StringValue#,\n  "[a-z"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extractAll("foo bar", "[a-z")',
+      locationAst:
+        'regex^#1[1,0]#.extractAll(\n  "foo bar"^#3[1,17]#,\n  "[a-z"^#4[1,28]#\n)^#2[1,16]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 26, 1, 17, 1, 26],
+        [4, 28, 34, 1, 28, 1, 34],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extractAll",
+            args: [
+              { id: "3", constExpr: { stringValue: "foo bar" } },
+              { id: "4", constExpr: { stringValue: "[a-z" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 28 },
+        },
+      },
+      checkedAst:
+        'regex.extractAll(\n  "foo bar"~string,\n  "[a-z"~string\n)~list(string)^regex_extractAll_string_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 28 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.extractAll",
+            args: [
+              { id: "3", constExpr: { stringValue: "foo bar" } },
+              { id: "4", constExpr: { stringValue: "[a-z" } },
+            ],
+          },
+        },
+      },
+      type: "list(string)",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "given regex is invalid: error parsing regexp: missing closing ]: `[a-z`",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.replace('id=123', r'id=(?P\u003cvalue\u003e\\d+)', r'value: \\values')",
+        evalError: {
+          errors: [
+            {
+              message:
+                "invalid replacement string: 'value: \\values' \\ must be followed by a digit",
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.replace(\n  "id=123"^#*expr.Constant_StringValue#,\n  "id=(?P\u003cvalue\u003e\\\\d+)"^#*expr.Constant_StringValue#,\n  "value: \\\\values"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("id=123", "id=(?P\u003cvalue\u003e\\\\d+)", "value: \\\\values")',
+      locationAst:
+        'regex^#1[1,0]#.replace(\n  "id=123"^#3[1,14]#,\n  "id=(?P\u003cvalue\u003e\\\\d+)"^#4[1,24]#,\n  "value: \\\\values"^#5[1,46]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 44, 1, 24, 1, 44],
+        [5, 46, 63, 1, 46, 1, 63],
+      ],
+      lineOffsets: [65],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "id=123" } },
+              {
+                id: "4",
+                constExpr: { stringValue: "id=(?P\u003cvalue\u003e\\d+)" },
+              },
+              { id: "5", constExpr: { stringValue: "value: \\values" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [65],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 46 },
+        },
+      },
+      checkedAst:
+        'regex.replace(\n  "id=123"~string,\n  "id=(?P\u003cvalue\u003e\\\\d+)"~string,\n  "value: \\\\values"~string\n)~string^regex_replace_string_string_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [65],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 46 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "id=123" } },
+              {
+                id: "4",
+                constExpr: { stringValue: "id=(?P\u003cvalue\u003e\\d+)" },
+              },
+              { id: "5", constExpr: { stringValue: "value: \\values" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "invalid replacement string: 'value: \\values' \\ must be followed by a digit or \\",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.replace('test', '(.)', r'\\2')",
+        evalError: {
+          errors: [
+            {
+              message:
+                "replacement string references group 2 but regex has only 1 group(s)",
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.replace(\n  "test"^#*expr.Constant_StringValue#,\n  "(.)"^#*expr.Constant_StringValue#,\n  "\\\\2"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("test", "(.)", "\\\\2")',
+      locationAst:
+        'regex^#1[1,0]#.replace(\n  "test"^#3[1,14]#,\n  "(.)"^#4[1,22]#,\n  "\\\\2"^#5[1,29]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 20, 1, 14, 1, 20],
+        [4, 22, 27, 1, 22, 1, 27],
+        [5, 29, 34, 1, 29, 1, 34],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "test" } },
+              { id: "4", constExpr: { stringValue: "(.)" } },
+              { id: "5", constExpr: { stringValue: "\\2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 22, "5": 29 },
+        },
+      },
+      checkedAst:
+        'regex.replace(\n  "test"~string,\n  "(.)"~string,\n  "\\\\2"~string\n)~string^regex_replace_string_string_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 22, "5": 29 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "test" } },
+              { id: "4", constExpr: { stringValue: "(.)" } },
+              { id: "5", constExpr: { stringValue: "\\2" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "replacement string references group 2 but regex has only 1 group(s)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.replace('id=123', r'id=(?P\u003cvalue\u003e\\d+)', r'value: \\')",
+        evalError: {
+          errors: [
+            {
+              message:
+                "invalid replacement string: 'value: \\' \\ not allowed at end",
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.replace(\n  "id=123"^#*expr.Constant_StringValue#,\n  "id=(?P\u003cvalue\u003e\\\\d+)"^#*expr.Constant_StringValue#,\n  "value: \\\\"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.replace("id=123", "id=(?P\u003cvalue\u003e\\\\d+)", "value: \\\\")',
+      locationAst:
+        'regex^#1[1,0]#.replace(\n  "id=123"^#3[1,14]#,\n  "id=(?P\u003cvalue\u003e\\\\d+)"^#4[1,24]#,\n  "value: \\\\"^#5[1,46]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 44, 1, 24, 1, 44],
+        [5, 46, 57, 1, 46, 1, 57],
+      ],
+      lineOffsets: [59],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "id=123" } },
+              {
+                id: "4",
+                constExpr: { stringValue: "id=(?P\u003cvalue\u003e\\d+)" },
+              },
+              { id: "5", constExpr: { stringValue: "value: \\" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 46 },
+        },
+      },
+      checkedAst:
+        'regex.replace(\n  "id=123"~string,\n  "id=(?P\u003cvalue\u003e\\\\d+)"~string,\n  "value: \\\\"~string\n)~string^regex_replace_string_string_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 46 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "id=123" } },
+              {
+                id: "4",
+                constExpr: { stringValue: "id=(?P\u003cvalue\u003e\\d+)" },
+              },
+              { id: "5", constExpr: { stringValue: "value: \\" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "invalid replacement string: 'value: \\' \\ not allowed at end",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.replace('foofoo', 'foo', 'bar', 9223372036854775807)",
+        evalError: { errors: [{ message: "integer overflow" }] },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.replace(\n  "foofoo"^#*expr.Constant_StringValue#,\n  "foo"^#*expr.Constant_StringValue#,\n  "bar"^#*expr.Constant_StringValue#,\n  9223372036854775807^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.replace("foofoo", "foo", "bar", 9223372036854775807)',
+      locationAst:
+        'regex^#1[1,0]#.replace(\n  "foofoo"^#3[1,14]#,\n  "foo"^#4[1,24]#,\n  "bar"^#5[1,31]#,\n  9223372036854775807^#6[1,38]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 29, 1, 24, 1, 29],
+        [5, 31, 36, 1, 31, 1, 36],
+        [6, 38, 57, 1, 38, 1, 57],
+      ],
+      lineOffsets: [59],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "foofoo" } },
+              { id: "4", constExpr: { stringValue: "foo" } },
+              { id: "5", constExpr: { stringValue: "bar" } },
+              { id: "6", constExpr: { int64Value: "9223372036854775807" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 31, "6": 38 },
+        },
+      },
+      checkedAst:
+        'regex.replace(\n  "foofoo"~string,\n  "foo"~string,\n  "bar"~string,\n  9223372036854775807~int\n)~string^regex_replace_string_string_string_int',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_replace_string_string_string_int"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 31, "6": 38 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.replace",
+            args: [
+              { id: "3", constExpr: { stringValue: "foofoo" } },
+              { id: "4", constExpr: { stringValue: "foo" } },
+              { id: "5", constExpr: { stringValue: "bar" } },
+              { id: "6", constExpr: { int64Value: "9223372036854775807" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1" },
+      result: { error: { errors: [{ code: 2, message: "integer overflow" }] } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.extract('phone: 415-5551212', r'phone: ((\\d{3})-)?')",
+        evalError: {
+          errors: [
+            {
+              message:
+                'regular expression has more than one capturing group: "phone: ((\\\\d{3})-)?"',
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.extract(\n  "phone: 415-5551212"^#*expr.Constant_StringValue#,\n  "phone: ((\\\\d{3})-)?"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extract("phone: 415-5551212", "phone: ((\\\\d{3})-)?")',
+      locationAst:
+        'regex^#1[1,0]#.extract(\n  "phone: 415-5551212"^#3[1,14]#,\n  "phone: ((\\\\d{3})-)?"^#4[1,36]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 34, 1, 14, 1, 34],
+        [4, 36, 57, 1, 36, 1, 57],
+      ],
+      lineOffsets: [59],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extract",
+            args: [
+              { id: "3", constExpr: { stringValue: "phone: 415-5551212" } },
+              { id: "4", constExpr: { stringValue: "phone: ((\\d{3})-)?" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 36 },
+        },
+      },
+      checkedAst:
+        'regex.extract(\n  "phone: 415-5551212"~string,\n  "phone: ((\\\\d{3})-)?"~string\n)~optional_type(string)^regex_extract_string_string',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["regex_extract_string_string"] } },
+        typeMap: {
+          "2": {
+            abstractType: {
+              name: "optional_type",
+              parameterTypes: [{ primitive: "STRING" }],
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 36 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.extract",
+            args: [
+              { id: "3", constExpr: { stringValue: "phone: 415-5551212" } },
+              { id: "4", constExpr: { stringValue: "phone: ((\\d{3})-)?" } },
+            ],
+          },
+        },
+      },
+      type: "optional_type(string)",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                'regular expression has more than one capturing group: "phone: ((\\\\d{3})-)?"',
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('Name: John Doe, Age:321', r'Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\d+)')",
+        evalError: {
+          errors: [
+            {
+              message:
+                'regular expression has more than one capturing group: "Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\\\d+)"',
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.extractAll(\n  "Name: John Doe, Age:321"^#*expr.Constant_StringValue#,\n  "Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\\\d+)"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("Name: John Doe, Age:321", "Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\\\d+)")',
+      locationAst:
+        'regex^#1[1,0]#.extractAll(\n  "Name: John Doe, Age:321"^#3[1,17]#,\n  "Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\\\d+)"^#4[1,44]#\n)^#2[1,16]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 42, 1, 17, 1, 42],
+        [4, 44, 83, 1, 44, 1, 83],
+      ],
+      lineOffsets: [85],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extractAll",
+            args: [
+              {
+                id: "3",
+                constExpr: { stringValue: "Name: John Doe, Age:321" },
+              },
+              {
+                id: "4",
+                constExpr: {
+                  stringValue:
+                    "Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\d+)",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [85],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 44 },
+        },
+      },
+      checkedAst:
+        'regex.extractAll(\n  "Name: John Doe, Age:321"~string,\n  "Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\\\d+)"~string\n)~list(string)^regex_extractAll_string_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [85],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 44 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.extractAll",
+            args: [
+              {
+                id: "3",
+                constExpr: { stringValue: "Name: John Doe, Age:321" },
+              },
+              {
+                id: "4",
+                constExpr: {
+                  stringValue:
+                    "Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\d+)",
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(string)",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                'regular expression has more than one capturing group: "Name: (?P\u003cName\u003e.*), Age:(?P\u003cAge\u003e\\\\d+)"',
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('testuser@testdomain', '(.*)@([^.]*)')",
+        evalError: {
+          errors: [
+            {
+              message:
+                'regular expression has more than one capturing group: "(.*)@([^.]*)"',
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.extractAll(\n  "testuser@testdomain"^#*expr.Constant_StringValue#,\n  "(.*)@([^.]*)"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'regex.extractAll("testuser@testdomain", "(.*)@([^.]*)")',
+      locationAst:
+        'regex^#1[1,0]#.extractAll(\n  "testuser@testdomain"^#3[1,17]#,\n  "(.*)@([^.]*)"^#4[1,40]#\n)^#2[1,16]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 38, 1, 17, 1, 38],
+        [4, 40, 54, 1, 40, 1, 54],
+      ],
+      lineOffsets: [56],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extractAll",
+            args: [
+              { id: "3", constExpr: { stringValue: "testuser@testdomain" } },
+              { id: "4", constExpr: { stringValue: "(.*)@([^.]*)" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 40 },
+        },
+      },
+      checkedAst:
+        'regex.extractAll(\n  "testuser@testdomain"~string,\n  "(.*)@([^.]*)"~string\n)~list(string)^regex_extractAll_string_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [56],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 40 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.extractAll",
+            args: [
+              { id: "3", constExpr: { stringValue: "testuser@testdomain" } },
+              { id: "4", constExpr: { stringValue: "(.*)@([^.]*)" } },
+            ],
+          },
+        },
+      },
+      type: "list(string)",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                'regular expression has more than one capturing group: "(.*)@([^.]*)"',
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "regex.extractAll('The user testuser belongs to testdomain', 'The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)')",
+        evalError: {
+          errors: [
+            {
+              message:
+                'regular expression has more than one capturing group: "The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)"',
+            },
+          ],
+        },
+      },
+      section: "TestRegexRuntimeErrors",
+      library: "regex",
+      ast: 'regex^#*expr.Expr_IdentExpr#.extractAll(\n  "The user testuser belongs to testdomain"^#*expr.Constant_StringValue#,\n  "The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'regex.extractAll("The user testuser belongs to testdomain", "The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)")',
+      locationAst:
+        'regex^#1[1,0]#.extractAll(\n  "The user testuser belongs to testdomain"^#3[1,17]#,\n  "The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)"^#4[1,60]#\n)^#2[1,16]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 58, 1, 17, 1, 58],
+        [4, 60, 124, 1, 60, 1, 124],
+      ],
+      lineOffsets: [126],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "regex" } },
+            function: "extractAll",
+            args: [
+              {
+                id: "3",
+                constExpr: {
+                  stringValue: "The user testuser belongs to testdomain",
+                },
+              },
+              {
+                id: "4",
+                constExpr: {
+                  stringValue:
+                    "The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)",
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [126],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 60 },
+        },
+      },
+      checkedAst:
+        'regex.extractAll(\n  "The user testuser belongs to testdomain"~string,\n  "The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)"~string\n)~list(string)^regex_extractAll_string_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["regex_extractAll_string_string"] },
+        },
+        typeMap: {
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [126],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 60 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "regex.extractAll",
+            args: [
+              {
+                id: "3",
+                constExpr: {
+                  stringValue: "The user testuser belongs to testdomain",
+                },
+              },
+              {
+                id: "4",
+                constExpr: {
+                  stringValue:
+                    "The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)",
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(string)",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                'regular expression has more than one capturing group: "The (user|domain) (?P\u003cUsername\u003e.*) belongs (to) (?P\u003cDomain\u003e.*)"',
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+  ],
+} as const;
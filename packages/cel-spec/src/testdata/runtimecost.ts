@@ -0,0 +1,12762 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/interpreter/runtimecost_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "runtimecost",
+  tests: [
+    {
+      original: { name: "const", expr: '"Hello World!"' },
+      ast: '"Hello World!"^#*expr.Constant_StringValue#',
+      unparsed: '"Hello World!"',
+      locationAst: '"Hello World!"^#1[1,0]#',
+      positions: [[1, 0, 14, 1, 0, 1, 14]],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "Hello World!" } },
+        sourceInfo: {
+          location: "const",
+          lineOffsets: [15],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: '"Hello World!"~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "Hello World!" } },
+      },
+      type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "Hello World!" } },
+      runtimeCost: "0",
+      expectedRuntimeCost: "0",
+    },
+    {
+      original: {
+        name: "identity",
+        expr: "input",
+        typeEnv: [
+          {
+            name: "input",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        bindings: {
+          input: {
+            value: {
+              listValue: { values: [{ int64Value: "1" }, { int64Value: "2" }] },
+            },
+          },
+        },
+      },
+      ast: "input^#*expr.Expr_IdentExpr#",
+      unparsed: "input",
+      locationAst: "input^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "input" } },
+        sourceInfo: {
+          location: "identity",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "input~list(int)^input",
+      checkedExpr: {
+        referenceMap: { "1": { name: "input" } },
+        typeMap: { "1": { listType: { elemType: { primitive: "INT64" } } } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", identExpr: { name: "input" } },
+      },
+      type: "list(int)",
+      cost: { min: "1", max: "1" },
+      result: {
+        value: {
+          listValue: { values: [{ int64Value: "1" }, { int64Value: "2" }] },
+        },
+      },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: {
+        name: "select: map",
+        expr: "input['key']",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          input: {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "key" }, value: { stringValue: "v" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      ast: '_[_](\n  input^#*expr.Expr_IdentExpr#,\n  "key"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input["key"]',
+      locationAst: '_[_](\n  input^#1[1,0]#,\n  "key"^#3[1,6]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 6, 11, 1, 6, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              { id: "3", constExpr: { stringValue: "key" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select: map",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 6 },
+        },
+      },
+      checkedAst:
+        '_[_](\n  input~map(string, string)^input,\n  "key"~string\n)~string^index_map',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["index_map"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 6 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              { id: "3", constExpr: { stringValue: "key" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "2", max: "2" },
+      result: { value: { stringValue: "v" } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "select: array index",
+        expr: "input[0]",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+        ],
+        bindings: {
+          input: { value: { listValue: { values: [{ stringValue: "v" }] } } },
+        },
+      },
+      ast: "_[_](\n  input^#*expr.Expr_IdentExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "input[0]",
+      locationAst: "_[_](\n  input^#1[1,0]#,\n  0^#3[1,6]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 6, 7, 1, 6, 1, 7],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              { id: "3", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select: array index",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 5, "3": 6 },
+        },
+      },
+      checkedAst:
+        "_[_](\n  input~list(string)^input,\n  0~int\n)~string^index_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["index_list"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 5, "3": 6 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              { id: "3", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "2", max: "2" },
+      result: { value: { stringValue: "v" } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "expr select: map",
+        expr: "input['ke' + 'y']",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "STRING" },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          input: {
+            value: {
+              mapValue: {
+                entries: [
+                  { key: { stringValue: "key" }, value: { stringValue: "v" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      ast: '_[_](\n  input^#*expr.Expr_IdentExpr#,\n  _+_(\n    "ke"^#*expr.Constant_StringValue#,\n    "y"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input["ke" + "y"]',
+      locationAst:
+        '_[_](\n  input^#1[1,0]#,\n  _+_(\n    "ke"^#3[1,6]#,\n    "y"^#5[1,13]#\n  )^#4[1,11]#\n)^#2[1,5]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 6, 10, 1, 6, 1, 10],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 13, 16, 1, 13, 1, 16],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ke" } },
+                    { id: "5", constExpr: { stringValue: "y" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "expr select: map",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 5, "3": 6, "4": 11, "5": 13 },
+        },
+      },
+      checkedAst:
+        '_[_](\n  input~map(string, string)^input,\n  _+_(\n    "ke"~string,\n    "y"~string\n  )~string^add_string\n)~string^index_map',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["index_map"] },
+          "4": { overloadId: ["add_string"] },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "STRING" },
+            },
+          },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0, "2": 5, "3": 6, "4": 11, "5": 13 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ke" } },
+                    { id: "5", constExpr: { stringValue: "y" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "3", max: "3" },
+      result: { value: { stringValue: "v" } },
+      runtimeCost: "3",
+      expectedRuntimeCost: "3",
+    },
+    {
+      original: {
+        name: "expr select: array index",
+        expr: "input[3-3]",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: { listType: { elemType: { primitive: "STRING" } } },
+            },
+          },
+        ],
+        bindings: {
+          input: { value: { listValue: { values: [{ stringValue: "v" }] } } },
+        },
+      },
+      ast: "_[_](\n  input^#*expr.Expr_IdentExpr#,\n  _-_(\n    3^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "input[3 - 3]",
+      locationAst:
+        "_[_](\n  input^#1[1,0]#,\n  _-_(\n    3^#3[1,6]#,\n    3^#5[1,8]#\n  )^#4[1,7]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "3" } },
+                    { id: "5", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "expr select: array index",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 6, "4": 7, "5": 8 },
+        },
+      },
+      checkedAst:
+        "_[_](\n  input~list(string)^input,\n  _-_(\n    3~int,\n    3~int\n  )~int^subtract_int64\n)~string^index_list",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["index_list"] },
+          "4": { overloadId: ["subtract_int64"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0, "2": 5, "3": 6, "4": 7, "5": 8 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              { id: "1", identExpr: { name: "input" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "3" } },
+                    { id: "5", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "3", max: "3" },
+      result: { value: { stringValue: "v" } },
+      runtimeCost: "3",
+      expectedRuntimeCost: "3",
+    },
+    {
+      original: {
+        name: "select: non-proto field test has() cost",
+        expr: "has(input.testAttr.nestedAttr)",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          input: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "testAttr" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "nestedAttr" },
+                            value: { stringValue: "0" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      presenceTestHasCost: true,
+      ast: "input^#*expr.Expr_IdentExpr#.testAttr^#*expr.Expr_SelectExpr#.nestedAttr~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(input.testAttr.nestedAttr)",
+      locationAst:
+        "input^#2[1,4]#.testAttr^#3[1,9]#.nestedAttr~test-only~^#5[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "select: non-proto field test has() cost",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "input" } },
+                    field: "testAttr",
+                  },
+                },
+                field: "nestedAttr",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input.testAttr~map(string, google.expr.proto3.test.TestAllTypes).nestedAttr~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "input" } },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+      expectedRuntimeCost: "3",
+    },
+    {
+      original: {
+        name: "select: non-proto field test no has() cost",
+        expr: "has(input.testAttr.nestedAttr)",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          input: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "testAttr" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "nestedAttr" },
+                            value: { stringValue: "0" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      presenceTestHasCost: false,
+      ast: "input^#*expr.Expr_IdentExpr#.testAttr^#*expr.Expr_SelectExpr#.nestedAttr~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(input.testAttr.nestedAttr)",
+      locationAst:
+        "input^#2[1,4]#.testAttr^#3[1,9]#.nestedAttr~test-only~^#5[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "select: non-proto field test no has() cost",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "input" } },
+                    field: "testAttr",
+                  },
+                },
+                field: "nestedAttr",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input.testAttr~map(string, google.expr.proto3.test.TestAllTypes).nestedAttr~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "input" } },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "select: non-proto field test",
+        expr: "has(input.testAttr.nestedAttr)",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          input: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "testAttr" },
+                    value: {
+                      mapValue: {
+                        entries: [
+                          {
+                            key: { stringValue: "nestedAttr" },
+                            value: { stringValue: "0" },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.testAttr^#*expr.Expr_SelectExpr#.nestedAttr~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(input.testAttr.nestedAttr)",
+      locationAst:
+        "input^#2[1,4]#.testAttr^#3[1,9]#.nestedAttr~test-only~^#5[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "select: non-proto field test",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 5,
+          function: "has",
+          args: [
+            {
+              id: "4",
+              selectExpr: {
+                operand: {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "input" } },
+                    field: "testAttr",
+                  },
+                },
+                field: "nestedAttr",
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input.testAttr~map(string, google.expr.proto3.test.TestAllTypes).nestedAttr~test-only~~bool",
+      checkedExpr: {
+        referenceMap: { "2": { name: "input" } },
+        typeMap: {
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "3": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "5": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "2": 4, "3": 9, "4": 18, "5": 3 },
+          macroCalls: {
+            "5": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "4",
+                    selectExpr: {
+                      operand: {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "input" } },
+                          field: "testAttr",
+                        },
+                      },
+                      field: "nestedAttr",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "input" } },
+                field: "testAttr",
+              },
+            },
+            field: "nestedAttr",
+            testOnly: true,
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+      expectedRuntimeCost: "3",
+    },
+    {
+      original: {
+        name: "estimated function call",
+        expr: "input.getFullYear()",
+        typeEnv: [
+          { name: "input", ident: { type: { wellKnown: "TIMESTAMP" } } },
+        ],
+        bindings: {
+          input: {
+            value: {
+              objectValue: {
+                "@type": "type.googleapis.com/google.protobuf.Timestamp",
+                value: "1970-01-01T00:00:00Z",
+              },
+            },
+          },
+        },
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.getFullYear()^#*expr.Expr_CallExpr#",
+      unparsed: "input.getFullYear()",
+      locationAst: "input^#1[1,0]#.getFullYear()^#2[1,17]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "getFullYear",
+          },
+        },
+        sourceInfo: {
+          location: "estimated function call",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 17 },
+        },
+      },
+      checkedAst: "input~timestamp^input.getFullYear()~int^timestamp_to_year",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["timestamp_to_year"] },
+        },
+        typeMap: {
+          "1": { wellKnown: "TIMESTAMP" },
+          "2": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0, "2": 17 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "getFullYear",
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "8", max: "8" },
+      result: { value: { int64Value: "1970" } },
+      runtimeCost: "8",
+      expectedRuntimeCost: "8",
+    },
+    {
+      original: { name: "create list", expr: "[1, 2, 3]" },
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 2, 3]",
+      locationAst: "[\n  1^#2[1,1]#,\n  2^#3[1,4]#,\n  3^#4[1,7]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "create list",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+      },
+      checkedAst: "[\n  1~int,\n  2~int,\n  3~int\n]~list(int)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedRuntimeCost: "10",
+    },
+    {
+      original: {
+        name: "create struct",
+        expr: "google.expr.proto3.test.TestAllTypes{single_int32: 1, single_float: 3.14, single_string: 'str'}",
+      },
+      ast: 'google.expr.proto3.test.TestAllTypes{\n  single_int32:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  single_float:3.14^#*expr.Constant_DoubleValue#^#*expr.Expr_CreateStruct_Entry#,\n  single_string:"str"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed:
+        'google.expr.proto3.test.TestAllTypes{single_int32: 1, single_float: 3.14, single_string: "str"}',
+      locationAst:
+        'google.expr.proto3.test.TestAllTypes{\n  single_int32:1^#3[1,51]#^#2[1,49]#,\n  single_float:3.14^#5[1,68]#^#4[1,66]#,\n  single_string:"str"^#7[1,89]#^#6[1,87]#\n}^#1[1,36]#',
+      positions: [
+        [1, 36, 37, 1, 36, 1, 37],
+        [2, 49, 50, 1, 49, 1, 50],
+        [3, 51, 52, 1, 51, 1, 52],
+        [4, 66, 67, 1, 66, 1, 67],
+        [5, 68, 72, 1, 68, 1, 72],
+        [6, 87, 88, 1, 87, 1, 88],
+        [7, 89, 94, 1, 89, 1, 94],
+      ],
+      lineOffsets: [96],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "single_float",
+                value: { id: "5", constExpr: { doubleValue: 3.14 } },
+              },
+              {
+                id: "6",
+                fieldKey: "single_string",
+                value: { id: "7", constExpr: { stringValue: "str" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "create struct",
+          lineOffsets: [96],
+          positions: {
+            "1": 36,
+            "2": 49,
+            "3": 51,
+            "4": 66,
+            "5": 68,
+            "6": 87,
+            "7": 89,
+          },
+        },
+      },
+      checkedAst:
+        'google.expr.proto3.test.TestAllTypes{\n  single_int32:1~int,\n  single_float:3.14~double,\n  single_string:"str"~string\n}~google.expr.proto3.test.TestAllTypes^google.expr.proto3.test.TestAllTypes',
+      checkedExpr: {
+        referenceMap: { "1": { name: "google.expr.proto3.test.TestAllTypes" } },
+        typeMap: {
+          "1": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "3": { primitive: "INT64" },
+          "5": { primitive: "DOUBLE" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [96],
+          positions: {
+            "1": 36,
+            "2": 49,
+            "3": 51,
+            "4": 66,
+            "5": 68,
+            "6": 87,
+            "7": 89,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "google.expr.proto3.test.TestAllTypes",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "single_int32",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "single_float",
+                value: { id: "5", constExpr: { doubleValue: 3.14 } },
+              },
+              {
+                id: "6",
+                fieldKey: "single_string",
+                value: { id: "7", constExpr: { stringValue: "str" } },
+              },
+            ],
+          },
+        },
+      },
+      type: "google.expr.proto3.test.TestAllTypes",
+      cost: { min: "40", max: "40" },
+      result: {
+        value: {
+          objectValue: {
+            "@type": "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+            singleInt32: 1,
+            singleFloat: 3.14,
+            singleString: "str",
+          },
+        },
+      },
+      runtimeCost: "40",
+      expectedRuntimeCost: "40",
+    },
+    {
+      original: { name: "create map", expr: '{"a": 1, "b": 2, "c": 3}' },
+      ast: '{\n  "a"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  "b"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  "c"^#*expr.Constant_StringValue#:3^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{"a": 1, "b": 2, "c": 3}',
+      locationAst:
+        '{\n  "a"^#3[1,1]#:1^#4[1,6]#^#2[1,4]#,\n  "b"^#6[1,9]#:2^#7[1,14]#^#5[1,12]#,\n  "c"^#9[1,17]#:3^#10[1,22]#^#8[1,20]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 9, 12, 1, 9, 1, 12],
+        [7, 14, 15, 1, 14, 1, 15],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 17, 20, 1, 17, 1, 20],
+        [10, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: { id: "4", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { stringValue: "b" } },
+                value: { id: "7", constExpr: { int64Value: "2" } },
+              },
+              {
+                id: "8",
+                mapKey: { id: "9", constExpr: { stringValue: "c" } },
+                value: { id: "10", constExpr: { int64Value: "3" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "create map",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 12,
+            "6": 9,
+            "7": 14,
+            "8": 20,
+            "9": 17,
+            "10": 22,
+          },
+        },
+      },
+      checkedAst:
+        '{\n  "a"~string:1~int,\n  "b"~string:2~int,\n  "c"~string:3~int\n}~map(string, int)',
+      checkedExpr: {
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "INT64" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 12,
+            "6": 9,
+            "7": 14,
+            "8": 20,
+            "9": 17,
+            "10": 22,
+          },
+        },
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: { id: "4", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", constExpr: { stringValue: "b" } },
+                value: { id: "7", constExpr: { int64Value: "2" } },
+              },
+              {
+                id: "8",
+                mapKey: { id: "9", constExpr: { stringValue: "c" } },
+                value: { id: "10", constExpr: { int64Value: "3" } },
+              },
+            ],
+          },
+        },
+      },
+      type: "map(string, int)",
+      cost: { min: "30", max: "30" },
+      result: {
+        value: {
+          mapValue: {
+            entries: [
+              { key: { stringValue: "a" }, value: { int64Value: "1" } },
+              { key: { stringValue: "b" }, value: { int64Value: "2" } },
+              { key: { stringValue: "c" }, value: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      runtimeCost: "30",
+      expectedRuntimeCost: "30",
+    },
+    {
+      original: {
+        name: "all comprehension",
+        expr: "input.all(x, true)",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                listType: {
+                  elemType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: { input: { value: { listValue: {} } } },
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(x, true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#5[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#6[1,9]#\n  )^#7[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#8[1,9]#,\n    true^#4[1,13]#\n  )^#9[1,9]#,\n  // Result\n  @result^#10[1,9]#)^#11[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 17, 1, 13, 1, 17],
+        [5, 9, 9, 1, 9, 1, 9],
+        [6, 9, 9, 1, 9, 1, 9],
+        [7, 9, 9, 1, 9, 1, 9],
+        [8, 9, 9, 1, 9, 1, 9],
+        [9, 9, 9, 1, 9, 1, 9],
+        [10, 9, 9, 1, 9, 1, 9],
+        [11, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "7",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "6", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "8", identExpr: { name: "@result" } },
+                  { id: "4", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "all comprehension",
+          lineOffsets: [19],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 9,
+            "6": 9,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "x" } },
+                  { id: "4", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 11,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "x" } },
+            { id: "4", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input~list(google.expr.proto3.test.TestAllTypes)^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "6": { name: "@result" },
+          "7": { overloadId: ["not_strictly_false"] },
+          "8": { name: "@result" },
+          "9": { overloadId: ["logical_and"] },
+          "10": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 9,
+            "6": 9,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+          },
+          macroCalls: {
+            "11": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "x" } },
+                  { id: "4", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "11",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "5", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "7",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "6", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "9",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "8", identExpr: { name: "@result" } },
+                  { id: "4", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "10", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "nested all comprehension",
+        expr: "input.all(x, x.all(y, true))",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                listType: {
+                  elemType: {
+                    listType: {
+                      elemType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: { input: { value: { listValue: {} } } },
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      x^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        true^#*expr.Constant_BoolValue#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(x, x.all(y, true))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#15[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#16[1,9]#\n  )^#17[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#18[1,9]#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      x^#4[1,13]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#8[1,18]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#9[1,18]#\n      )^#10[1,18]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#11[1,18]#,\n        true^#7[1,22]#\n      )^#12[1,18]#,\n      // Result\n      @result^#13[1,18]#)^#14[1,18]#\n  )^#19[1,9]#,\n  // Result\n  @result^#20[1,9]#)^#21[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 14, 1, 13, 1, 14],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 22, 26, 1, 22, 1, 26],
+        [8, 18, 18, 1, 18, 1, 18],
+        [9, 18, 18, 1, 18, 1, 18],
+        [10, 18, 18, 1, 18, 1, 18],
+        [11, 18, 18, 1, 18, 1, 18],
+        [12, 18, 18, 1, 18, 1, 18],
+        [13, 18, 18, 1, 18, 1, 18],
+        [14, 18, 18, 1, 18, 1, 18],
+        [15, 9, 9, 1, 9, 1, 9],
+        [16, 9, 9, 1, 9, 1, 9],
+        [17, 9, 9, 1, 9, 1, 9],
+        [18, 9, 9, 1, 9, 1, 9],
+        [19, 9, 9, 1, 9, 1, 9],
+        [20, 9, 9, 1, 9, 1, 9],
+        [21, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "21",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "17",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "16", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "19",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "18", identExpr: { name: "@result" } },
+                  {
+                    id: "14",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "4", identExpr: { name: "x" } },
+                      accuVar: "@result",
+                      accuInit: { id: "8", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "10",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "9", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "12",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "11", identExpr: { name: "@result" } },
+                            { id: "7", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      result: { id: "13", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "20", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "nested all comprehension",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "6": 19,
+            "7": 22,
+            "8": 18,
+            "9": 18,
+            "10": 18,
+            "11": 18,
+            "12": 18,
+            "13": 18,
+            "14": 18,
+            "15": 9,
+            "16": 9,
+            "17": 9,
+            "18": 9,
+            "19": 9,
+            "20": 9,
+            "21": 9,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "4", identExpr: { name: "x" } },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "y" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "21": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [{ id: "3", identExpr: { name: "x" } }, { id: "14" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "all",
+          target: { id: "4", identExpr: { name: "x" } },
+          args: [
+            { id: "6", identExpr: { name: "y" } },
+            { id: "7", constExpr: { boolValue: true } },
+          ],
+        },
+        {
+          id: 21,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [{ id: "3", identExpr: { name: "x" } }, { id: "14" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  input~list(list(google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      x~list(google.expr.proto3.test.TestAllTypes)^x,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        true~bool\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "x" },
+          "9": { name: "@result" },
+          "10": { overloadId: ["not_strictly_false"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["logical_and"] },
+          "13": { name: "@result" },
+          "16": { name: "@result" },
+          "17": { overloadId: ["not_strictly_false"] },
+          "18": { name: "@result" },
+          "19": { overloadId: ["logical_and"] },
+          "20": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                listType: {
+                  elemType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "6": 19,
+            "7": 22,
+            "8": 18,
+            "9": 18,
+            "10": 18,
+            "11": 18,
+            "12": 18,
+            "13": 18,
+            "14": 18,
+            "15": 9,
+            "16": 9,
+            "17": 9,
+            "18": 9,
+            "19": 9,
+            "20": 9,
+            "21": 9,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: { id: "4", identExpr: { name: "x" } },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "y" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "21": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [{ id: "3", identExpr: { name: "x" } }, { id: "14" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "21",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "15", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "17",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "16", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "19",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "18", identExpr: { name: "@result" } },
+                  {
+                    id: "14",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "4", identExpr: { name: "x" } },
+                      accuVar: "@result",
+                      accuInit: { id: "8", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "10",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "9", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "12",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "11", identExpr: { name: "@result" } },
+                            { id: "7", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      result: { id: "13", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "20", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "all comprehension on literal",
+        expr: "[1, 2, 3].all(x, true)",
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].all(x, true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#8[1,13]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#9[1,13]#\n  )^#10[1,13]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#11[1,13]#,\n    true^#7[1,17]#\n  )^#12[1,13]#,\n  // Result\n  @result^#13[1,13]#)^#14[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 21, 1, 17, 1, 21],
+        [8, 13, 13, 1, 13, 1, 13],
+        [9, 13, 13, 1, 13, 1, 13],
+        [10, 13, 13, 1, 13, 1, 13],
+        [11, 13, 13, 1, 13, 1, 13],
+        [12, 13, 13, 1, 13, 1, 13],
+        [13, 13, 13, 1, 13, 1, 13],
+        [14, 13, 13, 1, 13, 1, 13],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "14",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "8", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "10",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "9", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "12",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "11", identExpr: { name: "@result" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "13", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "all comprehension on literal",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 13,
+            "9": 13,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 14,
+          function: "all",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            { id: "7", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "@result" },
+          "10": { overloadId: ["not_strictly_false"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["logical_and"] },
+          "13": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 13,
+            "9": 13,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+          },
+          macroCalls: {
+            "14": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "14",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "8", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "10",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "9", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "12",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "11", identExpr: { name: "@result" } },
+                  { id: "7", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "13", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "20", max: "20" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "20",
+      expectedRuntimeCost: "20",
+    },
+    {
+      original: {
+        name: "variable cost function",
+        expr: "input.matches('[0-9]')",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+        bindings: {
+          input: {
+            value: {
+              stringValue:
+                "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+            },
+          },
+        },
+      },
+      ast: 'input^#*expr.Expr_IdentExpr#.matches(\n  "[0-9]"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input.matches("[0-9]")',
+      locationAst: 'input^#1[1,0]#.matches(\n  "[0-9]"^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "[0-9]" } }],
+          },
+        },
+        sourceInfo: {
+          location: "variable cost function",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        'input~string^input.matches(\n  "[0-9]"~string\n)~bool^matches_string',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["matches_string"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "[0-9]" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3689348814741910529" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "103",
+      expectedRuntimeCost: "103",
+    },
+    {
+      original: {
+        name: "variable cost function with constant",
+        expr: "'123'.matches('[0-9]')",
+      },
+      ast: '"123"^#*expr.Constant_StringValue#.matches(\n  "[0-9]"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"123".matches("[0-9]")',
+      locationAst: '"123"^#1[1,0]#.matches(\n  "[0-9]"^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "123" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "[0-9]" } }],
+          },
+        },
+        sourceInfo: {
+          location: "variable cost function with constant",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        '"123"~string.matches(\n  "[0-9]"~string\n)~bool^matches_string',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["matches_string"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "123" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "[0-9]" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: { name: "or", expr: "false || false" },
+      ast: "_||_(\n  false^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false || false",
+      locationAst: "_||_(\n  false^#1[1,0]#,\n  false^#2[1,9]#\n)^#3[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 14, 1, 9, 1, 14],
+        [3, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+      },
+      checkedAst: "_||_(\n  false~bool,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_or"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedRuntimeCost: "0",
+    },
+    {
+      original: { name: "or short-circuit", expr: "true || false" },
+      ast: "_||_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true || false",
+      locationAst: "_||_(\n  true^#1[1,0]#,\n  false^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or short-circuit",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst: "_||_(\n  true~bool,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_or"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      expectedRuntimeCost: "0",
+    },
+    {
+      original: {
+        name: "or accumulated branch cost",
+        expr: "a || b || c || d",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          { name: "c", ident: { type: { primitive: "BOOL" } } },
+          { name: "d", ident: { type: { primitive: "BOOL" } } },
+        ],
+        bindings: {
+          a: { value: { boolValue: false } },
+          b: { value: { boolValue: false } },
+          c: { value: { boolValue: false } },
+          d: { value: { boolValue: false } },
+        },
+      },
+      ast: "_||_(\n  _||_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    c^#*expr.Expr_IdentExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a || b || c || d",
+      locationAst:
+        "_||_(\n  _||_(\n    a^#1[1,0]#,\n    b^#2[1,5]#\n  )^#3[1,2]#,\n  _||_(\n    c^#4[1,10]#,\n    d^#6[1,15]#\n  )^#7[1,12]#\n)^#5[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "or accumulated branch cost",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+          },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _||_(\n    a~bool^a,\n    b~bool^b\n  )~bool^logical_or,\n  _||_(\n    c~bool^c,\n    d~bool^d\n  )~bool^logical_or\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "b" },
+          "3": { overloadId: ["logical_or"] },
+          "4": { name: "c" },
+          "5": { overloadId: ["logical_or"] },
+          "6": { name: "d" },
+          "7": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "4" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "4",
+      expectedRuntimeCost: "4",
+    },
+    {
+      original: { name: "and", expr: "true \u0026\u0026 false" },
+      ast: "_\u0026\u0026_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true \u0026\u0026 false",
+      locationAst:
+        "_\u0026\u0026_(\n  true^#1[1,0]#,\n  false^#2[1,8]#\n)^#3[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 8, 13, 1, 8, 1, 13],
+        [3, 5, 7, 1, 5, 1, 7],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  true~bool,\n  false~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_and"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 8, "3": 5 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "2", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedRuntimeCost: "0",
+    },
+    {
+      original: { name: "and short-circuit", expr: "false \u0026\u0026 true" },
+      ast: "_\u0026\u0026_(\n  false^#*expr.Constant_BoolValue#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false \u0026\u0026 true",
+      locationAst:
+        "_\u0026\u0026_(\n  false^#1[1,0]#,\n  true^#2[1,9]#\n)^#3[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 13, 1, 9, 1, 13],
+        [3, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and short-circuit",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  false~bool,\n  true~bool\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: { "3": { overloadId: ["logical_and"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 9, "3": 6 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "2", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedRuntimeCost: "0",
+    },
+    {
+      original: {
+        name: "and accumulated branch cost",
+        expr: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d",
+        typeEnv: [
+          { name: "a", ident: { type: { primitive: "BOOL" } } },
+          { name: "b", ident: { type: { primitive: "BOOL" } } },
+          { name: "c", ident: { type: { primitive: "BOOL" } } },
+          { name: "d", ident: { type: { primitive: "BOOL" } } },
+        ],
+        bindings: {
+          a: { value: { boolValue: true } },
+          b: { value: { boolValue: true } },
+          c: { value: { boolValue: true } },
+          d: { value: { boolValue: true } },
+        },
+      },
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    c^#*expr.Expr_IdentExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a^#1[1,0]#,\n    b^#2[1,5]#\n  )^#3[1,2]#,\n  _\u0026\u0026_(\n    c^#4[1,10]#,\n    d^#6[1,15]#\n  )^#7[1,12]#\n)^#5[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "and accumulated branch cost",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+          },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    a~bool^a,\n    b~bool^b\n  )~bool^logical_and,\n  _\u0026\u0026_(\n    c~bool^c,\n    d~bool^d\n  )~bool^logical_and\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "a" },
+          "2": { name: "b" },
+          "3": { overloadId: ["logical_and"] },
+          "4": { name: "c" },
+          "5": { overloadId: ["logical_and"] },
+          "6": { name: "d" },
+          "7": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "2", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+      expectedRuntimeCost: "4",
+    },
+    {
+      original: { name: "lt", expr: "1 \u003c 2" },
+      ast: "_\u003c_(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 \u003c 2",
+      locationAst: "_\u003c_(\n  1^#1[1,0]#,\n  2^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "lt",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_\u003c_(\n  1~int,\n  2~int\n)~bool^less_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["less_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "lte", expr: "1 \u003c= 2" },
+      ast: "_\u003c=_(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 \u003c= 2",
+      locationAst: "_\u003c=_(\n  1^#1[1,0]#,\n  2^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c=_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "lte",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      checkedAst: "_\u003c=_(\n  1~int,\n  2~int\n)~bool^less_equals_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["less_equals_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003c=_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "eq", expr: "1 == 2" },
+      ast: "_==_(\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 == 2",
+      locationAst: "_==_(\n  1^#1[1,0]#,\n  2^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "eq",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      checkedAst: "_==_(\n  1~int,\n  2~int\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "gt", expr: "2 \u003e 1" },
+      ast: "_\u003e_(\n  2^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2 \u003e 1",
+      locationAst: "_\u003e_(\n  2^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "gt",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_\u003e_(\n  2~int,\n  1~int\n)~bool^greater_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["greater_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "gte", expr: "2 \u003e= 1" },
+      ast: "_\u003e=_(\n  2^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2 \u003e= 1",
+      locationAst: "_\u003e=_(\n  2^#1[1,0]#,\n  1^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "gte",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      checkedAst: "_\u003e=_(\n  2~int,\n  1~int\n)~bool^greater_equals_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["greater_equals_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "in", expr: "2 in [1, 2, 3]" },
+      ast: "@in(\n  2^#*expr.Constant_Int64Value#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "2 in [1, 2, 3]",
+      locationAst:
+        "@in(\n  2^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    2^#5[1,9]#,\n    3^#6[1,12]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "in",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 12 },
+        },
+      },
+      checkedAst:
+        "@in(\n  2~int,\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^in_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["in_list"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [15],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9, "6": 12 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", constExpr: { int64Value: "2" } },
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "13" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "13",
+      expectedRuntimeCost: "13",
+    },
+    {
+      original: { name: "plus", expr: "1 + 1" },
+      ast: "_+_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + 1",
+      locationAst: "_+_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "plus",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_+_(\n  1~int,\n  1~int\n)~int^add_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["add_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "2" } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "minus", expr: "1 - 1" },
+      ast: "_-_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 - 1",
+      locationAst: "_-_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "minus",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_-_(\n  1~int,\n  1~int\n)~int^subtract_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["subtract_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "0" } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "/", expr: "1 / 1" },
+      ast: "_/_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 / 1",
+      locationAst: "_/_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_/_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "/",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_/_(\n  1~int,\n  1~int\n)~int^divide_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["divide_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_/_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "1" } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "/", expr: "1 * 1" },
+      ast: "_*_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 * 1",
+      locationAst: "_*_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_*_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "/",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_*_(\n  1~int,\n  1~int\n)~int^multiply_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["multiply_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_*_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "1" } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "%", expr: "1 % 1" },
+      ast: "_%_(\n  1^#*expr.Constant_Int64Value#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 % 1",
+      locationAst: "_%_(\n  1^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_%_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "%",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+      },
+      checkedAst: "_%_(\n  1~int,\n  1~int\n)~int^modulo_int64",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["modulo_int64"] } },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 2, "3": 4 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_%_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "0" } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: { name: "ternary", expr: "true ? 1 : 2" },
+      ast: "_?_:_(\n  true^#*expr.Constant_BoolValue#,\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true ? 1 : 2",
+      locationAst:
+        "_?_:_(\n  true^#1[1,0]#,\n  1^#3[1,7]#,\n  2^#4[1,11]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "3", constExpr: { int64Value: "1" } },
+              { id: "4", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "ternary",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 7, "4": 11 },
+        },
+      },
+      checkedAst: "_?_:_(\n  true~bool,\n  1~int,\n  2~int\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["conditional"] } },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 5, "3": 7, "4": 11 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "3", constExpr: { int64Value: "1" } },
+              { id: "4", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "0", max: "0" },
+      result: { value: { int64Value: "1" } },
+      runtimeCost: "0",
+      expectedRuntimeCost: "0",
+    },
+    {
+      original: { name: "string size", expr: 'size("123")' },
+      ast: 'size(\n  "123"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'size("123")',
+      locationAst: 'size(\n  "123"^#2[1,5]#\n)^#1[1,4]#',
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 10, 1, 5, 1, 10],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", constExpr: { stringValue: "123" } }],
+          },
+        },
+        sourceInfo: {
+          location: "string size",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5 },
+        },
+      },
+      checkedAst: 'size(\n  "123"~string\n)~int^size_string',
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["size_string"] } },
+        typeMap: { "1": { primitive: "INT64" }, "2": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", constExpr: { stringValue: "123" } }],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "1", max: "1" },
+      result: { value: { int64Value: "3" } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: {
+        name: "str eq str",
+        expr: "'12345678901234567890' == '123456789012345678901234567890'",
+      },
+      ast: '_==_(\n  "12345678901234567890"^#*expr.Constant_StringValue#,\n  "123456789012345678901234567890"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"12345678901234567890" == "123456789012345678901234567890"',
+      locationAst:
+        '_==_(\n  "12345678901234567890"^#1[1,0]#,\n  "123456789012345678901234567890"^#3[1,26]#\n)^#2[1,23]#',
+      positions: [
+        [1, 0, 22, 1, 0, 1, 22],
+        [2, 23, 25, 1, 23, 1, 25],
+        [3, 26, 58, 1, 26, 1, 58],
+      ],
+      lineOffsets: [59],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", constExpr: { stringValue: "12345678901234567890" } },
+              {
+                id: "3",
+                constExpr: { stringValue: "123456789012345678901234567890" },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "str eq str",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 23, "3": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "12345678901234567890"~string,\n  "123456789012345678901234567890"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["equals"] } },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 23, "3": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_==_",
+            args: [
+              { id: "1", constExpr: { stringValue: "12345678901234567890" } },
+              {
+                id: "3",
+                constExpr: { stringValue: "123456789012345678901234567890" },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "2" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "bytes to string conversion",
+        expr: "string(input)",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "BYTES" } } }],
+        bindings: {
+          input: {
+            value: {
+              bytesValue:
+                "YWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXpBQkNERUZHSElKS0xNTk9QUVJTVFVWV1hZWmFiY2RlZmdoaWprbG1ub3BxcnN0dXZ3eHl6QUJDREVGR0hJSktMTU5PUFFSU1RVVldYWVphYmNkZWZnaGlqa2xtbm9wcXJzdHV2d3h5ekFCQ0RFRkdISUpLTE1OT1BRUlNUVVZXWFlaYWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXpBQkNERUZHSElKS0xNTk9QUVJTVFVWV1hZWmFiY2RlZmdoaWprbG1ub3BxcnN0dXZ3eHl6QUJDREVGR0hJSktMTU5PUFFSU1RVVldYWVphYmNkZWZnaGlqa2xtbm9wcXJzdHV2d3h5ekFCQ0RFRkdISUpLTE1OT1BRUlNUVVZXWFlaYWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXpBQkNERUZHSElKS0xNTk9QUVJTVFVWV1hZWmFiY2RlZmdoaWprbG1ub3BxcnN0dXZ3eHl6QUJDREVGR0hJSktMTU5PUFFSU1RVVldYWVphYmNkZWZnaGlqa2xtbm9wcXJzdHV2d3h5ekFCQ0RFRkdISUpLTE1OT1BRUlNUVVZXWFlaYWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXpBQkNERUY=",
+            },
+          },
+        },
+      },
+      ast: "string(\n  input^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "string(input)",
+      locationAst: "string(\n  input^#2[1,7]#\n)^#1[1,6]#",
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 12, 1, 7, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+        sourceInfo: {
+          location: "bytes to string conversion",
+          lineOffsets: [14],
+          positions: { "1": 6, "2": 7 },
+        },
+      },
+      checkedAst: "string(\n  input~bytes^input\n)~string^bytes_to_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["bytes_to_string"] },
+          "2": { name: "input" },
+        },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [14],
+          positions: { "1": 6, "2": 7 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1844674407370955265" },
+      result: {
+        value: {
+          stringValue:
+            "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+        },
+      },
+      runtimeCost: "51",
+      expectedRuntimeCost: "51",
+    },
+    {
+      original: {
+        name: "string to bytes conversion",
+        expr: "bytes(input)",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+        bindings: {
+          input: {
+            value: {
+              stringValue:
+                "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+            },
+          },
+        },
+      },
+      ast: "bytes(\n  input^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "bytes(input)",
+      locationAst: "bytes(\n  input^#2[1,6]#\n)^#1[1,5]#",
+      positions: [
+        [1, 5, 6, 1, 5, 1, 6],
+        [2, 6, 11, 1, 6, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "bytes",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+        sourceInfo: {
+          location: "string to bytes conversion",
+          lineOffsets: [13],
+          positions: { "1": 5, "2": 6 },
+        },
+      },
+      checkedAst: "bytes(\n  input~string^input\n)~bytes^string_to_bytes",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["string_to_bytes"] },
+          "2": { name: "input" },
+        },
+        typeMap: { "1": { primitive: "BYTES" }, "2": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 5, "2": 6 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "bytes",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+      },
+      type: "bytes",
+      cost: { min: "1", max: "1844674407370955265" },
+      result: {
+        value: {
+          bytesValue:
+            "YWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXpBQkNERUZHSElKS0xNTk9QUVJTVFVWV1hZWmFiY2RlZmdoaWprbG1ub3BxcnN0dXZ3eHl6QUJDREVGR0hJSktMTU5PUFFSU1RVVldYWVphYmNkZWZnaGlqa2xtbm9wcXJzdHV2d3h5ekFCQ0RFRkdISUpLTE1OT1BRUlNUVVZXWFlaYWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXpBQkNERUZHSElKS0xNTk9QUVJTVFVWV1hZWmFiY2RlZmdoaWprbG1ub3BxcnN0dXZ3eHl6QUJDREVGR0hJSktMTU5PUFFSU1RVVldYWVphYmNkZWZnaGlqa2xtbm9wcXJzdHV2d3h5ekFCQ0RFRkdISUpLTE1OT1BRUlNUVVZXWFlaYWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXpBQkNERUZHSElKS0xNTk9QUVJTVFVWV1hZWmFiY2RlZmdoaWprbG1ub3BxcnN0dXZ3eHl6QUJDREVGR0hJSktMTU5PUFFSU1RVVldYWVphYmNkZWZnaGlqa2xtbm9wcXJzdHV2d3h5ekFCQ0RFRkdISUpLTE1OT1BRUlNUVVZXWFlaYWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXpBQkNERUY=",
+        },
+      },
+      runtimeCost: "51",
+      expectedRuntimeCost: "51",
+    },
+    {
+      original: { name: "int to string conversion", expr: "string(1)" },
+      ast: "string(\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "string(1)",
+      locationAst: "string(\n  1^#2[1,7]#\n)^#1[1,6]#",
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", constExpr: { int64Value: "1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "int to string conversion",
+          lineOffsets: [10],
+          positions: { "1": 6, "2": 7 },
+        },
+      },
+      checkedAst: "string(\n  1~int\n)~string^int64_to_string",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["int64_to_string"] } },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 6, "2": 7 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "string",
+            args: [{ id: "2", constExpr: { int64Value: "1" } }],
+          },
+        },
+      },
+      type: "string",
+      cost: { min: "1", max: "1" },
+      result: { value: { stringValue: "1" } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: {
+        name: "contains",
+        expr: "input.contains(arg1)",
+        typeEnv: [
+          { name: "input", ident: { type: { primitive: "STRING" } } },
+          { name: "arg1", ident: { type: { primitive: "STRING" } } },
+        ],
+        bindings: {
+          arg1: {
+            value: {
+              stringValue:
+                "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+            },
+          },
+          input: {
+            value: {
+              stringValue:
+                "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+            },
+          },
+        },
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.contains(\n  arg1^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "input.contains(arg1)",
+      locationAst: "input^#1[1,0]#.contains(\n  arg1^#3[1,15]#\n)^#2[1,14]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 19, 1, 15, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "contains",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "contains",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 14, "3": 15 },
+        },
+      },
+      checkedAst:
+        "input~string^input.contains(\n  arg1~string^arg1\n)~bool^contains_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["contains_string"] },
+          "3": { name: "arg1" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 14, "3": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "contains",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2502",
+      expectedRuntimeCost: "2502",
+    },
+    {
+      original: {
+        name: "matches",
+        expr: "input.matches('\\\\d+a\\\\d+b')",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+        bindings: {
+          arg1: {
+            value: {
+              stringValue:
+                "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+            },
+          },
+          input: {
+            value: {
+              stringValue:
+                "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+            },
+          },
+        },
+      },
+      ast: 'input^#*expr.Expr_IdentExpr#.matches(\n  "\\\\d+a\\\\d+b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'input.matches("\\\\d+a\\\\d+b")',
+      locationAst:
+        'input^#1[1,0]#.matches(\n  "\\\\d+a\\\\d+b"^#3[1,14]#\n)^#2[1,13]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 26, 1, 14, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "\\d+a\\d+b" } }],
+          },
+        },
+        sourceInfo: {
+          location: "matches",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+      },
+      checkedAst:
+        'input~string^input.matches(\n  "\\\\d+a\\\\d+b"~string\n)~bool^matches_string',
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["matches_string"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "matches",
+            args: [{ id: "3", constExpr: { stringValue: "\\d+a\\d+b" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "3689348814741910529" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "103",
+      expectedRuntimeCost: "103",
+    },
+    {
+      original: {
+        name: "startsWith",
+        expr: "input.startsWith(arg1)",
+        typeEnv: [
+          { name: "input", ident: { type: { primitive: "STRING" } } },
+          { name: "arg1", ident: { type: { primitive: "STRING" } } },
+        ],
+        bindings: {
+          arg1: {
+            value: {
+              stringValue:
+                "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+            },
+          },
+          input: { value: { stringValue: "idc" } },
+        },
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.startsWith(\n  arg1^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "input.startsWith(arg1)",
+      locationAst: "input^#1[1,0]#.startsWith(\n  arg1^#3[1,17]#\n)^#2[1,16]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 21, 1, 17, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "startsWith",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "startsWith",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 16, "3": 17 },
+        },
+      },
+      checkedAst:
+        "input~string^input.startsWith(\n  arg1~string^arg1\n)~bool^starts_with_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["starts_with_string"] },
+          "3": { name: "arg1" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 16, "3": 17 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "startsWith",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "1844674407370955266" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "3",
+      expectedRuntimeCost: "3",
+    },
+    {
+      original: {
+        name: "endsWith",
+        expr: "input.endsWith(arg1)",
+        typeEnv: [
+          { name: "input", ident: { type: { primitive: "STRING" } } },
+          { name: "arg1", ident: { type: { primitive: "STRING" } } },
+        ],
+        bindings: {
+          arg1: {
+            value: {
+              stringValue:
+                "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyzABCDEF",
+            },
+          },
+          input: { value: { stringValue: "idc" } },
+        },
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.endsWith(\n  arg1^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "input.endsWith(arg1)",
+      locationAst: "input^#1[1,0]#.endsWith(\n  arg1^#3[1,15]#\n)^#2[1,14]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 19, 1, 15, 1, 19],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "endsWith",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+        sourceInfo: {
+          location: "endsWith",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 14, "3": 15 },
+        },
+      },
+      checkedAst:
+        "input~string^input.endsWith(\n  arg1~string^arg1\n)~bool^ends_with_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["ends_with_string"] },
+          "3": { name: "arg1" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 14, "3": 15 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "endsWith",
+            args: [{ id: "3", identExpr: { name: "arg1" } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "1844674407370955266" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "3",
+      expectedRuntimeCost: "3",
+    },
+    {
+      original: {
+        name: "size receiver",
+        expr: "input.size()",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+        bindings: {
+          arg1: { value: { stringValue: "500" } },
+          input: { value: { stringValue: "500" } },
+        },
+      },
+      ast: "input^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#",
+      unparsed: "input.size()",
+      locationAst: "input^#1[1,0]#.size()^#2[1,10]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 10, 11, 1, 10, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "size",
+          },
+        },
+        sourceInfo: {
+          location: "size receiver",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 10 },
+        },
+      },
+      checkedAst: "input~string^input.size()~int^string_size",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "2": { overloadId: ["string_size"] },
+        },
+        typeMap: { "1": { primitive: "STRING" }, "2": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 10 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "input" } },
+            function: "size",
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: { value: { int64Value: "3" } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "size",
+        expr: "size(input)",
+        typeEnv: [{ name: "input", ident: { type: { primitive: "STRING" } } }],
+        bindings: {
+          arg1: { value: { stringValue: "500" } },
+          input: { value: { stringValue: "500" } },
+        },
+      },
+      ast: "size(\n  input^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "size(input)",
+      locationAst: "size(\n  input^#2[1,5]#\n)^#1[1,4]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 10, 1, 5, 1, 10],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+        sourceInfo: {
+          location: "size",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5 },
+        },
+      },
+      checkedAst: "size(\n  input~string^input\n)~int^size_string",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["size_string"] },
+          "2": { name: "input" },
+        },
+        typeMap: { "1": { primitive: "INT64" }, "2": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 4, "2": 5 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [{ id: "2", identExpr: { name: "input" } }],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: { value: { int64Value: "3" } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "ternary eval",
+        expr: "(x \u003e 2 ? input1 : input2).all(y, true)",
+        typeEnv: [
+          { name: "x", ident: { type: { primitive: "INT64" } } },
+          {
+            name: "input1",
+            ident: {
+              type: {
+                listType: {
+                  elemType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          {
+            name: "input2",
+            ident: {
+              type: {
+                listType: {
+                  elemType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          input1: {
+            value: {
+              listValue: {
+                values: [
+                  {
+                    objectValue: {
+                      "@type":
+                        "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+          input2: {
+            value: {
+              listValue: {
+                values: [
+                  {
+                    objectValue: {
+                      "@type":
+                        "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+          x: { value: { int64Value: "1" } },
+        },
+      },
+      ast: "__comprehension__(\n  // Variable\n  y,\n  // Target\n  _?_:_(\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    input1^#*expr.Expr_IdentExpr#,\n    input2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "((x \u003e 2) ? input1 : input2).all(y, true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  _?_:_(\n    _\u003e_(\n      x^#1[1,1]#,\n      2^#3[1,5]#\n    )^#2[1,3]#,\n    input1^#5[1,9]#,\n    input2^#6[1,18]#\n  )^#4[1,7]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#10[1,29]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#11[1,29]#\n  )^#12[1,29]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#13[1,29]#,\n    true^#9[1,33]#\n  )^#14[1,29]#,\n  // Result\n  @result^#15[1,29]#)^#16[1,29]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 15, 1, 9, 1, 15],
+        [6, 18, 24, 1, 18, 1, 24],
+        [8, 30, 31, 1, 30, 1, 31],
+        [9, 33, 37, 1, 33, 1, 37],
+        [10, 29, 29, 1, 29, 1, 29],
+        [11, 29, 29, 1, 29, 1, 29],
+        [12, 29, 29, 1, 29, 1, 29],
+        [13, 29, 29, 1, 29, 1, 29],
+        [14, 29, 29, 1, 29, 1, 29],
+        [15, 29, 29, 1, 29, 1, 29],
+        [16, 29, 29, 1, 29, 1, 29],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "4",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "2",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "5", identExpr: { name: "input1" } },
+                  { id: "6", identExpr: { name: "input2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "ternary eval",
+          lineOffsets: [39],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 18,
+            "8": 30,
+            "9": 33,
+            "10": 29,
+            "11": 29,
+            "12": 29,
+            "13": 29,
+            "14": 29,
+            "15": 29,
+            "16": 29,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "4",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "2",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "1", identExpr: { name: "x" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      { id: "5", identExpr: { name: "input1" } },
+                      { id: "6", identExpr: { name: "input2" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "y" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "4",
+            callExpr: {
+              function: "_?_:_",
+              args: [
+                {
+                  id: "2",
+                  callExpr: {
+                    function: "_\u003e_",
+                    args: [
+                      { id: "1", identExpr: { name: "x" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                { id: "5", identExpr: { name: "input1" } },
+                { id: "6", identExpr: { name: "input2" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "y" } },
+            { id: "9", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  _?_:_(\n    _\u003e_(\n      x~int^x,\n      2~int\n    )~bool^greater_int64,\n    input1~list(google.expr.proto3.test.TestAllTypes)^input1,\n    input2~list(google.expr.proto3.test.TestAllTypes)^input2\n  )~list(google.expr.proto3.test.TestAllTypes)^conditional,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "x" },
+          "2": { overloadId: ["greater_int64"] },
+          "4": { overloadId: ["conditional"] },
+          "5": { name: "input1" },
+          "6": { name: "input2" },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "5": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "6": {
+            listType: {
+              elemType: { messageType: "google.expr.proto3.test.TestAllTypes" },
+            },
+          },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 18,
+            "8": 30,
+            "9": 33,
+            "10": 29,
+            "11": 29,
+            "12": 29,
+            "13": 29,
+            "14": 29,
+            "15": 29,
+            "16": 29,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "4",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "2",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "1", identExpr: { name: "x" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      { id: "5", identExpr: { name: "input1" } },
+                      { id: "6", identExpr: { name: "input2" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "y" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "4",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "2",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "5", identExpr: { name: "input1" } },
+                  { id: "6", identExpr: { name: "input2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "6",
+      expectedRuntimeCost: "6",
+    },
+    {
+      original: {
+        name: "ternary eval trivial, true",
+        expr: "true ? false : 1 \u003e 3",
+      },
+      ast: "_?_:_(\n  true^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#,\n  _\u003e_(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "true ? false : (1 \u003e 3)",
+      locationAst:
+        "_?_:_(\n  true^#1[1,0]#,\n  false^#3[1,7]#,\n  _\u003e_(\n    1^#4[1,15]#,\n    3^#6[1,19]#\n  )^#5[1,17]#\n)^#2[1,5]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 7, 12, 1, 7, 1, 12],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "3", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "ternary eval trivial, true",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 5, "3": 7, "4": 15, "5": 17, "6": 19 },
+        },
+      },
+      checkedAst:
+        "_?_:_(\n  true~bool,\n  false~bool,\n  _\u003e_(\n    1~int,\n    3~int\n  )~bool^greater_int64\n)~bool^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["conditional"] },
+          "5": { overloadId: ["greater_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 5, "3": 7, "4": 15, "5": 17, "6": 19 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: true } },
+              { id: "3", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedRuntimeCost: "0",
+    },
+    {
+      original: {
+        name: "ternary eval trivial, false",
+        expr: "false ? false : 1 \u003e 3",
+      },
+      ast: "_?_:_(\n  false^#*expr.Constant_BoolValue#,\n  false^#*expr.Constant_BoolValue#,\n  _\u003e_(\n    1^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false ? false : (1 \u003e 3)",
+      locationAst:
+        "_?_:_(\n  false^#1[1,0]#,\n  false^#3[1,8]#,\n  _\u003e_(\n    1^#4[1,16]#,\n    3^#6[1,20]#\n  )^#5[1,18]#\n)^#2[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 8, 13, 1, 8, 1, 13],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "3", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "ternary eval trivial, false",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 6, "3": 8, "4": 16, "5": 18, "6": 20 },
+        },
+      },
+      checkedAst:
+        "_?_:_(\n  false~bool,\n  false~bool,\n  _\u003e_(\n    1~int,\n    3~int\n  )~bool^greater_int64\n)~bool^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["conditional"] },
+          "5": { overloadId: ["greater_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 6, "3": 8, "4": 16, "5": 18, "6": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              { id: "3", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "1",
+      expectedRuntimeCost: "1",
+    },
+    {
+      original: {
+        name: "comprehension over map",
+        expr: "input.all(k, input[k].single_int32 \u003e 3)",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: {
+          input: {
+            value: {
+              mapValue: {
+                entries: [
+                  {
+                    key: { stringValue: "val" },
+                    value: {
+                      objectValue: {
+                        "@type":
+                          "type.googleapis.com/google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003e_(\n      _[_](\n        input^#*expr.Expr_IdentExpr#,\n        k^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#.single_int32^#*expr.Expr_SelectExpr#,\n      3^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(k, input[k].single_int32 \u003e 3)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#10[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#11[1,9]#\n  )^#12[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#13[1,9]#,\n    _\u003e_(\n      _[_](\n        input^#4[1,13]#,\n        k^#6[1,19]#\n      )^#5[1,18]#.single_int32^#7[1,21]#,\n      3^#9[1,37]#\n    )^#8[1,35]#\n  )^#14[1,9]#,\n  // Result\n  @result^#15[1,9]#)^#16[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 18, 1, 13, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 35, 36, 1, 35, 1, 36],
+        [9, 37, 38, 1, 37, 1, 38],
+        [10, 9, 9, 1, 9, 1, 9],
+        [11, 9, 9, 1, 9, 1, 9],
+        [12, 9, 9, 1, 9, 1, 9],
+        [13, 9, 9, 1, 9, 1, 9],
+        [14, 9, 9, 1, 9, 1, 9],
+        [15, 9, 9, 1, 9, 1, 9],
+        [16, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            field: "single_int32",
+                          },
+                        },
+                        { id: "9", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension over map",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "7": 21,
+            "8": 35,
+            "9": 37,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+            "14": 9,
+            "15": 9,
+            "16": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            field: "single_int32",
+                          },
+                        },
+                        { id: "9", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "k" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  {
+                    id: "7",
+                    selectExpr: {
+                      operand: {
+                        id: "5",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "4", identExpr: { name: "input" } },
+                            { id: "6", identExpr: { name: "k" } },
+                          ],
+                        },
+                      },
+                      field: "single_int32",
+                    },
+                  },
+                  { id: "9", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, google.expr.proto3.test.TestAllTypes)^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u003e_(\n      _[_](\n        input~map(string, google.expr.proto3.test.TestAllTypes)^input,\n        k~string^k\n      )~google.expr.proto3.test.TestAllTypes^index_map.single_int32~int,\n      3~int\n    )~bool^greater_int64\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "input" },
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "k" },
+          "8": { overloadId: ["greater_int64"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "5": { messageType: "google.expr.proto3.test.TestAllTypes" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "7": 21,
+            "8": 35,
+            "9": 37,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+            "14": 9,
+            "15": 9,
+            "16": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            field: "single_int32",
+                          },
+                        },
+                        { id: "9", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        {
+                          id: "7",
+                          selectExpr: {
+                            operand: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            field: "single_int32",
+                          },
+                        },
+                        { id: "9", constExpr: { int64Value: "3" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "9",
+      expectedRuntimeCost: "9",
+    },
+    {
+      original: {
+        name: "comprehension over nested map of maps",
+        expr: "input.all(k, input[k].all(x, true))",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: { input: { value: { mapValue: {} } } },
+      },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      _[_](\n        input^#*expr.Expr_IdentExpr#,\n        k^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        true^#*expr.Constant_BoolValue#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(k, input[k].all(x, true))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#17[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#18[1,9]#\n  )^#19[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#20[1,9]#,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      _[_](\n        input^#4[1,13]#,\n        k^#6[1,19]#\n      )^#5[1,18]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#10[1,25]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#11[1,25]#\n      )^#12[1,25]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#13[1,25]#,\n        true^#9[1,29]#\n      )^#14[1,25]#,\n      // Result\n      @result^#15[1,25]#)^#16[1,25]#\n  )^#21[1,9]#,\n  // Result\n  @result^#22[1,9]#)^#23[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 18, 1, 13, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 29, 33, 1, 29, 1, 33],
+        [10, 25, 25, 1, 25, 1, 25],
+        [11, 25, 25, 1, 25, 1, 25],
+        [12, 25, 25, 1, 25, 1, 25],
+        [13, 25, 25, 1, 25, 1, 25],
+        [14, 25, 25, 1, 25, 1, 25],
+        [15, 25, 25, 1, 25, 1, 25],
+        [16, 25, 25, 1, 25, 1, 25],
+        [17, 9, 9, 1, 9, 1, 9],
+        [18, 9, 9, 1, 9, 1, 9],
+        [19, 9, 9, 1, 9, 1, 9],
+        [20, 9, 9, 1, 9, 1, 9],
+        [21, 9, 9, 1, 9, 1, 9],
+        [22, 9, 9, 1, 9, 1, 9],
+        [23, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "23",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "19",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "18", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "21",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "20", identExpr: { name: "@result" } },
+                  {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "5",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "4", identExpr: { name: "input" } },
+                            { id: "6", identExpr: { name: "k" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "12",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "11", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "13", identExpr: { name: "@result" } },
+                            { id: "9", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "22", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension over nested map of maps",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 9,
+            "18": 9,
+            "19": 9,
+            "20": 9,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "23": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [{ id: "3", identExpr: { name: "k" } }, { id: "16" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "5",
+            callExpr: {
+              function: "_[_]",
+              args: [
+                { id: "4", identExpr: { name: "input" } },
+                { id: "6", identExpr: { name: "k" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", constExpr: { boolValue: true } },
+          ],
+        },
+        {
+          id: 23,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [{ id: "3", identExpr: { name: "k" } }, { id: "16" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      _[_](\n        input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n        k~string^k\n      )~map(string, google.expr.proto3.test.TestAllTypes)^index_map,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        true~bool\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "input" },
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "k" },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+          "18": { name: "@result" },
+          "19": { overloadId: ["not_strictly_false"] },
+          "20": { name: "@result" },
+          "21": { overloadId: ["logical_and"] },
+          "22": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "5": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 9,
+            "18": 9,
+            "19": 9,
+            "20": 9,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "23": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [{ id: "3", identExpr: { name: "k" } }, { id: "16" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "23",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "17", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "19",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "18", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "21",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "20", identExpr: { name: "@result" } },
+                  {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "5",
+                        callExpr: {
+                          function: "_[_]",
+                          args: [
+                            { id: "4", identExpr: { name: "input" } },
+                            { id: "6", identExpr: { name: "k" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "12",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "11", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "13", identExpr: { name: "@result" } },
+                            { id: "9", constExpr: { boolValue: true } },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "22", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "string size of map keys",
+        expr: "input.all(k, k.contains(k))",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: { input: { value: { mapValue: {} } } },
+      },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    k^#*expr.Expr_IdentExpr#.contains(\n      k^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "input.all(k, k.contains(k))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#7[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#8[1,9]#\n  )^#9[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#10[1,9]#,\n    k^#4[1,13]#.contains(\n      k^#6[1,24]#\n    )^#5[1,23]#\n  )^#11[1,9]#,\n  // Result\n  @result^#12[1,9]#)^#13[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 14, 1, 13, 1, 14],
+        [5, 23, 24, 1, 23, 1, 24],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 9, 9, 1, 9, 1, 9],
+        [8, 9, 9, 1, 9, 1, 9],
+        [9, 9, 9, 1, 9, 1, 9],
+        [10, 9, 9, 1, 9, 1, 9],
+        [11, 9, 9, 1, 9, 1, 9],
+        [12, 9, 9, 1, 9, 1, 9],
+        [13, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "6", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "string size of map keys",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 23,
+            "6": 24,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "6", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "k" } },
+            {
+              id: "5",
+              callExpr: {
+                target: { id: "4", identExpr: { name: "k" } },
+                function: "contains",
+                args: [{ id: "6", identExpr: { name: "k" } }],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    k~string^k.contains(\n      k~string^k\n    )~bool^contains_string\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "k" },
+          "5": { overloadId: ["contains_string"] },
+          "6": { name: "k" },
+          "8": { name: "@result" },
+          "9": { overloadId: ["not_strictly_false"] },
+          "10": { name: "@result" },
+          "11": { overloadId: ["logical_and"] },
+          "12": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 23,
+            "6": 24,
+            "7": 9,
+            "8": 9,
+            "9": 9,
+            "10": 9,
+            "11": 9,
+            "12": 9,
+            "13": 9,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "6", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      target: { id: "4", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "6", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "comprehension variable shadowing",
+        expr: "input.all(k, input[k].all(k, true) \u0026\u0026 k.contains(k))",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: { input: { value: { mapValue: {} } } },
+      },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input^#*expr.Expr_IdentExpr#,\n          k^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Accumulator\n        @result,\n        // Init\n        true^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#*expr.Expr_IdentExpr#,\n          true^#*expr.Constant_BoolValue#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n      k^#*expr.Expr_IdentExpr#.contains(\n        k^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "input.all(k, input[k].all(k, true) \u0026\u0026 k.contains(k))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#21[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#22[1,9]#\n  )^#23[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#24[1,9]#,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input^#4[1,13]#,\n          k^#6[1,19]#\n        )^#5[1,18]#,\n        // Accumulator\n        @result,\n        // Init\n        true^#10[1,25]#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#11[1,25]#\n        )^#12[1,25]#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#13[1,25]#,\n          true^#9[1,29]#\n        )^#14[1,25]#,\n        // Result\n        @result^#15[1,25]#)^#16[1,25]#,\n      k^#17[1,38]#.contains(\n        k^#19[1,49]#\n      )^#18[1,48]#\n    )^#20[1,35]#\n  )^#25[1,9]#,\n  // Result\n  @result^#26[1,9]#)^#27[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 18, 1, 13, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 29, 33, 1, 29, 1, 33],
+        [10, 25, 25, 1, 25, 1, 25],
+        [11, 25, 25, 1, 25, 1, 25],
+        [12, 25, 25, 1, 25, 1, 25],
+        [13, 25, 25, 1, 25, 1, 25],
+        [14, 25, 25, 1, 25, 1, 25],
+        [15, 25, 25, 1, 25, 1, 25],
+        [16, 25, 25, 1, 25, 1, 25],
+        [17, 38, 39, 1, 38, 1, 39],
+        [18, 48, 49, 1, 48, 1, 49],
+        [19, 49, 50, 1, 49, 1, 50],
+        [20, 35, 37, 1, 35, 1, 37],
+        [21, 9, 9, 1, 9, 1, 9],
+        [22, 9, 9, 1, 9, 1, 9],
+        [23, 9, 9, 1, 9, 1, 9],
+        [24, 9, 9, 1, 9, 1, 9],
+        [25, 9, 9, 1, 9, 1, 9],
+        [26, 9, 9, 1, 9, 1, 9],
+        [27, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "21", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "22", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "10",
+                              constExpr: { boolValue: true },
+                            },
+                            loopCondition: {
+                              id: "12",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  { id: "11", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_\u0026\u0026_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                  { id: "9", constExpr: { boolValue: true } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension variable shadowing",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 38,
+            "18": 48,
+            "19": 49,
+            "20": 35,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+            "24": 9,
+            "25": 9,
+            "26": 9,
+            "27": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "16" },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "5",
+            callExpr: {
+              function: "_[_]",
+              args: [
+                { id: "4", identExpr: { name: "input" } },
+                { id: "6", identExpr: { name: "k" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "k" } },
+            { id: "9", constExpr: { boolValue: true } },
+          ],
+        },
+        {
+          id: 27,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "k" } },
+            {
+              id: "20",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "16" },
+                  {
+                    id: "18",
+                    callExpr: {
+                      target: { id: "17", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "19", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n          k~string^k\n        )~map(string, google.expr.proto3.test.TestAllTypes)^index_map,\n        // Accumulator\n        @result,\n        // Init\n        true~bool,\n        // LoopCondition\n        @not_strictly_false(\n          @result~bool^@result\n        )~bool^not_strictly_false,\n        // LoopStep\n        _\u0026\u0026_(\n          @result~bool^@result,\n          true~bool\n        )~bool^logical_and,\n        // Result\n        @result~bool^@result)~bool,\n      k~string^k.contains(\n        k~string^k\n      )~bool^contains_string\n    )~bool^logical_and\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "input" },
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "k" },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+          "17": { name: "k" },
+          "18": { overloadId: ["contains_string"] },
+          "19": { name: "k" },
+          "20": { overloadId: ["logical_and"] },
+          "22": { name: "@result" },
+          "23": { overloadId: ["not_strictly_false"] },
+          "24": { name: "@result" },
+          "25": { overloadId: ["logical_and"] },
+          "26": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "5": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "STRING" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 38,
+            "18": 48,
+            "19": 49,
+            "20": 35,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+            "24": 9,
+            "25": 9,
+            "26": 9,
+            "27": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "16" },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "21", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "22", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "10",
+                              constExpr: { boolValue: true },
+                            },
+                            loopCondition: {
+                              id: "12",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  { id: "11", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_\u0026\u0026_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                  { id: "9", constExpr: { boolValue: true } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "comprehension variable shadowing",
+        expr: "input.all(k, input[k].all(k, true) \u0026\u0026 k.contains(k))",
+        typeEnv: [
+          {
+            name: "input",
+            ident: {
+              type: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    mapType: {
+                      keyType: { primitive: "STRING" },
+                      valueType: {
+                        messageType: "google.expr.proto3.test.TestAllTypes",
+                      },
+                    },
+                  },
+                },
+              },
+            },
+          },
+        ],
+        bindings: { input: { value: { mapValue: {} } } },
+      },
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input^#*expr.Expr_IdentExpr#,\n          k^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Accumulator\n        @result,\n        // Init\n        true^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#*expr.Expr_IdentExpr#,\n          true^#*expr.Constant_BoolValue#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n      k^#*expr.Expr_IdentExpr#.contains(\n        k^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "input.all(k, input[k].all(k, true) \u0026\u0026 k.contains(k))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#21[1,9]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#22[1,9]#\n  )^#23[1,9]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#24[1,9]#,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input^#4[1,13]#,\n          k^#6[1,19]#\n        )^#5[1,18]#,\n        // Accumulator\n        @result,\n        // Init\n        true^#10[1,25]#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#11[1,25]#\n        )^#12[1,25]#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#13[1,25]#,\n          true^#9[1,29]#\n        )^#14[1,25]#,\n        // Result\n        @result^#15[1,25]#)^#16[1,25]#,\n      k^#17[1,38]#.contains(\n        k^#19[1,49]#\n      )^#18[1,48]#\n    )^#20[1,35]#\n  )^#25[1,9]#,\n  // Result\n  @result^#26[1,9]#)^#27[1,9]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 18, 1, 13, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 29, 33, 1, 29, 1, 33],
+        [10, 25, 25, 1, 25, 1, 25],
+        [11, 25, 25, 1, 25, 1, 25],
+        [12, 25, 25, 1, 25, 1, 25],
+        [13, 25, 25, 1, 25, 1, 25],
+        [14, 25, 25, 1, 25, 1, 25],
+        [15, 25, 25, 1, 25, 1, 25],
+        [16, 25, 25, 1, 25, 1, 25],
+        [17, 38, 39, 1, 38, 1, 39],
+        [18, 48, 49, 1, 48, 1, 49],
+        [19, 49, 50, 1, 49, 1, 50],
+        [20, 35, 37, 1, 35, 1, 37],
+        [21, 9, 9, 1, 9, 1, 9],
+        [22, 9, 9, 1, 9, 1, 9],
+        [23, 9, 9, 1, 9, 1, 9],
+        [24, 9, 9, 1, 9, 1, 9],
+        [25, 9, 9, 1, 9, 1, 9],
+        [26, 9, 9, 1, 9, 1, 9],
+        [27, 9, 9, 1, 9, 1, 9],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "21", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "22", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "10",
+                              constExpr: { boolValue: true },
+                            },
+                            loopCondition: {
+                              id: "12",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  { id: "11", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_\u0026\u0026_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                  { id: "9", constExpr: { boolValue: true } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension variable shadowing",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 38,
+            "18": 48,
+            "19": 49,
+            "20": 35,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+            "24": 9,
+            "25": 9,
+            "26": 9,
+            "27": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "16" },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "5",
+            callExpr: {
+              function: "_[_]",
+              args: [
+                { id: "4", identExpr: { name: "input" } },
+                { id: "6", identExpr: { name: "k" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "k" } },
+            { id: "9", constExpr: { boolValue: true } },
+          ],
+        },
+        {
+          id: 27,
+          function: "all",
+          target: { id: "1", identExpr: { name: "input" } },
+          args: [
+            { id: "3", identExpr: { name: "k" } },
+            {
+              id: "20",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "16" },
+                  {
+                    id: "18",
+                    callExpr: {
+                      target: { id: "17", identExpr: { name: "k" } },
+                      function: "contains",
+                      args: [{ id: "19", identExpr: { name: "k" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        k,\n        // Target\n        _[_](\n          input~map(string, map(string, google.expr.proto3.test.TestAllTypes))^input,\n          k~string^k\n        )~map(string, google.expr.proto3.test.TestAllTypes)^index_map,\n        // Accumulator\n        @result,\n        // Init\n        true~bool,\n        // LoopCondition\n        @not_strictly_false(\n          @result~bool^@result\n        )~bool^not_strictly_false,\n        // LoopStep\n        _\u0026\u0026_(\n          @result~bool^@result,\n          true~bool\n        )~bool^logical_and,\n        // Result\n        @result~bool^@result)~bool,\n      k~string^k.contains(\n        k~string^k\n      )~bool^contains_string\n    )~bool^logical_and\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "input" },
+          "4": { name: "input" },
+          "5": { overloadId: ["index_map"] },
+          "6": { name: "k" },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+          "17": { name: "k" },
+          "18": { overloadId: ["contains_string"] },
+          "19": { name: "k" },
+          "20": { overloadId: ["logical_and"] },
+          "22": { name: "@result" },
+          "23": { overloadId: ["not_strictly_false"] },
+          "24": { name: "@result" },
+          "25": { overloadId: ["logical_and"] },
+          "26": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "4": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: {
+                    messageType: "google.expr.proto3.test.TestAllTypes",
+                  },
+                },
+              },
+            },
+          },
+          "5": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: {
+                messageType: "google.expr.proto3.test.TestAllTypes",
+              },
+            },
+          },
+          "6": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "STRING" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "STRING" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "BOOL" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: {
+            "1": 0,
+            "3": 10,
+            "4": 13,
+            "5": 18,
+            "6": 19,
+            "8": 26,
+            "9": 29,
+            "10": 25,
+            "11": 25,
+            "12": 25,
+            "13": 25,
+            "14": 25,
+            "15": 25,
+            "16": 25,
+            "17": 38,
+            "18": 48,
+            "19": 49,
+            "20": 35,
+            "21": 9,
+            "22": 9,
+            "23": 9,
+            "24": 9,
+            "25": 9,
+            "26": 9,
+            "27": 9,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "5",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      { id: "4", identExpr: { name: "input" } },
+                      { id: "6", identExpr: { name: "k" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  { id: "9", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            "27": {
+              callExpr: {
+                target: { id: "1", identExpr: { name: "input" } },
+                function: "all",
+                args: [
+                  { id: "3", identExpr: { name: "k" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "16" },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: { id: "1", identExpr: { name: "input" } },
+            accuVar: "@result",
+            accuInit: { id: "21", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "23",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "22", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "25",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "24", identExpr: { name: "@result" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        {
+                          id: "16",
+                          comprehensionExpr: {
+                            iterVar: "k",
+                            iterRange: {
+                              id: "5",
+                              callExpr: {
+                                function: "_[_]",
+                                args: [
+                                  { id: "4", identExpr: { name: "input" } },
+                                  { id: "6", identExpr: { name: "k" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: {
+                              id: "10",
+                              constExpr: { boolValue: true },
+                            },
+                            loopCondition: {
+                              id: "12",
+                              callExpr: {
+                                function: "@not_strictly_false",
+                                args: [
+                                  { id: "11", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            loopStep: {
+                              id: "14",
+                              callExpr: {
+                                function: "_\u0026\u0026_",
+                                args: [
+                                  { id: "13", identExpr: { name: "@result" } },
+                                  { id: "9", constExpr: { boolValue: true } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "15",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                        {
+                          id: "18",
+                          callExpr: {
+                            target: { id: "17", identExpr: { name: "k" } },
+                            function: "contains",
+                            args: [{ id: "19", identExpr: { name: "k" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "26", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "list concat",
+        expr: "(list1 + list2).all(x, true)",
+        typeEnv: [
+          {
+            name: "list1",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+          {
+            name: "list2",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        bindings: {
+          list1: { value: { listValue: {} } },
+          list2: { value: { listValue: {} } },
+        },
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  _+_(\n    list1^#*expr.Expr_IdentExpr#,\n    list2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "(list1 + list2).all(x, true)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  _+_(\n    list1^#1[1,1]#,\n    list2^#3[1,9]#\n  )^#2[1,7]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#7[1,19]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#8[1,19]#\n  )^#9[1,19]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#10[1,19]#,\n    true^#6[1,23]#\n  )^#11[1,19]#,\n  // Result\n  @result^#12[1,19]#)^#13[1,19]#",
+      positions: [
+        [1, 1, 6, 1, 1, 1, 6],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 9, 14, 1, 9, 1, 14],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 23, 27, 1, 23, 1, 27],
+        [7, 19, 19, 1, 19, 1, 19],
+        [8, 19, 19, 1, 19, 1, 19],
+        [9, 19, 19, 1, 19, 1, 19],
+        [10, 19, 19, 1, 19, 1, 19],
+        [11, 19, 19, 1, 19, 1, 19],
+        [12, 19, 19, 1, 19, 1, 19],
+        [13, 19, 19, 1, 19, 1, 19],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "list1" } },
+                  { id: "3", identExpr: { name: "list2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "list concat",
+          lineOffsets: [29],
+          positions: {
+            "1": 1,
+            "2": 7,
+            "3": 9,
+            "5": 20,
+            "6": 23,
+            "7": 19,
+            "8": 19,
+            "9": 19,
+            "10": 19,
+            "11": 19,
+            "12": 19,
+            "13": 19,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "1", identExpr: { name: "list1" } },
+                      { id: "3", identExpr: { name: "list2" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "5", identExpr: { name: "x" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 13,
+          function: "all",
+          target: {
+            id: "2",
+            callExpr: {
+              function: "_+_",
+              args: [
+                { id: "1", identExpr: { name: "list1" } },
+                { id: "3", identExpr: { name: "list2" } },
+              ],
+            },
+          },
+          args: [
+            { id: "5", identExpr: { name: "x" } },
+            { id: "6", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  _+_(\n    list1~list(int)^list1,\n    list2~list(int)^list2\n  )~list(int)^add_list,\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "1": { name: "list1" },
+          "2": { overloadId: ["add_list"] },
+          "3": { name: "list2" },
+          "8": { name: "@result" },
+          "9": { overloadId: ["not_strictly_false"] },
+          "10": { name: "@result" },
+          "11": { overloadId: ["logical_and"] },
+          "12": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 1,
+            "2": 7,
+            "3": 9,
+            "5": 20,
+            "6": 23,
+            "7": 19,
+            "8": 19,
+            "9": 19,
+            "10": 19,
+            "11": 19,
+            "12": 19,
+            "13": 19,
+          },
+          macroCalls: {
+            "13": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "1", identExpr: { name: "list1" } },
+                      { id: "3", identExpr: { name: "list2" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "5", identExpr: { name: "x" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "13",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "list1" } },
+                  { id: "3", identExpr: { name: "list2" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "7", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "9",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "8", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "11",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "10", identExpr: { name: "@result" } },
+                  { id: "6", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "12", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+      expectedRuntimeCost: "4",
+    },
+    {
+      original: {
+        name: "str concat",
+        expr: '"abcdefg".contains(str1 + str2)',
+        typeEnv: [
+          { name: "str1", ident: { type: { primitive: "STRING" } } },
+          { name: "str2", ident: { type: { primitive: "STRING" } } },
+        ],
+        bindings: {
+          str1: { value: { stringValue: "val1" } },
+          str2: { value: { stringValue: "val2222222" } },
+        },
+      },
+      ast: '"abcdefg"^#*expr.Constant_StringValue#.contains(\n  _+_(\n    str1^#*expr.Expr_IdentExpr#,\n    str2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"abcdefg".contains(str1 + str2)',
+      locationAst:
+        '"abcdefg"^#1[1,0]#.contains(\n  _+_(\n    str1^#3[1,19]#,\n    str2^#5[1,26]#\n  )^#4[1,24]#\n)^#2[1,18]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 23, 1, 19, 1, 23],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 26, 30, 1, 26, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "abcdefg" } },
+            function: "contains",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "str1" } },
+                    { id: "5", identExpr: { name: "str2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "str concat",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 24, "5": 26 },
+        },
+      },
+      checkedAst:
+        '"abcdefg"~string.contains(\n  _+_(\n    str1~string^str1,\n    str2~string^str2\n  )~string^add_string\n)~bool^contains_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["contains_string"] },
+          "3": { name: "str1" },
+          "4": { overloadId: ["add_string"] },
+          "5": { name: "str2" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 24, "5": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "abcdefg" } },
+            function: "contains",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "str1" } },
+                    { id: "5", identExpr: { name: "str2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3689348814741910530" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "6",
+      expectedRuntimeCost: "6",
+    },
+    {
+      original: {
+        name: "at limit",
+        expr: '"abcdefg".contains(str1 + str2)',
+        typeEnv: [
+          { name: "str1", ident: { type: { primitive: "STRING" } } },
+          { name: "str2", ident: { type: { primitive: "STRING" } } },
+        ],
+        bindings: {
+          str1: { value: { stringValue: "val1" } },
+          str2: { value: { stringValue: "val2222222" } },
+        },
+      },
+      costLimit: "6",
+      ast: '"abcdefg"^#*expr.Constant_StringValue#.contains(\n  _+_(\n    str1^#*expr.Expr_IdentExpr#,\n    str2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"abcdefg".contains(str1 + str2)',
+      locationAst:
+        '"abcdefg"^#1[1,0]#.contains(\n  _+_(\n    str1^#3[1,19]#,\n    str2^#5[1,26]#\n  )^#4[1,24]#\n)^#2[1,18]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 23, 1, 19, 1, 23],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 26, 30, 1, 26, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "abcdefg" } },
+            function: "contains",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "str1" } },
+                    { id: "5", identExpr: { name: "str2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "at limit",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 24, "5": 26 },
+        },
+      },
+      checkedAst:
+        '"abcdefg"~string.contains(\n  _+_(\n    str1~string^str1,\n    str2~string^str2\n  )~string^add_string\n)~bool^contains_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["contains_string"] },
+          "3": { name: "str1" },
+          "4": { overloadId: ["add_string"] },
+          "5": { name: "str2" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 24, "5": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "abcdefg" } },
+            function: "contains",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "str1" } },
+                    { id: "5", identExpr: { name: "str2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3689348814741910530" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "6",
+      expectedRuntimeCost: "6",
+    },
+    {
+      original: {
+        name: "above limit",
+        expr: '"abcdefg".contains(str1 + str2)',
+        typeEnv: [
+          { name: "str1", ident: { type: { primitive: "STRING" } } },
+          { name: "str2", ident: { type: { primitive: "STRING" } } },
+        ],
+        bindings: {
+          str1: { value: { stringValue: "val1" } },
+          str2: { value: { stringValue: "val2222222" } },
+        },
+        evalError: {
+          errors: [
+            { message: "operation cancelled: actual cost limit exceeded" },
+          ],
+        },
+      },
+      costLimit: "5",
+      ast: '"abcdefg"^#*expr.Constant_StringValue#.contains(\n  _+_(\n    str1^#*expr.Expr_IdentExpr#,\n    str2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"abcdefg".contains(str1 + str2)',
+      locationAst:
+        '"abcdefg"^#1[1,0]#.contains(\n  _+_(\n    str1^#3[1,19]#,\n    str2^#5[1,26]#\n  )^#4[1,24]#\n)^#2[1,18]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 18, 19, 1, 18, 1, 19],
+        [3, 19, 23, 1, 19, 1, 23],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 26, 30, 1, 26, 1, 30],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "abcdefg" } },
+            function: "contains",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "str1" } },
+                    { id: "5", identExpr: { name: "str2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "above limit",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 24, "5": 26 },
+        },
+      },
+      checkedAst:
+        '"abcdefg"~string.contains(\n  _+_(\n    str1~string^str1,\n    str2~string^str2\n  )~string^add_string\n)~bool^contains_string',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["contains_string"] },
+          "3": { name: "str1" },
+          "4": { overloadId: ["add_string"] },
+          "5": { name: "str2" },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 18, "3": 19, "4": 24, "5": 26 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", constExpr: { stringValue: "abcdefg" } },
+            function: "contains",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "3", identExpr: { name: "str1" } },
+                    { id: "5", identExpr: { name: "str2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3689348814741910530" },
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "operation cancelled: actual cost limit exceeded",
+            },
+          ],
+        },
+      },
+      runtimeCost: "6",
+    },
+    {
+      original: {
+        name: "ternary as operand",
+        expr: "(1 \u003e 2 ? 5 : 3) \u003e 1",
+      },
+      ast: "_\u003e_(\n  _?_:_(\n    _\u003e_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    5^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "((1 \u003e 2) ? 5 : 3) \u003e 1",
+      locationAst:
+        "_\u003e_(\n  _?_:_(\n    _\u003e_(\n      1^#1[1,1]#,\n      2^#3[1,5]#\n    )^#2[1,3]#,\n    5^#5[1,9]#,\n    3^#6[1,13]#\n  )^#4[1,7]#,\n  1^#8[1,18]#\n)^#7[1,16]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 16, 17, 1, 16, 1, 17],
+        [8, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "5" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "ternary as operand",
+          lineOffsets: [20],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 16,
+            "8": 18,
+          },
+        },
+      },
+      checkedAst:
+        "_\u003e_(\n  _?_:_(\n    _\u003e_(\n      1~int,\n      2~int\n    )~bool^greater_int64,\n    5~int,\n    3~int\n  )~int^conditional,\n  1~int\n)~bool^greater_int64",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["greater_int64"] },
+          "4": { overloadId: ["conditional"] },
+          "7": { overloadId: ["greater_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 16,
+            "8": 18,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { int64Value: "5" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+      expectedRuntimeCost: "2",
+    },
+    {
+      original: {
+        name: "ternary as operand",
+        expr: "(1 \u003e 2 || 2 \u003e 1) == true",
+      },
+      ast: "_==_(\n  _||_(\n    _\u003e_(\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _\u003e_(\n      2^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(1 \u003e 2 || 2 \u003e 1) == true",
+      locationAst:
+        "_==_(\n  _||_(\n    _\u003e_(\n      1^#1[1,1]#,\n      2^#3[1,5]#\n    )^#2[1,3]#,\n    _\u003e_(\n      2^#4[1,10]#,\n      1^#6[1,14]#\n    )^#5[1,12]#\n  )^#7[1,7]#,\n  true^#9[1,20]#\n)^#8[1,17]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 7, 9, 1, 7, 1, 9],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "4", constExpr: { int64Value: "2" } },
+                          { id: "6", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "ternary as operand",
+          lineOffsets: [25],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 10,
+            "5": 12,
+            "6": 14,
+            "7": 7,
+            "8": 17,
+            "9": 20,
+          },
+        },
+      },
+      checkedAst:
+        "_==_(\n  _||_(\n    _\u003e_(\n      1~int,\n      2~int\n    )~bool^greater_int64,\n    _\u003e_(\n      2~int,\n      1~int\n    )~bool^greater_int64\n  )~bool^logical_or,\n  true~bool\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["greater_int64"] },
+          "5": { overloadId: ["greater_int64"] },
+          "7": { overloadId: ["logical_or"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "BOOL" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 10,
+            "5": 12,
+            "6": 14,
+            "7": 7,
+            "8": 17,
+            "9": 20,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "7",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "1", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "4", constExpr: { int64Value: "2" } },
+                          { id: "6", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+      expectedRuntimeCost: "3",
+    },
+    {
+      original: {
+        name: "list map literal",
+        expr: "[{'k1': 1}, {'k2': 2}].all(x, true)",
+      },
+      ast: '__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    {\n      "k1"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "k2"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: '[{"k1": 1}, {"k2": 2}].all(x, true)',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    {\n      "k1"^#4[1,2]#:1^#5[1,8]#^#3[1,6]#\n    }^#2[1,1]#,\n    {\n      "k2"^#8[1,13]#:2^#9[1,19]#^#7[1,17]#\n    }^#6[1,12]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#13[1,26]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#14[1,26]#\n  )^#15[1,26]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#16[1,26]#,\n    true^#12[1,30]#\n  )^#17[1,26]#,\n  // Result\n  @result^#18[1,26]#)^#19[1,26]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 2, 6, 1, 2, 1, 6],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 13, 17, 1, 13, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [11, 27, 28, 1, 27, 1, 28],
+        [12, 30, 34, 1, 30, 1, 34],
+        [13, 26, 26, 1, 26, 1, 26],
+        [14, 26, 26, 1, 26, 1, 26],
+        [15, 26, 26, 1, 26, 1, 26],
+        [16, 26, 26, 1, 26, 1, 26],
+        [17, 26, 26, 1, 26, 1, 26],
+        [18, 26, 26, 1, 26, 1, 26],
+        [19, 26, 26, 1, 26, 1, 26],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "2",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "3",
+                          mapKey: { id: "4", constExpr: { stringValue: "k1" } },
+                          value: { id: "5", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "7",
+                          mapKey: { id: "8", constExpr: { stringValue: "k2" } },
+                          value: { id: "9", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "13", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "14", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  { id: "12", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "list map literal",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 2,
+            "5": 8,
+            "6": 12,
+            "7": 17,
+            "8": 13,
+            "9": 19,
+            "11": 27,
+            "12": 30,
+            "13": 26,
+            "14": 26,
+            "15": 26,
+            "16": 26,
+            "17": 26,
+            "18": 26,
+            "19": 26,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "3",
+                              mapKey: {
+                                id: "4",
+                                constExpr: { stringValue: "k1" },
+                              },
+                              value: {
+                                id: "5",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "7",
+                              mapKey: {
+                                id: "8",
+                                constExpr: { stringValue: "k2" },
+                              },
+                              value: {
+                                id: "9",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  { id: "12", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "all",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "2",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "3",
+                        mapKey: { id: "4", constExpr: { stringValue: "k1" } },
+                        value: { id: "5", constExpr: { int64Value: "1" } },
+                      },
+                    ],
+                  },
+                },
+                {
+                  id: "6",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "7",
+                        mapKey: { id: "8", constExpr: { stringValue: "k2" } },
+                        value: { id: "9", constExpr: { int64Value: "2" } },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "11", identExpr: { name: "x" } },
+            { id: "12", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        '__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    {\n      "k1"~string:1~int\n    }~map(string, int),\n    {\n      "k2"~string:2~int\n    }~map(string, int)\n  ]~list(map(string, int)),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool',
+      checkedExpr: {
+        referenceMap: {
+          "14": { name: "@result" },
+          "15": { overloadId: ["not_strictly_false"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_and"] },
+          "18": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "INT64" },
+          "6": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "INT64" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 2,
+            "5": 8,
+            "6": 12,
+            "7": 17,
+            "8": 13,
+            "9": 19,
+            "11": 27,
+            "12": 30,
+            "13": 26,
+            "14": 26,
+            "15": 26,
+            "16": 26,
+            "17": 26,
+            "18": 26,
+            "19": 26,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "3",
+                              mapKey: {
+                                id: "4",
+                                constExpr: { stringValue: "k1" },
+                              },
+                              value: {
+                                id: "5",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "7",
+                              mapKey: {
+                                id: "8",
+                                constExpr: { stringValue: "k2" },
+                              },
+                              value: {
+                                id: "9",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  { id: "12", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "2",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "3",
+                          mapKey: { id: "4", constExpr: { stringValue: "k1" } },
+                          value: { id: "5", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "7",
+                          mapKey: { id: "8", constExpr: { stringValue: "k2" } },
+                          value: { id: "9", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "13", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "14", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  { id: "12", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "77", max: "77" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "77",
+      expectedRuntimeCost: "77",
+    },
+    {
+      original: {
+        name: "list map literal",
+        expr: "[{'k1': 1}, {'k2': 2}].all(x, true)",
+      },
+      ast: '__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    {\n      "k1"^#*expr.Constant_StringValue#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#,\n    {\n      "k2"^#*expr.Constant_StringValue#:2^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n    }^#*expr.Expr_StructExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: '[{"k1": 1}, {"k2": 2}].all(x, true)',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    {\n      "k1"^#4[1,2]#:1^#5[1,8]#^#3[1,6]#\n    }^#2[1,1]#,\n    {\n      "k2"^#8[1,13]#:2^#9[1,19]#^#7[1,17]#\n    }^#6[1,12]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#13[1,26]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#14[1,26]#\n  )^#15[1,26]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#16[1,26]#,\n    true^#12[1,30]#\n  )^#17[1,26]#,\n  // Result\n  @result^#18[1,26]#)^#19[1,26]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 2, 6, 1, 2, 1, 6],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 13, 17, 1, 13, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [11, 27, 28, 1, 27, 1, 28],
+        [12, 30, 34, 1, 30, 1, 34],
+        [13, 26, 26, 1, 26, 1, 26],
+        [14, 26, 26, 1, 26, 1, 26],
+        [15, 26, 26, 1, 26, 1, 26],
+        [16, 26, 26, 1, 26, 1, 26],
+        [17, 26, 26, 1, 26, 1, 26],
+        [18, 26, 26, 1, 26, 1, 26],
+        [19, 26, 26, 1, 26, 1, 26],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "2",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "3",
+                          mapKey: { id: "4", constExpr: { stringValue: "k1" } },
+                          value: { id: "5", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "7",
+                          mapKey: { id: "8", constExpr: { stringValue: "k2" } },
+                          value: { id: "9", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "13", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "14", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  { id: "12", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "list map literal",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 2,
+            "5": 8,
+            "6": 12,
+            "7": 17,
+            "8": 13,
+            "9": 19,
+            "11": 27,
+            "12": 30,
+            "13": 26,
+            "14": 26,
+            "15": 26,
+            "16": 26,
+            "17": 26,
+            "18": 26,
+            "19": 26,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "3",
+                              mapKey: {
+                                id: "4",
+                                constExpr: { stringValue: "k1" },
+                              },
+                              value: {
+                                id: "5",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "7",
+                              mapKey: {
+                                id: "8",
+                                constExpr: { stringValue: "k2" },
+                              },
+                              value: {
+                                id: "9",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  { id: "12", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 19,
+          function: "all",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "2",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "3",
+                        mapKey: { id: "4", constExpr: { stringValue: "k1" } },
+                        value: { id: "5", constExpr: { int64Value: "1" } },
+                      },
+                    ],
+                  },
+                },
+                {
+                  id: "6",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "7",
+                        mapKey: { id: "8", constExpr: { stringValue: "k2" } },
+                        value: { id: "9", constExpr: { int64Value: "2" } },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "11", identExpr: { name: "x" } },
+            { id: "12", constExpr: { boolValue: true } },
+          ],
+        },
+      ],
+      checkedAst:
+        '__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    {\n      "k1"~string:1~int\n    }~map(string, int),\n    {\n      "k2"~string:2~int\n    }~map(string, int)\n  ]~list(map(string, int)),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    true~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool',
+      checkedExpr: {
+        referenceMap: {
+          "14": { name: "@result" },
+          "15": { overloadId: ["not_strictly_false"] },
+          "16": { name: "@result" },
+          "17": { overloadId: ["logical_and"] },
+          "18": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+            },
+          },
+          "2": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "INT64" },
+          "6": {
+            mapType: {
+              keyType: { primitive: "STRING" },
+              valueType: { primitive: "INT64" },
+            },
+          },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "INT64" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+          "18": { primitive: "BOOL" },
+          "19": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 2,
+            "5": 8,
+            "6": 12,
+            "7": 17,
+            "8": 13,
+            "9": 19,
+            "11": 27,
+            "12": 30,
+            "13": 26,
+            "14": 26,
+            "15": 26,
+            "16": 26,
+            "17": 26,
+            "18": 26,
+            "19": 26,
+          },
+          macroCalls: {
+            "19": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "3",
+                              mapKey: {
+                                id: "4",
+                                constExpr: { stringValue: "k1" },
+                              },
+                              value: {
+                                id: "5",
+                                constExpr: { int64Value: "1" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        structExpr: {
+                          entries: [
+                            {
+                              id: "7",
+                              mapKey: {
+                                id: "8",
+                                constExpr: { stringValue: "k2" },
+                              },
+                              value: {
+                                id: "9",
+                                constExpr: { int64Value: "2" },
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "11", identExpr: { name: "x" } },
+                  { id: "12", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "19",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "2",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "3",
+                          mapKey: { id: "4", constExpr: { stringValue: "k1" } },
+                          value: { id: "5", constExpr: { int64Value: "1" } },
+                        },
+                      ],
+                    },
+                  },
+                  {
+                    id: "6",
+                    structExpr: {
+                      entries: [
+                        {
+                          id: "7",
+                          mapKey: { id: "8", constExpr: { stringValue: "k2" } },
+                          value: { id: "9", constExpr: { int64Value: "2" } },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "13", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "15",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "14", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "17",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "16", identExpr: { name: "@result" } },
+                  { id: "12", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            result: { id: "18", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "77", max: "77" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "77",
+      expectedRuntimeCost: "77",
+    },
+    {
+      original: {
+        name: ".filter list literal",
+        expr: "[1,2,3,4,5].filter(x, x % 2 == 0)",
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#*expr.Expr_IdentExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].filter(x, x % 2 == 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#,\n    5^#6[1,9]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#14[1,18]#,\n  // LoopCondition\n  true^#15[1,18]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#9[1,22]#,\n        2^#11[1,26]#\n      )^#10[1,24]#,\n      0^#13[1,31]#\n    )^#12[1,28]#,\n    _+_(\n      @result^#16[1,18]#,\n      [\n        x^#8[1,19]#\n      ]^#17[1,18]#\n    )^#18[1,18]#,\n    @result^#19[1,18]#\n  )^#20[1,18]#,\n  // Result\n  @result^#21[1,18]#)^#22[1,18]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 24, 25, 1, 24, 1, 25],
+        [11, 26, 27, 1, 26, 1, 27],
+        [12, 28, 30, 1, 28, 1, 30],
+        [13, 31, 32, 1, 31, 1, 32],
+        [14, 18, 18, 1, 18, 1, 18],
+        [15, 18, 18, 1, 18, 1, 18],
+        [16, 18, 18, 1, 18, 1, 18],
+        [17, 18, 18, 1, 18, 1, 18],
+        [18, 18, 18, 1, 18, 1, 18],
+        [19, 18, 18, 1, 18, 1, 18],
+        [20, 18, 18, 1, 18, 1, 18],
+        [21, 18, 18, 1, 18, 1, 18],
+        [22, 18, 18, 1, 18, 1, 18],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "14", listExpr: {} },
+            loopCondition: { id: "15", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "9", identExpr: { name: "x" } },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "13", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "16", identExpr: { name: "@result" } },
+                        {
+                          id: "17",
+                          listExpr: {
+                            elements: [{ id: "8", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "19", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".filter list literal",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 19,
+            "9": 22,
+            "10": 24,
+            "11": 26,
+            "12": 28,
+            "13": 31,
+            "14": 18,
+            "15": 18,
+            "16": 18,
+            "17": 18,
+            "18": 18,
+            "19": 18,
+            "20": 18,
+            "21": 18,
+            "22": 18,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "9", identExpr: { name: "x" } },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "13", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 22,
+          function: "filter",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "12",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_%_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "13", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int,\n    5~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x~int^x,\n        2~int\n      )~int^modulo_int64,\n      0~int\n    )~bool^equals,\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "8": { name: "x" },
+          "9": { name: "x" },
+          "10": { overloadId: ["modulo_int64"] },
+          "12": { overloadId: ["equals"] },
+          "16": { name: "@result" },
+          "18": { overloadId: ["add_list"] },
+          "19": { name: "@result" },
+          "20": { overloadId: ["conditional"] },
+          "21": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "INT64" },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { primitive: "BOOL" },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 19,
+            "9": 22,
+            "10": 24,
+            "11": 26,
+            "12": 28,
+            "13": 31,
+            "14": 18,
+            "15": 18,
+            "16": 18,
+            "17": 18,
+            "18": 18,
+            "19": 18,
+            "20": 18,
+            "21": 18,
+            "22": 18,
+          },
+          macroCalls: {
+            "22": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "9", identExpr: { name: "x" } },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "13", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "22",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "14", listExpr: {} },
+            loopCondition: { id: "15", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "20",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "10",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "9", identExpr: { name: "x" } },
+                              { id: "11", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "13", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "16", identExpr: { name: "@result" } },
+                        {
+                          id: "17",
+                          listExpr: {
+                            elements: [{ id: "8", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "19", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "21", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "41", max: "101" },
+      result: {
+        value: {
+          listValue: { values: [{ int64Value: "2" }, { int64Value: "4" }] },
+        },
+      },
+      runtimeCost: "62",
+      expectedRuntimeCost: "62",
+    },
+    {
+      original: { name: ".map list literal", expr: "[1,2,3,4,5].map(x, x)" },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#,\n    5^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      x^#*expr.Expr_IdentExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#,\n    4^#5[1,7]#,\n    5^#6[1,9]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#10[1,15]#,\n  // LoopCondition\n  true^#11[1,15]#,\n  // LoopStep\n  _+_(\n    @result^#12[1,15]#,\n    [\n      x^#9[1,19]#\n    ]^#13[1,15]#\n  )^#14[1,15]#,\n  // Result\n  @result^#15[1,15]#)^#16[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", listExpr: {} },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "12", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    listExpr: {
+                      elements: [{ id: "9", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".map list literal",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int,\n    5~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(int)^@result,\n    [\n      x~int^x\n    ]~list(int)\n  )~list(int)^add_list,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                  { id: "5", constExpr: { int64Value: "4" } },
+                  { id: "6", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", listExpr: {} },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "12", identExpr: { name: "@result" } },
+                  {
+                    id: "13",
+                    listExpr: {
+                      elements: [{ id: "9", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "86", max: "86" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+              { int64Value: "4" },
+              { int64Value: "5" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "86",
+      expectedRuntimeCost: "86",
+    },
+    {
+      original: {
+        name: ".map.filter list literal",
+        expr: "[1,2,3,4,5].map(x, x).filter(x, x % 2 == 0)",
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#*expr.Expr_IdentExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x).filter(x, x % 2 == 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,3]#,\n      3^#4[1,5]#,\n      4^#5[1,7]#,\n      5^#6[1,9]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#10[1,15]#,\n    // LoopCondition\n    true^#11[1,15]#,\n    // LoopStep\n    _+_(\n      @result^#12[1,15]#,\n      [\n        x^#9[1,19]#\n      ]^#13[1,15]#\n    )^#14[1,15]#,\n    // Result\n    @result^#15[1,15]#)^#16[1,15]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#24[1,28]#,\n  // LoopCondition\n  true^#25[1,28]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x^#19[1,32]#,\n        2^#21[1,36]#\n      )^#20[1,34]#,\n      0^#23[1,41]#\n    )^#22[1,38]#,\n    _+_(\n      @result^#26[1,28]#,\n      [\n        x^#18[1,29]#\n      ]^#27[1,28]#\n    )^#28[1,28]#,\n    @result^#29[1,28]#\n  )^#30[1,28]#,\n  // Result\n  @result^#31[1,28]#)^#32[1,28]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [18, 29, 30, 1, 29, 1, 30],
+        [19, 32, 33, 1, 32, 1, 33],
+        [20, 34, 35, 1, 34, 1, 35],
+        [21, 36, 37, 1, 36, 1, 37],
+        [22, 38, 40, 1, 38, 1, 40],
+        [23, 41, 42, 1, 41, 1, 42],
+        [24, 28, 28, 1, 28, 1, 28],
+        [25, 28, 28, 1, 28, 1, 28],
+        [26, 28, 28, 1, 28, 1, 28],
+        [27, 28, 28, 1, 28, 1, 28],
+        [28, 28, 28, 1, 28, 1, 28],
+        [29, 28, 28, 1, 28, 1, 28],
+        [30, 28, 28, 1, 28, 1, 28],
+        [31, 28, 28, 1, 28, 1, 28],
+        [32, 28, 28, 1, 28, 1, 28],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "32",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "10", listExpr: {} },
+                loopCondition: { id: "11", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "13",
+                        listExpr: {
+                          elements: [{ id: "9", identExpr: { name: "x" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "24", listExpr: {} },
+            loopCondition: { id: "25", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "30",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "23", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "28",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "26", identExpr: { name: "@result" } },
+                        {
+                          id: "27",
+                          listExpr: {
+                            elements: [{ id: "18", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "29", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "31", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".map.filter list literal",
+          lineOffsets: [44],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 29,
+            "19": 32,
+            "20": 34,
+            "21": 36,
+            "22": 38,
+            "23": 41,
+            "24": 28,
+            "25": 28,
+            "26": 28,
+            "27": 28,
+            "28": 28,
+            "29": 28,
+            "30": 28,
+            "31": 28,
+            "32": 28,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "32": {
+              callExpr: {
+                target: { id: "16" },
+                function: "filter",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "23", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+        {
+          id: 32,
+          function: "filter",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "x" } },
+            {
+              id: "22",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_%_",
+                      args: [
+                        { id: "19", identExpr: { name: "x" } },
+                        { id: "21", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  { id: "23", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int,\n      5~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      _%_(\n        x~int^x,\n        2~int\n      )~int^modulo_int64,\n      0~int\n    )~bool^equals,\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "18": { name: "x" },
+          "19": { name: "x" },
+          "20": { overloadId: ["modulo_int64"] },
+          "22": { overloadId: ["equals"] },
+          "26": { name: "@result" },
+          "28": { overloadId: ["add_list"] },
+          "29": { name: "@result" },
+          "30": { overloadId: ["conditional"] },
+          "31": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { primitive: "INT64" },
+          "19": { primitive: "INT64" },
+          "20": { primitive: "INT64" },
+          "21": { primitive: "INT64" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "INT64" },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { primitive: "BOOL" },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+          "28": { listType: { elemType: { primitive: "INT64" } } },
+          "29": { listType: { elemType: { primitive: "INT64" } } },
+          "30": { listType: { elemType: { primitive: "INT64" } } },
+          "31": { listType: { elemType: { primitive: "INT64" } } },
+          "32": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 29,
+            "19": 32,
+            "20": 34,
+            "21": 36,
+            "22": 38,
+            "23": 41,
+            "24": 28,
+            "25": 28,
+            "26": 28,
+            "27": 28,
+            "28": 28,
+            "29": 28,
+            "30": 28,
+            "31": 28,
+            "32": 28,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "32": {
+              callExpr: {
+                target: { id: "16" },
+                function: "filter",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "23", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "32",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "10", listExpr: {} },
+                loopCondition: { id: "11", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "13",
+                        listExpr: {
+                          elements: [{ id: "9", identExpr: { name: "x" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "24", listExpr: {} },
+            loopCondition: { id: "25", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "30",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_%_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        { id: "23", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "28",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "26", identExpr: { name: "@result" } },
+                        {
+                          id: "27",
+                          listExpr: {
+                            elements: [{ id: "18", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "29", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "31", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "117", max: "177" },
+      result: {
+        value: {
+          listValue: { values: [{ int64Value: "2" }, { int64Value: "4" }] },
+        },
+      },
+      runtimeCost: "138",
+      expectedRuntimeCost: "138",
+    },
+    {
+      original: {
+        name: ".map.exists list literal",
+        expr: "[1,2,3,4,5].map(x, x).exists(x, x == 5) == true",
+      },
+      ast: "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#,\n        4^#*expr.Constant_Int64Value#,\n        5^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    // Accumulator\n    @result,\n    // Init\n    false^#*expr.Constant_BoolValue#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // LoopStep\n    _||_(\n      @result^#*expr.Expr_IdentExpr#,\n      _==_(\n        x^#*expr.Expr_IdentExpr#,\n        5^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x).exists(x, x == 5) == true",
+      locationAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1^#2[1,1]#,\n        2^#3[1,3]#,\n        3^#4[1,5]#,\n        4^#5[1,7]#,\n        5^#6[1,9]#\n      ]^#1[1,0]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#10[1,15]#,\n      // LoopCondition\n      true^#11[1,15]#,\n      // LoopStep\n      _+_(\n        @result^#12[1,15]#,\n        [\n          x^#9[1,19]#\n        ]^#13[1,15]#\n      )^#14[1,15]#,\n      // Result\n      @result^#15[1,15]#)^#16[1,15]#,\n    // Accumulator\n    @result,\n    // Init\n    false^#22[1,28]#,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result^#23[1,28]#\n      )^#24[1,28]#\n    )^#25[1,28]#,\n    // LoopStep\n    _||_(\n      @result^#26[1,28]#,\n      _==_(\n        x^#19[1,32]#,\n        5^#21[1,37]#\n      )^#20[1,34]#\n    )^#27[1,28]#,\n    // Result\n    @result^#28[1,28]#)^#29[1,28]#,\n  true^#31[1,43]#\n)^#30[1,40]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [18, 29, 30, 1, 29, 1, 30],
+        [19, 32, 33, 1, 32, 1, 33],
+        [20, 34, 36, 1, 34, 1, 36],
+        [21, 37, 38, 1, 37, 1, 38],
+        [22, 28, 28, 1, 28, 1, 28],
+        [23, 28, 28, 1, 28, 1, 28],
+        [24, 28, 28, 1, 28, 1, 28],
+        [25, 28, 28, 1, 28, 1, 28],
+        [26, 28, 28, 1, 28, 1, 28],
+        [27, 28, 28, 1, 28, 1, 28],
+        [28, 28, 28, 1, 28, 1, 28],
+        [29, 28, 28, 1, 28, 1, 28],
+        [30, 40, 42, 1, 40, 1, 42],
+        [31, 43, 47, 1, 43, 1, 47],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "30",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "29",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                            { id: "5", constExpr: { int64Value: "4" } },
+                            { id: "6", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "12", identExpr: { name: "@result" } },
+                            {
+                              id: "13",
+                              listExpr: {
+                                elements: [
+                                  { id: "9", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "22", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "25",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "24",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "23", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "27",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "26", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "5" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "28", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "31", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: ".map.exists list literal",
+          lineOffsets: [48],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 29,
+            "19": 32,
+            "20": 34,
+            "21": 37,
+            "22": 28,
+            "23": 28,
+            "24": 28,
+            "25": 28,
+            "26": 28,
+            "27": 28,
+            "28": 28,
+            "29": 28,
+            "30": 40,
+            "31": 43,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: { id: "16" },
+                function: "exists",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "19", identExpr: { name: "x" } },
+                        { id: "21", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+        {
+          id: 29,
+          function: "exists",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "x" } },
+            {
+              id: "20",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "19", identExpr: { name: "x" } },
+                  { id: "21", constExpr: { int64Value: "5" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "_==_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1~int,\n        2~int,\n        3~int,\n        4~int,\n        5~int\n      ]~list(int),\n      // Accumulator\n      @result,\n      // Init\n      []~list(int),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _+_(\n        @result~list(int)^@result,\n        [\n          x~int^x\n        ]~list(int)\n      )~list(int)^add_list,\n      // Result\n      @result~list(int)^@result)~list(int),\n    // Accumulator\n    @result,\n    // Init\n    false~bool,\n    // LoopCondition\n    @not_strictly_false(\n      !_(\n        @result~bool^@result\n      )~bool^logical_not\n    )~bool^not_strictly_false,\n    // LoopStep\n    _||_(\n      @result~bool^@result,\n      _==_(\n        x~int^x,\n        5~int\n      )~bool^equals\n    )~bool^logical_or,\n    // Result\n    @result~bool^@result)~bool,\n  true~bool\n)~bool^equals",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "19": { name: "x" },
+          "20": { overloadId: ["equals"] },
+          "23": { name: "@result" },
+          "24": { overloadId: ["logical_not"] },
+          "25": { overloadId: ["not_strictly_false"] },
+          "26": { name: "@result" },
+          "27": { overloadId: ["logical_or"] },
+          "28": { name: "@result" },
+          "30": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "INT64" },
+          "20": { primitive: "BOOL" },
+          "21": { primitive: "INT64" },
+          "22": { primitive: "BOOL" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "BOOL" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 29,
+            "19": 32,
+            "20": 34,
+            "21": 37,
+            "22": 28,
+            "23": 28,
+            "24": 28,
+            "25": 28,
+            "26": 28,
+            "27": 28,
+            "28": 28,
+            "29": 28,
+            "30": 40,
+            "31": 43,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "29": {
+              callExpr: {
+                target: { id: "16" },
+                function: "exists",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  {
+                    id: "20",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "19", identExpr: { name: "x" } },
+                        { id: "21", constExpr: { int64Value: "5" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "30",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "29",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                            { id: "5", constExpr: { int64Value: "4" } },
+                            { id: "6", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "12", identExpr: { name: "@result" } },
+                            {
+                              id: "13",
+                              listExpr: {
+                                elements: [
+                                  { id: "9", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "22", constExpr: { boolValue: false } },
+                  loopCondition: {
+                    id: "25",
+                    callExpr: {
+                      function: "@not_strictly_false",
+                      args: [
+                        {
+                          id: "24",
+                          callExpr: {
+                            function: "!_",
+                            args: [
+                              { id: "23", identExpr: { name: "@result" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  loopStep: {
+                    id: "27",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "26", identExpr: { name: "@result" } },
+                        {
+                          id: "20",
+                          callExpr: {
+                            function: "_==_",
+                            args: [
+                              { id: "19", identExpr: { name: "x" } },
+                              { id: "21", constExpr: { int64Value: "5" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "28", identExpr: { name: "@result" } },
+                },
+              },
+              { id: "31", constExpr: { boolValue: true } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "108", max: "118" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "118",
+      expectedRuntimeCost: "118",
+    },
+    {
+      original: {
+        name: ".map.map list literal",
+        expr: "[1,2,3,4,5].map(x, x).map(x, x)",
+      },
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      x^#*expr.Expr_IdentExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x).map(x, x)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,3]#,\n      3^#4[1,5]#,\n      4^#5[1,7]#,\n      5^#6[1,9]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#10[1,15]#,\n    // LoopCondition\n    true^#11[1,15]#,\n    // LoopStep\n    _+_(\n      @result^#12[1,15]#,\n      [\n        x^#9[1,19]#\n      ]^#13[1,15]#\n    )^#14[1,15]#,\n    // Result\n    @result^#15[1,15]#)^#16[1,15]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#20[1,25]#,\n  // LoopCondition\n  true^#21[1,25]#,\n  // LoopStep\n  _+_(\n    @result^#22[1,25]#,\n    [\n      x^#19[1,29]#\n    ]^#23[1,25]#\n  )^#24[1,25]#,\n  // Result\n  @result^#25[1,25]#)^#26[1,25]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [18, 26, 27, 1, 26, 1, 27],
+        [19, 29, 30, 1, 29, 1, 30],
+        [20, 25, 25, 1, 25, 1, 25],
+        [21, 25, 25, 1, 25, 1, 25],
+        [22, 25, 25, 1, 25, 1, 25],
+        [23, 25, 25, 1, 25, 1, 25],
+        [24, 25, 25, 1, 25, 1, 25],
+        [25, 25, 25, 1, 25, 1, 25],
+        [26, 25, 25, 1, 25, 1, 25],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "26",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "10", listExpr: {} },
+                loopCondition: { id: "11", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "13",
+                        listExpr: {
+                          elements: [{ id: "9", identExpr: { name: "x" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", listExpr: {} },
+            loopCondition: { id: "21", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "24",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "22", identExpr: { name: "@result" } },
+                  {
+                    id: "23",
+                    listExpr: {
+                      elements: [{ id: "19", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "25", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".map.map list literal",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 26,
+            "19": 29,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+        {
+          id: 26,
+          function: "map",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "x" } },
+            { id: "19", identExpr: { name: "x" } },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int,\n      5~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(int)^@result,\n    [\n      x~int^x\n    ]~list(int)\n  )~list(int)^add_list,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "19": { name: "x" },
+          "22": { name: "@result" },
+          "24": { overloadId: ["add_list"] },
+          "25": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "INT64" },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { primitive: "BOOL" },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { listType: { elemType: { primitive: "INT64" } } },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 26,
+            "19": 29,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "26",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "10", listExpr: {} },
+                loopCondition: { id: "11", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "13",
+                        listExpr: {
+                          elements: [{ id: "9", identExpr: { name: "x" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "20", listExpr: {} },
+            loopCondition: { id: "21", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "24",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "22", identExpr: { name: "@result" } },
+                  {
+                    id: "23",
+                    listExpr: {
+                      elements: [{ id: "19", identExpr: { name: "x" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "25", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "162", max: "162" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+              { int64Value: "4" },
+              { int64Value: "5" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "162",
+      expectedRuntimeCost: "162",
+    },
+    {
+      original: {
+        name: ".map.map list literal",
+        expr: "[1,2,3,4,5].map(x, [x, x]).filter(z, z.size() == 2)",
+      },
+      ast: "__comprehension__(\n  // Variable\n  z,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        [\n          x^#*expr.Expr_IdentExpr#,\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      z^#*expr.Expr_IdentExpr#.size()^#*expr.Expr_CallExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        z^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, [x, x]).filter(z, z.size() == 2)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  z,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,3]#,\n      3^#4[1,5]#,\n      4^#5[1,7]#,\n      5^#6[1,9]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#12[1,15]#,\n    // LoopCondition\n    true^#13[1,15]#,\n    // LoopStep\n    _+_(\n      @result^#14[1,15]#,\n      [\n        [\n          x^#10[1,20]#,\n          x^#11[1,23]#\n        ]^#9[1,19]#\n      ]^#15[1,15]#\n    )^#16[1,15]#,\n    // Result\n    @result^#17[1,15]#)^#18[1,15]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#25[1,33]#,\n  // LoopCondition\n  true^#26[1,33]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      z^#21[1,37]#.size()^#22[1,43]#,\n      2^#24[1,49]#\n    )^#23[1,46]#,\n    _+_(\n      @result^#27[1,33]#,\n      [\n        z^#20[1,34]#\n      ]^#28[1,33]#\n    )^#29[1,33]#,\n    @result^#30[1,33]#\n  )^#31[1,33]#,\n  // Result\n  @result^#32[1,33]#)^#33[1,33]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 20, 21, 1, 20, 1, 21],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [17, 15, 15, 1, 15, 1, 15],
+        [18, 15, 15, 1, 15, 1, 15],
+        [20, 34, 35, 1, 34, 1, 35],
+        [21, 37, 38, 1, 37, 1, 38],
+        [22, 43, 44, 1, 43, 1, 44],
+        [23, 46, 48, 1, 46, 1, 48],
+        [24, 49, 50, 1, 49, 1, 50],
+        [25, 33, 33, 1, 33, 1, 33],
+        [26, 33, 33, 1, 33, 1, 33],
+        [27, 33, 33, 1, 33, 1, 33],
+        [28, 33, 33, 1, 33, 1, 33],
+        [29, 33, 33, 1, 33, 1, 33],
+        [30, 33, 33, 1, 33, 1, 33],
+        [31, 33, 33, 1, 33, 1, 33],
+        [32, 33, 33, 1, 33, 1, 33],
+        [33, 33, 33, 1, 33, 1, 33],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "33",
+          comprehensionExpr: {
+            iterVar: "z",
+            iterRange: {
+              id: "18",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "12", listExpr: {} },
+                loopCondition: { id: "13", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "16",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "14", identExpr: { name: "@result" } },
+                      {
+                        id: "15",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", identExpr: { name: "x" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "17", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "25", listExpr: {} },
+            loopCondition: { id: "26", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "31",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "23",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "22",
+                          callExpr: {
+                            target: { id: "21", identExpr: { name: "z" } },
+                            function: "size",
+                          },
+                        },
+                        { id: "24", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "29",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "27", identExpr: { name: "@result" } },
+                        {
+                          id: "28",
+                          listExpr: {
+                            elements: [{ id: "20", identExpr: { name: "z" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "30", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "32", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: ".map.map list literal",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 20,
+            "11": 23,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "17": 15,
+            "18": 15,
+            "20": 34,
+            "21": 37,
+            "22": 43,
+            "23": 46,
+            "24": 49,
+            "25": 33,
+            "26": 33,
+            "27": 33,
+            "28": 33,
+            "29": 33,
+            "30": 33,
+            "31": 33,
+            "32": 33,
+            "33": 33,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "9",
+                    listExpr: {
+                      elements: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "18" },
+                function: "filter",
+                args: [
+                  { id: "20", identExpr: { name: "z" } },
+                  {
+                    id: "23",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "22",
+                          callExpr: {
+                            target: { id: "21", identExpr: { name: "z" } },
+                            function: "size",
+                          },
+                        },
+                        { id: "24", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "9",
+              listExpr: {
+                elements: [
+                  { id: "10", identExpr: { name: "x" } },
+                  { id: "11", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 33,
+          function: "filter",
+          target: { id: "18" },
+          args: [
+            { id: "20", identExpr: { name: "z" } },
+            {
+              id: "23",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  {
+                    id: "22",
+                    callExpr: {
+                      target: { id: "21", identExpr: { name: "z" } },
+                      function: "size",
+                    },
+                  },
+                  { id: "24", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  z,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int,\n      5~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(list(int)),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(list(int))^@result,\n      [\n        [\n          x~int^x,\n          x~int^x\n        ]~list(int)\n      ]~list(list(int))\n    )~list(list(int))^add_list,\n    // Result\n    @result~list(list(int))^@result)~list(list(int)),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      z~list(int)^z.size()~int^list_size,\n      2~int\n    )~bool^equals,\n    _+_(\n      @result~list(list(int))^@result,\n      [\n        z~list(int)^z\n      ]~list(list(int))\n    )~list(list(int))^add_list,\n    @result~list(list(int))^@result\n  )~list(list(int))^conditional,\n  // Result\n  @result~list(list(int))^@result)~list(list(int))",
+      checkedExpr: {
+        referenceMap: {
+          "10": { name: "x" },
+          "11": { name: "x" },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_list"] },
+          "17": { name: "@result" },
+          "20": { name: "z" },
+          "21": { name: "z" },
+          "22": { overloadId: ["list_size"] },
+          "23": { overloadId: ["equals"] },
+          "27": { name: "@result" },
+          "29": { overloadId: ["add_list"] },
+          "30": { name: "@result" },
+          "31": { overloadId: ["conditional"] },
+          "32": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "13": { primitive: "BOOL" },
+          "14": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "15": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "16": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "17": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "18": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { primitive: "INT64" },
+          "23": { primitive: "BOOL" },
+          "24": { primitive: "INT64" },
+          "25": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "26": { primitive: "BOOL" },
+          "27": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "28": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "29": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "30": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "31": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "32": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "33": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 20,
+            "11": 23,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "17": 15,
+            "18": 15,
+            "20": 34,
+            "21": 37,
+            "22": 43,
+            "23": 46,
+            "24": 49,
+            "25": 33,
+            "26": 33,
+            "27": 33,
+            "28": 33,
+            "29": 33,
+            "30": 33,
+            "31": 33,
+            "32": 33,
+            "33": 33,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "9",
+                    listExpr: {
+                      elements: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "18" },
+                function: "filter",
+                args: [
+                  { id: "20", identExpr: { name: "z" } },
+                  {
+                    id: "23",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "22",
+                          callExpr: {
+                            target: { id: "21", identExpr: { name: "z" } },
+                            function: "size",
+                          },
+                        },
+                        { id: "24", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "33",
+          comprehensionExpr: {
+            iterVar: "z",
+            iterRange: {
+              id: "18",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "12", listExpr: {} },
+                loopCondition: { id: "13", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "16",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "14", identExpr: { name: "@result" } },
+                      {
+                        id: "15",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", identExpr: { name: "x" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "17", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "25", listExpr: {} },
+            loopCondition: { id: "26", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "31",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "23",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "22",
+                          callExpr: {
+                            target: { id: "21", identExpr: { name: "z" } },
+                            function: "size",
+                          },
+                        },
+                        { id: "24", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "29",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "27", identExpr: { name: "@result" } },
+                        {
+                          id: "28",
+                          listExpr: {
+                            elements: [{ id: "20", identExpr: { name: "z" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "30", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "32", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(list(int))",
+      cost: { min: "172", max: "232" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              {
+                listValue: {
+                  values: [{ int64Value: "1" }, { int64Value: "1" }],
+                },
+              },
+              {
+                listValue: {
+                  values: [{ int64Value: "2" }, { int64Value: "2" }],
+                },
+              },
+              {
+                listValue: {
+                  values: [{ int64Value: "3" }, { int64Value: "3" }],
+                },
+              },
+              {
+                listValue: {
+                  values: [{ int64Value: "4" }, { int64Value: "4" }],
+                },
+              },
+              {
+                listValue: {
+                  values: [{ int64Value: "5" }, { int64Value: "5" }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      runtimeCost: "232",
+      expectedRuntimeCost: "232",
+    },
+    {
+      original: {
+        name: "comprehension on nested list",
+        expr: "[1,2,3,4,5].map(x, [x, x]).all(y, y.all(y, y == 1))",
+      },
+      ast: "__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#,\n      4^#*expr.Constant_Int64Value#,\n      5^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        [\n          x^#*expr.Expr_IdentExpr#,\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#*expr.Expr_IdentExpr#,\n      // Accumulator\n      @result,\n      // Init\n      true^#*expr.Constant_BoolValue#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#*expr.Expr_IdentExpr#,\n        _==_(\n          y^#*expr.Expr_IdentExpr#,\n          1^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, [x, x]).all(y, y.all(y, y == 1))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,3]#,\n      3^#4[1,5]#,\n      4^#5[1,7]#,\n      5^#6[1,9]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#12[1,15]#,\n    // LoopCondition\n    true^#13[1,15]#,\n    // LoopStep\n    _+_(\n      @result^#14[1,15]#,\n      [\n        [\n          x^#10[1,20]#,\n          x^#11[1,23]#\n        ]^#9[1,19]#\n      ]^#15[1,15]#\n    )^#16[1,15]#,\n    // Result\n    @result^#17[1,15]#)^#18[1,15]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#34[1,30]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#35[1,30]#\n  )^#36[1,30]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#37[1,30]#,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y^#21[1,34]#,\n      // Accumulator\n      @result,\n      // Init\n      true^#27[1,39]#,\n      // LoopCondition\n      @not_strictly_false(\n        @result^#28[1,39]#\n      )^#29[1,39]#,\n      // LoopStep\n      _\u0026\u0026_(\n        @result^#30[1,39]#,\n        _==_(\n          y^#24[1,43]#,\n          1^#26[1,48]#\n        )^#25[1,45]#\n      )^#31[1,39]#,\n      // Result\n      @result^#32[1,39]#)^#33[1,39]#\n  )^#38[1,30]#,\n  // Result\n  @result^#39[1,30]#)^#40[1,30]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 20, 21, 1, 20, 1, 21],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [17, 15, 15, 1, 15, 1, 15],
+        [18, 15, 15, 1, 15, 1, 15],
+        [20, 31, 32, 1, 31, 1, 32],
+        [21, 34, 35, 1, 34, 1, 35],
+        [23, 40, 41, 1, 40, 1, 41],
+        [24, 43, 44, 1, 43, 1, 44],
+        [25, 45, 47, 1, 45, 1, 47],
+        [26, 48, 49, 1, 48, 1, 49],
+        [27, 39, 39, 1, 39, 1, 39],
+        [28, 39, 39, 1, 39, 1, 39],
+        [29, 39, 39, 1, 39, 1, 39],
+        [30, 39, 39, 1, 39, 1, 39],
+        [31, 39, 39, 1, 39, 1, 39],
+        [32, 39, 39, 1, 39, 1, 39],
+        [33, 39, 39, 1, 39, 1, 39],
+        [34, 30, 30, 1, 30, 1, 30],
+        [35, 30, 30, 1, 30, 1, 30],
+        [36, 30, 30, 1, 30, 1, 30],
+        [37, 30, 30, 1, 30, 1, 30],
+        [38, 30, 30, 1, 30, 1, 30],
+        [39, 30, 30, 1, 30, 1, 30],
+        [40, 30, 30, 1, 30, 1, 30],
+      ],
+      lineOffsets: [52],
+      parsedExpr: {
+        expr: {
+          id: "40",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "18",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "12", listExpr: {} },
+                loopCondition: { id: "13", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "16",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "14", identExpr: { name: "@result" } },
+                      {
+                        id: "15",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", identExpr: { name: "x" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "17", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "34", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "36",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "35", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "38",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "37", identExpr: { name: "@result" } },
+                  {
+                    id: "33",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "21", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "27", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "29",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "28", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "31",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "30", identExpr: { name: "@result" } },
+                            {
+                              id: "25",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "24", identExpr: { name: "y" } },
+                                  { id: "26", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "32", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "39", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comprehension on nested list",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 20,
+            "11": 23,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "17": 15,
+            "18": 15,
+            "20": 31,
+            "21": 34,
+            "23": 40,
+            "24": 43,
+            "25": 45,
+            "26": 48,
+            "27": 39,
+            "28": 39,
+            "29": 39,
+            "30": 39,
+            "31": 39,
+            "32": 39,
+            "33": 39,
+            "34": 30,
+            "35": 30,
+            "36": 30,
+            "37": 30,
+            "38": 30,
+            "39": 30,
+            "40": 30,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "9",
+                    listExpr: {
+                      elements: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "21", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "23", identExpr: { name: "y" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "24", identExpr: { name: "y" } },
+                        { id: "26", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "40": {
+              callExpr: {
+                target: { id: "18" },
+                function: "all",
+                args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 18,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "9",
+              listExpr: {
+                elements: [
+                  { id: "10", identExpr: { name: "x" } },
+                  { id: "11", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 33,
+          function: "all",
+          target: { id: "21", identExpr: { name: "y" } },
+          args: [
+            { id: "23", identExpr: { name: "y" } },
+            {
+              id: "25",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "24", identExpr: { name: "y" } },
+                  { id: "26", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 40,
+          function: "all",
+          target: { id: "18" },
+          args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  y,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int,\n      4~int,\n      5~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(list(int)),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(list(int))^@result,\n      [\n        [\n          x~int^x,\n          x~int^x\n        ]~list(int)\n      ]~list(list(int))\n    )~list(list(int))^add_list,\n    // Result\n    @result~list(list(int))^@result)~list(list(int)),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    __comprehension__(\n      // Variable\n      y,\n      // Target\n      y~list(int)^y,\n      // Accumulator\n      @result,\n      // Init\n      true~bool,\n      // LoopCondition\n      @not_strictly_false(\n        @result~bool^@result\n      )~bool^not_strictly_false,\n      // LoopStep\n      _\u0026\u0026_(\n        @result~bool^@result,\n        _==_(\n          y~int^y,\n          1~int\n        )~bool^equals\n      )~bool^logical_and,\n      // Result\n      @result~bool^@result)~bool\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "10": { name: "x" },
+          "11": { name: "x" },
+          "14": { name: "@result" },
+          "16": { overloadId: ["add_list"] },
+          "17": { name: "@result" },
+          "21": { name: "y" },
+          "24": { name: "y" },
+          "25": { overloadId: ["equals"] },
+          "28": { name: "@result" },
+          "29": { overloadId: ["not_strictly_false"] },
+          "30": { name: "@result" },
+          "31": { overloadId: ["logical_and"] },
+          "32": { name: "@result" },
+          "35": { name: "@result" },
+          "36": { overloadId: ["not_strictly_false"] },
+          "37": { name: "@result" },
+          "38": { overloadId: ["logical_and"] },
+          "39": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "13": { primitive: "BOOL" },
+          "14": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "15": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "16": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "17": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "18": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+          "32": { primitive: "BOOL" },
+          "33": { primitive: "BOOL" },
+          "34": { primitive: "BOOL" },
+          "35": { primitive: "BOOL" },
+          "36": { primitive: "BOOL" },
+          "37": { primitive: "BOOL" },
+          "38": { primitive: "BOOL" },
+          "39": { primitive: "BOOL" },
+          "40": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [52],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 20,
+            "11": 23,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "17": 15,
+            "18": 15,
+            "20": 31,
+            "21": 34,
+            "23": 40,
+            "24": 43,
+            "25": 45,
+            "26": 48,
+            "27": 39,
+            "28": 39,
+            "29": 39,
+            "30": 39,
+            "31": 39,
+            "32": 39,
+            "33": 39,
+            "34": 30,
+            "35": 30,
+            "36": 30,
+            "37": 30,
+            "38": 30,
+            "39": 30,
+            "40": 30,
+          },
+          macroCalls: {
+            "18": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "9",
+                    listExpr: {
+                      elements: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "11", identExpr: { name: "x" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "33": {
+              callExpr: {
+                target: { id: "21", identExpr: { name: "y" } },
+                function: "all",
+                args: [
+                  { id: "23", identExpr: { name: "y" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        { id: "24", identExpr: { name: "y" } },
+                        { id: "26", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "40": {
+              callExpr: {
+                target: { id: "18" },
+                function: "all",
+                args: [{ id: "20", identExpr: { name: "y" } }, { id: "33" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "40",
+          comprehensionExpr: {
+            iterVar: "y",
+            iterRange: {
+              id: "18",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "12", listExpr: {} },
+                loopCondition: { id: "13", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "16",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "14", identExpr: { name: "@result" } },
+                      {
+                        id: "15",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", identExpr: { name: "x" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "17", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "34", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "36",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "35", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "38",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "37", identExpr: { name: "@result" } },
+                  {
+                    id: "33",
+                    comprehensionExpr: {
+                      iterVar: "y",
+                      iterRange: { id: "21", identExpr: { name: "y" } },
+                      accuVar: "@result",
+                      accuInit: { id: "27", constExpr: { boolValue: true } },
+                      loopCondition: {
+                        id: "29",
+                        callExpr: {
+                          function: "@not_strictly_false",
+                          args: [{ id: "28", identExpr: { name: "@result" } }],
+                        },
+                      },
+                      loopStep: {
+                        id: "31",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            { id: "30", identExpr: { name: "@result" } },
+                            {
+                              id: "25",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "24", identExpr: { name: "y" } },
+                                  { id: "26", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "32", identExpr: { name: "@result" } },
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "39", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "157", max: "217" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "171",
+      expectedRuntimeCost: "171",
+    },
+    {
+      original: {
+        name: "comprehension size",
+        expr: "[1,2,3,4,5].map(x, x).map(x, x) + [1]",
+      },
+      ast: "_+_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1^#*expr.Constant_Int64Value#,\n        2^#*expr.Constant_Int64Value#,\n        3^#*expr.Constant_Int64Value#,\n        4^#*expr.Constant_Int64Value#,\n        5^#*expr.Constant_Int64Value#\n      ]^#*expr.Expr_ListExpr#,\n      // Accumulator\n      @result,\n      // Init\n      []^#*expr.Expr_ListExpr#,\n      // LoopCondition\n      true^#*expr.Constant_BoolValue#,\n      // LoopStep\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          x^#*expr.Expr_IdentExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      // Result\n      @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "[1, 2, 3, 4, 5].map(x, x).map(x, x) + [1]",
+      locationAst:
+        "_+_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1^#2[1,1]#,\n        2^#3[1,3]#,\n        3^#4[1,5]#,\n        4^#5[1,7]#,\n        5^#6[1,9]#\n      ]^#1[1,0]#,\n      // Accumulator\n      @result,\n      // Init\n      []^#10[1,15]#,\n      // LoopCondition\n      true^#11[1,15]#,\n      // LoopStep\n      _+_(\n        @result^#12[1,15]#,\n        [\n          x^#9[1,19]#\n        ]^#13[1,15]#\n      )^#14[1,15]#,\n      // Result\n      @result^#15[1,15]#)^#16[1,15]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#20[1,25]#,\n    // LoopCondition\n    true^#21[1,25]#,\n    // LoopStep\n    _+_(\n      @result^#22[1,25]#,\n      [\n        x^#19[1,29]#\n      ]^#23[1,25]#\n    )^#24[1,25]#,\n    // Result\n    @result^#25[1,25]#)^#26[1,25]#,\n  [\n    1^#29[1,35]#\n  ]^#28[1,34]#\n)^#27[1,32]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 15, 15, 1, 15, 1, 15],
+        [11, 15, 15, 1, 15, 1, 15],
+        [12, 15, 15, 1, 15, 1, 15],
+        [13, 15, 15, 1, 15, 1, 15],
+        [14, 15, 15, 1, 15, 1, 15],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [18, 26, 27, 1, 26, 1, 27],
+        [19, 29, 30, 1, 29, 1, 30],
+        [20, 25, 25, 1, 25, 1, 25],
+        [21, 25, 25, 1, 25, 1, 25],
+        [22, 25, 25, 1, 25, 1, 25],
+        [23, 25, 25, 1, 25, 1, 25],
+        [24, 25, 25, 1, 25, 1, 25],
+        [25, 25, 25, 1, 25, 1, 25],
+        [26, 25, 25, 1, 25, 1, 25],
+        [27, 32, 33, 1, 32, 1, 33],
+        [28, 34, 35, 1, 34, 1, 35],
+        [29, 35, 36, 1, 35, 1, 36],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "27",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "26",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                            { id: "5", constExpr: { int64Value: "4" } },
+                            { id: "6", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "12", identExpr: { name: "@result" } },
+                            {
+                              id: "13",
+                              listExpr: {
+                                elements: [
+                                  { id: "9", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "20", listExpr: {} },
+                  loopCondition: { id: "21", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "24",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "22", identExpr: { name: "@result" } },
+                        {
+                          id: "23",
+                          listExpr: {
+                            elements: [{ id: "19", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "25", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "28",
+                listExpr: {
+                  elements: [{ id: "29", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "comprehension size",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 26,
+            "19": 29,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+            "27": 32,
+            "28": 34,
+            "29": 35,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+                { id: "5", constExpr: { int64Value: "4" } },
+                { id: "6", constExpr: { int64Value: "5" } },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            { id: "9", identExpr: { name: "x" } },
+          ],
+        },
+        {
+          id: 26,
+          function: "map",
+          target: { id: "16" },
+          args: [
+            { id: "18", identExpr: { name: "x" } },
+            { id: "19", identExpr: { name: "x" } },
+          ],
+        },
+      ],
+      checkedAst:
+        "_+_(\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    __comprehension__(\n      // Variable\n      x,\n      // Target\n      [\n        1~int,\n        2~int,\n        3~int,\n        4~int,\n        5~int\n      ]~list(int),\n      // Accumulator\n      @result,\n      // Init\n      []~list(int),\n      // LoopCondition\n      true~bool,\n      // LoopStep\n      _+_(\n        @result~list(int)^@result,\n        [\n          x~int^x\n        ]~list(int)\n      )~list(int)^add_list,\n      // Result\n      @result~list(int)^@result)~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    // Result\n    @result~list(int)^@result)~list(int),\n  [\n    1~int\n  ]~list(int)\n)~list(int)^add_list",
+      checkedExpr: {
+        referenceMap: {
+          "9": { name: "x" },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_list"] },
+          "15": { name: "@result" },
+          "19": { name: "x" },
+          "22": { name: "@result" },
+          "24": { overloadId: ["add_list"] },
+          "25": { name: "@result" },
+          "27": { overloadId: ["add_list"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "BOOL" },
+          "12": { listType: { elemType: { primitive: "INT64" } } },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { listType: { elemType: { primitive: "INT64" } } },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "INT64" },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { primitive: "BOOL" },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { listType: { elemType: { primitive: "INT64" } } },
+          "26": { listType: { elemType: { primitive: "INT64" } } },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+          "28": { listType: { elemType: { primitive: "INT64" } } },
+          "29": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "5": 7,
+            "6": 9,
+            "8": 16,
+            "9": 19,
+            "10": 15,
+            "11": 15,
+            "12": 15,
+            "13": 15,
+            "14": 15,
+            "15": 15,
+            "16": 15,
+            "18": 26,
+            "19": 29,
+            "20": 25,
+            "21": 25,
+            "22": 25,
+            "23": 25,
+            "24": 25,
+            "25": 25,
+            "26": 25,
+            "27": 32,
+            "28": 34,
+            "29": 35,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                      { id: "5", constExpr: { int64Value: "4" } },
+                      { id: "6", constExpr: { int64Value: "5" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  { id: "9", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            "26": {
+              callExpr: {
+                target: { id: "16" },
+                function: "map",
+                args: [
+                  { id: "18", identExpr: { name: "x" } },
+                  { id: "19", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "27",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "26",
+                comprehensionExpr: {
+                  iterVar: "x",
+                  iterRange: {
+                    id: "16",
+                    comprehensionExpr: {
+                      iterVar: "x",
+                      iterRange: {
+                        id: "1",
+                        listExpr: {
+                          elements: [
+                            { id: "2", constExpr: { int64Value: "1" } },
+                            { id: "3", constExpr: { int64Value: "2" } },
+                            { id: "4", constExpr: { int64Value: "3" } },
+                            { id: "5", constExpr: { int64Value: "4" } },
+                            { id: "6", constExpr: { int64Value: "5" } },
+                          ],
+                        },
+                      },
+                      accuVar: "@result",
+                      accuInit: { id: "10", listExpr: {} },
+                      loopCondition: {
+                        id: "11",
+                        constExpr: { boolValue: true },
+                      },
+                      loopStep: {
+                        id: "14",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "12", identExpr: { name: "@result" } },
+                            {
+                              id: "13",
+                              listExpr: {
+                                elements: [
+                                  { id: "9", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      result: { id: "15", identExpr: { name: "@result" } },
+                    },
+                  },
+                  accuVar: "@result",
+                  accuInit: { id: "20", listExpr: {} },
+                  loopCondition: { id: "21", constExpr: { boolValue: true } },
+                  loopStep: {
+                    id: "24",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "22", identExpr: { name: "@result" } },
+                        {
+                          id: "23",
+                          listExpr: {
+                            elements: [{ id: "19", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  result: { id: "25", identExpr: { name: "@result" } },
+                },
+              },
+              {
+                id: "28",
+                listExpr: {
+                  elements: [{ id: "29", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "173", max: "173" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+              { int64Value: "4" },
+              { int64Value: "5" },
+              { int64Value: "1" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "173",
+      expectedRuntimeCost: "173",
+    },
+    {
+      original: {
+        name: "nested comprehension",
+        expr: "[1,2,3].all(i, i in [1,2,3].map(j, j + j))",
+      },
+      ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    @in(\n      i^#*expr.Expr_IdentExpr#,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1^#*expr.Constant_Int64Value#,\n          2^#*expr.Constant_Int64Value#,\n          3^#*expr.Constant_Int64Value#\n        ]^#*expr.Expr_ListExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _+_(\n          @result^#*expr.Expr_IdentExpr#,\n          [\n            _+_(\n              j^#*expr.Expr_IdentExpr#,\n              j^#*expr.Expr_IdentExpr#\n            )^#*expr.Expr_CallExpr#\n          ]^#*expr.Expr_ListExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].all(i, i in [1, 2, 3].map(j, j + j))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,3]#,\n    3^#4[1,5]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#25[1,11]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#26[1,11]#\n  )^#27[1,11]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#28[1,11]#,\n    @in(\n      i^#7[1,15]#,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1^#10[1,21]#,\n          2^#11[1,23]#,\n          3^#12[1,25]#\n        ]^#9[1,20]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#18[1,31]#,\n        // LoopCondition\n        true^#19[1,31]#,\n        // LoopStep\n        _+_(\n          @result^#20[1,31]#,\n          [\n            _+_(\n              j^#15[1,35]#,\n              j^#17[1,39]#\n            )^#16[1,37]#\n          ]^#21[1,31]#\n        )^#22[1,31]#,\n        // Result\n        @result^#23[1,31]#)^#24[1,31]#\n    )^#8[1,17]#\n  )^#29[1,11]#,\n  // Result\n  @result^#30[1,11]#)^#31[1,11]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 5, 6, 1, 5, 1, 6],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 21, 1, 20, 1, 21],
+        [10, 21, 22, 1, 21, 1, 22],
+        [11, 23, 24, 1, 23, 1, 24],
+        [12, 25, 26, 1, 25, 1, 26],
+        [14, 32, 33, 1, 32, 1, 33],
+        [15, 35, 36, 1, 35, 1, 36],
+        [16, 37, 38, 1, 37, 1, 38],
+        [17, 39, 40, 1, 39, 1, 40],
+        [18, 31, 31, 1, 31, 1, 31],
+        [19, 31, 31, 1, 31, 1, 31],
+        [20, 31, 31, 1, 31, 1, 31],
+        [21, 31, 31, 1, 31, 1, 31],
+        [22, 31, 31, 1, 31, 1, 31],
+        [23, 31, 31, 1, 31, 1, 31],
+        [24, 31, 31, 1, 31, 1, 31],
+        [25, 11, 11, 1, 11, 1, 11],
+        [26, 11, 11, 1, 11, 1, 11],
+        [27, 11, 11, 1, 11, 1, 11],
+        [28, 11, 11, 1, 11, 1, 11],
+        [29, 11, 11, 1, 11, 1, 11],
+        [30, 11, 11, 1, 11, 1, 11],
+        [31, 11, 11, 1, 11, 1, 11],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "31",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "25", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "27",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "26", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "29",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "28", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        {
+                          id: "24",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", constExpr: { int64Value: "1" } },
+                                  { id: "11", constExpr: { int64Value: "2" } },
+                                  { id: "12", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "18", listExpr: {} },
+                            loopCondition: {
+                              id: "19",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "22",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "20", identExpr: { name: "@result" } },
+                                  {
+                                    id: "21",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "16",
+                                          callExpr: {
+                                            function: "_+_",
+                                            args: [
+                                              {
+                                                id: "15",
+                                                identExpr: { name: "j" },
+                                              },
+                                              {
+                                                id: "17",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "23",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "30", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "nested comprehension",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+            "14": 32,
+            "15": 35,
+            "16": 37,
+            "17": 39,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 11,
+            "26": 11,
+            "27": 11,
+            "28": 11,
+            "29": 11,
+            "30": 11,
+            "31": 11,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: {
+                  id: "9",
+                  listExpr: {
+                    elements: [
+                      { id: "10", constExpr: { int64Value: "1" } },
+                      { id: "11", constExpr: { int64Value: "2" } },
+                      { id: "12", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "14", identExpr: { name: "j" } },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "15", identExpr: { name: "j" } },
+                        { id: "17", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "i" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        { id: "24" },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 24,
+          function: "map",
+          target: {
+            id: "9",
+            listExpr: {
+              elements: [
+                { id: "10", constExpr: { int64Value: "1" } },
+                { id: "11", constExpr: { int64Value: "2" } },
+                { id: "12", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "14", identExpr: { name: "j" } },
+            {
+              id: "16",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "15", identExpr: { name: "j" } },
+                  { id: "17", identExpr: { name: "j" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 31,
+          function: "all",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "i" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "@in",
+                args: [{ id: "7", identExpr: { name: "i" } }, { id: "24" }],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    @in(\n      i~int^i,\n      __comprehension__(\n        // Variable\n        j,\n        // Target\n        [\n          1~int,\n          2~int,\n          3~int\n        ]~list(int),\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _+_(\n          @result~list(int)^@result,\n          [\n            _+_(\n              j~int^j,\n              j~int^j\n            )~int^add_int64\n          ]~list(int)\n        )~list(int)^add_list,\n        // Result\n        @result~list(int)^@result)~list(int)\n    )~bool^in_list\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "i" },
+          "8": { overloadId: ["in_list"] },
+          "15": { name: "j" },
+          "16": { overloadId: ["add_int64"] },
+          "17": { name: "j" },
+          "20": { name: "@result" },
+          "22": { overloadId: ["add_list"] },
+          "23": { name: "@result" },
+          "26": { name: "@result" },
+          "27": { overloadId: ["not_strictly_false"] },
+          "28": { name: "@result" },
+          "29": { overloadId: ["logical_and"] },
+          "30": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { listType: { elemType: { primitive: "INT64" } } },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { primitive: "BOOL" },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "BOOL" },
+          "27": { primitive: "BOOL" },
+          "28": { primitive: "BOOL" },
+          "29": { primitive: "BOOL" },
+          "30": { primitive: "BOOL" },
+          "31": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 5,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+            "10": 21,
+            "11": 23,
+            "12": 25,
+            "14": 32,
+            "15": 35,
+            "16": 37,
+            "17": 39,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 11,
+            "26": 11,
+            "27": 11,
+            "28": 11,
+            "29": 11,
+            "30": 11,
+            "31": 11,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: {
+                  id: "9",
+                  listExpr: {
+                    elements: [
+                      { id: "10", constExpr: { int64Value: "1" } },
+                      { id: "11", constExpr: { int64Value: "2" } },
+                      { id: "12", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "14", identExpr: { name: "j" } },
+                  {
+                    id: "16",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "15", identExpr: { name: "j" } },
+                        { id: "17", identExpr: { name: "j" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "i" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        { id: "24" },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "31",
+          comprehensionExpr: {
+            iterVar: "i",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "25", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "27",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "26", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "29",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "28", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "@in",
+                      args: [
+                        { id: "7", identExpr: { name: "i" } },
+                        {
+                          id: "24",
+                          comprehensionExpr: {
+                            iterVar: "j",
+                            iterRange: {
+                              id: "9",
+                              listExpr: {
+                                elements: [
+                                  { id: "10", constExpr: { int64Value: "1" } },
+                                  { id: "11", constExpr: { int64Value: "2" } },
+                                  { id: "12", constExpr: { int64Value: "3" } },
+                                ],
+                              },
+                            },
+                            accuVar: "@result",
+                            accuInit: { id: "18", listExpr: {} },
+                            loopCondition: {
+                              id: "19",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "22",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "20", identExpr: { name: "@result" } },
+                                  {
+                                    id: "21",
+                                    listExpr: {
+                                      elements: [
+                                        {
+                                          id: "16",
+                                          callExpr: {
+                                            function: "_+_",
+                                            args: [
+                                              {
+                                                id: "15",
+                                                identExpr: { name: "j" },
+                                              },
+                                              {
+                                                id: "17",
+                                                identExpr: { name: "j" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "23",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "30", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "20", max: "230" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "86",
+      expectedRuntimeCost: "86",
+    },
+  ],
+} as const;
@@ -0,0 +1,5472 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/sets_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "sets",
+  tests: [
+    {
+      original: {
+        expr: "sets.contains(x, [1, 2, 3])",
+        typeEnv: [
+          {
+            name: "x",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        bindings: {
+          x: {
+            value: {
+              listValue: {
+                values: [
+                  { int64Value: "5" },
+                  { int64Value: "4" },
+                  { int64Value: "3" },
+                  { int64Value: "2" },
+                  { int64Value: "1" },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  x^#*expr.Expr_IdentExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains(x, [1, 2, 3])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  x^#3[1,14]#,\n  [\n    1^#5[1,18]#,\n    2^#6[1,21]#,\n    3^#7[1,24]#\n  ]^#4[1,17]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              { id: "3", identExpr: { name: "x" } },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 24,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  x~list(int)^x,\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["list_sets_contains_list"] },
+          "3": { name: "x" },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 24,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              { id: "3", identExpr: { name: "x" } },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { int64Value: "2" } },
+                    { id: "7", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "27",
+    },
+    {
+      original: {
+        expr: "sets.contains(x, [1, 1, 1, 1, 1])",
+        typeEnv: [
+          {
+            name: "x",
+            ident: { type: { listType: { elemType: { primitive: "INT64" } } } },
+          },
+        ],
+        bindings: {
+          x: {
+            value: {
+              listValue: {
+                values: [
+                  { int64Value: "5" },
+                  { int64Value: "4" },
+                  { int64Value: "3" },
+                  { int64Value: "2" },
+                  { int64Value: "1" },
+                ],
+              },
+            },
+          },
+        },
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  x^#*expr.Expr_IdentExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains(x, [1, 1, 1, 1, 1])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  x^#3[1,14]#,\n  [\n    1^#5[1,18]#,\n    1^#6[1,21]#,\n    1^#7[1,24]#,\n    1^#8[1,27]#,\n    1^#9[1,30]#\n  ]^#4[1,17]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 27, 28, 1, 27, 1, 28],
+        [9, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              { id: "3", identExpr: { name: "x" } },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "1" } },
+                    { id: "9", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 30,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  x~list(int)^x,\n  [\n    1~int,\n    1~int,\n    1~int,\n    1~int,\n    1~int\n  ]~list(int)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["list_sets_contains_list"] },
+          "3": { name: "x" },
+        },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 24,
+            "8": 27,
+            "9": 30,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              { id: "3", identExpr: { name: "x" } },
+              {
+                id: "4",
+                listExpr: {
+                  elements: [
+                    { id: "5", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "1" } },
+                    { id: "9", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "18446744073709551615" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "37",
+    },
+    {
+      original: { expr: "sets.contains([], [])", value: { boolValue: true } },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  []^#*expr.Expr_ListExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([], [])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  []^#3[1,14]#,\n  []^#4[1,18]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              { id: "3", listExpr: {} },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18 },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  []~list(dyn),\n  []~list(dyn)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              { id: "3", listExpr: {} },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: { expr: "sets.contains([1], [])", value: { boolValue: true } },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1], [])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#\n  ]^#3[1,14]#,\n  []^#5[1,19]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "5", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19 },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int\n  ]~list(int),\n  []~list(int)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              { id: "5", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: { expr: "sets.contains([1], [1])", value: { boolValue: true } },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1], [1])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#\n  ]^#3[1,14]#,\n  [\n    1^#6[1,20]#\n  ]^#5[1,19]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19, "6": 20 },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~int\n  ]~list(int)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19, "6": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "sets.contains([1], [1, 1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1], [1, 1])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#\n  ]^#3[1,14]#,\n  [\n    1^#6[1,20]#,\n    1^#7[1,23]#\n  ]^#5[1,19]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 19,
+            "6": 20,
+            "7": 23,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~int,\n    1~int\n  ]~list(int)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 19,
+            "6": 20,
+            "7": 23,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.contains([1, 1], [1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1, 1], [1])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#,\n    1^#5[1,18]#\n  ]^#3[1,14]#,\n  [\n    1^#7[1,23]#\n  ]^#6[1,22]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int,\n    1~int\n  ]~list(int),\n  [\n    1~int\n  ]~list(int)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.contains([2, 1], [1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([2, 1], [1])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    2^#4[1,15]#,\n    1^#5[1,18]#\n  ]^#3[1,14]#,\n  [\n    1^#7[1,23]#\n  ]^#6[1,22]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    2~int,\n    1~int\n  ]~list(int),\n  [\n    1~int\n  ]~list(int)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.contains([1, 2, 3, 4], [2, 3])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1, 2, 3, 4], [2, 3])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#,\n    2^#5[1,18]#,\n    3^#6[1,21]#,\n    4^#7[1,24]#\n  ]^#3[1,14]#,\n  [\n    2^#9[1,29]#,\n    3^#10[1,32]#\n  ]^#8[1,28]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 28, 29, 1, 28, 1, 29],
+        [9, 29, 30, 1, 29, 1, 30],
+        [10, 32, 33, 1, 32, 1, 33],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                    { id: "7", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 21,
+            "7": 24,
+            "8": 28,
+            "9": 29,
+            "10": 32,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int,\n    2~int,\n    3~int,\n    4~int\n  ]~list(int),\n  [\n    2~int,\n    3~int\n  ]~list(int)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { listType: { elemType: { primitive: "INT64" } } },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 21,
+            "7": 24,
+            "8": 28,
+            "9": 29,
+            "10": 32,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                    { id: "7", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                listExpr: {
+                  elements: [
+                    { id: "9", constExpr: { int64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "29", max: "29" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "29",
+    },
+    {
+      original: {
+        expr: "sets.contains([1], [1.0, 1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_DoubleValue#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1], [1.0, 1])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#\n  ]^#3[1,14]#,\n  [\n    1^#6[1,20]#,\n    1^#7[1,25]#\n  ]^#5[1,19]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 20, 23, 1, 20, 1, 23],
+        [7, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { doubleValue: 1 } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 19,
+            "6": 20,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~double,\n    1~int\n  ]~list(dyn)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 19,
+            "6": 20,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { doubleValue: 1 } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.contains([1, 2], [2u, 2.0])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    2u^#*expr.Constant_Uint64Value#,\n    2^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1, 2], [2u, 2.0])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#,\n    2^#5[1,18]#\n  ]^#3[1,14]#,\n  [\n    2u^#7[1,23]#,\n    2^#8[1,27]#\n  ]^#6[1,22]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 25, 1, 23, 1, 25],
+        [8, 27, 30, 1, 27, 1, 30],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { uint64Value: "2" } },
+                    { id: "8", constExpr: { doubleValue: 2 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 27,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  [\n    2u~uint,\n    2~double\n  ]~list(dyn)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { dyn: {} } } },
+          "7": { primitive: "UINT64" },
+          "8": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 27,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { uint64Value: "2" } },
+                    { id: "8", constExpr: { doubleValue: 2 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "25", max: "25" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "25",
+    },
+    {
+      original: {
+        expr: "sets.contains([1, 2u], [2, 2.0])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2u^#*expr.Constant_Uint64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    2^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1, 2u], [2, 2.0])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#,\n    2u^#5[1,18]#\n  ]^#3[1,14]#,\n  [\n    2^#7[1,24]#,\n    2^#8[1,27]#\n  ]^#6[1,23]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 23, 24, 1, 23, 1, 24],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 27, 30, 1, 27, 1, 30],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { uint64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { int64Value: "2" } },
+                    { id: "8", constExpr: { doubleValue: 2 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 23,
+            "7": 24,
+            "8": 27,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int,\n    2u~uint\n  ]~list(dyn),\n  [\n    2~int,\n    2~double\n  ]~list(dyn)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "UINT64" },
+          "6": { listType: { elemType: { dyn: {} } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 23,
+            "7": 24,
+            "8": 27,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { uint64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { int64Value: "2" } },
+                    { id: "8", constExpr: { doubleValue: 2 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "25", max: "25" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "25",
+    },
+    {
+      original: {
+        expr: "sets.contains([1, 2.0, 3u], [1.0, 2u, 3])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_DoubleValue#,\n    3u^#*expr.Constant_Uint64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_DoubleValue#,\n    2u^#*expr.Constant_Uint64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([1, 2.0, 3u], [1.0, 2u, 3])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    1^#4[1,15]#,\n    2^#5[1,18]#,\n    3u^#6[1,23]#\n  ]^#3[1,14]#,\n  [\n    1^#8[1,29]#,\n    2u^#9[1,34]#,\n    3^#10[1,38]#\n  ]^#7[1,28]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 21, 1, 18, 1, 21],
+        [6, 23, 25, 1, 23, 1, 25],
+        [7, 28, 29, 1, 28, 1, 29],
+        [8, 29, 32, 1, 29, 1, 32],
+        [9, 34, 36, 1, 34, 1, 36],
+        [10, 38, 39, 1, 38, 1, 39],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { doubleValue: 2 } },
+                    { id: "6", constExpr: { uint64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { doubleValue: 1 } },
+                    { id: "9", constExpr: { uint64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 23,
+            "7": 28,
+            "8": 29,
+            "9": 34,
+            "10": 38,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    1~int,\n    2~double,\n    3u~uint\n  ]~list(dyn),\n  [\n    1~double,\n    2u~uint,\n    3~int\n  ]~list(dyn)\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "DOUBLE" },
+          "6": { primitive: "UINT64" },
+          "7": { listType: { elemType: { dyn: {} } } },
+          "8": { primitive: "DOUBLE" },
+          "9": { primitive: "UINT64" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 18,
+            "6": 23,
+            "7": 28,
+            "8": 29,
+            "9": 34,
+            "10": 38,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { doubleValue: 2 } },
+                    { id: "6", constExpr: { uint64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { doubleValue: 1 } },
+                    { id: "9", constExpr: { uint64Value: "2" } },
+                    { id: "10", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "30", max: "30" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "30",
+    },
+    {
+      original: {
+        expr: "sets.contains([[1], [2, 3]], [[2, 3.0]])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.contains(\n  [\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    [\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.contains([[1], [2, 3]], [[2, 3.0]])",
+      locationAst:
+        "sets^#1[1,0]#.contains(\n  [\n    [\n      1^#5[1,16]#\n    ]^#4[1,15]#,\n    [\n      2^#7[1,21]#,\n      3^#8[1,24]#\n    ]^#6[1,20]#\n  ]^#3[1,14]#,\n  [\n    [\n      2^#11[1,31]#,\n      3^#12[1,34]#\n    ]^#10[1,30]#\n  ]^#9[1,29]#\n)^#2[1,13]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 24, 25, 1, 24, 1, 25],
+        [9, 29, 30, 1, 29, 1, 30],
+        [10, 30, 31, 1, 30, 1, 31],
+        [11, 31, 32, 1, 31, 1, 32],
+        [12, 34, 37, 1, 34, 1, 37],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "2" } },
+                          { id: "8", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "10",
+                      listExpr: {
+                        elements: [
+                          { id: "11", constExpr: { int64Value: "2" } },
+                          { id: "12", constExpr: { doubleValue: 3 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+            "8": 24,
+            "9": 29,
+            "10": 30,
+            "11": 31,
+            "12": 34,
+          },
+        },
+      },
+      checkedAst:
+        "sets.contains(\n  [\n    [\n      1~int\n    ]~list(int),\n    [\n      2~int,\n      3~int\n    ]~list(int)\n  ]~list(list(int)),\n  [\n    [\n      2~int,\n      3~double\n    ]~list(dyn)\n  ]~list(list(dyn))\n)~bool^list_sets_contains_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_contains_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "10": { listType: { elemType: { dyn: {} } } },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+            "8": 24,
+            "9": 29,
+            "10": 30,
+            "11": 31,
+            "12": 34,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.contains",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "2" } },
+                          { id: "8", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "10",
+                      listExpr: {
+                        elements: [
+                          { id: "11", constExpr: { int64Value: "2" } },
+                          { id: "12", constExpr: { doubleValue: 3 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "53", max: "53" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "53",
+    },
+    {
+      original: {
+        expr: "!sets.contains([1], [2])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.contains(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.contains([1], [2]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.contains(\n    [\n      1^#5[1,16]#\n    ]^#4[1,15]#,\n    [\n      2^#7[1,21]#\n    ]^#6[1,20]#\n  )^#3[1,14]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "contains",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [{ id: "7", constExpr: { int64Value: "2" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.contains(\n    [\n      1~int\n    ]~list(int),\n    [\n      2~int\n    ]~list(int)\n  )~bool^list_sets_contains_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_contains_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.contains",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [{ id: "7", constExpr: { int64Value: "2" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "!sets.contains([1], [1, 2])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.contains(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.contains([1], [1, 2]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.contains(\n    [\n      1^#5[1,16]#\n    ]^#4[1,15]#,\n    [\n      1^#7[1,21]#,\n      2^#8[1,24]#\n    ]^#6[1,20]#\n  )^#3[1,14]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 21, 22, 1, 21, 1, 22],
+        [8, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "contains",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "1" } },
+                          { id: "8", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+            "8": 24,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.contains(\n    [\n      1~int\n    ]~list(int),\n    [\n      1~int,\n      2~int\n    ]~list(int)\n  )~bool^list_sets_contains_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_contains_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+            "8": 24,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.contains",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "1" } },
+                          { id: "8", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "24", max: "24" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "24",
+    },
+    {
+      original: {
+        expr: '!sets.contains([1], ["1", 1])',
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: '!_(\n  sets^#*expr.Expr_IdentExpr#.contains(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      "1"^#*expr.Constant_StringValue#,\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '!(sets.contains([1], ["1", 1]))',
+      locationAst:
+        '!_(\n  sets^#2[1,1]#.contains(\n    [\n      1^#5[1,16]#\n    ]^#4[1,15]#,\n    [\n      "1"^#7[1,21]#,\n      1^#8[1,26]#\n    ]^#6[1,20]#\n  )^#3[1,14]#\n)^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 21, 24, 1, 21, 1, 24],
+        [8, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "contains",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { stringValue: "1" } },
+                          { id: "8", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+            "8": 26,
+          },
+        },
+      },
+      checkedAst:
+        '!_(\n  sets.contains(\n    [\n      1~int\n    ]~list(int),\n    [\n      "1"~string,\n      1~int\n    ]~list(dyn)\n  )~bool^list_sets_contains_list\n)~bool^logical_not',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_contains_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { dyn: {} } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+            "8": 26,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.contains",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { stringValue: "1" } },
+                          { id: "8", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "24", max: "24" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "24",
+    },
+    {
+      original: {
+        expr: "!sets.contains([1], [1.1, 1u])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.contains(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1.1^#*expr.Constant_DoubleValue#,\n      1u^#*expr.Constant_Uint64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.contains([1], [1.1, 1u]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.contains(\n    [\n      1^#5[1,16]#\n    ]^#4[1,15]#,\n    [\n      1.1^#7[1,21]#,\n      1u^#8[1,26]#\n    ]^#6[1,20]#\n  )^#3[1,14]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 21, 24, 1, 21, 1, 24],
+        [8, 26, 28, 1, 26, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "contains",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { doubleValue: 1.1 } },
+                          { id: "8", constExpr: { uint64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+            "8": 26,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.contains(\n    [\n      1~int\n    ]~list(int),\n    [\n      1.1~double,\n      1u~uint\n    ]~list(dyn)\n  )~bool^list_sets_contains_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_contains_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { dyn: {} } } },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 14,
+            "4": 15,
+            "5": 16,
+            "6": 20,
+            "7": 21,
+            "8": 26,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.contains",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { doubleValue: 1.1 } },
+                          { id: "8", constExpr: { uint64Value: "1" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "24", max: "24" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "24",
+    },
+    {
+      original: { expr: "sets.equivalent([], [])", value: { boolValue: true } },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.equivalent(\n  []^#*expr.Expr_ListExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.equivalent([], [])",
+      locationAst:
+        "sets^#1[1,0]#.equivalent(\n  []^#3[1,16]#,\n  []^#4[1,20]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "equivalent",
+            args: [
+              { id: "3", listExpr: {} },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 20 },
+        },
+      },
+      checkedAst:
+        "sets.equivalent(\n  []~list(dyn),\n  []~list(dyn)\n)~bool^list_sets_equivalent_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_equivalent_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 20 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.equivalent",
+            args: [
+              { id: "3", listExpr: {} },
+              { id: "4", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "21" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "21",
+    },
+    {
+      original: {
+        expr: "sets.equivalent([1], [1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.equivalent(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.equivalent([1], [1])",
+      locationAst:
+        "sets^#1[1,0]#.equivalent(\n  [\n    1^#4[1,17]#\n  ]^#3[1,16]#,\n  [\n    1^#6[1,22]#\n  ]^#5[1,21]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 17, "5": 21, "6": 22 },
+        },
+      },
+      checkedAst:
+        "sets.equivalent(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~int\n  ]~list(int)\n)~bool^list_sets_equivalent_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_equivalent_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 17, "5": 21, "6": 22 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.equivalent([1], [1, 1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.equivalent(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.equivalent([1], [1, 1])",
+      locationAst:
+        "sets^#1[1,0]#.equivalent(\n  [\n    1^#4[1,17]#\n  ]^#3[1,16]#,\n  [\n    1^#6[1,22]#,\n    1^#7[1,25]#\n  ]^#5[1,21]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "sets.equivalent(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~int,\n    1~int\n  ]~list(int)\n)~bool^list_sets_equivalent_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_equivalent_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "25", max: "25" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "25",
+    },
+    {
+      original: {
+        expr: "sets.equivalent([1, 1], [1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.equivalent(\n  [\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.equivalent([1, 1], [1])",
+      locationAst:
+        "sets^#1[1,0]#.equivalent(\n  [\n    1^#4[1,17]#,\n    1^#5[1,20]#\n  ]^#3[1,16]#,\n  [\n    1^#7[1,25]#\n  ]^#6[1,24]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "sets.equivalent(\n  [\n    1~int,\n    1~int\n  ]~list(int),\n  [\n    1~int\n  ]~list(int)\n)~bool^list_sets_equivalent_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_equivalent_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "25", max: "25" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "25",
+    },
+    {
+      original: {
+        expr: "sets.equivalent([1], [1u, 1.0])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.equivalent(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1u^#*expr.Constant_Uint64Value#,\n    1^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.equivalent([1], [1u, 1.0])",
+      locationAst:
+        "sets^#1[1,0]#.equivalent(\n  [\n    1^#4[1,17]#\n  ]^#3[1,16]#,\n  [\n    1u^#6[1,22]#,\n    1^#7[1,26]#\n  ]^#5[1,21]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 22, 24, 1, 22, 1, 24],
+        [7, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { uint64Value: "1" } },
+                    { id: "7", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 26,
+          },
+        },
+      },
+      checkedAst:
+        "sets.equivalent(\n  [\n    1~int\n  ]~list(int),\n  [\n    1u~uint,\n    1~double\n  ]~list(dyn)\n)~bool^list_sets_equivalent_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_equivalent_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 26,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { uint64Value: "1" } },
+                    { id: "7", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "25", max: "25" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "25",
+    },
+    {
+      original: {
+        expr: "sets.equivalent([1], [1u, 1.0])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.equivalent(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1u^#*expr.Constant_Uint64Value#,\n    1^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.equivalent([1], [1u, 1.0])",
+      locationAst:
+        "sets^#1[1,0]#.equivalent(\n  [\n    1^#4[1,17]#\n  ]^#3[1,16]#,\n  [\n    1u^#6[1,22]#,\n    1^#7[1,26]#\n  ]^#5[1,21]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 22, 24, 1, 22, 1, 24],
+        [7, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { uint64Value: "1" } },
+                    { id: "7", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 26,
+          },
+        },
+      },
+      checkedAst:
+        "sets.equivalent(\n  [\n    1~int\n  ]~list(int),\n  [\n    1u~uint,\n    1~double\n  ]~list(dyn)\n)~bool^list_sets_equivalent_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_equivalent_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "UINT64" },
+          "7": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 26,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { uint64Value: "1" } },
+                    { id: "7", constExpr: { doubleValue: 1 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "25", max: "25" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "25",
+    },
+    {
+      original: {
+        expr: "sets.equivalent([1, 2, 3], [3u, 2.0, 1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.equivalent(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    3u^#*expr.Constant_Uint64Value#,\n    2^#*expr.Constant_DoubleValue#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.equivalent([1, 2, 3], [3u, 2.0, 1])",
+      locationAst:
+        "sets^#1[1,0]#.equivalent(\n  [\n    1^#4[1,17]#,\n    2^#5[1,20]#,\n    3^#6[1,23]#\n  ]^#3[1,16]#,\n  [\n    3u^#8[1,28]#,\n    2^#9[1,32]#,\n    1^#10[1,37]#\n  ]^#7[1,27]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 23, 24, 1, 23, 1, 24],
+        [7, 27, 28, 1, 27, 1, 28],
+        [8, 28, 30, 1, 28, 1, 30],
+        [9, 32, 35, 1, 32, 1, 35],
+        [10, 37, 38, 1, 37, 1, 38],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { uint64Value: "3" } },
+                    { id: "9", constExpr: { doubleValue: 2 } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 23,
+            "7": 27,
+            "8": 28,
+            "9": 32,
+            "10": 37,
+          },
+        },
+      },
+      checkedAst:
+        "sets.equivalent(\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  [\n    3u~uint,\n    2~double,\n    1~int\n  ]~list(dyn)\n)~bool^list_sets_equivalent_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_equivalent_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { dyn: {} } } },
+          "8": { primitive: "UINT64" },
+          "9": { primitive: "DOUBLE" },
+          "10": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 23,
+            "7": 27,
+            "8": 28,
+            "9": 32,
+            "10": 37,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                    { id: "6", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [
+                    { id: "8", constExpr: { uint64Value: "3" } },
+                    { id: "9", constExpr: { doubleValue: 2 } },
+                    { id: "10", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "39", max: "39" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "39",
+    },
+    {
+      original: {
+        expr: "sets.equivalent([[1.0], [2, 3]], [[1], [2, 3.0]])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.equivalent(\n  [\n    [\n      1^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.equivalent([[1.0], [2, 3]], [[1], [2, 3.0]])",
+      locationAst:
+        "sets^#1[1,0]#.equivalent(\n  [\n    [\n      1^#5[1,18]#\n    ]^#4[1,17]#,\n    [\n      2^#7[1,25]#,\n      3^#8[1,28]#\n    ]^#6[1,24]#\n  ]^#3[1,16]#,\n  [\n    [\n      1^#11[1,35]#\n    ]^#10[1,34]#,\n    [\n      2^#13[1,40]#,\n      3^#14[1,43]#\n    ]^#12[1,39]#\n  ]^#9[1,33]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 21, 1, 18, 1, 21],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 28, 29, 1, 28, 1, 29],
+        [9, 33, 34, 1, 33, 1, 34],
+        [10, 34, 35, 1, 34, 1, 35],
+        [11, 35, 36, 1, 35, 1, 36],
+        [12, 39, 40, 1, 39, 1, 40],
+        [13, 40, 41, 1, 40, 1, 41],
+        [14, 43, 46, 1, 43, 1, 46],
+      ],
+      lineOffsets: [50],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { doubleValue: 1 } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "2" } },
+                          { id: "8", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "10",
+                      listExpr: {
+                        elements: [
+                          { id: "11", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "12",
+                      listExpr: {
+                        elements: [
+                          { id: "13", constExpr: { int64Value: "2" } },
+                          { id: "14", constExpr: { doubleValue: 3 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 24,
+            "7": 25,
+            "8": 28,
+            "9": 33,
+            "10": 34,
+            "11": 35,
+            "12": 39,
+            "13": 40,
+            "14": 43,
+          },
+        },
+      },
+      checkedAst:
+        "sets.equivalent(\n  [\n    [\n      1~double\n    ]~list(double),\n    [\n      2~int,\n      3~int\n    ]~list(int)\n  ]~list(dyn),\n  [\n    [\n      1~int\n    ]~list(int),\n    [\n      2~int,\n      3~double\n    ]~list(dyn)\n  ]~list(list(dyn))\n)~bool^list_sets_equivalent_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_equivalent_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { dyn: {} } } },
+          "4": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "5": { primitive: "DOUBLE" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "INT64" },
+          "12": { listType: { elemType: { dyn: {} } } },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 24,
+            "7": 25,
+            "8": 28,
+            "9": 33,
+            "10": 34,
+            "11": 35,
+            "12": 39,
+            "13": 40,
+            "14": 43,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.equivalent",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { doubleValue: 1 } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "2" } },
+                          { id: "8", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "10",
+                      listExpr: {
+                        elements: [
+                          { id: "11", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "12",
+                      listExpr: {
+                        elements: [
+                          { id: "13", constExpr: { int64Value: "2" } },
+                          { id: "14", constExpr: { doubleValue: 3 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "69", max: "69" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "69",
+    },
+    {
+      original: {
+        expr: "!sets.equivalent([2, 1], [1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.equivalent(\n    [\n      2^#*expr.Constant_Int64Value#,\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.equivalent([2, 1], [1]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.equivalent(\n    [\n      2^#5[1,18]#,\n      1^#6[1,21]#\n    ]^#4[1,17]#,\n    [\n      1^#8[1,26]#\n    ]^#7[1,25]#\n  )^#3[1,16]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "equivalent",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "2" } },
+                          { id: "6", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [{ id: "8", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 25,
+            "8": 26,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.equivalent(\n    [\n      2~int,\n      1~int\n    ]~list(int),\n    [\n      1~int\n    ]~list(int)\n  )~bool^list_sets_equivalent_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_equivalent_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { primitive: "INT64" } } },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 25,
+            "8": 26,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.equivalent",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "2" } },
+                          { id: "6", constExpr: { int64Value: "1" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [{ id: "8", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "26", max: "26" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "26",
+    },
+    {
+      original: {
+        expr: "!sets.equivalent([1], [1, 2])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.equivalent(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.equivalent([1], [1, 2]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.equivalent(\n    [\n      1^#5[1,18]#\n    ]^#4[1,17]#,\n    [\n      1^#7[1,23]#,\n      2^#8[1,26]#\n    ]^#6[1,22]#\n  )^#3[1,16]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 24, 1, 23, 1, 24],
+        [8, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "equivalent",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "1" } },
+                          { id: "8", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 26,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.equivalent(\n    [\n      1~int\n    ]~list(int),\n    [\n      1~int,\n      2~int\n    ]~list(int)\n  )~bool^list_sets_equivalent_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_equivalent_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 26,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.equivalent",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "1" } },
+                          { id: "8", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "26", max: "26" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "26",
+    },
+    {
+      original: {
+        expr: "!sets.equivalent([1, 2], [2u, 2, 2.0])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.equivalent(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2u^#*expr.Constant_Uint64Value#,\n      2^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.equivalent([1, 2], [2u, 2, 2.0]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.equivalent(\n    [\n      1^#5[1,18]#,\n      2^#6[1,21]#\n    ]^#4[1,17]#,\n    [\n      2u^#8[1,26]#,\n      2^#9[1,30]#,\n      2^#10[1,33]#\n    ]^#7[1,25]#\n  )^#3[1,16]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 26, 28, 1, 26, 1, 28],
+        [9, 30, 31, 1, 30, 1, 31],
+        [10, 33, 36, 1, 33, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "equivalent",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "6", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "8", constExpr: { uint64Value: "2" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                          { id: "10", constExpr: { doubleValue: 2 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 25,
+            "8": 26,
+            "9": 30,
+            "10": 33,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.equivalent(\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      2u~uint,\n      2~int,\n      2~double\n    ]~list(dyn)\n  )~bool^list_sets_equivalent_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_equivalent_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { dyn: {} } } },
+          "8": { primitive: "UINT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 25,
+            "8": 26,
+            "9": 30,
+            "10": 33,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.equivalent",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "6", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "8", constExpr: { uint64Value: "2" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                          { id: "10", constExpr: { doubleValue: 2 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "34", max: "34" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "34",
+    },
+    {
+      original: {
+        expr: "!sets.equivalent([1, 2], [1u, 2, 2.3])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.equivalent(\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1u^#*expr.Constant_Uint64Value#,\n      2^#*expr.Constant_Int64Value#,\n      2.3^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.equivalent([1, 2], [1u, 2, 2.3]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.equivalent(\n    [\n      1^#5[1,18]#,\n      2^#6[1,21]#\n    ]^#4[1,17]#,\n    [\n      1u^#8[1,26]#,\n      2^#9[1,30]#,\n      2.3^#10[1,33]#\n    ]^#7[1,25]#\n  )^#3[1,16]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 26, 28, 1, 26, 1, 28],
+        [9, 30, 31, 1, 30, 1, 31],
+        [10, 33, 36, 1, 33, 1, 36],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "equivalent",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "6", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "8", constExpr: { uint64Value: "1" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                          { id: "10", constExpr: { doubleValue: 2.3 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 25,
+            "8": 26,
+            "9": 30,
+            "10": 33,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.equivalent(\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      1u~uint,\n      2~int,\n      2.3~double\n    ]~list(dyn)\n  )~bool^list_sets_equivalent_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_equivalent_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "INT64" },
+          "7": { listType: { elemType: { dyn: {} } } },
+          "8": { primitive: "UINT64" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 21,
+            "7": 25,
+            "8": 26,
+            "9": 30,
+            "10": 33,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.equivalent",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [
+                          { id: "5", constExpr: { int64Value: "1" } },
+                          { id: "6", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      listExpr: {
+                        elements: [
+                          { id: "8", constExpr: { uint64Value: "1" } },
+                          { id: "9", constExpr: { int64Value: "2" } },
+                          { id: "10", constExpr: { doubleValue: 2.3 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "34", max: "34" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "34",
+    },
+    {
+      original: {
+        expr: "sets.intersects([1], [1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([1], [1])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    1^#4[1,17]#\n  ]^#3[1,16]#,\n  [\n    1^#6[1,22]#\n  ]^#5[1,21]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 17, "5": 21, "6": 22 },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~int\n  ]~list(int)\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 17, "5": 21, "6": 22 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "sets.intersects([1], [1, 1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([1], [1, 1])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    1^#4[1,17]#\n  ]^#3[1,16]#,\n  [\n    1^#6[1,22]#,\n    1^#7[1,25]#\n  ]^#5[1,21]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~int,\n    1~int\n  ]~list(int)\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.intersects([1, 1], [1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([1, 1], [1])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    1^#4[1,17]#,\n    1^#5[1,20]#\n  ]^#3[1,16]#,\n  [\n    1^#7[1,25]#\n  ]^#6[1,24]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    1~int,\n    1~int\n  ]~list(int),\n  [\n    1~int\n  ]~list(int)\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.intersects([2, 1], [1])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([2, 1], [1])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    2^#4[1,17]#,\n    1^#5[1,20]#\n  ]^#3[1,16]#,\n  [\n    1^#7[1,25]#\n  ]^#6[1,24]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    2~int,\n    1~int\n  ]~list(int),\n  [\n    1~int\n  ]~list(int)\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { int64Value: "1" } }],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.intersects([1], [1, 2])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([1], [1, 2])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    1^#4[1,17]#\n  ]^#3[1,16]#,\n  [\n    1^#6[1,22]#,\n    2^#7[1,25]#\n  ]^#5[1,21]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 25,
+          },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~int,\n    2~int\n  ]~list(int)\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { primitive: "INT64" } } },
+          "6": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 25,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { int64Value: "1" } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.intersects([1], [1.0, 2])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    1^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1^#*expr.Constant_DoubleValue#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([1], [1.0, 2])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    1^#4[1,17]#\n  ]^#3[1,16]#,\n  [\n    1^#6[1,22]#,\n    2^#7[1,27]#\n  ]^#5[1,21]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 22, 25, 1, 22, 1, 25],
+        [7, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { doubleValue: 1 } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 27,
+          },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    1~int\n  ]~list(int),\n  [\n    1~double,\n    2~int\n  ]~list(dyn)\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { listType: { elemType: { dyn: {} } } },
+          "6": { primitive: "DOUBLE" },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 21,
+            "6": 22,
+            "7": 27,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { doubleValue: 1 } },
+                    { id: "7", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: "sets.intersects([1, 2], [2u, 2, 2.0])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    2u^#*expr.Constant_Uint64Value#,\n    2^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([1, 2], [2u, 2, 2.0])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    1^#4[1,17]#,\n    2^#5[1,20]#\n  ]^#3[1,16]#,\n  [\n    2u^#7[1,25]#,\n    2^#8[1,29]#,\n    2^#9[1,32]#\n  ]^#6[1,24]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 25, 27, 1, 25, 1, 27],
+        [8, 29, 30, 1, 29, 1, 30],
+        [9, 32, 35, 1, 32, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { uint64Value: "2" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { doubleValue: 2 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+            "8": 29,
+            "9": 32,
+          },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  [\n    2u~uint,\n    2~int,\n    2~double\n  ]~list(dyn)\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { dyn: {} } } },
+          "7": { primitive: "UINT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+            "8": 29,
+            "9": 32,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { uint64Value: "2" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { doubleValue: 2 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "27", max: "27" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "27",
+    },
+    {
+      original: {
+        expr: "sets.intersects([1, 2], [1u, 2, 2.3])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    1u^#*expr.Constant_Uint64Value#,\n    2^#*expr.Constant_Int64Value#,\n    2.3^#*expr.Constant_DoubleValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([1, 2], [1u, 2, 2.3])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    1^#4[1,17]#,\n    2^#5[1,20]#\n  ]^#3[1,16]#,\n  [\n    1u^#7[1,25]#,\n    2^#8[1,29]#,\n    2.3^#9[1,32]#\n  ]^#6[1,24]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 20, 21, 1, 20, 1, 21],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 25, 27, 1, 25, 1, 27],
+        [8, 29, 30, 1, 29, 1, 30],
+        [9, 32, 35, 1, 32, 1, 35],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { uint64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { doubleValue: 2.3 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+            "8": 29,
+            "9": 32,
+          },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  [\n    1u~uint,\n    2~int,\n    2.3~double\n  ]~list(dyn)\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { dyn: {} } } },
+          "7": { primitive: "UINT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 20,
+            "6": 24,
+            "7": 25,
+            "8": 29,
+            "9": 32,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { uint64Value: "1" } },
+                    { id: "8", constExpr: { int64Value: "2" } },
+                    { id: "9", constExpr: { doubleValue: 2.3 } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "27", max: "27" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "27",
+    },
+    {
+      original: {
+        expr: "sets.intersects([[1], [2, 3]], [[1, 2], [2, 3.0]])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "sets^#*expr.Expr_IdentExpr#.intersects(\n  [\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#,\n  [\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_DoubleValue#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "sets.intersects([[1], [2, 3]], [[1, 2], [2, 3.0]])",
+      locationAst:
+        "sets^#1[1,0]#.intersects(\n  [\n    [\n      1^#5[1,18]#\n    ]^#4[1,17]#,\n    [\n      2^#7[1,23]#,\n      3^#8[1,26]#\n    ]^#6[1,22]#\n  ]^#3[1,16]#,\n  [\n    [\n      1^#11[1,33]#,\n      2^#12[1,36]#\n    ]^#10[1,32]#,\n    [\n      2^#14[1,41]#,\n      3^#15[1,44]#\n    ]^#13[1,40]#\n  ]^#9[1,31]#\n)^#2[1,15]#",
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 24, 1, 23, 1, 24],
+        [8, 26, 27, 1, 26, 1, 27],
+        [9, 31, 32, 1, 31, 1, 32],
+        [10, 32, 33, 1, 32, 1, 33],
+        [11, 33, 34, 1, 33, 1, 34],
+        [12, 36, 37, 1, 36, 1, 37],
+        [13, 40, 41, 1, 40, 1, 41],
+        [14, 41, 42, 1, 41, 1, 42],
+        [15, 44, 47, 1, 44, 1, 47],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "sets" } },
+            function: "intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "2" } },
+                          { id: "8", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "10",
+                      listExpr: {
+                        elements: [
+                          { id: "11", constExpr: { int64Value: "1" } },
+                          { id: "12", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "13",
+                      listExpr: {
+                        elements: [
+                          { id: "14", constExpr: { int64Value: "2" } },
+                          { id: "15", constExpr: { doubleValue: 3 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 26,
+            "9": 31,
+            "10": 32,
+            "11": 33,
+            "12": 36,
+            "13": 40,
+            "14": 41,
+            "15": 44,
+          },
+        },
+      },
+      checkedAst:
+        "sets.intersects(\n  [\n    [\n      1~int\n    ]~list(int),\n    [\n      2~int,\n      3~int\n    ]~list(int)\n  ]~list(list(int)),\n  [\n    [\n      1~int,\n      2~int\n    ]~list(int),\n    [\n      2~int,\n      3~double\n    ]~list(dyn)\n  ]~list(list(dyn))\n)~bool^list_sets_intersects_list",
+      checkedExpr: {
+        referenceMap: { "2": { overloadId: ["list_sets_intersects_list"] } },
+        typeMap: {
+          "2": { primitive: "BOOL" },
+          "3": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": {
+            listType: { elemType: { listType: { elemType: { dyn: {} } } } },
+          },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { dyn: {} } } },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 26,
+            "9": 31,
+            "10": 32,
+            "11": 33,
+            "12": 36,
+            "13": 40,
+            "14": 41,
+            "15": 44,
+          },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "sets.intersects",
+            args: [
+              {
+                id: "3",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { int64Value: "2" } },
+                          { id: "8", constExpr: { int64Value: "3" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "10",
+                      listExpr: {
+                        elements: [
+                          { id: "11", constExpr: { int64Value: "1" } },
+                          { id: "12", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "13",
+                      listExpr: {
+                        elements: [
+                          { id: "14", constExpr: { int64Value: "2" } },
+                          { id: "15", constExpr: { doubleValue: 3 } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "65", max: "65" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "65",
+    },
+    {
+      original: {
+        expr: "!sets.intersects([], [])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.intersects(\n    []^#*expr.Expr_ListExpr#,\n    []^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.intersects([], []))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.intersects(\n    []^#4[1,17]#,\n    []^#5[1,21]#\n  )^#3[1,16]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 21, 22, 1, 21, 1, 22],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "intersects",
+                  args: [
+                    { id: "4", listExpr: {} },
+                    { id: "5", listExpr: {} },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 1, "3": 16, "4": 17, "5": 21 },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.intersects(\n    []~list(dyn),\n    []~list(dyn)\n  )~bool^list_sets_intersects_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_intersects_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { dyn: {} } } },
+          "5": { listType: { elemType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 1, "3": 16, "4": 17, "5": 21 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.intersects",
+                  args: [
+                    { id: "4", listExpr: {} },
+                    { id: "5", listExpr: {} },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "!sets.intersects([1], [])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.intersects(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    []^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.intersects([1], []))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.intersects(\n    [\n      1^#5[1,18]#\n    ]^#4[1,17]#,\n    []^#6[1,22]#\n  )^#3[1,16]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "intersects",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    { id: "6", listExpr: {} },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 16, "4": 17, "5": 18, "6": 22 },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.intersects(\n    [\n      1~int\n    ]~list(int),\n    []~list(int)\n  )~bool^list_sets_intersects_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_intersects_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 16, "4": 17, "5": 18, "6": 22 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.intersects",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    { id: "6", listExpr: {} },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "22", max: "22" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "22",
+    },
+    {
+      original: {
+        expr: "!sets.intersects([1], [2])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.intersects(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.intersects([1], [2]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.intersects(\n    [\n      1^#5[1,18]#\n    ]^#4[1,17]#,\n    [\n      2^#7[1,23]#\n    ]^#6[1,22]#\n  )^#3[1,16]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "intersects",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [{ id: "7", constExpr: { int64Value: "2" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.intersects(\n    [\n      1~int\n    ]~list(int),\n    [\n      2~int\n    ]~list(int)\n  )~bool^list_sets_intersects_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_intersects_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.intersects",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [{ id: "7", constExpr: { int64Value: "2" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "23" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "23",
+    },
+    {
+      original: {
+        expr: '!sets.intersects([1], ["1", 2])',
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: '!_(\n  sets^#*expr.Expr_IdentExpr#.intersects(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      "1"^#*expr.Constant_StringValue#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '!(sets.intersects([1], ["1", 2]))',
+      locationAst:
+        '!_(\n  sets^#2[1,1]#.intersects(\n    [\n      1^#5[1,18]#\n    ]^#4[1,17]#,\n    [\n      "1"^#7[1,23]#,\n      2^#8[1,28]#\n    ]^#6[1,22]#\n  )^#3[1,16]#\n)^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 26, 1, 23, 1, 26],
+        [8, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "intersects",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { stringValue: "1" } },
+                          { id: "8", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 28,
+          },
+        },
+      },
+      checkedAst:
+        '!_(\n  sets.intersects(\n    [\n      1~int\n    ]~list(int),\n    [\n      "1"~string,\n      2~int\n    ]~list(dyn)\n  )~bool^list_sets_intersects_list\n)~bool^logical_not',
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_intersects_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { dyn: {} } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 28,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.intersects",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { stringValue: "1" } },
+                          { id: "8", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "24", max: "24" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "24",
+    },
+    {
+      original: {
+        expr: "!sets.intersects([1], [1.1, 2u])",
+        value: { boolValue: true },
+      },
+      section: "TestSets",
+      library: "sets",
+      ast: "!_(\n  sets^#*expr.Expr_IdentExpr#.intersects(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      1.1^#*expr.Constant_DoubleValue#,\n      2u^#*expr.Constant_Uint64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!(sets.intersects([1], [1.1, 2u]))",
+      locationAst:
+        "!_(\n  sets^#2[1,1]#.intersects(\n    [\n      1^#5[1,18]#\n    ]^#4[1,17]#,\n    [\n      1.1^#7[1,23]#,\n      2u^#8[1,28]#\n    ]^#6[1,22]#\n  )^#3[1,16]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+        [3, 16, 17, 1, 16, 1, 17],
+        [4, 17, 18, 1, 17, 1, 18],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 26, 1, 23, 1, 26],
+        [8, 28, 30, 1, 28, 1, 30],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  target: { id: "2", identExpr: { name: "sets" } },
+                  function: "intersects",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { doubleValue: 1.1 } },
+                          { id: "8", constExpr: { uint64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 28,
+          },
+        },
+      },
+      checkedAst:
+        "!_(\n  sets.intersects(\n    [\n      1~int\n    ]~list(int),\n    [\n      1.1~double,\n      2u~uint\n    ]~list(dyn)\n  )~bool^list_sets_intersects_list\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["logical_not"] },
+          "3": { overloadId: ["list_sets_intersects_list"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { dyn: {} } } },
+          "7": { primitive: "DOUBLE" },
+          "8": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 16,
+            "4": 17,
+            "5": 18,
+            "6": 22,
+            "7": 23,
+            "8": 28,
+          },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "sets.intersects",
+                  args: [
+                    {
+                      id: "4",
+                      listExpr: {
+                        elements: [{ id: "5", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                    {
+                      id: "6",
+                      listExpr: {
+                        elements: [
+                          { id: "7", constExpr: { doubleValue: 1.1 } },
+                          { id: "8", constExpr: { uint64Value: "2" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "24", max: "24" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "24",
+    },
+  ],
+} as const;
@@ -0,0 +1,15305 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/ext/strings_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "strings",
+  tests: [
+    {
+      original: {
+        expr: "'tacocat'.charAt(3) == 'o'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.charAt(\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "o"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".charAt(3) == "o"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.charAt(\n    3^#3[1,17]#\n  )^#2[1,16]#,\n  "o"^#5[1,23]#\n)^#4[1,20]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 18, 1, 17, 1, 18],
+        [4, 20, 22, 1, 20, 1, 22],
+        [5, 23, 26, 1, 23, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "3" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "o" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 20, "5": 23 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.charAt(\n    3~int\n  )~string^string_char_at_int,\n  "o"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_char_at_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 20, "5": 23 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "3" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "o" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.charAt(7) == ''",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.charAt(\n    7^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".charAt(7) == ""',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.charAt(\n    7^#3[1,17]#\n  )^#2[1,16]#,\n  ""^#5[1,23]#\n)^#4[1,20]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 18, 1, 17, 1, 18],
+        [4, 20, 22, 1, 20, 1, 22],
+        [5, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "7" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 20, "5": 23 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.charAt(\n    7~int\n  )~string^string_char_at_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_char_at_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 20, "5": 23 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "7" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "'©αT'.charAt(0) == '©' \u0026\u0026 '©αT'.charAt(1) == 'α' \u0026\u0026 '©αT'.charAt(2) == 'T'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _==_(\n      "©αT"^#*expr.Constant_StringValue#.charAt(\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      "©"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      "©αT"^#*expr.Constant_StringValue#.charAt(\n        1^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      "α"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    "©αT"^#*expr.Constant_StringValue#.charAt(\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    "T"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"©αT".charAt(0) == "©" \u0026\u0026 "©αT".charAt(1) == "α" \u0026\u0026 "©αT".charAt(2) == "T"',
+      locationAst:
+        '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _==_(\n      "©αT"^#1[1,0]#.charAt(\n        0^#3[1,13]#\n      )^#2[1,12]#,\n      "©"^#5[1,19]#\n    )^#4[1,16]#,\n    _==_(\n      "©αT"^#6[1,26]#.charAt(\n        1^#8[1,39]#\n      )^#7[1,38]#,\n      "α"^#10[1,45]#\n    )^#9[1,42]#\n  )^#11[1,23]#,\n  _==_(\n    "©αT"^#12[1,52]#.charAt(\n      2^#14[1,65]#\n    )^#13[1,64]#,\n    "T"^#16[1,71]#\n  )^#15[1,68]#\n)^#17[1,49]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 23, 1, 19, 1, 23],
+        [6, 26, 33, 1, 26, 1, 33],
+        [7, 38, 39, 1, 38, 1, 39],
+        [8, 39, 40, 1, 39, 1, 40],
+        [9, 42, 44, 1, 42, 1, 44],
+        [10, 45, 49, 1, 45, 1, 49],
+        [11, 23, 25, 1, 23, 1, 25],
+        [12, 52, 59, 1, 52, 1, 59],
+        [13, 64, 65, 1, 64, 1, 65],
+        [14, 65, 66, 1, 65, 1, 66],
+        [15, 68, 70, 1, 68, 1, 70],
+        [16, 71, 74, 1, 71, 1, 74],
+        [17, 49, 51, 1, 49, 1, 51],
+      ],
+      lineOffsets: [75],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              target: {
+                                id: "1",
+                                constExpr: { stringValue: "©αT" },
+                              },
+                              function: "charAt",
+                              args: [
+                                { id: "3", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          { id: "5", constExpr: { stringValue: "©" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "7",
+                            callExpr: {
+                              target: {
+                                id: "6",
+                                constExpr: { stringValue: "©αT" },
+                              },
+                              function: "charAt",
+                              args: [
+                                { id: "8", constExpr: { int64Value: "1" } },
+                              ],
+                            },
+                          },
+                          { id: "10", constExpr: { stringValue: "α" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "13",
+                      callExpr: {
+                        target: { id: "12", constExpr: { stringValue: "©αT" } },
+                        function: "charAt",
+                        args: [{ id: "14", constExpr: { int64Value: "2" } }],
+                      },
+                    },
+                    { id: "16", constExpr: { stringValue: "T" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [75],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 16,
+            "5": 19,
+            "6": 26,
+            "7": 38,
+            "8": 39,
+            "9": 42,
+            "10": 45,
+            "11": 23,
+            "12": 52,
+            "13": 64,
+            "14": 65,
+            "15": 68,
+            "16": 71,
+            "17": 49,
+          },
+        },
+      },
+      checkedAst:
+        '_\u0026\u0026_(\n  _\u0026\u0026_(\n    _==_(\n      "©αT"~string.charAt(\n        0~int\n      )~string^string_char_at_int,\n      "©"~string\n    )~bool^equals,\n    _==_(\n      "©αT"~string.charAt(\n        1~int\n      )~string^string_char_at_int,\n      "α"~string\n    )~bool^equals\n  )~bool^logical_and,\n  _==_(\n    "©αT"~string.charAt(\n      2~int\n    )~string^string_char_at_int,\n    "T"~string\n  )~bool^equals\n)~bool^logical_and',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_char_at_int"] },
+          "4": { overloadId: ["equals"] },
+          "7": { overloadId: ["string_char_at_int"] },
+          "9": { overloadId: ["equals"] },
+          "11": { overloadId: ["logical_and"] },
+          "13": { overloadId: ["string_char_at_int"] },
+          "15": { overloadId: ["equals"] },
+          "17": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "STRING" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "STRING" },
+          "13": { primitive: "STRING" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "STRING" },
+          "17": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [75],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 16,
+            "5": 19,
+            "6": 26,
+            "7": 38,
+            "8": 39,
+            "9": 42,
+            "10": 45,
+            "11": 23,
+            "12": 52,
+            "13": 64,
+            "14": 65,
+            "15": 68,
+            "16": 71,
+            "17": 49,
+          },
+        },
+        expr: {
+          id: "17",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "11",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              target: {
+                                id: "1",
+                                constExpr: { stringValue: "©αT" },
+                              },
+                              function: "charAt",
+                              args: [
+                                { id: "3", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                          { id: "5", constExpr: { stringValue: "©" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "9",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "7",
+                            callExpr: {
+                              target: {
+                                id: "6",
+                                constExpr: { stringValue: "©αT" },
+                              },
+                              function: "charAt",
+                              args: [
+                                { id: "8", constExpr: { int64Value: "1" } },
+                              ],
+                            },
+                          },
+                          { id: "10", constExpr: { stringValue: "α" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "15",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "13",
+                      callExpr: {
+                        target: { id: "12", constExpr: { stringValue: "©αT" } },
+                        function: "charAt",
+                        args: [{ id: "14", constExpr: { int64Value: "2" } }],
+                      },
+                    },
+                    { id: "16", constExpr: { stringValue: "T" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "6" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "6",
+    },
+    {
+      original: {
+        expr: "'tacocat'.indexOf('') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.indexOf(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".indexOf("") == 0',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.indexOf(\n    ""^#3[1,18]#\n  )^#2[1,17]#,\n  0^#5[1,25]#\n)^#4[1,22]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 20, 1, 18, 1, 20],
+        [4, 22, 24, 1, 22, 1, 24],
+        [5, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 25 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.indexOf(\n    ""~string\n  )~int^string_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 25 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.indexOf('ac') == 1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.indexOf(\n    "ac"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".indexOf("ac") == 1',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.indexOf(\n    "ac"^#3[1,18]#\n  )^#2[1,17]#,\n  1^#5[1,27]#\n)^#4[1,24]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 22, 1, 18, 1, 22],
+        [4, 24, 26, 1, 24, 1, 26],
+        [5, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "ac" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 24, "5": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.indexOf(\n    "ac"~string\n  )~int^string_index_of_string,\n  1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 24, "5": 27 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "ac" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.indexOf('none') == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.indexOf(\n    "none"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".indexOf("none") == -1',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.indexOf(\n    "none"^#3[1,18]#\n  )^#2[1,17]#,\n  -1^#5[1,29]#\n)^#4[1,26]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 24, 1, 18, 1, 24],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 29, 31, 1, 29, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "none" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 26, "5": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.indexOf(\n    "none"~string\n  )~int^string_index_of_string,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 26, "5": 29 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "none" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.indexOf('', 3) == 3",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.indexOf(\n    ""^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".indexOf("", 3) == 3',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.indexOf(\n    ""^#3[1,18]#,\n    3^#4[1,22]#\n  )^#2[1,17]#,\n  3^#6[1,28]#\n)^#5[1,25]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 20, 1, 18, 1, 20],
+        [4, 22, 23, 1, 22, 1, 23],
+        [5, 25, 27, 1, 25, 1, 27],
+        [6, 28, 29, 1, 28, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 25, "6": 28 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.indexOf(\n    ""~string,\n    3~int\n  )~int^string_index_of_string_int,\n  3~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 22, "5": 25, "6": 28 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.indexOf('a', 3) == 5",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.indexOf(\n    "a"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  5^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".indexOf("a", 3) == 5',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.indexOf(\n    "a"^#3[1,18]#,\n    3^#4[1,23]#\n  )^#2[1,17]#,\n  5^#6[1,29]#\n)^#5[1,26]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 21, 1, 18, 1, 21],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 30, 1, 29, 1, 30],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 23, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.indexOf(\n    "a"~string,\n    3~int\n  )~int^string_index_of_string_int,\n  5~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 23, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.indexOf('at', 3) == 5",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.indexOf(\n    "at"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  5^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".indexOf("at", 3) == 5',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.indexOf(\n    "at"^#3[1,18]#,\n    3^#4[1,24]#\n  )^#2[1,17]#,\n  5^#6[1,30]#\n)^#5[1,27]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 22, 1, 18, 1, 22],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "at" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 24, "5": 27, "6": 30 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.indexOf(\n    "at"~string,\n    3~int\n  )~int^string_index_of_string_int,\n  5~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 24, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "at" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.indexOf('©') == 2",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.indexOf(\n    "©"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".indexOf("©") == 2',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.indexOf(\n    "©"^#3[1,18]#\n  )^#2[1,17]#,\n  2^#5[1,26]#\n)^#4[1,23]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 22, 1, 18, 1, 22],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "©" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 23, "5": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.indexOf(\n    "©"~string\n  )~int^string_index_of_string,\n  2~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 23, "5": 26 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "©" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.indexOf('©', 3) == 4",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.indexOf(\n    "©"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".indexOf("©", 3) == 4',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.indexOf(\n    "©"^#3[1,18]#,\n    3^#4[1,23]#\n  )^#2[1,17]#,\n  4^#6[1,29]#\n)^#5[1,26]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 22, 1, 18, 1, 22],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 30, 1, 29, 1, 30],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 23, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.indexOf(\n    "©"~string,\n    3~int\n  )~int^string_index_of_string_int,\n  4~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 23, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.indexOf('©αT', 3) == 4",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.indexOf(\n    "©αT"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".indexOf("©αT", 3) == 4',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.indexOf(\n    "©αT"^#3[1,18]#,\n    3^#4[1,25]#\n  )^#2[1,17]#,\n  4^#6[1,31]#\n)^#5[1,28]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 25, 1, 18, 1, 25],
+        [4, 25, 26, 1, 25, 1, 26],
+        [5, 28, 30, 1, 28, 1, 30],
+        [6, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©αT" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 25, "5": 28, "6": 31 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.indexOf(\n    "©αT"~string,\n    3~int\n  )~int^string_index_of_string_int,\n  4~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 25, "5": 28, "6": 31 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©αT" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.indexOf('©α', 5) == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.indexOf(\n    "©α"^#*expr.Constant_StringValue#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".indexOf("©α", 5) == -1',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.indexOf(\n    "©α"^#3[1,18]#,\n    5^#4[1,24]#\n  )^#2[1,17]#,\n  -1^#6[1,30]#\n)^#5[1,27]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 24, 1, 18, 1, 24],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©α" } },
+                    { id: "4", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 24, "5": 27, "6": 30 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.indexOf(\n    "©α"~string,\n    5~int\n  )~int^string_index_of_string_int,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 24, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©α" } },
+                    { id: "4", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: { expr: "'ijk'.indexOf('k') == 2", value: { boolValue: true } },
+      library: "strings",
+      ast: '_==_(\n  "ijk"^#*expr.Constant_StringValue#.indexOf(\n    "k"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ijk".indexOf("k") == 2',
+      locationAst:
+        '_==_(\n  "ijk"^#1[1,0]#.indexOf(\n    "k"^#3[1,14]#\n  )^#2[1,13]#,\n  2^#5[1,22]#\n)^#4[1,19]#',
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 17, 1, 14, 1, 17],
+        [4, 19, 21, 1, 19, 1, 21],
+        [5, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ijk" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "k" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 19, "5": 22 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ijk"~string.indexOf(\n    "k"~string\n  )~int^string_index_of_string,\n  2~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 19, "5": 22 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ijk" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "k" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.indexOf('hello wello') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.indexOf(\n    "hello wello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".indexOf("hello wello") == 0',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.indexOf(\n    "hello wello"^#3[1,22]#\n  )^#2[1,21]#,\n  0^#5[1,40]#\n)^#4[1,37]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 35, 1, 22, 1, 35],
+        [4, 37, 39, 1, 37, 1, 39],
+        [5, 40, 41, 1, 40, 1, 41],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello wello" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 37, "5": 40 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.indexOf(\n    "hello wello"~string\n  )~int^string_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 37, "5": 40 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello wello" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.indexOf('ello', 6) == 7",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.indexOf(\n    "ello"^#*expr.Constant_StringValue#,\n    6^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  7^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".indexOf("ello", 6) == 7',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.indexOf(\n    "ello"^#3[1,22]#,\n    6^#4[1,30]#\n  )^#2[1,21]#,\n  7^#6[1,36]#\n)^#5[1,33]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 28, 1, 22, 1, 28],
+        [4, 30, 31, 1, 30, 1, 31],
+        [5, 33, 35, 1, 33, 1, 35],
+        [6, 36, 37, 1, 36, 1, 37],
+      ],
+      lineOffsets: [38],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ello" } },
+                    { id: "4", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 30, "5": 33, "6": 36 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.indexOf(\n    "ello"~string,\n    6~int\n  )~int^string_index_of_string_int,\n  7~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [38],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 30, "5": 33, "6": 36 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ello" } },
+                    { id: "4", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.indexOf('elbo room!!') == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.indexOf(\n    "elbo room!!"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".indexOf("elbo room!!") == -1',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.indexOf(\n    "elbo room!!"^#3[1,22]#\n  )^#2[1,21]#,\n  -1^#5[1,40]#\n)^#4[1,37]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 35, 1, 22, 1, 35],
+        [4, 37, 39, 1, 37, 1, 39],
+        [5, 40, 42, 1, 40, 1, 42],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "elbo room!!" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 37, "5": 40 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.indexOf(\n    "elbo room!!"~string\n  )~int^string_index_of_string,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 37, "5": 40 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "elbo room!!" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.indexOf('elbo room!!!') == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.indexOf(\n    "elbo room!!!"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".indexOf("elbo room!!!") == -1',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.indexOf(\n    "elbo room!!!"^#3[1,22]#\n  )^#2[1,21]#,\n  -1^#5[1,41]#\n)^#4[1,38]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 36, 1, 22, 1, 36],
+        [4, 38, 40, 1, 38, 1, 40],
+        [5, 41, 43, 1, 41, 1, 43],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "elbo room!!!" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 38, "5": 41 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.indexOf(\n    "elbo room!!!"~string\n  )~int^string_index_of_string,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 38, "5": 41 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "elbo room!!!" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "''.lastIndexOf('@@') == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  ""^#*expr.Constant_StringValue#.lastIndexOf(\n    "@@"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"".lastIndexOf("@@") == -1',
+      locationAst:
+        '_==_(\n  ""^#1[1,0]#.lastIndexOf(\n    "@@"^#3[1,15]#\n  )^#2[1,14]#,\n  -1^#5[1,24]#\n)^#4[1,21]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 19, 1, 15, 1, 19],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 24, 26, 1, 24, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "@@" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 15, "4": 21, "5": 24 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  ""~string.lastIndexOf(\n    "@@"~string\n  )~int^string_last_index_of_string,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 15, "4": 21, "5": 24 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "@@" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.lastIndexOf('') == 7",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.lastIndexOf(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  7^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".lastIndexOf("") == 7',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.lastIndexOf(\n    ""^#3[1,22]#\n  )^#2[1,21]#,\n  7^#5[1,29]#\n)^#4[1,26]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 24, 1, 22, 1, 24],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 29, 30, 1, 29, 1, 30],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 26, "5": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.lastIndexOf(\n    ""~string\n  )~int^string_last_index_of_string,\n  7~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 26, "5": 29 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "7" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.lastIndexOf('at') == 5",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.lastIndexOf(\n    "at"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  5^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".lastIndexOf("at") == 5',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.lastIndexOf(\n    "at"^#3[1,22]#\n  )^#2[1,21]#,\n  5^#5[1,31]#\n)^#4[1,28]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 26, 1, 22, 1, 26],
+        [4, 28, 30, 1, 28, 1, 30],
+        [5, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "at" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 31 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.lastIndexOf(\n    "at"~string\n  )~int^string_last_index_of_string,\n  5~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 31 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "at" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.lastIndexOf('none') == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.lastIndexOf(\n    "none"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".lastIndexOf("none") == -1',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.lastIndexOf(\n    "none"^#3[1,22]#\n  )^#2[1,21]#,\n  -1^#5[1,33]#\n)^#4[1,30]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 28, 1, 22, 1, 28],
+        [4, 30, 32, 1, 30, 1, 32],
+        [5, 33, 35, 1, 33, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "none" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 30, "5": 33 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.lastIndexOf(\n    "none"~string\n  )~int^string_last_index_of_string,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 30, "5": 33 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "none" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.lastIndexOf('', 3) == 3",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.lastIndexOf(\n    ""^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".lastIndexOf("", 3) == 3',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.lastIndexOf(\n    ""^#3[1,22]#,\n    3^#4[1,26]#\n  )^#2[1,21]#,\n  3^#6[1,32]#\n)^#5[1,29]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 24, 1, 22, 1, 24],
+        [4, 26, 27, 1, 26, 1, 27],
+        [5, 29, 31, 1, 29, 1, 31],
+        [6, 32, 33, 1, 32, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 26, "5": 29, "6": 32 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.lastIndexOf(\n    ""~string,\n    3~int\n  )~int^string_last_index_of_string_int,\n  3~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 26, "5": 29, "6": 32 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.lastIndexOf('a', 3) == 1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.lastIndexOf(\n    "a"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".lastIndexOf("a", 3) == 1',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.lastIndexOf(\n    "a"^#3[1,22]#,\n    3^#4[1,27]#\n  )^#2[1,21]#,\n  1^#6[1,33]#\n)^#5[1,30]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 25, 1, 22, 1, 25],
+        [4, 27, 28, 1, 27, 1, 28],
+        [5, 30, 32, 1, 30, 1, 32],
+        [6, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 30, "6": 33 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.lastIndexOf(\n    "a"~string,\n    3~int\n  )~int^string_last_index_of_string_int,\n  1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 30, "6": 33 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.lastIndexOf('©') == 4",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.lastIndexOf(\n    "©"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".lastIndexOf("©") == 4',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.lastIndexOf(\n    "©"^#3[1,22]#\n  )^#2[1,21]#,\n  4^#5[1,30]#\n)^#4[1,27]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 26, 1, 22, 1, 26],
+        [4, 27, 29, 1, 27, 1, 29],
+        [5, 30, 31, 1, 30, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "©" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 30 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.lastIndexOf(\n    "©"~string\n  )~int^string_last_index_of_string,\n  4~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 30 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "©" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.lastIndexOf('©', 3) == 2",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.lastIndexOf(\n    "©"^#*expr.Constant_StringValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".lastIndexOf("©", 3) == 2',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.lastIndexOf(\n    "©"^#3[1,22]#,\n    3^#4[1,27]#\n  )^#2[1,21]#,\n  2^#6[1,33]#\n)^#5[1,30]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 26, 1, 22, 1, 26],
+        [4, 27, 28, 1, 27, 1, 28],
+        [5, 30, 32, 1, 30, 1, 32],
+        [6, 33, 34, 1, 33, 1, 34],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 30, "6": 33 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.lastIndexOf(\n    "©"~string,\n    3~int\n  )~int^string_last_index_of_string_int,\n  2~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 30, "6": 33 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "2" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.lastIndexOf('©α', 4) == 4",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.lastIndexOf(\n    "©α"^#*expr.Constant_StringValue#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  4^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".lastIndexOf("©α", 4) == 4',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.lastIndexOf(\n    "©α"^#3[1,22]#,\n    4^#4[1,28]#\n  )^#2[1,21]#,\n  4^#6[1,34]#\n)^#5[1,31]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 28, 1, 22, 1, 28],
+        [4, 28, 29, 1, 28, 1, 29],
+        [5, 31, 33, 1, 31, 1, 33],
+        [6, 34, 35, 1, 34, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©α" } },
+                    { id: "4", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 31, "6": 34 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.lastIndexOf(\n    "©α"~string,\n    4~int\n  )~int^string_last_index_of_string_int,\n  4~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 31, "6": 34 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©α" } },
+                    { id: "4", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "4" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.lastIndexOf('ello', 6) == 1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.lastIndexOf(\n    "ello"^#*expr.Constant_StringValue#,\n    6^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".lastIndexOf("ello", 6) == 1',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.lastIndexOf(\n    "ello"^#3[1,26]#,\n    6^#4[1,34]#\n  )^#2[1,25]#,\n  1^#6[1,40]#\n)^#5[1,37]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 32, 1, 26, 1, 32],
+        [4, 34, 35, 1, 34, 1, 35],
+        [5, 37, 39, 1, 37, 1, 39],
+        [6, 40, 41, 1, 40, 1, 41],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ello" } },
+                    { id: "4", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 34, "5": 37, "6": 40 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.lastIndexOf(\n    "ello"~string,\n    6~int\n  )~int^string_last_index_of_string_int,\n  1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 34, "5": 37, "6": 40 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ello" } },
+                    { id: "4", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.lastIndexOf('low') == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.lastIndexOf(\n    "low"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".lastIndexOf("low") == -1',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.lastIndexOf(\n    "low"^#3[1,26]#\n  )^#2[1,25]#,\n  -1^#5[1,36]#\n)^#4[1,33]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 31, 1, 26, 1, 31],
+        [4, 33, 35, 1, 33, 1, 35],
+        [5, 36, 38, 1, 36, 1, 38],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "low" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 33, "5": 36 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.lastIndexOf(\n    "low"~string\n  )~int^string_last_index_of_string,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 33, "5": 36 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "low" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.lastIndexOf('elbo room!!') == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.lastIndexOf(\n    "elbo room!!"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".lastIndexOf("elbo room!!") == -1',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.lastIndexOf(\n    "elbo room!!"^#3[1,26]#\n  )^#2[1,25]#,\n  -1^#5[1,44]#\n)^#4[1,41]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 39, 1, 26, 1, 39],
+        [4, 41, 43, 1, 41, 1, 43],
+        [5, 44, 46, 1, 44, 1, 46],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "elbo room!!" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 41, "5": 44 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.lastIndexOf(\n    "elbo room!!"~string\n  )~int^string_last_index_of_string,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 41, "5": 44 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "elbo room!!" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.lastIndexOf('elbo room!!!') == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.lastIndexOf(\n    "elbo room!!!"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".lastIndexOf("elbo room!!!") == -1',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.lastIndexOf(\n    "elbo room!!!"^#3[1,26]#\n  )^#2[1,25]#,\n  -1^#5[1,45]#\n)^#4[1,42]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 40, 1, 26, 1, 40],
+        [4, 42, 44, 1, 42, 1, 44],
+        [5, 45, 47, 1, 45, 1, 47],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "elbo room!!!" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 42, "5": 45 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.lastIndexOf(\n    "elbo room!!!"~string\n  )~int^string_last_index_of_string,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [48],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 42, "5": 45 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "elbo room!!!" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello wello'.lastIndexOf('hello wello') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello wello"^#*expr.Constant_StringValue#.lastIndexOf(\n    "hello wello"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello wello".lastIndexOf("hello wello") == 0',
+      locationAst:
+        '_==_(\n  "hello wello"^#1[1,0]#.lastIndexOf(\n    "hello wello"^#3[1,26]#\n  )^#2[1,25]#,\n  0^#5[1,44]#\n)^#4[1,41]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 39, 1, 26, 1, 39],
+        [4, 41, 43, 1, 41, 1, 43],
+        [5, 44, 45, 1, 44, 1, 45],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello wello" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 41, "5": 44 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello wello"~string.lastIndexOf(\n    "hello wello"~string\n  )~int^string_last_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 25, "3": 26, "4": 41, "5": 44 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello wello" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "hello wello" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'bananananana'.lastIndexOf('nana', 7) == 6",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "bananananana"^#*expr.Constant_StringValue#.lastIndexOf(\n    "nana"^#*expr.Constant_StringValue#,\n    7^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  6^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"bananananana".lastIndexOf("nana", 7) == 6',
+      locationAst:
+        '_==_(\n  "bananananana"^#1[1,0]#.lastIndexOf(\n    "nana"^#3[1,27]#,\n    7^#4[1,35]#\n  )^#2[1,26]#,\n  6^#6[1,41]#\n)^#5[1,38]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 26, 27, 1, 26, 1, 27],
+        [3, 27, 33, 1, 27, 1, 33],
+        [4, 35, 36, 1, 35, 1, 36],
+        [5, 38, 40, 1, 38, 1, 40],
+        [6, 41, 42, 1, 41, 1, 42],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "bananananana" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "nana" } },
+                    { id: "4", constExpr: { int64Value: "7" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "6" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 26, "3": 27, "4": 35, "5": 38, "6": 41 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "bananananana"~string.lastIndexOf(\n    "nana"~string,\n    7~int\n  )~int^string_last_index_of_string_int,\n  6~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: { "1": 0, "2": 26, "3": 27, "4": 35, "5": 38, "6": 41 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "bananananana" },
+                  },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "nana" } },
+                    { id: "4", constExpr: { int64Value: "7" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "6" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'TacoCat'.lowerAscii() == 'tacocat'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "TacoCat"^#*expr.Constant_StringValue#.lowerAscii()^#*expr.Expr_CallExpr#,\n  "tacocat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"TacoCat".lowerAscii() == "tacocat"',
+      locationAst:
+        '_==_(\n  "TacoCat"^#1[1,0]#.lowerAscii()^#2[1,20]#,\n  "tacocat"^#4[1,26]#\n)^#3[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 35, 1, 26, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCat" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "tacocat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "TacoCat"~string.lowerAscii()~string^string_lower_ascii,\n  "tacocat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_lower_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCat" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "tacocat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'TacoCÆt'.lowerAscii() == 'tacocÆt'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "TacoCÆt"^#*expr.Constant_StringValue#.lowerAscii()^#*expr.Expr_CallExpr#,\n  "tacocÆt"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"TacoCÆt".lowerAscii() == "tacocÆt"',
+      locationAst:
+        '_==_(\n  "TacoCÆt"^#1[1,0]#.lowerAscii()^#2[1,20]#,\n  "tacocÆt"^#4[1,26]#\n)^#3[1,23]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 36, 1, 26, 2, 0],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCÆt" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "tacocÆt" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "TacoCÆt"~string.lowerAscii()~string^string_lower_ascii,\n  "tacocÆt"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_lower_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCÆt" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "tacocÆt" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'TacoCÆt Xii'.lowerAscii() == 'tacocÆt xii'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "TacoCÆt Xii"^#*expr.Constant_StringValue#.lowerAscii()^#*expr.Expr_CallExpr#,\n  "tacocÆt xii"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"TacoCÆt Xii".lowerAscii() == "tacocÆt xii"',
+      locationAst:
+        '_==_(\n  "TacoCÆt Xii"^#1[1,0]#.lowerAscii()^#2[1,24]#,\n  "tacocÆt xii"^#4[1,30]#\n)^#3[1,27]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 27, 29, 1, 27, 1, 29],
+        [4, 30, 44, 1, 30, 2, 0],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "TacoCÆt Xii" },
+                  },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "tacocÆt xii" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "1": 0, "2": 24, "3": 27, "4": 30 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "TacoCÆt Xii"~string.lowerAscii()~string^string_lower_ascii,\n  "tacocÆt xii"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_lower_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "1": 0, "2": 24, "3": 27, "4": 30 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "TacoCÆt Xii" },
+                  },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "tacocÆt xii" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: '"12 days 12 hours".replace("{0}", "2") == "12 days 12 hours"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "12 days 12 hours"^#*expr.Constant_StringValue#.replace(\n    "{0}"^#*expr.Constant_StringValue#,\n    "2"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "12 days 12 hours"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"12 days 12 hours".replace("{0}", "2") == "12 days 12 hours"',
+      locationAst:
+        '_==_(\n  "12 days 12 hours"^#1[1,0]#.replace(\n    "{0}"^#3[1,27]#,\n    "2"^#4[1,34]#\n  )^#2[1,26]#,\n  "12 days 12 hours"^#6[1,42]#\n)^#5[1,39]#',
+      positions: [
+        [1, 0, 18, 1, 0, 1, 18],
+        [2, 26, 27, 1, 26, 1, 27],
+        [3, 27, 32, 1, 27, 1, 32],
+        [4, 34, 37, 1, 34, 1, 37],
+        [5, 39, 41, 1, 39, 1, 41],
+        [6, 42, 60, 1, 42, 1, 60],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "12 days 12 hours" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "{0}" } },
+                    { id: "4", constExpr: { stringValue: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "12 days 12 hours" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 26, "3": 27, "4": 34, "5": 39, "6": 42 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "12 days 12 hours"~string.replace(\n    "{0}"~string,\n    "2"~string\n  )~string^string_replace_string_string,\n  "12 days 12 hours"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 26, "3": 27, "4": 34, "5": 39, "6": 42 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "12 days 12 hours" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "{0}" } },
+                    { id: "4", constExpr: { stringValue: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "12 days 12 hours" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: '"{0} days {0} hours".replace("{0}", "2") == "2 days 2 hours"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "{0} days {0} hours"^#*expr.Constant_StringValue#.replace(\n    "{0}"^#*expr.Constant_StringValue#,\n    "2"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "2 days 2 hours"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"{0} days {0} hours".replace("{0}", "2") == "2 days 2 hours"',
+      locationAst:
+        '_==_(\n  "{0} days {0} hours"^#1[1,0]#.replace(\n    "{0}"^#3[1,29]#,\n    "2"^#4[1,36]#\n  )^#2[1,28]#,\n  "2 days 2 hours"^#6[1,44]#\n)^#5[1,41]#',
+      positions: [
+        [1, 0, 20, 1, 0, 1, 20],
+        [2, 28, 29, 1, 28, 1, 29],
+        [3, 29, 34, 1, 29, 1, 34],
+        [4, 36, 39, 1, 36, 1, 39],
+        [5, 41, 43, 1, 41, 1, 43],
+        [6, 44, 60, 1, 44, 1, 60],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "{0} days {0} hours" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "{0}" } },
+                    { id: "4", constExpr: { stringValue: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "2 days 2 hours" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 28, "3": 29, "4": 36, "5": 41, "6": 44 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "{0} days {0} hours"~string.replace(\n    "{0}"~string,\n    "2"~string\n  )~string^string_replace_string_string,\n  "2 days 2 hours"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 28, "3": 29, "4": 36, "5": 41, "6": 44 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "{0} days {0} hours" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "{0}" } },
+                    { id: "4", constExpr: { stringValue: "2" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "2 days 2 hours" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: '"{0} days {0} hours".replace("{0}", "2", 1).replace("{0}", "23") == "2 days 23 hours"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "{0} days {0} hours"^#*expr.Constant_StringValue#.replace(\n    "{0}"^#*expr.Constant_StringValue#,\n    "2"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#.replace(\n    "{0}"^#*expr.Constant_StringValue#,\n    "23"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "2 days 23 hours"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"{0} days {0} hours".replace("{0}", "2", 1).replace("{0}", "23") == "2 days 23 hours"',
+      locationAst:
+        '_==_(\n  "{0} days {0} hours"^#1[1,0]#.replace(\n    "{0}"^#3[1,29]#,\n    "2"^#4[1,36]#,\n    1^#5[1,41]#\n  )^#2[1,28]#.replace(\n    "{0}"^#7[1,52]#,\n    "23"^#8[1,59]#\n  )^#6[1,51]#,\n  "2 days 23 hours"^#10[1,68]#\n)^#9[1,65]#',
+      positions: [
+        [1, 0, 20, 1, 0, 1, 20],
+        [2, 28, 29, 1, 28, 1, 29],
+        [3, 29, 34, 1, 29, 1, 34],
+        [4, 36, 39, 1, 36, 1, 39],
+        [5, 41, 42, 1, 41, 1, 42],
+        [6, 51, 52, 1, 51, 1, 52],
+        [7, 52, 57, 1, 52, 1, 57],
+        [8, 59, 63, 1, 59, 1, 63],
+        [9, 65, 67, 1, 65, 1, 67],
+        [10, 68, 85, 1, 68, 1, 85],
+      ],
+      lineOffsets: [86],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      target: {
+                        id: "1",
+                        constExpr: { stringValue: "{0} days {0} hours" },
+                      },
+                      function: "replace",
+                      args: [
+                        { id: "3", constExpr: { stringValue: "{0}" } },
+                        { id: "4", constExpr: { stringValue: "2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "7", constExpr: { stringValue: "{0}" } },
+                    { id: "8", constExpr: { stringValue: "23" } },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { stringValue: "2 days 23 hours" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [86],
+          positions: {
+            "1": 0,
+            "2": 28,
+            "3": 29,
+            "4": 36,
+            "5": 41,
+            "6": 51,
+            "7": 52,
+            "8": 59,
+            "9": 65,
+            "10": 68,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "{0} days {0} hours"~string.replace(\n    "{0}"~string,\n    "2"~string,\n    1~int\n  )~string^string_replace_string_string_int.replace(\n    "{0}"~string,\n    "23"~string\n  )~string^string_replace_string_string,\n  "2 days 23 hours"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string_int"] },
+          "6": { overloadId: ["string_replace_string_string"] },
+          "9": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "INT64" },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "BOOL" },
+          "10": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [86],
+          positions: {
+            "1": 0,
+            "2": 28,
+            "3": 29,
+            "4": 36,
+            "5": 41,
+            "6": 51,
+            "7": 52,
+            "8": 59,
+            "9": 65,
+            "10": 68,
+          },
+        },
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      target: {
+                        id: "1",
+                        constExpr: { stringValue: "{0} days {0} hours" },
+                      },
+                      function: "replace",
+                      args: [
+                        { id: "3", constExpr: { stringValue: "{0}" } },
+                        { id: "4", constExpr: { stringValue: "2" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "7", constExpr: { stringValue: "{0}" } },
+                    { id: "8", constExpr: { stringValue: "23" } },
+                  ],
+                },
+              },
+              { id: "10", constExpr: { stringValue: "2 days 23 hours" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: '"1 ©αT taco".replace("αT", "o©α") == "1 ©o©α taco"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "1 ©αT taco"^#*expr.Constant_StringValue#.replace(\n    "αT"^#*expr.Constant_StringValue#,\n    "o©α"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "1 ©o©α taco"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"1 ©αT taco".replace("αT", "o©α") == "1 ©o©α taco"',
+      locationAst:
+        '_==_(\n  "1 ©αT taco"^#1[1,0]#.replace(\n    "αT"^#3[1,21]#,\n    "o©α"^#4[1,27]#\n  )^#2[1,20]#,\n  "1 ©o©α taco"^#6[1,37]#\n)^#5[1,34]#',
+      positions: [
+        [1, 0, 14, 1, 0, 1, 14],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 21, 26, 1, 21, 1, 26],
+        [4, 27, 34, 1, 27, 1, 34],
+        [5, 34, 36, 1, 34, 1, 36],
+        [6, 37, 53, 1, 37, 2, 2],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "1 ©αT taco" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "αT" } },
+                    { id: "4", constExpr: { stringValue: "o©α" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "1 ©o©α taco" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 20, "3": 21, "4": 27, "5": 34, "6": 37 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "1 ©αT taco"~string.replace(\n    "αT"~string,\n    "o©α"~string\n  )~string^string_replace_string_string,\n  "1 ©o©α taco"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 20, "3": 21, "4": 27, "5": 34, "6": 37 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "1 ©αT taco" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "αT" } },
+                    { id: "4", constExpr: { stringValue: "o©α" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "1 ©o©α taco" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: '"hello hello".replace("", "_") == "_h_e_l_l_o_ _h_e_l_l_o_"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello hello"^#*expr.Constant_StringValue#.replace(\n    ""^#*expr.Constant_StringValue#,\n    "_"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "_h_e_l_l_o_ _h_e_l_l_o_"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello hello".replace("", "_") == "_h_e_l_l_o_ _h_e_l_l_o_"',
+      locationAst:
+        '_==_(\n  "hello hello"^#1[1,0]#.replace(\n    ""^#3[1,22]#,\n    "_"^#4[1,26]#\n  )^#2[1,21]#,\n  "_h_e_l_l_o_ _h_e_l_l_o_"^#6[1,34]#\n)^#5[1,31]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 24, 1, 22, 1, 24],
+        [4, 26, 29, 1, 26, 1, 29],
+        [5, 31, 33, 1, 31, 1, 33],
+        [6, 34, 59, 1, 34, 1, 59],
+      ],
+      lineOffsets: [60],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { stringValue: "_" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                constExpr: { stringValue: "_h_e_l_l_o_ _h_e_l_l_o_" },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [60],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 26, "5": 31, "6": 34 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello hello"~string.replace(\n    ""~string,\n    "_"~string\n  )~string^string_replace_string_string,\n  "_h_e_l_l_o_ _h_e_l_l_o_"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [60],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 26, "5": 31, "6": 34 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "" } },
+                    { id: "4", constExpr: { stringValue: "_" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                constExpr: { stringValue: "_h_e_l_l_o_ _h_e_l_l_o_" },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: '"hello hello".replace("h", "") == "ello ello"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello hello"^#*expr.Constant_StringValue#.replace(\n    "h"^#*expr.Constant_StringValue#,\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "ello ello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello hello".replace("h", "") == "ello ello"',
+      locationAst:
+        '_==_(\n  "hello hello"^#1[1,0]#.replace(\n    "h"^#3[1,22]#,\n    ""^#4[1,27]#\n  )^#2[1,21]#,\n  "ello ello"^#6[1,34]#\n)^#5[1,31]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 25, 1, 22, 1, 25],
+        [4, 27, 29, 1, 27, 1, 29],
+        [5, 31, 33, 1, 31, 1, 33],
+        [6, 34, 45, 1, 34, 1, 45],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "h" } },
+                    { id: "4", constExpr: { stringValue: "" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "ello ello" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 31, "6": 34 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello hello"~string.replace(\n    "h"~string,\n    ""~string\n  )~string^string_replace_string_string,\n  "ello ello"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 31, "6": 34 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "h" } },
+                    { id: "4", constExpr: { stringValue: "" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "ello ello" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"hello world".split(" ") == ["hello", "world"]',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello world"^#*expr.Constant_StringValue#.split(\n    " "^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "hello"^#*expr.Constant_StringValue#,\n    "world"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello world".split(" ") == ["hello", "world"]',
+      locationAst:
+        '_==_(\n  "hello world"^#1[1,0]#.split(\n    " "^#3[1,20]#\n  )^#2[1,19]#,\n  [\n    "hello"^#6[1,29]#,\n    "world"^#7[1,38]#\n  ]^#5[1,28]#\n)^#4[1,25]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 23, 1, 20, 1, 23],
+        [4, 25, 27, 1, 25, 1, 27],
+        [5, 28, 29, 1, 28, 1, 29],
+        [6, 29, 36, 1, 29, 1, 36],
+        [7, 38, 45, 1, 38, 1, 45],
+      ],
+      lineOffsets: [47],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello world" },
+                  },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: " " } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "7", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "2": 19,
+            "3": 20,
+            "4": 25,
+            "5": 28,
+            "6": 29,
+            "7": 38,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello world"~string.split(\n    " "~string\n  )~list(string)^string_split_string,\n  [\n    "hello"~string,\n    "world"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_split_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "STRING" } } },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [47],
+          positions: {
+            "1": 0,
+            "2": 19,
+            "3": 20,
+            "4": 25,
+            "5": 28,
+            "6": 29,
+            "7": 38,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello world" },
+                  },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: " " } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "7", constExpr: { stringValue: "world" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '"hello world events!".split(" ", 0) == []',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello world events!"^#*expr.Constant_StringValue#.split(\n    " "^#*expr.Constant_StringValue#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  []^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello world events!".split(" ", 0) == []',
+      locationAst:
+        '_==_(\n  "hello world events!"^#1[1,0]#.split(\n    " "^#3[1,28]#,\n    0^#4[1,33]#\n  )^#2[1,27]#,\n  []^#6[1,39]#\n)^#5[1,36]#',
+      positions: [
+        [1, 0, 21, 1, 0, 1, 21],
+        [2, 27, 28, 1, 27, 1, 28],
+        [3, 28, 31, 1, 28, 1, 31],
+        [4, 33, 34, 1, 33, 1, 34],
+        [5, 36, 38, 1, 36, 1, 38],
+        [6, 39, 40, 1, 39, 1, 40],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello world events!" },
+                  },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: " " } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", listExpr: {} },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 27, "3": 28, "4": 33, "5": 36, "6": 39 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello world events!"~string.split(\n    " "~string,\n    0~int\n  )~list(string)^string_split_string_int,\n  []~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_split_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 27, "3": 28, "4": 33, "5": 36, "6": 39 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello world events!" },
+                  },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: " " } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", listExpr: {} },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "11" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: '"hello world events!".split(" ", 1) == ["hello world events!"]',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello world events!"^#*expr.Constant_StringValue#.split(\n    " "^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "hello world events!"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"hello world events!".split(" ", 1) == ["hello world events!"]',
+      locationAst:
+        '_==_(\n  "hello world events!"^#1[1,0]#.split(\n    " "^#3[1,28]#,\n    1^#4[1,33]#\n  )^#2[1,27]#,\n  [\n    "hello world events!"^#7[1,40]#\n  ]^#6[1,39]#\n)^#5[1,36]#',
+      positions: [
+        [1, 0, 21, 1, 0, 1, 21],
+        [2, 27, 28, 1, 27, 1, 28],
+        [3, 28, 31, 1, 28, 1, 31],
+        [4, 33, 34, 1, 33, 1, 34],
+        [5, 36, 38, 1, 36, 1, 38],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 40, 61, 1, 40, 1, 61],
+      ],
+      lineOffsets: [63],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello world events!" },
+                  },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: " " } },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "7",
+                      constExpr: { stringValue: "hello world events!" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 27,
+            "3": 28,
+            "4": 33,
+            "5": 36,
+            "6": 39,
+            "7": 40,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello world events!"~string.split(\n    " "~string,\n    1~int\n  )~list(string)^string_split_string_int,\n  [\n    "hello world events!"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_split_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [63],
+          positions: {
+            "1": 0,
+            "2": 27,
+            "3": 28,
+            "4": 33,
+            "5": 36,
+            "6": 39,
+            "7": 40,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello world events!" },
+                  },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: " " } },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    {
+                      id: "7",
+                      constExpr: { stringValue: "hello world events!" },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '"o©o©o©o".split("©", -1) == ["o", "o", "o", "o"]',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "o©o©o©o"^#*expr.Constant_StringValue#.split(\n    "©"^#*expr.Constant_StringValue#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "o"^#*expr.Constant_StringValue#,\n    "o"^#*expr.Constant_StringValue#,\n    "o"^#*expr.Constant_StringValue#,\n    "o"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"o©o©o©o".split("©", -1) == ["o", "o", "o", "o"]',
+      locationAst:
+        '_==_(\n  "o©o©o©o"^#1[1,0]#.split(\n    "©"^#3[1,16]#,\n    -1^#4[1,21]#\n  )^#2[1,15]#,\n  [\n    "o"^#7[1,29]#,\n    "o"^#8[1,34]#,\n    "o"^#9[1,39]#,\n    "o"^#10[1,44]#\n  ]^#6[1,28]#\n)^#5[1,25]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 20, 1, 16, 1, 20],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 25, 27, 1, 25, 1, 27],
+        [6, 28, 29, 1, 28, 1, 29],
+        [7, 29, 32, 1, 29, 1, 32],
+        [8, 34, 37, 1, 34, 1, 37],
+        [9, 39, 42, 1, 39, 1, 42],
+        [10, 44, 47, 1, 44, 1, 47],
+      ],
+      lineOffsets: [49],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "o©o©o©o" } },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "o" } },
+                    { id: "8", constExpr: { stringValue: "o" } },
+                    { id: "9", constExpr: { stringValue: "o" } },
+                    { id: "10", constExpr: { stringValue: "o" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 21,
+            "5": 25,
+            "6": 28,
+            "7": 29,
+            "8": 34,
+            "9": 39,
+            "10": 44,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "o©o©o©o"~string.split(\n    "©"~string,\n    -1~int\n  )~list(string)^string_split_string_int,\n  [\n    "o"~string,\n    "o"~string,\n    "o"~string,\n    "o"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_split_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { listType: { elemType: { primitive: "STRING" } } },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+          "9": { primitive: "STRING" },
+          "10": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [49],
+          positions: {
+            "1": 0,
+            "2": 15,
+            "3": 16,
+            "4": 21,
+            "5": 25,
+            "6": 28,
+            "7": 29,
+            "8": 34,
+            "9": 39,
+            "10": 44,
+          },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "o©o©o©o" } },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "©" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [
+                    { id: "7", constExpr: { stringValue: "o" } },
+                    { id: "8", constExpr: { stringValue: "o" } },
+                    { id: "9", constExpr: { stringValue: "o" } },
+                    { id: "10", constExpr: { stringValue: "o" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(4) == "cat"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(4) == "cat"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    4^#3[1,20]#\n  )^#2[1,19]#,\n  "cat"^#5[1,26]#\n)^#4[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 26, 31, 1, 26, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    4~int\n  )~string^string_substring_int,\n  "cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(7) == ""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    7^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(7) == ""',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    7^#3[1,20]#\n  )^#2[1,19]#,\n  ""^#5[1,26]#\n)^#4[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 26, 28, 1, 26, 1, 28],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "7" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    7~int\n  )~string^string_substring_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "7" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(0, 4) == "taco"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    0^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "taco"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(0, 4) == "taco"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    0^#3[1,20]#,\n    4^#4[1,23]#\n  )^#2[1,19]#,\n  "taco"^#6[1,29]#\n)^#5[1,26]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 35, 1, 29, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "0" } },
+                    { id: "4", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "taco" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    0~int,\n    4~int\n  )~string^string_substring_int_int,\n  "taco"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "0" } },
+                    { id: "4", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "taco" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(4, 4) == ""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    4^#*expr.Constant_Int64Value#,\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(4, 4) == ""',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    4^#3[1,20]#,\n    4^#4[1,23]#\n  )^#2[1,19]#,\n  ""^#6[1,29]#\n)^#5[1,26]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 31, 1, 29, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    4~int,\n    4~int\n  )~string^string_substring_int_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "4" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.substring(2, 6) == \"©o©α\"",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.substring(\n    2^#*expr.Constant_Int64Value#,\n    6^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "©o©α"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".substring(2, 6) == "©o©α"',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.substring(\n    2^#3[1,20]#,\n    6^#4[1,23]#\n  )^#2[1,19]#,\n  "©o©α"^#6[1,29]#\n)^#5[1,26]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 38, 1, 29, 2, 2],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "©o©α" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.substring(\n    2~int,\n    6~int\n  )~string^string_substring_int_int,\n  "©o©α"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { int64Value: "6" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "©o©α" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'ta©o©αT'.substring(7, 7) == \"\"",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "ta©o©αT"^#*expr.Constant_StringValue#.substring(\n    7^#*expr.Constant_Int64Value#,\n    7^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"ta©o©αT".substring(7, 7) == ""',
+      locationAst:
+        '_==_(\n  "ta©o©αT"^#1[1,0]#.substring(\n    7^#3[1,20]#,\n    7^#4[1,23]#\n  )^#2[1,19]#,\n  ""^#6[1,29]#\n)^#5[1,26]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 31, 1, 29, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "7" } },
+                    { id: "4", constExpr: { int64Value: "7" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "ta©o©αT"~string.substring(\n    7~int,\n    7~int\n  )~string^string_substring_int_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "ta©o©αT" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "7" } },
+                    { id: "4", constExpr: { int64Value: "7" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: '" \\f\\n\\r\\t\\vtext  ".trim() == "text"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  " \\f\\n\\r\\t\\vtext  "^#*expr.Constant_StringValue#.trim()^#*expr.Expr_CallExpr#,\n  "text"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '" \\f\\n\\r\\t\\vtext  ".trim() == "text"',
+      locationAst:
+        '_==_(\n  " \\f\\n\\r\\t\\vtext  "^#1[1,0]#.trim()^#2[1,24]#,\n  "text"^#4[1,30]#\n)^#3[1,27]#',
+      positions: [
+        [1, 0, 19, 1, 0, 1, 19],
+        [2, 24, 25, 1, 24, 1, 25],
+        [3, 27, 29, 1, 27, 1, 29],
+        [4, 30, 36, 1, 30, 1, 36],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: " \f\n\r\t\u000btext  " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "text" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 24, "3": 27, "4": 30 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  " \\f\\n\\r\\t\\vtext  "~string.trim()~string^string_trim,\n  "text"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_trim"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 24, "3": 27, "4": 30 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: " \f\n\r\t\u000btext  " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "text" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"\\u0085\\u00a0\\u1680text".trim() == "text"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "\\u0085\\u00a0\\u1680text"^#*expr.Constant_StringValue#.trim()^#*expr.Expr_CallExpr#,\n  "text"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"\\u0085\\u00a0\\u1680text".trim() == "text"',
+      locationAst:
+        '_==_(\n  "\\u0085\\u00a0\\u1680text"^#1[1,0]#.trim()^#2[1,29]#,\n  "text"^#4[1,35]#\n)^#3[1,32]#',
+      positions: [
+        [1, 0, 24, 1, 0, 1, 24],
+        [2, 29, 30, 1, 29, 1, 30],
+        [3, 32, 34, 1, 32, 1, 34],
+        [4, 35, 41, 1, 35, 1, 41],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "  text" } },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "text" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 29, "3": 32, "4": 35 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "\\u0085\\u00a0\\u1680text"~string.trim()~string^string_trim,\n  "text"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_trim"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 29, "3": 32, "4": 35 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "  text" } },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "text" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"text\\u2000\\u2001\\u2002\\u2003\\u2004\\u2004\\u2006\\u2007\\u2008\\u2009".trim() == "text"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "text\\u2000\\u2001\\u2002\\u2003\\u2004\\u2004\\u2006\\u2007\\u2008\\u2009"^#*expr.Constant_StringValue#.trim()^#*expr.Expr_CallExpr#,\n  "text"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"text\\u2000\\u2001\\u2002\\u2003\\u2004\\u2004\\u2006\\u2007\\u2008\\u2009".trim() == "text"',
+      locationAst:
+        '_==_(\n  "text\\u2000\\u2001\\u2002\\u2003\\u2004\\u2004\\u2006\\u2007\\u2008\\u2009"^#1[1,0]#.trim()^#2[1,71]#,\n  "text"^#4[1,77]#\n)^#3[1,74]#',
+      positions: [
+        [1, 0, 66, 1, 0, 1, 66],
+        [2, 71, 72, 1, 71, 1, 72],
+        [3, 74, 76, 1, 74, 1, 76],
+        [4, 77, 83, 1, 77, 1, 83],
+      ],
+      lineOffsets: [84],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "text          " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "text" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [84],
+          positions: { "1": 0, "2": 71, "3": 74, "4": 77 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "text\\u2000\\u2001\\u2002\\u2003\\u2004\\u2004\\u2006\\u2007\\u2008\\u2009"~string.trim()~string^string_trim,\n  "text"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_trim"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [84],
+          positions: { "1": 0, "2": 71, "3": 74, "4": 77 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "text          " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "text" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"\\u200atext\\u2028\\u2029\\u202F\\u205F\\u3000".trim() == "text"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "\\u200atext\\u2028\\u2029\\u202f\\u205f\\u3000"^#*expr.Constant_StringValue#.trim()^#*expr.Expr_CallExpr#,\n  "text"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"\\u200atext\\u2028\\u2029\\u202f\\u205f\\u3000".trim() == "text"',
+      locationAst:
+        '_==_(\n  "\\u200atext\\u2028\\u2029\\u202f\\u205f\\u3000"^#1[1,0]#.trim()^#2[1,47]#,\n  "text"^#4[1,53]#\n)^#3[1,50]#',
+      positions: [
+        [1, 0, 42, 1, 0, 1, 42],
+        [2, 47, 48, 1, 47, 1, 48],
+        [3, 50, 52, 1, 50, 1, 52],
+        [4, 53, 59, 1, 53, 1, 59],
+      ],
+      lineOffsets: [60],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: " text\u2028\u2029  　" },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "text" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [60],
+          positions: { "1": 0, "2": 47, "3": 50, "4": 53 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "\\u200atext\\u2028\\u2029\\u202f\\u205f\\u3000"~string.trim()~string^string_trim,\n  "text"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_trim"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [60],
+          positions: { "1": 0, "2": 47, "3": 50, "4": 53 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: " text\u2028\u2029  　" },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "text" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff".trim()\n\t\t\t\t== "\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff"',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff"^#*expr.Constant_StringValue#.trim()^#*expr.Expr_CallExpr#,\n  "\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff".trim() == "\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff"',
+      locationAst:
+        '_==_(\n  "\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff"^#1[1,0]#.trim()^#2[1,47]#,\n  "\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff"^#4[2,7]#\n)^#3[2,4]#',
+      positions: [
+        [1, 0, 42, 1, 0, 1, 42],
+        [2, 47, 48, 1, 47, 1, 48],
+        [3, 54, 56, 2, 4, 2, 6],
+        [4, 57, 99, 2, 7, 2, 49],
+      ],
+      lineOffsets: [50, 100],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "᠎text​‌‍⁠﻿" } },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "᠎text​‌‍⁠﻿" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50, 100],
+          positions: { "1": 0, "2": 47, "3": 54, "4": 57 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff"~string.trim()~string^string_trim,\n  "\\u180etext\\u200b\\u200c\\u200d\\u2060\\ufeff"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_trim"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [50, 100],
+          positions: { "1": 0, "2": 47, "3": 54, "4": 57 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "᠎text​‌‍⁠﻿" } },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "᠎text​‌‍⁠﻿" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacoCat'.upperAscii() == 'TACOCAT'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacoCat"^#*expr.Constant_StringValue#.upperAscii()^#*expr.Expr_CallExpr#,\n  "TACOCAT"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacoCat".upperAscii() == "TACOCAT"',
+      locationAst:
+        '_==_(\n  "tacoCat"^#1[1,0]#.upperAscii()^#2[1,20]#,\n  "TACOCAT"^#4[1,26]#\n)^#3[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 35, 1, 26, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacoCat" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCAT" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacoCat"~string.upperAscii()~string^string_upper_ascii,\n  "TACOCAT"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_upper_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacoCat" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCAT" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacoCαt'.upperAscii() == 'TACOCαT'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacoCαt"^#*expr.Constant_StringValue#.upperAscii()^#*expr.Expr_CallExpr#,\n  "TACOCαT"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacoCαt".upperAscii() == "TACOCαT"',
+      locationAst:
+        '_==_(\n  "tacoCαt"^#1[1,0]#.upperAscii()^#2[1,20]#,\n  "TACOCαT"^#4[1,26]#\n)^#3[1,23]#',
+      positions: [
+        [1, 0, 10, 1, 0, 1, 10],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 36, 1, 26, 2, 0],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacoCαt" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCαT" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacoCαt"~string.upperAscii()~string^string_upper_ascii,\n  "TACOCαT"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_upper_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacoCαt" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCαT" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'gums'.reverse() == 'smug'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "gums"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "smug"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"gums".reverse() == "smug"',
+      locationAst:
+        '_==_(\n  "gums"^#1[1,0]#.reverse()^#2[1,14]#,\n  "smug"^#4[1,20]#\n)^#3[1,17]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 17, 19, 1, 17, 1, 19],
+        [4, 20, 26, 1, 20, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "gums" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "smug" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "gums"~string.reverse()~string^string_reverse,\n  "smug"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_reverse"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "gums" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "smug" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'palindromes'.reverse() == 'semordnilap'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "palindromes"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "semordnilap"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"palindromes".reverse() == "semordnilap"',
+      locationAst:
+        '_==_(\n  "palindromes"^#1[1,0]#.reverse()^#2[1,21]#,\n  "semordnilap"^#4[1,27]#\n)^#3[1,24]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 24, 26, 1, 24, 1, 26],
+        [4, 27, 40, 1, 27, 1, 40],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "palindromes" },
+                  },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "semordnilap" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 21, "3": 24, "4": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "palindromes"~string.reverse()~string^string_reverse,\n  "semordnilap"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_reverse"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 21, "3": 24, "4": 27 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "palindromes" },
+                  },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "semordnilap" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: "'John Smith'.reverse() == 'htimS nhoJ'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "John Smith"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "htimS nhoJ"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"John Smith".reverse() == "htimS nhoJ"',
+      locationAst:
+        '_==_(\n  "John Smith"^#1[1,0]#.reverse()^#2[1,20]#,\n  "htimS nhoJ"^#4[1,26]#\n)^#3[1,23]#',
+      positions: [
+        [1, 0, 12, 1, 0, 1, 12],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 38, 1, 26, 1, 38],
+      ],
+      lineOffsets: [39],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "John Smith" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "htimS nhoJ" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "John Smith"~string.reverse()~string^string_reverse,\n  "htimS nhoJ"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_reverse"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [39],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "John Smith" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "htimS nhoJ" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'u180etext'.reverse() == 'txete081u'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "u180etext"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "txete081u"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"u180etext".reverse() == "txete081u"',
+      locationAst:
+        '_==_(\n  "u180etext"^#1[1,0]#.reverse()^#2[1,19]#,\n  "txete081u"^#4[1,25]#\n)^#3[1,22]#',
+      positions: [
+        [1, 0, 11, 1, 0, 1, 11],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 22, 24, 1, 22, 1, 24],
+        [4, 25, 36, 1, 25, 1, 36],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "u180etext" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "txete081u" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 19, "3": 22, "4": 25 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "u180etext"~string.reverse()~string^string_reverse,\n  "txete081u"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_reverse"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 19, "3": 22, "4": 25 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "u180etext" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "txete081u" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'2600+U'.reverse() == 'U+0062'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "2600+U"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "U+0062"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"2600+U".reverse() == "U+0062"',
+      locationAst:
+        '_==_(\n  "2600+U"^#1[1,0]#.reverse()^#2[1,16]#,\n  "U+0062"^#4[1,22]#\n)^#3[1,19]#',
+      positions: [
+        [1, 0, 8, 1, 0, 1, 8],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 19, 21, 1, 19, 1, 21],
+        [4, 22, 30, 1, 22, 1, 30],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "2600+U" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "U+0062" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 16, "3": 19, "4": 22 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "2600+U"~string.reverse()~string^string_reverse,\n  "U+0062"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_reverse"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: { "1": 0, "2": 16, "3": 19, "4": 22 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "2600+U" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "U+0062" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'\\u180e\\u200b\\u200c\\u200d\\u2060\\ufeff'.reverse() == '\\ufeff\\u2060\\u200d\\u200c\\u200b\\u180e'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "\\u180e\\u200b\\u200c\\u200d\\u2060\\ufeff"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "\\ufeff\\u2060\\u200d\\u200c\\u200b\\u180e"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"\\u180e\\u200b\\u200c\\u200d\\u2060\\ufeff".reverse() == "\\ufeff\\u2060\\u200d\\u200c\\u200b\\u180e"',
+      locationAst:
+        '_==_(\n  "\\u180e\\u200b\\u200c\\u200d\\u2060\\ufeff"^#1[1,0]#.reverse()^#2[1,46]#,\n  "\\ufeff\\u2060\\u200d\\u200c\\u200b\\u180e"^#4[1,52]#\n)^#3[1,49]#',
+      positions: [
+        [1, 0, 38, 1, 0, 1, 38],
+        [2, 46, 47, 1, 46, 1, 47],
+        [3, 49, 51, 1, 49, 1, 51],
+        [4, 52, 90, 1, 52, 1, 90],
+      ],
+      lineOffsets: [91],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "᠎​‌‍⁠﻿" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "﻿⁠‍‌​᠎" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [91],
+          positions: { "1": 0, "2": 46, "3": 49, "4": 52 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "\\u180e\\u200b\\u200c\\u200d\\u2060\\ufeff"~string.reverse()~string^string_reverse,\n  "\\ufeff\\u2060\\u200d\\u200c\\u200b\\u180e"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_reverse"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [91],
+          positions: { "1": 0, "2": 46, "3": 49, "4": 52 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "᠎​‌‍⁠﻿" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "﻿⁠‍‌​᠎" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "['x', 'y'].join() == 'xy'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  [\n    "x"^#*expr.Constant_StringValue#,\n    "y"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.join()^#*expr.Expr_CallExpr#,\n  "xy"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["x", "y"].join() == "xy"',
+      locationAst:
+        '_==_(\n  [\n    "x"^#2[1,1]#,\n    "y"^#3[1,6]#\n  ]^#1[1,0]#.join()^#4[1,15]#,\n  "xy"^#6[1,21]#\n)^#5[1,18]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 25, 1, 21, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "x" } },
+                        { id: "3", constExpr: { stringValue: "y" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                },
+              },
+              { id: "6", constExpr: { stringValue: "xy" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "x"~string,\n    "y"~string\n  ]~list(string).join()~string^list_join,\n  "xy"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["list_join"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "x" } },
+                        { id: "3", constExpr: { stringValue: "y" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                },
+              },
+              { id: "6", constExpr: { stringValue: "xy" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "['x', 'y'].join('-') == 'x-y'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  [\n    "x"^#*expr.Constant_StringValue#,\n    "y"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.join(\n    "-"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "x-y"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["x", "y"].join("-") == "x-y"',
+      locationAst:
+        '_==_(\n  [\n    "x"^#2[1,1]#,\n    "y"^#3[1,6]#\n  ]^#1[1,0]#.join(\n    "-"^#5[1,16]#\n  )^#4[1,15]#,\n  "x-y"^#7[1,24]#\n)^#6[1,21]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 19, 1, 16, 1, 19],
+        [6, 21, 23, 1, 21, 1, 23],
+        [7, 24, 29, 1, 24, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "x" } },
+                        { id: "3", constExpr: { stringValue: "y" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                  args: [{ id: "5", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "x-y" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 15,
+            "5": 16,
+            "6": 21,
+            "7": 24,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "x"~string,\n    "y"~string\n  ]~list(string).join(\n    "-"~string\n  )~string^list_join_string,\n  "x-y"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["list_join_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 15,
+            "5": 16,
+            "6": 21,
+            "7": 24,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "x" } },
+                        { id: "3", constExpr: { stringValue: "y" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                  args: [{ id: "5", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "x-y" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: { expr: "[].join() == ''", value: { boolValue: true } },
+      library: "strings",
+      ast: '_==_(\n  []^#*expr.Expr_ListExpr#.join()^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '[].join() == ""',
+      locationAst:
+        '_==_(\n  []^#1[1,0]#.join()^#2[1,7]#,\n  ""^#4[1,13]#\n)^#3[1,10]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 10, 12, 1, 10, 1, 12],
+        [4, 13, 15, 1, 13, 1, 15],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "join",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 7, "3": 10, "4": 13 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  []~list(string).join()~string^list_join,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["list_join"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 7, "3": 10, "4": 13 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "join",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "11" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "11",
+    },
+    {
+      original: { expr: "[].join('-') == ''", value: { boolValue: true } },
+      library: "strings",
+      ast: '_==_(\n  []^#*expr.Expr_ListExpr#.join(\n    "-"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '[].join("-") == ""',
+      locationAst:
+        '_==_(\n  []^#1[1,0]#.join(\n    "-"^#3[1,8]#\n  )^#2[1,7]#,\n  ""^#5[1,16]#\n)^#4[1,13]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 8, 11, 1, 8, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 18, 1, 16, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "join",
+                  args: [{ id: "3", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 7, "3": 8, "4": 13, "5": 16 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  []~list(string).join(\n    "-"~string\n  )~string^list_join_string,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["list_join_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 7, "3": 8, "4": 13, "5": 16 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", listExpr: {} },
+                  function: "join",
+                  args: [{ id: "3", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "11", max: "11" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "11",
+    },
+    {
+      original: {
+        expr: 'strings.quote("first\\nsecond") == "\\"first\\\\nsecond\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "first\\nsecond"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"first\\\\nsecond\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("first\\nsecond") == "\\"first\\\\nsecond\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "first\\nsecond"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"first\\\\nsecond\\""^#5[1,34]#\n)^#4[1,31]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 29, 1, 14, 1, 29],
+        [4, 31, 33, 1, 31, 1, 33],
+        [5, 34, 54, 1, 34, 1, 54],
+      ],
+      lineOffsets: [55],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "first\nsecond" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"first\\nsecond"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [55],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 31, "5": 34 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "first\\nsecond"~string\n  )~string^strings_quote,\n  "\\"first\\\\nsecond\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [55],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 31, "5": 34 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "first\nsecond" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"first\\nsecond"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("bell\\a") == "\\"bell\\\\a\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "bell\\a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"bell\\\\a\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("bell\\a") == "\\"bell\\\\a\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "bell\\a"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"bell\\\\a\\""^#5[1,27]#\n)^#4[1,24]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 22, 1, 14, 1, 22],
+        [4, 24, 26, 1, 24, 1, 26],
+        [5, 27, 40, 1, 27, 1, 40],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [{ id: "3", constExpr: { stringValue: "bell\u0007" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"bell\\a"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "bell\\a"~string\n  )~string^strings_quote,\n  "\\"bell\\\\a\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 27 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [{ id: "3", constExpr: { stringValue: "bell\u0007" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"bell\\a"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: 'strings.quote("\\bbackspace") == "\\"\\\\bbackspace\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "\\bbackspace"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"\\\\bbackspace\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("\\bbackspace") == "\\"\\\\bbackspace\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "\\bbackspace"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"\\\\bbackspace\\""^#5[1,32]#\n)^#4[1,29]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 27, 1, 14, 1, 27],
+        [4, 29, 31, 1, 29, 1, 31],
+        [5, 32, 50, 1, 32, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "\bbackspace" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"\\bbackspace"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 29, "5": 32 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "\\bbackspace"~string\n  )~string^strings_quote,\n  "\\"\\\\bbackspace\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 29, "5": 32 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "\bbackspace" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"\\bbackspace"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: 'strings.quote("\\fform feed") == "\\"\\\\fform feed\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "\\fform feed"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"\\\\fform feed\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("\\fform feed") == "\\"\\\\fform feed\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "\\fform feed"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"\\\\fform feed\\""^#5[1,32]#\n)^#4[1,29]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 27, 1, 14, 1, 27],
+        [4, 29, 31, 1, 29, 1, 31],
+        [5, 32, 50, 1, 32, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "\fform feed" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"\\fform feed"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 29, "5": 32 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "\\fform feed"~string\n  )~string^strings_quote,\n  "\\"\\\\fform feed\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 29, "5": 32 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "\fform feed" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"\\fform feed"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: 'strings.quote("carriage \\r return") == "\\"carriage \\\\r return\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "carriage \\r return"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"carriage \\\\r return\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("carriage \\r return") == "\\"carriage \\\\r return\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "carriage \\r return"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"carriage \\\\r return\\""^#5[1,39]#\n)^#4[1,36]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 34, 1, 14, 1, 34],
+        [4, 36, 38, 1, 36, 1, 38],
+        [5, 39, 64, 1, 39, 1, 64],
+      ],
+      lineOffsets: [65],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "carriage \r return" },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"carriage \\r return"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [65],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 36, "5": 39 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "carriage \\r return"~string\n  )~string^strings_quote,\n  "\\"carriage \\\\r return\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [65],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 36, "5": 39 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "carriage \r return" },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"carriage \\r return"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("horizontal tab\\t") == "\\"horizontal tab\\\\t\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "horizontal tab\\t"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"horizontal tab\\\\t\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("horizontal tab\\t") == "\\"horizontal tab\\\\t\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "horizontal tab\\t"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"horizontal tab\\\\t\\""^#5[1,37]#\n)^#4[1,34]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 32, 1, 14, 1, 32],
+        [4, 34, 36, 1, 34, 1, 36],
+        [5, 37, 60, 1, 37, 1, 60],
+      ],
+      lineOffsets: [61],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "horizontal tab\t" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"horizontal tab\\t"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 34, "5": 37 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "horizontal tab\\t"~string\n  )~string^strings_quote,\n  "\\"horizontal tab\\\\t\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [61],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 34, "5": 37 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "horizontal tab\t" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"horizontal tab\\t"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("vertical \\v tab") == "\\"vertical \\\\v tab\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "vertical \\v tab"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"vertical \\\\v tab\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("vertical \\v tab") == "\\"vertical \\\\v tab\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "vertical \\v tab"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"vertical \\\\v tab\\""^#5[1,36]#\n)^#4[1,33]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 31, 1, 14, 1, 31],
+        [4, 33, 35, 1, 33, 1, 35],
+        [5, 36, 58, 1, 36, 1, 58],
+      ],
+      lineOffsets: [59],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "vertical \u000b tab" },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"vertical \\v tab"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 33, "5": 36 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "vertical \\v tab"~string\n  )~string^strings_quote,\n  "\\"vertical \\\\v tab\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [59],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 33, "5": 36 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "vertical \u000b tab" },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"vertical \\v tab"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("double \\\\\\\\ slash") == "\\"double \\\\\\\\\\\\\\\\ slash\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "double \\\\\\\\ slash"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"double \\\\\\\\\\\\\\\\ slash\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("double \\\\\\\\ slash") == "\\"double \\\\\\\\\\\\\\\\ slash\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "double \\\\\\\\ slash"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"double \\\\\\\\\\\\\\\\ slash\\""^#5[1,38]#\n)^#4[1,35]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 33, 1, 14, 1, 33],
+        [4, 35, 37, 1, 35, 1, 37],
+        [5, 38, 65, 1, 38, 1, 65],
+      ],
+      lineOffsets: [66],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "double \\\\ slash" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"double \\\\\\\\ slash"' },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [66],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 35, "5": 38 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "double \\\\\\\\ slash"~string\n  )~string^strings_quote,\n  "\\"double \\\\\\\\\\\\\\\\ slash\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [66],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 35, "5": 38 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "double \\\\ slash" },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"double \\\\\\\\ slash"' },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("two escape sequences \\a\\n") == "\\"two escape sequences \\\\a\\\\n\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "two escape sequences \\a\\n"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"two escape sequences \\\\a\\\\n\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("two escape sequences \\a\\n") == "\\"two escape sequences \\\\a\\\\n\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "two escape sequences \\a\\n"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"two escape sequences \\\\a\\\\n\\""^#5[1,46]#\n)^#4[1,43]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 41, 1, 14, 1, 41],
+        [4, 43, 45, 1, 43, 1, 45],
+        [5, 46, 79, 1, 46, 1, 79],
+      ],
+      lineOffsets: [80],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue: "two escape sequences \u0007\n",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"two escape sequences \\a\\n"' },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [80],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 43, "5": 46 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "two escape sequences \\a\\n"~string\n  )~string^strings_quote,\n  "\\"two escape sequences \\\\a\\\\n\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [80],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 43, "5": 46 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue: "two escape sequences \u0007\n",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"two escape sequences \\a\\n"' },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "4", max: "6" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "6",
+    },
+    {
+      original: {
+        expr: 'strings.quote("verbatim") == "\\"verbatim\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "verbatim"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"verbatim\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("verbatim") == "\\"verbatim\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "verbatim"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"verbatim\\""^#5[1,29]#\n)^#4[1,26]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 24, 1, 14, 1, 24],
+        [4, 26, 28, 1, 26, 1, 28],
+        [5, 29, 43, 1, 29, 1, 43],
+      ],
+      lineOffsets: [44],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [{ id: "3", constExpr: { stringValue: "verbatim" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"verbatim"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 26, "5": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "verbatim"~string\n  )~string^strings_quote,\n  "\\"verbatim\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [44],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 26, "5": 29 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [{ id: "3", constExpr: { stringValue: "verbatim" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"verbatim"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: 'strings.quote("ends with \\\\") == "\\"ends with \\\\\\\\\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "ends with \\\\"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"ends with \\\\\\\\\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("ends with \\\\") == "\\"ends with \\\\\\\\\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "ends with \\\\"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"ends with \\\\\\\\\\""^#5[1,33]#\n)^#4[1,30]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 28, 1, 14, 1, 28],
+        [4, 30, 32, 1, 30, 1, 32],
+        [5, 33, 53, 1, 33, 1, 53],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ends with \\" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"ends with \\\\"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 30, "5": 33 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "ends with \\\\"~string\n  )~string^strings_quote,\n  "\\"ends with \\\\\\\\\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 30, "5": 33 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "ends with \\" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"ends with \\\\"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("\\\\ starts with") == "\\"\\\\\\\\ starts with\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "\\\\ starts with"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"\\\\\\\\ starts with\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("\\\\ starts with") == "\\"\\\\\\\\ starts with\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "\\\\ starts with"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"\\\\\\\\ starts with\\""^#5[1,35]#\n)^#4[1,32]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 30, 1, 14, 1, 30],
+        [4, 32, 34, 1, 32, 1, 34],
+        [5, 35, 57, 1, 35, 1, 57],
+      ],
+      lineOffsets: [58],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "\\ starts with" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"\\\\ starts with"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 32, "5": 35 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "\\\\ starts with"~string\n  )~string^strings_quote,\n  "\\"\\\\\\\\ starts with\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [58],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 32, "5": 35 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "\\ starts with" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"\\\\ starts with"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("printable unicode😀") == "\\"printable unicode😀\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "printable unicode😀"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"printable unicode😀\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("printable unicode😀") == "\\"printable unicode😀\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "printable unicode😀"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"printable unicode😀\\""^#5[1,39]#\n)^#4[1,36]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 37, 1, 14, 1, 37],
+        [4, 36, 38, 1, 36, 1, 38],
+        [5, 39, 66, 1, 39, 2, 2],
+      ],
+      lineOffsets: [64],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "printable unicode😀" },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"printable unicode😀"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 36, "5": 39 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "printable unicode😀"~string\n  )~string^strings_quote,\n  "\\"printable unicode😀\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [64],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 36, "5": 39 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: "printable unicode😀" },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"printable unicode😀"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("mid string \\" quote") == "\\"mid string \\\\\\" quote\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "mid string \\" quote"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"mid string \\\\\\" quote\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("mid string \\" quote") == "\\"mid string \\\\\\" quote\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "mid string \\" quote"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"mid string \\\\\\" quote\\""^#5[1,40]#\n)^#4[1,37]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 35, 1, 14, 1, 35],
+        [4, 37, 39, 1, 37, 1, 39],
+        [5, 40, 67, 1, 40, 1, 67],
+      ],
+      lineOffsets: [68],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: 'mid string " quote' },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"mid string \\" quote"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [68],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 37, "5": 40 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "mid string \\" quote"~string\n  )~string^strings_quote,\n  "\\"mid string \\\\\\" quote\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [68],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 37, "5": 40 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: 'mid string " quote' },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"mid string \\" quote"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        expr: 'strings.quote(\'single-quote with "double quote"\') == "\\"single-quote with \\\\\\"double quote\\\\\\"\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "single-quote with \\"double quote\\""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"single-quote with \\\\\\"double quote\\\\\\"\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("single-quote with \\"double quote\\"") == "\\"single-quote with \\\\\\"double quote\\\\\\"\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "single-quote with \\"double quote\\""^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"single-quote with \\\\\\"double quote\\\\\\"\\""^#5[1,53]#\n)^#4[1,50]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 48, 1, 14, 1, 48],
+        [4, 50, 52, 1, 50, 1, 52],
+        [5, 53, 97, 1, 53, 1, 97],
+      ],
+      lineOffsets: [98],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue: 'single-quote with "double quote"',
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: {
+                  stringValue: '"single-quote with \\"double quote\\""',
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [98],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 50, "5": 53 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "single-quote with \\"double quote\\""~string\n  )~string^strings_quote,\n  "\\"single-quote with \\\\\\"double quote\\\\\\"\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [98],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 50, "5": 53 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: {
+                        stringValue: 'single-quote with "double quote"',
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: {
+                  stringValue: '"single-quote with \\"double quote\\""',
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "5", max: "8" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "8",
+    },
+    {
+      original: {
+        expr: 'strings.quote("size(\'ÿ\')") == "\\"size(\'ÿ\')\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "size(\'ÿ\')"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"size(\'ÿ\')\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("size(\'ÿ\')") == "\\"size(\'ÿ\')\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "size(\'ÿ\')"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"size(\'ÿ\')\\""^#5[1,30]#\n)^#4[1,27]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 26, 1, 14, 1, 26],
+        [4, 27, 29, 1, 27, 1, 29],
+        [5, 30, 46, 1, 30, 2, 0],
+      ],
+      lineOffsets: [46],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [{ id: "3", constExpr: { stringValue: "size('ÿ')" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "\"size('ÿ')\"" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 27, "5": 30 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "size(\'ÿ\')"~string\n  )~string^strings_quote,\n  "\\"size(\'ÿ\')\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [46],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 27, "5": 30 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [{ id: "3", constExpr: { stringValue: "size('ÿ')" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "\"size('ÿ')\"" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        expr: 'strings.quote("size(\'πέντε\')") == "\\"size(\'πέντε\')\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "size(\'πέντε\')"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"size(\'πέντε\')\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("size(\'πέντε\')") == "\\"size(\'πέντε\')\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "size(\'πέντε\')"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"size(\'πέντε\')\\""^#5[1,34]#\n)^#4[1,31]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 34, 1, 14, 1, 34],
+        [4, 31, 33, 1, 31, 1, 33],
+        [5, 34, 58, 1, 34, 2, 4],
+      ],
+      lineOffsets: [54],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "size('πέντε')" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "\"size('πέντε')\"" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 31, "5": 34 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "size(\'πέντε\')"~string\n  )~string^strings_quote,\n  "\\"size(\'πέντε\')\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [54],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 31, "5": 34 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "size('πέντε')" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "\"size('πέντε')\"" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "4" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "4",
+    },
+    {
+      original: {
+        expr: 'strings.quote("завтра") == "\\"завтра\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "завтра"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"завтра\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("завтра") == "\\"завтра\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "завтра"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"завтра\\""^#5[1,27]#\n)^#4[1,24]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 28, 1, 14, 1, 28],
+        [4, 24, 26, 1, 24, 1, 26],
+        [5, 27, 45, 1, 27, 2, 5],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [{ id: "3", constExpr: { stringValue: "завтра" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"завтра"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "завтра"~string\n  )~string^strings_quote,\n  "\\"завтра\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [40],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 24, "5": 27 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [{ id: "3", constExpr: { stringValue: "завтра" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"завтра"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: 'strings.quote("\\U0001F431\\U0001F600\\U0001F61B") == "\\"\\U0001F431\\U0001F600\\U0001F61B\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "🐱😀😛"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"🐱😀😛\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("🐱😀😛") == "\\"🐱😀😛\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "🐱😀😛"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"🐱😀😛\\""^#5[1,51]#\n)^#4[1,48]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 46, 1, 14, 1, 46],
+        [4, 48, 50, 1, 48, 1, 50],
+        [5, 51, 87, 1, 51, 1, 87],
+      ],
+      lineOffsets: [88],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [{ id: "3", constExpr: { stringValue: "🐱😀😛" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"🐱😀😛"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [88],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 48, "5": 51 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "🐱😀😛"~string\n  )~string^strings_quote,\n  "\\"🐱😀😛\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [88],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 48, "5": 51 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [{ id: "3", constExpr: { stringValue: "🐱😀😛" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"🐱😀😛"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: 'strings.quote("ta©o©αT") == "\\"ta©o©αT\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "ta©o©αT"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"ta©o©αT\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("ta©o©αT") == "\\"ta©o©αT\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "ta©o©αT"^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"ta©o©αT\\""^#5[1,28]#\n)^#4[1,25]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 26, 1, 14, 1, 26],
+        [4, 25, 27, 1, 25, 1, 27],
+        [5, 28, 44, 1, 28, 2, 2],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [{ id: "3", constExpr: { stringValue: "ta©o©αT" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"ta©o©αT"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 25, "5": 28 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "ta©o©αT"~string\n  )~string^strings_quote,\n  "\\"ta©o©αT\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [42],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 25, "5": 28 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [{ id: "3", constExpr: { stringValue: "ta©o©αT" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '"ta©o©αT"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: 'strings.quote("") == "\\"\\""',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'strings.quote("") == "\\"\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    ""^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"\\""^#5[1,21]#\n)^#4[1,18]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 18, 20, 1, 18, 1, 20],
+        [5, 21, 27, 1, 21, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [{ id: "3", constExpr: { stringValue: "" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '""' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 21 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    ""~string\n  )~string^strings_quote,\n  "\\"\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 18, "5": 21 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [{ id: "3", constExpr: { stringValue: "" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: '""' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "strings.quote('%s %s').format(['hello', 'world']) == \"\\\"hello world\\\"\"",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "%s %s"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#.format(\n    [\n      "hello"^#*expr.Constant_StringValue#,\n      "world"^#*expr.Constant_StringValue#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  "\\"hello world\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("%s %s").format(["hello", "world"]) == "\\"hello world\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "%s %s"^#3[1,14]#\n  )^#2[1,13]#.format(\n    [\n      "hello"^#6[1,31]#,\n      "world"^#7[1,40]#\n    ]^#5[1,30]#\n  )^#4[1,29]#,\n  "\\"hello world\\""^#9[1,53]#\n)^#8[1,50]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 21, 1, 14, 1, 21],
+        [4, 29, 30, 1, 29, 1, 30],
+        [5, 30, 31, 1, 30, 1, 31],
+        [6, 31, 38, 1, 31, 1, 38],
+        [7, 40, 47, 1, 40, 1, 47],
+        [8, 50, 52, 1, 50, 1, 52],
+        [9, 53, 70, 1, 53, 1, 70],
+      ],
+      lineOffsets: [71],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      target: { id: "1", identExpr: { name: "strings" } },
+                      function: "quote",
+                      args: [{ id: "3", constExpr: { stringValue: "%s %s" } }],
+                    },
+                  },
+                  function: "format",
+                  args: [
+                    {
+                      id: "5",
+                      listExpr: {
+                        elements: [
+                          { id: "6", constExpr: { stringValue: "hello" } },
+                          { id: "7", constExpr: { stringValue: "world" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { stringValue: '"hello world"' } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [71],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 29,
+            "5": 30,
+            "6": 31,
+            "7": 40,
+            "8": 50,
+            "9": 53,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "%s %s"~string\n  )~string^strings_quote.format(\n    [\n      "hello"~string,\n      "world"~string\n    ]~list(string)\n  )~string^string_format,\n  "\\"hello world\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["string_format"] },
+          "8": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { listType: { elemType: { primitive: "STRING" } } },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [71],
+          positions: {
+            "1": 0,
+            "2": 13,
+            "3": 14,
+            "4": 29,
+            "5": 30,
+            "6": 31,
+            "7": 40,
+            "8": 50,
+            "9": 53,
+          },
+        },
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "2",
+                    callExpr: {
+                      function: "strings.quote",
+                      args: [{ id: "3", constExpr: { stringValue: "%s %s" } }],
+                    },
+                  },
+                  function: "format",
+                  args: [
+                    {
+                      id: "5",
+                      listExpr: {
+                        elements: [
+                          { id: "6", constExpr: { stringValue: "hello" } },
+                          { id: "7", constExpr: { stringValue: "world" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "9", constExpr: { stringValue: '"hello world"' } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "13", max: "15" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "14",
+    },
+    {
+      original: {
+        expr: "'tacocat'.charAt(30) == ''",
+        evalError: { errors: [{ message: "index out of range: 30" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.charAt(\n    30^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".charAt(30) == ""',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.charAt(\n    30^#3[1,17]#\n  )^#2[1,16]#,\n  ""^#5[1,24]#\n)^#4[1,21]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 17, 19, 1, 17, 1, 19],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 24, 26, 1, 24, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "30" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 21, "5": 24 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.charAt(\n    30~int\n  )~string^string_char_at_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_char_at_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 16, "3": 17, "4": 21, "5": 24 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "30" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: { errors: [{ code: 2, message: "index out of range: 30" }] },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "'tacocat'.indexOf('a', 30) == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.indexOf(\n    "a"^#*expr.Constant_StringValue#,\n    30^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".indexOf("a", 30) == -1',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.indexOf(\n    "a"^#3[1,18]#,\n    30^#4[1,23]#\n  )^#2[1,17]#,\n  -1^#6[1,30]#\n)^#5[1,27]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 21, 1, 18, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "30" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 23, "5": 27, "6": 30 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.indexOf(\n    "a"~string,\n    30~int\n  )~int^string_index_of_string_int,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 17, "3": 18, "4": 23, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "30" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.lastIndexOf('a', -1) == -1",
+        evalError: { errors: [{ message: "index out of range: -1" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.lastIndexOf(\n    "a"^#*expr.Constant_StringValue#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".lastIndexOf("a", -1) == -1',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.lastIndexOf(\n    "a"^#3[1,22]#,\n    -1^#4[1,27]#\n  )^#2[1,21]#,\n  -1^#6[1,34]#\n)^#5[1,31]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 25, 1, 22, 1, 25],
+        [4, 27, 29, 1, 27, 1, 29],
+        [5, 31, 33, 1, 31, 1, 33],
+        [6, 34, 36, 1, 34, 1, 36],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 31, "6": 34 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.lastIndexOf(\n    "a"~string,\n    -1~int\n  )~int^string_last_index_of_string_int,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 31, "6": 34 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "index out of range: -1" }] },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'tacocat'.lastIndexOf('a', 30) == -1",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.lastIndexOf(\n    "a"^#*expr.Constant_StringValue#,\n    30^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  -1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".lastIndexOf("a", 30) == -1',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.lastIndexOf(\n    "a"^#3[1,22]#,\n    30^#4[1,27]#\n  )^#2[1,21]#,\n  -1^#6[1,34]#\n)^#5[1,31]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 25, 1, 22, 1, 25],
+        [4, 27, 29, 1, 27, 1, 29],
+        [5, 31, 33, 1, 31, 1, 33],
+        [6, 34, 36, 1, 34, 1, 36],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "30" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 31, "6": 34 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.lastIndexOf(\n    "a"~string,\n    30~int\n  )~int^string_last_index_of_string_int,\n  -1~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 27, "5": 31, "6": 34 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "lastIndexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "a" } },
+                    { id: "4", constExpr: { int64Value: "30" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "-1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(40) == "cat"',
+        evalError: { errors: [{ message: "index out of range: 40" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    40^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(40) == "cat"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    40^#3[1,20]#\n  )^#2[1,19]#,\n  "cat"^#5[1,27]#\n)^#4[1,24]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 22, 1, 20, 1, 22],
+        [4, 24, 26, 1, 24, 1, 26],
+        [5, 27, 32, 1, 27, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "40" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 24, "5": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    40~int\n  )~string^string_substring_int,\n  "cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 24, "5": 27 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "40" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "index out of range: 40" }] },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(-1) == "cat"',
+        evalError: { errors: [{ message: "index out of range: -1" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(-1) == "cat"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    -1^#3[1,20]#\n  )^#2[1,19]#,\n  "cat"^#5[1,27]#\n)^#4[1,24]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 22, 1, 20, 1, 22],
+        [4, 24, 26, 1, 24, 1, 26],
+        [5, 27, 32, 1, 27, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 24, "5": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    -1~int\n  )~string^string_substring_int,\n  "cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 24, "5": 27 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "-1" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "index out of range: -1" }] },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(1, 50) == "cat"',
+        evalError: { errors: [{ message: "index out of range: 50" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    1^#*expr.Constant_Int64Value#,\n    50^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(1, 50) == "cat"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    1^#3[1,20]#,\n    50^#4[1,23]#\n  )^#2[1,19]#,\n  "cat"^#6[1,30]#\n)^#5[1,27]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 27, 29, 1, 27, 1, 29],
+        [6, 30, 35, 1, 30, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "50" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 27, "6": 30 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    1~int,\n    50~int\n  )~string^string_substring_int_int,\n  "cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 27, "6": 30 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "50" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "index out of range: 50" }] },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(49, 50) == "cat"',
+        evalError: { errors: [{ message: "index out of range: 49" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    49^#*expr.Constant_Int64Value#,\n    50^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(49, 50) == "cat"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    49^#3[1,20]#,\n    50^#4[1,24]#\n  )^#2[1,19]#,\n  "cat"^#6[1,31]#\n)^#5[1,28]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 22, 1, 20, 1, 22],
+        [4, 24, 26, 1, 24, 1, 26],
+        [5, 28, 30, 1, 28, 1, 30],
+        [6, 31, 36, 1, 31, 1, 36],
+      ],
+      lineOffsets: [37],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "49" } },
+                    { id: "4", constExpr: { int64Value: "50" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 24, "5": 28, "6": 31 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    49~int,\n    50~int\n  )~string^string_substring_int_int,\n  "cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [37],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 24, "5": 28, "6": 31 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "49" } },
+                    { id: "4", constExpr: { int64Value: "50" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: {
+        error: { errors: [{ code: 2, message: "index out of range: 49" }] },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(4, 3) == ""',
+        evalError: {
+          errors: [{ message: "invalid substring range. start: 4, end: 3" }],
+        },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    4^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(4, 3) == ""',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    4^#3[1,20]#,\n    3^#4[1,23]#\n  )^#2[1,19]#,\n  ""^#6[1,29]#\n)^#5[1,26]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 24, 1, 23, 1, 24],
+        [5, 26, 28, 1, 26, 1, 28],
+        [6, 29, 31, 1, 29, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    4~int,\n    3~int\n  )~string^string_substring_int_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int_int"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26, "6": 29 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "invalid substring range. start: 4, end: 3" },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: '42.charAt(2) == ""',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  42^#*expr.Constant_Int64Value#.charAt(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '42.charAt(2) == ""',
+      locationAst:
+        '_==_(\n  42^#1[1,0]#.charAt(\n    2^#3[1,10]#\n  )^#2[1,9]#,\n  ""^#5[1,16]#\n)^#4[1,13]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 18, 1, 16, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "42" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "2" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:10: found no matching overload for 'charAt' applied to 'int.(int)'\n | 42.charAt(2) == \"\"\n | .........^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'charAt' applied to 'int.(int)'",
+          id: 2,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      result: {
+        error: {
+          errors: [{ code: 2, message: "no such overload: charAt(int, int)" }],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "'hello'.charAt(true) == \"\"",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello"^#*expr.Constant_StringValue#.charAt(\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello".charAt(true) == ""',
+      locationAst:
+        '_==_(\n  "hello"^#1[1,0]#.charAt(\n    true^#3[1,15]#\n  )^#2[1,14]#,\n  ""^#5[1,24]#\n)^#4[1,21]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 15, 19, 1, 15, 1, 19],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 24, 26, 1, 24, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "hello" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { boolValue: true } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 15, "4": 21, "5": 24 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: found no matching overload for 'charAt' applied to 'string.(bool)'\n | 'hello'.charAt(true) == \"\"\n | ..............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'charAt' applied to 'string.(bool)'",
+          id: 2,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: charAt(string, bool)" },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: "24.indexOf('2') == 0",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  24^#*expr.Constant_Int64Value#.indexOf(\n    "2"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '24.indexOf("2") == 0',
+      locationAst:
+        '_==_(\n  24^#1[1,0]#.indexOf(\n    "2"^#3[1,11]#\n  )^#2[1,10]#,\n  0^#5[1,19]#\n)^#4[1,16]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 18, 1, 16, 1, 18],
+        [5, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [21],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "24" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "2" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [21],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:11: found no matching overload for 'indexOf' applied to 'int.(string)'\n | 24.indexOf('2') == 0\n | ..........^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'indexOf' applied to 'int.(string)'",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: indexOf(int, string)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'hello'.indexOf(true) == 1",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello"^#*expr.Constant_StringValue#.indexOf(\n    true^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello".indexOf(true) == 1',
+      locationAst:
+        '_==_(\n  "hello"^#1[1,0]#.indexOf(\n    true^#3[1,16]#\n  )^#2[1,15]#,\n  1^#5[1,25]#\n)^#4[1,22]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 20, 1, 16, 1, 20],
+        [4, 22, 24, 1, 22, 1, 24],
+        [5, 25, 26, 1, 25, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "hello" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { boolValue: true } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 22, "5": 25 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:16: found no matching overload for 'indexOf' applied to 'string.(bool)'\n | 'hello'.indexOf(true) == 1\n | ...............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'indexOf' applied to 'string.(bool)'",
+          id: 2,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: indexOf(string, bool)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "42.indexOf('4', 0) == 0",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  42^#*expr.Constant_Int64Value#.indexOf(\n    "4"^#*expr.Constant_StringValue#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '42.indexOf("4", 0) == 0',
+      locationAst:
+        '_==_(\n  42^#1[1,0]#.indexOf(\n    "4"^#3[1,11]#,\n    0^#4[1,16]#\n  )^#2[1,10]#,\n  0^#6[1,22]#\n)^#5[1,19]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "42" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "4" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 16, "5": 19, "6": 22 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:11: found no matching overload for 'indexOf' applied to 'int.(string, int)'\n | 42.indexOf('4', 0) == 0\n | ..........^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'indexOf' applied to 'int.(string, int)'",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: indexOf(int, string, int)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'42'.indexOf(4, 0) == 0",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.indexOf(\n    4^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".indexOf(4, 0) == 0',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.indexOf(\n    4^#3[1,13]#,\n    0^#4[1,16]#\n  )^#2[1,12]#,\n  0^#6[1,22]#\n)^#5[1,19]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "4" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 12, "3": 13, "4": 16, "5": 19, "6": 22 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:13: found no matching overload for 'indexOf' applied to 'string.(int, int)'\n | '42'.indexOf(4, 0) == 0\n | ............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'indexOf' applied to 'string.(int, int)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: indexOf(string, int, int)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'42'.indexOf('4', '0') == 0",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.indexOf(\n    "4"^#*expr.Constant_StringValue#,\n    "0"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".indexOf("4", "0") == 0',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.indexOf(\n    "4"^#3[1,13]#,\n    "0"^#4[1,18]#\n  )^#2[1,12]#,\n  0^#6[1,26]#\n)^#5[1,23]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 21, 1, 18, 1, 21],
+        [5, 23, 25, 1, 23, 1, 25],
+        [6, 26, 27, 1, 26, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "4" } },
+                    { id: "4", constExpr: { stringValue: "0" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 12, "3": 13, "4": 18, "5": 23, "6": 26 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:13: found no matching overload for 'indexOf' applied to 'string.(string, string)'\n | '42'.indexOf('4', '0') == 0\n | ............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'indexOf' applied to 'string.(string, string)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: indexOf(string, string, string)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: "'42'.indexOf('4', 0, 1) == 0",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.indexOf(\n    "4"^#*expr.Constant_StringValue#,\n    0^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".indexOf("4", 0, 1) == 0',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.indexOf(\n    "4"^#3[1,13]#,\n    0^#4[1,18]#,\n    1^#5[1,21]#\n  )^#2[1,12]#,\n  0^#7[1,27]#\n)^#6[1,24]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 24, 26, 1, 24, 1, 26],
+        [7, 27, 28, 1, 27, 1, 28],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "indexOf",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "4" } },
+                    { id: "4", constExpr: { int64Value: "0" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 21,
+            "6": 24,
+            "7": 27,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:13: found no matching overload for 'indexOf' applied to 'string.(string, int, int)'\n | '42'.indexOf('4', 0, 1) == 0\n | ............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'indexOf' applied to 'string.(string, int, int)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: indexOf(string, string, int, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '42.split("2") == ["4"]',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  42^#*expr.Constant_Int64Value#.split(\n    "2"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "4"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '42.split("2") == ["4"]',
+      locationAst:
+        '_==_(\n  42^#1[1,0]#.split(\n    "2"^#3[1,9]#\n  )^#2[1,8]#,\n  [\n    "4"^#6[1,18]#\n  ]^#5[1,17]#\n)^#4[1,14]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 12, 1, 9, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "42" } },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: "2" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { stringValue: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 8, "3": 9, "4": 14, "5": 17, "6": 18 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:9: found no matching overload for 'split' applied to 'int.(string)'\n | 42.split(\"2\") == [\"4\"]\n | ........^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'split' applied to 'int.(string)'",
+          id: 2,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: split(int, string)" },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '42.replace(2, 1) == "41"',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  42^#*expr.Constant_Int64Value#.replace(\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "41"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '42.replace(2, 1) == "41"',
+      locationAst:
+        '_==_(\n  42^#1[1,0]#.replace(\n    2^#3[1,11]#,\n    1^#4[1,14]#\n  )^#2[1,10]#,\n  "41"^#6[1,20]#\n)^#5[1,17]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 17, 19, 1, 17, 1, 19],
+        [6, 20, 24, 1, 20, 1, 24],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "42" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "41" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [25],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 20 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:11: found no matching overload for 'replace' applied to 'int.(int, int)'\n | 42.replace(2, 1) == \"41\"\n | ..........^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'replace' applied to 'int.(int, int)'",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: replace(int, int, int)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"42".replace(2, 1) == "41"',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.replace(\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "41"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".replace(2, 1) == "41"',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.replace(\n    2^#3[1,13]#,\n    1^#4[1,16]#\n  )^#2[1,12]#,\n  "41"^#6[1,22]#\n)^#5[1,19]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 26, 1, 22, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "41" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 12, "3": 13, "4": 16, "5": 19, "6": 22 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:13: found no matching overload for 'replace' applied to 'string.(int, int)'\n | \"42\".replace(2, 1) == \"41\"\n | ............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'replace' applied to 'string.(int, int)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: replace(string, int, int)" },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"42".replace("2", 1) == "41"',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.replace(\n    "2"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "41"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".replace("2", 1) == "41"',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.replace(\n    "2"^#3[1,13]#,\n    1^#4[1,18]#\n  )^#2[1,12]#,\n  "41"^#6[1,24]#\n)^#5[1,21]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 21, 23, 1, 21, 1, 23],
+        [6, 24, 28, 1, 24, 1, 28],
+      ],
+      lineOffsets: [29],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "2" } },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "41" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [29],
+          positions: { "1": 0, "2": 12, "3": 13, "4": 18, "5": 21, "6": 24 },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:13: found no matching overload for \'replace\' applied to \'string.(string, int)\'\n | "42".replace("2", 1) == "41"\n | ............^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'replace' applied to 'string.(string, int)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: replace(string, string, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '42.replace("2", "1", 1) == "41"',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  42^#*expr.Constant_Int64Value#.replace(\n    "2"^#*expr.Constant_StringValue#,\n    "1"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "41"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '42.replace("2", "1", 1) == "41"',
+      locationAst:
+        '_==_(\n  42^#1[1,0]#.replace(\n    "2"^#3[1,11]#,\n    "1"^#4[1,16]#,\n    1^#5[1,21]#\n  )^#2[1,10]#,\n  "41"^#7[1,27]#\n)^#6[1,24]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 19, 1, 16, 1, 19],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 24, 26, 1, 24, 1, 26],
+        [7, 27, 31, 1, 27, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "42" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "2" } },
+                    { id: "4", constExpr: { stringValue: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "41" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 16,
+            "5": 21,
+            "6": 24,
+            "7": 27,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:11: found no matching overload for \'replace\' applied to \'int.(string, string, int)\'\n | 42.replace("2", "1", 1) == "41"\n | ..........^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'replace' applied to 'int.(string, string, int)'",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: replace(int, string, string, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"42".replace(2, "1", 1) == "41"',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.replace(\n    2^#*expr.Constant_Int64Value#,\n    "1"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "41"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".replace(2, "1", 1) == "41"',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.replace(\n    2^#3[1,13]#,\n    "1"^#4[1,16]#,\n    1^#5[1,21]#\n  )^#2[1,12]#,\n  "41"^#7[1,27]#\n)^#6[1,24]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 14, 1, 13, 1, 14],
+        [4, 16, 19, 1, 16, 1, 19],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 24, 26, 1, 24, 1, 26],
+        [7, 27, 31, 1, 27, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { stringValue: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "41" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 16,
+            "5": 21,
+            "6": 24,
+            "7": 27,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:13: found no matching overload for \'replace\' applied to \'string.(int, string, int)\'\n | "42".replace(2, "1", 1) == "41"\n | ............^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'replace' applied to 'string.(int, string, int)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: replace(string, int, string, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"42".replace("2", 1, 1) == "41"',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.replace(\n    "2"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "41"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".replace("2", 1, 1) == "41"',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.replace(\n    "2"^#3[1,13]#,\n    1^#4[1,18]#,\n    1^#5[1,21]#\n  )^#2[1,12]#,\n  "41"^#7[1,27]#\n)^#6[1,24]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 19, 1, 18, 1, 19],
+        [5, 21, 22, 1, 21, 1, 22],
+        [6, 24, 26, 1, 24, 1, 26],
+        [7, 27, 31, 1, 27, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "2" } },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "41" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 21,
+            "6": 24,
+            "7": 27,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:13: found no matching overload for \'replace\' applied to \'string.(string, int, int)\'\n | "42".replace("2", 1, 1) == "41"\n | ............^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'replace' applied to 'string.(string, int, int)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: replace(string, string, int, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"42".replace("2", "1", "1") == "41"',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.replace(\n    "2"^#*expr.Constant_StringValue#,\n    "1"^#*expr.Constant_StringValue#,\n    "1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "41"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".replace("2", "1", "1") == "41"',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.replace(\n    "2"^#3[1,13]#,\n    "1"^#4[1,18]#,\n    "1"^#5[1,23]#\n  )^#2[1,12]#,\n  "41"^#7[1,31]#\n)^#6[1,28]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 21, 1, 18, 1, 21],
+        [5, 23, 26, 1, 23, 1, 26],
+        [6, 28, 30, 1, 28, 1, 30],
+        [7, 31, 35, 1, 31, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "2" } },
+                    { id: "4", constExpr: { stringValue: "1" } },
+                    { id: "5", constExpr: { stringValue: "1" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "41" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 23,
+            "6": 28,
+            "7": 31,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:13: found no matching overload for \'replace\' applied to \'string.(string, string, string)\'\n | "42".replace("2", "1", "1") == "41"\n | ............^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'replace' applied to 'string.(string, string, string)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "no such overload: replace(string, string, string, string)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '"42".replace("2", "1", 1, false) == "41"',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.replace(\n    "2"^#*expr.Constant_StringValue#,\n    "1"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  "41"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".replace("2", "1", 1, false) == "41"',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.replace(\n    "2"^#3[1,13]#,\n    "1"^#4[1,18]#,\n    1^#5[1,23]#,\n    false^#6[1,26]#\n  )^#2[1,12]#,\n  "41"^#8[1,36]#\n)^#7[1,33]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 16, 1, 13, 1, 16],
+        [4, 18, 21, 1, 18, 1, 21],
+        [5, 23, 24, 1, 23, 1, 24],
+        [6, 26, 31, 1, 26, 1, 31],
+        [7, 33, 35, 1, 33, 1, 35],
+        [8, 36, 40, 1, 36, 1, 40],
+      ],
+      lineOffsets: [41],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "2" } },
+                    { id: "4", constExpr: { stringValue: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                    { id: "6", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { stringValue: "41" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [41],
+          positions: {
+            "1": 0,
+            "2": 12,
+            "3": 13,
+            "4": 18,
+            "5": 23,
+            "6": 26,
+            "7": 33,
+            "8": 36,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:13: found no matching overload for \'replace\' applied to \'string.(string, string, int, bool)\'\n | "42".replace("2", "1", 1, false) == "41"\n | ............^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'replace' applied to 'string.(string, string, int, bool)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message:
+                "no such overload: replace(string, string, string, int, bool)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        expr: '42.split("") == ["4", "2"]',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  42^#*expr.Constant_Int64Value#.split(\n    ""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "4"^#*expr.Constant_StringValue#,\n    "2"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '42.split("") == ["4", "2"]',
+      locationAst:
+        '_==_(\n  42^#1[1,0]#.split(\n    ""^#3[1,9]#\n  )^#2[1,8]#,\n  [\n    "4"^#6[1,17]#,\n    "2"^#7[1,22]#\n  ]^#5[1,16]#\n)^#4[1,13]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 11, 1, 9, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 17, 1, 16, 1, 17],
+        [6, 17, 20, 1, 17, 1, 20],
+        [7, 22, 25, 1, 22, 1, 25],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "42" } },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: "" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "4" } },
+                    { id: "7", constExpr: { stringValue: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 13,
+            "5": 16,
+            "6": 17,
+            "7": 22,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:9: found no matching overload for \'split\' applied to \'int.(string)\'\n | 42.split("") == ["4", "2"]\n | ........^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'split' applied to 'int.(string)'",
+          id: 2,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: split(int, string)" },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '"42".split(2) == ["4"]',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.split(\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "4"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".split(2) == ["4"]',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.split(\n    2^#3[1,11]#\n  )^#2[1,10]#,\n  [\n    "4"^#6[1,18]#\n  ]^#5[1,17]#\n)^#4[1,14]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 16, 1, 14, 1, 16],
+        [5, 17, 18, 1, 17, 1, 18],
+        [6, 18, 21, 1, 18, 1, 21],
+      ],
+      lineOffsets: [23],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { int64Value: "2" } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [{ id: "6", constExpr: { stringValue: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [23],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 14, "5": 17, "6": 18 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:11: found no matching overload for 'split' applied to 'string.(int)'\n | \"42\".split(2) == [\"4\"]\n | ..........^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'split' applied to 'string.(int)'",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: split(string, int)" },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '42.split("2", "1") == ["4"]',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  42^#*expr.Constant_Int64Value#.split(\n    "2"^#*expr.Constant_StringValue#,\n    "1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "4"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '42.split("2", "1") == ["4"]',
+      locationAst:
+        '_==_(\n  42^#1[1,0]#.split(\n    "2"^#3[1,9]#,\n    "1"^#4[1,14]#\n  )^#2[1,8]#,\n  [\n    "4"^#7[1,23]#\n  ]^#6[1,22]#\n)^#5[1,19]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 8, 9, 1, 8, 1, 9],
+        [3, 9, 12, 1, 9, 1, 12],
+        [4, 14, 17, 1, 14, 1, 17],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 23, 1, 22, 1, 23],
+        [7, 23, 26, 1, 23, 1, 26],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "42" } },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "2" } },
+                    { id: "4", constExpr: { stringValue: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { stringValue: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 8,
+            "3": 9,
+            "4": 14,
+            "5": 19,
+            "6": 22,
+            "7": 23,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:9: found no matching overload for \'split\' applied to \'int.(string, string)\'\n | 42.split("2", "1") == ["4"]\n | ........^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'split' applied to 'int.(string, string)'",
+          id: 2,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: split(int, string, string)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '"42".split(2, 1) == ["4"]',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.split(\n    2^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "4"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".split(2, 1) == ["4"]',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.split(\n    2^#3[1,11]#,\n    1^#4[1,14]#\n  )^#2[1,10]#,\n  [\n    "4"^#7[1,21]#\n  ]^#6[1,20]#\n)^#5[1,17]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 14, 15, 1, 14, 1, 15],
+        [5, 17, 19, 1, 17, 1, 19],
+        [6, 20, 21, 1, 20, 1, 21],
+        [7, 21, 24, 1, 21, 1, 24],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { stringValue: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 14,
+            "5": 17,
+            "6": 20,
+            "7": 21,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:11: found no matching overload for 'split' applied to 'string.(int, int)'\n | \"42\".split(2, 1) == [\"4\"]\n | ..........^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'split' applied to 'string.(int, int)'",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: split(string, int, int)" },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '"42".split("2", "1") == ["4"]',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.split(\n    "2"^#*expr.Constant_StringValue#,\n    "1"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "4"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".split("2", "1") == ["4"]',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.split(\n    "2"^#3[1,11]#,\n    "1"^#4[1,16]#\n  )^#2[1,10]#,\n  [\n    "4"^#7[1,25]#\n  ]^#6[1,24]#\n)^#5[1,21]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 19, 1, 16, 1, 19],
+        [5, 21, 23, 1, 21, 1, 23],
+        [6, 24, 25, 1, 24, 1, 25],
+        [7, 25, 28, 1, 25, 1, 28],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "2" } },
+                    { id: "4", constExpr: { stringValue: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                listExpr: {
+                  elements: [{ id: "7", constExpr: { stringValue: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 16,
+            "5": 21,
+            "6": 24,
+            "7": 25,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:11: found no matching overload for \'split\' applied to \'string.(string, string)\'\n | "42".split("2", "1") == ["4"]\n | ..........^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'split' applied to 'string.(string, string)'",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: split(string, string, string)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: '"42".split("2", 1, 1) == ["4"]',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "42"^#*expr.Constant_StringValue#.split(\n    "2"^#*expr.Constant_StringValue#,\n    1^#*expr.Constant_Int64Value#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "4"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"42".split("2", 1, 1) == ["4"]',
+      locationAst:
+        '_==_(\n  "42"^#1[1,0]#.split(\n    "2"^#3[1,11]#,\n    1^#4[1,16]#,\n    1^#5[1,19]#\n  )^#2[1,10]#,\n  [\n    "4"^#8[1,26]#\n  ]^#7[1,25]#\n)^#6[1,22]#',
+      positions: [
+        [1, 0, 4, 1, 0, 1, 4],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 14, 1, 11, 1, 14],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 19, 20, 1, 19, 1, 20],
+        [6, 22, 24, 1, 22, 1, 24],
+        [7, 25, 26, 1, 25, 1, 26],
+        [8, 26, 29, 1, 26, 1, 29],
+      ],
+      lineOffsets: [31],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "42" } },
+                  function: "split",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "2" } },
+                    { id: "4", constExpr: { int64Value: "1" } },
+                    { id: "5", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                listExpr: {
+                  elements: [{ id: "8", constExpr: { stringValue: "4" } }],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [31],
+          positions: {
+            "1": 0,
+            "2": 10,
+            "3": 11,
+            "4": 16,
+            "5": 19,
+            "6": 22,
+            "7": 25,
+            "8": 26,
+          },
+        },
+      },
+      error:
+        'ERROR: \u003cinput\u003e:1:11: found no matching overload for \'split\' applied to \'string.(string, int, int)\'\n | "42".split("2", 1, 1) == ["4"]\n | ..........^',
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'split' applied to 'string.(string, int, int)'",
+          id: 2,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: split(string, string, int, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        expr: "'hello'.substring(1, 2, 3) == \"\"",
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "hello"^#*expr.Constant_StringValue#.substring(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello".substring(1, 2, 3) == ""',
+      locationAst:
+        '_==_(\n  "hello"^#1[1,0]#.substring(\n    1^#3[1,18]#,\n    2^#4[1,21]#,\n    3^#5[1,24]#\n  )^#2[1,17]#,\n  ""^#7[1,30]#\n)^#6[1,27]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 21, 22, 1, 21, 1, 22],
+        [5, 24, 25, 1, 24, 1, 25],
+        [6, 27, 29, 1, 27, 1, 29],
+        [7, 30, 32, 1, 30, 1, 32],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "hello" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 17,
+            "3": 18,
+            "4": 21,
+            "5": 24,
+            "6": 27,
+            "7": 30,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:18: found no matching overload for 'substring' applied to 'string.(int, int, int)'\n | 'hello'.substring(1, 2, 3) == \"\"\n | .................^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'substring' applied to 'string.(int, int, int)'",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: substring(string, int, int, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: '30.substring(true, 3) == ""',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  30^#*expr.Constant_Int64Value#.substring(\n    true^#*expr.Constant_BoolValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '30.substring(true, 3) == ""',
+      locationAst:
+        '_==_(\n  30^#1[1,0]#.substring(\n    true^#3[1,13]#,\n    3^#4[1,19]#\n  )^#2[1,12]#,\n  ""^#6[1,25]#\n)^#5[1,22]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 12, 13, 1, 12, 1, 13],
+        [3, 13, 17, 1, 13, 1, 17],
+        [4, 19, 20, 1, 19, 1, 20],
+        [5, 22, 24, 1, 22, 1, 24],
+        [6, 25, 27, 1, 25, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { int64Value: "30" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { boolValue: true } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 12, "3": 13, "4": 19, "5": 22, "6": 25 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:13: found no matching overload for 'substring' applied to 'int.(bool, int)'\n | 30.substring(true, 3) == \"\"\n | ............^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'substring' applied to 'int.(bool, int)'",
+          id: 2,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            { code: 2, message: "no such overload: substring(int, bool, int)" },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(true, 3) == ""',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    true^#*expr.Constant_BoolValue#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(true, 3) == ""',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    true^#3[1,20]#,\n    3^#4[1,26]#\n  )^#2[1,19]#,\n  ""^#6[1,32]#\n)^#5[1,29]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 24, 1, 20, 1, 24],
+        [4, 26, 27, 1, 26, 1, 27],
+        [5, 29, 31, 1, 29, 1, 31],
+        [6, 32, 34, 1, 32, 1, 34],
+      ],
+      lineOffsets: [35],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { boolValue: true } },
+                    { id: "4", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [35],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 26, "5": 29, "6": 32 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:20: found no matching overload for 'substring' applied to 'string.(bool, int)'\n | \"tacocat\".substring(true, 3) == \"\"\n | ...................^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'substring' applied to 'string.(bool, int)'",
+          id: 2,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: substring(string, bool, int)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        expr: '"tacocat".substring(0, false) == ""',
+        disableCheck: true,
+        evalError: { errors: [{ message: "no such overload" }] },
+      },
+      library: "strings",
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    0^#*expr.Constant_Int64Value#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(0, false) == ""',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    0^#3[1,20]#,\n    false^#4[1,23]#\n  )^#2[1,19]#,\n  ""^#6[1,33]#\n)^#5[1,30]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 28, 1, 23, 1, 28],
+        [5, 30, 32, 1, 30, 1, 32],
+        [6, 33, 35, 1, 33, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "0" } },
+                    { id: "4", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 30, "6": 33 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:20: found no matching overload for 'substring' applied to 'string.(int, bool)'\n | \"tacocat\".substring(0, false) == \"\"\n | ...................^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for 'substring' applied to 'string.(int, bool)'",
+          id: 2,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+      result: {
+        error: {
+          errors: [
+            {
+              code: 2,
+              message: "no such overload: substring(string, int, bool)",
+            },
+          ],
+        },
+      },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "version=0/chatAt-supported=true",
+        expr: "''.charAt(0) == ''",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  ""^#*expr.Constant_StringValue#.charAt(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"".charAt(0) == ""',
+      locationAst:
+        '_==_(\n  ""^#1[1,0]#.charAt(\n    0^#3[1,10]#\n  )^#2[1,9]#,\n  ""^#5[1,16]#\n)^#4[1,13]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 18, 1, 16, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/chatAt-supported=true",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  ""~string.charAt(\n    0~int\n  )~string^string_char_at_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_char_at_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "version=0/indexOf-supported=true",
+        expr: "'a'.indexOf('a') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.indexOf(\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".indexOf("a") == 0',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.indexOf(\n    "a"^#3[1,12]#\n  )^#2[1,11]#,\n  0^#5[1,20]#\n)^#4[1,17]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 15, 1, 12, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/indexOf-supported=true",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.indexOf(\n    "a"~string\n  )~int^string_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 20 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/lastIndexOf-supported=true",
+        expr: "'a'.lastIndexOf('a') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.lastIndexOf(\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".lastIndexOf("a") == 0',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.lastIndexOf(\n    "a"^#3[1,16]#\n  )^#2[1,15]#,\n  0^#5[1,24]#\n)^#4[1,21]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 19, 1, 16, 1, 19],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/lastIndexOf-supported=true",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 21, "5": 24 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.lastIndexOf(\n    "a"~string\n  )~int^string_last_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 21, "5": 24 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/join-supported=true",
+        expr: "['a', 'b'].join() == 'ab'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.join()^#*expr.Expr_CallExpr#,\n  "ab"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["a", "b"].join() == "ab"',
+      locationAst:
+        '_==_(\n  [\n    "a"^#2[1,1]#,\n    "b"^#3[1,6]#\n  ]^#1[1,0]#.join()^#4[1,15]#,\n  "ab"^#6[1,21]#\n)^#5[1,18]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 25, 1, 21, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                },
+              },
+              { id: "6", constExpr: { stringValue: "ab" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/join-supported=true",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "a"~string,\n    "b"~string\n  ]~list(string).join()~string^list_join,\n  "ab"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["list_join"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                },
+              },
+              { id: "6", constExpr: { stringValue: "ab" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=0/joinSep-supported=true",
+        expr: "['a', 'b'].join('-') == 'a-b'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.join(\n    "-"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "a-b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["a", "b"].join("-") == "a-b"',
+      locationAst:
+        '_==_(\n  [\n    "a"^#2[1,1]#,\n    "b"^#3[1,6]#\n  ]^#1[1,0]#.join(\n    "-"^#5[1,16]#\n  )^#4[1,15]#,\n  "a-b"^#7[1,24]#\n)^#6[1,21]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 19, 1, 16, 1, 19],
+        [6, 21, 23, 1, 21, 1, 23],
+        [7, 24, 29, 1, 24, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                  args: [{ id: "5", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "a-b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/joinSep-supported=true",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 15,
+            "5": 16,
+            "6": 21,
+            "7": 24,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "a"~string,\n    "b"~string\n  ]~list(string).join(\n    "-"~string\n  )~string^list_join_string,\n  "a-b"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["list_join_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 15,
+            "5": 16,
+            "6": 21,
+            "7": 24,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                  args: [{ id: "5", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "a-b" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=0/lowerAscii-supported=true",
+        expr: "'a'.lowerAscii() == 'a'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.lowerAscii()^#*expr.Expr_CallExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".lowerAscii() == "a"',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.lowerAscii()^#2[1,14]#,\n  "a"^#4[1,20]#\n)^#3[1,17]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 17, 19, 1, 17, 1, 19],
+        [4, 20, 23, 1, 20, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "a" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/lowerAscii-supported=true",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.lowerAscii()~string^string_lower_ascii,\n  "a"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_lower_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "a" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/replace-supported=true",
+        expr: "'hello hello'.replace('he', 'we') == 'wello wello'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "hello hello"^#*expr.Constant_StringValue#.replace(\n    "he"^#*expr.Constant_StringValue#,\n    "we"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "wello wello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello hello".replace("he", "we") == "wello wello"',
+      locationAst:
+        '_==_(\n  "hello hello"^#1[1,0]#.replace(\n    "he"^#3[1,22]#,\n    "we"^#4[1,28]#\n  )^#2[1,21]#,\n  "wello wello"^#6[1,37]#\n)^#5[1,34]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 26, 1, 22, 1, 26],
+        [4, 28, 32, 1, 28, 1, 32],
+        [5, 34, 36, 1, 34, 1, 36],
+        [6, 37, 50, 1, 37, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "he" } },
+                    { id: "4", constExpr: { stringValue: "we" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "wello wello" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/replace-supported=true",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 34, "6": 37 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello hello"~string.replace(\n    "he"~string,\n    "we"~string\n  )~string^string_replace_string_string,\n  "wello wello"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 34, "6": 37 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "he" } },
+                    { id: "4", constExpr: { stringValue: "we" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "wello wello" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "version=0/split-supported=true",
+        expr: "'hello hello hello'.split(' ') == ['hello', 'hello', 'hello']",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "hello hello hello"^#*expr.Constant_StringValue#.split(\n    " "^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "hello"^#*expr.Constant_StringValue#,\n    "hello"^#*expr.Constant_StringValue#,\n    "hello"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello hello hello".split(" ") == ["hello", "hello", "hello"]',
+      locationAst:
+        '_==_(\n  "hello hello hello"^#1[1,0]#.split(\n    " "^#3[1,26]#\n  )^#2[1,25]#,\n  [\n    "hello"^#6[1,35]#,\n    "hello"^#7[1,44]#,\n    "hello"^#8[1,53]#\n  ]^#5[1,34]#\n)^#4[1,31]#',
+      positions: [
+        [1, 0, 19, 1, 0, 1, 19],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 29, 1, 26, 1, 29],
+        [4, 31, 33, 1, 31, 1, 33],
+        [5, 34, 35, 1, 34, 1, 35],
+        [6, 35, 42, 1, 35, 1, 42],
+        [7, 44, 51, 1, 44, 1, 51],
+        [8, 53, 60, 1, 53, 1, 60],
+      ],
+      lineOffsets: [62],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello hello" },
+                  },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: " " } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "7", constExpr: { stringValue: "hello" } },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/split-supported=true",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 31,
+            "5": 34,
+            "6": 35,
+            "7": 44,
+            "8": 53,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello hello hello"~string.split(\n    " "~string\n  )~list(string)^string_split_string,\n  [\n    "hello"~string,\n    "hello"~string,\n    "hello"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_split_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "STRING" } } },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 31,
+            "5": 34,
+            "6": 35,
+            "7": 44,
+            "8": 53,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello hello" },
+                  },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: " " } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "7", constExpr: { stringValue: "hello" } },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=0/substring-supported=true",
+        expr: "'tacocat'.substring(4) == 'cat'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(4) == "cat"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    4^#3[1,20]#\n  )^#2[1,19]#,\n  "cat"^#5[1,26]#\n)^#4[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 26, 31, 1, 26, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/substring-supported=true",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    4~int\n  )~string^string_substring_int,\n  "cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/trim-supported=true",
+        expr: "'  \\ttrim\\n    '.trim() == 'trim'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "  \\ttrim\\n    "^#*expr.Constant_StringValue#.trim()^#*expr.Expr_CallExpr#,\n  "trim"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"  \\ttrim\\n    ".trim() == "trim"',
+      locationAst:
+        '_==_(\n  "  \\ttrim\\n    "^#1[1,0]#.trim()^#2[1,21]#,\n  "trim"^#4[1,27]#\n)^#3[1,24]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 24, 26, 1, 24, 1, 26],
+        [4, 27, 33, 1, 27, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "  \ttrim\n    " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "trim" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/trim-supported=true",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 21, "3": 24, "4": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "  \\ttrim\\n    "~string.trim()~string^string_trim,\n  "trim"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_trim"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 21, "3": 24, "4": 27 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "  \ttrim\n    " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "trim" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/upperAscii-supported=true",
+        expr: "'TacoCat'.upperAscii() == 'TACOCAT'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "TacoCat"^#*expr.Constant_StringValue#.upperAscii()^#*expr.Expr_CallExpr#,\n  "TACOCAT"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"TacoCat".upperAscii() == "TACOCAT"',
+      locationAst:
+        '_==_(\n  "TacoCat"^#1[1,0]#.upperAscii()^#2[1,20]#,\n  "TACOCAT"^#4[1,26]#\n)^#3[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 35, 1, 26, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCat" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCAT" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/upperAscii-supported=true",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "TacoCat"~string.upperAscii()~string^string_upper_ascii,\n  "TACOCAT"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_upper_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCat" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCAT" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=0/format-supported=false",
+        expr: "'a %d'.format([1]) == 'a 1'",
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "a %d"^#*expr.Constant_StringValue#.format(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  "a 1"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a %d".format([1]) == "a 1"',
+      locationAst:
+        '_==_(\n  "a %d"^#1[1,0]#.format(\n    [\n      1^#4[1,15]#\n    ]^#3[1,14]#\n  )^#2[1,13]#,\n  "a 1"^#6[1,22]#\n)^#5[1,19]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 27, 1, 22, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a %d" } },
+                  function: "format",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "a 1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/format-supported=false",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19, "6": 22 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: undeclared reference to 'format' (in container '')\n | 'a %d'.format([1]) == 'a 1'\n | .............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'format' (in container '')",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/quote-supported=false",
+        expr: 'strings.quote(\'\\a \\b "double quotes"\') == \'"\\\\a \\\\b \\\\"double quotes\\\\""\'',
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "\\a \\b \\"double quotes\\""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("\\a \\b \\"double quotes\\"") == "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "\\a \\b \\"double quotes\\""^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""^#5[1,42]#\n)^#4[1,39]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 37, 1, 14, 1, 37],
+        [4, 39, 41, 1, 39, 1, 41],
+        [5, 42, 73, 1, 42, 1, 73],
+      ],
+      lineOffsets: [74],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: '\u0007 \b "double quotes"' },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"\\a \\b \\"double quotes\\""' },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/quote-supported=false",
+          lineOffsets: [74],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 39, "5": 42 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'strings' (in container '')\n | strings.quote('\\a \\b \"double quotes\"') == '\"\\\\a \\\\b \\\\\"double quotes\\\\\"\"'\n | ^\nERROR: \u003cinput\u003e:1:14: undeclared reference to 'quote' (in container '')\n | strings.quote('\\a \\b \"double quotes\"') == '\"\\\\a \\\\b \\\\\"double quotes\\\\\"\"'\n | .............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'strings' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'quote' (in container '')",
+          id: 2,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=0/reverse-supported=false",
+        expr: "'taco'.reverse() == 'ocat'",
+      },
+      library: "strings",
+      libraryVersion: 0,
+      ast: '_==_(\n  "taco"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "ocat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"taco".reverse() == "ocat"',
+      locationAst:
+        '_==_(\n  "taco"^#1[1,0]#.reverse()^#2[1,14]#,\n  "ocat"^#4[1,20]#\n)^#3[1,17]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 17, 19, 1, 17, 1, 19],
+        [4, 20, 26, 1, 20, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "taco" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "ocat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=0/reverse-supported=false",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: undeclared reference to 'reverse' (in container '')\n | 'taco'.reverse() == 'ocat'\n | ..............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'reverse' (in container '')",
+          id: 2,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=1/chatAt-supported=true",
+        expr: "''.charAt(0) == ''",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  ""^#*expr.Constant_StringValue#.charAt(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"".charAt(0) == ""',
+      locationAst:
+        '_==_(\n  ""^#1[1,0]#.charAt(\n    0^#3[1,10]#\n  )^#2[1,9]#,\n  ""^#5[1,16]#\n)^#4[1,13]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 18, 1, 16, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/chatAt-supported=true",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  ""~string.charAt(\n    0~int\n  )~string^string_char_at_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_char_at_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "version=1/indexOf-supported=true",
+        expr: "'a'.indexOf('a') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.indexOf(\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".indexOf("a") == 0',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.indexOf(\n    "a"^#3[1,12]#\n  )^#2[1,11]#,\n  0^#5[1,20]#\n)^#4[1,17]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 15, 1, 12, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/indexOf-supported=true",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.indexOf(\n    "a"~string\n  )~int^string_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 20 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/lastIndexOf-supported=true",
+        expr: "'a'.lastIndexOf('a') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.lastIndexOf(\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".lastIndexOf("a") == 0',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.lastIndexOf(\n    "a"^#3[1,16]#\n  )^#2[1,15]#,\n  0^#5[1,24]#\n)^#4[1,21]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 19, 1, 16, 1, 19],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/lastIndexOf-supported=true",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 21, "5": 24 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.lastIndexOf(\n    "a"~string\n  )~int^string_last_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 21, "5": 24 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/join-supported=true",
+        expr: "['a', 'b'].join() == 'ab'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.join()^#*expr.Expr_CallExpr#,\n  "ab"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["a", "b"].join() == "ab"',
+      locationAst:
+        '_==_(\n  [\n    "a"^#2[1,1]#,\n    "b"^#3[1,6]#\n  ]^#1[1,0]#.join()^#4[1,15]#,\n  "ab"^#6[1,21]#\n)^#5[1,18]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 25, 1, 21, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                },
+              },
+              { id: "6", constExpr: { stringValue: "ab" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/join-supported=true",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "a"~string,\n    "b"~string\n  ]~list(string).join()~string^list_join,\n  "ab"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["list_join"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                },
+              },
+              { id: "6", constExpr: { stringValue: "ab" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=1/joinSep-supported=true",
+        expr: "['a', 'b'].join('-') == 'a-b'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.join(\n    "-"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "a-b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["a", "b"].join("-") == "a-b"',
+      locationAst:
+        '_==_(\n  [\n    "a"^#2[1,1]#,\n    "b"^#3[1,6]#\n  ]^#1[1,0]#.join(\n    "-"^#5[1,16]#\n  )^#4[1,15]#,\n  "a-b"^#7[1,24]#\n)^#6[1,21]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 19, 1, 16, 1, 19],
+        [6, 21, 23, 1, 21, 1, 23],
+        [7, 24, 29, 1, 24, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                  args: [{ id: "5", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "a-b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/joinSep-supported=true",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 15,
+            "5": 16,
+            "6": 21,
+            "7": 24,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "a"~string,\n    "b"~string\n  ]~list(string).join(\n    "-"~string\n  )~string^list_join_string,\n  "a-b"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["list_join_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 15,
+            "5": 16,
+            "6": 21,
+            "7": 24,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                  args: [{ id: "5", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "a-b" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=1/lowerAscii-supported=true",
+        expr: "'a'.lowerAscii() == 'a'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.lowerAscii()^#*expr.Expr_CallExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".lowerAscii() == "a"',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.lowerAscii()^#2[1,14]#,\n  "a"^#4[1,20]#\n)^#3[1,17]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 17, 19, 1, 17, 1, 19],
+        [4, 20, 23, 1, 20, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "a" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/lowerAscii-supported=true",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.lowerAscii()~string^string_lower_ascii,\n  "a"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_lower_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "a" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/replace-supported=true",
+        expr: "'hello hello'.replace('he', 'we') == 'wello wello'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "hello hello"^#*expr.Constant_StringValue#.replace(\n    "he"^#*expr.Constant_StringValue#,\n    "we"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "wello wello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello hello".replace("he", "we") == "wello wello"',
+      locationAst:
+        '_==_(\n  "hello hello"^#1[1,0]#.replace(\n    "he"^#3[1,22]#,\n    "we"^#4[1,28]#\n  )^#2[1,21]#,\n  "wello wello"^#6[1,37]#\n)^#5[1,34]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 26, 1, 22, 1, 26],
+        [4, 28, 32, 1, 28, 1, 32],
+        [5, 34, 36, 1, 34, 1, 36],
+        [6, 37, 50, 1, 37, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "he" } },
+                    { id: "4", constExpr: { stringValue: "we" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "wello wello" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/replace-supported=true",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 34, "6": 37 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello hello"~string.replace(\n    "he"~string,\n    "we"~string\n  )~string^string_replace_string_string,\n  "wello wello"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 34, "6": 37 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "he" } },
+                    { id: "4", constExpr: { stringValue: "we" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "wello wello" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "version=1/split-supported=true",
+        expr: "'hello hello hello'.split(' ') == ['hello', 'hello', 'hello']",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "hello hello hello"^#*expr.Constant_StringValue#.split(\n    " "^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "hello"^#*expr.Constant_StringValue#,\n    "hello"^#*expr.Constant_StringValue#,\n    "hello"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello hello hello".split(" ") == ["hello", "hello", "hello"]',
+      locationAst:
+        '_==_(\n  "hello hello hello"^#1[1,0]#.split(\n    " "^#3[1,26]#\n  )^#2[1,25]#,\n  [\n    "hello"^#6[1,35]#,\n    "hello"^#7[1,44]#,\n    "hello"^#8[1,53]#\n  ]^#5[1,34]#\n)^#4[1,31]#',
+      positions: [
+        [1, 0, 19, 1, 0, 1, 19],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 29, 1, 26, 1, 29],
+        [4, 31, 33, 1, 31, 1, 33],
+        [5, 34, 35, 1, 34, 1, 35],
+        [6, 35, 42, 1, 35, 1, 42],
+        [7, 44, 51, 1, 44, 1, 51],
+        [8, 53, 60, 1, 53, 1, 60],
+      ],
+      lineOffsets: [62],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello hello" },
+                  },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: " " } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "7", constExpr: { stringValue: "hello" } },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/split-supported=true",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 31,
+            "5": 34,
+            "6": 35,
+            "7": 44,
+            "8": 53,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello hello hello"~string.split(\n    " "~string\n  )~list(string)^string_split_string,\n  [\n    "hello"~string,\n    "hello"~string,\n    "hello"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_split_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "STRING" } } },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 31,
+            "5": 34,
+            "6": 35,
+            "7": 44,
+            "8": 53,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello hello" },
+                  },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: " " } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "7", constExpr: { stringValue: "hello" } },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=1/substring-supported=true",
+        expr: "'tacocat'.substring(4) == 'cat'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(4) == "cat"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    4^#3[1,20]#\n  )^#2[1,19]#,\n  "cat"^#5[1,26]#\n)^#4[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 26, 31, 1, 26, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/substring-supported=true",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    4~int\n  )~string^string_substring_int,\n  "cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/trim-supported=true",
+        expr: "'  \\ttrim\\n    '.trim() == 'trim'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "  \\ttrim\\n    "^#*expr.Constant_StringValue#.trim()^#*expr.Expr_CallExpr#,\n  "trim"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"  \\ttrim\\n    ".trim() == "trim"',
+      locationAst:
+        '_==_(\n  "  \\ttrim\\n    "^#1[1,0]#.trim()^#2[1,21]#,\n  "trim"^#4[1,27]#\n)^#3[1,24]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 24, 26, 1, 24, 1, 26],
+        [4, 27, 33, 1, 27, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "  \ttrim\n    " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "trim" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/trim-supported=true",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 21, "3": 24, "4": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "  \\ttrim\\n    "~string.trim()~string^string_trim,\n  "trim"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_trim"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 21, "3": 24, "4": 27 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "  \ttrim\n    " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "trim" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/upperAscii-supported=true",
+        expr: "'TacoCat'.upperAscii() == 'TACOCAT'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "TacoCat"^#*expr.Constant_StringValue#.upperAscii()^#*expr.Expr_CallExpr#,\n  "TACOCAT"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"TacoCat".upperAscii() == "TACOCAT"',
+      locationAst:
+        '_==_(\n  "TacoCat"^#1[1,0]#.upperAscii()^#2[1,20]#,\n  "TACOCAT"^#4[1,26]#\n)^#3[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 35, 1, 26, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCat" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCAT" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/upperAscii-supported=true",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "TacoCat"~string.upperAscii()~string^string_upper_ascii,\n  "TACOCAT"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_upper_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCat" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCAT" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=1/format-supported=true",
+        expr: "'a %d'.format([1]) == 'a 1'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "a %d"^#*expr.Constant_StringValue#.format(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  "a 1"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a %d".format([1]) == "a 1"',
+      locationAst:
+        '_==_(\n  "a %d"^#1[1,0]#.format(\n    [\n      1^#4[1,15]#\n    ]^#3[1,14]#\n  )^#2[1,13]#,\n  "a 1"^#6[1,22]#\n)^#5[1,19]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 27, 1, 22, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a %d" } },
+                  function: "format",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "a 1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/format-supported=true",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19, "6": 22 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a %d"~string.format(\n    [\n      1~int\n    ]~list(int)\n  )~string^string_format,\n  "a 1"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19, "6": 22 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a %d" } },
+                  function: "format",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "a 1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=1/quote-supported=true",
+        expr: 'strings.quote(\'\\a \\b "double quotes"\') == \'"\\\\a \\\\b \\\\"double quotes\\\\""\'',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "\\a \\b \\"double quotes\\""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("\\a \\b \\"double quotes\\"") == "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "\\a \\b \\"double quotes\\""^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""^#5[1,42]#\n)^#4[1,39]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 37, 1, 14, 1, 37],
+        [4, 39, 41, 1, 39, 1, 41],
+        [5, 42, 73, 1, 42, 1, 73],
+      ],
+      lineOffsets: [74],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: '\u0007 \b "double quotes"' },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"\\a \\b \\"double quotes\\""' },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/quote-supported=true",
+          lineOffsets: [74],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 39, "5": 42 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "\\a \\b \\"double quotes\\""~string\n  )~string^strings_quote,\n  "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [74],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 39, "5": 42 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: '\u0007 \b "double quotes"' },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"\\a \\b \\"double quotes\\""' },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        name: "version=1/reverse-supported=false",
+        expr: "'taco'.reverse() == 'ocat'",
+      },
+      library: "strings",
+      libraryVersion: 1,
+      ast: '_==_(\n  "taco"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "ocat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"taco".reverse() == "ocat"',
+      locationAst:
+        '_==_(\n  "taco"^#1[1,0]#.reverse()^#2[1,14]#,\n  "ocat"^#4[1,20]#\n)^#3[1,17]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 17, 19, 1, 17, 1, 19],
+        [4, 20, 26, 1, 20, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "taco" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "ocat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=1/reverse-supported=false",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:15: undeclared reference to 'reverse' (in container '')\n | 'taco'.reverse() == 'ocat'\n | ..............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'reverse' (in container '')",
+          id: 2,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedError: "undeclared reference",
+    },
+    {
+      original: {
+        name: "version=3/chatAt-supported=true",
+        expr: "''.charAt(0) == ''",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  ""^#*expr.Constant_StringValue#.charAt(\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  ""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"".charAt(0) == ""',
+      locationAst:
+        '_==_(\n  ""^#1[1,0]#.charAt(\n    0^#3[1,10]#\n  )^#2[1,9]#,\n  ""^#5[1,16]#\n)^#4[1,13]#',
+      positions: [
+        [1, 0, 2, 1, 0, 1, 2],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 11, 1, 10, 1, 11],
+        [4, 13, 15, 1, 13, 1, 15],
+        [5, 16, 18, 1, 16, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/chatAt-supported=true",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  ""~string.charAt(\n    0~int\n  )~string^string_char_at_int,\n  ""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_char_at_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 13, "5": 16 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "" } },
+                  function: "charAt",
+                  args: [{ id: "3", constExpr: { int64Value: "0" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "1",
+    },
+    {
+      original: {
+        name: "version=3/indexOf-supported=true",
+        expr: "'a'.indexOf('a') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.indexOf(\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".indexOf("a") == 0',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.indexOf(\n    "a"^#3[1,12]#\n  )^#2[1,11]#,\n  0^#5[1,20]#\n)^#4[1,17]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 12, 15, 1, 12, 1, 15],
+        [4, 17, 19, 1, 17, 1, 19],
+        [5, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/indexOf-supported=true",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 20 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.indexOf(\n    "a"~string\n  )~int^string_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [22],
+          positions: { "1": 0, "2": 11, "3": 12, "4": 17, "5": 20 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "indexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=3/lastIndexOf-supported=true",
+        expr: "'a'.lastIndexOf('a') == 0",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.lastIndexOf(\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".lastIndexOf("a") == 0',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.lastIndexOf(\n    "a"^#3[1,16]#\n  )^#2[1,15]#,\n  0^#5[1,24]#\n)^#4[1,21]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 15, 16, 1, 15, 1, 16],
+        [3, 16, 19, 1, 16, 1, 19],
+        [4, 21, 23, 1, 21, 1, 23],
+        [5, 24, 25, 1, 24, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/lastIndexOf-supported=true",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 21, "5": 24 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.lastIndexOf(\n    "a"~string\n  )~int^string_last_index_of_string,\n  0~int\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_last_index_of_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 15, "3": 16, "4": 21, "5": 24 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lastIndexOf",
+                  args: [{ id: "3", constExpr: { stringValue: "a" } }],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=3/join-supported=true",
+        expr: "['a', 'b'].join() == 'ab'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.join()^#*expr.Expr_CallExpr#,\n  "ab"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["a", "b"].join() == "ab"',
+      locationAst:
+        '_==_(\n  [\n    "a"^#2[1,1]#,\n    "b"^#3[1,6]#\n  ]^#1[1,0]#.join()^#4[1,15]#,\n  "ab"^#6[1,21]#\n)^#5[1,18]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 18, 20, 1, 18, 1, 20],
+        [6, 21, 25, 1, 21, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                },
+              },
+              { id: "6", constExpr: { stringValue: "ab" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/join-supported=true",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 15, "5": 18, "6": 21 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "a"~string,\n    "b"~string\n  ]~list(string).join()~string^list_join,\n  "ab"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["list_join"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 15, "5": 18, "6": 21 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                },
+              },
+              { id: "6", constExpr: { stringValue: "ab" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=3/joinSep-supported=true",
+        expr: "['a', 'b'].join('-') == 'a-b'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  [\n    "a"^#*expr.Constant_StringValue#,\n    "b"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#.join(\n    "-"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "a-b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '["a", "b"].join("-") == "a-b"',
+      locationAst:
+        '_==_(\n  [\n    "a"^#2[1,1]#,\n    "b"^#3[1,6]#\n  ]^#1[1,0]#.join(\n    "-"^#5[1,16]#\n  )^#4[1,15]#,\n  "a-b"^#7[1,24]#\n)^#6[1,21]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 16, 19, 1, 16, 1, 19],
+        [6, 21, 23, 1, 21, 1, 23],
+        [7, 24, 29, 1, 24, 1, 29],
+      ],
+      lineOffsets: [30],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                  args: [{ id: "5", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "a-b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/joinSep-supported=true",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 15,
+            "5": 16,
+            "6": 21,
+            "7": 24,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  [\n    "a"~string,\n    "b"~string\n  ]~list(string).join(\n    "-"~string\n  )~string^list_join_string,\n  "a-b"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "4": { overloadId: ["list_join_string"] },
+          "6": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "STRING" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [30],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 6,
+            "4": 15,
+            "5": 16,
+            "6": 21,
+            "7": 24,
+          },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    listExpr: {
+                      elements: [
+                        { id: "2", constExpr: { stringValue: "a" } },
+                        { id: "3", constExpr: { stringValue: "b" } },
+                      ],
+                    },
+                  },
+                  function: "join",
+                  args: [{ id: "5", constExpr: { stringValue: "-" } }],
+                },
+              },
+              { id: "7", constExpr: { stringValue: "a-b" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=3/lowerAscii-supported=true",
+        expr: "'a'.lowerAscii() == 'a'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "a"^#*expr.Constant_StringValue#.lowerAscii()^#*expr.Expr_CallExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a".lowerAscii() == "a"',
+      locationAst:
+        '_==_(\n  "a"^#1[1,0]#.lowerAscii()^#2[1,14]#,\n  "a"^#4[1,20]#\n)^#3[1,17]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 17, 19, 1, 17, 1, 19],
+        [4, 20, 23, 1, 20, 1, 23],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "a" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/lowerAscii-supported=true",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a"~string.lowerAscii()~string^string_lower_ascii,\n  "a"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_lower_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a" } },
+                  function: "lowerAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "a" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=3/replace-supported=true",
+        expr: "'hello hello'.replace('he', 'we') == 'wello wello'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "hello hello"^#*expr.Constant_StringValue#.replace(\n    "he"^#*expr.Constant_StringValue#,\n    "we"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "wello wello"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello hello".replace("he", "we") == "wello wello"',
+      locationAst:
+        '_==_(\n  "hello hello"^#1[1,0]#.replace(\n    "he"^#3[1,22]#,\n    "we"^#4[1,28]#\n  )^#2[1,21]#,\n  "wello wello"^#6[1,37]#\n)^#5[1,34]#',
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 22, 26, 1, 22, 1, 26],
+        [4, 28, 32, 1, 28, 1, 32],
+        [5, 34, 36, 1, 34, 1, 36],
+        [6, 37, 50, 1, 37, 1, 50],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "he" } },
+                    { id: "4", constExpr: { stringValue: "we" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "wello wello" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/replace-supported=true",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 34, "6": 37 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello hello"~string.replace(\n    "he"~string,\n    "we"~string\n  )~string^string_replace_string_string,\n  "wello wello"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_replace_string_string"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: { "1": 0, "2": 21, "3": 22, "4": 28, "5": 34, "6": 37 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello" },
+                  },
+                  function: "replace",
+                  args: [
+                    { id: "3", constExpr: { stringValue: "he" } },
+                    { id: "4", constExpr: { stringValue: "we" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "wello wello" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "3" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "3",
+    },
+    {
+      original: {
+        name: "version=3/split-supported=true",
+        expr: "'hello hello hello'.split(' ') == ['hello', 'hello', 'hello']",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "hello hello hello"^#*expr.Constant_StringValue#.split(\n    " "^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  [\n    "hello"^#*expr.Constant_StringValue#,\n    "hello"^#*expr.Constant_StringValue#,\n    "hello"^#*expr.Constant_StringValue#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"hello hello hello".split(" ") == ["hello", "hello", "hello"]',
+      locationAst:
+        '_==_(\n  "hello hello hello"^#1[1,0]#.split(\n    " "^#3[1,26]#\n  )^#2[1,25]#,\n  [\n    "hello"^#6[1,35]#,\n    "hello"^#7[1,44]#,\n    "hello"^#8[1,53]#\n  ]^#5[1,34]#\n)^#4[1,31]#',
+      positions: [
+        [1, 0, 19, 1, 0, 1, 19],
+        [2, 25, 26, 1, 25, 1, 26],
+        [3, 26, 29, 1, 26, 1, 29],
+        [4, 31, 33, 1, 31, 1, 33],
+        [5, 34, 35, 1, 34, 1, 35],
+        [6, 35, 42, 1, 35, 1, 42],
+        [7, 44, 51, 1, 44, 1, 51],
+        [8, 53, 60, 1, 53, 1, 60],
+      ],
+      lineOffsets: [62],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello hello" },
+                  },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: " " } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "7", constExpr: { stringValue: "hello" } },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/split-supported=true",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 31,
+            "5": 34,
+            "6": 35,
+            "7": 44,
+            "8": 53,
+          },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "hello hello hello"~string.split(\n    " "~string\n  )~list(string)^string_split_string,\n  [\n    "hello"~string,\n    "hello"~string,\n    "hello"~string\n  ]~list(string)\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_split_string"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { listType: { elemType: { primitive: "STRING" } } },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { listType: { elemType: { primitive: "STRING" } } },
+          "6": { primitive: "STRING" },
+          "7": { primitive: "STRING" },
+          "8": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [62],
+          positions: {
+            "1": 0,
+            "2": 25,
+            "3": 26,
+            "4": 31,
+            "5": 34,
+            "6": 35,
+            "7": 44,
+            "8": 53,
+          },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "hello hello hello" },
+                  },
+                  function: "split",
+                  args: [{ id: "3", constExpr: { stringValue: " " } }],
+                },
+              },
+              {
+                id: "5",
+                listExpr: {
+                  elements: [
+                    { id: "6", constExpr: { stringValue: "hello" } },
+                    { id: "7", constExpr: { stringValue: "hello" } },
+                    { id: "8", constExpr: { stringValue: "hello" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=3/substring-supported=true",
+        expr: "'tacocat'.substring(4) == 'cat'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "tacocat"^#*expr.Constant_StringValue#.substring(\n    4^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "cat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"tacocat".substring(4) == "cat"',
+      locationAst:
+        '_==_(\n  "tacocat"^#1[1,0]#.substring(\n    4^#3[1,20]#\n  )^#2[1,19]#,\n  "cat"^#5[1,26]#\n)^#4[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 19, 20, 1, 19, 1, 20],
+        [3, 20, 21, 1, 20, 1, 21],
+        [4, 23, 25, 1, 23, 1, 25],
+        [5, 26, 31, 1, 26, 1, 31],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/substring-supported=true",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "tacocat"~string.substring(\n    4~int\n  )~string^string_substring_int,\n  "cat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_substring_int"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: { "1": 0, "2": 19, "3": 20, "4": 23, "5": 26 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "tacocat" } },
+                  function: "substring",
+                  args: [{ id: "3", constExpr: { int64Value: "4" } }],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "cat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=3/trim-supported=true",
+        expr: "'  \\ttrim\\n    '.trim() == 'trim'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "  \\ttrim\\n    "^#*expr.Constant_StringValue#.trim()^#*expr.Expr_CallExpr#,\n  "trim"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"  \\ttrim\\n    ".trim() == "trim"',
+      locationAst:
+        '_==_(\n  "  \\ttrim\\n    "^#1[1,0]#.trim()^#2[1,21]#,\n  "trim"^#4[1,27]#\n)^#3[1,24]#',
+      positions: [
+        [1, 0, 16, 1, 0, 1, 16],
+        [2, 21, 22, 1, 21, 1, 22],
+        [3, 24, 26, 1, 24, 1, 26],
+        [4, 27, 33, 1, 27, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "  \ttrim\n    " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "trim" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/trim-supported=true",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 21, "3": 24, "4": 27 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "  \\ttrim\\n    "~string.trim()~string^string_trim,\n  "trim"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_trim"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: { "1": 0, "2": 21, "3": 24, "4": 27 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: {
+                    id: "1",
+                    constExpr: { stringValue: "  \ttrim\n    " },
+                  },
+                  function: "trim",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "trim" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=3/upperAscii-supported=true",
+        expr: "'TacoCat'.upperAscii() == 'TACOCAT'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "TacoCat"^#*expr.Constant_StringValue#.upperAscii()^#*expr.Expr_CallExpr#,\n  "TACOCAT"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"TacoCat".upperAscii() == "TACOCAT"',
+      locationAst:
+        '_==_(\n  "TacoCat"^#1[1,0]#.upperAscii()^#2[1,20]#,\n  "TACOCAT"^#4[1,26]#\n)^#3[1,23]#',
+      positions: [
+        [1, 0, 9, 1, 0, 1, 9],
+        [2, 20, 21, 1, 20, 1, 21],
+        [3, 23, 25, 1, 23, 1, 25],
+        [4, 26, 35, 1, 26, 1, 35],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCat" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCAT" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/upperAscii-supported=true",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "TacoCat"~string.upperAscii()~string^string_upper_ascii,\n  "TACOCAT"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_upper_ascii"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [36],
+          positions: { "1": 0, "2": 20, "3": 23, "4": 26 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "TacoCat" } },
+                  function: "upperAscii",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "TACOCAT" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+    {
+      original: {
+        name: "version=3/format-supported=true",
+        expr: "'a %d'.format([1]) == 'a 1'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "a %d"^#*expr.Constant_StringValue#.format(\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  "a 1"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"a %d".format([1]) == "a 1"',
+      locationAst:
+        '_==_(\n  "a %d"^#1[1,0]#.format(\n    [\n      1^#4[1,15]#\n    ]^#3[1,14]#\n  )^#2[1,13]#,\n  "a 1"^#6[1,22]#\n)^#5[1,19]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 15, 1, 14, 1, 15],
+        [4, 15, 16, 1, 15, 1, 16],
+        [5, 19, 21, 1, 19, 1, 21],
+        [6, 22, 27, 1, 22, 1, 27],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a %d" } },
+                  function: "format",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "a 1" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/format-supported=true",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19, "6": 22 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "a %d"~string.format(\n    [\n      1~int\n    ]~list(int)\n  )~string^string_format,\n  "a 1"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_format"] },
+          "5": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { listType: { elemType: { primitive: "INT64" } } },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [28],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 15, "5": 19, "6": 22 },
+        },
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "a %d" } },
+                  function: "format",
+                  args: [
+                    {
+                      id: "3",
+                      listExpr: {
+                        elements: [{ id: "4", constExpr: { int64Value: "1" } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { stringValue: "a 1" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "12", max: "12" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "12",
+    },
+    {
+      original: {
+        name: "version=3/quote-supported=true",
+        expr: 'strings.quote(\'\\a \\b "double quotes"\') == \'"\\\\a \\\\b \\\\"double quotes\\\\""\'',
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  strings^#*expr.Expr_IdentExpr#.quote(\n    "\\a \\b \\"double quotes\\""^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#,\n  "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'strings.quote("\\a \\b \\"double quotes\\"") == "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""',
+      locationAst:
+        '_==_(\n  strings^#1[1,0]#.quote(\n    "\\a \\b \\"double quotes\\""^#3[1,14]#\n  )^#2[1,13]#,\n  "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""^#5[1,42]#\n)^#4[1,39]#',
+      positions: [
+        [1, 0, 7, 1, 0, 1, 7],
+        [2, 13, 14, 1, 13, 1, 14],
+        [3, 14, 37, 1, 14, 1, 37],
+        [4, 39, 41, 1, 39, 1, 41],
+        [5, 42, 73, 1, 42, 1, 73],
+      ],
+      lineOffsets: [74],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", identExpr: { name: "strings" } },
+                  function: "quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: '\u0007 \b "double quotes"' },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"\\a \\b \\"double quotes\\""' },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/quote-supported=true",
+          lineOffsets: [74],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 39, "5": 42 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  strings.quote(\n    "\\a \\b \\"double quotes\\""~string\n  )~string^strings_quote,\n  "\\"\\\\a \\\\b \\\\\\"double quotes\\\\\\"\\""~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["strings_quote"] },
+          "4": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [74],
+          positions: { "1": 0, "2": 13, "3": 14, "4": 39, "5": 42 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "strings.quote",
+                  args: [
+                    {
+                      id: "3",
+                      constExpr: { stringValue: '\u0007 \b "double quotes"' },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "5",
+                constExpr: { stringValue: '"\\a \\b \\"double quotes\\""' },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "3", max: "5" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "5",
+    },
+    {
+      original: {
+        name: "version=3/reverse-supported=true",
+        expr: "'taco'.reverse() == 'ocat'",
+        value: { boolValue: true },
+      },
+      library: "strings",
+      libraryVersion: 3,
+      ast: '_==_(\n  "taco"^#*expr.Constant_StringValue#.reverse()^#*expr.Expr_CallExpr#,\n  "ocat"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: '"taco".reverse() == "ocat"',
+      locationAst:
+        '_==_(\n  "taco"^#1[1,0]#.reverse()^#2[1,14]#,\n  "ocat"^#4[1,20]#\n)^#3[1,17]#',
+      positions: [
+        [1, 0, 6, 1, 0, 1, 6],
+        [2, 14, 15, 1, 14, 1, 15],
+        [3, 17, 19, 1, 17, 1, 19],
+        [4, 20, 26, 1, 20, 1, 26],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "taco" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "ocat" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "version=3/reverse-supported=true",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+      },
+      checkedAst:
+        '_==_(\n  "taco"~string.reverse()~string^string_reverse,\n  "ocat"~string\n)~bool^equals',
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["string_reverse"] },
+          "3": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { primitive: "STRING" },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: { "1": 0, "2": 14, "3": 17, "4": 20 },
+        },
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  target: { id: "1", constExpr: { stringValue: "taco" } },
+                  function: "reverse",
+                },
+              },
+              { id: "4", constExpr: { stringValue: "ocat" } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "2", max: "2" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "2",
+    },
+  ],
+} as const;
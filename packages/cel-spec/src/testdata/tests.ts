@@ -12,15 +12,43 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import { fromJson, type JsonObject } from "@bufbuild/protobuf";
+import { fromJson, type JsonObject, type Registry } from "@bufbuild/protobuf";
 import {
   SimpleTestSchema,
   type SimpleTest,
 } from "../gen/cel/expr/conformance/test/simple_pb.js";
+import { ExprValueSchema, type ExprValue } from "../gen/cel/expr/eval_pb.js";
+import {
+  ExprSchema,
+  ParsedExprSchema,
+  type Expr,
+  type ParsedExpr,
+} from "../gen/cel/expr/syntax_pb.js";
+import {
+  CheckedExprSchema,
+  type CheckedExpr,
+} from "../gen/cel/expr/checked_pb.js";
 import { tests as conformance } from "./conformance.js";
 import { tests as comprehension } from "./comprehension.js";
 import { tests as parsing } from "./parsing.js";
 import { tests as checking } from "./checking.js";
+import { tests as strings } from "./strings.js";
+import { tests as math } from "./math.js";
+import { tests as lists } from "./lists.js";
+import { tests as sets } from "./sets.js";
+import { tests as regex } from "./regex.js";
+import { tests as encoders } from "./encoders.js";
+import { tests as protos } from "./protos.js";
+import { tests as bindings } from "./bindings.js";
+import { tests as format } from "./format.js";
+import { tests as interpreter } from "./interpreter.js";
+import { tests as prune } from "./prune.js";
+import { tests as unknowns } from "./unknowns.js";
+import { tests as cost } from "./cost.js";
+import { tests as runtimecost } from "./runtimecost.js";
+import { tests as folding } from "./folding.js";
+import { tests as inlining } from "./inlining.js";
+import { tests as unparsing } from "./unparsing.js";
 import { getTestRegistry } from "./registry.js";
 
 const registry = getTestRegistry();
@@ -29,13 +57,213 @@ let conformanceSuite: IncrementalTestSuite;
 let comprehensionSuite: IncrementalTestSuite;
 let parsingSuite: IncrementalTestSuite;
 let checkingSuite: IncrementalTestSuite;
+let stringsSuite: IncrementalTestSuite;
+let mathSuite: IncrementalTestSuite;
+let listsSuite: IncrementalTestSuite;
+let setsSuite: IncrementalTestSuite;
+let regexSuite: IncrementalTestSuite;
+let encodersSuite: IncrementalTestSuite;
+const protosSuites = new WeakMap<Registry, IncrementalTestSuite>();
+let bindingsSuite: IncrementalTestSuite;
+let formatSuite: IncrementalTestSuite;
+const interpreterSuites = new WeakMap<Registry, IncrementalTestSuite>();
+const pruneSuites = new WeakMap<Registry, IncrementalTestSuite>();
+let unknownsSuite: IncrementalTestSuite;
+let costSuite: IncrementalTestSuite;
+const runtimecostSuites = new WeakMap<Registry, IncrementalTestSuite>();
+const foldingSuites = new WeakMap<Registry, IncrementalTestSuite>();
+let inliningSuite: IncrementalTestSuite;
+let unparsingSuite: IncrementalTestSuite;
 
 export interface SerializedIncrementalTest {
   original: JsonObject & { name?: string; expr: string };
+  section?: string;
+  variadicAsts?: boolean;
+  optionalSyntax?: boolean;
+  crossTypeNumericComparisons?: boolean;
+  disableStdEnv?: boolean;
+  library?: string;
+  libraryVersion?: number;
+  locale?: string;
+  unknowns?: AttributePattern[];
+  sizeHints?: Record<string, number>;
+  presenceTestHasCost?: boolean;
+  costLimit?: string;
+  foldKnownValues?: boolean;
+  maxFoldIterations?: number;
+  inlineVariables?: InlineVariable[];
+  wrapOnColumn?: number;
+  wrapOnOperators?: string[];
+  wrapAfterColumnLimit?: boolean;
   ast?: string;
+  unparsed?: string;
+  unparseError?: string;
+  locationAst?: string;
+  positions?: SerializedSourcePosition[];
+  lineOffsets?: number[];
+  parsedExpr?: JsonObject;
+  macroCalls?: SerializedMacroCall[];
   checkedAst?: string;
+  checkedExpr?: JsonObject;
   type?: string;
+  cost?: SerializedCostEstimate;
   error?: string;
+  errors?: CompileError[];
+  result?: JsonObject;
+  runtimeCost?: string;
+  unknownAttributes?: UnknownAttribute[];
+  residualAst?: string;
+  residual?: string;
+  inlinedAst?: string;
+  inlined?: string;
+  foldedAst?: string;
+  folded?: string;
+  foldError?: string;
+  referenceStatus?: ReferenceStatus;
+  expectedAst?: string;
+  expectedUnparsed?: string;
+  expectedLocationAst?: string;
+  expectedMacroCalls?: string;
+  expectedCheckedAst?: string;
+  expectedType?: string;
+  expectedCost?: SerializedCostEstimate;
+  expectedRuntimeCost?: string;
+  expectedError?: string;
+  expectedResidual?: string;
+  expectedInlined?: string;
+  expectedFolded?: string;
+}
+
+interface SerializedCostEstimate {
+  min: string;
+  max: string;
+}
+
+/**
+ * A `SourcePosition` as `[id, start, stop, startLine, startColumn, stopLine,
+ * stopColumn]`.
+ */
+type SerializedSourcePosition = [
+  number,
+  number,
+  number,
+  number,
+  number,
+  number,
+  number,
+];
+
+interface SerializedMacroCall {
+  id: number;
+  function: string;
+  target?: JsonObject;
+  args?: JsonObject[];
+}
+
+export type ReferenceStatus = "agrees" | "disagrees" | "cel-go-error";
+
+export type ErrorCategory =
+  | "syntax"
+  | "undeclared-reference"
+  | "no-matching-overload"
+  | "type-mismatch"
+  | "undefined-field"
+  | "other";
+
+/**
+ * An error that `cel-go`'s parser or checker reports, with the ID of the
+ * expression it is reported for, or 0, and the code point offset, 1-based line
+ * and 0-based column it is reported at, unless it has no location. Every parser
+ * error, including the errors of macros, is a syntax error; the category of a
+ * checker error is normalized from its message.
+ */
+export interface CompileError {
+  category: ErrorCategory;
+  message: string;
+  id: number;
+  offset?: number;
+  line?: number;
+  column?: number;
+}
+
+/**
+ * An `AttributePattern` matches the attributes of a variable, such as `a.b[0]`,
+ * whose qualifiers start with the given ones.
+ */
+export interface AttributePattern {
+  variable: string;
+  qualifiers?: AttributeQualifier[];
+}
+
+/**
+ * A map key, field name or index of an `AttributePattern`, or a wildcard that
+ * matches any qualifier. Exactly one property is set.
+ */
+export interface AttributeQualifier {
+  string?: string;
+  int?: number;
+  uint?: number;
+  bool?: boolean;
+  wildcard?: boolean;
+}
+
+/**
+ * An attribute that evaluation found to be unknown, as a variable and the
+ * qualifiers of its trail, along with the ID of the expression that resolved
+ * it.
+ */
+export interface UnknownAttribute {
+  id: number;
+  variable: string;
+  qualifiers?: AttributeQualifier[];
+}
+
+/**
+ * The source range of an expression ID, as the code point offsets at which it
+ * starts and ends, exclusive, along with the 1-based lines and 0-based columns
+ * of both.
+ */
+export interface SourcePosition {
+  id: number;
+  start: number;
+  stop: number;
+  startLine: number;
+  startColumn: number;
+  stopLine: number;
+  stopColumn: number;
+}
+
+/**
+ * A call that a macro expanded, by the ID of the expression it expanded to. An
+ * argument that is itself a macro call is an expression without a kind, with
+ * the ID of its expansion, as recorded by `cel-go`'s parser.
+ */
+export interface MacroCall {
+  id: number;
+  function: string;
+  target?: Expr;
+  args: Expr[];
+}
+
+/**
+ * A variable, or a field selection such as `a.b`, that `cel-go`'s inlining
+ * optimizer replaces with the expression `expr`. If the variable is used more
+ * than once and has an alias, the expression is bound to the alias with
+ * `cel.bind` instead.
+ */
+export interface InlineVariable {
+  name: string;
+  alias?: string;
+  expr: string;
+}
+
+/**
+ * The range of the cost of evaluating an expression, as estimated by `cel-go`'s
+ * checker. A maximum of 2^64-1 is unbounded.
+ */
+export interface CostEstimate {
+  min: bigint;
+  max: bigint;
 }
 
 export interface SerializedIncrementalTestSuite {
@@ -54,7 +282,8 @@ export interface IncrementalTest {
    * The original test as a `cel.expr.conformance.test.SimpleTest` message
    * https://buf.build/google/cel-spec/docs/main:cel.expr.conformance.test#cel.expr.conformance.test.SimpleTest
    * For conformance tests, every field may be used; for tests extracted from
-   * the `cel-go` source code, only `expr` is present.
+   * the `cel-go` source code, `expr` is present, along with the name, type
+   * environment and expected result where the upstream test declares them.
    */
   original: SimpleTest;
   /**
@@ -62,27 +291,331 @@ export interface IncrementalTest {
    * input expression.
    */
   name: string;
+  /**
+   * The upstream `cel-go` test function the test was extracted from, where a
+   * source file holds several, e.g. `TestMathRuntimeErrors`.
+   */
+  section?: string;
+  /**
+   * Whether the test is parsed with variadic operator ASTs, where chained
+   * logical operators are flattened into a single call. Only set for tests
+   * extracted from `cel-go`'s checker tests that declare it, and for its
+   * interpreter tests, which always enable it.
+   */
+  variadicAsts?: boolean;
+  /**
+   * Whether the test is parsed with optional syntax (`.?`, `[?`, and `?` in
+   * aggregate literals) enabled. Only set for tests extracted from `cel-go`'s
+   * checker tests that declare it, and for its interpreter and comprehension
+   * tests, which always enable it. Conformance tests are always parsed with
+   * it, as well as with the `cel.block` macros, like `cel-go`'s conformance
+   * runner does.
+   */
+  optionalSyntax?: boolean;
+  /**
+   * Whether the test is checked with cross-type numeric comparisons, which
+   * allow ordering values of different numeric types, such as `1 < 1.5`. Only
+   * set for tests extracted from `cel-go`'s checker tests, which enable them
+   * unless the test case disables them.
+   */
+  crossTypeNumericComparisons?: boolean;
+  /**
+   * Whether the test is checked without the standard library. Only set for
+   * tests extracted from `cel-go`'s checker tests that declare it.
+   */
+  disableStdEnv?: boolean;
+  /**
+   * The `cel-go` extension library exercised by the test, e.g. `strings`. Only
+   * set for tests extracted from `cel-go`'s extension tests.
+   */
+  library?: string;
+  /**
+   * The version of `library` the test is run against, as declared by the
+   * upstream test case, or else the earliest version that introduces every
+   * function the test calls. If absent, the latest version is used.
+   */
+  libraryVersion?: number;
+  /**
+   * The locale `library` is configured with, e.g. `fr_FR` for `string.format`
+   * tests. If absent, the library's default locale is used.
+   */
+  locale?: string;
+  /**
+   * The attributes that are unknown when the test is partially evaluated. Only
+   * set for tests extracted from `cel-go`'s prune tests, which are partially
+   * evaluated even without unknowns, and for the unknown propagation tests.
+   */
+  unknowns?: AttributePattern[];
+  /**
+   * The maximum sizes of variables, or of their elements, by path, e.g.
+   * `input.@items` for the elements of the list `input`, that `cost` is
+   * estimated with. Sizes without a hint are unbounded. Only set for tests
+   * extracted from `cel-go`'s cost tests, which also estimate every bytes
+   * value to be at most 12 bytes, and the cost of `getFullYear()` to be 7.
+   */
+  sizeHints?: Record<string, number>;
+  /**
+   * Whether presence tests with `has()` are charged, if not the default,
+   * for the cost estimate and the runtime cost.
+   */
+  presenceTestHasCost?: boolean;
+  /**
+   * The runtime cost limit the test is evaluated with, if any. Evaluation
+   * fails once the runtime cost exceeds it.
+   */
+  costLimit?: bigint;
+  /**
+   * Whether the bindings of the original test are known values that constant
+   * folding replaces variables with. Only set for tests extracted from
+   * `cel-go`'s constant folding tests.
+   */
+  foldKnownValues?: boolean;
+  /**
+   * The maximum number of constant folding passes, if not the default.
+   */
+  maxFoldIterations?: number;
+  /**
+   * The variables that are inlined into the checked AST before it is folded.
+   * Only set for tests extracted from `cel-go`'s inlining tests.
+   */
+  inlineVariables?: InlineVariable[];
+  /**
+   * The column after which `unparsed` wraps at the next operator of
+   * `wrapOnOperators`, if not the default of 80. Only set for tests extracted
+   * from `cel-go`'s unparser tests, like the other unparser options.
+   */
+  wrapOnColumn?: number;
+  /**
+   * The operators, as function names such as `_&&_`, that `unparsed` wraps
+   * at, if not the default of `_&&_` and `_||_`.
+   */
+  wrapOnOperators?: string[];
+  /**
+   * Whether `unparsed` wraps after the operator, which is the default, rather
+   * than before it.
+   */
+  wrapAfterColumnLimit?: boolean;
   /**
    * The AST as produced by the `ToDebugString()` function provided by `cel-go`:
    * https://pkg.go.dev/github.com/google/cel-go/common/debug#ToDebugString
    */
   ast?: string;
+  /**
+   * The parsed AST unparsed to an expression by `cel-go`'s `Unparse()`, with
+   * the unparser options of the test, if any:
+   * https://pkg.go.dev/github.com/google/cel-go/parser#Unparse
+   * Macros are unparsed as the calls they expand.
+   */
+  unparsed?: string;
+  /**
+   * The error, if any, of unparsing the parsed AST, such as an invalid
+   * unparser option, in which case `unparsed` is absent.
+   */
+  unparseError?: string;
+  /**
+   * The AST adorned with the ID of each expression and the line and column at
+   * which it starts, e.g. `x^#1[1,0]#`, like `expectedLocationAst`.
+   */
+  locationAst?: string;
+  /**
+   * The source ranges of the expression IDs of the parsed AST, ordered by ID,
+   * as recorded by `cel-go`'s parser. Calls, selections and map entries are
+   * located at their operator token, such as `.` or `:`, rather than their
+   * full span, and the expressions that a macro expands to have an empty range
+   * at the opening parenthesis of the macro call.
+   */
+  positions?: SourcePosition[];
+  /**
+   * The line offsets of the parsed AST's source info, as in the `line_offsets`
+   * of a `cel.expr.SourceInfo` message: the offset that follows each line,
+   * including its newline.
+   */
+  lineOffsets?: number[];
+  /**
+   * The parsed AST as a `cel.expr.ParsedExpr` message, converted by `cel-go`'s
+   * `AstToParsedExpr()`, with the source info of `positions` and `lineOffsets`
+   * and, for macros, the calls they expand:
+   * https://buf.build/google/cel-spec/docs/main:cel.expr#cel.expr.ParsedExpr
+   */
+  parsedExpr?: ParsedExpr;
+  /**
+   * The calls that the macros of the parsed AST expanded, ordered by the ID of
+   * the expression each expanded to, as in the `macro_calls` of `parsedExpr`.
+   */
+  macroCalls?: MacroCall[];
   /**
    * The checked AST as produced by the `ToDebugString()` function provided by `cel-go`:
    * https://pkg.go.dev/github.com/google/cel-go/common/debug#ToDebugString
    */
   checkedAst?: string;
+  /**
+   * The checked AST as a `cel.expr.CheckedExpr` message, converted by
+   * `cel-go`'s `AstToCheckedExpr()`, with the reference and type maps that
+   * `checkedAst` is adorned with:
+   * https://buf.build/google/cel-spec/docs/main:cel.expr#cel.expr.CheckedExpr
+   */
+  checkedExpr?: CheckedExpr;
   /**
    * The original test may separately have an expected type; this one is derived
    * from `cel-go` when tests are extracted from upstream. Where the two types
    * disagree, the type embedded in the original test should be deemed correct.
    */
   type?: string;
+  /**
+   * The cost of the checked expression, as estimated by `cel-go`'s
+   * `EstimateCost()`:
+   * https://pkg.go.dev/github.com/google/cel-go/cel#Env.EstimateCost
+   * Only present for tests that type-check.
+   */
+  cost?: CostEstimate;
   /**
    * This is the error, if any, produced by `cel-go`; it is only informational,
-   * not something that should be tested against.
+   * not something that should be tested against. Test against `errors`
+   * instead.
    */
   error?: string;
+  /**
+   * The errors of parsing or checking the expression, if any, ordered by
+   * location as in `error`. Absent if `cel-go` fails for another reason, such
+   * as an invalid declaration.
+   */
+  errors?: CompileError[];
+  /**
+   * The result of evaluating the checked expression with `cel-go`, against
+   * the bindings of the original test, as a `cel.expr.ExprValue` message:
+   * https://buf.build/google/cel-spec/docs/main:cel.expr#cel.expr.ExprValue
+   * Evaluation errors are reported in its error set. Only present for tests
+   * that type-check and are not marked `check_only`.
+   */
+  result?: ExprValue;
+  /**
+   * The runtime cost tracked by `cel-go` while evaluating the test:
+   * https://pkg.go.dev/github.com/google/cel-go/cel#EvalDetails.ActualCost
+   * If evaluation exceeds `costLimit`, the cost at that point. Absent if the
+   * cost depends on the iteration order of a map, which `cel-go` randomizes,
+   * such as when `exists()` stops early over a map. Tests extracted from
+   * `cel-go`'s runtime cost tests charge 7 for `getFullYear()`, in their
+   * estimated `cost` as well.
+   */
+  runtimeCost?: bigint;
+  /**
+   * For partially evaluated tests that produce an unknown, the attributes it
+   * merges, ordered by their trail. `result` only holds the expression IDs.
+   */
+  unknownAttributes?: UnknownAttribute[];
+  /**
+   * For partially evaluated tests, the residual AST, with the parts of the
+   * expression that evaluation resolved replaced by their values, as produced
+   * by `ToDebugString()`, like `ast`.
+   */
+  residualAst?: string;
+  /**
+   * For partially evaluated tests, the residual AST unparsed to an expression.
+   */
+  residual?: string;
+  /**
+   * For tests with `inlineVariables`, the checked AST optimized by `cel-go`'s
+   * inlining optimizer, as produced by `ToDebugString()`, like `checkedAst`:
+   * https://pkg.go.dev/github.com/google/cel-go/cel#NewInliningOptimizer
+   */
+  inlinedAst?: string;
+  /**
+   * The optimized AST of `inlinedAst` unparsed to an expression.
+   */
+  inlined?: string;
+  /**
+   * For conformance tests and tests extracted from `cel-go`'s constant folding
+   * and inlining tests, the checked AST, after inlining if any, optimized by
+   * `cel-go`'s constant folding optimizer, as produced by `ToDebugString()`,
+   * like `checkedAst`:
+   * https://pkg.go.dev/github.com/google/cel-go/cel#NewConstantFoldingOptimizer
+   * Absent if the folded map literals depend on the iteration order of a map,
+   * which `cel-go` randomizes, such as when `transformMap()` folds to a map.
+   */
+  foldedAst?: string;
+  /**
+   * The optimized AST of `foldedAst` unparsed to an expression.
+   */
+  folded?: string;
+  /**
+   * The error, if any, of constant folding, such as an overload missing for
+   * constant arguments, in which case `foldedAst` and `folded` are absent.
+   */
+  foldError?: string;
+  /**
+   * For conformance tests, how the outputs of `cel-go` compare with the
+   * expectation of the original test:
+   * - `agrees`: `cel-go` produces the expected value, type, error or unknown.
+   * - `disagrees`: `cel-go` produces a different result.
+   * - `cel-go-error`: `cel-go` fails to parse, check or evaluate the test where
+   *   the test expects it to succeed.
+   * Static errors are deemed to agree with an expected evaluation error.
+   */
+  referenceStatus?: ReferenceStatus;
+  /**
+   * The AST asserted by the upstream `cel-go` parser test case, if any. It is
+   * rendered with expression IDs as well as kinds, e.g. `x^#1:*expr.Expr_IdentExpr#`,
+   * so it is not directly comparable to `ast`. `cel-go` compares it ignoring
+   * whitespace.
+   */
+  expectedAst?: string;
+  /**
+   * The unparsed expression asserted by the upstream `cel-go` unparser test
+   * case, if any. `cel-go` compares it to `unparsed` exactly.
+   */
+  expectedUnparsed?: string;
+  /**
+   * The AST adorned with source locations, e.g. `x^#1[1,0]#`, asserted by the
+   * upstream `cel-go` parser test case, if any.
+   */
+  expectedLocationAst?: string;
+  /**
+   * The macro calls asserted by the upstream `cel-go` parser test case, if any.
+   * Each call is rendered like `expectedAst`, with the ID of the expression the
+   * macro expanded to, and calls are joined by `,` in descending ID order.
+   */
+  expectedMacroCalls?: string;
+  /**
+   * The checked AST asserted by the upstream `cel-go` test case, if any. Unlike
+   * `checkedAst`, this is not regenerated. `cel-go` compares it ignoring
+   * whitespace.
+   */
+  expectedCheckedAst?: string;
+  /**
+   * The output type asserted by the upstream `cel-go` test case, if any,
+   * formatted like `type`.
+   */
+  expectedType?: string;
+  /**
+   * The cost estimate asserted by the upstream `cel-go` test case, if any.
+   */
+  expectedCost?: CostEstimate;
+  /**
+   * The runtime cost asserted by the upstream `cel-go` test case, if any.
+   */
+  expectedRuntimeCost?: bigint;
+  /**
+   * The error asserted by the upstream `cel-go` test case, if any. `cel-go`
+   * compares it ignoring whitespace, or, for extension tests other than parse
+   * errors and for unparser tests, as a substring of the actual error, which
+   * is `unparseError` for the latter.
+   */
+  expectedError?: string;
+  /**
+   * The residual expression asserted by the upstream `cel-go` test case, if
+   * any. `cel-go` compares it ignoring whitespace.
+   */
+  expectedResidual?: string;
+  /**
+   * The inlined expression asserted by the upstream `cel-go` test case, if
+   * any. `cel-go` compares it to `inlined` exactly.
+   */
+  expectedInlined?: string;
+  /**
+   * The folded expression asserted by the upstream `cel-go` test case, if any.
+   * `cel-go` compares it to `folded` exactly.
+   */
+  expectedFolded?: string;
 }
 
 export interface IncrementalTestSuite {
@@ -91,24 +624,103 @@ export interface IncrementalTestSuite {
   tests: IncrementalTest[];
 }
 
+/**
+ * Deserializes a suite with the given registry, caching it per registry, since
+ * messages deserialized with one registry may not be valid with another.
+ */
+function getSuiteWithRegistry(
+  cache: WeakMap<Registry, IncrementalTestSuite>,
+  s: SerializedIncrementalTestSuite,
+  r: Registry,
+): IncrementalTestSuite {
+  let suite = cache.get(r);
+  if (suite === undefined) {
+    suite = deserializeTestSuite(s, r);
+    cache.set(r, suite);
+  }
+  return suite;
+}
+
 function deserializeTestSuite(
   s: SerializedIncrementalTestSuite,
+  r: Registry = registry,
 ): IncrementalTestSuite {
   return {
     name: s.name,
-    suites: (s.suites ?? []).map(deserializeTestSuite),
-    tests: (s.tests ?? []).map(deserializeTest),
+    suites: (s.suites ?? []).map((suite) => deserializeTestSuite(suite, r)),
+    tests: (s.tests ?? []).map((test) => deserializeTest(test, r)),
   };
 }
 
-function deserializeTest(t: SerializedIncrementalTest): IncrementalTest {
+function deserializeTest(
+  t: SerializedIncrementalTest,
+  r: Registry,
+): IncrementalTest {
   return {
     ...t,
     name: t.original.name ?? t.original.expr.replace(/\s+/g, " ").trim(),
-    original: fromJson(SimpleTestSchema, t.original, { registry }),
+    original: fromJson(SimpleTestSchema, t.original, { registry: r }),
+    result:
+      t.result !== undefined
+        ? fromJson(ExprValueSchema, t.result, { registry: r })
+        : undefined,
+    positions: t.positions?.map(deserializeSourcePosition),
+    parsedExpr:
+      t.parsedExpr !== undefined
+        ? fromJson(ParsedExprSchema, t.parsedExpr, { registry: r })
+        : undefined,
+    macroCalls: t.macroCalls?.map((c) => deserializeMacroCall(c, r)),
+    checkedExpr:
+      t.checkedExpr !== undefined
+        ? fromJson(CheckedExprSchema, t.checkedExpr, { registry: r })
+        : undefined,
+    costLimit: deserializeCost(t.costLimit),
+    cost: deserializeCostEstimate(t.cost),
+    runtimeCost: deserializeCost(t.runtimeCost),
+    expectedCost: deserializeCostEstimate(t.expectedCost),
+    expectedRuntimeCost: deserializeCost(t.expectedRuntimeCost),
   };
 }
 
+function deserializeSourcePosition([
+  id,
+  start,
+  stop,
+  startLine,
+  startColumn,
+  stopLine,
+  stopColumn,
+]: SerializedSourcePosition): SourcePosition {
+  return { id, start, stop, startLine, startColumn, stopLine, stopColumn };
+}
+
+function deserializeMacroCall(
+  c: SerializedMacroCall,
+  r: Registry,
+): MacroCall {
+  return {
+    id: c.id,
+    function: c.function,
+    target:
+      c.target !== undefined
+        ? fromJson(ExprSchema, c.target, { registry: r })
+        : undefined,
+    args: (c.args ?? []).map((a) => fromJson(ExprSchema, a, { registry: r })),
+  };
+}
+
+function deserializeCost(c: string | undefined): bigint | undefined {
+  return c !== undefined ? BigInt(c) : undefined;
+}
+
+function deserializeCostEstimate(
+  c: SerializedCostEstimate | undefined,
+): CostEstimate | undefined {
+  return c !== undefined
+    ? { min: BigInt(c.min), max: BigInt(c.max) }
+    : undefined;
+}
+
 export function getConformanceSuite() {
   conformanceSuite ??= deserializeTestSuite(conformance);
   return conformanceSuite;
@@ -128,3 +740,117 @@ export function getCheckingSuite() {
   checkingSuite ??= deserializeTestSuite(checking);
   return checkingSuite;
 }
+
+export function getStringsSuite() {
+  stringsSuite ??= deserializeTestSuite(strings);
+  return stringsSuite;
+}
+
+export function getMathSuite() {
+  mathSuite ??= deserializeTestSuite(math);
+  return mathSuite;
+}
+
+export function getListsSuite() {
+  listsSuite ??= deserializeTestSuite(lists);
+  return listsSuite;
+}
+
+export function getSetsSuite() {
+  setsSuite ??= deserializeTestSuite(sets);
+  return setsSuite;
+}
+
+export function getRegexSuite() {
+  regexSuite ??= deserializeTestSuite(regex);
+  return regexSuite;
+}
+
+export function getEncodersSuite() {
+  encodersSuite ??= deserializeTestSuite(encoders);
+  return encodersSuite;
+}
+
+/**
+ * Returns the tests of cel-go's protos extension. They bind messages of the
+ * `google.expr.proto2.test` package of cel-go, which the test registry does not
+ * include, so the given registry must include them and their extensions.
+ */
+export function getProtosSuite(protosRegistry: Registry) {
+  return getSuiteWithRegistry(protosSuites, protos, protosRegistry);
+}
+
+export function getBindingsSuite() {
+  bindingsSuite ??= deserializeTestSuite(bindings);
+  return bindingsSuite;
+}
+
+export function getFormatSuite() {
+  formatSuite ??= deserializeTestSuite(format);
+  return formatSuite;
+}
+
+/**
+ * Returns the tests of cel-go's interpreter. Some of them bind or expect
+ * messages of the `google.expr.proto2.test` and `google.expr.proto3.test`
+ * packages of cel-go, which the test registry does not include, so the given
+ * registry must include them.
+ */
+export function getInterpreterSuite(interpreterRegistry: Registry) {
+  return getSuiteWithRegistry(
+    interpreterSuites,
+    interpreter,
+    interpreterRegistry,
+  );
+}
+
+/**
+ * Returns the partial evaluation tests of cel-go's interpreter. One of them
+ * binds a message of the `google.expr.proto3.test` package of cel-go, which the
+ * test registry does not include, so the given registry must include it.
+ */
+export function getPruneSuite(pruneRegistry: Registry) {
+  return getSuiteWithRegistry(pruneSuites, prune, pruneRegistry);
+}
+
+export function getUnknownsSuite() {
+  unknownsSuite ??= deserializeTestSuite(unknowns);
+  return unknownsSuite;
+}
+
+export function getCostSuite() {
+  costSuite ??= deserializeTestSuite(cost);
+  return costSuite;
+}
+
+/**
+ * Returns the runtime cost tests of cel-go's interpreter. Some of them bind
+ * messages of the `google.expr.proto3.test` package of cel-go, which the test
+ * registry does not include, so the given registry must include them.
+ */
+export function getRuntimeCostSuite(runtimeCostRegistry: Registry) {
+  return getSuiteWithRegistry(
+    runtimecostSuites,
+    runtimecost,
+    runtimeCostRegistry,
+  );
+}
+
+/**
+ * Returns the constant folding tests of cel-go. Some of them bind messages of
+ * the `google.expr.proto3.test` package of cel-go, which the test registry does
+ * not include, so the given registry must include them.
+ */
+export function getFoldingSuite(foldingRegistry: Registry) {
+  return getSuiteWithRegistry(foldingSuites, folding, foldingRegistry);
+}
+
+export function getInliningSuite() {
+  inliningSuite ??= deserializeTestSuite(inlining);
+  return inliningSuite;
+}
+
+export function getUnparsingSuite() {
+  unparsingSuite ??= deserializeTestSuite(unparsing);
+  return unparsingSuite;
+}
@@ -0,0 +1,9009 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1 with attribute patterns
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "unknowns",
+  suites: [
+    {
+      name: "ident",
+      tests: [
+        {
+          original: {
+            name: "unknown",
+            expr: "x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "x^#*expr.Expr_IdentExpr#",
+          unparsed: "x",
+          locationAst: "x^#1[1,0]#",
+          positions: [[1, 0, 1, 1, 0, 1, 1]],
+          lineOffsets: [2],
+          parsedExpr: {
+            expr: { id: "1", identExpr: { name: "x" } },
+            sourceInfo: {
+              location: "unknown",
+              lineOffsets: [2],
+              positions: { "1": 0 },
+            },
+          },
+          checkedAst: "x~dyn^x",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [2],
+              positions: { "1": 0 },
+            },
+            expr: { id: "1", identExpr: { name: "x" } },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst: "x^#*expr.Expr_IdentExpr#",
+          residual: "x",
+        },
+        {
+          original: {
+            name: "known",
+            expr: "x",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+            bindings: { x: { value: { int64Value: "1" } } },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "y" }],
+          ast: "x^#*expr.Expr_IdentExpr#",
+          unparsed: "x",
+          locationAst: "x^#1[1,0]#",
+          positions: [[1, 0, 1, 1, 0, 1, 1]],
+          lineOffsets: [2],
+          parsedExpr: {
+            expr: { id: "1", identExpr: { name: "x" } },
+            sourceInfo: {
+              location: "known",
+              lineOffsets: [2],
+              positions: { "1": 0 },
+            },
+          },
+          checkedAst: "x~dyn^x",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [2],
+              positions: { "1": 0 },
+            },
+            expr: { id: "1", identExpr: { name: "x" } },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { value: { int64Value: "1" } },
+          residualAst: "1^#*expr.Constant_Int64Value#",
+          residual: "1",
+        },
+        {
+          original: {
+            name: "pattern_matches_known",
+            expr: "x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: { x: { value: { int64Value: "1" } } },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "x^#*expr.Expr_IdentExpr#",
+          unparsed: "x",
+          locationAst: "x^#1[1,0]#",
+          positions: [[1, 0, 1, 1, 0, 1, 1]],
+          lineOffsets: [2],
+          parsedExpr: {
+            expr: { id: "1", identExpr: { name: "x" } },
+            sourceInfo: {
+              location: "pattern_matches_known",
+              lineOffsets: [2],
+              positions: { "1": 0 },
+            },
+          },
+          checkedAst: "x~dyn^x",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [2],
+              positions: { "1": 0 },
+            },
+            expr: { id: "1", identExpr: { name: "x" } },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst: "x^#*expr.Expr_IdentExpr#",
+          residual: "x",
+        },
+      ],
+    },
+    {
+      name: "select",
+      tests: [
+        {
+          original: {
+            name: "unknown_operand",
+            expr: "x.a",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#",
+          unparsed: "x.a",
+          locationAst: "x^#1[1,0]#.a^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+          ],
+          lineOffsets: [4],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "a",
+              },
+            },
+            sourceInfo: {
+              location: "unknown_operand",
+              lineOffsets: [4],
+              positions: { "1": 0, "2": 1 },
+            },
+          },
+          checkedAst: "x~dyn^x.a~dyn",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [4],
+              positions: { "1": 0, "2": 1 },
+            },
+            expr: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "a",
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst: "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#",
+          residual: "x.a",
+        },
+        {
+          original: {
+            name: "unknown_field",
+            expr: "x.a",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      { key: { stringValue: "a" }, value: { int64Value: "1" } },
+                      { key: { stringValue: "b" }, value: { int64Value: "2" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ string: "a" }] }],
+          ast: "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#",
+          unparsed: "x.a",
+          locationAst: "x^#1[1,0]#.a^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+          ],
+          lineOffsets: [4],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "a",
+              },
+            },
+            sourceInfo: {
+              location: "unknown_field",
+              lineOffsets: [4],
+              positions: { "1": 0, "2": 1 },
+            },
+          },
+          checkedAst: "x~dyn^x.a~dyn",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [4],
+              positions: { "1": 0, "2": 1 },
+            },
+            expr: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "a",
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ string: "a" }] },
+          ],
+          residualAst: "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#",
+          residual: "x.a",
+        },
+        {
+          original: {
+            name: "other_field",
+            expr: "x.b",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      { key: { stringValue: "a" }, value: { int64Value: "1" } },
+                      { key: { stringValue: "b" }, value: { int64Value: "2" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ string: "a" }] }],
+          ast: "x^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#",
+          unparsed: "x.b",
+          locationAst: "x^#1[1,0]#.b^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+          ],
+          lineOffsets: [4],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "b",
+              },
+            },
+            sourceInfo: {
+              location: "other_field",
+              lineOffsets: [4],
+              positions: { "1": 0, "2": 1 },
+            },
+          },
+          checkedAst: "x~dyn^x.b~dyn",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [4],
+              positions: { "1": 0, "2": 1 },
+            },
+            expr: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "x" } },
+                field: "b",
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { value: { int64Value: "2" } },
+          residualAst: "2^#*expr.Constant_Int64Value#",
+          residual: "2",
+        },
+        {
+          original: {
+            name: "nested_field",
+            expr: "x.a.b",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      {
+                        key: { stringValue: "a" },
+                        value: {
+                          mapValue: {
+                            entries: [
+                              {
+                                key: { stringValue: "b" },
+                                value: { int64Value: "1" },
+                              },
+                            ],
+                          },
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ string: "a" }] }],
+          ast: "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#.b^#*expr.Expr_SelectExpr#",
+          unparsed: "x.a.b",
+          locationAst: "x^#1[1,0]#.a^#2[1,1]#.b^#3[1,3]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 3, 4, 1, 3, 1, 4],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "a",
+                  },
+                },
+                field: "b",
+              },
+            },
+            sourceInfo: {
+              location: "nested_field",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+          },
+          checkedAst: "x~dyn^x.a~dyn.b~dyn",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} }, "3": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+            expr: {
+              id: "3",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "a",
+                  },
+                },
+                field: "b",
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ string: "a" }] },
+          ],
+          residualAst:
+            "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#.b^#*expr.Expr_SelectExpr#",
+          residual: "x.a.b",
+        },
+        {
+          original: {
+            name: "wildcard",
+            expr: "x.a.b",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      {
+                        key: { stringValue: "a" },
+                        value: {
+                          mapValue: {
+                            entries: [
+                              {
+                                key: { stringValue: "b" },
+                                value: { int64Value: "1" },
+                              },
+                            ],
+                          },
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [
+            {
+              variable: "x",
+              qualifiers: [{ wildcard: true }, { string: "b" }],
+            },
+          ],
+          ast: "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#.b^#*expr.Expr_SelectExpr#",
+          unparsed: "x.a.b",
+          locationAst: "x^#1[1,0]#.a^#2[1,1]#.b^#3[1,3]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 3, 4, 1, 3, 1, 4],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "a",
+                  },
+                },
+                field: "b",
+              },
+            },
+            sourceInfo: {
+              location: "wildcard",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+          },
+          checkedAst: "x~dyn^x.a~dyn.b~dyn",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} }, "3": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+            expr: {
+              id: "3",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "a",
+                  },
+                },
+                field: "b",
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [
+            {
+              id: 3,
+              variable: "x",
+              qualifiers: [{ string: "a" }, { string: "b" }],
+            },
+          ],
+          residualAst:
+            "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#.b^#*expr.Expr_SelectExpr#",
+          residual: "x.a.b",
+        },
+        {
+          original: {
+            name: "wildcard_miss",
+            expr: "x.a.c",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      {
+                        key: { stringValue: "a" },
+                        value: {
+                          mapValue: {
+                            entries: [
+                              {
+                                key: { stringValue: "c" },
+                                value: { int64Value: "1" },
+                              },
+                            ],
+                          },
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [
+            {
+              variable: "x",
+              qualifiers: [{ wildcard: true }, { string: "b" }],
+            },
+          ],
+          ast: "x^#*expr.Expr_IdentExpr#.a^#*expr.Expr_SelectExpr#.c^#*expr.Expr_SelectExpr#",
+          unparsed: "x.a.c",
+          locationAst: "x^#1[1,0]#.a^#2[1,1]#.c^#3[1,3]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 3, 4, 1, 3, 1, 4],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "a",
+                  },
+                },
+                field: "c",
+              },
+            },
+            sourceInfo: {
+              location: "wildcard_miss",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+          },
+          checkedAst: "x~dyn^x.a~dyn.c~dyn",
+          checkedExpr: {
+            referenceMap: { "1": { name: "x" } },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} }, "3": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+            expr: {
+              id: "3",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "a",
+                  },
+                },
+                field: "c",
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { value: { int64Value: "1" } },
+          residualAst: "1^#*expr.Constant_Int64Value#",
+          residual: "1",
+        },
+        {
+          original: {
+            name: "presence_test",
+            expr: "has(x.a)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      { key: { stringValue: "a" }, value: { int64Value: "1" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ string: "a" }] }],
+          ast: "x^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#",
+          unparsed: "has(x.a)",
+          locationAst: "x^#2[1,4]#.a~test-only~^#4[1,3]#",
+          positions: [
+            [2, 4, 5, 1, 4, 1, 5],
+            [3, 5, 6, 1, 5, 1, 6],
+            [4, 3, 3, 1, 3, 1, 3],
+          ],
+          lineOffsets: [9],
+          parsedExpr: {
+            expr: {
+              id: "4",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "x" } },
+                field: "a",
+                testOnly: true,
+              },
+            },
+            sourceInfo: {
+              location: "presence_test",
+              lineOffsets: [9],
+              positions: { "2": 4, "3": 5, "4": 3 },
+              macroCalls: {
+                "4": {
+                  callExpr: {
+                    function: "has",
+                    args: [
+                      {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "x" } },
+                          field: "a",
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 4,
+              function: "has",
+              args: [
+                {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "x" } },
+                    field: "a",
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst: "x~dyn^x.a~test-only~~bool",
+          checkedExpr: {
+            referenceMap: { "2": { name: "x" } },
+            typeMap: { "2": { dyn: {} }, "4": { primitive: "BOOL" } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [9],
+              positions: { "2": 4, "3": 5, "4": 3 },
+              macroCalls: {
+                "4": {
+                  callExpr: {
+                    function: "has",
+                    args: [
+                      {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "x" } },
+                          field: "a",
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "4",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "x" } },
+                field: "a",
+                testOnly: true,
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["4"] } },
+          unknownAttributes: [
+            { id: 4, variable: "x", qualifiers: [{ string: "a" }] },
+          ],
+          residualAst:
+            "x^#*expr.Expr_IdentExpr#.a~test-only~^#*expr.Expr_SelectExpr#",
+          residual: "has(x.a)",
+        },
+        {
+          original: {
+            name: "presence_test_other_field",
+            expr: "has(x.b)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      { key: { stringValue: "a" }, value: { int64Value: "1" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ string: "a" }] }],
+          ast: "x^#*expr.Expr_IdentExpr#.b~test-only~^#*expr.Expr_SelectExpr#",
+          unparsed: "has(x.b)",
+          locationAst: "x^#2[1,4]#.b~test-only~^#4[1,3]#",
+          positions: [
+            [2, 4, 5, 1, 4, 1, 5],
+            [3, 5, 6, 1, 5, 1, 6],
+            [4, 3, 3, 1, 3, 1, 3],
+          ],
+          lineOffsets: [9],
+          parsedExpr: {
+            expr: {
+              id: "4",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "x" } },
+                field: "b",
+                testOnly: true,
+              },
+            },
+            sourceInfo: {
+              location: "presence_test_other_field",
+              lineOffsets: [9],
+              positions: { "2": 4, "3": 5, "4": 3 },
+              macroCalls: {
+                "4": {
+                  callExpr: {
+                    function: "has",
+                    args: [
+                      {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "x" } },
+                          field: "b",
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 4,
+              function: "has",
+              args: [
+                {
+                  id: "3",
+                  selectExpr: {
+                    operand: { id: "2", identExpr: { name: "x" } },
+                    field: "b",
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst: "x~dyn^x.b~test-only~~bool",
+          checkedExpr: {
+            referenceMap: { "2": { name: "x" } },
+            typeMap: { "2": { dyn: {} }, "4": { primitive: "BOOL" } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [9],
+              positions: { "2": 4, "3": 5, "4": 3 },
+              macroCalls: {
+                "4": {
+                  callExpr: {
+                    function: "has",
+                    args: [
+                      {
+                        id: "3",
+                        selectExpr: {
+                          operand: { id: "2", identExpr: { name: "x" } },
+                          field: "b",
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "4",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "x" } },
+                field: "b",
+                testOnly: true,
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "2", max: "2" },
+          result: { value: { boolValue: false } },
+          residualAst: "false^#*expr.Constant_BoolValue#",
+          residual: "false",
+        },
+        {
+          original: {
+            name: "optional",
+            expr: "x.?a",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      { key: { stringValue: "a" }, value: { int64Value: "1" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ string: "a" }] }],
+          ast: '_?._(\n  x^#*expr.Expr_IdentExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+          unparsed: "x.?a",
+          locationAst: '_?._(\n  x^#1[1,0]#,\n  "a"^#2[1,3]#\n)^#3[1,1]#',
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 3, 4, 1, 3, 1, 4],
+            [3, 1, 2, 1, 1, 1, 2],
+          ],
+          lineOffsets: [5],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_?._",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { stringValue: "a" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "optional",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 3, "3": 1 },
+            },
+          },
+          checkedAst:
+            '_?._(\n  x~dyn^x,\n  "a"\n)~optional_type(dyn)^select_optional_field',
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "3": { overloadId: ["select_optional_field"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "3": {
+                abstractType: {
+                  name: "optional_type",
+                  parameterTypes: [{ dyn: {} }],
+                },
+              },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 3, "3": 1 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_?._",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { stringValue: "a" } },
+                ],
+              },
+            },
+          },
+          type: "optional_type(dyn)",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [
+            { id: 3, variable: "x", qualifiers: [{ string: "a" }] },
+          ],
+          residualAst:
+            '_?._(\n  x^#*expr.Expr_IdentExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+          residual: "x.?a",
+        },
+        {
+          original: {
+            name: "qualified_name",
+            expr: "x.y.z",
+            typeEnv: [{ name: "x.y", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x.y" }],
+          ast: "x^#*expr.Expr_IdentExpr#.y^#*expr.Expr_SelectExpr#.z^#*expr.Expr_SelectExpr#",
+          unparsed: "x.y.z",
+          locationAst: "x^#1[1,0]#.y^#2[1,1]#.z^#3[1,3]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 3, 4, 1, 3, 1, 4],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              selectExpr: {
+                operand: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "x" } },
+                    field: "y",
+                  },
+                },
+                field: "z",
+              },
+            },
+            sourceInfo: {
+              location: "qualified_name",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+          },
+          checkedAst: "x.y~dyn^x.y.z~dyn",
+          checkedExpr: {
+            referenceMap: { "2": { name: "x.y" } },
+            typeMap: { "2": { dyn: {} }, "3": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+            expr: {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "x.y" } },
+                field: "z",
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [{ id: 3, variable: "x.y" }],
+          residualAst: "x.y^#*expr.Expr_IdentExpr#.z^#*expr.Expr_SelectExpr#",
+          residual: "x.y.z",
+        },
+      ],
+    },
+    {
+      name: "index",
+      tests: [
+        {
+          original: {
+            name: "list_int",
+            expr: "x[0]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  listValue: {
+                    values: [{ int64Value: "1" }, { int64Value: "2" }],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ int: 0 }] }],
+          ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[0]",
+          locationAst: "_[_](\n  x^#1[1,0]#,\n  0^#3[1,2]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+          ],
+          lineOffsets: [5],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "list_int",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_[_](\n  x~dyn^x,\n  0~int\n)~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": {
+                overloadId: [
+                  "index_list",
+                  "index_map",
+                  "optional_list_index_int",
+                  "optional_map_index_value",
+                ],
+              },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ int: 0 }] },
+          ],
+          residualAst:
+            "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          residual: "x[0]",
+        },
+        {
+          original: {
+            name: "list_other_int",
+            expr: "x[1]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  listValue: {
+                    values: [{ int64Value: "1" }, { int64Value: "2" }],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ int: 0 }] }],
+          ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[1]",
+          locationAst: "_[_](\n  x^#1[1,0]#,\n  1^#3[1,2]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+          ],
+          lineOffsets: [5],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "list_other_int",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_[_](\n  x~dyn^x,\n  1~int\n)~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": {
+                overloadId: [
+                  "index_list",
+                  "index_map",
+                  "optional_list_index_int",
+                  "optional_map_index_value",
+                ],
+              },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { value: { int64Value: "2" } },
+          residualAst: "2^#*expr.Constant_Int64Value#",
+          residual: "2",
+        },
+        {
+          original: {
+            name: "list_uint",
+            expr: "x[0u]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  listValue: {
+                    values: [{ int64Value: "1" }, { int64Value: "2" }],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ int: 0 }] }],
+          ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[0u]",
+          locationAst: "_[_](\n  x^#1[1,0]#,\n  0u^#3[1,2]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { uint64Value: "0" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "list_uint",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_[_](\n  x~dyn^x,\n  0u~uint\n)~dyn^index_map|optional_map_index_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["index_map", "optional_map_index_value"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "UINT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { uint64Value: "0" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ uint: 0 }] },
+          ],
+          residualAst:
+            "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  0u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+          residual: "x[0u]",
+        },
+        {
+          original: {
+            name: "map_uint",
+            expr: "x[1u]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      {
+                        key: { uint64Value: "1" },
+                        value: { stringValue: "a" },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ uint: 1 }] }],
+          ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[1u]",
+          locationAst: "_[_](\n  x^#1[1,0]#,\n  1u^#3[1,2]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "map_uint",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_[_](\n  x~dyn^x,\n  1u~uint\n)~dyn^index_map|optional_map_index_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["index_map", "optional_map_index_value"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "UINT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { uint64Value: "1" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ uint: 1 }] },
+          ],
+          residualAst:
+            "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  1u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+          residual: "x[1u]",
+        },
+        {
+          original: {
+            name: "map_bool",
+            expr: "x[true]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      { key: { boolValue: true }, value: { stringValue: "a" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ bool: true }] }],
+          ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[true]",
+          locationAst: "_[_](\n  x^#1[1,0]#,\n  true^#3[1,2]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 6, 1, 2, 1, 6],
+          ],
+          lineOffsets: [8],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "map_bool",
+              lineOffsets: [8],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_[_](\n  x~dyn^x,\n  true~bool\n)~dyn^index_map|optional_map_index_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["index_map", "optional_map_index_value"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [8],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ bool: true }] },
+          ],
+          residualAst:
+            "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+          residual: "x[true]",
+        },
+        {
+          original: {
+            name: "map_string",
+            expr: "x['a']",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  mapValue: {
+                    entries: [
+                      { key: { stringValue: "a" }, value: { int64Value: "1" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ string: "a" }] }],
+          ast: '_[_](\n  x^#*expr.Expr_IdentExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+          unparsed: 'x["a"]',
+          locationAst: '_[_](\n  x^#1[1,0]#,\n  "a"^#3[1,2]#\n)^#2[1,1]#',
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 5, 1, 2, 1, 5],
+          ],
+          lineOffsets: [7],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { stringValue: "a" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "map_string",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+          },
+          checkedAst:
+            '_[_](\n  x~dyn^x,\n  "a"~string\n)~dyn^index_map|optional_map_index_value',
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["index_map", "optional_map_index_value"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "STRING" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { stringValue: "a" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ string: "a" }] },
+          ],
+          residualAst:
+            '_[_](\n  x^#*expr.Expr_IdentExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+          residual: 'x["a"]',
+        },
+        {
+          original: {
+            name: "unknown_index",
+            expr: "x[y]",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+            bindings: {
+              x: {
+                value: {
+                  listValue: {
+                    values: [{ int64Value: "1" }, { int64Value: "2" }],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "y" }],
+          ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[y]",
+          locationAst: "_[_](\n  x^#1[1,0]#,\n  y^#3[1,2]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+          ],
+          lineOffsets: [5],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_index",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_[_](\n  x~dyn^x,\n  y~dyn^y\n)~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": {
+                overloadId: [
+                  "index_list",
+                  "index_map",
+                  "optional_list_index_int",
+                  "optional_map_index_value",
+                ],
+              },
+              "3": { name: "y" },
+            },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} }, "3": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "3", max: "3" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [{ id: 3, variable: "y" }],
+          residualAst:
+            "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x[y]",
+        },
+        {
+          original: {
+            name: "unknown_operand_and_index",
+            expr: "x[y]",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }],
+          ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[y]",
+          locationAst: "_[_](\n  x^#1[1,0]#,\n  y^#3[1,2]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+          ],
+          lineOffsets: [5],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_operand_and_index",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_[_](\n  x~dyn^x,\n  y~dyn^y\n)~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": {
+                overloadId: [
+                  "index_list",
+                  "index_map",
+                  "optional_list_index_int",
+                  "optional_map_index_value",
+                ],
+              },
+              "3": { name: "y" },
+            },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} }, "3": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 1, "3": 2 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "3", max: "3" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x[y]",
+        },
+        {
+          original: {
+            name: "computed_index",
+            expr: "x[y + 1]",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+            bindings: {
+              x: {
+                value: {
+                  listValue: {
+                    values: [{ int64Value: "1" }, { int64Value: "2" }],
+                  },
+                },
+              },
+              y: { value: { int64Value: "0" } },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ int: 1 }] }],
+          ast: "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  _+_(\n    y^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[y + 1]",
+          locationAst:
+            "_[_](\n  x^#1[1,0]#,\n  _+_(\n    y^#3[1,2]#,\n    1^#5[1,6]#\n  )^#4[1,4]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+            [4, 4, 5, 1, 4, 1, 5],
+            [5, 6, 7, 1, 6, 1, 7],
+          ],
+          lineOffsets: [9],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "3", identExpr: { name: "y" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "computed_index",
+              lineOffsets: [9],
+              positions: { "1": 0, "2": 1, "3": 2, "4": 4, "5": 6 },
+            },
+          },
+          checkedAst:
+            "_[_](\n  x~dyn^x,\n  _+_(\n    y~dyn^y,\n    1~int\n  )~int^add_int64\n)~dyn^index_list|index_map|optional_list_index_int|optional_map_index_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": {
+                overloadId: [
+                  "index_list",
+                  "index_map",
+                  "optional_list_index_int",
+                  "optional_map_index_value",
+                ],
+              },
+              "3": { name: "y" },
+              "4": { overloadId: ["add_int64"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { dyn: {} },
+              "4": { primitive: "INT64" },
+              "5": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [9],
+              positions: { "1": 0, "2": 1, "3": 2, "4": 4, "5": 6 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "3", identExpr: { name: "y" } },
+                        { id: "5", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "4", max: "4" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ int: 1 }] },
+          ],
+          residualAst:
+            "_[_](\n  x^#*expr.Expr_IdentExpr#,\n  _+_(\n    y^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x[y + 1]",
+        },
+        {
+          original: {
+            name: "optional",
+            expr: "x[?0]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  listValue: {
+                    values: [{ int64Value: "1" }, { int64Value: "2" }],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ int: 0 }] }],
+          ast: "_[?_](\n  x^#*expr.Expr_IdentExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x[?0]",
+          locationAst: "_[?_](\n  x^#1[1,0]#,\n  0^#3[1,3]#\n)^#2[1,1]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 3, 4, 1, 3, 1, 4],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[?_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "optional",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+          },
+          checkedAst:
+            "_[?_](\n  x~dyn^x,\n  0~int\n)~dyn^list_optindex_optional_int|map_optindex_optional_value|optional_list_optindex_optional_int|optional_map_optindex_optional_value",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": {
+                overloadId: [
+                  "list_optindex_optional_int",
+                  "map_optindex_optional_value",
+                  "optional_list_optindex_optional_int",
+                  "optional_map_optindex_optional_value",
+                ],
+              },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 1, "3": 3 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_[?_]",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [
+            { id: 2, variable: "x", qualifiers: [{ int: 0 }] },
+          ],
+          residualAst:
+            "_[?_](\n  x^#*expr.Expr_IdentExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          residual: "x[?0]",
+        },
+      ],
+    },
+    {
+      name: "logical_and",
+      tests: [
+        {
+          original: {
+            name: "unknown_true",
+            expr: "x \u0026\u0026 true",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x \u0026\u0026 true",
+          locationAst:
+            "_\u0026\u0026_(\n  x^#1[1,0]#,\n  true^#2[1,5]#\n)^#3[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 9, 1, 5, 1, 9],
+            [3, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [10],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_true",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_\u0026\u0026_(\n  x~dyn^x,\n  true~bool\n)~bool^logical_and",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "3": { overloadId: ["logical_and"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "BOOL" },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst: "x^#*expr.Expr_IdentExpr#",
+          residual: "x",
+        },
+        {
+          original: {
+            name: "unknown_false",
+            expr: "x \u0026\u0026 false",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x \u0026\u0026 false",
+          locationAst:
+            "_\u0026\u0026_(\n  x^#1[1,0]#,\n  false^#2[1,5]#\n)^#3[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 10, 1, 5, 1, 10],
+            [3, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [11],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { boolValue: false } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_false",
+              lineOffsets: [11],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_\u0026\u0026_(\n  x~dyn^x,\n  false~bool\n)~bool^logical_and",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "3": { overloadId: ["logical_and"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "BOOL" },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [11],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { boolValue: false } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "1" },
+          result: { value: { boolValue: false } },
+          residualAst: "false^#*expr.Constant_BoolValue#",
+          residual: "false",
+        },
+        {
+          original: {
+            name: "false_unknown",
+            expr: "false \u0026\u0026 x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_\u0026\u0026_(\n  false^#*expr.Constant_BoolValue#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "false \u0026\u0026 x",
+          locationAst:
+            "_\u0026\u0026_(\n  false^#1[1,0]#,\n  x^#2[1,9]#\n)^#3[1,6]#",
+          positions: [
+            [1, 0, 5, 1, 0, 1, 5],
+            [2, 9, 10, 1, 9, 1, 10],
+            [3, 6, 8, 1, 6, 1, 8],
+          ],
+          lineOffsets: [11],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", constExpr: { boolValue: false } },
+                  { id: "2", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "false_unknown",
+              lineOffsets: [11],
+              positions: { "1": 0, "2": 9, "3": 6 },
+            },
+          },
+          checkedAst:
+            "_\u0026\u0026_(\n  false~bool,\n  x~dyn^x\n)~bool^logical_and",
+          checkedExpr: {
+            referenceMap: {
+              "2": { name: "x" },
+              "3": { overloadId: ["logical_and"] },
+            },
+            typeMap: {
+              "1": { primitive: "BOOL" },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [11],
+              positions: { "1": 0, "2": 9, "3": 6 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", constExpr: { boolValue: false } },
+                  { id: "2", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "0", max: "1" },
+          result: { value: { boolValue: false } },
+          residualAst: "false^#*expr.Constant_BoolValue#",
+          residual: "false",
+        },
+        {
+          original: {
+            name: "unknown_unknown",
+            expr: "x \u0026\u0026 y",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }],
+          ast: "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x \u0026\u0026 y",
+          locationAst:
+            "_\u0026\u0026_(\n  x^#1[1,0]#,\n  y^#2[1,5]#\n)^#3[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 6, 1, 5, 1, 6],
+            [3, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [7],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", identExpr: { name: "y" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_unknown",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+          },
+          checkedAst:
+            "_\u0026\u0026_(\n  x~dyn^x,\n  y~dyn^y\n)~bool^logical_and",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { name: "y" },
+              "3": { overloadId: ["logical_and"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", identExpr: { name: "y" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "2" },
+          result: { unknown: { exprs: ["1", "2"] } },
+          unknownAttributes: [
+            { id: 1, variable: "x" },
+            { id: 2, variable: "y" },
+          ],
+          residualAst:
+            "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x \u0026\u0026 y",
+        },
+        {
+          original: {
+            name: "unknown_error",
+            expr: "x \u0026\u0026 1/0 == 0",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x \u0026\u0026 1 / 0 == 0",
+          locationAst:
+            "_\u0026\u0026_(\n  x^#1[1,0]#,\n  _==_(\n    _/_(\n      1^#2[1,5]#,\n      0^#4[1,7]#\n    )^#3[1,6]#,\n    0^#6[1,12]#\n  )^#5[1,9]#\n)^#7[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 6, 1, 5, 1, 6],
+            [3, 6, 7, 1, 6, 1, 7],
+            [4, 7, 8, 1, 7, 1, 8],
+            [5, 9, 11, 1, 9, 1, 11],
+            [6, 12, 13, 1, 12, 1, 13],
+            [7, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [14],
+          parsedExpr: {
+            expr: {
+              id: "7",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "3",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "2", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "6", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_error",
+              lineOffsets: [14],
+              positions: {
+                "1": 0,
+                "2": 5,
+                "3": 6,
+                "4": 7,
+                "5": 9,
+                "6": 12,
+                "7": 2,
+              },
+            },
+          },
+          checkedAst:
+            "_\u0026\u0026_(\n  x~dyn^x,\n  _==_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^equals\n)~bool^logical_and",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "3": { overloadId: ["divide_int64"] },
+              "5": { overloadId: ["equals"] },
+              "7": { overloadId: ["logical_and"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "4": { primitive: "INT64" },
+              "5": { primitive: "BOOL" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [14],
+              positions: {
+                "1": 0,
+                "2": 5,
+                "3": 6,
+                "4": 7,
+                "5": 9,
+                "6": 12,
+                "7": 2,
+              },
+            },
+            expr: {
+              id: "7",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "3",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "2", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "6", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "3" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x \u0026\u0026 1 / 0 == 0",
+        },
+        {
+          original: {
+            name: "error_unknown",
+            expr: "1/0 == 0 \u0026\u0026 x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_\u0026\u0026_(\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "1 / 0 == 0 \u0026\u0026 x",
+          locationAst:
+            "_\u0026\u0026_(\n  _==_(\n    _/_(\n      1^#1[1,0]#,\n      0^#3[1,2]#\n    )^#2[1,1]#,\n    0^#5[1,7]#\n  )^#4[1,4]#,\n  x^#6[1,12]#\n)^#7[1,9]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+            [4, 4, 6, 1, 4, 1, 6],
+            [5, 7, 8, 1, 7, 1, 8],
+            [6, 12, 13, 1, 12, 1, 13],
+            [7, 9, 11, 1, 9, 1, 11],
+          ],
+          lineOffsets: [14],
+          parsedExpr: {
+            expr: {
+              id: "7",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "2",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "1", constExpr: { int64Value: "1" } },
+                              { id: "3", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "6", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "error_unknown",
+              lineOffsets: [14],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 2,
+                "4": 4,
+                "5": 7,
+                "6": 12,
+                "7": 9,
+              },
+            },
+          },
+          checkedAst:
+            "_\u0026\u0026_(\n  _==_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^equals,\n  x~dyn^x\n)~bool^logical_and",
+          checkedExpr: {
+            referenceMap: {
+              "2": { overloadId: ["divide_int64"] },
+              "4": { overloadId: ["equals"] },
+              "6": { name: "x" },
+              "7": { overloadId: ["logical_and"] },
+            },
+            typeMap: {
+              "1": { primitive: "INT64" },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "4": { primitive: "BOOL" },
+              "5": { primitive: "INT64" },
+              "6": { dyn: {} },
+              "7": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [14],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 2,
+                "4": 4,
+                "5": 7,
+                "6": 12,
+                "7": 9,
+              },
+            },
+            expr: {
+              id: "7",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "2",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "1", constExpr: { int64Value: "1" } },
+                              { id: "3", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "6", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "2", max: "3" },
+          result: { unknown: { exprs: ["6"] } },
+          unknownAttributes: [{ id: 6, variable: "x" }],
+          residualAst:
+            "_\u0026\u0026_(\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "1 / 0 == 0 \u0026\u0026 x",
+        },
+        {
+          original: {
+            name: "chain",
+            expr: "x \u0026\u0026 y \u0026\u0026 z",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+              { name: "z", ident: { type: { dyn: {} } } },
+            ],
+            bindings: { y: { value: { boolValue: true } } },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "z" }],
+          ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    x^#*expr.Expr_IdentExpr#,\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x \u0026\u0026 y \u0026\u0026 z",
+          locationAst:
+            "_\u0026\u0026_(\n  _\u0026\u0026_(\n    x^#1[1,0]#,\n    y^#2[1,5]#\n  )^#3[1,2]#,\n  z^#4[1,10]#\n)^#5[1,7]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 6, 1, 5, 1, 6],
+            [3, 2, 4, 1, 2, 1, 4],
+            [4, 10, 11, 1, 10, 1, 11],
+            [5, 7, 9, 1, 7, 1, 9],
+          ],
+          lineOffsets: [12],
+          parsedExpr: {
+            expr: {
+              id: "5",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "2", identExpr: { name: "y" } },
+                      ],
+                    },
+                  },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "chain",
+              lineOffsets: [12],
+              positions: { "1": 0, "2": 5, "3": 2, "4": 10, "5": 7 },
+            },
+          },
+          checkedAst:
+            "_\u0026\u0026_(\n  _\u0026\u0026_(\n    x~dyn^x,\n    y~dyn^y\n  )~bool^logical_and,\n  z~dyn^z\n)~bool^logical_and",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { name: "y" },
+              "3": { overloadId: ["logical_and"] },
+              "4": { name: "z" },
+              "5": { overloadId: ["logical_and"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+              "4": { dyn: {} },
+              "5": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [12],
+              positions: { "1": 0, "2": 5, "3": 2, "4": 10, "5": 7 },
+            },
+            expr: {
+              id: "5",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_\u0026\u0026_",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "2", identExpr: { name: "y" } },
+                      ],
+                    },
+                  },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "3" },
+          result: { unknown: { exprs: ["1", "4"] } },
+          unknownAttributes: [
+            { id: 1, variable: "x" },
+            { id: 4, variable: "z" },
+          ],
+          residualAst:
+            "_\u0026\u0026_(\n  x^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x \u0026\u0026 z",
+        },
+      ],
+    },
+    {
+      name: "logical_or",
+      tests: [
+        {
+          original: {
+            name: "unknown_true",
+            expr: "x || true",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x || true",
+          locationAst: "_||_(\n  x^#1[1,0]#,\n  true^#2[1,5]#\n)^#3[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 9, 1, 5, 1, 9],
+            [3, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [10],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_true",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+          },
+          checkedAst: "_||_(\n  x~dyn^x,\n  true~bool\n)~bool^logical_or",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "3": { overloadId: ["logical_or"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "BOOL" },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { boolValue: true } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "1" },
+          result: { value: { boolValue: true } },
+          residualAst: "true^#*expr.Constant_BoolValue#",
+          residual: "true",
+        },
+        {
+          original: {
+            name: "unknown_false",
+            expr: "x || false",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x || false",
+          locationAst: "_||_(\n  x^#1[1,0]#,\n  false^#2[1,5]#\n)^#3[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 10, 1, 5, 1, 10],
+            [3, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [11],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { boolValue: false } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_false",
+              lineOffsets: [11],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+          },
+          checkedAst: "_||_(\n  x~dyn^x,\n  false~bool\n)~bool^logical_or",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "3": { overloadId: ["logical_or"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "BOOL" },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [11],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", constExpr: { boolValue: false } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst: "x^#*expr.Expr_IdentExpr#",
+          residual: "x",
+        },
+        {
+          original: {
+            name: "true_unknown",
+            expr: "true || x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_||_(\n  true^#*expr.Constant_BoolValue#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "true || x",
+          locationAst: "_||_(\n  true^#1[1,0]#,\n  x^#2[1,8]#\n)^#3[1,5]#",
+          positions: [
+            [1, 0, 4, 1, 0, 1, 4],
+            [2, 8, 9, 1, 8, 1, 9],
+            [3, 5, 7, 1, 5, 1, 7],
+          ],
+          lineOffsets: [10],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", constExpr: { boolValue: true } },
+                  { id: "2", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "true_unknown",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 8, "3": 5 },
+            },
+          },
+          checkedAst: "_||_(\n  true~bool,\n  x~dyn^x\n)~bool^logical_or",
+          checkedExpr: {
+            referenceMap: {
+              "2": { name: "x" },
+              "3": { overloadId: ["logical_or"] },
+            },
+            typeMap: {
+              "1": { primitive: "BOOL" },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 8, "3": 5 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", constExpr: { boolValue: true } },
+                  { id: "2", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "0", max: "1" },
+          result: { value: { boolValue: true } },
+          residualAst: "true^#*expr.Constant_BoolValue#",
+          residual: "true",
+        },
+        {
+          original: {
+            name: "unknown_unknown",
+            expr: "x || y",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }],
+          ast: "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x || y",
+          locationAst: "_||_(\n  x^#1[1,0]#,\n  y^#2[1,5]#\n)^#3[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 6, 1, 5, 1, 6],
+            [3, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [7],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", identExpr: { name: "y" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_unknown",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+          },
+          checkedAst: "_||_(\n  x~dyn^x,\n  y~dyn^y\n)~bool^logical_or",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { name: "y" },
+              "3": { overloadId: ["logical_or"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 5, "3": 2 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "2", identExpr: { name: "y" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "2" },
+          result: { unknown: { exprs: ["1", "2"] } },
+          unknownAttributes: [
+            { id: 1, variable: "x" },
+            { id: 2, variable: "y" },
+          ],
+          residualAst:
+            "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x || y",
+        },
+        {
+          original: {
+            name: "unknown_error",
+            expr: "x || 1/0 == 0",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x || 1 / 0 == 0",
+          locationAst:
+            "_||_(\n  x^#1[1,0]#,\n  _==_(\n    _/_(\n      1^#2[1,5]#,\n      0^#4[1,7]#\n    )^#3[1,6]#,\n    0^#6[1,12]#\n  )^#5[1,9]#\n)^#7[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 6, 1, 5, 1, 6],
+            [3, 6, 7, 1, 6, 1, 7],
+            [4, 7, 8, 1, 7, 1, 8],
+            [5, 9, 11, 1, 9, 1, 11],
+            [6, 12, 13, 1, 12, 1, 13],
+            [7, 2, 4, 1, 2, 1, 4],
+          ],
+          lineOffsets: [14],
+          parsedExpr: {
+            expr: {
+              id: "7",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "3",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "2", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "6", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_error",
+              lineOffsets: [14],
+              positions: {
+                "1": 0,
+                "2": 5,
+                "3": 6,
+                "4": 7,
+                "5": 9,
+                "6": 12,
+                "7": 2,
+              },
+            },
+          },
+          checkedAst:
+            "_||_(\n  x~dyn^x,\n  _==_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^equals\n)~bool^logical_or",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "3": { overloadId: ["divide_int64"] },
+              "5": { overloadId: ["equals"] },
+              "7": { overloadId: ["logical_or"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "4": { primitive: "INT64" },
+              "5": { primitive: "BOOL" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [14],
+              positions: {
+                "1": 0,
+                "2": 5,
+                "3": 6,
+                "4": 7,
+                "5": 9,
+                "6": 12,
+                "7": 2,
+              },
+            },
+            expr: {
+              id: "7",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "3",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "2", constExpr: { int64Value: "1" } },
+                              { id: "4", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "6", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "3" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x || 1 / 0 == 0",
+        },
+        {
+          original: {
+            name: "error_unknown",
+            expr: "1/0 == 0 || x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_||_(\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "1 / 0 == 0 || x",
+          locationAst:
+            "_||_(\n  _==_(\n    _/_(\n      1^#1[1,0]#,\n      0^#3[1,2]#\n    )^#2[1,1]#,\n    0^#5[1,7]#\n  )^#4[1,4]#,\n  x^#6[1,12]#\n)^#7[1,9]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+            [4, 4, 6, 1, 4, 1, 6],
+            [5, 7, 8, 1, 7, 1, 8],
+            [6, 12, 13, 1, 12, 1, 13],
+            [7, 9, 11, 1, 9, 1, 11],
+          ],
+          lineOffsets: [14],
+          parsedExpr: {
+            expr: {
+              id: "7",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "2",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "1", constExpr: { int64Value: "1" } },
+                              { id: "3", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "6", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "error_unknown",
+              lineOffsets: [14],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 2,
+                "4": 4,
+                "5": 7,
+                "6": 12,
+                "7": 9,
+              },
+            },
+          },
+          checkedAst:
+            "_||_(\n  _==_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^equals,\n  x~dyn^x\n)~bool^logical_or",
+          checkedExpr: {
+            referenceMap: {
+              "2": { overloadId: ["divide_int64"] },
+              "4": { overloadId: ["equals"] },
+              "6": { name: "x" },
+              "7": { overloadId: ["logical_or"] },
+            },
+            typeMap: {
+              "1": { primitive: "INT64" },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "4": { primitive: "BOOL" },
+              "5": { primitive: "INT64" },
+              "6": { dyn: {} },
+              "7": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [14],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 2,
+                "4": 4,
+                "5": 7,
+                "6": 12,
+                "7": 9,
+              },
+            },
+            expr: {
+              id: "7",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "2",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "1", constExpr: { int64Value: "1" } },
+                              { id: "3", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "6", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "2", max: "3" },
+          result: { unknown: { exprs: ["6"] } },
+          unknownAttributes: [{ id: 6, variable: "x" }],
+          residualAst:
+            "_||_(\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "1 / 0 == 0 || x",
+        },
+        {
+          original: {
+            name: "chain",
+            expr: "x || y || z",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+              { name: "z", ident: { type: { dyn: {} } } },
+            ],
+            bindings: { y: { value: { boolValue: false } } },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "z" }],
+          ast: "_||_(\n  _||_(\n    x^#*expr.Expr_IdentExpr#,\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x || y || z",
+          locationAst:
+            "_||_(\n  _||_(\n    x^#1[1,0]#,\n    y^#2[1,5]#\n  )^#3[1,2]#,\n  z^#4[1,10]#\n)^#5[1,7]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 5, 6, 1, 5, 1, 6],
+            [3, 2, 4, 1, 2, 1, 4],
+            [4, 10, 11, 1, 10, 1, 11],
+            [5, 7, 9, 1, 7, 1, 9],
+          ],
+          lineOffsets: [12],
+          parsedExpr: {
+            expr: {
+              id: "5",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "2", identExpr: { name: "y" } },
+                      ],
+                    },
+                  },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "chain",
+              lineOffsets: [12],
+              positions: { "1": 0, "2": 5, "3": 2, "4": 10, "5": 7 },
+            },
+          },
+          checkedAst:
+            "_||_(\n  _||_(\n    x~dyn^x,\n    y~dyn^y\n  )~bool^logical_or,\n  z~dyn^z\n)~bool^logical_or",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { name: "y" },
+              "3": { overloadId: ["logical_or"] },
+              "4": { name: "z" },
+              "5": { overloadId: ["logical_or"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+              "4": { dyn: {} },
+              "5": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [12],
+              positions: { "1": 0, "2": 5, "3": 2, "4": 10, "5": 7 },
+            },
+            expr: {
+              id: "5",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "2", identExpr: { name: "y" } },
+                      ],
+                    },
+                  },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "3" },
+          result: { unknown: { exprs: ["1", "4"] } },
+          unknownAttributes: [
+            { id: 1, variable: "x" },
+            { id: 4, variable: "z" },
+          ],
+          residualAst:
+            "_||_(\n  x^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x || z",
+        },
+      ],
+    },
+    {
+      name: "logical_not",
+      tests: [
+        {
+          original: {
+            name: "unknown",
+            expr: "!x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "!_(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "!x",
+          locationAst: "!_(\n  x^#2[1,1]#\n)^#1[1,0]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+          ],
+          lineOffsets: [3],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              callExpr: {
+                function: "!_",
+                args: [{ id: "2", identExpr: { name: "x" } }],
+              },
+            },
+            sourceInfo: {
+              location: "unknown",
+              lineOffsets: [3],
+              positions: { "1": 0, "2": 1 },
+            },
+          },
+          checkedAst: "!_(\n  x~dyn^x\n)~bool^logical_not",
+          checkedExpr: {
+            referenceMap: {
+              "1": { overloadId: ["logical_not"] },
+              "2": { name: "x" },
+            },
+            typeMap: { "1": { primitive: "BOOL" }, "2": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [3],
+              positions: { "1": 0, "2": 1 },
+            },
+            expr: {
+              id: "1",
+              callExpr: {
+                function: "!_",
+                args: [{ id: "2", identExpr: { name: "x" } }],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "!_(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "!x",
+        },
+      ],
+    },
+    {
+      name: "conditional",
+      tests: [
+        {
+          original: {
+            name: "unknown_condition",
+            expr: "x ? 1 : 2",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_?_:_(\n  x^#*expr.Expr_IdentExpr#,\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x ? 1 : 2",
+          locationAst:
+            "_?_:_(\n  x^#1[1,0]#,\n  1^#3[1,4]#,\n  2^#4[1,8]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 3, 1, 2, 1, 3],
+            [3, 4, 5, 1, 4, 1, 5],
+            [4, 8, 9, 1, 8, 1, 9],
+          ],
+          lineOffsets: [10],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_condition",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 2, "3": 4, "4": 8 },
+            },
+          },
+          checkedAst:
+            "_?_:_(\n  x~dyn^x,\n  1~int,\n  2~int\n)~int^conditional",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["conditional"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "4": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 2, "3": 4, "4": 8 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "1" } },
+                  { id: "4", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          },
+          type: "int",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "_?_:_(\n  x^#*expr.Expr_IdentExpr#,\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          residual: "x ? 1 : 2",
+        },
+        {
+          original: {
+            name: "unknown_condition_and_branches",
+            expr: "x ? y : z",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+              { name: "z", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }, { variable: "z" }],
+          ast: "_?_:_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x ? y : z",
+          locationAst:
+            "_?_:_(\n  x^#1[1,0]#,\n  y^#3[1,4]#,\n  z^#4[1,8]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 3, 1, 2, 1, 3],
+            [3, 4, 5, 1, 4, 1, 5],
+            [4, 8, 9, 1, 8, 1, 9],
+          ],
+          lineOffsets: [10],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "unknown_condition_and_branches",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 2, "3": 4, "4": 8 },
+            },
+          },
+          checkedAst:
+            "_?_:_(\n  x~dyn^x,\n  y~dyn^y,\n  z~dyn^z\n)~dyn^conditional",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["conditional"] },
+              "3": { name: "y" },
+              "4": { name: "z" },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { dyn: {} },
+              "4": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 2, "3": 4, "4": 8 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "_?_:_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x ? y : z",
+        },
+        {
+          original: {
+            name: "true_unknown_branch",
+            expr: "true ? y : z",
+            typeEnv: [
+              { name: "y", ident: { type: { dyn: {} } } },
+              { name: "z", ident: { type: { dyn: {} } } },
+            ],
+            bindings: { z: { value: { int64Value: "2" } } },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "y" }],
+          ast: "_?_:_(\n  true^#*expr.Constant_BoolValue#,\n  y^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "true ? y : z",
+          locationAst:
+            "_?_:_(\n  true^#1[1,0]#,\n  y^#3[1,7]#,\n  z^#4[1,11]#\n)^#2[1,5]#",
+          positions: [
+            [1, 0, 4, 1, 0, 1, 4],
+            [2, 5, 6, 1, 5, 1, 6],
+            [3, 7, 8, 1, 7, 1, 8],
+            [4, 11, 12, 1, 11, 1, 12],
+          ],
+          lineOffsets: [13],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", constExpr: { boolValue: true } },
+                  { id: "3", identExpr: { name: "y" } },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "true_unknown_branch",
+              lineOffsets: [13],
+              positions: { "1": 0, "2": 5, "3": 7, "4": 11 },
+            },
+          },
+          checkedAst:
+            "_?_:_(\n  true~bool,\n  y~dyn^y,\n  z~dyn^z\n)~dyn^conditional",
+          checkedExpr: {
+            referenceMap: {
+              "2": { overloadId: ["conditional"] },
+              "3": { name: "y" },
+              "4": { name: "z" },
+            },
+            typeMap: {
+              "1": { primitive: "BOOL" },
+              "2": { dyn: {} },
+              "3": { dyn: {} },
+              "4": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [13],
+              positions: { "1": 0, "2": 5, "3": 7, "4": 11 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", constExpr: { boolValue: true } },
+                  { id: "3", identExpr: { name: "y" } },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [{ id: 3, variable: "y" }],
+          residualAst: "y^#*expr.Expr_IdentExpr#",
+          residual: "y",
+        },
+        {
+          original: {
+            name: "false_unknown_branch",
+            expr: "false ? y : z",
+            typeEnv: [
+              { name: "y", ident: { type: { dyn: {} } } },
+              { name: "z", ident: { type: { dyn: {} } } },
+            ],
+            bindings: { z: { value: { int64Value: "2" } } },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "y" }],
+          ast: "_?_:_(\n  false^#*expr.Constant_BoolValue#,\n  y^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "false ? y : z",
+          locationAst:
+            "_?_:_(\n  false^#1[1,0]#,\n  y^#3[1,8]#,\n  z^#4[1,12]#\n)^#2[1,6]#",
+          positions: [
+            [1, 0, 5, 1, 0, 1, 5],
+            [2, 6, 7, 1, 6, 1, 7],
+            [3, 8, 9, 1, 8, 1, 9],
+            [4, 12, 13, 1, 12, 1, 13],
+          ],
+          lineOffsets: [14],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", constExpr: { boolValue: false } },
+                  { id: "3", identExpr: { name: "y" } },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "false_unknown_branch",
+              lineOffsets: [14],
+              positions: { "1": 0, "2": 6, "3": 8, "4": 12 },
+            },
+          },
+          checkedAst:
+            "_?_:_(\n  false~bool,\n  y~dyn^y,\n  z~dyn^z\n)~dyn^conditional",
+          checkedExpr: {
+            referenceMap: {
+              "2": { overloadId: ["conditional"] },
+              "3": { name: "y" },
+              "4": { name: "z" },
+            },
+            typeMap: {
+              "1": { primitive: "BOOL" },
+              "2": { dyn: {} },
+              "3": { dyn: {} },
+              "4": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [14],
+              positions: { "1": 0, "2": 6, "3": 8, "4": 12 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", constExpr: { boolValue: false } },
+                  { id: "3", identExpr: { name: "y" } },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "1", max: "1" },
+          result: { value: { int64Value: "2" } },
+          residualAst: "2^#*expr.Constant_Int64Value#",
+          residual: "2",
+        },
+        {
+          original: {
+            name: "known_condition",
+            expr: "x ? y : z",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+              { name: "z", ident: { type: { dyn: {} } } },
+            ],
+            bindings: { x: { value: { boolValue: false } } },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "y" }, { variable: "z" }],
+          ast: "_?_:_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x ? y : z",
+          locationAst:
+            "_?_:_(\n  x^#1[1,0]#,\n  y^#3[1,4]#,\n  z^#4[1,8]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 3, 1, 2, 1, 3],
+            [3, 4, 5, 1, 4, 1, 5],
+            [4, 8, 9, 1, 8, 1, 9],
+          ],
+          lineOffsets: [10],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "known_condition",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 2, "3": 4, "4": 8 },
+            },
+          },
+          checkedAst:
+            "_?_:_(\n  x~dyn^x,\n  y~dyn^y,\n  z~dyn^z\n)~dyn^conditional",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["conditional"] },
+              "3": { name: "y" },
+              "4": { name: "z" },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { dyn: {} },
+              "3": { dyn: {} },
+              "4": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [10],
+              positions: { "1": 0, "2": 2, "3": 4, "4": 8 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                  { id: "4", identExpr: { name: "z" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["4"] } },
+          unknownAttributes: [{ id: 4, variable: "z" }],
+          residualAst: "z^#*expr.Expr_IdentExpr#",
+          residual: "z",
+        },
+        {
+          original: {
+            name: "error_condition",
+            expr: "1/0 == 0 ? y : z",
+            typeEnv: [
+              { name: "y", ident: { type: { dyn: {} } } },
+              { name: "z", ident: { type: { dyn: {} } } },
+            ],
+            bindings: { z: { value: { int64Value: "2" } } },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "y" }],
+          ast: "_?_:_(\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  y^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "(1 / 0 == 0) ? y : z",
+          locationAst:
+            "_?_:_(\n  _==_(\n    _/_(\n      1^#1[1,0]#,\n      0^#3[1,2]#\n    )^#2[1,1]#,\n    0^#5[1,7]#\n  )^#4[1,4]#,\n  y^#7[1,11]#,\n  z^#8[1,15]#\n)^#6[1,9]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+            [4, 4, 6, 1, 4, 1, 6],
+            [5, 7, 8, 1, 7, 1, 8],
+            [6, 9, 10, 1, 9, 1, 10],
+            [7, 11, 12, 1, 11, 1, 12],
+            [8, 15, 16, 1, 15, 1, 16],
+          ],
+          lineOffsets: [17],
+          parsedExpr: {
+            expr: {
+              id: "6",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "2",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "1", constExpr: { int64Value: "1" } },
+                              { id: "3", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "7", identExpr: { name: "y" } },
+                  { id: "8", identExpr: { name: "z" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "error_condition",
+              lineOffsets: [17],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 2,
+                "4": 4,
+                "5": 7,
+                "6": 9,
+                "7": 11,
+                "8": 15,
+              },
+            },
+          },
+          checkedAst:
+            "_?_:_(\n  _==_(\n    _/_(\n      1~int,\n      0~int\n    )~int^divide_int64,\n    0~int\n  )~bool^equals,\n  y~dyn^y,\n  z~dyn^z\n)~dyn^conditional",
+          checkedExpr: {
+            referenceMap: {
+              "2": { overloadId: ["divide_int64"] },
+              "4": { overloadId: ["equals"] },
+              "6": { overloadId: ["conditional"] },
+              "7": { name: "y" },
+              "8": { name: "z" },
+            },
+            typeMap: {
+              "1": { primitive: "INT64" },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "4": { primitive: "BOOL" },
+              "5": { primitive: "INT64" },
+              "6": { dyn: {} },
+              "7": { dyn: {} },
+              "8": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [17],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 2,
+                "4": 4,
+                "5": 7,
+                "6": 9,
+                "7": 11,
+                "8": 15,
+              },
+            },
+            expr: {
+              id: "6",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_==_",
+                      args: [
+                        {
+                          id: "2",
+                          callExpr: {
+                            function: "_/_",
+                            args: [
+                              { id: "1", constExpr: { int64Value: "1" } },
+                              { id: "3", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        { id: "5", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "7", identExpr: { name: "y" } },
+                  { id: "8", identExpr: { name: "z" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "3", max: "3" },
+          result: {
+            error: { errors: [{ code: 2, message: "division by zero" }] },
+          },
+          residualAst:
+            "_?_:_(\n  _==_(\n    _/_(\n      1^#*expr.Constant_Int64Value#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  y^#*expr.Expr_IdentExpr#,\n  z^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "(1 / 0 == 0) ? y : z",
+        },
+      ],
+    },
+    {
+      name: "call",
+      tests: [
+        {
+          original: {
+            name: "unary",
+            expr: "-x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "-_(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "-x",
+          locationAst: "-_(\n  x^#2[1,1]#\n)^#1[1,0]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+          ],
+          lineOffsets: [3],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              callExpr: {
+                function: "-_",
+                args: [{ id: "2", identExpr: { name: "x" } }],
+              },
+            },
+            sourceInfo: {
+              location: "unary",
+              lineOffsets: [3],
+              positions: { "1": 0, "2": 1 },
+            },
+          },
+          checkedAst: "-_(\n  x~dyn^x\n)~dyn^negate_double|negate_int64",
+          checkedExpr: {
+            referenceMap: {
+              "1": { overloadId: ["negate_double", "negate_int64"] },
+              "2": { name: "x" },
+            },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [3],
+              positions: { "1": 0, "2": 1 },
+            },
+            expr: {
+              id: "1",
+              callExpr: {
+                function: "-_",
+                args: [{ id: "2", identExpr: { name: "x" } }],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "-_(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "-x",
+        },
+        {
+          original: {
+            name: "binary_unknown_known",
+            expr: "x + 1",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_+_(\n  x^#*expr.Expr_IdentExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x + 1",
+          locationAst: "_+_(\n  x^#1[1,0]#,\n  1^#3[1,4]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 3, 1, 2, 1, 3],
+            [3, 4, 5, 1, 4, 1, 5],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "binary_unknown_known",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 2, "3": 4 },
+            },
+          },
+          checkedAst: "_+_(\n  x~dyn^x,\n  1~int\n)~int^add_int64",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["add_int64"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 2, "3": 4 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+          type: "int",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "_+_(\n  x^#*expr.Expr_IdentExpr#,\n  1^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+          residual: "x + 1",
+        },
+        {
+          original: {
+            name: "binary_unknown_unknown",
+            expr: "x + y",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }],
+          ast: "_+_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x + y",
+          locationAst: "_+_(\n  x^#1[1,0]#,\n  y^#3[1,4]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 3, 1, 2, 1, 3],
+            [3, 4, 5, 1, 4, 1, 5],
+          ],
+          lineOffsets: [6],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "binary_unknown_unknown",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 2, "3": 4 },
+            },
+          },
+          checkedAst:
+            "_+_(\n  x~dyn^x,\n  y~dyn^y\n)~dyn^add_bytes|add_double|add_duration_duration|add_duration_timestamp|add_int64|add_list|add_string|add_timestamp_duration|add_uint64",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": {
+                overloadId: [
+                  "add_bytes",
+                  "add_double",
+                  "add_duration_duration",
+                  "add_duration_timestamp",
+                  "add_int64",
+                  "add_list",
+                  "add_string",
+                  "add_timestamp_duration",
+                  "add_uint64",
+                ],
+              },
+              "3": { name: "y" },
+            },
+            typeMap: { "1": { dyn: {} }, "2": { dyn: {} }, "3": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [6],
+              positions: { "1": 0, "2": 2, "3": 4 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+          },
+          type: "dyn",
+          cost: { min: "2", max: "1844674407370955266" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "_+_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x + y",
+        },
+        {
+          original: {
+            name: "binary_unknown_error",
+            expr: "x + 1/0",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_+_(\n  x^#*expr.Expr_IdentExpr#,\n  _/_(\n    1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x + 1 / 0",
+          locationAst:
+            "_+_(\n  x^#1[1,0]#,\n  _/_(\n    1^#3[1,4]#,\n    0^#5[1,6]#\n  )^#4[1,5]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 3, 1, 2, 1, 3],
+            [3, 4, 5, 1, 4, 1, 5],
+            [4, 5, 6, 1, 5, 1, 6],
+            [5, 6, 7, 1, 6, 1, 7],
+          ],
+          lineOffsets: [8],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_/_",
+                      args: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "5", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "binary_unknown_error",
+              lineOffsets: [8],
+              positions: { "1": 0, "2": 2, "3": 4, "4": 5, "5": 6 },
+            },
+          },
+          checkedAst:
+            "_+_(\n  x~dyn^x,\n  _/_(\n    1~int,\n    0~int\n  )~int^divide_int64\n)~int^add_int64",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["add_int64"] },
+              "4": { overloadId: ["divide_int64"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "4": { primitive: "INT64" },
+              "5": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [8],
+              positions: { "1": 0, "2": 2, "3": 4, "4": 5, "5": 6 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "_/_",
+                      args: [
+                        { id: "3", constExpr: { int64Value: "1" } },
+                        { id: "5", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+          type: "int",
+          cost: { min: "3", max: "3" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "_+_(\n  x^#*expr.Expr_IdentExpr#,\n  _/_(\n    1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x + 1 / 0",
+        },
+        {
+          original: {
+            name: "binary_error_unknown",
+            expr: "1/0 + x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "_+_(\n  _/_(\n    1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "1 / 0 + x",
+          locationAst:
+            "_+_(\n  _/_(\n    1^#1[1,0]#,\n    0^#3[1,2]#\n  )^#2[1,1]#,\n  x^#5[1,6]#\n)^#4[1,4]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 2, 3, 1, 2, 1, 3],
+            [4, 4, 5, 1, 4, 1, 5],
+            [5, 6, 7, 1, 6, 1, 7],
+          ],
+          lineOffsets: [8],
+          parsedExpr: {
+            expr: {
+              id: "4",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  {
+                    id: "2",
+                    callExpr: {
+                      function: "_/_",
+                      args: [
+                        { id: "1", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "5", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "binary_error_unknown",
+              lineOffsets: [8],
+              positions: { "1": 0, "2": 1, "3": 2, "4": 4, "5": 6 },
+            },
+          },
+          checkedAst:
+            "_+_(\n  _/_(\n    1~int,\n    0~int\n  )~int^divide_int64,\n  x~dyn^x\n)~int^add_int64",
+          checkedExpr: {
+            referenceMap: {
+              "2": { overloadId: ["divide_int64"] },
+              "4": { overloadId: ["add_int64"] },
+              "5": { name: "x" },
+            },
+            typeMap: {
+              "1": { primitive: "INT64" },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "4": { primitive: "INT64" },
+              "5": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [8],
+              positions: { "1": 0, "2": 1, "3": 2, "4": 4, "5": 6 },
+            },
+            expr: {
+              id: "4",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  {
+                    id: "2",
+                    callExpr: {
+                      function: "_/_",
+                      args: [
+                        { id: "1", constExpr: { int64Value: "1" } },
+                        { id: "3", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  { id: "5", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+          type: "int",
+          cost: { min: "3", max: "3" },
+          result: {
+            error: { errors: [{ code: 2, message: "division by zero" }] },
+          },
+          residualAst:
+            "_+_(\n  _/_(\n    1^#*expr.Constant_Int64Value#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "1 / 0 + x",
+        },
+        {
+          original: {
+            name: "equality",
+            expr: "x == y",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }],
+          ast: "_==_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x == y",
+          locationAst: "_==_(\n  x^#1[1,0]#,\n  y^#3[1,5]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 4, 1, 2, 1, 4],
+            [3, 5, 6, 1, 5, 1, 6],
+          ],
+          lineOffsets: [7],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "equality",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 2, "3": 5 },
+            },
+          },
+          checkedAst: "_==_(\n  x~dyn^x,\n  y~dyn^y\n)~bool^equals",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["equals"] },
+              "3": { name: "y" },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "BOOL" },
+              "3": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 2, "3": 5 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "3", max: "1844674407370955266" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "_==_(\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x == y",
+        },
+        {
+          original: {
+            name: "global",
+            expr: "size(x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "size(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "size(x)",
+          locationAst: "size(\n  x^#2[1,5]#\n)^#1[1,4]#",
+          positions: [
+            [1, 4, 5, 1, 4, 1, 5],
+            [2, 5, 6, 1, 5, 1, 6],
+          ],
+          lineOffsets: [8],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              callExpr: {
+                function: "size",
+                args: [{ id: "2", identExpr: { name: "x" } }],
+              },
+            },
+            sourceInfo: {
+              location: "global",
+              lineOffsets: [8],
+              positions: { "1": 4, "2": 5 },
+            },
+          },
+          checkedAst:
+            "size(\n  x~dyn^x\n)~int^size_bytes|size_list|size_map|size_string",
+          checkedExpr: {
+            referenceMap: {
+              "1": {
+                overloadId: [
+                  "size_bytes",
+                  "size_list",
+                  "size_map",
+                  "size_string",
+                ],
+              },
+              "2": { name: "x" },
+            },
+            typeMap: { "1": { primitive: "INT64" }, "2": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [8],
+              positions: { "1": 4, "2": 5 },
+            },
+            expr: {
+              id: "1",
+              callExpr: {
+                function: "size",
+                args: [{ id: "2", identExpr: { name: "x" } }],
+              },
+            },
+          },
+          type: "int",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "size(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "size(x)",
+        },
+        {
+          original: {
+            name: "member_unknown_target",
+            expr: "x.startsWith('a')",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: 'x^#*expr.Expr_IdentExpr#.startsWith(\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+          unparsed: 'x.startsWith("a")',
+          locationAst: 'x^#1[1,0]#.startsWith(\n  "a"^#3[1,13]#\n)^#2[1,12]#',
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 12, 13, 1, 12, 1, 13],
+            [3, 13, 16, 1, 13, 1, 16],
+          ],
+          lineOffsets: [18],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                target: { id: "1", identExpr: { name: "x" } },
+                function: "startsWith",
+                args: [{ id: "3", constExpr: { stringValue: "a" } }],
+              },
+            },
+            sourceInfo: {
+              location: "member_unknown_target",
+              lineOffsets: [18],
+              positions: { "1": 0, "2": 12, "3": 13 },
+            },
+          },
+          checkedAst:
+            'x~dyn^x.startsWith(\n  "a"~string\n)~bool^starts_with_string',
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["starts_with_string"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "BOOL" },
+              "3": { primitive: "STRING" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [18],
+              positions: { "1": 0, "2": 12, "3": 13 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                target: { id: "1", identExpr: { name: "x" } },
+                function: "startsWith",
+                args: [{ id: "3", constExpr: { stringValue: "a" } }],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            'x^#*expr.Expr_IdentExpr#.startsWith(\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+          residual: 'x.startsWith("a")',
+        },
+        {
+          original: {
+            name: "member_unknown_arg",
+            expr: "'abc'.startsWith(x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: '"abc"^#*expr.Constant_StringValue#.startsWith(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+          unparsed: '"abc".startsWith(x)',
+          locationAst: '"abc"^#1[1,0]#.startsWith(\n  x^#3[1,17]#\n)^#2[1,16]#',
+          positions: [
+            [1, 0, 5, 1, 0, 1, 5],
+            [2, 16, 17, 1, 16, 1, 17],
+            [3, 17, 18, 1, 17, 1, 18],
+          ],
+          lineOffsets: [20],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                target: { id: "1", constExpr: { stringValue: "abc" } },
+                function: "startsWith",
+                args: [{ id: "3", identExpr: { name: "x" } }],
+              },
+            },
+            sourceInfo: {
+              location: "member_unknown_arg",
+              lineOffsets: [20],
+              positions: { "1": 0, "2": 16, "3": 17 },
+            },
+          },
+          checkedAst:
+            '"abc"~string.startsWith(\n  x~dyn^x\n)~bool^starts_with_string',
+          checkedExpr: {
+            referenceMap: {
+              "2": { overloadId: ["starts_with_string"] },
+              "3": { name: "x" },
+            },
+            typeMap: {
+              "1": { primitive: "STRING" },
+              "2": { primitive: "BOOL" },
+              "3": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [20],
+              positions: { "1": 0, "2": 16, "3": 17 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                target: { id: "1", constExpr: { stringValue: "abc" } },
+                function: "startsWith",
+                args: [{ id: "3", identExpr: { name: "x" } }],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "1844674407370955265" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [{ id: 3, variable: "x" }],
+          residualAst:
+            '"abc"^#*expr.Constant_StringValue#.startsWith(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#',
+          residual: '"abc".startsWith(x)',
+        },
+        {
+          original: {
+            name: "nested",
+            expr: "string(x) + string(y)",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }],
+          ast: "_+_(\n  string(\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  string(\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "string(x) + string(y)",
+          locationAst:
+            "_+_(\n  string(\n    x^#2[1,7]#\n  )^#1[1,6]#,\n  string(\n    y^#5[1,19]#\n  )^#4[1,18]#\n)^#3[1,10]#",
+          positions: [
+            [1, 6, 7, 1, 6, 1, 7],
+            [2, 7, 8, 1, 7, 1, 8],
+            [3, 10, 11, 1, 10, 1, 11],
+            [4, 18, 19, 1, 18, 1, 19],
+            [5, 19, 20, 1, 19, 1, 20],
+          ],
+          lineOffsets: [22],
+          parsedExpr: {
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  {
+                    id: "1",
+                    callExpr: {
+                      function: "string",
+                      args: [{ id: "2", identExpr: { name: "x" } }],
+                    },
+                  },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "string",
+                      args: [{ id: "5", identExpr: { name: "y" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "nested",
+              lineOffsets: [22],
+              positions: { "1": 6, "2": 7, "3": 10, "4": 18, "5": 19 },
+            },
+          },
+          checkedAst:
+            "_+_(\n  string(\n    x~dyn^x\n  )~string^bool_to_string|bytes_to_string|double_to_string|duration_to_string|int64_to_string|string_to_string|timestamp_to_string|uint64_to_string,\n  string(\n    y~dyn^y\n  )~string^bool_to_string|bytes_to_string|double_to_string|duration_to_string|int64_to_string|string_to_string|timestamp_to_string|uint64_to_string\n)~string^add_string",
+          checkedExpr: {
+            referenceMap: {
+              "1": {
+                overloadId: [
+                  "bool_to_string",
+                  "bytes_to_string",
+                  "double_to_string",
+                  "duration_to_string",
+                  "int64_to_string",
+                  "string_to_string",
+                  "timestamp_to_string",
+                  "uint64_to_string",
+                ],
+              },
+              "2": { name: "x" },
+              "3": { overloadId: ["add_string"] },
+              "4": {
+                overloadId: [
+                  "bool_to_string",
+                  "bytes_to_string",
+                  "double_to_string",
+                  "duration_to_string",
+                  "int64_to_string",
+                  "string_to_string",
+                  "timestamp_to_string",
+                  "uint64_to_string",
+                ],
+              },
+              "5": { name: "y" },
+            },
+            typeMap: {
+              "1": { primitive: "STRING" },
+              "2": { dyn: {} },
+              "3": { primitive: "STRING" },
+              "4": { primitive: "STRING" },
+              "5": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [22],
+              positions: { "1": 6, "2": 7, "3": 10, "4": 18, "5": 19 },
+            },
+            expr: {
+              id: "3",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  {
+                    id: "1",
+                    callExpr: {
+                      function: "string",
+                      args: [{ id: "2", identExpr: { name: "x" } }],
+                    },
+                  },
+                  {
+                    id: "4",
+                    callExpr: {
+                      function: "string",
+                      args: [{ id: "5", identExpr: { name: "y" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+          type: "string",
+          cost: { min: "2", max: "5534023222112865794" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "_+_(\n  string(\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  string(\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "string(x) + string(y)",
+        },
+        {
+          original: {
+            name: "in_unknown_element",
+            expr: "x in [1, 2]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "@in(\n  x^#*expr.Expr_IdentExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "x in [1, 2]",
+          locationAst:
+            "@in(\n  x^#1[1,0]#,\n  [\n    1^#4[1,6]#,\n    2^#5[1,9]#\n  ]^#3[1,5]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 4, 1, 2, 1, 4],
+            [3, 5, 6, 1, 5, 1, 6],
+            [4, 6, 7, 1, 6, 1, 7],
+            [5, 9, 10, 1, 9, 1, 10],
+          ],
+          lineOffsets: [12],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "@in",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "3",
+                    listExpr: {
+                      elements: [
+                        { id: "4", constExpr: { int64Value: "1" } },
+                        { id: "5", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "in_unknown_element",
+              lineOffsets: [12],
+              positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9 },
+            },
+          },
+          checkedAst:
+            "@in(\n  x~dyn^x,\n  [\n    1~int,\n    2~int\n  ]~list(int)\n)~bool^in_list",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "2": { overloadId: ["in_list"] },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "2": { primitive: "BOOL" },
+              "3": { listType: { elemType: { primitive: "INT64" } } },
+              "4": { primitive: "INT64" },
+              "5": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [12],
+              positions: { "1": 0, "2": 2, "3": 5, "4": 6, "5": 9 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "@in",
+                args: [
+                  { id: "1", identExpr: { name: "x" } },
+                  {
+                    id: "3",
+                    listExpr: {
+                      elements: [
+                        { id: "4", constExpr: { int64Value: "1" } },
+                        { id: "5", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "13", max: "13" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "@in(\n  x^#*expr.Expr_IdentExpr#,\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "x in [1, 2]",
+        },
+        {
+          original: {
+            name: "in_unknown_list",
+            expr: "1 in x",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "@in(\n  1^#*expr.Constant_Int64Value#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "1 in x",
+          locationAst: "@in(\n  1^#1[1,0]#,\n  x^#3[1,5]#\n)^#2[1,2]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 4, 1, 2, 1, 4],
+            [3, 5, 6, 1, 5, 1, 6],
+          ],
+          lineOffsets: [7],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "@in",
+                args: [
+                  { id: "1", constExpr: { int64Value: "1" } },
+                  { id: "3", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "in_unknown_list",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 2, "3": 5 },
+            },
+          },
+          checkedAst: "@in(\n  1~int,\n  x~dyn^x\n)~bool^in_list|in_map",
+          checkedExpr: {
+            referenceMap: {
+              "2": { overloadId: ["in_list", "in_map"] },
+              "3": { name: "x" },
+            },
+            typeMap: {
+              "1": { primitive: "INT64" },
+              "2": { primitive: "BOOL" },
+              "3": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 2, "3": 5 },
+            },
+            expr: {
+              id: "2",
+              callExpr: {
+                function: "@in",
+                args: [
+                  { id: "1", constExpr: { int64Value: "1" } },
+                  { id: "3", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "1", max: "18446744073709551615" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [{ id: 3, variable: "x" }],
+          residualAst:
+            "@in(\n  1^#*expr.Constant_Int64Value#,\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "1 in x",
+        },
+        {
+          original: {
+            name: "type",
+            expr: "type(x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "type(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "type(x)",
+          locationAst: "type(\n  x^#2[1,5]#\n)^#1[1,4]#",
+          positions: [
+            [1, 4, 5, 1, 4, 1, 5],
+            [2, 5, 6, 1, 5, 1, 6],
+          ],
+          lineOffsets: [8],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              callExpr: {
+                function: "type",
+                args: [{ id: "2", identExpr: { name: "x" } }],
+              },
+            },
+            sourceInfo: {
+              location: "type",
+              lineOffsets: [8],
+              positions: { "1": 4, "2": 5 },
+            },
+          },
+          checkedAst: "type(\n  x~dyn^x\n)~type(dyn)^type",
+          checkedExpr: {
+            referenceMap: { "1": { overloadId: ["type"] }, "2": { name: "x" } },
+            typeMap: { "1": { type: { dyn: {} } }, "2": { dyn: {} } },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [8],
+              positions: { "1": 4, "2": 5 },
+            },
+            expr: {
+              id: "1",
+              callExpr: {
+                function: "type",
+                args: [{ id: "2", identExpr: { name: "x" } }],
+              },
+            },
+          },
+          type: "type(dyn)",
+          cost: { min: "2", max: "2" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "type(\n  x^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+          residual: "type(x)",
+        },
+      ],
+    },
+    {
+      name: "literal",
+      tests: [
+        {
+          original: {
+            name: "list_element",
+            expr: "[1, x]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "[\n  1^#*expr.Constant_Int64Value#,\n  x^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+          unparsed: "[1, x]",
+          locationAst: "[\n  1^#2[1,1]#,\n  x^#3[1,4]#\n]^#1[1,0]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+          ],
+          lineOffsets: [7],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", identExpr: { name: "x" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "list_element",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 1, "3": 4 },
+            },
+          },
+          checkedAst: "[\n  1~int,\n  x~dyn^x\n]~list(dyn)",
+          checkedExpr: {
+            referenceMap: { "3": { name: "x" } },
+            typeMap: {
+              "1": { listType: { elemType: { dyn: {} } } },
+              "2": { primitive: "INT64" },
+              "3": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 1, "3": 4 },
+            },
+            expr: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", identExpr: { name: "x" } },
+                ],
+              },
+            },
+          },
+          type: "list(dyn)",
+          cost: { min: "11", max: "11" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [{ id: 3, variable: "x" }],
+          residualAst:
+            "[\n  1^#*expr.Constant_Int64Value#,\n  x^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+          residual: "[1, x]",
+        },
+        {
+          original: {
+            name: "list_elements",
+            expr: "[x, y]",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }],
+          ast: "[\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+          unparsed: "[x, y]",
+          locationAst: "[\n  x^#2[1,1]#,\n  y^#3[1,4]#\n]^#1[1,0]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+          ],
+          lineOffsets: [7],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "list_elements",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 1, "3": 4 },
+            },
+          },
+          checkedAst: "[\n  x~dyn^x,\n  y~dyn^y\n]~list(dyn)",
+          checkedExpr: {
+            referenceMap: { "2": { name: "x" }, "3": { name: "y" } },
+            typeMap: {
+              "1": { listType: { elemType: { dyn: {} } } },
+              "2": { dyn: {} },
+              "3": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 1, "3": 4 },
+            },
+            expr: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", identExpr: { name: "x" } },
+                  { id: "3", identExpr: { name: "y" } },
+                ],
+              },
+            },
+          },
+          type: "list(dyn)",
+          cost: { min: "12", max: "12" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "[\n  x^#*expr.Expr_IdentExpr#,\n  y^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+          residual: "[x, y]",
+        },
+        {
+          original: {
+            name: "map_key",
+            expr: "{x: 1}",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "{\n  x^#*expr.Expr_IdentExpr#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+          unparsed: "{x: 1}",
+          locationAst: "{\n  x^#3[1,1]#:1^#4[1,4]#^#2[1,2]#\n}^#1[1,0]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 3, 1, 2, 1, 3],
+            [3, 1, 2, 1, 1, 1, 2],
+            [4, 4, 5, 1, 4, 1, 5],
+          ],
+          lineOffsets: [7],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", identExpr: { name: "x" } },
+                    value: { id: "4", constExpr: { int64Value: "1" } },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "map_key",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 2, "3": 1, "4": 4 },
+            },
+          },
+          checkedAst: "{\n  x~dyn^x:1~int\n}~map(dyn, int)",
+          checkedExpr: {
+            referenceMap: { "3": { name: "x" } },
+            typeMap: {
+              "1": {
+                mapType: {
+                  keyType: { dyn: {} },
+                  valueType: { primitive: "INT64" },
+                },
+              },
+              "3": { dyn: {} },
+              "4": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [7],
+              positions: { "1": 0, "2": 2, "3": 1, "4": 4 },
+            },
+            expr: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", identExpr: { name: "x" } },
+                    value: { id: "4", constExpr: { int64Value: "1" } },
+                  },
+                ],
+              },
+            },
+          },
+          type: "map(dyn, int)",
+          cost: { min: "31", max: "31" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [{ id: 3, variable: "x" }],
+          residualAst:
+            "{\n  x^#*expr.Expr_IdentExpr#:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+          residual: "{x: 1}",
+        },
+        {
+          original: {
+            name: "map_value",
+            expr: "{'a': x}",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: '{\n  "a"^#*expr.Constant_StringValue#:x^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+          unparsed: '{"a": x}',
+          locationAst: '{\n  "a"^#3[1,1]#:x^#4[1,6]#^#2[1,4]#\n}^#1[1,0]#',
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 4, 5, 1, 4, 1, 5],
+            [3, 1, 4, 1, 1, 1, 4],
+            [4, 6, 7, 1, 6, 1, 7],
+          ],
+          lineOffsets: [9],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                    value: { id: "4", identExpr: { name: "x" } },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "map_value",
+              lineOffsets: [9],
+              positions: { "1": 0, "2": 4, "3": 1, "4": 6 },
+            },
+          },
+          checkedAst: '{\n  "a"~string:x~dyn^x\n}~map(string, dyn)',
+          checkedExpr: {
+            referenceMap: { "4": { name: "x" } },
+            typeMap: {
+              "1": {
+                mapType: {
+                  keyType: { primitive: "STRING" },
+                  valueType: { dyn: {} },
+                },
+              },
+              "3": { primitive: "STRING" },
+              "4": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [9],
+              positions: { "1": 0, "2": 4, "3": 1, "4": 6 },
+            },
+            expr: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                    value: { id: "4", identExpr: { name: "x" } },
+                  },
+                ],
+              },
+            },
+          },
+          type: "map(string, dyn)",
+          cost: { min: "31", max: "31" },
+          result: { unknown: { exprs: ["4"] } },
+          unknownAttributes: [{ id: 4, variable: "x" }],
+          residualAst:
+            '{\n  "a"^#*expr.Constant_StringValue#:x^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+          residual: '{"a": x}',
+        },
+        {
+          original: {
+            name: "optional_list_element",
+            expr: "[?x]",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "[\n  x^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+          unparsed: "[?x]",
+          locationAst: "[\n  x^#2[1,2]#\n]^#1[1,0]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 2, 3, 1, 2, 1, 3],
+          ],
+          lineOffsets: [5],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              listExpr: {
+                elements: [{ id: "2", identExpr: { name: "x" } }],
+                optionalIndices: [0],
+              },
+            },
+            sourceInfo: {
+              location: "optional_list_element",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 2 },
+            },
+          },
+          checkedAst: "[\n  x~dyn^x\n]~list(dyn)",
+          checkedExpr: {
+            referenceMap: { "2": { name: "x" } },
+            typeMap: {
+              "1": { listType: { elemType: { dyn: {} } } },
+              "2": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [5],
+              positions: { "1": 0, "2": 2 },
+            },
+            expr: {
+              id: "1",
+              listExpr: {
+                elements: [{ id: "2", identExpr: { name: "x" } }],
+                optionalIndices: [0],
+              },
+            },
+          },
+          type: "list(dyn)",
+          cost: { min: "11", max: "11" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst: "[\n  x^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+          residual: "[?x]",
+        },
+        {
+          original: {
+            name: "message_field",
+            expr: "cel.expr.conformance.proto3.TestAllTypes{single_int64: x}",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "cel.expr.conformance.proto3.TestAllTypes{\n  single_int64:x^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+          unparsed: "cel.expr.conformance.proto3.TestAllTypes{single_int64: x}",
+          locationAst:
+            "cel.expr.conformance.proto3.TestAllTypes{\n  single_int64:x^#3[1,55]#^#2[1,53]#\n}^#1[1,40]#",
+          positions: [
+            [1, 40, 41, 1, 40, 1, 41],
+            [2, 53, 54, 1, 53, 1, 54],
+            [3, 55, 56, 1, 55, 1, 56],
+          ],
+          lineOffsets: [58],
+          parsedExpr: {
+            expr: {
+              id: "1",
+              structExpr: {
+                messageName: "cel.expr.conformance.proto3.TestAllTypes",
+                entries: [
+                  {
+                    id: "2",
+                    fieldKey: "single_int64",
+                    value: { id: "3", identExpr: { name: "x" } },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "message_field",
+              lineOffsets: [58],
+              positions: { "1": 40, "2": 53, "3": 55 },
+            },
+          },
+          checkedAst:
+            "cel.expr.conformance.proto3.TestAllTypes{\n  single_int64:x~dyn^x\n}~cel.expr.conformance.proto3.TestAllTypes^cel.expr.conformance.proto3.TestAllTypes",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "cel.expr.conformance.proto3.TestAllTypes" },
+              "3": { name: "x" },
+            },
+            typeMap: {
+              "1": { messageType: "cel.expr.conformance.proto3.TestAllTypes" },
+              "3": { dyn: {} },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [58],
+              positions: { "1": 40, "2": 53, "3": 55 },
+            },
+            expr: {
+              id: "1",
+              structExpr: {
+                messageName: "cel.expr.conformance.proto3.TestAllTypes",
+                entries: [
+                  {
+                    id: "2",
+                    fieldKey: "single_int64",
+                    value: { id: "3", identExpr: { name: "x" } },
+                  },
+                ],
+              },
+            },
+          },
+          type: "cel.expr.conformance.proto3.TestAllTypes",
+          cost: { min: "41", max: "41" },
+          result: { unknown: { exprs: ["3"] } },
+          unknownAttributes: [{ id: 3, variable: "x" }],
+          residualAst:
+            "cel.expr.conformance.proto3.TestAllTypes{\n  single_int64:x^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+          residual: "cel.expr.conformance.proto3.TestAllTypes{single_int64: x}",
+        },
+      ],
+    },
+    {
+      name: "comprehension",
+      tests: [
+        {
+          original: {
+            name: "unknown_range",
+            expr: "x.exists(i, i \u003e 0)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003e_(\n      i^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "x.exists(i, i \u003e 0)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  x^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#7[1,8]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#8[1,8]#\n    )^#9[1,8]#\n  )^#10[1,8]#,\n  // LoopStep\n  _||_(\n    @result^#11[1,8]#,\n    _\u003e_(\n      i^#4[1,12]#,\n      0^#6[1,16]#\n    )^#5[1,14]#\n  )^#12[1,8]#,\n  // Result\n  @result^#13[1,8]#)^#14[1,8]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [3, 9, 10, 1, 9, 1, 10],
+            [4, 12, 13, 1, 12, 1, 13],
+            [5, 14, 15, 1, 14, 1, 15],
+            [6, 16, 17, 1, 16, 1, 17],
+            [7, 8, 8, 1, 8, 1, 8],
+            [8, 8, 8, 1, 8, 1, 8],
+            [9, 8, 8, 1, 8, 1, 8],
+            [10, 8, 8, 1, 8, 1, 8],
+            [11, 8, 8, 1, 8, 1, 8],
+            [12, 8, 8, 1, 8, 1, 8],
+            [13, 8, 8, 1, 8, 1, 8],
+            [14, 8, 8, 1, 8, 1, 8],
+          ],
+          lineOffsets: [19],
+          parsedExpr: {
+            expr: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: { id: "1", identExpr: { name: "x" } },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "10",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "8", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "4", identExpr: { name: "i" } },
+                            { id: "6", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "unknown_range",
+              lineOffsets: [19],
+              positions: {
+                "1": 0,
+                "3": 9,
+                "4": 12,
+                "5": 14,
+                "6": 16,
+                "7": 8,
+                "8": 8,
+                "9": 8,
+                "10": 8,
+                "11": 8,
+                "12": 8,
+                "13": 8,
+                "14": 8,
+              },
+              macroCalls: {
+                "14": {
+                  callExpr: {
+                    target: { id: "1", identExpr: { name: "x" } },
+                    function: "exists",
+                    args: [
+                      { id: "3", identExpr: { name: "i" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "4", identExpr: { name: "i" } },
+                            { id: "6", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 14,
+              function: "exists",
+              target: { id: "1", identExpr: { name: "x" } },
+              args: [
+                { id: "3", identExpr: { name: "i" } },
+                {
+                  id: "5",
+                  callExpr: {
+                    function: "_\u003e_",
+                    args: [
+                      { id: "4", identExpr: { name: "i" } },
+                      { id: "6", constExpr: { int64Value: "0" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  x~dyn^x,\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _\u003e_(\n      i~dyn^i,\n      0~int\n    )~bool^greater_double_int64|greater_int64|greater_uint64_int64\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "4": { name: "i" },
+              "5": {
+                overloadId: [
+                  "greater_double_int64",
+                  "greater_int64",
+                  "greater_uint64_int64",
+                ],
+              },
+              "8": { name: "@result" },
+              "9": { overloadId: ["logical_not"] },
+              "10": { overloadId: ["not_strictly_false"] },
+              "11": { name: "@result" },
+              "12": { overloadId: ["logical_or"] },
+              "13": { name: "@result" },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "4": { dyn: {} },
+              "5": { primitive: "BOOL" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+              "8": { primitive: "BOOL" },
+              "9": { primitive: "BOOL" },
+              "10": { primitive: "BOOL" },
+              "11": { primitive: "BOOL" },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+              "14": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [19],
+              positions: {
+                "1": 0,
+                "3": 9,
+                "4": 12,
+                "5": 14,
+                "6": 16,
+                "7": 8,
+                "8": 8,
+                "9": 8,
+                "10": 8,
+                "11": 8,
+                "12": 8,
+                "13": 8,
+                "14": 8,
+              },
+              macroCalls: {
+                "14": {
+                  callExpr: {
+                    target: { id: "1", identExpr: { name: "x" } },
+                    function: "exists",
+                    args: [
+                      { id: "3", identExpr: { name: "i" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "4", identExpr: { name: "i" } },
+                            { id: "6", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: { id: "1", identExpr: { name: "x" } },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "10",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "8", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "4", identExpr: { name: "i" } },
+                            { id: "6", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "2", max: "18446744073709551615" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003e_(\n      i^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "x.exists(i, i \u003e 0)",
+        },
+        {
+          original: {
+            name: "unknown_range_element",
+            expr: "x.exists(i, i \u003e 0)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+            bindings: {
+              x: {
+                value: {
+                  listValue: {
+                    values: [{ int64Value: "0" }, { int64Value: "1" }],
+                  },
+                },
+              },
+            },
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x", qualifiers: [{ int: 0 }] }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003e_(\n      i^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "x.exists(i, i \u003e 0)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  x^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#7[1,8]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#8[1,8]#\n    )^#9[1,8]#\n  )^#10[1,8]#,\n  // LoopStep\n  _||_(\n    @result^#11[1,8]#,\n    _\u003e_(\n      i^#4[1,12]#,\n      0^#6[1,16]#\n    )^#5[1,14]#\n  )^#12[1,8]#,\n  // Result\n  @result^#13[1,8]#)^#14[1,8]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [3, 9, 10, 1, 9, 1, 10],
+            [4, 12, 13, 1, 12, 1, 13],
+            [5, 14, 15, 1, 14, 1, 15],
+            [6, 16, 17, 1, 16, 1, 17],
+            [7, 8, 8, 1, 8, 1, 8],
+            [8, 8, 8, 1, 8, 1, 8],
+            [9, 8, 8, 1, 8, 1, 8],
+            [10, 8, 8, 1, 8, 1, 8],
+            [11, 8, 8, 1, 8, 1, 8],
+            [12, 8, 8, 1, 8, 1, 8],
+            [13, 8, 8, 1, 8, 1, 8],
+            [14, 8, 8, 1, 8, 1, 8],
+          ],
+          lineOffsets: [19],
+          parsedExpr: {
+            expr: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: { id: "1", identExpr: { name: "x" } },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "10",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "8", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "4", identExpr: { name: "i" } },
+                            { id: "6", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "unknown_range_element",
+              lineOffsets: [19],
+              positions: {
+                "1": 0,
+                "3": 9,
+                "4": 12,
+                "5": 14,
+                "6": 16,
+                "7": 8,
+                "8": 8,
+                "9": 8,
+                "10": 8,
+                "11": 8,
+                "12": 8,
+                "13": 8,
+                "14": 8,
+              },
+              macroCalls: {
+                "14": {
+                  callExpr: {
+                    target: { id: "1", identExpr: { name: "x" } },
+                    function: "exists",
+                    args: [
+                      { id: "3", identExpr: { name: "i" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "4", identExpr: { name: "i" } },
+                            { id: "6", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 14,
+              function: "exists",
+              target: { id: "1", identExpr: { name: "x" } },
+              args: [
+                { id: "3", identExpr: { name: "i" } },
+                {
+                  id: "5",
+                  callExpr: {
+                    function: "_\u003e_",
+                    args: [
+                      { id: "4", identExpr: { name: "i" } },
+                      { id: "6", constExpr: { int64Value: "0" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  x~dyn^x,\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _\u003e_(\n      i~dyn^i,\n      0~int\n    )~bool^greater_double_int64|greater_int64|greater_uint64_int64\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "1": { name: "x" },
+              "4": { name: "i" },
+              "5": {
+                overloadId: [
+                  "greater_double_int64",
+                  "greater_int64",
+                  "greater_uint64_int64",
+                ],
+              },
+              "8": { name: "@result" },
+              "9": { overloadId: ["logical_not"] },
+              "10": { overloadId: ["not_strictly_false"] },
+              "11": { name: "@result" },
+              "12": { overloadId: ["logical_or"] },
+              "13": { name: "@result" },
+            },
+            typeMap: {
+              "1": { dyn: {} },
+              "4": { dyn: {} },
+              "5": { primitive: "BOOL" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+              "8": { primitive: "BOOL" },
+              "9": { primitive: "BOOL" },
+              "10": { primitive: "BOOL" },
+              "11": { primitive: "BOOL" },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+              "14": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [19],
+              positions: {
+                "1": 0,
+                "3": 9,
+                "4": 12,
+                "5": 14,
+                "6": 16,
+                "7": 8,
+                "8": 8,
+                "9": 8,
+                "10": 8,
+                "11": 8,
+                "12": 8,
+                "13": 8,
+                "14": 8,
+              },
+              macroCalls: {
+                "14": {
+                  callExpr: {
+                    target: { id: "1", identExpr: { name: "x" } },
+                    function: "exists",
+                    args: [
+                      { id: "3", identExpr: { name: "i" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "4", identExpr: { name: "i" } },
+                            { id: "6", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: { id: "1", identExpr: { name: "x" } },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "10",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "8", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "4", identExpr: { name: "i" } },
+                            { id: "6", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "2", max: "18446744073709551615" },
+          result: { unknown: { exprs: ["1"] } },
+          unknownAttributes: [{ id: 1, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  x^#*expr.Expr_IdentExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003e_(\n      i^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "x.exists(i, i \u003e 0)",
+        },
+        {
+          original: {
+            name: "exists_unknown_predicate",
+            expr: "[1, 2].exists(i, i == x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      i^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[1, 2].exists(i, i == x)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#9[1,13]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#10[1,13]#\n    )^#11[1,13]#\n  )^#12[1,13]#,\n  // LoopStep\n  _||_(\n    @result^#13[1,13]#,\n    _==_(\n      i^#6[1,17]#,\n      x^#8[1,22]#\n    )^#7[1,19]#\n  )^#14[1,13]#,\n  // Result\n  @result^#15[1,13]#)^#16[1,13]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+            [5, 14, 15, 1, 14, 1, 15],
+            [6, 17, 18, 1, 17, 1, 18],
+            [7, 19, 21, 1, 19, 1, 21],
+            [8, 22, 23, 1, 22, 1, 23],
+            [9, 13, 13, 1, 13, 1, 13],
+            [10, 13, 13, 1, 13, 1, 13],
+            [11, 13, 13, 1, 13, 1, 13],
+            [12, 13, 13, 1, 13, 1, 13],
+            [13, 13, 13, 1, 13, 1, 13],
+            [14, 13, 13, 1, 13, 1, 13],
+            [15, 13, 13, 1, 13, 1, 13],
+            [16, 13, 13, 1, 13, 1, 13],
+          ],
+          lineOffsets: [25],
+          parsedExpr: {
+            expr: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "12",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "11",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "10", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "13", identExpr: { name: "@result" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "exists_unknown_predicate",
+              lineOffsets: [25],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 14,
+                "6": 17,
+                "7": 19,
+                "8": 22,
+                "9": 13,
+                "10": 13,
+                "11": 13,
+                "12": 13,
+                "13": 13,
+                "14": 13,
+                "15": 13,
+                "16": 13,
+              },
+              macroCalls: {
+                "16": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "exists",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 16,
+              function: "exists",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                {
+                  id: "7",
+                  callExpr: {
+                    function: "_==_",
+                    args: [
+                      { id: "6", identExpr: { name: "i" } },
+                      { id: "8", identExpr: { name: "x" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _==_(\n      i~int^i,\n      x~dyn^x\n    )~bool^equals\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "6": { name: "i" },
+              "7": { overloadId: ["equals"] },
+              "8": { name: "x" },
+              "10": { name: "@result" },
+              "11": { overloadId: ["logical_not"] },
+              "12": { overloadId: ["not_strictly_false"] },
+              "13": { name: "@result" },
+              "14": { overloadId: ["logical_or"] },
+              "15": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { primitive: "INT64" } } },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+              "8": { dyn: {} },
+              "9": { primitive: "BOOL" },
+              "10": { primitive: "BOOL" },
+              "11": { primitive: "BOOL" },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+              "14": { primitive: "BOOL" },
+              "15": { primitive: "BOOL" },
+              "16": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [25],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 14,
+                "6": 17,
+                "7": 19,
+                "8": 22,
+                "9": 13,
+                "10": 13,
+                "11": 13,
+                "12": 13,
+                "13": 13,
+                "14": 13,
+                "15": 13,
+                "16": 13,
+              },
+              macroCalls: {
+                "16": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "exists",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "16",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "12",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "11",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "10", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "14",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "13", identExpr: { name: "@result" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "15", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "19", max: "25" },
+          result: { unknown: { exprs: ["8"] } },
+          unknownAttributes: [{ id: 8, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _==_(\n      i^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "[1, 2].exists(i, i == x)",
+        },
+        {
+          original: {
+            name: "exists_short_circuit",
+            expr: "[1, 2].exists(i, i == 1 || i == x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _||_(\n      _==_(\n        i^#*expr.Expr_IdentExpr#,\n        1^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _==_(\n        i^#*expr.Expr_IdentExpr#,\n        x^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[1, 2].exists(i, i == 1 || i == x)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#13[1,13]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#14[1,13]#\n    )^#15[1,13]#\n  )^#16[1,13]#,\n  // LoopStep\n  _||_(\n    @result^#17[1,13]#,\n    _||_(\n      _==_(\n        i^#6[1,17]#,\n        1^#8[1,22]#\n      )^#7[1,19]#,\n      _==_(\n        i^#9[1,27]#,\n        x^#11[1,32]#\n      )^#10[1,29]#\n    )^#12[1,24]#\n  )^#18[1,13]#,\n  // Result\n  @result^#19[1,13]#)^#20[1,13]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+            [5, 14, 15, 1, 14, 1, 15],
+            [6, 17, 18, 1, 17, 1, 18],
+            [7, 19, 21, 1, 19, 1, 21],
+            [8, 22, 23, 1, 22, 1, 23],
+            [9, 27, 28, 1, 27, 1, 28],
+            [10, 29, 31, 1, 29, 1, 31],
+            [11, 32, 33, 1, 32, 1, 33],
+            [12, 24, 26, 1, 24, 1, 26],
+            [13, 13, 13, 1, 13, 1, 13],
+            [14, 13, 13, 1, 13, 1, 13],
+            [15, 13, 13, 1, 13, 1, 13],
+            [16, 13, 13, 1, 13, 1, 13],
+            [17, 13, 13, 1, 13, 1, 13],
+            [18, 13, 13, 1, 13, 1, 13],
+            [19, 13, 13, 1, 13, 1, 13],
+            [20, 13, 13, 1, 13, 1, 13],
+          ],
+          lineOffsets: [35],
+          parsedExpr: {
+            expr: {
+              id: "20",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "13", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "16",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "15",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "14", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "18",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "17", identExpr: { name: "@result" } },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_||_",
+                          args: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "9", identExpr: { name: "i" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "19", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "exists_short_circuit",
+              lineOffsets: [35],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 14,
+                "6": 17,
+                "7": 19,
+                "8": 22,
+                "9": 27,
+                "10": 29,
+                "11": 32,
+                "12": 24,
+                "13": 13,
+                "14": 13,
+                "15": 13,
+                "16": 13,
+                "17": 13,
+                "18": 13,
+                "19": 13,
+                "20": 13,
+              },
+              macroCalls: {
+                "20": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "exists",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_||_",
+                          args: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "9", identExpr: { name: "i" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 20,
+              function: "exists",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "10",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "9", identExpr: { name: "i" } },
+                            { id: "11", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _||_(\n      _==_(\n        i~int^i,\n        1~int\n      )~bool^equals,\n      _==_(\n        i~int^i,\n        x~dyn^x\n      )~bool^equals\n    )~bool^logical_or\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "6": { name: "i" },
+              "7": { overloadId: ["equals"] },
+              "9": { name: "i" },
+              "10": { overloadId: ["equals"] },
+              "11": { name: "x" },
+              "12": { overloadId: ["logical_or"] },
+              "14": { name: "@result" },
+              "15": { overloadId: ["logical_not"] },
+              "16": { overloadId: ["not_strictly_false"] },
+              "17": { name: "@result" },
+              "18": { overloadId: ["logical_or"] },
+              "19": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { primitive: "INT64" } } },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+              "8": { primitive: "INT64" },
+              "9": { primitive: "INT64" },
+              "10": { primitive: "BOOL" },
+              "11": { dyn: {} },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+              "14": { primitive: "BOOL" },
+              "15": { primitive: "BOOL" },
+              "16": { primitive: "BOOL" },
+              "17": { primitive: "BOOL" },
+              "18": { primitive: "BOOL" },
+              "19": { primitive: "BOOL" },
+              "20": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [35],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 14,
+                "6": 17,
+                "7": 19,
+                "8": 22,
+                "9": 27,
+                "10": 29,
+                "11": 32,
+                "12": 24,
+                "13": 13,
+                "14": 13,
+                "15": 13,
+                "16": 13,
+                "17": 13,
+                "18": 13,
+                "19": 13,
+                "20": 13,
+              },
+              macroCalls: {
+                "20": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "exists",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_||_",
+                          args: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "9", identExpr: { name: "i" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "20",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "13", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "16",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "15",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "14", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "18",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "17", identExpr: { name: "@result" } },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_||_",
+                          args: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_==_",
+                                args: [
+                                  { id: "9", identExpr: { name: "i" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "19", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "19", max: "29" },
+          result: { value: { boolValue: true } },
+          residualAst: "true^#*expr.Constant_BoolValue#",
+          residual: "true",
+        },
+        {
+          original: {
+            name: "all_unknown_predicate",
+            expr: "[1, 2].all(i, i \u003c x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003c_(\n      i^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[1, 2].all(i, i \u003c x)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#9[1,10]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#10[1,10]#\n  )^#11[1,10]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#12[1,10]#,\n    _\u003c_(\n      i^#6[1,14]#,\n      x^#8[1,18]#\n    )^#7[1,16]#\n  )^#13[1,10]#,\n  // Result\n  @result^#14[1,10]#)^#15[1,10]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+            [5, 11, 12, 1, 11, 1, 12],
+            [6, 14, 15, 1, 14, 1, 15],
+            [7, 16, 17, 1, 16, 1, 17],
+            [8, 18, 19, 1, 18, 1, 19],
+            [9, 10, 10, 1, 10, 1, 10],
+            [10, 10, 10, 1, 10, 1, 10],
+            [11, 10, 10, 1, 10, 1, 10],
+            [12, 10, 10, 1, 10, 1, 10],
+            [13, 10, 10, 1, 10, 1, 10],
+            [14, 10, 10, 1, 10, 1, 10],
+            [15, 10, 10, 1, 10, 1, 10],
+          ],
+          lineOffsets: [21],
+          parsedExpr: {
+            expr: {
+              id: "15",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", constExpr: { boolValue: true } },
+                loopCondition: {
+                  id: "11",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [{ id: "10", identExpr: { name: "@result" } }],
+                  },
+                },
+                loopStep: {
+                  id: "13",
+                  callExpr: {
+                    function: "_\u0026\u0026_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_\u003c_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "14", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "all_unknown_predicate",
+              lineOffsets: [21],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 11,
+                "6": 14,
+                "7": 16,
+                "8": 18,
+                "9": 10,
+                "10": 10,
+                "11": 10,
+                "12": 10,
+                "13": 10,
+                "14": 10,
+                "15": 10,
+              },
+              macroCalls: {
+                "15": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "all",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_\u003c_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 15,
+              function: "all",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                {
+                  id: "7",
+                  callExpr: {
+                    function: "_\u003c_",
+                    args: [
+                      { id: "6", identExpr: { name: "i" } },
+                      { id: "8", identExpr: { name: "x" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u003c_(\n      i~int^i,\n      x~dyn^x\n    )~bool^less_int64|less_int64_double|less_int64_uint64\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "6": { name: "i" },
+              "7": {
+                overloadId: [
+                  "less_int64",
+                  "less_int64_double",
+                  "less_int64_uint64",
+                ],
+              },
+              "8": { name: "x" },
+              "10": { name: "@result" },
+              "11": { overloadId: ["not_strictly_false"] },
+              "12": { name: "@result" },
+              "13": { overloadId: ["logical_and"] },
+              "14": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { primitive: "INT64" } } },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+              "8": { dyn: {} },
+              "9": { primitive: "BOOL" },
+              "10": { primitive: "BOOL" },
+              "11": { primitive: "BOOL" },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+              "14": { primitive: "BOOL" },
+              "15": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [21],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 11,
+                "6": 14,
+                "7": 16,
+                "8": 18,
+                "9": 10,
+                "10": 10,
+                "11": 10,
+                "12": 10,
+                "13": 10,
+                "14": 10,
+                "15": 10,
+              },
+              macroCalls: {
+                "15": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "all",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_\u003c_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "15",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", constExpr: { boolValue: true } },
+                loopCondition: {
+                  id: "11",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [{ id: "10", identExpr: { name: "@result" } }],
+                  },
+                },
+                loopStep: {
+                  id: "13",
+                  callExpr: {
+                    function: "_\u0026\u0026_",
+                    args: [
+                      { id: "12", identExpr: { name: "@result" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_\u003c_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "14", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "17", max: "23" },
+          result: { unknown: { exprs: ["8"] } },
+          unknownAttributes: [{ id: 8, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003c_(\n      i^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "[1, 2].all(i, i \u003c x)",
+        },
+        {
+          original: {
+            name: "all_short_circuit",
+            expr: "[1, 2].all(i, i \u003e 1 \u0026\u0026 i \u003c x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u0026\u0026_(\n      _\u003e_(\n        i^#*expr.Expr_IdentExpr#,\n        1^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _\u003c_(\n        i^#*expr.Expr_IdentExpr#,\n        x^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[1, 2].all(i, i \u003e 1 \u0026\u0026 i \u003c x)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#13[1,10]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#14[1,10]#\n  )^#15[1,10]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#16[1,10]#,\n    _\u0026\u0026_(\n      _\u003e_(\n        i^#6[1,14]#,\n        1^#8[1,18]#\n      )^#7[1,16]#,\n      _\u003c_(\n        i^#9[1,23]#,\n        x^#11[1,27]#\n      )^#10[1,25]#\n    )^#12[1,20]#\n  )^#17[1,10]#,\n  // Result\n  @result^#18[1,10]#)^#19[1,10]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+            [5, 11, 12, 1, 11, 1, 12],
+            [6, 14, 15, 1, 14, 1, 15],
+            [7, 16, 17, 1, 16, 1, 17],
+            [8, 18, 19, 1, 18, 1, 19],
+            [9, 23, 24, 1, 23, 1, 24],
+            [10, 25, 26, 1, 25, 1, 26],
+            [11, 27, 28, 1, 27, 1, 28],
+            [12, 20, 22, 1, 20, 1, 22],
+            [13, 10, 10, 1, 10, 1, 10],
+            [14, 10, 10, 1, 10, 1, 10],
+            [15, 10, 10, 1, 10, 1, 10],
+            [16, 10, 10, 1, 10, 1, 10],
+            [17, 10, 10, 1, 10, 1, 10],
+            [18, 10, 10, 1, 10, 1, 10],
+            [19, 10, 10, 1, 10, 1, 10],
+          ],
+          lineOffsets: [30],
+          parsedExpr: {
+            expr: {
+              id: "19",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "13", constExpr: { boolValue: true } },
+                loopCondition: {
+                  id: "15",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [{ id: "14", identExpr: { name: "@result" } }],
+                  },
+                },
+                loopStep: {
+                  id: "17",
+                  callExpr: {
+                    function: "_\u0026\u0026_",
+                    args: [
+                      { id: "16", identExpr: { name: "@result" } },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_\u003e_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_\u003c_",
+                                args: [
+                                  { id: "9", identExpr: { name: "i" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "18", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "all_short_circuit",
+              lineOffsets: [30],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 11,
+                "6": 14,
+                "7": 16,
+                "8": 18,
+                "9": 23,
+                "10": 25,
+                "11": 27,
+                "12": 20,
+                "13": 10,
+                "14": 10,
+                "15": 10,
+                "16": 10,
+                "17": 10,
+                "18": 10,
+                "19": 10,
+              },
+              macroCalls: {
+                "19": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "all",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_\u003e_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_\u003c_",
+                                args: [
+                                  { id: "9", identExpr: { name: "i" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 19,
+              function: "all",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                {
+                  id: "12",
+                  callExpr: {
+                    function: "_\u0026\u0026_",
+                    args: [
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_\u003e_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "10",
+                        callExpr: {
+                          function: "_\u003c_",
+                          args: [
+                            { id: "9", identExpr: { name: "i" } },
+                            { id: "11", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u0026\u0026_(\n      _\u003e_(\n        i~int^i,\n        1~int\n      )~bool^greater_int64,\n      _\u003c_(\n        i~int^i,\n        x~dyn^x\n      )~bool^less_int64|less_int64_double|less_int64_uint64\n    )~bool^logical_and\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "6": { name: "i" },
+              "7": { overloadId: ["greater_int64"] },
+              "9": { name: "i" },
+              "10": {
+                overloadId: [
+                  "less_int64",
+                  "less_int64_double",
+                  "less_int64_uint64",
+                ],
+              },
+              "11": { name: "x" },
+              "12": { overloadId: ["logical_and"] },
+              "14": { name: "@result" },
+              "15": { overloadId: ["not_strictly_false"] },
+              "16": { name: "@result" },
+              "17": { overloadId: ["logical_and"] },
+              "18": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { primitive: "INT64" } } },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+              "8": { primitive: "INT64" },
+              "9": { primitive: "INT64" },
+              "10": { primitive: "BOOL" },
+              "11": { dyn: {} },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+              "14": { primitive: "BOOL" },
+              "15": { primitive: "BOOL" },
+              "16": { primitive: "BOOL" },
+              "17": { primitive: "BOOL" },
+              "18": { primitive: "BOOL" },
+              "19": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [30],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 11,
+                "6": 14,
+                "7": 16,
+                "8": 18,
+                "9": 23,
+                "10": 25,
+                "11": 27,
+                "12": 20,
+                "13": 10,
+                "14": 10,
+                "15": 10,
+                "16": 10,
+                "17": 10,
+                "18": 10,
+                "19": 10,
+              },
+              macroCalls: {
+                "19": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "all",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_\u003e_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_\u003c_",
+                                args: [
+                                  { id: "9", identExpr: { name: "i" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "19",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "13", constExpr: { boolValue: true } },
+                loopCondition: {
+                  id: "15",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [{ id: "14", identExpr: { name: "@result" } }],
+                  },
+                },
+                loopStep: {
+                  id: "17",
+                  callExpr: {
+                    function: "_\u0026\u0026_",
+                    args: [
+                      { id: "16", identExpr: { name: "@result" } },
+                      {
+                        id: "12",
+                        callExpr: {
+                          function: "_\u0026\u0026_",
+                          args: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_\u003e_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", constExpr: { int64Value: "1" } },
+                                ],
+                              },
+                            },
+                            {
+                              id: "10",
+                              callExpr: {
+                                function: "_\u003c_",
+                                args: [
+                                  { id: "9", identExpr: { name: "i" } },
+                                  { id: "11", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "18", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "17", max: "27" },
+          result: { value: { boolValue: false } },
+          residualAst: "false^#*expr.Constant_BoolValue#",
+          residual: "false",
+        },
+        {
+          original: {
+            name: "exists_one",
+            expr: "[1, 2].exists_one(i, i == x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  0^#*expr.Constant_Int64Value#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      i^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  _==_(\n    @result^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[1, 2].exists_one(i, i == x)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  0^#9[1,17]#,\n  // LoopCondition\n  true^#10[1,17]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      i^#6[1,21]#,\n      x^#8[1,26]#\n    )^#7[1,23]#,\n    _+_(\n      @result^#11[1,17]#,\n      1^#12[1,17]#\n    )^#13[1,17]#,\n    @result^#14[1,17]#\n  )^#15[1,17]#,\n  // Result\n  _==_(\n    @result^#16[1,17]#,\n    1^#17[1,17]#\n  )^#18[1,17]#)^#19[1,17]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+            [5, 18, 19, 1, 18, 1, 19],
+            [6, 21, 22, 1, 21, 1, 22],
+            [7, 23, 25, 1, 23, 1, 25],
+            [8, 26, 27, 1, 26, 1, 27],
+            [9, 17, 17, 1, 17, 1, 17],
+            [10, 17, 17, 1, 17, 1, 17],
+            [11, 17, 17, 1, 17, 1, 17],
+            [12, 17, 17, 1, 17, 1, 17],
+            [13, 17, 17, 1, 17, 1, 17],
+            [14, 17, 17, 1, 17, 1, 17],
+            [15, 17, 17, 1, 17, 1, 17],
+            [16, 17, 17, 1, 17, 1, 17],
+            [17, 17, 17, 1, 17, 1, 17],
+            [18, 17, 17, 1, 17, 1, 17],
+            [19, 17, 17, 1, 17, 1, 17],
+          ],
+          lineOffsets: [29],
+          parsedExpr: {
+            expr: {
+              id: "19",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", constExpr: { int64Value: "0" } },
+                loopCondition: { id: "10", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "15",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "13",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "11", identExpr: { name: "@result" } },
+                            { id: "12", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      { id: "14", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: {
+                  id: "18",
+                  callExpr: {
+                    function: "_==_",
+                    args: [
+                      { id: "16", identExpr: { name: "@result" } },
+                      { id: "17", constExpr: { int64Value: "1" } },
+                    ],
+                  },
+                },
+              },
+            },
+            sourceInfo: {
+              location: "exists_one",
+              lineOffsets: [29],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 18,
+                "6": 21,
+                "7": 23,
+                "8": 26,
+                "9": 17,
+                "10": 17,
+                "11": 17,
+                "12": 17,
+                "13": 17,
+                "14": 17,
+                "15": 17,
+                "16": 17,
+                "17": 17,
+                "18": 17,
+                "19": 17,
+              },
+              macroCalls: {
+                "19": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "exists_one",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 19,
+              function: "exists_one",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                {
+                  id: "7",
+                  callExpr: {
+                    function: "_==_",
+                    args: [
+                      { id: "6", identExpr: { name: "i" } },
+                      { id: "8", identExpr: { name: "x" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  0~int,\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      i~int^i,\n      x~dyn^x\n    )~bool^equals,\n    _+_(\n      @result~int^@result,\n      1~int\n    )~int^add_int64,\n    @result~int^@result\n  )~int^conditional,\n  // Result\n  _==_(\n    @result~int^@result,\n    1~int\n  )~bool^equals)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "6": { name: "i" },
+              "7": { overloadId: ["equals"] },
+              "8": { name: "x" },
+              "11": { name: "@result" },
+              "13": { overloadId: ["add_int64"] },
+              "14": { name: "@result" },
+              "15": { overloadId: ["conditional"] },
+              "16": { name: "@result" },
+              "18": { overloadId: ["equals"] },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { primitive: "INT64" } } },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+              "8": { dyn: {} },
+              "9": { primitive: "INT64" },
+              "10": { primitive: "BOOL" },
+              "11": { primitive: "INT64" },
+              "12": { primitive: "INT64" },
+              "13": { primitive: "INT64" },
+              "14": { primitive: "INT64" },
+              "15": { primitive: "INT64" },
+              "16": { primitive: "INT64" },
+              "17": { primitive: "INT64" },
+              "18": { primitive: "BOOL" },
+              "19": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [29],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 18,
+                "6": 21,
+                "7": 23,
+                "8": 26,
+                "9": 17,
+                "10": 17,
+                "11": 17,
+                "12": 17,
+                "13": 17,
+                "14": 17,
+                "15": 17,
+                "16": 17,
+                "17": 17,
+                "18": 17,
+                "19": 17,
+              },
+              macroCalls: {
+                "19": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "exists_one",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "19",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", constExpr: { int64Value: "0" } },
+                loopCondition: { id: "10", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "15",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "13",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "11", identExpr: { name: "@result" } },
+                            { id: "12", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      { id: "14", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: {
+                  id: "18",
+                  callExpr: {
+                    function: "_==_",
+                    args: [
+                      { id: "16", identExpr: { name: "@result" } },
+                      { id: "17", constExpr: { int64Value: "1" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "20", max: "22" },
+          result: { unknown: { exprs: ["8"] } },
+          unknownAttributes: [{ id: 8, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  0^#*expr.Constant_Int64Value#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      i^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  _==_(\n    @result^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "[1, 2].exists_one(i, i == x)",
+        },
+        {
+          original: {
+            name: "map",
+            expr: "[1, 2].map(i, i + x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      _+_(\n        i^#*expr.Expr_IdentExpr#,\n        x^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[1, 2].map(i, i + x)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#9[1,10]#,\n  // LoopCondition\n  true^#10[1,10]#,\n  // LoopStep\n  _+_(\n    @result^#11[1,10]#,\n    [\n      _+_(\n        i^#6[1,14]#,\n        x^#8[1,18]#\n      )^#7[1,16]#\n    ]^#12[1,10]#\n  )^#13[1,10]#,\n  // Result\n  @result^#14[1,10]#)^#15[1,10]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+            [5, 11, 12, 1, 11, 1, 12],
+            [6, 14, 15, 1, 14, 1, 15],
+            [7, 16, 17, 1, 16, 1, 17],
+            [8, 18, 19, 1, 18, 1, 19],
+            [9, 10, 10, 1, 10, 1, 10],
+            [10, 10, 10, 1, 10, 1, 10],
+            [11, 10, 10, 1, 10, 1, 10],
+            [12, 10, 10, 1, 10, 1, 10],
+            [13, 10, 10, 1, 10, 1, 10],
+            [14, 10, 10, 1, 10, 1, 10],
+            [15, 10, 10, 1, 10, 1, 10],
+          ],
+          lineOffsets: [21],
+          parsedExpr: {
+            expr: {
+              id: "15",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", listExpr: {} },
+                loopCondition: { id: "10", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "13",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      {
+                        id: "12",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "14", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "map",
+              lineOffsets: [21],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 11,
+                "6": 14,
+                "7": 16,
+                "8": 18,
+                "9": 10,
+                "10": 10,
+                "11": 10,
+                "12": 10,
+                "13": 10,
+                "14": 10,
+                "15": 10,
+              },
+              macroCalls: {
+                "15": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "map",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 15,
+              function: "map",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                {
+                  id: "7",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "6", identExpr: { name: "i" } },
+                      { id: "8", identExpr: { name: "x" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(int)^@result,\n    [\n      _+_(\n        i~int^i,\n        x~dyn^x\n      )~int^add_int64\n    ]~list(int)\n  )~list(int)^add_list,\n  // Result\n  @result~list(int)^@result)~list(int)",
+          checkedExpr: {
+            referenceMap: {
+              "6": { name: "i" },
+              "7": { overloadId: ["add_int64"] },
+              "8": { name: "x" },
+              "11": { name: "@result" },
+              "13": { overloadId: ["add_list"] },
+              "14": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { primitive: "INT64" } } },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "INT64" },
+              "8": { dyn: {} },
+              "9": { listType: { elemType: { primitive: "INT64" } } },
+              "10": { primitive: "BOOL" },
+              "11": { listType: { elemType: { primitive: "INT64" } } },
+              "12": { listType: { elemType: { primitive: "INT64" } } },
+              "13": { listType: { elemType: { primitive: "INT64" } } },
+              "14": { listType: { elemType: { primitive: "INT64" } } },
+              "15": { listType: { elemType: { primitive: "INT64" } } },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [21],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 11,
+                "6": 14,
+                "7": 16,
+                "8": 18,
+                "9": 10,
+                "10": 10,
+                "11": 10,
+                "12": 10,
+                "13": 10,
+                "14": 10,
+                "15": 10,
+              },
+              macroCalls: {
+                "15": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "map",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "15",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", listExpr: {} },
+                loopCondition: { id: "10", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "13",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      {
+                        id: "12",
+                        listExpr: {
+                          elements: [
+                            {
+                              id: "7",
+                              callExpr: {
+                                function: "_+_",
+                                args: [
+                                  { id: "6", identExpr: { name: "i" } },
+                                  { id: "8", identExpr: { name: "x" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                result: { id: "14", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "list(int)",
+          cost: { min: "51", max: "51" },
+          result: { unknown: { exprs: ["8"] } },
+          unknownAttributes: [{ id: 8, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      _+_(\n        i^#*expr.Expr_IdentExpr#,\n        x^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "[1, 2].map(i, i + x)",
+        },
+        {
+          original: {
+            name: "filter",
+            expr: "[1, 2].filter(i, i == x)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      i^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        i^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[1, 2].filter(i, i == x)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#9[1,13]#,\n  // LoopCondition\n  true^#10[1,13]#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      i^#6[1,17]#,\n      x^#8[1,22]#\n    )^#7[1,19]#,\n    _+_(\n      @result^#11[1,13]#,\n      [\n        i^#5[1,14]#\n      ]^#12[1,13]#\n    )^#13[1,13]#,\n    @result^#14[1,13]#\n  )^#15[1,13]#,\n  // Result\n  @result^#16[1,13]#)^#17[1,13]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+            [5, 14, 15, 1, 14, 1, 15],
+            [6, 17, 18, 1, 17, 1, 18],
+            [7, 19, 21, 1, 19, 1, 21],
+            [8, 22, 23, 1, 22, 1, 23],
+            [9, 13, 13, 1, 13, 1, 13],
+            [10, 13, 13, 1, 13, 1, 13],
+            [11, 13, 13, 1, 13, 1, 13],
+            [12, 13, 13, 1, 13, 1, 13],
+            [13, 13, 13, 1, 13, 1, 13],
+            [14, 13, 13, 1, 13, 1, 13],
+            [15, 13, 13, 1, 13, 1, 13],
+            [16, 13, 13, 1, 13, 1, 13],
+            [17, 13, 13, 1, 13, 1, 13],
+          ],
+          lineOffsets: [25],
+          parsedExpr: {
+            expr: {
+              id: "17",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", listExpr: {} },
+                loopCondition: { id: "10", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "15",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "13",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "11", identExpr: { name: "@result" } },
+                            {
+                              id: "12",
+                              listExpr: {
+                                elements: [
+                                  { id: "5", identExpr: { name: "i" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "14", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "16", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "filter",
+              lineOffsets: [25],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 14,
+                "6": 17,
+                "7": 19,
+                "8": 22,
+                "9": 13,
+                "10": 13,
+                "11": 13,
+                "12": 13,
+                "13": 13,
+                "14": 13,
+                "15": 13,
+                "16": 13,
+                "17": 13,
+              },
+              macroCalls: {
+                "17": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "filter",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 17,
+              function: "filter",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                {
+                  id: "7",
+                  callExpr: {
+                    function: "_==_",
+                    args: [
+                      { id: "6", identExpr: { name: "i" } },
+                      { id: "8", identExpr: { name: "x" } },
+                    ],
+                  },
+                },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1~int,\n    2~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _==_(\n      i~int^i,\n      x~dyn^x\n    )~bool^equals,\n    _+_(\n      @result~list(int)^@result,\n      [\n        i~int^i\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+          checkedExpr: {
+            referenceMap: {
+              "5": { name: "i" },
+              "6": { name: "i" },
+              "7": { overloadId: ["equals"] },
+              "8": { name: "x" },
+              "11": { name: "@result" },
+              "13": { overloadId: ["add_list"] },
+              "14": { name: "@result" },
+              "15": { overloadId: ["conditional"] },
+              "16": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { primitive: "INT64" } } },
+              "2": { primitive: "INT64" },
+              "3": { primitive: "INT64" },
+              "5": { primitive: "INT64" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "BOOL" },
+              "8": { dyn: {} },
+              "9": { listType: { elemType: { primitive: "INT64" } } },
+              "10": { primitive: "BOOL" },
+              "11": { listType: { elemType: { primitive: "INT64" } } },
+              "12": { listType: { elemType: { primitive: "INT64" } } },
+              "13": { listType: { elemType: { primitive: "INT64" } } },
+              "14": { listType: { elemType: { primitive: "INT64" } } },
+              "15": { listType: { elemType: { primitive: "INT64" } } },
+              "16": { listType: { elemType: { primitive: "INT64" } } },
+              "17": { listType: { elemType: { primitive: "INT64" } } },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [25],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 14,
+                "6": 17,
+                "7": 19,
+                "8": 22,
+                "9": 13,
+                "10": 13,
+                "11": 13,
+                "12": 13,
+                "13": 13,
+                "14": 13,
+                "15": 13,
+                "16": 13,
+                "17": 13,
+              },
+              macroCalls: {
+                "17": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", constExpr: { int64Value: "1" } },
+                          { id: "3", constExpr: { int64Value: "2" } },
+                        ],
+                      },
+                    },
+                    function: "filter",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "17",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "9", listExpr: {} },
+                loopCondition: { id: "10", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "15",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "7",
+                        callExpr: {
+                          function: "_==_",
+                          args: [
+                            { id: "6", identExpr: { name: "i" } },
+                            { id: "8", identExpr: { name: "x" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "13",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "11", identExpr: { name: "@result" } },
+                            {
+                              id: "12",
+                              listExpr: {
+                                elements: [
+                                  { id: "5", identExpr: { name: "i" } },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "14", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "16", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "list(int)",
+          cost: { min: "29", max: "53" },
+          result: { unknown: { exprs: ["8"] } },
+          unknownAttributes: [{ id: 8, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _==_(\n      i^#*expr.Expr_IdentExpr#,\n      x^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        i^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "[1, 2].filter(i, i == x)",
+        },
+        {
+          original: {
+            name: "unknown_elements",
+            expr: "[x, y].exists(i, i)",
+            typeEnv: [
+              { name: "x", ident: { type: { dyn: {} } } },
+              { name: "y", ident: { type: { dyn: {} } } },
+            ],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }, { variable: "y" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#*expr.Expr_IdentExpr#,\n    y^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    i^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[x, y].exists(i, i)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#2[1,1]#,\n    y^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#7[1,13]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#8[1,13]#\n    )^#9[1,13]#\n  )^#10[1,13]#,\n  // LoopStep\n  _||_(\n    @result^#11[1,13]#,\n    i^#6[1,17]#\n  )^#12[1,13]#,\n  // Result\n  @result^#13[1,13]#)^#14[1,13]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 5, 1, 4, 1, 5],
+            [5, 14, 15, 1, 14, 1, 15],
+            [6, 17, 18, 1, 17, 1, 18],
+            [7, 13, 13, 1, 13, 1, 13],
+            [8, 13, 13, 1, 13, 1, 13],
+            [9, 13, 13, 1, 13, 1, 13],
+            [10, 13, 13, 1, 13, 1, 13],
+            [11, 13, 13, 1, 13, 1, 13],
+            [12, 13, 13, 1, 13, 1, 13],
+            [13, 13, 13, 1, 13, 1, 13],
+            [14, 13, 13, 1, 13, 1, 13],
+          ],
+          lineOffsets: [20],
+          parsedExpr: {
+            expr: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "x" } },
+                      { id: "3", identExpr: { name: "y" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "10",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "8", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "unknown_elements",
+              lineOffsets: [20],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 14,
+                "6": 17,
+                "7": 13,
+                "8": 13,
+                "9": 13,
+                "10": 13,
+                "11": 13,
+                "12": 13,
+                "13": 13,
+                "14": 13,
+              },
+              macroCalls: {
+                "14": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", identExpr: { name: "x" } },
+                          { id: "3", identExpr: { name: "y" } },
+                        ],
+                      },
+                    },
+                    function: "exists",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 14,
+              function: "exists",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", identExpr: { name: "x" } },
+                    { id: "3", identExpr: { name: "y" } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                { id: "6", identExpr: { name: "i" } },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x~dyn^x,\n    y~dyn^y\n  ]~list(dyn),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    i~dyn^i\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "2": { name: "x" },
+              "3": { name: "y" },
+              "6": { name: "i" },
+              "8": { name: "@result" },
+              "9": { overloadId: ["logical_not"] },
+              "10": { overloadId: ["not_strictly_false"] },
+              "11": { name: "@result" },
+              "12": { overloadId: ["logical_or"] },
+              "13": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { dyn: {} } } },
+              "2": { dyn: {} },
+              "3": { dyn: {} },
+              "6": { dyn: {} },
+              "7": { primitive: "BOOL" },
+              "8": { primitive: "BOOL" },
+              "9": { primitive: "BOOL" },
+              "10": { primitive: "BOOL" },
+              "11": { primitive: "BOOL" },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+              "14": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [20],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 14,
+                "6": 17,
+                "7": 13,
+                "8": 13,
+                "9": 13,
+                "10": 13,
+                "11": 13,
+                "12": 13,
+                "13": 13,
+                "14": 13,
+              },
+              macroCalls: {
+                "14": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", identExpr: { name: "x" } },
+                          { id: "3", identExpr: { name: "y" } },
+                        ],
+                      },
+                    },
+                    function: "exists",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "x" } },
+                      { id: "3", identExpr: { name: "y" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "10",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "8", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "21", max: "23" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#*expr.Expr_IdentExpr#,\n    y^#*expr.Expr_IdentExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    i^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "[x, y].exists(i, i)",
+        },
+        {
+          original: {
+            name: "unknown_and_true_elements",
+            expr: "[x, true].exists(i, i)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    i^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[x, true].exists(i, i)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#2[1,1]#,\n    true^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#7[1,16]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#8[1,16]#\n    )^#9[1,16]#\n  )^#10[1,16]#,\n  // LoopStep\n  _||_(\n    @result^#11[1,16]#,\n    i^#6[1,20]#\n  )^#12[1,16]#,\n  // Result\n  @result^#13[1,16]#)^#14[1,16]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 8, 1, 4, 1, 8],
+            [5, 17, 18, 1, 17, 1, 18],
+            [6, 20, 21, 1, 20, 1, 21],
+            [7, 16, 16, 1, 16, 1, 16],
+            [8, 16, 16, 1, 16, 1, 16],
+            [9, 16, 16, 1, 16, 1, 16],
+            [10, 16, 16, 1, 16, 1, 16],
+            [11, 16, 16, 1, 16, 1, 16],
+            [12, 16, 16, 1, 16, 1, 16],
+            [13, 16, 16, 1, 16, 1, 16],
+            [14, 16, 16, 1, 16, 1, 16],
+          ],
+          lineOffsets: [23],
+          parsedExpr: {
+            expr: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "x" } },
+                      { id: "3", constExpr: { boolValue: true } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "10",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "8", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "unknown_and_true_elements",
+              lineOffsets: [23],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 17,
+                "6": 20,
+                "7": 16,
+                "8": 16,
+                "9": 16,
+                "10": 16,
+                "11": 16,
+                "12": 16,
+                "13": 16,
+                "14": 16,
+              },
+              macroCalls: {
+                "14": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", identExpr: { name: "x" } },
+                          { id: "3", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    function: "exists",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 14,
+              function: "exists",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", identExpr: { name: "x" } },
+                    { id: "3", constExpr: { boolValue: true } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                { id: "6", identExpr: { name: "i" } },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x~dyn^x,\n    true~bool\n  ]~list(dyn),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    i~dyn^i\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "2": { name: "x" },
+              "6": { name: "i" },
+              "8": { name: "@result" },
+              "9": { overloadId: ["logical_not"] },
+              "10": { overloadId: ["not_strictly_false"] },
+              "11": { name: "@result" },
+              "12": { overloadId: ["logical_or"] },
+              "13": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { dyn: {} } } },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+              "6": { dyn: {} },
+              "7": { primitive: "BOOL" },
+              "8": { primitive: "BOOL" },
+              "9": { primitive: "BOOL" },
+              "10": { primitive: "BOOL" },
+              "11": { primitive: "BOOL" },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+              "14": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [23],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 17,
+                "6": 20,
+                "7": 16,
+                "8": 16,
+                "9": 16,
+                "10": 16,
+                "11": 16,
+                "12": 16,
+                "13": 16,
+                "14": 16,
+              },
+              macroCalls: {
+                "14": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", identExpr: { name: "x" } },
+                          { id: "3", constExpr: { boolValue: true } },
+                        ],
+                      },
+                    },
+                    function: "exists",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "14",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "x" } },
+                      { id: "3", constExpr: { boolValue: true } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: false } },
+                loopCondition: {
+                  id: "10",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [
+                      {
+                        id: "9",
+                        callExpr: {
+                          function: "!_",
+                          args: [{ id: "8", identExpr: { name: "@result" } }],
+                        },
+                      },
+                    ],
+                  },
+                },
+                loopStep: {
+                  id: "12",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "11", identExpr: { name: "@result" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+                result: { id: "13", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "20", max: "22" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#*expr.Expr_IdentExpr#,\n    true^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    i^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "[x, true].exists(i, i)",
+        },
+        {
+          original: {
+            name: "unknown_and_false_elements",
+            expr: "[x, false].all(i, i)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#*expr.Expr_IdentExpr#,\n    false^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    i^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          unparsed: "[x, false].all(i, i)",
+          locationAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#2[1,1]#,\n    false^#3[1,4]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#7[1,14]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#8[1,14]#\n  )^#9[1,14]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#10[1,14]#,\n    i^#6[1,18]#\n  )^#11[1,14]#,\n  // Result\n  @result^#12[1,14]#)^#13[1,14]#",
+          positions: [
+            [1, 0, 1, 1, 0, 1, 1],
+            [2, 1, 2, 1, 1, 1, 2],
+            [3, 4, 9, 1, 4, 1, 9],
+            [5, 15, 16, 1, 15, 1, 16],
+            [6, 18, 19, 1, 18, 1, 19],
+            [7, 14, 14, 1, 14, 1, 14],
+            [8, 14, 14, 1, 14, 1, 14],
+            [9, 14, 14, 1, 14, 1, 14],
+            [10, 14, 14, 1, 14, 1, 14],
+            [11, 14, 14, 1, 14, 1, 14],
+            [12, 14, 14, 1, 14, 1, 14],
+            [13, 14, 14, 1, 14, 1, 14],
+          ],
+          lineOffsets: [21],
+          parsedExpr: {
+            expr: {
+              id: "13",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "x" } },
+                      { id: "3", constExpr: { boolValue: false } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: true } },
+                loopCondition: {
+                  id: "9",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [{ id: "8", identExpr: { name: "@result" } }],
+                  },
+                },
+                loopStep: {
+                  id: "11",
+                  callExpr: {
+                    function: "_\u0026\u0026_",
+                    args: [
+                      { id: "10", identExpr: { name: "@result" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+                result: { id: "12", identExpr: { name: "@result" } },
+              },
+            },
+            sourceInfo: {
+              location: "unknown_and_false_elements",
+              lineOffsets: [21],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 15,
+                "6": 18,
+                "7": 14,
+                "8": 14,
+                "9": 14,
+                "10": 14,
+                "11": 14,
+                "12": 14,
+                "13": 14,
+              },
+              macroCalls: {
+                "13": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", identExpr: { name: "x" } },
+                          { id: "3", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    function: "all",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          macroCalls: [
+            {
+              id: 13,
+              function: "all",
+              target: {
+                id: "1",
+                listExpr: {
+                  elements: [
+                    { id: "2", identExpr: { name: "x" } },
+                    { id: "3", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              args: [
+                { id: "5", identExpr: { name: "i" } },
+                { id: "6", identExpr: { name: "i" } },
+              ],
+            },
+          ],
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x~dyn^x,\n    false~bool\n  ]~list(dyn),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    i~dyn^i\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+          checkedExpr: {
+            referenceMap: {
+              "2": { name: "x" },
+              "6": { name: "i" },
+              "8": { name: "@result" },
+              "9": { overloadId: ["not_strictly_false"] },
+              "10": { name: "@result" },
+              "11": { overloadId: ["logical_and"] },
+              "12": { name: "@result" },
+            },
+            typeMap: {
+              "1": { listType: { elemType: { dyn: {} } } },
+              "2": { dyn: {} },
+              "3": { primitive: "BOOL" },
+              "6": { dyn: {} },
+              "7": { primitive: "BOOL" },
+              "8": { primitive: "BOOL" },
+              "9": { primitive: "BOOL" },
+              "10": { primitive: "BOOL" },
+              "11": { primitive: "BOOL" },
+              "12": { primitive: "BOOL" },
+              "13": { primitive: "BOOL" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [21],
+              positions: {
+                "1": 0,
+                "2": 1,
+                "3": 4,
+                "5": 15,
+                "6": 18,
+                "7": 14,
+                "8": 14,
+                "9": 14,
+                "10": 14,
+                "11": 14,
+                "12": 14,
+                "13": 14,
+              },
+              macroCalls: {
+                "13": {
+                  callExpr: {
+                    target: {
+                      id: "1",
+                      listExpr: {
+                        elements: [
+                          { id: "2", identExpr: { name: "x" } },
+                          { id: "3", constExpr: { boolValue: false } },
+                        ],
+                      },
+                    },
+                    function: "all",
+                    args: [
+                      { id: "5", identExpr: { name: "i" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "13",
+              comprehensionExpr: {
+                iterVar: "i",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "x" } },
+                      { id: "3", constExpr: { boolValue: false } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "7", constExpr: { boolValue: true } },
+                loopCondition: {
+                  id: "9",
+                  callExpr: {
+                    function: "@not_strictly_false",
+                    args: [{ id: "8", identExpr: { name: "@result" } }],
+                  },
+                },
+                loopStep: {
+                  id: "11",
+                  callExpr: {
+                    function: "_\u0026\u0026_",
+                    args: [
+                      { id: "10", identExpr: { name: "@result" } },
+                      { id: "6", identExpr: { name: "i" } },
+                    ],
+                  },
+                },
+                result: { id: "12", identExpr: { name: "@result" } },
+              },
+            },
+          },
+          type: "bool",
+          cost: { min: "18", max: "20" },
+          result: { unknown: { exprs: ["2"] } },
+          unknownAttributes: [{ id: 2, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  i,\n  // Target\n  [\n    x^#*expr.Expr_IdentExpr#,\n    false^#*expr.Constant_BoolValue#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    i^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "[x, false].all(i, i)",
+        },
+        {
+          original: {
+            name: "bind",
+            expr: "cel.bind(y, x + 1, y * y)",
+            typeEnv: [{ name: "x", ident: { type: { dyn: {} } } }],
+          },
+          optionalSyntax: true,
+          unknowns: [{ variable: "x" }],
+          ast: "cel^#*expr.Expr_IdentExpr#.bind(\n  y^#*expr.Expr_IdentExpr#,\n  _+_(\n    x^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _*_(\n    y^#*expr.Expr_IdentExpr#,\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+          unparsed: "cel.bind(y, x + 1, y * y)",
+          locationAst:
+            "cel^#1[1,0]#.bind(\n  y^#3[1,9]#,\n  _+_(\n    x^#4[1,12]#,\n    1^#6[1,16]#\n  )^#5[1,14]#,\n  _*_(\n    y^#7[1,19]#,\n    y^#9[1,23]#\n  )^#8[1,21]#\n)^#2[1,8]#",
+          positions: [
+            [1, 0, 3, 1, 0, 1, 3],
+            [2, 8, 9, 1, 8, 1, 9],
+            [3, 9, 10, 1, 9, 1, 10],
+            [4, 12, 13, 1, 12, 1, 13],
+            [5, 14, 15, 1, 14, 1, 15],
+            [6, 16, 17, 1, 16, 1, 17],
+            [7, 19, 20, 1, 19, 1, 20],
+            [8, 21, 22, 1, 21, 1, 22],
+            [9, 23, 24, 1, 23, 1, 24],
+          ],
+          lineOffsets: [26],
+          parsedExpr: {
+            expr: {
+              id: "2",
+              callExpr: {
+                target: { id: "1", identExpr: { name: "cel" } },
+                function: "bind",
+                args: [
+                  { id: "3", identExpr: { name: "y" } },
+                  {
+                    id: "5",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "4", identExpr: { name: "x" } },
+                        { id: "6", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "7", identExpr: { name: "y" } },
+                        { id: "9", identExpr: { name: "y" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            sourceInfo: {
+              location: "bind",
+              lineOffsets: [26],
+              positions: {
+                "1": 0,
+                "2": 8,
+                "3": 9,
+                "4": 12,
+                "5": 14,
+                "6": 16,
+                "7": 19,
+                "8": 21,
+                "9": 23,
+              },
+            },
+          },
+          checkedAst:
+            "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []~list(dyn),\n  // Accumulator\n  y,\n  // Init\n  _+_(\n    x~dyn^x,\n    1~int\n  )~int^add_int64,\n  // LoopCondition\n  false~bool,\n  // LoopStep\n  y~int^y,\n  // Result\n  _*_(\n    y~int^y,\n    y~int^y\n  )~int^multiply_int64)~int",
+          checkedExpr: {
+            referenceMap: {
+              "4": { name: "x" },
+              "5": { overloadId: ["add_int64"] },
+              "7": { name: "y" },
+              "8": { overloadId: ["multiply_int64"] },
+              "9": { name: "y" },
+              "12": { name: "y" },
+            },
+            typeMap: {
+              "4": { dyn: {} },
+              "5": { primitive: "INT64" },
+              "6": { primitive: "INT64" },
+              "7": { primitive: "INT64" },
+              "8": { primitive: "INT64" },
+              "9": { primitive: "INT64" },
+              "10": { listType: { elemType: { dyn: {} } } },
+              "11": { primitive: "BOOL" },
+              "12": { primitive: "INT64" },
+              "13": { primitive: "INT64" },
+            },
+            sourceInfo: {
+              location: "\u003cinput\u003e",
+              lineOffsets: [26],
+              positions: {
+                "1": 0,
+                "3": 9,
+                "4": 12,
+                "5": 14,
+                "6": 16,
+                "7": 19,
+                "8": 21,
+                "9": 23,
+                "10": 8,
+                "11": 8,
+                "12": 8,
+                "13": 8,
+              },
+              macroCalls: {
+                "13": {
+                  callExpr: {
+                    target: { id: "1", identExpr: { name: "cel" } },
+                    function: "bind",
+                    args: [
+                      { id: "3", identExpr: { name: "y" } },
+                      {
+                        id: "5",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "4", identExpr: { name: "x" } },
+                            { id: "6", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "8",
+                        callExpr: {
+                          function: "_*_",
+                          args: [
+                            { id: "7", identExpr: { name: "y" } },
+                            { id: "9", identExpr: { name: "y" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            },
+            expr: {
+              id: "13",
+              comprehensionExpr: {
+                iterVar: "#unused",
+                iterRange: { id: "10", listExpr: {} },
+                accuVar: "y",
+                accuInit: {
+                  id: "5",
+                  callExpr: {
+                    function: "_+_",
+                    args: [
+                      { id: "4", identExpr: { name: "x" } },
+                      { id: "6", constExpr: { int64Value: "1" } },
+                    ],
+                  },
+                },
+                loopCondition: { id: "11", constExpr: { boolValue: false } },
+                loopStep: { id: "12", identExpr: { name: "y" } },
+                result: {
+                  id: "8",
+                  callExpr: {
+                    function: "_*_",
+                    args: [
+                      { id: "7", identExpr: { name: "y" } },
+                      { id: "9", identExpr: { name: "y" } },
+                    ],
+                  },
+                },
+              },
+            },
+          },
+          type: "int",
+          cost: { min: "15", max: "15" },
+          result: { unknown: { exprs: ["4"] } },
+          unknownAttributes: [{ id: 4, variable: "x" }],
+          residualAst:
+            "__comprehension__(\n  // Variable\n  #unused,\n  // Target\n  []^#*expr.Expr_ListExpr#,\n  // Accumulator\n  y,\n  // Init\n  _+_(\n    x^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  // LoopCondition\n  false^#*expr.Constant_BoolValue#,\n  // LoopStep\n  y^#*expr.Expr_IdentExpr#,\n  // Result\n  _*_(\n    y^#*expr.Expr_IdentExpr#,\n    y^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#)^#*expr.Expr_ComprehensionExpr#",
+          residual: "cel.bind(y, x + 1, y * y)",
+        },
+      ],
+    },
+  ],
+} as const;
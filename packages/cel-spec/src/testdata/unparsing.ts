@@ -0,0 +1,14399 @@
+// Copyright 2024-2026 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Generated from cel-go github.com/google/cel-go@v0.26.1/parser/unparser_test.go
+import type { SerializedIncrementalTestSuite } from "./tests.js";
+export const tests: SerializedIncrementalTestSuite = {
+  name: "unparsing",
+  tests: [
+    {
+      original: { name: "call_add", expr: "a + b - c" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_-_(\n  _+_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a + b - c",
+      locationAst:
+        "_-_(\n  _+_(\n    a^#1[1,0]#,\n    b^#3[1,4]#\n  )^#2[1,2]#,\n  c^#5[1,8]#\n)^#4[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_-_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              { id: "5", identExpr: { name: "c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_add",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 6, "5": 8 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a + b - c\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a + b - c\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a + b - c\n | ........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedUnparsed: "a + b - c",
+    },
+    {
+      original: {
+        name: "call_and",
+        expr: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      a^#1[1,0]#,\n      b^#2[1,5]#\n    )^#3[1,2]#,\n    c^#4[1,10]#\n  )^#5[1,7]#,\n  _\u0026\u0026_(\n    d^#6[1,15]#,\n    e^#8[1,20]#\n  )^#9[1,17]#\n)^#7[1,12]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "2", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "4", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "6", identExpr: { name: "d" } },
+                    { id: "8", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_and",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+            "8": 20,
+            "9": 17,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 8,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed:
+        "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e",
+    },
+    {
+      original: {
+        name: "call_and_or",
+        expr: "a || b \u0026\u0026 (c || d) \u0026\u0026 e",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_||_(\n  a^#*expr.Expr_IdentExpr#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      b^#*expr.Expr_IdentExpr#,\n      _||_(\n        c^#*expr.Expr_IdentExpr#,\n        d^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a || b \u0026\u0026 (c || d) \u0026\u0026 e",
+      locationAst:
+        "_||_(\n  a^#1[1,0]#,\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      b^#2[1,5]#,\n      _||_(\n        c^#3[1,11]#,\n        d^#4[1,16]#\n      )^#5[1,13]#\n    )^#6[1,7]#,\n    e^#7[1,22]#\n  )^#8[1,19]#\n)^#9[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 11, 12, 1, 11, 1, 12],
+        [4, 16, 17, 1, 16, 1, 17],
+        [5, 13, 15, 1, 13, 1, 15],
+        [6, 7, 9, 1, 7, 1, 9],
+        [7, 22, 23, 1, 22, 1, 23],
+        [8, 19, 21, 1, 19, 1, 21],
+        [9, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "2", identExpr: { name: "b" } },
+                          {
+                            id: "5",
+                            callExpr: {
+                              function: "_||_",
+                              args: [
+                                { id: "3", identExpr: { name: "c" } },
+                                { id: "4", identExpr: { name: "d" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_and_or",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 11,
+            "4": 16,
+            "5": 13,
+            "6": 7,
+            "7": 22,
+            "8": 19,
+            "9": 2,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a || b \u0026\u0026 (c || d) \u0026\u0026 e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a || b \u0026\u0026 (c || d) \u0026\u0026 e\n | .....^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'c' (in container '')\n | a || b \u0026\u0026 (c || d) \u0026\u0026 e\n | ...........^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'd' (in container '')\n | a || b \u0026\u0026 (c || d) \u0026\u0026 e\n | ................^\nERROR: \u003cinput\u003e:1:23: undeclared reference to 'e' (in container '')\n | a || b \u0026\u0026 (c || d) \u0026\u0026 e\n | ......................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 3,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 4,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 7,
+          offset: 22,
+          line: 1,
+          column: 22,
+        },
+      ],
+      expectedUnparsed: "a || b \u0026\u0026 (c || d) \u0026\u0026 e",
+    },
+    {
+      original: { name: "call_cond", expr: "a ? b : c" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ? b : c",
+      locationAst:
+        "_?_:_(\n  a^#1[1,0]#,\n  b^#3[1,4]#,\n  c^#4[1,8]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_cond",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 8 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a ? b : c\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a ? b : c\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a ? b : c\n | ........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedUnparsed: "a ? b : c",
+    },
+    {
+      original: { name: "call_cond_nested_inner", expr: "a ? (c ? d : e) : b" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  _?_:_(\n    c^#*expr.Expr_IdentExpr#,\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ? (c ? d : e) : b",
+      locationAst:
+        "_?_:_(\n  a^#1[1,0]#,\n  _?_:_(\n    c^#3[1,5]#,\n    d^#5[1,9]#,\n    e^#6[1,13]#\n  )^#4[1,7]#,\n  b^#7[1,18]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 18, 19, 1, 18, 1, 19],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    { id: "3", identExpr: { name: "c" } },
+                    { id: "5", identExpr: { name: "d" } },
+                    { id: "6", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+              { id: "7", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_cond_nested_inner",
+          lineOffsets: [20],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 13,
+            "7": 18,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a ? (c ? d : e) : b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'c' (in container '')\n | a ? (c ? d : e) : b\n | .....^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'd' (in container '')\n | a ? (c ? d : e) : b\n | .........^\nERROR: \u003cinput\u003e:1:14: undeclared reference to 'e' (in container '')\n | a ? (c ? d : e) : b\n | .............^\nERROR: \u003cinput\u003e:1:19: undeclared reference to 'b' (in container '')\n | a ? (c ? d : e) : b\n | ..................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 5,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 6,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 7,
+          offset: 18,
+          line: 1,
+          column: 18,
+        },
+      ],
+      expectedUnparsed: "a ? (c ? d : e) : b",
+    },
+    {
+      original: { name: "call_cond_nested_outer", expr: "a ? b : c ? d : e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  _?_:_(\n    c^#*expr.Expr_IdentExpr#,\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ? b : (c ? d : e)",
+      locationAst:
+        "_?_:_(\n  a^#1[1,0]#,\n  b^#3[1,4]#,\n  _?_:_(\n    c^#4[1,8]#,\n    d^#6[1,12]#,\n    e^#7[1,16]#\n  )^#5[1,10]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                    { id: "7", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_cond_nested_outer",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 8,
+            "5": 10,
+            "6": 12,
+            "7": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a ? b : c ? d : e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a ? b : c ? d : e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a ? b : c ? d : e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a ? b : c ? d : e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a ? b : c ? d : e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 7,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a ? b : (c ? d : e)",
+    },
+    {
+      original: { name: "call_index", expr: 'a[1]["b"]' },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '_[_](\n  _[_](\n    a^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'a[1]["b"]',
+      locationAst:
+        '_[_](\n  _[_](\n    a^#1[1,0]#,\n    1^#3[1,2]#\n  )^#2[1,1]#,\n  "b"^#5[1,5]#\n)^#4[1,4]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 5, 8, 1, 5, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_index",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 4, "5": 5 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a[1][\"b\"]\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: 'a[1]["b"]',
+    },
+    {
+      original: { name: "call_index_eq", expr: 'x["a"].single_int32 == 23' },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '_==_(\n  _[_](\n    x^#*expr.Expr_IdentExpr#,\n    "a"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#.single_int32^#*expr.Expr_SelectExpr#,\n  23^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'x["a"].single_int32 == 23',
+      locationAst:
+        '_==_(\n  _[_](\n    x^#1[1,0]#,\n    "a"^#3[1,2]#\n  )^#2[1,1]#.single_int32^#4[1,6]#,\n  23^#6[1,23]#\n)^#5[1,20]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 5, 1, 2, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 20, 22, 1, 20, 1, 22],
+        [6, 23, 25, 1, 23, 1, 25],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "4",
+                selectExpr: {
+                  operand: {
+                    id: "2",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "1", identExpr: { name: "x" } },
+                        { id: "3", constExpr: { stringValue: "a" } },
+                      ],
+                    },
+                  },
+                  field: "single_int32",
+                },
+              },
+              { id: "6", constExpr: { int64Value: "23" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_index_eq",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 6, "5": 20, "6": 23 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x[\"a\"].single_int32 == 23\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: 'x["a"].single_int32 == 23',
+    },
+    {
+      original: { name: "call_mul", expr: "a * (b / c) % 0" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_%_(\n  _*_(\n    a^#*expr.Expr_IdentExpr#,\n    _/_(\n      b^#*expr.Expr_IdentExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a * (b / c) % 0",
+      locationAst:
+        "_%_(\n  _*_(\n    a^#1[1,0]#,\n    _/_(\n      b^#3[1,5]#,\n      c^#5[1,9]#\n    )^#4[1,7]#\n  )^#2[1,2]#,\n  0^#7[1,14]#\n)^#6[1,12]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_%_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          { id: "3", identExpr: { name: "b" } },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_mul",
+          lineOffsets: [16],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 7,
+            "5": 9,
+            "6": 12,
+            "7": 14,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a * (b / c) % 0\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a * (b / c) % 0\n | .....^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'c' (in container '')\n | a * (b / c) % 0\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedUnparsed: "a * (b / c) % 0",
+    },
+    {
+      original: { name: "call_mul_add", expr: "a + b * c" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_+_(\n  a^#*expr.Expr_IdentExpr#,\n  _*_(\n    b^#*expr.Expr_IdentExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a + b * c",
+      locationAst:
+        "_+_(\n  a^#1[1,0]#,\n  _*_(\n    b^#3[1,4]#,\n    c^#5[1,8]#\n  )^#4[1,6]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "3", identExpr: { name: "b" } },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_mul_add",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 6, "5": 8 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a + b * c\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a + b * c\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a + b * c\n | ........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedUnparsed: "a + b * c",
+    },
+    {
+      original: { name: "call_mul_add_nested", expr: "(a + b) * c / (d - e)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_/_(\n  _*_(\n    _+_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _-_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(a + b) * c / (d - e)",
+      locationAst:
+        "_/_(\n  _*_(\n    _+_(\n      a^#1[1,1]#,\n      b^#3[1,5]#\n    )^#2[1,3]#,\n    c^#5[1,10]#\n  )^#4[1,8]#,\n  _-_(\n    d^#7[1,15]#,\n    e^#9[1,19]#\n  )^#8[1,17]#\n)^#6[1,12]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 18, 1, 17, 1, 18],
+        [9, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_/_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "3", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    { id: "7", identExpr: { name: "d" } },
+                    { id: "9", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_mul_add_nested",
+          lineOffsets: [22],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 8,
+            "5": 10,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 19,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | (a + b) * c / (d - e)\n | .^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | (a + b) * c / (d - e)\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | (a + b) * c / (d - e)\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | (a + b) * c / (d - e)\n | ...............^\nERROR: \u003cinput\u003e:1:20: undeclared reference to 'e' (in container '')\n | (a + b) * c / (d - e)\n | ...................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+      expectedUnparsed: "(a + b) * c / (d - e)",
+    },
+    {
+      original: { name: "call_mul_nested", expr: "a * b / c % 0" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_%_(\n  _/_(\n    _*_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a * b / c % 0",
+      locationAst:
+        "_%_(\n  _/_(\n    _*_(\n      a^#1[1,0]#,\n      b^#3[1,4]#\n    )^#2[1,2]#,\n    c^#5[1,8]#\n  )^#4[1,6]#,\n  0^#7[1,12]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_%_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_*_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "3", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_mul_nested",
+          lineOffsets: [14],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a * b / c % 0\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a * b / c % 0\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a * b / c % 0\n | ........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedUnparsed: "a * b / c % 0",
+    },
+    {
+      original: { name: "call_not", expr: "!true" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "!_(\n  true^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "!true",
+      locationAst: "!_(\n  true^#2[1,1]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 5, 1, 1, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", constExpr: { boolValue: true } }],
+          },
+        },
+        sourceInfo: {
+          location: "call_not",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      checkedAst: "!_(\n  true~bool\n)~bool^logical_not",
+      checkedExpr: {
+        referenceMap: { "1": { overloadId: ["logical_not"] } },
+        typeMap: { "1": { primitive: "BOOL" }, "2": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "!_",
+            args: [{ id: "2", constExpr: { boolValue: true } }],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "1", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "1",
+      expectedUnparsed: "!true",
+    },
+    {
+      original: { name: "call_neg", expr: "-num" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "-_(\n  num^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "-num",
+      locationAst: "-_(\n  num^#2[1,1]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "-_",
+            args: [{ id: "2", identExpr: { name: "num" } }],
+          },
+        },
+        sourceInfo: {
+          location: "call_neg",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'num' (in container '')\n | -num\n | .^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'num' (in container '')",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+      ],
+      expectedUnparsed: "-num",
+    },
+    {
+      original: { name: "call_or", expr: "a || b || c || d || e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_||_(\n  _||_(\n    _||_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a || b || c || d || e",
+      locationAst:
+        "_||_(\n  _||_(\n    _||_(\n      a^#1[1,0]#,\n      b^#2[1,5]#\n    )^#3[1,2]#,\n    c^#4[1,10]#\n  )^#5[1,7]#,\n  _||_(\n    d^#6[1,15]#,\n    e^#8[1,20]#\n  )^#9[1,17]#\n)^#7[1,12]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_||_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "2", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "4", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "6", identExpr: { name: "d" } },
+                    { id: "8", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_or",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+            "8": 20,
+            "9": 17,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a || b || c || d || e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a || b || c || d || e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a || b || c || d || e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a || b || c || d || e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a || b || c || d || e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 8,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed: "a || b || c || d || e",
+    },
+    {
+      original: { name: "call_neg_mult", expr: "-(1 * 2)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "-_(\n  _*_(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "-(1 * 2)",
+      locationAst:
+        "-_(\n  _*_(\n    1^#2[1,2]#,\n    2^#4[1,6]#\n  )^#3[1,4]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "-_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_neg_mult",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 6 },
+        },
+      },
+      checkedAst:
+        "-_(\n  _*_(\n    1~int,\n    2~int\n  )~int^multiply_int64\n)~int^negate_int64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["negate_int64"] },
+          "3": { overloadId: ["multiply_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 6 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "-_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: { value: { int64Value: "-2" } },
+      runtimeCost: "2",
+      expectedUnparsed: "-(1 * 2)",
+    },
+    {
+      original: { name: "call_neg_add", expr: "-(1 + 2)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "-_(\n  _+_(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "-(1 + 2)",
+      locationAst:
+        "-_(\n  _+_(\n    1^#2[1,2]#,\n    2^#4[1,6]#\n  )^#3[1,4]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "-_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_neg_add",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 6 },
+        },
+      },
+      checkedAst:
+        "-_(\n  _+_(\n    1~int,\n    2~int\n  )~int^add_int64\n)~int^negate_int64",
+      checkedExpr: {
+        referenceMap: {
+          "1": { overloadId: ["negate_int64"] },
+          "3": { overloadId: ["add_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 6 },
+        },
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "-_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "2", constExpr: { int64Value: "1" } },
+                    { id: "4", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: { value: { int64Value: "-3" } },
+      runtimeCost: "2",
+      expectedUnparsed: "-(1 + 2)",
+    },
+    {
+      original: { name: "call_operator_precedence", expr: "1 - (2 == -1)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_-_(\n  1^#*expr.Constant_Int64Value#,\n  _==_(\n    2^#*expr.Constant_Int64Value#,\n    -1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 - (2 == -1)",
+      locationAst:
+        "_-_(\n  1^#1[1,0]#,\n  _==_(\n    2^#3[1,5]#,\n    -1^#5[1,10]#\n  )^#4[1,7]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 10, 12, 1, 10, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_-_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "-1" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_operator_precedence",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 2, "3": 5, "4": 7, "5": 10 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:3: found no matching overload for '_-_' applied to '(int, bool)'\n | 1 - (2 == -1)\n | ..^",
+      errors: [
+        {
+          category: "no-matching-overload",
+          message:
+            "found no matching overload for '_-_' applied to '(int, bool)'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+      ],
+      expectedUnparsed: "1 - (2 == -1)",
+    },
+    {
+      original: { name: "calc_distr_paren", expr: "(1 + 2) * 3" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_*_(\n  _+_(\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(1 + 2) * 3",
+      locationAst:
+        "_*_(\n  _+_(\n    1^#1[1,1]#,\n    2^#3[1,5]#\n  )^#2[1,3]#,\n  3^#5[1,10]#\n)^#4[1,8]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_*_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "calc_distr_paren",
+          lineOffsets: [12],
+          positions: { "1": 1, "2": 3, "3": 5, "4": 8, "5": 10 },
+        },
+      },
+      checkedAst:
+        "_*_(\n  _+_(\n    1~int,\n    2~int\n  )~int^add_int64,\n  3~int\n)~int^multiply_int64",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_int64"] },
+          "4": { overloadId: ["multiply_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [12],
+          positions: { "1": 1, "2": 3, "3": 5, "4": 8, "5": 10 },
+        },
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_*_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", constExpr: { int64Value: "1" } },
+                    { id: "3", constExpr: { int64Value: "2" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: { value: { int64Value: "9" } },
+      runtimeCost: "2",
+      expectedUnparsed: "(1 + 2) * 3",
+    },
+    {
+      original: { name: "calc_distr_noparen", expr: "1 + 2 * 3" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_+_(\n  1^#*expr.Constant_Int64Value#,\n  _*_(\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "1 + 2 * 3",
+      locationAst:
+        "_+_(\n  1^#1[1,0]#,\n  _*_(\n    2^#3[1,4]#,\n    3^#5[1,8]#\n  )^#4[1,6]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "calc_distr_noparen",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 6, "5": 8 },
+        },
+      },
+      checkedAst:
+        "_+_(\n  1~int,\n  _*_(\n    2~int,\n    3~int\n  )~int^multiply_int64\n)~int^add_int64",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["add_int64"] },
+          "4": { overloadId: ["multiply_int64"] },
+        },
+        typeMap: {
+          "1": { primitive: "INT64" },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "5": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 6, "5": 8 },
+        },
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_+_",
+            args: [
+              { id: "1", constExpr: { int64Value: "1" } },
+              {
+                id: "4",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "3", constExpr: { int64Value: "2" } },
+                    { id: "5", constExpr: { int64Value: "3" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "2", max: "2" },
+      result: { value: { int64Value: "7" } },
+      runtimeCost: "2",
+      expectedUnparsed: "1 + 2 * 3",
+    },
+    {
+      original: {
+        name: "cond_tern_simple",
+        expr: "(x \u003e 5) ? (x - 5) : 0",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  _\u003e_(\n    x^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _-_(\n    x^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(x \u003e 5) ? (x - 5) : 0",
+      locationAst:
+        "_?_:_(\n  _\u003e_(\n    x^#1[1,1]#,\n    5^#3[1,5]#\n  )^#2[1,3]#,\n  _-_(\n    x^#5[1,11]#,\n    5^#7[1,15]#\n  )^#6[1,13]#,\n  0^#8[1,20]#\n)^#4[1,8]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 11, 12, 1, 11, 1, 12],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "1", identExpr: { name: "x" } },
+                    { id: "3", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    { id: "5", identExpr: { name: "x" } },
+                    { id: "7", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_tern_simple",
+          lineOffsets: [22],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 8,
+            "5": 11,
+            "6": 13,
+            "7": 15,
+            "8": 20,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'x' (in container '')\n | (x \u003e 5) ? (x - 5) : 0\n | .^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'x' (in container '')\n | (x \u003e 5) ? (x - 5) : 0\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 5,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedUnparsed: "(x \u003e 5) ? (x - 5) : 0",
+    },
+    {
+      original: {
+        name: "cond_tern_neg_expr",
+        expr: "-((x \u003e 5) ? (x - 5) : 0)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "-_(\n  _?_:_(\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      5^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _-_(\n      x^#*expr.Expr_IdentExpr#,\n      5^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    0^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "-((x \u003e 5) ? (x - 5) : 0)",
+      locationAst:
+        "-_(\n  _?_:_(\n    _\u003e_(\n      x^#2[1,3]#,\n      5^#4[1,7]#\n    )^#3[1,5]#,\n    _-_(\n      x^#6[1,13]#,\n      5^#8[1,17]#\n    )^#7[1,15]#,\n    0^#9[1,22]#\n  )^#5[1,10]#\n)^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 18, 1, 17, 1, 18],
+        [9, 22, 23, 1, 22, 1, 23],
+      ],
+      lineOffsets: [25],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "-_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "2", identExpr: { name: "x" } },
+                          { id: "4", constExpr: { int64Value: "5" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "7",
+                      callExpr: {
+                        function: "_-_",
+                        args: [
+                          { id: "6", identExpr: { name: "x" } },
+                          { id: "8", constExpr: { int64Value: "5" } },
+                        ],
+                      },
+                    },
+                    { id: "9", constExpr: { int64Value: "0" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_tern_neg_expr",
+          lineOffsets: [25],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 5,
+            "4": 7,
+            "5": 10,
+            "6": 13,
+            "7": 15,
+            "8": 17,
+            "9": 22,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'x' (in container '')\n | -((x \u003e 5) ? (x - 5) : 0)\n | ...^\nERROR: \u003cinput\u003e:1:14: undeclared reference to 'x' (in container '')\n | -((x \u003e 5) ? (x - 5) : 0)\n | .............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 2,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 6,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedUnparsed: "-((x \u003e 5) ? (x - 5) : 0)",
+    },
+    {
+      original: { name: "cond_tern_neg_term", expr: "-x ? (x - 5) : 0" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  -_(\n    x^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _-_(\n    x^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "-x ? (x - 5) : 0",
+      locationAst:
+        "_?_:_(\n  -_(\n    x^#2[1,1]#\n  )^#1[1,0]#,\n  _-_(\n    x^#4[1,6]#,\n    5^#6[1,10]#\n  )^#5[1,8]#,\n  0^#7[1,15]#\n)^#3[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 15, 16, 1, 15, 1, 16],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "1",
+                callExpr: {
+                  function: "-_",
+                  args: [{ id: "2", identExpr: { name: "x" } }],
+                },
+              },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    { id: "4", identExpr: { name: "x" } },
+                    { id: "6", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "7", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_tern_neg_term",
+          lineOffsets: [17],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 3,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 15,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'x' (in container '')\n | -x ? (x - 5) : 0\n | .^\nERROR: \u003cinput\u003e:1:7: undeclared reference to 'x' (in container '')\n | -x ? (x - 5) : 0\n | ......^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 2,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 4,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+      ],
+      expectedUnparsed: "-x ? (x - 5) : 0",
+    },
+    {
+      original: { name: "func_global", expr: "size(a ? (b ? c : d) : e)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "size(\n  _?_:_(\n    a^#*expr.Expr_IdentExpr#,\n    _?_:_(\n      b^#*expr.Expr_IdentExpr#,\n      c^#*expr.Expr_IdentExpr#,\n      d^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "size(a ? (b ? c : d) : e)",
+      locationAst:
+        "size(\n  _?_:_(\n    a^#2[1,5]#,\n    _?_:_(\n      b^#4[1,10]#,\n      c^#6[1,14]#,\n      d^#7[1,18]#\n    )^#5[1,12]#,\n    e^#8[1,23]#\n  )^#3[1,7]#\n)^#1[1,4]#",
+      positions: [
+        [1, 4, 5, 1, 4, 1, 5],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 18, 19, 1, 18, 1, 19],
+        [8, 23, 24, 1, 23, 1, 24],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "size",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    { id: "2", identExpr: { name: "a" } },
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_?_:_",
+                        args: [
+                          { id: "4", identExpr: { name: "b" } },
+                          { id: "6", identExpr: { name: "c" } },
+                          { id: "7", identExpr: { name: "d" } },
+                        ],
+                      },
+                    },
+                    { id: "8", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "func_global",
+          lineOffsets: [26],
+          positions: {
+            "1": 4,
+            "2": 5,
+            "3": 7,
+            "4": 10,
+            "5": 12,
+            "6": 14,
+            "7": 18,
+            "8": 23,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:6: undeclared reference to 'a' (in container '')\n | size(a ? (b ? c : d) : e)\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'b' (in container '')\n | size(a ? (b ? c : d) : e)\n | ..........^\nERROR: \u003cinput\u003e:1:15: undeclared reference to 'c' (in container '')\n | size(a ? (b ? c : d) : e)\n | ..............^\nERROR: \u003cinput\u003e:1:19: undeclared reference to 'd' (in container '')\n | size(a ? (b ? c : d) : e)\n | ..................^\nERROR: \u003cinput\u003e:1:24: undeclared reference to 'e' (in container '')\n | size(a ? (b ? c : d) : e)\n | .......................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 4,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 6,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 18,
+          line: 1,
+          column: 18,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 8,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+      expectedUnparsed: "size(a ? (b ? c : d) : e)",
+    },
+    {
+      original: { name: "func_member", expr: 'a.hello("world")' },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: 'a^#*expr.Expr_IdentExpr#.hello(\n  "world"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'a.hello("world")',
+      locationAst: 'a^#1[1,0]#.hello(\n  "world"^#3[1,8]#\n)^#2[1,7]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 7, 8, 1, 7, 1, 8],
+        [3, 8, 15, 1, 8, 1, 15],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            target: { id: "1", identExpr: { name: "a" } },
+            function: "hello",
+            args: [{ id: "3", constExpr: { stringValue: "world" } }],
+          },
+        },
+        sourceInfo: {
+          location: "func_member",
+          lineOffsets: [17],
+          positions: { "1": 0, "2": 7, "3": 8 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.hello(\"world\")\n | ^\nERROR: \u003cinput\u003e:1:8: undeclared reference to 'hello' (in container '')\n | a.hello(\"world\")\n | .......^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'hello' (in container '')",
+          id: 2,
+          offset: 7,
+          line: 1,
+          column: 7,
+        },
+      ],
+      expectedUnparsed: 'a.hello("world")',
+    },
+    {
+      original: { name: "func_no_arg", expr: "zero()" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "zero()^#*expr.Expr_CallExpr#",
+      unparsed: "zero()",
+      locationAst: "zero()^#1[1,4]#",
+      positions: [[1, 4, 5, 1, 4, 1, 5]],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: { id: "1", callExpr: { function: "zero" } },
+        sourceInfo: {
+          location: "func_no_arg",
+          lineOffsets: [7],
+          positions: { "1": 4 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:5: undeclared reference to 'zero' (in container '')\n | zero()\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'zero' (in container '')",
+          id: 1,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedUnparsed: "zero()",
+    },
+    {
+      original: { name: "func_one_arg", expr: 'one("a")' },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: 'one(\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'one("a")',
+      locationAst: 'one(\n  "a"^#2[1,4]#\n)^#1[1,3]#',
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 7, 1, 4, 1, 7],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "one",
+            args: [{ id: "2", constExpr: { stringValue: "a" } }],
+          },
+        },
+        sourceInfo: {
+          location: "func_one_arg",
+          lineOffsets: [9],
+          positions: { "1": 3, "2": 4 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'one' (in container '')\n | one(\"a\")\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'one' (in container '')",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedUnparsed: 'one("a")',
+    },
+    {
+      original: { name: "func_two_args", expr: "and(d, 32u)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "and(\n  d^#*expr.Expr_IdentExpr#,\n  32u^#*expr.Constant_Uint64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "and(d, 32u)",
+      locationAst: "and(\n  d^#2[1,4]#,\n  32u^#3[1,7]#\n)^#1[1,3]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 7, 10, 1, 7, 1, 10],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "and",
+            args: [
+              { id: "2", identExpr: { name: "d" } },
+              { id: "3", constExpr: { uint64Value: "32" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "func_two_args",
+          lineOffsets: [12],
+          positions: { "1": 3, "2": 4, "3": 7 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'and' (in container '')\n | and(d, 32u)\n | ...^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'd' (in container '')\n | and(d, 32u)\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'and' (in container '')",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 2,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedUnparsed: "and(d, 32u)",
+    },
+    {
+      original: { name: "func_var_args", expr: "max(a, b, 100)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "max(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  100^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "max(a, b, 100)",
+      locationAst:
+        "max(\n  a^#2[1,4]#,\n  b^#3[1,7]#,\n  100^#4[1,10]#\n)^#1[1,3]#",
+      positions: [
+        [1, 3, 4, 1, 3, 1, 4],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 7, 8, 1, 7, 1, 8],
+        [4, 10, 13, 1, 10, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          callExpr: {
+            function: "max",
+            args: [
+              { id: "2", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", constExpr: { int64Value: "100" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "func_var_args",
+          lineOffsets: [15],
+          positions: { "1": 3, "2": 4, "3": 7, "4": 10 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:4: undeclared reference to 'max' (in container '')\n | max(a, b, 100)\n | ...^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'a' (in container '')\n | max(a, b, 100)\n | ....^\nERROR: \u003cinput\u003e:1:8: undeclared reference to 'b' (in container '')\n | max(a, b, 100)\n | .......^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'max' (in container '')",
+          id: 1,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 7,
+          line: 1,
+          column: 7,
+        },
+      ],
+      expectedUnparsed: "max(a, b, 100)",
+    },
+    {
+      original: { name: "func_neq", expr: 'x != "a"' },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '_!=_(\n  x^#*expr.Expr_IdentExpr#,\n  "a"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'x != "a"',
+      locationAst: '_!=_(\n  x^#1[1,0]#,\n  "a"^#3[1,5]#\n)^#2[1,2]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 8, 1, 5, 1, 8],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              { id: "1", identExpr: { name: "x" } },
+              { id: "3", constExpr: { stringValue: "a" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "func_neq",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'x' (in container '')\n | x != \"a\"\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: 'x != "a"',
+    },
+    {
+      original: { name: "func_in", expr: "a in b" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "@in(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a in b",
+      locationAst: "@in(\n  a^#1[1,0]#,\n  b^#3[1,5]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "@in",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "func_in",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 2, "3": 5 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a in b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a in b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedUnparsed: "a in b",
+    },
+    {
+      original: { name: "list_empty", expr: "[]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "[]^#*expr.Expr_ListExpr#",
+      unparsed: "[]",
+      locationAst: "[]^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", listExpr: {} },
+        sourceInfo: {
+          location: "list_empty",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "[]~list(dyn)",
+      checkedExpr: {
+        typeMap: { "1": { listType: { elemType: { dyn: {} } } } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", listExpr: {} },
+      },
+      type: "list(dyn)",
+      cost: { min: "10", max: "10" },
+      result: { value: { listValue: {} } },
+      runtimeCost: "10",
+      expectedUnparsed: "[]",
+    },
+    {
+      original: { name: "list_one", expr: "[1]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1]",
+      locationAst: "[\n  1^#2[1,1]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: { elements: [{ id: "2", constExpr: { int64Value: "1" } }] },
+        },
+        sourceInfo: {
+          location: "list_one",
+          lineOffsets: [4],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      checkedAst: "[\n  1~int\n]~list(int)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0, "2": 1 },
+        },
+        expr: {
+          id: "1",
+          listExpr: { elements: [{ id: "2", constExpr: { int64Value: "1" } }] },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "10", max: "10" },
+      result: { value: { listValue: { values: [{ int64Value: "1" }] } } },
+      runtimeCost: "10",
+      expectedUnparsed: "[1]",
+    },
+    {
+      original: { name: "list_ints", expr: "[1, 2, 3]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 2, 3]",
+      locationAst: "[\n  1^#2[1,1]#,\n  2^#3[1,4]#,\n  3^#4[1,7]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_ints",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+      },
+      checkedAst: "[\n  1~int,\n  2~int,\n  3~int\n]~list(int)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 7 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { int64Value: "2" } },
+              { id: "4", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { int64Value: "2" },
+              { int64Value: "3" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedUnparsed: "[1, 2, 3]",
+    },
+    {
+      original: { name: "list_doubles", expr: "[1.0, 2.0, 3.0]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_DoubleValue#,\n  2^#*expr.Constant_DoubleValue#,\n  3^#*expr.Constant_DoubleValue#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1.0, 2.0, 3.0]",
+      locationAst: "[\n  1^#2[1,1]#,\n  2^#3[1,6]#,\n  3^#4[1,11]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 11, 14, 1, 11, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { doubleValue: 1 } },
+              { id: "3", constExpr: { doubleValue: 2 } },
+              { id: "4", constExpr: { doubleValue: 3 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_doubles",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 11 },
+        },
+      },
+      checkedAst: "[\n  1~double,\n  2~double,\n  3~double\n]~list(double)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 11 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { doubleValue: 1 } },
+              { id: "3", constExpr: { doubleValue: 2 } },
+              { id: "4", constExpr: { doubleValue: 3 } },
+            ],
+          },
+        },
+      },
+      type: "list(double)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { doubleValue: 1 },
+              { doubleValue: 2 },
+              { doubleValue: 3 },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedUnparsed: "[1.0, 2.0, 3.0]",
+    },
+    {
+      original: { name: "list_doubles", expr: "[1.1, 2.1, 3.1]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "[\n  1.1^#*expr.Constant_DoubleValue#,\n  2.1^#*expr.Constant_DoubleValue#,\n  3.1^#*expr.Constant_DoubleValue#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1.1, 2.1, 3.1]",
+      locationAst:
+        "[\n  1.1^#2[1,1]#,\n  2.1^#3[1,6]#,\n  3.1^#4[1,11]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 4, 1, 1, 1, 4],
+        [3, 6, 9, 1, 6, 1, 9],
+        [4, 11, 14, 1, 11, 1, 14],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { doubleValue: 1.1 } },
+              { id: "3", constExpr: { doubleValue: 2.1 } },
+              { id: "4", constExpr: { doubleValue: 3.1 } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_doubles",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 11 },
+        },
+      },
+      checkedAst:
+        "[\n  1.1~double,\n  2.1~double,\n  3.1~double\n]~list(double)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "DOUBLE" } } },
+          "2": { primitive: "DOUBLE" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "DOUBLE" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [16],
+          positions: { "1": 0, "2": 1, "3": 6, "4": 11 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { doubleValue: 1.1 } },
+              { id: "3", constExpr: { doubleValue: 2.1 } },
+              { id: "4", constExpr: { doubleValue: 3.1 } },
+            ],
+          },
+        },
+      },
+      type: "list(double)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { doubleValue: 1.1 },
+              { doubleValue: 2.1 },
+              { doubleValue: 3.1 },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedUnparsed: "[1.1, 2.1, 3.1]",
+    },
+    {
+      original: { name: "list_uints", expr: "[1u, 2u, 3u]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "[\n  1u^#*expr.Constant_Uint64Value#,\n  2u^#*expr.Constant_Uint64Value#,\n  3u^#*expr.Constant_Uint64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1u, 2u, 3u]",
+      locationAst:
+        "[\n  1u^#2[1,1]#,\n  2u^#3[1,5]#,\n  3u^#4[1,9]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 3, 1, 1, 1, 3],
+        [3, 5, 7, 1, 5, 1, 7],
+        [4, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { uint64Value: "1" } },
+              { id: "3", constExpr: { uint64Value: "2" } },
+              { id: "4", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_uints",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 1, "3": 5, "4": 9 },
+        },
+      },
+      checkedAst: "[\n  1u~uint,\n  2u~uint,\n  3u~uint\n]~list(uint)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "UINT64" } } },
+          "2": { primitive: "UINT64" },
+          "3": { primitive: "UINT64" },
+          "4": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 1, "3": 5, "4": 9 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { uint64Value: "1" } },
+              { id: "3", constExpr: { uint64Value: "2" } },
+              { id: "4", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "list(uint)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { uint64Value: "1" },
+              { uint64Value: "2" },
+              { uint64Value: "3" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedUnparsed: "[1u, 2u, 3u]",
+    },
+    {
+      original: { name: "list_numeric", expr: "[1, 2.0, 3u]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "[\n  1^#*expr.Constant_Int64Value#,\n  2^#*expr.Constant_DoubleValue#,\n  3u^#*expr.Constant_Uint64Value#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[1, 2.0, 3u]",
+      locationAst: "[\n  1^#2[1,1]#,\n  2^#3[1,4]#,\n  3u^#4[1,9]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 7, 1, 4, 1, 7],
+        [4, 9, 11, 1, 9, 1, 11],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { doubleValue: 2 } },
+              { id: "4", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_numeric",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 9 },
+        },
+      },
+      checkedAst: "[\n  1~int,\n  2~double,\n  3u~uint\n]~list(dyn)",
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { dyn: {} } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "DOUBLE" },
+          "4": { primitive: "UINT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 9 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { int64Value: "1" } },
+              { id: "3", constExpr: { doubleValue: 2 } },
+              { id: "4", constExpr: { uint64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "list(dyn)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { int64Value: "1" },
+              { doubleValue: 2 },
+              { uint64Value: "3" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedUnparsed: "[1, 2.0, 3u]",
+    },
+    {
+      original: {
+        name: "list_many",
+        expr: '["hello, world", "goodbye, world", "sure, why not?"]',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '[\n  "hello, world"^#*expr.Constant_StringValue#,\n  "goodbye, world"^#*expr.Constant_StringValue#,\n  "sure, why not?"^#*expr.Constant_StringValue#\n]^#*expr.Expr_ListExpr#',
+      unparsed: '["hello, world", "goodbye, world", "sure, why not?"]',
+      locationAst:
+        '[\n  "hello, world"^#2[1,1]#,\n  "goodbye, world"^#3[1,17]#,\n  "sure, why not?"^#4[1,35]#\n]^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 15, 1, 1, 1, 15],
+        [3, 17, 33, 1, 17, 1, 33],
+        [4, 35, 51, 1, 35, 1, 51],
+      ],
+      lineOffsets: [53],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { stringValue: "hello, world" } },
+              { id: "3", constExpr: { stringValue: "goodbye, world" } },
+              { id: "4", constExpr: { stringValue: "sure, why not?" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "list_many",
+          lineOffsets: [53],
+          positions: { "1": 0, "2": 1, "3": 17, "4": 35 },
+        },
+      },
+      checkedAst:
+        '[\n  "hello, world"~string,\n  "goodbye, world"~string,\n  "sure, why not?"~string\n]~list(string)',
+      checkedExpr: {
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "STRING" } } },
+          "2": { primitive: "STRING" },
+          "3": { primitive: "STRING" },
+          "4": { primitive: "STRING" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [53],
+          positions: { "1": 0, "2": 1, "3": 17, "4": 35 },
+        },
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", constExpr: { stringValue: "hello, world" } },
+              { id: "3", constExpr: { stringValue: "goodbye, world" } },
+              { id: "4", constExpr: { stringValue: "sure, why not?" } },
+            ],
+          },
+        },
+      },
+      type: "list(string)",
+      cost: { min: "10", max: "10" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { stringValue: "hello, world" },
+              { stringValue: "goodbye, world" },
+              { stringValue: "sure, why not?" },
+            ],
+          },
+        },
+      },
+      runtimeCost: "10",
+      expectedUnparsed: '["hello, world", "goodbye, world", "sure, why not?"]',
+    },
+    {
+      original: { name: "lit_bytes", expr: 'b"\\303\\203\\302\\277"' },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: 'b"Ã¿"^#*expr.Constant_BytesValue#',
+      unparsed: 'b"\\303\\203\\302\\277"',
+      locationAst: 'b"Ã¿"^#1[1,0]#',
+      positions: [[1, 0, 19, 1, 0, 1, 19]],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { bytesValue: "w4PCvw==" } },
+        sourceInfo: {
+          location: "lit_bytes",
+          lineOffsets: [20],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: 'b"Ã¿"~bytes',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [20],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { bytesValue: "w4PCvw==" } },
+      },
+      type: "bytes",
+      cost: { min: "0", max: "0" },
+      result: { value: { bytesValue: "w4PCvw==" } },
+      runtimeCost: "0",
+      expectedUnparsed: 'b"\\303\\203\\302\\277"',
+    },
+    {
+      original: { name: "lit_double", expr: "-42.101" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "-42.101^#*expr.Constant_DoubleValue#",
+      unparsed: "-42.101",
+      locationAst: "-42.101^#1[1,0]#",
+      positions: [[1, 0, 7, 1, 0, 1, 7]],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { doubleValue: -42.101 } },
+        sourceInfo: {
+          location: "lit_double",
+          lineOffsets: [8],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "-42.101~double",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "DOUBLE" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { doubleValue: -42.101 } },
+      },
+      type: "double",
+      cost: { min: "0", max: "0" },
+      result: { value: { doubleValue: -42.101 } },
+      runtimeCost: "0",
+      expectedUnparsed: "-42.101",
+    },
+    {
+      original: { name: "lit_false", expr: "false" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "false^#*expr.Constant_BoolValue#",
+      unparsed: "false",
+      locationAst: "false^#1[1,0]#",
+      positions: [[1, 0, 5, 1, 0, 1, 5]],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: false } },
+        sourceInfo: {
+          location: "lit_false",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "false~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [6],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { boolValue: false } },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedUnparsed: "false",
+    },
+    {
+      original: { name: "lit_int", expr: "-405069" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "-405069^#*expr.Constant_Int64Value#",
+      unparsed: "-405069",
+      locationAst: "-405069^#1[1,0]#",
+      positions: [[1, 0, 7, 1, 0, 1, 7]],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { int64Value: "-405069" } },
+        sourceInfo: {
+          location: "lit_int",
+          lineOffsets: [8],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "-405069~int",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "INT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [8],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { int64Value: "-405069" } },
+      },
+      type: "int",
+      cost: { min: "0", max: "0" },
+      result: { value: { int64Value: "-405069" } },
+      runtimeCost: "0",
+      expectedUnparsed: "-405069",
+    },
+    {
+      original: { name: "lit_null", expr: "null" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "null^#*expr.Constant_NullValue#",
+      unparsed: "null",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "lit_null",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
+      type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+      expectedUnparsed: "null",
+    },
+    {
+      original: { name: "lit_string", expr: "\"hello:\\t'world'\"" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "\"hello:\\t'world'\"^#*expr.Constant_StringValue#",
+      unparsed: "\"hello:\\t'world'\"",
+      locationAst: "\"hello:\\t'world'\"^#1[1,0]#",
+      positions: [[1, 0, 17, 1, 0, 1, 17]],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: "hello:\t'world'" } },
+        sourceInfo: {
+          location: "lit_string",
+          lineOffsets: [18],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "\"hello:\\t'world'\"~string",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: "hello:\t'world'" } },
+      },
+      type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: "hello:\t'world'" } },
+      runtimeCost: "0",
+      expectedUnparsed: "\"hello:\\t'world'\"",
+    },
+    {
+      original: { name: "lit_string_quote", expr: '"hello:\\"world\\""' },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '"hello:\\"world\\""^#*expr.Constant_StringValue#',
+      unparsed: '"hello:\\"world\\""',
+      locationAst: '"hello:\\"world\\""^#1[1,0]#',
+      positions: [[1, 0, 17, 1, 0, 1, 17]],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { stringValue: 'hello:"world"' } },
+        sourceInfo: {
+          location: "lit_string_quote",
+          lineOffsets: [18],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: '"hello:\\"world\\""~string',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "STRING" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [18],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { stringValue: 'hello:"world"' } },
+      },
+      type: "string",
+      cost: { min: "0", max: "0" },
+      result: { value: { stringValue: 'hello:"world"' } },
+      runtimeCost: "0",
+      expectedUnparsed: '"hello:\\"world\\""',
+    },
+    {
+      original: { name: "lit_true", expr: "true" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "true^#*expr.Constant_BoolValue#",
+      unparsed: "true",
+      locationAst: "true^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: true } },
+        sourceInfo: {
+          location: "lit_true",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "true~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { boolValue: true } },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      expectedUnparsed: "true",
+    },
+    {
+      original: { name: "lit_uint", expr: "42u" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "42u^#*expr.Constant_Uint64Value#",
+      unparsed: "42u",
+      locationAst: "42u^#1[1,0]#",
+      positions: [[1, 0, 3, 1, 0, 1, 3]],
+      lineOffsets: [4],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { uint64Value: "42" } },
+        sourceInfo: {
+          location: "lit_uint",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "42u~uint",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "UINT64" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [4],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { uint64Value: "42" } },
+      },
+      type: "uint",
+      cost: { min: "0", max: "0" },
+      result: { value: { uint64Value: "42" } },
+      runtimeCost: "0",
+      expectedUnparsed: "42u",
+    },
+    {
+      original: { name: "ident", expr: "my_ident" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "my_ident^#*expr.Expr_IdentExpr#",
+      unparsed: "my_ident",
+      locationAst: "my_ident^#1[1,0]#",
+      positions: [[1, 0, 8, 1, 0, 1, 8]],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: { id: "1", identExpr: { name: "my_ident" } },
+        sourceInfo: {
+          location: "ident",
+          lineOffsets: [9],
+          positions: { "1": 0 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'my_ident' (in container '')\n | my_ident\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'my_ident' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: "my_ident",
+    },
+    {
+      original: { name: "macro_has", expr: "has(hello.world)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "hello^#*expr.Expr_IdentExpr#.world~test-only~^#*expr.Expr_SelectExpr#",
+      unparsed: "has(hello.world)",
+      locationAst: "hello^#2[1,4]#.world~test-only~^#4[1,3]#",
+      positions: [
+        [2, 4, 9, 1, 4, 1, 9],
+        [3, 9, 10, 1, 9, 1, 10],
+        [4, 3, 3, 1, 3, 1, 3],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: { id: "2", identExpr: { name: "hello" } },
+            field: "world",
+            testOnly: true,
+          },
+        },
+        sourceInfo: {
+          location: "macro_has",
+          lineOffsets: [17],
+          positions: { "2": 4, "3": 9, "4": 3 },
+          macroCalls: {
+            "4": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "3",
+                    selectExpr: {
+                      operand: { id: "2", identExpr: { name: "hello" } },
+                      field: "world",
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 4,
+          function: "has",
+          args: [
+            {
+              id: "3",
+              selectExpr: {
+                operand: { id: "2", identExpr: { name: "hello" } },
+                field: "world",
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        "ERROR: \u003cinput\u003e:1:5: undeclared reference to 'hello' (in container '')\n | has(hello.world)\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'hello' (in container '')",
+          id: 2,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedUnparsed: "has(hello.world)",
+    },
+    {
+      original: { name: "map_empty", expr: "{}" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "{}^#*expr.Expr_StructExpr#",
+      unparsed: "{}",
+      locationAst: "{}^#1[1,0]#",
+      positions: [[1, 0, 1, 1, 0, 1, 1]],
+      lineOffsets: [3],
+      parsedExpr: {
+        expr: { id: "1", structExpr: {} },
+        sourceInfo: {
+          location: "map_empty",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "{}~map(dyn, dyn)",
+      checkedExpr: {
+        typeMap: {
+          "1": { mapType: { keyType: { dyn: {} }, valueType: { dyn: {} } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [3],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", structExpr: {} },
+      },
+      type: "map(dyn, dyn)",
+      cost: { min: "30", max: "30" },
+      result: { value: { mapValue: {} } },
+      runtimeCost: "30",
+      expectedUnparsed: "{}",
+    },
+    {
+      original: {
+        name: "map_lit_key",
+        expr: '{"a": a.b.c, b"\\142": bytes(a.b.c)}',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '{\n  "a"^#*expr.Constant_StringValue#:a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#.c^#*expr.Expr_SelectExpr#^#*expr.Expr_CreateStruct_Entry#,\n  b"b"^#*expr.Constant_BytesValue#:bytes(\n    a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#.c^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed: '{"a": a.b.c, b"\\142": bytes(a.b.c)}',
+      locationAst:
+        '{\n  "a"^#3[1,1]#:a^#4[1,6]#.b^#5[1,7]#.c^#6[1,9]#^#2[1,4]#,\n  b"b"^#8[1,13]#:bytes(\n    a^#10[1,28]#.b^#11[1,29]#.c^#12[1,31]#\n  )^#9[1,27]#^#7[1,20]#\n}^#1[1,0]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 4, 5, 1, 4, 1, 5],
+        [3, 1, 4, 1, 1, 1, 4],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 9, 10, 1, 9, 1, 10],
+        [7, 20, 21, 1, 20, 1, 21],
+        [8, 13, 20, 1, 13, 1, 20],
+        [9, 27, 28, 1, 27, 1, 28],
+        [10, 28, 29, 1, 28, 1, 29],
+        [11, 29, 30, 1, 29, 1, 30],
+        [12, 31, 32, 1, 31, 1, 32],
+      ],
+      lineOffsets: [36],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", constExpr: { stringValue: "a" } },
+                value: {
+                  id: "6",
+                  selectExpr: {
+                    operand: {
+                      id: "5",
+                      selectExpr: {
+                        operand: { id: "4", identExpr: { name: "a" } },
+                        field: "b",
+                      },
+                    },
+                    field: "c",
+                  },
+                },
+              },
+              {
+                id: "7",
+                mapKey: { id: "8", constExpr: { bytesValue: "Yg==" } },
+                value: {
+                  id: "9",
+                  callExpr: {
+                    function: "bytes",
+                    args: [
+                      {
+                        id: "12",
+                        selectExpr: {
+                          operand: {
+                            id: "11",
+                            selectExpr: {
+                              operand: { id: "10", identExpr: { name: "a" } },
+                              field: "b",
+                            },
+                          },
+                          field: "c",
+                        },
+                      },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "map_lit_key",
+          lineOffsets: [36],
+          positions: {
+            "1": 0,
+            "2": 4,
+            "3": 1,
+            "4": 6,
+            "5": 7,
+            "6": 9,
+            "7": 20,
+            "8": 13,
+            "9": 27,
+            "10": 28,
+            "11": 29,
+            "12": 31,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:7: undeclared reference to 'a' (in container '')\n | {\"a\": a.b.c, b\"\\142\": bytes(a.b.c)}\n | ......^\nERROR: \u003cinput\u003e:1:29: undeclared reference to 'a' (in container '')\n | {\"a\": a.b.c, b\"\\142\": bytes(a.b.c)}\n | ............................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 4,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 10,
+          offset: 28,
+          line: 1,
+          column: 28,
+        },
+      ],
+      expectedUnparsed: '{"a": a.b.c, b"\\142": bytes(a.b.c)}',
+    },
+    {
+      original: {
+        name: "map_expr_key",
+        expr: "{a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "{\n  a^#*expr.Expr_IdentExpr#:a^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#,\n  b^#*expr.Expr_IdentExpr#:a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#^#*expr.Expr_CreateStruct_Entry#,\n  c^#*expr.Expr_IdentExpr#:a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#.c^#*expr.Expr_SelectExpr#^#*expr.Expr_CreateStruct_Entry#,\n  _?_:_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#:false^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#,\n  _||_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#:true^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "{a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}",
+      locationAst:
+        "{\n  a^#3[1,1]#:a^#4[1,4]#^#2[1,2]#,\n  b^#6[1,7]#:a^#7[1,10]#.b^#8[1,11]#^#5[1,8]#,\n  c^#10[1,15]#:a^#11[1,18]#.b^#12[1,19]#.c^#13[1,21]#^#9[1,16]#,\n  _?_:_(\n    a^#15[1,25]#,\n    b^#17[1,29]#,\n    c^#18[1,33]#\n  )^#16[1,27]#:false^#19[1,36]#^#14[1,34]#,\n  _||_(\n    a^#21[1,43]#,\n    b^#22[1,48]#\n  )^#23[1,45]#:true^#24[1,51]#^#20[1,49]#\n}^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 7, 8, 1, 7, 1, 8],
+        [7, 10, 11, 1, 10, 1, 11],
+        [8, 11, 12, 1, 11, 1, 12],
+        [9, 16, 17, 1, 16, 1, 17],
+        [10, 15, 16, 1, 15, 1, 16],
+        [11, 18, 19, 1, 18, 1, 19],
+        [12, 19, 20, 1, 19, 1, 20],
+        [13, 21, 22, 1, 21, 1, 22],
+        [14, 34, 35, 1, 34, 1, 35],
+        [15, 25, 26, 1, 25, 1, 26],
+        [16, 27, 28, 1, 27, 1, 28],
+        [17, 29, 30, 1, 29, 1, 30],
+        [18, 33, 34, 1, 33, 1, 34],
+        [19, 36, 41, 1, 36, 1, 41],
+        [20, 49, 50, 1, 49, 1, 50],
+        [21, 43, 44, 1, 43, 1, 44],
+        [22, 48, 49, 1, 48, 1, 49],
+        [23, 45, 47, 1, 45, 1, 47],
+        [24, 51, 55, 1, 51, 1, 55],
+      ],
+      lineOffsets: [57],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", identExpr: { name: "a" } },
+                value: { id: "4", identExpr: { name: "a" } },
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", identExpr: { name: "b" } },
+                value: {
+                  id: "8",
+                  selectExpr: {
+                    operand: { id: "7", identExpr: { name: "a" } },
+                    field: "b",
+                  },
+                },
+              },
+              {
+                id: "9",
+                mapKey: { id: "10", identExpr: { name: "c" } },
+                value: {
+                  id: "13",
+                  selectExpr: {
+                    operand: {
+                      id: "12",
+                      selectExpr: {
+                        operand: { id: "11", identExpr: { name: "a" } },
+                        field: "b",
+                      },
+                    },
+                    field: "c",
+                  },
+                },
+              },
+              {
+                id: "14",
+                mapKey: {
+                  id: "16",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      { id: "15", identExpr: { name: "a" } },
+                      { id: "17", identExpr: { name: "b" } },
+                      { id: "18", identExpr: { name: "c" } },
+                    ],
+                  },
+                },
+                value: { id: "19", constExpr: { boolValue: false } },
+              },
+              {
+                id: "20",
+                mapKey: {
+                  id: "23",
+                  callExpr: {
+                    function: "_||_",
+                    args: [
+                      { id: "21", identExpr: { name: "a" } },
+                      { id: "22", identExpr: { name: "b" } },
+                    ],
+                  },
+                },
+                value: { id: "24", constExpr: { boolValue: true } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "map_expr_key",
+          lineOffsets: [57],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 8,
+            "6": 7,
+            "7": 10,
+            "8": 11,
+            "9": 16,
+            "10": 15,
+            "11": 18,
+            "12": 19,
+            "13": 21,
+            "14": 34,
+            "15": 25,
+            "16": 27,
+            "17": 29,
+            "18": 33,
+            "19": 36,
+            "20": 49,
+            "21": 43,
+            "22": 48,
+            "23": 45,
+            "24": 51,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | .^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'a' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | ....^\nERROR: \u003cinput\u003e:1:8: undeclared reference to 'b' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | .......^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'a' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'c' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | ...............^\nERROR: \u003cinput\u003e:1:19: undeclared reference to 'a' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | ..................^\nERROR: \u003cinput\u003e:1:26: undeclared reference to 'a' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | .........................^\nERROR: \u003cinput\u003e:1:30: undeclared reference to 'b' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | .............................^\nERROR: \u003cinput\u003e:1:34: undeclared reference to 'c' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | .................................^\nERROR: \u003cinput\u003e:1:44: undeclared reference to 'a' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | ...........................................^\nERROR: \u003cinput\u003e:1:49: undeclared reference to 'b' (in container '')\n | {a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}\n | ................................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 4,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 6,
+          offset: 7,
+          line: 1,
+          column: 7,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 7,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 10,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 11,
+          offset: 18,
+          line: 1,
+          column: 18,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 15,
+          offset: 25,
+          line: 1,
+          column: 25,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 17,
+          offset: 29,
+          line: 1,
+          column: 29,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 18,
+          offset: 33,
+          line: 1,
+          column: 33,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 21,
+          offset: 43,
+          line: 1,
+          column: 43,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 22,
+          offset: 48,
+          line: 1,
+          column: 48,
+        },
+      ],
+      expectedUnparsed:
+        "{a: a, b: a.b, c: a.b.c, a ? b : c: false, a || b: true}",
+    },
+    {
+      original: { name: "msg_empty", expr: "v1alpha1.Expr{}" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "v1alpha1.Expr{}^#*expr.Expr_StructExpr#",
+      unparsed: "v1alpha1.Expr{}",
+      locationAst: "v1alpha1.Expr{}^#1[1,13]#",
+      positions: [[1, 13, 14, 1, 13, 1, 14]],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: { id: "1", structExpr: { messageName: "v1alpha1.Expr" } },
+        sourceInfo: {
+          location: "msg_empty",
+          lineOffsets: [16],
+          positions: { "1": 13 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: undeclared reference to 'v1alpha1.Expr' (in container '')\n | v1alpha1.Expr{}\n | .............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'v1alpha1.Expr' (in container '')",
+          id: 1,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedUnparsed: "v1alpha1.Expr{}",
+    },
+    {
+      original: {
+        name: "msg_fields",
+        expr: 'v1alpha1.Expr{id: 1, call_expr: v1alpha1.Call_Expr{function: "name"}}',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: 'v1alpha1.Expr{\n  id:1^#*expr.Constant_Int64Value#^#*expr.Expr_CreateStruct_Entry#,\n  call_expr:v1alpha1.Call_Expr{\n    function:"name"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed:
+        'v1alpha1.Expr{id: 1, call_expr: v1alpha1.Call_Expr{function: "name"}}',
+      locationAst:
+        'v1alpha1.Expr{\n  id:1^#3[1,18]#^#2[1,16]#,\n  call_expr:v1alpha1.Call_Expr{\n    function:"name"^#7[1,61]#^#6[1,59]#\n  }^#5[1,50]#^#4[1,30]#\n}^#1[1,13]#',
+      positions: [
+        [1, 13, 14, 1, 13, 1, 14],
+        [2, 16, 17, 1, 16, 1, 17],
+        [3, 18, 19, 1, 18, 1, 19],
+        [4, 30, 31, 1, 30, 1, 31],
+        [5, 50, 51, 1, 50, 1, 51],
+        [6, 59, 60, 1, 59, 1, 60],
+        [7, 61, 67, 1, 61, 1, 67],
+      ],
+      lineOffsets: [70],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "v1alpha1.Expr",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "id",
+                value: { id: "3", constExpr: { int64Value: "1" } },
+              },
+              {
+                id: "4",
+                fieldKey: "call_expr",
+                value: {
+                  id: "5",
+                  structExpr: {
+                    messageName: "v1alpha1.Call_Expr",
+                    entries: [
+                      {
+                        id: "6",
+                        fieldKey: "function",
+                        value: { id: "7", constExpr: { stringValue: "name" } },
+                      },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "msg_fields",
+          lineOffsets: [70],
+          positions: {
+            "1": 13,
+            "2": 16,
+            "3": 18,
+            "4": 30,
+            "5": 50,
+            "6": 59,
+            "7": 61,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: undeclared reference to 'v1alpha1.Expr' (in container '')\n | v1alpha1.Expr{id: 1, call_expr: v1alpha1.Call_Expr{function: \"name\"}}\n | .............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'v1alpha1.Expr' (in container '')",
+          id: 1,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedUnparsed:
+        'v1alpha1.Expr{id: 1, call_expr: v1alpha1.Call_Expr{function: "name"}}',
+    },
+    {
+      original: { name: "select", expr: "a.b.c" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#.c^#*expr.Expr_SelectExpr#",
+      unparsed: "a.b.c",
+      locationAst: "a^#1[1,0]#.b^#2[1,1]#.c^#3[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          selectExpr: {
+            operand: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+            field: "c",
+          },
+        },
+        sourceInfo: {
+          location: "select",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.b.c\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: "a.b.c",
+    },
+    {
+      original: { name: "idx_idx_sel", expr: "a[b][c].name" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_[_](\n  _[_](\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#.name^#*expr.Expr_SelectExpr#",
+      unparsed: "a[b][c].name",
+      locationAst:
+        "_[_](\n  _[_](\n    a^#1[1,0]#,\n    b^#3[1,2]#\n  )^#2[1,1]#,\n  c^#5[1,5]#\n)^#4[1,4]#.name^#6[1,7]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 5, 6, 1, 5, 1, 6],
+        [6, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          selectExpr: {
+            operand: {
+              id: "4",
+              callExpr: {
+                function: "_[_]",
+                args: [
+                  {
+                    id: "2",
+                    callExpr: {
+                      function: "_[_]",
+                      args: [
+                        { id: "1", identExpr: { name: "a" } },
+                        { id: "3", identExpr: { name: "b" } },
+                      ],
+                    },
+                  },
+                  { id: "5", identExpr: { name: "c" } },
+                ],
+              },
+            },
+            field: "name",
+          },
+        },
+        sourceInfo: {
+          location: "idx_idx_sel",
+          lineOffsets: [13],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 4, "5": 5, "6": 7 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a[b][c].name\n | ^\nERROR: \u003cinput\u003e:1:3: undeclared reference to 'b' (in container '')\n | a[b][c].name\n | ..^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'c' (in container '')\n | a[b][c].name\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedUnparsed: "a[b][c].name",
+    },
+    {
+      original: { name: "sel_expr_target", expr: "(a + b).name" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_+_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#.name^#*expr.Expr_SelectExpr#",
+      unparsed: "(a + b).name",
+      locationAst:
+        "_+_(\n  a^#1[1,1]#,\n  b^#3[1,5]#\n)^#2[1,3]#.name^#4[1,7]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 8, 1, 7, 1, 8],
+      ],
+      lineOffsets: [13],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          selectExpr: {
+            operand: {
+              id: "2",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "1", identExpr: { name: "a" } },
+                  { id: "3", identExpr: { name: "b" } },
+                ],
+              },
+            },
+            field: "name",
+          },
+        },
+        sourceInfo: {
+          location: "sel_expr_target",
+          lineOffsets: [13],
+          positions: { "1": 1, "2": 3, "3": 5, "4": 7 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | (a + b).name\n | .^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | (a + b).name\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedUnparsed: "(a + b).name",
+    },
+    {
+      original: { name: "sel_cond_target", expr: "(a ? b : c).name" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#.name^#*expr.Expr_SelectExpr#",
+      unparsed: "(a ? b : c).name",
+      locationAst:
+        "_?_:_(\n  a^#1[1,1]#,\n  b^#3[1,5]#,\n  c^#4[1,9]#\n)^#2[1,3]#.name^#5[1,11]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [17],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          selectExpr: {
+            operand: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", identExpr: { name: "a" } },
+                  { id: "3", identExpr: { name: "b" } },
+                  { id: "4", identExpr: { name: "c" } },
+                ],
+              },
+            },
+            field: "name",
+          },
+        },
+        sourceInfo: {
+          location: "sel_cond_target",
+          lineOffsets: [17],
+          positions: { "1": 1, "2": 3, "3": 5, "4": 9, "5": 11 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | (a ? b : c).name\n | .^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | (a ? b : c).name\n | .....^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'c' (in container '')\n | (a ? b : c).name\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedUnparsed: "(a ? b : c).name",
+    },
+    {
+      original: { name: "idx_cond_target", expr: "(a ? b : c)[0]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_[_](\n  _?_:_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(a ? b : c)[0]",
+      locationAst:
+        "_[_](\n  _?_:_(\n    a^#1[1,1]#,\n    b^#3[1,5]#,\n    c^#4[1,9]#\n  )^#2[1,3]#,\n  0^#6[1,12]#\n)^#5[1,11]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 11, 12, 1, 11, 1, 12],
+        [6, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [15],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                    { id: "4", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              { id: "6", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "idx_cond_target",
+          lineOffsets: [15],
+          positions: { "1": 1, "2": 3, "3": 5, "4": 9, "5": 11, "6": 12 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | (a ? b : c)[0]\n | .^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | (a ? b : c)[0]\n | .....^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'c' (in container '')\n | (a ? b : c)[0]\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedUnparsed: "(a ? b : c)[0]",
+    },
+    {
+      original: { name: "cond_conj", expr: "(a1 \u0026\u0026 a2) ? b : c" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  _\u0026\u0026_(\n    a1^#*expr.Expr_IdentExpr#,\n    a2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(a1 \u0026\u0026 a2) ? b : c",
+      locationAst:
+        "_?_:_(\n  _\u0026\u0026_(\n    a1^#1[1,1]#,\n    a2^#2[1,7]#\n  )^#3[1,4]#,\n  b^#5[1,13]#,\n  c^#6[1,17]#\n)^#4[1,11]#",
+      positions: [
+        [1, 1, 3, 1, 1, 1, 3],
+        [2, 7, 9, 1, 7, 1, 9],
+        [3, 4, 6, 1, 4, 1, 6],
+        [4, 11, 12, 1, 11, 1, 12],
+        [5, 13, 14, 1, 13, 1, 14],
+        [6, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "a1" } },
+                    { id: "2", identExpr: { name: "a2" } },
+                  ],
+                },
+              },
+              { id: "5", identExpr: { name: "b" } },
+              { id: "6", identExpr: { name: "c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_conj",
+          lineOffsets: [19],
+          positions: { "1": 1, "2": 7, "3": 4, "4": 11, "5": 13, "6": 17 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a1' (in container '')\n | (a1 \u0026\u0026 a2) ? b : c\n | .^\nERROR: \u003cinput\u003e:1:8: undeclared reference to 'a2' (in container '')\n | (a1 \u0026\u0026 a2) ? b : c\n | .......^\nERROR: \u003cinput\u003e:1:14: undeclared reference to 'b' (in container '')\n | (a1 \u0026\u0026 a2) ? b : c\n | .............^\nERROR: \u003cinput\u003e:1:18: undeclared reference to 'c' (in container '')\n | (a1 \u0026\u0026 a2) ? b : c\n | .................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a1' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a2' (in container '')",
+          id: 2,
+          offset: 7,
+          line: 1,
+          column: 7,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 5,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 6,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+      expectedUnparsed: "(a1 \u0026\u0026 a2) ? b : c",
+    },
+    {
+      original: {
+        name: "cond_disj_conj",
+        expr: "a ? (b1 || b2) : (c1 \u0026\u0026 c2)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  _||_(\n    b1^#*expr.Expr_IdentExpr#,\n    b2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    c1^#*expr.Expr_IdentExpr#,\n    c2^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ? (b1 || b2) : (c1 \u0026\u0026 c2)",
+      locationAst:
+        "_?_:_(\n  a^#1[1,0]#,\n  _||_(\n    b1^#3[1,5]#,\n    b2^#4[1,11]#\n  )^#5[1,8]#,\n  _\u0026\u0026_(\n    c1^#6[1,18]#,\n    c2^#7[1,24]#\n  )^#8[1,21]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 5, 7, 1, 5, 1, 7],
+        [4, 11, 13, 1, 11, 1, 13],
+        [5, 8, 10, 1, 8, 1, 10],
+        [6, 18, 20, 1, 18, 1, 20],
+        [7, 24, 26, 1, 24, 1, 26],
+        [8, 21, 23, 1, 21, 1, 23],
+      ],
+      lineOffsets: [28],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "3", identExpr: { name: "b1" } },
+                    { id: "4", identExpr: { name: "b2" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "6", identExpr: { name: "c1" } },
+                    { id: "7", identExpr: { name: "c2" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_disj_conj",
+          lineOffsets: [28],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 11,
+            "5": 8,
+            "6": 18,
+            "7": 24,
+            "8": 21,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a ? (b1 || b2) : (c1 \u0026\u0026 c2)\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b1' (in container '')\n | a ? (b1 || b2) : (c1 \u0026\u0026 c2)\n | .....^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'b2' (in container '')\n | a ? (b1 || b2) : (c1 \u0026\u0026 c2)\n | ...........^\nERROR: \u003cinput\u003e:1:19: undeclared reference to 'c1' (in container '')\n | a ? (b1 || b2) : (c1 \u0026\u0026 c2)\n | ..................^\nERROR: \u003cinput\u003e:1:25: undeclared reference to 'c2' (in container '')\n | a ? (b1 || b2) : (c1 \u0026\u0026 c2)\n | ........................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b1' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b2' (in container '')",
+          id: 4,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c1' (in container '')",
+          id: 6,
+          offset: 18,
+          line: 1,
+          column: 18,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c2' (in container '')",
+          id: 7,
+          offset: 24,
+          line: 1,
+          column: 24,
+        },
+      ],
+      expectedUnparsed: "a ? (b1 || b2) : (c1 \u0026\u0026 c2)",
+    },
+    {
+      original: { name: "call_cond_target", expr: "(a ? b : c).method(d)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#.method(\n  d^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(a ? b : c).method(d)",
+      locationAst:
+        "_?_:_(\n  a^#1[1,1]#,\n  b^#3[1,5]#,\n  c^#4[1,9]#\n)^#2[1,3]#.method(\n  d^#6[1,19]#\n)^#5[1,18]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 9, 10, 1, 9, 1, 10],
+        [5, 18, 19, 1, 18, 1, 19],
+        [6, 19, 20, 1, 19, 1, 20],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            target: {
+              id: "2",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  { id: "1", identExpr: { name: "a" } },
+                  { id: "3", identExpr: { name: "b" } },
+                  { id: "4", identExpr: { name: "c" } },
+                ],
+              },
+            },
+            function: "method",
+            args: [{ id: "6", identExpr: { name: "d" } }],
+          },
+        },
+        sourceInfo: {
+          location: "call_cond_target",
+          lineOffsets: [22],
+          positions: { "1": 1, "2": 3, "3": 5, "4": 9, "5": 18, "6": 19 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | (a ? b : c).method(d)\n | .^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | (a ? b : c).method(d)\n | .....^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'c' (in container '')\n | (a ? b : c).method(d)\n | .........^\nERROR: \u003cinput\u003e:1:19: undeclared reference to 'method' (in container '')\n | (a ? b : c).method(d)\n | ..................^\nERROR: \u003cinput\u003e:1:20: undeclared reference to 'd' (in container '')\n | (a ? b : c).method(d)\n | ...................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'method' (in container '')",
+          id: 5,
+          offset: 18,
+          line: 1,
+          column: 18,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+      ],
+      expectedUnparsed: "(a ? b : c).method(d)",
+    },
+    {
+      original: {
+        name: "cond_flat",
+        expr: "false \u0026\u0026 !true || false",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_||_(\n  _\u0026\u0026_(\n    false^#*expr.Constant_BoolValue#,\n    !_(\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false \u0026\u0026 !true || false",
+      locationAst:
+        "_||_(\n  _\u0026\u0026_(\n    false^#1[1,0]#,\n    !_(\n      true^#3[1,10]#\n    )^#2[1,9]#\n  )^#4[1,6]#,\n  false^#5[1,18]#\n)^#6[1,15]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 9, 10, 1, 9, 1, 10],
+        [3, 10, 14, 1, 10, 1, 14],
+        [4, 6, 8, 1, 6, 1, 8],
+        [5, 18, 23, 1, 18, 1, 23],
+        [6, 15, 17, 1, 15, 1, 17],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "!_",
+                        args: [{ id: "3", constExpr: { boolValue: true } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_flat",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 6, "5": 18, "6": 15 },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _\u0026\u0026_(\n    false~bool,\n    !_(\n      true~bool\n    )~bool^logical_not\n  )~bool^logical_and,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["logical_not"] },
+          "4": { overloadId: ["logical_and"] },
+          "6": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: { "1": 0, "2": 9, "3": 10, "4": 6, "5": 18, "6": 15 },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "!_",
+                        args: [{ id: "3", constExpr: { boolValue: true } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedUnparsed: "false \u0026\u0026 !true || false",
+    },
+    {
+      original: {
+        name: "cond_paren",
+        expr: "false \u0026\u0026 (!true || false)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_\u0026\u0026_(\n  false^#*expr.Constant_BoolValue#,\n  _||_(\n    !_(\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false \u0026\u0026 (!true || false)",
+      locationAst:
+        "_\u0026\u0026_(\n  false^#1[1,0]#,\n  _||_(\n    !_(\n      true^#3[1,11]#\n    )^#2[1,10]#,\n    false^#4[1,19]#\n  )^#5[1,16]#\n)^#6[1,6]#",
+      positions: [
+        [1, 0, 5, 1, 0, 1, 5],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 19, 24, 1, 19, 1, 24],
+        [5, 16, 18, 1, 16, 1, 18],
+        [6, 6, 8, 1, 6, 1, 8],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "!_",
+                        args: [{ id: "3", constExpr: { boolValue: true } }],
+                      },
+                    },
+                    { id: "4", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_paren",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 19, "5": 16, "6": 6 },
+        },
+      },
+      checkedAst:
+        "_\u0026\u0026_(\n  false~bool,\n  _||_(\n    !_(\n      true~bool\n    )~bool^logical_not,\n    false~bool\n  )~bool^logical_or\n)~bool^logical_and",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["logical_not"] },
+          "5": { overloadId: ["logical_or"] },
+          "6": { overloadId: ["logical_and"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 0, "2": 10, "3": 11, "4": 19, "5": 16, "6": 6 },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", constExpr: { boolValue: false } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "!_",
+                        args: [{ id: "3", constExpr: { boolValue: true } }],
+                      },
+                    },
+                    { id: "4", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedUnparsed: "false \u0026\u0026 (!true || false)",
+    },
+    {
+      original: {
+        name: "cond_cond",
+        expr: "(false \u0026\u0026 !true || false) ? 2 : 3",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false^#*expr.Constant_BoolValue#,\n      !_(\n        true^#*expr.Constant_BoolValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#,\n    false^#*expr.Constant_BoolValue#\n  )^#*expr.Expr_CallExpr#,\n  2^#*expr.Constant_Int64Value#,\n  3^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(false \u0026\u0026 !true || false) ? 2 : 3",
+      locationAst:
+        "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false^#1[1,1]#,\n      !_(\n        true^#3[1,11]#\n      )^#2[1,10]#\n    )^#4[1,7]#,\n    false^#5[1,19]#\n  )^#6[1,16]#,\n  2^#8[1,28]#,\n  3^#9[1,32]#\n)^#7[1,26]#",
+      positions: [
+        [1, 1, 6, 1, 1, 1, 6],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 19, 24, 1, 19, 1, 24],
+        [6, 16, 18, 1, 16, 1, 18],
+        [7, 26, 27, 1, 26, 1, 27],
+        [8, 28, 29, 1, 28, 1, 29],
+        [9, 32, 33, 1, 32, 1, 33],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", constExpr: { boolValue: false } },
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                { id: "3", constExpr: { boolValue: true } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "2" } },
+              { id: "9", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_cond",
+          lineOffsets: [34],
+          positions: {
+            "1": 1,
+            "2": 10,
+            "3": 11,
+            "4": 7,
+            "5": 19,
+            "6": 16,
+            "7": 26,
+            "8": 28,
+            "9": 32,
+          },
+        },
+      },
+      checkedAst:
+        "_?_:_(\n  _||_(\n    _\u0026\u0026_(\n      false~bool,\n      !_(\n        true~bool\n      )~bool^logical_not\n    )~bool^logical_and,\n    false~bool\n  )~bool^logical_or,\n  2~int,\n  3~int\n)~int^conditional",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["logical_not"] },
+          "4": { overloadId: ["logical_and"] },
+          "6": { overloadId: ["logical_or"] },
+          "7": { overloadId: ["conditional"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "INT64" },
+          "9": { primitive: "INT64" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [34],
+          positions: {
+            "1": 1,
+            "2": 10,
+            "3": 11,
+            "4": 7,
+            "5": 19,
+            "6": 16,
+            "7": 26,
+            "8": 28,
+            "9": 32,
+          },
+        },
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", constExpr: { boolValue: false } },
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "!_",
+                              args: [
+                                { id: "3", constExpr: { boolValue: true } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    { id: "5", constExpr: { boolValue: false } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "2" } },
+              { id: "9", constExpr: { int64Value: "3" } },
+            ],
+          },
+        },
+      },
+      type: "int",
+      cost: { min: "0", max: "1" },
+      result: { value: { int64Value: "3" } },
+      runtimeCost: "0",
+      expectedUnparsed: "(false \u0026\u0026 !true || false) ? 2 : 3",
+    },
+    {
+      original: { name: "cond_binop", expr: "(x \u003c 5) ? x : 5" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  _\u003c_(\n    x^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  x^#*expr.Expr_IdentExpr#,\n  5^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(x \u003c 5) ? x : 5",
+      locationAst:
+        "_?_:_(\n  _\u003c_(\n    x^#1[1,1]#,\n    5^#3[1,5]#\n  )^#2[1,3]#,\n  x^#5[1,10]#,\n  5^#6[1,14]#\n)^#4[1,8]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 14, 15, 1, 14, 1, 15],
+      ],
+      lineOffsets: [16],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    { id: "1", identExpr: { name: "x" } },
+                    { id: "3", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "5", identExpr: { name: "x" } },
+              { id: "6", constExpr: { int64Value: "5" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_binop",
+          lineOffsets: [16],
+          positions: { "1": 1, "2": 3, "3": 5, "4": 8, "5": 10, "6": 14 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'x' (in container '')\n | (x \u003c 5) ? x : 5\n | .^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'x' (in container '')\n | (x \u003c 5) ? x : 5\n | ..........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 5,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+      ],
+      expectedUnparsed: "(x \u003c 5) ? x : 5",
+    },
+    {
+      original: {
+        name: "cond_binop_binop",
+        expr: "(x \u003e 5) ? (x - 5) : 0",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  _\u003e_(\n    x^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _-_(\n    x^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(x \u003e 5) ? (x - 5) : 0",
+      locationAst:
+        "_?_:_(\n  _\u003e_(\n    x^#1[1,1]#,\n    5^#3[1,5]#\n  )^#2[1,3]#,\n  _-_(\n    x^#5[1,11]#,\n    5^#7[1,15]#\n  )^#6[1,13]#,\n  0^#8[1,20]#\n)^#4[1,8]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 11, 12, 1, 11, 1, 12],
+        [6, 13, 14, 1, 13, 1, 14],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "1", identExpr: { name: "x" } },
+                    { id: "3", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    { id: "5", identExpr: { name: "x" } },
+                    { id: "7", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "8", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_binop_binop",
+          lineOffsets: [22],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 8,
+            "5": 11,
+            "6": 13,
+            "7": 15,
+            "8": 20,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'x' (in container '')\n | (x \u003e 5) ? (x - 5) : 0\n | .^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'x' (in container '')\n | (x \u003e 5) ? (x - 5) : 0\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 5,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedUnparsed: "(x \u003e 5) ? (x - 5) : 0",
+    },
+    {
+      original: {
+        name: "cond_cond_binop",
+        expr: "(x \u003e 5) ? ((x \u003e 10) ? (x - 10) : 5) : 0",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  _\u003e_(\n    x^#*expr.Expr_IdentExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  _?_:_(\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      10^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _-_(\n      x^#*expr.Expr_IdentExpr#,\n      10^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    5^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  0^#*expr.Constant_Int64Value#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "(x \u003e 5) ? ((x \u003e 10) ? (x - 10) : 5) : 0",
+      locationAst:
+        "_?_:_(\n  _\u003e_(\n    x^#1[1,1]#,\n    5^#3[1,5]#\n  )^#2[1,3]#,\n  _?_:_(\n    _\u003e_(\n      x^#5[1,12]#,\n      10^#7[1,16]#\n    )^#6[1,14]#,\n    _-_(\n      x^#9[1,23]#,\n      10^#11[1,27]#\n    )^#10[1,25]#,\n    5^#12[1,33]#\n  )^#8[1,20]#,\n  0^#13[1,38]#\n)^#4[1,8]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 12, 13, 1, 12, 1, 13],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 16, 18, 1, 16, 1, 18],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 23, 24, 1, 23, 1, 24],
+        [10, 25, 26, 1, 25, 1, 26],
+        [11, 27, 29, 1, 27, 1, 29],
+        [12, 33, 34, 1, 33, 1, 34],
+        [13, 38, 39, 1, 38, 1, 39],
+      ],
+      lineOffsets: [40],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    { id: "1", identExpr: { name: "x" } },
+                    { id: "3", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    {
+                      id: "6",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          { id: "5", identExpr: { name: "x" } },
+                          { id: "7", constExpr: { int64Value: "10" } },
+                        ],
+                      },
+                    },
+                    {
+                      id: "10",
+                      callExpr: {
+                        function: "_-_",
+                        args: [
+                          { id: "9", identExpr: { name: "x" } },
+                          { id: "11", constExpr: { int64Value: "10" } },
+                        ],
+                      },
+                    },
+                    { id: "12", constExpr: { int64Value: "5" } },
+                  ],
+                },
+              },
+              { id: "13", constExpr: { int64Value: "0" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "cond_cond_binop",
+          lineOffsets: [40],
+          positions: {
+            "1": 1,
+            "2": 3,
+            "3": 5,
+            "4": 8,
+            "5": 12,
+            "6": 14,
+            "7": 16,
+            "8": 20,
+            "9": 23,
+            "10": 25,
+            "11": 27,
+            "12": 33,
+            "13": 38,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'x' (in container '')\n | (x \u003e 5) ? ((x \u003e 10) ? (x - 10) : 5) : 0\n | .^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'x' (in container '')\n | (x \u003e 5) ? ((x \u003e 10) ? (x - 10) : 5) : 0\n | ............^\nERROR: \u003cinput\u003e:1:24: undeclared reference to 'x' (in container '')\n | (x \u003e 5) ? ((x \u003e 10) ? (x - 10) : 5) : 0\n | .......................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 5,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 9,
+          offset: 23,
+          line: 1,
+          column: 23,
+        },
+      ],
+      expectedUnparsed: "(x \u003e 5) ? ((x \u003e 10) ? (x - 10) : 5) : 0",
+    },
+    {
+      original: { name: "select_opt", expr: "a.?b" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '_?._(\n  a^#*expr.Expr_IdentExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "a.?b",
+      locationAst: '_?._(\n  a^#1[1,0]#,\n  "b"^#2[1,3]#\n)^#3[1,1]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "select_opt",
+          lineOffsets: [5],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.?b\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: "a.?b",
+    },
+    {
+      original: { name: "index_opt", expr: "a[?b]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_[?_](\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a[?b]",
+      locationAst: "_[?_](\n  a^#1[1,0]#,\n  b^#3[1,3]#\n)^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 3, 4, 1, 3, 1, 4],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_[?_]",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "index_opt",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1, "3": 3 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a[?b]\n | ^\nERROR: \u003cinput\u003e:1:4: undeclared reference to 'b' (in container '')\n | a[?b]\n | ...^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 3,
+          line: 1,
+          column: 3,
+        },
+      ],
+      expectedUnparsed: "a[?b]",
+    },
+    {
+      original: { name: "list_lit_opt", expr: "[?a, ?b, c]" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "[\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n]^#*expr.Expr_ListExpr#",
+      unparsed: "[?a, ?b, c]",
+      locationAst: "[\n  a^#2[1,2]#,\n  b^#3[1,6]#,\n  c^#4[1,9]#\n]^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 6, 7, 1, 6, 1, 7],
+        [4, 9, 10, 1, 9, 1, 10],
+      ],
+      lineOffsets: [12],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          listExpr: {
+            elements: [
+              { id: "2", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "c" } },
+            ],
+            optionalIndices: [0, 1],
+          },
+        },
+        sourceInfo: {
+          location: "list_lit_opt",
+          lineOffsets: [12],
+          positions: { "1": 0, "2": 2, "3": 6, "4": 9 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:3: undeclared reference to 'a' (in container '')\n | [?a, ?b, c]\n | ..^\nERROR: \u003cinput\u003e:1:3: expected type 'optional_type(!error!)' but found '!error!'\n | [?a, ?b, c]\n | ..^\nERROR: \u003cinput\u003e:1:7: undeclared reference to 'b' (in container '')\n | [?a, ?b, c]\n | ......^\nERROR: \u003cinput\u003e:1:7: expected type 'optional_type(!error!)' but found '!error!'\n | [?a, ?b, c]\n | ......^\nERROR: \u003cinput\u003e:1:10: undeclared reference to 'c' (in container '')\n | [?a, ?b, c]\n | .........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(!error!)' but found '!error!'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(!error!)' but found '!error!'",
+          id: 3,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 9,
+          line: 1,
+          column: 9,
+        },
+      ],
+      expectedUnparsed: "[?a, ?b, c]",
+    },
+    {
+      original: { name: "map_lit_opt", expr: "{?a: b, c: d}" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "{\n  ?a^#*expr.Expr_IdentExpr#:b^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#,\n  c^#*expr.Expr_IdentExpr#:d^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "{?a: b, c: d}",
+      locationAst:
+        "{\n  ?a^#3[1,2]#:b^#4[1,5]#^#2[1,3]#,\n  c^#6[1,8]#:d^#7[1,11]#^#5[1,9]#\n}^#1[1,0]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 9, 10, 1, 9, 1, 10],
+        [6, 8, 9, 1, 8, 1, 9],
+        [7, 11, 12, 1, 11, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            entries: [
+              {
+                id: "2",
+                mapKey: { id: "3", identExpr: { name: "a" } },
+                value: { id: "4", identExpr: { name: "b" } },
+                optionalEntry: true,
+              },
+              {
+                id: "5",
+                mapKey: { id: "6", identExpr: { name: "c" } },
+                value: { id: "7", identExpr: { name: "d" } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "map_lit_opt",
+          lineOffsets: [14],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 2,
+            "4": 5,
+            "5": 9,
+            "6": 8,
+            "7": 11,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:3: undeclared reference to 'a' (in container '')\n | {?a: b, c: d}\n | ..^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | {?a: b, c: d}\n | .....^\nERROR: \u003cinput\u003e:1:6: expected type 'optional_type(!error!)' but found '!error!'\n | {?a: b, c: d}\n | .....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | {?a: b, c: d}\n | ........^\nERROR: \u003cinput\u003e:1:12: undeclared reference to 'd' (in container '')\n | {?a: b, c: d}\n | ...........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 3,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 4,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(!error!)' but found '!error!'",
+          id: 4,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 6,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 11,
+          line: 1,
+          column: 11,
+        },
+      ],
+      expectedUnparsed: "{?a: b, c: d}",
+    },
+    {
+      original: {
+        name: "msg_fields_opt",
+        expr: 'v1alpha1.Expr{?id: id, call_expr: v1alpha1.Call_Expr{function: "name"}}',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: 'v1alpha1.Expr{\n  ?id:id^#*expr.Expr_IdentExpr#^#*expr.Expr_CreateStruct_Entry#,\n  call_expr:v1alpha1.Call_Expr{\n    function:"name"^#*expr.Constant_StringValue#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#',
+      unparsed:
+        'v1alpha1.Expr{?id: id, call_expr: v1alpha1.Call_Expr{function: "name"}}',
+      locationAst:
+        'v1alpha1.Expr{\n  ?id:id^#3[1,19]#^#2[1,17]#,\n  call_expr:v1alpha1.Call_Expr{\n    function:"name"^#7[1,63]#^#6[1,61]#\n  }^#5[1,52]#^#4[1,32]#\n}^#1[1,13]#',
+      positions: [
+        [1, 13, 14, 1, 13, 1, 14],
+        [2, 17, 18, 1, 17, 1, 18],
+        [3, 19, 21, 1, 19, 1, 21],
+        [4, 32, 33, 1, 32, 1, 33],
+        [5, 52, 53, 1, 52, 1, 53],
+        [6, 61, 62, 1, 61, 1, 62],
+        [7, 63, 69, 1, 63, 1, 69],
+      ],
+      lineOffsets: [72],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "v1alpha1.Expr",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "id",
+                value: { id: "3", identExpr: { name: "id" } },
+                optionalEntry: true,
+              },
+              {
+                id: "4",
+                fieldKey: "call_expr",
+                value: {
+                  id: "5",
+                  structExpr: {
+                    messageName: "v1alpha1.Call_Expr",
+                    entries: [
+                      {
+                        id: "6",
+                        fieldKey: "function",
+                        value: { id: "7", constExpr: { stringValue: "name" } },
+                      },
+                    ],
+                  },
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "msg_fields_opt",
+          lineOffsets: [72],
+          positions: {
+            "1": 13,
+            "2": 17,
+            "3": 19,
+            "4": 32,
+            "5": 52,
+            "6": 61,
+            "7": 63,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:14: undeclared reference to 'v1alpha1.Expr' (in container '')\n | v1alpha1.Expr{?id: id, call_expr: v1alpha1.Call_Expr{function: \"name\"}}\n | .............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'v1alpha1.Expr' (in container '')",
+          id: 1,
+          offset: 13,
+          line: 1,
+          column: 13,
+        },
+      ],
+      expectedUnparsed:
+        'v1alpha1.Expr{?id: id, call_expr: v1alpha1.Call_Expr{function: "name"}}',
+    },
+    {
+      original: { name: "select_quoted", expr: "a.`b-c`" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "a^#*expr.Expr_IdentExpr#.b-c^#*expr.Expr_SelectExpr#",
+      unparsed: "a.`b-c`",
+      locationAst: "a^#1[1,0]#.b-c^#2[1,1]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [8],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          selectExpr: {
+            operand: { id: "1", identExpr: { name: "a" } },
+            field: "b-c",
+          },
+        },
+        sourceInfo: {
+          location: "select_quoted",
+          lineOffsets: [8],
+          positions: { "1": 0, "2": 1 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.`b-c`\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: "a.`b-c`",
+    },
+    {
+      original: { name: "opt_select_quoted", expr: "a.?`b.c`" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '_?._(\n  a^#*expr.Expr_IdentExpr#,\n  "b.c"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: "a.?`b.c`",
+      locationAst: '_?._(\n  a^#1[1,0]#,\n  "b.c"^#2[1,3]#\n)^#3[1,1]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 8, 1, 3, 1, 8],
+        [3, 1, 2, 1, 1, 1, 2],
+      ],
+      lineOffsets: [9],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_?._",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", constExpr: { stringValue: "b.c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "opt_select_quoted",
+          lineOffsets: [9],
+          positions: { "1": 0, "2": 3, "3": 1 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a.?`b.c`\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: "a.?`b.c`",
+    },
+    {
+      original: { name: "message_create_quoted", expr: "MyType{`in`: false}" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "MyType{\n  in:false^#*expr.Constant_BoolValue#^#*expr.Expr_CreateStruct_Entry#\n}^#*expr.Expr_StructExpr#",
+      unparsed: "MyType{`in`: false}",
+      locationAst: "MyType{\n  in:false^#3[1,13]#^#2[1,11]#\n}^#1[1,6]#",
+      positions: [
+        [1, 6, 7, 1, 6, 1, 7],
+        [2, 11, 12, 1, 11, 1, 12],
+        [3, 13, 18, 1, 13, 1, 18],
+      ],
+      lineOffsets: [20],
+      parsedExpr: {
+        expr: {
+          id: "1",
+          structExpr: {
+            messageName: "MyType",
+            entries: [
+              {
+                id: "2",
+                fieldKey: "in",
+                value: { id: "3", constExpr: { boolValue: false } },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "message_create_quoted",
+          lineOffsets: [20],
+          positions: { "1": 6, "2": 11, "3": 13 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:7: undeclared reference to 'MyType' (in container '')\n | MyType{`in`: false}\n | ......^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'MyType' (in container '')",
+          id: 1,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+      ],
+      expectedUnparsed: "MyType{`in`: false}",
+    },
+    {
+      original: { name: "call_add_equiv", expr: "a+b-c" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_-_(\n  _+_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a + b - c",
+      locationAst:
+        "_-_(\n  _+_(\n    a^#1[1,0]#,\n    b^#3[1,2]#\n  )^#2[1,1]#,\n  c^#5[1,4]#\n)^#4[1,3]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 3, 4, 1, 3, 1, 4],
+        [5, 4, 5, 1, 4, 1, 5],
+      ],
+      lineOffsets: [6],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_-_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              { id: "5", identExpr: { name: "c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_add_equiv",
+          lineOffsets: [6],
+          positions: { "1": 0, "2": 1, "3": 2, "4": 3, "5": 4 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a+b-c\n | ^\nERROR: \u003cinput\u003e:1:3: undeclared reference to 'b' (in container '')\n | a+b-c\n | ..^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'c' (in container '')\n | a+b-c\n | ....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+      ],
+      expectedUnparsed: "a + b - c",
+    },
+    {
+      original: { name: "call_cond_equiv", expr: "a ? b          : c" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  c^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ? b : c",
+      locationAst:
+        "_?_:_(\n  a^#1[1,0]#,\n  b^#3[1,4]#,\n  c^#4[1,17]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 17, 18, 1, 17, 1, 18],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              { id: "4", identExpr: { name: "c" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_cond_equiv",
+          lineOffsets: [19],
+          positions: { "1": 0, "2": 2, "3": 4, "4": 17 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a ? b          : c\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a ? b          : c\n | ....^\nERROR: \u003cinput\u003e:1:18: undeclared reference to 'c' (in container '')\n | a ? b          : c\n | .................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+      ],
+      expectedUnparsed: "a ? b : c",
+    },
+    {
+      original: { name: "call_index_equiv", expr: 'a[  1  ]["b"]' },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '_[_](\n  _[_](\n    a^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#,\n  "b"^#*expr.Constant_StringValue#\n)^#*expr.Expr_CallExpr#',
+      unparsed: 'a[1]["b"]',
+      locationAst:
+        '_[_](\n  _[_](\n    a^#1[1,0]#,\n    1^#3[1,4]#\n  )^#2[1,1]#,\n  "b"^#5[1,9]#\n)^#4[1,8]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 9, 12, 1, 9, 1, 12],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_[_]",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_[_]",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", constExpr: { int64Value: "1" } },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { stringValue: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_index_equiv",
+          lineOffsets: [14],
+          positions: { "1": 0, "2": 1, "3": 4, "4": 8, "5": 9 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a[  1  ][\"b\"]\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: 'a[1]["b"]',
+    },
+    {
+      original: {
+        name: "call_or_and_equiv",
+        expr: "(false \u0026\u0026 !true) || false",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_||_(\n  _\u0026\u0026_(\n    false^#*expr.Constant_BoolValue#,\n    !_(\n      true^#*expr.Constant_BoolValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  false^#*expr.Constant_BoolValue#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "false \u0026\u0026 !true || false",
+      locationAst:
+        "_||_(\n  _\u0026\u0026_(\n    false^#1[1,1]#,\n    !_(\n      true^#3[1,11]#\n    )^#2[1,10]#\n  )^#4[1,7]#,\n  false^#5[1,20]#\n)^#6[1,17]#",
+      positions: [
+        [1, 1, 6, 1, 1, 1, 6],
+        [2, 10, 11, 1, 10, 1, 11],
+        [3, 11, 15, 1, 11, 1, 15],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 20, 25, 1, 20, 1, 25],
+        [6, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [26],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "!_",
+                        args: [{ id: "3", constExpr: { boolValue: true } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_or_and_equiv",
+          lineOffsets: [26],
+          positions: { "1": 1, "2": 10, "3": 11, "4": 7, "5": 20, "6": 17 },
+        },
+      },
+      checkedAst:
+        "_||_(\n  _\u0026\u0026_(\n    false~bool,\n    !_(\n      true~bool\n    )~bool^logical_not\n  )~bool^logical_and,\n  false~bool\n)~bool^logical_or",
+      checkedExpr: {
+        referenceMap: {
+          "2": { overloadId: ["logical_not"] },
+          "4": { overloadId: ["logical_and"] },
+          "6": { overloadId: ["logical_or"] },
+        },
+        typeMap: {
+          "1": { primitive: "BOOL" },
+          "2": { primitive: "BOOL" },
+          "3": { primitive: "BOOL" },
+          "4": { primitive: "BOOL" },
+          "5": { primitive: "BOOL" },
+          "6": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [26],
+          positions: { "1": 1, "2": 10, "3": 11, "4": 7, "5": 20, "6": 17 },
+        },
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", constExpr: { boolValue: false } },
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "!_",
+                        args: [{ id: "3", constExpr: { boolValue: true } }],
+                      },
+                    },
+                  ],
+                },
+              },
+              { id: "5", constExpr: { boolValue: false } },
+            ],
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "0", max: "1" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "0",
+      expectedUnparsed: "false \u0026\u0026 !true || false",
+    },
+    {
+      original: { name: "call_not_not_equiv", expr: "!!true" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "true^#*expr.Constant_BoolValue#",
+      unparsed: "true",
+      locationAst: "true^#1[1,2]#",
+      positions: [[1, 2, 6, 1, 2, 1, 6]],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { boolValue: true } },
+        sourceInfo: {
+          location: "call_not_not_equiv",
+          lineOffsets: [7],
+          positions: { "1": 2 },
+        },
+      },
+      checkedAst: "true~bool",
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BOOL" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [7],
+          positions: { "1": 2 },
+        },
+        expr: { id: "1", constExpr: { boolValue: true } },
+      },
+      type: "bool",
+      cost: { min: "0", max: "0" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "0",
+      expectedUnparsed: "true",
+    },
+    {
+      original: { name: "call_cond_equiv", expr: "(a || b ? c : d).e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "_?_:_(\n  _||_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  c^#*expr.Expr_IdentExpr#,\n  d^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#.e^#*expr.Expr_SelectExpr#",
+      unparsed: "((a || b) ? c : d).e",
+      locationAst:
+        "_?_:_(\n  _||_(\n    a^#1[1,1]#,\n    b^#2[1,6]#\n  )^#3[1,3]#,\n  c^#5[1,10]#,\n  d^#6[1,14]#\n)^#4[1,8]#.e^#7[1,16]#",
+      positions: [
+        [1, 1, 2, 1, 1, 1, 2],
+        [2, 6, 7, 1, 6, 1, 7],
+        [3, 3, 5, 1, 3, 1, 5],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [19],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          selectExpr: {
+            operand: {
+              id: "4",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "3",
+                    callExpr: {
+                      function: "_||_",
+                      args: [
+                        { id: "1", identExpr: { name: "a" } },
+                        { id: "2", identExpr: { name: "b" } },
+                      ],
+                    },
+                  },
+                  { id: "5", identExpr: { name: "c" } },
+                  { id: "6", identExpr: { name: "d" } },
+                ],
+              },
+            },
+            field: "e",
+          },
+        },
+        sourceInfo: {
+          location: "call_cond_equiv",
+          lineOffsets: [19],
+          positions: {
+            "1": 1,
+            "2": 6,
+            "3": 3,
+            "4": 8,
+            "5": 10,
+            "6": 14,
+            "7": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | (a || b ? c : d).e\n | .^\nERROR: \u003cinput\u003e:1:7: undeclared reference to 'b' (in container '')\n | (a || b ? c : d).e\n | ......^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | (a || b ? c : d).e\n | ..........^\nERROR: \u003cinput\u003e:1:15: undeclared reference to 'd' (in container '')\n | (a || b ? c : d).e\n | ..............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 6,
+          line: 1,
+          column: 6,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+      ],
+      expectedUnparsed: "((a || b) ? c : d).e",
+    },
+    {
+      original: { name: "lit_quote_bytes_equiv", expr: "b'aaa\"bbb'" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: 'b"aaa\\"bbb"^#*expr.Constant_BytesValue#',
+      unparsed: 'b"\\141\\141\\141\\042\\142\\142\\142"',
+      locationAst: 'b"aaa\\"bbb"^#1[1,0]#',
+      positions: [[1, 0, 10, 1, 0, 1, 10]],
+      lineOffsets: [11],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { bytesValue: "YWFhImJiYg==" } },
+        sourceInfo: {
+          location: "lit_quote_bytes_equiv",
+          lineOffsets: [11],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: 'b"aaa\\"bbb"~bytes',
+      checkedExpr: {
+        typeMap: { "1": { primitive: "BYTES" } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [11],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { bytesValue: "YWFhImJiYg==" } },
+      },
+      type: "bytes",
+      cost: { min: "0", max: "0" },
+      result: { value: { bytesValue: "YWFhImJiYg==" } },
+      runtimeCost: "0",
+      expectedUnparsed: 'b"\\141\\141\\141\\042\\142\\142\\142"',
+    },
+    {
+      original: { name: "select_equiv", expr: "a . b . c" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "a^#*expr.Expr_IdentExpr#.b^#*expr.Expr_SelectExpr#.c^#*expr.Expr_SelectExpr#",
+      unparsed: "a.b.c",
+      locationAst: "a^#1[1,0]#.b^#2[1,2]#.c^#3[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 6, 7, 1, 6, 1, 7],
+      ],
+      lineOffsets: [10],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          selectExpr: {
+            operand: {
+              id: "2",
+              selectExpr: {
+                operand: { id: "1", identExpr: { name: "a" } },
+                field: "b",
+              },
+            },
+            field: "c",
+          },
+        },
+        sourceInfo: {
+          location: "select_equiv",
+          lineOffsets: [10],
+          positions: { "1": 0, "2": 2, "3": 6 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a . b . c\n | ^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+      ],
+      expectedUnparsed: "a.b.c",
+    },
+    {
+      original: { name: "comp_all", expr: "[1, 2, 3].all(x, x \u003e 0)" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  true^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].all(x, x \u003e 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  true^#10[1,13]#,\n  // LoopCondition\n  @not_strictly_false(\n    @result^#11[1,13]#\n  )^#12[1,13]#,\n  // LoopStep\n  _\u0026\u0026_(\n    @result^#13[1,13]#,\n    _\u003e_(\n      x^#7[1,17]#,\n      0^#9[1,21]#\n    )^#8[1,19]#\n  )^#14[1,13]#,\n  // Result\n  @result^#15[1,13]#)^#16[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 21, 22, 1, 21, 1, 22],
+        [10, 13, 13, 1, 13, 1, 13],
+        [11, 13, 13, 1, 13, 1, 13],
+        [12, 13, 13, 1, 13, 1, 13],
+        [13, 13, 13, 1, 13, 1, 13],
+        [14, 13, 13, 1, 13, 1, 13],
+        [15, 13, 13, 1, 13, 1, 13],
+        [16, 13, 13, 1, 13, 1, 13],
+      ],
+      lineOffsets: [24],
+      parsedExpr: {
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_all",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 21,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "all",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  true~bool,\n  // LoopCondition\n  @not_strictly_false(\n    @result~bool^@result\n  )~bool^not_strictly_false,\n  // LoopStep\n  _\u0026\u0026_(\n    @result~bool^@result,\n    _\u003e_(\n      x~int^x,\n      0~int\n    )~bool^greater_int64\n  )~bool^logical_and,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "x" },
+          "8": { overloadId: ["greater_int64"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["not_strictly_false"] },
+          "13": { name: "@result" },
+          "14": { overloadId: ["logical_and"] },
+          "15": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [24],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 21,
+            "10": 13,
+            "11": 13,
+            "12": 13,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "16",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: true } },
+            loopCondition: {
+              id: "12",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [{ id: "11", identExpr: { name: "@result" } }],
+              },
+            },
+            loopStep: {
+              id: "14",
+              callExpr: {
+                function: "_\u0026\u0026_",
+                args: [
+                  { id: "13", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "15", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "20", max: "26" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "26",
+      expectedUnparsed: "[1, 2, 3].all(x, x \u003e 0)",
+    },
+    {
+      original: {
+        name: "comp_exists",
+        expr: "[1, 2, 3].exists(x, x \u003e 0)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _\u003e_(\n      x^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].exists(x, x \u003e 0)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#10[1,16]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#11[1,16]#\n    )^#12[1,16]#\n  )^#13[1,16]#,\n  // LoopStep\n  _||_(\n    @result^#14[1,16]#,\n    _\u003e_(\n      x^#7[1,20]#,\n      0^#9[1,24]#\n    )^#8[1,22]#\n  )^#15[1,16]#,\n  // Result\n  @result^#16[1,16]#)^#17[1,16]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 17, 18, 1, 17, 1, 18],
+        [7, 20, 21, 1, 20, 1, 21],
+        [8, 22, 23, 1, 22, 1, 23],
+        [9, 24, 25, 1, 24, 1, 25],
+        [10, 16, 16, 1, 16, 1, 16],
+        [11, 16, 16, 1, 16, 1, 16],
+        [12, 16, 16, 1, 16, 1, 16],
+        [13, 16, 16, 1, 16, 1, 16],
+        [14, 16, 16, 1, 16, 1, 16],
+        [15, 16, 16, 1, 16, 1, 16],
+        [16, 16, 16, 1, 16, 1, 16],
+        [17, 16, 16, 1, 16, 1, 16],
+      ],
+      lineOffsets: [27],
+      parsedExpr: {
+        expr: {
+          id: "17",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "13",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "11", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "15",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "14", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "16", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_exists",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 17,
+            "7": 20,
+            "8": 22,
+            "9": 24,
+            "10": 16,
+            "11": 16,
+            "12": 16,
+            "13": 16,
+            "14": 16,
+            "15": 16,
+            "16": 16,
+            "17": 16,
+          },
+          macroCalls: {
+            "17": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 17,
+          function: "exists",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { int64Value: "0" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  false~bool,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result~bool^@result\n    )~bool^logical_not\n  )~bool^not_strictly_false,\n  // LoopStep\n  _||_(\n    @result~bool^@result,\n    _\u003e_(\n      x~int^x,\n      0~int\n    )~bool^greater_int64\n  )~bool^logical_or,\n  // Result\n  @result~bool^@result)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "x" },
+          "8": { overloadId: ["greater_int64"] },
+          "11": { name: "@result" },
+          "12": { overloadId: ["logical_not"] },
+          "13": { overloadId: ["not_strictly_false"] },
+          "14": { name: "@result" },
+          "15": { overloadId: ["logical_or"] },
+          "16": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "BOOL" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "BOOL" },
+          "13": { primitive: "BOOL" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "BOOL" },
+          "16": { primitive: "BOOL" },
+          "17": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [27],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 17,
+            "7": 20,
+            "8": 22,
+            "9": 24,
+            "10": 16,
+            "11": 16,
+            "12": 16,
+            "13": 16,
+            "14": 16,
+            "15": 16,
+            "16": 16,
+            "17": 16,
+          },
+          macroCalls: {
+            "17": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "17",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "13",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "12",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "11", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "15",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "14", identExpr: { name: "@result" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "16", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "23", max: "29" },
+      result: { value: { boolValue: true } },
+      runtimeCost: "20",
+      expectedUnparsed: "[1, 2, 3].exists(x, x \u003e 0)",
+    },
+    {
+      original: {
+        name: "comp_map",
+        expr: "[1, 2, 3].map(x, x \u003e= 2, x * 4)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003e=_(\n      x^#*expr.Expr_IdentExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        _*_(\n          x^#*expr.Expr_IdentExpr#,\n          4^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].map(x, x \u003e= 2, x * 4)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#13[1,13]#,\n  // LoopCondition\n  true^#14[1,13]#,\n  // LoopStep\n  _?_:_(\n    _\u003e=_(\n      x^#7[1,17]#,\n      2^#9[1,22]#\n    )^#8[1,19]#,\n    _+_(\n      @result^#15[1,13]#,\n      [\n        _*_(\n          x^#10[1,25]#,\n          4^#12[1,29]#\n        )^#11[1,27]#\n      ]^#16[1,13]#\n    )^#17[1,13]#,\n    @result^#18[1,13]#\n  )^#19[1,13]#,\n  // Result\n  @result^#20[1,13]#)^#21[1,13]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 19, 21, 1, 19, 1, 21],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 25, 26, 1, 25, 1, 26],
+        [11, 27, 28, 1, 27, 1, 28],
+        [12, 29, 30, 1, 29, 1, 30],
+        [13, 13, 13, 1, 13, 1, 13],
+        [14, 13, 13, 1, 13, 1, 13],
+        [15, 13, 13, 1, 13, 1, 13],
+        [16, 13, 13, 1, 13, 1, 13],
+        [17, 13, 13, 1, 13, 1, 13],
+        [18, 13, 13, 1, 13, 1, 13],
+        [19, 13, 13, 1, 13, 1, 13],
+        [20, 13, 13, 1, 13, 1, 13],
+        [21, 13, 13, 1, 13, 1, 13],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "21",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "13", listExpr: {} },
+            loopCondition: { id: "14", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "19",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "15", identExpr: { name: "@result" } },
+                        {
+                          id: "16",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "11",
+                                callExpr: {
+                                  function: "_*_",
+                                  args: [
+                                    { id: "10", identExpr: { name: "x" } },
+                                    {
+                                      id: "12",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "18", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "20", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_map",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 27,
+            "12": 29,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "17": 13,
+            "18": 13,
+            "19": 13,
+            "20": 13,
+            "21": 13,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "12", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 21,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e=_",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+            {
+              id: "11",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "10", identExpr: { name: "x" } },
+                  { id: "12", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _\u003e=_(\n      x~int^x,\n      2~int\n    )~bool^greater_equals_int64,\n    _+_(\n      @result~list(int)^@result,\n      [\n        _*_(\n          x~int^x,\n          4~int\n        )~int^multiply_int64\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "x" },
+          "8": { overloadId: ["greater_equals_int64"] },
+          "10": { name: "x" },
+          "11": { overloadId: ["multiply_int64"] },
+          "15": { name: "@result" },
+          "17": { overloadId: ["add_list"] },
+          "18": { name: "@result" },
+          "19": { overloadId: ["conditional"] },
+          "20": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { primitive: "BOOL" },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 27,
+            "12": 29,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "17": 13,
+            "18": 13,
+            "19": 13,
+            "20": 13,
+            "21": 13,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "12", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "21",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "13", listExpr: {} },
+            loopCondition: { id: "14", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "19",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "17",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "15", identExpr: { name: "@result" } },
+                        {
+                          id: "16",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "11",
+                                callExpr: {
+                                  function: "_*_",
+                                  args: [
+                                    { id: "10", identExpr: { name: "x" } },
+                                    {
+                                      id: "12",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "18", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "20", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "30", max: "69" },
+      result: {
+        value: {
+          listValue: { values: [{ int64Value: "8" }, { int64Value: "12" }] },
+        },
+      },
+      runtimeCost: "55",
+      expectedUnparsed: "[1, 2, 3].map(x, x \u003e= 2, x * 4)",
+    },
+    {
+      original: {
+        name: "comp_exists_one",
+        expr: "[1, 2, 3].exists_one(x, x \u003e= 2)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#*expr.Constant_Int64Value#,\n    2^#*expr.Constant_Int64Value#,\n    3^#*expr.Constant_Int64Value#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  0^#*expr.Constant_Int64Value#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003e=_(\n      x^#*expr.Expr_IdentExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      1^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  _==_(\n    @result^#*expr.Expr_IdentExpr#,\n    1^#*expr.Constant_Int64Value#\n  )^#*expr.Expr_CallExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].exists_one(x, x \u003e= 2)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1^#2[1,1]#,\n    2^#3[1,4]#,\n    3^#4[1,7]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  0^#10[1,20]#,\n  // LoopCondition\n  true^#11[1,20]#,\n  // LoopStep\n  _?_:_(\n    _\u003e=_(\n      x^#7[1,24]#,\n      2^#9[1,29]#\n    )^#8[1,26]#,\n    _+_(\n      @result^#12[1,20]#,\n      1^#13[1,20]#\n    )^#14[1,20]#,\n    @result^#15[1,20]#\n  )^#16[1,20]#,\n  // Result\n  _==_(\n    @result^#17[1,20]#,\n    1^#18[1,20]#\n  )^#19[1,20]#)^#20[1,20]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 21, 22, 1, 21, 1, 22],
+        [7, 24, 25, 1, 24, 1, 25],
+        [8, 26, 28, 1, 26, 1, 28],
+        [9, 29, 30, 1, 29, 1, 30],
+        [10, 20, 20, 1, 20, 1, 20],
+        [11, 20, 20, 1, 20, 1, 20],
+        [12, 20, 20, 1, 20, 1, 20],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 20, 20, 1, 20, 1, 20],
+        [16, 20, 20, 1, 20, 1, 20],
+        [17, 20, 20, 1, 20, 1, 20],
+        [18, 20, 20, 1, 20, 1, 20],
+        [19, 20, 20, 1, 20, 1, 20],
+        [20, 20, 20, 1, 20, 1, 20],
+      ],
+      lineOffsets: [32],
+      parsedExpr: {
+        expr: {
+          id: "20",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { int64Value: "0" } },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "16",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        { id: "13", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  { id: "15", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: {
+              id: "19",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "17", identExpr: { name: "@result" } },
+                  { id: "18", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "comp_exists_one",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 21,
+            "7": 24,
+            "8": 26,
+            "9": 29,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+            "20": 20,
+          },
+          macroCalls: {
+            "20": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "exists_one",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 20,
+          function: "exists_one",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e=_",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    1~int,\n    2~int,\n    3~int\n  ]~list(int),\n  // Accumulator\n  @result,\n  // Init\n  0~int,\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _\u003e=_(\n      x~int^x,\n      2~int\n    )~bool^greater_equals_int64,\n    _+_(\n      @result~int^@result,\n      1~int\n    )~int^add_int64,\n    @result~int^@result\n  )~int^conditional,\n  // Result\n  _==_(\n    @result~int^@result,\n    1~int\n  )~bool^equals)~bool",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "x" },
+          "8": { overloadId: ["greater_equals_int64"] },
+          "12": { name: "@result" },
+          "14": { overloadId: ["add_int64"] },
+          "15": { name: "@result" },
+          "16": { overloadId: ["conditional"] },
+          "17": { name: "@result" },
+          "19": { overloadId: ["equals"] },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "BOOL" },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "INT64" },
+          "15": { primitive: "INT64" },
+          "16": { primitive: "INT64" },
+          "17": { primitive: "INT64" },
+          "18": { primitive: "INT64" },
+          "19": { primitive: "BOOL" },
+          "20": { primitive: "BOOL" },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [32],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 21,
+            "7": 24,
+            "8": 26,
+            "9": 29,
+            "10": 20,
+            "11": 20,
+            "12": 20,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+            "20": 20,
+          },
+          macroCalls: {
+            "20": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "exists_one",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "20",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  { id: "2", constExpr: { int64Value: "1" } },
+                  { id: "3", constExpr: { int64Value: "2" } },
+                  { id: "4", constExpr: { int64Value: "3" } },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "10", constExpr: { int64Value: "0" } },
+            loopCondition: { id: "11", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "16",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "12", identExpr: { name: "@result" } },
+                        { id: "13", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                  { id: "15", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: {
+              id: "19",
+              callExpr: {
+                function: "_==_",
+                args: [
+                  { id: "17", identExpr: { name: "@result" } },
+                  { id: "18", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          },
+        },
+      },
+      type: "bool",
+      cost: { min: "21", max: "24" },
+      result: { value: { boolValue: false } },
+      runtimeCost: "22",
+      expectedUnparsed: "[1, 2, 3].exists_one(x, x \u003e= 2)",
+    },
+    {
+      original: {
+        name: "comp_nested",
+        expr: "[[1], [2], [3]].map(x, x.filter(y, y \u003e 1))",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    [\n      1^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    [\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#\n  ]^#*expr.Expr_ListExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _+_(\n    @result^#*expr.Expr_IdentExpr#,\n    [\n      __comprehension__(\n        // Variable\n        y,\n        // Target\n        x^#*expr.Expr_IdentExpr#,\n        // Accumulator\n        @result,\n        // Init\n        []^#*expr.Expr_ListExpr#,\n        // LoopCondition\n        true^#*expr.Constant_BoolValue#,\n        // LoopStep\n        _?_:_(\n          _\u003e_(\n            y^#*expr.Expr_IdentExpr#,\n            1^#*expr.Constant_Int64Value#\n          )^#*expr.Expr_CallExpr#,\n          _+_(\n            @result^#*expr.Expr_IdentExpr#,\n            [\n              y^#*expr.Expr_IdentExpr#\n            ]^#*expr.Expr_ListExpr#\n          )^#*expr.Expr_CallExpr#,\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    ]^#*expr.Expr_ListExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[[1], [2], [3]].map(x, x.filter(y, y \u003e 1))",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    [\n      1^#3[1,2]#\n    ]^#2[1,1]#,\n    [\n      2^#5[1,7]#\n    ]^#4[1,6]#,\n    [\n      3^#7[1,12]#\n    ]^#6[1,11]#\n  ]^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#25[1,19]#,\n  // LoopCondition\n  true^#26[1,19]#,\n  // LoopStep\n  _+_(\n    @result^#27[1,19]#,\n    [\n      __comprehension__(\n        // Variable\n        y,\n        // Target\n        x^#10[1,23]#,\n        // Accumulator\n        @result,\n        // Init\n        []^#16[1,31]#,\n        // LoopCondition\n        true^#17[1,31]#,\n        // LoopStep\n        _?_:_(\n          _\u003e_(\n            y^#13[1,35]#,\n            1^#15[1,39]#\n          )^#14[1,37]#,\n          _+_(\n            @result^#18[1,31]#,\n            [\n              y^#12[1,32]#\n            ]^#19[1,31]#\n          )^#20[1,31]#,\n          @result^#21[1,31]#\n        )^#22[1,31]#,\n        // Result\n        @result^#23[1,31]#)^#24[1,31]#\n    ]^#28[1,19]#\n  )^#29[1,19]#,\n  // Result\n  @result^#30[1,19]#)^#31[1,19]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 7, 8, 1, 7, 1, 8],
+        [6, 11, 12, 1, 11, 1, 12],
+        [7, 12, 13, 1, 12, 1, 13],
+        [9, 20, 21, 1, 20, 1, 21],
+        [10, 23, 24, 1, 23, 1, 24],
+        [12, 32, 33, 1, 32, 1, 33],
+        [13, 35, 36, 1, 35, 1, 36],
+        [14, 37, 38, 1, 37, 1, 38],
+        [15, 39, 40, 1, 39, 1, 40],
+        [16, 31, 31, 1, 31, 1, 31],
+        [17, 31, 31, 1, 31, 1, 31],
+        [18, 31, 31, 1, 31, 1, 31],
+        [19, 31, 31, 1, 31, 1, 31],
+        [20, 31, 31, 1, 31, 1, 31],
+        [21, 31, 31, 1, 31, 1, 31],
+        [22, 31, 31, 1, 31, 1, 31],
+        [23, 31, 31, 1, 31, 1, 31],
+        [24, 31, 31, 1, 31, 1, 31],
+        [25, 19, 19, 1, 19, 1, 19],
+        [26, 19, 19, 1, 19, 1, 19],
+        [27, 19, 19, 1, 19, 1, 19],
+        [28, 19, 19, 1, 19, 1, 19],
+        [29, 19, 19, 1, 19, 1, 19],
+        [30, 19, 19, 1, 19, 1, 19],
+        [31, 19, 19, 1, 19, 1, 19],
+      ],
+      lineOffsets: [43],
+      parsedExpr: {
+        expr: {
+          id: "31",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "2",
+                    listExpr: {
+                      elements: [{ id: "3", constExpr: { int64Value: "1" } }],
+                    },
+                  },
+                  {
+                    id: "4",
+                    listExpr: {
+                      elements: [{ id: "5", constExpr: { int64Value: "2" } }],
+                    },
+                  },
+                  {
+                    id: "6",
+                    listExpr: {
+                      elements: [{ id: "7", constExpr: { int64Value: "3" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "25", listExpr: {} },
+            loopCondition: { id: "26", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "29",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "27", identExpr: { name: "@result" } },
+                  {
+                    id: "28",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "24",
+                          comprehensionExpr: {
+                            iterVar: "y",
+                            iterRange: { id: "10", identExpr: { name: "x" } },
+                            accuVar: "@result",
+                            accuInit: { id: "16", listExpr: {} },
+                            loopCondition: {
+                              id: "17",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "22",
+                              callExpr: {
+                                function: "_?_:_",
+                                args: [
+                                  {
+                                    id: "14",
+                                    callExpr: {
+                                      function: "_\u003e_",
+                                      args: [
+                                        { id: "13", identExpr: { name: "y" } },
+                                        {
+                                          id: "15",
+                                          constExpr: { int64Value: "1" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  {
+                                    id: "20",
+                                    callExpr: {
+                                      function: "_+_",
+                                      args: [
+                                        {
+                                          id: "18",
+                                          identExpr: { name: "@result" },
+                                        },
+                                        {
+                                          id: "19",
+                                          listExpr: {
+                                            elements: [
+                                              {
+                                                id: "12",
+                                                identExpr: { name: "y" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  { id: "21", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "23",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "30", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_nested",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 6,
+            "5": 7,
+            "6": 11,
+            "7": 12,
+            "9": 20,
+            "10": 23,
+            "12": 32,
+            "13": 35,
+            "14": 37,
+            "15": 39,
+            "16": 31,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 19,
+            "26": 19,
+            "27": 19,
+            "28": 19,
+            "29": 19,
+            "30": 19,
+            "31": 19,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: { id: "10", identExpr: { name: "x" } },
+                function: "filter",
+                args: [
+                  { id: "12", identExpr: { name: "y" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "13", identExpr: { name: "y" } },
+                        { id: "15", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        listExpr: {
+                          elements: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "4",
+                        listExpr: {
+                          elements: [
+                            { id: "5", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        listExpr: {
+                          elements: [
+                            { id: "7", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "9", identExpr: { name: "x" } }, { id: "24" }],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 24,
+          function: "filter",
+          target: { id: "10", identExpr: { name: "x" } },
+          args: [
+            { id: "12", identExpr: { name: "y" } },
+            {
+              id: "14",
+              callExpr: {
+                function: "_\u003e_",
+                args: [
+                  { id: "13", identExpr: { name: "y" } },
+                  { id: "15", constExpr: { int64Value: "1" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 31,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                {
+                  id: "2",
+                  listExpr: {
+                    elements: [{ id: "3", constExpr: { int64Value: "1" } }],
+                  },
+                },
+                {
+                  id: "4",
+                  listExpr: {
+                    elements: [{ id: "5", constExpr: { int64Value: "2" } }],
+                  },
+                },
+                {
+                  id: "6",
+                  listExpr: {
+                    elements: [{ id: "7", constExpr: { int64Value: "3" } }],
+                  },
+                },
+              ],
+            },
+          },
+          args: [{ id: "9", identExpr: { name: "x" } }, { id: "24" }],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  [\n    [\n      1~int\n    ]~list(int),\n    [\n      2~int\n    ]~list(int),\n    [\n      3~int\n    ]~list(int)\n  ]~list(list(int)),\n  // Accumulator\n  @result,\n  // Init\n  []~list(list(int)),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _+_(\n    @result~list(list(int))^@result,\n    [\n      __comprehension__(\n        // Variable\n        y,\n        // Target\n        x~list(int)^x,\n        // Accumulator\n        @result,\n        // Init\n        []~list(int),\n        // LoopCondition\n        true~bool,\n        // LoopStep\n        _?_:_(\n          _\u003e_(\n            y~int^y,\n            1~int\n          )~bool^greater_int64,\n          _+_(\n            @result~list(int)^@result,\n            [\n              y~int^y\n            ]~list(int)\n          )~list(int)^add_list,\n          @result~list(int)^@result\n        )~list(int)^conditional,\n        // Result\n        @result~list(int)^@result)~list(int)\n    ]~list(list(int))\n  )~list(list(int))^add_list,\n  // Result\n  @result~list(list(int))^@result)~list(list(int))",
+      checkedExpr: {
+        referenceMap: {
+          "10": { name: "x" },
+          "12": { name: "y" },
+          "13": { name: "y" },
+          "14": { overloadId: ["greater_int64"] },
+          "18": { name: "@result" },
+          "20": { overloadId: ["add_list"] },
+          "21": { name: "@result" },
+          "22": { overloadId: ["conditional"] },
+          "23": { name: "@result" },
+          "27": { name: "@result" },
+          "29": { overloadId: ["add_list"] },
+          "30": { name: "@result" },
+        },
+        typeMap: {
+          "1": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "2": { listType: { elemType: { primitive: "INT64" } } },
+          "3": { primitive: "INT64" },
+          "4": { listType: { elemType: { primitive: "INT64" } } },
+          "5": { primitive: "INT64" },
+          "6": { listType: { elemType: { primitive: "INT64" } } },
+          "7": { primitive: "INT64" },
+          "10": { listType: { elemType: { primitive: "INT64" } } },
+          "12": { primitive: "INT64" },
+          "13": { primitive: "INT64" },
+          "14": { primitive: "BOOL" },
+          "15": { primitive: "INT64" },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { primitive: "BOOL" },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "22": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { listType: { elemType: { primitive: "INT64" } } },
+          "24": { listType: { elemType: { primitive: "INT64" } } },
+          "25": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "26": { primitive: "BOOL" },
+          "27": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "28": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "29": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "30": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+          "31": {
+            listType: {
+              elemType: { listType: { elemType: { primitive: "INT64" } } },
+            },
+          },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [43],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 2,
+            "4": 6,
+            "5": 7,
+            "6": 11,
+            "7": 12,
+            "9": 20,
+            "10": 23,
+            "12": 32,
+            "13": 35,
+            "14": 37,
+            "15": 39,
+            "16": 31,
+            "17": 31,
+            "18": 31,
+            "19": 31,
+            "20": 31,
+            "21": 31,
+            "22": 31,
+            "23": 31,
+            "24": 31,
+            "25": 19,
+            "26": 19,
+            "27": 19,
+            "28": 19,
+            "29": 19,
+            "30": 19,
+            "31": 19,
+          },
+          macroCalls: {
+            "24": {
+              callExpr: {
+                target: { id: "10", identExpr: { name: "x" } },
+                function: "filter",
+                args: [
+                  { id: "12", identExpr: { name: "y" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_\u003e_",
+                      args: [
+                        { id: "13", identExpr: { name: "y" } },
+                        { id: "15", constExpr: { int64Value: "1" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "31": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      {
+                        id: "2",
+                        listExpr: {
+                          elements: [
+                            { id: "3", constExpr: { int64Value: "1" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "4",
+                        listExpr: {
+                          elements: [
+                            { id: "5", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "6",
+                        listExpr: {
+                          elements: [
+                            { id: "7", constExpr: { int64Value: "3" } },
+                          ],
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [{ id: "9", identExpr: { name: "x" } }, { id: "24" }],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "31",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "1",
+              listExpr: {
+                elements: [
+                  {
+                    id: "2",
+                    listExpr: {
+                      elements: [{ id: "3", constExpr: { int64Value: "1" } }],
+                    },
+                  },
+                  {
+                    id: "4",
+                    listExpr: {
+                      elements: [{ id: "5", constExpr: { int64Value: "2" } }],
+                    },
+                  },
+                  {
+                    id: "6",
+                    listExpr: {
+                      elements: [{ id: "7", constExpr: { int64Value: "3" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "25", listExpr: {} },
+            loopCondition: { id: "26", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "29",
+              callExpr: {
+                function: "_+_",
+                args: [
+                  { id: "27", identExpr: { name: "@result" } },
+                  {
+                    id: "28",
+                    listExpr: {
+                      elements: [
+                        {
+                          id: "24",
+                          comprehensionExpr: {
+                            iterVar: "y",
+                            iterRange: { id: "10", identExpr: { name: "x" } },
+                            accuVar: "@result",
+                            accuInit: { id: "16", listExpr: {} },
+                            loopCondition: {
+                              id: "17",
+                              constExpr: { boolValue: true },
+                            },
+                            loopStep: {
+                              id: "22",
+                              callExpr: {
+                                function: "_?_:_",
+                                args: [
+                                  {
+                                    id: "14",
+                                    callExpr: {
+                                      function: "_\u003e_",
+                                      args: [
+                                        { id: "13", identExpr: { name: "y" } },
+                                        {
+                                          id: "15",
+                                          constExpr: { int64Value: "1" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  {
+                                    id: "20",
+                                    callExpr: {
+                                      function: "_+_",
+                                      args: [
+                                        {
+                                          id: "18",
+                                          identExpr: { name: "@result" },
+                                        },
+                                        {
+                                          id: "19",
+                                          listExpr: {
+                                            elements: [
+                                              {
+                                                id: "12",
+                                                identExpr: { name: "y" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  { id: "21", identExpr: { name: "@result" } },
+                                ],
+                              },
+                            },
+                            result: {
+                              id: "23",
+                              identExpr: { name: "@result" },
+                            },
+                          },
+                        },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "30", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(list(int))",
+      cost: { min: "132", max: "168" },
+      result: {
+        value: {
+          listValue: {
+            values: [
+              { listValue: {} },
+              { listValue: { values: [{ int64Value: "2" }] } },
+              { listValue: { values: [{ int64Value: "3" }] } },
+            ],
+          },
+        },
+      },
+      runtimeCost: "155",
+      expectedUnparsed: "[[1], [2], [3]].map(x, x.filter(y, y \u003e 1))",
+    },
+    {
+      original: {
+        name: "comp_chained",
+        expr: "[1, 2, 3].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _?_:_(\n      _\u003e=_(\n        x^#*expr.Expr_IdentExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          _*_(\n            x^#*expr.Expr_IdentExpr#,\n            4^#*expr.Constant_Int64Value#\n          )^#*expr.Expr_CallExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003c=_(\n      x^#*expr.Expr_IdentExpr#,\n      10^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "[1, 2, 3].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,4]#,\n      3^#4[1,7]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#13[1,13]#,\n    // LoopCondition\n    true^#14[1,13]#,\n    // LoopStep\n    _?_:_(\n      _\u003e=_(\n        x^#7[1,17]#,\n        2^#9[1,22]#\n      )^#8[1,19]#,\n      _+_(\n        @result^#15[1,13]#,\n        [\n          _*_(\n            x^#10[1,25]#,\n            4^#12[1,29]#\n          )^#11[1,27]#\n        ]^#16[1,13]#\n      )^#17[1,13]#,\n      @result^#18[1,13]#\n    )^#19[1,13]#,\n    // Result\n    @result^#20[1,13]#)^#21[1,13]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#27[1,38]#,\n  // LoopCondition\n  true^#28[1,38]#,\n  // LoopStep\n  _?_:_(\n    _\u003c=_(\n      x^#24[1,42]#,\n      10^#26[1,47]#\n    )^#25[1,44]#,\n    _+_(\n      @result^#29[1,38]#,\n      [\n        x^#23[1,39]#\n      ]^#30[1,38]#\n    )^#31[1,38]#,\n    @result^#32[1,38]#\n  )^#33[1,38]#,\n  // Result\n  @result^#34[1,38]#)^#35[1,38]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 19, 21, 1, 19, 1, 21],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 25, 26, 1, 25, 1, 26],
+        [11, 27, 28, 1, 27, 1, 28],
+        [12, 29, 30, 1, 29, 1, 30],
+        [13, 13, 13, 1, 13, 1, 13],
+        [14, 13, 13, 1, 13, 1, 13],
+        [15, 13, 13, 1, 13, 1, 13],
+        [16, 13, 13, 1, 13, 1, 13],
+        [17, 13, 13, 1, 13, 1, 13],
+        [18, 13, 13, 1, 13, 1, 13],
+        [19, 13, 13, 1, 13, 1, 13],
+        [20, 13, 13, 1, 13, 1, 13],
+        [21, 13, 13, 1, 13, 1, 13],
+        [23, 39, 40, 1, 39, 1, 40],
+        [24, 42, 43, 1, 42, 1, 43],
+        [25, 44, 46, 1, 44, 1, 46],
+        [26, 47, 49, 1, 47, 1, 49],
+        [27, 38, 38, 1, 38, 1, 38],
+        [28, 38, 38, 1, 38, 1, 38],
+        [29, 38, 38, 1, 38, 1, 38],
+        [30, 38, 38, 1, 38, 1, 38],
+        [31, 38, 38, 1, 38, 1, 38],
+        [32, 38, 38, 1, 38, 1, 38],
+        [33, 38, 38, 1, 38, 1, 38],
+        [34, 38, 38, 1, 38, 1, 38],
+        [35, 38, 38, 1, 38, 1, 38],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "35",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "21",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "13", listExpr: {} },
+                loopCondition: { id: "14", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "19",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "8",
+                        callExpr: {
+                          function: "_\u003e=_",
+                          args: [
+                            { id: "7", identExpr: { name: "x" } },
+                            { id: "9", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "17",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "15", identExpr: { name: "@result" } },
+                            {
+                              id: "16",
+                              listExpr: {
+                                elements: [
+                                  {
+                                    id: "11",
+                                    callExpr: {
+                                      function: "_*_",
+                                      args: [
+                                        { id: "10", identExpr: { name: "x" } },
+                                        {
+                                          id: "12",
+                                          constExpr: { int64Value: "4" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "18", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "20", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "27", listExpr: {} },
+            loopCondition: { id: "28", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "33",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "24", identExpr: { name: "x" } },
+                        { id: "26", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "31",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "29", identExpr: { name: "@result" } },
+                        {
+                          id: "30",
+                          listExpr: {
+                            elements: [{ id: "23", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "32", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "34", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_chained",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 27,
+            "12": 29,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "17": 13,
+            "18": 13,
+            "19": 13,
+            "20": 13,
+            "21": 13,
+            "23": 39,
+            "24": 42,
+            "25": 44,
+            "26": 47,
+            "27": 38,
+            "28": 38,
+            "29": 38,
+            "30": 38,
+            "31": 38,
+            "32": 38,
+            "33": 38,
+            "34": 38,
+            "35": 38,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "12", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "35": {
+              callExpr: {
+                target: { id: "21" },
+                function: "filter",
+                args: [
+                  { id: "23", identExpr: { name: "x" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "24", identExpr: { name: "x" } },
+                        { id: "26", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 21,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e=_",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+            {
+              id: "11",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "10", identExpr: { name: "x" } },
+                  { id: "12", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 35,
+          function: "filter",
+          target: { id: "21" },
+          args: [
+            { id: "23", identExpr: { name: "x" } },
+            {
+              id: "25",
+              callExpr: {
+                function: "_\u003c=_",
+                args: [
+                  { id: "24", identExpr: { name: "x" } },
+                  { id: "26", constExpr: { int64Value: "10" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _?_:_(\n      _\u003e=_(\n        x~int^x,\n        2~int\n      )~bool^greater_equals_int64,\n      _+_(\n        @result~list(int)^@result,\n        [\n          _*_(\n            x~int^x,\n            4~int\n          )~int^multiply_int64\n        ]~list(int)\n      )~list(int)^add_list,\n      @result~list(int)^@result\n    )~list(int)^conditional,\n    // Result\n    @result~list(int)^@result)~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _\u003c=_(\n      x~int^x,\n      10~int\n    )~bool^less_equals_int64,\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "x" },
+          "8": { overloadId: ["greater_equals_int64"] },
+          "10": { name: "x" },
+          "11": { overloadId: ["multiply_int64"] },
+          "15": { name: "@result" },
+          "17": { overloadId: ["add_list"] },
+          "18": { name: "@result" },
+          "19": { overloadId: ["conditional"] },
+          "20": { name: "@result" },
+          "23": { name: "x" },
+          "24": { name: "x" },
+          "25": { overloadId: ["less_equals_int64"] },
+          "29": { name: "@result" },
+          "31": { overloadId: ["add_list"] },
+          "32": { name: "@result" },
+          "33": { overloadId: ["conditional"] },
+          "34": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { primitive: "BOOL" },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { primitive: "INT64" },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+          "28": { primitive: "BOOL" },
+          "29": { listType: { elemType: { primitive: "INT64" } } },
+          "30": { listType: { elemType: { primitive: "INT64" } } },
+          "31": { listType: { elemType: { primitive: "INT64" } } },
+          "32": { listType: { elemType: { primitive: "INT64" } } },
+          "33": { listType: { elemType: { primitive: "INT64" } } },
+          "34": { listType: { elemType: { primitive: "INT64" } } },
+          "35": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 27,
+            "12": 29,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "17": 13,
+            "18": 13,
+            "19": 13,
+            "20": 13,
+            "21": 13,
+            "23": 39,
+            "24": 42,
+            "25": 44,
+            "26": 47,
+            "27": 38,
+            "28": 38,
+            "29": 38,
+            "30": 38,
+            "31": 38,
+            "32": 38,
+            "33": 38,
+            "34": 38,
+            "35": 38,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "12", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "35": {
+              callExpr: {
+                target: { id: "21" },
+                function: "filter",
+                args: [
+                  { id: "23", identExpr: { name: "x" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "24", identExpr: { name: "x" } },
+                        { id: "26", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "35",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "21",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "13", listExpr: {} },
+                loopCondition: { id: "14", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "19",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "8",
+                        callExpr: {
+                          function: "_\u003e=_",
+                          args: [
+                            { id: "7", identExpr: { name: "x" } },
+                            { id: "9", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "17",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "15", identExpr: { name: "@result" } },
+                            {
+                              id: "16",
+                              listExpr: {
+                                elements: [
+                                  {
+                                    id: "11",
+                                    callExpr: {
+                                      function: "_*_",
+                                      args: [
+                                        { id: "10", identExpr: { name: "x" } },
+                                        {
+                                          id: "12",
+                                          constExpr: { int64Value: "4" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "18", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "20", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "27", listExpr: {} },
+            loopCondition: { id: "28", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "33",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "24", identExpr: { name: "x" } },
+                        { id: "26", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "31",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "29", identExpr: { name: "@result" } },
+                        {
+                          id: "30",
+                          listExpr: {
+                            elements: [{ id: "23", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "32", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "34", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "50", max: "125" },
+      result: { value: { listValue: { values: [{ int64Value: "8" }] } } },
+      runtimeCost: "83",
+      expectedUnparsed:
+        "[1, 2, 3].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)",
+    },
+    {
+      original: {
+        name: "comp_chained_opt",
+        expr: "[?a, b[?0], c].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      a^#*expr.Expr_IdentExpr#,\n      _[?_](\n        b^#*expr.Expr_IdentExpr#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _?_:_(\n      _\u003e=_(\n        x^#*expr.Expr_IdentExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          _*_(\n            x^#*expr.Expr_IdentExpr#,\n            4^#*expr.Constant_Int64Value#\n          )^#*expr.Expr_CallExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003c=_(\n      x^#*expr.Expr_IdentExpr#,\n      10^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "[?a, b[?0], c].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      a^#2[1,2]#,\n      _[?_](\n        b^#3[1,5]#,\n        0^#5[1,8]#\n      )^#4[1,6]#,\n      c^#6[1,12]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#15[1,18]#,\n    // LoopCondition\n    true^#16[1,18]#,\n    // LoopStep\n    _?_:_(\n      _\u003e=_(\n        x^#9[1,22]#,\n        2^#11[1,27]#\n      )^#10[1,24]#,\n      _+_(\n        @result^#17[1,18]#,\n        [\n          _*_(\n            x^#12[1,30]#,\n            4^#14[1,34]#\n          )^#13[1,32]#\n        ]^#18[1,18]#\n      )^#19[1,18]#,\n      @result^#20[1,18]#\n    )^#21[1,18]#,\n    // Result\n    @result^#22[1,18]#)^#23[1,18]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#29[1,43]#,\n  // LoopCondition\n  true^#30[1,43]#,\n  // LoopStep\n  _?_:_(\n    _\u003c=_(\n      x^#26[1,47]#,\n      10^#28[1,52]#\n    )^#27[1,49]#,\n    _+_(\n      @result^#31[1,43]#,\n      [\n        x^#25[1,44]#\n      ]^#32[1,43]#\n    )^#33[1,43]#,\n    @result^#34[1,43]#\n  )^#35[1,43]#,\n  // Result\n  @result^#36[1,43]#)^#37[1,43]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 12, 13, 1, 12, 1, 13],
+        [8, 19, 20, 1, 19, 1, 20],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 24, 26, 1, 24, 1, 26],
+        [11, 27, 28, 1, 27, 1, 28],
+        [12, 30, 31, 1, 30, 1, 31],
+        [13, 32, 33, 1, 32, 1, 33],
+        [14, 34, 35, 1, 34, 1, 35],
+        [15, 18, 18, 1, 18, 1, 18],
+        [16, 18, 18, 1, 18, 1, 18],
+        [17, 18, 18, 1, 18, 1, 18],
+        [18, 18, 18, 1, 18, 1, 18],
+        [19, 18, 18, 1, 18, 1, 18],
+        [20, 18, 18, 1, 18, 1, 18],
+        [21, 18, 18, 1, 18, 1, 18],
+        [22, 18, 18, 1, 18, 1, 18],
+        [23, 18, 18, 1, 18, 1, 18],
+        [25, 44, 45, 1, 44, 1, 45],
+        [26, 47, 48, 1, 47, 1, 48],
+        [27, 49, 51, 1, 49, 1, 51],
+        [28, 52, 54, 1, 52, 1, 54],
+        [29, 43, 43, 1, 43, 1, 43],
+        [30, 43, 43, 1, 43, 1, 43],
+        [31, 43, 43, 1, 43, 1, 43],
+        [32, 43, 43, 1, 43, 1, 43],
+        [33, 43, 43, 1, 43, 1, 43],
+        [34, 43, 43, 1, 43, 1, 43],
+        [35, 43, 43, 1, 43, 1, 43],
+        [36, 43, 43, 1, 43, 1, 43],
+        [37, 43, 43, 1, 43, 1, 43],
+      ],
+      lineOffsets: [56],
+      parsedExpr: {
+        expr: {
+          id: "37",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "23",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "a" } },
+                      {
+                        id: "4",
+                        callExpr: {
+                          function: "_[?_]",
+                          args: [
+                            { id: "3", identExpr: { name: "b" } },
+                            { id: "5", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      { id: "6", identExpr: { name: "c" } },
+                    ],
+                    optionalIndices: [0],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "15", listExpr: {} },
+                loopCondition: { id: "16", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "21",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "10",
+                        callExpr: {
+                          function: "_\u003e=_",
+                          args: [
+                            { id: "9", identExpr: { name: "x" } },
+                            { id: "11", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "19",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "17", identExpr: { name: "@result" } },
+                            {
+                              id: "18",
+                              listExpr: {
+                                elements: [
+                                  {
+                                    id: "13",
+                                    callExpr: {
+                                      function: "_*_",
+                                      args: [
+                                        { id: "12", identExpr: { name: "x" } },
+                                        {
+                                          id: "14",
+                                          constExpr: { int64Value: "4" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "20", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "22", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "29", listExpr: {} },
+            loopCondition: { id: "30", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "35",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "27",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "26", identExpr: { name: "x" } },
+                        { id: "28", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "33",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "31", identExpr: { name: "@result" } },
+                        {
+                          id: "32",
+                          listExpr: {
+                            elements: [{ id: "25", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "34", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "36", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_chained_opt",
+          lineOffsets: [56],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 6,
+            "5": 8,
+            "6": 12,
+            "8": 19,
+            "9": 22,
+            "10": 24,
+            "11": 27,
+            "12": 30,
+            "13": 32,
+            "14": 34,
+            "15": 18,
+            "16": 18,
+            "17": 18,
+            "18": 18,
+            "19": 18,
+            "20": 18,
+            "21": 18,
+            "22": 18,
+            "23": 18,
+            "25": 44,
+            "26": 47,
+            "27": 49,
+            "28": 52,
+            "29": 43,
+            "30": 43,
+            "31": 43,
+            "32": 43,
+            "33": 43,
+            "34": 43,
+            "35": 43,
+            "36": 43,
+            "37": 43,
+          },
+          macroCalls: {
+            "23": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", identExpr: { name: "a" } },
+                      {
+                        id: "4",
+                        callExpr: {
+                          function: "_[?_]",
+                          args: [
+                            { id: "3", identExpr: { name: "b" } },
+                            { id: "5", constExpr: { int64Value: "0" } },
+                          ],
+                        },
+                      },
+                      { id: "6", identExpr: { name: "c" } },
+                    ],
+                    optionalIndices: [0],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "x" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "37": {
+              callExpr: {
+                target: { id: "23" },
+                function: "filter",
+                args: [
+                  { id: "25", identExpr: { name: "x" } },
+                  {
+                    id: "27",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "26", identExpr: { name: "x" } },
+                        { id: "28", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 23,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", identExpr: { name: "a" } },
+                {
+                  id: "4",
+                  callExpr: {
+                    function: "_[?_]",
+                    args: [
+                      { id: "3", identExpr: { name: "b" } },
+                      { id: "5", constExpr: { int64Value: "0" } },
+                    ],
+                  },
+                },
+                { id: "6", identExpr: { name: "c" } },
+              ],
+              optionalIndices: [0],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "x" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_\u003e=_",
+                args: [
+                  { id: "9", identExpr: { name: "x" } },
+                  { id: "11", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+            {
+              id: "13",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "12", identExpr: { name: "x" } },
+                  { id: "14", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 37,
+          function: "filter",
+          target: { id: "23" },
+          args: [
+            { id: "25", identExpr: { name: "x" } },
+            {
+              id: "27",
+              callExpr: {
+                function: "_\u003c=_",
+                args: [
+                  { id: "26", identExpr: { name: "x" } },
+                  { id: "28", constExpr: { int64Value: "10" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        "ERROR: \u003cinput\u003e:1:3: undeclared reference to 'a' (in container '')\n | [?a, b[?0], c].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)\n | ..^\nERROR: \u003cinput\u003e:1:3: expected type 'optional_type(!error!)' but found '!error!'\n | [?a, b[?0], c].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)\n | ..^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | [?a, b[?0], c].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)\n | .....^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'c' (in container '')\n | [?a, b[?0], c].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "type-mismatch",
+          message: "expected type 'optional_type(!error!)' but found '!error!'",
+          id: 2,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 6,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedUnparsed:
+        "[?a, b[?0], c].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)",
+    },
+    {
+      original: {
+        name: "comp_map_opt",
+        expr: "{?a: b[?0]}.map(k, x \u003e= 2, x * 4)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: "__comprehension__(\n  // Variable\n  k,\n  // Target\n  {\n    ?a^#*expr.Expr_IdentExpr#:_[?_](\n      b^#*expr.Expr_IdentExpr#,\n      0^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003e=_(\n      x^#*expr.Expr_IdentExpr#,\n      2^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        _*_(\n          x^#*expr.Expr_IdentExpr#,\n          4^#*expr.Constant_Int64Value#\n        )^#*expr.Expr_CallExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed: "{?a: b[?0]}.map(k, x \u003e= 2, x * 4)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  k,\n  // Target\n  {\n    ?a^#3[1,2]#:_[?_](\n      b^#4[1,5]#,\n      0^#6[1,8]#\n    )^#5[1,6]#^#2[1,3]#\n  }^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#15[1,15]#,\n  // LoopCondition\n  true^#16[1,15]#,\n  // LoopStep\n  _?_:_(\n    _\u003e=_(\n      x^#9[1,19]#,\n      2^#11[1,24]#\n    )^#10[1,21]#,\n    _+_(\n      @result^#17[1,15]#,\n      [\n        _*_(\n          x^#12[1,27]#,\n          4^#14[1,31]#\n        )^#13[1,29]#\n      ]^#18[1,15]#\n    )^#19[1,15]#,\n    @result^#20[1,15]#\n  )^#21[1,15]#,\n  // Result\n  @result^#22[1,15]#)^#23[1,15]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 3, 4, 1, 3, 1, 4],
+        [3, 2, 3, 1, 2, 1, 3],
+        [4, 5, 6, 1, 5, 1, 6],
+        [5, 6, 7, 1, 6, 1, 7],
+        [6, 8, 9, 1, 8, 1, 9],
+        [8, 16, 17, 1, 16, 1, 17],
+        [9, 19, 20, 1, 19, 1, 20],
+        [10, 21, 23, 1, 21, 1, 23],
+        [11, 24, 25, 1, 24, 1, 25],
+        [12, 27, 28, 1, 27, 1, 28],
+        [13, 29, 30, 1, 29, 1, 30],
+        [14, 31, 32, 1, 31, 1, 32],
+        [15, 15, 15, 1, 15, 1, 15],
+        [16, 15, 15, 1, 15, 1, 15],
+        [17, 15, 15, 1, 15, 1, 15],
+        [18, 15, 15, 1, 15, 1, 15],
+        [19, 15, 15, 1, 15, 1, 15],
+        [20, 15, 15, 1, 15, 1, 15],
+        [21, 15, 15, 1, 15, 1, 15],
+        [22, 15, 15, 1, 15, 1, 15],
+        [23, 15, 15, 1, 15, 1, 15],
+      ],
+      lineOffsets: [34],
+      parsedExpr: {
+        expr: {
+          id: "23",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", identExpr: { name: "a" } },
+                    value: {
+                      id: "5",
+                      callExpr: {
+                        function: "_[?_]",
+                        args: [
+                          { id: "4", identExpr: { name: "b" } },
+                          { id: "6", constExpr: { int64Value: "0" } },
+                        ],
+                      },
+                    },
+                    optionalEntry: true,
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "15", listExpr: {} },
+            loopCondition: { id: "16", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "21",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "19",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "17", identExpr: { name: "@result" } },
+                        {
+                          id: "18",
+                          listExpr: {
+                            elements: [
+                              {
+                                id: "13",
+                                callExpr: {
+                                  function: "_*_",
+                                  args: [
+                                    { id: "12", identExpr: { name: "x" } },
+                                    {
+                                      id: "14",
+                                      constExpr: { int64Value: "4" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "20", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "22", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_map_opt",
+          lineOffsets: [34],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "3": 2,
+            "4": 5,
+            "5": 6,
+            "6": 8,
+            "8": 16,
+            "9": 19,
+            "10": 21,
+            "11": 24,
+            "12": 27,
+            "13": 29,
+            "14": 31,
+            "15": 15,
+            "16": 15,
+            "17": 15,
+            "18": 15,
+            "19": 15,
+            "20": 15,
+            "21": 15,
+            "22": 15,
+            "23": 15,
+          },
+          macroCalls: {
+            "23": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "2",
+                        mapKey: { id: "3", identExpr: { name: "a" } },
+                        value: {
+                          id: "5",
+                          callExpr: {
+                            function: "_[?_]",
+                            args: [
+                              { id: "4", identExpr: { name: "b" } },
+                              { id: "6", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                        optionalEntry: true,
+                      },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "8", identExpr: { name: "k" } },
+                  {
+                    id: "10",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "9", identExpr: { name: "x" } },
+                        { id: "11", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "13",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "12", identExpr: { name: "x" } },
+                        { id: "14", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 23,
+          function: "map",
+          target: {
+            id: "1",
+            structExpr: {
+              entries: [
+                {
+                  id: "2",
+                  mapKey: { id: "3", identExpr: { name: "a" } },
+                  value: {
+                    id: "5",
+                    callExpr: {
+                      function: "_[?_]",
+                      args: [
+                        { id: "4", identExpr: { name: "b" } },
+                        { id: "6", constExpr: { int64Value: "0" } },
+                      ],
+                    },
+                  },
+                  optionalEntry: true,
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "8", identExpr: { name: "k" } },
+            {
+              id: "10",
+              callExpr: {
+                function: "_\u003e=_",
+                args: [
+                  { id: "9", identExpr: { name: "x" } },
+                  { id: "11", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+            {
+              id: "13",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "12", identExpr: { name: "x" } },
+                  { id: "14", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        "ERROR: \u003cinput\u003e:1:3: undeclared reference to 'a' (in container '')\n | {?a: b[?0]}.map(k, x \u003e= 2, x * 4)\n | ..^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | {?a: b[?0]}.map(k, x \u003e= 2, x * 4)\n | .....^\nERROR: \u003cinput\u003e:1:20: undeclared reference to 'x' (in container '')\n | {?a: b[?0]}.map(k, x \u003e= 2, x * 4)\n | ...................^\nERROR: \u003cinput\u003e:1:28: undeclared reference to 'x' (in container '')\n | {?a: b[?0]}.map(k, x \u003e= 2, x * 4)\n | ...........................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 3,
+          offset: 2,
+          line: 1,
+          column: 2,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 4,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 9,
+          offset: 19,
+          line: 1,
+          column: 19,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'x' (in container '')",
+          id: 12,
+          offset: 27,
+          line: 1,
+          column: 27,
+        },
+      ],
+      expectedUnparsed: "{?a: b[?0]}.map(k, x \u003e= 2, x * 4)",
+    },
+    {
+      original: {
+        name: "comp_map_opt",
+        expr: '{a: has(b.c)}.exists(k, k != "")',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '__comprehension__(\n  // Variable\n  k,\n  // Target\n  {\n    a^#*expr.Expr_IdentExpr#:b^#*expr.Expr_IdentExpr#.c~test-only~^#*expr.Expr_SelectExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _!=_(\n      k^#*expr.Expr_IdentExpr#,\n      ""^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: '{a: has(b.c)}.exists(k, k != "")',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  k,\n  // Target\n  {\n    a^#3[1,1]#:b^#5[1,8]#.c~test-only~^#7[1,7]#^#2[1,2]#\n  }^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#13[1,20]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#14[1,20]#\n    )^#15[1,20]#\n  )^#16[1,20]#,\n  // LoopStep\n  _||_(\n    @result^#17[1,20]#,\n    _!=_(\n      k^#10[1,24]#,\n      ""^#12[1,29]#\n    )^#11[1,26]#\n  )^#18[1,20]#,\n  // Result\n  @result^#19[1,20]#)^#20[1,20]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 9, 10, 1, 9, 1, 10],
+        [7, 7, 7, 1, 7, 1, 7],
+        [9, 21, 22, 1, 21, 1, 22],
+        [10, 24, 25, 1, 24, 1, 25],
+        [11, 26, 28, 1, 26, 1, 28],
+        [12, 29, 31, 1, 29, 1, 31],
+        [13, 20, 20, 1, 20, 1, 20],
+        [14, 20, 20, 1, 20, 1, 20],
+        [15, 20, 20, 1, 20, 1, 20],
+        [16, 20, 20, 1, 20, 1, 20],
+        [17, 20, 20, 1, 20, 1, 20],
+        [18, 20, 20, 1, 20, 1, 20],
+        [19, 20, 20, 1, 20, 1, 20],
+        [20, 20, 20, 1, 20, 1, 20],
+      ],
+      lineOffsets: [33],
+      parsedExpr: {
+        expr: {
+          id: "20",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", identExpr: { name: "a" } },
+                    value: {
+                      id: "7",
+                      selectExpr: {
+                        operand: { id: "5", identExpr: { name: "b" } },
+                        field: "c",
+                        testOnly: true,
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "13", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "16",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "15",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "14", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "18",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "17", identExpr: { name: "@result" } },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_!=_",
+                      args: [
+                        { id: "10", identExpr: { name: "k" } },
+                        { id: "12", constExpr: { stringValue: "" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "19", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_map_opt",
+          lineOffsets: [33],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "5": 8,
+            "6": 9,
+            "7": 7,
+            "9": 21,
+            "10": 24,
+            "11": 26,
+            "12": 29,
+            "13": 20,
+            "14": 20,
+            "15": 20,
+            "16": 20,
+            "17": 20,
+            "18": 20,
+            "19": 20,
+            "20": 20,
+          },
+          macroCalls: {
+            "7": {
+              callExpr: {
+                function: "has",
+                args: [
+                  {
+                    id: "6",
+                    selectExpr: {
+                      operand: { id: "5", identExpr: { name: "b" } },
+                      field: "c",
+                    },
+                  },
+                ],
+              },
+            },
+            "20": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "2",
+                        mapKey: { id: "3", identExpr: { name: "a" } },
+                        value: {
+                          id: "7",
+                          selectExpr: {
+                            operand: { id: "5", identExpr: { name: "b" } },
+                            field: "c",
+                            testOnly: true,
+                          },
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "9", identExpr: { name: "k" } },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_!=_",
+                      args: [
+                        { id: "10", identExpr: { name: "k" } },
+                        { id: "12", constExpr: { stringValue: "" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 7,
+          function: "has",
+          args: [
+            {
+              id: "6",
+              selectExpr: {
+                operand: { id: "5", identExpr: { name: "b" } },
+                field: "c",
+              },
+            },
+          ],
+        },
+        {
+          id: 20,
+          function: "exists",
+          target: {
+            id: "1",
+            structExpr: {
+              entries: [
+                {
+                  id: "2",
+                  mapKey: { id: "3", identExpr: { name: "a" } },
+                  value: {
+                    id: "7",
+                    selectExpr: {
+                      operand: { id: "5", identExpr: { name: "b" } },
+                      field: "c",
+                      testOnly: true,
+                    },
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "9", identExpr: { name: "k" } },
+            {
+              id: "11",
+              callExpr: {
+                function: "_!=_",
+                args: [
+                  { id: "10", identExpr: { name: "k" } },
+                  { id: "12", constExpr: { stringValue: "" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | {a: has(b.c)}.exists(k, k != \"\")\n | .^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'b' (in container '')\n | {a: has(b.c)}.exists(k, k != \"\")\n | ........^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+      ],
+      expectedUnparsed: '{a: has(b.c)}.exists(k, k != "")',
+    },
+    {
+      original: {
+        name: "comp_nested",
+        expr: '{a: [1, 2].all(i \u003e 0)}.exists(k, k != "")',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '__comprehension__(\n  // Variable\n  k,\n  // Target\n  {\n    a^#*expr.Expr_IdentExpr#:[\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#.all(\n      _\u003e_(\n        i^#*expr.Expr_IdentExpr#,\n        0^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#^#*expr.Expr_CreateStruct_Entry#\n  }^#*expr.Expr_StructExpr#,\n  // Accumulator\n  @result,\n  // Init\n  false^#*expr.Constant_BoolValue#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // LoopStep\n  _||_(\n    @result^#*expr.Expr_IdentExpr#,\n    _!=_(\n      k^#*expr.Expr_IdentExpr#,\n      ""^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#',
+      unparsed: '{a: [1, 2].all(i \u003e 0)}.exists(k, k != "")',
+      locationAst:
+        '__comprehension__(\n  // Variable\n  k,\n  // Target\n  {\n    a^#3[1,1]#:[\n      1^#5[1,5]#,\n      2^#6[1,8]#\n    ]^#4[1,4]#.all(\n      _\u003e_(\n        i^#8[1,15]#,\n        0^#10[1,19]#\n      )^#9[1,17]#\n    )^#7[1,14]#^#2[1,2]#\n  }^#1[1,0]#,\n  // Accumulator\n  @result,\n  // Init\n  false^#16[1,29]#,\n  // LoopCondition\n  @not_strictly_false(\n    !_(\n      @result^#17[1,29]#\n    )^#18[1,29]#\n  )^#19[1,29]#,\n  // LoopStep\n  _||_(\n    @result^#20[1,29]#,\n    _!=_(\n      k^#13[1,33]#,\n      ""^#15[1,38]#\n    )^#14[1,35]#\n  )^#21[1,29]#,\n  // Result\n  @result^#22[1,29]#)^#23[1,29]#',
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 1, 2, 1, 1, 1, 2],
+        [4, 4, 5, 1, 4, 1, 5],
+        [5, 5, 6, 1, 5, 1, 6],
+        [6, 8, 9, 1, 8, 1, 9],
+        [7, 14, 15, 1, 14, 1, 15],
+        [8, 15, 16, 1, 15, 1, 16],
+        [9, 17, 18, 1, 17, 1, 18],
+        [10, 19, 20, 1, 19, 1, 20],
+        [12, 30, 31, 1, 30, 1, 31],
+        [13, 33, 34, 1, 33, 1, 34],
+        [14, 35, 37, 1, 35, 1, 37],
+        [15, 38, 40, 1, 38, 1, 40],
+        [16, 29, 29, 1, 29, 1, 29],
+        [17, 29, 29, 1, 29, 1, 29],
+        [18, 29, 29, 1, 29, 1, 29],
+        [19, 29, 29, 1, 29, 1, 29],
+        [20, 29, 29, 1, 29, 1, 29],
+        [21, 29, 29, 1, 29, 1, 29],
+        [22, 29, 29, 1, 29, 1, 29],
+        [23, 29, 29, 1, 29, 1, 29],
+      ],
+      lineOffsets: [42],
+      parsedExpr: {
+        expr: {
+          id: "23",
+          comprehensionExpr: {
+            iterVar: "k",
+            iterRange: {
+              id: "1",
+              structExpr: {
+                entries: [
+                  {
+                    id: "2",
+                    mapKey: { id: "3", identExpr: { name: "a" } },
+                    value: {
+                      id: "7",
+                      callExpr: {
+                        target: {
+                          id: "4",
+                          listExpr: {
+                            elements: [
+                              { id: "5", constExpr: { int64Value: "1" } },
+                              { id: "6", constExpr: { int64Value: "2" } },
+                            ],
+                          },
+                        },
+                        function: "all",
+                        args: [
+                          {
+                            id: "9",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "8", identExpr: { name: "i" } },
+                                { id: "10", constExpr: { int64Value: "0" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  },
+                ],
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "16", constExpr: { boolValue: false } },
+            loopCondition: {
+              id: "19",
+              callExpr: {
+                function: "@not_strictly_false",
+                args: [
+                  {
+                    id: "18",
+                    callExpr: {
+                      function: "!_",
+                      args: [{ id: "17", identExpr: { name: "@result" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            loopStep: {
+              id: "21",
+              callExpr: {
+                function: "_||_",
+                args: [
+                  { id: "20", identExpr: { name: "@result" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_!=_",
+                      args: [
+                        { id: "13", identExpr: { name: "k" } },
+                        { id: "15", constExpr: { stringValue: "" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            result: { id: "22", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_nested",
+          lineOffsets: [42],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 1,
+            "4": 4,
+            "5": 5,
+            "6": 8,
+            "7": 14,
+            "8": 15,
+            "9": 17,
+            "10": 19,
+            "12": 30,
+            "13": 33,
+            "14": 35,
+            "15": 38,
+            "16": 29,
+            "17": 29,
+            "18": 29,
+            "19": 29,
+            "20": 29,
+            "21": 29,
+            "22": 29,
+            "23": 29,
+          },
+          macroCalls: {
+            "23": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  structExpr: {
+                    entries: [
+                      {
+                        id: "2",
+                        mapKey: { id: "3", identExpr: { name: "a" } },
+                        value: {
+                          id: "7",
+                          callExpr: {
+                            target: {
+                              id: "4",
+                              listExpr: {
+                                elements: [
+                                  { id: "5", constExpr: { int64Value: "1" } },
+                                  { id: "6", constExpr: { int64Value: "2" } },
+                                ],
+                              },
+                            },
+                            function: "all",
+                            args: [
+                              {
+                                id: "9",
+                                callExpr: {
+                                  function: "_\u003e_",
+                                  args: [
+                                    { id: "8", identExpr: { name: "i" } },
+                                    {
+                                      id: "10",
+                                      constExpr: { int64Value: "0" },
+                                    },
+                                  ],
+                                },
+                              },
+                            ],
+                          },
+                        },
+                      },
+                    ],
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "12", identExpr: { name: "k" } },
+                  {
+                    id: "14",
+                    callExpr: {
+                      function: "_!=_",
+                      args: [
+                        { id: "13", identExpr: { name: "k" } },
+                        { id: "15", constExpr: { stringValue: "" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 23,
+          function: "exists",
+          target: {
+            id: "1",
+            structExpr: {
+              entries: [
+                {
+                  id: "2",
+                  mapKey: { id: "3", identExpr: { name: "a" } },
+                  value: {
+                    id: "7",
+                    callExpr: {
+                      target: {
+                        id: "4",
+                        listExpr: {
+                          elements: [
+                            { id: "5", constExpr: { int64Value: "1" } },
+                            { id: "6", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      function: "all",
+                      args: [
+                        {
+                          id: "9",
+                          callExpr: {
+                            function: "_\u003e_",
+                            args: [
+                              { id: "8", identExpr: { name: "i" } },
+                              { id: "10", constExpr: { int64Value: "0" } },
+                            ],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                },
+              ],
+            },
+          },
+          args: [
+            { id: "12", identExpr: { name: "k" } },
+            {
+              id: "14",
+              callExpr: {
+                function: "_!=_",
+                args: [
+                  { id: "13", identExpr: { name: "k" } },
+                  { id: "15", constExpr: { stringValue: "" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        "ERROR: \u003cinput\u003e:1:2: undeclared reference to 'a' (in container '')\n | {a: [1, 2].all(i \u003e 0)}.exists(k, k != \"\")\n | .^\nERROR: \u003cinput\u003e:1:15: undeclared reference to 'all' (in container '')\n | {a: [1, 2].all(i \u003e 0)}.exists(k, k != \"\")\n | ..............^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'i' (in container '')\n | {a: [1, 2].all(i \u003e 0)}.exists(k, k != \"\")\n | ...............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 3,
+          offset: 1,
+          line: 1,
+          column: 1,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'all' (in container '')",
+          id: 7,
+          offset: 14,
+          line: 1,
+          column: 14,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'i' (in container '')",
+          id: 8,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+      ],
+      expectedUnparsed: '{a: [1, 2].all(i \u003e 0)}.exists(k, k != "")',
+    },
+    {
+      original: { name: "call_no_wrap_no_operators", expr: "a + b + c + d" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      ast: "_+_(\n  _+_(\n    _+_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  d^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a + b + c + d",
+      locationAst:
+        "_+_(\n  _+_(\n    _+_(\n      a^#1[1,0]#,\n      b^#3[1,4]#\n    )^#2[1,2]#,\n    c^#5[1,8]#\n  )^#4[1,6]#,\n  d^#7[1,12]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "3", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              { id: "7", identExpr: { name: "d" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_no_wrap_no_operators",
+          lineOffsets: [14],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a + b + c + d\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a + b + c + d\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a + b + c + d\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a + b + c + d\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedUnparsed: "a + b + c + d",
+    },
+    {
+      original: {
+        name: "call_no_wrap_column_limit_large_val",
+        expr: "a + b + c + d",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 1000,
+      wrapOnOperators: ["_+_"],
+      ast: "_+_(\n  _+_(\n    _+_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  d^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a + b + c + d",
+      locationAst:
+        "_+_(\n  _+_(\n    _+_(\n      a^#1[1,0]#,\n      b^#3[1,4]#\n    )^#2[1,2]#,\n    c^#5[1,8]#\n  )^#4[1,6]#,\n  d^#7[1,12]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "3", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              { id: "7", identExpr: { name: "d" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_no_wrap_column_limit_large_val",
+          lineOffsets: [14],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a + b + c + d\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a + b + c + d\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a + b + c + d\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a + b + c + d\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedUnparsed: "a + b + c + d",
+    },
+    {
+      original: {
+        name: "call_no_wrap_column_limit_equal_length_to_input",
+        expr: "a + b + c + d",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 13,
+      wrapOnOperators: ["_+_"],
+      ast: "_+_(\n  _+_(\n    _+_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  d^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a + b + c + d",
+      locationAst:
+        "_+_(\n  _+_(\n    _+_(\n      a^#1[1,0]#,\n      b^#3[1,4]#\n    )^#2[1,2]#,\n    c^#5[1,8]#\n  )^#4[1,6]#,\n  d^#7[1,12]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_+_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "3", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              { id: "7", identExpr: { name: "d" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_no_wrap_column_limit_equal_length_to_input",
+          lineOffsets: [14],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a + b + c + d\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a + b + c + d\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a + b + c + d\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a + b + c + d\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedUnparsed: "a + b + c + d",
+    },
+    {
+      original: { name: "call_wrap_add", expr: "a + b - d * e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_+_"],
+      ast: "_-_(\n  _+_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _*_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a +\nb - d * e",
+      locationAst:
+        "_-_(\n  _+_(\n    a^#1[1,0]#,\n    b^#3[1,4]#\n  )^#2[1,2]#,\n  _*_(\n    d^#5[1,8]#,\n    e^#7[1,12]#\n  )^#6[1,10]#\n)^#4[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_-_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "5", identExpr: { name: "d" } },
+                    { id: "7", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_add",
+          lineOffsets: [14],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a + b - d * e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a + b - d * e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'd' (in container '')\n | a + b - d * e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'e' (in container '')\n | a + b - d * e\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedUnparsed: "a +\nb - d * e",
+    },
+    {
+      original: { name: "call_wrap_add_subtract", expr: "a * b + c - d * e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_+_", "_-_"],
+      ast: "_-_(\n  _+_(\n    _*_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _*_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a * b +\nc -\nd * e",
+      locationAst:
+        "_-_(\n  _+_(\n    _*_(\n      a^#1[1,0]#,\n      b^#3[1,4]#\n    )^#2[1,2]#,\n    c^#5[1,8]#\n  )^#4[1,6]#,\n  _*_(\n    d^#7[1,12]#,\n    e^#9[1,16]#\n  )^#8[1,14]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_-_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_*_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "3", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "7", identExpr: { name: "d" } },
+                    { id: "9", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_add_subtract",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a * b + c - d * e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a * b + c - d * e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a * b + c - d * e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a * b + c - d * e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a * b + c - d * e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a * b +\nc -\nd * e",
+    },
+    {
+      original: { name: "call_wrap_add_subtract", expr: "a * b + c - d * e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_+_", "_-_"],
+      ast: "_-_(\n  _+_(\n    _*_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _*_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a * b +\nc -\nd * e",
+      locationAst:
+        "_-_(\n  _+_(\n    _*_(\n      a^#1[1,0]#,\n      b^#3[1,4]#\n    )^#2[1,2]#,\n    c^#5[1,8]#\n  )^#4[1,6]#,\n  _*_(\n    d^#7[1,12]#,\n    e^#9[1,16]#\n  )^#8[1,14]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_-_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_*_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "3", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "7", identExpr: { name: "d" } },
+                    { id: "9", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_add_subtract",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a * b + c - d * e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a * b + c - d * e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a * b + c - d * e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a * b + c - d * e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a * b + c - d * e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a * b +\nc -\nd * e",
+    },
+    {
+      original: {
+        name: "call_wrap_logical_and",
+        expr: "a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u0026\u0026_"],
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u0026\u0026_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "a \u0026\u0026\nb \u0026\u0026\nc \u0026\u0026\nd \u0026\u0026\ne",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    _\u0026\u0026_(\n      a^#1[1,0]#,\n      b^#2[1,5]#\n    )^#3[1,2]#,\n    c^#4[1,10]#\n  )^#5[1,7]#,\n  _\u0026\u0026_(\n    d^#6[1,15]#,\n    e^#8[1,20]#\n  )^#9[1,17]#\n)^#7[1,12]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "2", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "4", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "6", identExpr: { name: "d" } },
+                    { id: "8", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_logical_and",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+            "8": 20,
+            "9": 17,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a \u0026\u0026 b \u0026\u0026 c \u0026\u0026 d \u0026\u0026 e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 8,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed:
+        "a \u0026\u0026\nb \u0026\u0026\nc \u0026\u0026\nd \u0026\u0026\ne",
+    },
+    {
+      original: { name: "call_wrap_logical_and_2", expr: "a \u0026\u0026 b" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u0026\u0026_"],
+      ast: "_\u0026\u0026_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u0026\u0026\nb",
+      locationAst: "_\u0026\u0026_(\n  a^#1[1,0]#,\n  b^#2[1,5]#\n)^#3[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+      ],
+      lineOffsets: [7],
+      parsedExpr: {
+        expr: {
+          id: "3",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "2", identExpr: { name: "b" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_logical_and_2",
+          lineOffsets: [7],
+          positions: { "1": 0, "2": 5, "3": 2 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u0026\u0026 b\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u0026\u0026 b\n | .....^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+      ],
+      expectedUnparsed: "a \u0026\u0026\nb",
+    },
+    {
+      original: { name: "call_wrap_conditional", expr: "a ? b : c ? d : e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_?_:_"],
+      ast: "_?_:_(\n  a^#*expr.Expr_IdentExpr#,\n  b^#*expr.Expr_IdentExpr#,\n  _?_:_(\n    c^#*expr.Expr_IdentExpr#,\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ?\nb : (c ?\nd : e)",
+      locationAst:
+        "_?_:_(\n  a^#1[1,0]#,\n  b^#3[1,4]#,\n  _?_:_(\n    c^#4[1,8]#,\n    d^#6[1,12]#,\n    e^#7[1,16]#\n  )^#5[1,10]#\n)^#2[1,2]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 8, 9, 1, 8, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 13, 1, 12, 1, 13],
+        [7, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "2",
+          callExpr: {
+            function: "_?_:_",
+            args: [
+              { id: "1", identExpr: { name: "a" } },
+              { id: "3", identExpr: { name: "b" } },
+              {
+                id: "5",
+                callExpr: {
+                  function: "_?_:_",
+                  args: [
+                    { id: "4", identExpr: { name: "c" } },
+                    { id: "6", identExpr: { name: "d" } },
+                    { id: "7", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_conditional",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 8,
+            "5": 10,
+            "6": 12,
+            "7": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a ? b : c ? d : e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a ? b : c ? d : e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a ? b : c ? d : e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a ? b : c ? d : e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a ? b : c ? d : e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 7,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a ?\nb : (c ?\nd : e)",
+    },
+    {
+      original: { name: "call_wrap_or", expr: "a || b || c || d || e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_||_"],
+      ast: "_||_(\n  _||_(\n    _||_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _||_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ||\nb ||\nc ||\nd ||\ne",
+      locationAst:
+        "_||_(\n  _||_(\n    _||_(\n      a^#1[1,0]#,\n      b^#2[1,5]#\n    )^#3[1,2]#,\n    c^#4[1,10]#\n  )^#5[1,7]#,\n  _||_(\n    d^#6[1,15]#,\n    e^#8[1,20]#\n  )^#9[1,17]#\n)^#7[1,12]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 5, 6, 1, 5, 1, 6],
+        [3, 2, 4, 1, 2, 1, 4],
+        [4, 10, 11, 1, 10, 1, 11],
+        [5, 7, 9, 1, 7, 1, 9],
+        [6, 15, 16, 1, 15, 1, 16],
+        [7, 12, 14, 1, 12, 1, 14],
+        [8, 20, 21, 1, 20, 1, 21],
+        [9, 17, 19, 1, 17, 1, 19],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "7",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "5",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "3",
+                      callExpr: {
+                        function: "_||_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "2", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "4", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "9",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    { id: "6", identExpr: { name: "d" } },
+                    { id: "8", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_or",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 5,
+            "3": 2,
+            "4": 10,
+            "5": 7,
+            "6": 15,
+            "7": 12,
+            "8": 20,
+            "9": 17,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a || b || c || d || e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a || b || c || d || e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a || b || c || d || e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a || b || c || d || e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a || b || c || d || e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 2,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 4,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 6,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 8,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed: "a ||\nb ||\nc ||\nd ||\ne",
+    },
+    {
+      original: { name: "call_wrap_equals", expr: "a == b == c == d == e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_==_"],
+      ast: "_==_(\n  _==_(\n    _==_(\n      _==_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a ==\nb ==\nc ==\nd ==\ne",
+      locationAst:
+        "_==_(\n  _==_(\n    _==_(\n      _==_(\n        a^#1[1,0]#,\n        b^#3[1,5]#\n      )^#2[1,2]#,\n      c^#5[1,10]#\n    )^#4[1,7]#,\n    d^#7[1,15]#\n  )^#6[1,12]#,\n  e^#9[1,20]#\n)^#8[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 14, 1, 12, 1, 14],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_==_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_==_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_equals",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 7,
+            "5": 10,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a == b == c == d == e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a == b == c == d == e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a == b == c == d == e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a == b == c == d == e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a == b == c == d == e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed: "a ==\nb ==\nc ==\nd ==\ne",
+    },
+    {
+      original: {
+        name: "call_wrap_greater",
+        expr: "a \u003e b \u003e c \u003e d \u003e e",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u003e_"],
+      ast: "_\u003e_(\n  _\u003e_(\n    _\u003e_(\n      _\u003e_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003e\nb \u003e\nc \u003e\nd \u003e\ne",
+      locationAst:
+        "_\u003e_(\n  _\u003e_(\n    _\u003e_(\n      _\u003e_(\n        a^#1[1,0]#,\n        b^#3[1,4]#\n      )^#2[1,2]#,\n      c^#5[1,8]#\n    )^#4[1,6]#,\n    d^#7[1,12]#\n  )^#6[1,10]#,\n  e^#9[1,16]#\n)^#8[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_\u003e_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u003e_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003e_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_greater",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003e b \u003e c \u003e d \u003e e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a \u003e b \u003e c \u003e d \u003e e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a \u003e b \u003e c \u003e d \u003e e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a \u003e b \u003e c \u003e d \u003e e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a \u003e b \u003e c \u003e d \u003e e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a \u003e\nb \u003e\nc \u003e\nd \u003e\ne",
+    },
+    {
+      original: {
+        name: "call_wrap_greater_equals",
+        expr: "a \u003e= b \u003e= c \u003e= d \u003e= e",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u003e=_"],
+      ast: "_\u003e=_(\n  _\u003e=_(\n    _\u003e=_(\n      _\u003e=_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003e=\nb \u003e=\nc \u003e=\nd \u003e=\ne",
+      locationAst:
+        "_\u003e=_(\n  _\u003e=_(\n    _\u003e=_(\n      _\u003e=_(\n        a^#1[1,0]#,\n        b^#3[1,5]#\n      )^#2[1,2]#,\n      c^#5[1,10]#\n    )^#4[1,7]#,\n    d^#7[1,15]#\n  )^#6[1,12]#,\n  e^#9[1,20]#\n)^#8[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 14, 1, 12, 1, 14],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_\u003e=_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_\u003e=_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u003e=_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003e=_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_greater_equals",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 7,
+            "5": 10,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003e= b \u003e= c \u003e= d \u003e= e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u003e= b \u003e= c \u003e= d \u003e= e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a \u003e= b \u003e= c \u003e= d \u003e= e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a \u003e= b \u003e= c \u003e= d \u003e= e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a \u003e= b \u003e= c \u003e= d \u003e= e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed: "a \u003e=\nb \u003e=\nc \u003e=\nd \u003e=\ne",
+    },
+    {
+      original: { name: "call_wrap_in", expr: "a in b in c in d in e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["@in"],
+      ast: "@in(\n  @in(\n    @in(\n      @in(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a in\nb in\nc in\nd in\ne",
+      locationAst:
+        "@in(\n  @in(\n    @in(\n      @in(\n        a^#1[1,0]#,\n        b^#3[1,5]#\n      )^#2[1,2]#,\n      c^#5[1,10]#\n    )^#4[1,7]#,\n    d^#7[1,15]#\n  )^#6[1,12]#,\n  e^#9[1,20]#\n)^#8[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 14, 1, 12, 1, 14],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "@in",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "@in",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "@in",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_in",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 7,
+            "5": 10,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a in b in c in d in e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a in b in c in d in e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a in b in c in d in e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a in b in c in d in e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a in b in c in d in e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed: "a in\nb in\nc in\nd in\ne",
+    },
+    {
+      original: {
+        name: "call_wrap_less",
+        expr: "a \u003c b \u003c c \u003c d \u003c e",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u003c_"],
+      ast: "_\u003c_(\n  _\u003c_(\n    _\u003c_(\n      _\u003c_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003c\nb \u003c\nc \u003c\nd \u003c\ne",
+      locationAst:
+        "_\u003c_(\n  _\u003c_(\n    _\u003c_(\n      _\u003c_(\n        a^#1[1,0]#,\n        b^#3[1,4]#\n      )^#2[1,2]#,\n      c^#5[1,8]#\n    )^#4[1,6]#,\n    d^#7[1,12]#\n  )^#6[1,10]#,\n  e^#9[1,16]#\n)^#8[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_\u003c_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_\u003c_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u003c_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003c_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_less",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003c b \u003c c \u003c d \u003c e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a \u003c b \u003c c \u003c d \u003c e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a \u003c b \u003c c \u003c d \u003c e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a \u003c b \u003c c \u003c d \u003c e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a \u003c b \u003c c \u003c d \u003c e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a \u003c\nb \u003c\nc \u003c\nd \u003c\ne",
+    },
+    {
+      original: {
+        name: "call_wrap_less_equals",
+        expr: "a \u003c= b \u003c= c \u003c= d \u003c= e",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u003c=_"],
+      ast: "_\u003c=_(\n  _\u003c=_(\n    _\u003c=_(\n      _\u003c=_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a \u003c=\nb \u003c=\nc \u003c=\nd \u003c=\ne",
+      locationAst:
+        "_\u003c=_(\n  _\u003c=_(\n    _\u003c=_(\n      _\u003c=_(\n        a^#1[1,0]#,\n        b^#3[1,5]#\n      )^#2[1,2]#,\n      c^#5[1,10]#\n    )^#4[1,7]#,\n    d^#7[1,15]#\n  )^#6[1,12]#,\n  e^#9[1,20]#\n)^#8[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 14, 1, 12, 1, 14],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_\u003c=_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_\u003c=_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_\u003c=_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_\u003c=_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_less_equals",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 7,
+            "5": 10,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a \u003c= b \u003c= c \u003c= d \u003c= e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a \u003c= b \u003c= c \u003c= d \u003c= e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a \u003c= b \u003c= c \u003c= d \u003c= e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a \u003c= b \u003c= c \u003c= d \u003c= e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a \u003c= b \u003c= c \u003c= d \u003c= e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed: "a \u003c=\nb \u003c=\nc \u003c=\nd \u003c=\ne",
+    },
+    {
+      original: { name: "call_wrap_not_equals", expr: "a != b != c != d != e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_!=_"],
+      ast: "_!=_(\n  _!=_(\n    _!=_(\n      _!=_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a !=\nb !=\nc !=\nd !=\ne",
+      locationAst:
+        "_!=_(\n  _!=_(\n    _!=_(\n      _!=_(\n        a^#1[1,0]#,\n        b^#3[1,5]#\n      )^#2[1,2]#,\n      c^#5[1,10]#\n    )^#4[1,7]#,\n    d^#7[1,15]#\n  )^#6[1,12]#,\n  e^#9[1,20]#\n)^#8[1,17]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 4, 1, 2, 1, 4],
+        [3, 5, 6, 1, 5, 1, 6],
+        [4, 7, 9, 1, 7, 1, 9],
+        [5, 10, 11, 1, 10, 1, 11],
+        [6, 12, 14, 1, 12, 1, 14],
+        [7, 15, 16, 1, 15, 1, 16],
+        [8, 17, 19, 1, 17, 1, 19],
+        [9, 20, 21, 1, 20, 1, 21],
+      ],
+      lineOffsets: [22],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_!=_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_!=_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_!=_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_!=_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_not_equals",
+          lineOffsets: [22],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 5,
+            "4": 7,
+            "5": 10,
+            "6": 12,
+            "7": 15,
+            "8": 17,
+            "9": 20,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a != b != c != d != e\n | ^\nERROR: \u003cinput\u003e:1:6: undeclared reference to 'b' (in container '')\n | a != b != c != d != e\n | .....^\nERROR: \u003cinput\u003e:1:11: undeclared reference to 'c' (in container '')\n | a != b != c != d != e\n | ..........^\nERROR: \u003cinput\u003e:1:16: undeclared reference to 'd' (in container '')\n | a != b != c != d != e\n | ...............^\nERROR: \u003cinput\u003e:1:21: undeclared reference to 'e' (in container '')\n | a != b != c != d != e\n | ....................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 5,
+          line: 1,
+          column: 5,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 10,
+          line: 1,
+          column: 10,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 15,
+          line: 1,
+          column: 15,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 20,
+          line: 1,
+          column: 20,
+        },
+      ],
+      expectedUnparsed: "a !=\nb !=\nc !=\nd !=\ne",
+    },
+    {
+      original: { name: "call_wrap_divide", expr: "a / b / c / d / e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_/_"],
+      ast: "_/_(\n  _/_(\n    _/_(\n      _/_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a /\nb /\nc /\nd /\ne",
+      locationAst:
+        "_/_(\n  _/_(\n    _/_(\n      _/_(\n        a^#1[1,0]#,\n        b^#3[1,4]#\n      )^#2[1,2]#,\n      c^#5[1,8]#\n    )^#4[1,6]#,\n    d^#7[1,12]#\n  )^#6[1,10]#,\n  e^#9[1,16]#\n)^#8[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_/_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_/_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_/_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_/_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_divide",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a / b / c / d / e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a / b / c / d / e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a / b / c / d / e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a / b / c / d / e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a / b / c / d / e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a /\nb /\nc /\nd /\ne",
+    },
+    {
+      original: { name: "call_wrap_modulo", expr: "a % b % c % d % e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_%_"],
+      ast: "_%_(\n  _%_(\n    _%_(\n      _%_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a %\nb %\nc %\nd %\ne",
+      locationAst:
+        "_%_(\n  _%_(\n    _%_(\n      _%_(\n        a^#1[1,0]#,\n        b^#3[1,4]#\n      )^#2[1,2]#,\n      c^#5[1,8]#\n    )^#4[1,6]#,\n    d^#7[1,12]#\n  )^#6[1,10]#,\n  e^#9[1,16]#\n)^#8[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_%_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_%_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_%_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_%_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_modulo",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a % b % c % d % e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a % b % c % d % e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a % b % c % d % e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a % b % c % d % e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a % b % c % d % e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a %\nb %\nc %\nd %\ne",
+    },
+    {
+      original: { name: "call_wrap_multiply", expr: "a * b * c * d * e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_*_"],
+      ast: "_*_(\n  _*_(\n    _*_(\n      _*_(\n        a^#*expr.Expr_IdentExpr#,\n        b^#*expr.Expr_IdentExpr#\n      )^#*expr.Expr_CallExpr#,\n      c^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    d^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  e^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a *\nb *\nc *\nd *\ne",
+      locationAst:
+        "_*_(\n  _*_(\n    _*_(\n      _*_(\n        a^#1[1,0]#,\n        b^#3[1,4]#\n      )^#2[1,2]#,\n      c^#5[1,8]#\n    )^#4[1,6]#,\n    d^#7[1,12]#\n  )^#6[1,10]#,\n  e^#9[1,16]#\n)^#8[1,14]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "8",
+          callExpr: {
+            function: "_*_",
+            args: [
+              {
+                id: "6",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    {
+                      id: "4",
+                      callExpr: {
+                        function: "_*_",
+                        args: [
+                          {
+                            id: "2",
+                            callExpr: {
+                              function: "_*_",
+                              args: [
+                                { id: "1", identExpr: { name: "a" } },
+                                { id: "3", identExpr: { name: "b" } },
+                              ],
+                            },
+                          },
+                          { id: "5", identExpr: { name: "c" } },
+                        ],
+                      },
+                    },
+                    { id: "7", identExpr: { name: "d" } },
+                  ],
+                },
+              },
+              { id: "9", identExpr: { name: "e" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_multiply",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a * b * c * d * e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a * b * c * d * e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a * b * c * d * e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a * b * c * d * e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a * b * c * d * e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a *\nb *\nc *\nd *\ne",
+    },
+    {
+      original: {
+        name: "call_wrap_logical_and_long_variables",
+        expr: "longVariableA \u0026\u0026 longVariableB \u0026\u0026 longVariableC",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u0026\u0026_"],
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    longVariableA^#*expr.Expr_IdentExpr#,\n    longVariableB^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  longVariableC^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "longVariableA \u0026\u0026\nlongVariableB \u0026\u0026\nlongVariableC",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    longVariableA^#1[1,0]#,\n    longVariableB^#2[1,17]#\n  )^#3[1,14]#,\n  longVariableC^#4[1,34]#\n)^#5[1,31]#",
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 17, 30, 1, 17, 1, 30],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 34, 47, 1, 34, 1, 47],
+        [5, 31, 33, 1, 31, 1, 33],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "longVariableA" } },
+                    { id: "2", identExpr: { name: "longVariableB" } },
+                  ],
+                },
+              },
+              { id: "4", identExpr: { name: "longVariableC" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_logical_and_long_variables",
+          lineOffsets: [48],
+          positions: { "1": 0, "2": 17, "3": 14, "4": 34, "5": 31 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'longVariableA' (in container '')\n | longVariableA \u0026\u0026 longVariableB \u0026\u0026 longVariableC\n | ^\nERROR: \u003cinput\u003e:1:18: undeclared reference to 'longVariableB' (in container '')\n | longVariableA \u0026\u0026 longVariableB \u0026\u0026 longVariableC\n | .................^\nERROR: \u003cinput\u003e:1:35: undeclared reference to 'longVariableC' (in container '')\n | longVariableA \u0026\u0026 longVariableB \u0026\u0026 longVariableC\n | ..................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableA' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableB' (in container '')",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableC' (in container '')",
+          id: 4,
+          offset: 34,
+          line: 1,
+          column: 34,
+        },
+      ],
+      expectedUnparsed:
+        "longVariableA \u0026\u0026\nlongVariableB \u0026\u0026\nlongVariableC",
+    },
+    {
+      original: {
+        name: "comp_chained_wrap_comparisons",
+        expr: "[1, 2, 3].map(x, x \u003e= 2, x * 4).filter(x, x \u003c= 10)",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u003e=_", "_\u003c=_"],
+      ast: "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#*expr.Constant_Int64Value#,\n      2^#*expr.Constant_Int64Value#,\n      3^#*expr.Constant_Int64Value#\n    ]^#*expr.Expr_ListExpr#,\n    // Accumulator\n    @result,\n    // Init\n    []^#*expr.Expr_ListExpr#,\n    // LoopCondition\n    true^#*expr.Constant_BoolValue#,\n    // LoopStep\n    _?_:_(\n      _\u003e=_(\n        x^#*expr.Expr_IdentExpr#,\n        2^#*expr.Constant_Int64Value#\n      )^#*expr.Expr_CallExpr#,\n      _+_(\n        @result^#*expr.Expr_IdentExpr#,\n        [\n          _*_(\n            x^#*expr.Expr_IdentExpr#,\n            4^#*expr.Constant_Int64Value#\n          )^#*expr.Expr_CallExpr#\n        ]^#*expr.Expr_ListExpr#\n      )^#*expr.Expr_CallExpr#,\n      @result^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    // Result\n    @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n  // Accumulator\n  @result,\n  // Init\n  []^#*expr.Expr_ListExpr#,\n  // LoopCondition\n  true^#*expr.Constant_BoolValue#,\n  // LoopStep\n  _?_:_(\n    _\u003c=_(\n      x^#*expr.Expr_IdentExpr#,\n      10^#*expr.Constant_Int64Value#\n    )^#*expr.Expr_CallExpr#,\n    _+_(\n      @result^#*expr.Expr_IdentExpr#,\n      [\n        x^#*expr.Expr_IdentExpr#\n      ]^#*expr.Expr_ListExpr#\n    )^#*expr.Expr_CallExpr#,\n    @result^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  // Result\n  @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#",
+      unparsed:
+        "[1, 2, 3].map(x, x \u003e=\n2, x * 4).filter(x, x \u003c=\n10)",
+      locationAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1^#2[1,1]#,\n      2^#3[1,4]#,\n      3^#4[1,7]#\n    ]^#1[1,0]#,\n    // Accumulator\n    @result,\n    // Init\n    []^#13[1,13]#,\n    // LoopCondition\n    true^#14[1,13]#,\n    // LoopStep\n    _?_:_(\n      _\u003e=_(\n        x^#7[1,17]#,\n        2^#9[1,22]#\n      )^#8[1,19]#,\n      _+_(\n        @result^#15[1,13]#,\n        [\n          _*_(\n            x^#10[1,25]#,\n            4^#12[1,29]#\n          )^#11[1,27]#\n        ]^#16[1,13]#\n      )^#17[1,13]#,\n      @result^#18[1,13]#\n    )^#19[1,13]#,\n    // Result\n    @result^#20[1,13]#)^#21[1,13]#,\n  // Accumulator\n  @result,\n  // Init\n  []^#27[1,38]#,\n  // LoopCondition\n  true^#28[1,38]#,\n  // LoopStep\n  _?_:_(\n    _\u003c=_(\n      x^#24[1,42]#,\n      10^#26[1,47]#\n    )^#25[1,44]#,\n    _+_(\n      @result^#29[1,38]#,\n      [\n        x^#23[1,39]#\n      ]^#30[1,38]#\n    )^#31[1,38]#,\n    @result^#32[1,38]#\n  )^#33[1,38]#,\n  // Result\n  @result^#34[1,38]#)^#35[1,38]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 1, 2, 1, 1, 1, 2],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 7, 8, 1, 7, 1, 8],
+        [6, 14, 15, 1, 14, 1, 15],
+        [7, 17, 18, 1, 17, 1, 18],
+        [8, 19, 21, 1, 19, 1, 21],
+        [9, 22, 23, 1, 22, 1, 23],
+        [10, 25, 26, 1, 25, 1, 26],
+        [11, 27, 28, 1, 27, 1, 28],
+        [12, 29, 30, 1, 29, 1, 30],
+        [13, 13, 13, 1, 13, 1, 13],
+        [14, 13, 13, 1, 13, 1, 13],
+        [15, 13, 13, 1, 13, 1, 13],
+        [16, 13, 13, 1, 13, 1, 13],
+        [17, 13, 13, 1, 13, 1, 13],
+        [18, 13, 13, 1, 13, 1, 13],
+        [19, 13, 13, 1, 13, 1, 13],
+        [20, 13, 13, 1, 13, 1, 13],
+        [21, 13, 13, 1, 13, 1, 13],
+        [23, 39, 40, 1, 39, 1, 40],
+        [24, 42, 43, 1, 42, 1, 43],
+        [25, 44, 46, 1, 44, 1, 46],
+        [26, 47, 49, 1, 47, 1, 49],
+        [27, 38, 38, 1, 38, 1, 38],
+        [28, 38, 38, 1, 38, 1, 38],
+        [29, 38, 38, 1, 38, 1, 38],
+        [30, 38, 38, 1, 38, 1, 38],
+        [31, 38, 38, 1, 38, 1, 38],
+        [32, 38, 38, 1, 38, 1, 38],
+        [33, 38, 38, 1, 38, 1, 38],
+        [34, 38, 38, 1, 38, 1, 38],
+        [35, 38, 38, 1, 38, 1, 38],
+      ],
+      lineOffsets: [51],
+      parsedExpr: {
+        expr: {
+          id: "35",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "21",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "13", listExpr: {} },
+                loopCondition: { id: "14", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "19",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "8",
+                        callExpr: {
+                          function: "_\u003e=_",
+                          args: [
+                            { id: "7", identExpr: { name: "x" } },
+                            { id: "9", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "17",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "15", identExpr: { name: "@result" } },
+                            {
+                              id: "16",
+                              listExpr: {
+                                elements: [
+                                  {
+                                    id: "11",
+                                    callExpr: {
+                                      function: "_*_",
+                                      args: [
+                                        { id: "10", identExpr: { name: "x" } },
+                                        {
+                                          id: "12",
+                                          constExpr: { int64Value: "4" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "18", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "20", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "27", listExpr: {} },
+            loopCondition: { id: "28", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "33",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "24", identExpr: { name: "x" } },
+                        { id: "26", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "31",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "29", identExpr: { name: "@result" } },
+                        {
+                          id: "30",
+                          listExpr: {
+                            elements: [{ id: "23", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "32", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "34", identExpr: { name: "@result" } },
+          },
+        },
+        sourceInfo: {
+          location: "comp_chained_wrap_comparisons",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 27,
+            "12": 29,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "17": 13,
+            "18": 13,
+            "19": 13,
+            "20": 13,
+            "21": 13,
+            "23": 39,
+            "24": 42,
+            "25": 44,
+            "26": 47,
+            "27": 38,
+            "28": 38,
+            "29": 38,
+            "30": 38,
+            "31": 38,
+            "32": 38,
+            "33": 38,
+            "34": 38,
+            "35": 38,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "12", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "35": {
+              callExpr: {
+                target: { id: "21" },
+                function: "filter",
+                args: [
+                  { id: "23", identExpr: { name: "x" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "24", identExpr: { name: "x" } },
+                        { id: "26", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 21,
+          function: "map",
+          target: {
+            id: "1",
+            listExpr: {
+              elements: [
+                { id: "2", constExpr: { int64Value: "1" } },
+                { id: "3", constExpr: { int64Value: "2" } },
+                { id: "4", constExpr: { int64Value: "3" } },
+              ],
+            },
+          },
+          args: [
+            { id: "6", identExpr: { name: "x" } },
+            {
+              id: "8",
+              callExpr: {
+                function: "_\u003e=_",
+                args: [
+                  { id: "7", identExpr: { name: "x" } },
+                  { id: "9", constExpr: { int64Value: "2" } },
+                ],
+              },
+            },
+            {
+              id: "11",
+              callExpr: {
+                function: "_*_",
+                args: [
+                  { id: "10", identExpr: { name: "x" } },
+                  { id: "12", constExpr: { int64Value: "4" } },
+                ],
+              },
+            },
+          ],
+        },
+        {
+          id: 35,
+          function: "filter",
+          target: { id: "21" },
+          args: [
+            { id: "23", identExpr: { name: "x" } },
+            {
+              id: "25",
+              callExpr: {
+                function: "_\u003c=_",
+                args: [
+                  { id: "24", identExpr: { name: "x" } },
+                  { id: "26", constExpr: { int64Value: "10" } },
+                ],
+              },
+            },
+          ],
+        },
+      ],
+      checkedAst:
+        "__comprehension__(\n  // Variable\n  x,\n  // Target\n  __comprehension__(\n    // Variable\n    x,\n    // Target\n    [\n      1~int,\n      2~int,\n      3~int\n    ]~list(int),\n    // Accumulator\n    @result,\n    // Init\n    []~list(int),\n    // LoopCondition\n    true~bool,\n    // LoopStep\n    _?_:_(\n      _\u003e=_(\n        x~int^x,\n        2~int\n      )~bool^greater_equals_int64,\n      _+_(\n        @result~list(int)^@result,\n        [\n          _*_(\n            x~int^x,\n            4~int\n          )~int^multiply_int64\n        ]~list(int)\n      )~list(int)^add_list,\n      @result~list(int)^@result\n    )~list(int)^conditional,\n    // Result\n    @result~list(int)^@result)~list(int),\n  // Accumulator\n  @result,\n  // Init\n  []~list(int),\n  // LoopCondition\n  true~bool,\n  // LoopStep\n  _?_:_(\n    _\u003c=_(\n      x~int^x,\n      10~int\n    )~bool^less_equals_int64,\n    _+_(\n      @result~list(int)^@result,\n      [\n        x~int^x\n      ]~list(int)\n    )~list(int)^add_list,\n    @result~list(int)^@result\n  )~list(int)^conditional,\n  // Result\n  @result~list(int)^@result)~list(int)",
+      checkedExpr: {
+        referenceMap: {
+          "7": { name: "x" },
+          "8": { overloadId: ["greater_equals_int64"] },
+          "10": { name: "x" },
+          "11": { overloadId: ["multiply_int64"] },
+          "15": { name: "@result" },
+          "17": { overloadId: ["add_list"] },
+          "18": { name: "@result" },
+          "19": { overloadId: ["conditional"] },
+          "20": { name: "@result" },
+          "23": { name: "x" },
+          "24": { name: "x" },
+          "25": { overloadId: ["less_equals_int64"] },
+          "29": { name: "@result" },
+          "31": { overloadId: ["add_list"] },
+          "32": { name: "@result" },
+          "33": { overloadId: ["conditional"] },
+          "34": { name: "@result" },
+        },
+        typeMap: {
+          "1": { listType: { elemType: { primitive: "INT64" } } },
+          "2": { primitive: "INT64" },
+          "3": { primitive: "INT64" },
+          "4": { primitive: "INT64" },
+          "7": { primitive: "INT64" },
+          "8": { primitive: "BOOL" },
+          "9": { primitive: "INT64" },
+          "10": { primitive: "INT64" },
+          "11": { primitive: "INT64" },
+          "12": { primitive: "INT64" },
+          "13": { listType: { elemType: { primitive: "INT64" } } },
+          "14": { primitive: "BOOL" },
+          "15": { listType: { elemType: { primitive: "INT64" } } },
+          "16": { listType: { elemType: { primitive: "INT64" } } },
+          "17": { listType: { elemType: { primitive: "INT64" } } },
+          "18": { listType: { elemType: { primitive: "INT64" } } },
+          "19": { listType: { elemType: { primitive: "INT64" } } },
+          "20": { listType: { elemType: { primitive: "INT64" } } },
+          "21": { listType: { elemType: { primitive: "INT64" } } },
+          "23": { primitive: "INT64" },
+          "24": { primitive: "INT64" },
+          "25": { primitive: "BOOL" },
+          "26": { primitive: "INT64" },
+          "27": { listType: { elemType: { primitive: "INT64" } } },
+          "28": { primitive: "BOOL" },
+          "29": { listType: { elemType: { primitive: "INT64" } } },
+          "30": { listType: { elemType: { primitive: "INT64" } } },
+          "31": { listType: { elemType: { primitive: "INT64" } } },
+          "32": { listType: { elemType: { primitive: "INT64" } } },
+          "33": { listType: { elemType: { primitive: "INT64" } } },
+          "34": { listType: { elemType: { primitive: "INT64" } } },
+          "35": { listType: { elemType: { primitive: "INT64" } } },
+        },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [51],
+          positions: {
+            "1": 0,
+            "2": 1,
+            "3": 4,
+            "4": 7,
+            "6": 14,
+            "7": 17,
+            "8": 19,
+            "9": 22,
+            "10": 25,
+            "11": 27,
+            "12": 29,
+            "13": 13,
+            "14": 13,
+            "15": 13,
+            "16": 13,
+            "17": 13,
+            "18": 13,
+            "19": 13,
+            "20": 13,
+            "21": 13,
+            "23": 39,
+            "24": 42,
+            "25": 44,
+            "26": 47,
+            "27": 38,
+            "28": 38,
+            "29": 38,
+            "30": 38,
+            "31": 38,
+            "32": 38,
+            "33": 38,
+            "34": 38,
+            "35": 38,
+          },
+          macroCalls: {
+            "21": {
+              callExpr: {
+                target: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                function: "map",
+                args: [
+                  { id: "6", identExpr: { name: "x" } },
+                  {
+                    id: "8",
+                    callExpr: {
+                      function: "_\u003e=_",
+                      args: [
+                        { id: "7", identExpr: { name: "x" } },
+                        { id: "9", constExpr: { int64Value: "2" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "11",
+                    callExpr: {
+                      function: "_*_",
+                      args: [
+                        { id: "10", identExpr: { name: "x" } },
+                        { id: "12", constExpr: { int64Value: "4" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "35": {
+              callExpr: {
+                target: { id: "21" },
+                function: "filter",
+                args: [
+                  { id: "23", identExpr: { name: "x" } },
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "24", identExpr: { name: "x" } },
+                        { id: "26", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+        expr: {
+          id: "35",
+          comprehensionExpr: {
+            iterVar: "x",
+            iterRange: {
+              id: "21",
+              comprehensionExpr: {
+                iterVar: "x",
+                iterRange: {
+                  id: "1",
+                  listExpr: {
+                    elements: [
+                      { id: "2", constExpr: { int64Value: "1" } },
+                      { id: "3", constExpr: { int64Value: "2" } },
+                      { id: "4", constExpr: { int64Value: "3" } },
+                    ],
+                  },
+                },
+                accuVar: "@result",
+                accuInit: { id: "13", listExpr: {} },
+                loopCondition: { id: "14", constExpr: { boolValue: true } },
+                loopStep: {
+                  id: "19",
+                  callExpr: {
+                    function: "_?_:_",
+                    args: [
+                      {
+                        id: "8",
+                        callExpr: {
+                          function: "_\u003e=_",
+                          args: [
+                            { id: "7", identExpr: { name: "x" } },
+                            { id: "9", constExpr: { int64Value: "2" } },
+                          ],
+                        },
+                      },
+                      {
+                        id: "17",
+                        callExpr: {
+                          function: "_+_",
+                          args: [
+                            { id: "15", identExpr: { name: "@result" } },
+                            {
+                              id: "16",
+                              listExpr: {
+                                elements: [
+                                  {
+                                    id: "11",
+                                    callExpr: {
+                                      function: "_*_",
+                                      args: [
+                                        { id: "10", identExpr: { name: "x" } },
+                                        {
+                                          id: "12",
+                                          constExpr: { int64Value: "4" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                ],
+                              },
+                            },
+                          ],
+                        },
+                      },
+                      { id: "18", identExpr: { name: "@result" } },
+                    ],
+                  },
+                },
+                result: { id: "20", identExpr: { name: "@result" } },
+              },
+            },
+            accuVar: "@result",
+            accuInit: { id: "27", listExpr: {} },
+            loopCondition: { id: "28", constExpr: { boolValue: true } },
+            loopStep: {
+              id: "33",
+              callExpr: {
+                function: "_?_:_",
+                args: [
+                  {
+                    id: "25",
+                    callExpr: {
+                      function: "_\u003c=_",
+                      args: [
+                        { id: "24", identExpr: { name: "x" } },
+                        { id: "26", constExpr: { int64Value: "10" } },
+                      ],
+                    },
+                  },
+                  {
+                    id: "31",
+                    callExpr: {
+                      function: "_+_",
+                      args: [
+                        { id: "29", identExpr: { name: "@result" } },
+                        {
+                          id: "30",
+                          listExpr: {
+                            elements: [{ id: "23", identExpr: { name: "x" } }],
+                          },
+                        },
+                      ],
+                    },
+                  },
+                  { id: "32", identExpr: { name: "@result" } },
+                ],
+              },
+            },
+            result: { id: "34", identExpr: { name: "@result" } },
+          },
+        },
+      },
+      type: "list(int)",
+      cost: { min: "50", max: "125" },
+      result: { value: { listValue: { values: [{ int64Value: "8" }] } } },
+      runtimeCost: "83",
+      expectedUnparsed:
+        "[1, 2, 3].map(x, x \u003e=\n2, x * 4).filter(x, x \u003c=\n10)",
+    },
+    {
+      original: { name: "call_wrap_before_add", expr: "a + b - d * e" },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_+_"],
+      wrapAfterColumnLimit: false,
+      ast: "_-_(\n  _+_(\n    a^#*expr.Expr_IdentExpr#,\n    b^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _*_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a\n+ b - d * e",
+      locationAst:
+        "_-_(\n  _+_(\n    a^#1[1,0]#,\n    b^#3[1,4]#\n  )^#2[1,2]#,\n  _*_(\n    d^#5[1,8]#,\n    e^#7[1,12]#\n  )^#6[1,10]#\n)^#4[1,6]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+      ],
+      lineOffsets: [14],
+      parsedExpr: {
+        expr: {
+          id: "4",
+          callExpr: {
+            function: "_-_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    { id: "1", identExpr: { name: "a" } },
+                    { id: "3", identExpr: { name: "b" } },
+                  ],
+                },
+              },
+              {
+                id: "6",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "5", identExpr: { name: "d" } },
+                    { id: "7", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_before_add",
+          lineOffsets: [14],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a + b - d * e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a + b - d * e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'd' (in container '')\n | a + b - d * e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'e' (in container '')\n | a + b - d * e\n | ............^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+      ],
+      expectedUnparsed: "a\n+ b - d * e",
+    },
+    {
+      original: {
+        name: "call_wrap_before_add_subtract",
+        expr: "a * b + c - d * e",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_+_", "_-_"],
+      wrapAfterColumnLimit: false,
+      ast: "_-_(\n  _+_(\n    _*_(\n      a^#*expr.Expr_IdentExpr#,\n      b^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    c^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _*_(\n    d^#*expr.Expr_IdentExpr#,\n    e^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed: "a * b\n+ c\n- d * e",
+      locationAst:
+        "_-_(\n  _+_(\n    _*_(\n      a^#1[1,0]#,\n      b^#3[1,4]#\n    )^#2[1,2]#,\n    c^#5[1,8]#\n  )^#4[1,6]#,\n  _*_(\n    d^#7[1,12]#,\n    e^#9[1,16]#\n  )^#8[1,14]#\n)^#6[1,10]#",
+      positions: [
+        [1, 0, 1, 1, 0, 1, 1],
+        [2, 2, 3, 1, 2, 1, 3],
+        [3, 4, 5, 1, 4, 1, 5],
+        [4, 6, 7, 1, 6, 1, 7],
+        [5, 8, 9, 1, 8, 1, 9],
+        [6, 10, 11, 1, 10, 1, 11],
+        [7, 12, 13, 1, 12, 1, 13],
+        [8, 14, 15, 1, 14, 1, 15],
+        [9, 16, 17, 1, 16, 1, 17],
+      ],
+      lineOffsets: [18],
+      parsedExpr: {
+        expr: {
+          id: "6",
+          callExpr: {
+            function: "_-_",
+            args: [
+              {
+                id: "4",
+                callExpr: {
+                  function: "_+_",
+                  args: [
+                    {
+                      id: "2",
+                      callExpr: {
+                        function: "_*_",
+                        args: [
+                          { id: "1", identExpr: { name: "a" } },
+                          { id: "3", identExpr: { name: "b" } },
+                        ],
+                      },
+                    },
+                    { id: "5", identExpr: { name: "c" } },
+                  ],
+                },
+              },
+              {
+                id: "8",
+                callExpr: {
+                  function: "_*_",
+                  args: [
+                    { id: "7", identExpr: { name: "d" } },
+                    { id: "9", identExpr: { name: "e" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_before_add_subtract",
+          lineOffsets: [18],
+          positions: {
+            "1": 0,
+            "2": 2,
+            "3": 4,
+            "4": 6,
+            "5": 8,
+            "6": 10,
+            "7": 12,
+            "8": 14,
+            "9": 16,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'a' (in container '')\n | a * b + c - d * e\n | ^\nERROR: \u003cinput\u003e:1:5: undeclared reference to 'b' (in container '')\n | a * b + c - d * e\n | ....^\nERROR: \u003cinput\u003e:1:9: undeclared reference to 'c' (in container '')\n | a * b + c - d * e\n | ........^\nERROR: \u003cinput\u003e:1:13: undeclared reference to 'd' (in container '')\n | a * b + c - d * e\n | ............^\nERROR: \u003cinput\u003e:1:17: undeclared reference to 'e' (in container '')\n | a * b + c - d * e\n | ................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'a' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'b' (in container '')",
+          id: 3,
+          offset: 4,
+          line: 1,
+          column: 4,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'c' (in container '')",
+          id: 5,
+          offset: 8,
+          line: 1,
+          column: 8,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'd' (in container '')",
+          id: 7,
+          offset: 12,
+          line: 1,
+          column: 12,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'e' (in container '')",
+          id: 9,
+          offset: 16,
+          line: 1,
+          column: 16,
+        },
+      ],
+      expectedUnparsed: "a * b\n+ c\n- d * e",
+    },
+    {
+      original: {
+        name: "call_wrap_logical_and_long_variables",
+        expr: "longVariableA \u0026\u0026 longVariableB \u0026\u0026 longVariableC",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      wrapOnOperators: ["_\u0026\u0026_"],
+      wrapAfterColumnLimit: false,
+      ast: "_\u0026\u0026_(\n  _\u0026\u0026_(\n    longVariableA^#*expr.Expr_IdentExpr#,\n    longVariableB^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  longVariableC^#*expr.Expr_IdentExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "longVariableA\n\u0026\u0026 longVariableB\n\u0026\u0026 longVariableC",
+      locationAst:
+        "_\u0026\u0026_(\n  _\u0026\u0026_(\n    longVariableA^#1[1,0]#,\n    longVariableB^#2[1,17]#\n  )^#3[1,14]#,\n  longVariableC^#4[1,34]#\n)^#5[1,31]#",
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 17, 30, 1, 17, 1, 30],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 34, 47, 1, 34, 1, 47],
+        [5, 31, 33, 1, 31, 1, 33],
+      ],
+      lineOffsets: [48],
+      parsedExpr: {
+        expr: {
+          id: "5",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "longVariableA" } },
+                    { id: "2", identExpr: { name: "longVariableB" } },
+                  ],
+                },
+              },
+              { id: "4", identExpr: { name: "longVariableC" } },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_logical_and_long_variables",
+          lineOffsets: [48],
+          positions: { "1": 0, "2": 17, "3": 14, "4": 34, "5": 31 },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'longVariableA' (in container '')\n | longVariableA \u0026\u0026 longVariableB \u0026\u0026 longVariableC\n | ^\nERROR: \u003cinput\u003e:1:18: undeclared reference to 'longVariableB' (in container '')\n | longVariableA \u0026\u0026 longVariableB \u0026\u0026 longVariableC\n | .................^\nERROR: \u003cinput\u003e:1:35: undeclared reference to 'longVariableC' (in container '')\n | longVariableA \u0026\u0026 longVariableB \u0026\u0026 longVariableC\n | ..................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableA' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableB' (in container '')",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableC' (in container '')",
+          id: 4,
+          offset: 34,
+          line: 1,
+          column: 34,
+        },
+      ],
+      expectedUnparsed:
+        "longVariableA\n\u0026\u0026 longVariableB\n\u0026\u0026 longVariableC",
+    },
+    {
+      original: {
+        name: "call_wrap_logical_and_long_input",
+        expr: '"my-principal-group" in request.auth.claims \u0026\u0026 request.auth.claims.iat \u003e now - duration("5m")',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 40,
+      wrapOnOperators: ["_\u0026\u0026_"],
+      ast: '_\u0026\u0026_(\n  @in(\n    "my-principal-group"^#*expr.Constant_StringValue#,\n    request^#*expr.Expr_IdentExpr#.auth^#*expr.Expr_SelectExpr#.claims^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e_(\n    request^#*expr.Expr_IdentExpr#.auth^#*expr.Expr_SelectExpr#.claims^#*expr.Expr_SelectExpr#.iat^#*expr.Expr_SelectExpr#,\n    _-_(\n      now^#*expr.Expr_IdentExpr#,\n      duration(\n        "5m"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"my-principal-group" in request.auth.claims \u0026\u0026\nrequest.auth.claims.iat \u003e now - duration("5m")',
+      locationAst:
+        '_\u0026\u0026_(\n  @in(\n    "my-principal-group"^#1[1,0]#,\n    request^#3[1,24]#.auth^#4[1,31]#.claims^#5[1,36]#\n  )^#2[1,21]#,\n  _\u003e_(\n    request^#6[1,47]#.auth^#7[1,54]#.claims^#8[1,59]#.iat^#9[1,66]#,\n    _-_(\n      now^#11[1,73]#,\n      duration(\n        "5m"^#14[1,88]#\n      )^#13[1,87]#\n    )^#12[1,77]#\n  )^#10[1,71]#\n)^#15[1,44]#',
+      positions: [
+        [1, 0, 20, 1, 0, 1, 20],
+        [2, 21, 23, 1, 21, 1, 23],
+        [3, 24, 31, 1, 24, 1, 31],
+        [4, 31, 32, 1, 31, 1, 32],
+        [5, 36, 37, 1, 36, 1, 37],
+        [6, 47, 54, 1, 47, 1, 54],
+        [7, 54, 55, 1, 54, 1, 55],
+        [8, 59, 60, 1, 59, 1, 60],
+        [9, 66, 67, 1, 66, 1, 67],
+        [10, 71, 72, 1, 71, 1, 72],
+        [11, 73, 76, 1, 73, 1, 76],
+        [12, 77, 78, 1, 77, 1, 78],
+        [13, 87, 88, 1, 87, 1, 88],
+        [14, 88, 92, 1, 88, 1, 92],
+        [15, 44, 46, 1, 44, 1, 46],
+      ],
+      lineOffsets: [94],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    {
+                      id: "1",
+                      constExpr: { stringValue: "my-principal-group" },
+                    },
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: {
+                          id: "4",
+                          selectExpr: {
+                            operand: {
+                              id: "3",
+                              identExpr: { name: "request" },
+                            },
+                            field: "auth",
+                          },
+                        },
+                        field: "claims",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: {
+                                  id: "6",
+                                  identExpr: { name: "request" },
+                                },
+                                field: "auth",
+                              },
+                            },
+                            field: "claims",
+                          },
+                        },
+                        field: "iat",
+                      },
+                    },
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "_-_",
+                        args: [
+                          { id: "11", identExpr: { name: "now" } },
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "duration",
+                              args: [
+                                { id: "14", constExpr: { stringValue: "5m" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_logical_and_long_input",
+          lineOffsets: [94],
+          positions: {
+            "1": 0,
+            "2": 21,
+            "3": 24,
+            "4": 31,
+            "5": 36,
+            "6": 47,
+            "7": 54,
+            "8": 59,
+            "9": 66,
+            "10": 71,
+            "11": 73,
+            "12": 77,
+            "13": 87,
+            "14": 88,
+            "15": 44,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:25: undeclared reference to 'request' (in container '')\n | \"my-principal-group\" in request.auth.claims \u0026\u0026 request.auth.claims.iat \u003e now - duration(\"5m\")\n | ........................^\nERROR: \u003cinput\u003e:1:48: undeclared reference to 'request' (in container '')\n | \"my-principal-group\" in request.auth.claims \u0026\u0026 request.auth.claims.iat \u003e now - duration(\"5m\")\n | ...............................................^\nERROR: \u003cinput\u003e:1:74: undeclared reference to 'now' (in container '')\n | \"my-principal-group\" in request.auth.claims \u0026\u0026 request.auth.claims.iat \u003e now - duration(\"5m\")\n | .........................................................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'request' (in container '')",
+          id: 3,
+          offset: 24,
+          line: 1,
+          column: 24,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'request' (in container '')",
+          id: 6,
+          offset: 47,
+          line: 1,
+          column: 47,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'now' (in container '')",
+          id: 11,
+          offset: 73,
+          line: 1,
+          column: 73,
+        },
+      ],
+      expectedUnparsed:
+        '"my-principal-group" in request.auth.claims \u0026\u0026\nrequest.auth.claims.iat \u003e now - duration("5m")',
+    },
+    {
+      original: {
+        name: "call_wrap_before_logical_and_long_input",
+        expr: '"my-principal-group" in request.auth.claims \u0026\u0026 request.auth.claims.iat \u003e now - duration("5m")',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 40,
+      wrapOnOperators: ["_\u0026\u0026_"],
+      wrapAfterColumnLimit: false,
+      ast: '_\u0026\u0026_(\n  @in(\n    "my-principal-group"^#*expr.Constant_StringValue#,\n    request^#*expr.Expr_IdentExpr#.auth^#*expr.Expr_SelectExpr#.claims^#*expr.Expr_SelectExpr#\n  )^#*expr.Expr_CallExpr#,\n  _\u003e_(\n    request^#*expr.Expr_IdentExpr#.auth^#*expr.Expr_SelectExpr#.claims^#*expr.Expr_SelectExpr#.iat^#*expr.Expr_SelectExpr#,\n    _-_(\n      now^#*expr.Expr_IdentExpr#,\n      duration(\n        "5m"^#*expr.Constant_StringValue#\n      )^#*expr.Expr_CallExpr#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        '"my-principal-group" in request.auth.claims\n\u0026\u0026 request.auth.claims.iat \u003e now - duration("5m")',
+      locationAst:
+        '_\u0026\u0026_(\n  @in(\n    "my-principal-group"^#1[1,0]#,\n    request^#3[1,24]#.auth^#4[1,31]#.claims^#5[1,36]#\n  )^#2[1,21]#,\n  _\u003e_(\n    request^#6[1,47]#.auth^#7[1,54]#.claims^#8[1,59]#.iat^#9[1,66]#,\n    _-_(\n      now^#11[1,73]#,\n      duration(\n        "5m"^#14[1,88]#\n      )^#13[1,87]#\n    )^#12[1,77]#\n  )^#10[1,71]#\n)^#15[1,44]#',
+      positions: [
+        [1, 0, 20, 1, 0, 1, 20],
+        [2, 21, 23, 1, 21, 1, 23],
+        [3, 24, 31, 1, 24, 1, 31],
+        [4, 31, 32, 1, 31, 1, 32],
+        [5, 36, 37, 1, 36, 1, 37],
+        [6, 47, 54, 1, 47, 1, 54],
+        [7, 54, 55, 1, 54, 1, 55],
+        [8, 59, 60, 1, 59, 1, 60],
+        [9, 66, 67, 1, 66, 1, 67],
+        [10, 71, 72, 1, 71, 1, 72],
+        [11, 73, 76, 1, 73, 1, 76],
+        [12, 77, 78, 1, 77, 1, 78],
+        [13, 87, 88, 1, 87, 1, 88],
+        [14, 88, 92, 1, 88, 1, 92],
+        [15, 44, 46, 1, 44, 1, 46],
+      ],
+      lineOffsets: [94],
+      parsedExpr: {
+        expr: {
+          id: "15",
+          callExpr: {
+            function: "_\u0026\u0026_",
+            args: [
+              {
+                id: "2",
+                callExpr: {
+                  function: "@in",
+                  args: [
+                    {
+                      id: "1",
+                      constExpr: { stringValue: "my-principal-group" },
+                    },
+                    {
+                      id: "5",
+                      selectExpr: {
+                        operand: {
+                          id: "4",
+                          selectExpr: {
+                            operand: {
+                              id: "3",
+                              identExpr: { name: "request" },
+                            },
+                            field: "auth",
+                          },
+                        },
+                        field: "claims",
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "10",
+                callExpr: {
+                  function: "_\u003e_",
+                  args: [
+                    {
+                      id: "9",
+                      selectExpr: {
+                        operand: {
+                          id: "8",
+                          selectExpr: {
+                            operand: {
+                              id: "7",
+                              selectExpr: {
+                                operand: {
+                                  id: "6",
+                                  identExpr: { name: "request" },
+                                },
+                                field: "auth",
+                              },
+                            },
+                            field: "claims",
+                          },
+                        },
+                        field: "iat",
+                      },
+                    },
+                    {
+                      id: "12",
+                      callExpr: {
+                        function: "_-_",
+                        args: [
+                          { id: "11", identExpr: { name: "now" } },
+                          {
+                            id: "13",
+                            callExpr: {
+                              function: "duration",
+                              args: [
+                                { id: "14", constExpr: { stringValue: "5m" } },
+                              ],
+                            },
+                          },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_before_logical_and_long_input",
+          lineOffsets: [94],
+          positions: {
+            "1": 0,
+            "2": 21,
+            "3": 24,
+            "4": 31,
+            "5": 36,
+            "6": 47,
+            "7": 54,
+            "8": 59,
+            "9": 66,
+            "10": 71,
+            "11": 73,
+            "12": 77,
+            "13": 87,
+            "14": 88,
+            "15": 44,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:25: undeclared reference to 'request' (in container '')\n | \"my-principal-group\" in request.auth.claims \u0026\u0026 request.auth.claims.iat \u003e now - duration(\"5m\")\n | ........................^\nERROR: \u003cinput\u003e:1:48: undeclared reference to 'request' (in container '')\n | \"my-principal-group\" in request.auth.claims \u0026\u0026 request.auth.claims.iat \u003e now - duration(\"5m\")\n | ...............................................^\nERROR: \u003cinput\u003e:1:74: undeclared reference to 'now' (in container '')\n | \"my-principal-group\" in request.auth.claims \u0026\u0026 request.auth.claims.iat \u003e now - duration(\"5m\")\n | .........................................................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'request' (in container '')",
+          id: 3,
+          offset: 24,
+          line: 1,
+          column: 24,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'request' (in container '')",
+          id: 6,
+          offset: 47,
+          line: 1,
+          column: 47,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'now' (in container '')",
+          id: 11,
+          offset: 73,
+          line: 1,
+          column: 73,
+        },
+      ],
+      expectedUnparsed:
+        '"my-principal-group" in request.auth.claims\n\u0026\u0026 request.auth.claims.iat \u003e now - duration("5m")',
+    },
+    {
+      original: {
+        name: "call_wrap_default",
+        expr: 'jwt.extra_claims.filter(c, c.startsWith("group")).all(c, jwt.extra_claims[c].all(g, g.endsWith("@acme.co"))) \u0026\u0026 jwt.extra_claims.exists(c, c.startsWith("group")) || request.auth.claims.group == "admin" || request.auth.principal == "user:me@acme.co"',
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      ast: '_||_(\n  _||_(\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        c,\n        // Target\n        __comprehension__(\n          // Variable\n          c,\n          // Target\n          jwt^#*expr.Expr_IdentExpr#.extra_claims^#*expr.Expr_SelectExpr#,\n          // Accumulator\n          @result,\n          // Init\n          []^#*expr.Expr_ListExpr#,\n          // LoopCondition\n          true^#*expr.Constant_BoolValue#,\n          // LoopStep\n          _?_:_(\n            c^#*expr.Expr_IdentExpr#.startsWith(\n              "group"^#*expr.Constant_StringValue#\n            )^#*expr.Expr_CallExpr#,\n            _+_(\n              @result^#*expr.Expr_IdentExpr#,\n              [\n                c^#*expr.Expr_IdentExpr#\n              ]^#*expr.Expr_ListExpr#\n            )^#*expr.Expr_CallExpr#,\n            @result^#*expr.Expr_IdentExpr#\n          )^#*expr.Expr_CallExpr#,\n          // Result\n          @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n        // Accumulator\n        @result,\n        // Init\n        true^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#*expr.Expr_IdentExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#*expr.Expr_IdentExpr#,\n          __comprehension__(\n            // Variable\n            g,\n            // Target\n            _[_](\n              jwt^#*expr.Expr_IdentExpr#.extra_claims^#*expr.Expr_SelectExpr#,\n              c^#*expr.Expr_IdentExpr#\n            )^#*expr.Expr_CallExpr#,\n            // Accumulator\n            @result,\n            // Init\n            true^#*expr.Constant_BoolValue#,\n            // LoopCondition\n            @not_strictly_false(\n              @result^#*expr.Expr_IdentExpr#\n            )^#*expr.Expr_CallExpr#,\n            // LoopStep\n            _\u0026\u0026_(\n              @result^#*expr.Expr_IdentExpr#,\n              g^#*expr.Expr_IdentExpr#.endsWith(\n                "@acme.co"^#*expr.Constant_StringValue#\n              )^#*expr.Expr_CallExpr#\n            )^#*expr.Expr_CallExpr#,\n            // Result\n            @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#,\n      __comprehension__(\n        // Variable\n        c,\n        // Target\n        jwt^#*expr.Expr_IdentExpr#.extra_claims^#*expr.Expr_SelectExpr#,\n        // Accumulator\n        @result,\n        // Init\n        false^#*expr.Constant_BoolValue#,\n        // LoopCondition\n        @not_strictly_false(\n          !_(\n            @result^#*expr.Expr_IdentExpr#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#,\n        // LoopStep\n        _||_(\n          @result^#*expr.Expr_IdentExpr#,\n          c^#*expr.Expr_IdentExpr#.startsWith(\n            "group"^#*expr.Constant_StringValue#\n          )^#*expr.Expr_CallExpr#\n        )^#*expr.Expr_CallExpr#,\n        // Result\n        @result^#*expr.Expr_IdentExpr#)^#*expr.Expr_ComprehensionExpr#\n    )^#*expr.Expr_CallExpr#,\n    _==_(\n      request^#*expr.Expr_IdentExpr#.auth^#*expr.Expr_SelectExpr#.claims^#*expr.Expr_SelectExpr#.group^#*expr.Expr_SelectExpr#,\n      "admin"^#*expr.Constant_StringValue#\n    )^#*expr.Expr_CallExpr#\n  )^#*expr.Expr_CallExpr#,\n  _==_(\n    request^#*expr.Expr_IdentExpr#.auth^#*expr.Expr_SelectExpr#.principal^#*expr.Expr_SelectExpr#,\n    "user:me@acme.co"^#*expr.Constant_StringValue#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#',
+      unparsed:
+        'jwt.extra_claims.filter(c, c.startsWith("group")).all(c, jwt.extra_claims[c].all(g, g.endsWith("@acme.co"))) \u0026\u0026\njwt.extra_claims.exists(c, c.startsWith("group")) || request.auth.claims.group == "admin" ||\nrequest.auth.principal == "user:me@acme.co"',
+      locationAst:
+        '_||_(\n  _||_(\n    _\u0026\u0026_(\n      __comprehension__(\n        // Variable\n        c,\n        // Target\n        __comprehension__(\n          // Variable\n          c,\n          // Target\n          jwt^#1[1,0]#.extra_claims^#2[1,3]#,\n          // Accumulator\n          @result,\n          // Init\n          []^#8[1,23]#,\n          // LoopCondition\n          true^#9[1,23]#,\n          // LoopStep\n          _?_:_(\n            c^#5[1,27]#.startsWith(\n              "group"^#7[1,40]#\n            )^#6[1,39]#,\n            _+_(\n              @result^#10[1,23]#,\n              [\n                c^#4[1,24]#\n              ]^#11[1,23]#\n            )^#12[1,23]#,\n            @result^#13[1,23]#\n          )^#14[1,23]#,\n          // Result\n          @result^#15[1,23]#)^#16[1,23]#,\n        // Accumulator\n        @result,\n        // Init\n        true^#35[1,53]#,\n        // LoopCondition\n        @not_strictly_false(\n          @result^#36[1,53]#\n        )^#37[1,53]#,\n        // LoopStep\n        _\u0026\u0026_(\n          @result^#38[1,53]#,\n          __comprehension__(\n            // Variable\n            g,\n            // Target\n            _[_](\n              jwt^#19[1,57]#.extra_claims^#20[1,60]#,\n              c^#22[1,74]#\n            )^#21[1,73]#,\n            // Accumulator\n            @result,\n            // Init\n            true^#28[1,80]#,\n            // LoopCondition\n            @not_strictly_false(\n              @result^#29[1,80]#\n            )^#30[1,80]#,\n            // LoopStep\n            _\u0026\u0026_(\n              @result^#31[1,80]#,\n              g^#25[1,84]#.endsWith(\n                "@acme.co"^#27[1,95]#\n              )^#26[1,94]#\n            )^#32[1,80]#,\n            // Result\n            @result^#33[1,80]#)^#34[1,80]#\n        )^#39[1,53]#,\n        // Result\n        @result^#40[1,53]#)^#41[1,53]#,\n      __comprehension__(\n        // Variable\n        c,\n        // Target\n        jwt^#42[1,112]#.extra_claims^#43[1,115]#,\n        // Accumulator\n        @result,\n        // Init\n        false^#49[1,135]#,\n        // LoopCondition\n        @not_strictly_false(\n          !_(\n            @result^#50[1,135]#\n          )^#51[1,135]#\n        )^#52[1,135]#,\n        // LoopStep\n        _||_(\n          @result^#53[1,135]#,\n          c^#46[1,139]#.startsWith(\n            "group"^#48[1,152]#\n          )^#47[1,151]#\n        )^#54[1,135]#,\n        // Result\n        @result^#55[1,135]#)^#56[1,135]#\n    )^#57[1,109]#,\n    _==_(\n      request^#58[1,165]#.auth^#59[1,172]#.claims^#60[1,177]#.group^#61[1,184]#,\n      "admin"^#63[1,194]#\n    )^#62[1,191]#\n  )^#64[1,162]#,\n  _==_(\n    request^#65[1,205]#.auth^#66[1,212]#.principal^#67[1,217]#,\n    "user:me@acme.co"^#69[1,231]#\n  )^#68[1,228]#\n)^#70[1,202]#',
+      positions: [
+        [1, 0, 3, 1, 0, 1, 3],
+        [2, 3, 4, 1, 3, 1, 4],
+        [4, 24, 25, 1, 24, 1, 25],
+        [5, 27, 28, 1, 27, 1, 28],
+        [6, 39, 40, 1, 39, 1, 40],
+        [7, 40, 47, 1, 40, 1, 47],
+        [8, 23, 23, 1, 23, 1, 23],
+        [9, 23, 23, 1, 23, 1, 23],
+        [10, 23, 23, 1, 23, 1, 23],
+        [11, 23, 23, 1, 23, 1, 23],
+        [12, 23, 23, 1, 23, 1, 23],
+        [13, 23, 23, 1, 23, 1, 23],
+        [14, 23, 23, 1, 23, 1, 23],
+        [15, 23, 23, 1, 23, 1, 23],
+        [16, 23, 23, 1, 23, 1, 23],
+        [18, 54, 55, 1, 54, 1, 55],
+        [19, 57, 60, 1, 57, 1, 60],
+        [20, 60, 61, 1, 60, 1, 61],
+        [21, 73, 74, 1, 73, 1, 74],
+        [22, 74, 75, 1, 74, 1, 75],
+        [24, 81, 82, 1, 81, 1, 82],
+        [25, 84, 85, 1, 84, 1, 85],
+        [26, 94, 95, 1, 94, 1, 95],
+        [27, 95, 105, 1, 95, 1, 105],
+        [28, 80, 80, 1, 80, 1, 80],
+        [29, 80, 80, 1, 80, 1, 80],
+        [30, 80, 80, 1, 80, 1, 80],
+        [31, 80, 80, 1, 80, 1, 80],
+        [32, 80, 80, 1, 80, 1, 80],
+        [33, 80, 80, 1, 80, 1, 80],
+        [34, 80, 80, 1, 80, 1, 80],
+        [35, 53, 53, 1, 53, 1, 53],
+        [36, 53, 53, 1, 53, 1, 53],
+        [37, 53, 53, 1, 53, 1, 53],
+        [38, 53, 53, 1, 53, 1, 53],
+        [39, 53, 53, 1, 53, 1, 53],
+        [40, 53, 53, 1, 53, 1, 53],
+        [41, 53, 53, 1, 53, 1, 53],
+        [42, 112, 115, 1, 112, 1, 115],
+        [43, 115, 116, 1, 115, 1, 116],
+        [45, 136, 137, 1, 136, 1, 137],
+        [46, 139, 140, 1, 139, 1, 140],
+        [47, 151, 152, 1, 151, 1, 152],
+        [48, 152, 159, 1, 152, 1, 159],
+        [49, 135, 135, 1, 135, 1, 135],
+        [50, 135, 135, 1, 135, 1, 135],
+        [51, 135, 135, 1, 135, 1, 135],
+        [52, 135, 135, 1, 135, 1, 135],
+        [53, 135, 135, 1, 135, 1, 135],
+        [54, 135, 135, 1, 135, 1, 135],
+        [55, 135, 135, 1, 135, 1, 135],
+        [56, 135, 135, 1, 135, 1, 135],
+        [57, 109, 111, 1, 109, 1, 111],
+        [58, 165, 172, 1, 165, 1, 172],
+        [59, 172, 173, 1, 172, 1, 173],
+        [60, 177, 178, 1, 177, 1, 178],
+        [61, 184, 185, 1, 184, 1, 185],
+        [62, 191, 193, 1, 191, 1, 193],
+        [63, 194, 201, 1, 194, 1, 201],
+        [64, 162, 164, 1, 162, 1, 164],
+        [65, 205, 212, 1, 205, 1, 212],
+        [66, 212, 213, 1, 212, 1, 213],
+        [67, 217, 218, 1, 217, 1, 218],
+        [68, 228, 230, 1, 228, 1, 230],
+        [69, 231, 248, 1, 231, 1, 248],
+        [70, 202, 204, 1, 202, 1, 204],
+      ],
+      lineOffsets: [249],
+      parsedExpr: {
+        expr: {
+          id: "70",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "64",
+                callExpr: {
+                  function: "_||_",
+                  args: [
+                    {
+                      id: "57",
+                      callExpr: {
+                        function: "_\u0026\u0026_",
+                        args: [
+                          {
+                            id: "41",
+                            comprehensionExpr: {
+                              iterVar: "c",
+                              iterRange: {
+                                id: "16",
+                                comprehensionExpr: {
+                                  iterVar: "c",
+                                  iterRange: {
+                                    id: "2",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "1",
+                                        identExpr: { name: "jwt" },
+                                      },
+                                      field: "extra_claims",
+                                    },
+                                  },
+                                  accuVar: "@result",
+                                  accuInit: { id: "8", listExpr: {} },
+                                  loopCondition: {
+                                    id: "9",
+                                    constExpr: { boolValue: true },
+                                  },
+                                  loopStep: {
+                                    id: "14",
+                                    callExpr: {
+                                      function: "_?_:_",
+                                      args: [
+                                        {
+                                          id: "6",
+                                          callExpr: {
+                                            target: {
+                                              id: "5",
+                                              identExpr: { name: "c" },
+                                            },
+                                            function: "startsWith",
+                                            args: [
+                                              {
+                                                id: "7",
+                                                constExpr: {
+                                                  stringValue: "group",
+                                                },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        {
+                                          id: "12",
+                                          callExpr: {
+                                            function: "_+_",
+                                            args: [
+                                              {
+                                                id: "10",
+                                                identExpr: { name: "@result" },
+                                              },
+                                              {
+                                                id: "11",
+                                                listExpr: {
+                                                  elements: [
+                                                    {
+                                                      id: "4",
+                                                      identExpr: { name: "c" },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        {
+                                          id: "13",
+                                          identExpr: { name: "@result" },
+                                        },
+                                      ],
+                                    },
+                                  },
+                                  result: {
+                                    id: "15",
+                                    identExpr: { name: "@result" },
+                                  },
+                                },
+                              },
+                              accuVar: "@result",
+                              accuInit: {
+                                id: "35",
+                                constExpr: { boolValue: true },
+                              },
+                              loopCondition: {
+                                id: "37",
+                                callExpr: {
+                                  function: "@not_strictly_false",
+                                  args: [
+                                    {
+                                      id: "36",
+                                      identExpr: { name: "@result" },
+                                    },
+                                  ],
+                                },
+                              },
+                              loopStep: {
+                                id: "39",
+                                callExpr: {
+                                  function: "_\u0026\u0026_",
+                                  args: [
+                                    {
+                                      id: "38",
+                                      identExpr: { name: "@result" },
+                                    },
+                                    {
+                                      id: "34",
+                                      comprehensionExpr: {
+                                        iterVar: "g",
+                                        iterRange: {
+                                          id: "21",
+                                          callExpr: {
+                                            function: "_[_]",
+                                            args: [
+                                              {
+                                                id: "20",
+                                                selectExpr: {
+                                                  operand: {
+                                                    id: "19",
+                                                    identExpr: { name: "jwt" },
+                                                  },
+                                                  field: "extra_claims",
+                                                },
+                                              },
+                                              {
+                                                id: "22",
+                                                identExpr: { name: "c" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        accuVar: "@result",
+                                        accuInit: {
+                                          id: "28",
+                                          constExpr: { boolValue: true },
+                                        },
+                                        loopCondition: {
+                                          id: "30",
+                                          callExpr: {
+                                            function: "@not_strictly_false",
+                                            args: [
+                                              {
+                                                id: "29",
+                                                identExpr: { name: "@result" },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        loopStep: {
+                                          id: "32",
+                                          callExpr: {
+                                            function: "_\u0026\u0026_",
+                                            args: [
+                                              {
+                                                id: "31",
+                                                identExpr: { name: "@result" },
+                                              },
+                                              {
+                                                id: "26",
+                                                callExpr: {
+                                                  target: {
+                                                    id: "25",
+                                                    identExpr: { name: "g" },
+                                                  },
+                                                  function: "endsWith",
+                                                  args: [
+                                                    {
+                                                      id: "27",
+                                                      constExpr: {
+                                                        stringValue: "@acme.co",
+                                                      },
+                                                    },
+                                                  ],
+                                                },
+                                              },
+                                            ],
+                                          },
+                                        },
+                                        result: {
+                                          id: "33",
+                                          identExpr: { name: "@result" },
+                                        },
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              result: {
+                                id: "40",
+                                identExpr: { name: "@result" },
+                              },
+                            },
+                          },
+                          {
+                            id: "56",
+                            comprehensionExpr: {
+                              iterVar: "c",
+                              iterRange: {
+                                id: "43",
+                                selectExpr: {
+                                  operand: {
+                                    id: "42",
+                                    identExpr: { name: "jwt" },
+                                  },
+                                  field: "extra_claims",
+                                },
+                              },
+                              accuVar: "@result",
+                              accuInit: {
+                                id: "49",
+                                constExpr: { boolValue: false },
+                              },
+                              loopCondition: {
+                                id: "52",
+                                callExpr: {
+                                  function: "@not_strictly_false",
+                                  args: [
+                                    {
+                                      id: "51",
+                                      callExpr: {
+                                        function: "!_",
+                                        args: [
+                                          {
+                                            id: "50",
+                                            identExpr: { name: "@result" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              loopStep: {
+                                id: "54",
+                                callExpr: {
+                                  function: "_||_",
+                                  args: [
+                                    {
+                                      id: "53",
+                                      identExpr: { name: "@result" },
+                                    },
+                                    {
+                                      id: "47",
+                                      callExpr: {
+                                        target: {
+                                          id: "46",
+                                          identExpr: { name: "c" },
+                                        },
+                                        function: "startsWith",
+                                        args: [
+                                          {
+                                            id: "48",
+                                            constExpr: { stringValue: "group" },
+                                          },
+                                        ],
+                                      },
+                                    },
+                                  ],
+                                },
+                              },
+                              result: {
+                                id: "55",
+                                identExpr: { name: "@result" },
+                              },
+                            },
+                          },
+                        ],
+                      },
+                    },
+                    {
+                      id: "62",
+                      callExpr: {
+                        function: "_==_",
+                        args: [
+                          {
+                            id: "61",
+                            selectExpr: {
+                              operand: {
+                                id: "60",
+                                selectExpr: {
+                                  operand: {
+                                    id: "59",
+                                    selectExpr: {
+                                      operand: {
+                                        id: "58",
+                                        identExpr: { name: "request" },
+                                      },
+                                      field: "auth",
+                                    },
+                                  },
+                                  field: "claims",
+                                },
+                              },
+                              field: "group",
+                            },
+                          },
+                          { id: "63", constExpr: { stringValue: "admin" } },
+                        ],
+                      },
+                    },
+                  ],
+                },
+              },
+              {
+                id: "68",
+                callExpr: {
+                  function: "_==_",
+                  args: [
+                    {
+                      id: "67",
+                      selectExpr: {
+                        operand: {
+                          id: "66",
+                          selectExpr: {
+                            operand: {
+                              id: "65",
+                              identExpr: { name: "request" },
+                            },
+                            field: "auth",
+                          },
+                        },
+                        field: "principal",
+                      },
+                    },
+                    { id: "69", constExpr: { stringValue: "user:me@acme.co" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_default",
+          lineOffsets: [249],
+          positions: {
+            "1": 0,
+            "2": 3,
+            "4": 24,
+            "5": 27,
+            "6": 39,
+            "7": 40,
+            "8": 23,
+            "9": 23,
+            "10": 23,
+            "11": 23,
+            "12": 23,
+            "13": 23,
+            "14": 23,
+            "15": 23,
+            "16": 23,
+            "18": 54,
+            "19": 57,
+            "20": 60,
+            "21": 73,
+            "22": 74,
+            "24": 81,
+            "25": 84,
+            "26": 94,
+            "27": 95,
+            "28": 80,
+            "29": 80,
+            "30": 80,
+            "31": 80,
+            "32": 80,
+            "33": 80,
+            "34": 80,
+            "35": 53,
+            "36": 53,
+            "37": 53,
+            "38": 53,
+            "39": 53,
+            "40": 53,
+            "41": 53,
+            "42": 112,
+            "43": 115,
+            "45": 136,
+            "46": 139,
+            "47": 151,
+            "48": 152,
+            "49": 135,
+            "50": 135,
+            "51": 135,
+            "52": 135,
+            "53": 135,
+            "54": 135,
+            "55": 135,
+            "56": 135,
+            "57": 109,
+            "58": 165,
+            "59": 172,
+            "60": 177,
+            "61": 184,
+            "62": 191,
+            "63": 194,
+            "64": 162,
+            "65": 205,
+            "66": 212,
+            "67": 217,
+            "68": 228,
+            "69": 231,
+            "70": 202,
+          },
+          macroCalls: {
+            "16": {
+              callExpr: {
+                target: {
+                  id: "2",
+                  selectExpr: {
+                    operand: { id: "1", identExpr: { name: "jwt" } },
+                    field: "extra_claims",
+                  },
+                },
+                function: "filter",
+                args: [
+                  { id: "4", identExpr: { name: "c" } },
+                  {
+                    id: "6",
+                    callExpr: {
+                      target: { id: "5", identExpr: { name: "c" } },
+                      function: "startsWith",
+                      args: [{ id: "7", constExpr: { stringValue: "group" } }],
+                    },
+                  },
+                ],
+              },
+            },
+            "34": {
+              callExpr: {
+                target: {
+                  id: "21",
+                  callExpr: {
+                    function: "_[_]",
+                    args: [
+                      {
+                        id: "20",
+                        selectExpr: {
+                          operand: { id: "19", identExpr: { name: "jwt" } },
+                          field: "extra_claims",
+                        },
+                      },
+                      { id: "22", identExpr: { name: "c" } },
+                    ],
+                  },
+                },
+                function: "all",
+                args: [
+                  { id: "24", identExpr: { name: "g" } },
+                  {
+                    id: "26",
+                    callExpr: {
+                      target: { id: "25", identExpr: { name: "g" } },
+                      function: "endsWith",
+                      args: [
+                        { id: "27", constExpr: { stringValue: "@acme.co" } },
+                      ],
+                    },
+                  },
+                ],
+              },
+            },
+            "41": {
+              callExpr: {
+                target: { id: "16" },
+                function: "all",
+                args: [{ id: "18", identExpr: { name: "c" } }, { id: "34" }],
+              },
+            },
+            "56": {
+              callExpr: {
+                target: {
+                  id: "43",
+                  selectExpr: {
+                    operand: { id: "42", identExpr: { name: "jwt" } },
+                    field: "extra_claims",
+                  },
+                },
+                function: "exists",
+                args: [
+                  { id: "45", identExpr: { name: "c" } },
+                  {
+                    id: "47",
+                    callExpr: {
+                      target: { id: "46", identExpr: { name: "c" } },
+                      function: "startsWith",
+                      args: [{ id: "48", constExpr: { stringValue: "group" } }],
+                    },
+                  },
+                ],
+              },
+            },
+          },
+        },
+      },
+      macroCalls: [
+        {
+          id: 16,
+          function: "filter",
+          target: {
+            id: "2",
+            selectExpr: {
+              operand: { id: "1", identExpr: { name: "jwt" } },
+              field: "extra_claims",
+            },
+          },
+          args: [
+            { id: "4", identExpr: { name: "c" } },
+            {
+              id: "6",
+              callExpr: {
+                target: { id: "5", identExpr: { name: "c" } },
+                function: "startsWith",
+                args: [{ id: "7", constExpr: { stringValue: "group" } }],
+              },
+            },
+          ],
+        },
+        {
+          id: 34,
+          function: "all",
+          target: {
+            id: "21",
+            callExpr: {
+              function: "_[_]",
+              args: [
+                {
+                  id: "20",
+                  selectExpr: {
+                    operand: { id: "19", identExpr: { name: "jwt" } },
+                    field: "extra_claims",
+                  },
+                },
+                { id: "22", identExpr: { name: "c" } },
+              ],
+            },
+          },
+          args: [
+            { id: "24", identExpr: { name: "g" } },
+            {
+              id: "26",
+              callExpr: {
+                target: { id: "25", identExpr: { name: "g" } },
+                function: "endsWith",
+                args: [{ id: "27", constExpr: { stringValue: "@acme.co" } }],
+              },
+            },
+          ],
+        },
+        {
+          id: 41,
+          function: "all",
+          target: { id: "16" },
+          args: [{ id: "18", identExpr: { name: "c" } }, { id: "34" }],
+        },
+        {
+          id: 56,
+          function: "exists",
+          target: {
+            id: "43",
+            selectExpr: {
+              operand: { id: "42", identExpr: { name: "jwt" } },
+              field: "extra_claims",
+            },
+          },
+          args: [
+            { id: "45", identExpr: { name: "c" } },
+            {
+              id: "47",
+              callExpr: {
+                target: { id: "46", identExpr: { name: "c" } },
+                function: "startsWith",
+                args: [{ id: "48", constExpr: { stringValue: "group" } }],
+              },
+            },
+          ],
+        },
+      ],
+      error:
+        'ERROR: \u003cinput\u003e:1:1: undeclared reference to \'jwt\' (in container \'\')\n | jwt.extra_claims.filter(c, c.startsWith("group")).all(c, jwt.extra_claims[c].all(g, g.endsWith("@acme.co"))) \u0026\u0026 jwt.extra_claims.exists(c, c.startsWith("group")) || request.auth.claims.group == "admin" || request.auth.principal == "user:me@acme.co"\n | ^\nERROR: \u003cinput\u003e:1:58: undeclared reference to \'jwt\' (in container \'\')\n | jwt.extra_claims.filter(c, c.startsWith("group")).all(c, jwt.extra_claims[c].all(g, g.endsWith("@acme.co"))) \u0026\u0026 jwt.extra_claims.exists(c, c.startsWith("group")) || request.auth.claims.group == "admin" || request.auth.principal == "user:me@acme.co"\n | .........................................................^\nERROR: \u003cinput\u003e:1:113: undeclared reference to \'jwt\' (in container \'\')\n | jwt.extra_claims.filter(c, c.startsWith("group")).all(c, jwt.extra_claims[c].all(g, g.endsWith("@acme.co"))) \u0026\u0026 jwt.extra_claims.exists(c, c.startsWith("group")) || request.auth.claims.group == "admin" || request.auth.principal == "user:me@acme.co"\n | ................................................................................................................^\nERROR: \u003cinput\u003e:1:166: undeclared reference to \'request\' (in container \'\')\n | jwt.extra_claims.filter(c, c.startsWith("group")).all(c, jwt.extra_claims[c].all(g, g.endsWith("@acme.co"))) \u0026\u0026 jwt.extra_claims.exists(c, c.startsWith("group")) || request.auth.claims.group == "admin" || request.auth.principal == "user:me@acme.co"\n | .....................................................................................................................................................................^\nERROR: \u003cinput\u003e:1:206: undeclared reference to \'request\' (in container \'\')\n | jwt.extra_claims.filter(c, c.startsWith("group")).all(c, jwt.extra_claims[c].all(g, g.endsWith("@acme.co"))) \u0026\u0026 jwt.extra_claims.exists(c, c.startsWith("group")) || request.auth.claims.group == "admin" || request.auth.principal == "user:me@acme.co"\n | .............................................................................................................................................................................................................^',
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'jwt' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'jwt' (in container '')",
+          id: 19,
+          offset: 57,
+          line: 1,
+          column: 57,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'jwt' (in container '')",
+          id: 42,
+          offset: 112,
+          line: 1,
+          column: 112,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'request' (in container '')",
+          id: 58,
+          offset: 165,
+          line: 1,
+          column: 165,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'request' (in container '')",
+          id: 65,
+          offset: 205,
+          line: 1,
+          column: 205,
+        },
+      ],
+      expectedUnparsed:
+        'jwt.extra_claims.filter(c, c.startsWith("group")).all(c, jwt.extra_claims[c].all(g, g.endsWith("@acme.co"))) \u0026\u0026\njwt.extra_claims.exists(c, c.startsWith("group")) || request.auth.claims.group == "admin" ||\nrequest.auth.principal == "user:me@acme.co"',
+    },
+    {
+      original: {
+        name: "call_wrap_default_operators",
+        expr: "longVariableA \u0026\u0026 longVariableB || longVariableC + longVariableD - longVariableE",
+      },
+      section: "TestUnparse",
+      optionalSyntax: true,
+      wrapOnColumn: 3,
+      ast: "_||_(\n  _\u0026\u0026_(\n    longVariableA^#*expr.Expr_IdentExpr#,\n    longVariableB^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#,\n  _-_(\n    _+_(\n      longVariableC^#*expr.Expr_IdentExpr#,\n      longVariableD^#*expr.Expr_IdentExpr#\n    )^#*expr.Expr_CallExpr#,\n    longVariableE^#*expr.Expr_IdentExpr#\n  )^#*expr.Expr_CallExpr#\n)^#*expr.Expr_CallExpr#",
+      unparsed:
+        "longVariableA \u0026\u0026\nlongVariableB ||\nlongVariableC + longVariableD - longVariableE",
+      locationAst:
+        "_||_(\n  _\u0026\u0026_(\n    longVariableA^#1[1,0]#,\n    longVariableB^#2[1,17]#\n  )^#3[1,14]#,\n  _-_(\n    _+_(\n      longVariableC^#4[1,34]#,\n      longVariableD^#6[1,50]#\n    )^#5[1,48]#,\n    longVariableE^#8[1,66]#\n  )^#7[1,64]#\n)^#9[1,31]#",
+      positions: [
+        [1, 0, 13, 1, 0, 1, 13],
+        [2, 17, 30, 1, 17, 1, 30],
+        [3, 14, 16, 1, 14, 1, 16],
+        [4, 34, 47, 1, 34, 1, 47],
+        [5, 48, 49, 1, 48, 1, 49],
+        [6, 50, 63, 1, 50, 1, 63],
+        [7, 64, 65, 1, 64, 1, 65],
+        [8, 66, 79, 1, 66, 1, 79],
+        [9, 31, 33, 1, 31, 1, 33],
+      ],
+      lineOffsets: [80],
+      parsedExpr: {
+        expr: {
+          id: "9",
+          callExpr: {
+            function: "_||_",
+            args: [
+              {
+                id: "3",
+                callExpr: {
+                  function: "_\u0026\u0026_",
+                  args: [
+                    { id: "1", identExpr: { name: "longVariableA" } },
+                    { id: "2", identExpr: { name: "longVariableB" } },
+                  ],
+                },
+              },
+              {
+                id: "7",
+                callExpr: {
+                  function: "_-_",
+                  args: [
+                    {
+                      id: "5",
+                      callExpr: {
+                        function: "_+_",
+                        args: [
+                          { id: "4", identExpr: { name: "longVariableC" } },
+                          { id: "6", identExpr: { name: "longVariableD" } },
+                        ],
+                      },
+                    },
+                    { id: "8", identExpr: { name: "longVariableE" } },
+                  ],
+                },
+              },
+            ],
+          },
+        },
+        sourceInfo: {
+          location: "call_wrap_default_operators",
+          lineOffsets: [80],
+          positions: {
+            "1": 0,
+            "2": 17,
+            "3": 14,
+            "4": 34,
+            "5": 48,
+            "6": 50,
+            "7": 64,
+            "8": 66,
+            "9": 31,
+          },
+        },
+      },
+      error:
+        "ERROR: \u003cinput\u003e:1:1: undeclared reference to 'longVariableA' (in container '')\n | longVariableA \u0026\u0026 longVariableB || longVariableC + longVariableD - longVariableE\n | ^\nERROR: \u003cinput\u003e:1:18: undeclared reference to 'longVariableB' (in container '')\n | longVariableA \u0026\u0026 longVariableB || longVariableC + longVariableD - longVariableE\n | .................^\nERROR: \u003cinput\u003e:1:35: undeclared reference to 'longVariableC' (in container '')\n | longVariableA \u0026\u0026 longVariableB || longVariableC + longVariableD - longVariableE\n | ..................................^\nERROR: \u003cinput\u003e:1:51: undeclared reference to 'longVariableD' (in container '')\n | longVariableA \u0026\u0026 longVariableB || longVariableC + longVariableD - longVariableE\n | ..................................................^\nERROR: \u003cinput\u003e:1:67: undeclared reference to 'longVariableE' (in container '')\n | longVariableA \u0026\u0026 longVariableB || longVariableC + longVariableD - longVariableE\n | ..................................................................^",
+      errors: [
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableA' (in container '')",
+          id: 1,
+          offset: 0,
+          line: 1,
+          column: 0,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableB' (in container '')",
+          id: 2,
+          offset: 17,
+          line: 1,
+          column: 17,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableC' (in container '')",
+          id: 4,
+          offset: 34,
+          line: 1,
+          column: 34,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableD' (in container '')",
+          id: 6,
+          offset: 50,
+          line: 1,
+          column: 50,
+        },
+        {
+          category: "undeclared-reference",
+          message: "undeclared reference to 'longVariableE' (in container '')",
+          id: 8,
+          offset: 66,
+          line: 1,
+          column: 66,
+        },
+      ],
+      expectedUnparsed:
+        "longVariableA \u0026\u0026\nlongVariableB ||\nlongVariableC + longVariableD - longVariableE",
+    },
+    {
+      original: { name: "bad_unparser_option_wrap_column_zero", expr: "null" },
+      section: "TestUnparseErrors",
+      wrapOnColumn: 0,
+      ast: "null^#*expr.Constant_NullValue#",
+      unparseError:
+        "Invalid unparser option. Wrap column value must be greater than or equal to 1. Got 0 instead",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "bad_unparser_option_wrap_column_zero",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
+      type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+      expectedError:
+        "Invalid unparser option. Wrap column value must be greater than or equal to 1. Got 0 instead",
+    },
+    {
+      original: {
+        name: "bad_unparser_option_wrap_column_negative",
+        expr: "null",
+      },
+      section: "TestUnparseErrors",
+      wrapOnColumn: -1,
+      ast: "null^#*expr.Constant_NullValue#",
+      unparseError:
+        "Invalid unparser option. Wrap column value must be greater than or equal to 1. Got -1 instead",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "bad_unparser_option_wrap_column_negative",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
+      type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+      expectedError:
+        "Invalid unparser option. Wrap column value must be greater than or equal to 1. Got -1 instead",
+    },
+    {
+      original: {
+        name: "bad_unparser_option_unsupported_operator",
+        expr: "null",
+      },
+      section: "TestUnparseErrors",
+      wrapOnOperators: ["bogus"],
+      ast: "null^#*expr.Constant_NullValue#",
+      unparseError: "Invalid unparser option. Unsupported operator: bogus",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "bad_unparser_option_unsupported_operator",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
+      type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+      expectedError: "Invalid unparser option. Unsupported operator: bogus",
+    },
+    {
+      original: { name: "bad_unparser_option_unary_operator", expr: "null" },
+      section: "TestUnparseErrors",
+      wrapOnOperators: ["-_"],
+      ast: "null^#*expr.Constant_NullValue#",
+      unparseError:
+        "Invalid unparser option. Unary operators are unsupported: -_",
+      locationAst: "null^#1[1,0]#",
+      positions: [[1, 0, 4, 1, 0, 1, 4]],
+      lineOffsets: [5],
+      parsedExpr: {
+        expr: { id: "1", constExpr: { nullValue: null } },
+        sourceInfo: {
+          location: "bad_unparser_option_unary_operator",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+      },
+      checkedAst: "null~null",
+      checkedExpr: {
+        typeMap: { "1": { null: null } },
+        sourceInfo: {
+          location: "\u003cinput\u003e",
+          lineOffsets: [5],
+          positions: { "1": 0 },
+        },
+        expr: { id: "1", constExpr: { nullValue: null } },
+      },
+      type: "null",
+      cost: { min: "0", max: "0" },
+      result: { value: { nullValue: null } },
+      runtimeCost: "0",
+      expectedError:
+        "Invalid unparser option. Unary operators are unsupported: -_",
+    },
+  ],
+} as const;
@@ -12,14 +12,17 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-import { getCheckingSuite } from "@bufbuild/cel-spec/testdata/tests.js";
+import {
+  getCheckingSuite,
+  type IncrementalTest,
+} from "@bufbuild/cel-spec/testdata/tests.js";
 import {
   createExpressionFilter,
   runCheckingTest,
   runTestSuite,
 } from "./testing.js";
 
-const filter = createExpressionFilter([
+const expressionFilter = createExpressionFilter([
   "[]",
   "[1]",
   '[1, "A"]',
@@ -103,4 +106,16 @@ const filter = createExpressionFilter([
   "{}.map(c,[c,type(c)])",
 ]);
 
+// cel-es parses without variadic operator ASTs or optional syntax, so it cannot
+// reproduce the checked ASTs of the tests that enable them.
+function filter(path: string[], test: IncrementalTest): boolean {
+  if (
+    (test.variadicAsts || test.optionalSyntax) &&
+    test.checkedAst !== undefined
+  ) {
+    return false;
+  }
+  return expressionFilter(path, test);
+}
+
 runTestSuite(getCheckingSuite(), runCheckingTest, [], filter);
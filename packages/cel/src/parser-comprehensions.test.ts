@@ -13,6 +13,24 @@
 // limitations under the License.
 
 import { getComprehensionSuite } from "@bufbuild/cel-spec/testdata/tests.js";
-import { runParsingTest, runTestSuite } from "./testing.js";
+import {
+  createExpressionFilter,
+  runParsingTest,
+  runTestSuite,
+} from "./testing.js";
 
-runTestSuite(getComprehensionSuite(), runParsingTest, []);
+const filter = createExpressionFilter([
+  // optional syntax is not supported
+  "\n\t\tcel.bind(listA, [1, 2, 3, 4],\n\t\tcel.bind(listB, [1, 2, 3, 4, 5],\n\t\t   listA.all(i, v, listB[?i].hasValue() && listB[i] == v)\n\t\t))\n\t\t",
+  "\n\t\tcel.bind(listA, [1, 2, 3, 4, 5, 6],\n\t\tcel.bind(listB, [1, 2, 3, 4, 5],\n\t\t   listA.all(i, v, listB[?i].hasValue() && listB[i] == v)\n\t\t)) == false\n\t\t",
+  "\n\t\tcel.bind(l, ['hello', 'world', 'hello!', 'worlds'],\n\t\t  l.exists(i, v,\n\t\t    v.startsWith('hello') && l[?(i+1)].optMap(next, next.endsWith('world')).orValue(false)\n\t\t  )\n\t\t)\n\t\t",
+  "\n\t\tcel.bind(l, ['hello', 'world', 'hello!', 'worlds'],\n\t\t  l.existsOne(i, v,\n\t\t    v.startsWith('hello') && l[?(i+1)].optMap(next, next.endsWith('world')).orValue(false)\n\t\t  )\n\t\t)\n\t\t",
+  "\n\t\tcel.bind(l, ['hello', 'goodbye', 'hello!', 'goodbye'],\n\t\t  l.exists_one(i, v,\n\t\t    v.startsWith('hello') && l[?(i+1)].optMap(next, next == \"goodbye\").orValue(false)\n\t\t  )\n\t\t) == false\n\t\t",
+  "\n\t\t\"key1:value1:extra key2:value2 key3\".split(\" \")\n\t\t.transformMapEntry(i, v,\n\t\t  cel.bind(entry, v.split(\":\"), {?entry[0]: entry[?1]})\n\t\t) == {'key1': 'value1', 'key2': 'value2'}\n\t\t",
+  "x.exists(val, y.exists(key, _, key == val)) || (x[?0].hasValue() && x[?1].hasValue())",
+  "x.exists(key, val, y[?key] == optional.of(val))",
+  "y.exists(key, y[?key] == x[?key])",
+  "cel.bind(z, y[0], x.all(i, val, val == z || optional.of(val) == y[?i]))",
+]);
+
+runTestSuite(getComprehensionSuite(), runParsingTest, [], filter);
@@ -16,15 +16,69 @@ import { getConformanceSuite } from "@bufbuild/cel-spec/testdata/tests.js";
 import { createPathFilter, runParsingTest, runTestSuite } from "./testing.js";
 
 const filter = createPathFilter([
-  ["block_ext", "basic", "multiple_macros_1"],
-  ["block_ext", "basic", "multiple_macros_2"],
-  ["block_ext", "basic", "multiple_macros_3"],
-  ["block_ext", "basic", "nested_macros_1"],
-  ["block_ext", "basic", "nested_macros_2"],
-  ["block_ext", "basic", "adjacent_macros"],
-  ["block_ext", "basic", "macro_shadowed_variable_1"],
-  ["block_ext", "basic", "macro_shadowed_variable_2"],
-  ["parse", "nest", "parens"],
+  // cel.block macros are not supported
+  ["block_ext", "basic"],
+  // optional syntax is not supported
+  ["optionals", "optionals", "empty_list_optindex_hasValue"],
+  ["optionals", "optionals", "empty_map_optFlatMap_hasValue"],
+  ["optionals", "optionals", "empty_struct_optindex_hasValue"],
+  ["optionals", "optionals", "has_map_optindex"],
+  ["optionals", "optionals", "has_map_optindex_field"],
+  [
+    "optionals",
+    "optionals",
+    "has_optional_ofNonZeroValue_struct_optional_ofNonZeroValue_map_optindex_field",
+  ],
+  ["optionals", "optionals", "list_optindex_value"],
+  ["optionals", "optionals", "map_absent_key_absent_field_none"],
+  ["optionals", "optionals", "map_empty_submap_optFlatMap_hasValue"],
+  ["optionals", "optionals", "map_key_mixed_numbers_double_key_optindex_value"],
+  ["optionals", "optionals", "map_key_mixed_numbers_int_key_optindex_value"],
+  ["optionals", "optionals", "map_key_mixed_numbers_uint_key_optindex_value"],
+  ["optionals", "optionals", "map_key_mixed_type_optindex_value"],
+  ["optionals", "optionals", "map_null_entry_hasValue"],
+  ["optionals", "optionals", "map_null_entry_no_such_key"],
+  ["optionals", "optionals", "map_optindex_hasValue"],
+  [
+    "optionals",
+    "optionals",
+    "map_optindex_optFlatMap_optional_ofNonZeroValue_hasValue",
+  ],
+  ["optionals", "optionals", "map_optional_entry_has"],
+  ["optionals", "optionals", "map_present_key_invalid_field"],
+  ["optionals", "optionals", "map_submap_optFlatMap_value"],
+  ["optionals", "optionals", "map_submap_subkey_optFlatMap_value"],
+  ["optionals", "optionals", "map_undefined_entry_hasValue"],
+  ["optionals", "optionals", "optional_chaining_11"],
+  ["optionals", "optionals", "optional_chaining_12"],
+  ["optionals", "optionals", "optional_chaining_13"],
+  ["optionals", "optionals", "optional_chaining_14"],
+  ["optionals", "optionals", "optional_chaining_15"],
+  ["optionals", "optionals", "optional_chaining_16"],
+  ["optionals", "optionals", "optional_chaining_2"],
+  ["optionals", "optionals", "optional_chaining_3"],
+  ["optionals", "optionals", "optional_empty_list_optindex_hasValue"],
+  ["optionals", "optionals", "optional_empty_map_optindex_hasValue"],
+  ["optionals", "optionals", "optional_empty_struct_optindex_hasValue"],
+  ["optionals", "optionals", "optional_list_optindex_value"],
+  ["optionals", "optionals", "optional_none_optindex_hasValue"],
+  ["optionals", "optionals", "optional_none_optselect_hasValue"],
+  [
+    "optionals",
+    "optionals",
+    "optional_ofNonZeroValue_struct_optional_ofNonZeroValue_map_optindex_field",
+  ],
+  ["optionals", "optionals", "optional_struct_optindex_index_value"],
+  ["optionals", "optionals", "optional_struct_optindex_value"],
+  ["optionals", "optionals", "struct_list_optindex_field"],
+  ["optionals", "optionals", "struct_map_optindex_field"],
+  ["optionals", "optionals", "struct_map_optindex_field_nested"],
+  ["optionals", "optionals", "struct_optindex_value"],
+  [
+    "optionals",
+    "optionals",
+    "struct_optional_ofNonZeroValue_map_optindex_field",
+  ],
 ]);
 
 runTestSuite(getConformanceSuite(), runParsingTest, [], filter);